@@ -127,6 +127,10 @@ config.yaml: |
 {{- if .Values.config.gardenClientConnection.kubeconfigValidity }}
     kubeconfigValidity:
 {{ toYaml .Values.config.gardenClientConnection.kubeconfigValidity | indent 6 }}
+    {{- end }}
+    {{- if .Values.config.gardenClientConnection.adaptiveRateLimiting }}
+    adaptiveRateLimiting:
+{{ toYaml .Values.config.gardenClientConnection.adaptiveRateLimiting | indent 6 }}
     {{- end }}
     {{- if .Values.config.gardenClientConnection.kubeconfig }}
     kubeconfig: /etc/gardenlet/kubeconfig-garden/kubeconfig
@@ -140,6 +144,10 @@ config.yaml: |
     {{- end }}
     qps: {{ required ".Values.config.seedClientConnection.qps is required" .Values.config.seedClientConnection.qps }}
     burst: {{ required ".Values.config.seedClientConnection.burst is required" .Values.config.seedClientConnection.burst }}
+    {{- if .Values.config.seedClientConnection.adaptiveRateLimiting }}
+    adaptiveRateLimiting:
+{{ toYaml .Values.config.seedClientConnection.adaptiveRateLimiting | indent 6 }}
+    {{- end }}
     {{- if .Values.config.seedClientConnection.kubeconfig }}
     kubeconfig: /etc/gardenlet/kubeconfig-seed/kubeconfig
     {{- end }}
@@ -169,11 +177,26 @@ config.yaml: |
     {{- if .Values.config.controllers.controllerInstallation }}
     controllerInstallation:
       concurrentSyncs: {{ required ".Values.config.controllers.controllerInstallation.concurrentSyncs is required" .Values.config.controllers.controllerInstallation.concurrentSyncs }}
+      {{- if .Values.config.controllers.controllerInstallation.ociVerification }}
+      ociVerification:
+        publicKeys:
+{{ toYaml (required ".Values.config.controllers.controllerInstallation.ociVerification.publicKeys is required" .Values.config.controllers.controllerInstallation.ociVerification.publicKeys) | indent 8 }}
+      {{- end }}
     {{- end }}
     {{- if .Values.config.controllers.controllerInstallationCare }}
     controllerInstallationCare:
       concurrentSyncs: {{ required ".Values.config.controllers.controllerInstallationCare.concurrentSyncs is required" .Values.config.controllers.controllerInstallationCare.concurrentSyncs }}
       syncPeriod: {{ required ".Values.config.controllers.controllerInstallationCare.syncPeriod is required" .Values.config.controllers.controllerInstallationCare.syncPeriod }}
+      {{- if .Values.config.controllers.controllerInstallationCare.heartbeatRestart }}
+      heartbeatRestart:
+        enabled: {{ required ".Values.config.controllers.controllerInstallationCare.heartbeatRestart.enabled is required" .Values.config.controllers.controllerInstallationCare.heartbeatRestart.enabled }}
+        {{- if .Values.config.controllers.controllerInstallationCare.heartbeatRestart.threshold }}
+        threshold: {{ .Values.config.controllers.controllerInstallationCare.heartbeatRestart.threshold }}
+        {{- end }}
+        {{- if .Values.config.controllers.controllerInstallationCare.heartbeatRestart.maxRestartsPerHour }}
+        maxRestartsPerHour: {{ .Values.config.controllers.controllerInstallationCare.heartbeatRestart.maxRestartsPerHour }}
+        {{- end }}
+      {{- end }}
     {{- end }}
     {{- if .Values.config.controllers.controllerInstallationRequired }}
     controllerInstallationRequired:
@@ -223,17 +246,56 @@ config.yaml: |
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 6 }}
       {{- end }}
       webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+      {{- if .Values.config.controllers.shootCare.remediations }}
+      remediations:
+{{ toYaml .Values.config.controllers.shootCare.remediations | indent 8 }}
+      {{- end }}
+      {{- if .Values.config.controllers.shootCare.nodeLeaseProbe }}
+      nodeLeaseProbe:
+{{ toYaml .Values.config.controllers.shootCare.nodeLeaseProbe | indent 8 }}
+      {{- end }}
     seedCare:
       syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
       conditionThresholds:
       {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 6 }}
       {{- end }}
+    {{- if .Values.config.controllers.seedGarbageCollection }}
+    seedGarbageCollection:
+      syncPeriod: {{ required ".Values.config.controllers.seedGarbageCollection.syncPeriod is required" .Values.config.controllers.seedGarbageCollection.syncPeriod }}
+      gracePeriod: {{ required ".Values.config.controllers.seedGarbageCollection.gracePeriod is required" .Values.config.controllers.seedGarbageCollection.gracePeriod }}
+      dryRun: {{ required ".Values.config.controllers.seedGarbageCollection.dryRun is required" .Values.config.controllers.seedGarbageCollection.dryRun }}
+    {{- end }}
+    {{- if .Values.config.controllers.seedZoneOutage }}
+    seedZoneOutage:
+      syncPeriod: {{ required ".Values.config.controllers.seedZoneOutage.syncPeriod is required" .Values.config.controllers.seedZoneOutage.syncPeriod }}
+      outageThreshold: {{ required ".Values.config.controllers.seedZoneOutage.outageThreshold is required" .Values.config.controllers.seedZoneOutage.outageThreshold }}
+      degradationMode: {{ required ".Values.config.controllers.seedZoneOutage.degradationMode is required" .Values.config.controllers.seedZoneOutage.degradationMode }}
+    {{- end }}
     {{- if .Values.config.controllers.shootState }}
     shootState:
       concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
       syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
     {{- end }}
+    {{- if .Values.config.controllers.shootAvailability }}
+    shootAvailability:
+      concurrentSyncs: {{ required ".Values.config.controllers.shootAvailability.concurrentSyncs is required" .Values.config.controllers.shootAvailability.concurrentSyncs }}
+      syncPeriod: {{ required ".Values.config.controllers.shootAvailability.syncPeriod is required" .Values.config.controllers.shootAvailability.syncPeriod }}
+      objective: {{ required ".Values.config.controllers.shootAvailability.objective is required" .Values.config.controllers.shootAvailability.objective }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootLeftover }}
+    shootLeftover:
+      concurrentSyncs: {{ required ".Values.config.controllers.shootLeftover.concurrentSyncs is required" .Values.config.controllers.shootLeftover.concurrentSyncs }}
+      syncPeriod: {{ required ".Values.config.controllers.shootLeftover.syncPeriod is required" .Values.config.controllers.shootLeftover.syncPeriod }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootSecurityAdvisory }}
+    shootSecurityAdvisory:
+      concurrentSyncs: {{ required ".Values.config.controllers.shootSecurityAdvisory.concurrentSyncs is required" .Values.config.controllers.shootSecurityAdvisory.concurrentSyncs }}
+      syncPeriod: {{ required ".Values.config.controllers.shootSecurityAdvisory.syncPeriod is required" .Values.config.controllers.shootSecurityAdvisory.syncPeriod }}
+      {{- if .Values.config.controllers.shootSecurityAdvisory.feedConfigMapName }}
+      feedConfigMapName: {{ .Values.config.controllers.shootSecurityAdvisory.feedConfigMapName }}
+      {{- end }}
+    {{- end }}
     {{- if .Values.config.controllers.managedSeed }}
     managedSeed:
       concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -280,6 +342,10 @@ config.yaml: |
     resourceNamespace: {{ .Values.config.leaderElection.resourceNamespace }}
     {{- end }}
   logLevel: {{ .Values.config.logLevel }}
+  {{- if .Values.config.logLevelOverrides }}
+  logLevelOverrides:
+{{ toYaml .Values.config.logLevelOverrides | indent 4 }}
+  {{- end }}
   logFormat: {{ .Values.config.logFormat }}
   server:
     healthProbes:
@@ -95,6 +95,15 @@ config.yaml: |
 {{ toYaml .Values.config.controllers.gardenCare.conditionThresholds | indent 6 }}
       {{- end }}
     {{- end }}
+    {{- if .Values.config.controllers.gardenDrift }}
+    gardenDrift:
+      {{- if .Values.config.controllers.gardenDrift.syncPeriod }}
+      syncPeriod: {{ .Values.config.controllers.gardenDrift.syncPeriod }}
+      {{- end }}
+      {{- if .Values.config.controllers.gardenDrift.autoRevert }}
+      autoRevert: {{ .Values.config.controllers.gardenDrift.autoRevert }}
+      {{- end }}
+    {{- end }}
     {{- if .Values.config.controllers.networkPolicy }}
     networkPolicy:
       {{- if .Values.config.controllers.networkPolicy.concurrentSyncs }}
@@ -7,6 +7,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
@@ -71,7 +72,8 @@ func run(ctx context.Context, log logr.Logger, cfg *config.AdmissionControllerCo
 
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers = maps.Clone(routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.LogLevelHandlers)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
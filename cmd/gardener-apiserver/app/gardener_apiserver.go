@@ -61,6 +61,7 @@ import (
 	seedmanagementinformers "github.com/gardener/gardener/pkg/client/seedmanagement/informers/externalversions"
 	settingsclientset "github.com/gardener/gardener/pkg/client/settings/clientset/versioned"
 	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	"github.com/gardener/gardener/pkg/controllerutils/routes"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
 	plugin "github.com/gardener/gardener/plugin/pkg"
@@ -308,6 +309,10 @@ func (o *Options) Run(ctx context.Context) error {
 		return err
 	}
 
+	for path, handler := range routes.FeatureGatesHandlers {
+		server.GenericAPIServer.Handler.NonGoRestfulMux.Handle(path, handler)
+	}
+
 	if err := server.GenericAPIServer.AddPostStartHook("start-gardener-apiserver-informers", func(context genericapiserver.PostStartHookContext) error {
 		o.CoreInformerFactory.Start(context.StopCh)
 		o.KubeInformerFactory.Start(context.StopCh)
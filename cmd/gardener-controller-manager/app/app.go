@@ -7,8 +7,8 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
-	"net/http"
 	"os"
 	goruntime "runtime"
 	"strconv"
@@ -84,10 +84,14 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ControllerManagerConf
 	if err != nil {
 		return err
 	}
+	if features.DefaultFeatureGate.Enabled(features.MutationAttribution) {
+		kubernetes.AttributeMutations(restConfig)
+	}
 
-	var extraHandlers map[string]http.Handler
+	extraHandlers := maps.Clone(routes.FeatureGatesHandlers)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.LogLevelHandlers)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
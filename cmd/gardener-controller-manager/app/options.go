@@ -74,3 +74,7 @@ func (o *options) Validate() error {
 func (o *options) LogConfig() (string, string) {
 	return o.config.LogLevel, o.config.LogFormat
 }
+
+func (o *options) LogLevelOverrides() map[string]string {
+	return o.config.LogLevelOverrides
+}
@@ -37,6 +37,7 @@ import (
 	extensionsheartbeatcmd "github.com/gardener/gardener/extensions/pkg/controller/heartbeat/cmd"
 	extensionscmdwebhook "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	localinstall "github.com/gardener/gardener/pkg/provider-local/apis/local/install"
@@ -45,12 +46,16 @@ import (
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
 	localcontrolplane "github.com/gardener/gardener/pkg/provider-local/controller/controlplane"
 	localdnsrecord "github.com/gardener/gardener/pkg/provider-local/controller/dnsrecord"
+	localextensionseed "github.com/gardener/gardener/pkg/provider-local/controller/extension/seed"
+	localextensionshoot "github.com/gardener/gardener/pkg/provider-local/controller/extension/shoot"
+	localextensionshootafterworker "github.com/gardener/gardener/pkg/provider-local/controller/extension/shootafterworker"
 	localhealthcheck "github.com/gardener/gardener/pkg/provider-local/controller/healthcheck"
 	localinfrastructure "github.com/gardener/gardener/pkg/provider-local/controller/infrastructure"
 	localingress "github.com/gardener/gardener/pkg/provider-local/controller/ingress"
 	localoperatingsystemconfig "github.com/gardener/gardener/pkg/provider-local/controller/operatingsystemconfig"
 	localservice "github.com/gardener/gardener/pkg/provider-local/controller/service"
 	localworker "github.com/gardener/gardener/pkg/provider-local/controller/worker"
+	"github.com/gardener/gardener/pkg/provider-local/faultinjection"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
@@ -140,6 +145,9 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			Namespace:            os.Getenv("LEADER_ELECTION_NAMESPACE"),
 		}
 
+		// options for injecting faults into the operations of the actuators
+		faultInjectionOpts = &faultinjection.Options{}
+
 		// options for the webhook server
 		webhookServerOptions = &extensionscmdwebhook.ServerOptions{
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
@@ -171,6 +179,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			extensionscmdcontroller.PrefixOption("heartbeat-", heartbeatCtrlOptions),
 			controllerSwitches,
 			reconcileOpts,
+			faultInjectionOpts,
 			webhookOptions,
 		)
 	)
@@ -254,6 +263,17 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&localoperatingsystemconfig.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&localworker.DefaultAddOptions.IgnoreOperationAnnotation)
 
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.BackupBucketResource, &localbackupbucket.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.BackupEntryResource, &localbackupentry.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.ControlPlaneResource, &localcontrolplane.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.DNSRecordResource, &localdnsrecord.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.ExtensionResource, &localextensionseed.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.ExtensionResource, &localextensionshoot.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.ExtensionResource, &localextensionshootafterworker.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.InfrastructureResource, &localinfrastructure.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.OperatingSystemConfigResource, &localoperatingsystemconfig.DefaultAddOptions.FaultInjection)
+			faultInjectionOpts.Completed().Apply(extensionsv1alpha1.WorkerResource, &localworker.DefaultAddOptions.FaultInjection)
+
 			if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
 				return fmt.Errorf("could not add readycheck for informers: %w", err)
 			}
@@ -360,11 +380,7 @@ func (w *webhookTriggerer) Start(ctx context.Context) error {
 		return err
 	}
 
-	if err := w.trigger(ctx, w.client, nil, w.client.Status(), &corev1.NodeList{}); err != nil {
-		return err
-	}
-
-	return w.trigger(ctx, w.client, w.client, nil, &appsv1.DeploymentList{}, client.MatchingLabels{"app": "dependency-watchdog-prober"})
+	return w.trigger(ctx, w.client, nil, w.client.Status(), &corev1.NodeList{})
 }
 
 func (w *webhookTriggerer) trigger(ctx context.Context, reader client.Reader, writer client.Writer, statusWriter client.StatusWriter, objectList client.ObjectList, opts ...client.ListOption) error {
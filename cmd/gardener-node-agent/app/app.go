@@ -8,8 +8,8 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
+	"maps"
 	"net"
-	"net/http"
 	"os"
 	"path/filepath"
 	goruntime "runtime"
@@ -134,9 +134,10 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		}
 	}
 
-	var extraHandlers map[string]http.Handler
+	extraHandlers := maps.Clone(routes.FeatureGatesHandlers)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.LogLevelHandlers)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
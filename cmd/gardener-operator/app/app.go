@@ -7,8 +7,8 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
-	"net/http"
 	"os"
 	goruntime "runtime"
 	"strconv"
@@ -85,9 +85,10 @@ func run(ctx context.Context, log logr.Logger, cfg *config.OperatorConfiguration
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	extraHandlers := maps.Clone(routes.FeatureGatesHandlers)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.LogLevelHandlers)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -7,8 +7,8 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
-	"net/http"
 	"os"
 	goruntime "runtime"
 	"strconv"
@@ -16,6 +16,9 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +30,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/component-base/tracing"
 	"k8s.io/component-base/version/verflag"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -95,6 +100,25 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *config.GardenletConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracer provider for exporting spans via OTLP")
+		tracerProvider, err := tracing.NewProvider(ctx, cfg.Tracing, nil, []resource.Option{resource.WithAttributes(semconv.ServiceName("gardenlet"))})
+		if err != nil {
+			return fmt.Errorf("failed creating tracer provider: %w", err)
+		}
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer shutdownCancel()
+
+			if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+				log.Error(err, "Failed shutting down tracer provider")
+			}
+		}()
+
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetTextMapPropagator(tracing.Propagators())
+	}
+
 	if kubeconfig := os.Getenv("GARDEN_KUBECONFIG"); kubeconfig != "" {
 		cfg.GardenClientConnection.Kubeconfig = kubeconfig
 	}
@@ -107,10 +131,12 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 	if err != nil {
 		return err
 	}
+	applyAdaptiveRateLimiting(seedRESTConfig, cfg.SeedClientConnection.ClientConnectionConfiguration, cfg.SeedClientConnection.AdaptiveRateLimiting, "seed")
 
-	var extraHandlers map[string]http.Handler
+	extraHandlers := maps.Clone(routes.FeatureGatesHandlers)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		maps.Copy(extraHandlers, routes.ProfilingHandlers)
+		maps.Copy(extraHandlers, routes.LogLevelHandlers)
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -220,11 +246,25 @@ func (g *garden) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	applyAdaptiveRateLimiting(gardenRESTConfig, g.config.GardenClientConnection.ClientConnectionConfiguration, g.config.GardenClientConnection.AdaptiveRateLimiting, "garden")
+	if features.DefaultFeatureGate.Enabled(features.MutationAttribution) {
+		kubernetes.AttributeMutations(gardenRESTConfig)
+	}
+
+	// The event broadcaster aggregates similar events and deduplicates identical events before they are sent to the
+	// garden cluster, see https://github.com/kubernetes/client-go/blob/master/tools/record/events_cache.go.
+	eventBroadcaster := record.NewBroadcaster(record.WithCorrelatorOptions(gardenlethelper.EventCorrelatorOptions(g.config)))
+	go func() {
+		<-ctx.Done()
+		eventBroadcaster.Shutdown()
+	}()
 
 	log.Info("Setting up cluster object for garden")
 	gardenCluster, err := cluster.New(gardenRESTConfig, func(opts *cluster.Options) {
 		opts.Scheme = kubernetes.GardenScheme
 		opts.Logger = log
+		// The broadcaster is shut down when the context is cancelled, hence it does not leak.
+		opts.EventBroadcaster = eventBroadcaster //nolint:staticcheck
 
 		opts.Client.Cache = &client.CacheOptions{
 			DisableFor: []client.Object{
@@ -418,7 +458,13 @@ func (g *garden) registerSeed(ctx context.Context, gardenClient client.Client) e
 			v1beta1constants.GardenRole: v1beta1constants.GardenRoleSeed,
 		}, g.config.SeedConfig.Labels)
 
+		// The operation might have been set directly on the Seed resource by an operator (e.g., to decommission it),
+		// hence it is only overwritten if it is explicitly specified in the seed config.
+		operation := seed.Spec.Operation
 		seed.Spec = cfg.SeedConfig.Spec
+		if seed.Spec.Operation == nil {
+			seed.Spec.Operation = operation
+		}
 		return nil
 	}); err != nil {
 		return fmt.Errorf("could not register seed %q: %w", seed.Name, err)
@@ -487,6 +533,7 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 		indexer.AddControllerInstallationRegistrationRefName,
 		// operations API group
 		indexer.AddBastionShootName,
+		indexer.AddSSHAccessRequestShootName,
 		// seedmanagement API group
 		indexer.AddManagedSeedShootName,
 	} {
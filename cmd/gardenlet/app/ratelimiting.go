@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/client-go/rest"
+	componentbaseconfig "k8s.io/component-base/config"
+	"k8s.io/utils/clock"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// clientRateLimiterQPS defines the gauge gardenlet_client_rate_limiter_qps.
+var clientRateLimiterQPS = promauto.With(runtimemetrics.Registry).NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: "gardenlet",
+		Name:      "client_rate_limiter_qps",
+		Help:      "Effective queries per second of the adaptive client-side rate limiter for requests to the garden or seed cluster.",
+	},
+	[]string{"cluster"},
+)
+
+// applyAdaptiveRateLimiting replaces the fixed client-side rate limit of the given REST config by an adaptive one if
+// configured. The cluster is used as label for the exported metric.
+func applyAdaptiveRateLimiting(restConfig *rest.Config, clientConnection componentbaseconfig.ClientConnectionConfiguration, adaptiveRateLimiting *config.AdaptiveRateLimiting, cluster string) {
+	if adaptiveRateLimiting == nil {
+		return
+	}
+
+	gauge := clientRateLimiterQPS.WithLabelValues(cluster)
+	ratelimiter.NewAdaptive(
+		clock.RealClock{},
+		clientConnection.QPS,
+		adaptiveRateLimiting.MinQPS,
+		adaptiveRateLimiting.MaxQPS,
+		int(clientConnection.Burst),
+		func(qps float32) { gauge.Set(float64(qps)) },
+	).Apply(restConfig)
+}
@@ -28,6 +28,12 @@ type Options interface {
 	LogConfig() (logLevel, logFormat string)
 }
 
+// LogLevelOverridesOptions is an optional interface for options which configure log levels for individual controllers.
+type LogLevelOverridesOptions interface {
+	// LogLevelOverrides returns the log levels for individual controllers which override the global log level.
+	LogLevelOverrides() map[string]string
+}
+
 // InitRun initializes the run command by completing and validating the options, creating and settings a logger,
 // printing all command line flags, and configuring command settings.
 func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error) {
@@ -42,7 +48,16 @@ func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error)
 	}
 
 	logLevel, logFormat := opts.LogConfig()
-	log, err := logger.NewZapLogger(logLevel, logFormat)
+	if err := logger.DefaultLevels.SetGlobal(logLevel); err != nil {
+		return logr.Discard(), fmt.Errorf("error setting log level: %w", err)
+	}
+	if o, ok := opts.(LogLevelOverridesOptions); ok {
+		if err := logger.DefaultLevels.SetOverrides(o.LogLevelOverrides()); err != nil {
+			return logr.Discard(), fmt.Errorf("error setting log level overrides: %w", err)
+		}
+	}
+
+	log, err := logger.NewZapLogger(logLevel, logFormat, logger.WithLevels(logger.DefaultLevels))
 	if err != nil {
 		return logr.Discard(), fmt.Errorf("error instantiating zap logger: %w", err)
 	}
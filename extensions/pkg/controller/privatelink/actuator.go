@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package privatelink
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Actuator acts upon PrivateLink resources.
+type Actuator interface {
+	// Reconcile reconciles the PrivateLink resource.
+	Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.PrivateLink, *extensionscontroller.Cluster) error
+	// Delete deletes the PrivateLink resource.
+	Delete(context.Context, logr.Logger, *extensionsv1alpha1.PrivateLink, *extensionscontroller.Cluster) error
+	// ForceDelete forcefully deletes the PrivateLink resource.
+	ForceDelete(context.Context, logr.Logger, *extensionsv1alpha1.PrivateLink, *extensionscontroller.Cluster) error
+	// Restore restores the PrivateLink resource.
+	Restore(context.Context, logr.Logger, *extensionsv1alpha1.PrivateLink, *extensionscontroller.Cluster) error
+	// Migrate migrates the PrivateLink resource.
+	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.PrivateLink, *extensionscontroller.Cluster) error
+}
@@ -33,9 +33,11 @@ func LastError(description string, codes ...gardencorev1beta1.ErrorCode) *garden
 	now := metav1.Now()
 
 	return &gardencorev1beta1.LastError{
-		Description:    description,
-		Codes:          codes,
-		LastUpdateTime: &now,
+		Description:     description,
+		Codes:           codes,
+		Category:        v1beta1helper.ErrorCategoryForCodes(codes...),
+		RemediationHint: v1beta1helper.RemediationHintForCodes(codes...),
+		LastUpdateTime:  &now,
 	}
 }
 
@@ -151,6 +153,8 @@ func (s *statusUpdater) ErrorCustom(
 		lastOp, lastErr = ReconcileError(lastOperationType, errDescription, 50, v1beta1helper.ExtractErrorCodes(err)...)
 	)
 
+	lastErr.ProviderCodes = v1beta1helper.ExtractProviderErrorCodes(err)
+
 	log.Error(fmt.Errorf(errDescription), "Error") //nolint:logcheck
 
 	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
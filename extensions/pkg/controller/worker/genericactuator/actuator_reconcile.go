@@ -15,6 +15,7 @@ import (
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
@@ -313,6 +314,14 @@ func (a *genericActuator) waitUntilWantedMachineDeploymentsAvailable(ctx context
 		// map the owner reference to the machine sets
 		ownerReferenceToMachineSet := gardenerutils.BuildOwnerToMachineSetsMap(machineSets.Items)
 
+		// Report the rollout progress of the worker pools before checking the machine deployments, so that it is also
+		// visible if the rollout is stuck.
+		if !extensionscontroller.IsHibernationEnabled(cluster) {
+			if err := a.updateWorkerStatusWorkersRollout(ctx, worker, cluster, machineDeployments.Items, wantedMachineDeployments); err != nil {
+				return retryutils.SevereError(fmt.Errorf("failed to update the workers rollout in worker status: %w", err))
+			}
+		}
+
 		// Collect the numbers of available and desired replicas.
 		for _, deployment := range machineDeployments.Items {
 			wantedDeployment := wantedMachineDeployments.FindByName(deployment.Name)
@@ -445,6 +454,81 @@ func (a *genericActuator) updateWorkerStatusMachineDeployments(ctx context.Conte
 	return a.seedClient.Status().Patch(ctx, worker, patch)
 }
 
+func (a *genericActuator) updateWorkerStatusWorkersRollout(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster, machineDeployments []machinev1alpha1.MachineDeployment, wantedMachineDeployments extensionsworkercontroller.MachineDeployments) error {
+	var kubernetesVersion string
+	if cluster.Shoot != nil {
+		kubernetesVersion = cluster.Shoot.Spec.Kubernetes.Version
+	}
+
+	workersRollout := computeWorkersRollout(worker, kubernetesVersion, machineDeployments, wantedMachineDeployments)
+	if apiequality.Semantic.DeepEqual(worker.Status.WorkersRollout, workersRollout) {
+		return nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.WorkersRollout = workersRollout
+	return a.seedClient.Status().Patch(ctx, worker, patch)
+}
+
+// computeWorkersRollout computes the rollout progress of the worker pools of the given worker based on the existing
+// machine deployments. The machine deployments are assigned to the worker pools via the worker pool label of the
+// wanted machine deployments. The current machine image and kubelet version of a worker pool are only changed to the
+// target values once all machines of the pool have been updated.
+func computeWorkersRollout(worker *extensionsv1alpha1.Worker, kubernetesVersion string, machineDeployments []machinev1alpha1.MachineDeployment, wantedMachineDeployments extensionsworkercontroller.MachineDeployments) []extensionsv1alpha1.WorkerPoolRollout {
+	poolNameToRollout := make(map[string]*extensionsv1alpha1.WorkerPoolRollout)
+
+	for _, deployment := range machineDeployments {
+		wantedDeployment := wantedMachineDeployments.FindByName(deployment.Name)
+		if wantedDeployment == nil {
+			continue
+		}
+
+		poolName, ok := wantedDeployment.Labels[v1beta1constants.LabelWorkerPool]
+		if !ok {
+			continue
+		}
+
+		rollout, ok := poolNameToRollout[poolName]
+		if !ok {
+			rollout = &extensionsv1alpha1.WorkerPoolRollout{Name: poolName}
+			poolNameToRollout[poolName] = rollout
+		}
+
+		// During a rolling update, the machine deployment temporarily has more replicas than desired. All machines which
+		// are not updated yet are pending, no matter whether they still have to be replaced or drained.
+		rollout.MachinesUpdated += deployment.Status.UpdatedReplicas
+		rollout.MachinesPending += max(max(deployment.Spec.Replicas, deployment.Status.Replicas)-deployment.Status.UpdatedReplicas, 0)
+		rollout.MachinesFailed += int32(len(deployment.Status.FailedMachines))
+	}
+
+	workersRollout := make([]extensionsv1alpha1.WorkerPoolRollout, 0, len(worker.Spec.Pools))
+	for _, pool := range worker.Spec.Pools {
+		rollout, ok := poolNameToRollout[pool.Name]
+		if !ok {
+			rollout = &extensionsv1alpha1.WorkerPoolRollout{Name: pool.Name}
+		}
+
+		rollout.TargetMachineImage = &extensionsv1alpha1.MachineImage{Name: pool.MachineImage.Name, Version: pool.MachineImage.Version}
+		rollout.TargetKubeletVersion = ptr.To(ptr.Deref(pool.KubernetesVersion, kubernetesVersion))
+
+		for _, previous := range worker.Status.WorkersRollout {
+			if previous.Name == pool.Name {
+				rollout.CurrentMachineImage = previous.CurrentMachineImage
+				rollout.CurrentKubeletVersion = previous.CurrentKubeletVersion
+			}
+		}
+
+		if rollout.MachinesPending == 0 && rollout.MachinesFailed == 0 {
+			rollout.CurrentMachineImage = rollout.TargetMachineImage
+			rollout.CurrentKubeletVersion = rollout.TargetKubeletVersion
+		}
+
+		workersRollout = append(workersRollout, *rollout)
+	}
+
+	return workersRollout
+}
+
 // Helper functions
 
 func shootIsAwake(isHibernated bool, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) bool {
@@ -16,10 +16,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
@@ -300,4 +302,103 @@ var _ = Describe("Actuator", func() {
 			Expect(restoreMachineSetsAndMachines(ctx, logger, a.seedClient, machineDeployments)).To(Succeed())
 		})
 	})
+	Describe("#computeWorkersRollout", func() {
+		var (
+			extensionsWorker         *extensionsv1alpha1.Worker
+			machineDeployments       []machinev1alpha1.MachineDeployment
+			wantedMachineDeployments worker.MachineDeployments
+
+			oldImage = &extensionsv1alpha1.MachineImage{Name: "gardenlinux", Version: "1.0.0"}
+			newImage = &extensionsv1alpha1.MachineImage{Name: "gardenlinux", Version: "1.1.0"}
+		)
+
+		BeforeEach(func() {
+			extensionsWorker = &extensionsv1alpha1.Worker{
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{Name: "pool1", MachineImage: *newImage},
+						{Name: "pool2", MachineImage: *oldImage, KubernetesVersion: ptr.To("1.30.1")},
+					},
+				},
+				Status: extensionsv1alpha1.WorkerStatus{
+					WorkersRollout: []extensionsv1alpha1.WorkerPoolRollout{{
+						Name:                  "pool1",
+						CurrentMachineImage:   oldImage,
+						CurrentKubeletVersion: ptr.To("1.31.0"),
+					}},
+				},
+			}
+
+			machineDeployments = []machinev1alpha1.MachineDeployment{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool1-z1"},
+					Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: 2},
+					Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: 3, UpdatedReplicas: 1},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool1-z2"},
+					Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: 2},
+					Status: machinev1alpha1.MachineDeploymentStatus{
+						Replicas:        2,
+						UpdatedReplicas: 1,
+						FailedMachines:  []*machinev1alpha1.MachineSummary{{Name: "machine"}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pool2-z1"},
+					Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: 1},
+					Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: 1, UpdatedReplicas: 1},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "unwanted"},
+					Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: 1},
+					Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: 1},
+				},
+			}
+
+			wantedMachineDeployments = worker.MachineDeployments{
+				{Name: "pool1-z1", Labels: map[string]string{"worker.gardener.cloud/pool": "pool1"}},
+				{Name: "pool1-z2", Labels: map[string]string{"worker.gardener.cloud/pool": "pool1"}},
+				{Name: "pool2-z1", Labels: map[string]string{"worker.gardener.cloud/pool": "pool2"}},
+			}
+		})
+
+		It("should compute the rollout progress of all worker pools", func() {
+			Expect(computeWorkersRollout(extensionsWorker, "1.31.1", machineDeployments, wantedMachineDeployments)).To(Equal([]extensionsv1alpha1.WorkerPoolRollout{
+				{
+					Name:                  "pool1",
+					MachinesUpdated:       2,
+					MachinesPending:       3,
+					MachinesFailed:        1,
+					CurrentMachineImage:   oldImage,
+					TargetMachineImage:    newImage,
+					CurrentKubeletVersion: ptr.To("1.31.0"),
+					TargetKubeletVersion:  ptr.To("1.31.1"),
+				},
+				{
+					Name:                  "pool2",
+					MachinesUpdated:       1,
+					CurrentMachineImage:   oldImage,
+					TargetMachineImage:    oldImage,
+					CurrentKubeletVersion: ptr.To("1.30.1"),
+					TargetKubeletVersion:  ptr.To("1.30.1"),
+				},
+			}))
+		})
+
+		It("should update the current values once all machines of a pool are updated", func() {
+			for i := range machineDeployments {
+				machineDeployments[i].Status = machinev1alpha1.MachineDeploymentStatus{Replicas: machineDeployments[i].Spec.Replicas, UpdatedReplicas: machineDeployments[i].Spec.Replicas}
+			}
+
+			Expect(computeWorkersRollout(extensionsWorker, "1.31.1", machineDeployments, wantedMachineDeployments)).To(ContainElement(extensionsv1alpha1.WorkerPoolRollout{
+				Name:                  "pool1",
+				MachinesUpdated:       4,
+				CurrentMachineImage:   newImage,
+				TargetMachineImage:    newImage,
+				CurrentKubeletVersion: ptr.To("1.31.1"),
+				TargetKubeletVersion:  ptr.To("1.31.1"),
+			}))
+		})
+	})
 })
@@ -30,7 +30,7 @@ func DetermineError(err error, knownCodes map[gardencorev1beta1.ErrorCode]func(s
 		return err
 	}
 
-	return v1beta1helper.NewErrorWithCodes(err, codes...)
+	return v1beta1helper.NewErrorWithProviderCodes(err, v1beta1helper.ExtractProviderErrorCodes(err), codes...)
 }
 
 // DetermineErrorCodes determines error codes based on the given error.
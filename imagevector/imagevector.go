@@ -7,6 +7,7 @@ package imagevector
 
 import (
 	_ "embed"
+	"sync/atomic"
 
 	"k8s.io/apimachinery/pkg/util/runtime"
 
@@ -17,6 +18,9 @@ var (
 	//go:embed images.yaml
 	imagesYAML  string
 	imageVector imagevector.ImageVector
+	// overriddenImageVector is the image vector merged with the override set via SetOverride. It is nil if there is
+	// no override.
+	overriddenImageVector atomic.Pointer[imagevector.ImageVector]
 )
 
 func init() {
@@ -31,5 +35,20 @@ func init() {
 
 // ImageVector is the image vector that contains all the needed images.
 func ImageVector() imagevector.ImageVector {
+	if v := overriddenImageVector.Load(); v != nil {
+		return *v
+	}
 	return imageVector
 }
+
+// SetOverride merges the given override into the image vector returned by ImageVector. It replaces previously set
+// overrides. An empty override resets the image vector to its original state. It is safe for concurrent use.
+func SetOverride(override imagevector.ImageVector) {
+	if len(override) == 0 {
+		overriddenImageVector.Store(nil)
+		return
+	}
+
+	v := imagevector.Merge(imageVector, override)
+	overriddenImageVector.Store(&v)
+}
@@ -62,6 +62,7 @@ var (
 	eventCoreResource                 = corev1.Resource("events")
 	eventResource                     = eventsv1.Resource("events")
 	exposureClassResource             = gardencorev1beta1.Resource("exposureclasses")
+	imageVectorOverrideResource       = gardencorev1beta1.Resource("imagevectoroverrides")
 	internalSecretResource            = gardencorev1beta1.Resource("internalsecrets")
 	leaseResource                     = coordinationv1.Resource("leases")
 	managedSeedResource               = seedmanagementv1alpha1.Resource("managedseeds")
@@ -73,6 +74,7 @@ var (
 	serviceAccountResource            = corev1.Resource("serviceaccounts")
 	shootResource                     = gardencorev1beta1.Resource("shoots")
 	shootStateResource                = gardencorev1beta1.Resource("shootstates")
+	sshAccessRequestResource          = operationsv1alpha1.Resource("sshaccessrequests")
 )
 
 // TODO: Revisit all `DecisionNoOpinion` later. Today we cannot deny the request for backwards compatibility
@@ -152,6 +154,8 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 			return a.authorizeEvent(requestLog, attrs)
 		case exposureClassResource:
 			return a.authorizeRead(requestLog, seedName, graph.VertexTypeExposureClass, attrs)
+		case imageVectorOverrideResource:
+			return a.authorizeImageVectorOverride(requestLog, attrs)
 		case internalSecretResource:
 			return a.authorize(requestLog, seedName, graph.VertexTypeInternalSecret, attrs,
 				[]string{"get", "update", "patch", "delete", "list", "watch"},
@@ -204,6 +208,12 @@ func (a *authorizer) Authorize(_ context.Context, attrs auth.Attributes) (auth.D
 				[]string{"create"},
 				nil,
 			)
+		case sshAccessRequestResource:
+			return a.authorize(requestLog, seedName, graph.VertexTypeSSHAccessRequest, attrs,
+				[]string{"get"},
+				[]string{"list", "watch"},
+				nil,
+			)
 		default:
 			a.logger.Info(
 				"Unhandled resource request",
@@ -249,6 +259,20 @@ func (a *authorizer) authorizeEvent(log logr.Logger, attrs auth.Attributes) (aut
 	return auth.DecisionAllow, "", nil
 }
 
+func (a *authorizer) authorizeImageVectorOverride(log logr.Logger, attrs auth.Attributes) (auth.Decision, string, error) {
+	// ImageVectorOverrides are selected via label selectors for seeds and shoots, hence gardenlet must be able to read
+	// all of them in order to determine which apply to its seed.
+	if ok, reason := a.checkVerb(log, attrs, "get", "list", "watch"); !ok {
+		return auth.DecisionNoOpinion, reason, nil
+	}
+
+	if ok, reason := a.checkSubresource(log, attrs); !ok {
+		return auth.DecisionNoOpinion, reason, nil
+	}
+
+	return auth.DecisionAllow, "", nil
+}
+
 func (a *authorizer) authorizeLease(log logr.Logger, seedName string, userType seedidentity.UserType, attrs auth.Attributes) (auth.Decision, string, error) {
 	// extension clients may only work with leases in the seed namespace
 	if userType == seedidentity.UserTypeExtension {
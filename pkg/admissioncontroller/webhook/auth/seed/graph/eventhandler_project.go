@@ -36,7 +36,8 @@ func (g *graph) setupProjectWatch(_ context.Context, informer cache.Informer) er
 				return
 			}
 
-			if !apiequality.Semantic.DeepEqual(oldProject.Spec.Namespace, newProject.Spec.Namespace) {
+			if !apiequality.Semantic.DeepEqual(oldProject.Spec.Namespace, newProject.Spec.Namespace) ||
+				!apiequality.Semantic.DeepEqual(oldProject.Spec.AlertRoutes, newProject.Spec.AlertRoutes) {
 				g.handleProjectCreateOrUpdate(newProject)
 			}
 		},
@@ -72,6 +73,23 @@ func (g *graph) handleProjectCreateOrUpdate(project *gardencorev1beta1.Project)
 		)
 
 		g.addEdge(projectVertex, namespaceVertex)
+
+		// The secrets referenced by the alert routes are read by gardenlet when deploying the alertmanager of the shoots
+		// in this project.
+		for _, route := range project.Spec.AlertRoutes {
+			var secretName string
+			switch {
+			case route.Receiver.Webhook != nil:
+				secretName = route.Receiver.Webhook.SecretName
+			case route.Receiver.Slack != nil:
+				secretName = route.Receiver.Slack.SecretName
+			default:
+				continue
+			}
+
+			secretVertex := g.getOrCreateVertex(VertexTypeSecret, *project.Spec.Namespace, secretName)
+			g.addEdge(secretVertex, projectVertex)
+		}
 	}
 }
 
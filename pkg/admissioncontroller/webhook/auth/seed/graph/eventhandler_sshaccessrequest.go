@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"time"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+func (g *graph) setupSSHAccessRequestWatch(_ context.Context, informer cache.Informer) error {
+	_, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			sshAccessRequest, ok := obj.(*operationsv1alpha1.SSHAccessRequest)
+			if !ok {
+				return
+			}
+			g.handleSSHAccessRequestCreateOrUpdate(sshAccessRequest)
+		},
+
+		UpdateFunc: func(oldObj, newObj any) {
+			oldSSHAccessRequest, ok := oldObj.(*operationsv1alpha1.SSHAccessRequest)
+			if !ok {
+				return
+			}
+
+			newSSHAccessRequest, ok := newObj.(*operationsv1alpha1.SSHAccessRequest)
+			if !ok {
+				return
+			}
+
+			if oldSSHAccessRequest.Spec.ShootRef.Name != newSSHAccessRequest.Spec.ShootRef.Name {
+				g.handleSSHAccessRequestCreateOrUpdate(newSSHAccessRequest)
+			}
+		},
+
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			sshAccessRequest, ok := obj.(*operationsv1alpha1.SSHAccessRequest)
+			if !ok {
+				return
+			}
+			g.handleSSHAccessRequestDelete(sshAccessRequest)
+		},
+	})
+	return err
+}
+
+func (g *graph) handleSSHAccessRequestCreateOrUpdate(sshAccessRequest *operationsv1alpha1.SSHAccessRequest) {
+	start := time.Now()
+	defer func() {
+		metricUpdateDuration.WithLabelValues("SSHAccessRequest", "CreateOrUpdate").Observe(time.Since(start).Seconds())
+	}()
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.deleteVertex(VertexTypeSSHAccessRequest, sshAccessRequest.Namespace, sshAccessRequest.Name)
+
+	sshAccessRequestVertex := g.getOrCreateVertex(VertexTypeSSHAccessRequest, sshAccessRequest.Namespace, sshAccessRequest.Name)
+	shootVertex := g.getOrCreateVertex(VertexTypeShoot, sshAccessRequest.Namespace, sshAccessRequest.Spec.ShootRef.Name)
+	g.addEdge(sshAccessRequestVertex, shootVertex)
+}
+
+func (g *graph) handleSSHAccessRequestDelete(sshAccessRequest *operationsv1alpha1.SSHAccessRequest) {
+	start := time.Now()
+	defer func() {
+		metricUpdateDuration.WithLabelValues("SSHAccessRequest", "Delete").Observe(time.Since(start).Seconds())
+	}()
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.deleteVertex(VertexTypeSSHAccessRequest, sshAccessRequest.Namespace, sshAccessRequest.Name)
+}
@@ -70,6 +70,7 @@ func (g *graph) Setup(ctx context.Context, c cache.Cache) error {
 		{&gardencorev1beta1.Seed{}, g.setupSeedWatch},
 		{&corev1.ServiceAccount{}, g.setupServiceAccountWatch},
 		{&gardencorev1beta1.Shoot{}, g.setupShootWatch},
+		{&operationsv1alpha1.SSHAccessRequest{}, g.setupSSHAccessRequestWatch},
 	} {
 		informer, err := c.GetInformer(ctx, resource.obj)
 		if err != nil {
@@ -55,6 +55,7 @@ var _ = Describe("graph", func() {
 		fakeInformerManagedSeed               *controllertest.FakeInformer
 		fakeInformerCertificateSigningRequest *controllertest.FakeInformer
 		fakeInformerServiceAccount            *controllertest.FakeInformer
+		fakeInformerSSHAccessRequest          *controllertest.FakeInformer
 		fakeInformers                         *informertest.FakeInformers
 
 		log   logr.Logger
@@ -94,6 +95,8 @@ var _ = Describe("graph", func() {
 
 		bastion1 *operationsv1alpha1.Bastion
 
+		sshAccessRequest1 *operationsv1alpha1.SSHAccessRequest
+
 		secretBinding1          *gardencorev1beta1.SecretBinding
 		secretBinding1SecretRef = corev1.SecretReference{Namespace: "foobar", Name: "bazfoo"}
 
@@ -131,6 +134,7 @@ var _ = Describe("graph", func() {
 		fakeInformerManagedSeed = &controllertest.FakeInformer{}
 		fakeInformerCertificateSigningRequest = &controllertest.FakeInformer{}
 		fakeInformerServiceAccount = &controllertest.FakeInformer{}
+		fakeInformerSSHAccessRequest = &controllertest.FakeInformer{}
 
 		fakeInformers = &informertest.FakeInformers{
 			Scheme: scheme,
@@ -146,6 +150,7 @@ var _ = Describe("graph", func() {
 				seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeed"):       fakeInformerManagedSeed,
 				certificatesv1.SchemeGroupVersion.WithKind("CertificateSigningRequest"): fakeInformerCertificateSigningRequest,
 				corev1.SchemeGroupVersion.WithKind("ServiceAccount"):                    fakeInformerServiceAccount,
+				operationsv1alpha1.SchemeGroupVersion.WithKind("SSHAccessRequest"):      fakeInformerSSHAccessRequest,
 			},
 		}
 
@@ -256,6 +261,13 @@ var _ = Describe("graph", func() {
 			},
 		}
 
+		sshAccessRequest1 = &operationsv1alpha1.SSHAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "sshaccessrequest1", Namespace: shoot1.Namespace},
+			Spec: operationsv1alpha1.SSHAccessRequestSpec{
+				ShootRef: corev1.LocalObjectReference{Name: shoot1.Name},
+			},
+		}
+
 		secretBinding1 = &gardencorev1beta1.SecretBinding{
 			ObjectMeta: metav1.ObjectMeta{Name: "secretbinding1", Namespace: "sb1namespace"},
 			SecretRef:  secretBinding1SecretRef,
@@ -740,12 +752,27 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeProject, "", project1.Name, VertexTypeNamespace, "", *project1Copy.Spec.Namespace)).To(BeFalse())
 		Expect(graph.HasPathFrom(VertexTypeProject, "", project1.Name, VertexTypeNamespace, "", *project1.Spec.Namespace)).To(BeTrue())
 
+		By("Update (alert routes)")
+		project1Copy = project1.DeepCopy()
+		project1.Spec.AlertRoutes = []gardencorev1beta1.AlertRoute{
+			{Name: "mail", Receiver: gardencorev1beta1.AlertReceiver{Email: &gardencorev1beta1.EmailAlertReceiver{To: []string{"foo@example.com"}}}},
+			{Name: "webhook", Receiver: gardencorev1beta1.AlertReceiver{Webhook: &gardencorev1beta1.WebhookAlertReceiver{SecretName: "webhook"}}},
+			{Name: "slack", Receiver: gardencorev1beta1.AlertReceiver{Slack: &gardencorev1beta1.SlackAlertReceiver{SecretName: "slack"}}},
+		}
+		fakeInformerProject.Update(project1Copy, project1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(4))
+		Expect(graph.graph.Edges().Len()).To(Equal(3))
+		Expect(graph.HasPathFrom(VertexTypeProject, "", project1.Name, VertexTypeNamespace, "", *project1.Spec.Namespace)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeSecret, *project1.Spec.Namespace, "webhook", VertexTypeProject, "", project1.Name)).To(BeTrue())
+		Expect(graph.HasPathFrom(VertexTypeSecret, *project1.Spec.Namespace, "slack", VertexTypeProject, "", project1.Name)).To(BeTrue())
+
 		By("Delete")
 		fakeInformerProject.Delete(project1)
 		Expect(graph.graph.Nodes().Len()).To(BeZero())
 		Expect(graph.graph.Edges().Len()).To(BeZero())
 		Expect(graph.HasPathFrom(VertexTypeProject, "", project1.Name, VertexTypeNamespace, "", *project1Copy.Spec.Namespace)).To(BeFalse())
 		Expect(graph.HasPathFrom(VertexTypeProject, "", project1.Name, VertexTypeNamespace, "", *project1.Spec.Namespace)).To(BeFalse())
+		Expect(graph.HasPathFrom(VertexTypeSecret, *project1.Spec.Namespace, "webhook", VertexTypeProject, "", project1.Name)).To(BeFalse())
 	})
 
 	It("should behave as expected for gardencorev1beta1.BackupBucket", func() {
@@ -912,6 +939,33 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasPathFrom(VertexTypeBastion, bastion1.Namespace, bastion1.Name, VertexTypeSeed, "", *bastion1.Spec.SeedName)).To(BeFalse())
 	})
 
+	It("should behave as expected for operationsv1alpha1.SSHAccessRequest", func() {
+		By("Add")
+		fakeInformerSSHAccessRequest.Add(sshAccessRequest1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(2))
+		Expect(graph.graph.Edges().Len()).To(Equal(1))
+		Expect(graph.HasPathFrom(VertexTypeSSHAccessRequest, sshAccessRequest1.Namespace, sshAccessRequest1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		By("Update (irrelevant change)")
+		sshAccessRequest1Copy := sshAccessRequest1.DeepCopy()
+		sshAccessRequest1.Spec.Reason = "foobar"
+		fakeInformerSSHAccessRequest.Update(sshAccessRequest1Copy, sshAccessRequest1)
+		Expect(graph.graph.Nodes().Len()).To(Equal(2))
+		Expect(graph.graph.Edges().Len()).To(Equal(1))
+		Expect(graph.HasPathFrom(VertexTypeSSHAccessRequest, sshAccessRequest1.Namespace, sshAccessRequest1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeTrue())
+
+		By("Add shoot")
+		fakeInformerShoot.Add(shoot1)
+		Expect(graph.HasPathFrom(VertexTypeSSHAccessRequest, sshAccessRequest1.Namespace, sshAccessRequest1.Name, VertexTypeSeed, "", *shoot1.Spec.SeedName)).To(BeTrue())
+
+		By("Delete")
+		fakeInformerShoot.Delete(shoot1)
+		fakeInformerSSHAccessRequest.Delete(sshAccessRequest1)
+		Expect(graph.graph.Nodes().Len()).To(BeZero())
+		Expect(graph.graph.Edges().Len()).To(BeZero())
+		Expect(graph.HasPathFrom(VertexTypeSSHAccessRequest, sshAccessRequest1.Namespace, sshAccessRequest1.Name, VertexTypeShoot, shoot1.Namespace, shoot1.Name)).To(BeFalse())
+	})
+
 	It("should behave as expected for gardencorev1beta1.SecretBinding", func() {
 		By("Add")
 		fakeInformerSecretBinding.Add(secretBinding1)
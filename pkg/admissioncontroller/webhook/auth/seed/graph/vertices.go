@@ -52,6 +52,8 @@ const (
 	VertexTypeShoot
 	// VertexTypeShootState is a constant for a 'ShootState' vertex.
 	VertexTypeShootState
+	// VertexTypeSSHAccessRequest is a constant for a 'SSHAccessRequest' vertex.
+	VertexTypeSSHAccessRequest
 )
 
 var vertexTypes = map[VertexType]string{
@@ -76,6 +78,7 @@ var vertexTypes = map[VertexType]string{
 	VertexTypeServiceAccount:            "ServiceAccount",
 	VertexTypeShoot:                     "Shoot",
 	VertexTypeShootState:                "ShootState",
+	VertexTypeSSHAccessRequest:          "SSHAccessRequest",
 }
 
 type vertex struct {
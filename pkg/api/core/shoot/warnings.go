@@ -37,6 +37,10 @@ func GetWarnings(_ context.Context, shoot, oldShoot *core.Shoot, credentialsRota
 		warnings = append(warnings, "you are setting the spec.kubernetes.kubeControllerManager.podEvictionTimeout field. The field does not have effect since Kubernetes 1.13. Instead, use the spec.kubernetes.kubeAPIServer.(defaultNotReadyTolerationSeconds/defaultUnreachableTolerationSeconds) fields.")
 	}
 
+	if helper.NginxIngressEnabled(shoot.Spec.Addons) || helper.KubernetesDashboardEnabled(shoot.Spec.Addons) {
+		warnings = append(warnings, "you are using the deprecated spec.addons.nginxIngress or spec.addons.kubernetesDashboard fields. Instead, use spec.addons.charts to deploy addons from OCI Helm charts.")
+	}
+
 	return warnings
 }
 
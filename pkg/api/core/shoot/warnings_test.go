@@ -289,5 +289,10 @@ var _ = Describe("Warnings", func() {
 			}
 			Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(Equal("you are setting the spec.kubernetes.kubeControllerManager.podEvictionTimeout field. The field does not have effect since Kubernetes 1.13. Instead, use the spec.kubernetes.kubeAPIServer.(defaultNotReadyTolerationSeconds/defaultUnreachableTolerationSeconds) fields.")))
 		})
+
+		It("should return a warning when legacy addons are enabled", func() {
+			shoot.Spec.Addons = &core.Addons{NginxIngress: &core.NginxIngress{Addon: core.Addon{Enabled: true}}}
+			Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(Equal("you are using the deprecated spec.addons.nginxIngress or spec.addons.kubernetesDashboard fields. Instead, use spec.addons.charts to deploy addons from OCI Helm charts.")))
+		})
 	})
 })
@@ -30,3 +30,20 @@ func AddBastionShootName(ctx context.Context, indexer client.FieldIndexer) error
 	}
 	return nil
 }
+
+// SSHAccessRequestShootNameIndexerFunc extracts the .spec.shootRef.name field of an SSHAccessRequest.
+func SSHAccessRequestShootNameIndexerFunc(obj client.Object) []string {
+	sshAccessRequest, ok := obj.(*operationsv1alpha1.SSHAccessRequest)
+	if !ok {
+		return []string{""}
+	}
+	return []string{sshAccessRequest.Spec.ShootRef.Name}
+}
+
+// AddSSHAccessRequestShootName adds an index for operations.SSHAccessRequestShootName to the given indexer.
+func AddSSHAccessRequestShootName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &operationsv1alpha1.SSHAccessRequest{}, operations.SSHAccessRequestShootName, SSHAccessRequestShootNameIndexerFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to SSHAccessRequest Informer: %w", operations.SSHAccessRequestShootName, err)
+	}
+	return nil
+}
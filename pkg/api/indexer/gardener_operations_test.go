@@ -38,4 +38,19 @@ var _ = Describe("Operations", func() {
 		Entry("Bastion w/o shootRef", &operationsv1alpha1.Bastion{}, ConsistOf("")),
 		Entry("Bastion w/ shootRef", &operationsv1alpha1.Bastion{Spec: operationsv1alpha1.BastionSpec{ShootRef: corev1.LocalObjectReference{Name: "shoot"}}}, ConsistOf("shoot")),
 	)
+
+	DescribeTable("#AddSSHAccessRequestShootName",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddSSHAccessRequestShootName(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&operationsv1alpha1.SSHAccessRequest{}))
+			Expect(indexer.field).To(Equal("spec.shootRef.name"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no SSHAccessRequest", &corev1.Secret{}, ConsistOf("")),
+		Entry("SSHAccessRequest w/o shootRef", &operationsv1alpha1.SSHAccessRequest{}, ConsistOf("")),
+		Entry("SSHAccessRequest w/ shootRef", &operationsv1alpha1.SSHAccessRequest{Spec: operationsv1alpha1.SSHAccessRequestSpec{ShootRef: corev1.LocalObjectReference{Name: "shoot"}}}, ConsistOf("shoot")),
+	)
 })
@@ -42,4 +42,10 @@ const (
 	// the Seed cluster of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot
 	// referred in the status.
 	ShootStatusSeedName = "status.seedName"
+	// ShootStatusLastOperationState is the field selector path for finding
+	// the state of the last operation of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
+	ShootStatusLastOperationState = "status.lastOperation.state"
+	// ShootStatusConditionsHealth is the field selector path for finding
+	// the aggregated health of the conditions of a core.gardener.cloud/{v1alpha1,v1beta1} Shoot.
+	ShootStatusConditionsHealth = "status.conditions.health"
 )
@@ -195,6 +195,11 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *core.SeedSettings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingPrivateLinkEnabled returns true if the seed supports private link connectivity for shoots.
+func SeedSettingPrivateLinkEnabled(settings *core.SeedSettings) bool {
+	return settings != nil && settings.PrivateLink != nil && settings.PrivateLink.Enabled
+}
+
 // FindMachineImageVersion finds the machine image version in the <cloudProfile> for the given <name> and <version>.
 // In case no machine image version can be found with the given <name> or <version>, false is being returned.
 func FindMachineImageVersion(machineImages []core.MachineImage, name, version string) (core.MachineImageVersion, bool) {
@@ -510,6 +515,24 @@ func ShootEnablesSSHAccess(shoot *core.Shoot) bool {
 		(shoot.Spec.Provider.WorkersSettings == nil || shoot.Spec.Provider.WorkersSettings.SSHAccess == nil || shoot.Spec.Provider.WorkersSettings.SSHAccess.Enabled)
 }
 
+// ShootEnablesEgressOnlyInternetAccess returns true if the worker nodes of the given shoot shall be provisioned without
+// public IPs.
+func ShootEnablesEgressOnlyInternetAccess(shoot *core.Shoot) bool {
+	return !IsWorkerless(shoot) &&
+		shoot.Spec.Provider.WorkersSettings != nil &&
+		shoot.Spec.Provider.WorkersSettings.EgressOnlyInternetAccess != nil &&
+		shoot.Spec.Provider.WorkersSettings.EgressOnlyInternetAccess.Enabled
+}
+
+// ShootEnablesPrivateLink returns true if the worker nodes of the given shoot shall reach the control plane via a
+// private link endpoint.
+func ShootEnablesPrivateLink(shoot *core.Shoot) bool {
+	return !IsWorkerless(shoot) &&
+		shoot.Spec.Networking != nil &&
+		shoot.Spec.Networking.PrivateLink != nil &&
+		shoot.Spec.Networking.PrivateLink.Enabled
+}
+
 // DeterminePrimaryIPFamily determines the primary IP family out of a specified list of IP families.
 func DeterminePrimaryIPFamily(ipFamilies []core.IPFamily) core.IPFamily {
 	if len(ipFamilies) == 0 {
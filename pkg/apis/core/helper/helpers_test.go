@@ -549,6 +549,17 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing disabled", &core.SeedSettings{TopologyAwareRouting: &core.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingPrivateLinkEnabled",
+		func(settings *core.SeedSettings, expected bool) {
+			Expect(SeedSettingPrivateLinkEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no private link setting", &core.SeedSettings{}, false),
+		Entry("private link enabled", &core.SeedSettings{PrivateLink: &core.SeedSettingPrivateLink{Enabled: true}}, true),
+		Entry("private link disabled", &core.SeedSettings{PrivateLink: &core.SeedSettingPrivateLink{Enabled: false}}, false),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []core.MachineImage
 
@@ -989,6 +1000,44 @@ var _ = Describe("helper", func() {
 		Entry("should return false when shoot worker settings has SSHAccess set to false", []core.Worker{{Name: "worker"}}, &core.WorkersSettings{SSHAccess: &core.SSHAccess{Enabled: false}}, false),
 	)
 
+	DescribeTable("#ShootEnablesEgressOnlyInternetAccess",
+		func(workers []core.Worker, workersSettings *core.WorkersSettings, expectedResult bool) {
+			shoot := &core.Shoot{
+				Spec: core.ShootSpec{
+					Provider: core.Provider{
+						Workers:         workers,
+						WorkersSettings: workersSettings,
+					},
+				},
+			}
+			Expect(ShootEnablesEgressOnlyInternetAccess(shoot)).To(Equal(expectedResult))
+		},
+
+		Entry("should return false when shoot provider has zero workers", nil, &core.WorkersSettings{EgressOnlyInternetAccess: &core.EgressOnlyInternetAccess{Enabled: true}}, false),
+		Entry("should return false when shoot provider has no WorkersSettings", []core.Worker{{Name: "worker"}}, nil, false),
+		Entry("should return false when shoot worker settings has no EgressOnlyInternetAccess", []core.Worker{{Name: "worker"}}, &core.WorkersSettings{}, false),
+		Entry("should return false when shoot worker settings has EgressOnlyInternetAccess set to false", []core.Worker{{Name: "worker"}}, &core.WorkersSettings{EgressOnlyInternetAccess: &core.EgressOnlyInternetAccess{Enabled: false}}, false),
+		Entry("should return true when shoot worker settings has EgressOnlyInternetAccess set to true", []core.Worker{{Name: "worker"}}, &core.WorkersSettings{EgressOnlyInternetAccess: &core.EgressOnlyInternetAccess{Enabled: true}}, true),
+	)
+
+	DescribeTable("#ShootEnablesPrivateLink",
+		func(workers []core.Worker, networking *core.Networking, expectedResult bool) {
+			shoot := &core.Shoot{
+				Spec: core.ShootSpec{
+					Provider:   core.Provider{Workers: workers},
+					Networking: networking,
+				},
+			}
+			Expect(ShootEnablesPrivateLink(shoot)).To(Equal(expectedResult))
+		},
+
+		Entry("should return false when shoot provider has zero workers", nil, &core.Networking{PrivateLink: &core.PrivateLink{Enabled: true}}, false),
+		Entry("should return false when shoot has no networking", []core.Worker{{Name: "worker"}}, nil, false),
+		Entry("should return false when shoot networking has no PrivateLink", []core.Worker{{Name: "worker"}}, &core.Networking{}, false),
+		Entry("should return false when shoot networking has PrivateLink set to false", []core.Worker{{Name: "worker"}}, &core.Networking{PrivateLink: &core.PrivateLink{Enabled: false}}, false),
+		Entry("should return true when shoot networking has PrivateLink set to true", []core.Worker{{Name: "worker"}}, &core.Networking{PrivateLink: &core.PrivateLink{Enabled: true}}, true),
+	)
+
 	Describe("#DeterminePrimaryIPFamily", func() {
 		It("should return IPv4 for empty ipFamilies", func() {
 			Expect(DeterminePrimaryIPFamily(nil)).To(Equal(core.IPFamilyIPv4))
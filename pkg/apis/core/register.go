@@ -49,6 +49,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ControllerInstallationList{},
 		&ExposureClass{},
 		&ExposureClassList{},
+		&ImageVectorOverride{},
+		&ImageVectorOverrideList{},
 		&InternalSecret{},
 		&InternalSecretList{},
 		&NamespacedCloudProfile{},
@@ -61,8 +63,15 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SecretBindingList{},
 		&Seed{},
 		&SeedList{},
+		&ShootClone{},
+		&ShootExport{},
+		&ShootCostEstimate{},
+		&ShootRevision{},
+		&ShootRevisionList{},
 		&ShootState{},
 		&ShootStateList{},
+		&VersionPolicy{},
+		&VersionPolicyList{},
 		&Shoot{},
 		&ShootList{},
 	)
@@ -59,6 +59,18 @@ type CloudProfileSpec struct {
 	Type string
 	// VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.
 	VolumeTypes []VolumeType
+	// ClusterAutoscalerProfiles contains curated sets of cluster-autoscaler parameters which can be selected in the
+	// Shoot specification.
+	ClusterAutoscalerProfiles []ClusterAutoscalerProfileSettings
+}
+
+// ClusterAutoscalerProfileSettings contains the cluster-autoscaler parameters of a profile.
+type ClusterAutoscalerProfileSettings struct {
+	// Name is the name of the profile.
+	Name ClusterAutoscalerProfile
+	// Parameters contains the cluster-autoscaler parameters of the profile. Parameters which are not specified default
+	// to the values Gardener uses if no profile is selected.
+	Parameters ClusterAutoscaler
 }
 
 // GetProviderType gets the type of the provider.
@@ -136,6 +148,27 @@ type MachineType struct {
 	Usable *bool
 	// Architecture is the CPU architecture of this machine type.
 	Architecture *string
+	// HourlyPrice is the price of one machine of this machine type per hour as decimal number (e.g., "0.096"). It is
+	// used for estimating the cost of Shoots. All prices in a CloudProfile must be given in the same currency.
+	HourlyPrice *string
+	// KubeReserved are the resources reserved for kubernetes node components on machines of this type. They are used
+	// for worker pools with the `Auto` kubelet reservation policy.
+	KubeReserved *KubeletConfigReserved
+	// Capabilities are the capabilities of this machine type. Worker pools can request machine types by their
+	// capabilities instead of their name.
+	Capabilities *MachineTypeCapabilities
+}
+
+// MachineTypeCapabilities contains the capabilities of a machine type.
+type MachineTypeCapabilities struct {
+	// CPUFamily is the CPU family of the machine type (e.g., "intel-sapphire-rapids" or "amd-genoa").
+	CPUFamily *string
+	// NVMe specifies whether the machine type provides local NVMe storage.
+	NVMe *bool
+	// NestedVirtualization specifies whether the machine type supports nested virtualization.
+	NestedVirtualization *bool
+	// ConfidentialCompute specifies whether the machine type supports confidential computing.
+	ConfidentialCompute *bool
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -161,6 +194,9 @@ type Region struct {
 	// It can be used by Gardener administrators/operators to provide additional information about a region, e.g. wrt
 	// quality, reliability, access restrictions, etc.
 	Labels map[string]string
+	// EgressOnlyInternetAccessSupported indicates whether worker nodes in this region can be provisioned without public
+	// IPs, i.e., whether Shoots in this region may enable egress-only internet access for their worker nodes.
+	EgressOnlyInternetAccessSupported *bool
 }
 
 // AvailabilityZone is an availability zone.
@@ -183,6 +219,9 @@ type VolumeType struct {
 	Usable *bool
 	// MinSize is the minimal supported storage size.
 	MinSize *resource.Quantity
+	// MonthlyPricePerGi is the price of one Gi of this volume type per month as decimal number (e.g., "0.08"). It is used
+	// for estimating the cost of Shoots. All prices in a CloudProfile must be given in the same currency.
+	MonthlyPricePerGi *string
 }
 
 const (
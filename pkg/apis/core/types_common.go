@@ -43,6 +43,22 @@ const (
 	ErrorProblematicWebhook ErrorCode = "ERR_PROBLEMATIC_WEBHOOK"
 )
 
+// ErrorCategory is a string alias.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryCredentials indicates that the last error was caused by invalid or insufficient infrastructure credentials.
+	ErrorCategoryCredentials ErrorCategory = "Credentials"
+	// ErrorCategoryInfrastructure indicates that the last error was caused by the infrastructure, e.g., exceeded quotas or depleted resources.
+	ErrorCategoryInfrastructure ErrorCategory = "Infrastructure"
+	// ErrorCategoryConfiguration indicates that the last error was caused by the configuration of the resource.
+	ErrorCategoryConfiguration ErrorCategory = "Configuration"
+	// ErrorCategoryCleanup indicates that the last error was caused by resources which could not be cleaned up.
+	ErrorCategoryCleanup ErrorCategory = "Cleanup"
+	// ErrorCategoryWebhook indicates that the last error was caused by a problematic webhook.
+	ErrorCategoryWebhook ErrorCategory = "Webhook"
+)
+
 // LastError indicates the last occurred error for an operation on a resource.
 type LastError struct {
 	// A human readable message indicating details about the last error.
@@ -54,6 +70,12 @@ type LastError struct {
 	Codes []ErrorCode
 	// Last time the error was reported
 	LastUpdateTime *metav1.Time
+	// Category is the category of the last error derived from its error codes.
+	Category *ErrorCategory
+	// ProviderCodes are the error codes reported by the infrastructure provider, e.g., the cloud provider's API.
+	ProviderCodes []string
+	// RemediationHint is a human readable hint on how the last error can be remediated.
+	RemediationHint *string
 }
 
 // LastOperationType is a string alias.
@@ -115,6 +137,16 @@ type Gardener struct {
 	Version string
 }
 
+// FeatureGateStatus contains the state of a feature gate of a Gardener component.
+type FeatureGateStatus struct {
+	// Name is the name of the feature gate.
+	Name string
+	// Enabled specifies whether the feature gate is enabled.
+	Enabled bool
+	// LockToDefault specifies whether the feature gate is locked to its default value, i.e., it cannot be configured.
+	LockToDefault bool
+}
+
 // HighAvailability specifies the configuration settings for high availability for a resource. Typical
 // usages could be to configure HA for shoot control plane or for seed system components.
 type HighAvailability struct {
@@ -68,6 +68,10 @@ type ControllerResource struct {
 	// WorkerlessSupported specifies whether this ControllerResource supports Workerless Shoot clusters.
 	// This field is only relevant when kind is "Extension".
 	WorkerlessSupported *bool
+	// RequiredCredentialKeys are the keys which must be present in the data of Secrets referenced by SecretBindings and
+	// CredentialsBindings for this provider type.
+	// This field is only relevant when kind is "Infrastructure".
+	RequiredCredentialKeys []string
 }
 
 // DeploymentRef contains information about `ControllerDeployment` references.
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImageVectorOverride overrides images of the image vector used by gardenlet for selected seeds or shoots.
+type ImageVectorOverride struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of the ImageVectorOverride.
+	Spec ImageVectorOverrideSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImageVectorOverrideList is a collection of ImageVectorOverrides.
+type ImageVectorOverrideList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ImageVectorOverrides.
+	Items []ImageVectorOverride
+}
+
+// ImageVectorOverrideSpec is the specification of an ImageVectorOverride.
+type ImageVectorOverrideSpec struct {
+	// SeedSelector is an optional label selector for Seeds whose gardenlets apply the override. If it is not set, the
+	// override is applied by the gardenlets of all Seeds.
+	SeedSelector *metav1.LabelSelector
+	// ShootSelector is an optional label selector for Shoots. If it is set, the override is only applied to the
+	// components of the selected Shoots. Otherwise, it is applied to all components deployed by the gardenlets of the
+	// selected Seeds.
+	ShootSelector *metav1.LabelSelector
+	// Images is the list of image overrides.
+	Images []ImageOverride
+}
+
+// ImageOverride overrides the repository and the tag or digest of an image of the image vector.
+type ImageOverride struct {
+	// Name is the name of the image in the image vector.
+	Name string
+	// Repository is the repository of the image, without tag or digest.
+	Repository string
+	// Tag is the tag of the image. Exactly one of Tag or Digest must be set.
+	Tag *string
+	// Digest is the digest of the image, e.g. 'sha256:...'. Exactly one of Tag or Digest must be set.
+	Digest *string
+	// RuntimeVersion is an optional semantic version constraint for the Kubernetes version of the cluster the image
+	// runs in. It must match the one of the overridden image vector entry.
+	RuntimeVersion *string
+	// TargetVersion is an optional semantic version constraint for the Kubernetes version of the cluster the image
+	// operates on. It must match the one of the overridden image vector entry.
+	TargetVersion *string
+}
@@ -5,6 +5,7 @@
 package core
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -58,6 +59,15 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// AlertRoutes is a list of routes which forward the control plane alerts of the shoots in this project to external
+	// receivers.
+	AlertRoutes []AlertRoute
+	// NamespaceResources contains overrides for the ResourceQuota and LimitRange which are created by Gardener in the
+	// project namespace.
+	NamespaceResources *ProjectNamespaceResources
+	// CustomRoles is a list of project-specific roles with explicit rule sets. Members can be bound to them via the
+	// `custom:<name>` role. Modifying this field requires the `modify-spec-customroles` verb for the project.
+	CustomRoles []ProjectCustomRole
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -111,6 +121,68 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool
 }
 
+// AlertRoute is a route which forwards the control plane alerts of the shoots in a project to an external receiver.
+type AlertRoute struct {
+	// Name is the unique name of the route.
+	Name string
+	// Severities is a list of alert severities which are forwarded by this route. If empty, alerts of all severities
+	// are forwarded.
+	Severities []string
+	// Receiver is the external receiver to which the alerts are forwarded.
+	Receiver AlertReceiver
+}
+
+// AlertReceiver is an external receiver for alerts. Exactly one of the receiver types must be set.
+type AlertReceiver struct {
+	// Email contains the configuration for sending alerts via email. It requires that the Gardener operator has
+	// configured an SMTP server for alerting.
+	Email *EmailAlertReceiver
+	// Webhook contains the configuration for sending alerts to a generic webhook.
+	Webhook *WebhookAlertReceiver
+	// Slack contains the configuration for sending alerts to a Slack-compatible incoming webhook.
+	Slack *SlackAlertReceiver
+}
+
+// EmailAlertReceiver contains the configuration for sending alerts via email.
+type EmailAlertReceiver struct {
+	// To is a list of email addresses to which the alerts are sent.
+	To []string
+}
+
+// WebhookAlertReceiver contains the configuration for sending alerts to a generic webhook.
+type WebhookAlertReceiver struct {
+	// SecretName is the name of a secret in the project namespace which contains the URL of the webhook in the `url`
+	// data key.
+	SecretName string
+}
+
+// SlackAlertReceiver contains the configuration for sending alerts to a Slack-compatible incoming webhook.
+type SlackAlertReceiver struct {
+	// SecretName is the name of a secret in the project namespace which contains the URL of the incoming webhook in
+	// the `url` data key.
+	SecretName string
+	// Channel is the channel or user to which the alerts are sent.
+	Channel *string
+}
+
+// ProjectNamespaceResources contains overrides for the ResourceQuota and LimitRange which are created by Gardener in
+// the project namespace.
+type ProjectNamespaceResources struct {
+	// ResourceQuota contains hard limits which override the respective limits of the ResourceQuota template configured
+	// for the project.
+	ResourceQuota corev1.ResourceList
+	// LimitRange contains limits which replace the limits of the LimitRange template configured for the project.
+	LimitRange []corev1.LimitRangeItem
+}
+
+// ProjectCustomRole is a project-specific role with an explicit rule set.
+type ProjectCustomRole struct {
+	// Name is the name of the custom role. Members reference it with the `custom:<name>` role.
+	Name string
+	// Rules is the list of policy rules which are granted to the members having this role in the project namespace.
+	Rules []rbacv1.PolicyRule
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -124,6 +196,8 @@ const (
 	ProjectMemberServiceAccountManager = "serviceaccountmanager"
 	// ProjectMemberExtensionPrefix is a prefix for custom roles that are not known by Gardener.
 	ProjectMemberExtensionPrefix = "extension:"
+	// ProjectMemberCustomPrefix is a prefix for roles that reference a custom role defined in the project.
+	ProjectMemberCustomPrefix = "custom:"
 )
 
 // ProjectPhase is a label for the condition of a project at the current time.
@@ -138,6 +212,9 @@ const (
 	ProjectFailed ProjectPhase = "Failed"
 	// ProjectTerminating indicates that the project is in termination process.
 	ProjectTerminating ProjectPhase = "Terminating"
+	// ProjectArchived indicates that the project has been deleted and is archived until its deletion grace period has
+	// passed.
+	ProjectArchived ProjectPhase = "Archived"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -149,4 +226,8 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventArchived indicates that the project has been archived.
+	ProjectEventArchived = "Archived"
+	// ProjectEventArchivalFailed indicates that archiving the project failed.
+	ProjectEventArchivalFailed = "ArchivalFailed"
 )
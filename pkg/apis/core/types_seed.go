@@ -66,8 +66,20 @@ type SeedSpec struct {
 	Volume *SeedVolume
 	// Ingress configures Ingress specific settings of the Seed cluster. This field is immutable.
 	Ingress *Ingress
+	// Operation is an operation which is performed for the Seed. If set to `Decommission`, the seed is cordoned for
+	// scheduling and the control planes of all hosted shoots are migrated to other eligible seeds.
+	Operation *SeedOperation
 }
 
+// SeedOperation is an operation which is performed for a Seed.
+type SeedOperation string
+
+const (
+	// SeedOperationDecommission is a constant for the operation which cordons the seed for scheduling and migrates
+	// the control planes of all hosted shoots to other eligible seeds.
+	SeedOperationDecommission SeedOperation = "Decommission"
+)
+
 // GetProviderType gets the type of the provider.
 func (s *Seed) GetProviderType() string {
 	return s.Spec.Provider.Type
@@ -95,6 +107,62 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// ExposureClassHandlers contains information about the ingress gateways of the exposure class handlers which are
+	// deployed in the seed cluster.
+	ExposureClassHandlers []ExposureClassHandlerStatus
+	// SystemComponents contains information about the versions of the system components deployed in the seed cluster.
+	SystemComponents []SeedSystemComponent
+	// Decommission contains information about the progress of the decommissioning of the seed.
+	Decommission *SeedDecommissionStatus
+	// FeatureGates contains the states of the feature gates of the gardenlet responsible for the seed.
+	FeatureGates []FeatureGateStatus
+}
+
+// SeedDecommissionStatus contains information about the progress of the decommissioning of a seed.
+type SeedDecommissionStatus struct {
+	// StartTime is the time when the decommissioning of the seed was started.
+	StartTime metav1.Time
+	// LastUpdateTime is the time when the progress was last updated.
+	LastUpdateTime metav1.Time
+	// RemainingShoots is the number of shoots which are still scheduled to the seed or whose control plane is still
+	// hosted by the seed.
+	RemainingShoots int32
+	// MigratingShoots is the number of shoots whose control plane is currently being migrated away from the seed.
+	MigratingShoots int32
+	// Message contains details about the progress, e.g., the number of pending and skipped shoots.
+	Message *string
+}
+
+// ExposureClassHandlerStatus contains information about the ingress gateway of an exposure class handler.
+type ExposureClassHandlerStatus struct {
+	// Name is the name of the exposure class handler.
+	Name string
+	// Autoscaling contains the autoscaling settings applied to the ingress gateway of the exposure class handler.
+	Autoscaling *ExposureClassHandlerAutoscaling
+}
+
+// ExposureClassHandlerAutoscaling contains the autoscaling settings applied to an ingress gateway.
+type ExposureClassHandlerAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the ingress gateway.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of replicas of the ingress gateway.
+	MaxReplicas *int32
+	// TargetConnections is the average number of active downstream connections per replica the ingress gateway is
+	// scaled on.
+	TargetConnections *int32
+}
+
+// SeedSystemComponent contains information about the version of a system component deployed in the seed cluster.
+type SeedSystemComponent struct {
+	// Name is the name of the system component.
+	Name string
+	// Version is the version of the system component which is currently deployed in the seed cluster.
+	Version *string
+	// ExpectedVersion is the version of the system component according to the image vector of the gardenlet.
+	ExpectedVersion *string
+	// VersionSkew indicates whether the deployed version differs from the expected version, e.g., after a partial
+	// upgrade.
+	VersionSkew bool
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -196,6 +264,12 @@ type SeedSettings struct {
 	// TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting
+	// APIServerProxy controls certain settings for the apiserver-proxy path of shoot clusters through the istio ingress
+	// gateway of the seed.
+	APIServerProxy *SeedSettingAPIServerProxy
+	// PrivateLink controls whether the seed supports connecting shoot nodes to their control planes via private
+	// endpoints.
+	PrivateLink *SeedSettingPrivateLink
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the
@@ -205,6 +279,9 @@ type SeedSettingExcessCapacityReservation struct {
 	Enabled *bool
 	// Configs configures excess capacity reservation deployments for shoot control planes in the seed.
 	Configs []SeedSettingExcessCapacityReservationConfig
+	// Profiles configures named excess capacity reservation profiles for shoot control plane size classes in the seed.
+	// Each profile is reconciled as a separate excess capacity reservation deployment.
+	Profiles []SeedSettingExcessCapacityReservationProfile
 }
 
 // SeedSettingExcessCapacityReservationConfig configures excess capacity reservation deployments for shoot control planes in the seed.
@@ -217,6 +294,23 @@ type SeedSettingExcessCapacityReservationConfig struct {
 	Tolerations []corev1.Toleration
 }
 
+// SeedSettingExcessCapacityReservationProfile configures the excess capacity reservation for shoot control planes of a
+// certain size class in the seed.
+type SeedSettingExcessCapacityReservationProfile struct {
+	// Name is the name of the shoot control plane size class this profile reserves capacity for, e.g. `large`.
+	Name string
+	// Replicas is the number of shoot control planes of this size class for which capacity is reserved. When not
+	// specified, the number of zones of the seed (but at least 2) is used.
+	Replicas *int32
+	// Resources specify the resource requests and limits of the excess-capacity-reservation pod. They should roughly
+	// correspond to the resource consumption of a single shoot control plane of this size class.
+	Resources corev1.ResourceList
+	// NodeSelector specifies the node where the excess-capacity-reservation pod should run.
+	NodeSelector map[string]string
+	// Tolerations specify the tolerations for the the excess-capacity-reservation pod.
+	Tolerations []corev1.Toleration
+}
+
 // SeedSettingScheduling controls settings for scheduling decisions for the seed.
 type SeedSettingScheduling struct {
 	// Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds
@@ -294,8 +388,7 @@ type SeedSettingDependencyWatchdogWeeder struct {
 
 // SeedSettingDependencyWatchdogProber controls the prober settings for the dependency-watchdog for the seed.
 type SeedSettingDependencyWatchdogProber struct {
-	// Enabled controls whether the prober of the dependency-watchdog should be enabled.
-	// reachable via its external ingress in order to avoid melt-down situations.
+	// Enabled controls whether the node lease probe of the shoot care controller in gardenlet should be enabled.
 	Enabled bool
 }
 
@@ -307,6 +400,26 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool
 }
 
+// SeedSettingAPIServerProxy controls certain settings for the apiserver-proxy path of shoot clusters, i.e., for the
+// traffic which is routed from the apiserver-proxy in the shoot cluster via the istio ingress gateway of the seed to
+// the kube-apiserver of the shoot.
+type SeedSettingAPIServerProxy struct {
+	// MaxConnections is the maximum number of concurrent connections per shoot on the apiserver-proxy path. Further
+	// connections are closed immediately. When not specified, the number of connections is not limited.
+	MaxConnections *int32
+	// MaxConnectionsPerSecond is the maximum number of new connections per second and shoot on the apiserver-proxy
+	// path. Further connections are closed immediately. When not specified, the connection rate is not limited.
+	MaxConnectionsPerSecond *int32
+}
+
+// SeedSettingPrivateLink controls whether the seed supports connecting shoot nodes to their control planes via private
+// endpoints, i.e., whether the ingress of the seed can be exposed via private link or private endpoint services of the
+// infrastructure provider.
+type SeedSettingPrivateLink struct {
+	// Enabled controls whether shoots requesting private link connectivity can be scheduled to the seed.
+	Enabled bool
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -344,8 +457,14 @@ const (
 	SeedExtensionsReady ConditionType = "ExtensionsReady"
 	// SeedGardenletReady is a constant for a condition type indicating that the Gardenlet is ready.
 	SeedGardenletReady ConditionType = "GardenletReady"
+	// SeedShootsRestored is a constant for a condition type indicating the progress of restoring the Shoots of a
+	// destroyed Seed to another Seed.
+	SeedShootsRestored ConditionType = "ShootsRestored"
 	// SeedSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
+	// SeedZoneOutage is a constant for a condition type indicating whether an outage of one or more availability zones
+	// of the seed cluster has been detected.
+	SeedZoneOutage ConditionType = "ZoneOutage"
 )
 
 // Resource constants for Gardener object types
@@ -102,6 +102,58 @@ type ShootSpec struct {
 	SchedulerName *string
 	// CloudProfile is a reference to a CloudProfile or a NamespacedCloudProfile.
 	CloudProfile *CloudProfileReference
+	// Observability contains settings for the observability components of the shoot.
+	Observability *Observability
+	// ReadinessGates is a list of user-defined checks which are evaluated by gardenlet. Their results are reflected in
+	// the `ReadinessGatesPassed` condition of the shoot.
+	ReadinessGates []ShootReadinessGate
+	// Reconciliation contains settings for the reconciliation of the shoot.
+	Reconciliation *ShootReconciliation
+}
+
+// ShootReadinessGate is a user-defined check which must pass before the shoot is considered ready. Exactly one of the
+// checks must be configured.
+type ShootReadinessGate struct {
+	// Name is the unique name of the readiness gate.
+	Name string
+	// HTTPGet checks whether a service in the shoot responds with a 2xx status code to an HTTP GET request.
+	HTTPGet *ShootReadinessGateHTTPGet
+	// CustomResourceDefinition checks whether a CustomResourceDefinition exists and is established in the shoot.
+	CustomResourceDefinition *ShootReadinessGateCustomResourceDefinition
+}
+
+// ShootReadinessGateHTTPGet describes an HTTP GET request against a service in the shoot. The request is sent through
+// the service proxy of the shoot's kube-apiserver.
+type ShootReadinessGateHTTPGet struct {
+	// Namespace is the namespace of the service.
+	Namespace string
+	// Service is the name of the service.
+	Service string
+	// Port is the port of the service.
+	Port int32
+	// Path is the path of the request.
+	Path *string
+	// Scheme is the scheme used for connecting to the service (`http` or `https`).
+	Scheme *string
+}
+
+// ShootReadinessGateCustomResourceDefinition describes a CustomResourceDefinition in the shoot.
+type ShootReadinessGateCustomResourceDefinition struct {
+	// Name is the name of the CustomResourceDefinition, e.g. `certificates.cert.gardener.cloud`.
+	Name string
+}
+
+// ShootReconciliation contains settings for the reconciliation of the shoot.
+type ShootReconciliation struct {
+	// Paused specifies whether the reconciliation of the shoot is paused. While paused, gardenlet does not perform any
+	// operation (create, reconcile, delete, etc.) for the shoot and the shoot maintenance is skipped.
+	Paused bool
+	// Reason is a human-readable explanation why the reconciliation is paused. It is required when the reconciliation is
+	// paused.
+	Reason *string
+	// PausedUntil is the time until which the reconciliation is paused. If not specified, the reconciliation is paused
+	// until Paused is set to false.
+	PausedUntil *metav1.Time
 }
 
 // GetProviderType gets the type of the provider.
@@ -156,6 +208,207 @@ type ShootStatus struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	EncryptedResources []string
+	// Availability contains information about the availability of the Shoot's API server within the current month.
+	Availability *ShootAvailability
+	// LeftoverResources contains the inventory of resources which were created for the Shoot and still exist. It is
+	// only maintained while the Shoot is being deleted.
+	LeftoverResources *LeftoverResources
+	// ETCD contains information about the etcds of the Shoot.
+	ETCD *ShootETCDStatus
+	// WorkersRollout contains information about the rollout progress of the worker pools of the Shoot. It is reported
+	// by the Worker extension for all update strategies.
+	WorkersRollout []WorkerPoolRollout
+	// SecurityAdvisories contains the known vulnerabilities which affect the machine images of the worker pools of the
+	// Shoot. They are determined by gardenlet based on the machine image metadata reported by the operating system
+	// extensions and the CVE feed configured by the Gardener operator.
+	SecurityAdvisories []ShootSecurityAdvisory
+	// Maintenance contains information about the upcoming maintenance of the Shoot.
+	Maintenance *ShootMaintenanceStatus
+	// Networking contains information about the network of the Shoot.
+	Networking *NetworkingStatus
+}
+
+// NetworkingStatus contains information about the network of the Shoot.
+type NetworkingStatus struct {
+	// PathMTU is the smallest maximum transmission unit of the network paths between the seed and the worker nodes of
+	// the Shoot. It is discovered by gardener-node-agent and propagated to the VPN and networking components.
+	PathMTU *int32
+}
+
+// ShootMaintenanceStatus contains information about the upcoming maintenance of the Shoot.
+type ShootMaintenanceStatus struct {
+	// PlannedChanges are the version updates which the next maintenance would apply based on the current state of the
+	// Shoot and its CloudProfile.
+	PlannedChanges []MaintenancePlannedChange
+	// LastUpdateTime is the time when the planned changes were last updated.
+	LastUpdateTime metav1.Time
+	// RunningVersions contains the Kubernetes and machine image versions which the Shoot successfully runs. They are
+	// used to evaluate the maintenance dependencies of other Shoots.
+	RunningVersions *ShootRunningVersions
+}
+
+// ShootRunningVersions contains the Kubernetes and machine image versions which a Shoot successfully runs.
+type ShootRunningVersions struct {
+	// Kubernetes is the lowest Kubernetes version of the control plane and the worker pools.
+	Kubernetes *RunningVersion
+	// MachineImages contains the lowest version of each machine image used by the worker pools.
+	MachineImages []MachineImageRunningVersion
+}
+
+// RunningVersion is a version which a Shoot successfully runs.
+type RunningVersion struct {
+	// Version is the version.
+	Version string
+	// Since is the time since when the Shoot successfully runs the version.
+	Since metav1.Time
+}
+
+// MachineImageRunningVersion is a machine image version which a Shoot successfully runs.
+type MachineImageRunningVersion struct {
+	// Name is the name of the machine image.
+	Name string
+	// Version is the version of the machine image.
+	Version string
+	// Since is the time since when the Shoot successfully runs the version.
+	Since metav1.Time
+}
+
+// MaintenancePlannedChange is a version update which the next maintenance would apply.
+type MaintenancePlannedChange struct {
+	// Type is the type of the version update.
+	Type MaintenancePlannedChangeType
+	// WorkerPool is the name of the affected worker pool. It is not set for updates of the control plane.
+	WorkerPool *string
+	// MachineImageName is the name of the machine image. It is only set for machine image version updates.
+	MachineImageName *string
+	// CurrentVersion is the currently used version.
+	CurrentVersion string
+	// TargetVersion is the version which the maintenance would update to.
+	TargetVersion string
+	// Reason is the reason for the update.
+	Reason string
+	// Forced indicates that the update is required, e.g., because the current version is expired, and cannot be vetoed.
+	Forced bool
+}
+
+// MaintenancePlannedChangeType is the type of a planned maintenance change.
+type MaintenancePlannedChangeType string
+
+const (
+	// MaintenancePlannedChangeTypeKubernetesVersion is the type of a Kubernetes version update.
+	MaintenancePlannedChangeTypeKubernetesVersion MaintenancePlannedChangeType = "KubernetesVersion"
+	// MaintenancePlannedChangeTypeMachineImageVersion is the type of a machine image version update.
+	MaintenancePlannedChangeTypeMachineImageVersion MaintenancePlannedChangeType = "MachineImageVersion"
+)
+
+// ShootSecurityAdvisory contains information about a known vulnerability affecting worker pools of a Shoot.
+type ShootSecurityAdvisory struct {
+	// ID is the identifier of the vulnerability, e.g., a CVE ID.
+	ID string
+	// Severity is the severity of the vulnerability as stated in the CVE feed.
+	Severity *string
+	// Summary is a short description of the vulnerability.
+	Summary *string
+	// WorkerPools is the list of names of the worker pools affected by the vulnerability.
+	WorkerPools []string
+}
+
+// WorkerPoolRollout contains information about the rollout progress of a worker pool.
+type WorkerPoolRollout struct {
+	// Name is the name of the worker pool.
+	Name string
+	// MachinesUpdated is the number of machines which already run with the target configuration.
+	MachinesUpdated int32
+	// MachinesPending is the number of machines which still have to be updated to the target configuration.
+	MachinesPending int32
+	// MachinesFailed is the number of machines which failed.
+	MachinesFailed int32
+	// CurrentMachineImage is the machine image of the last completed rollout of the worker pool.
+	CurrentMachineImage *ShootMachineImage
+	// TargetMachineImage is the machine image the machines of the worker pool are updated to.
+	TargetMachineImage *ShootMachineImage
+	// CurrentKubeletVersion is the kubelet version of the last completed rollout of the worker pool.
+	CurrentKubeletVersion *string
+	// TargetKubeletVersion is the kubelet version the machines of the worker pool are updated to.
+	TargetKubeletVersion *string
+}
+
+// ShootETCDStatus contains information about the etcds of a Shoot.
+type ShootETCDStatus struct {
+	// Main contains information about the main etcd.
+	Main *ETCDStatus
+	// Events contains information about the events etcd.
+	Events *ETCDStatus
+}
+
+// ETCDStatus contains information about the database of an etcd.
+type ETCDStatus struct {
+	// DBSize is the physically allocated size of the database.
+	DBSize *resource.Quantity
+	// DBSizeInUse is the logically used size of the database. The difference to DBSize can be reclaimed by a
+	// defragmentation.
+	DBSizeInUse *resource.Quantity
+	// LastDefragmentations is the list of the last successful defragmentations, the most recent first.
+	LastDefragmentations []ETCDDefragmentationStatus
+	// LastUpdateTime is the time when the information was last updated.
+	LastUpdateTime metav1.Time
+}
+
+// ETCDDefragmentationStatus contains information about a defragmentation of an etcd.
+type ETCDDefragmentationStatus struct {
+	// Time is the time when the defragmentation was observed.
+	Time metav1.Time
+	// DBSizeBefore is the size of the database which was last observed before the defragmentation.
+	DBSizeBefore *resource.Quantity
+}
+
+// LeftoverResources contains the inventory of resources which were created for a Shoot and still exist.
+type LeftoverResources struct {
+	// Resources is the list of resources which still exist.
+	Resources []LeftoverResource
+	// LastUpdateTime is the time when the inventory last changed.
+	LastUpdateTime metav1.Time
+}
+
+// LeftoverResource describes a resource which was created for a Shoot and still exists.
+type LeftoverResource struct {
+	// Kind is the kind of the resource, e.g. `Infrastructure` or `MachineDeployment`.
+	Kind string
+	// Name is the name of the resource.
+	Name string
+	// Type is the extension type of the resource, if applicable.
+	Type *string
+	// State indicates whether the resource will be destroyed by the deletion of the Shoot or whether it might be
+	// orphaned.
+	State LeftoverResourceState
+	// Description is a human-readable explanation of the state.
+	Description *string
+}
+
+// LeftoverResourceState is a string alias.
+type LeftoverResourceState string
+
+const (
+	// LeftoverResourceStateToBeDeleted indicates that the resource will be destroyed by the deletion of the Shoot.
+	LeftoverResourceStateToBeDeleted LeftoverResourceState = "ToBeDeleted"
+	// LeftoverResourceStatePotentiallyOrphaned indicates that the resource might not be destroyed by the deletion of
+	// the Shoot and might have to be cleaned up manually.
+	LeftoverResourceStatePotentiallyOrphaned LeftoverResourceState = "PotentiallyOrphaned"
+)
+
+// ShootAvailability contains information about the availability of the Shoot's API server within a period.
+type ShootAvailability struct {
+	// Period is the calendar month (in UTC) the availability was computed for, in the format `YYYY-MM`.
+	Period string
+	// Objective is the availability objective of the API server in percent which the error budget is based on.
+	Objective string
+	// APIServer is the availability of the API server in percent measured within the period so far.
+	APIServer string
+	// ErrorBudgetRemaining is the remaining error budget of the period in percent of the total error budget. It is
+	// negative if the error budget is exhausted.
+	ErrorBudgetRemaining string
+	// LastUpdateTime is the time when the availability was last computed.
+	LastUpdateTime metav1.Time
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -293,9 +546,25 @@ type ShootAdvertisedAddress struct {
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
+	// Deprecated: This field is deprecated. Use `charts` to deploy the kubernetes-dashboard instead.
 	KubernetesDashboard *KubernetesDashboard
 	// NginxIngress holds configuration settings for the nginx-ingress addon.
+	// Deprecated: This field is deprecated. Use `charts` to deploy an ingress controller instead.
 	NginxIngress *NginxIngress
+	// Charts is a list of addons which are deployed into the shoot cluster by rendering the referenced Helm charts.
+	Charts []AddonChart
+}
+
+// AddonChart describes an addon which is deployed into the shoot cluster by rendering a Helm chart.
+type AddonChart struct {
+	// Name is the unique name of the addon. It is used as release name when rendering the chart.
+	Name string
+	// Namespace is the namespace in the shoot cluster into which the chart is rendered.
+	Namespace *string
+	// OCIRepository defines where to pull the chart from.
+	OCIRepository OCIRepository
+	// Values are the chart values.
+	Values *runtime.RawExtension
 }
 
 // Addon allows enabling or disabling a specific addon and is used to derive from.
@@ -336,6 +605,72 @@ type ControlPlane struct {
 	HighAvailability *HighAvailability
 }
 
+// Observability contains settings for the observability components of a shoot.
+type Observability struct {
+	// Logging contains settings for the logging stack of the shoot.
+	Logging *ObservabilityLogging
+	// EventArchive contains settings for archiving the events of the shoot to object storage. If set, the events of
+	// the shoot and its control plane are continuously exported, so that they outlive the event TTL of the clusters.
+	EventArchive *EventArchive
+	// Probes is a list of blackbox probes which are executed by the monitoring stack of the seed against endpoints
+	// exposed by the shoot, e.g., the load balancers of its workload. The results contribute to the
+	// `ObservabilityProbesHealthy` condition of the shoot.
+	Probes []ObservabilityProbe
+}
+
+// ObservabilityLogging contains settings for the logging stack of a shoot.
+type ObservabilityLogging struct {
+	// RetentionDays is the number of days for which the logs of the shoot are retained. Defaults to 15.
+	RetentionDays *int32
+	// IngestionRate is the amount of log data per second which is ingested for the shoot. Logs exceeding this rate
+	// are rejected. If not set, the default rate limit of the logging stack applies.
+	IngestionRate *resource.Quantity
+	// IngestionBurstSize is the amount of log data which may be ingested at once for the shoot. If not set, the
+	// default burst size of the logging stack applies.
+	IngestionBurstSize *resource.Quantity
+}
+
+// EventArchive contains settings for archiving the events of a shoot.
+type EventArchive struct {
+	// RetentionDays is the number of days for which the archived events are retained. Defaults to 30.
+	RetentionDays *int32
+	// Sink is the object storage to which the events are exported. If not set, the events are exported to the backup
+	// bucket of the seed.
+	Sink *EventArchiveSink
+}
+
+// EventArchiveSink contains information about an object storage to which events are exported.
+type EventArchiveSink struct {
+	// Provider is the provider type of the object storage.
+	Provider string
+	// ResourceName is the name of a resource in `.spec.resources` referring to a secret which contains the
+	// credentials and the bucket name of the object storage.
+	ResourceName string
+}
+
+// ObservabilityProbe is a blackbox probe against an endpoint exposed by a shoot.
+type ObservabilityProbe struct {
+	// Name is the unique name of the probe.
+	Name string
+	// Protocol is the protocol used for probing the target.
+	Protocol ObservabilityProbeProtocol
+	// Target is the endpoint to probe. For the `HTTP` protocol, this is an `http` or `https` URL, for the `TCP`
+	// protocol it is an address in the form `host:port`.
+	Target string
+}
+
+// ObservabilityProbeProtocol is a protocol used for blackbox probes.
+type ObservabilityProbeProtocol string
+
+const (
+	// ObservabilityProbeProtocolHTTP is a constant for probing a target via HTTP(S). The probe succeeds if the target
+	// responds with a 2xx status code.
+	ObservabilityProbeProtocolHTTP ObservabilityProbeProtocol = "HTTP"
+	// ObservabilityProbeProtocolTCP is a constant for probing a target via TCP. The probe succeeds if a connection can
+	// be established.
+	ObservabilityProbeProtocolTCP ObservabilityProbeProtocol = "TCP"
+)
+
 // DNS holds information about the provider, the hosted zone id and the domain.
 type DNS struct {
 	// Domain is the external available domain of the Shoot cluster. This domain will be written into the
@@ -452,6 +787,54 @@ type Kubernetes struct {
 	// Defaults to true for Shoots with Kubernetes versions < 1.26. Defaults to false for Shoots with Kubernetes versions >= 1.26.
 	// Starting Kubernetes 1.27 the field will be locked to false.
 	EnableStaticTokenKubeconfig *bool
+	// ETCD contains configuration for the etcds of the Shoot cluster.
+	ETCD *ETCD
+}
+
+// ETCD contains configuration for the etcds of the Shoot cluster.
+type ETCD struct {
+	// Main contains configuration for the main etcd.
+	Main *ETCDConfig
+	// Events contains configuration for the events etcd.
+	Events *ETCDConfig
+}
+
+// ETCDConfig contains configuration for an etcd.
+type ETCDConfig struct {
+	// Compaction contains configuration for the automatic compaction of the etcd.
+	Compaction *ETCDCompaction
+	// Defragmentation contains configuration for the defragmentation of the etcd.
+	Defragmentation *ETCDDefragmentation
+}
+
+// ETCDCompaction contains configuration for the automatic compaction of an etcd.
+type ETCDCompaction struct {
+	// Mode is the auto compaction mode of the etcd. Possible values are `periodic` and `revision`.
+	// Defaults to `periodic`.
+	Mode *ETCDCompactionMode
+	// Retention is the auto compaction retention of the etcd. For mode `periodic`, it is a duration (e.g. `30m`) or a
+	// number of hours. For mode `revision`, it is the number of revisions to keep.
+	Retention *string
+}
+
+// ETCDCompactionMode is a string alias.
+type ETCDCompactionMode string
+
+const (
+	// ETCDCompactionModePeriodic is a constant for the periodic auto compaction mode.
+	ETCDCompactionModePeriodic ETCDCompactionMode = "periodic"
+	// ETCDCompactionModeRevision is a constant for the revision auto compaction mode.
+	ETCDCompactionModeRevision ETCDCompactionMode = "revision"
+)
+
+// ETCDDefragmentation contains configuration for the defragmentation of an etcd.
+type ETCDDefragmentation struct {
+	// IntervalDays is the number of days between two defragmentations. The defragmentation is always scheduled within
+	// the maintenance time window of the Shoot. Defaults to `3` (or `1` for the main etcd of a ManagedSeed).
+	IntervalDays *int32
+	// DBSizeThreshold is the size of the database above which the etcd is defragmented daily within the maintenance
+	// time window of the Shoot, independent of IntervalDays.
+	DBSizeThreshold *resource.Quantity
 }
 
 // ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
@@ -485,8 +868,25 @@ type ClusterAutoscaler struct {
 	IgnoreDaemonsetsUtilization *bool
 	// Verbosity allows CA to modify its log level.
 	Verbosity *int32
+	// Profile is the name of a curated set of cluster-autoscaler parameters maintained in the CloudProfile. Parameters
+	// explicitly configured in this section take precedence over the ones of the profile.
+	Profile *ClusterAutoscalerProfile
 }
 
+// ClusterAutoscalerProfile is a string alias used for cluster-autoscaler profile names.
+type ClusterAutoscalerProfile string
+
+const (
+	// ClusterAutoscalerProfileConservative is a cluster-autoscaler profile which favors stability over costs, i.e., it
+	// scales down nodes rather slowly.
+	ClusterAutoscalerProfileConservative ClusterAutoscalerProfile = "conservative"
+	// ClusterAutoscalerProfileBalanced is a cluster-autoscaler profile which balances stability and costs.
+	ClusterAutoscalerProfileBalanced ClusterAutoscalerProfile = "balanced"
+	// ClusterAutoscalerProfileAggressive is a cluster-autoscaler profile which favors costs over stability, i.e., it
+	// scales down unneeded nodes quickly.
+	ClusterAutoscalerProfileAggressive ClusterAutoscalerProfile = "aggressive"
+)
+
 // ExpanderMode is type used for Expander values
 type ExpanderMode string
 
@@ -589,6 +989,18 @@ type KubeAPIServerConfig struct {
 	DefaultUnreachableTolerationSeconds *int64
 	// EncryptionConfig contains customizable encryption configuration of the API server.
 	EncryptionConfig *EncryptionConfig
+	// AdditionalSANs contains additional subject alternative names which are added to the serving certificate of the
+	// kube-apiserver, e.g., for clusters which are exposed via custom load balancers or domains.
+	AdditionalSANs *KubeAPIServerAdditionalSANs
+}
+
+// KubeAPIServerAdditionalSANs contains additional subject alternative names for the serving certificate of the
+// kube-apiserver.
+type KubeAPIServerAdditionalSANs struct {
+	// DNSNames is a list of additional DNS names. Wildcard DNS names (e.g., `*.example.com`) are allowed.
+	DNSNames []string
+	// IPAddresses is a list of additional IP addresses.
+	IPAddresses []string
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -898,8 +1310,27 @@ type KubeletConfig struct {
 	StreamingConnectionIdleTimeout *metav1.Duration
 	// MemorySwap configures swap memory available to container workloads.
 	MemorySwap *MemorySwapConfiguration
+	// CredentialProviders is a list of kubelet image credential provider plugins which are used to dynamically retrieve
+	// credentials for pulling container images, e.g., from private registries.
+	CredentialProviders []KubeletCredentialProvider
+	// ReservationPolicy is the policy for calculating the resources reserved for kubernetes node components. With
+	// `Static`, the configured `kubeReserved` values (or the Gardener defaults) are used. With `Auto`, the values are
+	// derived from the machine type of the worker pool, either from the `kubeReserved` table of the machine type in the
+	// CloudProfile or, if not present, based on the machine's CPU and memory capacity. Explicitly configured
+	// `kubeReserved` values always take precedence.
+	ReservationPolicy *KubeletReservationPolicy
 }
 
+// KubeletReservationPolicy is the policy for calculating the resources reserved for kubernetes node components.
+type KubeletReservationPolicy string
+
+const (
+	// KubeletReservationPolicyStatic uses the configured or the default reserved resources.
+	KubeletReservationPolicyStatic KubeletReservationPolicy = "Static"
+	// KubeletReservationPolicyAuto derives the reserved resources from the machine type of the worker pool.
+	KubeletReservationPolicyAuto KubeletReservationPolicy = "Auto"
+)
+
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
 type KubeletConfigEviction struct {
 	// MemoryAvailable is the threshold for the free memory on the host server.
@@ -978,6 +1409,41 @@ type MemorySwapConfiguration struct {
 	SwapBehavior *SwapBehavior
 }
 
+// KubeletCredentialProvider contains the configuration of a kubelet image credential provider plugin.
+type KubeletCredentialProvider struct {
+	// Name is the name of the credential provider. It must match the name of the provider executable on the node.
+	Name string
+	// Image is the container image which contains the credential provider executable. The executable is extracted from
+	// the image to the kubelet's credential provider binary directory on the nodes by gardener-node-agent.
+	Image string
+	// FilePathInImage is the path of the credential provider executable in the image.
+	// Defaults to `/<name>`.
+	FilePathInImage *string
+	// MatchImages is a list of patterns used to match against images in order to determine if this provider should be
+	// invoked, see https://kubernetes.io/docs/tasks/administer-cluster/kubelet-credential-provider/#configure-image-matching.
+	MatchImages []string
+	// DefaultCacheDuration is the default duration the kubelet caches credentials in-memory if the provider does not
+	// specify a cache duration in its response.
+	// Defaults to 1m.
+	DefaultCacheDuration *metav1.Duration
+	// APIVersion is the input and output version of the CredentialProviderRequest and CredentialProviderResponse
+	// exchanged with the plugin.
+	// Defaults to `credentialprovider.kubelet.k8s.io/v1`.
+	APIVersion *string
+	// Args are the arguments passed to the credential provider executable.
+	Args []string
+	// Env are additional environment variables exposed to the credential provider executable.
+	Env []KubeletCredentialProviderEnvVar
+}
+
+// KubeletCredentialProviderEnvVar is an environment variable passed to a kubelet image credential provider plugin.
+type KubeletCredentialProviderEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string
+	// Value is the value of the environment variable.
+	Value string
+}
+
 // Networking defines networking parameters for the shoot cluster.
 type Networking struct {
 	// Type identifies the type of the networking plugin. This field is immutable.
@@ -995,6 +1461,22 @@ type Networking struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md.
 	// Defaults to ["IPv4"].
 	IPFamilies []IPFamily
+	// AdditionalNodes is a list of additional CIDRs of the node network, e.g., added after the creation of the cluster
+	// when the initial node network has been exhausted. CIDRs can be added to this list but not removed.
+	AdditionalNodes []string
+	// PrivateLink contains the configuration for connecting the shoot nodes to the control plane in the seed via
+	// private endpoints instead of public load balancers.
+	PrivateLink *PrivateLink
+}
+
+// PrivateLink contains the configuration for connecting the shoot nodes to the control plane in the seed via private
+// endpoints (e.g., private link or private endpoint services of the infrastructure provider).
+type PrivateLink struct {
+	// Enabled controls whether the shoot nodes reach the control plane via private endpoints. This requires the seed to
+	// support private link connectivity.
+	Enabled bool
+	// ProviderConfig is the provider specific configuration passed to the PrivateLink extension resource.
+	ProviderConfig *runtime.RawExtension
 }
 
 const (
@@ -1022,6 +1504,18 @@ type Maintenance struct {
 	// Instead, they are rolled out during the shoot's maintenance time window. There is one exception that will trigger
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	ConfineSpecUpdateRollout *bool
+	// DependsOn references another Shoot whose versions gate the automatic version updates of this Shoot.
+	DependsOn *MaintenanceDependency
+}
+
+// MaintenanceDependency references a Shoot which must successfully run a version before automatic updates to this
+// version are applied to the dependent Shoot.
+type MaintenanceDependency struct {
+	// ShootName is the name of the referenced Shoot in the same namespace.
+	ShootName string
+	// MinimumRunDuration is the duration for which the referenced Shoot must have successfully run a version before
+	// automatic updates to this version are applied.
+	MinimumRunDuration *metav1.Duration
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1069,6 +1563,11 @@ type Provider struct {
 	Workers []Worker
 	// WorkersSettings contains settings for all workers.
 	WorkersSettings *WorkersSettings
+	// ResourceTags is a map of key/value pairs which are added as tags (or labels, depending on the provider) to all
+	// cloud resources created for the Shoot by the provider extension. The values may contain the placeholders
+	// `$(PROJECT_NAME)` and `$(SHOOT_NAME)`, which are replaced with the name of the Shoot's project and the name of
+	// the Shoot, respectively.
+	ResourceTags map[string]string
 }
 
 // Worker is the base definition of a worker group.
@@ -1121,6 +1620,8 @@ type Worker struct {
 	Sysctls map[string]string
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	ClusterAutoscaler *ClusterAutoscalerOptions
+	// Confidential contains settings for running the machines of this worker pool as confidential VMs.
+	Confidential *WorkerConfidential
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -1137,6 +1638,21 @@ type ClusterAutoscalerOptions struct {
 	MaxNodeProvisionTime *metav1.Duration
 }
 
+// WorkerConfidential contains settings for running the machines of a worker pool as confidential VMs.
+type WorkerConfidential struct {
+	// Attestation contains settings for the remote attestation of the machines.
+	Attestation *WorkerConfidentialAttestation
+}
+
+// WorkerConfidentialAttestation contains settings for the remote attestation of confidential machines.
+type WorkerConfidentialAttestation struct {
+	// Endpoint is the URL of the attestation service which verifies the attestation reports of the machines.
+	Endpoint string
+	// Policy is the name of the attestation policy the machines have to satisfy. If not set, the default policy of the
+	// attestation service is used.
+	Policy *string
+}
+
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
 type MachineControllerManagerSettings struct {
 	// MachineDrainTimeout is the period after which machine is forcefully deleted.
@@ -1178,6 +1694,22 @@ type Machine struct {
 	Image *ShootMachineImage
 	// Architecture is the CPU architecture of the machines in this worker pool.
 	Architecture *string
+	// Requirements are the requirements for the machine type of the worker group. If the machine type is not
+	// specified, the smallest machine type of the CloudProfile satisfying the requirements is selected.
+	Requirements *MachineTypeRequirements
+}
+
+// MachineTypeRequirements contains the requirements for the machine type of a worker group.
+type MachineTypeRequirements struct {
+	// CPU is the minimum number of CPUs.
+	CPU *resource.Quantity
+	// GPU is the minimum number of GPUs.
+	GPU *resource.Quantity
+	// Memory is the minimum amount of memory.
+	Memory *resource.Quantity
+	// Capabilities are the capabilities the machine type must provide. Capabilities which are not set or disabled are
+	// not required.
+	Capabilities *MachineTypeCapabilities
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1251,6 +1783,10 @@ var (
 type WorkersSettings struct {
 	// SSHAccess contains settings regarding ssh access to the worker nodes.
 	SSHAccess *SSHAccess
+	// Containerd contains settings for containerd which are applied on all worker pools.
+	Containerd *ContainerdSettings
+	// EgressOnlyInternetAccess contains settings regarding the internet access of the worker nodes.
+	EgressOnlyInternetAccess *EgressOnlyInternetAccess
 }
 
 // SSHAccess contains settings regarding ssh access to the worker nodes.
@@ -1260,12 +1796,73 @@ type SSHAccess struct {
 	Enabled bool
 }
 
+// EgressOnlyInternetAccess contains settings regarding the internet access of the worker nodes.
+type EgressOnlyInternetAccess struct {
+	// Enabled indicates whether the worker nodes are provisioned without public IPs and reach the internet only via
+	// NAT which is set up by the provider extension. This field is immutable.
+	Enabled bool
+}
+
+// ContainerdSettings contains settings for containerd which are applied on all worker pools.
+type ContainerdSettings struct {
+	// Registries is a list of registry host configurations for containerd.
+	Registries []ContainerdRegistry
+}
+
+// ContainerdRegistry contains the hosts configuration of containerd for an upstream registry.
+type ContainerdRegistry struct {
+	// Upstream is the upstream registry host (and optional port) for which the configuration applies, e.g.
+	// `docker.io` or `my-registry.example.com:5000`.
+	Upstream string
+	// Server is the URL of the upstream registry which is used when none of the hosts can serve a request.
+	Server *string
+	// Hosts is a list of hosts (e.g. mirrors) which are tried in the given order before falling back to the server.
+	Hosts []ContainerdRegistryHost
+}
+
+// ContainerdRegistryHost contains the configuration of containerd for a registry host.
+type ContainerdRegistryHost struct {
+	// URL is the URL of the registry host, e.g. `https://mirror.example.com`.
+	URL string
+	// Capabilities are the operations the host is capable of performing.
+	Capabilities []ContainerdRegistryCapability
+	// ResourceName is the name of a resource in `.spec.resources` referring to a secret which contains the CA bundle
+	// (`ca.crt`) and/or the credentials (`username` and `password`, or `token`) for the registry host.
+	ResourceName *string
+}
+
+// ContainerdRegistryCapability is an operation a containerd registry host is capable of performing.
+type ContainerdRegistryCapability string
+
+const (
+	// ContainerdRegistryCapabilityPull is the capability to fetch manifests and blobs by digest.
+	ContainerdRegistryCapabilityPull ContainerdRegistryCapability = "pull"
+	// ContainerdRegistryCapabilityResolve is the capability to resolve a name into a digest.
+	ContainerdRegistryCapabilityResolve ContainerdRegistryCapability = "resolve"
+	// ContainerdRegistryCapabilityPush is the capability to push manifests and blobs.
+	ContainerdRegistryCapabilityPush ContainerdRegistryCapability = "push"
+)
+
 // SystemComponents contains the settings of system components in the control or data plane of the Shoot cluster.
 type SystemComponents struct {
 	// CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
 	CoreDNS *CoreDNS
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	NodeLocalDNS *NodeLocalDNS
+	// PriorityClasses is a list of additional PriorityClasses which are managed by Gardener in the Shoot cluster. They
+	// can be used by system components deployed by the Shoot owner.
+	PriorityClasses []SystemComponentsPriorityClass
+}
+
+// SystemComponentsPriorityClass is a PriorityClass which is managed by Gardener in the Shoot cluster.
+type SystemComponentsPriorityClass struct {
+	// Name is the name of the PriorityClass.
+	Name string
+	// Value is the priority of the PriorityClass. It must not be higher than 999999000 so that it stays below the
+	// priorities of the PriorityClasses for the Shoot system components managed by Gardener.
+	Value int32
+	// Description is an optional description of the PriorityClass.
+	Description *string
 }
 
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1341,6 +1938,15 @@ const (
 	ShootEveryNodeReady ConditionType = "EveryNodeReady"
 	// ShootSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// ShootObservabilityProbesHealthy is a constant for a condition type indicating the health of the endpoints which are
+	// probed according to the observability probes of the shoot.
+	ShootObservabilityProbesHealthy ConditionType = "ObservabilityProbesHealthy"
+	// ShootReadinessGatesPassed is a constant for a condition type indicating whether the readiness gates of the shoot
+	// pass.
+	ShootReadinessGatesPassed ConditionType = "ReadinessGatesPassed"
+	// ShootCertificateIssuanceExpected is a constant for a condition type indicating that no unexpected certificates
+	// were found in the public certificate transparency logs for the API server domains of the shoot.
+	ShootCertificateIssuanceExpected ConditionType = "CertificateIssuanceExpected"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible ConditionType = "HibernationPossible"
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
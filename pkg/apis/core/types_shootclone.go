@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClone can be used to generate the manifest of a new Shoot based on an existing Shoot. The new Shoot is not
+// created, the returned manifest has to be submitted by the client.
+type ShootClone struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of the ShootClone.
+	Spec ShootCloneSpec
+	// Status is the status of the ShootClone containing the generated Shoot.
+	Status ShootCloneStatus
+}
+
+// ShootCloneSpec is the specification of the ShootClone.
+type ShootCloneSpec struct {
+	// Name is the name of the new Shoot.
+	Name string
+	// CopyWorkers specifies whether the worker pools shall be copied.
+	CopyWorkers *bool
+	// CopyNetworking specifies whether the node, pod, and service networks shall be copied.
+	CopyNetworking *bool
+	// CopyAddons specifies whether the addons and extensions shall be copied.
+	CopyAddons *bool
+}
+
+// ShootCloneStatus contains the generated Shoot.
+type ShootCloneStatus struct {
+	// Shoot is the manifest of the new Shoot.
+	Shoot *Shoot
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootCostEstimate can be used to estimate the monthly cost of the worker pools of a Shoot based on the prices
+// maintained in its CloudProfile.
+type ShootCostEstimate struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of the ShootCostEstimate.
+	Spec ShootCostEstimateSpec
+	// Status is the status of the ShootCostEstimate containing the estimated cost.
+	Status ShootCostEstimateStatus
+}
+
+// ShootCostEstimateSpec is the specification of the ShootCostEstimate.
+type ShootCostEstimateSpec struct {
+	// Workers is a list of worker pools whose cost shall be estimated. It allows comparing different worker pool
+	// configurations without changing the Shoot. If empty, the worker pools of the Shoot are used.
+	Workers []Worker
+}
+
+// ShootCostEstimateStatus contains the estimated monthly cost. All costs are decimal numbers rounded to two decimal
+// places and given in the currency of the prices in the CloudProfile.
+type ShootCostEstimateStatus struct {
+	// Workers contains the estimated monthly cost of the individual worker pools.
+	Workers []WorkerCostEstimate
+	// MinimumMonthlyCost is the estimated monthly cost of all worker pools running with their minimum number of
+	// machines.
+	MinimumMonthlyCost string
+	// MaximumMonthlyCost is the estimated monthly cost of all worker pools running with their maximum number of
+	// machines.
+	MaximumMonthlyCost string
+	// Warnings contains information about cost which could not be considered in the estimate, e.g., because no price is
+	// maintained in the CloudProfile for a machine or volume type.
+	Warnings []string
+}
+
+// WorkerCostEstimate contains the estimated monthly cost of a worker pool.
+type WorkerCostEstimate struct {
+	// Name is the name of the worker pool.
+	Name string
+	// MinimumMonthlyCost is the estimated monthly cost of the worker pool running with its minimum number of machines.
+	MinimumMonthlyCost string
+	// MaximumMonthlyCost is the estimated monthly cost of the worker pool running with its maximum number of machines.
+	MaximumMonthlyCost string
+}
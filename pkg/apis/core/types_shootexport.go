@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootExport can be used to export a Shoot into a provider-agnostic ShootProfile and, optionally, to import this
+// profile against another CloudProfile.
+type ShootExport struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec is the specification of the ShootExport.
+	Spec ShootExportSpec
+	// Status is the status of the ShootExport containing the exported profile.
+	Status ShootExportStatus
+}
+
+// ShootExportSpec is the specification of the ShootExport.
+type ShootExportSpec struct {
+	// Target specifies the CloudProfile and region against which the exported profile shall be imported.
+	Target *ShootExportTarget
+}
+
+// ShootExportTarget specifies the CloudProfile and region against which an exported profile shall be imported.
+type ShootExportTarget struct {
+	// CloudProfileName is the name of the CloudProfile.
+	CloudProfileName string
+	// Region is the name of the region.
+	Region string
+}
+
+// ShootExportStatus contains the exported profile and the imported Shoot.
+type ShootExportStatus struct {
+	// Profile is the provider-agnostic profile of the Shoot.
+	Profile *ShootProfile
+	// Shoot is the manifest of the Shoot imported against the target CloudProfile.
+	Shoot *Shoot
+	// Warnings contains information about aspects of the profile that could not be imported exactly.
+	Warnings []string
+}
+
+// ShootProfile is a provider-agnostic representation of the specification of a Shoot.
+type ShootProfile struct {
+	// Version is the version of the profile format.
+	Version string
+	// KubernetesVersion is the Kubernetes version of the Shoot.
+	KubernetesVersion string
+	// Purpose is the purpose of the Shoot.
+	Purpose *ShootPurpose
+	// ControlPlane contains general settings for the control plane of the Shoot.
+	ControlPlane *ControlPlane
+	// Networking contains information about the networking of the Shoot.
+	Networking *Networking
+	// Maintenance contains information about the maintenance of the Shoot.
+	Maintenance *Maintenance
+	// Hibernation contains information about the hibernation of the Shoot.
+	Hibernation *Hibernation
+	// Workers is a list of provider-agnostic worker pools.
+	Workers []WorkerProfile
+}
+
+// WorkerProfile is a provider-agnostic representation of a worker pool.
+type WorkerProfile struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Machine contains the capability requirements for the machines of the worker pool.
+	Machine MachineRequirements
+	// Volume contains the requirements for the root volumes of the machines.
+	Volume *VolumeRequirements
+	// Minimum is the minimum number of machines.
+	Minimum int32
+	// Maximum is the maximum number of machines.
+	Maximum int32
+	// ZoneCount is the number of zones the worker pool is spread across.
+	ZoneCount int32
+	// Labels is a map of key/value pairs for labels applied to all the nodes in the worker pool.
+	Labels map[string]string
+	// Taints is a list of taints applied to all the nodes in the worker pool.
+	Taints []corev1.Taint
+}
+
+// MachineRequirements contains the capability requirements for machines.
+type MachineRequirements struct {
+	// CPU is the minimum number of CPUs.
+	CPU resource.Quantity
+	// GPU is the minimum number of GPUs.
+	GPU resource.Quantity
+	// Memory is the minimum amount of memory.
+	Memory resource.Quantity
+	// Architecture is the CPU architecture.
+	Architecture *string
+	// Capabilities are the capabilities the machines must provide.
+	Capabilities *MachineTypeCapabilities
+}
+
+// VolumeRequirements contains the requirements for volumes.
+type VolumeRequirements struct {
+	// VolumeSize is the size of the volume.
+	VolumeSize string
+	// Class is the class of the volume type.
+	Class *string
+}
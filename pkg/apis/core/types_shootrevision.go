@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:onlyVerbs=get,list,watch,delete
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRevision holds the history of the changes of the specification of the Shoot with the same name. It is
+// maintained by the gardener-apiserver and cannot be created or modified by clients.
+type ShootRevision struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Revisions is the list of the recorded changes of the Shoot specification, ordered from the oldest to the newest
+	// change. Only the most recent changes are kept.
+	Revisions []ShootSpecChange
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRevisionList is a collection of ShootRevisions.
+type ShootRevisionList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootRevisions.
+	Items []ShootRevision
+}
+
+// ShootSpecChange is a change of the specification of a Shoot.
+type ShootSpecChange struct {
+	// Generation is the generation of the Shoot after the change.
+	Generation int64
+	// Timestamp is the time when the change was made.
+	Timestamp metav1.Time
+	// User is the name of the user who made the change.
+	User string
+	// Patch is the JSON patch (RFC 6902) transforming the previous specification of the Shoot into the changed one.
+	Patch string
+}
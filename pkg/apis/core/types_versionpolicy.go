@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VersionPolicy declares garden-wide minimum Kubernetes and machine image versions for Shoots.
+type VersionPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the specification of the VersionPolicy.
+	Spec VersionPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VersionPolicyList is a collection of VersionPolicies.
+type VersionPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of VersionPolicies.
+	Items []VersionPolicy
+}
+
+// VersionPolicySpec is the specification of a VersionPolicy.
+type VersionPolicySpec struct {
+	// Kubernetes contains the minimum Kubernetes version of the control plane and of all worker pools.
+	Kubernetes *KubernetesVersionPolicy
+	// MachineImages contains the minimum versions of machine images.
+	MachineImages []MachineImageVersionPolicy
+	// ForceUpdate indicates whether Shoots violating the policy are forcefully updated during their next maintenance
+	// time window, similar to Shoots using an expired version of their CloudProfile.
+	ForceUpdate bool
+}
+
+// KubernetesVersionPolicy contains the minimum Kubernetes version.
+type KubernetesVersionPolicy struct {
+	// MinimumVersion is the minimum allowed Kubernetes version.
+	MinimumVersion string
+}
+
+// MachineImageVersionPolicy contains the minimum version of a machine image.
+type MachineImageVersionPolicy struct {
+	// Name is the name of the machine image.
+	Name string
+	// MinimumVersion is the minimum allowed version of the machine image.
+	MinimumVersion string
+}
@@ -281,6 +281,12 @@ const (
 	GardenRoleExposureClassHandler = "exposureclass-handler"
 	// GardenRoleShootServiceAccountIssuer is the value of the GardenRole key indicating type 'shoot-service-account-issuer'.
 	GardenRoleShootServiceAccountIssuer = "shoot-service-account-issuer"
+	// GardenRoleUsageReport is the value of the GardenRole key indicating type 'usage-report'.
+	GardenRoleUsageReport = "usage-report"
+	// GardenRoleShootSystemComponent is the value of the GardenRole key indicating type 'shoot-system-component'.
+	GardenRoleShootSystemComponent = "shoot-system-component"
+	// GardenRoleShootAddonChart is the value of the GardenRole key indicating type 'shoot-addon-chart'.
+	GardenRoleShootAddonChart = "shoot-addon-chart"
 
 	// ShootUID is an annotation key for the shoot namespace in the seed cluster,
 	// which value will be the value of `shoot.status.uid`
@@ -292,9 +298,14 @@ const (
 	// does only mean that the period reconciliation is disabled. However, when the Gardener is restarted/redeployed or the specification is
 	// changed then the reconciliation flow will be executed.
 	ShootSyncPeriod = "shoot.gardener.cloud/sync-period"
+	// ShootSkipKubernetesVersionUpgradeChecks is a constant for an annotation on a Shoot which allows Gardener operators
+	// to skip the checks for Kubernetes version downgrades and upgrades skipping a minor version. Only users who are
+	// allowed to use the `skip-kubernetes-version-upgrade-checks` verb for shoots may make use of it.
+	ShootSkipKubernetesVersionUpgradeChecks = "shoot.gardener.cloud/skip-kubernetes-version-upgrade-checks"
 	// ShootIgnore is a constant for an annotation on a Shoot which may be used to tell the Gardener that the Shoot with this name should be
 	// ignored completely. That means that the Shoot will never reach the reconciliation flow (independent of the operation (create/update/
 	// delete)).
+	// Deprecated: Use the `.spec.reconciliation.paused` field of the Shoot instead.
 	ShootIgnore = "shoot.gardener.cloud/ignore"
 	// ShootNoCleanup is a constant for a label on a resource indicating that the Gardener cleaner should not delete this
 	// resource when cleaning a shoot during the deletion flow.
@@ -320,9 +331,16 @@ const (
 	ShootStatus = "shoot.gardener.cloud/status"
 	// FailedShootNeedsRetryOperation is a constant for an annotation on a Shoot in a failed state indicating that a retry operation should be triggered during the next maintenance time window.
 	FailedShootNeedsRetryOperation = "maintenance.shoot.gardener.cloud/needs-retry-operation"
+	// ShootMaintenanceVetoPlannedChanges is a constant for an annotation on a Shoot indicating that the planned version
+	// updates which are not forced shall not be applied during the next maintenance time window. The annotation is
+	// removed after the maintenance.
+	ShootMaintenanceVetoPlannedChanges = "maintenance.shoot.gardener.cloud/veto-planned-changes"
 	// LabelExcludeWebhookFromRemediation is a constant for a label on a webhook in the shoot which makes it being
 	// excluded from automatic remediation.
 	LabelExcludeWebhookFromRemediation = "remediation.webhook.shoot.gardener.cloud/exclude"
+	// LabelExcludeAPIServiceFromRemediation is a constant for a label on an APIService in the shoot which makes it
+	// being excluded from automatic remediation.
+	LabelExcludeAPIServiceFromRemediation = "remediation.apiservice.shoot.gardener.cloud/exclude"
 
 	// ShootTasks is a constant for an annotation on a Shoot which states that certain tasks should be done.
 	ShootTasks = "shoot.gardener.cloud/tasks"
@@ -424,6 +442,9 @@ const (
 	// LabelPrefixMonitoringDashboard is the prefix of a label key on ConfigMaps for indicating that the data contains a
 	// dashboard.
 	LabelPrefixMonitoringDashboard = "dashboard.monitoring.gardener.cloud/"
+	// AnnotationMonitoringDashboardFolder is the key of an annotation on dashboard ConfigMaps for specifying the folder
+	// in which the contained dashboards are organized.
+	AnnotationMonitoringDashboardFolder = "dashboard.monitoring.gardener.cloud/folder"
 	// LabelKeyCustomLoggingResource is the key of the label which is used from the operator to select the CustomResources which will be imported in the FluentBit configuration.
 	// TODO(nickytd): the label key has to be migrated to "fluentbit.gardener.cloud/type".
 	LabelKeyCustomLoggingResource = "fluentbit.gardener/type"
@@ -551,6 +572,8 @@ const (
 	LabelProxy = "proxy"
 	// LabelExtensionProjectRole is a constant for a label value for extension project roles
 	LabelExtensionProjectRole = "extension-project-role"
+	// LabelCustomProjectRole is a constant for a label value for custom project roles
+	LabelCustomProjectRole = "custom-project-role"
 
 	// LabelShootNamespace is a constant for a label key that indicates a relationship to a shoot in the specified namespace.
 	LabelShootNamespace = "shoot.gardener.cloud/namespace"
@@ -621,6 +644,9 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// AnnotationSchedulingReschedule is a constant for an annotation key on a Shoot which instructs the
+	// gardener-scheduler to move the shoot to another seed because its current seed is being decommissioned.
+	AnnotationSchedulingReschedule = "scheduling.gardener.cloud/reschedule"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -635,6 +661,12 @@ const (
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
+	// AnnotationSeedRestoreShootsTo is a key for an annotation on a destroyed Seed whose value is the name of the Seed
+	// to which the control planes of all Shoots hosted by the destroyed Seed shall be restored.
+	AnnotationSeedRestoreShootsTo = "seed.gardener.cloud/restore-shoots-to"
+	// AnnotationShootRestoredFrom is a key for an annotation on a Shoot whose value is the name of the destroyed Seed
+	// from which its control plane is restored.
+	AnnotationShootRestoredFrom = "shoot.gardener.cloud/restored-from"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
 	// declares the grace period in seconds for finalizing the resources handled in the 'cleanup webhooks' step.
 	// Concretely, after the specified seconds, all the finalizers of the affected resources are forcefully removed.
@@ -657,6 +689,11 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootCertificateValidities is a key for an annotation on a Shoot resource shortening the validities of
+	// the server and client certificates generated for its control plane. The value is a comma-separated list of
+	// `<type>=<duration>` where type is either `server` or `client`. Validities longer than the ones configured for
+	// gardenlet are ignored, and changed values only apply to certificates generated afterwards.
+	AnnotationShootCertificateValidities = "shoot.gardener.cloud/certificate-validities"
 	// AnnotationCoreDNSRewritingDisabled disables core dns query rewriting even if the corresponding feature gate is enabled.
 	AnnotationCoreDNSRewritingDisabled = "alpha.featuregates.shoot.gardener.cloud/core-dns-rewriting-disabled"
 
@@ -717,6 +754,25 @@ const (
 	// LabelCareConditionType is a key for a label on a ManagedResource indicating to which condition type its status
 	// should be aggregated.
 	LabelCareConditionType = "care.gardener.cloud/condition-type"
+	// AnnotationShootCareConditionThresholds is a key for an annotation on a Shoot resource overriding the durations
+	// how long care conditions can stay in the progressing state. The value is a comma-separated list of `<type>=<duration>`.
+	AnnotationShootCareConditionThresholds = "care.gardener.cloud/condition-thresholds"
+	// AnnotationShootCareConditionStaleDurations is a key for an annotation on a Shoot resource overriding the durations
+	// after which health check reports of extensions are considered outdated per care condition. The value is a
+	// comma-separated list of `<type>=<duration>`.
+	AnnotationShootCareConditionStaleDurations = "care.gardener.cloud/condition-stale-durations"
+	// AnnotationShootCareConditionSeverities is a key for an annotation on a Shoot resource overriding the severities of
+	// care conditions. The value is a comma-separated list of `<type>=<severity>`.
+	AnnotationShootCareConditionSeverities = "care.gardener.cloud/condition-severities"
+	// AnnotationScaledDownReplicas is a key for an annotation on a control plane Deployment which has been scaled down
+	// by the node lease probe of the shoot care controller. The value is the number of replicas before scaling down.
+	AnnotationScaledDownReplicas = "care.gardener.cloud/scaled-down-replicas"
+	// CareConditionSeverityError is a constant for the severity of care conditions which are set to `False` if their
+	// health checks fail.
+	CareConditionSeverityError = "Error"
+	// CareConditionSeverityWarning is a constant for the severity of care conditions which are kept in the progressing
+	// state if their health checks fail.
+	CareConditionSeverityWarning = "Warning"
 	// ObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
 	ObservabilityComponentsHealthy = "ObservabilityComponentsHealthy"
 
@@ -728,6 +784,9 @@ const (
 	LabelWorkerPoolDeprecated = "worker.garden.sapcloud.io/group"
 	// LabelWorkerPoolSystemComponents is a constant that indicates whether the worker pool should host system components
 	LabelWorkerPoolSystemComponents = "worker.gardener.cloud/system-components"
+	// LabelWorkerPoolConfidential is a constant for a label that indicates that the machines of the worker pool are
+	// confidential VMs.
+	LabelWorkerPoolConfidential = "worker.gardener.cloud/confidential"
 	// LabelWorkerPoolGardenerNodeAgentSecretName is the name of the secret used by the gardener node agent
 	LabelWorkerPoolGardenerNodeAgentSecretName = "worker.gardener.cloud/gardener-node-agent-secret-name"
 
@@ -938,4 +997,11 @@ const (
 
 	// GardenPurposeMachineClass is a constant for the 'machineclass' value in a label.
 	GardenPurposeMachineClass = "machineclass"
+
+	// ResourceTagPlaceholderProjectName is the placeholder in the values of a Shoot's resource tags which is replaced
+	// with the name of the Shoot's project.
+	ResourceTagPlaceholderProjectName = "$(PROJECT_NAME)"
+	// ResourceTagPlaceholderShootName is the placeholder in the values of a Shoot's resource tags which is replaced
+	// with the name of the Shoot.
+	ResourceTagPlaceholderShootName = "$(SHOOT_NAME)"
 )
@@ -90,7 +90,7 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		SchemeGroupVersion.WithKind("Shoot"),
 		func(label, value string) (string, string, error) {
 			switch label {
-			case "metadata.name", "metadata.namespace", core.ShootSeedName, core.ShootCloudProfileName, core.ShootStatusSeedName:
+			case "metadata.name", "metadata.namespace", core.ShootSeedName, core.ShootCloudProfileName, core.ShootStatusSeedName, core.ShootStatusLastOperationState, core.ShootStatusConditionsHealth:
 				return label, value, nil
 			default:
 				return "", "", fmt.Errorf("field label not supported: %s", label)
@@ -24,7 +24,7 @@ func SetDefaults_SeedSettings(obj *SeedSettings) {
 		setDefaults_ExcessCapacityReservationConfig(obj.ExcessCapacityReservation)
 	}
 
-	if ptr.Deref(obj.ExcessCapacityReservation.Enabled, true) && len(obj.ExcessCapacityReservation.Configs) == 0 {
+	if ptr.Deref(obj.ExcessCapacityReservation.Enabled, true) && len(obj.ExcessCapacityReservation.Configs) == 0 && len(obj.ExcessCapacityReservation.Profiles) == 0 {
 		setDefaults_ExcessCapacityReservationConfig(obj.ExcessCapacityReservation)
 	}
 
@@ -57,6 +57,20 @@ var _ = Describe("Seed defaulting", func() {
 			Expect(obj.Spec.Settings.ExcessCapacityReservation).To(PointTo(Equal(excessCapacityReservation)))
 		})
 
+		It("should not default the excessCapacityReservation configs when excess capacity reservation profiles are configured", func() {
+			var excessCapacityReservation = SeedSettingExcessCapacityReservation{
+				Profiles: []SeedSettingExcessCapacityReservationProfile{
+					{Name: "large", Resources: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8"), corev1.ResourceMemory: resource.MustParse("32Gi")}},
+				},
+			}
+
+			obj.Spec.Settings = &SeedSettings{ExcessCapacityReservation: excessCapacityReservation.DeepCopy()}
+
+			SetObjectDefaults_Seed(obj)
+
+			Expect(obj.Spec.Settings.ExcessCapacityReservation).To(PointTo(Equal(excessCapacityReservation)))
+		})
+
 		It("should not overwrite the already set values for seed settings field", func() {
 			var (
 				excessCapacityReservation = SeedSettingExcessCapacityReservation{
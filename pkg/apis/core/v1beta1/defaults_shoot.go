@@ -271,8 +271,44 @@ func SetDefaults_Worker(obj *Worker) {
 	}
 }
 
+// SetDefaults_KubeletCredentialProvider sets default values for KubeletCredentialProvider objects.
+func SetDefaults_KubeletCredentialProvider(obj *KubeletCredentialProvider) {
+	if obj.FilePathInImage == nil {
+		obj.FilePathInImage = ptr.To("/" + obj.Name)
+	}
+	if obj.DefaultCacheDuration == nil {
+		obj.DefaultCacheDuration = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.APIVersion == nil {
+		obj.APIVersion = ptr.To("credentialprovider.kubelet.k8s.io/v1")
+	}
+}
+
+// SetDefaults_ContainerdRegistry sets default values for ContainerdRegistry objects.
+func SetDefaults_ContainerdRegistry(obj *ContainerdRegistry) {
+	if obj.Server == nil {
+		server := "https://" + obj.Upstream
+		if obj.Upstream == "docker.io" {
+			server = "https://registry-1.docker.io"
+		}
+		obj.Server = &server
+	}
+}
+
+// SetDefaults_ContainerdRegistryHost sets default values for ContainerdRegistryHost objects.
+func SetDefaults_ContainerdRegistryHost(obj *ContainerdRegistryHost) {
+	if len(obj.Capabilities) == 0 {
+		obj.Capabilities = []ContainerdRegistryCapability{ContainerdRegistryCapabilityPull, ContainerdRegistryCapabilityResolve}
+	}
+}
+
 // SetDefaults_ClusterAutoscaler sets default values for ClusterAutoscaler object.
 func SetDefaults_ClusterAutoscaler(obj *ClusterAutoscaler) {
+	// Unset parameters of clusters selecting a profile are determined based on the profile in the CloudProfile.
+	if obj.Profile != nil {
+		return
+	}
+
 	if obj.ScaleDownDelayAfterAdd == nil {
 		obj.ScaleDownDelayAfterAdd = &metav1.Duration{Duration: 1 * time.Hour}
 	}
@@ -323,6 +359,13 @@ func SetDefaults_NginxIngress(obj *NginxIngress) {
 	}
 }
 
+// SetDefaults_AddonChart sets default values for AddonChart objects.
+func SetDefaults_AddonChart(obj *AddonChart) {
+	if obj.Namespace == nil {
+		obj.Namespace = ptr.To(metav1.NamespaceSystem)
+	}
+}
+
 // Helper functions
 
 func calculateDefaultNodeCIDRMaskSize(shoot *ShootSpec) *int32 {
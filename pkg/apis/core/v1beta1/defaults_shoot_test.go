@@ -479,6 +479,20 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.Addons.NginxIngress.ExternalTrafficPolicy).To(PointTo(Equal(corev1.ServiceExternalTrafficPolicyLocal)))
 		})
 
+		It("should default the namespace of addon charts", func() {
+			obj.Spec.Addons = &Addons{
+				Charts: []AddonChart{
+					{Name: "foo"},
+					{Name: "bar", Namespace: ptr.To("bar")},
+				},
+			}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Addons.Charts[0].Namespace).To(PointTo(Equal("kube-system")))
+			Expect(obj.Spec.Addons.Charts[1].Namespace).To(PointTo(Equal("bar")))
+		})
+
 		It("should not default the addons field for workerless Shoot", func() {
 			obj.Spec.Provider.Workers = nil
 			obj.Spec.Addons = nil
@@ -799,6 +813,76 @@ var _ = Describe("Shoot defaulting", func() {
 		})
 	})
 
+	Describe("KubeletCredentialProvider defaulting", func() {
+		It("should default the credential provider fields", func() {
+			obj.Spec.Provider.Workers[0].Kubernetes = &WorkerKubernetes{Kubelet: &KubeletConfig{
+				CredentialProviders: []KubeletCredentialProvider{{Name: "foo"}},
+			}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].Kubernetes.Kubelet.CredentialProviders).To(ConsistOf(KubeletCredentialProvider{
+				Name:                 "foo",
+				FilePathInImage:      ptr.To("/foo"),
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           ptr.To("credentialprovider.kubelet.k8s.io/v1"),
+			}))
+		})
+
+		It("should not overwrite the already set values for credential provider fields", func() {
+			provider := KubeletCredentialProvider{
+				Name:                 "foo",
+				FilePathInImage:      ptr.To("/bin/foo"),
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Hour},
+				APIVersion:           ptr.To("credentialprovider.kubelet.k8s.io/v1beta1"),
+			}
+			obj.Spec.Kubernetes.Kubelet = &KubeletConfig{CredentialProviders: []KubeletCredentialProvider{provider}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Kubernetes.Kubelet.CredentialProviders).To(ConsistOf(provider))
+		})
+	})
+
+	Describe("ContainerdRegistry defaulting", func() {
+		It("should default the server and the host capabilities", func() {
+			obj.Spec.Provider.WorkersSettings = &WorkersSettings{Containerd: &ContainerdSettings{Registries: []ContainerdRegistry{
+				{Upstream: "docker.io", Hosts: []ContainerdRegistryHost{{URL: "https://mirror.example.com"}}},
+				{Upstream: "registry.example.com:5000"},
+			}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.WorkersSettings.Containerd.Registries).To(Equal([]ContainerdRegistry{
+				{
+					Upstream: "docker.io",
+					Server:   ptr.To("https://registry-1.docker.io"),
+					Hosts: []ContainerdRegistryHost{{
+						URL:          "https://mirror.example.com",
+						Capabilities: []ContainerdRegistryCapability{ContainerdRegistryCapabilityPull, ContainerdRegistryCapabilityResolve},
+					}},
+				},
+				{Upstream: "registry.example.com:5000", Server: ptr.To("https://registry.example.com:5000")},
+			}))
+		})
+
+		It("should not overwrite the already set values", func() {
+			registry := ContainerdRegistry{
+				Upstream: "docker.io",
+				Server:   ptr.To("http://docker.example.com"),
+				Hosts: []ContainerdRegistryHost{{
+					URL:          "https://mirror.example.com",
+					Capabilities: []ContainerdRegistryCapability{ContainerdRegistryCapabilityPull},
+				}},
+			}
+			obj.Spec.Provider.WorkersSettings = &WorkersSettings{Containerd: &ContainerdSettings{Registries: []ContainerdRegistry{registry}}}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.WorkersSettings.Containerd.Registries).To(ConsistOf(registry))
+		})
+	})
+
 	Describe("ClusterAutoscaler defaulting", func() {
 		var (
 			expanderRandom     = ClusterAutoscalerExpanderRandom
@@ -857,6 +941,22 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.Kubernetes.ClusterAutoscaler.NewPodScaleUpDelay).To(PointTo(Equal(metav1.Duration{Duration: 1})))
 			Expect(obj.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete).To(PointTo(Equal(int32(20))))
 		})
+
+		It("should not default the ClusterAutoscaler field if a profile is selected", func() {
+			obj.Spec.Kubernetes.ClusterAutoscaler = &ClusterAutoscaler{
+				Profile:   ptr.To(ClusterAutoscalerProfileAggressive),
+				Expander:  &expanderRandom,
+				Verbosity: ptr.To[int32](4),
+			}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Kubernetes.ClusterAutoscaler).To(Equal(&ClusterAutoscaler{
+				Profile:   ptr.To(ClusterAutoscalerProfileAggressive),
+				Expander:  &expanderRandom,
+				Verbosity: ptr.To[int32](4),
+			}))
+		})
 	})
 
 	Describe("VerticalPodAutoscaler defaulting", func() {
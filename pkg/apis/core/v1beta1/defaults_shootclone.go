@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"k8s.io/utils/ptr"
+)
+
+// SetDefaults_ShootCloneSpec sets default values for ShootCloneSpec objects.
+func SetDefaults_ShootCloneSpec(obj *ShootCloneSpec) {
+	if obj.CopyWorkers == nil {
+		obj.CopyWorkers = ptr.To(true)
+	}
+	if obj.CopyNetworking == nil {
+		obj.CopyNetworking = ptr.To(true)
+	}
+	if obj.CopyAddons == nil {
+		obj.CopyAddons = ptr.To(true)
+	}
+}
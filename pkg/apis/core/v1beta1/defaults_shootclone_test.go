@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("ShootClone defaulting", func() {
+	var obj *ShootClone
+
+	BeforeEach(func() {
+		obj = &ShootClone{Spec: ShootCloneSpec{Name: "clone"}}
+	})
+
+	It("should copy everything by default", func() {
+		SetObjectDefaults_ShootClone(obj)
+
+		Expect(obj.Spec).To(Equal(ShootCloneSpec{
+			Name:           "clone",
+			CopyWorkers:    ptr.To(true),
+			CopyNetworking: ptr.To(true),
+			CopyAddons:     ptr.To(true),
+		}))
+	})
+
+	It("should not overwrite already set values", func() {
+		obj.Spec.CopyWorkers = ptr.To(false)
+		obj.Spec.CopyNetworking = ptr.To(false)
+		obj.Spec.CopyAddons = ptr.To(false)
+
+		SetObjectDefaults_ShootClone(obj)
+
+		Expect(obj.Spec).To(Equal(ShootCloneSpec{
+			Name:           "clone",
+			CopyWorkers:    ptr.To(false),
+			CopyNetworking: ptr.To(false),
+			CopyAddons:     ptr.To(false),
+		}))
+	})
+})
@@ -11,15 +11,18 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 )
 
-// ErrorWithCodes contains the error and Gardener error codes.
+// ErrorWithCodes contains the error, Gardener error codes and optional error codes reported by the infrastructure
+// provider.
 type ErrorWithCodes struct {
-	err   error
-	codes []gardencorev1beta1.ErrorCode
+	err           error
+	codes         []gardencorev1beta1.ErrorCode
+	providerCodes []string
 }
 
 // Retriable marks ErrorWithCodes as retriable.
@@ -27,7 +30,13 @@ func (e *ErrorWithCodes) Retriable() {}
 
 // NewErrorWithCodes creates a new error that additionally exposes the given codes via the Coder interface.
 func NewErrorWithCodes(err error, codes ...gardencorev1beta1.ErrorCode) error {
-	return &ErrorWithCodes{err, codes}
+	return &ErrorWithCodes{err: err, codes: codes}
+}
+
+// NewErrorWithProviderCodes creates a new error that additionally exposes the given codes via the Coder interface and
+// the given provider codes via the ProviderCoder interface.
+func NewErrorWithProviderCodes(err error, providerCodes []string, codes ...gardencorev1beta1.ErrorCode) error {
+	return &ErrorWithCodes{err: err, codes: codes, providerCodes: providerCodes}
 }
 
 // Codes returns all error codes.
@@ -35,6 +44,11 @@ func (e *ErrorWithCodes) Codes() []gardencorev1beta1.ErrorCode {
 	return e.codes
 }
 
+// ProviderCodes returns all error codes reported by the infrastructure provider.
+func (e *ErrorWithCodes) ProviderCodes() []string {
+	return e.providerCodes
+}
+
 // Unwrap rettieves the error from ErrorWithCodes.
 func (e *ErrorWithCodes) Unwrap() error {
 	return e.err
@@ -64,6 +78,25 @@ func ExtractErrorCodes(err error) []gardencorev1beta1.ErrorCode {
 	return codes
 }
 
+// ProviderCoder is an error that may produce error codes reported by the infrastructure provider.
+type ProviderCoder interface {
+	error
+	ProviderCodes() []string
+}
+
+// ExtractProviderErrorCodes extracts all provider error codes from the given error by using errorsutils.Errors.
+func ExtractProviderErrorCodes(err error) []string {
+	var providerCodes []string
+
+	for _, err := range errorsutils.Errors(err) {
+		var providerCoder ProviderCoder
+		if errors.As(err, &providerCoder) {
+			providerCodes = append(providerCodes, providerCoder.ProviderCodes()...)
+		}
+	}
+	return providerCodes
+}
+
 var _ error = (*MultiErrorWithCodes)(nil)
 
 // MultiErrorWithCodes is a struct that contains multiple errors and ErrorCodes.
@@ -141,10 +174,12 @@ func NewWrappedLastErrors(description string, err error) *WrappedLastErrors {
 	var lastErrors []gardencorev1beta1.LastError
 
 	for _, partError := range errorsutils.Errors(err) {
-		lastErrors = append(lastErrors, *LastErrorWithTaskID(
+		lastError := LastErrorWithTaskID(
 			partError.Error(),
 			errorsutils.GetID(partError),
-			ExtractErrorCodes(partError)...))
+			ExtractErrorCodes(partError)...)
+		lastError.ProviderCodes = ExtractProviderErrorCodes(partError)
+		lastErrors = append(lastErrors, *lastError)
 	}
 
 	return &WrappedLastErrors{
@@ -154,10 +189,13 @@ func NewWrappedLastErrors(description string, err error) *WrappedLastErrors {
 }
 
 // LastError creates a new LastError with the given description, optional codes and sets timestamp when the error is lastly observed.
+// The category and the remediation hint are derived from the given codes.
 func LastError(description string, codes ...gardencorev1beta1.ErrorCode) *gardencorev1beta1.LastError {
 	return &gardencorev1beta1.LastError{
-		Description: description,
-		Codes:       codes,
+		Description:     description,
+		Codes:           codes,
+		Category:        ErrorCategoryForCodes(codes...),
+		RemediationHint: RemediationHintForCodes(codes...),
 		LastUpdateTime: &metav1.Time{
 			Time: time.Now(),
 		},
@@ -165,17 +203,83 @@ func LastError(description string, codes ...gardencorev1beta1.ErrorCode) *garden
 }
 
 // LastErrorWithTaskID creates a new LastError with the given description, the ID of the task when the error occurred, optional codes and sets timestamp when the error is lastly observed.
+// The category and the remediation hint are derived from the given codes.
 func LastErrorWithTaskID(description string, taskID string, codes ...gardencorev1beta1.ErrorCode) *gardencorev1beta1.LastError {
 	return &gardencorev1beta1.LastError{
-		Description: description,
-		Codes:       codes,
-		TaskID:      &taskID,
+		Description:     description,
+		Codes:           codes,
+		Category:        ErrorCategoryForCodes(codes...),
+		RemediationHint: RemediationHintForCodes(codes...),
+		TaskID:          &taskID,
 		LastUpdateTime: &metav1.Time{
 			Time: time.Now(),
 		},
 	}
 }
 
+var errorCodeCategories = map[gardencorev1beta1.ErrorCode]gardencorev1beta1.ErrorCategory{
+	gardencorev1beta1.ErrorInfraUnauthenticated:          gardencorev1beta1.ErrorCategoryCredentials,
+	gardencorev1beta1.ErrorInfraUnauthorized:             gardencorev1beta1.ErrorCategoryCredentials,
+	gardencorev1beta1.ErrorInfraQuotaExceeded:            gardencorev1beta1.ErrorCategoryInfrastructure,
+	gardencorev1beta1.ErrorInfraRateLimitsExceeded:       gardencorev1beta1.ErrorCategoryInfrastructure,
+	gardencorev1beta1.ErrorInfraDependencies:             gardencorev1beta1.ErrorCategoryInfrastructure,
+	gardencorev1beta1.ErrorRetryableInfraDependencies:    gardencorev1beta1.ErrorCategoryInfrastructure,
+	gardencorev1beta1.ErrorInfraResourcesDepleted:        gardencorev1beta1.ErrorCategoryInfrastructure,
+	gardencorev1beta1.ErrorCleanupClusterResources:       gardencorev1beta1.ErrorCategoryCleanup,
+	gardencorev1beta1.ErrorConfigurationProblem:          gardencorev1beta1.ErrorCategoryConfiguration,
+	gardencorev1beta1.ErrorRetryableConfigurationProblem: gardencorev1beta1.ErrorCategoryConfiguration,
+	gardencorev1beta1.ErrorProblematicWebhook:            gardencorev1beta1.ErrorCategoryWebhook,
+}
+
+var errorCodeRemediationHints = map[gardencorev1beta1.ErrorCode]string{
+	gardencorev1beta1.ErrorInfraUnauthenticated:          "Check that the infrastructure credentials referenced by the shoot are valid and have not expired.",
+	gardencorev1beta1.ErrorInfraUnauthorized:             "Check that the infrastructure credentials referenced by the shoot have all permissions required by the provider extension.",
+	gardencorev1beta1.ErrorInfraQuotaExceeded:            "Request a quota increase from the infrastructure provider or reduce the requested resources.",
+	gardencorev1beta1.ErrorInfraRateLimitsExceeded:       "Reduce the number of requests against the infrastructure account, the operation is retried automatically.",
+	gardencorev1beta1.ErrorInfraDependencies:             "Remove infrastructure resources which were created outside of Gardener and depend on the shoot's infrastructure.",
+	gardencorev1beta1.ErrorRetryableInfraDependencies:    "Wait for dependent infrastructure resources to be removed, the operation is retried automatically.",
+	gardencorev1beta1.ErrorInfraResourcesDepleted:        "Choose a different machine type or zone, or wait until the infrastructure provider has free capacity again.",
+	gardencorev1beta1.ErrorCleanupClusterResources:       "Check the resources in the shoot cluster which are stuck in deletion, e.g., because of finalizers.",
+	gardencorev1beta1.ErrorConfigurationProblem:          "Correct the shoot specification according to the error description.",
+	gardencorev1beta1.ErrorRetryableConfigurationProblem: "Correct the shoot specification according to the error description, the operation is retried automatically.",
+	gardencorev1beta1.ErrorProblematicWebhook:            "Adapt the webhooks in the shoot cluster according to the Kubernetes best practices, see the shoot's constraints for details.",
+}
+
+// ErrorCategoryForCodes returns the category of the first of the given error codes which is known. It returns nil if
+// none of the codes is known.
+func ErrorCategoryForCodes(codes ...gardencorev1beta1.ErrorCode) *gardencorev1beta1.ErrorCategory {
+	for _, code := range codes {
+		if category, ok := errorCodeCategories[code]; ok {
+			return &category
+		}
+	}
+	return nil
+}
+
+// RemediationHintForCodes returns the remediation hints for the given error codes. It returns nil if there is no hint
+// for any of the codes.
+func RemediationHintForCodes(codes ...gardencorev1beta1.ErrorCode) *string {
+	var (
+		hints    []string
+		observed = sets.New[gardencorev1beta1.ErrorCode]()
+	)
+
+	for _, code := range codes {
+		hint, ok := errorCodeRemediationHints[code]
+		if !ok || observed.Has(code) {
+			continue
+		}
+
+		observed.Insert(code)
+		hints = append(hints, hint)
+	}
+
+	if len(hints) == 0 {
+		return nil
+	}
+	return ptr.To(strings.Join(hints, " "))
+}
+
 // HasNonRetryableErrorCode returns true if at least one of given list of last errors has at least one error code that
 // indicates that an automatic retry would not help fixing the problem.
 func HasNonRetryableErrorCode(lastErrors ...gardencorev1beta1.LastError) bool {
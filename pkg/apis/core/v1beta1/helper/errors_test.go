@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -42,6 +44,63 @@ var _ = Describe("errors", func() {
 			ConsistOf(Equal(gardencorev1beta1.ErrorInfraUnauthorized))),
 	)
 
+	DescribeTable("#ExtractProviderErrorCodes",
+		func(err error, matcher gomegatypes.GomegaMatcher) {
+			Expect(ExtractProviderErrorCodes(err)).To(matcher)
+		},
+
+		Entry("no error given", nil, BeEmpty()),
+		Entry("no code error given", errors.New("error"), BeEmpty()),
+		Entry("code error without provider codes given", NewErrorWithCodes(errors.New("error"), gardencorev1beta1.ErrorInfraUnauthorized), BeEmpty()),
+		Entry("provider code error given",
+			NewErrorWithProviderCodes(errors.New("error"), []string{"InsufficientInstanceCapacity"}, gardencorev1beta1.ErrorInfraResourcesDepleted),
+			ConsistOf("InsufficientInstanceCapacity")),
+		Entry("wrapped provider code error", fmt.Errorf("error %w", NewErrorWithProviderCodes(errors.New("error"), []string{"QuotaExceeded"})),
+			ConsistOf("QuotaExceeded")),
+	)
+
+	Describe("#NewWrappedLastErrors", func() {
+		It("should populate the category, provider codes and remediation hint", func() {
+			wrappedLastErrors := NewWrappedLastErrors("description", multierror.Append(nil,
+				NewErrorWithProviderCodes(errors.New("error 1"), []string{"QuotaExceeded"}, gardencorev1beta1.ErrorInfraQuotaExceeded),
+				errors.New("error 2"),
+			))
+
+			Expect(wrappedLastErrors.LastErrors).To(HaveLen(2))
+			Expect(wrappedLastErrors.LastErrors[0].Codes).To(ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded))
+			Expect(wrappedLastErrors.LastErrors[0].ProviderCodes).To(ConsistOf("QuotaExceeded"))
+			Expect(wrappedLastErrors.LastErrors[0].Category).To(PointTo(Equal(gardencorev1beta1.ErrorCategoryInfrastructure)))
+			Expect(wrappedLastErrors.LastErrors[0].RemediationHint).To(PointTo(ContainSubstring("quota increase")))
+			Expect(wrappedLastErrors.LastErrors[1].ProviderCodes).To(BeEmpty())
+			Expect(wrappedLastErrors.LastErrors[1].Category).To(BeNil())
+			Expect(wrappedLastErrors.LastErrors[1].RemediationHint).To(BeNil())
+		})
+	})
+
+	DescribeTable("#ErrorCategoryForCodes",
+		func(codes []gardencorev1beta1.ErrorCode, matcher gomegatypes.GomegaMatcher) {
+			Expect(ErrorCategoryForCodes(codes...)).To(matcher)
+		},
+
+		Entry("no codes given", nil, BeNil()),
+		Entry("unknown code given", []gardencorev1beta1.ErrorCode{"ERR_UNKNOWN"}, BeNil()),
+		Entry("credentials code given", []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}, PointTo(Equal(gardencorev1beta1.ErrorCategoryCredentials))),
+		Entry("configuration code given", []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorRetryableConfigurationProblem}, PointTo(Equal(gardencorev1beta1.ErrorCategoryConfiguration))),
+		Entry("multiple codes given", []gardencorev1beta1.ErrorCode{"ERR_UNKNOWN", gardencorev1beta1.ErrorProblematicWebhook, gardencorev1beta1.ErrorCleanupClusterResources}, PointTo(Equal(gardencorev1beta1.ErrorCategoryWebhook))),
+	)
+
+	DescribeTable("#RemediationHintForCodes",
+		func(codes []gardencorev1beta1.ErrorCode, matcher gomegatypes.GomegaMatcher) {
+			Expect(RemediationHintForCodes(codes...)).To(matcher)
+		},
+
+		Entry("no codes given", nil, BeNil()),
+		Entry("unknown code given", []gardencorev1beta1.ErrorCode{"ERR_UNKNOWN"}, BeNil()),
+		Entry("single code given", []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized}, PointTo(ContainSubstring("permissions"))),
+		Entry("multiple codes given", []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized, gardencorev1beta1.ErrorInfraQuotaExceeded, gardencorev1beta1.ErrorInfraUnauthorized},
+			PointTo(Equal("Check that the infrastructure credentials referenced by the shoot have all permissions required by the provider extension. Request a quota increase from the infrastructure provider or reduce the requested resources."))),
+	)
+
 	Describe("#MultiErrorWithCodes", func() {
 		var (
 			formatFn   func(errs []error) string
@@ -7,6 +7,7 @@ package helper
 import (
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -379,6 +381,16 @@ func ShootConfinesSpecUpdateRollout(maintenance *gardencorev1beta1.Maintenance)
 	return maintenance != nil && maintenance.ConfineSpecUpdateRollout != nil && *maintenance.ConfineSpecUpdateRollout
 }
 
+// IsShootReconciliationPaused returns true if the reconciliation of the given shoot is paused at the given time.
+func IsShootReconciliationPaused(shoot *gardencorev1beta1.Shoot, now time.Time) bool {
+	reconciliation := shoot.Spec.Reconciliation
+	if reconciliation == nil || !reconciliation.Paused {
+		return false
+	}
+
+	return reconciliation.PausedUntil == nil || now.Before(reconciliation.PausedUntil.Time)
+}
+
 // SeedSettingExcessCapacityReservationEnabled returns true if the 'excess capacity reservation' setting is enabled.
 func SeedSettingExcessCapacityReservationEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	return settings == nil || settings.ExcessCapacityReservation == nil || ptr.Deref(settings.ExcessCapacityReservation.Enabled, true)
@@ -394,7 +406,7 @@ func SeedSettingDependencyWatchdogWeederEnabled(settings *gardencorev1beta1.Seed
 	return settings == nil || settings.DependencyWatchdog == nil || settings.DependencyWatchdog.Weeder == nil || settings.DependencyWatchdog.Weeder.Enabled
 }
 
-// SeedSettingDependencyWatchdogProberEnabled returns true if the dependency-watchdog-prober is enabled.
+// SeedSettingDependencyWatchdogProberEnabled returns true if the node lease probe of the shoot care controller is enabled.
 func SeedSettingDependencyWatchdogProberEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	return settings == nil || settings.DependencyWatchdog == nil || settings.DependencyWatchdog.Prober == nil || settings.DependencyWatchdog.Prober.Enabled
 }
@@ -404,6 +416,23 @@ func SeedSettingTopologyAwareRoutingEnabled(settings *gardencorev1beta1.SeedSett
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
 
+// SeedSettingPrivateLinkEnabled returns true if the seed supports private link connectivity for shoots.
+func SeedSettingPrivateLinkEnabled(settings *gardencorev1beta1.SeedSettings) bool {
+	return settings != nil && settings.PrivateLink != nil && settings.PrivateLink.Enabled
+}
+
+// SeedIngressControllerProvidedByExtension returns true if the ingress controller of the seed is not the Gardener
+// managed nginx ingress controller but provided by an extension of the respective kind.
+func SeedIngressControllerProvidedByExtension(ingress *gardencorev1beta1.Ingress) bool {
+	return ingress != nil && ingress.Controller.Kind != v1beta1constants.IngressKindNginx
+}
+
+// IsSeedDecommissioning returns true if the given seed is being decommissioned, i.e., its hosted shoots are migrated
+// away and no new shoots may be scheduled onto it.
+func IsSeedDecommissioning(seed *gardencorev1beta1.Seed) bool {
+	return seed != nil && seed.Spec.Operation != nil && *seed.Spec.Operation == gardencorev1beta1.SeedOperationDecommission
+}
+
 // DetermineMachineImageForName finds the cloud specific machine images in the <cloudProfile> for the given <name> and
 // region. In case it does not find the machine image with the <name>, it returns false. Otherwise, true and the
 // cloud-specific machine image will be returned.
@@ -466,11 +495,153 @@ func FindMachineTypeByName(machines []gardencorev1beta1.MachineType, name string
 	return nil
 }
 
+// MachineTypeSatisfiesRequirements checks if the given machine type provides at least the required resources and all
+// required capabilities.
+func MachineTypeSatisfiesRequirements(machineType gardencorev1beta1.MachineType, requirements gardencorev1beta1.MachineTypeRequirements) bool {
+	if requirements.CPU != nil && machineType.CPU.Cmp(*requirements.CPU) < 0 ||
+		requirements.GPU != nil && machineType.GPU.Cmp(*requirements.GPU) < 0 ||
+		requirements.Memory != nil && machineType.Memory.Cmp(*requirements.Memory) < 0 {
+		return false
+	}
+
+	return MachineTypeHasCapabilities(machineType, requirements.Capabilities)
+}
+
+// MachineTypeHasCapabilities checks if the given machine type provides all required capabilities. Capabilities which
+// are not set or disabled in the required capabilities are not considered.
+func MachineTypeHasCapabilities(machineType gardencorev1beta1.MachineType, required *gardencorev1beta1.MachineTypeCapabilities) bool {
+	if required == nil {
+		return true
+	}
+
+	capabilities := ptr.Deref(machineType.Capabilities, gardencorev1beta1.MachineTypeCapabilities{})
+
+	if required.CPUFamily != nil && ptr.Deref(capabilities.CPUFamily, "") != *required.CPUFamily {
+		return false
+	}
+
+	for _, c := range []struct{ required, provided *bool }{
+		{required.NVMe, capabilities.NVMe},
+		{required.NestedVirtualization, capabilities.NestedVirtualization},
+		{required.ConfidentialCompute, capabilities.ConfidentialCompute},
+	} {
+		if ptr.Deref(c.required, false) && !ptr.Deref(c.provided, false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CompareMachineTypeSizes compares the sizes of the given machine types by their CPU, memory, and GPU (in this order).
+// It returns a negative number if a is smaller than b, a positive number if a is larger than b, and zero otherwise.
+func CompareMachineTypeSizes(a, b gardencorev1beta1.MachineType) int {
+	if c := a.CPU.Cmp(b.CPU); c != 0 {
+		return c
+	}
+	if c := a.Memory.Cmp(b.Memory); c != 0 {
+		return c
+	}
+	return a.GPU.Cmp(b.GPU)
+}
+
+type reservationTier struct {
+	// upTo is the upper bound of the tier.
+	upTo int64
+	// basisPoints is the share of the capacity within the tier which is reserved (in 1/100 percent).
+	basisPoints int64
+}
+
+var (
+	kubeReservedCPUTiers = []reservationTier{
+		{upTo: 1000, basisPoints: 600},
+		{upTo: 2000, basisPoints: 100},
+		{upTo: 4000, basisPoints: 50},
+		{upTo: math.MaxInt64, basisPoints: 25},
+	}
+	kubeReservedMemoryTiers = []reservationTier{
+		{upTo: 4 << 30, basisPoints: 2500},
+		{upTo: 8 << 30, basisPoints: 2000},
+		{upTo: 16 << 30, basisPoints: 1000},
+		{upTo: 128 << 30, basisPoints: 600},
+		{upTo: math.MaxInt64, basisPoints: 200},
+	}
+	kubeReservedMemoryMinimum int64 = 255 << 20
+)
+
+// KubeReservedForMachineType returns the resources reserved for kubernetes node components on machines of the given
+// type when the `Auto` kubelet reservation policy is used. Values from the `kubeReserved` table of the machine type take
+// precedence, missing CPU and memory values are calculated based on the machine's capacity.
+func KubeReservedForMachineType(machineType gardencorev1beta1.MachineType) gardencorev1beta1.KubeletConfigReserved {
+	var reserved gardencorev1beta1.KubeletConfigReserved
+	if machineType.KubeReserved != nil {
+		reserved = *machineType.KubeReserved.DeepCopy()
+	}
+
+	if reserved.CPU == nil {
+		reserved.CPU = resource.NewMilliQuantity(reserveByTiers(machineType.CPU.MilliValue(), kubeReservedCPUTiers), resource.DecimalSI)
+	}
+
+	if reserved.Memory == nil {
+		memory := reserveByTiers(machineType.Memory.Value(), kubeReservedMemoryTiers)
+		if machineType.Memory.Value() < 1<<30 {
+			memory = kubeReservedMemoryMinimum
+		}
+		// round down to full mebibytes
+		reserved.Memory = resource.NewQuantity(memory>>20<<20, resource.BinarySI)
+	}
+
+	return reserved
+}
+
+func reserveByTiers(capacity int64, tiers []reservationTier) int64 {
+	var reserved, lower int64
+
+	for _, tier := range tiers {
+		if capacity <= lower {
+			break
+		}
+		reserved += (min(capacity, tier.upTo) - lower) * tier.basisPoints / 10000
+		lower = tier.upTo
+	}
+
+	return reserved
+}
+
 // SystemComponentsAllowed checks if the given worker allows system components to be scheduled onto it
 func SystemComponentsAllowed(worker *gardencorev1beta1.Worker) bool {
 	return worker.SystemComponents == nil || worker.SystemComponents.Allow
 }
 
+// WorkerArchitecture returns the CPU architecture of the machines of the given worker. Workers without architecture
+// run amd64 machines.
+func WorkerArchitecture(worker gardencorev1beta1.Worker) string {
+	return ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
+}
+
+// WorkerArchitectures returns the sorted list of distinct CPU architectures of the machines of the given workers.
+func WorkerArchitectures(workers []gardencorev1beta1.Worker) []string {
+	architectures := sets.New[string]()
+	for _, worker := range workers {
+		architectures.Insert(WorkerArchitecture(worker))
+	}
+	return sets.List(architectures)
+}
+
+// NodeNetworkCIDRs returns the CIDRs of the node network of the given networking configuration, i.e., the primary node
+// network followed by the additional node networks.
+func NodeNetworkCIDRs(networking *gardencorev1beta1.Networking) []string {
+	if networking == nil {
+		return nil
+	}
+
+	var cidrs []string
+	if networking.Nodes != nil {
+		cidrs = append(cidrs, *networking.Nodes)
+	}
+	return append(cidrs, networking.AdditionalNodes...)
+}
+
 // KubernetesVersionExistsInCloudProfile checks if the given Kubernetes version exists in the CloudProfile
 func KubernetesVersionExistsInCloudProfile(cloudProfile *gardencorev1beta1.CloudProfile, currentVersion string) (bool, gardencorev1beta1.ExpirableVersion, error) {
 	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
@@ -1465,6 +1636,57 @@ func ShootEnablesSSHAccess(shoot *gardencorev1beta1.Shoot) bool {
 		(shoot.Spec.Provider.WorkersSettings == nil || shoot.Spec.Provider.WorkersSettings.SSHAccess == nil || shoot.Spec.Provider.WorkersSettings.SSHAccess.Enabled)
 }
 
+// ShootPathMTU returns the discovered path MTU between the seed and the nodes of the given shoot, or nil if it was
+// not discovered yet.
+func ShootPathMTU(shoot *gardencorev1beta1.Shoot) *int32 {
+	if shoot.Status.Networking == nil {
+		return nil
+	}
+	return shoot.Status.Networking.PathMTU
+}
+
+// ShootEnablesEgressOnlyInternetAccess returns true if the worker nodes of the given shoot shall be provisioned without
+// public IPs.
+func ShootEnablesEgressOnlyInternetAccess(shoot *gardencorev1beta1.Shoot) bool {
+	return !IsWorkerless(shoot) &&
+		shoot.Spec.Provider.WorkersSettings != nil &&
+		shoot.Spec.Provider.WorkersSettings.EgressOnlyInternetAccess != nil &&
+		shoot.Spec.Provider.WorkersSettings.EgressOnlyInternetAccess.Enabled
+}
+
+// ShootEnablesPrivateLink returns true if the worker nodes of the given shoot shall reach the control plane via a
+// private link endpoint.
+func ShootEnablesPrivateLink(shoot *gardencorev1beta1.Shoot) bool {
+	return !IsWorkerless(shoot) &&
+		shoot.Spec.Networking != nil &&
+		shoot.Spec.Networking.PrivateLink != nil &&
+		shoot.Spec.Networking.PrivateLink.Enabled
+}
+
+// GetShootObservabilityLogging returns the logging settings of the given shoot, or nil if they are not configured.
+func GetShootObservabilityLogging(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.ObservabilityLogging {
+	if shoot.Spec.Observability == nil {
+		return nil
+	}
+	return shoot.Spec.Observability.Logging
+}
+
+// GetShootEventArchive returns the event archive settings of the given shoot, or nil if archiving is not configured.
+func GetShootEventArchive(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.EventArchive {
+	if shoot.Spec.Observability == nil {
+		return nil
+	}
+	return shoot.Spec.Observability.EventArchive
+}
+
+// GetShootObservabilityProbes returns the observability probes of the given shoot.
+func GetShootObservabilityProbes(shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.ObservabilityProbe {
+	if shoot.Spec.Observability == nil {
+		return nil
+	}
+	return shoot.Spec.Observability.Probes
+}
+
 // GetFailureToleranceType determines the failure tolerance type of the given shoot.
 func GetFailureToleranceType(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.FailureToleranceType {
 	if shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil {
@@ -1539,3 +1761,92 @@ func ConvertShootList(list []gardencorev1beta1.Shoot) []*gardencorev1beta1.Shoot
 func HasManagedIssuer(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
 }
+
+// GetShootResourceTags returns the resource tags of the given Shoot with all placeholders in their values being replaced
+// by the name of the given project and the name of the Shoot.
+func GetShootResourceTags(shoot *gardencorev1beta1.Shoot, projectName string) map[string]string {
+	if len(shoot.Spec.Provider.ResourceTags) == 0 {
+		return nil
+	}
+
+	replacer := strings.NewReplacer(
+		v1beta1constants.ResourceTagPlaceholderProjectName, projectName,
+		v1beta1constants.ResourceTagPlaceholderShootName, shoot.Name,
+	)
+
+	tags := make(map[string]string, len(shoot.Spec.Provider.ResourceTags))
+	for key, value := range shoot.Spec.Provider.ResourceTags {
+		tags[key] = replacer.Replace(value)
+	}
+	return tags
+}
+
+// EffectiveClusterAutoscalerConfig returns the cluster-autoscaler configuration which results from the given
+// configuration and the selected profile in the given list of profiles. Explicitly configured parameters take precedence
+// over the ones of the profile, parameters which are still unset are defaulted. The returned configuration does not
+// refer to a profile anymore.
+func EffectiveClusterAutoscalerConfig(clusterAutoscaler *gardencorev1beta1.ClusterAutoscaler, profiles []gardencorev1beta1.ClusterAutoscalerProfileSettings) *gardencorev1beta1.ClusterAutoscaler {
+	if clusterAutoscaler == nil {
+		return nil
+	}
+
+	out := clusterAutoscaler.DeepCopy()
+
+	if out.Profile != nil {
+		for _, profile := range profiles {
+			if profile.Name != *out.Profile {
+				continue
+			}
+
+			parameters := profile.Parameters.DeepCopy()
+			if out.ScaleDownDelayAfterAdd == nil {
+				out.ScaleDownDelayAfterAdd = parameters.ScaleDownDelayAfterAdd
+			}
+			if out.ScaleDownDelayAfterDelete == nil {
+				out.ScaleDownDelayAfterDelete = parameters.ScaleDownDelayAfterDelete
+			}
+			if out.ScaleDownDelayAfterFailure == nil {
+				out.ScaleDownDelayAfterFailure = parameters.ScaleDownDelayAfterFailure
+			}
+			if out.ScaleDownUnneededTime == nil {
+				out.ScaleDownUnneededTime = parameters.ScaleDownUnneededTime
+			}
+			if out.ScaleDownUtilizationThreshold == nil {
+				out.ScaleDownUtilizationThreshold = parameters.ScaleDownUtilizationThreshold
+			}
+			if out.ScanInterval == nil {
+				out.ScanInterval = parameters.ScanInterval
+			}
+			if out.Expander == nil {
+				out.Expander = parameters.Expander
+			}
+			if out.MaxNodeProvisionTime == nil {
+				out.MaxNodeProvisionTime = parameters.MaxNodeProvisionTime
+			}
+			if out.MaxGracefulTerminationSeconds == nil {
+				out.MaxGracefulTerminationSeconds = parameters.MaxGracefulTerminationSeconds
+			}
+			if out.IgnoreTaints == nil {
+				out.IgnoreTaints = parameters.IgnoreTaints
+			}
+			if out.NewPodScaleUpDelay == nil {
+				out.NewPodScaleUpDelay = parameters.NewPodScaleUpDelay
+			}
+			if out.MaxEmptyBulkDelete == nil {
+				out.MaxEmptyBulkDelete = parameters.MaxEmptyBulkDelete
+			}
+			if out.IgnoreDaemonsetsUtilization == nil {
+				out.IgnoreDaemonsetsUtilization = parameters.IgnoreDaemonsetsUtilization
+			}
+			if out.Verbosity == nil {
+				out.Verbosity = parameters.Verbosity
+			}
+			break
+		}
+	}
+
+	out.Profile = nil
+	gardencorev1beta1.SetDefaults_ClusterAutoscaler(out)
+
+	return out
+}
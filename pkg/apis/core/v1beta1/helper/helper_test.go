@@ -15,6 +15,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
@@ -235,6 +236,110 @@ var _ = Describe("Helper", func() {
 		Entry("worker found", []gardencorev1beta1.MachineType{{Name: "foo"}}, "foo", &gardencorev1beta1.MachineType{Name: "foo"}),
 	)
 
+	DescribeTable("#MachineTypeSatisfiesRequirements",
+		func(requirements gardencorev1beta1.MachineTypeRequirements, expected bool) {
+			machineType := gardencorev1beta1.MachineType{
+				CPU:    resource.MustParse("4"),
+				GPU:    resource.MustParse("1"),
+				Memory: resource.MustParse("16Gi"),
+				Capabilities: &gardencorev1beta1.MachineTypeCapabilities{
+					CPUFamily: ptr.To("amd-genoa"),
+					NVMe:      ptr.To(true),
+				},
+			}
+
+			Expect(MachineTypeSatisfiesRequirements(machineType, requirements)).To(Equal(expected))
+		},
+
+		Entry("no requirements", gardencorev1beta1.MachineTypeRequirements{}, true),
+		Entry("satisfied resources", gardencorev1beta1.MachineTypeRequirements{CPU: ptr.To(resource.MustParse("4")), GPU: ptr.To(resource.MustParse("1")), Memory: ptr.To(resource.MustParse("8Gi"))}, true),
+		Entry("too few CPUs", gardencorev1beta1.MachineTypeRequirements{CPU: ptr.To(resource.MustParse("8"))}, false),
+		Entry("too few GPUs", gardencorev1beta1.MachineTypeRequirements{GPU: ptr.To(resource.MustParse("2"))}, false),
+		Entry("too little memory", gardencorev1beta1.MachineTypeRequirements{Memory: ptr.To(resource.MustParse("32Gi"))}, false),
+		Entry("satisfied capabilities", gardencorev1beta1.MachineTypeRequirements{Capabilities: &gardencorev1beta1.MachineTypeCapabilities{CPUFamily: ptr.To("amd-genoa"), NVMe: ptr.To(true), ConfidentialCompute: ptr.To(false)}}, true),
+		Entry("different CPU family", gardencorev1beta1.MachineTypeRequirements{Capabilities: &gardencorev1beta1.MachineTypeCapabilities{CPUFamily: ptr.To("intel-sapphire-rapids")}}, false),
+		Entry("missing capability", gardencorev1beta1.MachineTypeRequirements{Capabilities: &gardencorev1beta1.MachineTypeCapabilities{NestedVirtualization: ptr.To(true)}}, false),
+	)
+
+	DescribeTable("#CompareMachineTypeSizes",
+		func(a, b gardencorev1beta1.MachineType, expected int) {
+			Expect(CompareMachineTypeSizes(a, b)).To(Equal(expected))
+		},
+
+		Entry("equal", gardencorev1beta1.MachineType{CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi")}, gardencorev1beta1.MachineType{CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi")}, 0),
+		Entry("fewer CPUs", gardencorev1beta1.MachineType{CPU: resource.MustParse("2"), Memory: resource.MustParse("16Gi")}, gardencorev1beta1.MachineType{CPU: resource.MustParse("4"), Memory: resource.MustParse("8Gi")}, -1),
+		Entry("more memory", gardencorev1beta1.MachineType{CPU: resource.MustParse("2"), Memory: resource.MustParse("16Gi")}, gardencorev1beta1.MachineType{CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi")}, 1),
+		Entry("fewer GPUs", gardencorev1beta1.MachineType{CPU: resource.MustParse("2")}, gardencorev1beta1.MachineType{CPU: resource.MustParse("2"), GPU: resource.MustParse("1")}, -1),
+	)
+
+	DescribeTable("#KubeReservedForMachineType",
+		func(machineType gardencorev1beta1.MachineType, expectedCPU, expectedMemory string) {
+			reserved := KubeReservedForMachineType(machineType)
+			Expect(reserved.CPU.Cmp(resource.MustParse(expectedCPU))).To(BeZero(), "cpu: %s", reserved.CPU)
+			Expect(reserved.Memory.Cmp(resource.MustParse(expectedMemory))).To(BeZero(), "memory: %s", reserved.Memory)
+		},
+
+		Entry("small machine", gardencorev1beta1.MachineType{CPU: resource.MustParse("1"), Memory: resource.MustParse("512Mi")}, "60m", "255Mi"),
+		Entry("medium machine", gardencorev1beta1.MachineType{CPU: resource.MustParse("4"), Memory: resource.MustParse("8Gi")}, "80m", "1843Mi"),
+		Entry("large machine", gardencorev1beta1.MachineType{CPU: resource.MustParse("64"), Memory: resource.MustParse("256Gi")}, "230m", "12165Mi"),
+		Entry("values from the machine type table", gardencorev1beta1.MachineType{
+			CPU:          resource.MustParse("4"),
+			Memory:       resource.MustParse("8Gi"),
+			KubeReserved: &gardencorev1beta1.KubeletConfigReserved{Memory: ptr.To(resource.MustParse("1Gi"))},
+		}, "80m", "1Gi"),
+	)
+
+	Describe("#EffectiveClusterAutoscalerConfig", func() {
+		var profiles []gardencorev1beta1.ClusterAutoscalerProfileSettings
+
+		BeforeEach(func() {
+			profiles = []gardencorev1beta1.ClusterAutoscalerProfileSettings{{
+				Name: gardencorev1beta1.ClusterAutoscalerProfileAggressive,
+				Parameters: gardencorev1beta1.ClusterAutoscaler{
+					ScaleDownUnneededTime:  &metav1.Duration{Duration: 5 * time.Minute},
+					ScaleDownDelayAfterAdd: &metav1.Duration{Duration: 10 * time.Minute},
+					IgnoreTaints:           []string{"foo"},
+				},
+			}}
+		})
+
+		It("should return nil if no configuration is given", func() {
+			Expect(EffectiveClusterAutoscalerConfig(nil, profiles)).To(BeNil())
+		})
+
+		It("should default the configuration if no profile is selected", func() {
+			config := EffectiveClusterAutoscalerConfig(&gardencorev1beta1.ClusterAutoscaler{Verbosity: ptr.To[int32](4)}, profiles)
+
+			Expect(config.Verbosity).To(PointTo(Equal(int32(4))))
+			Expect(config.ScaleDownUnneededTime).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+			Expect(config.IgnoreTaints).To(BeEmpty())
+		})
+
+		It("should use the parameters of the selected profile for unset fields", func() {
+			clusterAutoscaler := &gardencorev1beta1.ClusterAutoscaler{
+				Profile:                ptr.To(gardencorev1beta1.ClusterAutoscalerProfileAggressive),
+				ScaleDownDelayAfterAdd: &metav1.Duration{Duration: time.Minute},
+			}
+
+			config := EffectiveClusterAutoscalerConfig(clusterAutoscaler, profiles)
+
+			Expect(config.Profile).To(BeNil())
+			Expect(config.ScaleDownDelayAfterAdd).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(config.ScaleDownUnneededTime).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(config.IgnoreTaints).To(ConsistOf("foo"))
+			Expect(config.ScanInterval).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+			Expect(clusterAutoscaler.ScanInterval).To(BeNil())
+		})
+
+		It("should fall back to the defaults if the selected profile does not exist", func() {
+			config := EffectiveClusterAutoscalerConfig(&gardencorev1beta1.ClusterAutoscaler{Profile: ptr.To(gardencorev1beta1.ClusterAutoscalerProfileConservative)}, profiles)
+
+			Expect(config.Profile).To(BeNil())
+			Expect(config.ScaleDownUnneededTime).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+			Expect(config.ScaleDownDelayAfterAdd).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+	})
+
 	DescribeTable("#TaintsHave",
 		func(taints []gardencorev1beta1.SeedTaint, key string, expectation bool) {
 			Expect(TaintsHave(taints, key)).To(Equal(expectation))
@@ -495,6 +600,44 @@ var _ = Describe("Helper", func() {
 		Entry("systemComponents.allowed = true", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true}}, true),
 	)
 
+	DescribeTable("#WorkerArchitecture",
+		func(worker gardencorev1beta1.Worker, architecture string) {
+			Expect(WorkerArchitecture(worker)).To(Equal(architecture))
+		},
+		Entry("no architecture", gardencorev1beta1.Worker{}, "amd64"),
+		Entry("amd64", gardencorev1beta1.Worker{Machine: gardencorev1beta1.Machine{Architecture: ptr.To("amd64")}}, "amd64"),
+		Entry("arm64", gardencorev1beta1.Worker{Machine: gardencorev1beta1.Machine{Architecture: ptr.To("arm64")}}, "arm64"),
+	)
+
+	DescribeTable("#WorkerArchitectures",
+		func(workers []gardencorev1beta1.Worker, architectures []string) {
+			Expect(WorkerArchitectures(workers)).To(Equal(architectures))
+		},
+		Entry("no workers", nil, []string{}),
+		Entry("single architecture", []gardencorev1beta1.Worker{
+			{Machine: gardencorev1beta1.Machine{Architecture: ptr.To("amd64")}},
+			{},
+		}, []string{"amd64"}),
+		Entry("multiple architectures", []gardencorev1beta1.Worker{
+			{Machine: gardencorev1beta1.Machine{Architecture: ptr.To("arm64")}},
+			{Machine: gardencorev1beta1.Machine{Architecture: ptr.To("amd64")}},
+			{Machine: gardencorev1beta1.Machine{Architecture: ptr.To("arm64")}},
+		}, []string{"amd64", "arm64"}),
+	)
+
+	DescribeTable("#NodeNetworkCIDRs",
+		func(networking *gardencorev1beta1.Networking, cidrs []string) {
+			Expect(NodeNetworkCIDRs(networking)).To(Equal(cidrs))
+		},
+		Entry("no networking", nil, nil),
+		Entry("no node network", &gardencorev1beta1.Networking{}, nil),
+		Entry("only primary node network", &gardencorev1beta1.Networking{Nodes: ptr.To("10.250.0.0/16")}, []string{"10.250.0.0/16"}),
+		Entry("primary and additional node networks", &gardencorev1beta1.Networking{
+			Nodes:           ptr.To("10.250.0.0/16"),
+			AdditionalNodes: []string{"10.251.0.0/16", "10.252.0.0/16"},
+		}, []string{"10.250.0.0/16", "10.251.0.0/16", "10.252.0.0/16"}),
+	)
+
 	DescribeTable("#HibernationIsEnabled",
 		func(shoot *gardencorev1beta1.Shoot, hibernated bool) {
 			Expect(HibernationIsEnabled(shoot)).To(Equal(hibernated))
@@ -639,6 +782,20 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	DescribeTable("#IsShootReconciliationPaused",
+		func(reconciliation *gardencorev1beta1.ShootReconciliation, expected bool) {
+			now := time.Date(2024, 5, 28, 10, 0, 0, 0, time.UTC)
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Reconciliation: reconciliation}}
+			Expect(IsShootReconciliationPaused(shoot, now)).To(Equal(expected))
+		},
+
+		Entry("reconciliation is nil", nil, false),
+		Entry("reconciliation is not paused", &gardencorev1beta1.ShootReconciliation{}, false),
+		Entry("reconciliation is paused", &gardencorev1beta1.ShootReconciliation{Paused: true}, true),
+		Entry("reconciliation is paused until the future", &gardencorev1beta1.ShootReconciliation{Paused: true, PausedUntil: &metav1.Time{Time: time.Date(2024, 5, 28, 11, 0, 0, 0, time.UTC)}}, true),
+		Entry("reconciliation was paused until the past", &gardencorev1beta1.ShootReconciliation{Paused: true, PausedUntil: &metav1.Time{Time: time.Date(2024, 5, 28, 9, 0, 0, 0, time.UTC)}}, false),
+	)
+
 	DescribeTable("#SeedSettingExcessCapacityReservationEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expectation bool) {
 			Expect(SeedSettingExcessCapacityReservationEnabled(settings)).To(Equal(expectation))
@@ -686,6 +843,38 @@ var _ = Describe("Helper", func() {
 		Entry("topology-aware routing disabled", &gardencorev1beta1.SeedSettings{TopologyAwareRouting: &gardencorev1beta1.SeedSettingTopologyAwareRouting{Enabled: false}}, false),
 	)
 
+	DescribeTable("#SeedSettingPrivateLinkEnabled",
+		func(settings *gardencorev1beta1.SeedSettings, expected bool) {
+			Expect(SeedSettingPrivateLinkEnabled(settings)).To(Equal(expected))
+		},
+
+		Entry("no settings", nil, false),
+		Entry("no private link setting", &gardencorev1beta1.SeedSettings{}, false),
+		Entry("private link enabled", &gardencorev1beta1.SeedSettings{PrivateLink: &gardencorev1beta1.SeedSettingPrivateLink{Enabled: true}}, true),
+		Entry("private link disabled", &gardencorev1beta1.SeedSettings{PrivateLink: &gardencorev1beta1.SeedSettingPrivateLink{Enabled: false}}, false),
+	)
+
+	DescribeTable("#SeedIngressControllerProvidedByExtension",
+		func(ingress *gardencorev1beta1.Ingress, expected bool) {
+			Expect(SeedIngressControllerProvidedByExtension(ingress)).To(Equal(expected))
+		},
+
+		Entry("no ingress", nil, false),
+		Entry("nginx ingress controller", &gardencorev1beta1.Ingress{Controller: gardencorev1beta1.IngressController{Kind: "nginx"}}, false),
+		Entry("extension ingress controller", &gardencorev1beta1.Ingress{Controller: gardencorev1beta1.IngressController{Kind: "contour"}}, true),
+	)
+
+	DescribeTable("#IsSeedDecommissioning",
+		func(seed *gardencorev1beta1.Seed, expected bool) {
+			Expect(IsSeedDecommissioning(seed)).To(Equal(expected))
+		},
+
+		Entry("no seed", nil, false),
+		Entry("no operation", &gardencorev1beta1.Seed{}, false),
+		Entry("unknown operation", &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Operation: ptr.To[gardencorev1beta1.SeedOperation]("Foo")}}, false),
+		Entry("decommission operation", &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Operation: ptr.To(gardencorev1beta1.SeedOperationDecommission)}}, true),
+	)
+
 	Describe("#FindMachineImageVersion", func() {
 		var machineImages []gardencorev1beta1.MachineImage
 
@@ -3082,6 +3271,88 @@ var _ = Describe("Helper", func() {
 		Entry("should return false when shoot worker settings has SSHAccess set to false", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}}, false),
 	)
 
+	DescribeTable("#ShootPathMTU",
+		func(networking *gardencorev1beta1.NetworkingStatus, expected *int32) {
+			shoot := &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Networking: networking}}
+			Expect(ShootPathMTU(shoot)).To(Equal(expected))
+		},
+
+		Entry("no networking status", nil, nil),
+		Entry("no path MTU", &gardencorev1beta1.NetworkingStatus{}, nil),
+		Entry("path MTU", &gardencorev1beta1.NetworkingStatus{PathMTU: ptr.To[int32](1400)}, ptr.To[int32](1400)),
+	)
+
+	DescribeTable("#ShootEnablesEgressOnlyInternetAccess",
+		func(workers []gardencorev1beta1.Worker, workersSettings *gardencorev1beta1.WorkersSettings, expectedResult bool) {
+			shoot := &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers:         workers,
+						WorkersSettings: workersSettings,
+					},
+				},
+			}
+			Expect(ShootEnablesEgressOnlyInternetAccess(shoot)).To(Equal(expectedResult))
+		},
+
+		Entry("should return false when shoot provider has zero workers", nil, &gardencorev1beta1.WorkersSettings{EgressOnlyInternetAccess: &gardencorev1beta1.EgressOnlyInternetAccess{Enabled: true}}, false),
+		Entry("should return false when shoot provider has no WorkersSettings", []gardencorev1beta1.Worker{{}}, nil, false),
+		Entry("should return false when shoot worker settings has no EgressOnlyInternetAccess", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.WorkersSettings{}, false),
+		Entry("should return false when shoot worker settings has EgressOnlyInternetAccess set to false", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.WorkersSettings{EgressOnlyInternetAccess: &gardencorev1beta1.EgressOnlyInternetAccess{Enabled: false}}, false),
+		Entry("should return true when shoot worker settings has EgressOnlyInternetAccess set to true", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.WorkersSettings{EgressOnlyInternetAccess: &gardencorev1beta1.EgressOnlyInternetAccess{Enabled: true}}, true),
+	)
+
+	DescribeTable("#ShootEnablesPrivateLink",
+		func(workers []gardencorev1beta1.Worker, networking *gardencorev1beta1.Networking, expectedResult bool) {
+			shoot := &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider:   gardencorev1beta1.Provider{Workers: workers},
+					Networking: networking,
+				},
+			}
+			Expect(ShootEnablesPrivateLink(shoot)).To(Equal(expectedResult))
+		},
+
+		Entry("should return false when shoot provider has zero workers", nil, &gardencorev1beta1.Networking{PrivateLink: &gardencorev1beta1.PrivateLink{Enabled: true}}, false),
+		Entry("should return false when shoot has no networking", []gardencorev1beta1.Worker{{}}, nil, false),
+		Entry("should return false when shoot networking has no PrivateLink", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.Networking{}, false),
+		Entry("should return false when shoot networking has PrivateLink set to false", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.Networking{PrivateLink: &gardencorev1beta1.PrivateLink{Enabled: false}}, false),
+		Entry("should return true when shoot networking has PrivateLink set to true", []gardencorev1beta1.Worker{{}}, &gardencorev1beta1.Networking{PrivateLink: &gardencorev1beta1.PrivateLink{Enabled: true}}, true),
+	)
+
+	DescribeTable("#GetShootObservabilityLogging",
+		func(observability *gardencorev1beta1.Observability, expected *gardencorev1beta1.ObservabilityLogging) {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Observability: observability}}
+			Expect(GetShootObservabilityLogging(shoot)).To(Equal(expected))
+		},
+
+		Entry("no observability settings", nil, nil),
+		Entry("no logging settings", &gardencorev1beta1.Observability{}, nil),
+		Entry("logging settings", &gardencorev1beta1.Observability{Logging: &gardencorev1beta1.ObservabilityLogging{RetentionDays: ptr.To[int32](7)}}, &gardencorev1beta1.ObservabilityLogging{RetentionDays: ptr.To[int32](7)}),
+	)
+
+	DescribeTable("#GetShootEventArchive",
+		func(observability *gardencorev1beta1.Observability, expected *gardencorev1beta1.EventArchive) {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Observability: observability}}
+			Expect(GetShootEventArchive(shoot)).To(Equal(expected))
+		},
+
+		Entry("no observability settings", nil, nil),
+		Entry("no event archive settings", &gardencorev1beta1.Observability{}, nil),
+		Entry("event archive settings", &gardencorev1beta1.Observability{EventArchive: &gardencorev1beta1.EventArchive{RetentionDays: ptr.To[int32](90)}}, &gardencorev1beta1.EventArchive{RetentionDays: ptr.To[int32](90)}),
+	)
+
+	DescribeTable("#GetShootObservabilityProbes",
+		func(observability *gardencorev1beta1.Observability, expected []gardencorev1beta1.ObservabilityProbe) {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Observability: observability}}
+			Expect(GetShootObservabilityProbes(shoot)).To(Equal(expected))
+		},
+
+		Entry("no observability settings", nil, nil),
+		Entry("no probes", &gardencorev1beta1.Observability{}, nil),
+		Entry("probes", &gardencorev1beta1.Observability{Probes: []gardencorev1beta1.ObservabilityProbe{{Name: "foo", Protocol: "TCP", Target: "foo:80"}}}, []gardencorev1beta1.ObservabilityProbe{{Name: "foo", Protocol: "TCP", Target: "foo:80"}}),
+	)
+
 	Describe("#GetFailureToleranceType", func() {
 		var shoot *gardencorev1beta1.Shoot
 
@@ -3422,4 +3693,31 @@ var _ = Describe("Helper", func() {
 			Expect(converted[1].Name).To(Equal("shoot2"))
 		})
 	})
+
+	Describe("#GetShootResourceTags", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot"}}
+		})
+
+		It("should return nil if no resource tags are configured", func() {
+			Expect(GetShootResourceTags(shoot, "dev")).To(BeNil())
+		})
+
+		It("should replace the placeholders in the values", func() {
+			shoot.Spec.Provider.ResourceTags = map[string]string{
+				"cost-center": "1234",
+				"owner":       "$(PROJECT_NAME)",
+				"cluster":     "$(PROJECT_NAME)/$(SHOOT_NAME)",
+			}
+
+			Expect(GetShootResourceTags(shoot, "dev")).To(Equal(map[string]string{
+				"cost-center": "1234",
+				"owner":       "dev",
+				"cluster":     "dev/my-shoot",
+			}))
+			Expect(shoot.Spec.Provider.ResourceTags).To(HaveKeyWithValue("owner", "$(PROJECT_NAME)"))
+		})
+	})
 })
@@ -51,6 +51,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ControllerInstallationList{},
 		&ExposureClass{},
 		&ExposureClassList{},
+		&ImageVectorOverride{},
+		&ImageVectorOverrideList{},
 		&InternalSecret{},
 		&InternalSecretList{},
 		&NamespacedCloudProfile{},
@@ -65,8 +67,15 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SeedList{},
 		&Shoot{},
 		&ShootList{},
+		&ShootClone{},
+		&ShootExport{},
+		&ShootCostEstimate{},
+		&ShootRevision{},
+		&ShootRevisionList{},
 		&ShootState{},
 		&ShootStateList{},
+		&VersionPolicy{},
+		&VersionPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
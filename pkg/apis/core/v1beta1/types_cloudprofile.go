@@ -74,6 +74,22 @@ type CloudProfileSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	VolumeTypes []VolumeType `json:"volumeTypes,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,9,rep,name=volumeTypes"`
+	// ClusterAutoscalerProfiles contains curated sets of cluster-autoscaler parameters which can be selected in the
+	// Shoot specification.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	ClusterAutoscalerProfiles []ClusterAutoscalerProfileSettings `json:"clusterAutoscalerProfiles,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,10,rep,name=clusterAutoscalerProfiles"`
+}
+
+// ClusterAutoscalerProfileSettings contains the cluster-autoscaler parameters of a profile.
+type ClusterAutoscalerProfileSettings struct {
+	// Name is the name of the profile.
+	// Possible values are `conservative`, `balanced` and `aggressive`.
+	Name ClusterAutoscalerProfile `json:"name" protobuf:"bytes,1,opt,name=name,casttype=ClusterAutoscalerProfile"`
+	// Parameters contains the cluster-autoscaler parameters of the profile. Parameters which are not specified default
+	// to the values Gardener uses if no profile is selected.
+	Parameters ClusterAutoscaler `json:"parameters" protobuf:"bytes,2,opt,name=parameters"`
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -160,6 +176,34 @@ type MachineType struct {
 	// Architecture is the CPU architecture of this machine type.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,7,opt,name=architecture"`
+	// HourlyPrice is the price of one machine of this machine type per hour as decimal number (e.g., "0.096"). It is
+	// used for estimating the cost of Shoots. All prices in a CloudProfile must be given in the same currency.
+	// +optional
+	HourlyPrice *string `json:"hourlyPrice,omitempty" protobuf:"bytes,8,opt,name=hourlyPrice"`
+	// KubeReserved are the resources reserved for kubernetes node components on machines of this type. They are used
+	// for worker pools with the `Auto` kubelet reservation policy.
+	// +optional
+	KubeReserved *KubeletConfigReserved `json:"kubeReserved,omitempty" protobuf:"bytes,9,opt,name=kubeReserved"`
+	// Capabilities are the capabilities of this machine type. Worker pools can request machine types by their
+	// capabilities instead of their name.
+	// +optional
+	Capabilities *MachineTypeCapabilities `json:"capabilities,omitempty" protobuf:"bytes,10,opt,name=capabilities"`
+}
+
+// MachineTypeCapabilities contains the capabilities of a machine type.
+type MachineTypeCapabilities struct {
+	// CPUFamily is the CPU family of the machine type (e.g., "intel-sapphire-rapids" or "amd-genoa").
+	// +optional
+	CPUFamily *string `json:"cpuFamily,omitempty" protobuf:"bytes,1,opt,name=cpuFamily"`
+	// NVMe specifies whether the machine type provides local NVMe storage.
+	// +optional
+	NVMe *bool `json:"nvme,omitempty" protobuf:"varint,2,opt,name=nvme"`
+	// NestedVirtualization specifies whether the machine type supports nested virtualization.
+	// +optional
+	NestedVirtualization *bool `json:"nestedVirtualization,omitempty" protobuf:"varint,3,opt,name=nestedVirtualization"`
+	// ConfidentialCompute specifies whether the machine type supports confidential computing.
+	// +optional
+	ConfidentialCompute *bool `json:"confidentialCompute,omitempty" protobuf:"varint,4,opt,name=confidentialCompute"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -191,6 +235,10 @@ type Region struct {
 	// quality, reliability, access restrictions, etc.
 	// +optional
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+	// EgressOnlyInternetAccessSupported indicates whether worker nodes in this region can be provisioned without public
+	// IPs, i.e., whether Shoots in this region may enable egress-only internet access for their worker nodes.
+	// +optional
+	EgressOnlyInternetAccessSupported *bool `json:"egressOnlyInternetAccessSupported,omitempty" protobuf:"varint,4,opt,name=egressOnlyInternetAccessSupported"`
 }
 
 // AvailabilityZone is an availability zone.
@@ -217,6 +265,10 @@ type VolumeType struct {
 	// MinSize is the minimal supported storage size.
 	// +optional
 	MinSize *resource.Quantity `json:"minSize,omitempty" protobuf:"bytes,4,opt,name=minSize"`
+	// MonthlyPricePerGi is the price of one Gi of this volume type per month as decimal number (e.g., "0.08"). It is used
+	// for estimating the cost of Shoots. All prices in a CloudProfile must be given in the same currency.
+	// +optional
+	MonthlyPricePerGi *string `json:"monthlyPricePerGi,omitempty" protobuf:"bytes,5,opt,name=monthlyPricePerGi"`
 }
 
 const (
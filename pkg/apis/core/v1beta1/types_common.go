@@ -43,6 +43,22 @@ const (
 	ErrorProblematicWebhook ErrorCode = "ERR_PROBLEMATIC_WEBHOOK"
 )
 
+// ErrorCategory is a string alias.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryCredentials indicates that the last error was caused by invalid or insufficient infrastructure credentials.
+	ErrorCategoryCredentials ErrorCategory = "Credentials"
+	// ErrorCategoryInfrastructure indicates that the last error was caused by the infrastructure, e.g., exceeded quotas or depleted resources.
+	ErrorCategoryInfrastructure ErrorCategory = "Infrastructure"
+	// ErrorCategoryConfiguration indicates that the last error was caused by the configuration of the resource.
+	ErrorCategoryConfiguration ErrorCategory = "Configuration"
+	// ErrorCategoryCleanup indicates that the last error was caused by resources which could not be cleaned up.
+	ErrorCategoryCleanup ErrorCategory = "Cleanup"
+	// ErrorCategoryWebhook indicates that the last error was caused by a problematic webhook.
+	ErrorCategoryWebhook ErrorCategory = "Webhook"
+)
+
 // LastError indicates the last occurred error for an operation on a resource.
 type LastError struct {
 	// A human readable message indicating details about the last error.
@@ -56,6 +72,15 @@ type LastError struct {
 	// Last time the error was reported
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// Category is the category of the last error derived from its error codes.
+	// +optional
+	Category *ErrorCategory `json:"category,omitempty" protobuf:"bytes,5,opt,name=category,casttype=ErrorCategory"`
+	// ProviderCodes are the error codes reported by the infrastructure provider, e.g., the cloud provider's API.
+	// +optional
+	ProviderCodes []string `json:"providerCodes,omitempty" protobuf:"bytes,6,rep,name=providerCodes"`
+	// RemediationHint is a human readable hint on how the last error can be remediated.
+	// +optional
+	RemediationHint *string `json:"remediationHint,omitempty" protobuf:"bytes,7,opt,name=remediationHint"`
 }
 
 // LastOperationType is a string alias.
@@ -117,6 +142,17 @@ type Gardener struct {
 	Version string `json:"version" protobuf:"bytes,3,opt,name=version"`
 }
 
+// FeatureGateStatus contains the state of a feature gate of a Gardener component.
+type FeatureGateStatus struct {
+	// Name is the name of the feature gate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Enabled specifies whether the feature gate is enabled.
+	Enabled bool `json:"enabled" protobuf:"varint,2,opt,name=enabled"`
+	// LockToDefault specifies whether the feature gate is locked to its default value, i.e., it cannot be configured.
+	// +optional
+	LockToDefault bool `json:"lockToDefault,omitempty" protobuf:"varint,3,opt,name=lockToDefault"`
+}
+
 const (
 	// GardenerName is the value in a Garden resource's `.metadata.finalizers[]` array on which the Gardener will react
 	// when performing a delete request on a resource.
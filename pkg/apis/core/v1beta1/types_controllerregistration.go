@@ -76,6 +76,11 @@ type ControllerResource struct {
 	// This field is only relevant when kind is "Extension".
 	// +optional
 	WorkerlessSupported *bool `json:"workerlessSupported,omitempty" protobuf:"varint,7,opt,name=workerlessSupported"`
+	// RequiredCredentialKeys are the keys which must be present in the data of Secrets referenced by SecretBindings and
+	// CredentialsBindings for this provider type.
+	// This field is only relevant when kind is "Infrastructure".
+	// +optional
+	RequiredCredentialKeys []string `json:"requiredCredentialKeys,omitempty" protobuf:"bytes,8,rep,name=requiredCredentialKeys"`
 }
 
 // DeploymentRef contains information about `ControllerDeployment` references.
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImageVectorOverride overrides images of the image vector used by gardenlet for selected seeds or shoots.
+type ImageVectorOverride struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of the ImageVectorOverride.
+	Spec ImageVectorOverrideSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImageVectorOverrideList is a collection of ImageVectorOverrides.
+type ImageVectorOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ImageVectorOverrides.
+	Items []ImageVectorOverride `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ImageVectorOverrideSpec is the specification of an ImageVectorOverride.
+type ImageVectorOverrideSpec struct {
+	// SeedSelector is an optional label selector for Seeds whose gardenlets apply the override. If it is not set, the
+	// override is applied by the gardenlets of all Seeds.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty" protobuf:"bytes,1,opt,name=seedSelector"`
+	// ShootSelector is an optional label selector for Shoots. If it is set, the override is only applied to the
+	// components of the selected Shoots. Otherwise, it is applied to all components deployed by the gardenlets of the
+	// selected Seeds.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty" protobuf:"bytes,2,opt,name=shootSelector"`
+	// Images is the list of image overrides.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Images []ImageOverride `json:"images" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=images"`
+}
+
+// ImageOverride overrides the repository and the tag or digest of an image of the image vector.
+type ImageOverride struct {
+	// Name is the name of the image in the image vector.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Repository is the repository of the image, without tag or digest.
+	Repository string `json:"repository" protobuf:"bytes,2,opt,name=repository"`
+	// Tag is the tag of the image. Exactly one of Tag or Digest must be set.
+	// +optional
+	Tag *string `json:"tag,omitempty" protobuf:"bytes,3,opt,name=tag"`
+	// Digest is the digest of the image, e.g. 'sha256:...'. Exactly one of Tag or Digest must be set.
+	// +optional
+	Digest *string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
+	// RuntimeVersion is an optional semantic version constraint for the Kubernetes version of the cluster the image
+	// runs in. It must match the one of the overridden image vector entry.
+	// +optional
+	RuntimeVersion *string `json:"runtimeVersion,omitempty" protobuf:"bytes,5,opt,name=runtimeVersion"`
+	// TargetVersion is an optional semantic version constraint for the Kubernetes version of the cluster the image
+	// operates on. It must match the one of the overridden image vector entry.
+	// +optional
+	TargetVersion *string `json:"targetVersion,omitempty" protobuf:"bytes,6,opt,name=targetVersion"`
+}
@@ -5,6 +5,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -74,6 +75,18 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// AlertRoutes is a list of routes which forward the control plane alerts of the shoots in this project to external
+	// receivers.
+	// +optional
+	AlertRoutes []AlertRoute `json:"alertRoutes,omitempty" protobuf:"bytes,9,rep,name=alertRoutes"`
+	// NamespaceResources contains overrides for the ResourceQuota and LimitRange which are created by Gardener in the
+	// project namespace. Modifying this field requires the `modify-spec-namespaceresources` verb for the project.
+	// +optional
+	NamespaceResources *ProjectNamespaceResources `json:"namespaceResources,omitempty" protobuf:"bytes,10,opt,name=namespaceResources"`
+	// CustomRoles is a list of project-specific roles with explicit rule sets. Members can be bound to them via the
+	// `custom:<name>` role. Modifying this field requires the `modify-spec-customroles` verb for the project.
+	// +optional
+	CustomRoles []ProjectCustomRole `json:"customRoles,omitempty" protobuf:"bytes,11,rep,name=customRoles"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -146,6 +159,75 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool `json:"includeServiceAccounts,omitempty" protobuf:"varint,3,opt,name=includeServiceAccounts"`
 }
 
+// AlertRoute is a route which forwards the control plane alerts of the shoots in a project to an external receiver.
+type AlertRoute struct {
+	// Name is the unique name of the route.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Severities is a list of alert severities which are forwarded by this route. If empty, alerts of all severities
+	// are forwarded.
+	// +optional
+	Severities []string `json:"severities,omitempty" protobuf:"bytes,2,rep,name=severities"`
+	// Receiver is the external receiver to which the alerts are forwarded.
+	Receiver AlertReceiver `json:"receiver" protobuf:"bytes,3,opt,name=receiver"`
+}
+
+// AlertReceiver is an external receiver for alerts. Exactly one of the receiver types must be set.
+type AlertReceiver struct {
+	// Email contains the configuration for sending alerts via email. It requires that the Gardener operator has
+	// configured an SMTP server for alerting.
+	// +optional
+	Email *EmailAlertReceiver `json:"email,omitempty" protobuf:"bytes,1,opt,name=email"`
+	// Webhook contains the configuration for sending alerts to a generic webhook.
+	// +optional
+	Webhook *WebhookAlertReceiver `json:"webhook,omitempty" protobuf:"bytes,2,opt,name=webhook"`
+	// Slack contains the configuration for sending alerts to a Slack-compatible incoming webhook.
+	// +optional
+	Slack *SlackAlertReceiver `json:"slack,omitempty" protobuf:"bytes,3,opt,name=slack"`
+}
+
+// EmailAlertReceiver contains the configuration for sending alerts via email.
+type EmailAlertReceiver struct {
+	// To is a list of email addresses to which the alerts are sent.
+	To []string `json:"to" protobuf:"bytes,1,rep,name=to"`
+}
+
+// WebhookAlertReceiver contains the configuration for sending alerts to a generic webhook.
+type WebhookAlertReceiver struct {
+	// SecretName is the name of a secret in the project namespace which contains the URL of the webhook in the `url`
+	// data key.
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
+}
+
+// SlackAlertReceiver contains the configuration for sending alerts to a Slack-compatible incoming webhook.
+type SlackAlertReceiver struct {
+	// SecretName is the name of a secret in the project namespace which contains the URL of the incoming webhook in
+	// the `url` data key.
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
+	// Channel is the channel or user to which the alerts are sent.
+	// +optional
+	Channel *string `json:"channel,omitempty" protobuf:"bytes,2,opt,name=channel"`
+}
+
+// ProjectNamespaceResources contains overrides for the ResourceQuota and LimitRange which are created by Gardener in
+// the project namespace.
+type ProjectNamespaceResources struct {
+	// ResourceQuota contains hard limits which override the respective limits of the ResourceQuota template configured
+	// for the project.
+	// +optional
+	ResourceQuota corev1.ResourceList `json:"resourceQuota,omitempty" protobuf:"bytes,1,rep,name=resourceQuota,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// LimitRange contains limits which replace the limits of the LimitRange template configured for the project.
+	// +optional
+	LimitRange []corev1.LimitRangeItem `json:"limitRange,omitempty" protobuf:"bytes,2,rep,name=limitRange"`
+}
+
+// ProjectCustomRole is a project-specific role with an explicit rule set.
+type ProjectCustomRole struct {
+	// Name is the name of the custom role. Members reference it with the `custom:<name>` role.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Rules is the list of policy rules which are granted to the members having this role in the project namespace.
+	Rules []rbacv1.PolicyRule `json:"rules" protobuf:"bytes,2,rep,name=rules"`
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -159,6 +241,8 @@ const (
 	ProjectMemberViewer = "viewer"
 	// ProjectMemberExtensionPrefix is a prefix for custom roles that are not known by Gardener.
 	ProjectMemberExtensionPrefix = "extension:"
+	// ProjectMemberCustomPrefix is a prefix for roles that reference a custom role defined in the project.
+	ProjectMemberCustomPrefix = "custom:"
 )
 
 // ProjectPhase is a label for the condition of a project at the current time.
@@ -173,6 +257,9 @@ const (
 	ProjectFailed ProjectPhase = "Failed"
 	// ProjectTerminating indicates that the project is in termination process.
 	ProjectTerminating ProjectPhase = "Terminating"
+	// ProjectArchived indicates that the project has been deleted and is archived until its deletion grace period has
+	// passed.
+	ProjectArchived ProjectPhase = "Archived"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -184,4 +271,8 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventArchived indicates that the project has been archived.
+	ProjectEventArchived = "Archived"
+	// ProjectEventArchivalFailed indicates that archiving the project failed.
+	ProjectEventArchivalFailed = "ArchivalFailed"
 )
@@ -78,8 +78,21 @@ type SeedSpec struct {
 	// Ingress configures Ingress specific settings of the Seed cluster. This field is immutable.
 	// +optional
 	Ingress *Ingress `json:"ingress,omitempty" protobuf:"bytes,9,opt,name=ingress"`
+	// Operation is an operation which is performed for the Seed. If set to `Decommission`, the seed is cordoned for
+	// scheduling and the control planes of all hosted shoots are migrated to other eligible seeds.
+	// +optional
+	Operation *SeedOperation `json:"operation,omitempty" protobuf:"bytes,10,opt,name=operation,casttype=SeedOperation"`
 }
 
+// SeedOperation is an operation which is performed for a Seed.
+type SeedOperation string
+
+const (
+	// SeedOperationDecommission is a constant for the operation which cordons the seed for scheduling and migrates
+	// the control planes of all hosted shoots to other eligible seeds.
+	SeedOperationDecommission SeedOperation = "Decommission"
+)
+
 // SeedStatus is the status of a Seed.
 type SeedStatus struct {
 	// Gardener holds information about the Gardener which last acted on the Shoot.
@@ -113,6 +126,74 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// ExposureClassHandlers contains information about the ingress gateways of the exposure class handlers which are
+	// deployed in the seed cluster.
+	// +optional
+	ExposureClassHandlers []ExposureClassHandlerStatus `json:"exposureClassHandlers,omitempty" protobuf:"bytes,10,rep,name=exposureClassHandlers"`
+	// SystemComponents contains information about the versions of the system components deployed in the seed cluster.
+	// +optional
+	SystemComponents []SeedSystemComponent `json:"systemComponents,omitempty" protobuf:"bytes,11,rep,name=systemComponents"`
+	// Decommission contains information about the progress of the decommissioning of the seed.
+	// +optional
+	Decommission *SeedDecommissionStatus `json:"decommission,omitempty" protobuf:"bytes,12,opt,name=decommission"`
+	// FeatureGates contains the states of the feature gates of the gardenlet responsible for the seed.
+	// +optional
+	FeatureGates []FeatureGateStatus `json:"featureGates,omitempty" protobuf:"bytes,13,rep,name=featureGates"`
+}
+
+// SeedDecommissionStatus contains information about the progress of the decommissioning of a seed.
+type SeedDecommissionStatus struct {
+	// StartTime is the time when the decommissioning of the seed was started.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,1,opt,name=startTime"`
+	// LastUpdateTime is the time when the progress was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+	// RemainingShoots is the number of shoots which are still scheduled to the seed or whose control plane is still
+	// hosted by the seed.
+	RemainingShoots int32 `json:"remainingShoots" protobuf:"varint,3,opt,name=remainingShoots"`
+	// MigratingShoots is the number of shoots whose control plane is currently being migrated away from the seed.
+	MigratingShoots int32 `json:"migratingShoots" protobuf:"varint,4,opt,name=migratingShoots"`
+	// Message contains details about the progress, e.g., the number of pending and skipped shoots.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// ExposureClassHandlerStatus contains information about the ingress gateway of an exposure class handler.
+type ExposureClassHandlerStatus struct {
+	// Name is the name of the exposure class handler.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Autoscaling contains the autoscaling settings applied to the ingress gateway of the exposure class handler.
+	// +optional
+	Autoscaling *ExposureClassHandlerAutoscaling `json:"autoscaling,omitempty" protobuf:"bytes,2,opt,name=autoscaling"`
+}
+
+// ExposureClassHandlerAutoscaling contains the autoscaling settings applied to an ingress gateway.
+type ExposureClassHandlerAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the ingress gateway.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+	// MaxReplicas is the maximum number of replicas of the ingress gateway.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" protobuf:"varint,2,opt,name=maxReplicas"`
+	// TargetConnections is the average number of active downstream connections per replica the ingress gateway is
+	// scaled on.
+	// +optional
+	TargetConnections *int32 `json:"targetConnections,omitempty" protobuf:"varint,3,opt,name=targetConnections"`
+}
+
+// SeedSystemComponent contains information about the version of a system component deployed in the seed cluster.
+type SeedSystemComponent struct {
+	// Name is the name of the system component.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Version is the version of the system component which is currently deployed in the seed cluster.
+	// +optional
+	Version *string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// ExpectedVersion is the version of the system component according to the image vector of the gardenlet.
+	// +optional
+	ExpectedVersion *string `json:"expectedVersion,omitempty" protobuf:"bytes,3,opt,name=expectedVersion"`
+	// VersionSkew indicates whether the deployed version differs from the expected version, e.g., after a partial
+	// upgrade.
+	// +optional
+	VersionSkew bool `json:"versionSkew,omitempty" protobuf:"varint,4,opt,name=versionSkew"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -253,6 +334,14 @@ type SeedSettings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty" protobuf:"bytes,8,opt,name=topologyAwareRouting"`
+	// APIServerProxy controls certain settings for the apiserver-proxy path of shoot clusters through the istio ingress
+	// gateway of the seed.
+	// +optional
+	APIServerProxy *SeedSettingAPIServerProxy `json:"apiServerProxy,omitempty" protobuf:"bytes,9,opt,name=apiServerProxy"`
+	// PrivateLink controls whether the seed supports connecting shoot nodes to their control planes via private
+	// endpoints.
+	// +optional
+	PrivateLink *SeedSettingPrivateLink `json:"privateLink,omitempty" protobuf:"bytes,10,opt,name=privateLink"`
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.
@@ -263,6 +352,10 @@ type SeedSettingExcessCapacityReservation struct {
 	// Configs configures excess capacity reservation deployments for shoot control planes in the seed.
 	// +optional
 	Configs []SeedSettingExcessCapacityReservationConfig `json:"configs,omitempty" protobuf:"bytes,2,rep,name=configs"`
+	// Profiles configures named excess capacity reservation profiles for shoot control plane size classes in the seed.
+	// Each profile is reconciled as a separate excess capacity reservation deployment.
+	// +optional
+	Profiles []SeedSettingExcessCapacityReservationProfile `json:"profiles,omitempty" protobuf:"bytes,3,rep,name=profiles"`
 }
 
 // SeedSettingExcessCapacityReservationConfig configures excess capacity reservation deployments for shoot control planes in the seed.
@@ -277,6 +370,26 @@ type SeedSettingExcessCapacityReservationConfig struct {
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,3,rep,name=tolerations"`
 }
 
+// SeedSettingExcessCapacityReservationProfile configures the excess capacity reservation for shoot control planes of a
+// certain size class in the seed.
+type SeedSettingExcessCapacityReservationProfile struct {
+	// Name is the name of the shoot control plane size class this profile reserves capacity for, e.g. `large`.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Replicas is the number of shoot control planes of this size class for which capacity is reserved. When not
+	// specified, the number of zones of the seed (but at least 2) is used.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,2,opt,name=replicas"`
+	// Resources specify the resource requests and limits of the excess-capacity-reservation pod. They should roughly
+	// correspond to the resource consumption of a single shoot control plane of this size class.
+	Resources corev1.ResourceList `json:"resources" protobuf:"bytes,3,rep,name=resources,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// NodeSelector specifies the node where the excess-capacity-reservation pod should run.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,4,rep,name=nodeSelector"`
+	// Tolerations specify the tolerations for the the excess-capacity-reservation pod.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,5,rep,name=tolerations"`
+}
+
 // SeedSettingScheduling controls settings for scheduling decisions for the seed.
 type SeedSettingScheduling struct {
 	// Visible controls whether the gardener-scheduler shall consider this seed when scheduling shoots. Invisible seeds
@@ -368,9 +481,10 @@ type SeedSettingDependencyWatchdogWeeder struct {
 
 // SeedSettingDependencyWatchdogProber controls the prober settings for the dependency-watchdog for the seed.
 type SeedSettingDependencyWatchdogProber struct {
-	// Enabled controls whether the probe controller(prober) of the dependency-watchdog should be enabled. This controller
-	// scales down the kube-controller-manager, machine-controller-manager and cluster-autoscaler of shoot clusters in case their respective kube-apiserver is not
-	// reachable via its external ingress in order to avoid melt-down situations.
+	// Enabled controls whether the node lease probe of the shoot care controller in gardenlet should be enabled. This probe
+	// scales down the kube-controller-manager, machine-controller-manager and cluster-autoscaler of shoot clusters in case
+	// too many of their node leases expire, e.g., because their respective kube-apiserver is not reachable via its external
+	// ingress, in order to avoid melt-down situations.
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
@@ -382,6 +496,28 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
+// SeedSettingAPIServerProxy controls certain settings for the apiserver-proxy path of shoot clusters, i.e., for the
+// traffic which is routed from the apiserver-proxy in the shoot cluster via the istio ingress gateway of the seed to
+// the kube-apiserver of the shoot.
+type SeedSettingAPIServerProxy struct {
+	// MaxConnections is the maximum number of concurrent connections per shoot on the apiserver-proxy path. Further
+	// connections are closed immediately. When not specified, the number of connections is not limited.
+	// +optional
+	MaxConnections *int32 `json:"maxConnections,omitempty" protobuf:"varint,1,opt,name=maxConnections"`
+	// MaxConnectionsPerSecond is the maximum number of new connections per second and shoot on the apiserver-proxy
+	// path. Further connections are closed immediately. When not specified, the connection rate is not limited.
+	// +optional
+	MaxConnectionsPerSecond *int32 `json:"maxConnectionsPerSecond,omitempty" protobuf:"varint,2,opt,name=maxConnectionsPerSecond"`
+}
+
+// SeedSettingPrivateLink controls whether the seed supports connecting shoot nodes to their control planes via private
+// endpoints, i.e., whether the ingress of the seed can be exposed via private link or private endpoint services of the
+// infrastructure provider.
+type SeedSettingPrivateLink struct {
+	// Enabled controls whether shoots requesting private link connectivity can be scheduled to the seed.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -424,8 +560,14 @@ const (
 	SeedExtensionsReady ConditionType = "ExtensionsReady"
 	// SeedGardenletReady is a constant for a condition type indicating that the Gardenlet is ready.
 	SeedGardenletReady ConditionType = "GardenletReady"
+	// SeedShootsRestored is a constant for a condition type indicating the progress of restoring the Shoots of a
+	// destroyed Seed to another Seed.
+	SeedShootsRestored ConditionType = "ShootsRestored"
 	// SeedSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
+	// SeedZoneOutage is a constant for a condition type indicating whether an outage of one or more availability zones
+	// of the seed cluster has been detected.
+	SeedZoneOutage ConditionType = "ZoneOutage"
 )
 
 // Resource constants for Gardener object types
@@ -130,6 +130,70 @@ type ShootSpec struct {
 	// CloudProfile contains a reference to a CloudProfile or a NamespacedCloudProfile.
 	// +optional
 	CloudProfile *CloudProfileReference `json:"cloudProfile,omitempty" protobuf:"bytes,22,opt,name=cloudProfile"`
+	// Observability contains settings for the observability components of the shoot.
+	// +optional
+	Observability *Observability `json:"observability,omitempty" protobuf:"bytes,23,opt,name=observability"`
+	// ReadinessGates is a list of user-defined checks which are evaluated by gardenlet. Their results are reflected in
+	// the `ReadinessGatesPassed` condition of the shoot.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	ReadinessGates []ShootReadinessGate `json:"readinessGates,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,24,rep,name=readinessGates"`
+	// Reconciliation contains settings for the reconciliation of the shoot.
+	// +optional
+	Reconciliation *ShootReconciliation `json:"reconciliation,omitempty" protobuf:"bytes,25,opt,name=reconciliation"`
+}
+
+// ShootReadinessGate is a user-defined check which must pass before the shoot is considered ready. Exactly one of the
+// checks must be configured.
+type ShootReadinessGate struct {
+	// Name is the unique name of the readiness gate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// HTTPGet checks whether a service in the shoot responds with a 2xx status code to an HTTP GET request.
+	// +optional
+	HTTPGet *ShootReadinessGateHTTPGet `json:"httpGet,omitempty" protobuf:"bytes,2,opt,name=httpGet"`
+	// CustomResourceDefinition checks whether a CustomResourceDefinition exists and is established in the shoot.
+	// +optional
+	CustomResourceDefinition *ShootReadinessGateCustomResourceDefinition `json:"customResourceDefinition,omitempty" protobuf:"bytes,3,opt,name=customResourceDefinition"`
+}
+
+// ShootReadinessGateHTTPGet describes an HTTP GET request against a service in the shoot. The request is sent through
+// the service proxy of the shoot's kube-apiserver.
+type ShootReadinessGateHTTPGet struct {
+	// Namespace is the namespace of the service.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Service is the name of the service.
+	Service string `json:"service" protobuf:"bytes,2,opt,name=service"`
+	// Port is the port of the service.
+	Port int32 `json:"port" protobuf:"varint,3,opt,name=port"`
+	// Path is the path of the request (default: `/`).
+	// +optional
+	Path *string `json:"path,omitempty" protobuf:"bytes,4,opt,name=path"`
+	// Scheme is the scheme used for connecting to the service (`http` or `https`, default: `http`).
+	// +optional
+	Scheme *string `json:"scheme,omitempty" protobuf:"bytes,5,opt,name=scheme"`
+}
+
+// ShootReadinessGateCustomResourceDefinition describes a CustomResourceDefinition in the shoot.
+type ShootReadinessGateCustomResourceDefinition struct {
+	// Name is the name of the CustomResourceDefinition, e.g. `certificates.cert.gardener.cloud`.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// ShootReconciliation contains settings for the reconciliation of the shoot.
+type ShootReconciliation struct {
+	// Paused specifies whether the reconciliation of the shoot is paused. While paused, gardenlet does not perform any
+	// operation (create, reconcile, delete, etc.) for the shoot and the shoot maintenance is skipped.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,1,opt,name=paused"`
+	// Reason is a human-readable explanation why the reconciliation is paused. It is required when the reconciliation is
+	// paused.
+	// +optional
+	Reason *string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// PausedUntil is the time until which the reconciliation is paused. If not specified, the reconciliation is paused
+	// until Paused is set to false.
+	// +optional
+	PausedUntil *metav1.Time `json:"pausedUntil,omitempty" protobuf:"bytes,3,opt,name=pausedUntil"`
 }
 
 // GetProviderType gets the type of the provider.
@@ -204,6 +268,237 @@ type ShootStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty" protobuf:"bytes,18,rep,name=encryptedResources"`
+	// Availability contains information about the availability of the Shoot's API server within the current month.
+	// +optional
+	Availability *ShootAvailability `json:"availability,omitempty" protobuf:"bytes,19,opt,name=availability"`
+	// LeftoverResources contains the inventory of resources which were created for the Shoot and still exist. It is
+	// only maintained while the Shoot is being deleted.
+	// +optional
+	LeftoverResources *LeftoverResources `json:"leftoverResources,omitempty" protobuf:"bytes,20,opt,name=leftoverResources"`
+	// ETCD contains information about the etcds of the Shoot.
+	// +optional
+	ETCD *ShootETCDStatus `json:"etcd,omitempty" protobuf:"bytes,21,opt,name=etcd"`
+	// WorkersRollout contains information about the rollout progress of the worker pools of the Shoot. It is reported
+	// by the Worker extension for all update strategies.
+	// +optional
+	WorkersRollout []WorkerPoolRollout `json:"workersRollout,omitempty" protobuf:"bytes,22,rep,name=workersRollout"`
+	// SecurityAdvisories contains the known vulnerabilities which affect the machine images of the worker pools of the
+	// Shoot. They are determined by gardenlet based on the machine image metadata reported by the operating system
+	// extensions and the CVE feed configured by the Gardener operator.
+	// +optional
+	SecurityAdvisories []ShootSecurityAdvisory `json:"securityAdvisories,omitempty" protobuf:"bytes,23,rep,name=securityAdvisories"`
+	// Maintenance contains information about the upcoming maintenance of the Shoot.
+	// +optional
+	Maintenance *ShootMaintenanceStatus `json:"maintenance,omitempty" protobuf:"bytes,24,opt,name=maintenance"`
+	// Networking contains information about the network of the Shoot.
+	// +optional
+	Networking *NetworkingStatus `json:"networking,omitempty" protobuf:"bytes,25,opt,name=networking"`
+}
+
+// NetworkingStatus contains information about the network of the Shoot.
+type NetworkingStatus struct {
+	// PathMTU is the smallest maximum transmission unit of the network paths between the seed and the worker nodes of
+	// the Shoot. It is discovered by gardener-node-agent and propagated to the VPN and networking components.
+	// +optional
+	PathMTU *int32 `json:"pathMTU,omitempty" protobuf:"varint,1,opt,name=pathMTU"`
+}
+
+// ShootMaintenanceStatus contains information about the upcoming maintenance of the Shoot.
+type ShootMaintenanceStatus struct {
+	// PlannedChanges are the version updates which the next maintenance would apply based on the current state of the
+	// Shoot and its CloudProfile.
+	// +optional
+	PlannedChanges []MaintenancePlannedChange `json:"plannedChanges,omitempty" protobuf:"bytes,1,rep,name=plannedChanges"`
+	// LastUpdateTime is the time when the planned changes were last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+	// RunningVersions contains the Kubernetes and machine image versions which the Shoot successfully runs. They are
+	// used to evaluate the maintenance dependencies of other Shoots.
+	// +optional
+	RunningVersions *ShootRunningVersions `json:"runningVersions,omitempty" protobuf:"bytes,3,opt,name=runningVersions"`
+}
+
+// ShootRunningVersions contains the Kubernetes and machine image versions which a Shoot successfully runs.
+type ShootRunningVersions struct {
+	// Kubernetes is the lowest Kubernetes version of the control plane and the worker pools.
+	// +optional
+	Kubernetes *RunningVersion `json:"kubernetes,omitempty" protobuf:"bytes,1,opt,name=kubernetes"`
+	// MachineImages contains the lowest version of each machine image used by the worker pools.
+	// +optional
+	MachineImages []MachineImageRunningVersion `json:"machineImages,omitempty" protobuf:"bytes,2,rep,name=machineImages"`
+}
+
+// RunningVersion is a version which a Shoot successfully runs.
+type RunningVersion struct {
+	// Version is the version.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// Since is the time since when the Shoot successfully runs the version.
+	Since metav1.Time `json:"since" protobuf:"bytes,2,opt,name=since"`
+}
+
+// MachineImageRunningVersion is a machine image version which a Shoot successfully runs.
+type MachineImageRunningVersion struct {
+	// Name is the name of the machine image.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Version is the version of the machine image.
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	// Since is the time since when the Shoot successfully runs the version.
+	Since metav1.Time `json:"since" protobuf:"bytes,3,opt,name=since"`
+}
+
+// MaintenancePlannedChange is a version update which the next maintenance would apply.
+type MaintenancePlannedChange struct {
+	// Type is the type of the version update.
+	Type MaintenancePlannedChangeType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=MaintenancePlannedChangeType"`
+	// WorkerPool is the name of the affected worker pool. It is not set for updates of the control plane.
+	// +optional
+	WorkerPool *string `json:"workerPool,omitempty" protobuf:"bytes,2,opt,name=workerPool"`
+	// MachineImageName is the name of the machine image. It is only set for machine image version updates.
+	// +optional
+	MachineImageName *string `json:"machineImageName,omitempty" protobuf:"bytes,3,opt,name=machineImageName"`
+	// CurrentVersion is the currently used version.
+	CurrentVersion string `json:"currentVersion" protobuf:"bytes,4,opt,name=currentVersion"`
+	// TargetVersion is the version which the maintenance would update to.
+	TargetVersion string `json:"targetVersion" protobuf:"bytes,5,opt,name=targetVersion"`
+	// Reason is the reason for the update.
+	Reason string `json:"reason" protobuf:"bytes,6,opt,name=reason"`
+	// Forced indicates that the update is required, e.g., because the current version is expired, and cannot be vetoed.
+	// +optional
+	Forced bool `json:"forced,omitempty" protobuf:"varint,7,opt,name=forced"`
+}
+
+// MaintenancePlannedChangeType is the type of a planned maintenance change.
+type MaintenancePlannedChangeType string
+
+const (
+	// MaintenancePlannedChangeTypeKubernetesVersion is the type of a Kubernetes version update.
+	MaintenancePlannedChangeTypeKubernetesVersion MaintenancePlannedChangeType = "KubernetesVersion"
+	// MaintenancePlannedChangeTypeMachineImageVersion is the type of a machine image version update.
+	MaintenancePlannedChangeTypeMachineImageVersion MaintenancePlannedChangeType = "MachineImageVersion"
+)
+
+// ShootSecurityAdvisory contains information about a known vulnerability affecting worker pools of a Shoot.
+type ShootSecurityAdvisory struct {
+	// ID is the identifier of the vulnerability, e.g., a CVE ID.
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Severity is the severity of the vulnerability as stated in the CVE feed.
+	// +optional
+	Severity *string `json:"severity,omitempty" protobuf:"bytes,2,opt,name=severity"`
+	// Summary is a short description of the vulnerability.
+	// +optional
+	Summary *string `json:"summary,omitempty" protobuf:"bytes,3,opt,name=summary"`
+	// WorkerPools is the list of names of the worker pools affected by the vulnerability.
+	WorkerPools []string `json:"workerPools" protobuf:"bytes,4,rep,name=workerPools"`
+}
+
+// WorkerPoolRollout contains information about the rollout progress of a worker pool.
+type WorkerPoolRollout struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MachinesUpdated is the number of machines which already run with the target configuration.
+	MachinesUpdated int32 `json:"machinesUpdated" protobuf:"varint,2,opt,name=machinesUpdated"`
+	// MachinesPending is the number of machines which still have to be updated to the target configuration.
+	MachinesPending int32 `json:"machinesPending" protobuf:"varint,3,opt,name=machinesPending"`
+	// MachinesFailed is the number of machines which failed.
+	MachinesFailed int32 `json:"machinesFailed" protobuf:"varint,4,opt,name=machinesFailed"`
+	// CurrentMachineImage is the machine image of the last completed rollout of the worker pool.
+	// +optional
+	CurrentMachineImage *ShootMachineImage `json:"currentMachineImage,omitempty" protobuf:"bytes,5,opt,name=currentMachineImage"`
+	// TargetMachineImage is the machine image the machines of the worker pool are updated to.
+	// +optional
+	TargetMachineImage *ShootMachineImage `json:"targetMachineImage,omitempty" protobuf:"bytes,6,opt,name=targetMachineImage"`
+	// CurrentKubeletVersion is the kubelet version of the last completed rollout of the worker pool.
+	// +optional
+	CurrentKubeletVersion *string `json:"currentKubeletVersion,omitempty" protobuf:"bytes,7,opt,name=currentKubeletVersion"`
+	// TargetKubeletVersion is the kubelet version the machines of the worker pool are updated to.
+	// +optional
+	TargetKubeletVersion *string `json:"targetKubeletVersion,omitempty" protobuf:"bytes,8,opt,name=targetKubeletVersion"`
+}
+
+// ShootETCDStatus contains information about the etcds of a Shoot.
+type ShootETCDStatus struct {
+	// Main contains information about the main etcd.
+	// +optional
+	Main *ETCDStatus `json:"main,omitempty" protobuf:"bytes,1,opt,name=main"`
+	// Events contains information about the events etcd.
+	// +optional
+	Events *ETCDStatus `json:"events,omitempty" protobuf:"bytes,2,opt,name=events"`
+}
+
+// ETCDStatus contains information about the database of an etcd.
+type ETCDStatus struct {
+	// DBSize is the physically allocated size of the database.
+	// +optional
+	DBSize *resource.Quantity `json:"dbSize,omitempty" protobuf:"bytes,1,opt,name=dbSize"`
+	// DBSizeInUse is the logically used size of the database. The difference to DBSize can be reclaimed by a
+	// defragmentation.
+	// +optional
+	DBSizeInUse *resource.Quantity `json:"dbSizeInUse,omitempty" protobuf:"bytes,2,opt,name=dbSizeInUse"`
+	// LastDefragmentations is the list of the last successful defragmentations, the most recent first.
+	// +optional
+	LastDefragmentations []ETCDDefragmentationStatus `json:"lastDefragmentations,omitempty" protobuf:"bytes,3,rep,name=lastDefragmentations"`
+	// LastUpdateTime is the time when the information was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+}
+
+// ETCDDefragmentationStatus contains information about a defragmentation of an etcd.
+type ETCDDefragmentationStatus struct {
+	// Time is the time when the defragmentation was observed.
+	Time metav1.Time `json:"time" protobuf:"bytes,1,opt,name=time"`
+	// DBSizeBefore is the size of the database which was last observed before the defragmentation.
+	// +optional
+	DBSizeBefore *resource.Quantity `json:"dbSizeBefore,omitempty" protobuf:"bytes,2,opt,name=dbSizeBefore"`
+}
+
+// LeftoverResources contains the inventory of resources which were created for a Shoot and still exist.
+type LeftoverResources struct {
+	// Resources is the list of resources which still exist.
+	// +optional
+	Resources []LeftoverResource `json:"resources,omitempty" protobuf:"bytes,1,rep,name=resources"`
+	// LastUpdateTime is the time when the inventory last changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+}
+
+// LeftoverResource describes a resource which was created for a Shoot and still exists.
+type LeftoverResource struct {
+	// Kind is the kind of the resource, e.g. `Infrastructure` or `MachineDeployment`.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name is the name of the resource.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Type is the extension type of the resource, if applicable.
+	// +optional
+	Type *string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// State indicates whether the resource will be destroyed by the deletion of the Shoot or whether it might be
+	// orphaned.
+	State LeftoverResourceState `json:"state" protobuf:"bytes,4,opt,name=state,casttype=LeftoverResourceState"`
+	// Description is a human-readable explanation of the state.
+	// +optional
+	Description *string `json:"description,omitempty" protobuf:"bytes,5,opt,name=description"`
+}
+
+// LeftoverResourceState is a string alias.
+type LeftoverResourceState string
+
+const (
+	// LeftoverResourceStateToBeDeleted indicates that the resource will be destroyed by the deletion of the Shoot.
+	LeftoverResourceStateToBeDeleted LeftoverResourceState = "ToBeDeleted"
+	// LeftoverResourceStatePotentiallyOrphaned indicates that the resource might not be destroyed by the deletion of
+	// the Shoot and might have to be cleaned up manually.
+	LeftoverResourceStatePotentiallyOrphaned LeftoverResourceState = "PotentiallyOrphaned"
+)
+
+// ShootAvailability contains information about the availability of the Shoot's API server within a period.
+type ShootAvailability struct {
+	// Period is the calendar month (in UTC) the availability was computed for, in the format `YYYY-MM`.
+	Period string `json:"period" protobuf:"bytes,1,opt,name=period"`
+	// Objective is the availability objective of the API server in percent which the error budget is based on.
+	Objective string `json:"objective" protobuf:"bytes,2,opt,name=objective"`
+	// APIServer is the availability of the API server in percent measured within the period so far.
+	APIServer string `json:"apiServer" protobuf:"bytes,3,opt,name=apiServer"`
+	// ErrorBudgetRemaining is the remaining error budget of the period in percent of the total error budget. It is
+	// negative if the error budget is exhausted.
+	ErrorBudgetRemaining string `json:"errorBudgetRemaining" protobuf:"bytes,4,opt,name=errorBudgetRemaining"`
+	// LastUpdateTime is the time when the availability was last computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,5,opt,name=lastUpdateTime"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -367,11 +662,32 @@ type ShootAdvertisedAddress struct {
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
+	// Deprecated: This field is deprecated. Use `charts` to deploy the kubernetes-dashboard instead.
 	// +optional
 	KubernetesDashboard *KubernetesDashboard `json:"kubernetesDashboard,omitempty" protobuf:"bytes,1,opt,name=kubernetesDashboard"`
 	// NginxIngress holds configuration settings for the nginx-ingress addon.
+	// Deprecated: This field is deprecated. Use `charts` to deploy an ingress controller instead.
 	// +optional
 	NginxIngress *NginxIngress `json:"nginxIngress,omitempty" protobuf:"bytes,2,opt,name=nginxIngress"`
+	// Charts is a list of addons which are deployed into the shoot cluster by rendering the referenced Helm charts.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Charts []AddonChart `json:"charts,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=charts"`
+}
+
+// AddonChart describes an addon which is deployed into the shoot cluster by rendering a Helm chart.
+type AddonChart struct {
+	// Name is the unique name of the addon. It is used as release name when rendering the chart.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace in the shoot cluster into which the chart is rendered. Defaults to `kube-system`.
+	// +optional
+	Namespace *string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// OCIRepository defines where to pull the chart from.
+	OCIRepository OCIRepository `json:"ociRepository" protobuf:"bytes,3,opt,name=ociRepository"`
+	// Values are the chart values.
+	// +optional
+	Values *runtime.RawExtension `json:"values,omitempty" protobuf:"bytes,4,opt,name=values"`
 }
 
 // Addon allows enabling or disabling a specific addon and is used to derive from.
@@ -417,6 +733,80 @@ type ControlPlane struct {
 	HighAvailability *HighAvailability `json:"highAvailability,omitempty" protobuf:"bytes,1,name=highAvailability"`
 }
 
+// Observability contains settings for the observability components of a shoot.
+type Observability struct {
+	// Logging contains settings for the logging stack of the shoot.
+	// +optional
+	Logging *ObservabilityLogging `json:"logging,omitempty" protobuf:"bytes,1,opt,name=logging"`
+	// EventArchive contains settings for archiving the events of the shoot to object storage. If set, the events of
+	// the shoot and its control plane are continuously exported, so that they outlive the event TTL of the clusters.
+	// +optional
+	EventArchive *EventArchive `json:"eventArchive,omitempty" protobuf:"bytes,2,opt,name=eventArchive"`
+	// Probes is a list of blackbox probes which are executed by the monitoring stack of the seed against endpoints
+	// exposed by the shoot, e.g., the load balancers of its workload. The results contribute to the
+	// `ObservabilityProbesHealthy` condition of the shoot.
+	// +optional
+	Probes []ObservabilityProbe `json:"probes,omitempty" protobuf:"bytes,3,rep,name=probes"`
+}
+
+// ObservabilityLogging contains settings for the logging stack of a shoot.
+type ObservabilityLogging struct {
+	// RetentionDays is the number of days for which the logs of the shoot are retained. Defaults to 15.
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty" protobuf:"varint,1,opt,name=retentionDays"`
+	// IngestionRate is the amount of log data per second which is ingested for the shoot. Logs exceeding this rate
+	// are rejected. If not set, the default rate limit of the logging stack applies.
+	// +optional
+	IngestionRate *resource.Quantity `json:"ingestionRate,omitempty" protobuf:"bytes,2,opt,name=ingestionRate"`
+	// IngestionBurstSize is the amount of log data which may be ingested at once for the shoot. If not set, the
+	// default burst size of the logging stack applies.
+	// +optional
+	IngestionBurstSize *resource.Quantity `json:"ingestionBurstSize,omitempty" protobuf:"bytes,3,opt,name=ingestionBurstSize"`
+}
+
+// EventArchive contains settings for archiving the events of a shoot.
+type EventArchive struct {
+	// RetentionDays is the number of days for which the archived events are retained. Defaults to 30.
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty" protobuf:"varint,1,opt,name=retentionDays"`
+	// Sink is the object storage to which the events are exported. If not set, the events are exported to the backup
+	// bucket of the seed.
+	// +optional
+	Sink *EventArchiveSink `json:"sink,omitempty" protobuf:"bytes,2,opt,name=sink"`
+}
+
+// EventArchiveSink contains information about an object storage to which events are exported.
+type EventArchiveSink struct {
+	// Provider is the provider type of the object storage.
+	Provider string `json:"provider" protobuf:"bytes,1,opt,name=provider"`
+	// ResourceName is the name of a resource in `.spec.resources` referring to a secret which contains the
+	// credentials and the bucket name of the object storage.
+	ResourceName string `json:"resourceName" protobuf:"bytes,2,opt,name=resourceName"`
+}
+
+// ObservabilityProbe is a blackbox probe against an endpoint exposed by a shoot.
+type ObservabilityProbe struct {
+	// Name is the unique name of the probe.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Protocol is the protocol used for probing the target.
+	Protocol ObservabilityProbeProtocol `json:"protocol" protobuf:"bytes,2,opt,name=protocol,casttype=ObservabilityProbeProtocol"`
+	// Target is the endpoint to probe. For the `HTTP` protocol, this is an `http` or `https` URL, for the `TCP`
+	// protocol it is an address in the form `host:port`.
+	Target string `json:"target" protobuf:"bytes,3,opt,name=target"`
+}
+
+// ObservabilityProbeProtocol is a protocol used for blackbox probes.
+type ObservabilityProbeProtocol string
+
+const (
+	// ObservabilityProbeProtocolHTTP is a constant for probing a target via HTTP(S). The probe succeeds if the target
+	// responds with a 2xx status code.
+	ObservabilityProbeProtocolHTTP ObservabilityProbeProtocol = "HTTP"
+	// ObservabilityProbeProtocolTCP is a constant for probing a target via TCP. The probe succeeds if a connection can
+	// be established.
+	ObservabilityProbeProtocolTCP ObservabilityProbeProtocol = "TCP"
+)
+
 // DNS holds information about the provider, the hosted zone id and the domain.
 type DNS struct {
 	// Domain is the external available domain of the Shoot cluster. This domain will be written into the
@@ -563,6 +953,63 @@ type Kubernetes struct {
 	// Starting Kubernetes 1.27 the field will be locked to false.
 	// +optional
 	EnableStaticTokenKubeconfig *bool `json:"enableStaticTokenKubeconfig,omitempty" protobuf:"varint,10,opt,name=enableStaticTokenKubeconfig"`
+	// ETCD contains configuration for the etcds of the Shoot cluster.
+	// +optional
+	ETCD *ETCD `json:"etcd,omitempty" protobuf:"bytes,11,opt,name=etcd"`
+}
+
+// ETCD contains configuration for the etcds of the Shoot cluster.
+type ETCD struct {
+	// Main contains configuration for the main etcd.
+	// +optional
+	Main *ETCDConfig `json:"main,omitempty" protobuf:"bytes,1,opt,name=main"`
+	// Events contains configuration for the events etcd.
+	// +optional
+	Events *ETCDConfig `json:"events,omitempty" protobuf:"bytes,2,opt,name=events"`
+}
+
+// ETCDConfig contains configuration for an etcd.
+type ETCDConfig struct {
+	// Compaction contains configuration for the automatic compaction of the etcd.
+	// +optional
+	Compaction *ETCDCompaction `json:"compaction,omitempty" protobuf:"bytes,1,opt,name=compaction"`
+	// Defragmentation contains configuration for the defragmentation of the etcd.
+	// +optional
+	Defragmentation *ETCDDefragmentation `json:"defragmentation,omitempty" protobuf:"bytes,2,opt,name=defragmentation"`
+}
+
+// ETCDCompaction contains configuration for the automatic compaction of an etcd.
+type ETCDCompaction struct {
+	// Mode is the auto compaction mode of the etcd. Possible values are `periodic` and `revision`.
+	// Defaults to `periodic`.
+	// +optional
+	Mode *ETCDCompactionMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=ETCDCompactionMode"`
+	// Retention is the auto compaction retention of the etcd. For mode `periodic`, it is a duration (e.g. `30m`) or a
+	// number of hours. For mode `revision`, it is the number of revisions to keep.
+	// +optional
+	Retention *string `json:"retention,omitempty" protobuf:"bytes,2,opt,name=retention"`
+}
+
+// ETCDCompactionMode is a string alias.
+type ETCDCompactionMode string
+
+const (
+	// ETCDCompactionModePeriodic is a constant for the periodic auto compaction mode.
+	ETCDCompactionModePeriodic ETCDCompactionMode = "periodic"
+	// ETCDCompactionModeRevision is a constant for the revision auto compaction mode.
+	ETCDCompactionModeRevision ETCDCompactionMode = "revision"
+)
+
+// ETCDDefragmentation contains configuration for the defragmentation of an etcd.
+type ETCDDefragmentation struct {
+	// IntervalDays is the number of days between two defragmentations. The defragmentation is always scheduled within
+	// the maintenance time window of the Shoot. Defaults to `3` (or `1` for the main etcd of a ManagedSeed).
+	// +optional
+	IntervalDays *int32 `json:"intervalDays,omitempty" protobuf:"varint,1,opt,name=intervalDays"`
+	// DBSizeThreshold is the size of the database above which the etcd is defragmented daily within the maintenance
+	// time window of the Shoot, independent of IntervalDays.
+	// +optional
+	DBSizeThreshold *resource.Quantity `json:"dbSizeThreshold,omitempty" protobuf:"bytes,2,opt,name=dbSizeThreshold"`
 }
 
 // ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
@@ -610,8 +1057,27 @@ type ClusterAutoscaler struct {
 	// Verbosity allows CA to modify its log level (default: 2).
 	// +optional
 	Verbosity *int32 `json:"verbosity,omitempty" protobuf:"varint,14,opt,name=verbosity"`
+	// Profile is the name of a curated set of cluster-autoscaler parameters maintained in the CloudProfile. Parameters
+	// explicitly configured in this section take precedence over the ones of the profile.
+	// Possible values are `conservative`, `balanced` and `aggressive`.
+	// +optional
+	Profile *ClusterAutoscalerProfile `json:"profile,omitempty" protobuf:"bytes,15,opt,name=profile,casttype=ClusterAutoscalerProfile"`
 }
 
+// ClusterAutoscalerProfile is a string alias used for cluster-autoscaler profile names.
+type ClusterAutoscalerProfile string
+
+const (
+	// ClusterAutoscalerProfileConservative is a cluster-autoscaler profile which favors stability over costs, i.e., it
+	// scales down nodes rather slowly.
+	ClusterAutoscalerProfileConservative ClusterAutoscalerProfile = "conservative"
+	// ClusterAutoscalerProfileBalanced is a cluster-autoscaler profile which balances stability and costs.
+	ClusterAutoscalerProfileBalanced ClusterAutoscalerProfile = "balanced"
+	// ClusterAutoscalerProfileAggressive is a cluster-autoscaler profile which favors costs over stability, i.e., it
+	// scales down unneeded nodes quickly.
+	ClusterAutoscalerProfileAggressive ClusterAutoscalerProfile = "aggressive"
+)
+
 // ExpanderMode is type used for Expander values
 type ExpanderMode string
 
@@ -768,6 +1234,21 @@ type KubeAPIServerConfig struct {
 	// EncryptionConfig contains customizable encryption configuration of the Kube API server.
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty" protobuf:"bytes,16,opt,name=encryptionConfig"`
+	// AdditionalSANs contains additional subject alternative names which are added to the serving certificate of the
+	// kube-apiserver, e.g., for clusters which are exposed via custom load balancers or domains.
+	// +optional
+	AdditionalSANs *KubeAPIServerAdditionalSANs `json:"additionalSANs,omitempty" protobuf:"bytes,17,opt,name=additionalSANs"`
+}
+
+// KubeAPIServerAdditionalSANs contains additional subject alternative names for the serving certificate of the
+// kube-apiserver.
+type KubeAPIServerAdditionalSANs struct {
+	// DNSNames is a list of additional DNS names. Wildcard DNS names (e.g., `*.example.com`) are allowed.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty" protobuf:"bytes,1,rep,name=dnsNames"`
+	// IPAddresses is a list of additional IP addresses.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty" protobuf:"bytes,2,rep,name=ipAddresses"`
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -1170,8 +1651,30 @@ type KubeletConfig struct {
 	// MemorySwap configures swap memory available to container workloads.
 	// +optional
 	MemorySwap *MemorySwapConfiguration `json:"memorySwap,omitempty" protobuf:"bytes,26,opt,name=memorySwap"`
+	// CredentialProviders is a list of kubelet image credential provider plugins which are used to dynamically retrieve
+	// credentials for pulling container images, e.g., from private registries.
+	// +optional
+	CredentialProviders []KubeletCredentialProvider `json:"credentialProviders,omitempty" protobuf:"bytes,27,rep,name=credentialProviders"`
+	// ReservationPolicy is the policy for calculating the resources reserved for kubernetes node components. With
+	// `Static`, the configured `kubeReserved` values (or the Gardener defaults) are used. With `Auto`, the values are
+	// derived from the machine type of the worker pool, either from the `kubeReserved` table of the machine type in the
+	// CloudProfile or, if not present, based on the machine's CPU and memory capacity. Explicitly configured
+	// `kubeReserved` values always take precedence.
+	// Defaults to `Static`.
+	// +optional
+	ReservationPolicy *KubeletReservationPolicy `json:"reservationPolicy,omitempty" protobuf:"bytes,28,opt,name=reservationPolicy,casttype=KubeletReservationPolicy"`
 }
 
+// KubeletReservationPolicy is the policy for calculating the resources reserved for kubernetes node components.
+type KubeletReservationPolicy string
+
+const (
+	// KubeletReservationPolicyStatic uses the configured or the default reserved resources.
+	KubeletReservationPolicyStatic KubeletReservationPolicy = "Static"
+	// KubeletReservationPolicyAuto derives the reserved resources from the machine type of the worker pool.
+	KubeletReservationPolicyAuto KubeletReservationPolicy = "Auto"
+)
+
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
 type KubeletConfigEviction struct {
 	// MemoryAvailable is the threshold for the free memory on the host server.
@@ -1270,6 +1773,46 @@ type MemorySwapConfiguration struct {
 	SwapBehavior *SwapBehavior `json:"swapBehavior,omitempty" protobuf:"bytes,1,opt,name=swapBehavior"`
 }
 
+// KubeletCredentialProvider contains the configuration of a kubelet image credential provider plugin.
+type KubeletCredentialProvider struct {
+	// Name is the name of the credential provider. It must match the name of the provider executable on the node.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Image is the container image which contains the credential provider executable. The executable is extracted from
+	// the image to the kubelet's credential provider binary directory on the nodes by gardener-node-agent.
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
+	// FilePathInImage is the path of the credential provider executable in the image.
+	// Defaults to `/<name>`.
+	// +optional
+	FilePathInImage *string `json:"filePathInImage,omitempty" protobuf:"bytes,3,opt,name=filePathInImage"`
+	// MatchImages is a list of patterns used to match against images in order to determine if this provider should be
+	// invoked, see https://kubernetes.io/docs/tasks/administer-cluster/kubelet-credential-provider/#configure-image-matching.
+	MatchImages []string `json:"matchImages" protobuf:"bytes,4,rep,name=matchImages"`
+	// DefaultCacheDuration is the default duration the kubelet caches credentials in-memory if the provider does not
+	// specify a cache duration in its response.
+	// Defaults to 1m.
+	// +optional
+	DefaultCacheDuration *metav1.Duration `json:"defaultCacheDuration,omitempty" protobuf:"bytes,5,opt,name=defaultCacheDuration"`
+	// APIVersion is the input and output version of the CredentialProviderRequest and CredentialProviderResponse
+	// exchanged with the plugin.
+	// Defaults to `credentialprovider.kubelet.k8s.io/v1`.
+	// +optional
+	APIVersion *string `json:"apiVersion,omitempty" protobuf:"bytes,6,opt,name=apiVersion"`
+	// Args are the arguments passed to the credential provider executable.
+	// +optional
+	Args []string `json:"args,omitempty" protobuf:"bytes,7,rep,name=args"`
+	// Env are additional environment variables exposed to the credential provider executable.
+	// +optional
+	Env []KubeletCredentialProviderEnvVar `json:"env,omitempty" protobuf:"bytes,8,rep,name=env"`
+}
+
+// KubeletCredentialProviderEnvVar is an environment variable passed to a kubelet image credential provider plugin.
+type KubeletCredentialProviderEnvVar struct {
+	// Name is the name of the environment variable.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the environment variable.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
 // Networking defines networking parameters for the shoot cluster.
 type Networking struct {
 	// Type identifies the type of the networking plugin. This field is immutable.
@@ -1293,6 +1836,25 @@ type Networking struct {
 	// Defaults to ["IPv4"].
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty" protobuf:"bytes,6,rep,name=ipFamilies,casttype=IPFamily"`
+	// AdditionalNodes is a list of additional CIDRs of the node network, e.g., added after the creation of the cluster
+	// when the initial node network has been exhausted. CIDRs can be added to this list but not removed.
+	// +optional
+	AdditionalNodes []string `json:"additionalNodes,omitempty" protobuf:"bytes,7,rep,name=additionalNodes"`
+	// PrivateLink contains the configuration for connecting the shoot nodes to the control plane in the seed via
+	// private endpoints instead of public load balancers.
+	// +optional
+	PrivateLink *PrivateLink `json:"privateLink,omitempty" protobuf:"bytes,8,opt,name=privateLink"`
+}
+
+// PrivateLink contains the configuration for connecting the shoot nodes to the control plane in the seed via private
+// endpoints (e.g., private link or private endpoint services of the infrastructure provider).
+type PrivateLink struct {
+	// Enabled controls whether the shoot nodes reach the control plane via private endpoints. This requires the seed to
+	// support private link connectivity.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+	// ProviderConfig is the provider specific configuration passed to the PrivateLink extension resource.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,2,opt,name=providerConfig"`
 }
 
 const (
@@ -1323,6 +1885,20 @@ type Maintenance struct {
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	// +optional
 	ConfineSpecUpdateRollout *bool `json:"confineSpecUpdateRollout,omitempty" protobuf:"varint,3,opt,name=confineSpecUpdateRollout"`
+	// DependsOn references another Shoot whose versions gate the automatic version updates of this Shoot.
+	// +optional
+	DependsOn *MaintenanceDependency `json:"dependsOn,omitempty" protobuf:"bytes,4,opt,name=dependsOn"`
+}
+
+// MaintenanceDependency references a Shoot which must successfully run a version before automatic updates to this
+// version are applied to the dependent Shoot.
+type MaintenanceDependency struct {
+	// ShootName is the name of the referenced Shoot in the same namespace.
+	ShootName string `json:"shootName" protobuf:"bytes,1,opt,name=shootName"`
+	// MinimumRunDuration is the duration for which the referenced Shoot must have successfully run a version before
+	// automatic updates to this version are applied.
+	// +optional
+	MinimumRunDuration *metav1.Duration `json:"minimumRunDuration,omitempty" protobuf:"bytes,2,opt,name=minimumRunDuration"`
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1383,6 +1959,12 @@ type Provider struct {
 	// WorkersSettings contains settings for all workers.
 	// +optional
 	WorkersSettings *WorkersSettings `json:"workersSettings,omitempty" protobuf:"bytes,5,opt,name=workersSettings"`
+	// ResourceTags is a map of key/value pairs which are added as tags (or labels, depending on the provider) to all
+	// cloud resources created for the Shoot by the provider extension. The values may contain the placeholders
+	// `$(PROJECT_NAME)` and `$(SHOOT_NAME)`, which are replaced with the name of the Shoot's project and the name of
+	// the Shoot, respectively.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty" protobuf:"bytes,6,rep,name=resourceTags"`
 }
 
 // Worker is the base definition of a worker group.
@@ -1452,6 +2034,9 @@ type Worker struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty" protobuf:"bytes,21,opt,name=clusterAutoscaler"`
+	// Confidential contains settings for running the machines of this worker pool as confidential VMs.
+	// +optional
+	Confidential *WorkerConfidential `json:"confidential,omitempty" protobuf:"bytes,22,opt,name=confidential"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -1473,6 +2058,23 @@ type ClusterAutoscalerOptions struct {
 	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty" protobuf:"bytes,5,opt,name=maxNodeProvisionTime"`
 }
 
+// WorkerConfidential contains settings for running the machines of a worker pool as confidential VMs.
+type WorkerConfidential struct {
+	// Attestation contains settings for the remote attestation of the machines.
+	// +optional
+	Attestation *WorkerConfidentialAttestation `json:"attestation,omitempty" protobuf:"bytes,1,opt,name=attestation"`
+}
+
+// WorkerConfidentialAttestation contains settings for the remote attestation of confidential machines.
+type WorkerConfidentialAttestation struct {
+	// Endpoint is the URL of the attestation service which verifies the attestation reports of the machines.
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+	// Policy is the name of the attestation policy the machines have to satisfy. If not set, the default policy of the
+	// attestation service is used.
+	// +optional
+	Policy *string `json:"policy,omitempty" protobuf:"bytes,2,opt,name=policy"`
+}
+
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
 type MachineControllerManagerSettings struct {
 	// MachineDrainTimeout is the period after which machine is forcefully deleted.
@@ -1523,6 +2125,27 @@ type Machine struct {
 	// Architecture is CPU architecture of machines in this worker pool.
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,3,opt,name=architecture"`
+	// Requirements are the requirements for the machine type of the worker group. If the machine type is not
+	// specified, the smallest machine type of the CloudProfile satisfying the requirements is selected.
+	// +optional
+	Requirements *MachineTypeRequirements `json:"requirements,omitempty" protobuf:"bytes,4,opt,name=requirements"`
+}
+
+// MachineTypeRequirements contains the requirements for the machine type of a worker group.
+type MachineTypeRequirements struct {
+	// CPU is the minimum number of CPUs.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty" protobuf:"bytes,1,opt,name=cpu"`
+	// GPU is the minimum number of GPUs.
+	// +optional
+	GPU *resource.Quantity `json:"gpu,omitempty" protobuf:"bytes,2,opt,name=gpu"`
+	// Memory is the minimum amount of memory.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty" protobuf:"bytes,3,opt,name=memory"`
+	// Capabilities are the capabilities the machine type must provide. Capabilities which are not set or disabled are
+	// not required.
+	// +optional
+	Capabilities *MachineTypeCapabilities `json:"capabilities,omitempty" protobuf:"bytes,4,opt,name=capabilities"`
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1600,6 +2223,12 @@ type WorkersSettings struct {
 	// SSHAccess contains settings regarding ssh access to the worker nodes.
 	// +optional
 	SSHAccess *SSHAccess `json:"sshAccess,omitempty" protobuf:"bytes,1,opt,name=sshAccess"`
+	// Containerd contains settings for containerd which are applied on all worker pools.
+	// +optional
+	Containerd *ContainerdSettings `json:"containerd,omitempty" protobuf:"bytes,2,opt,name=containerd"`
+	// EgressOnlyInternetAccess contains settings regarding the internet access of the worker nodes.
+	// +optional
+	EgressOnlyInternetAccess *EgressOnlyInternetAccess `json:"egressOnlyInternetAccess,omitempty" protobuf:"bytes,3,opt,name=egressOnlyInternetAccess"`
 }
 
 // SSHAccess contains settings regarding ssh access to the worker nodes.
@@ -1609,6 +2238,60 @@ type SSHAccess struct {
 	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
 }
 
+// EgressOnlyInternetAccess contains settings regarding the internet access of the worker nodes.
+type EgressOnlyInternetAccess struct {
+	// Enabled indicates whether the worker nodes are provisioned without public IPs and reach the internet only via
+	// NAT which is set up by the provider extension. This field is immutable.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// ContainerdSettings contains settings for containerd which are applied on all worker pools.
+type ContainerdSettings struct {
+	// Registries is a list of registry host configurations for containerd.
+	// +optional
+	Registries []ContainerdRegistry `json:"registries,omitempty" protobuf:"bytes,1,rep,name=registries"`
+}
+
+// ContainerdRegistry contains the hosts configuration of containerd for an upstream registry.
+type ContainerdRegistry struct {
+	// Upstream is the upstream registry host (and optional port) for which the configuration applies, e.g.
+	// `docker.io` or `my-registry.example.com:5000`.
+	Upstream string `json:"upstream" protobuf:"bytes,1,opt,name=upstream"`
+	// Server is the URL of the upstream registry which is used when none of the hosts can serve a request.
+	// Defaults to `https://<upstream>` (`https://registry-1.docker.io` for `docker.io`).
+	// +optional
+	Server *string `json:"server,omitempty" protobuf:"bytes,2,opt,name=server"`
+	// Hosts is a list of hosts (e.g. mirrors) which are tried in the given order before falling back to the server.
+	// +optional
+	Hosts []ContainerdRegistryHost `json:"hosts,omitempty" protobuf:"bytes,3,rep,name=hosts"`
+}
+
+// ContainerdRegistryHost contains the configuration of containerd for a registry host.
+type ContainerdRegistryHost struct {
+	// URL is the URL of the registry host, e.g. `https://mirror.example.com`.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Capabilities are the operations the host is capable of performing.
+	// Defaults to `["pull", "resolve"]`.
+	// +optional
+	Capabilities []ContainerdRegistryCapability `json:"capabilities,omitempty" protobuf:"bytes,2,rep,name=capabilities,casttype=ContainerdRegistryCapability"`
+	// ResourceName is the name of a resource in `.spec.resources` referring to a secret which contains the CA bundle
+	// (`ca.crt`) and/or the credentials (`username` and `password`, or `token`) for the registry host.
+	// +optional
+	ResourceName *string `json:"resourceName,omitempty" protobuf:"bytes,3,opt,name=resourceName"`
+}
+
+// ContainerdRegistryCapability is an operation a containerd registry host is capable of performing.
+type ContainerdRegistryCapability string
+
+const (
+	// ContainerdRegistryCapabilityPull is the capability to fetch manifests and blobs by digest.
+	ContainerdRegistryCapabilityPull ContainerdRegistryCapability = "pull"
+	// ContainerdRegistryCapabilityResolve is the capability to resolve a name into a digest.
+	ContainerdRegistryCapabilityResolve ContainerdRegistryCapability = "resolve"
+	// ContainerdRegistryCapabilityPush is the capability to push manifests and blobs.
+	ContainerdRegistryCapabilityPush ContainerdRegistryCapability = "push"
+)
+
 var (
 	// DefaultWorkerMaxSurge is the default value for Worker MaxSurge.
 	DefaultWorkerMaxSurge = intstr.FromInt32(1)
@@ -1626,6 +2309,22 @@ type SystemComponents struct {
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	// +optional
 	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty" protobuf:"bytes,2,opt,name=nodeLocalDNS"`
+	// PriorityClasses is a list of additional PriorityClasses which are managed by Gardener in the Shoot cluster. They
+	// can be used by system components deployed by the Shoot owner.
+	// +optional
+	PriorityClasses []SystemComponentsPriorityClass `json:"priorityClasses,omitempty" protobuf:"bytes,3,rep,name=priorityClasses"`
+}
+
+// SystemComponentsPriorityClass is a PriorityClass which is managed by Gardener in the Shoot cluster.
+type SystemComponentsPriorityClass struct {
+	// Name is the name of the PriorityClass.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the priority of the PriorityClass. It must not be higher than 999999000 so that it stays below the
+	// priorities of the PriorityClasses for the Shoot system components managed by Gardener.
+	Value int32 `json:"value" protobuf:"varint,2,opt,name=value"`
+	// Description is an optional description of the PriorityClass.
+	// +optional
+	Description *string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
 }
 
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1683,6 +2382,8 @@ type NodeLocalDNS struct {
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
+	// ShootMaintenanceSkipped indicates that a shoot maintenance operation was skipped.
+	ShootMaintenanceSkipped = "MaintenanceSkipped"
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
@@ -1708,6 +2409,15 @@ const (
 	ShootEveryNodeReady ConditionType = "EveryNodeReady"
 	// ShootSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// ShootObservabilityProbesHealthy is a constant for a condition type indicating the health of the endpoints which are
+	// probed according to the observability probes of the shoot.
+	ShootObservabilityProbesHealthy ConditionType = "ObservabilityProbesHealthy"
+	// ShootReadinessGatesPassed is a constant for a condition type indicating whether the readiness gates of the shoot
+	// pass.
+	ShootReadinessGatesPassed ConditionType = "ReadinessGatesPassed"
+	// ShootCertificateIssuanceExpected is a constant for a condition type indicating that no unexpected certificates
+	// were found in the public certificate transparency logs for the API server domains of the shoot.
+	ShootCertificateIssuanceExpected ConditionType = "CertificateIssuanceExpected"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible ConditionType = "HibernationPossible"
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
@@ -1719,6 +2429,12 @@ const (
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
+	// ShootVersionPolicyCompliant is a constant for a condition type indicating whether the Kubernetes and machine image
+	// versions of the Shoot satisfy the minimum versions declared by all VersionPolicies.
+	ShootVersionPolicyCompliant ConditionType = "VersionPolicyCompliant"
+	// ShootReconciliationPauseAcceptable is a constant for a condition type indicating whether the reconciliation of the
+	// Shoot has not been paused for too long.
+	ShootReconciliationPauseAcceptable ConditionType = "ReconciliationPauseAcceptable"
 )
 
 // ShootPurpose is a type alias for string.
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClone can be used to generate the manifest of a new Shoot based on an existing Shoot. The new Shoot is not
+// created, the returned manifest has to be submitted by the client.
+type ShootClone struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the specification of the ShootClone.
+	Spec ShootCloneSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the ShootClone containing the generated Shoot.
+	// +optional
+	Status ShootCloneStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ShootCloneSpec is the specification of the ShootClone.
+type ShootCloneSpec struct {
+	// Name is the name of the new Shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// CopyWorkers specifies whether the worker pools shall be copied. Defaults to true.
+	// +optional
+	CopyWorkers *bool `json:"copyWorkers,omitempty" protobuf:"varint,2,opt,name=copyWorkers"`
+	// CopyNetworking specifies whether the node, pod, and service networks shall be copied. Defaults to true.
+	// +optional
+	CopyNetworking *bool `json:"copyNetworking,omitempty" protobuf:"varint,3,opt,name=copyNetworking"`
+	// CopyAddons specifies whether the addons and extensions shall be copied. Defaults to true.
+	// +optional
+	CopyAddons *bool `json:"copyAddons,omitempty" protobuf:"varint,4,opt,name=copyAddons"`
+}
+
+// ShootCloneStatus contains the generated Shoot.
+type ShootCloneStatus struct {
+	// Shoot is the manifest of the new Shoot.
+	// +optional
+	Shoot *Shoot `json:"shoot,omitempty" protobuf:"bytes,1,opt,name=shoot"`
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootCostEstimate can be used to estimate the monthly cost of the worker pools of a Shoot based on the prices
+// maintained in its CloudProfile.
+type ShootCostEstimate struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the specification of the ShootCostEstimate.
+	// +optional
+	Spec ShootCostEstimateSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the ShootCostEstimate containing the estimated cost.
+	// +optional
+	Status ShootCostEstimateStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ShootCostEstimateSpec is the specification of the ShootCostEstimate.
+type ShootCostEstimateSpec struct {
+	// Workers is a list of worker pools whose cost shall be estimated. It allows comparing different worker pool
+	// configurations without changing the Shoot. If empty, the worker pools of the Shoot are used.
+	// +optional
+	Workers []Worker `json:"workers,omitempty" protobuf:"bytes,1,rep,name=workers"`
+}
+
+// ShootCostEstimateStatus contains the estimated monthly cost. All costs are decimal numbers rounded to two decimal
+// places and given in the currency of the prices in the CloudProfile.
+type ShootCostEstimateStatus struct {
+	// Workers contains the estimated monthly cost of the individual worker pools.
+	// +optional
+	Workers []WorkerCostEstimate `json:"workers,omitempty" protobuf:"bytes,1,rep,name=workers"`
+	// MinimumMonthlyCost is the estimated monthly cost of all worker pools running with their minimum number of
+	// machines.
+	MinimumMonthlyCost string `json:"minimumMonthlyCost" protobuf:"bytes,2,opt,name=minimumMonthlyCost"`
+	// MaximumMonthlyCost is the estimated monthly cost of all worker pools running with their maximum number of
+	// machines.
+	MaximumMonthlyCost string `json:"maximumMonthlyCost" protobuf:"bytes,3,opt,name=maximumMonthlyCost"`
+	// Warnings contains information about cost which could not be considered in the estimate, e.g., because no price is
+	// maintained in the CloudProfile for a machine or volume type.
+	// +optional
+	Warnings []string `json:"warnings,omitempty" protobuf:"bytes,4,rep,name=warnings"`
+}
+
+// WorkerCostEstimate contains the estimated monthly cost of a worker pool.
+type WorkerCostEstimate struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MinimumMonthlyCost is the estimated monthly cost of the worker pool running with its minimum number of machines.
+	MinimumMonthlyCost string `json:"minimumMonthlyCost" protobuf:"bytes,2,opt,name=minimumMonthlyCost"`
+	// MaximumMonthlyCost is the estimated monthly cost of the worker pool running with its maximum number of machines.
+	MaximumMonthlyCost string `json:"maximumMonthlyCost" protobuf:"bytes,3,opt,name=maximumMonthlyCost"`
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootExport can be used to export a Shoot into a provider-agnostic ShootProfile and, optionally, to import this
+// profile against another CloudProfile.
+type ShootExport struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the specification of the ShootExport.
+	// +optional
+	Spec ShootExportSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the ShootExport containing the exported profile.
+	// +optional
+	Status ShootExportStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ShootExportSpec is the specification of the ShootExport.
+type ShootExportSpec struct {
+	// Target specifies the CloudProfile and region against which the exported profile shall be imported. If not set,
+	// the profile is only exported.
+	// +optional
+	Target *ShootExportTarget `json:"target,omitempty" protobuf:"bytes,1,opt,name=target"`
+}
+
+// ShootExportTarget specifies the CloudProfile and region against which an exported profile shall be imported.
+type ShootExportTarget struct {
+	// CloudProfileName is the name of the CloudProfile.
+	CloudProfileName string `json:"cloudProfileName" protobuf:"bytes,1,opt,name=cloudProfileName"`
+	// Region is the name of the region.
+	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
+}
+
+// ShootExportStatus contains the exported profile and the imported Shoot.
+type ShootExportStatus struct {
+	// Profile is the provider-agnostic profile of the Shoot.
+	// +optional
+	Profile *ShootProfile `json:"profile,omitempty" protobuf:"bytes,1,opt,name=profile"`
+	// Shoot is the manifest of the Shoot imported against the target CloudProfile.
+	// +optional
+	Shoot *Shoot `json:"shoot,omitempty" protobuf:"bytes,2,opt,name=shoot"`
+	// Warnings contains information about aspects of the profile that could not be imported exactly.
+	// +optional
+	Warnings []string `json:"warnings,omitempty" protobuf:"bytes,3,rep,name=warnings"`
+}
+
+// ShootProfile is a provider-agnostic representation of the specification of a Shoot. Machine types are abstracted to
+// capability requirements so that the profile can be imported against CloudProfiles of other providers.
+type ShootProfile struct {
+	// Version is the version of the profile format.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// KubernetesVersion is the Kubernetes version of the Shoot.
+	KubernetesVersion string `json:"kubernetesVersion" protobuf:"bytes,2,opt,name=kubernetesVersion"`
+	// Purpose is the purpose of the Shoot.
+	// +optional
+	Purpose *ShootPurpose `json:"purpose,omitempty" protobuf:"bytes,3,opt,name=purpose,casttype=ShootPurpose"`
+	// ControlPlane contains general settings for the control plane of the Shoot.
+	// +optional
+	ControlPlane *ControlPlane `json:"controlPlane,omitempty" protobuf:"bytes,4,opt,name=controlPlane"`
+	// Networking contains information about the networking of the Shoot.
+	// +optional
+	Networking *Networking `json:"networking,omitempty" protobuf:"bytes,5,opt,name=networking"`
+	// Maintenance contains information about the maintenance of the Shoot.
+	// +optional
+	Maintenance *Maintenance `json:"maintenance,omitempty" protobuf:"bytes,6,opt,name=maintenance"`
+	// Hibernation contains information about the hibernation of the Shoot.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty" protobuf:"bytes,7,opt,name=hibernation"`
+	// Workers is a list of provider-agnostic worker pools.
+	// +optional
+	Workers []WorkerProfile `json:"workers,omitempty" protobuf:"bytes,8,rep,name=workers"`
+}
+
+// WorkerProfile is a provider-agnostic representation of a worker pool.
+type WorkerProfile struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Machine contains the capability requirements for the machines of the worker pool.
+	Machine MachineRequirements `json:"machine" protobuf:"bytes,2,opt,name=machine"`
+	// Volume contains the requirements for the root volumes of the machines.
+	// +optional
+	Volume *VolumeRequirements `json:"volume,omitempty" protobuf:"bytes,3,opt,name=volume"`
+	// Minimum is the minimum number of machines.
+	Minimum int32 `json:"minimum" protobuf:"varint,4,opt,name=minimum"`
+	// Maximum is the maximum number of machines.
+	Maximum int32 `json:"maximum" protobuf:"varint,5,opt,name=maximum"`
+	// ZoneCount is the number of zones the worker pool is spread across.
+	// +optional
+	ZoneCount int32 `json:"zoneCount,omitempty" protobuf:"varint,6,opt,name=zoneCount"`
+	// Labels is a map of key/value pairs for labels applied to all the nodes in the worker pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,7,rep,name=labels"`
+	// Taints is a list of taints applied to all the nodes in the worker pool.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty" protobuf:"bytes,8,rep,name=taints"`
+}
+
+// MachineRequirements contains the capability requirements for machines.
+type MachineRequirements struct {
+	// CPU is the minimum number of CPUs.
+	CPU resource.Quantity `json:"cpu" protobuf:"bytes,1,opt,name=cpu"`
+	// GPU is the minimum number of GPUs.
+	GPU resource.Quantity `json:"gpu" protobuf:"bytes,2,opt,name=gpu"`
+	// Memory is the minimum amount of memory.
+	Memory resource.Quantity `json:"memory" protobuf:"bytes,3,opt,name=memory"`
+	// Architecture is the CPU architecture.
+	// +optional
+	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,4,opt,name=architecture"`
+	// Capabilities are the capabilities the machines must provide.
+	// +optional
+	Capabilities *MachineTypeCapabilities `json:"capabilities,omitempty" protobuf:"bytes,5,opt,name=capabilities"`
+}
+
+// VolumeRequirements contains the requirements for volumes.
+type VolumeRequirements struct {
+	// VolumeSize is the size of the volume.
+	VolumeSize string `json:"size" protobuf:"bytes,1,opt,name=size"`
+	// Class is the class of the volume type.
+	// +optional
+	Class *string `json:"class,omitempty" protobuf:"bytes,2,opt,name=class"`
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:onlyVerbs=get,list,watch,delete
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRevision holds the history of the changes of the specification of the Shoot with the same name. It is
+// maintained by the gardener-apiserver and cannot be created or modified by clients.
+type ShootRevision struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Revisions is the list of the recorded changes of the Shoot specification, ordered from the oldest to the newest
+	// change. Only the most recent changes are kept.
+	// +optional
+	Revisions []ShootSpecChange `json:"revisions,omitempty" protobuf:"bytes,2,rep,name=revisions"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootRevisionList is a collection of ShootRevisions.
+type ShootRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ShootRevisions.
+	Items []ShootRevision `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootSpecChange is a change of the specification of a Shoot.
+type ShootSpecChange struct {
+	// Generation is the generation of the Shoot after the change.
+	Generation int64 `json:"generation" protobuf:"varint,1,opt,name=generation"`
+	// Timestamp is the time when the change was made.
+	Timestamp metav1.Time `json:"timestamp" protobuf:"bytes,2,opt,name=timestamp"`
+	// User is the name of the user who made the change.
+	User string `json:"user" protobuf:"bytes,3,opt,name=user"`
+	// Patch is the JSON patch (RFC 6902) transforming the previous specification of the Shoot into the changed one.
+	Patch string `json:"patch" protobuf:"bytes,4,opt,name=patch"`
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VersionPolicy declares garden-wide minimum Kubernetes and machine image versions for Shoots.
+type VersionPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the specification of the VersionPolicy.
+	Spec VersionPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VersionPolicyList is a collection of VersionPolicies.
+type VersionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of VersionPolicies.
+	Items []VersionPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// VersionPolicySpec is the specification of a VersionPolicy.
+type VersionPolicySpec struct {
+	// Kubernetes contains the minimum Kubernetes version of the control plane and of all worker pools.
+	// +optional
+	Kubernetes *KubernetesVersionPolicy `json:"kubernetes,omitempty" protobuf:"bytes,1,opt,name=kubernetes"`
+	// MachineImages contains the minimum versions of machine images.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	MachineImages []MachineImageVersionPolicy `json:"machineImages,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=machineImages"`
+	// ForceUpdate indicates whether Shoots violating the policy are forcefully updated during their next maintenance
+	// time window, similar to Shoots using an expired version of their CloudProfile.
+	// +optional
+	ForceUpdate bool `json:"forceUpdate,omitempty" protobuf:"varint,3,opt,name=forceUpdate"`
+}
+
+// KubernetesVersionPolicy contains the minimum Kubernetes version.
+type KubernetesVersionPolicy struct {
+	// MinimumVersion is the minimum allowed Kubernetes version.
+	MinimumVersion string `json:"minimumVersion" protobuf:"bytes,1,opt,name=minimumVersion"`
+}
+
+// MachineImageVersionPolicy contains the minimum version of a machine image.
+type MachineImageVersionPolicy struct {
+	// Name is the name of the machine image.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MinimumVersion is the minimum allowed version of the machine image.
+	MinimumVersion string `json:"minimumVersion" protobuf:"bytes,2,opt,name=minimumVersion"`
+}
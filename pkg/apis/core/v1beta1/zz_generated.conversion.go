@@ -61,6 +61,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AddonChart)(nil), (*core.AddonChart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AddonChart_To_core_AddonChart(a.(*AddonChart), b.(*core.AddonChart), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AddonChart)(nil), (*AddonChart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AddonChart_To_v1beta1_AddonChart(a.(*core.AddonChart), b.(*AddonChart), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Addons)(nil), (*core.Addons)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Addons_To_core_Addons(a.(*Addons), b.(*core.Addons), scope)
 	}); err != nil {
@@ -81,6 +91,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlertReceiver)(nil), (*core.AlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertReceiver_To_core_AlertReceiver(a.(*AlertReceiver), b.(*core.AlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertReceiver)(nil), (*AlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertReceiver_To_v1beta1_AlertReceiver(a.(*core.AlertReceiver), b.(*AlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertRoute)(nil), (*core.AlertRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertRoute_To_core_AlertRoute(a.(*AlertRoute), b.(*core.AlertRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertRoute)(nil), (*AlertRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertRoute_To_v1beta1_AlertRoute(a.(*core.AlertRoute), b.(*AlertRoute), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*core.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Alerting_To_core_Alerting(a.(*Alerting), b.(*core.Alerting), scope)
 	}); err != nil {
@@ -291,6 +321,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAutoscalerProfileSettings)(nil), (*core.ClusterAutoscalerProfileSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAutoscalerProfileSettings_To_core_ClusterAutoscalerProfileSettings(a.(*ClusterAutoscalerProfileSettings), b.(*core.ClusterAutoscalerProfileSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ClusterAutoscalerProfileSettings)(nil), (*ClusterAutoscalerProfileSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ClusterAutoscalerProfileSettings_To_v1beta1_ClusterAutoscalerProfileSettings(a.(*core.ClusterAutoscalerProfileSettings), b.(*ClusterAutoscalerProfileSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*core.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Condition_To_core_Condition(a.(*Condition), b.(*core.Condition), scope)
 	}); err != nil {
@@ -311,6 +351,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdRegistry)(nil), (*core.ContainerdRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(a.(*ContainerdRegistry), b.(*core.ContainerdRegistry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ContainerdRegistry)(nil), (*ContainerdRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(a.(*core.ContainerdRegistry), b.(*ContainerdRegistry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdRegistryHost)(nil), (*core.ContainerdRegistryHost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerdRegistryHost_To_core_ContainerdRegistryHost(a.(*ContainerdRegistryHost), b.(*core.ContainerdRegistryHost), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ContainerdRegistryHost)(nil), (*ContainerdRegistryHost)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ContainerdRegistryHost_To_v1beta1_ContainerdRegistryHost(a.(*core.ContainerdRegistryHost), b.(*ContainerdRegistryHost), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContainerdSettings)(nil), (*core.ContainerdSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerdSettings_To_core_ContainerdSettings(a.(*ContainerdSettings), b.(*core.ContainerdSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ContainerdSettings)(nil), (*ContainerdSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ContainerdSettings_To_v1beta1_ContainerdSettings(a.(*core.ContainerdSettings), b.(*ContainerdSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlane)(nil), (*core.ControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControlPlane_To_core_ControlPlane(a.(*ControlPlane), b.(*core.ControlPlane), scope)
 	}); err != nil {
@@ -521,6 +591,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCD)(nil), (*core.ETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCD_To_core_ETCD(a.(*ETCD), b.(*core.ETCD), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCD)(nil), (*ETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCD_To_v1beta1_ETCD(a.(*core.ETCD), b.(*ETCD), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDCompaction)(nil), (*core.ETCDCompaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDCompaction_To_core_ETCDCompaction(a.(*ETCDCompaction), b.(*core.ETCDCompaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDCompaction)(nil), (*ETCDCompaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDCompaction_To_v1beta1_ETCDCompaction(a.(*core.ETCDCompaction), b.(*ETCDCompaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDConfig)(nil), (*core.ETCDConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDConfig_To_core_ETCDConfig(a.(*ETCDConfig), b.(*core.ETCDConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDConfig)(nil), (*ETCDConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDConfig_To_v1beta1_ETCDConfig(a.(*core.ETCDConfig), b.(*ETCDConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDDefragmentation)(nil), (*core.ETCDDefragmentation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDDefragmentation_To_core_ETCDDefragmentation(a.(*ETCDDefragmentation), b.(*core.ETCDDefragmentation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDDefragmentation)(nil), (*ETCDDefragmentation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDDefragmentation_To_v1beta1_ETCDDefragmentation(a.(*core.ETCDDefragmentation), b.(*ETCDDefragmentation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDDefragmentationStatus)(nil), (*core.ETCDDefragmentationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDDefragmentationStatus_To_core_ETCDDefragmentationStatus(a.(*ETCDDefragmentationStatus), b.(*core.ETCDDefragmentationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDDefragmentationStatus)(nil), (*ETCDDefragmentationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDDefragmentationStatus_To_v1beta1_ETCDDefragmentationStatus(a.(*core.ETCDDefragmentationStatus), b.(*ETCDDefragmentationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ETCDEncryptionKeyRotation)(nil), (*core.ETCDEncryptionKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation(a.(*ETCDEncryptionKeyRotation), b.(*core.ETCDEncryptionKeyRotation), scope)
 	}); err != nil {
@@ -531,6 +651,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCDStatus)(nil), (*core.ETCDStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDStatus_To_core_ETCDStatus(a.(*ETCDStatus), b.(*core.ETCDStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDStatus)(nil), (*ETCDStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDStatus_To_v1beta1_ETCDStatus(a.(*core.ETCDStatus), b.(*ETCDStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressOnlyInternetAccess)(nil), (*core.EgressOnlyInternetAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EgressOnlyInternetAccess_To_core_EgressOnlyInternetAccess(a.(*EgressOnlyInternetAccess), b.(*core.EgressOnlyInternetAccess), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EgressOnlyInternetAccess)(nil), (*EgressOnlyInternetAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EgressOnlyInternetAccess_To_v1beta1_EgressOnlyInternetAccess(a.(*core.EgressOnlyInternetAccess), b.(*EgressOnlyInternetAccess), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EmailAlertReceiver)(nil), (*core.EmailAlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EmailAlertReceiver_To_core_EmailAlertReceiver(a.(*EmailAlertReceiver), b.(*core.EmailAlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EmailAlertReceiver)(nil), (*EmailAlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EmailAlertReceiver_To_v1beta1_EmailAlertReceiver(a.(*core.EmailAlertReceiver), b.(*EmailAlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EncryptionConfig)(nil), (*core.EncryptionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(a.(*EncryptionConfig), b.(*core.EncryptionConfig), scope)
 	}); err != nil {
@@ -541,6 +691,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EventArchive)(nil), (*core.EventArchive)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EventArchive_To_core_EventArchive(a.(*EventArchive), b.(*core.EventArchive), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EventArchive)(nil), (*EventArchive)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EventArchive_To_v1beta1_EventArchive(a.(*core.EventArchive), b.(*EventArchive), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventArchiveSink)(nil), (*core.EventArchiveSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EventArchiveSink_To_core_EventArchiveSink(a.(*EventArchiveSink), b.(*core.EventArchiveSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EventArchiveSink)(nil), (*EventArchiveSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EventArchiveSink_To_v1beta1_EventArchiveSink(a.(*core.EventArchiveSink), b.(*EventArchiveSink), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExpirableVersion)(nil), (*core.ExpirableVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(a.(*ExpirableVersion), b.(*core.ExpirableVersion), scope)
 	}); err != nil {
@@ -561,6 +731,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExposureClassHandlerAutoscaling)(nil), (*core.ExposureClassHandlerAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExposureClassHandlerAutoscaling_To_core_ExposureClassHandlerAutoscaling(a.(*ExposureClassHandlerAutoscaling), b.(*core.ExposureClassHandlerAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExposureClassHandlerAutoscaling)(nil), (*ExposureClassHandlerAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExposureClassHandlerAutoscaling_To_v1beta1_ExposureClassHandlerAutoscaling(a.(*core.ExposureClassHandlerAutoscaling), b.(*ExposureClassHandlerAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExposureClassHandlerStatus)(nil), (*core.ExposureClassHandlerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExposureClassHandlerStatus_To_core_ExposureClassHandlerStatus(a.(*ExposureClassHandlerStatus), b.(*core.ExposureClassHandlerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExposureClassHandlerStatus)(nil), (*ExposureClassHandlerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExposureClassHandlerStatus_To_v1beta1_ExposureClassHandlerStatus(a.(*core.ExposureClassHandlerStatus), b.(*ExposureClassHandlerStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassList)(nil), (*core.ExposureClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExposureClassList_To_core_ExposureClassList(a.(*ExposureClassList), b.(*core.ExposureClassList), scope)
 	}); err != nil {
@@ -611,6 +801,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FeatureGateStatus)(nil), (*core.FeatureGateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FeatureGateStatus_To_core_FeatureGateStatus(a.(*FeatureGateStatus), b.(*core.FeatureGateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FeatureGateStatus)(nil), (*FeatureGateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FeatureGateStatus_To_v1beta1_FeatureGateStatus(a.(*core.FeatureGateStatus), b.(*FeatureGateStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Gardener)(nil), (*core.Gardener)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Gardener_To_core_Gardener(a.(*Gardener), b.(*core.Gardener), scope)
 	}); err != nil {
@@ -671,6 +871,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageOverride)(nil), (*core.ImageOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ImageOverride_To_core_ImageOverride(a.(*ImageOverride), b.(*core.ImageOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImageOverride)(nil), (*ImageOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImageOverride_To_v1beta1_ImageOverride(a.(*core.ImageOverride), b.(*ImageOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImageVectorOverride)(nil), (*core.ImageVectorOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ImageVectorOverride_To_core_ImageVectorOverride(a.(*ImageVectorOverride), b.(*core.ImageVectorOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImageVectorOverride)(nil), (*ImageVectorOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImageVectorOverride_To_v1beta1_ImageVectorOverride(a.(*core.ImageVectorOverride), b.(*ImageVectorOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImageVectorOverrideList)(nil), (*core.ImageVectorOverrideList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ImageVectorOverrideList_To_core_ImageVectorOverrideList(a.(*ImageVectorOverrideList), b.(*core.ImageVectorOverrideList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImageVectorOverrideList)(nil), (*ImageVectorOverrideList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImageVectorOverrideList_To_v1beta1_ImageVectorOverrideList(a.(*core.ImageVectorOverrideList), b.(*ImageVectorOverrideList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImageVectorOverrideSpec)(nil), (*core.ImageVectorOverrideSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ImageVectorOverrideSpec_To_core_ImageVectorOverrideSpec(a.(*ImageVectorOverrideSpec), b.(*core.ImageVectorOverrideSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImageVectorOverrideSpec)(nil), (*ImageVectorOverrideSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImageVectorOverrideSpec_To_v1beta1_ImageVectorOverrideSpec(a.(*core.ImageVectorOverrideSpec), b.(*ImageVectorOverrideSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Ingress)(nil), (*core.Ingress)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Ingress_To_core_Ingress(a.(*Ingress), b.(*core.Ingress), scope)
 	}); err != nil {
@@ -706,6 +946,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeAPIServerAdditionalSANs)(nil), (*core.KubeAPIServerAdditionalSANs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeAPIServerAdditionalSANs_To_core_KubeAPIServerAdditionalSANs(a.(*KubeAPIServerAdditionalSANs), b.(*core.KubeAPIServerAdditionalSANs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeAPIServerAdditionalSANs)(nil), (*KubeAPIServerAdditionalSANs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeAPIServerAdditionalSANs_To_v1beta1_KubeAPIServerAdditionalSANs(a.(*core.KubeAPIServerAdditionalSANs), b.(*KubeAPIServerAdditionalSANs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerConfig)(nil), (*core.KubeAPIServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(a.(*KubeAPIServerConfig), b.(*core.KubeAPIServerConfig), scope)
 	}); err != nil {
@@ -796,6 +1046,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletCredentialProvider)(nil), (*core.KubeletCredentialProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(a.(*KubeletCredentialProvider), b.(*core.KubeletCredentialProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeletCredentialProvider)(nil), (*KubeletCredentialProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(a.(*core.KubeletCredentialProvider), b.(*KubeletCredentialProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeletCredentialProviderEnvVar)(nil), (*core.KubeletCredentialProviderEnvVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(a.(*KubeletCredentialProviderEnvVar), b.(*core.KubeletCredentialProviderEnvVar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeletCredentialProviderEnvVar)(nil), (*KubeletCredentialProviderEnvVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(a.(*core.KubeletCredentialProviderEnvVar), b.(*KubeletCredentialProviderEnvVar), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Kubernetes)(nil), (*core.Kubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Kubernetes_To_core_Kubernetes(a.(*Kubernetes), b.(*core.Kubernetes), scope)
 	}); err != nil {
@@ -836,6 +1106,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubernetesVersionPolicy)(nil), (*core.KubernetesVersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubernetesVersionPolicy_To_core_KubernetesVersionPolicy(a.(*KubernetesVersionPolicy), b.(*core.KubernetesVersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubernetesVersionPolicy)(nil), (*KubernetesVersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubernetesVersionPolicy_To_v1beta1_KubernetesVersionPolicy(a.(*core.KubernetesVersionPolicy), b.(*KubernetesVersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LastError)(nil), (*core.LastError)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_LastError_To_core_LastError(a.(*LastError), b.(*core.LastError), scope)
 	}); err != nil {
@@ -866,6 +1146,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LeftoverResource)(nil), (*core.LeftoverResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LeftoverResource_To_core_LeftoverResource(a.(*LeftoverResource), b.(*core.LeftoverResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LeftoverResource)(nil), (*LeftoverResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LeftoverResource_To_v1beta1_LeftoverResource(a.(*core.LeftoverResource), b.(*LeftoverResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LeftoverResources)(nil), (*core.LeftoverResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LeftoverResources_To_core_LeftoverResources(a.(*LeftoverResources), b.(*core.LeftoverResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LeftoverResources)(nil), (*LeftoverResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LeftoverResources_To_v1beta1_LeftoverResources(a.(*core.LeftoverResources), b.(*LeftoverResources), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerServicesProxyProtocol)(nil), (*core.LoadBalancerServicesProxyProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_LoadBalancerServicesProxyProtocol_To_core_LoadBalancerServicesProxyProtocol(a.(*LoadBalancerServicesProxyProtocol), b.(*core.LoadBalancerServicesProxyProtocol), scope)
 	}); err != nil {
@@ -906,6 +1206,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageRunningVersion)(nil), (*core.MachineImageRunningVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageRunningVersion_To_core_MachineImageRunningVersion(a.(*MachineImageRunningVersion), b.(*core.MachineImageRunningVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageRunningVersion)(nil), (*MachineImageRunningVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageRunningVersion_To_v1beta1_MachineImageRunningVersion(a.(*core.MachineImageRunningVersion), b.(*MachineImageRunningVersion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*core.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(a.(*MachineImageVersion), b.(*core.MachineImageVersion), scope)
 	}); err != nil {
@@ -916,6 +1226,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageVersionPolicy)(nil), (*core.MachineImageVersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageVersionPolicy_To_core_MachineImageVersionPolicy(a.(*MachineImageVersionPolicy), b.(*core.MachineImageVersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageVersionPolicy)(nil), (*MachineImageVersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageVersionPolicy_To_v1beta1_MachineImageVersionPolicy(a.(*core.MachineImageVersionPolicy), b.(*MachineImageVersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineRequirements)(nil), (*core.MachineRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineRequirements_To_core_MachineRequirements(a.(*MachineRequirements), b.(*core.MachineRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineRequirements)(nil), (*MachineRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineRequirements_To_v1beta1_MachineRequirements(a.(*core.MachineRequirements), b.(*MachineRequirements), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineType)(nil), (*core.MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineType_To_core_MachineType(a.(*MachineType), b.(*core.MachineType), scope)
 	}); err != nil {
@@ -926,6 +1256,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineTypeCapabilities)(nil), (*core.MachineTypeCapabilities)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineTypeCapabilities_To_core_MachineTypeCapabilities(a.(*MachineTypeCapabilities), b.(*core.MachineTypeCapabilities), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineTypeCapabilities)(nil), (*MachineTypeCapabilities)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineTypeCapabilities_To_v1beta1_MachineTypeCapabilities(a.(*core.MachineTypeCapabilities), b.(*MachineTypeCapabilities), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineTypeRequirements)(nil), (*core.MachineTypeRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineTypeRequirements_To_core_MachineTypeRequirements(a.(*MachineTypeRequirements), b.(*core.MachineTypeRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineTypeRequirements)(nil), (*MachineTypeRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineTypeRequirements_To_v1beta1_MachineTypeRequirements(a.(*core.MachineTypeRequirements), b.(*MachineTypeRequirements), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineTypeStorage)(nil), (*core.MachineTypeStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineTypeStorage_To_core_MachineTypeStorage(a.(*MachineTypeStorage), b.(*core.MachineTypeStorage), scope)
 	}); err != nil {
@@ -956,6 +1306,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceDependency)(nil), (*core.MaintenanceDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceDependency_To_core_MaintenanceDependency(a.(*MaintenanceDependency), b.(*core.MaintenanceDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceDependency)(nil), (*MaintenanceDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceDependency_To_v1beta1_MaintenanceDependency(a.(*core.MaintenanceDependency), b.(*MaintenanceDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePlannedChange)(nil), (*core.MaintenancePlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenancePlannedChange_To_core_MaintenancePlannedChange(a.(*MaintenancePlannedChange), b.(*core.MaintenancePlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePlannedChange)(nil), (*MaintenancePlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePlannedChange_To_v1beta1_MaintenancePlannedChange(a.(*core.MaintenancePlannedChange), b.(*MaintenancePlannedChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1046,6 +1416,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkingStatus)(nil), (*core.NetworkingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(a.(*NetworkingStatus), b.(*core.NetworkingStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NetworkingStatus)(nil), (*NetworkingStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(a.(*core.NetworkingStatus), b.(*NetworkingStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NginxIngress)(nil), (*core.NginxIngress)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NginxIngress_To_core_NginxIngress(a.(*NginxIngress), b.(*core.NginxIngress), scope)
 	}); err != nil {
@@ -1086,6 +1466,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Observability)(nil), (*core.Observability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Observability_To_core_Observability(a.(*Observability), b.(*core.Observability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Observability)(nil), (*Observability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Observability_To_v1beta1_Observability(a.(*core.Observability), b.(*Observability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObservabilityLogging)(nil), (*core.ObservabilityLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ObservabilityLogging_To_core_ObservabilityLogging(a.(*ObservabilityLogging), b.(*core.ObservabilityLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ObservabilityLogging)(nil), (*ObservabilityLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ObservabilityLogging_To_v1beta1_ObservabilityLogging(a.(*core.ObservabilityLogging), b.(*ObservabilityLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObservabilityProbe)(nil), (*core.ObservabilityProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ObservabilityProbe_To_core_ObservabilityProbe(a.(*ObservabilityProbe), b.(*core.ObservabilityProbe), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ObservabilityProbe)(nil), (*ObservabilityProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ObservabilityProbe_To_v1beta1_ObservabilityProbe(a.(*core.ObservabilityProbe), b.(*ObservabilityProbe), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ObservabilityRotation)(nil), (*core.ObservabilityRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(a.(*ObservabilityRotation), b.(*core.ObservabilityRotation), scope)
 	}); err != nil {
@@ -1106,6 +1516,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PrivateLink)(nil), (*core.PrivateLink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PrivateLink_To_core_PrivateLink(a.(*PrivateLink), b.(*core.PrivateLink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PrivateLink)(nil), (*PrivateLink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PrivateLink_To_v1beta1_PrivateLink(a.(*core.PrivateLink), b.(*PrivateLink), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1116,13 +1536,33 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
+	if err := s.AddGeneratedConversionFunc((*ProjectCustomRole)(nil), (*core.ProjectCustomRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectCustomRole_To_core_ProjectCustomRole(a.(*ProjectCustomRole), b.(*core.ProjectCustomRole), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.ProjectList)(nil), (*ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_ProjectList_To_v1beta1_ProjectList(a.(*core.ProjectList), b.(*ProjectList), scope)
+	if err := s.AddGeneratedConversionFunc((*core.ProjectCustomRole)(nil), (*ProjectCustomRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectCustomRole_To_v1beta1_ProjectCustomRole(a.(*core.ProjectCustomRole), b.(*ProjectCustomRole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectList)(nil), (*ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectList_To_v1beta1_ProjectList(a.(*core.ProjectList), b.(*ProjectList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectNamespaceResources)(nil), (*core.ProjectNamespaceResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectNamespaceResources_To_core_ProjectNamespaceResources(a.(*ProjectNamespaceResources), b.(*core.ProjectNamespaceResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectNamespaceResources)(nil), (*ProjectNamespaceResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectNamespaceResources_To_v1beta1_ProjectNamespaceResources(a.(*core.ProjectNamespaceResources), b.(*ProjectNamespaceResources), scope)
 	}); err != nil {
 		return err
 	}
@@ -1216,6 +1656,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RunningVersion)(nil), (*core.RunningVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RunningVersion_To_core_RunningVersion(a.(*RunningVersion), b.(*core.RunningVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RunningVersion)(nil), (*RunningVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RunningVersion_To_v1beta1_RunningVersion(a.(*core.RunningVersion), b.(*RunningVersion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHAccess)(nil), (*core.SSHAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SSHAccess_To_core_SSHAccess(a.(*SSHAccess), b.(*core.SSHAccess), scope)
 	}); err != nil {
@@ -1296,6 +1746,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDecommissionStatus)(nil), (*core.SeedDecommissionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDecommissionStatus_To_core_SeedDecommissionStatus(a.(*SeedDecommissionStatus), b.(*core.SeedDecommissionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedDecommissionStatus)(nil), (*SeedDecommissionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedDecommissionStatus_To_v1beta1_SeedDecommissionStatus(a.(*core.SeedDecommissionStatus), b.(*SeedDecommissionStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*core.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_core_SeedList(a.(*SeedList), b.(*core.SeedList), scope)
 	}); err != nil {
@@ -1336,6 +1796,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingAPIServerProxy)(nil), (*core.SeedSettingAPIServerProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingAPIServerProxy_To_core_SeedSettingAPIServerProxy(a.(*SeedSettingAPIServerProxy), b.(*core.SeedSettingAPIServerProxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingAPIServerProxy)(nil), (*SeedSettingAPIServerProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingAPIServerProxy_To_v1beta1_SeedSettingAPIServerProxy(a.(*core.SeedSettingAPIServerProxy), b.(*SeedSettingAPIServerProxy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingDependencyWatchdog)(nil), (*core.SeedSettingDependencyWatchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(a.(*SeedSettingDependencyWatchdog), b.(*core.SeedSettingDependencyWatchdog), scope)
 	}); err != nil {
@@ -1386,6 +1856,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingExcessCapacityReservationProfile)(nil), (*core.SeedSettingExcessCapacityReservationProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingExcessCapacityReservationProfile_To_core_SeedSettingExcessCapacityReservationProfile(a.(*SeedSettingExcessCapacityReservationProfile), b.(*core.SeedSettingExcessCapacityReservationProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingExcessCapacityReservationProfile)(nil), (*SeedSettingExcessCapacityReservationProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingExcessCapacityReservationProfile_To_v1beta1_SeedSettingExcessCapacityReservationProfile(a.(*core.SeedSettingExcessCapacityReservationProfile), b.(*SeedSettingExcessCapacityReservationProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingLoadBalancerServices)(nil), (*core.SeedSettingLoadBalancerServices)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(a.(*SeedSettingLoadBalancerServices), b.(*core.SeedSettingLoadBalancerServices), scope)
 	}); err != nil {
@@ -1406,6 +1886,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingPrivateLink)(nil), (*core.SeedSettingPrivateLink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingPrivateLink_To_core_SeedSettingPrivateLink(a.(*SeedSettingPrivateLink), b.(*core.SeedSettingPrivateLink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingPrivateLink)(nil), (*SeedSettingPrivateLink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingPrivateLink_To_v1beta1_SeedSettingPrivateLink(a.(*core.SeedSettingPrivateLink), b.(*SeedSettingPrivateLink), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingScheduling)(nil), (*core.SeedSettingScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingScheduling_To_core_SeedSettingScheduling(a.(*SeedSettingScheduling), b.(*core.SeedSettingScheduling), scope)
 	}); err != nil {
@@ -1466,6 +1956,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSystemComponent)(nil), (*core.SeedSystemComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSystemComponent_To_core_SeedSystemComponent(a.(*SeedSystemComponent), b.(*core.SeedSystemComponent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSystemComponent)(nil), (*SeedSystemComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSystemComponent_To_v1beta1_SeedSystemComponent(a.(*core.SeedSystemComponent), b.(*SeedSystemComponent), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedTaint)(nil), (*core.SeedTaint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedTaint_To_core_SeedTaint(a.(*SeedTaint), b.(*core.SeedTaint), scope)
 	}); err != nil {
@@ -1546,6 +2046,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAvailability)(nil), (*core.ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAvailability_To_core_ShootAvailability(a.(*ShootAvailability), b.(*core.ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootAvailability)(nil), (*ShootAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootAvailability_To_v1beta1_ShootAvailability(a.(*core.ShootAvailability), b.(*ShootAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootClone)(nil), (*core.ShootClone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootClone_To_core_ShootClone(a.(*ShootClone), b.(*core.ShootClone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootClone)(nil), (*ShootClone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootClone_To_v1beta1_ShootClone(a.(*core.ShootClone), b.(*ShootClone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCloneSpec)(nil), (*core.ShootCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCloneSpec_To_core_ShootCloneSpec(a.(*ShootCloneSpec), b.(*core.ShootCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCloneSpec)(nil), (*ShootCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCloneSpec_To_v1beta1_ShootCloneSpec(a.(*core.ShootCloneSpec), b.(*ShootCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCloneStatus)(nil), (*core.ShootCloneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCloneStatus_To_core_ShootCloneStatus(a.(*ShootCloneStatus), b.(*core.ShootCloneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCloneStatus)(nil), (*ShootCloneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCloneStatus_To_v1beta1_ShootCloneStatus(a.(*core.ShootCloneStatus), b.(*ShootCloneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCostEstimate)(nil), (*core.ShootCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(a.(*ShootCostEstimate), b.(*core.ShootCostEstimate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCostEstimate)(nil), (*ShootCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(a.(*core.ShootCostEstimate), b.(*ShootCostEstimate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCostEstimateSpec)(nil), (*core.ShootCostEstimateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCostEstimateSpec_To_core_ShootCostEstimateSpec(a.(*ShootCostEstimateSpec), b.(*core.ShootCostEstimateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCostEstimateSpec)(nil), (*ShootCostEstimateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCostEstimateSpec_To_v1beta1_ShootCostEstimateSpec(a.(*core.ShootCostEstimateSpec), b.(*ShootCostEstimateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCostEstimateStatus)(nil), (*core.ShootCostEstimateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootCostEstimateStatus_To_core_ShootCostEstimateStatus(a.(*ShootCostEstimateStatus), b.(*core.ShootCostEstimateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCostEstimateStatus)(nil), (*ShootCostEstimateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCostEstimateStatus_To_v1beta1_ShootCostEstimateStatus(a.(*core.ShootCostEstimateStatus), b.(*ShootCostEstimateStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -1566,6 +2136,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootETCDStatus)(nil), (*core.ShootETCDStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootETCDStatus_To_core_ShootETCDStatus(a.(*ShootETCDStatus), b.(*core.ShootETCDStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootETCDStatus)(nil), (*ShootETCDStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootETCDStatus_To_v1beta1_ShootETCDStatus(a.(*core.ShootETCDStatus), b.(*ShootETCDStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootExport)(nil), (*core.ShootExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootExport_To_core_ShootExport(a.(*ShootExport), b.(*core.ShootExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootExport)(nil), (*ShootExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootExport_To_v1beta1_ShootExport(a.(*core.ShootExport), b.(*ShootExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootExportSpec)(nil), (*core.ShootExportSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootExportSpec_To_core_ShootExportSpec(a.(*ShootExportSpec), b.(*core.ShootExportSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootExportSpec)(nil), (*ShootExportSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootExportSpec_To_v1beta1_ShootExportSpec(a.(*core.ShootExportSpec), b.(*ShootExportSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootExportStatus)(nil), (*core.ShootExportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootExportStatus_To_core_ShootExportStatus(a.(*ShootExportStatus), b.(*core.ShootExportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootExportStatus)(nil), (*ShootExportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootExportStatus_To_v1beta1_ShootExportStatus(a.(*core.ShootExportStatus), b.(*ShootExportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootExportTarget)(nil), (*core.ShootExportTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootExportTarget_To_core_ShootExportTarget(a.(*ShootExportTarget), b.(*core.ShootExportTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootExportTarget)(nil), (*ShootExportTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootExportTarget_To_v1beta1_ShootExportTarget(a.(*core.ShootExportTarget), b.(*ShootExportTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootKubeconfigRotation)(nil), (*core.ShootKubeconfigRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(a.(*ShootKubeconfigRotation), b.(*core.ShootKubeconfigRotation), scope)
 	}); err != nil {
@@ -1596,6 +2216,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMaintenanceStatus)(nil), (*core.ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(a.(*ShootMaintenanceStatus), b.(*core.ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootMaintenanceStatus)(nil), (*ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(a.(*core.ShootMaintenanceStatus), b.(*ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNetworks)(nil), (*core.ShootNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootNetworks_To_core_ShootNetworks(a.(*ShootNetworks), b.(*core.ShootNetworks), scope)
 	}); err != nil {
@@ -1606,6 +2236,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootProfile)(nil), (*core.ShootProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootProfile_To_core_ShootProfile(a.(*ShootProfile), b.(*core.ShootProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootProfile)(nil), (*ShootProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootProfile_To_v1beta1_ShootProfile(a.(*core.ShootProfile), b.(*ShootProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootReadinessGate)(nil), (*core.ShootReadinessGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootReadinessGate_To_core_ShootReadinessGate(a.(*ShootReadinessGate), b.(*core.ShootReadinessGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootReadinessGate)(nil), (*ShootReadinessGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootReadinessGate_To_v1beta1_ShootReadinessGate(a.(*core.ShootReadinessGate), b.(*ShootReadinessGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootReadinessGateCustomResourceDefinition)(nil), (*core.ShootReadinessGateCustomResourceDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootReadinessGateCustomResourceDefinition_To_core_ShootReadinessGateCustomResourceDefinition(a.(*ShootReadinessGateCustomResourceDefinition), b.(*core.ShootReadinessGateCustomResourceDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootReadinessGateCustomResourceDefinition)(nil), (*ShootReadinessGateCustomResourceDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootReadinessGateCustomResourceDefinition_To_v1beta1_ShootReadinessGateCustomResourceDefinition(a.(*core.ShootReadinessGateCustomResourceDefinition), b.(*ShootReadinessGateCustomResourceDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootReadinessGateHTTPGet)(nil), (*core.ShootReadinessGateHTTPGet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootReadinessGateHTTPGet_To_core_ShootReadinessGateHTTPGet(a.(*ShootReadinessGateHTTPGet), b.(*core.ShootReadinessGateHTTPGet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootReadinessGateHTTPGet)(nil), (*ShootReadinessGateHTTPGet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootReadinessGateHTTPGet_To_v1beta1_ShootReadinessGateHTTPGet(a.(*core.ShootReadinessGateHTTPGet), b.(*ShootReadinessGateHTTPGet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootReconciliation)(nil), (*core.ShootReconciliation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootReconciliation_To_core_ShootReconciliation(a.(*ShootReconciliation), b.(*core.ShootReconciliation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootReconciliation)(nil), (*ShootReconciliation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootReconciliation_To_v1beta1_ShootReconciliation(a.(*core.ShootReconciliation), b.(*ShootReconciliation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRevision)(nil), (*core.ShootRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootRevision_To_core_ShootRevision(a.(*ShootRevision), b.(*core.ShootRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootRevision)(nil), (*ShootRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootRevision_To_v1beta1_ShootRevision(a.(*core.ShootRevision), b.(*ShootRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRevisionList)(nil), (*core.ShootRevisionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootRevisionList_To_core_ShootRevisionList(a.(*ShootRevisionList), b.(*core.ShootRevisionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootRevisionList)(nil), (*ShootRevisionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootRevisionList_To_v1beta1_ShootRevisionList(a.(*core.ShootRevisionList), b.(*ShootRevisionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootRunningVersions)(nil), (*core.ShootRunningVersions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootRunningVersions_To_core_ShootRunningVersions(a.(*ShootRunningVersions), b.(*core.ShootRunningVersions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootRunningVersions)(nil), (*ShootRunningVersions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootRunningVersions_To_v1beta1_ShootRunningVersions(a.(*core.ShootRunningVersions), b.(*ShootRunningVersions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSSHKeypairRotation)(nil), (*core.ShootSSHKeypairRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(a.(*ShootSSHKeypairRotation), b.(*core.ShootSSHKeypairRotation), scope)
 	}); err != nil {
@@ -1616,6 +2326,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSecurityAdvisory)(nil), (*core.ShootSecurityAdvisory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSecurityAdvisory_To_core_ShootSecurityAdvisory(a.(*ShootSecurityAdvisory), b.(*core.ShootSecurityAdvisory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSecurityAdvisory)(nil), (*ShootSecurityAdvisory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSecurityAdvisory_To_v1beta1_ShootSecurityAdvisory(a.(*core.ShootSecurityAdvisory), b.(*ShootSecurityAdvisory), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSpec)(nil), (*core.ShootSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSpec_To_core_ShootSpec(a.(*ShootSpec), b.(*core.ShootSpec), scope)
 	}); err != nil {
@@ -1626,6 +2346,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootSpecChange)(nil), (*core.ShootSpecChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootSpecChange_To_core_ShootSpecChange(a.(*ShootSpecChange), b.(*core.ShootSpecChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootSpecChange)(nil), (*ShootSpecChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootSpecChange_To_v1beta1_ShootSpecChange(a.(*core.ShootSpecChange), b.(*ShootSpecChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootState)(nil), (*core.ShootState)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootState_To_core_ShootState(a.(*ShootState), b.(*core.ShootState), scope)
 	}); err != nil {
@@ -1676,6 +2406,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SlackAlertReceiver)(nil), (*core.SlackAlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SlackAlertReceiver_To_core_SlackAlertReceiver(a.(*SlackAlertReceiver), b.(*core.SlackAlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SlackAlertReceiver)(nil), (*SlackAlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SlackAlertReceiver_To_v1beta1_SlackAlertReceiver(a.(*core.SlackAlertReceiver), b.(*SlackAlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SystemComponents)(nil), (*core.SystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SystemComponents_To_core_SystemComponents(a.(*SystemComponents), b.(*core.SystemComponents), scope)
 	}); err != nil {
@@ -1686,6 +2426,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SystemComponentsPriorityClass)(nil), (*core.SystemComponentsPriorityClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SystemComponentsPriorityClass_To_core_SystemComponentsPriorityClass(a.(*SystemComponentsPriorityClass), b.(*core.SystemComponentsPriorityClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SystemComponentsPriorityClass)(nil), (*SystemComponentsPriorityClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SystemComponentsPriorityClass_To_v1beta1_SystemComponentsPriorityClass(a.(*core.SystemComponentsPriorityClass), b.(*SystemComponentsPriorityClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Toleration)(nil), (*core.Toleration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Toleration_To_core_Toleration(a.(*Toleration), b.(*core.Toleration), scope)
 	}); err != nil {
@@ -1696,6 +2446,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionPolicy)(nil), (*core.VersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionPolicy_To_core_VersionPolicy(a.(*VersionPolicy), b.(*core.VersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionPolicy)(nil), (*VersionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionPolicy_To_v1beta1_VersionPolicy(a.(*core.VersionPolicy), b.(*VersionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionPolicyList)(nil), (*core.VersionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionPolicyList_To_core_VersionPolicyList(a.(*VersionPolicyList), b.(*core.VersionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionPolicyList)(nil), (*VersionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionPolicyList_To_v1beta1_VersionPolicyList(a.(*core.VersionPolicyList), b.(*VersionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionPolicySpec)(nil), (*core.VersionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionPolicySpec_To_core_VersionPolicySpec(a.(*VersionPolicySpec), b.(*core.VersionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionPolicySpec)(nil), (*VersionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionPolicySpec_To_v1beta1_VersionPolicySpec(a.(*core.VersionPolicySpec), b.(*VersionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -1716,6 +2496,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeRequirements)(nil), (*core.VolumeRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumeRequirements_To_core_VolumeRequirements(a.(*VolumeRequirements), b.(*core.VolumeRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VolumeRequirements)(nil), (*VolumeRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VolumeRequirements_To_v1beta1_VolumeRequirements(a.(*core.VolumeRequirements), b.(*VolumeRequirements), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VolumeType)(nil), (*core.VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VolumeType_To_core_VolumeType(a.(*VolumeType), b.(*core.VolumeType), scope)
 	}); err != nil {
@@ -1736,6 +2526,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WebhookAlertReceiver)(nil), (*core.WebhookAlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WebhookAlertReceiver_To_core_WebhookAlertReceiver(a.(*WebhookAlertReceiver), b.(*core.WebhookAlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WebhookAlertReceiver)(nil), (*WebhookAlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WebhookAlertReceiver_To_v1beta1_WebhookAlertReceiver(a.(*core.WebhookAlertReceiver), b.(*WebhookAlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Worker)(nil), (*core.Worker)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Worker_To_core_Worker(a.(*Worker), b.(*core.Worker), scope)
 	}); err != nil {
@@ -1746,53 +2546,103 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerKubernetes)(nil), (*core.WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(a.(*WorkerKubernetes), b.(*core.WorkerKubernetes), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerConfidential)(nil), (*core.WorkerConfidential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerConfidential_To_core_WorkerConfidential(a.(*WorkerConfidential), b.(*core.WorkerConfidential), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.WorkerKubernetes)(nil), (*WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(a.(*core.WorkerKubernetes), b.(*WorkerKubernetes), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerConfidential)(nil), (*WorkerConfidential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerConfidential_To_v1beta1_WorkerConfidential(a.(*core.WorkerConfidential), b.(*WorkerConfidential), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerConfidentialAttestation)(nil), (*core.WorkerConfidentialAttestation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerConfidentialAttestation_To_core_WorkerConfidentialAttestation(a.(*WorkerConfidentialAttestation), b.(*core.WorkerConfidentialAttestation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.WorkerSystemComponents)(nil), (*WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(a.(*core.WorkerSystemComponents), b.(*WorkerSystemComponents), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerConfidentialAttestation)(nil), (*WorkerConfidentialAttestation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerConfidentialAttestation_To_v1beta1_WorkerConfidentialAttestation(a.(*core.WorkerConfidentialAttestation), b.(*WorkerConfidentialAttestation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkersSettings)(nil), (*core.WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_WorkersSettings_To_core_WorkersSettings(a.(*WorkersSettings), b.(*core.WorkersSettings), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerCostEstimate)(nil), (*core.WorkerCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerCostEstimate_To_core_WorkerCostEstimate(a.(*WorkerCostEstimate), b.(*core.WorkerCostEstimate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.WorkersSettings)(nil), (*WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_WorkersSettings_To_v1beta1_WorkersSettings(a.(*core.WorkersSettings), b.(*WorkersSettings), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerCostEstimate)(nil), (*WorkerCostEstimate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerCostEstimate_To_v1beta1_WorkerCostEstimate(a.(*core.WorkerCostEstimate), b.(*WorkerCostEstimate), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*core.ControllerDeployment)(nil), (*ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(a.(*core.ControllerDeployment), b.(*ControllerDeployment), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerKubernetes)(nil), (*core.WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(a.(*WorkerKubernetes), b.(*core.WorkerKubernetes), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*core.HelmControllerDeployment)(nil), (*HelmControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployment(a.(*core.HelmControllerDeployment), b.(*HelmControllerDeployment), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerKubernetes)(nil), (*WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(a.(*core.WorkerKubernetes), b.(*WorkerKubernetes), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*core.ProjectMember)(nil), (*ProjectMember)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_ProjectMember_To_v1beta1_ProjectMember(a.(*core.ProjectMember), b.(*ProjectMember), scope)
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolRollout)(nil), (*core.WorkerPoolRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolRollout_To_core_WorkerPoolRollout(a.(*WorkerPoolRollout), b.(*core.WorkerPoolRollout), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*core.ProjectSpec)(nil), (*ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_ProjectSpec_To_v1beta1_ProjectSpec(a.(*core.ProjectSpec), b.(*ProjectSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolRollout)(nil), (*WorkerPoolRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolRollout_To_v1beta1_WorkerPoolRollout(a.(*core.WorkerPoolRollout), b.(*WorkerPoolRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerProfile)(nil), (*core.WorkerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerProfile_To_core_WorkerProfile(a.(*WorkerProfile), b.(*core.WorkerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerProfile)(nil), (*WorkerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerProfile_To_v1beta1_WorkerProfile(a.(*core.WorkerProfile), b.(*WorkerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerSystemComponents)(nil), (*WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(a.(*core.WorkerSystemComponents), b.(*WorkerSystemComponents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkersSettings)(nil), (*core.WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkersSettings_To_core_WorkersSettings(a.(*WorkersSettings), b.(*core.WorkersSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkersSettings)(nil), (*WorkersSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkersSettings_To_v1beta1_WorkersSettings(a.(*core.WorkersSettings), b.(*WorkersSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.ControllerDeployment)(nil), (*ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(a.(*core.ControllerDeployment), b.(*ControllerDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.HelmControllerDeployment)(nil), (*HelmControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployment(a.(*core.HelmControllerDeployment), b.(*HelmControllerDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.ProjectMember)(nil), (*ProjectMember)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMember_To_v1beta1_ProjectMember(a.(*core.ProjectMember), b.(*ProjectMember), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*core.ProjectSpec)(nil), (*ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectSpec_To_v1beta1_ProjectSpec(a.(*core.ProjectSpec), b.(*ProjectSpec), scope)
 	}); err != nil {
 		return err
 	}
@@ -1888,9 +2738,40 @@ func Convert_core_Addon_To_v1beta1_Addon(in *core.Addon, out *Addon, s conversio
 	return autoConvert_core_Addon_To_v1beta1_Addon(in, out, s)
 }
 
+func autoConvert_v1beta1_AddonChart_To_core_AddonChart(in *AddonChart, out *core.AddonChart, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	if err := Convert_v1beta1_OCIRepository_To_core_OCIRepository(&in.OCIRepository, &out.OCIRepository, s); err != nil {
+		return err
+	}
+	out.Values = (*runtime.RawExtension)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_v1beta1_AddonChart_To_core_AddonChart is an autogenerated conversion function.
+func Convert_v1beta1_AddonChart_To_core_AddonChart(in *AddonChart, out *core.AddonChart, s conversion.Scope) error {
+	return autoConvert_v1beta1_AddonChart_To_core_AddonChart(in, out, s)
+}
+
+func autoConvert_core_AddonChart_To_v1beta1_AddonChart(in *core.AddonChart, out *AddonChart, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	if err := Convert_core_OCIRepository_To_v1beta1_OCIRepository(&in.OCIRepository, &out.OCIRepository, s); err != nil {
+		return err
+	}
+	out.Values = (*runtime.RawExtension)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_core_AddonChart_To_v1beta1_AddonChart is an autogenerated conversion function.
+func Convert_core_AddonChart_To_v1beta1_AddonChart(in *core.AddonChart, out *AddonChart, s conversion.Scope) error {
+	return autoConvert_core_AddonChart_To_v1beta1_AddonChart(in, out, s)
+}
+
 func autoConvert_v1beta1_Addons_To_core_Addons(in *Addons, out *core.Addons, s conversion.Scope) error {
 	out.KubernetesDashboard = (*core.KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*core.NginxIngress)(unsafe.Pointer(in.NginxIngress))
+	out.Charts = *(*[]core.AddonChart)(unsafe.Pointer(&in.Charts))
 	return nil
 }
 
@@ -1902,6 +2783,7 @@ func Convert_v1beta1_Addons_To_core_Addons(in *Addons, out *core.Addons, s conve
 func autoConvert_core_Addons_To_v1beta1_Addons(in *core.Addons, out *Addons, s conversion.Scope) error {
 	out.KubernetesDashboard = (*KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*NginxIngress)(unsafe.Pointer(in.NginxIngress))
+	out.Charts = *(*[]AddonChart)(unsafe.Pointer(&in.Charts))
 	return nil
 }
 
@@ -1936,6 +2818,58 @@ func Convert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.AdmissionP
 	return autoConvert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in, out, s)
 }
 
+func autoConvert_v1beta1_AlertReceiver_To_core_AlertReceiver(in *AlertReceiver, out *core.AlertReceiver, s conversion.Scope) error {
+	out.Email = (*core.EmailAlertReceiver)(unsafe.Pointer(in.Email))
+	out.Webhook = (*core.WebhookAlertReceiver)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*core.SlackAlertReceiver)(unsafe.Pointer(in.Slack))
+	return nil
+}
+
+// Convert_v1beta1_AlertReceiver_To_core_AlertReceiver is an autogenerated conversion function.
+func Convert_v1beta1_AlertReceiver_To_core_AlertReceiver(in *AlertReceiver, out *core.AlertReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertReceiver_To_core_AlertReceiver(in, out, s)
+}
+
+func autoConvert_core_AlertReceiver_To_v1beta1_AlertReceiver(in *core.AlertReceiver, out *AlertReceiver, s conversion.Scope) error {
+	out.Email = (*EmailAlertReceiver)(unsafe.Pointer(in.Email))
+	out.Webhook = (*WebhookAlertReceiver)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*SlackAlertReceiver)(unsafe.Pointer(in.Slack))
+	return nil
+}
+
+// Convert_core_AlertReceiver_To_v1beta1_AlertReceiver is an autogenerated conversion function.
+func Convert_core_AlertReceiver_To_v1beta1_AlertReceiver(in *core.AlertReceiver, out *AlertReceiver, s conversion.Scope) error {
+	return autoConvert_core_AlertReceiver_To_v1beta1_AlertReceiver(in, out, s)
+}
+
+func autoConvert_v1beta1_AlertRoute_To_core_AlertRoute(in *AlertRoute, out *core.AlertRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	if err := Convert_v1beta1_AlertReceiver_To_core_AlertReceiver(&in.Receiver, &out.Receiver, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_AlertRoute_To_core_AlertRoute is an autogenerated conversion function.
+func Convert_v1beta1_AlertRoute_To_core_AlertRoute(in *AlertRoute, out *core.AlertRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertRoute_To_core_AlertRoute(in, out, s)
+}
+
+func autoConvert_core_AlertRoute_To_v1beta1_AlertRoute(in *core.AlertRoute, out *AlertRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	if err := Convert_core_AlertReceiver_To_v1beta1_AlertReceiver(&in.Receiver, &out.Receiver, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_AlertRoute_To_v1beta1_AlertRoute is an autogenerated conversion function.
+func Convert_core_AlertRoute_To_v1beta1_AlertRoute(in *core.AlertRoute, out *AlertRoute, s conversion.Scope) error {
+	return autoConvert_core_AlertRoute_To_v1beta1_AlertRoute(in, out, s)
+}
+
 func autoConvert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
 	return nil
@@ -2390,6 +3324,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProf
 	out.SeedSelector = (*core.SeedSelector)(unsafe.Pointer(in.SeedSelector))
 	out.Type = in.Type
 	out.VolumeTypes = *(*[]core.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
+	out.ClusterAutoscalerProfiles = *(*[]core.ClusterAutoscalerProfileSettings)(unsafe.Pointer(&in.ClusterAutoscalerProfiles))
 	return nil
 }
 
@@ -2410,6 +3345,7 @@ func autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.Clou
 	out.SeedSelector = (*SeedSelector)(unsafe.Pointer(in.SeedSelector))
 	out.Type = in.Type
 	out.VolumeTypes = *(*[]VolumeType)(unsafe.Pointer(&in.VolumeTypes))
+	out.ClusterAutoscalerProfiles = *(*[]ClusterAutoscalerProfileSettings)(unsafe.Pointer(&in.ClusterAutoscalerProfiles))
 	return nil
 }
 
@@ -2433,6 +3369,7 @@ func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *Cluster
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
 	out.IgnoreDaemonsetsUtilization = (*bool)(unsafe.Pointer(in.IgnoreDaemonsetsUtilization))
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.Profile = (*core.ClusterAutoscalerProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -2456,6 +3393,7 @@ func autoConvert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.Cl
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
 	out.IgnoreDaemonsetsUtilization = (*bool)(unsafe.Pointer(in.IgnoreDaemonsetsUtilization))
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	out.Profile = (*ClusterAutoscalerProfile)(unsafe.Pointer(in.Profile))
 	return nil
 }
 
@@ -2492,6 +3430,32 @@ func Convert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(i
 	return autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterAutoscalerProfileSettings_To_core_ClusterAutoscalerProfileSettings(in *ClusterAutoscalerProfileSettings, out *core.ClusterAutoscalerProfileSettings, s conversion.Scope) error {
+	out.Name = core.ClusterAutoscalerProfile(in.Name)
+	if err := Convert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(&in.Parameters, &out.Parameters, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ClusterAutoscalerProfileSettings_To_core_ClusterAutoscalerProfileSettings is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAutoscalerProfileSettings_To_core_ClusterAutoscalerProfileSettings(in *ClusterAutoscalerProfileSettings, out *core.ClusterAutoscalerProfileSettings, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAutoscalerProfileSettings_To_core_ClusterAutoscalerProfileSettings(in, out, s)
+}
+
+func autoConvert_core_ClusterAutoscalerProfileSettings_To_v1beta1_ClusterAutoscalerProfileSettings(in *core.ClusterAutoscalerProfileSettings, out *ClusterAutoscalerProfileSettings, s conversion.Scope) error {
+	out.Name = ClusterAutoscalerProfile(in.Name)
+	if err := Convert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(&in.Parameters, &out.Parameters, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ClusterAutoscalerProfileSettings_To_v1beta1_ClusterAutoscalerProfileSettings is an autogenerated conversion function.
+func Convert_core_ClusterAutoscalerProfileSettings_To_v1beta1_ClusterAutoscalerProfileSettings(in *core.ClusterAutoscalerProfileSettings, out *ClusterAutoscalerProfileSettings, s conversion.Scope) error {
+	return autoConvert_core_ClusterAutoscalerProfileSettings_To_v1beta1_ClusterAutoscalerProfileSettings(in, out, s)
+}
+
 func autoConvert_v1beta1_Condition_To_core_Condition(in *Condition, out *core.Condition, s conversion.Scope) error {
 	out.Type = core.ConditionType(in.Type)
 	out.Status = core.ConditionStatus(in.Status)
@@ -2546,6 +3510,74 @@ func Convert_core_ContainerRuntime_To_v1beta1_ContainerRuntime(in *core.Containe
 	return autoConvert_core_ContainerRuntime_To_v1beta1_ContainerRuntime(in, out, s)
 }
 
+func autoConvert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(in *ContainerdRegistry, out *core.ContainerdRegistry, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Server = (*string)(unsafe.Pointer(in.Server))
+	out.Hosts = *(*[]core.ContainerdRegistryHost)(unsafe.Pointer(&in.Hosts))
+	return nil
+}
+
+// Convert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry is an autogenerated conversion function.
+func Convert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(in *ContainerdRegistry, out *core.ContainerdRegistry, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerdRegistry_To_core_ContainerdRegistry(in, out, s)
+}
+
+func autoConvert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(in *core.ContainerdRegistry, out *ContainerdRegistry, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Server = (*string)(unsafe.Pointer(in.Server))
+	out.Hosts = *(*[]ContainerdRegistryHost)(unsafe.Pointer(&in.Hosts))
+	return nil
+}
+
+// Convert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry is an autogenerated conversion function.
+func Convert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(in *core.ContainerdRegistry, out *ContainerdRegistry, s conversion.Scope) error {
+	return autoConvert_core_ContainerdRegistry_To_v1beta1_ContainerdRegistry(in, out, s)
+}
+
+func autoConvert_v1beta1_ContainerdRegistryHost_To_core_ContainerdRegistryHost(in *ContainerdRegistryHost, out *core.ContainerdRegistryHost, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Capabilities = *(*[]core.ContainerdRegistryCapability)(unsafe.Pointer(&in.Capabilities))
+	out.ResourceName = (*string)(unsafe.Pointer(in.ResourceName))
+	return nil
+}
+
+// Convert_v1beta1_ContainerdRegistryHost_To_core_ContainerdRegistryHost is an autogenerated conversion function.
+func Convert_v1beta1_ContainerdRegistryHost_To_core_ContainerdRegistryHost(in *ContainerdRegistryHost, out *core.ContainerdRegistryHost, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerdRegistryHost_To_core_ContainerdRegistryHost(in, out, s)
+}
+
+func autoConvert_core_ContainerdRegistryHost_To_v1beta1_ContainerdRegistryHost(in *core.ContainerdRegistryHost, out *ContainerdRegistryHost, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Capabilities = *(*[]ContainerdRegistryCapability)(unsafe.Pointer(&in.Capabilities))
+	out.ResourceName = (*string)(unsafe.Pointer(in.ResourceName))
+	return nil
+}
+
+// Convert_core_ContainerdRegistryHost_To_v1beta1_ContainerdRegistryHost is an autogenerated conversion function.
+func Convert_core_ContainerdRegistryHost_To_v1beta1_ContainerdRegistryHost(in *core.ContainerdRegistryHost, out *ContainerdRegistryHost, s conversion.Scope) error {
+	return autoConvert_core_ContainerdRegistryHost_To_v1beta1_ContainerdRegistryHost(in, out, s)
+}
+
+func autoConvert_v1beta1_ContainerdSettings_To_core_ContainerdSettings(in *ContainerdSettings, out *core.ContainerdSettings, s conversion.Scope) error {
+	out.Registries = *(*[]core.ContainerdRegistry)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_v1beta1_ContainerdSettings_To_core_ContainerdSettings is an autogenerated conversion function.
+func Convert_v1beta1_ContainerdSettings_To_core_ContainerdSettings(in *ContainerdSettings, out *core.ContainerdSettings, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerdSettings_To_core_ContainerdSettings(in, out, s)
+}
+
+func autoConvert_core_ContainerdSettings_To_v1beta1_ContainerdSettings(in *core.ContainerdSettings, out *ContainerdSettings, s conversion.Scope) error {
+	out.Registries = *(*[]ContainerdRegistry)(unsafe.Pointer(&in.Registries))
+	return nil
+}
+
+// Convert_core_ContainerdSettings_To_v1beta1_ContainerdSettings is an autogenerated conversion function.
+func Convert_core_ContainerdSettings_To_v1beta1_ContainerdSettings(in *core.ContainerdSettings, out *ContainerdSettings, s conversion.Scope) error {
+	return autoConvert_core_ContainerdSettings_To_v1beta1_ContainerdSettings(in, out, s)
+}
+
 func autoConvert_v1beta1_ControlPlane_To_core_ControlPlane(in *ControlPlane, out *core.ControlPlane, s conversion.Scope) error {
 	out.HighAvailability = (*core.HighAvailability)(unsafe.Pointer(in.HighAvailability))
 	return nil
@@ -2829,6 +3861,7 @@ func autoConvert_v1beta1_ControllerResource_To_core_ControllerResource(in *Contr
 	out.Primary = (*bool)(unsafe.Pointer(in.Primary))
 	out.Lifecycle = (*core.ControllerResourceLifecycle)(unsafe.Pointer(in.Lifecycle))
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
+	out.RequiredCredentialKeys = *(*[]string)(unsafe.Pointer(&in.RequiredCredentialKeys))
 	return nil
 }
 
@@ -2845,6 +3878,7 @@ func autoConvert_core_ControllerResource_To_v1beta1_ControllerResource(in *core.
 	out.Primary = (*bool)(unsafe.Pointer(in.Primary))
 	out.Lifecycle = (*ControllerResourceLifecycle)(unsafe.Pointer(in.Lifecycle))
 	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
+	out.RequiredCredentialKeys = *(*[]string)(unsafe.Pointer(&in.RequiredCredentialKeys))
 	return nil
 }
 
@@ -3081,6 +4115,116 @@ func Convert_core_DualApprovalForDeletion_To_v1beta1_DualApprovalForDeletion(in
 	return autoConvert_core_DualApprovalForDeletion_To_v1beta1_DualApprovalForDeletion(in, out, s)
 }
 
+func autoConvert_v1beta1_ETCD_To_core_ETCD(in *ETCD, out *core.ETCD, s conversion.Scope) error {
+	out.Main = (*core.ETCDConfig)(unsafe.Pointer(in.Main))
+	out.Events = (*core.ETCDConfig)(unsafe.Pointer(in.Events))
+	return nil
+}
+
+// Convert_v1beta1_ETCD_To_core_ETCD is an autogenerated conversion function.
+func Convert_v1beta1_ETCD_To_core_ETCD(in *ETCD, out *core.ETCD, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCD_To_core_ETCD(in, out, s)
+}
+
+func autoConvert_core_ETCD_To_v1beta1_ETCD(in *core.ETCD, out *ETCD, s conversion.Scope) error {
+	out.Main = (*ETCDConfig)(unsafe.Pointer(in.Main))
+	out.Events = (*ETCDConfig)(unsafe.Pointer(in.Events))
+	return nil
+}
+
+// Convert_core_ETCD_To_v1beta1_ETCD is an autogenerated conversion function.
+func Convert_core_ETCD_To_v1beta1_ETCD(in *core.ETCD, out *ETCD, s conversion.Scope) error {
+	return autoConvert_core_ETCD_To_v1beta1_ETCD(in, out, s)
+}
+
+func autoConvert_v1beta1_ETCDCompaction_To_core_ETCDCompaction(in *ETCDCompaction, out *core.ETCDCompaction, s conversion.Scope) error {
+	out.Mode = (*core.ETCDCompactionMode)(unsafe.Pointer(in.Mode))
+	out.Retention = (*string)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_v1beta1_ETCDCompaction_To_core_ETCDCompaction is an autogenerated conversion function.
+func Convert_v1beta1_ETCDCompaction_To_core_ETCDCompaction(in *ETCDCompaction, out *core.ETCDCompaction, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDCompaction_To_core_ETCDCompaction(in, out, s)
+}
+
+func autoConvert_core_ETCDCompaction_To_v1beta1_ETCDCompaction(in *core.ETCDCompaction, out *ETCDCompaction, s conversion.Scope) error {
+	out.Mode = (*ETCDCompactionMode)(unsafe.Pointer(in.Mode))
+	out.Retention = (*string)(unsafe.Pointer(in.Retention))
+	return nil
+}
+
+// Convert_core_ETCDCompaction_To_v1beta1_ETCDCompaction is an autogenerated conversion function.
+func Convert_core_ETCDCompaction_To_v1beta1_ETCDCompaction(in *core.ETCDCompaction, out *ETCDCompaction, s conversion.Scope) error {
+	return autoConvert_core_ETCDCompaction_To_v1beta1_ETCDCompaction(in, out, s)
+}
+
+func autoConvert_v1beta1_ETCDConfig_To_core_ETCDConfig(in *ETCDConfig, out *core.ETCDConfig, s conversion.Scope) error {
+	out.Compaction = (*core.ETCDCompaction)(unsafe.Pointer(in.Compaction))
+	out.Defragmentation = (*core.ETCDDefragmentation)(unsafe.Pointer(in.Defragmentation))
+	return nil
+}
+
+// Convert_v1beta1_ETCDConfig_To_core_ETCDConfig is an autogenerated conversion function.
+func Convert_v1beta1_ETCDConfig_To_core_ETCDConfig(in *ETCDConfig, out *core.ETCDConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDConfig_To_core_ETCDConfig(in, out, s)
+}
+
+func autoConvert_core_ETCDConfig_To_v1beta1_ETCDConfig(in *core.ETCDConfig, out *ETCDConfig, s conversion.Scope) error {
+	out.Compaction = (*ETCDCompaction)(unsafe.Pointer(in.Compaction))
+	out.Defragmentation = (*ETCDDefragmentation)(unsafe.Pointer(in.Defragmentation))
+	return nil
+}
+
+// Convert_core_ETCDConfig_To_v1beta1_ETCDConfig is an autogenerated conversion function.
+func Convert_core_ETCDConfig_To_v1beta1_ETCDConfig(in *core.ETCDConfig, out *ETCDConfig, s conversion.Scope) error {
+	return autoConvert_core_ETCDConfig_To_v1beta1_ETCDConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_ETCDDefragmentation_To_core_ETCDDefragmentation(in *ETCDDefragmentation, out *core.ETCDDefragmentation, s conversion.Scope) error {
+	out.IntervalDays = (*int32)(unsafe.Pointer(in.IntervalDays))
+	out.DBSizeThreshold = (*resource.Quantity)(unsafe.Pointer(in.DBSizeThreshold))
+	return nil
+}
+
+// Convert_v1beta1_ETCDDefragmentation_To_core_ETCDDefragmentation is an autogenerated conversion function.
+func Convert_v1beta1_ETCDDefragmentation_To_core_ETCDDefragmentation(in *ETCDDefragmentation, out *core.ETCDDefragmentation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDDefragmentation_To_core_ETCDDefragmentation(in, out, s)
+}
+
+func autoConvert_core_ETCDDefragmentation_To_v1beta1_ETCDDefragmentation(in *core.ETCDDefragmentation, out *ETCDDefragmentation, s conversion.Scope) error {
+	out.IntervalDays = (*int32)(unsafe.Pointer(in.IntervalDays))
+	out.DBSizeThreshold = (*resource.Quantity)(unsafe.Pointer(in.DBSizeThreshold))
+	return nil
+}
+
+// Convert_core_ETCDDefragmentation_To_v1beta1_ETCDDefragmentation is an autogenerated conversion function.
+func Convert_core_ETCDDefragmentation_To_v1beta1_ETCDDefragmentation(in *core.ETCDDefragmentation, out *ETCDDefragmentation, s conversion.Scope) error {
+	return autoConvert_core_ETCDDefragmentation_To_v1beta1_ETCDDefragmentation(in, out, s)
+}
+
+func autoConvert_v1beta1_ETCDDefragmentationStatus_To_core_ETCDDefragmentationStatus(in *ETCDDefragmentationStatus, out *core.ETCDDefragmentationStatus, s conversion.Scope) error {
+	out.Time = in.Time
+	out.DBSizeBefore = (*resource.Quantity)(unsafe.Pointer(in.DBSizeBefore))
+	return nil
+}
+
+// Convert_v1beta1_ETCDDefragmentationStatus_To_core_ETCDDefragmentationStatus is an autogenerated conversion function.
+func Convert_v1beta1_ETCDDefragmentationStatus_To_core_ETCDDefragmentationStatus(in *ETCDDefragmentationStatus, out *core.ETCDDefragmentationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDDefragmentationStatus_To_core_ETCDDefragmentationStatus(in, out, s)
+}
+
+func autoConvert_core_ETCDDefragmentationStatus_To_v1beta1_ETCDDefragmentationStatus(in *core.ETCDDefragmentationStatus, out *ETCDDefragmentationStatus, s conversion.Scope) error {
+	out.Time = in.Time
+	out.DBSizeBefore = (*resource.Quantity)(unsafe.Pointer(in.DBSizeBefore))
+	return nil
+}
+
+// Convert_core_ETCDDefragmentationStatus_To_v1beta1_ETCDDefragmentationStatus is an autogenerated conversion function.
+func Convert_core_ETCDDefragmentationStatus_To_v1beta1_ETCDDefragmentationStatus(in *core.ETCDDefragmentationStatus, out *ETCDDefragmentationStatus, s conversion.Scope) error {
+	return autoConvert_core_ETCDDefragmentationStatus_To_v1beta1_ETCDDefragmentationStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *core.ETCDEncryptionKeyRotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -3109,6 +4253,72 @@ func Convert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation
 	return autoConvert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ETCDStatus_To_core_ETCDStatus(in *ETCDStatus, out *core.ETCDStatus, s conversion.Scope) error {
+	out.DBSize = (*resource.Quantity)(unsafe.Pointer(in.DBSize))
+	out.DBSizeInUse = (*resource.Quantity)(unsafe.Pointer(in.DBSizeInUse))
+	out.LastDefragmentations = *(*[]core.ETCDDefragmentationStatus)(unsafe.Pointer(&in.LastDefragmentations))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ETCDStatus_To_core_ETCDStatus is an autogenerated conversion function.
+func Convert_v1beta1_ETCDStatus_To_core_ETCDStatus(in *ETCDStatus, out *core.ETCDStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDStatus_To_core_ETCDStatus(in, out, s)
+}
+
+func autoConvert_core_ETCDStatus_To_v1beta1_ETCDStatus(in *core.ETCDStatus, out *ETCDStatus, s conversion.Scope) error {
+	out.DBSize = (*resource.Quantity)(unsafe.Pointer(in.DBSize))
+	out.DBSizeInUse = (*resource.Quantity)(unsafe.Pointer(in.DBSizeInUse))
+	out.LastDefragmentations = *(*[]ETCDDefragmentationStatus)(unsafe.Pointer(&in.LastDefragmentations))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_ETCDStatus_To_v1beta1_ETCDStatus is an autogenerated conversion function.
+func Convert_core_ETCDStatus_To_v1beta1_ETCDStatus(in *core.ETCDStatus, out *ETCDStatus, s conversion.Scope) error {
+	return autoConvert_core_ETCDStatus_To_v1beta1_ETCDStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_EgressOnlyInternetAccess_To_core_EgressOnlyInternetAccess(in *EgressOnlyInternetAccess, out *core.EgressOnlyInternetAccess, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_EgressOnlyInternetAccess_To_core_EgressOnlyInternetAccess is an autogenerated conversion function.
+func Convert_v1beta1_EgressOnlyInternetAccess_To_core_EgressOnlyInternetAccess(in *EgressOnlyInternetAccess, out *core.EgressOnlyInternetAccess, s conversion.Scope) error {
+	return autoConvert_v1beta1_EgressOnlyInternetAccess_To_core_EgressOnlyInternetAccess(in, out, s)
+}
+
+func autoConvert_core_EgressOnlyInternetAccess_To_v1beta1_EgressOnlyInternetAccess(in *core.EgressOnlyInternetAccess, out *EgressOnlyInternetAccess, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_EgressOnlyInternetAccess_To_v1beta1_EgressOnlyInternetAccess is an autogenerated conversion function.
+func Convert_core_EgressOnlyInternetAccess_To_v1beta1_EgressOnlyInternetAccess(in *core.EgressOnlyInternetAccess, out *EgressOnlyInternetAccess, s conversion.Scope) error {
+	return autoConvert_core_EgressOnlyInternetAccess_To_v1beta1_EgressOnlyInternetAccess(in, out, s)
+}
+
+func autoConvert_v1beta1_EmailAlertReceiver_To_core_EmailAlertReceiver(in *EmailAlertReceiver, out *core.EmailAlertReceiver, s conversion.Scope) error {
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_v1beta1_EmailAlertReceiver_To_core_EmailAlertReceiver is an autogenerated conversion function.
+func Convert_v1beta1_EmailAlertReceiver_To_core_EmailAlertReceiver(in *EmailAlertReceiver, out *core.EmailAlertReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_EmailAlertReceiver_To_core_EmailAlertReceiver(in, out, s)
+}
+
+func autoConvert_core_EmailAlertReceiver_To_v1beta1_EmailAlertReceiver(in *core.EmailAlertReceiver, out *EmailAlertReceiver, s conversion.Scope) error {
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_core_EmailAlertReceiver_To_v1beta1_EmailAlertReceiver is an autogenerated conversion function.
+func Convert_core_EmailAlertReceiver_To_v1beta1_EmailAlertReceiver(in *core.EmailAlertReceiver, out *EmailAlertReceiver, s conversion.Scope) error {
+	return autoConvert_core_EmailAlertReceiver_To_v1beta1_EmailAlertReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionConfig, out *core.EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
 	return nil
@@ -3129,6 +4339,50 @@ func Convert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.Encrypti
 	return autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_EventArchive_To_core_EventArchive(in *EventArchive, out *core.EventArchive, s conversion.Scope) error {
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.Sink = (*core.EventArchiveSink)(unsafe.Pointer(in.Sink))
+	return nil
+}
+
+// Convert_v1beta1_EventArchive_To_core_EventArchive is an autogenerated conversion function.
+func Convert_v1beta1_EventArchive_To_core_EventArchive(in *EventArchive, out *core.EventArchive, s conversion.Scope) error {
+	return autoConvert_v1beta1_EventArchive_To_core_EventArchive(in, out, s)
+}
+
+func autoConvert_core_EventArchive_To_v1beta1_EventArchive(in *core.EventArchive, out *EventArchive, s conversion.Scope) error {
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.Sink = (*EventArchiveSink)(unsafe.Pointer(in.Sink))
+	return nil
+}
+
+// Convert_core_EventArchive_To_v1beta1_EventArchive is an autogenerated conversion function.
+func Convert_core_EventArchive_To_v1beta1_EventArchive(in *core.EventArchive, out *EventArchive, s conversion.Scope) error {
+	return autoConvert_core_EventArchive_To_v1beta1_EventArchive(in, out, s)
+}
+
+func autoConvert_v1beta1_EventArchiveSink_To_core_EventArchiveSink(in *EventArchiveSink, out *core.EventArchiveSink, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1beta1_EventArchiveSink_To_core_EventArchiveSink is an autogenerated conversion function.
+func Convert_v1beta1_EventArchiveSink_To_core_EventArchiveSink(in *EventArchiveSink, out *core.EventArchiveSink, s conversion.Scope) error {
+	return autoConvert_v1beta1_EventArchiveSink_To_core_EventArchiveSink(in, out, s)
+}
+
+func autoConvert_core_EventArchiveSink_To_v1beta1_EventArchiveSink(in *core.EventArchiveSink, out *EventArchiveSink, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_core_EventArchiveSink_To_v1beta1_EventArchiveSink is an autogenerated conversion function.
+func Convert_core_EventArchiveSink_To_v1beta1_EventArchiveSink(in *core.EventArchiveSink, out *EventArchiveSink, s conversion.Scope) error {
+	return autoConvert_core_EventArchiveSink_To_v1beta1_EventArchiveSink(in, out, s)
+}
+
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
@@ -3177,30 +4431,76 @@ func Convert_core_ExposureClass_To_v1beta1_ExposureClass(in *core.ExposureClass,
 	return autoConvert_core_ExposureClass_To_v1beta1_ExposureClass(in, out, s)
 }
 
-func autoConvert_v1beta1_ExposureClassList_To_core_ExposureClassList(in *ExposureClassList, out *core.ExposureClassList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]core.ExposureClass)(unsafe.Pointer(&in.Items))
+func autoConvert_v1beta1_ExposureClassHandlerAutoscaling_To_core_ExposureClassHandlerAutoscaling(in *ExposureClassHandlerAutoscaling, out *core.ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.TargetConnections = (*int32)(unsafe.Pointer(in.TargetConnections))
 	return nil
 }
 
-// Convert_v1beta1_ExposureClassList_To_core_ExposureClassList is an autogenerated conversion function.
-func Convert_v1beta1_ExposureClassList_To_core_ExposureClassList(in *ExposureClassList, out *core.ExposureClassList, s conversion.Scope) error {
-	return autoConvert_v1beta1_ExposureClassList_To_core_ExposureClassList(in, out, s)
+// Convert_v1beta1_ExposureClassHandlerAutoscaling_To_core_ExposureClassHandlerAutoscaling is an autogenerated conversion function.
+func Convert_v1beta1_ExposureClassHandlerAutoscaling_To_core_ExposureClassHandlerAutoscaling(in *ExposureClassHandlerAutoscaling, out *core.ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExposureClassHandlerAutoscaling_To_core_ExposureClassHandlerAutoscaling(in, out, s)
 }
 
-func autoConvert_core_ExposureClassList_To_v1beta1_ExposureClassList(in *core.ExposureClassList, out *ExposureClassList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ExposureClass)(unsafe.Pointer(&in.Items))
+func autoConvert_core_ExposureClassHandlerAutoscaling_To_v1beta1_ExposureClassHandlerAutoscaling(in *core.ExposureClassHandlerAutoscaling, out *ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.TargetConnections = (*int32)(unsafe.Pointer(in.TargetConnections))
 	return nil
 }
 
-// Convert_core_ExposureClassList_To_v1beta1_ExposureClassList is an autogenerated conversion function.
-func Convert_core_ExposureClassList_To_v1beta1_ExposureClassList(in *core.ExposureClassList, out *ExposureClassList, s conversion.Scope) error {
-	return autoConvert_core_ExposureClassList_To_v1beta1_ExposureClassList(in, out, s)
+// Convert_core_ExposureClassHandlerAutoscaling_To_v1beta1_ExposureClassHandlerAutoscaling is an autogenerated conversion function.
+func Convert_core_ExposureClassHandlerAutoscaling_To_v1beta1_ExposureClassHandlerAutoscaling(in *core.ExposureClassHandlerAutoscaling, out *ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	return autoConvert_core_ExposureClassHandlerAutoscaling_To_v1beta1_ExposureClassHandlerAutoscaling(in, out, s)
 }
 
-func autoConvert_v1beta1_ExposureClassScheduling_To_core_ExposureClassScheduling(in *ExposureClassScheduling, out *core.ExposureClassScheduling, s conversion.Scope) error {
-	out.SeedSelector = (*core.SeedSelector)(unsafe.Pointer(in.SeedSelector))
+func autoConvert_v1beta1_ExposureClassHandlerStatus_To_core_ExposureClassHandlerStatus(in *ExposureClassHandlerStatus, out *core.ExposureClassHandlerStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Autoscaling = (*core.ExposureClassHandlerAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	return nil
+}
+
+// Convert_v1beta1_ExposureClassHandlerStatus_To_core_ExposureClassHandlerStatus is an autogenerated conversion function.
+func Convert_v1beta1_ExposureClassHandlerStatus_To_core_ExposureClassHandlerStatus(in *ExposureClassHandlerStatus, out *core.ExposureClassHandlerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExposureClassHandlerStatus_To_core_ExposureClassHandlerStatus(in, out, s)
+}
+
+func autoConvert_core_ExposureClassHandlerStatus_To_v1beta1_ExposureClassHandlerStatus(in *core.ExposureClassHandlerStatus, out *ExposureClassHandlerStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Autoscaling = (*ExposureClassHandlerAutoscaling)(unsafe.Pointer(in.Autoscaling))
+	return nil
+}
+
+// Convert_core_ExposureClassHandlerStatus_To_v1beta1_ExposureClassHandlerStatus is an autogenerated conversion function.
+func Convert_core_ExposureClassHandlerStatus_To_v1beta1_ExposureClassHandlerStatus(in *core.ExposureClassHandlerStatus, out *ExposureClassHandlerStatus, s conversion.Scope) error {
+	return autoConvert_core_ExposureClassHandlerStatus_To_v1beta1_ExposureClassHandlerStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ExposureClassList_To_core_ExposureClassList(in *ExposureClassList, out *core.ExposureClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ExposureClass)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ExposureClassList_To_core_ExposureClassList is an autogenerated conversion function.
+func Convert_v1beta1_ExposureClassList_To_core_ExposureClassList(in *ExposureClassList, out *core.ExposureClassList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExposureClassList_To_core_ExposureClassList(in, out, s)
+}
+
+func autoConvert_core_ExposureClassList_To_v1beta1_ExposureClassList(in *core.ExposureClassList, out *ExposureClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ExposureClass)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ExposureClassList_To_v1beta1_ExposureClassList is an autogenerated conversion function.
+func Convert_core_ExposureClassList_To_v1beta1_ExposureClassList(in *core.ExposureClassList, out *ExposureClassList, s conversion.Scope) error {
+	return autoConvert_core_ExposureClassList_To_v1beta1_ExposureClassList(in, out, s)
+}
+
+func autoConvert_v1beta1_ExposureClassScheduling_To_core_ExposureClassScheduling(in *ExposureClassScheduling, out *core.ExposureClassScheduling, s conversion.Scope) error {
+	out.SeedSelector = (*core.SeedSelector)(unsafe.Pointer(in.SeedSelector))
 	out.Tolerations = *(*[]core.Toleration)(unsafe.Pointer(&in.Tolerations))
 	return nil
 }
@@ -3293,6 +4593,30 @@ func Convert_core_FailureTolerance_To_v1beta1_FailureTolerance(in *core.FailureT
 	return autoConvert_core_FailureTolerance_To_v1beta1_FailureTolerance(in, out, s)
 }
 
+func autoConvert_v1beta1_FeatureGateStatus_To_core_FeatureGateStatus(in *FeatureGateStatus, out *core.FeatureGateStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = in.Enabled
+	out.LockToDefault = in.LockToDefault
+	return nil
+}
+
+// Convert_v1beta1_FeatureGateStatus_To_core_FeatureGateStatus is an autogenerated conversion function.
+func Convert_v1beta1_FeatureGateStatus_To_core_FeatureGateStatus(in *FeatureGateStatus, out *core.FeatureGateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_FeatureGateStatus_To_core_FeatureGateStatus(in, out, s)
+}
+
+func autoConvert_core_FeatureGateStatus_To_v1beta1_FeatureGateStatus(in *core.FeatureGateStatus, out *FeatureGateStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = in.Enabled
+	out.LockToDefault = in.LockToDefault
+	return nil
+}
+
+// Convert_core_FeatureGateStatus_To_v1beta1_FeatureGateStatus is an autogenerated conversion function.
+func Convert_core_FeatureGateStatus_To_v1beta1_FeatureGateStatus(in *core.FeatureGateStatus, out *FeatureGateStatus, s conversion.Scope) error {
+	return autoConvert_core_FeatureGateStatus_To_v1beta1_FeatureGateStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Gardener_To_core_Gardener(in *Gardener, out *core.Gardener, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -3455,6 +4779,108 @@ func Convert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscal
 	return autoConvert_core_HorizontalPodAutoscalerConfig_To_v1beta1_HorizontalPodAutoscalerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_ImageOverride_To_core_ImageOverride(in *ImageOverride, out *core.ImageOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Repository = in.Repository
+	out.Tag = (*string)(unsafe.Pointer(in.Tag))
+	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.RuntimeVersion = (*string)(unsafe.Pointer(in.RuntimeVersion))
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	return nil
+}
+
+// Convert_v1beta1_ImageOverride_To_core_ImageOverride is an autogenerated conversion function.
+func Convert_v1beta1_ImageOverride_To_core_ImageOverride(in *ImageOverride, out *core.ImageOverride, s conversion.Scope) error {
+	return autoConvert_v1beta1_ImageOverride_To_core_ImageOverride(in, out, s)
+}
+
+func autoConvert_core_ImageOverride_To_v1beta1_ImageOverride(in *core.ImageOverride, out *ImageOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Repository = in.Repository
+	out.Tag = (*string)(unsafe.Pointer(in.Tag))
+	out.Digest = (*string)(unsafe.Pointer(in.Digest))
+	out.RuntimeVersion = (*string)(unsafe.Pointer(in.RuntimeVersion))
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	return nil
+}
+
+// Convert_core_ImageOverride_To_v1beta1_ImageOverride is an autogenerated conversion function.
+func Convert_core_ImageOverride_To_v1beta1_ImageOverride(in *core.ImageOverride, out *ImageOverride, s conversion.Scope) error {
+	return autoConvert_core_ImageOverride_To_v1beta1_ImageOverride(in, out, s)
+}
+
+func autoConvert_v1beta1_ImageVectorOverride_To_core_ImageVectorOverride(in *ImageVectorOverride, out *core.ImageVectorOverride, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ImageVectorOverrideSpec_To_core_ImageVectorOverrideSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ImageVectorOverride_To_core_ImageVectorOverride is an autogenerated conversion function.
+func Convert_v1beta1_ImageVectorOverride_To_core_ImageVectorOverride(in *ImageVectorOverride, out *core.ImageVectorOverride, s conversion.Scope) error {
+	return autoConvert_v1beta1_ImageVectorOverride_To_core_ImageVectorOverride(in, out, s)
+}
+
+func autoConvert_core_ImageVectorOverride_To_v1beta1_ImageVectorOverride(in *core.ImageVectorOverride, out *ImageVectorOverride, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ImageVectorOverrideSpec_To_v1beta1_ImageVectorOverrideSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ImageVectorOverride_To_v1beta1_ImageVectorOverride is an autogenerated conversion function.
+func Convert_core_ImageVectorOverride_To_v1beta1_ImageVectorOverride(in *core.ImageVectorOverride, out *ImageVectorOverride, s conversion.Scope) error {
+	return autoConvert_core_ImageVectorOverride_To_v1beta1_ImageVectorOverride(in, out, s)
+}
+
+func autoConvert_v1beta1_ImageVectorOverrideList_To_core_ImageVectorOverrideList(in *ImageVectorOverrideList, out *core.ImageVectorOverrideList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ImageVectorOverride)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ImageVectorOverrideList_To_core_ImageVectorOverrideList is an autogenerated conversion function.
+func Convert_v1beta1_ImageVectorOverrideList_To_core_ImageVectorOverrideList(in *ImageVectorOverrideList, out *core.ImageVectorOverrideList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ImageVectorOverrideList_To_core_ImageVectorOverrideList(in, out, s)
+}
+
+func autoConvert_core_ImageVectorOverrideList_To_v1beta1_ImageVectorOverrideList(in *core.ImageVectorOverrideList, out *ImageVectorOverrideList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ImageVectorOverride)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ImageVectorOverrideList_To_v1beta1_ImageVectorOverrideList is an autogenerated conversion function.
+func Convert_core_ImageVectorOverrideList_To_v1beta1_ImageVectorOverrideList(in *core.ImageVectorOverrideList, out *ImageVectorOverrideList, s conversion.Scope) error {
+	return autoConvert_core_ImageVectorOverrideList_To_v1beta1_ImageVectorOverrideList(in, out, s)
+}
+
+func autoConvert_v1beta1_ImageVectorOverrideSpec_To_core_ImageVectorOverrideSpec(in *ImageVectorOverrideSpec, out *core.ImageVectorOverrideSpec, s conversion.Scope) error {
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Images = *(*[]core.ImageOverride)(unsafe.Pointer(&in.Images))
+	return nil
+}
+
+// Convert_v1beta1_ImageVectorOverrideSpec_To_core_ImageVectorOverrideSpec is an autogenerated conversion function.
+func Convert_v1beta1_ImageVectorOverrideSpec_To_core_ImageVectorOverrideSpec(in *ImageVectorOverrideSpec, out *core.ImageVectorOverrideSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ImageVectorOverrideSpec_To_core_ImageVectorOverrideSpec(in, out, s)
+}
+
+func autoConvert_core_ImageVectorOverrideSpec_To_v1beta1_ImageVectorOverrideSpec(in *core.ImageVectorOverrideSpec, out *ImageVectorOverrideSpec, s conversion.Scope) error {
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Images = *(*[]ImageOverride)(unsafe.Pointer(&in.Images))
+	return nil
+}
+
+// Convert_core_ImageVectorOverrideSpec_To_v1beta1_ImageVectorOverrideSpec is an autogenerated conversion function.
+func Convert_core_ImageVectorOverrideSpec_To_v1beta1_ImageVectorOverrideSpec(in *core.ImageVectorOverrideSpec, out *ImageVectorOverrideSpec, s conversion.Scope) error {
+	return autoConvert_core_ImageVectorOverrideSpec_To_v1beta1_ImageVectorOverrideSpec(in, out, s)
+}
+
 func autoConvert_v1beta1_Ingress_To_core_Ingress(in *Ingress, out *core.Ingress, s conversion.Scope) error {
 	out.Domain = in.Domain
 	if err := Convert_v1beta1_IngressController_To_core_IngressController(&in.Controller, &out.Controller, s); err != nil {
@@ -3567,6 +4993,28 @@ func Convert_core_InternalSecretList_To_v1beta1_InternalSecretList(in *core.Inte
 	return autoConvert_core_InternalSecretList_To_v1beta1_InternalSecretList(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeAPIServerAdditionalSANs_To_core_KubeAPIServerAdditionalSANs(in *KubeAPIServerAdditionalSANs, out *core.KubeAPIServerAdditionalSANs, s conversion.Scope) error {
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	return nil
+}
+
+// Convert_v1beta1_KubeAPIServerAdditionalSANs_To_core_KubeAPIServerAdditionalSANs is an autogenerated conversion function.
+func Convert_v1beta1_KubeAPIServerAdditionalSANs_To_core_KubeAPIServerAdditionalSANs(in *KubeAPIServerAdditionalSANs, out *core.KubeAPIServerAdditionalSANs, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeAPIServerAdditionalSANs_To_core_KubeAPIServerAdditionalSANs(in, out, s)
+}
+
+func autoConvert_core_KubeAPIServerAdditionalSANs_To_v1beta1_KubeAPIServerAdditionalSANs(in *core.KubeAPIServerAdditionalSANs, out *KubeAPIServerAdditionalSANs, s conversion.Scope) error {
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	return nil
+}
+
+// Convert_core_KubeAPIServerAdditionalSANs_To_v1beta1_KubeAPIServerAdditionalSANs is an autogenerated conversion function.
+func Convert_core_KubeAPIServerAdditionalSANs_To_v1beta1_KubeAPIServerAdditionalSANs(in *core.KubeAPIServerAdditionalSANs, out *KubeAPIServerAdditionalSANs, s conversion.Scope) error {
+	return autoConvert_core_KubeAPIServerAdditionalSANs_To_v1beta1_KubeAPIServerAdditionalSANs(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *KubeAPIServerConfig, out *core.KubeAPIServerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -3595,6 +5043,7 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*core.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.AdditionalSANs = (*core.KubeAPIServerAdditionalSANs)(unsafe.Pointer(in.AdditionalSANs))
 	return nil
 }
 
@@ -3631,6 +5080,7 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	out.AdditionalSANs = (*KubeAPIServerAdditionalSANs)(unsafe.Pointer(in.AdditionalSANs))
 	return nil
 }
 
@@ -3755,6 +5205,8 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*core.MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.CredentialProviders = *(*[]core.KubeletCredentialProvider)(unsafe.Pointer(&in.CredentialProviders))
+	out.ReservationPolicy = (*core.KubeletReservationPolicy)(unsafe.Pointer(in.ReservationPolicy))
 	return nil
 }
 
@@ -3791,6 +5243,8 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.CredentialProviders = *(*[]KubeletCredentialProvider)(unsafe.Pointer(&in.CredentialProviders))
+	out.ReservationPolicy = (*KubeletReservationPolicy)(unsafe.Pointer(in.ReservationPolicy))
 	return nil
 }
 
@@ -3909,6 +5363,62 @@ func Convert_core_KubeletConfigReserved_To_v1beta1_KubeletConfigReserved(in *cor
 	return autoConvert_core_KubeletConfigReserved_To_v1beta1_KubeletConfigReserved(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(in *KubeletCredentialProvider, out *core.KubeletCredentialProvider, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Image = in.Image
+	out.FilePathInImage = (*string)(unsafe.Pointer(in.FilePathInImage))
+	out.MatchImages = *(*[]string)(unsafe.Pointer(&in.MatchImages))
+	out.DefaultCacheDuration = (*metav1.Duration)(unsafe.Pointer(in.DefaultCacheDuration))
+	out.APIVersion = (*string)(unsafe.Pointer(in.APIVersion))
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Env = *(*[]core.KubeletCredentialProviderEnvVar)(unsafe.Pointer(&in.Env))
+	return nil
+}
+
+// Convert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider is an autogenerated conversion function.
+func Convert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(in *KubeletCredentialProvider, out *core.KubeletCredentialProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletCredentialProvider_To_core_KubeletCredentialProvider(in, out, s)
+}
+
+func autoConvert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(in *core.KubeletCredentialProvider, out *KubeletCredentialProvider, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Image = in.Image
+	out.FilePathInImage = (*string)(unsafe.Pointer(in.FilePathInImage))
+	out.MatchImages = *(*[]string)(unsafe.Pointer(&in.MatchImages))
+	out.DefaultCacheDuration = (*metav1.Duration)(unsafe.Pointer(in.DefaultCacheDuration))
+	out.APIVersion = (*string)(unsafe.Pointer(in.APIVersion))
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Env = *(*[]KubeletCredentialProviderEnvVar)(unsafe.Pointer(&in.Env))
+	return nil
+}
+
+// Convert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider is an autogenerated conversion function.
+func Convert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(in *core.KubeletCredentialProvider, out *KubeletCredentialProvider, s conversion.Scope) error {
+	return autoConvert_core_KubeletCredentialProvider_To_v1beta1_KubeletCredentialProvider(in, out, s)
+}
+
+func autoConvert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(in *KubeletCredentialProviderEnvVar, out *core.KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar is an autogenerated conversion function.
+func Convert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(in *KubeletCredentialProviderEnvVar, out *core.KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletCredentialProviderEnvVar_To_core_KubeletCredentialProviderEnvVar(in, out, s)
+}
+
+func autoConvert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(in *core.KubeletCredentialProviderEnvVar, out *KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar is an autogenerated conversion function.
+func Convert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(in *core.KubeletCredentialProviderEnvVar, out *KubeletCredentialProviderEnvVar, s conversion.Scope) error {
+	return autoConvert_core_KubeletCredentialProviderEnvVar_To_v1beta1_KubeletCredentialProviderEnvVar(in, out, s)
+}
+
 func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core.Kubernetes, s conversion.Scope) error {
 	out.ClusterAutoscaler = (*core.ClusterAutoscaler)(unsafe.Pointer(in.ClusterAutoscaler))
 	if in.KubeAPIServer != nil {
@@ -3935,6 +5445,7 @@ func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*core.VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.EnableStaticTokenKubeconfig = (*bool)(unsafe.Pointer(in.EnableStaticTokenKubeconfig))
+	out.ETCD = (*core.ETCD)(unsafe.Pointer(in.ETCD))
 	return nil
 }
 
@@ -3969,6 +5480,7 @@ func autoConvert_core_Kubernetes_To_v1beta1_Kubernetes(in *core.Kubernetes, out
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.EnableStaticTokenKubeconfig = (*bool)(unsafe.Pointer(in.EnableStaticTokenKubeconfig))
+	out.ETCD = (*ETCD)(unsafe.Pointer(in.ETCD))
 	return nil
 }
 
@@ -4043,11 +5555,34 @@ func Convert_core_KubernetesSettings_To_v1beta1_KubernetesSettings(in *core.Kube
 	return autoConvert_core_KubernetesSettings_To_v1beta1_KubernetesSettings(in, out, s)
 }
 
+func autoConvert_v1beta1_KubernetesVersionPolicy_To_core_KubernetesVersionPolicy(in *KubernetesVersionPolicy, out *core.KubernetesVersionPolicy, s conversion.Scope) error {
+	out.MinimumVersion = in.MinimumVersion
+	return nil
+}
+
+// Convert_v1beta1_KubernetesVersionPolicy_To_core_KubernetesVersionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_KubernetesVersionPolicy_To_core_KubernetesVersionPolicy(in *KubernetesVersionPolicy, out *core.KubernetesVersionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubernetesVersionPolicy_To_core_KubernetesVersionPolicy(in, out, s)
+}
+
+func autoConvert_core_KubernetesVersionPolicy_To_v1beta1_KubernetesVersionPolicy(in *core.KubernetesVersionPolicy, out *KubernetesVersionPolicy, s conversion.Scope) error {
+	out.MinimumVersion = in.MinimumVersion
+	return nil
+}
+
+// Convert_core_KubernetesVersionPolicy_To_v1beta1_KubernetesVersionPolicy is an autogenerated conversion function.
+func Convert_core_KubernetesVersionPolicy_To_v1beta1_KubernetesVersionPolicy(in *core.KubernetesVersionPolicy, out *KubernetesVersionPolicy, s conversion.Scope) error {
+	return autoConvert_core_KubernetesVersionPolicy_To_v1beta1_KubernetesVersionPolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_LastError_To_core_LastError(in *LastError, out *core.LastError, s conversion.Scope) error {
 	out.Description = in.Description
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Category = (*core.ErrorCategory)(unsafe.Pointer(in.Category))
+	out.ProviderCodes = *(*[]string)(unsafe.Pointer(&in.ProviderCodes))
+	out.RemediationHint = (*string)(unsafe.Pointer(in.RemediationHint))
 	return nil
 }
 
@@ -4061,6 +5596,9 @@ func autoConvert_core_LastError_To_v1beta1_LastError(in *core.LastError, out *La
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Category = (*ErrorCategory)(unsafe.Pointer(in.Category))
+	out.ProviderCodes = *(*[]string)(unsafe.Pointer(&in.ProviderCodes))
+	out.RemediationHint = (*string)(unsafe.Pointer(in.RemediationHint))
 	return nil
 }
 
@@ -4123,6 +5661,56 @@ func Convert_core_LastOperation_To_v1beta1_LastOperation(in *core.LastOperation,
 	return autoConvert_core_LastOperation_To_v1beta1_LastOperation(in, out, s)
 }
 
+func autoConvert_v1beta1_LeftoverResource_To_core_LeftoverResource(in *LeftoverResource, out *core.LeftoverResource, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.State = core.LeftoverResourceState(in.State)
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_v1beta1_LeftoverResource_To_core_LeftoverResource is an autogenerated conversion function.
+func Convert_v1beta1_LeftoverResource_To_core_LeftoverResource(in *LeftoverResource, out *core.LeftoverResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_LeftoverResource_To_core_LeftoverResource(in, out, s)
+}
+
+func autoConvert_core_LeftoverResource_To_v1beta1_LeftoverResource(in *core.LeftoverResource, out *LeftoverResource, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.State = LeftoverResourceState(in.State)
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_core_LeftoverResource_To_v1beta1_LeftoverResource is an autogenerated conversion function.
+func Convert_core_LeftoverResource_To_v1beta1_LeftoverResource(in *core.LeftoverResource, out *LeftoverResource, s conversion.Scope) error {
+	return autoConvert_core_LeftoverResource_To_v1beta1_LeftoverResource(in, out, s)
+}
+
+func autoConvert_v1beta1_LeftoverResources_To_core_LeftoverResources(in *LeftoverResources, out *core.LeftoverResources, s conversion.Scope) error {
+	out.Resources = *(*[]core.LeftoverResource)(unsafe.Pointer(&in.Resources))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_LeftoverResources_To_core_LeftoverResources is an autogenerated conversion function.
+func Convert_v1beta1_LeftoverResources_To_core_LeftoverResources(in *LeftoverResources, out *core.LeftoverResources, s conversion.Scope) error {
+	return autoConvert_v1beta1_LeftoverResources_To_core_LeftoverResources(in, out, s)
+}
+
+func autoConvert_core_LeftoverResources_To_v1beta1_LeftoverResources(in *core.LeftoverResources, out *LeftoverResources, s conversion.Scope) error {
+	out.Resources = *(*[]LeftoverResource)(unsafe.Pointer(&in.Resources))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_LeftoverResources_To_v1beta1_LeftoverResources is an autogenerated conversion function.
+func Convert_core_LeftoverResources_To_v1beta1_LeftoverResources(in *core.LeftoverResources, out *LeftoverResources, s conversion.Scope) error {
+	return autoConvert_core_LeftoverResources_To_v1beta1_LeftoverResources(in, out, s)
+}
+
 func autoConvert_v1beta1_LoadBalancerServicesProxyProtocol_To_core_LoadBalancerServicesProxyProtocol(in *LoadBalancerServicesProxyProtocol, out *core.LoadBalancerServicesProxyProtocol, s conversion.Scope) error {
 	out.Allowed = in.Allowed
 	return nil
@@ -4155,6 +5743,7 @@ func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Requirements = (*core.MachineTypeRequirements)(unsafe.Pointer(in.Requirements))
 	return nil
 }
 
@@ -4175,6 +5764,7 @@ func autoConvert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Requirements = (*MachineTypeRequirements)(unsafe.Pointer(in.Requirements))
 	return nil
 }
 
@@ -4235,6 +5825,30 @@ func Convert_core_MachineImage_To_v1beta1_MachineImage(in *core.MachineImage, ou
 	return autoConvert_core_MachineImage_To_v1beta1_MachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageRunningVersion_To_core_MachineImageRunningVersion(in *MachineImageRunningVersion, out *core.MachineImageRunningVersion, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Since = in.Since
+	return nil
+}
+
+// Convert_v1beta1_MachineImageRunningVersion_To_core_MachineImageRunningVersion is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageRunningVersion_To_core_MachineImageRunningVersion(in *MachineImageRunningVersion, out *core.MachineImageRunningVersion, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageRunningVersion_To_core_MachineImageRunningVersion(in, out, s)
+}
+
+func autoConvert_core_MachineImageRunningVersion_To_v1beta1_MachineImageRunningVersion(in *core.MachineImageRunningVersion, out *MachineImageRunningVersion, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Since = in.Since
+	return nil
+}
+
+// Convert_core_MachineImageRunningVersion_To_v1beta1_MachineImageRunningVersion is an autogenerated conversion function.
+func Convert_core_MachineImageRunningVersion_To_v1beta1_MachineImageRunningVersion(in *core.MachineImageRunningVersion, out *MachineImageRunningVersion, s conversion.Scope) error {
+	return autoConvert_core_MachineImageRunningVersion_To_v1beta1_MachineImageRunningVersion(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineImageVersion_To_core_MachineImageVersion(in *MachineImageVersion, out *core.MachineImageVersion, s conversion.Scope) error {
 	if err := Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
 		return err
@@ -4265,6 +5879,56 @@ func Convert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in *core.Ma
 	return autoConvert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageVersionPolicy_To_core_MachineImageVersionPolicy(in *MachineImageVersionPolicy, out *core.MachineImageVersionPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinimumVersion = in.MinimumVersion
+	return nil
+}
+
+// Convert_v1beta1_MachineImageVersionPolicy_To_core_MachineImageVersionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageVersionPolicy_To_core_MachineImageVersionPolicy(in *MachineImageVersionPolicy, out *core.MachineImageVersionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageVersionPolicy_To_core_MachineImageVersionPolicy(in, out, s)
+}
+
+func autoConvert_core_MachineImageVersionPolicy_To_v1beta1_MachineImageVersionPolicy(in *core.MachineImageVersionPolicy, out *MachineImageVersionPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinimumVersion = in.MinimumVersion
+	return nil
+}
+
+// Convert_core_MachineImageVersionPolicy_To_v1beta1_MachineImageVersionPolicy is an autogenerated conversion function.
+func Convert_core_MachineImageVersionPolicy_To_v1beta1_MachineImageVersionPolicy(in *core.MachineImageVersionPolicy, out *MachineImageVersionPolicy, s conversion.Scope) error {
+	return autoConvert_core_MachineImageVersionPolicy_To_v1beta1_MachineImageVersionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineRequirements_To_core_MachineRequirements(in *MachineRequirements, out *core.MachineRequirements, s conversion.Scope) error {
+	out.CPU = in.CPU
+	out.GPU = in.GPU
+	out.Memory = in.Memory
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Capabilities = (*core.MachineTypeCapabilities)(unsafe.Pointer(in.Capabilities))
+	return nil
+}
+
+// Convert_v1beta1_MachineRequirements_To_core_MachineRequirements is an autogenerated conversion function.
+func Convert_v1beta1_MachineRequirements_To_core_MachineRequirements(in *MachineRequirements, out *core.MachineRequirements, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineRequirements_To_core_MachineRequirements(in, out, s)
+}
+
+func autoConvert_core_MachineRequirements_To_v1beta1_MachineRequirements(in *core.MachineRequirements, out *MachineRequirements, s conversion.Scope) error {
+	out.CPU = in.CPU
+	out.GPU = in.GPU
+	out.Memory = in.Memory
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Capabilities = (*MachineTypeCapabilities)(unsafe.Pointer(in.Capabilities))
+	return nil
+}
+
+// Convert_core_MachineRequirements_To_v1beta1_MachineRequirements is an autogenerated conversion function.
+func Convert_core_MachineRequirements_To_v1beta1_MachineRequirements(in *core.MachineRequirements, out *MachineRequirements, s conversion.Scope) error {
+	return autoConvert_core_MachineRequirements_To_v1beta1_MachineRequirements(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *core.MachineType, s conversion.Scope) error {
 	out.CPU = in.CPU
 	out.GPU = in.GPU
@@ -4273,6 +5937,9 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Storage = (*core.MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.HourlyPrice = (*string)(unsafe.Pointer(in.HourlyPrice))
+	out.KubeReserved = (*core.KubeletConfigReserved)(unsafe.Pointer(in.KubeReserved))
+	out.Capabilities = (*core.MachineTypeCapabilities)(unsafe.Pointer(in.Capabilities))
 	return nil
 }
 
@@ -4289,6 +5956,9 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.HourlyPrice = (*string)(unsafe.Pointer(in.HourlyPrice))
+	out.KubeReserved = (*KubeletConfigReserved)(unsafe.Pointer(in.KubeReserved))
+	out.Capabilities = (*MachineTypeCapabilities)(unsafe.Pointer(in.Capabilities))
 	return nil
 }
 
@@ -4297,6 +5967,58 @@ func Convert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, out *
 	return autoConvert_core_MachineType_To_v1beta1_MachineType(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineTypeCapabilities_To_core_MachineTypeCapabilities(in *MachineTypeCapabilities, out *core.MachineTypeCapabilities, s conversion.Scope) error {
+	out.CPUFamily = (*string)(unsafe.Pointer(in.CPUFamily))
+	out.NVMe = (*bool)(unsafe.Pointer(in.NVMe))
+	out.NestedVirtualization = (*bool)(unsafe.Pointer(in.NestedVirtualization))
+	out.ConfidentialCompute = (*bool)(unsafe.Pointer(in.ConfidentialCompute))
+	return nil
+}
+
+// Convert_v1beta1_MachineTypeCapabilities_To_core_MachineTypeCapabilities is an autogenerated conversion function.
+func Convert_v1beta1_MachineTypeCapabilities_To_core_MachineTypeCapabilities(in *MachineTypeCapabilities, out *core.MachineTypeCapabilities, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineTypeCapabilities_To_core_MachineTypeCapabilities(in, out, s)
+}
+
+func autoConvert_core_MachineTypeCapabilities_To_v1beta1_MachineTypeCapabilities(in *core.MachineTypeCapabilities, out *MachineTypeCapabilities, s conversion.Scope) error {
+	out.CPUFamily = (*string)(unsafe.Pointer(in.CPUFamily))
+	out.NVMe = (*bool)(unsafe.Pointer(in.NVMe))
+	out.NestedVirtualization = (*bool)(unsafe.Pointer(in.NestedVirtualization))
+	out.ConfidentialCompute = (*bool)(unsafe.Pointer(in.ConfidentialCompute))
+	return nil
+}
+
+// Convert_core_MachineTypeCapabilities_To_v1beta1_MachineTypeCapabilities is an autogenerated conversion function.
+func Convert_core_MachineTypeCapabilities_To_v1beta1_MachineTypeCapabilities(in *core.MachineTypeCapabilities, out *MachineTypeCapabilities, s conversion.Scope) error {
+	return autoConvert_core_MachineTypeCapabilities_To_v1beta1_MachineTypeCapabilities(in, out, s)
+}
+
+func autoConvert_v1beta1_MachineTypeRequirements_To_core_MachineTypeRequirements(in *MachineTypeRequirements, out *core.MachineTypeRequirements, s conversion.Scope) error {
+	out.CPU = (*resource.Quantity)(unsafe.Pointer(in.CPU))
+	out.GPU = (*resource.Quantity)(unsafe.Pointer(in.GPU))
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	out.Capabilities = (*core.MachineTypeCapabilities)(unsafe.Pointer(in.Capabilities))
+	return nil
+}
+
+// Convert_v1beta1_MachineTypeRequirements_To_core_MachineTypeRequirements is an autogenerated conversion function.
+func Convert_v1beta1_MachineTypeRequirements_To_core_MachineTypeRequirements(in *MachineTypeRequirements, out *core.MachineTypeRequirements, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineTypeRequirements_To_core_MachineTypeRequirements(in, out, s)
+}
+
+func autoConvert_core_MachineTypeRequirements_To_v1beta1_MachineTypeRequirements(in *core.MachineTypeRequirements, out *MachineTypeRequirements, s conversion.Scope) error {
+	out.CPU = (*resource.Quantity)(unsafe.Pointer(in.CPU))
+	out.GPU = (*resource.Quantity)(unsafe.Pointer(in.GPU))
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	out.Capabilities = (*MachineTypeCapabilities)(unsafe.Pointer(in.Capabilities))
+	return nil
+}
+
+// Convert_core_MachineTypeRequirements_To_v1beta1_MachineTypeRequirements is an autogenerated conversion function.
+func Convert_core_MachineTypeRequirements_To_v1beta1_MachineTypeRequirements(in *core.MachineTypeRequirements, out *MachineTypeRequirements, s conversion.Scope) error {
+	return autoConvert_core_MachineTypeRequirements_To_v1beta1_MachineTypeRequirements(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineTypeStorage_To_core_MachineTypeStorage(in *MachineTypeStorage, out *core.MachineTypeStorage, s conversion.Scope) error {
 	out.Class = in.Class
 	out.StorageSize = (*resource.Quantity)(unsafe.Pointer(in.StorageSize))
@@ -4327,6 +6049,7 @@ func autoConvert_v1beta1_Maintenance_To_core_Maintenance(in *Maintenance, out *c
 	out.AutoUpdate = (*core.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.DependsOn = (*core.MaintenanceDependency)(unsafe.Pointer(in.DependsOn))
 	return nil
 }
 
@@ -4339,6 +6062,7 @@ func autoConvert_core_Maintenance_To_v1beta1_Maintenance(in *core.Maintenance, o
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.DependsOn = (*MaintenanceDependency)(unsafe.Pointer(in.DependsOn))
 	return nil
 }
 
@@ -4369,15 +6093,69 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
-func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
-	out.Begin = in.Begin
-	out.End = in.End
+func autoConvert_v1beta1_MaintenanceDependency_To_core_MaintenanceDependency(in *MaintenanceDependency, out *core.MaintenanceDependency, s conversion.Scope) error {
+	out.ShootName = in.ShootName
+	out.MinimumRunDuration = (*metav1.Duration)(unsafe.Pointer(in.MinimumRunDuration))
 	return nil
 }
 
-// Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow is an autogenerated conversion function.
-func Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
-	return autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in, out, s)
+// Convert_v1beta1_MaintenanceDependency_To_core_MaintenanceDependency is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceDependency_To_core_MaintenanceDependency(in *MaintenanceDependency, out *core.MaintenanceDependency, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceDependency_To_core_MaintenanceDependency(in, out, s)
+}
+
+func autoConvert_core_MaintenanceDependency_To_v1beta1_MaintenanceDependency(in *core.MaintenanceDependency, out *MaintenanceDependency, s conversion.Scope) error {
+	out.ShootName = in.ShootName
+	out.MinimumRunDuration = (*metav1.Duration)(unsafe.Pointer(in.MinimumRunDuration))
+	return nil
+}
+
+// Convert_core_MaintenanceDependency_To_v1beta1_MaintenanceDependency is an autogenerated conversion function.
+func Convert_core_MaintenanceDependency_To_v1beta1_MaintenanceDependency(in *core.MaintenanceDependency, out *MaintenanceDependency, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceDependency_To_v1beta1_MaintenanceDependency(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenancePlannedChange_To_core_MaintenancePlannedChange(in *MaintenancePlannedChange, out *core.MaintenancePlannedChange, s conversion.Scope) error {
+	out.Type = core.MaintenancePlannedChangeType(in.Type)
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.MachineImageName = (*string)(unsafe.Pointer(in.MachineImageName))
+	out.CurrentVersion = in.CurrentVersion
+	out.TargetVersion = in.TargetVersion
+	out.Reason = in.Reason
+	out.Forced = in.Forced
+	return nil
+}
+
+// Convert_v1beta1_MaintenancePlannedChange_To_core_MaintenancePlannedChange is an autogenerated conversion function.
+func Convert_v1beta1_MaintenancePlannedChange_To_core_MaintenancePlannedChange(in *MaintenancePlannedChange, out *core.MaintenancePlannedChange, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenancePlannedChange_To_core_MaintenancePlannedChange(in, out, s)
+}
+
+func autoConvert_core_MaintenancePlannedChange_To_v1beta1_MaintenancePlannedChange(in *core.MaintenancePlannedChange, out *MaintenancePlannedChange, s conversion.Scope) error {
+	out.Type = MaintenancePlannedChangeType(in.Type)
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.MachineImageName = (*string)(unsafe.Pointer(in.MachineImageName))
+	out.CurrentVersion = in.CurrentVersion
+	out.TargetVersion = in.TargetVersion
+	out.Reason = in.Reason
+	out.Forced = in.Forced
+	return nil
+}
+
+// Convert_core_MaintenancePlannedChange_To_v1beta1_MaintenancePlannedChange is an autogenerated conversion function.
+func Convert_core_MaintenancePlannedChange_To_v1beta1_MaintenancePlannedChange(in *core.MaintenancePlannedChange, out *MaintenancePlannedChange, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePlannedChange_To_v1beta1_MaintenancePlannedChange(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in, out, s)
 }
 
 func autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *core.MaintenanceTimeWindow, out *MaintenanceTimeWindow, s conversion.Scope) error {
@@ -4576,6 +6354,8 @@ func autoConvert_v1beta1_Networking_To_core_Networking(in *Networking, out *core
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
 	out.IPFamilies = *(*[]core.IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.AdditionalNodes = *(*[]string)(unsafe.Pointer(&in.AdditionalNodes))
+	out.PrivateLink = (*core.PrivateLink)(unsafe.Pointer(in.PrivateLink))
 	return nil
 }
 
@@ -4591,6 +6371,8 @@ func autoConvert_core_Networking_To_v1beta1_Networking(in *core.Networking, out
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
 	out.IPFamilies = *(*[]IPFamily)(unsafe.Pointer(&in.IPFamilies))
+	out.AdditionalNodes = *(*[]string)(unsafe.Pointer(&in.AdditionalNodes))
+	out.PrivateLink = (*PrivateLink)(unsafe.Pointer(in.PrivateLink))
 	return nil
 }
 
@@ -4599,6 +6381,26 @@ func Convert_core_Networking_To_v1beta1_Networking(in *core.Networking, out *Net
 	return autoConvert_core_Networking_To_v1beta1_Networking(in, out, s)
 }
 
+func autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *NetworkingStatus, out *core.NetworkingStatus, s conversion.Scope) error {
+	out.PathMTU = (*int32)(unsafe.Pointer(in.PathMTU))
+	return nil
+}
+
+// Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus is an autogenerated conversion function.
+func Convert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *NetworkingStatus, out *core.NetworkingStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in, out, s)
+}
+
+func autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.NetworkingStatus, out *NetworkingStatus, s conversion.Scope) error {
+	out.PathMTU = (*int32)(unsafe.Pointer(in.PathMTU))
+	return nil
+}
+
+// Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus is an autogenerated conversion function.
+func Convert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.NetworkingStatus, out *NetworkingStatus, s conversion.Scope) error {
+	return autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_NginxIngress_To_core_NginxIngress(in *NginxIngress, out *core.NginxIngress, s conversion.Scope) error {
 	if err := Convert_v1beta1_Addon_To_core_Addon(&in.Addon, &out.Addon, s); err != nil {
 		return err
@@ -4719,6 +6521,78 @@ func Convert_core_OIDCConfig_To_v1beta1_OIDCConfig(in *core.OIDCConfig, out *OID
 	return autoConvert_core_OIDCConfig_To_v1beta1_OIDCConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_Observability_To_core_Observability(in *Observability, out *core.Observability, s conversion.Scope) error {
+	out.Logging = (*core.ObservabilityLogging)(unsafe.Pointer(in.Logging))
+	out.EventArchive = (*core.EventArchive)(unsafe.Pointer(in.EventArchive))
+	out.Probes = *(*[]core.ObservabilityProbe)(unsafe.Pointer(&in.Probes))
+	return nil
+}
+
+// Convert_v1beta1_Observability_To_core_Observability is an autogenerated conversion function.
+func Convert_v1beta1_Observability_To_core_Observability(in *Observability, out *core.Observability, s conversion.Scope) error {
+	return autoConvert_v1beta1_Observability_To_core_Observability(in, out, s)
+}
+
+func autoConvert_core_Observability_To_v1beta1_Observability(in *core.Observability, out *Observability, s conversion.Scope) error {
+	out.Logging = (*ObservabilityLogging)(unsafe.Pointer(in.Logging))
+	out.EventArchive = (*EventArchive)(unsafe.Pointer(in.EventArchive))
+	out.Probes = *(*[]ObservabilityProbe)(unsafe.Pointer(&in.Probes))
+	return nil
+}
+
+// Convert_core_Observability_To_v1beta1_Observability is an autogenerated conversion function.
+func Convert_core_Observability_To_v1beta1_Observability(in *core.Observability, out *Observability, s conversion.Scope) error {
+	return autoConvert_core_Observability_To_v1beta1_Observability(in, out, s)
+}
+
+func autoConvert_v1beta1_ObservabilityLogging_To_core_ObservabilityLogging(in *ObservabilityLogging, out *core.ObservabilityLogging, s conversion.Scope) error {
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.IngestionRate = (*resource.Quantity)(unsafe.Pointer(in.IngestionRate))
+	out.IngestionBurstSize = (*resource.Quantity)(unsafe.Pointer(in.IngestionBurstSize))
+	return nil
+}
+
+// Convert_v1beta1_ObservabilityLogging_To_core_ObservabilityLogging is an autogenerated conversion function.
+func Convert_v1beta1_ObservabilityLogging_To_core_ObservabilityLogging(in *ObservabilityLogging, out *core.ObservabilityLogging, s conversion.Scope) error {
+	return autoConvert_v1beta1_ObservabilityLogging_To_core_ObservabilityLogging(in, out, s)
+}
+
+func autoConvert_core_ObservabilityLogging_To_v1beta1_ObservabilityLogging(in *core.ObservabilityLogging, out *ObservabilityLogging, s conversion.Scope) error {
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.IngestionRate = (*resource.Quantity)(unsafe.Pointer(in.IngestionRate))
+	out.IngestionBurstSize = (*resource.Quantity)(unsafe.Pointer(in.IngestionBurstSize))
+	return nil
+}
+
+// Convert_core_ObservabilityLogging_To_v1beta1_ObservabilityLogging is an autogenerated conversion function.
+func Convert_core_ObservabilityLogging_To_v1beta1_ObservabilityLogging(in *core.ObservabilityLogging, out *ObservabilityLogging, s conversion.Scope) error {
+	return autoConvert_core_ObservabilityLogging_To_v1beta1_ObservabilityLogging(in, out, s)
+}
+
+func autoConvert_v1beta1_ObservabilityProbe_To_core_ObservabilityProbe(in *ObservabilityProbe, out *core.ObservabilityProbe, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = core.ObservabilityProbeProtocol(in.Protocol)
+	out.Target = in.Target
+	return nil
+}
+
+// Convert_v1beta1_ObservabilityProbe_To_core_ObservabilityProbe is an autogenerated conversion function.
+func Convert_v1beta1_ObservabilityProbe_To_core_ObservabilityProbe(in *ObservabilityProbe, out *core.ObservabilityProbe, s conversion.Scope) error {
+	return autoConvert_v1beta1_ObservabilityProbe_To_core_ObservabilityProbe(in, out, s)
+}
+
+func autoConvert_core_ObservabilityProbe_To_v1beta1_ObservabilityProbe(in *core.ObservabilityProbe, out *ObservabilityProbe, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = ObservabilityProbeProtocol(in.Protocol)
+	out.Target = in.Target
+	return nil
+}
+
+// Convert_core_ObservabilityProbe_To_v1beta1_ObservabilityProbe is an autogenerated conversion function.
+func Convert_core_ObservabilityProbe_To_v1beta1_ObservabilityProbe(in *core.ObservabilityProbe, out *ObservabilityProbe, s conversion.Scope) error {
+	return autoConvert_core_ObservabilityProbe_To_v1beta1_ObservabilityProbe(in, out, s)
+}
+
 func autoConvert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(in *ObservabilityRotation, out *core.ObservabilityRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -4763,6 +6637,28 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_PrivateLink_To_core_PrivateLink(in *PrivateLink, out *core.PrivateLink, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_PrivateLink_To_core_PrivateLink is an autogenerated conversion function.
+func Convert_v1beta1_PrivateLink_To_core_PrivateLink(in *PrivateLink, out *core.PrivateLink, s conversion.Scope) error {
+	return autoConvert_v1beta1_PrivateLink_To_core_PrivateLink(in, out, s)
+}
+
+func autoConvert_core_PrivateLink_To_v1beta1_PrivateLink(in *core.PrivateLink, out *PrivateLink, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_core_PrivateLink_To_v1beta1_PrivateLink is an autogenerated conversion function.
+func Convert_core_PrivateLink_To_v1beta1_PrivateLink(in *core.PrivateLink, out *PrivateLink, s conversion.Scope) error {
+	return autoConvert_core_PrivateLink_To_v1beta1_PrivateLink(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4795,6 +6691,28 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectCustomRole_To_core_ProjectCustomRole(in *ProjectCustomRole, out *core.ProjectCustomRole, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Rules = *(*[]rbacv1.PolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1beta1_ProjectCustomRole_To_core_ProjectCustomRole is an autogenerated conversion function.
+func Convert_v1beta1_ProjectCustomRole_To_core_ProjectCustomRole(in *ProjectCustomRole, out *core.ProjectCustomRole, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectCustomRole_To_core_ProjectCustomRole(in, out, s)
+}
+
+func autoConvert_core_ProjectCustomRole_To_v1beta1_ProjectCustomRole(in *core.ProjectCustomRole, out *ProjectCustomRole, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Rules = *(*[]rbacv1.PolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_core_ProjectCustomRole_To_v1beta1_ProjectCustomRole is an autogenerated conversion function.
+func Convert_core_ProjectCustomRole_To_v1beta1_ProjectCustomRole(in *core.ProjectCustomRole, out *ProjectCustomRole, s conversion.Scope) error {
+	return autoConvert_core_ProjectCustomRole_To_v1beta1_ProjectCustomRole(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4850,6 +6768,28 @@ func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMem
 	return nil
 }
 
+func autoConvert_v1beta1_ProjectNamespaceResources_To_core_ProjectNamespaceResources(in *ProjectNamespaceResources, out *core.ProjectNamespaceResources, s conversion.Scope) error {
+	out.ResourceQuota = *(*v1.ResourceList)(unsafe.Pointer(&in.ResourceQuota))
+	out.LimitRange = *(*[]v1.LimitRangeItem)(unsafe.Pointer(&in.LimitRange))
+	return nil
+}
+
+// Convert_v1beta1_ProjectNamespaceResources_To_core_ProjectNamespaceResources is an autogenerated conversion function.
+func Convert_v1beta1_ProjectNamespaceResources_To_core_ProjectNamespaceResources(in *ProjectNamespaceResources, out *core.ProjectNamespaceResources, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectNamespaceResources_To_core_ProjectNamespaceResources(in, out, s)
+}
+
+func autoConvert_core_ProjectNamespaceResources_To_v1beta1_ProjectNamespaceResources(in *core.ProjectNamespaceResources, out *ProjectNamespaceResources, s conversion.Scope) error {
+	out.ResourceQuota = *(*v1.ResourceList)(unsafe.Pointer(&in.ResourceQuota))
+	out.LimitRange = *(*[]v1.LimitRangeItem)(unsafe.Pointer(&in.LimitRange))
+	return nil
+}
+
+// Convert_core_ProjectNamespaceResources_To_v1beta1_ProjectNamespaceResources is an autogenerated conversion function.
+func Convert_core_ProjectNamespaceResources_To_v1beta1_ProjectNamespaceResources(in *core.ProjectNamespaceResources, out *ProjectNamespaceResources, s conversion.Scope) error {
+	return autoConvert_core_ProjectNamespaceResources_To_v1beta1_ProjectNamespaceResources(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -4869,6 +6809,9 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.AlertRoutes = *(*[]core.AlertRoute)(unsafe.Pointer(&in.AlertRoutes))
+	out.NamespaceResources = (*core.ProjectNamespaceResources)(unsafe.Pointer(in.NamespaceResources))
+	out.CustomRoles = *(*[]core.ProjectCustomRole)(unsafe.Pointer(&in.CustomRoles))
 	return nil
 }
 
@@ -4891,6 +6834,9 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.AlertRoutes = *(*[]AlertRoute)(unsafe.Pointer(&in.AlertRoutes))
+	out.NamespaceResources = (*ProjectNamespaceResources)(unsafe.Pointer(in.NamespaceResources))
+	out.CustomRoles = *(*[]ProjectCustomRole)(unsafe.Pointer(&in.CustomRoles))
 	return nil
 }
 
@@ -4960,6 +6906,7 @@ func autoConvert_v1beta1_Provider_To_core_Provider(in *Provider, out *core.Provi
 		out.Workers = nil
 	}
 	out.WorkersSettings = (*core.WorkersSettings)(unsafe.Pointer(in.WorkersSettings))
+	out.ResourceTags = *(*map[string]string)(unsafe.Pointer(&in.ResourceTags))
 	return nil
 }
 
@@ -4984,6 +6931,7 @@ func autoConvert_core_Provider_To_v1beta1_Provider(in *core.Provider, out *Provi
 		out.Workers = nil
 	}
 	out.WorkersSettings = (*WorkersSettings)(unsafe.Pointer(in.WorkersSettings))
+	out.ResourceTags = *(*map[string]string)(unsafe.Pointer(&in.ResourceTags))
 	return nil
 }
 
@@ -5068,6 +7016,7 @@ func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s c
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.EgressOnlyInternetAccessSupported = (*bool)(unsafe.Pointer(in.EgressOnlyInternetAccessSupported))
 	return nil
 }
 
@@ -5080,6 +7029,7 @@ func autoConvert_core_Region_To_v1beta1_Region(in *core.Region, out *Region, s c
 	out.Name = in.Name
 	out.Zones = *(*[]AvailabilityZone)(unsafe.Pointer(&in.Zones))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.EgressOnlyInternetAccessSupported = (*bool)(unsafe.Pointer(in.EgressOnlyInternetAccessSupported))
 	return nil
 }
 
@@ -5134,6 +7084,28 @@ func Convert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in *c
 	return autoConvert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in, out, s)
 }
 
+func autoConvert_v1beta1_RunningVersion_To_core_RunningVersion(in *RunningVersion, out *core.RunningVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Since = in.Since
+	return nil
+}
+
+// Convert_v1beta1_RunningVersion_To_core_RunningVersion is an autogenerated conversion function.
+func Convert_v1beta1_RunningVersion_To_core_RunningVersion(in *RunningVersion, out *core.RunningVersion, s conversion.Scope) error {
+	return autoConvert_v1beta1_RunningVersion_To_core_RunningVersion(in, out, s)
+}
+
+func autoConvert_core_RunningVersion_To_v1beta1_RunningVersion(in *core.RunningVersion, out *RunningVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Since = in.Since
+	return nil
+}
+
+// Convert_core_RunningVersion_To_v1beta1_RunningVersion is an autogenerated conversion function.
+func Convert_core_RunningVersion_To_v1beta1_RunningVersion(in *core.RunningVersion, out *RunningVersion, s conversion.Scope) error {
+	return autoConvert_core_RunningVersion_To_v1beta1_RunningVersion(in, out, s)
+}
+
 func autoConvert_v1beta1_SSHAccess_To_core_SSHAccess(in *SSHAccess, out *core.SSHAccess, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -5322,6 +7294,34 @@ func Convert_core_SeedDNSProvider_To_v1beta1_SeedDNSProvider(in *core.SeedDNSPro
 	return autoConvert_core_SeedDNSProvider_To_v1beta1_SeedDNSProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedDecommissionStatus_To_core_SeedDecommissionStatus(in *SeedDecommissionStatus, out *core.SeedDecommissionStatus, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = in.MigratingShoots
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1beta1_SeedDecommissionStatus_To_core_SeedDecommissionStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedDecommissionStatus_To_core_SeedDecommissionStatus(in *SeedDecommissionStatus, out *core.SeedDecommissionStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDecommissionStatus_To_core_SeedDecommissionStatus(in, out, s)
+}
+
+func autoConvert_core_SeedDecommissionStatus_To_v1beta1_SeedDecommissionStatus(in *core.SeedDecommissionStatus, out *SeedDecommissionStatus, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = in.MigratingShoots
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_core_SeedDecommissionStatus_To_v1beta1_SeedDecommissionStatus is an autogenerated conversion function.
+func Convert_core_SeedDecommissionStatus_To_v1beta1_SeedDecommissionStatus(in *core.SeedDecommissionStatus, out *SeedDecommissionStatus, s conversion.Scope) error {
+	return autoConvert_core_SeedDecommissionStatus_To_v1beta1_SeedDecommissionStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_core_SeedList(in *SeedList, out *core.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5442,6 +7442,28 @@ func Convert_core_SeedSelector_To_v1beta1_SeedSelector(in *core.SeedSelector, ou
 	return autoConvert_core_SeedSelector_To_v1beta1_SeedSelector(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingAPIServerProxy_To_core_SeedSettingAPIServerProxy(in *SeedSettingAPIServerProxy, out *core.SeedSettingAPIServerProxy, s conversion.Scope) error {
+	out.MaxConnections = (*int32)(unsafe.Pointer(in.MaxConnections))
+	out.MaxConnectionsPerSecond = (*int32)(unsafe.Pointer(in.MaxConnectionsPerSecond))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingAPIServerProxy_To_core_SeedSettingAPIServerProxy is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingAPIServerProxy_To_core_SeedSettingAPIServerProxy(in *SeedSettingAPIServerProxy, out *core.SeedSettingAPIServerProxy, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingAPIServerProxy_To_core_SeedSettingAPIServerProxy(in, out, s)
+}
+
+func autoConvert_core_SeedSettingAPIServerProxy_To_v1beta1_SeedSettingAPIServerProxy(in *core.SeedSettingAPIServerProxy, out *SeedSettingAPIServerProxy, s conversion.Scope) error {
+	out.MaxConnections = (*int32)(unsafe.Pointer(in.MaxConnections))
+	out.MaxConnectionsPerSecond = (*int32)(unsafe.Pointer(in.MaxConnectionsPerSecond))
+	return nil
+}
+
+// Convert_core_SeedSettingAPIServerProxy_To_v1beta1_SeedSettingAPIServerProxy is an autogenerated conversion function.
+func Convert_core_SeedSettingAPIServerProxy_To_v1beta1_SeedSettingAPIServerProxy(in *core.SeedSettingAPIServerProxy, out *SeedSettingAPIServerProxy, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingAPIServerProxy_To_v1beta1_SeedSettingAPIServerProxy(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(in *SeedSettingDependencyWatchdog, out *core.SeedSettingDependencyWatchdog, s conversion.Scope) error {
 	out.Weeder = (*core.SeedSettingDependencyWatchdogWeeder)(unsafe.Pointer(in.Weeder))
 	out.Prober = (*core.SeedSettingDependencyWatchdogProber)(unsafe.Pointer(in.Prober))
@@ -5507,6 +7529,7 @@ func Convert_core_SeedSettingDependencyWatchdogWeeder_To_v1beta1_SeedSettingDepe
 func autoConvert_v1beta1_SeedSettingExcessCapacityReservation_To_core_SeedSettingExcessCapacityReservation(in *SeedSettingExcessCapacityReservation, out *core.SeedSettingExcessCapacityReservation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Configs = *(*[]core.SeedSettingExcessCapacityReservationConfig)(unsafe.Pointer(&in.Configs))
+	out.Profiles = *(*[]core.SeedSettingExcessCapacityReservationProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -5518,6 +7541,7 @@ func Convert_v1beta1_SeedSettingExcessCapacityReservation_To_core_SeedSettingExc
 func autoConvert_core_SeedSettingExcessCapacityReservation_To_v1beta1_SeedSettingExcessCapacityReservation(in *core.SeedSettingExcessCapacityReservation, out *SeedSettingExcessCapacityReservation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Configs = *(*[]SeedSettingExcessCapacityReservationConfig)(unsafe.Pointer(&in.Configs))
+	out.Profiles = *(*[]SeedSettingExcessCapacityReservationProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -5550,6 +7574,34 @@ func Convert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSett
 	return autoConvert_core_SeedSettingExcessCapacityReservationConfig_To_v1beta1_SeedSettingExcessCapacityReservationConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingExcessCapacityReservationProfile_To_core_SeedSettingExcessCapacityReservationProfile(in *SeedSettingExcessCapacityReservationProfile, out *core.SeedSettingExcessCapacityReservationProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingExcessCapacityReservationProfile_To_core_SeedSettingExcessCapacityReservationProfile is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingExcessCapacityReservationProfile_To_core_SeedSettingExcessCapacityReservationProfile(in *SeedSettingExcessCapacityReservationProfile, out *core.SeedSettingExcessCapacityReservationProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingExcessCapacityReservationProfile_To_core_SeedSettingExcessCapacityReservationProfile(in, out, s)
+}
+
+func autoConvert_core_SeedSettingExcessCapacityReservationProfile_To_v1beta1_SeedSettingExcessCapacityReservationProfile(in *core.SeedSettingExcessCapacityReservationProfile, out *SeedSettingExcessCapacityReservationProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	return nil
+}
+
+// Convert_core_SeedSettingExcessCapacityReservationProfile_To_v1beta1_SeedSettingExcessCapacityReservationProfile is an autogenerated conversion function.
+func Convert_core_SeedSettingExcessCapacityReservationProfile_To_v1beta1_SeedSettingExcessCapacityReservationProfile(in *core.SeedSettingExcessCapacityReservationProfile, out *SeedSettingExcessCapacityReservationProfile, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingExcessCapacityReservationProfile_To_v1beta1_SeedSettingExcessCapacityReservationProfile(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingLoadBalancerServices_To_core_SeedSettingLoadBalancerServices(in *SeedSettingLoadBalancerServices, out *core.SeedSettingLoadBalancerServices, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ExternalTrafficPolicy = (*v1.ServiceExternalTrafficPolicy)(unsafe.Pointer(in.ExternalTrafficPolicy))
@@ -5602,6 +7654,26 @@ func Convert_core_SeedSettingLoadBalancerServicesZones_To_v1beta1_SeedSettingLoa
 	return autoConvert_core_SeedSettingLoadBalancerServicesZones_To_v1beta1_SeedSettingLoadBalancerServicesZones(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingPrivateLink_To_core_SeedSettingPrivateLink(in *SeedSettingPrivateLink, out *core.SeedSettingPrivateLink, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingPrivateLink_To_core_SeedSettingPrivateLink is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingPrivateLink_To_core_SeedSettingPrivateLink(in *SeedSettingPrivateLink, out *core.SeedSettingPrivateLink, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingPrivateLink_To_core_SeedSettingPrivateLink(in, out, s)
+}
+
+func autoConvert_core_SeedSettingPrivateLink_To_v1beta1_SeedSettingPrivateLink(in *core.SeedSettingPrivateLink, out *SeedSettingPrivateLink, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_SeedSettingPrivateLink_To_v1beta1_SeedSettingPrivateLink is an autogenerated conversion function.
+func Convert_core_SeedSettingPrivateLink_To_v1beta1_SeedSettingPrivateLink(in *core.SeedSettingPrivateLink, out *SeedSettingPrivateLink, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingPrivateLink_To_v1beta1_SeedSettingPrivateLink(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingScheduling_To_core_SeedSettingScheduling(in *SeedSettingScheduling, out *core.SeedSettingScheduling, s conversion.Scope) error {
 	out.Visible = in.Visible
 	return nil
@@ -5669,6 +7741,8 @@ func autoConvert_v1beta1_SeedSettings_To_core_SeedSettings(in *SeedSettings, out
 	out.VerticalPodAutoscaler = (*core.SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*core.SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*core.SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.APIServerProxy = (*core.SeedSettingAPIServerProxy)(unsafe.Pointer(in.APIServerProxy))
+	out.PrivateLink = (*core.SeedSettingPrivateLink)(unsafe.Pointer(in.PrivateLink))
 	return nil
 }
 
@@ -5684,6 +7758,8 @@ func autoConvert_core_SeedSettings_To_v1beta1_SeedSettings(in *core.SeedSettings
 	out.VerticalPodAutoscaler = (*SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.APIServerProxy = (*SeedSettingAPIServerProxy)(unsafe.Pointer(in.APIServerProxy))
+	out.PrivateLink = (*SeedSettingPrivateLink)(unsafe.Pointer(in.PrivateLink))
 	return nil
 }
 
@@ -5707,6 +7783,7 @@ func autoConvert_v1beta1_SeedSpec_To_core_SeedSpec(in *SeedSpec, out *core.SeedS
 	out.Volume = (*core.SeedVolume)(unsafe.Pointer(in.Volume))
 	out.Settings = (*core.SeedSettings)(unsafe.Pointer(in.Settings))
 	out.Ingress = (*core.Ingress)(unsafe.Pointer(in.Ingress))
+	out.Operation = (*core.SeedOperation)(unsafe.Pointer(in.Operation))
 	return nil
 }
 
@@ -5730,6 +7807,7 @@ func autoConvert_core_SeedSpec_To_v1beta1_SeedSpec(in *core.SeedSpec, out *SeedS
 	out.Taints = *(*[]SeedTaint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*SeedVolume)(unsafe.Pointer(in.Volume))
 	out.Ingress = (*Ingress)(unsafe.Pointer(in.Ingress))
+	out.Operation = (*SeedOperation)(unsafe.Pointer(in.Operation))
 	return nil
 }
 
@@ -5748,6 +7826,10 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.ExposureClassHandlers = *(*[]core.ExposureClassHandlerStatus)(unsafe.Pointer(&in.ExposureClassHandlers))
+	out.SystemComponents = *(*[]core.SeedSystemComponent)(unsafe.Pointer(&in.SystemComponents))
+	out.Decommission = (*core.SeedDecommissionStatus)(unsafe.Pointer(in.Decommission))
+	out.FeatureGates = *(*[]core.FeatureGateStatus)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
 
@@ -5766,6 +7848,10 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.ExposureClassHandlers = *(*[]ExposureClassHandlerStatus)(unsafe.Pointer(&in.ExposureClassHandlers))
+	out.SystemComponents = *(*[]SeedSystemComponent)(unsafe.Pointer(&in.SystemComponents))
+	out.Decommission = (*SeedDecommissionStatus)(unsafe.Pointer(in.Decommission))
+	out.FeatureGates = *(*[]FeatureGateStatus)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
 
@@ -5774,6 +7860,32 @@ func Convert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out *See
 	return autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSystemComponent_To_core_SeedSystemComponent(in *SeedSystemComponent, out *core.SeedSystemComponent, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	out.ExpectedVersion = (*string)(unsafe.Pointer(in.ExpectedVersion))
+	out.VersionSkew = in.VersionSkew
+	return nil
+}
+
+// Convert_v1beta1_SeedSystemComponent_To_core_SeedSystemComponent is an autogenerated conversion function.
+func Convert_v1beta1_SeedSystemComponent_To_core_SeedSystemComponent(in *SeedSystemComponent, out *core.SeedSystemComponent, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSystemComponent_To_core_SeedSystemComponent(in, out, s)
+}
+
+func autoConvert_core_SeedSystemComponent_To_v1beta1_SeedSystemComponent(in *core.SeedSystemComponent, out *SeedSystemComponent, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	out.ExpectedVersion = (*string)(unsafe.Pointer(in.ExpectedVersion))
+	out.VersionSkew = in.VersionSkew
+	return nil
+}
+
+// Convert_core_SeedSystemComponent_To_v1beta1_SeedSystemComponent is an autogenerated conversion function.
+func Convert_core_SeedSystemComponent_To_v1beta1_SeedSystemComponent(in *core.SeedSystemComponent, out *SeedSystemComponent, s conversion.Scope) error {
+	return autoConvert_core_SeedSystemComponent_To_v1beta1_SeedSystemComponent(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedTaint_To_core_SeedTaint(in *SeedTaint, out *core.SeedTaint, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
@@ -5974,101 +8086,457 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
-	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+func autoConvert_v1beta1_ShootAvailability_To_core_ShootAvailability(in *ShootAvailability, out *core.ShootAvailability, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Objective = in.Objective
+	out.APIServer = in.APIServer
+	out.ErrorBudgetRemaining = in.ErrorBudgetRemaining
+	out.LastUpdateTime = in.LastUpdateTime
 	return nil
 }
 
-// Convert_v1beta1_ShootCredentials_To_core_ShootCredentials is an autogenerated conversion function.
-func Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in, out, s)
+// Convert_v1beta1_ShootAvailability_To_core_ShootAvailability is an autogenerated conversion function.
+func Convert_v1beta1_ShootAvailability_To_core_ShootAvailability(in *ShootAvailability, out *core.ShootAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAvailability_To_core_ShootAvailability(in, out, s)
 }
 
-func autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
-	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+func autoConvert_core_ShootAvailability_To_v1beta1_ShootAvailability(in *core.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	out.Period = in.Period
+	out.Objective = in.Objective
+	out.APIServer = in.APIServer
+	out.ErrorBudgetRemaining = in.ErrorBudgetRemaining
+	out.LastUpdateTime = in.LastUpdateTime
 	return nil
 }
 
-// Convert_core_ShootCredentials_To_v1beta1_ShootCredentials is an autogenerated conversion function.
-func Convert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
-	return autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in, out, s)
+// Convert_core_ShootAvailability_To_v1beta1_ShootAvailability is an autogenerated conversion function.
+func Convert_core_ShootAvailability_To_v1beta1_ShootAvailability(in *core.ShootAvailability, out *ShootAvailability, s conversion.Scope) error {
+	return autoConvert_core_ShootAvailability_To_v1beta1_ShootAvailability(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation(in *ShootCredentialsRotation, out *core.ShootCredentialsRotation, s conversion.Scope) error {
-	out.CertificateAuthorities = (*core.CARotation)(unsafe.Pointer(in.CertificateAuthorities))
-	out.Kubeconfig = (*core.ShootKubeconfigRotation)(unsafe.Pointer(in.Kubeconfig))
-	out.SSHKeypair = (*core.ShootSSHKeypairRotation)(unsafe.Pointer(in.SSHKeypair))
-	out.Observability = (*core.ObservabilityRotation)(unsafe.Pointer(in.Observability))
-	out.ServiceAccountKey = (*core.ServiceAccountKeyRotation)(unsafe.Pointer(in.ServiceAccountKey))
-	out.ETCDEncryptionKey = (*core.ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+func autoConvert_v1beta1_ShootClone_To_core_ShootClone(in *ShootClone, out *core.ShootClone, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootCloneSpec_To_core_ShootCloneSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootCloneStatus_To_core_ShootCloneStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation is an autogenerated conversion function.
-func Convert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation(in *ShootCredentialsRotation, out *core.ShootCredentialsRotation, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation(in, out, s)
+// Convert_v1beta1_ShootClone_To_core_ShootClone is an autogenerated conversion function.
+func Convert_v1beta1_ShootClone_To_core_ShootClone(in *ShootClone, out *core.ShootClone, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootClone_To_core_ShootClone(in, out, s)
 }
 
-func autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in *core.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
-	out.CertificateAuthorities = (*CARotation)(unsafe.Pointer(in.CertificateAuthorities))
-	out.Kubeconfig = (*ShootKubeconfigRotation)(unsafe.Pointer(in.Kubeconfig))
-	out.SSHKeypair = (*ShootSSHKeypairRotation)(unsafe.Pointer(in.SSHKeypair))
-	out.Observability = (*ObservabilityRotation)(unsafe.Pointer(in.Observability))
-	out.ServiceAccountKey = (*ServiceAccountKeyRotation)(unsafe.Pointer(in.ServiceAccountKey))
-	out.ETCDEncryptionKey = (*ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+func autoConvert_core_ShootClone_To_v1beta1_ShootClone(in *core.ShootClone, out *ShootClone, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootCloneSpec_To_v1beta1_ShootCloneSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootCloneStatus_To_v1beta1_ShootCloneStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation is an autogenerated conversion function.
-func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in *core.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
-	return autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in, out, s)
+// Convert_core_ShootClone_To_v1beta1_ShootClone is an autogenerated conversion function.
+func Convert_core_ShootClone_To_v1beta1_ShootClone(in *core.ShootClone, out *ShootClone, s conversion.Scope) error {
+	return autoConvert_core_ShootClone_To_v1beta1_ShootClone(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+func autoConvert_v1beta1_ShootCloneSpec_To_core_ShootCloneSpec(in *ShootCloneSpec, out *core.ShootCloneSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CopyWorkers = (*bool)(unsafe.Pointer(in.CopyWorkers))
+	out.CopyNetworking = (*bool)(unsafe.Pointer(in.CopyNetworking))
+	out.CopyAddons = (*bool)(unsafe.Pointer(in.CopyAddons))
 	return nil
 }
 
-// Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation is an autogenerated conversion function.
-func Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in, out, s)
+// Convert_v1beta1_ShootCloneSpec_To_core_ShootCloneSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootCloneSpec_To_core_ShootCloneSpec(in *ShootCloneSpec, out *core.ShootCloneSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCloneSpec_To_core_ShootCloneSpec(in, out, s)
 }
 
-func autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
-	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
-	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+func autoConvert_core_ShootCloneSpec_To_v1beta1_ShootCloneSpec(in *core.ShootCloneSpec, out *ShootCloneSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CopyWorkers = (*bool)(unsafe.Pointer(in.CopyWorkers))
+	out.CopyNetworking = (*bool)(unsafe.Pointer(in.CopyNetworking))
+	out.CopyAddons = (*bool)(unsafe.Pointer(in.CopyAddons))
 	return nil
 }
 
-// Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation is an autogenerated conversion function.
-func Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
-	return autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in, out, s)
+// Convert_core_ShootCloneSpec_To_v1beta1_ShootCloneSpec is an autogenerated conversion function.
+func Convert_core_ShootCloneSpec_To_v1beta1_ShootCloneSpec(in *core.ShootCloneSpec, out *ShootCloneSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootCloneSpec_To_v1beta1_ShootCloneSpec(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootList_To_core_ShootList(in *ShootList, out *core.ShootList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]core.Shoot, len(*in))
-		for i := range *in {
-			if err := Convert_v1beta1_Shoot_To_core_Shoot(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+func autoConvert_v1beta1_ShootCloneStatus_To_core_ShootCloneStatus(in *ShootCloneStatus, out *core.ShootCloneStatus, s conversion.Scope) error {
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(core.Shoot)
+		if err := Convert_v1beta1_Shoot_To_core_Shoot(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.Items = nil
+		out.Shoot = nil
 	}
 	return nil
 }
 
-// Convert_v1beta1_ShootList_To_core_ShootList is an autogenerated conversion function.
-func Convert_v1beta1_ShootList_To_core_ShootList(in *ShootList, out *core.ShootList, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootList_To_core_ShootList(in, out, s)
+// Convert_v1beta1_ShootCloneStatus_To_core_ShootCloneStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootCloneStatus_To_core_ShootCloneStatus(in *ShootCloneStatus, out *core.ShootCloneStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCloneStatus_To_core_ShootCloneStatus(in, out, s)
 }
 
-func autoConvert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *ShootList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
+func autoConvert_core_ShootCloneStatus_To_v1beta1_ShootCloneStatus(in *core.ShootCloneStatus, out *ShootCloneStatus, s conversion.Scope) error {
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(Shoot)
+		if err := Convert_core_Shoot_To_v1beta1_Shoot(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Shoot = nil
+	}
+	return nil
+}
+
+// Convert_core_ShootCloneStatus_To_v1beta1_ShootCloneStatus is an autogenerated conversion function.
+func Convert_core_ShootCloneStatus_To_v1beta1_ShootCloneStatus(in *core.ShootCloneStatus, out *ShootCloneStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootCloneStatus_To_v1beta1_ShootCloneStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in *ShootCostEstimate, out *core.ShootCostEstimate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootCostEstimateSpec_To_core_ShootCostEstimateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootCostEstimateStatus_To_core_ShootCostEstimateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate is an autogenerated conversion function.
+func Convert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in *ShootCostEstimate, out *core.ShootCostEstimate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCostEstimate_To_core_ShootCostEstimate(in, out, s)
+}
+
+func autoConvert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in *core.ShootCostEstimate, out *ShootCostEstimate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootCostEstimateSpec_To_v1beta1_ShootCostEstimateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootCostEstimateStatus_To_v1beta1_ShootCostEstimateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate is an autogenerated conversion function.
+func Convert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in *core.ShootCostEstimate, out *ShootCostEstimate, s conversion.Scope) error {
+	return autoConvert_core_ShootCostEstimate_To_v1beta1_ShootCostEstimate(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCostEstimateSpec_To_core_ShootCostEstimateSpec(in *ShootCostEstimateSpec, out *core.ShootCostEstimateSpec, s conversion.Scope) error {
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]core.Worker, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Worker_To_core_Worker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Workers = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootCostEstimateSpec_To_core_ShootCostEstimateSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootCostEstimateSpec_To_core_ShootCostEstimateSpec(in *ShootCostEstimateSpec, out *core.ShootCostEstimateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCostEstimateSpec_To_core_ShootCostEstimateSpec(in, out, s)
+}
+
+func autoConvert_core_ShootCostEstimateSpec_To_v1beta1_ShootCostEstimateSpec(in *core.ShootCostEstimateSpec, out *ShootCostEstimateSpec, s conversion.Scope) error {
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]Worker, len(*in))
+		for i := range *in {
+			if err := Convert_core_Worker_To_v1beta1_Worker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Workers = nil
+	}
+	return nil
+}
+
+// Convert_core_ShootCostEstimateSpec_To_v1beta1_ShootCostEstimateSpec is an autogenerated conversion function.
+func Convert_core_ShootCostEstimateSpec_To_v1beta1_ShootCostEstimateSpec(in *core.ShootCostEstimateSpec, out *ShootCostEstimateSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootCostEstimateSpec_To_v1beta1_ShootCostEstimateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCostEstimateStatus_To_core_ShootCostEstimateStatus(in *ShootCostEstimateStatus, out *core.ShootCostEstimateStatus, s conversion.Scope) error {
+	out.Workers = *(*[]core.WorkerCostEstimate)(unsafe.Pointer(&in.Workers))
+	out.MinimumMonthlyCost = in.MinimumMonthlyCost
+	out.MaximumMonthlyCost = in.MaximumMonthlyCost
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v1beta1_ShootCostEstimateStatus_To_core_ShootCostEstimateStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootCostEstimateStatus_To_core_ShootCostEstimateStatus(in *ShootCostEstimateStatus, out *core.ShootCostEstimateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCostEstimateStatus_To_core_ShootCostEstimateStatus(in, out, s)
+}
+
+func autoConvert_core_ShootCostEstimateStatus_To_v1beta1_ShootCostEstimateStatus(in *core.ShootCostEstimateStatus, out *ShootCostEstimateStatus, s conversion.Scope) error {
+	out.Workers = *(*[]WorkerCostEstimate)(unsafe.Pointer(&in.Workers))
+	out.MinimumMonthlyCost = in.MinimumMonthlyCost
+	out.MaximumMonthlyCost = in.MaximumMonthlyCost
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_core_ShootCostEstimateStatus_To_v1beta1_ShootCostEstimateStatus is an autogenerated conversion function.
+func Convert_core_ShootCostEstimateStatus_To_v1beta1_ShootCostEstimateStatus(in *core.ShootCostEstimateStatus, out *ShootCostEstimateStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootCostEstimateStatus_To_v1beta1_ShootCostEstimateStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
+	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_v1beta1_ShootCredentials_To_core_ShootCredentials is an autogenerated conversion function.
+func Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in, out, s)
+}
+
+func autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
+	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_core_ShootCredentials_To_v1beta1_ShootCredentials is an autogenerated conversion function.
+func Convert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
+	return autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation(in *ShootCredentialsRotation, out *core.ShootCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*core.CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	out.Kubeconfig = (*core.ShootKubeconfigRotation)(unsafe.Pointer(in.Kubeconfig))
+	out.SSHKeypair = (*core.ShootSSHKeypairRotation)(unsafe.Pointer(in.SSHKeypair))
+	out.Observability = (*core.ObservabilityRotation)(unsafe.Pointer(in.Observability))
+	out.ServiceAccountKey = (*core.ServiceAccountKeyRotation)(unsafe.Pointer(in.ServiceAccountKey))
+	out.ETCDEncryptionKey = (*core.ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	return nil
+}
+
+// Convert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation(in *ShootCredentialsRotation, out *core.ShootCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCredentialsRotation_To_core_ShootCredentialsRotation(in, out, s)
+}
+
+func autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in *core.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	out.Kubeconfig = (*ShootKubeconfigRotation)(unsafe.Pointer(in.Kubeconfig))
+	out.SSHKeypair = (*ShootSSHKeypairRotation)(unsafe.Pointer(in.SSHKeypair))
+	out.Observability = (*ObservabilityRotation)(unsafe.Pointer(in.Observability))
+	out.ServiceAccountKey = (*ServiceAccountKeyRotation)(unsafe.Pointer(in.ServiceAccountKey))
+	out.ETCDEncryptionKey = (*ETCDEncryptionKeyRotation)(unsafe.Pointer(in.ETCDEncryptionKey))
+	return nil
+}
+
+// Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation is an autogenerated conversion function.
+func Convert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in *core.ShootCredentialsRotation, out *ShootCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_core_ShootCredentialsRotation_To_v1beta1_ShootCredentialsRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootETCDStatus_To_core_ShootETCDStatus(in *ShootETCDStatus, out *core.ShootETCDStatus, s conversion.Scope) error {
+	out.Main = (*core.ETCDStatus)(unsafe.Pointer(in.Main))
+	out.Events = (*core.ETCDStatus)(unsafe.Pointer(in.Events))
+	return nil
+}
+
+// Convert_v1beta1_ShootETCDStatus_To_core_ShootETCDStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootETCDStatus_To_core_ShootETCDStatus(in *ShootETCDStatus, out *core.ShootETCDStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootETCDStatus_To_core_ShootETCDStatus(in, out, s)
+}
+
+func autoConvert_core_ShootETCDStatus_To_v1beta1_ShootETCDStatus(in *core.ShootETCDStatus, out *ShootETCDStatus, s conversion.Scope) error {
+	out.Main = (*ETCDStatus)(unsafe.Pointer(in.Main))
+	out.Events = (*ETCDStatus)(unsafe.Pointer(in.Events))
+	return nil
+}
+
+// Convert_core_ShootETCDStatus_To_v1beta1_ShootETCDStatus is an autogenerated conversion function.
+func Convert_core_ShootETCDStatus_To_v1beta1_ShootETCDStatus(in *core.ShootETCDStatus, out *ShootETCDStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootETCDStatus_To_v1beta1_ShootETCDStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootExport_To_core_ShootExport(in *ShootExport, out *core.ShootExport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootExportSpec_To_core_ShootExportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootExportStatus_To_core_ShootExportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootExport_To_core_ShootExport is an autogenerated conversion function.
+func Convert_v1beta1_ShootExport_To_core_ShootExport(in *ShootExport, out *core.ShootExport, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootExport_To_core_ShootExport(in, out, s)
+}
+
+func autoConvert_core_ShootExport_To_v1beta1_ShootExport(in *core.ShootExport, out *ShootExport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootExportSpec_To_v1beta1_ShootExportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ShootExportStatus_To_v1beta1_ShootExportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootExport_To_v1beta1_ShootExport is an autogenerated conversion function.
+func Convert_core_ShootExport_To_v1beta1_ShootExport(in *core.ShootExport, out *ShootExport, s conversion.Scope) error {
+	return autoConvert_core_ShootExport_To_v1beta1_ShootExport(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootExportSpec_To_core_ShootExportSpec(in *ShootExportSpec, out *core.ShootExportSpec, s conversion.Scope) error {
+	out.Target = (*core.ShootExportTarget)(unsafe.Pointer(in.Target))
+	return nil
+}
+
+// Convert_v1beta1_ShootExportSpec_To_core_ShootExportSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootExportSpec_To_core_ShootExportSpec(in *ShootExportSpec, out *core.ShootExportSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootExportSpec_To_core_ShootExportSpec(in, out, s)
+}
+
+func autoConvert_core_ShootExportSpec_To_v1beta1_ShootExportSpec(in *core.ShootExportSpec, out *ShootExportSpec, s conversion.Scope) error {
+	out.Target = (*ShootExportTarget)(unsafe.Pointer(in.Target))
+	return nil
+}
+
+// Convert_core_ShootExportSpec_To_v1beta1_ShootExportSpec is an autogenerated conversion function.
+func Convert_core_ShootExportSpec_To_v1beta1_ShootExportSpec(in *core.ShootExportSpec, out *ShootExportSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootExportSpec_To_v1beta1_ShootExportSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootExportStatus_To_core_ShootExportStatus(in *ShootExportStatus, out *core.ShootExportStatus, s conversion.Scope) error {
+	out.Profile = (*core.ShootProfile)(unsafe.Pointer(in.Profile))
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(core.Shoot)
+		if err := Convert_v1beta1_Shoot_To_core_Shoot(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Shoot = nil
+	}
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v1beta1_ShootExportStatus_To_core_ShootExportStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootExportStatus_To_core_ShootExportStatus(in *ShootExportStatus, out *core.ShootExportStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootExportStatus_To_core_ShootExportStatus(in, out, s)
+}
+
+func autoConvert_core_ShootExportStatus_To_v1beta1_ShootExportStatus(in *core.ShootExportStatus, out *ShootExportStatus, s conversion.Scope) error {
+	out.Profile = (*ShootProfile)(unsafe.Pointer(in.Profile))
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(Shoot)
+		if err := Convert_core_Shoot_To_v1beta1_Shoot(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Shoot = nil
+	}
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_core_ShootExportStatus_To_v1beta1_ShootExportStatus is an autogenerated conversion function.
+func Convert_core_ShootExportStatus_To_v1beta1_ShootExportStatus(in *core.ShootExportStatus, out *ShootExportStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootExportStatus_To_v1beta1_ShootExportStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootExportTarget_To_core_ShootExportTarget(in *ShootExportTarget, out *core.ShootExportTarget, s conversion.Scope) error {
+	out.CloudProfileName = in.CloudProfileName
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1beta1_ShootExportTarget_To_core_ShootExportTarget is an autogenerated conversion function.
+func Convert_v1beta1_ShootExportTarget_To_core_ShootExportTarget(in *ShootExportTarget, out *core.ShootExportTarget, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootExportTarget_To_core_ShootExportTarget(in, out, s)
+}
+
+func autoConvert_core_ShootExportTarget_To_v1beta1_ShootExportTarget(in *core.ShootExportTarget, out *ShootExportTarget, s conversion.Scope) error {
+	out.CloudProfileName = in.CloudProfileName
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_core_ShootExportTarget_To_v1beta1_ShootExportTarget is an autogenerated conversion function.
+func Convert_core_ShootExportTarget_To_v1beta1_ShootExportTarget(in *core.ShootExportTarget, out *ShootExportTarget, s conversion.Scope) error {
+	return autoConvert_core_ShootExportTarget_To_v1beta1_ShootExportTarget(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation is an autogenerated conversion function.
+func Convert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in *ShootKubeconfigRotation, out *core.ShootKubeconfigRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootKubeconfigRotation_To_core_ShootKubeconfigRotation(in, out, s)
+}
+
+func autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	return nil
+}
+
+// Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation is an autogenerated conversion function.
+func Convert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in *core.ShootKubeconfigRotation, out *ShootKubeconfigRotation, s conversion.Scope) error {
+	return autoConvert_core_ShootKubeconfigRotation_To_v1beta1_ShootKubeconfigRotation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootList_To_core_ShootList(in *ShootList, out *core.ShootList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]core.Shoot, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Shoot_To_core_Shoot(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootList_To_core_ShootList is an autogenerated conversion function.
+func Convert_v1beta1_ShootList_To_core_ShootList(in *ShootList, out *core.ShootList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootList_To_core_ShootList(in, out, s)
+}
+
+func autoConvert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *ShootList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]Shoot, len(*in))
@@ -6083,59 +8551,279 @@ func autoConvert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *Sh
 	return nil
 }
 
-// Convert_core_ShootList_To_v1beta1_ShootList is an autogenerated conversion function.
-func Convert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *ShootList, s conversion.Scope) error {
-	return autoConvert_core_ShootList_To_v1beta1_ShootList(in, out, s)
+// Convert_core_ShootList_To_v1beta1_ShootList is an autogenerated conversion function.
+func Convert_core_ShootList_To_v1beta1_ShootList(in *core.ShootList, out *ShootList, s conversion.Scope) error {
+	return autoConvert_core_ShootList_To_v1beta1_ShootList(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachineImage, out *core.ShootMachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	if err := metav1.Convert_Pointer_string_To_string(&in.Version, &out.Version, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage is an autogenerated conversion function.
+func Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachineImage, out *core.ShootMachineImage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in, out, s)
+}
+
+func autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootMachineImage, out *ShootMachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	if err := metav1.Convert_string_To_Pointer_string(&in.Version, &out.Version, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage is an autogenerated conversion function.
+func Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootMachineImage, out *ShootMachineImage, s conversion.Scope) error {
+	return autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *core.ShootMaintenanceStatus, s conversion.Scope) error {
+	out.PlannedChanges = *(*[]core.MaintenancePlannedChange)(unsafe.Pointer(&in.PlannedChanges))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RunningVersions = (*core.ShootRunningVersions)(unsafe.Pointer(in.RunningVersions))
+	return nil
+}
+
+// Convert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *core.ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMaintenanceStatus_To_core_ShootMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in *core.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	out.PlannedChanges = *(*[]MaintenancePlannedChange)(unsafe.Pointer(&in.PlannedChanges))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RunningVersions = (*ShootRunningVersions)(unsafe.Pointer(in.RunningVersions))
+	return nil
+}
+
+// Convert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in *core.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_core_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootNetworks_To_core_ShootNetworks(in *ShootNetworks, out *core.ShootNetworks, s conversion.Scope) error {
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.Services = (*string)(unsafe.Pointer(in.Services))
+	return nil
+}
+
+// Convert_v1beta1_ShootNetworks_To_core_ShootNetworks is an autogenerated conversion function.
+func Convert_v1beta1_ShootNetworks_To_core_ShootNetworks(in *ShootNetworks, out *core.ShootNetworks, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootNetworks_To_core_ShootNetworks(in, out, s)
+}
+
+func autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks, out *ShootNetworks, s conversion.Scope) error {
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.Services = (*string)(unsafe.Pointer(in.Services))
+	return nil
+}
+
+// Convert_core_ShootNetworks_To_v1beta1_ShootNetworks is an autogenerated conversion function.
+func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks, out *ShootNetworks, s conversion.Scope) error {
+	return autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootProfile_To_core_ShootProfile(in *ShootProfile, out *core.ShootProfile, s conversion.Scope) error {
+	out.Version = in.Version
+	out.KubernetesVersion = in.KubernetesVersion
+	out.Purpose = (*core.ShootPurpose)(unsafe.Pointer(in.Purpose))
+	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Networking = (*core.Networking)(unsafe.Pointer(in.Networking))
+	out.Maintenance = (*core.Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Hibernation = (*core.Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.Workers = *(*[]core.WorkerProfile)(unsafe.Pointer(&in.Workers))
+	return nil
+}
+
+// Convert_v1beta1_ShootProfile_To_core_ShootProfile is an autogenerated conversion function.
+func Convert_v1beta1_ShootProfile_To_core_ShootProfile(in *ShootProfile, out *core.ShootProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootProfile_To_core_ShootProfile(in, out, s)
+}
+
+func autoConvert_core_ShootProfile_To_v1beta1_ShootProfile(in *core.ShootProfile, out *ShootProfile, s conversion.Scope) error {
+	out.Version = in.Version
+	out.KubernetesVersion = in.KubernetesVersion
+	out.Purpose = (*ShootPurpose)(unsafe.Pointer(in.Purpose))
+	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Networking = (*Networking)(unsafe.Pointer(in.Networking))
+	out.Maintenance = (*Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.Workers = *(*[]WorkerProfile)(unsafe.Pointer(&in.Workers))
+	return nil
+}
+
+// Convert_core_ShootProfile_To_v1beta1_ShootProfile is an autogenerated conversion function.
+func Convert_core_ShootProfile_To_v1beta1_ShootProfile(in *core.ShootProfile, out *ShootProfile, s conversion.Scope) error {
+	return autoConvert_core_ShootProfile_To_v1beta1_ShootProfile(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootReadinessGate_To_core_ShootReadinessGate(in *ShootReadinessGate, out *core.ShootReadinessGate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.HTTPGet = (*core.ShootReadinessGateHTTPGet)(unsafe.Pointer(in.HTTPGet))
+	out.CustomResourceDefinition = (*core.ShootReadinessGateCustomResourceDefinition)(unsafe.Pointer(in.CustomResourceDefinition))
+	return nil
+}
+
+// Convert_v1beta1_ShootReadinessGate_To_core_ShootReadinessGate is an autogenerated conversion function.
+func Convert_v1beta1_ShootReadinessGate_To_core_ShootReadinessGate(in *ShootReadinessGate, out *core.ShootReadinessGate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootReadinessGate_To_core_ShootReadinessGate(in, out, s)
+}
+
+func autoConvert_core_ShootReadinessGate_To_v1beta1_ShootReadinessGate(in *core.ShootReadinessGate, out *ShootReadinessGate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.HTTPGet = (*ShootReadinessGateHTTPGet)(unsafe.Pointer(in.HTTPGet))
+	out.CustomResourceDefinition = (*ShootReadinessGateCustomResourceDefinition)(unsafe.Pointer(in.CustomResourceDefinition))
+	return nil
+}
+
+// Convert_core_ShootReadinessGate_To_v1beta1_ShootReadinessGate is an autogenerated conversion function.
+func Convert_core_ShootReadinessGate_To_v1beta1_ShootReadinessGate(in *core.ShootReadinessGate, out *ShootReadinessGate, s conversion.Scope) error {
+	return autoConvert_core_ShootReadinessGate_To_v1beta1_ShootReadinessGate(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootReadinessGateCustomResourceDefinition_To_core_ShootReadinessGateCustomResourceDefinition(in *ShootReadinessGateCustomResourceDefinition, out *core.ShootReadinessGateCustomResourceDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ShootReadinessGateCustomResourceDefinition_To_core_ShootReadinessGateCustomResourceDefinition is an autogenerated conversion function.
+func Convert_v1beta1_ShootReadinessGateCustomResourceDefinition_To_core_ShootReadinessGateCustomResourceDefinition(in *ShootReadinessGateCustomResourceDefinition, out *core.ShootReadinessGateCustomResourceDefinition, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootReadinessGateCustomResourceDefinition_To_core_ShootReadinessGateCustomResourceDefinition(in, out, s)
+}
+
+func autoConvert_core_ShootReadinessGateCustomResourceDefinition_To_v1beta1_ShootReadinessGateCustomResourceDefinition(in *core.ShootReadinessGateCustomResourceDefinition, out *ShootReadinessGateCustomResourceDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_ShootReadinessGateCustomResourceDefinition_To_v1beta1_ShootReadinessGateCustomResourceDefinition is an autogenerated conversion function.
+func Convert_core_ShootReadinessGateCustomResourceDefinition_To_v1beta1_ShootReadinessGateCustomResourceDefinition(in *core.ShootReadinessGateCustomResourceDefinition, out *ShootReadinessGateCustomResourceDefinition, s conversion.Scope) error {
+	return autoConvert_core_ShootReadinessGateCustomResourceDefinition_To_v1beta1_ShootReadinessGateCustomResourceDefinition(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootReadinessGateHTTPGet_To_core_ShootReadinessGateHTTPGet(in *ShootReadinessGateHTTPGet, out *core.ShootReadinessGateHTTPGet, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Service = in.Service
+	out.Port = in.Port
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.Scheme = (*string)(unsafe.Pointer(in.Scheme))
+	return nil
+}
+
+// Convert_v1beta1_ShootReadinessGateHTTPGet_To_core_ShootReadinessGateHTTPGet is an autogenerated conversion function.
+func Convert_v1beta1_ShootReadinessGateHTTPGet_To_core_ShootReadinessGateHTTPGet(in *ShootReadinessGateHTTPGet, out *core.ShootReadinessGateHTTPGet, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootReadinessGateHTTPGet_To_core_ShootReadinessGateHTTPGet(in, out, s)
+}
+
+func autoConvert_core_ShootReadinessGateHTTPGet_To_v1beta1_ShootReadinessGateHTTPGet(in *core.ShootReadinessGateHTTPGet, out *ShootReadinessGateHTTPGet, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Service = in.Service
+	out.Port = in.Port
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.Scheme = (*string)(unsafe.Pointer(in.Scheme))
+	return nil
+}
+
+// Convert_core_ShootReadinessGateHTTPGet_To_v1beta1_ShootReadinessGateHTTPGet is an autogenerated conversion function.
+func Convert_core_ShootReadinessGateHTTPGet_To_v1beta1_ShootReadinessGateHTTPGet(in *core.ShootReadinessGateHTTPGet, out *ShootReadinessGateHTTPGet, s conversion.Scope) error {
+	return autoConvert_core_ShootReadinessGateHTTPGet_To_v1beta1_ShootReadinessGateHTTPGet(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootReconciliation_To_core_ShootReconciliation(in *ShootReconciliation, out *core.ShootReconciliation, s conversion.Scope) error {
+	out.Paused = in.Paused
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.PausedUntil = (*metav1.Time)(unsafe.Pointer(in.PausedUntil))
+	return nil
+}
+
+// Convert_v1beta1_ShootReconciliation_To_core_ShootReconciliation is an autogenerated conversion function.
+func Convert_v1beta1_ShootReconciliation_To_core_ShootReconciliation(in *ShootReconciliation, out *core.ShootReconciliation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootReconciliation_To_core_ShootReconciliation(in, out, s)
+}
+
+func autoConvert_core_ShootReconciliation_To_v1beta1_ShootReconciliation(in *core.ShootReconciliation, out *ShootReconciliation, s conversion.Scope) error {
+	out.Paused = in.Paused
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.PausedUntil = (*metav1.Time)(unsafe.Pointer(in.PausedUntil))
+	return nil
+}
+
+// Convert_core_ShootReconciliation_To_v1beta1_ShootReconciliation is an autogenerated conversion function.
+func Convert_core_ShootReconciliation_To_v1beta1_ShootReconciliation(in *core.ShootReconciliation, out *ShootReconciliation, s conversion.Scope) error {
+	return autoConvert_core_ShootReconciliation_To_v1beta1_ShootReconciliation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootRevision_To_core_ShootRevision(in *ShootRevision, out *core.ShootRevision, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Revisions = *(*[]core.ShootSpecChange)(unsafe.Pointer(&in.Revisions))
+	return nil
+}
+
+// Convert_v1beta1_ShootRevision_To_core_ShootRevision is an autogenerated conversion function.
+func Convert_v1beta1_ShootRevision_To_core_ShootRevision(in *ShootRevision, out *core.ShootRevision, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootRevision_To_core_ShootRevision(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachineImage, out *core.ShootMachineImage, s conversion.Scope) error {
-	out.Name = in.Name
-	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	if err := metav1.Convert_Pointer_string_To_string(&in.Version, &out.Version, s); err != nil {
-		return err
-	}
+func autoConvert_core_ShootRevision_To_v1beta1_ShootRevision(in *core.ShootRevision, out *ShootRevision, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Revisions = *(*[]ShootSpecChange)(unsafe.Pointer(&in.Revisions))
 	return nil
 }
 
-// Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage is an autogenerated conversion function.
-func Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in *ShootMachineImage, out *core.ShootMachineImage, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(in, out, s)
+// Convert_core_ShootRevision_To_v1beta1_ShootRevision is an autogenerated conversion function.
+func Convert_core_ShootRevision_To_v1beta1_ShootRevision(in *core.ShootRevision, out *ShootRevision, s conversion.Scope) error {
+	return autoConvert_core_ShootRevision_To_v1beta1_ShootRevision(in, out, s)
 }
 
-func autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootMachineImage, out *ShootMachineImage, s conversion.Scope) error {
-	out.Name = in.Name
-	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
-	if err := metav1.Convert_string_To_Pointer_string(&in.Version, &out.Version, s); err != nil {
-		return err
-	}
+func autoConvert_v1beta1_ShootRevisionList_To_core_ShootRevisionList(in *ShootRevisionList, out *core.ShootRevisionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ShootRevision)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage is an autogenerated conversion function.
-func Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in *core.ShootMachineImage, out *ShootMachineImage, s conversion.Scope) error {
-	return autoConvert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(in, out, s)
+// Convert_v1beta1_ShootRevisionList_To_core_ShootRevisionList is an autogenerated conversion function.
+func Convert_v1beta1_ShootRevisionList_To_core_ShootRevisionList(in *ShootRevisionList, out *core.ShootRevisionList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootRevisionList_To_core_ShootRevisionList(in, out, s)
 }
 
-func autoConvert_v1beta1_ShootNetworks_To_core_ShootNetworks(in *ShootNetworks, out *core.ShootNetworks, s conversion.Scope) error {
-	out.Pods = (*string)(unsafe.Pointer(in.Pods))
-	out.Services = (*string)(unsafe.Pointer(in.Services))
+func autoConvert_core_ShootRevisionList_To_v1beta1_ShootRevisionList(in *core.ShootRevisionList, out *ShootRevisionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootRevision)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1beta1_ShootNetworks_To_core_ShootNetworks is an autogenerated conversion function.
-func Convert_v1beta1_ShootNetworks_To_core_ShootNetworks(in *ShootNetworks, out *core.ShootNetworks, s conversion.Scope) error {
-	return autoConvert_v1beta1_ShootNetworks_To_core_ShootNetworks(in, out, s)
+// Convert_core_ShootRevisionList_To_v1beta1_ShootRevisionList is an autogenerated conversion function.
+func Convert_core_ShootRevisionList_To_v1beta1_ShootRevisionList(in *core.ShootRevisionList, out *ShootRevisionList, s conversion.Scope) error {
+	return autoConvert_core_ShootRevisionList_To_v1beta1_ShootRevisionList(in, out, s)
 }
 
-func autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks, out *ShootNetworks, s conversion.Scope) error {
-	out.Pods = (*string)(unsafe.Pointer(in.Pods))
-	out.Services = (*string)(unsafe.Pointer(in.Services))
+func autoConvert_v1beta1_ShootRunningVersions_To_core_ShootRunningVersions(in *ShootRunningVersions, out *core.ShootRunningVersions, s conversion.Scope) error {
+	out.Kubernetes = (*core.RunningVersion)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = *(*[]core.MachineImageRunningVersion)(unsafe.Pointer(&in.MachineImages))
 	return nil
 }
 
-// Convert_core_ShootNetworks_To_v1beta1_ShootNetworks is an autogenerated conversion function.
-func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks, out *ShootNetworks, s conversion.Scope) error {
-	return autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in, out, s)
+// Convert_v1beta1_ShootRunningVersions_To_core_ShootRunningVersions is an autogenerated conversion function.
+func Convert_v1beta1_ShootRunningVersions_To_core_ShootRunningVersions(in *ShootRunningVersions, out *core.ShootRunningVersions, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootRunningVersions_To_core_ShootRunningVersions(in, out, s)
+}
+
+func autoConvert_core_ShootRunningVersions_To_v1beta1_ShootRunningVersions(in *core.ShootRunningVersions, out *ShootRunningVersions, s conversion.Scope) error {
+	out.Kubernetes = (*RunningVersion)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = *(*[]MachineImageRunningVersion)(unsafe.Pointer(&in.MachineImages))
+	return nil
+}
+
+// Convert_core_ShootRunningVersions_To_v1beta1_ShootRunningVersions is an autogenerated conversion function.
+func Convert_core_ShootRunningVersions_To_v1beta1_ShootRunningVersions(in *core.ShootRunningVersions, out *ShootRunningVersions, s conversion.Scope) error {
+	return autoConvert_core_ShootRunningVersions_To_v1beta1_ShootRunningVersions(in, out, s)
 }
 
 func autoConvert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in *ShootSSHKeypairRotation, out *core.ShootSSHKeypairRotation, s conversion.Scope) error {
@@ -6160,6 +8848,32 @@ func Convert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in
 	return autoConvert_core_ShootSSHKeypairRotation_To_v1beta1_ShootSSHKeypairRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootSecurityAdvisory_To_core_ShootSecurityAdvisory(in *ShootSecurityAdvisory, out *core.ShootSecurityAdvisory, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = (*string)(unsafe.Pointer(in.Severity))
+	out.Summary = (*string)(unsafe.Pointer(in.Summary))
+	out.WorkerPools = *(*[]string)(unsafe.Pointer(&in.WorkerPools))
+	return nil
+}
+
+// Convert_v1beta1_ShootSecurityAdvisory_To_core_ShootSecurityAdvisory is an autogenerated conversion function.
+func Convert_v1beta1_ShootSecurityAdvisory_To_core_ShootSecurityAdvisory(in *ShootSecurityAdvisory, out *core.ShootSecurityAdvisory, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSecurityAdvisory_To_core_ShootSecurityAdvisory(in, out, s)
+}
+
+func autoConvert_core_ShootSecurityAdvisory_To_v1beta1_ShootSecurityAdvisory(in *core.ShootSecurityAdvisory, out *ShootSecurityAdvisory, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = (*string)(unsafe.Pointer(in.Severity))
+	out.Summary = (*string)(unsafe.Pointer(in.Summary))
+	out.WorkerPools = *(*[]string)(unsafe.Pointer(&in.WorkerPools))
+	return nil
+}
+
+// Convert_core_ShootSecurityAdvisory_To_v1beta1_ShootSecurityAdvisory is an autogenerated conversion function.
+func Convert_core_ShootSecurityAdvisory_To_v1beta1_ShootSecurityAdvisory(in *core.ShootSecurityAdvisory, out *ShootSecurityAdvisory, s conversion.Scope) error {
+	return autoConvert_core_ShootSecurityAdvisory_To_v1beta1_ShootSecurityAdvisory(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*core.Addons)(unsafe.Pointer(in.Addons))
 	out.CloudProfileName = in.CloudProfileName
@@ -6187,6 +8901,9 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
+	out.Observability = (*core.Observability)(unsafe.Pointer(in.Observability))
+	out.ReadinessGates = *(*[]core.ShootReadinessGate)(unsafe.Pointer(&in.ReadinessGates))
+	out.Reconciliation = (*core.ShootReconciliation)(unsafe.Pointer(in.Reconciliation))
 	return nil
 }
 
@@ -6222,6 +8939,9 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
+	out.Observability = (*Observability)(unsafe.Pointer(in.Observability))
+	out.ReadinessGates = *(*[]ShootReadinessGate)(unsafe.Pointer(&in.ReadinessGates))
+	out.Reconciliation = (*ShootReconciliation)(unsafe.Pointer(in.Reconciliation))
 	return nil
 }
 
@@ -6230,6 +8950,32 @@ func Convert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *ShootS
 	return autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootSpecChange_To_core_ShootSpecChange(in *ShootSpecChange, out *core.ShootSpecChange, s conversion.Scope) error {
+	out.Generation = in.Generation
+	out.Timestamp = in.Timestamp
+	out.User = in.User
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_v1beta1_ShootSpecChange_To_core_ShootSpecChange is an autogenerated conversion function.
+func Convert_v1beta1_ShootSpecChange_To_core_ShootSpecChange(in *ShootSpecChange, out *core.ShootSpecChange, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootSpecChange_To_core_ShootSpecChange(in, out, s)
+}
+
+func autoConvert_core_ShootSpecChange_To_v1beta1_ShootSpecChange(in *core.ShootSpecChange, out *ShootSpecChange, s conversion.Scope) error {
+	out.Generation = in.Generation
+	out.Timestamp = in.Timestamp
+	out.User = in.User
+	out.Patch = in.Patch
+	return nil
+}
+
+// Convert_core_ShootSpecChange_To_v1beta1_ShootSpecChange is an autogenerated conversion function.
+func Convert_core_ShootSpecChange_To_v1beta1_ShootSpecChange(in *core.ShootSpecChange, out *ShootSpecChange, s conversion.Scope) error {
+	return autoConvert_core_ShootSpecChange_To_v1beta1_ShootSpecChange(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootState_To_core_ShootState(in *ShootState, out *core.ShootState, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ShootStateSpec_To_core_ShootStateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -6323,6 +9069,23 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.Availability = (*core.ShootAvailability)(unsafe.Pointer(in.Availability))
+	out.LeftoverResources = (*core.LeftoverResources)(unsafe.Pointer(in.LeftoverResources))
+	out.ETCD = (*core.ShootETCDStatus)(unsafe.Pointer(in.ETCD))
+	if in.WorkersRollout != nil {
+		in, out := &in.WorkersRollout, &out.WorkersRollout
+		*out = make([]core.WorkerPoolRollout, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_WorkerPoolRollout_To_core_WorkerPoolRollout(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WorkersRollout = nil
+	}
+	out.SecurityAdvisories = *(*[]core.ShootSecurityAdvisory)(unsafe.Pointer(&in.SecurityAdvisories))
+	out.Maintenance = (*core.ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	return nil
 }
 
@@ -6352,6 +9115,23 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.Availability = (*ShootAvailability)(unsafe.Pointer(in.Availability))
+	out.LeftoverResources = (*LeftoverResources)(unsafe.Pointer(in.LeftoverResources))
+	out.ETCD = (*ShootETCDStatus)(unsafe.Pointer(in.ETCD))
+	if in.WorkersRollout != nil {
+		in, out := &in.WorkersRollout, &out.WorkersRollout
+		*out = make([]WorkerPoolRollout, len(*in))
+		for i := range *in {
+			if err := Convert_core_WorkerPoolRollout_To_v1beta1_WorkerPoolRollout(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WorkersRollout = nil
+	}
+	out.SecurityAdvisories = *(*[]ShootSecurityAdvisory)(unsafe.Pointer(&in.SecurityAdvisories))
+	out.Maintenance = (*ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	return nil
 }
 
@@ -6386,9 +9166,32 @@ func Convert_core_ShootTemplate_To_v1beta1_ShootTemplate(in *core.ShootTemplate,
 	return autoConvert_core_ShootTemplate_To_v1beta1_ShootTemplate(in, out, s)
 }
 
+func autoConvert_v1beta1_SlackAlertReceiver_To_core_SlackAlertReceiver(in *SlackAlertReceiver, out *core.SlackAlertReceiver, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_v1beta1_SlackAlertReceiver_To_core_SlackAlertReceiver is an autogenerated conversion function.
+func Convert_v1beta1_SlackAlertReceiver_To_core_SlackAlertReceiver(in *SlackAlertReceiver, out *core.SlackAlertReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_SlackAlertReceiver_To_core_SlackAlertReceiver(in, out, s)
+}
+
+func autoConvert_core_SlackAlertReceiver_To_v1beta1_SlackAlertReceiver(in *core.SlackAlertReceiver, out *SlackAlertReceiver, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Channel = (*string)(unsafe.Pointer(in.Channel))
+	return nil
+}
+
+// Convert_core_SlackAlertReceiver_To_v1beta1_SlackAlertReceiver is an autogenerated conversion function.
+func Convert_core_SlackAlertReceiver_To_v1beta1_SlackAlertReceiver(in *core.SlackAlertReceiver, out *SlackAlertReceiver, s conversion.Scope) error {
+	return autoConvert_core_SlackAlertReceiver_To_v1beta1_SlackAlertReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemComponents, out *core.SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*core.CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*core.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.PriorityClasses = *(*[]core.SystemComponentsPriorityClass)(unsafe.Pointer(&in.PriorityClasses))
 	return nil
 }
 
@@ -6400,6 +9203,7 @@ func Convert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemCompone
 func autoConvert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemComponents, out *SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.PriorityClasses = *(*[]SystemComponentsPriorityClass)(unsafe.Pointer(&in.PriorityClasses))
 	return nil
 }
 
@@ -6408,6 +9212,30 @@ func Convert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemCo
 	return autoConvert_core_SystemComponents_To_v1beta1_SystemComponents(in, out, s)
 }
 
+func autoConvert_v1beta1_SystemComponentsPriorityClass_To_core_SystemComponentsPriorityClass(in *SystemComponentsPriorityClass, out *core.SystemComponentsPriorityClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_v1beta1_SystemComponentsPriorityClass_To_core_SystemComponentsPriorityClass is an autogenerated conversion function.
+func Convert_v1beta1_SystemComponentsPriorityClass_To_core_SystemComponentsPriorityClass(in *SystemComponentsPriorityClass, out *core.SystemComponentsPriorityClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_SystemComponentsPriorityClass_To_core_SystemComponentsPriorityClass(in, out, s)
+}
+
+func autoConvert_core_SystemComponentsPriorityClass_To_v1beta1_SystemComponentsPriorityClass(in *core.SystemComponentsPriorityClass, out *SystemComponentsPriorityClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_core_SystemComponentsPriorityClass_To_v1beta1_SystemComponentsPriorityClass is an autogenerated conversion function.
+func Convert_core_SystemComponentsPriorityClass_To_v1beta1_SystemComponentsPriorityClass(in *core.SystemComponentsPriorityClass, out *SystemComponentsPriorityClass, s conversion.Scope) error {
+	return autoConvert_core_SystemComponentsPriorityClass_To_v1beta1_SystemComponentsPriorityClass(in, out, s)
+}
+
 func autoConvert_v1beta1_Toleration_To_core_Toleration(in *Toleration, out *core.Toleration, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
@@ -6430,6 +9258,78 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionPolicy_To_core_VersionPolicy(in *VersionPolicy, out *core.VersionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_VersionPolicySpec_To_core_VersionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VersionPolicy_To_core_VersionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_VersionPolicy_To_core_VersionPolicy(in *VersionPolicy, out *core.VersionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionPolicy_To_core_VersionPolicy(in, out, s)
+}
+
+func autoConvert_core_VersionPolicy_To_v1beta1_VersionPolicy(in *core.VersionPolicy, out *VersionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_VersionPolicySpec_To_v1beta1_VersionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_VersionPolicy_To_v1beta1_VersionPolicy is an autogenerated conversion function.
+func Convert_core_VersionPolicy_To_v1beta1_VersionPolicy(in *core.VersionPolicy, out *VersionPolicy, s conversion.Scope) error {
+	return autoConvert_core_VersionPolicy_To_v1beta1_VersionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_VersionPolicyList_To_core_VersionPolicyList(in *VersionPolicyList, out *core.VersionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.VersionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_VersionPolicyList_To_core_VersionPolicyList is an autogenerated conversion function.
+func Convert_v1beta1_VersionPolicyList_To_core_VersionPolicyList(in *VersionPolicyList, out *core.VersionPolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionPolicyList_To_core_VersionPolicyList(in, out, s)
+}
+
+func autoConvert_core_VersionPolicyList_To_v1beta1_VersionPolicyList(in *core.VersionPolicyList, out *VersionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]VersionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_VersionPolicyList_To_v1beta1_VersionPolicyList is an autogenerated conversion function.
+func Convert_core_VersionPolicyList_To_v1beta1_VersionPolicyList(in *core.VersionPolicyList, out *VersionPolicyList, s conversion.Scope) error {
+	return autoConvert_core_VersionPolicyList_To_v1beta1_VersionPolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_VersionPolicySpec_To_core_VersionPolicySpec(in *VersionPolicySpec, out *core.VersionPolicySpec, s conversion.Scope) error {
+	out.Kubernetes = (*core.KubernetesVersionPolicy)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = *(*[]core.MachineImageVersionPolicy)(unsafe.Pointer(&in.MachineImages))
+	out.ForceUpdate = in.ForceUpdate
+	return nil
+}
+
+// Convert_v1beta1_VersionPolicySpec_To_core_VersionPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_VersionPolicySpec_To_core_VersionPolicySpec(in *VersionPolicySpec, out *core.VersionPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionPolicySpec_To_core_VersionPolicySpec(in, out, s)
+}
+
+func autoConvert_core_VersionPolicySpec_To_v1beta1_VersionPolicySpec(in *core.VersionPolicySpec, out *VersionPolicySpec, s conversion.Scope) error {
+	out.Kubernetes = (*KubernetesVersionPolicy)(unsafe.Pointer(in.Kubernetes))
+	out.MachineImages = *(*[]MachineImageVersionPolicy)(unsafe.Pointer(&in.MachineImages))
+	out.ForceUpdate = in.ForceUpdate
+	return nil
+}
+
+// Convert_core_VersionPolicySpec_To_v1beta1_VersionPolicySpec is an autogenerated conversion function.
+func Convert_core_VersionPolicySpec_To_v1beta1_VersionPolicySpec(in *core.VersionPolicySpec, out *VersionPolicySpec, s conversion.Scope) error {
+	return autoConvert_core_VersionPolicySpec_To_v1beta1_VersionPolicySpec(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -6492,11 +9392,34 @@ func Convert_core_Volume_To_v1beta1_Volume(in *core.Volume, out *Volume, s conve
 	return autoConvert_core_Volume_To_v1beta1_Volume(in, out, s)
 }
 
+func autoConvert_v1beta1_VolumeRequirements_To_core_VolumeRequirements(in *VolumeRequirements, out *core.VolumeRequirements, s conversion.Scope) error {
+	out.VolumeSize = in.VolumeSize
+	out.Class = (*string)(unsafe.Pointer(in.Class))
+	return nil
+}
+
+// Convert_v1beta1_VolumeRequirements_To_core_VolumeRequirements is an autogenerated conversion function.
+func Convert_v1beta1_VolumeRequirements_To_core_VolumeRequirements(in *VolumeRequirements, out *core.VolumeRequirements, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumeRequirements_To_core_VolumeRequirements(in, out, s)
+}
+
+func autoConvert_core_VolumeRequirements_To_v1beta1_VolumeRequirements(in *core.VolumeRequirements, out *VolumeRequirements, s conversion.Scope) error {
+	out.VolumeSize = in.VolumeSize
+	out.Class = (*string)(unsafe.Pointer(in.Class))
+	return nil
+}
+
+// Convert_core_VolumeRequirements_To_v1beta1_VolumeRequirements is an autogenerated conversion function.
+func Convert_core_VolumeRequirements_To_v1beta1_VolumeRequirements(in *core.VolumeRequirements, out *VolumeRequirements, s conversion.Scope) error {
+	return autoConvert_core_VolumeRequirements_To_v1beta1_VolumeRequirements(in, out, s)
+}
+
 func autoConvert_v1beta1_VolumeType_To_core_VolumeType(in *VolumeType, out *core.VolumeType, s conversion.Scope) error {
 	out.Class = in.Class
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.MonthlyPricePerGi = (*string)(unsafe.Pointer(in.MonthlyPricePerGi))
 	return nil
 }
 
@@ -6510,6 +9433,7 @@ func autoConvert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.MonthlyPricePerGi = (*string)(unsafe.Pointer(in.MonthlyPricePerGi))
 	return nil
 }
 
@@ -6540,6 +9464,26 @@ func Convert_core_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in *core.WatchCache
 	return autoConvert_core_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in, out, s)
 }
 
+func autoConvert_v1beta1_WebhookAlertReceiver_To_core_WebhookAlertReceiver(in *WebhookAlertReceiver, out *core.WebhookAlertReceiver, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1beta1_WebhookAlertReceiver_To_core_WebhookAlertReceiver is an autogenerated conversion function.
+func Convert_v1beta1_WebhookAlertReceiver_To_core_WebhookAlertReceiver(in *WebhookAlertReceiver, out *core.WebhookAlertReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_WebhookAlertReceiver_To_core_WebhookAlertReceiver(in, out, s)
+}
+
+func autoConvert_core_WebhookAlertReceiver_To_v1beta1_WebhookAlertReceiver(in *core.WebhookAlertReceiver, out *WebhookAlertReceiver, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_core_WebhookAlertReceiver_To_v1beta1_WebhookAlertReceiver is an autogenerated conversion function.
+func Convert_core_WebhookAlertReceiver_To_v1beta1_WebhookAlertReceiver(in *core.WebhookAlertReceiver, out *WebhookAlertReceiver, s conversion.Scope) error {
+	return autoConvert_core_WebhookAlertReceiver_To_v1beta1_WebhookAlertReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
@@ -6572,6 +9516,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.Confidential = (*core.WorkerConfidential)(unsafe.Pointer(in.Confidential))
 	return nil
 }
 
@@ -6612,6 +9557,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.Confidential = (*WorkerConfidential)(unsafe.Pointer(in.Confidential))
 	return nil
 }
 
@@ -6620,6 +9566,72 @@ func Convert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s conve
 	return autoConvert_core_Worker_To_v1beta1_Worker(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerConfidential_To_core_WorkerConfidential(in *WorkerConfidential, out *core.WorkerConfidential, s conversion.Scope) error {
+	out.Attestation = (*core.WorkerConfidentialAttestation)(unsafe.Pointer(in.Attestation))
+	return nil
+}
+
+// Convert_v1beta1_WorkerConfidential_To_core_WorkerConfidential is an autogenerated conversion function.
+func Convert_v1beta1_WorkerConfidential_To_core_WorkerConfidential(in *WorkerConfidential, out *core.WorkerConfidential, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerConfidential_To_core_WorkerConfidential(in, out, s)
+}
+
+func autoConvert_core_WorkerConfidential_To_v1beta1_WorkerConfidential(in *core.WorkerConfidential, out *WorkerConfidential, s conversion.Scope) error {
+	out.Attestation = (*WorkerConfidentialAttestation)(unsafe.Pointer(in.Attestation))
+	return nil
+}
+
+// Convert_core_WorkerConfidential_To_v1beta1_WorkerConfidential is an autogenerated conversion function.
+func Convert_core_WorkerConfidential_To_v1beta1_WorkerConfidential(in *core.WorkerConfidential, out *WorkerConfidential, s conversion.Scope) error {
+	return autoConvert_core_WorkerConfidential_To_v1beta1_WorkerConfidential(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerConfidentialAttestation_To_core_WorkerConfidentialAttestation(in *WorkerConfidentialAttestation, out *core.WorkerConfidentialAttestation, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Policy = (*string)(unsafe.Pointer(in.Policy))
+	return nil
+}
+
+// Convert_v1beta1_WorkerConfidentialAttestation_To_core_WorkerConfidentialAttestation is an autogenerated conversion function.
+func Convert_v1beta1_WorkerConfidentialAttestation_To_core_WorkerConfidentialAttestation(in *WorkerConfidentialAttestation, out *core.WorkerConfidentialAttestation, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerConfidentialAttestation_To_core_WorkerConfidentialAttestation(in, out, s)
+}
+
+func autoConvert_core_WorkerConfidentialAttestation_To_v1beta1_WorkerConfidentialAttestation(in *core.WorkerConfidentialAttestation, out *WorkerConfidentialAttestation, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Policy = (*string)(unsafe.Pointer(in.Policy))
+	return nil
+}
+
+// Convert_core_WorkerConfidentialAttestation_To_v1beta1_WorkerConfidentialAttestation is an autogenerated conversion function.
+func Convert_core_WorkerConfidentialAttestation_To_v1beta1_WorkerConfidentialAttestation(in *core.WorkerConfidentialAttestation, out *WorkerConfidentialAttestation, s conversion.Scope) error {
+	return autoConvert_core_WorkerConfidentialAttestation_To_v1beta1_WorkerConfidentialAttestation(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerCostEstimate_To_core_WorkerCostEstimate(in *WorkerCostEstimate, out *core.WorkerCostEstimate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinimumMonthlyCost = in.MinimumMonthlyCost
+	out.MaximumMonthlyCost = in.MaximumMonthlyCost
+	return nil
+}
+
+// Convert_v1beta1_WorkerCostEstimate_To_core_WorkerCostEstimate is an autogenerated conversion function.
+func Convert_v1beta1_WorkerCostEstimate_To_core_WorkerCostEstimate(in *WorkerCostEstimate, out *core.WorkerCostEstimate, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerCostEstimate_To_core_WorkerCostEstimate(in, out, s)
+}
+
+func autoConvert_core_WorkerCostEstimate_To_v1beta1_WorkerCostEstimate(in *core.WorkerCostEstimate, out *WorkerCostEstimate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinimumMonthlyCost = in.MinimumMonthlyCost
+	out.MaximumMonthlyCost = in.MaximumMonthlyCost
+	return nil
+}
+
+// Convert_core_WorkerCostEstimate_To_v1beta1_WorkerCostEstimate is an autogenerated conversion function.
+func Convert_core_WorkerCostEstimate_To_v1beta1_WorkerCostEstimate(in *core.WorkerCostEstimate, out *WorkerCostEstimate, s conversion.Scope) error {
+	return autoConvert_core_WorkerCostEstimate_To_v1beta1_WorkerCostEstimate(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerKubernetes_To_core_WorkerKubernetes(in *WorkerKubernetes, out *core.WorkerKubernetes, s conversion.Scope) error {
 	if in.Kubelet != nil {
 		in, out := &in.Kubelet, &out.Kubelet
@@ -6658,6 +9670,110 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerPoolRollout_To_core_WorkerPoolRollout(in *WorkerPoolRollout, out *core.WorkerPoolRollout, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachinesUpdated = in.MachinesUpdated
+	out.MachinesPending = in.MachinesPending
+	out.MachinesFailed = in.MachinesFailed
+	if in.CurrentMachineImage != nil {
+		in, out := &in.CurrentMachineImage, &out.CurrentMachineImage
+		*out = new(core.ShootMachineImage)
+		if err := Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CurrentMachineImage = nil
+	}
+	if in.TargetMachineImage != nil {
+		in, out := &in.TargetMachineImage, &out.TargetMachineImage
+		*out = new(core.ShootMachineImage)
+		if err := Convert_v1beta1_ShootMachineImage_To_core_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetMachineImage = nil
+	}
+	out.CurrentKubeletVersion = (*string)(unsafe.Pointer(in.CurrentKubeletVersion))
+	out.TargetKubeletVersion = (*string)(unsafe.Pointer(in.TargetKubeletVersion))
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolRollout_To_core_WorkerPoolRollout is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolRollout_To_core_WorkerPoolRollout(in *WorkerPoolRollout, out *core.WorkerPoolRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolRollout_To_core_WorkerPoolRollout(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolRollout_To_v1beta1_WorkerPoolRollout(in *core.WorkerPoolRollout, out *WorkerPoolRollout, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MachinesUpdated = in.MachinesUpdated
+	out.MachinesPending = in.MachinesPending
+	out.MachinesFailed = in.MachinesFailed
+	if in.CurrentMachineImage != nil {
+		in, out := &in.CurrentMachineImage, &out.CurrentMachineImage
+		*out = new(ShootMachineImage)
+		if err := Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CurrentMachineImage = nil
+	}
+	if in.TargetMachineImage != nil {
+		in, out := &in.TargetMachineImage, &out.TargetMachineImage
+		*out = new(ShootMachineImage)
+		if err := Convert_core_ShootMachineImage_To_v1beta1_ShootMachineImage(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TargetMachineImage = nil
+	}
+	out.CurrentKubeletVersion = (*string)(unsafe.Pointer(in.CurrentKubeletVersion))
+	out.TargetKubeletVersion = (*string)(unsafe.Pointer(in.TargetKubeletVersion))
+	return nil
+}
+
+// Convert_core_WorkerPoolRollout_To_v1beta1_WorkerPoolRollout is an autogenerated conversion function.
+func Convert_core_WorkerPoolRollout_To_v1beta1_WorkerPoolRollout(in *core.WorkerPoolRollout, out *WorkerPoolRollout, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolRollout_To_v1beta1_WorkerPoolRollout(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerProfile_To_core_WorkerProfile(in *WorkerProfile, out *core.WorkerProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_MachineRequirements_To_core_MachineRequirements(&in.Machine, &out.Machine, s); err != nil {
+		return err
+	}
+	out.Volume = (*core.VolumeRequirements)(unsafe.Pointer(in.Volume))
+	out.Minimum = in.Minimum
+	out.Maximum = in.Maximum
+	out.ZoneCount = in.ZoneCount
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	return nil
+}
+
+// Convert_v1beta1_WorkerProfile_To_core_WorkerProfile is an autogenerated conversion function.
+func Convert_v1beta1_WorkerProfile_To_core_WorkerProfile(in *WorkerProfile, out *core.WorkerProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerProfile_To_core_WorkerProfile(in, out, s)
+}
+
+func autoConvert_core_WorkerProfile_To_v1beta1_WorkerProfile(in *core.WorkerProfile, out *WorkerProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_MachineRequirements_To_v1beta1_MachineRequirements(&in.Machine, &out.Machine, s); err != nil {
+		return err
+	}
+	out.Volume = (*VolumeRequirements)(unsafe.Pointer(in.Volume))
+	out.Minimum = in.Minimum
+	out.Maximum = in.Maximum
+	out.ZoneCount = in.ZoneCount
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
+	return nil
+}
+
+// Convert_core_WorkerProfile_To_v1beta1_WorkerProfile is an autogenerated conversion function.
+func Convert_core_WorkerProfile_To_v1beta1_WorkerProfile(in *core.WorkerProfile, out *WorkerProfile, s conversion.Scope) error {
+	return autoConvert_core_WorkerProfile_To_v1beta1_WorkerProfile(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
@@ -6680,6 +9796,8 @@ func Convert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(in *c
 
 func autoConvert_v1beta1_WorkersSettings_To_core_WorkersSettings(in *WorkersSettings, out *core.WorkersSettings, s conversion.Scope) error {
 	out.SSHAccess = (*core.SSHAccess)(unsafe.Pointer(in.SSHAccess))
+	out.Containerd = (*core.ContainerdSettings)(unsafe.Pointer(in.Containerd))
+	out.EgressOnlyInternetAccess = (*core.EgressOnlyInternetAccess)(unsafe.Pointer(in.EgressOnlyInternetAccess))
 	return nil
 }
 
@@ -6690,6 +9808,8 @@ func Convert_v1beta1_WorkersSettings_To_core_WorkersSettings(in *WorkersSettings
 
 func autoConvert_core_WorkersSettings_To_v1beta1_WorkersSettings(in *core.WorkersSettings, out *WorkersSettings, s conversion.Scope) error {
 	out.SSHAccess = (*SSHAccess)(unsafe.Pointer(in.SSHAccess))
+	out.Containerd = (*ContainerdSettings)(unsafe.Pointer(in.Containerd))
+	out.EgressOnlyInternetAccess = (*EgressOnlyInternetAccess)(unsafe.Pointer(in.EgressOnlyInternetAccess))
 	return nil
 }
 
@@ -30,6 +30,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Seed{}, func(obj interface{}) { SetObjectDefaults_Seed(obj.(*Seed)) })
 	scheme.AddTypeDefaultingFunc(&SeedList{}, func(obj interface{}) { SetObjectDefaults_SeedList(obj.(*SeedList)) })
 	scheme.AddTypeDefaultingFunc(&Shoot{}, func(obj interface{}) { SetObjectDefaults_Shoot(obj.(*Shoot)) })
+	scheme.AddTypeDefaultingFunc(&ShootClone{}, func(obj interface{}) { SetObjectDefaults_ShootClone(obj.(*ShootClone)) })
+	scheme.AddTypeDefaultingFunc(&ShootCostEstimate{}, func(obj interface{}) { SetObjectDefaults_ShootCostEstimate(obj.(*ShootCostEstimate)) })
 	scheme.AddTypeDefaultingFunc(&ShootList{}, func(obj interface{}) { SetObjectDefaults_ShootList(obj.(*ShootList)) })
 	return nil
 }
@@ -51,6 +53,10 @@ func SetObjectDefaults_CloudProfile(in *CloudProfile) {
 		a := &in.Spec.VolumeTypes[i]
 		SetDefaults_VolumeType(a)
 	}
+	for i := range in.Spec.ClusterAutoscalerProfiles {
+		a := &in.Spec.ClusterAutoscalerProfiles[i]
+		SetDefaults_ClusterAutoscaler(&a.Parameters)
+	}
 }
 
 func SetObjectDefaults_CloudProfileList(in *CloudProfileList) {
@@ -113,6 +119,10 @@ func SetObjectDefaults_NamespacedCloudProfile(in *NamespacedCloudProfile) {
 		a := &in.Status.CloudProfileSpec.VolumeTypes[i]
 		SetDefaults_VolumeType(a)
 	}
+	for i := range in.Status.CloudProfileSpec.ClusterAutoscalerProfiles {
+		a := &in.Status.CloudProfileSpec.ClusterAutoscalerProfiles[i]
+		SetDefaults_ClusterAutoscaler(&a.Parameters)
+	}
 }
 
 func SetObjectDefaults_NamespacedCloudProfileList(in *NamespacedCloudProfileList) {
@@ -172,6 +182,10 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 		if in.Spec.Addons.NginxIngress != nil {
 			SetDefaults_NginxIngress(in.Spec.Addons.NginxIngress)
 		}
+		for i := range in.Spec.Addons.Charts {
+			a := &in.Spec.Addons.Charts[i]
+			SetDefaults_AddonChart(a)
+		}
 	}
 	if in.Spec.Kubernetes.ClusterAutoscaler != nil {
 		SetDefaults_ClusterAutoscaler(in.Spec.Kubernetes.ClusterAutoscaler)
@@ -179,6 +193,12 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	if in.Spec.Kubernetes.KubeAPIServer != nil {
 		SetDefaults_KubeAPIServerConfig(in.Spec.Kubernetes.KubeAPIServer)
 	}
+	if in.Spec.Kubernetes.Kubelet != nil {
+		for i := range in.Spec.Kubernetes.Kubelet.CredentialProviders {
+			a := &in.Spec.Kubernetes.Kubelet.CredentialProviders[i]
+			SetDefaults_KubeletCredentialProvider(a)
+		}
+	}
 	if in.Spec.Kubernetes.VerticalPodAutoscaler != nil {
 		SetDefaults_VerticalPodAutoscaler(in.Spec.Kubernetes.VerticalPodAutoscaler)
 	}
@@ -191,6 +211,48 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	for i := range in.Spec.Provider.Workers {
 		a := &in.Spec.Provider.Workers[i]
 		SetDefaults_Worker(a)
+		if a.Kubernetes != nil {
+			if a.Kubernetes.Kubelet != nil {
+				for j := range a.Kubernetes.Kubelet.CredentialProviders {
+					b := &a.Kubernetes.Kubelet.CredentialProviders[j]
+					SetDefaults_KubeletCredentialProvider(b)
+				}
+			}
+		}
+	}
+	if in.Spec.Provider.WorkersSettings != nil {
+		if in.Spec.Provider.WorkersSettings.Containerd != nil {
+			for i := range in.Spec.Provider.WorkersSettings.Containerd.Registries {
+				a := &in.Spec.Provider.WorkersSettings.Containerd.Registries[i]
+				SetDefaults_ContainerdRegistry(a)
+				for j := range a.Hosts {
+					b := &a.Hosts[j]
+					SetDefaults_ContainerdRegistryHost(b)
+				}
+			}
+		}
+	}
+}
+
+func SetObjectDefaults_ShootClone(in *ShootClone) {
+	SetDefaults_ShootCloneSpec(&in.Spec)
+	if in.Status.Shoot != nil {
+		SetObjectDefaults_Shoot(in.Status.Shoot)
+	}
+}
+
+func SetObjectDefaults_ShootCostEstimate(in *ShootCostEstimate) {
+	for i := range in.Spec.Workers {
+		a := &in.Spec.Workers[i]
+		SetDefaults_Worker(a)
+		if a.Kubernetes != nil {
+			if a.Kubernetes.Kubelet != nil {
+				for j := range a.Kubernetes.Kubelet.CredentialProviders {
+					b := &a.Kubernetes.Kubelet.CredentialProviders[j]
+					SetDefaults_KubeletCredentialProvider(b)
+				}
+			}
+		}
 	}
 }
 
@@ -71,6 +71,7 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateMachineTypes(spec.MachineTypes, fldPath.Child("machineTypes"))...)
 	allErrs = append(allErrs, validateVolumeTypes(spec.VolumeTypes, fldPath.Child("volumeTypes"))...)
 	allErrs = append(allErrs, validateRegions(spec.Regions, fldPath.Child("regions"))...)
+	allErrs = append(allErrs, validateClusterAutoscalerProfiles(spec.ClusterAutoscalerProfiles, fldPath.Child("clusterAutoscalerProfiles"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("seedSelector"))...)
 	}
@@ -182,6 +183,41 @@ func validateMachineTypes(machineTypes []core.MachineType, fldPath *field.Path)
 		if machineType.Storage != nil {
 			allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 		}
+
+		if machineType.HourlyPrice != nil {
+			allErrs = append(allErrs, validatePrice(*machineType.HourlyPrice, idxPath.Child("hourlyPrice"))...)
+		}
+
+		if machineType.KubeReserved != nil {
+			allErrs = append(allErrs, validateMachineTypeKubeReserved(machineType, idxPath.Child("kubeReserved"))...)
+		}
+
+		if machineType.Capabilities != nil {
+			allErrs = append(allErrs, validateMachineTypeCapabilities(*machineType.Capabilities, idxPath.Child("capabilities"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateMachineTypeCapabilities(capabilities core.MachineTypeCapabilities, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if capabilities.CPUFamily != nil && len(*capabilities.CPUFamily) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuFamily"), *capabilities.CPUFamily, "must not be empty if specified"))
+	}
+
+	return allErrs
+}
+
+func validateMachineTypeKubeReserved(machineType core.MachineType, fldPath *field.Path) field.ErrorList {
+	allErrs := validateKubeletConfigReserved(machineType.KubeReserved, fldPath)
+
+	if cpu := machineType.KubeReserved.CPU; cpu != nil && cpu.Cmp(machineType.CPU) >= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpu"), cpu.String(), fmt.Sprintf("must be less than the machine type's CPU capacity '%s'", machineType.CPU.String())))
+	}
+	if memory := machineType.KubeReserved.Memory; memory != nil && memory.Cmp(machineType.Memory) >= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memory"), memory.String(), fmt.Sprintf("must be less than the machine type's memory capacity '%s'", machineType.Memory.String())))
 	}
 
 	return allErrs
@@ -340,6 +376,33 @@ func validateMachineTypeArchitecture(arch *string, fldPath *field.Path) field.Er
 	return allErrs
 }
 
+func validateClusterAutoscalerProfiles(profiles []core.ClusterAutoscalerProfileSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[core.ClusterAutoscalerProfile]()
+
+	for i, profile := range profiles {
+		idxPath := fldPath.Index(i)
+
+		namePath := idxPath.Child("name")
+		if !availableClusterAutoscalerProfiles.Has(string(profile.Name)) {
+			allErrs = append(allErrs, field.NotSupported(namePath, profile.Name, sets.List(availableClusterAutoscalerProfiles)))
+		}
+		if names.Has(profile.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, profile.Name))
+		}
+		names.Insert(profile.Name)
+
+		parametersPath := idxPath.Child("parameters")
+		allErrs = append(allErrs, ValidateClusterAutoscaler(profile.Parameters, parametersPath)...)
+		if profile.Parameters.Profile != nil {
+			allErrs = append(allErrs, field.Forbidden(parametersPath.Child("profile"), "profiles must not refer to other profiles"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -366,6 +429,23 @@ func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) fie
 		if volumeType.MinSize != nil {
 			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("minSize", *volumeType.MinSize, idxPath.Child("minSize"))...)
 		}
+
+		if volumeType.MonthlyPricePerGi != nil {
+			allErrs = append(allErrs, validatePrice(*volumeType.MonthlyPricePerGi, idxPath.Child("monthlyPricePerGi"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// priceRegex is used for validating prices of machine and volume types.
+var priceRegex = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+func validatePrice(price string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !priceRegex.MatchString(price) {
+		allErrs = append(allErrs, field.Invalid(fldPath, price, "must be a non-negative decimal number, e.g. \"0.096\""))
 	}
 
 	return allErrs
@@ -891,6 +891,77 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					errorList := ValidateCloudProfile(cloudProfile)
 					Expect(errorList).To(BeEmpty())
 				})
+
+				It("should allow machine types with valid prices", func() {
+					machineType := cloudProfile.Spec.MachineTypes[0]
+					machineType.HourlyPrice = ptr.To("0.096")
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid machine types with invalid prices", func() {
+					machineType := cloudProfile.Spec.MachineTypes[0]
+					machineType.HourlyPrice = ptr.To("-1")
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].hourlyPrice"),
+					}))))
+				})
+
+				It("should allow machine types with valid kube reserved resources", func() {
+					machineType := cloudProfile.Spec.MachineTypes[0]
+					machineType.KubeReserved = &core.KubeletConfigReserved{CPU: ptr.To(resource.MustParse("80m")), Memory: ptr.To(resource.MustParse("1Gi"))}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid machine types with kube reserved resources exceeding the capacity", func() {
+					machineType := cloudProfile.Spec.MachineTypes[0]
+					machineType.KubeReserved = &core.KubeletConfigReserved{
+						CPU:    ptr.To(resource.MustParse("2")),
+						Memory: ptr.To(resource.MustParse("200Gi")),
+						PID:    ptr.To(resource.MustParse("-1")),
+					}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.machineTypes[0].kubeReserved.cpu"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.machineTypes[0].kubeReserved.memory"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.machineTypes[0].kubeReserved.pid"),
+						})),
+					))
+				})
+
+				It("should allow machine types with valid capabilities", func() {
+					machineType := cloudProfile.Spec.MachineTypes[0]
+					machineType.Capabilities = &core.MachineTypeCapabilities{CPUFamily: ptr.To("amd-genoa"), NVMe: ptr.To(true), ConfidentialCompute: ptr.To(false)}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid machine types with an empty CPU family", func() {
+					machineType := cloudProfile.Spec.MachineTypes[0]
+					machineType.Capabilities = &core.MachineTypeCapabilities{CPUFamily: ptr.To("")}
+					cloudProfile.Spec.MachineTypes = []core.MachineType{machineType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.machineTypes[0].capabilities.cpuFamily"),
+					}))))
+				})
 			})
 
 			Context("regions validation", func() {
@@ -962,6 +1033,67 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				})
 			})
 
+			Context("cluster-autoscaler profiles validation", func() {
+				It("should allow valid cluster-autoscaler profiles", func() {
+					cloudProfile.Spec.ClusterAutoscalerProfiles = []core.ClusterAutoscalerProfileSettings{
+						{
+							Name: core.ClusterAutoscalerProfileConservative,
+							Parameters: core.ClusterAutoscaler{
+								ScaleDownUnneededTime: &metav1.Duration{Duration: time.Hour},
+							},
+						},
+						{
+							Name: core.ClusterAutoscalerProfileAggressive,
+							Parameters: core.ClusterAutoscaler{
+								ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+								ScaleDownUtilizationThreshold: ptr.To(0.7),
+							},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid unsupported, duplicate, and invalid cluster-autoscaler profiles", func() {
+					cloudProfile.Spec.ClusterAutoscalerProfiles = []core.ClusterAutoscalerProfileSettings{
+						{
+							Name: "foo",
+						},
+						{
+							Name: core.ClusterAutoscalerProfileBalanced,
+							Parameters: core.ClusterAutoscaler{
+								ScaleDownUtilizationThreshold: ptr.To(1.5),
+							},
+						},
+						{
+							Name: core.ClusterAutoscalerProfileBalanced,
+							Parameters: core.ClusterAutoscaler{
+								Profile: ptr.To(core.ClusterAutoscalerProfileAggressive),
+							},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.clusterAutoscalerProfiles[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.clusterAutoscalerProfiles[1].parameters.scaleDownUtilizationThreshold"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.clusterAutoscalerProfiles[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.clusterAutoscalerProfiles[2].parameters.profile"),
+						})),
+					))
+				})
+			})
+
 			Context("volume types validation", func() {
 				It("should enforce uniqueness of volume type names", func() {
 					cloudProfile.Spec.VolumeTypes = []core.VolumeType{
@@ -994,6 +1126,25 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					})),
 					))
 				})
+
+				It("should allow volume types with valid prices", func() {
+					volumeType := cloudProfile.Spec.VolumeTypes[0]
+					volumeType.MonthlyPricePerGi = ptr.To("0.08")
+					cloudProfile.Spec.VolumeTypes = []core.VolumeType{volumeType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid volume types with invalid prices", func() {
+					volumeType := cloudProfile.Spec.VolumeTypes[0]
+					volumeType.MonthlyPricePerGi = ptr.To("8 cents")
+					cloudProfile.Spec.VolumeTypes = []core.VolumeType{volumeType}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.volumeTypes[0].monthlyPricePerGi"),
+					}))))
+				})
 			})
 
 			It("should forbid unsupported seed selectors", func() {
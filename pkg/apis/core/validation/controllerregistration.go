@@ -13,6 +13,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -57,6 +58,7 @@ var SupportedExtensionKinds = sets.New(
 	extensionsv1alpha1.InfrastructureResource,
 	extensionsv1alpha1.NetworkResource,
 	extensionsv1alpha1.OperatingSystemConfigResource,
+	extensionsv1alpha1.PrivateLinkResource,
 	extensionsv1alpha1.WorkerResource,
 )
 
@@ -101,6 +103,21 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 			}
 		}
 
+		if len(resource.RequiredCredentialKeys) > 0 && resource.Kind != extensionsv1alpha1.InfrastructureResource {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("requiredCredentialKeys"), fmt.Sprintf("field must not be set when kind != %s", extensionsv1alpha1.InfrastructureResource)))
+		}
+		requiredCredentialKeys := sets.New[string]()
+		for j, key := range resource.RequiredCredentialKeys {
+			keyPath := idxPath.Child("requiredCredentialKeys").Index(j)
+			for _, msg := range validation.IsConfigMapKey(key) {
+				allErrs = append(allErrs, field.Invalid(keyPath, key, msg))
+			}
+			if requiredCredentialKeys.Has(key) {
+				allErrs = append(allErrs, field.Duplicate(keyPath, key))
+			}
+			requiredCredentialKeys.Insert(key)
+		}
+
 		if resource.Kind == extensionsv1alpha1.ExtensionResource && resource.Lifecycle != nil {
 			lifecyclePath := idxPath.Child("lifecycle")
 			if resource.Lifecycle.Reconcile != nil && !availableExtensionStrategiesForReconcile.Has(string(*resource.Lifecycle.Reconcile)) {
@@ -206,6 +206,35 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow setting required credential keys for kind Infrastructure", func() {
+			controllerRegistration.Spec.Resources[0].Kind = extensionsv1alpha1.InfrastructureResource
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"accessKeyID", "secretAccessKey"}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(BeEmpty())
+		})
+
+		It("should forbid setting required credential keys for kind != Infrastructure", func() {
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"accessKeyID"}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.resources[0].requiredCredentialKeys"),
+			}))))
+		})
+
+		It("should forbid invalid and duplicate required credential keys", func() {
+			controllerRegistration.Spec.Resources[0].Kind = extensionsv1alpha1.InfrastructureResource
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"accessKeyID", "invalid/key", "accessKeyID"}
+
+			Expect(ValidateControllerRegistration(controllerRegistration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.resources[0].requiredCredentialKeys[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.resources[0].requiredCredentialKeys[2]"),
+			}))))
+		})
+
 		It("should allow setting the BeforeKubeAPIServer lifecycle strategy", func() {
 			beforeStrat := core.BeforeKubeAPIServer
 			controllerRegistration.Spec.Resources[0].Kind = "Extension"
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var (
+	// imageTagRegex is the format of image tags as defined by the OCI distribution specification.
+	imageTagRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+	// imageDigestRegex is the format of sha256 image digests.
+	imageDigestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+	// forbiddenImageTags are tags which are typically moved and hence do not pin an image.
+	forbiddenImageTags = sets.New("latest")
+)
+
+// ValidateImageVectorOverride validates an ImageVectorOverride object.
+func ValidateImageVectorOverride(imageVectorOverride *core.ImageVectorOverride) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&imageVectorOverride.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateImageVectorOverrideSpec(&imageVectorOverride.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateImageVectorOverrideUpdate validates an ImageVectorOverride object before an update.
+func ValidateImageVectorOverrideUpdate(newImageVectorOverride, oldImageVectorOverride *core.ImageVectorOverride) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newImageVectorOverride.ObjectMeta, &oldImageVectorOverride.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateImageVectorOverride(newImageVectorOverride)...)
+
+	return allErrs
+}
+
+// ValidateImageVectorOverrideSpec validates the specification of an ImageVectorOverride object.
+func ValidateImageVectorOverrideSpec(spec *core.ImageVectorOverrideSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	labelSelectorValidationOptions := metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}
+	if spec.SeedSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.SeedSelector, labelSelectorValidationOptions, fldPath.Child("seedSelector"))...)
+	}
+	if spec.ShootSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, labelSelectorValidationOptions, fldPath.Child("shootSelector"))...)
+	}
+
+	if len(spec.Images) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("images"), "at least one image must be specified"))
+	}
+
+	images := sets.New[string]()
+	for i, image := range spec.Images {
+		idxPath := fldPath.Child("images").Index(i)
+
+		allErrs = append(allErrs, validateImageOverride(image, idxPath)...)
+
+		key := fmt.Sprintf("%s/%s/%s", image.Name, ptr.Deref(image.RuntimeVersion, ""), ptr.Deref(image.TargetVersion, ""))
+		if images.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, image.Name))
+		}
+		images.Insert(key)
+	}
+
+	return allErrs
+}
+
+func validateImageOverride(image core.ImageOverride, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if image.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "image name is required"))
+	}
+
+	if image.Repository == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("repository"), "image repository is required"))
+	} else if strings.Contains(image.Repository, "@") || strings.Contains(image.Repository[strings.LastIndex(image.Repository, "/")+1:], ":") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("repository"), image.Repository, "repository must not contain a tag or digest, use the 'tag' or 'digest' fields instead"))
+	}
+
+	switch {
+	case image.Tag == nil && image.Digest == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of 'tag' or 'digest' must be specified"))
+	case image.Tag != nil && image.Digest != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of 'tag' or 'digest' must be specified"))
+	case image.Tag != nil:
+		if !imageTagRegex.MatchString(*image.Tag) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tag"), *image.Tag, fmt.Sprintf("tag must match %q", imageTagRegex.String())))
+		} else if forbiddenImageTags.Has(*image.Tag) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tag"), fmt.Sprintf("tag %q does not pin the image, use a fixed tag or a digest", *image.Tag)))
+		}
+	case image.Digest != nil:
+		if !imageDigestRegex.MatchString(*image.Digest) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("digest"), *image.Digest, fmt.Sprintf("digest must match %q", imageDigestRegex.String())))
+		}
+	}
+
+	if image.RuntimeVersion != nil {
+		if _, err := semver.NewConstraint(*image.RuntimeVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("runtimeVersion"), *image.RuntimeVersion, err.Error()))
+		}
+	}
+	if image.TargetVersion != nil {
+		if _, err := semver.NewConstraint(*image.TargetVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetVersion"), *image.TargetVersion, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ImageVectorOverride Validation Tests", func() {
+	var imageVectorOverride *core.ImageVectorOverride
+
+	BeforeEach(func() {
+		imageVectorOverride = &core.ImageVectorOverride{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "hotfix",
+			},
+			Spec: core.ImageVectorOverrideSpec{
+				SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+				Images: []core.ImageOverride{
+					{
+						Name:       "kube-apiserver",
+						Repository: "registry.example.com:5000/kube-apiserver",
+						Tag:        ptr.To("v1.30.1-hotfix.1"),
+					},
+					{
+						Name:          "coredns",
+						Repository:    "registry.example.com/coredns",
+						Digest:        ptr.To("sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+						TargetVersion: ptr.To(">= 1.29"),
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateImageVectorOverride", func() {
+		It("should allow valid resources", func() {
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(BeEmpty())
+		})
+
+		It("should forbid empty names and an empty list of images", func() {
+			imageVectorOverride.Name = ""
+			imageVectorOverride.Spec.Images = nil
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.images"),
+				})),
+			))
+		})
+
+		It("should forbid invalid label selectors", func() {
+			imageVectorOverride.Spec.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "no/slash/allowed"}}
+			imageVectorOverride.Spec.ShootSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "Invalid"}}}
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.seedSelector.matchLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+				})),
+			))
+		})
+
+		It("should forbid images without name and repository", func() {
+			imageVectorOverride.Spec.Images[0].Name = ""
+			imageVectorOverride.Spec.Images[0].Repository = ""
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.images[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.images[0].repository"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid repositories with tag or digest",
+			func(repository string) {
+				imageVectorOverride.Spec.Images[0].Repository = repository
+
+				Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.images[0].repository"),
+					})),
+				))
+			},
+
+			Entry("tag", "registry.example.com/kube-apiserver:v1.30.1"),
+			Entry("tag with registry port", "registry.example.com:5000/kube-apiserver:v1.30.1"),
+			Entry("digest", "registry.example.com/kube-apiserver@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+		)
+
+		It("should require exactly one of tag or digest", func() {
+			imageVectorOverride.Spec.Images[0].Tag = nil
+			imageVectorOverride.Spec.Images[1].Tag = ptr.To("v1.11.1")
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.images[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.images[1]"),
+				})),
+			))
+		})
+
+		DescribeTable("should validate the tag",
+			func(tag string, matcher gomegatypes.GomegaMatcher) {
+				imageVectorOverride.Spec.Images[0].Tag = &tag
+
+				Expect(ValidateImageVectorOverride(imageVectorOverride)).To(matcher)
+			},
+
+			Entry("valid tag", "v1.30.1", BeEmpty()),
+			Entry("invalid tag", "v1.30.1/foo", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.images[0].tag"),
+			})))),
+			Entry("mutable tag", "latest", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.images[0].tag"),
+			})))),
+		)
+
+		DescribeTable("should validate the digest",
+			func(digest string) {
+				imageVectorOverride.Spec.Images[1].Digest = &digest
+
+				Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.images[1].digest"),
+					})),
+				))
+			},
+
+			Entry("missing algorithm", "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+			Entry("unsupported algorithm", "md5:0123456789abcdef0123456789abcdef"),
+			Entry("too short", "sha256:0123456789abcdef"),
+			Entry("upper case", "sha256:0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF"),
+		)
+
+		It("should forbid invalid version constraints", func() {
+			imageVectorOverride.Spec.Images[0].RuntimeVersion = ptr.To("foo")
+			imageVectorOverride.Spec.Images[1].TargetVersion = ptr.To(">= bar")
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.images[0].runtimeVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.images[1].targetVersion"),
+				})),
+			))
+		})
+
+		It("should forbid duplicate images", func() {
+			imageVectorOverride.Spec.Images = append(imageVectorOverride.Spec.Images, imageVectorOverride.Spec.Images[1])
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.images[2]"),
+				})),
+			))
+		})
+
+		It("should allow the same image with different version constraints", func() {
+			image := imageVectorOverride.Spec.Images[1]
+			image.TargetVersion = ptr.To("< 1.29")
+			imageVectorOverride.Spec.Images = append(imageVectorOverride.Spec.Images, image)
+
+			Expect(ValidateImageVectorOverride(imageVectorOverride)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateImageVectorOverrideUpdate", func() {
+		It("should allow updating the images", func() {
+			newImageVectorOverride := imageVectorOverride.DeepCopy()
+			newImageVectorOverride.ResourceVersion = "1"
+			newImageVectorOverride.Spec.Images[0].Tag = ptr.To("v1.30.1-hotfix.2")
+
+			Expect(ValidateImageVectorOverrideUpdate(newImageVectorOverride, imageVectorOverride)).To(BeEmpty())
+		})
+
+		It("should validate the new object", func() {
+			newImageVectorOverride := imageVectorOverride.DeepCopy()
+			newImageVectorOverride.ResourceVersion = "1"
+			newImageVectorOverride.Spec.Images[0].Tag = ptr.To("latest")
+
+			Expect(ValidateImageVectorOverrideUpdate(newImageVectorOverride, imageVectorOverride)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.images[0].tag"),
+				})),
+			))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/api/validation/path"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/utils"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 // ValidateProject validates a Project object.
@@ -67,6 +69,11 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 
 	members := make(map[string]struct{}, len(projectSpec.Members))
 
+	customRoles := sets.New[string]()
+	for _, customRole := range projectSpec.CustomRoles {
+		customRoles.Insert(customRole.Name)
+	}
+
 	for i, member := range projectSpec.Members {
 		idxPath := fldPath.Child("members").Index(i)
 
@@ -93,6 +100,10 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 					ownerFound = true
 				}
 			}
+
+			if strings.HasPrefix(role, core.ProjectMemberCustomPrefix) && !customRoles.Has(strings.TrimPrefix(role, core.ProjectMemberCustomPrefix)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("roles").Index(j), role, "custom role is not defined in spec.customRoles"))
+			}
 		}
 	}
 	if createdBy := projectSpec.CreatedBy; createdBy != nil {
@@ -115,6 +126,63 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 	}
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
+	allErrs = append(allErrs, validateAlertRoutes(projectSpec.AlertRoutes, fldPath.Child("alertRoutes"))...)
+
+	if projectSpec.NamespaceResources != nil {
+		allErrs = append(allErrs, validateProjectNamespaceResources(projectSpec.NamespaceResources, fldPath.Child("namespaceResources"))...)
+	}
+
+	allErrs = append(allErrs, validateProjectCustomRoles(projectSpec.CustomRoles, fldPath.Child("customRoles"))...)
+
+	return allErrs
+}
+
+func validateProjectCustomRoles(customRoles []core.ProjectCustomRole, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, customRole := range customRoles {
+		idxPath := fldPath.Index(i)
+
+		if len(customRole.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			if names.Has(customRole.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), customRole.Name))
+			}
+			names.Insert(customRole.Name)
+
+			if len(customRole.Name) > extensionRoleMaxLength {
+				allErrs = append(allErrs, field.TooLong(idxPath.Child("name"), customRole.Name, extensionRoleMaxLength))
+			}
+
+			// the custom role name will be used as part of a ClusterRole name
+			if errs := path.IsValidPathSegmentName(customRole.Name); len(errs) > 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), customRole.Name, strings.Join(errs, ", ")))
+			}
+		}
+
+		if len(customRole.Rules) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("rules"), "must provide at least one rule"))
+		}
+
+		for j, rule := range customRole.Rules {
+			rulePath := idxPath.Child("rules").Index(j)
+
+			if len(rule.Verbs) == 0 {
+				allErrs = append(allErrs, field.Required(rulePath.Child("verbs"), "must provide at least one verb"))
+			}
+			if len(rule.Resources) == 0 {
+				allErrs = append(allErrs, field.Required(rulePath.Child("resources"), "must provide at least one resource"))
+			}
+			// the rules are bound in the project namespace via a RoleBinding, hence non-resource URLs are meaningless
+			if len(rule.NonResourceURLs) > 0 {
+				allErrs = append(allErrs, field.Forbidden(rulePath.Child("nonResourceURLs"), "non-resource URLs are not supported for custom project roles"))
+			}
+		}
+	}
 
 	return allErrs
 }
@@ -178,8 +246,8 @@ func ValidateProjectMember(member core.ProjectMember, fldPath *field.Path) field
 		}
 		foundRoles[role] = struct{}{}
 
-		if !supportedRoles.Has(role) && !strings.HasPrefix(role, core.ProjectMemberExtensionPrefix) {
-			allErrs = append(allErrs, field.NotSupported(rolesPath, role, append(sets.List(supportedRoles), core.ProjectMemberExtensionPrefix+"*")))
+		if !supportedRoles.Has(role) && !strings.HasPrefix(role, core.ProjectMemberExtensionPrefix) && !strings.HasPrefix(role, core.ProjectMemberCustomPrefix) {
+			allErrs = append(allErrs, field.NotSupported(rolesPath, role, append(sets.List(supportedRoles), core.ProjectMemberExtensionPrefix+"*", core.ProjectMemberCustomPrefix+"*")))
 		}
 
 		if strings.HasPrefix(role, core.ProjectMemberExtensionPrefix) {
@@ -273,6 +341,139 @@ func validateDualApprovalForDeletion(dualApproval []core.DualApprovalForDeletion
 	return allErrs
 }
 
+var availableAlertSeverities = sets.New("info", "warning", "critical", "blocker")
+
+func validateAlertRoutes(alertRoutes []core.AlertRoute, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, route := range alertRoutes {
+		idxPath := fldPath.Index(i)
+
+		if len(route.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(route.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), route.Name, msg))
+			}
+
+			if names.Has(route.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), route.Name))
+			}
+			names.Insert(route.Name)
+		}
+
+		for j, severity := range route.Severities {
+			if !availableAlertSeverities.Has(severity) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("severities").Index(j), severity, sets.List(availableAlertSeverities)))
+			}
+		}
+
+		allErrs = append(allErrs, validateAlertReceiver(route.Receiver, idxPath.Child("receiver"))...)
+	}
+
+	return allErrs
+}
+
+func validateAlertReceiver(receiver core.AlertReceiver, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs       field.ErrorList
+		receiverTypes int
+	)
+
+	if receiver.Email != nil {
+		receiverTypes++
+
+		if len(receiver.Email.To) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("email", "to"), "must provide at least one email address"))
+		}
+
+		emails := sets.New[string]()
+		for i, email := range receiver.Email.To {
+			if !utils.TestEmail(email) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("email", "to").Index(i), email, "must provide a valid email"))
+			}
+
+			if emails.Has(email) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("email", "to").Index(i), email))
+			}
+			emails.Insert(email)
+		}
+	}
+
+	if receiver.Webhook != nil {
+		receiverTypes++
+		allErrs = append(allErrs, validateAlertReceiverSecretName(receiver.Webhook.SecretName, fldPath.Child("webhook", "secretName"))...)
+	}
+
+	if receiver.Slack != nil {
+		receiverTypes++
+		allErrs = append(allErrs, validateAlertReceiverSecretName(receiver.Slack.SecretName, fldPath.Child("slack", "secretName"))...)
+
+		if receiver.Slack.Channel != nil && len(*receiver.Slack.Channel) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("slack", "channel"), *receiver.Slack.Channel, "cannot be empty if set"))
+		}
+	}
+
+	if receiverTypes != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, receiver, "exactly one of email, webhook or slack must be set"))
+	}
+
+	return allErrs
+}
+
+func validateAlertReceiverSecretName(secretName string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(secretName) == 0 {
+		return append(allErrs, field.Required(fldPath, "cannot be empty"))
+	}
+
+	for _, msg := range apivalidation.NameIsDNSSubdomain(secretName, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, secretName, msg))
+	}
+
+	return allErrs
+}
+
+var availableLimitRangeTypes = sets.New(corev1.LimitTypeContainer, corev1.LimitTypePod, corev1.LimitTypePersistentVolumeClaim)
+
+func validateProjectNamespaceResources(namespaceResources *core.ProjectNamespaceResources, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for k, v := range namespaceResources.ResourceQuota {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, fldPath.Child("resourceQuota").Key(string(k)))...)
+	}
+
+	limitTypes := sets.New[corev1.LimitType]()
+	for i, item := range namespaceResources.LimitRange {
+		idxPath := fldPath.Child("limitRange").Index(i)
+
+		if !availableLimitRangeTypes.Has(item.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), item.Type, sets.List(availableLimitRangeTypes)))
+		} else if limitTypes.Has(item.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), item.Type))
+		}
+		limitTypes.Insert(item.Type)
+
+		for name, resources := range map[string]corev1.ResourceList{
+			"max":                  item.Max,
+			"min":                  item.Min,
+			"default":              item.Default,
+			"defaultRequest":       item.DefaultRequest,
+			"maxLimitRequestRatio": item.MaxLimitRequestRatio,
+		} {
+			for k, v := range resources {
+				allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, idxPath.Child(name).Key(string(k)))...)
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateProjectStatusUpdate validates the status field of a Project object.
 func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -11,7 +11,9 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -456,6 +458,239 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("alert routes", func() {
+			It("should allow valid configurations", func() {
+				project.Spec.AlertRoutes = []core.AlertRoute{
+					{Name: "ops-mail", Severities: []string{"critical", "blocker"}, Receiver: core.AlertReceiver{Email: &core.EmailAlertReceiver{To: []string{"ops@example.com"}}}},
+					{Name: "webhook", Receiver: core.AlertReceiver{Webhook: &core.WebhookAlertReceiver{SecretName: "alert-webhook"}}},
+					{Name: "slack", Receiver: core.AlertReceiver{Slack: &core.SlackAlertReceiver{SecretName: "alert-slack", Channel: ptr.To("#alerts")}}},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate names", func() {
+				project.Spec.AlertRoutes = []core.AlertRoute{
+					{Receiver: core.AlertReceiver{Webhook: &core.WebhookAlertReceiver{SecretName: "foo"}}},
+					{Name: "foo", Receiver: core.AlertReceiver{Webhook: &core.WebhookAlertReceiver{SecretName: "foo"}}},
+					{Name: "foo", Receiver: core.AlertReceiver{Webhook: &core.WebhookAlertReceiver{SecretName: "foo"}}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.alertRoutes[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.alertRoutes[2].name"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported severities", func() {
+				project.Spec.AlertRoutes = []core.AlertRoute{
+					{Name: "foo", Severities: []string{"warning", "foo"}, Receiver: core.AlertReceiver{Webhook: &core.WebhookAlertReceiver{SecretName: "foo"}}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.alertRoutes[0].severities[1]"),
+					})),
+				))
+			})
+
+			It("should forbid receivers with none or multiple types", func() {
+				project.Spec.AlertRoutes = []core.AlertRoute{
+					{Name: "foo"},
+					{Name: "bar", Receiver: core.AlertReceiver{
+						Webhook: &core.WebhookAlertReceiver{SecretName: "foo"},
+						Slack:   &core.SlackAlertReceiver{SecretName: "foo"},
+					}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.alertRoutes[0].receiver"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.alertRoutes[1].receiver"),
+					})),
+				))
+			})
+
+			It("should forbid invalid receiver configurations", func() {
+				project.Spec.AlertRoutes = []core.AlertRoute{
+					{Name: "email", Receiver: core.AlertReceiver{Email: &core.EmailAlertReceiver{To: []string{"foo", "ops@example.com", "ops@example.com"}}}},
+					{Name: "webhook", Receiver: core.AlertReceiver{Webhook: &core.WebhookAlertReceiver{}}},
+					{Name: "slack", Receiver: core.AlertReceiver{Slack: &core.SlackAlertReceiver{SecretName: "Foo_Bar", Channel: ptr.To("")}}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.alertRoutes[0].receiver.email.to[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.alertRoutes[0].receiver.email.to[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.alertRoutes[1].receiver.webhook.secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.alertRoutes[2].receiver.slack.secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.alertRoutes[2].receiver.slack.channel"),
+					})),
+				))
+			})
+		})
+
+		Context("namespace resources", func() {
+			It("should allow valid configurations", func() {
+				project.Spec.NamespaceResources = &core.ProjectNamespaceResources{
+					ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("100")},
+					LimitRange: []corev1.LimitRangeItem{
+						{Type: corev1.LimitTypeContainer, Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}},
+						{Type: corev1.LimitTypePersistentVolumeClaim, Max: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")}},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid negative quantities", func() {
+				project.Spec.NamespaceResources = &core.ProjectNamespaceResources{
+					ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("-1")},
+					LimitRange: []corev1.LimitRangeItem{
+						{Type: corev1.LimitTypeContainer, DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Mi")}},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.namespaceResources.resourceQuota[count/shoots.core.gardener.cloud]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.namespaceResources.limitRange[0].defaultRequest[memory]"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported and duplicate limit types", func() {
+				project.Spec.NamespaceResources = &core.ProjectNamespaceResources{
+					LimitRange: []corev1.LimitRangeItem{
+						{Type: "foo"},
+						{Type: corev1.LimitTypePod},
+						{Type: corev1.LimitTypePod},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.namespaceResources.limitRange[0].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.namespaceResources.limitRange[2].type"),
+					})),
+				))
+			})
+		})
+
+		Context("custom roles", func() {
+			It("should allow valid configurations", func() {
+				project.Spec.CustomRoles = []core.ProjectCustomRole{{
+					Name:  "deployer",
+					Rules: []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "update"}}},
+				}}
+				project.Spec.Members[0].Roles = append(project.Spec.Members[0].Roles, core.ProjectMemberCustomPrefix+"deployer")
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid referencing undefined custom roles", func() {
+				project.Spec.Members[0].Roles = append(project.Spec.Members[0].Roles, core.ProjectMemberCustomPrefix+"deployer")
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.members[0].roles[1]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid and duplicate names", func() {
+				rules := []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"get"}}}
+				project.Spec.CustomRoles = []core.ProjectCustomRole{
+					{Name: "", Rules: rules},
+					{Name: "foo", Rules: rules},
+					{Name: "foo", Rules: rules},
+					{Name: "foo/bar", Rules: rules},
+					{Name: "this-is-a-very-long-role-name", Rules: rules},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customRoles[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.customRoles[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.customRoles[3].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooLong),
+						"Field": Equal("spec.customRoles[4].name"),
+					})),
+				))
+			})
+
+			It("should forbid invalid rules", func() {
+				project.Spec.CustomRoles = []core.ProjectCustomRole{
+					{Name: "foo"},
+					{Name: "bar", Rules: []rbacv1.PolicyRule{
+						{Resources: []string{"configmaps"}},
+						{NonResourceURLs: []string{"/healthz"}, Verbs: []string{"get"}},
+					}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customRoles[0].rules"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customRoles[1].rules[0].verbs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.customRoles[1].rules[1].resources"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.customRoles[1].rules[1].nonResourceURLs"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -12,6 +12,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -23,9 +24,6 @@ import (
 )
 
 var (
-	availableIngressKinds = sets.New(
-		v1beta1constants.IngressKindNginx,
-	)
 	availableExternalTrafficPolicies = sets.New(
 		string(corev1.ServiceExternalTrafficPolicyTypeCluster),
 		string(corev1.ServiceExternalTrafficPolicyTypeLocal),
@@ -35,6 +33,9 @@ var (
 		v1beta1constants.GardenerOperationReconcile,
 		v1beta1constants.GardenerOperationRenewKubeconfig,
 	)
+	availableSeedSpecOperations = sets.New(
+		core.SeedOperationDecommission,
+	)
 )
 
 // ValidateSeed validates a Seed object.
@@ -163,6 +164,30 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 				}
 				allErrs = append(allErrs, kubernetescorevalidation.ValidateTolerations(config.Tolerations, fldPath.Child("settings", "excessCapacityReservation", "configs").Index(i).Child("tolerations"))...)
 			}
+
+			profileNames := sets.New[string]()
+			for i, profile := range seedSpec.Settings.ExcessCapacityReservation.Profiles {
+				idxPath := fldPath.Child("settings", "excessCapacityReservation", "profiles").Index(i)
+
+				for _, msg := range validation.IsDNS1123Label(profile.Name) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), profile.Name, msg))
+				}
+				if profileNames.Has(profile.Name) {
+					allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), profile.Name))
+				}
+				profileNames.Insert(profile.Name)
+
+				if profile.Replicas != nil {
+					allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*profile.Replicas), idxPath.Child("replicas"))...)
+				}
+				if len(profile.Resources) == 0 {
+					allErrs = append(allErrs, field.Required(idxPath.Child("resources"), "cannot be empty"))
+				}
+				for resource, value := range profile.Resources {
+					allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resource.String(), value, idxPath.Child("resources").Child(resource.String()))...)
+				}
+				allErrs = append(allErrs, kubernetescorevalidation.ValidateTolerations(profile.Tolerations, idxPath.Child("tolerations"))...)
+			}
 		}
 		if seedSpec.Settings.LoadBalancerServices != nil {
 			allErrs = append(allErrs, apivalidation.ValidateAnnotations(seedSpec.Settings.LoadBalancerServices.Annotations, fldPath.Child("settings", "loadBalancerServices", "annotations"))...)
@@ -197,6 +222,18 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if helper.SeedSettingTopologyAwareRoutingEnabled(seedSpec.Settings) && len(seedSpec.Provider.Zones) <= 1 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "topologyAwareRouting", "enabled"), "topology-aware routing can only be enabled on multi-zone Seed clusters (with at least two zones in spec.provider.zones)"))
 		}
+		if apiServerProxy := seedSpec.Settings.APIServerProxy; apiServerProxy != nil {
+			if apiServerProxy.MaxConnections != nil && *apiServerProxy.MaxConnections <= 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("settings", "apiServerProxy", "maxConnections"), *apiServerProxy.MaxConnections, "must be positive"))
+			}
+			if apiServerProxy.MaxConnectionsPerSecond != nil && *apiServerProxy.MaxConnectionsPerSecond <= 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("settings", "apiServerProxy", "maxConnectionsPerSecond"), *apiServerProxy.MaxConnectionsPerSecond, "must be positive"))
+			}
+		}
+	}
+
+	if seedSpec.Operation != nil && !availableSeedSpecOperations.Has(*seedSpec.Operation) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operation"), *seedSpec.Operation, sets.List(availableSeedSpecOperations)))
 	}
 
 	if !inTemplate && seedSpec.Ingress == nil {
@@ -209,12 +246,14 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		} else {
 			allErrs = append(allErrs, ValidateDNS1123Subdomain(seedSpec.Ingress.Domain, fldPath.Child("ingress", "domain"))...)
 		}
-		if !availableIngressKinds.Has(seedSpec.Ingress.Controller.Kind) {
-			allErrs = append(allErrs, field.NotSupported(
-				fldPath.Child("ingress", "controller", "kind"),
-				seedSpec.Ingress.Controller.Kind,
-				availableIngressKinds.UnsortedList()),
-			)
+		// Ingress controller kinds other than nginx are provided by extensions. Whether the kind is offered by a registered
+		// extension is checked by the ExtensionValidator admission plugin.
+		if kindPath := fldPath.Child("ingress", "controller", "kind"); len(seedSpec.Ingress.Controller.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(kindPath, "cannot be empty"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(seedSpec.Ingress.Controller.Kind) {
+				allErrs = append(allErrs, field.Invalid(kindPath, seedSpec.Ingress.Controller.Kind, msg))
+			}
 		}
 		if seedSpec.DNS.Provider == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("dns", "provider"),
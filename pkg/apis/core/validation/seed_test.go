@@ -205,6 +205,22 @@ var _ = Describe("Seed Validation Tests", func() {
 			})
 		})
 
+		Context("spec operation", func() {
+			It("should allow the Decommission operation", func() {
+				seed.Spec.Operation = ptr.To(core.SeedOperationDecommission)
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported operations", func() {
+				seed.Spec.Operation = ptr.To(core.SeedOperation("Foo"))
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.operation"),
+					}))))
+			})
+		})
+
 		It("should forbid Seed specification with empty or invalid keys", func() {
 			invalidCIDR := "invalid-cidr"
 			seed.Spec.Provider = core.SeedProvider{
@@ -808,6 +824,79 @@ var _ = Describe("Seed Validation Tests", func() {
 						})),
 					))
 				})
+
+				It("should allow valid excessCapacityReservation profiles", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ExcessCapacityReservation: &core.SeedSettingExcessCapacityReservation{
+							Profiles: []core.SeedSettingExcessCapacityReservationProfile{
+								{
+									Name:      "small",
+									Resources: corev1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("2Gi")},
+								},
+								{
+									Name:         "large",
+									Replicas:     ptr.To[int32](1),
+									Resources:    corev1.ResourceList{"cpu": resource.MustParse("8"), "memory": resource.MustParse("32Gi")},
+									NodeSelector: map[string]string{"foo": "bar"},
+									Tolerations:  []corev1.Toleration{{Key: "foo", Operator: "Equal", Value: "bar", Effect: "NoExecute"}},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateSeed(seed)).To(BeEmpty())
+				})
+
+				It("should not allow invalid excessCapacityReservation profiles", func() {
+					seed.Spec.Settings = &core.SeedSettings{
+						ExcessCapacityReservation: &core.SeedSettingExcessCapacityReservation{
+							Profiles: []core.SeedSettingExcessCapacityReservationProfile{
+								{
+									Name:      "Large",
+									Replicas:  ptr.To[int32](-1),
+									Resources: corev1.ResourceList{"cpu": resource.MustParse("-1")},
+								},
+								{
+									Name:        "medium",
+									Tolerations: []corev1.Toleration{{Key: "foo", Value: "bar", Operator: "Equal", Effect: "foobar"}},
+								},
+								{
+									Name:      "medium",
+									Resources: corev1.ResourceList{"cpu": resource.MustParse("1")},
+								},
+							},
+						},
+					}
+
+					errorList := ValidateSeed(seed)
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.excessCapacityReservation.profiles[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.excessCapacityReservation.profiles[0].replicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.settings.excessCapacityReservation.profiles[0].resources.cpu"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.settings.excessCapacityReservation.profiles[1].resources"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.settings.excessCapacityReservation.profiles[1].tolerations[0].effect"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.settings.excessCapacityReservation.profiles[2].name"),
+						})),
+					))
+				})
 			})
 
 			Context("loadbalancer", func() {
@@ -1038,6 +1127,37 @@ var _ = Describe("Seed Validation Tests", func() {
 
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow valid apiserver-proxy limits", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					APIServerProxy: &core.SeedSettingAPIServerProxy{
+						MaxConnections:          ptr.To[int32](1000),
+						MaxConnectionsPerSecond: ptr.To[int32](100),
+					},
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid non-positive apiserver-proxy limits", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					APIServerProxy: &core.SeedSettingAPIServerProxy{
+						MaxConnections:          ptr.To[int32](0),
+						MaxConnectionsPerSecond: ptr.To[int32](-1),
+					},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.settings.apiServerProxy.maxConnections"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.settings.apiServerProxy.maxConnectionsPerSecond"),
+					})),
+				))
+			})
 		})
 
 		It("should fail updating immutable fields", func() {
@@ -1160,13 +1280,30 @@ var _ = Describe("Seed Validation Tests", func() {
 				}))
 			})
 
-			It("should fail if kind is different to nginx", func() {
-				seed.Spec.Ingress.Controller.Kind = "new-kind"
+			It("should allow kinds different to nginx which are provided by extensions", func() {
+				seed.Spec.Ingress.Controller.Kind = "contour"
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should fail if kind is empty", func() {
+				seed.Spec.Ingress.Controller.Kind = ""
+
+				errorList := ValidateSeed(seed)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.ingress.controller.kind"),
+				}))
+			})
+
+			It("should fail if kind is no DNS1123 label", func() {
+				seed.Spec.Ingress.Controller.Kind = "New_Kind"
 
 				errorList := ValidateSeed(seed)
 
 				Expect(errorList).To(ConsistOfFields(Fields{
-					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal("spec.ingress.controller.kind"),
 				}))
 			})
@@ -98,6 +98,11 @@ var (
 		string(core.ClusterAutoscalerExpanderPriority),
 		string(core.ClusterAutoscalerExpanderRandom),
 	)
+	availableClusterAutoscalerProfiles = sets.New(
+		string(core.ClusterAutoscalerProfileConservative),
+		string(core.ClusterAutoscalerProfileBalanced),
+		string(core.ClusterAutoscalerProfileAggressive),
+	)
 	availableCoreDNSAutoscalingModes = sets.New(
 		string(core.CoreDNSAutoscalingModeClusterProportional),
 		string(core.CoreDNSAutoscalingModeHorizontal),
@@ -106,6 +111,17 @@ var (
 		string(core.SchedulingProfileBalanced),
 		string(core.SchedulingProfileBinPacking),
 	)
+	availableObservabilityProbeProtocols = sets.New(
+		string(core.ObservabilityProbeProtocolHTTP),
+		string(core.ObservabilityProbeProtocolTCP),
+	)
+	availableETCDCompactionModes = sets.New(
+		string(core.ETCDCompactionModePeriodic),
+		string(core.ETCDCompactionModeRevision),
+	)
+	// etcdQuota is the backend quota of the etcds of Shoot clusters.
+	etcdQuota = resource.MustParse("8Gi")
+
 	errorCodesAllowingForceDeletion = sets.New(
 		core.ErrorInfraUnauthenticated,
 		core.ErrorInfraUnauthorized,
@@ -146,6 +162,40 @@ func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
 	allErrs = append(allErrs, validateShootManagedIssuer(shoot)...)
+	allErrs = append(allErrs, validateShootCareConditionAnnotations(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootCertificateValiditiesAnnotation(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+
+	return allErrs
+}
+
+func validateShootCertificateValiditiesAnnotation(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value, ok := annotations[v1beta1constants.AnnotationShootCertificateValidities]; ok {
+		if _, err := gardenerutils.ParseCertificateValidities(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootCertificateValidities), value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootCareConditionAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, key := range []string{v1beta1constants.AnnotationShootCareConditionThresholds, v1beta1constants.AnnotationShootCareConditionStaleDurations} {
+		if value, ok := annotations[key]; ok {
+			if _, err := gardenerutils.ParseCareConditionDurations(value); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, err.Error()))
+			}
+		}
+	}
+
+	if value, ok := annotations[v1beta1constants.AnnotationShootCareConditionSeverities]; ok {
+		if _, err := gardenerutils.ParseCareConditionSeverities(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(v1beta1constants.AnnotationShootCareConditionSeverities), value, err.Error()))
+		}
+	}
 
 	return allErrs
 }
@@ -259,14 +309,20 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 
 	allErrs = append(allErrs, ValidateCloudProfileReference(spec.CloudProfile, fldPath.Child("cloudProfile"))...)
 	allErrs = append(allErrs, validateProvider(spec.Provider, spec.Kubernetes, spec.Networking, workerless, fldPath.Child("provider"), inTemplate)...)
+	if !workerless && spec.Provider.WorkersSettings != nil {
+		allErrs = append(allErrs, validateWorkersSettings(spec.Provider.WorkersSettings, spec.Resources, fldPath.Child("provider", "workersSettings"))...)
+	}
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateResources(spec.Resources, fldPath.Child("resources"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
-	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
+	allErrs = append(allErrs, validateMaintenance(meta.Name, spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateObservability(spec.Observability, spec.Resources, fldPath.Child("observability"))...)
+	allErrs = append(allErrs, validateShootReadinessGates(spec.ReadinessGates, fldPath.Child("readinessGates"))...)
+	allErrs = append(allErrs, validateShootReconciliation(spec.Reconciliation, fldPath.Child("reconciliation"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
 
 	if len(spec.Region) == 0 {
@@ -319,7 +375,6 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ExposureClassName, oldSpec.ExposureClassName, fldPath.Child("exposureClassName"))...)
 
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, newSpec.SeedName != nil, fldPath.Child("dns"))...)
-	allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, false, fldPath.Child("kubernetes", "version"))...)
 
 	allErrs = append(allErrs, validateKubeControllerManagerUpdate(newSpec.Kubernetes.KubeControllerManager, oldSpec.Kubernetes.KubeControllerManager, fldPath.Child("kubernetes", "kubeControllerManager"))...)
 
@@ -339,30 +394,8 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 
 	allErrs = append(allErrs, ValidateProviderUpdate(&newSpec.Provider, &oldSpec.Provider, fldPath.Child("provider"))...)
 
-	for i, newWorker := range newSpec.Provider.Workers {
-		oldWorker := newWorker
-		for _, ow := range oldSpec.Provider.Workers {
-			if ow.Name == newWorker.Name {
-				oldWorker = ow
-				break
-			}
-		}
-		idxPath := fldPath.Child("provider", "workers").Index(i)
-
-		oldKubernetesVersion := oldSpec.Kubernetes.Version
-		newKubernetesVersion := newSpec.Kubernetes.Version
-		if oldWorker.Kubernetes != nil && oldWorker.Kubernetes.Version != nil {
-			oldKubernetesVersion = *oldWorker.Kubernetes.Version
-		}
-		if newWorker.Kubernetes != nil && newWorker.Kubernetes.Version != nil {
-			newKubernetesVersion = *newWorker.Kubernetes.Version
-		}
-
-		// worker kubernetes versions must not be downgraded and but can skip minor versions
-		allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newKubernetesVersion, oldKubernetesVersion, true, idxPath.Child("kubernetes", "version"))...)
-	}
-
 	allErrs = append(allErrs, validateNetworkingUpdate(newSpec.Networking, oldSpec.Networking, fldPath.Child("networking"))...)
+	allErrs = append(allErrs, validateSystemComponentsUpdate(newSpec.SystemComponents, oldSpec.SystemComponents, fldPath.Child("systemComponents"))...)
 
 	if !reflect.DeepEqual(oldSpec.SchedulerName, newSpec.SchedulerName) {
 		// only allow to set an empty scheduler name to the default scheduler
@@ -387,7 +420,16 @@ func validateWorkerUpdate(newHasWorkers, oldHasWorkers bool, fldPath *field.Path
 
 // ValidateProviderUpdate validates the specification of a Provider object.
 func ValidateProviderUpdate(newProvider, oldProvider *core.Provider, fldPath *field.Path) field.ErrorList {
-	return apivalidation.ValidateImmutableField(newProvider.Type, oldProvider.Type, fldPath.Child("type"))
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newProvider.Type, oldProvider.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(egressOnlyInternetAccessEnabled(newProvider.WorkersSettings), egressOnlyInternetAccessEnabled(oldProvider.WorkersSettings), fldPath.Child("workersSettings", "egressOnlyInternetAccess", "enabled"))...)
+
+	return allErrs
+}
+
+func egressOnlyInternetAccessEnabled(workersSettings *core.WorkersSettings) bool {
+	return workersSettings != nil && workersSettings.EgressOnlyInternetAccess != nil && workersSettings.EgressOnlyInternetAccess.Enabled
 }
 
 // ValidateShootStatusUpdate validates the status field of a Shoot object.
@@ -410,6 +452,9 @@ func ValidateShootStatusUpdate(newStatus, oldStatus core.ShootStatus) field.Erro
 	if len(newStatus.AdvertisedAddresses) > 0 {
 		allErrs = append(allErrs, validateAdvertiseAddresses(newStatus.AdvertisedAddresses, fldPath.Child("advertisedAddresses"))...)
 	}
+	if newStatus.Networking != nil && newStatus.Networking.PathMTU != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*newStatus.Networking.PathMTU), fldPath.Child("networking", "pathMTU"))...)
+	}
 
 	return allErrs
 }
@@ -485,6 +530,43 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 		}
 	}
 
+	if addons != nil {
+		allErrs = append(allErrs, validateAddonCharts(addons.Charts, fldPath.Child("charts"))...)
+	}
+
+	return allErrs
+}
+
+func validateAddonCharts(charts []core.AddonChart, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, chart := range charts {
+		idxPath := fldPath.Index(i)
+
+		if chart.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(chart.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), chart.Name, msg))
+			}
+			if names.Has(chart.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), chart.Name))
+			}
+			names.Insert(chart.Name)
+		}
+
+		if chart.Namespace != nil {
+			for _, msg := range validation.IsDNS1123Label(*chart.Namespace) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), *chart.Namespace, msg))
+			}
+		}
+
+		allErrs = append(allErrs, validateOCIRepository(&chart.OCIRepository, idxPath.Child("ociRepository"))...)
+	}
+
 	return allErrs
 }
 
@@ -675,6 +757,13 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Nodes, oldNetworking.Nodes, fldPath.Child("nodes"))...)
 	}
 
+	newAdditionalNodes := sets.New(newNetworking.AdditionalNodes...)
+	for _, additionalNodes := range oldNetworking.AdditionalNodes {
+		if !newAdditionalNodes.Has(additionalNodes) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalNodes"), fmt.Sprintf("additional node network %q cannot be removed", additionalNodes)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -826,6 +915,11 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 	allErrs = append(allErrs, ValidateKubeAPIServer(kubernetes.KubeAPIServer, kubernetes.Version, workerless, gardenerutils.DefaultResourcesForEncryption(), fldPath.Child("kubeAPIServer"))...)
 	allErrs = append(allErrs, ValidateKubeControllerManager(kubernetes.KubeControllerManager, networking, kubernetes.Version, workerless, fldPath.Child("kubeControllerManager"))...)
 
+	if etcd := kubernetes.ETCD; etcd != nil {
+		allErrs = append(allErrs, validateETCDConfig(etcd.Main, fldPath.Child("etcd", "main"))...)
+		allErrs = append(allErrs, validateETCDConfig(etcd.Events, fldPath.Child("etcd", "events"))...)
+	}
+
 	if workerless {
 		allErrs = append(allErrs, validateKubernetesForWorkerlessShoot(kubernetes, fldPath)...)
 	} else {
@@ -848,6 +942,58 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 	return allErrs
 }
 
+func validateETCDConfig(etcd *core.ETCDConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if etcd == nil {
+		return allErrs
+	}
+
+	if compaction := etcd.Compaction; compaction != nil {
+		mode := ptr.Deref(compaction.Mode, core.ETCDCompactionModePeriodic)
+		if !availableETCDCompactionModes.Has(string(mode)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("compaction", "mode"), mode, sets.List(availableETCDCompactionModes)))
+		}
+
+		if retention := compaction.Retention; retention != nil {
+			retentionPath := fldPath.Child("compaction", "retention")
+
+			switch mode {
+			case core.ETCDCompactionModePeriodic:
+				if hours, err := strconv.Atoi(*retention); err == nil {
+					if hours <= 0 {
+						allErrs = append(allErrs, field.Invalid(retentionPath, *retention, "must be positive"))
+					}
+				} else if duration, err := time.ParseDuration(*retention); err != nil {
+					allErrs = append(allErrs, field.Invalid(retentionPath, *retention, "must be a duration or a number of hours"))
+				} else if duration <= 0 {
+					allErrs = append(allErrs, field.Invalid(retentionPath, *retention, "must be positive"))
+				}
+			case core.ETCDCompactionModeRevision:
+				if revisions, err := strconv.ParseInt(*retention, 10, 64); err != nil || revisions <= 0 {
+					allErrs = append(allErrs, field.Invalid(retentionPath, *retention, "must be a positive number of revisions"))
+				}
+			}
+		}
+	}
+
+	if defragmentation := etcd.Defragmentation; defragmentation != nil {
+		if intervalDays := defragmentation.IntervalDays; intervalDays != nil && (*intervalDays < 1 || *intervalDays > 31) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("defragmentation", "intervalDays"), *intervalDays, "must be between 1 and 31"))
+		}
+
+		if threshold := defragmentation.DBSizeThreshold; threshold != nil {
+			if threshold.Sign() <= 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("defragmentation", "dbSizeThreshold"), threshold.String(), "must be positive"))
+			} else if threshold.Cmp(etcdQuota) >= 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("defragmentation", "dbSizeThreshold"), threshold.String(), fmt.Sprintf("must be less than the etcd quota of %s", etcdQuota.String())))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func validateKubernetesForWorkerlessShoot(kubernetes core.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -899,6 +1045,12 @@ func validateNetworking(networking *core.Networking, workerless bool, fldPath *f
 		if networking.Nodes != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodes"), workerlessErrorMsg))
 		}
+		if len(networking.AdditionalNodes) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalNodes"), workerlessErrorMsg))
+		}
+		if networking.PrivateLink != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateLink"), workerlessErrorMsg))
+		}
 	} else {
 		if networking == nil {
 			allErrs = append(allErrs, field.Required(fldPath, "networking should not be nil for a Shoot with workers"))
@@ -944,6 +1096,50 @@ func validateNetworking(networking *core.Networking, workerless bool, fldPath *f
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
 	}
 
+	if !workerless {
+		allErrs = append(allErrs, validateAdditionalNodeNetworks(networking, primaryIPFamily, fldPath)...)
+	}
+
+	return allErrs
+}
+
+func validateAdditionalNodeNetworks(networking *core.Networking, primaryIPFamily core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(networking.AdditionalNodes) == 0 {
+		return allErrs
+	}
+
+	if networking.Nodes == nil {
+		return append(allErrs, field.Forbidden(fldPath.Child("additionalNodes"), "additional node networks can only be configured if the primary node network (nodes) is set"))
+	}
+
+	var networks []cidrvalidation.CIDR
+	networks = append(networks, cidrvalidation.NewCIDR(*networking.Nodes, fldPath.Child("nodes")))
+	if networking.Pods != nil {
+		networks = append(networks, cidrvalidation.NewCIDR(*networking.Pods, fldPath.Child("pods")))
+	}
+	if networking.Services != nil {
+		networks = append(networks, cidrvalidation.NewCIDR(*networking.Services, fldPath.Child("services")))
+	}
+
+	for i, additionalNodes := range networking.AdditionalNodes {
+		path := fldPath.Child("additionalNodes").Index(i)
+		cidr := cidrvalidation.NewCIDR(additionalNodes, path)
+
+		if errs := cidr.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidr.ValidateIPFamily(string(primaryIPFamily))...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(path, cidr.GetCIDR())...)
+
+		for _, network := range networks {
+			allErrs = append(allErrs, network.ValidateNotOverlap(cidr)...)
+		}
+		networks = append(networks, cidr)
+	}
+
 	return allErrs
 }
 
@@ -1094,6 +1290,10 @@ func ValidateClusterAutoscaler(autoScaler core.ClusterAutoscaler, fldPath *field
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEmptyBulkDelete"), *maxEmptyBulkDelete, "can not be negative"))
 	}
 
+	if profile := autoScaler.Profile; profile != nil && !availableClusterAutoscalerProfiles.Has(string(*profile)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("profile"), *profile, sets.List(availableClusterAutoscalerProfiles)))
+	}
+
 	return allErrs
 }
 
@@ -1301,6 +1501,52 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kubeAPIServer.FeatureGates, version, fldPath.Child("featureGates"))...)
 
+	allErrs = append(allErrs, validateKubeAPIServerAdditionalSANs(kubeAPIServer.AdditionalSANs, fldPath.Child("additionalSANs"))...)
+
+	return allErrs
+}
+
+func validateKubeAPIServerAdditionalSANs(additionalSANs *core.KubeAPIServerAdditionalSANs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if additionalSANs == nil {
+		return allErrs
+	}
+
+	dnsNames := sets.New[string]()
+	for i, dnsName := range additionalSANs.DNSNames {
+		idxPath := fldPath.Child("dnsNames").Index(i)
+
+		validate := validation.IsDNS1123Subdomain
+		if strings.HasPrefix(dnsName, "*.") {
+			validate = validation.IsWildcardDNS1123Subdomain
+		}
+		for _, msg := range validate(dnsName) {
+			allErrs = append(allErrs, field.Invalid(idxPath, dnsName, msg))
+		}
+
+		if dnsNames.Has(dnsName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, dnsName))
+		}
+		dnsNames.Insert(dnsName)
+	}
+
+	ipAddresses := sets.New[string]()
+	for i, ipAddress := range additionalSANs.IPAddresses {
+		idxPath := fldPath.Child("ipAddresses").Index(i)
+
+		ip := net.ParseIP(ipAddress)
+		if ip == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, ipAddress, "must be a valid IP address"))
+			continue
+		}
+
+		if ipAddresses.Has(ip.String()) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, ipAddress))
+		}
+		ipAddresses.Insert(ip.String())
+	}
+
 	return allErrs
 }
 
@@ -1405,6 +1651,202 @@ func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.
 	return allErrs
 }
 
+func validateObservability(observability *core.Observability, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if observability == nil {
+		return allErrs
+	}
+	if observability.Logging != nil {
+		allErrs = append(allErrs, validateObservabilityLogging(observability.Logging, fldPath.Child("logging"))...)
+	}
+	if observability.EventArchive != nil {
+		allErrs = append(allErrs, validateEventArchive(observability.EventArchive, resources, fldPath.Child("eventArchive"))...)
+	}
+	allErrs = append(allErrs, validateObservabilityProbes(observability.Probes, fldPath.Child("probes"))...)
+	return allErrs
+}
+
+func validateObservabilityProbes(probes []core.ObservabilityProbe, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, probe := range probes {
+		idxPath := fldPath.Index(i)
+
+		if len(probe.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(probe.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), probe.Name, msg))
+			}
+			if names.Has(probe.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), probe.Name))
+			}
+			names.Insert(probe.Name)
+		}
+
+		if !availableObservabilityProbeProtocols.Has(string(probe.Protocol)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), probe.Protocol, sets.List(availableObservabilityProbeProtocols)))
+			continue
+		}
+
+		if len(probe.Target) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("target"), "must provide a target"))
+			continue
+		}
+
+		switch probe.Protocol {
+		case core.ObservabilityProbeProtocolHTTP:
+			if u, err := url.Parse(probe.Target); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("target"), probe.Target, "must be a valid http or https URL"))
+			}
+		case core.ObservabilityProbeProtocolTCP:
+			if host, port, err := net.SplitHostPort(probe.Target); err != nil || len(host) == 0 || len(port) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("target"), probe.Target, "must be an address in the form host:port"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootReadinessGates(readinessGates []core.ShootReadinessGate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, gate := range readinessGates {
+		idxPath := fldPath.Index(i)
+
+		if len(gate.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(gate.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), gate.Name, msg))
+			}
+			if names.Has(gate.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), gate.Name))
+			}
+			names.Insert(gate.Name)
+		}
+
+		if (gate.HTTPGet == nil) == (gate.CustomResourceDefinition == nil) {
+			allErrs = append(allErrs, field.Invalid(idxPath, gate.Name, "exactly one of httpGet or customResourceDefinition must be configured"))
+			continue
+		}
+
+		if httpGet := gate.HTTPGet; httpGet != nil {
+			httpGetPath := idxPath.Child("httpGet")
+
+			for _, msg := range apivalidation.ValidateNamespaceName(httpGet.Namespace, false) {
+				allErrs = append(allErrs, field.Invalid(httpGetPath.Child("namespace"), httpGet.Namespace, msg))
+			}
+			for _, msg := range apivalidation.NameIsDNS1035Label(httpGet.Service, false) {
+				allErrs = append(allErrs, field.Invalid(httpGetPath.Child("service"), httpGet.Service, msg))
+			}
+			for _, msg := range validation.IsValidPortNum(int(httpGet.Port)) {
+				allErrs = append(allErrs, field.Invalid(httpGetPath.Child("port"), httpGet.Port, msg))
+			}
+			if httpGet.Path != nil && !strings.HasPrefix(*httpGet.Path, "/") {
+				allErrs = append(allErrs, field.Invalid(httpGetPath.Child("path"), *httpGet.Path, "must start with '/'"))
+			}
+			if httpGet.Scheme != nil && *httpGet.Scheme != "http" && *httpGet.Scheme != "https" {
+				allErrs = append(allErrs, field.NotSupported(httpGetPath.Child("scheme"), *httpGet.Scheme, []string{"http", "https"}))
+			}
+		}
+
+		if crd := gate.CustomResourceDefinition; crd != nil {
+			if len(crd.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("customResourceDefinition", "name"), "must provide a name"))
+			} else {
+				for _, msg := range validation.IsDNS1123Subdomain(crd.Name) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("customResourceDefinition", "name"), crd.Name, msg))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootReconciliation(reconciliation *core.ShootReconciliation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if reconciliation == nil {
+		return allErrs
+	}
+
+	if !reconciliation.Paused {
+		if reconciliation.Reason != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("reason"), "must not be set when the reconciliation is not paused"))
+		}
+		if reconciliation.PausedUntil != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("pausedUntil"), "must not be set when the reconciliation is not paused"))
+		}
+		return allErrs
+	}
+
+	if len(strings.TrimSpace(ptr.Deref(reconciliation.Reason, ""))) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), "must provide a reason when the reconciliation is paused"))
+	}
+
+	return allErrs
+}
+
+func validateEventArchive(eventArchive *core.EventArchive, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if eventArchive.RetentionDays != nil && *eventArchive.RetentionDays < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *eventArchive.RetentionDays, "must be at least 1"))
+	}
+
+	if sink := eventArchive.Sink; sink != nil {
+		sinkPath := fldPath.Child("sink")
+		if len(sink.Provider) == 0 {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("provider"), "must provide a provider type"))
+		}
+
+		if len(sink.ResourceName) == 0 {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("resourceName"), "must provide a resource name"))
+		} else {
+			var found bool
+			for _, resource := range resources {
+				if resource.Name == sink.ResourceName {
+					found = true
+					if resource.ResourceRef.Kind != "Secret" {
+						allErrs = append(allErrs, field.Invalid(sinkPath.Child("resourceName"), sink.ResourceName, "must refer to a resource of kind Secret"))
+					}
+					break
+				}
+			}
+			if !found {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("resourceName"), sink.ResourceName, "must refer to a resource in spec.resources"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateObservabilityLogging(logging *core.ObservabilityLogging, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if logging.RetentionDays != nil && *logging.RetentionDays < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *logging.RetentionDays, "must be at least 1"))
+	}
+	if logging.IngestionRate != nil && logging.IngestionRate.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ingestionRate"), logging.IngestionRate.String(), "must be greater than 0"))
+	}
+	if logging.IngestionBurstSize != nil {
+		if logging.IngestionBurstSize.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ingestionBurstSize"), logging.IngestionBurstSize.String(), "must be greater than 0"))
+		} else if logging.IngestionRate != nil && logging.IngestionBurstSize.Cmp(*logging.IngestionRate) < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ingestionBurstSize"), logging.IngestionBurstSize.String(), "must be greater than or equal to ingestionRate"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	emails := make(map[string]struct{})
@@ -1422,7 +1864,7 @@ func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
-func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, workerless bool) field.ErrorList {
+func validateMaintenance(shootName string, maintenance *core.Maintenance, fldPath *field.Path, workerless bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if maintenance == nil {
@@ -1452,6 +1894,23 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 		}
 	}
 
+	if dependsOn := maintenance.DependsOn; dependsOn != nil {
+		idxPath := fldPath.Child("dependsOn")
+
+		if len(dependsOn.ShootName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("shootName"), "must provide the name of a shoot"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(dependsOn.ShootName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("shootName"), dependsOn.ShootName, msg))
+			}
+			if len(shootName) > 0 && dependsOn.ShootName == shootName {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("shootName"), dependsOn.ShootName, "shoot must not depend on itself"))
+			}
+		}
+
+		allErrs = append(allErrs, ValidatePositiveDuration(dependsOn.MinimumRunDuration, idxPath.Child("minimumRunDuration"))...)
+	}
+
 	return allErrs
 }
 
@@ -1475,7 +1934,12 @@ func validateProvider(provider core.Provider, kubernetes core.Kubernetes, networ
 		if provider.WorkersSettings != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("workersSettings"), workerlessErrorMsg))
 		}
+		if len(provider.ResourceTags) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourceTags"), workerlessErrorMsg))
+		}
 	} else {
+		allErrs = append(allErrs, validateResourceTags(provider.ResourceTags, fldPath.Child("resourceTags"))...)
+
 		if kubernetes.Kubelet != nil && kubernetes.Kubelet.MaxPods != nil {
 			maxPod = *kubernetes.Kubelet.MaxPods
 		}
@@ -1503,6 +1967,178 @@ func validateProvider(provider core.Provider, kubernetes core.Kubernetes, networ
 	return allErrs
 }
 
+var availableContainerdRegistryCapabilities = sets.New(
+	string(core.ContainerdRegistryCapabilityPull),
+	string(core.ContainerdRegistryCapabilityResolve),
+	string(core.ContainerdRegistryCapabilityPush),
+)
+
+func validateWorkersSettings(workersSettings *core.WorkersSettings, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workersSettings.Containerd != nil {
+		allErrs = append(allErrs, validateContainerdRegistries(workersSettings.Containerd.Registries, resources, fldPath.Child("containerd", "registries"))...)
+	}
+
+	return allErrs
+}
+
+func validateContainerdRegistries(registries []core.ContainerdRegistry, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	upstreams := sets.New[string]()
+
+	for i, registry := range registries {
+		idxPath := fldPath.Index(i)
+
+		if len(registry.Upstream) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstream"), "must provide an upstream"))
+		} else {
+			allErrs = append(allErrs, validateContainerdRegistryUpstream(registry.Upstream, idxPath.Child("upstream"))...)
+			if upstreams.Has(registry.Upstream) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("upstream"), registry.Upstream))
+			}
+			upstreams.Insert(registry.Upstream)
+		}
+
+		if registry.Server != nil {
+			allErrs = append(allErrs, validateContainerdRegistryURL(*registry.Server, idxPath.Child("server"))...)
+		}
+
+		hostURLs := sets.New[string]()
+		for j, host := range registry.Hosts {
+			hostPath := idxPath.Child("hosts").Index(j)
+
+			if len(host.URL) == 0 {
+				allErrs = append(allErrs, field.Required(hostPath.Child("url"), "must provide a url"))
+			} else {
+				allErrs = append(allErrs, validateContainerdRegistryURL(host.URL, hostPath.Child("url"))...)
+				if hostURLs.Has(host.URL) {
+					allErrs = append(allErrs, field.Duplicate(hostPath.Child("url"), host.URL))
+				}
+				hostURLs.Insert(host.URL)
+			}
+
+			for k, capability := range host.Capabilities {
+				if !availableContainerdRegistryCapabilities.Has(string(capability)) {
+					allErrs = append(allErrs, field.NotSupported(hostPath.Child("capabilities").Index(k), capability, sets.List(availableContainerdRegistryCapabilities)))
+				}
+			}
+
+			if host.ResourceName != nil {
+				var found bool
+				for _, resource := range resources {
+					if resource.Name == *host.ResourceName {
+						found = true
+						if resource.ResourceRef.Kind != "Secret" {
+							allErrs = append(allErrs, field.Invalid(hostPath.Child("resourceName"), *host.ResourceName, "must refer to a resource of kind Secret"))
+						}
+						break
+					}
+				}
+				if !found {
+					allErrs = append(allErrs, field.Invalid(hostPath.Child("resourceName"), *host.ResourceName, "must refer to a resource in spec.resources"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateContainerdRegistryUpstream(upstream string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	host := upstream
+	if strings.Contains(upstream, ":") {
+		var port string
+		var err error
+		if host, port, err = net.SplitHostPort(upstream); err != nil {
+			return append(allErrs, field.Invalid(fldPath, upstream, "must be a host with an optional port"))
+		}
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, upstream, "must have a valid port"))
+		}
+	}
+
+	for _, msg := range validation.IsDNS1123Subdomain(host) {
+		allErrs = append(allErrs, field.Invalid(fldPath, upstream, msg))
+	}
+
+	return allErrs
+}
+
+func validateContainerdRegistryURL(rawURL string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if u, err := url.Parse(rawURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, "must be a valid http or https URL"))
+	} else if u.User != nil || len(u.RawQuery) > 0 || len(u.Fragment) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, "must not contain user information, query or fragment"))
+	}
+
+	return allErrs
+}
+
+const (
+	// maxResourceTags is the maximum number of resource tags. Most providers limit the number of tags per resource,
+	// hence, a conservative value is chosen which leaves room for the tags added by the provider extensions.
+	maxResourceTags = 30
+	// maxResourceTagKeyLength is the maximum length of a resource tag key.
+	maxResourceTagKeyLength = 63
+	// maxResourceTagValueLength is the maximum length of a resource tag value.
+	maxResourceTagValueLength = 63
+)
+
+var (
+	// resourceTagKeyRegex is used for validating resource tag keys. It is restricted to characters which are supported
+	// by the tagging/labelling mechanisms of all common cloud providers.
+	resourceTagKeyRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	// resourceTagValueRegex is used for validating resource tag values.
+	resourceTagValueRegex = regexp.MustCompile(`^[a-z0-9_-]*$`)
+	// resourceTagPlaceholderRegex matches placeholders in resource tag values.
+	resourceTagPlaceholderRegex = regexp.MustCompile(`\$\([^)]*\)`)
+	// availableResourceTagPlaceholders are the placeholders which are supported in resource tag values.
+	availableResourceTagPlaceholders = sets.New(v1beta1constants.ResourceTagPlaceholderProjectName, v1beta1constants.ResourceTagPlaceholderShootName)
+	// reservedResourceTagKeyPrefixes are prefixes of resource tag keys which are reserved for tags added by Gardener
+	// and the provider extensions.
+	reservedResourceTagKeyPrefixes = []string{"gardener", "kubernetes", "k8s"}
+)
+
+func validateResourceTags(tags map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(tags) > maxResourceTags {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(tags), maxResourceTags))
+	}
+
+	for key, value := range tags {
+		if len(key) > maxResourceTagKeyLength {
+			allErrs = append(allErrs, field.TooLong(fldPath, key, maxResourceTagKeyLength))
+		} else if !resourceTagKeyRegex.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, fmt.Sprintf("key must match regex %q", resourceTagKeyRegex.String())))
+		}
+		for _, prefix := range reservedResourceTagKeyPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), fmt.Sprintf("keys with prefix %q are reserved", prefix)))
+			}
+		}
+
+		valueWithoutPlaceholders := resourceTagPlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+			if !availableResourceTagPlaceholders.Has(placeholder) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Key(key), placeholder, sets.List(availableResourceTagPlaceholders)))
+			}
+			return ""
+		})
+		if len(valueWithoutPlaceholders) > maxResourceTagValueLength {
+			allErrs = append(allErrs, field.TooLong(fldPath.Key(key), value, maxResourceTagValueLength))
+		} else if !resourceTagValueRegex.MatchString(valueWithoutPlaceholders) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, fmt.Sprintf("value (without placeholders) must match regex %q", resourceTagValueRegex.String())))
+		}
+	}
+
+	return allErrs
+}
+
 const (
 	// maxWorkerNameLength is a constant for the maximum length for worker name.
 	maxWorkerNameLength = 15
@@ -1514,6 +2150,29 @@ const (
 // volumeSizeRegex is used for volume size validation.
 var volumeSizeRegex = regexp.MustCompile(`^(\d)+Gi$`)
 
+func validateMachineTypeRequirements(requirements core.MachineTypeRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, r := range []struct {
+		name     string
+		quantity *resource.Quantity
+	}{
+		{"cpu", requirements.CPU},
+		{"gpu", requirements.GPU},
+		{"memory", requirements.Memory},
+	} {
+		if r.quantity != nil {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(r.name, *r.quantity, fldPath.Child(r.name))...)
+		}
+	}
+
+	if requirements.Capabilities != nil {
+		allErrs = append(allErrs, validateMachineTypeCapabilities(*requirements.Capabilities, fldPath.Child("capabilities"))...)
+	}
+
+	return allErrs
+}
+
 // ValidateWorker validates the worker object.
 func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	kubernetesVersion := kubernetes.Version
@@ -1524,7 +2183,10 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, field.TooLong(fldPath.Child("name"), worker.Name, maxWorkerNameLength))
 	}
 	if len(worker.Machine.Type) == 0 {
-		allErrs = append(allErrs, field.Required(fldPath.Child("machine", "type"), "must specify a machine type"))
+		allErrs = append(allErrs, field.Required(fldPath.Child("machine", "type"), "must specify a machine type or requirements for selecting a machine type"))
+	}
+	if worker.Machine.Requirements != nil {
+		allErrs = append(allErrs, validateMachineTypeRequirements(*worker.Machine.Requirements, fldPath.Child("machine", "requirements"))...)
 	}
 	if worker.Machine.Image != nil {
 		if len(worker.Machine.Image.Name) == 0 {
@@ -1635,6 +2297,56 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("autoscaler"))...)
 	}
 
+	if worker.MachineControllerManagerSettings != nil {
+		allErrs = append(allErrs, ValidateMachineControllerManagerSettings(worker.MachineControllerManagerSettings, fldPath.Child("machineControllerManager"))...)
+	}
+
+	if worker.Confidential != nil {
+		allErrs = append(allErrs, validateWorkerConfidential(*worker.Confidential, fldPath.Child("confidential"))...)
+	}
+
+	return allErrs
+}
+
+func validateWorkerConfidential(confidential core.WorkerConfidential, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if attestation := confidential.Attestation; attestation != nil {
+		endpointPath := fldPath.Child("attestation", "endpoint")
+		if len(attestation.Endpoint) == 0 {
+			allErrs = append(allErrs, field.Required(endpointPath, "must provide the endpoint of the attestation service"))
+		} else if u, err := url.Parse(attestation.Endpoint); err != nil || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(endpointPath, attestation.Endpoint, "must be a valid URL"))
+		} else if u.Scheme != "https" {
+			allErrs = append(allErrs, field.Invalid(endpointPath, attestation.Endpoint, "must have https scheme"))
+		}
+
+		if attestation.Policy != nil && len(*attestation.Policy) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("attestation", "policy"), *attestation.Policy, "must not be empty if set"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMachineControllerManagerSettings validates the machine-controller-manager settings of worker pools.
+func ValidateMachineControllerManagerSettings(settings *core.MachineControllerManagerSettings, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidatePositiveDuration(settings.MachineDrainTimeout, fldPath.Child("machineDrainTimeout"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(settings.MachineHealthTimeout, fldPath.Child("machineHealthTimeout"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(settings.MachineCreationTimeout, fldPath.Child("machineCreationTimeout"))...)
+
+	if settings.MaxEvictRetries != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*settings.MaxEvictRetries), fldPath.Child("maxEvictRetries"))...)
+	}
+
+	for i, condition := range settings.NodeConditions {
+		if len(condition) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("nodeConditions").Index(i), "node condition type must not be empty"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -1766,6 +2478,88 @@ func ValidateKubeletConfig(kubeletConfig core.KubeletConfig, version string, fld
 		}
 	}
 
+	if len(kubeletConfig.CredentialProviders) > 0 {
+		path := fldPath.Child("credentialProviders")
+
+		if featureGateEnabled, ok := kubeletConfig.FeatureGates["KubeletCredentialProviders"]; ok && !featureGateEnabled {
+			allErrs = append(allErrs, field.Forbidden(path, "configuring credential providers is not available when kubelet's 'KubeletCredentialProviders' feature gate is disabled"))
+		}
+
+		allErrs = append(allErrs, validateKubeletCredentialProviders(kubeletConfig.CredentialProviders, path)...)
+	}
+
+	if kubeletConfig.ReservationPolicy != nil && !availableKubeletReservationPolicies.Has(string(*kubeletConfig.ReservationPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("reservationPolicy"), *kubeletConfig.ReservationPolicy, sets.List(availableKubeletReservationPolicies)))
+	}
+
+	return allErrs
+}
+
+var availableKubeletReservationPolicies = sets.New(
+	string(core.KubeletReservationPolicyStatic),
+	string(core.KubeletReservationPolicyAuto),
+)
+
+var (
+	// kubeletCredentialProviderNameRegex is used to validate the name of kubelet credential providers. The name is used
+	// as file name of the provider executable on the nodes.
+	kubeletCredentialProviderNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+	supportedKubeletCredentialProviderAPIVersions = sets.New("credentialprovider.kubelet.k8s.io/v1")
+)
+
+func validateKubeletCredentialProviders(providers []core.KubeletCredentialProvider, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, provider := range providers {
+		idxPath := fldPath.Index(i)
+
+		if len(provider.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if !kubeletCredentialProviderNameRegex.MatchString(provider.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), provider.Name, fmt.Sprintf("name must be a valid file name (regex used for validation is %q)", kubeletCredentialProviderNameRegex.String())))
+		} else if names.Has(provider.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), provider.Name))
+		}
+		names.Insert(provider.Name)
+
+		if len(provider.Image) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), "must provide the image containing the credential provider executable"))
+		}
+
+		if v := provider.FilePathInImage; v != nil && (!strings.HasPrefix(*v, "/") || strings.HasSuffix(*v, "/")) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("filePathInImage"), *v, "must be an absolute path to a file"))
+		}
+
+		if len(provider.MatchImages) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("matchImages"), "must provide at least one image pattern"))
+		}
+		for j, matchImage := range provider.MatchImages {
+			if len(matchImage) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("matchImages").Index(j), "image pattern must not be empty"))
+			} else if strings.Contains(matchImage, "://") {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("matchImages").Index(j), matchImage, "image pattern must not contain a URL scheme"))
+			}
+		}
+
+		if v := provider.DefaultCacheDuration; v != nil && v.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("defaultCacheDuration"), v.Duration.String(), "must not be negative"))
+		}
+
+		if v := provider.APIVersion; v != nil && !supportedKubeletCredentialProviderAPIVersions.Has(*v) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("apiVersion"), *v, sets.List(supportedKubeletCredentialProviderAPIVersions)))
+		}
+
+		for j, env := range provider.Env {
+			if len(env.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("env").Index(j).Child("name"), "must provide a name"))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -2204,6 +2998,70 @@ func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *
 	}
 
 	allErrs = append(allErrs, validateCoreDNS(systemComponents.CoreDNS, fldPath.Child("coreDNS"))...)
+	allErrs = append(allErrs, validateSystemComponentsPriorityClasses(systemComponents.PriorityClasses, fldPath.Child("priorityClasses"))...)
+
+	return allErrs
+}
+
+func validateSystemComponentsUpdate(newSystemComponents, oldSystemComponents *core.SystemComponents, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if newSystemComponents == nil || oldSystemComponents == nil {
+		return allErrs
+	}
+
+	oldValues := make(map[string]int32, len(oldSystemComponents.PriorityClasses))
+	for _, priorityClass := range oldSystemComponents.PriorityClasses {
+		oldValues[priorityClass.Name] = priorityClass.Value
+	}
+
+	// The value of a PriorityClass is immutable, hence it cannot be changed without recreating the PriorityClass.
+	for i, priorityClass := range newSystemComponents.PriorityClasses {
+		if oldValue, ok := oldValues[priorityClass.Name]; ok {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(priorityClass.Value, oldValue, fldPath.Child("priorityClasses").Index(i).Child("value"))...)
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	// maxSystemComponentsPriorityClassValue is the maximum value of additional PriorityClasses for system components.
+	// It is lower than the values of the PriorityClasses for the Shoot system components managed by Gardener.
+	maxSystemComponentsPriorityClassValue = 999999000
+)
+
+// reservedPriorityClassNamePrefixes are prefixes of PriorityClass names which are reserved for Kubernetes and Gardener.
+var reservedPriorityClassNamePrefixes = []string{"system-", "gardener-"}
+
+func validateSystemComponentsPriorityClasses(priorityClasses []core.SystemComponentsPriorityClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, priorityClass := range priorityClasses {
+		idxPath := fldPath.Index(i)
+
+		if len(priorityClass.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(priorityClass.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), priorityClass.Name, msg))
+			}
+			for _, prefix := range reservedPriorityClassNamePrefixes {
+				if strings.HasPrefix(priorityClass.Name, prefix) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), priorityClass.Name, fmt.Sprintf("names with prefix %q are reserved", prefix)))
+				}
+			}
+			if names.Has(priorityClass.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), priorityClass.Name))
+			}
+			names.Insert(priorityClass.Name)
+		}
+
+		if priorityClass.Value > maxSystemComponentsPriorityClassValue {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), priorityClass.Value, fmt.Sprintf("must not be higher than %d", maxSystemComponentsPriorityClassValue)))
+		}
+	}
 
 	return allErrs
 }
@@ -2454,8 +3312,15 @@ func validateShootHAControlPlaneSpecUpdate(newShoot, oldShoot *core.Shoot, fldPa
 	}
 
 	if oldValExists && shootIsScheduled {
-		// If the HighAvailability field is already set for the shoot then enforce that it cannot be changed.
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVal, oldVal, fldPath.Child("highAvailability", "failureTolerance", "type"))...)
+		// If the HighAvailability field is already set for the shoot then enforce that it cannot be changed. The only
+		// exception is the scale-out from failure tolerance type 'node' to 'zone' which is orchestrated by gardenlet.
+		if oldVal == core.FailureToleranceTypeNode && newVal == core.FailureToleranceTypeZone {
+			if helper.IsShootInHibernation(newShoot) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("highAvailability", "failureTolerance", "type"), "Shoot is currently hibernated and cannot be scaled up to failure tolerance type 'zone'. Please make sure your cluster has woken up before scaling it up"))
+			}
+		} else {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newVal, oldVal, fldPath.Child("highAvailability", "failureTolerance", "type"))...)
+		}
 	}
 
 	return allErrs
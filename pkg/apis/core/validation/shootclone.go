@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootClone validates a ShootClone object for the Shoot with the given name.
+func ValidateShootClone(clone *core.ShootClone, shootName string) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("spec", "name")
+	)
+
+	if len(clone.Spec.Name) == 0 {
+		return append(allErrs, field.Required(fldPath, "name of the new Shoot is required"))
+	}
+
+	for _, msg := range apivalidation.NameIsDNSLabel(clone.Spec.Name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, clone.Spec.Name, msg))
+	}
+	allErrs = append(allErrs, validateNameConsecutiveHyphens(clone.Spec.Name, fldPath)...)
+
+	if clone.Spec.Name == shootName {
+		allErrs = append(allErrs, field.Invalid(fldPath, clone.Spec.Name, "name of the new Shoot must differ from the name of the cloned Shoot"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ShootClone Validation Tests", func() {
+	Describe("#ValidateShootClone", func() {
+		var clone *core.ShootClone
+
+		BeforeEach(func() {
+			clone = &core.ShootClone{Spec: core.ShootCloneSpec{Name: "clone"}}
+		})
+
+		It("should allow a valid clone", func() {
+			Expect(ValidateShootClone(clone, "shoot")).To(BeEmpty())
+		})
+
+		It("should require a name", func() {
+			clone.Spec.Name = ""
+
+			Expect(ValidateShootClone(clone, "shoot")).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.name"),
+			}))))
+		})
+
+		DescribeTable("should forbid invalid names",
+			func(name string) {
+				clone.Spec.Name = name
+
+				Expect(ValidateShootClone(clone, "shoot")).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.name"),
+				}))))
+			},
+
+			Entry("not a DNS label", "Clone.foo"),
+			Entry("consecutive hyphens", "clone--foo"),
+			Entry("name of the cloned Shoot", "shoot"),
+		)
+	})
+})
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootCostEstimate validates a ShootCostEstimate object for a Shoot with the given Kubernetes configuration.
+func ValidateShootCostEstimate(estimate *core.ShootCostEstimate, kubernetes core.Kubernetes) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("spec", "workers")
+	)
+
+	for i, worker := range estimate.Spec.Workers {
+		allErrs = append(allErrs, ValidateWorker(worker, kubernetes, fldPath.Index(i), true)...)
+	}
+	allErrs = append(allErrs, ValidateWorkers(estimate.Spec.Workers, fldPath)...)
+
+	return allErrs
+}
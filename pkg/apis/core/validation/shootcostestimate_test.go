@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ShootCostEstimate Validation Tests", func() {
+	Describe("#ValidateShootCostEstimate", func() {
+		var (
+			kubernetes core.Kubernetes
+			estimate   *core.ShootCostEstimate
+			worker     core.Worker
+		)
+
+		BeforeEach(func() {
+			kubernetes = core.Kubernetes{Version: "1.28.2"}
+			worker = core.Worker{
+				Name:           "worker",
+				Machine:        core.Machine{Type: "large"},
+				Minimum:        1,
+				Maximum:        3,
+				MaxSurge:       &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+				MaxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 0},
+			}
+			estimate = &core.ShootCostEstimate{}
+		})
+
+		It("should allow an empty estimate", func() {
+			Expect(ValidateShootCostEstimate(estimate, kubernetes)).To(BeEmpty())
+		})
+
+		It("should allow valid workers", func() {
+			estimate.Spec.Workers = []core.Worker{worker}
+
+			Expect(ValidateShootCostEstimate(estimate, kubernetes)).To(BeEmpty())
+		})
+
+		It("should forbid invalid workers", func() {
+			worker.Machine.Type = ""
+			worker.Maximum = 0
+			estimate.Spec.Workers = []core.Worker{worker}
+
+			Expect(ValidateShootCostEstimate(estimate, kubernetes)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.workers[0].machine.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.workers[0].maximum"),
+				})),
+			))
+		})
+
+		It("should forbid duplicate worker names", func() {
+			estimate.Spec.Workers = []core.Worker{worker, worker}
+
+			Expect(ValidateShootCostEstimate(estimate, kubernetes)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.workers[1].name"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootExport validates a ShootExport object.
+func ValidateShootExport(export *core.ShootExport) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("spec", "target")
+	)
+
+	if export.Spec.Target == nil {
+		return allErrs
+	}
+
+	if len(export.Spec.Target.CloudProfileName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cloudProfileName"), "name of the target CloudProfile is required"))
+	}
+	if len(export.Spec.Target.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "name of the target region is required"))
+	}
+
+	return allErrs
+}
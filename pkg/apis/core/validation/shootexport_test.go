@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ShootExport Validation Tests", func() {
+	Describe("#ValidateShootExport", func() {
+		It("should allow an export without target", func() {
+			Expect(ValidateShootExport(&core.ShootExport{})).To(BeEmpty())
+		})
+
+		It("should allow an export with a valid target", func() {
+			export := &core.ShootExport{Spec: core.ShootExportSpec{Target: &core.ShootExportTarget{CloudProfileName: "gcp", Region: "europe-west1"}}}
+
+			Expect(ValidateShootExport(export)).To(BeEmpty())
+		})
+
+		It("should require the CloudProfile name and region of the target", func() {
+			export := &core.ShootExport{Spec: core.ShootExportSpec{Target: &core.ShootExportTarget{}}}
+
+			Expect(ValidateShootExport(export)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.target.cloudProfileName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.target.region"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootRevision validates a ShootRevision object.
+func ValidateShootRevision(shootRevision *core.ShootRevision) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shootRevision.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+
+	for i, change := range shootRevision.Revisions {
+		idxPath := field.NewPath("revisions").Index(i)
+
+		if change.Generation < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("generation"), change.Generation, "must not be negative"))
+		}
+		if len(change.User) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("user"), "must provide the user who made the change"))
+		}
+		if !json.Valid([]byte(change.Patch)) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("patch"), change.Patch, "must be valid JSON"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateShootRevisionUpdate validates an update to a ShootRevision object.
+func ValidateShootRevisionUpdate(newShootRevision, oldShootRevision *core.ShootRevision) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShootRevision.ObjectMeta, &oldShootRevision.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootRevision(newShootRevision)...)
+
+	return allErrs
+}
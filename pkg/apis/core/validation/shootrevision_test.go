@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ShootRevision Validation Tests", func() {
+	var shootRevision *core.ShootRevision
+
+	BeforeEach(func() {
+		shootRevision = &core.ShootRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "garden-dev",
+			},
+			Revisions: []core.ShootSpecChange{{
+				Generation: 2,
+				User:       "alice",
+				Patch:      `[{"op":"replace","path":"/kubernetes/version","value":"1.31.1"}]`,
+			}},
+		}
+	})
+
+	Describe("#ValidateShootRevision", func() {
+		It("should allow valid resources", func() {
+			Expect(ValidateShootRevision(shootRevision)).To(BeEmpty())
+		})
+
+		It("should forbid missing namespace", func() {
+			shootRevision.Namespace = ""
+
+			Expect(ValidateShootRevision(shootRevision)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should forbid invalid revisions", func() {
+			shootRevision.Revisions = append(shootRevision.Revisions, core.ShootSpecChange{
+				Generation: -1,
+				Patch:      "[",
+			})
+
+			Expect(ValidateShootRevision(shootRevision)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("revisions[1].generation"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("revisions[1].user"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("revisions[1].patch"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootRevisionUpdate", func() {
+		It("should allow appending revisions", func() {
+			newShootRevision := shootRevision.DeepCopy()
+			newShootRevision.ResourceVersion = "1"
+			shootRevision.ResourceVersion = "1"
+			newShootRevision.Revisions = append(newShootRevision.Revisions, core.ShootSpecChange{
+				Generation: 3,
+				User:       "bob",
+				Patch:      "[]",
+			})
+
+			Expect(ValidateShootRevisionUpdate(newShootRevision, shootRevision)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/Masterminds/semver/v3"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateVersionPolicy validates a VersionPolicy object.
+func ValidateVersionPolicy(versionPolicy *core.VersionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&versionPolicy.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateVersionPolicySpec(&versionPolicy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateVersionPolicyUpdate validates a VersionPolicy object before an update.
+func ValidateVersionPolicyUpdate(newVersionPolicy, oldVersionPolicy *core.VersionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newVersionPolicy.ObjectMeta, &oldVersionPolicy.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateVersionPolicy(newVersionPolicy)...)
+
+	return allErrs
+}
+
+// ValidateVersionPolicySpec validates the specification of a VersionPolicy object.
+func ValidateVersionPolicySpec(spec *core.VersionPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Kubernetes == nil && len(spec.MachineImages) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of kubernetes or machineImages must be set"))
+	}
+
+	if spec.Kubernetes != nil {
+		allErrs = append(allErrs, validateMinimumVersion(spec.Kubernetes.MinimumVersion, fldPath.Child("kubernetes", "minimumVersion"))...)
+	}
+
+	names := sets.New[string]()
+	for i, machineImage := range spec.MachineImages {
+		idxPath := fldPath.Child("machineImages").Index(i)
+
+		if machineImage.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a machine image name"))
+		} else if names.Has(machineImage.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), machineImage.Name))
+		}
+		names.Insert(machineImage.Name)
+
+		allErrs = append(allErrs, validateMinimumVersion(machineImage.MinimumVersion, idxPath.Child("minimumVersion"))...)
+	}
+
+	return allErrs
+}
+
+func validateMinimumVersion(version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if version == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide a version"))
+	} else if _, err := semver.NewVersion(version); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, version, "must be a semantic version"))
+	}
+
+	return allErrs
+}
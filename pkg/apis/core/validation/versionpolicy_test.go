@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("VersionPolicy Validation Tests", func() {
+	var versionPolicy *core.VersionPolicy
+
+	BeforeEach(func() {
+		versionPolicy = &core.VersionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "baseline",
+			},
+			Spec: core.VersionPolicySpec{
+				Kubernetes: &core.KubernetesVersionPolicy{MinimumVersion: "1.29.0"},
+				MachineImages: []core.MachineImageVersionPolicy{
+					{Name: "gardenlinux", MinimumVersion: "1443.3.0"},
+					{Name: "ubuntu", MinimumVersion: "22.4.0"},
+				},
+				ForceUpdate: true,
+			},
+		}
+	})
+
+	Describe("#ValidateVersionPolicy", func() {
+		It("should allow valid resources", func() {
+			Expect(ValidateVersionPolicy(versionPolicy)).To(BeEmpty())
+		})
+
+		It("should forbid empty names and policies without minimum versions", func() {
+			versionPolicy.Name = ""
+			versionPolicy.Spec.Kubernetes = nil
+			versionPolicy.Spec.MachineImages = nil
+
+			Expect(ValidateVersionPolicy(versionPolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec"),
+				})),
+			))
+		})
+
+		It("should forbid invalid minimum versions", func() {
+			versionPolicy.Spec.Kubernetes.MinimumVersion = "latest"
+			versionPolicy.Spec.MachineImages[1].MinimumVersion = ""
+
+			Expect(ValidateVersionPolicy(versionPolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.minimumVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.machineImages[1].minimumVersion"),
+				})),
+			))
+		})
+
+		It("should forbid empty and duplicate machine image names", func() {
+			versionPolicy.Spec.MachineImages = append(versionPolicy.Spec.MachineImages,
+				core.MachineImageVersionPolicy{Name: "gardenlinux", MinimumVersion: "1592.1.0"},
+				core.MachineImageVersionPolicy{MinimumVersion: "1.0.0"},
+			)
+
+			Expect(ValidateVersionPolicy(versionPolicy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.machineImages[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.machineImages[3].name"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateVersionPolicyUpdate", func() {
+		It("should allow updating the minimum versions", func() {
+			newVersionPolicy := versionPolicy.DeepCopy()
+			newVersionPolicy.ResourceVersion = "1"
+			versionPolicy.ResourceVersion = "1"
+			newVersionPolicy.Spec.Kubernetes.MinimumVersion = "1.30.0"
+
+			Expect(ValidateVersionPolicyUpdate(newVersionPolicy, versionPolicy)).To(BeEmpty())
+		})
+	})
+})
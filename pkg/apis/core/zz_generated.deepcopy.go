@@ -86,6 +86,33 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonChart) DeepCopyInto(out *AddonChart) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	in.OCIRepository.DeepCopyInto(&out.OCIRepository)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonChart.
+func (in *AddonChart) DeepCopy() *AddonChart {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addons) DeepCopyInto(out *Addons) {
 	*out = *in
@@ -99,6 +126,13 @@ func (in *Addons) DeepCopyInto(out *Addons) {
 		*out = new(NginxIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]AddonChart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -143,6 +177,59 @@ func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertReceiver) DeepCopyInto(out *AlertReceiver) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailAlertReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookAlertReceiver)
+		**out = **in
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackAlertReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertReceiver.
+func (in *AlertReceiver) DeepCopy() *AlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRoute) DeepCopyInto(out *AlertRoute) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Receiver.DeepCopyInto(&out.Receiver)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRoute.
+func (in *AlertRoute) DeepCopy() *AlertRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
@@ -668,6 +755,13 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClusterAutoscalerProfiles != nil {
+		in, out := &in.ClusterAutoscalerProfiles, &out.ClusterAutoscalerProfiles
+		*out = make([]ClusterAutoscalerProfileSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -754,6 +848,11 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(ClusterAutoscalerProfile)
+		**out = **in
+	}
 	return
 }
 
@@ -808,6 +907,23 @@ func (in *ClusterAutoscalerOptions) DeepCopy() *ClusterAutoscalerOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerProfileSettings) DeepCopyInto(out *ClusterAutoscalerProfileSettings) {
+	*out = *in
+	in.Parameters.DeepCopyInto(&out.Parameters)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerProfileSettings.
+func (in *ClusterAutoscalerProfileSettings) DeepCopy() *ClusterAutoscalerProfileSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerProfileSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -852,6 +968,83 @@ func (in *ContainerRuntime) DeepCopy() *ContainerRuntime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistry) DeepCopyInto(out *ContainerdRegistry) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]ContainerdRegistryHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistry.
+func (in *ContainerdRegistry) DeepCopy() *ContainerdRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdRegistryHost) DeepCopyInto(out *ContainerdRegistryHost) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]ContainerdRegistryCapability, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceName != nil {
+		in, out := &in.ResourceName, &out.ResourceName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdRegistryHost.
+func (in *ContainerdRegistryHost) DeepCopy() *ContainerdRegistryHost {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdRegistryHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdSettings) DeepCopyInto(out *ContainerdSettings) {
+	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]ContainerdRegistry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdSettings.
+func (in *ContainerdSettings) DeepCopy() *ContainerdSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 	*out = *in
@@ -1199,6 +1392,11 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RequiredCredentialKeys != nil {
+		in, out := &in.RequiredCredentialKeys, &out.RequiredCredentialKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1466,1143 +1664,3180 @@ func (in *DualApprovalForDeletion) DeepCopy() *DualApprovalForDeletion {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ETCDEncryptionKeyRotation) DeepCopyInto(out *ETCDEncryptionKeyRotation) {
+func (in *ETCD) DeepCopyInto(out *ETCD) {
 	*out = *in
-	if in.LastInitiationTime != nil {
-		in, out := &in.LastInitiationTime, &out.LastInitiationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LastInitiationFinishedTime != nil {
-		in, out := &in.LastInitiationFinishedTime, &out.LastInitiationFinishedTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LastCompletionTime != nil {
-		in, out := &in.LastCompletionTime, &out.LastCompletionTime
-		*out = (*in).DeepCopy()
+	if in.Main != nil {
+		in, out := &in.Main, &out.Main
+		*out = new(ETCDConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LastCompletionTriggeredTime != nil {
-		in, out := &in.LastCompletionTriggeredTime, &out.LastCompletionTriggeredTime
-		*out = (*in).DeepCopy()
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(ETCDConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDEncryptionKeyRotation.
-func (in *ETCDEncryptionKeyRotation) DeepCopy() *ETCDEncryptionKeyRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCD.
+func (in *ETCD) DeepCopy() *ETCD {
 	if in == nil {
 		return nil
 	}
-	out := new(ETCDEncryptionKeyRotation)
+	out := new(ETCD)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
+func (in *ETCDCompaction) DeepCopyInto(out *ETCDCompaction) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ETCDCompactionMode)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
-func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDCompaction.
+func (in *ETCDCompaction) DeepCopy() *ETCDCompaction {
 	if in == nil {
 		return nil
 	}
-	out := new(EncryptionConfig)
+	out := new(ETCDCompaction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
+func (in *ETCDConfig) DeepCopyInto(out *ETCDConfig) {
 	*out = *in
-	if in.ExpirationDate != nil {
-		in, out := &in.ExpirationDate, &out.ExpirationDate
-		*out = (*in).DeepCopy()
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(ETCDCompaction)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Classification != nil {
-		in, out := &in.Classification, &out.Classification
-		*out = new(VersionClassification)
-		**out = **in
+	if in.Defragmentation != nil {
+		in, out := &in.Defragmentation, &out.Defragmentation
+		*out = new(ETCDDefragmentation)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpirableVersion.
-func (in *ExpirableVersion) DeepCopy() *ExpirableVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDConfig.
+func (in *ETCDConfig) DeepCopy() *ETCDConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ExpirableVersion)
+	out := new(ETCDConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExposureClass) DeepCopyInto(out *ExposureClass) {
+func (in *ETCDDefragmentation) DeepCopyInto(out *ETCDDefragmentation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Scheduling != nil {
-		in, out := &in.Scheduling, &out.Scheduling
-		*out = new(ExposureClassScheduling)
-		(*in).DeepCopyInto(*out)
+	if in.IntervalDays != nil {
+		in, out := &in.IntervalDays, &out.IntervalDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DBSizeThreshold != nil {
+		in, out := &in.DBSizeThreshold, &out.DBSizeThreshold
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClass.
-func (in *ExposureClass) DeepCopy() *ExposureClass {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDDefragmentation.
+func (in *ETCDDefragmentation) DeepCopy() *ETCDDefragmentation {
 	if in == nil {
 		return nil
 	}
-	out := new(ExposureClass)
+	out := new(ETCDDefragmentation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExposureClass) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExposureClassList) DeepCopyInto(out *ExposureClassList) {
+func (in *ETCDDefragmentationStatus) DeepCopyInto(out *ETCDDefragmentationStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ExposureClass, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.Time.DeepCopyInto(&out.Time)
+	if in.DBSizeBefore != nil {
+		in, out := &in.DBSizeBefore, &out.DBSizeBefore
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassList.
-func (in *ExposureClassList) DeepCopy() *ExposureClassList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDDefragmentationStatus.
+func (in *ETCDDefragmentationStatus) DeepCopy() *ETCDDefragmentationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ExposureClassList)
+	out := new(ETCDDefragmentationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ExposureClassList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExposureClassScheduling) DeepCopyInto(out *ExposureClassScheduling) {
+func (in *ETCDEncryptionKeyRotation) DeepCopyInto(out *ETCDEncryptionKeyRotation) {
 	*out = *in
-	if in.SeedSelector != nil {
-		in, out := &in.SeedSelector, &out.SeedSelector
-		*out = new(SeedSelector)
-		(*in).DeepCopyInto(*out)
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastInitiationFinishedTime != nil {
+		in, out := &in.LastInitiationFinishedTime, &out.LastInitiationFinishedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTriggeredTime != nil {
+		in, out := &in.LastCompletionTriggeredTime, &out.LastCompletionTriggeredTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassScheduling.
-func (in *ExposureClassScheduling) DeepCopy() *ExposureClassScheduling {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDEncryptionKeyRotation.
+func (in *ETCDEncryptionKeyRotation) DeepCopy() *ETCDEncryptionKeyRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(ExposureClassScheduling)
+	out := new(ETCDEncryptionKeyRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Extension) DeepCopyInto(out *Extension) {
+func (in *ETCDStatus) DeepCopyInto(out *ETCDStatus) {
 	*out = *in
-	if in.ProviderConfig != nil {
-		in, out := &in.ProviderConfig, &out.ProviderConfig
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.DBSize != nil {
+		in, out := &in.DBSize, &out.DBSize
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.Disabled != nil {
-		in, out := &in.Disabled, &out.Disabled
-		*out = new(bool)
-		**out = **in
+	if in.DBSizeInUse != nil {
+		in, out := &in.DBSizeInUse, &out.DBSizeInUse
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastDefragmentations != nil {
+		in, out := &in.LastDefragmentations, &out.LastDefragmentations
+		*out = make([]ETCDDefragmentationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Extension.
-func (in *Extension) DeepCopy() *Extension {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDStatus.
+func (in *ETCDStatus) DeepCopy() *ETCDStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Extension)
+	out := new(ETCDStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExtensionResourceState) DeepCopyInto(out *ExtensionResourceState) {
+func (in *EgressOnlyInternetAccess) DeepCopyInto(out *EgressOnlyInternetAccess) {
 	*out = *in
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.Purpose != nil {
-		in, out := &in.Purpose, &out.Purpose
-		*out = new(string)
-		**out = **in
-	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetAccess.
+func (in *EgressOnlyInternetAccess) DeepCopy() *EgressOnlyInternetAccess {
+	if in == nil {
+		return nil
 	}
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]NamedResourceReference, len(*in))
+	out := new(EgressOnlyInternetAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailAlertReceiver) DeepCopyInto(out *EmailAlertReceiver) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionResourceState.
-func (in *ExtensionResourceState) DeepCopy() *ExtensionResourceState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailAlertReceiver.
+func (in *EmailAlertReceiver) DeepCopy() *EmailAlertReceiver {
 	if in == nil {
 		return nil
 	}
-	out := new(ExtensionResourceState)
+	out := new(EmailAlertReceiver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailureTolerance) DeepCopyInto(out *FailureTolerance) {
+func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureTolerance.
-func (in *FailureTolerance) DeepCopy() *FailureTolerance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfig.
+func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(FailureTolerance)
+	out := new(EncryptionConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Gardener) DeepCopyInto(out *Gardener) {
+func (in *EventArchive) DeepCopyInto(out *EventArchive) {
 	*out = *in
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(EventArchiveSink)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gardener.
-func (in *Gardener) DeepCopy() *Gardener {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchive.
+func (in *EventArchive) DeepCopy() *EventArchive {
 	if in == nil {
 		return nil
 	}
-	out := new(Gardener)
+	out := new(EventArchive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GardenerResourceData) DeepCopyInto(out *GardenerResourceData) {
+func (in *EventArchiveSink) DeepCopyInto(out *EventArchiveSink) {
 	*out = *in
-	in.Data.DeepCopyInto(&out.Data)
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerResourceData.
-func (in *GardenerResourceData) DeepCopy() *GardenerResourceData {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchiveSink.
+func (in *EventArchiveSink) DeepCopy() *EventArchiveSink {
 	if in == nil {
 		return nil
 	}
-	out := new(GardenerResourceData)
+	out := new(EventArchiveSink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmControllerDeployment) DeepCopyInto(out *HelmControllerDeployment) {
+func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
 	*out = *in
-	if in.RawChart != nil {
-		in, out := &in.RawChart, &out.RawChart
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.Values != nil {
-		in, out := &in.Values, &out.Values
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
+	if in.ExpirationDate != nil {
+		in, out := &in.ExpirationDate, &out.ExpirationDate
+		*out = (*in).DeepCopy()
 	}
-	if in.OCIRepository != nil {
-		in, out := &in.OCIRepository, &out.OCIRepository
-		*out = new(OCIRepository)
-		(*in).DeepCopyInto(*out)
+	if in.Classification != nil {
+		in, out := &in.Classification, &out.Classification
+		*out = new(VersionClassification)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmControllerDeployment.
-func (in *HelmControllerDeployment) DeepCopy() *HelmControllerDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpirableVersion.
+func (in *ExpirableVersion) DeepCopy() *ExpirableVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmControllerDeployment)
+	out := new(ExpirableVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+func (in *ExposureClass) DeepCopyInto(out *ExposureClass) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Schedules != nil {
-		in, out := &in.Schedules, &out.Schedules
-		*out = make([]HibernationSchedule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(ExposureClassScheduling)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
-func (in *Hibernation) DeepCopy() *Hibernation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClass.
+func (in *ExposureClass) DeepCopy() *ExposureClass {
 	if in == nil {
 		return nil
 	}
-	out := new(Hibernation)
+	out := new(ExposureClass)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExposureClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
+func (in *ExposureClassHandlerAutoscaling) DeepCopyInto(out *ExposureClassHandlerAutoscaling) {
 	*out = *in
-	if in.Start != nil {
-		in, out := &in.Start, &out.Start
-		*out = new(string)
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
 		**out = **in
 	}
-	if in.End != nil {
-		in, out := &in.End, &out.End
-		*out = new(string)
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Location != nil {
-		in, out := &in.Location, &out.Location
-		*out = new(string)
+	if in.TargetConnections != nil {
+		in, out := &in.TargetConnections, &out.TargetConnections
+		*out = new(int32)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
-func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassHandlerAutoscaling.
+func (in *ExposureClassHandlerAutoscaling) DeepCopy() *ExposureClassHandlerAutoscaling {
 	if in == nil {
 		return nil
 	}
-	out := new(HibernationSchedule)
+	out := new(ExposureClassHandlerAutoscaling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
+func (in *ExposureClassHandlerStatus) DeepCopyInto(out *ExposureClassHandlerStatus) {
 	*out = *in
-	out.FailureTolerance = in.FailureTolerance
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ExposureClassHandlerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailability.
-func (in *HighAvailability) DeepCopy() *HighAvailability {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassHandlerStatus.
+func (in *ExposureClassHandlerStatus) DeepCopy() *ExposureClassHandlerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HighAvailability)
+	out := new(ExposureClassHandlerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
+func (in *ExposureClassList) DeepCopyInto(out *ExposureClassList) {
 	*out = *in
-	if in.CPUInitializationPeriod != nil {
-		in, out := &in.CPUInitializationPeriod, &out.CPUInitializationPeriod
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.DownscaleStabilization != nil {
-		in, out := &in.DownscaleStabilization, &out.DownscaleStabilization
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.InitialReadinessDelay != nil {
-		in, out := &in.InitialReadinessDelay, &out.InitialReadinessDelay
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.SyncPeriod != nil {
-		in, out := &in.SyncPeriod, &out.SyncPeriod
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.Tolerance != nil {
-		in, out := &in.Tolerance, &out.Tolerance
-		*out = new(float64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExposureClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerConfig.
-func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassList.
+func (in *ExposureClassList) DeepCopy() *ExposureClassList {
 	if in == nil {
 		return nil
 	}
-	out := new(HorizontalPodAutoscalerConfig)
+	out := new(ExposureClassList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExposureClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ingress) DeepCopyInto(out *Ingress) {
+func (in *ExposureClassScheduling) DeepCopyInto(out *ExposureClassScheduling) {
 	*out = *in
-	in.Controller.DeepCopyInto(&out.Controller)
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(SeedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
-func (in *Ingress) DeepCopy() *Ingress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassScheduling.
+func (in *ExposureClassScheduling) DeepCopy() *ExposureClassScheduling {
 	if in == nil {
 		return nil
 	}
-	out := new(Ingress)
+	out := new(ExposureClassScheduling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressController) DeepCopyInto(out *IngressController) {
+func (in *Extension) DeepCopyInto(out *Extension) {
 	*out = *in
 	if in.ProviderConfig != nil {
 		in, out := &in.ProviderConfig, &out.ProviderConfig
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressController.
-func (in *IngressController) DeepCopy() *IngressController {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Extension.
+func (in *Extension) DeepCopy() *Extension {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressController)
+	out := new(Extension)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InternalSecret) DeepCopyInto(out *InternalSecret) {
+func (in *ExtensionResourceState) DeepCopyInto(out *ExtensionResourceState) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Immutable != nil {
-		in, out := &in.Immutable, &out.Immutable
-		*out = new(bool)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
 		**out = **in
 	}
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make(map[string][]byte, len(*in))
-		for key, val := range *in {
-			var outVal []byte
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]byte, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]NamedResourceReference, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalSecret.
-func (in *InternalSecret) DeepCopy() *InternalSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionResourceState.
+func (in *ExtensionResourceState) DeepCopy() *ExtensionResourceState {
 	if in == nil {
 		return nil
 	}
-	out := new(InternalSecret)
+	out := new(ExtensionResourceState)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InternalSecret) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureTolerance) DeepCopyInto(out *FailureTolerance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureTolerance.
+func (in *FailureTolerance) DeepCopy() *FailureTolerance {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(FailureTolerance)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InternalSecretList) DeepCopyInto(out *InternalSecretList) {
+func (in *FeatureGateStatus) DeepCopyInto(out *FeatureGateStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]InternalSecret, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalSecretList.
-func (in *InternalSecretList) DeepCopy() *InternalSecretList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGateStatus.
+func (in *FeatureGateStatus) DeepCopy() *FeatureGateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InternalSecretList)
+	out := new(FeatureGateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InternalSecretList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gardener) DeepCopyInto(out *Gardener) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gardener.
+func (in *Gardener) DeepCopy() *Gardener {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(Gardener)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
+func (in *GardenerResourceData) DeepCopyInto(out *GardenerResourceData) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	if in.AdmissionPlugins != nil {
-		in, out := &in.AdmissionPlugins, &out.AdmissionPlugins
-		*out = make([]AdmissionPlugin, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.APIAudiences != nil {
-		in, out := &in.APIAudiences, &out.APIAudiences
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.AuditConfig != nil {
-		in, out := &in.AuditConfig, &out.AuditConfig
-		*out = new(AuditConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.OIDCConfig != nil {
-		in, out := &in.OIDCConfig, &out.OIDCConfig
-		*out = new(OIDCConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RuntimeConfig != nil {
-		in, out := &in.RuntimeConfig, &out.RuntimeConfig
-		*out = make(map[string]bool, len(*in))
+	in.Data.DeepCopyInto(&out.Data)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.ServiceAccountConfig != nil {
-		in, out := &in.ServiceAccountConfig, &out.ServiceAccountConfig
-		*out = new(ServiceAccountConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.WatchCacheSizes != nil {
-		in, out := &in.WatchCacheSizes, &out.WatchCacheSizes
-		*out = new(WatchCacheSizes)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = new(APIServerRequests)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EnableAnonymousAuthentication != nil {
-		in, out := &in.EnableAnonymousAuthentication, &out.EnableAnonymousAuthentication
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EventTTL != nil {
-		in, out := &in.EventTTL, &out.EventTTL
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(APIServerLogging)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DefaultNotReadyTolerationSeconds != nil {
-		in, out := &in.DefaultNotReadyTolerationSeconds, &out.DefaultNotReadyTolerationSeconds
-		*out = new(int64)
-		**out = **in
-	}
-	if in.DefaultUnreachableTolerationSeconds != nil {
-		in, out := &in.DefaultUnreachableTolerationSeconds, &out.DefaultUnreachableTolerationSeconds
-		*out = new(int64)
-		**out = **in
-	}
-	if in.EncryptionConfig != nil {
-		in, out := &in.EncryptionConfig, &out.EncryptionConfig
-		*out = new(EncryptionConfig)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerConfig.
-func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerResourceData.
+func (in *GardenerResourceData) DeepCopy() *GardenerResourceData {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeAPIServerConfig)
+	out := new(GardenerResourceData)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
+func (in *HelmControllerDeployment) DeepCopyInto(out *HelmControllerDeployment) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	if in.HorizontalPodAutoscalerConfig != nil {
-		in, out := &in.HorizontalPodAutoscalerConfig, &out.HorizontalPodAutoscalerConfig
-		*out = new(HorizontalPodAutoscalerConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NodeCIDRMaskSize != nil {
-		in, out := &in.NodeCIDRMaskSize, &out.NodeCIDRMaskSize
-		*out = new(int32)
-		**out = **in
+	if in.RawChart != nil {
+		in, out := &in.RawChart, &out.RawChart
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
 	}
-	if in.PodEvictionTimeout != nil {
-		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.NodeMonitorGracePeriod != nil {
-		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.OCIRepository != nil {
+		in, out := &in.OCIRepository, &out.OCIRepository
+		*out = new(OCIRepository)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllerManagerConfig.
-func (in *KubeControllerManagerConfig) DeepCopy() *KubeControllerManagerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmControllerDeployment.
+func (in *HelmControllerDeployment) DeepCopy() *HelmControllerDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeControllerManagerConfig)
+	out := new(HelmControllerDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(ProxyMode)
-		**out = **in
-	}
 	if in.Enabled != nil {
 		in, out := &in.Enabled, &out.Enabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]HibernationSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyConfig.
-func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyConfig)
+	out := new(Hibernation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
+func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	if in.KubeMaxPDVols != nil {
-		in, out := &in.KubeMaxPDVols, &out.KubeMaxPDVols
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
 		*out = new(string)
 		**out = **in
 	}
-	if in.Profile != nil {
-		in, out := &in.Profile, &out.Profile
-		*out = new(SchedulingProfile)
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerConfig.
-func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSchedule.
+func (in *HibernationSchedule) DeepCopy() *HibernationSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeSchedulerConfig)
+	out := new(HibernationSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+func (in *HighAvailability) DeepCopyInto(out *HighAvailability) {
 	*out = *in
-	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
-	if in.ContainerLogMaxSize != nil {
-		in, out := &in.ContainerLogMaxSize, &out.ContainerLogMaxSize
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.ContainerLogMaxFiles != nil {
-		in, out := &in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles
-		*out = new(int32)
-		**out = **in
-	}
-	if in.CPUCFSQuota != nil {
-		in, out := &in.CPUCFSQuota, &out.CPUCFSQuota
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CPUManagerPolicy != nil {
-		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
-		*out = new(string)
-		**out = **in
-	}
-	if in.EvictionHard != nil {
-		in, out := &in.EvictionHard, &out.EvictionHard
-		*out = new(KubeletConfigEviction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EvictionMaxPodGracePeriod != nil {
-		in, out := &in.EvictionMaxPodGracePeriod, &out.EvictionMaxPodGracePeriod
-		*out = new(int32)
-		**out = **in
-	}
-	if in.EvictionMinimumReclaim != nil {
-		in, out := &in.EvictionMinimumReclaim, &out.EvictionMinimumReclaim
-		*out = new(KubeletConfigEvictionMinimumReclaim)
-		(*in).DeepCopyInto(*out)
+	out.FailureTolerance = in.FailureTolerance
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HighAvailability.
+func (in *HighAvailability) DeepCopy() *HighAvailability {
+	if in == nil {
+		return nil
 	}
-	if in.EvictionPressureTransitionPeriod != nil {
-		in, out := &in.EvictionPressureTransitionPeriod, &out.EvictionPressureTransitionPeriod
+	out := new(HighAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscalerConfig) DeepCopyInto(out *HorizontalPodAutoscalerConfig) {
+	*out = *in
+	if in.CPUInitializationPeriod != nil {
+		in, out := &in.CPUInitializationPeriod, &out.CPUInitializationPeriod
 		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.EvictionSoft != nil {
-		in, out := &in.EvictionSoft, &out.EvictionSoft
-		*out = new(KubeletConfigEviction)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.EvictionSoftGracePeriod != nil {
-		in, out := &in.EvictionSoftGracePeriod, &out.EvictionSoftGracePeriod
-		*out = new(KubeletConfigEvictionSoftGracePeriod)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.MaxPods != nil {
-		in, out := &in.MaxPods, &out.MaxPods
-		*out = new(int32)
-		**out = **in
-	}
-	if in.PodPIDsLimit != nil {
-		in, out := &in.PodPIDsLimit, &out.PodPIDsLimit
-		*out = new(int64)
-		**out = **in
-	}
-	if in.FailSwapOn != nil {
-		in, out := &in.FailSwapOn, &out.FailSwapOn
-		*out = new(bool)
-		**out = **in
-	}
-	if in.KubeReserved != nil {
-		in, out := &in.KubeReserved, &out.KubeReserved
-		*out = new(KubeletConfigReserved)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SystemReserved != nil {
-		in, out := &in.SystemReserved, &out.SystemReserved
-		*out = new(KubeletConfigReserved)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ImageGCHighThresholdPercent != nil {
-		in, out := &in.ImageGCHighThresholdPercent, &out.ImageGCHighThresholdPercent
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ImageGCLowThresholdPercent != nil {
-		in, out := &in.ImageGCLowThresholdPercent, &out.ImageGCLowThresholdPercent
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SerializeImagePulls != nil {
-		in, out := &in.SerializeImagePulls, &out.SerializeImagePulls
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RegistryPullQPS != nil {
-		in, out := &in.RegistryPullQPS, &out.RegistryPullQPS
-		*out = new(int32)
-		**out = **in
-	}
-	if in.RegistryBurst != nil {
-		in, out := &in.RegistryBurst, &out.RegistryBurst
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SeccompDefault != nil {
-		in, out := &in.SeccompDefault, &out.SeccompDefault
-		*out = new(bool)
+	if in.DownscaleStabilization != nil {
+		in, out := &in.DownscaleStabilization, &out.DownscaleStabilization
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.ProtectKernelDefaults != nil {
-		in, out := &in.ProtectKernelDefaults, &out.ProtectKernelDefaults
-		*out = new(bool)
+	if in.InitialReadinessDelay != nil {
+		in, out := &in.InitialReadinessDelay, &out.InitialReadinessDelay
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.StreamingConnectionIdleTimeout != nil {
-		in, out := &in.StreamingConnectionIdleTimeout, &out.StreamingConnectionIdleTimeout
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
 		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.MemorySwap != nil {
-		in, out := &in.MemorySwap, &out.MemorySwap
-		*out = new(MemorySwapConfiguration)
-		(*in).DeepCopyInto(*out)
+	if in.Tolerance != nil {
+		in, out := &in.Tolerance, &out.Tolerance
+		*out = new(float64)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
-func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerConfig.
+func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfig)
+	out := new(HorizontalPodAutoscalerConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfigEviction) DeepCopyInto(out *KubeletConfigEviction) {
+func (in *ImageOverride) DeepCopyInto(out *ImageOverride) {
 	*out = *in
-	if in.MemoryAvailable != nil {
-		in, out := &in.MemoryAvailable, &out.MemoryAvailable
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImageFSAvailable != nil {
-		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
 		*out = new(string)
 		**out = **in
 	}
-	if in.ImageFSInodesFree != nil {
-		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
+	if in.Digest != nil {
+		in, out := &in.Digest, &out.Digest
 		*out = new(string)
 		**out = **in
 	}
-	if in.NodeFSAvailable != nil {
-		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+	if in.RuntimeVersion != nil {
+		in, out := &in.RuntimeVersion, &out.RuntimeVersion
 		*out = new(string)
 		**out = **in
 	}
-	if in.NodeFSInodesFree != nil {
-		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEviction.
-func (in *KubeletConfigEviction) DeepCopy() *KubeletConfigEviction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOverride.
+func (in *ImageOverride) DeepCopy() *ImageOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfigEviction)
+	out := new(ImageOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfigEvictionMinimumReclaim) DeepCopyInto(out *KubeletConfigEvictionMinimumReclaim) {
+func (in *ImageVectorOverride) DeepCopyInto(out *ImageVectorOverride) {
 	*out = *in
-	if in.MemoryAvailable != nil {
-		in, out := &in.MemoryAvailable, &out.MemoryAvailable
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.ImageFSAvailable != nil {
-		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.ImageFSInodesFree != nil {
-		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
-		x := (*in).DeepCopy()
-		*out = &x
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorOverride.
+func (in *ImageVectorOverride) DeepCopy() *ImageVectorOverride {
+	if in == nil {
+		return nil
 	}
-	if in.NodeFSAvailable != nil {
-		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
-		x := (*in).DeepCopy()
-		*out = &x
+	out := new(ImageVectorOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageVectorOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.NodeFSInodesFree != nil {
-		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
-		x := (*in).DeepCopy()
-		*out = &x
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVectorOverrideList) DeepCopyInto(out *ImageVectorOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageVectorOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEvictionMinimumReclaim.
-func (in *KubeletConfigEvictionMinimumReclaim) DeepCopy() *KubeletConfigEvictionMinimumReclaim {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorOverrideList.
+func (in *ImageVectorOverrideList) DeepCopy() *ImageVectorOverrideList {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfigEvictionMinimumReclaim)
+	out := new(ImageVectorOverrideList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageVectorOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopyInto(out *KubeletConfigEvictionSoftGracePeriod) {
+func (in *ImageVectorOverrideSpec) DeepCopyInto(out *ImageVectorOverrideSpec) {
 	*out = *in
-	if in.MemoryAvailable != nil {
-		in, out := &in.MemoryAvailable, &out.MemoryAvailable
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ImageFSAvailable != nil {
-		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ImageFSInodesFree != nil {
-		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
-		*out = new(metav1.Duration)
-		**out = **in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NodeFSAvailable != nil {
-		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorOverrideSpec.
+func (in *ImageVectorOverrideSpec) DeepCopy() *ImageVectorOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVectorOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ingress) DeepCopyInto(out *Ingress) {
+	*out = *in
+	in.Controller.DeepCopyInto(&out.Controller)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
+func (in *Ingress) DeepCopy() *Ingress {
+	if in == nil {
+		return nil
+	}
+	out := new(Ingress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressController) DeepCopyInto(out *IngressController) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressController.
+func (in *IngressController) DeepCopy() *IngressController {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressController)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalSecret) DeepCopyInto(out *InternalSecret) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Immutable != nil {
+		in, out := &in.Immutable, &out.Immutable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string][]byte, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]byte, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalSecret.
+func (in *InternalSecret) DeepCopy() *InternalSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InternalSecret) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalSecretList) DeepCopyInto(out *InternalSecretList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InternalSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalSecretList.
+func (in *InternalSecretList) DeepCopy() *InternalSecretList {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalSecretList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InternalSecretList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerAdditionalSANs) DeepCopyInto(out *KubeAPIServerAdditionalSANs) {
+	*out = *in
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerAdditionalSANs.
+func (in *KubeAPIServerAdditionalSANs) DeepCopy() *KubeAPIServerAdditionalSANs {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerAdditionalSANs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.AdmissionPlugins != nil {
+		in, out := &in.AdmissionPlugins, &out.AdmissionPlugins
+		*out = make([]AdmissionPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.APIAudiences != nil {
+		in, out := &in.APIAudiences, &out.APIAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditConfig != nil {
+		in, out := &in.AuditConfig, &out.AuditConfig
+		*out = new(AuditConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDCConfig != nil {
+		in, out := &in.OIDCConfig, &out.OIDCConfig
+		*out = new(OIDCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeConfig != nil {
+		in, out := &in.RuntimeConfig, &out.RuntimeConfig
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAccountConfig != nil {
+		in, out := &in.ServiceAccountConfig, &out.ServiceAccountConfig
+		*out = new(ServiceAccountConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WatchCacheSizes != nil {
+		in, out := &in.WatchCacheSizes, &out.WatchCacheSizes
+		*out = new(WatchCacheSizes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(APIServerRequests)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableAnonymousAuthentication != nil {
+		in, out := &in.EnableAnonymousAuthentication, &out.EnableAnonymousAuthentication
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EventTTL != nil {
+		in, out := &in.EventTTL, &out.EventTTL
 		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.NodeFSInodesFree != nil {
-		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(APIServerLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultNotReadyTolerationSeconds != nil {
+		in, out := &in.DefaultNotReadyTolerationSeconds, &out.DefaultNotReadyTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultUnreachableTolerationSeconds != nil {
+		in, out := &in.DefaultUnreachableTolerationSeconds, &out.DefaultUnreachableTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EncryptionConfig != nil {
+		in, out := &in.EncryptionConfig, &out.EncryptionConfig
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalSANs != nil {
+		in, out := &in.AdditionalSANs, &out.AdditionalSANs
+		*out = new(KubeAPIServerAdditionalSANs)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerConfig.
+func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.HorizontalPodAutoscalerConfig != nil {
+		in, out := &in.HorizontalPodAutoscalerConfig, &out.HorizontalPodAutoscalerConfig
+		*out = new(HorizontalPodAutoscalerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeCIDRMaskSize != nil {
+		in, out := &in.NodeCIDRMaskSize, &out.NodeCIDRMaskSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodEvictionTimeout != nil {
+		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllerManagerConfig.
+func (in *KubeControllerManagerConfig) DeepCopy() *KubeControllerManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllerManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ProxyMode)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyConfig.
+func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.KubeMaxPDVols != nil {
+		in, out := &in.KubeMaxPDVols, &out.KubeMaxPDVols
+		*out = new(string)
+		**out = **in
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(SchedulingProfile)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerConfig.
+func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSchedulerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	in.KubernetesConfig.DeepCopyInto(&out.KubernetesConfig)
+	if in.ContainerLogMaxSize != nil {
+		in, out := &in.ContainerLogMaxSize, &out.ContainerLogMaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ContainerLogMaxFiles != nil {
+		in, out := &in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CPUCFSQuota != nil {
+		in, out := &in.CPUCFSQuota, &out.CPUCFSQuota
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.EvictionHard != nil {
+		in, out := &in.EvictionHard, &out.EvictionHard
+		*out = new(KubeletConfigEviction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictionMaxPodGracePeriod != nil {
+		in, out := &in.EvictionMaxPodGracePeriod, &out.EvictionMaxPodGracePeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EvictionMinimumReclaim != nil {
+		in, out := &in.EvictionMinimumReclaim, &out.EvictionMinimumReclaim
+		*out = new(KubeletConfigEvictionMinimumReclaim)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictionPressureTransitionPeriod != nil {
+		in, out := &in.EvictionPressureTransitionPeriod, &out.EvictionPressureTransitionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.EvictionSoft != nil {
+		in, out := &in.EvictionSoft, &out.EvictionSoft
+		*out = new(KubeletConfigEviction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvictionSoftGracePeriod != nil {
+		in, out := &in.EvictionSoftGracePeriod, &out.EvictionSoftGracePeriod
+		*out = new(KubeletConfigEvictionSoftGracePeriod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodPIDsLimit != nil {
+		in, out := &in.PodPIDsLimit, &out.PodPIDsLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailSwapOn != nil {
+		in, out := &in.FailSwapOn, &out.FailSwapOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KubeReserved != nil {
+		in, out := &in.KubeReserved, &out.KubeReserved
+		*out = new(KubeletConfigReserved)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemReserved != nil {
+		in, out := &in.SystemReserved, &out.SystemReserved
+		*out = new(KubeletConfigReserved)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageGCHighThresholdPercent != nil {
+		in, out := &in.ImageGCHighThresholdPercent, &out.ImageGCHighThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ImageGCLowThresholdPercent != nil {
+		in, out := &in.ImageGCLowThresholdPercent, &out.ImageGCLowThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SerializeImagePulls != nil {
+		in, out := &in.SerializeImagePulls, &out.SerializeImagePulls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegistryPullQPS != nil {
+		in, out := &in.RegistryPullQPS, &out.RegistryPullQPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RegistryBurst != nil {
+		in, out := &in.RegistryBurst, &out.RegistryBurst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SeccompDefault != nil {
+		in, out := &in.SeccompDefault, &out.SeccompDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProtectKernelDefaults != nil {
+		in, out := &in.ProtectKernelDefaults, &out.ProtectKernelDefaults
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StreamingConnectionIdleTimeout != nil {
+		in, out := &in.StreamingConnectionIdleTimeout, &out.StreamingConnectionIdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MemorySwap != nil {
+		in, out := &in.MemorySwap, &out.MemorySwap
+		*out = new(MemorySwapConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]KubeletCredentialProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReservationPolicy != nil {
+		in, out := &in.ReservationPolicy, &out.ReservationPolicy
+		*out = new(KubeletReservationPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigEviction) DeepCopyInto(out *KubeletConfigEviction) {
+	*out = *in
+	if in.MemoryAvailable != nil {
+		in, out := &in.MemoryAvailable, &out.MemoryAvailable
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageFSAvailable != nil {
+		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageFSInodesFree != nil {
+		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeFSAvailable != nil {
+		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeFSInodesFree != nil {
+		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEviction.
+func (in *KubeletConfigEviction) DeepCopy() *KubeletConfigEviction {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigEviction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigEvictionMinimumReclaim) DeepCopyInto(out *KubeletConfigEvictionMinimumReclaim) {
+	*out = *in
+	if in.MemoryAvailable != nil {
+		in, out := &in.MemoryAvailable, &out.MemoryAvailable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ImageFSAvailable != nil {
+		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ImageFSInodesFree != nil {
+		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NodeFSAvailable != nil {
+		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NodeFSInodesFree != nil {
+		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEvictionMinimumReclaim.
+func (in *KubeletConfigEvictionMinimumReclaim) DeepCopy() *KubeletConfigEvictionMinimumReclaim {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigEvictionMinimumReclaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopyInto(out *KubeletConfigEvictionSoftGracePeriod) {
+	*out = *in
+	if in.MemoryAvailable != nil {
+		in, out := &in.MemoryAvailable, &out.MemoryAvailable
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ImageFSAvailable != nil {
+		in, out := &in.ImageFSAvailable, &out.ImageFSAvailable
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ImageFSInodesFree != nil {
+		in, out := &in.ImageFSInodesFree, &out.ImageFSInodesFree
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NodeFSAvailable != nil {
+		in, out := &in.NodeFSAvailable, &out.NodeFSAvailable
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NodeFSInodesFree != nil {
+		in, out := &in.NodeFSInodesFree, &out.NodeFSInodesFree
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEvictionSoftGracePeriod.
+func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopy() *KubeletConfigEvictionSoftGracePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigEvictionSoftGracePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigReserved) DeepCopyInto(out *KubeletConfigReserved) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.PID != nil {
+		in, out := &in.PID, &out.PID
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigReserved.
+func (in *KubeletConfigReserved) DeepCopy() *KubeletConfigReserved {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigReserved)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletCredentialProvider) DeepCopyInto(out *KubeletCredentialProvider) {
+	*out = *in
+	if in.FilePathInImage != nil {
+		in, out := &in.FilePathInImage, &out.FilePathInImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.MatchImages != nil {
+		in, out := &in.MatchImages, &out.MatchImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultCacheDuration != nil {
+		in, out := &in.DefaultCacheDuration, &out.DefaultCacheDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]KubeletCredentialProviderEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletCredentialProvider.
+func (in *KubeletCredentialProvider) DeepCopy() *KubeletCredentialProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletCredentialProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletCredentialProviderEnvVar) DeepCopyInto(out *KubeletCredentialProviderEnvVar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletCredentialProviderEnvVar.
+func (in *KubeletCredentialProviderEnvVar) DeepCopy() *KubeletCredentialProviderEnvVar {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletCredentialProviderEnvVar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
+	*out = *in
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(ClusterAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeAPIServer != nil {
+		in, out := &in.KubeAPIServer, &out.KubeAPIServer
+		*out = new(KubeAPIServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeControllerManager != nil {
+		in, out := &in.KubeControllerManager, &out.KubeControllerManager
+		*out = new(KubeControllerManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeScheduler != nil {
+		in, out := &in.KubeScheduler, &out.KubeScheduler
+		*out = new(KubeSchedulerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(KubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableStaticTokenKubeconfig != nil {
+		in, out := &in.EnableStaticTokenKubeconfig, &out.EnableStaticTokenKubeconfig
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ETCD != nil {
+		in, out := &in.ETCD, &out.ETCD
+		*out = new(ETCD)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kubernetes.
+func (in *Kubernetes) DeepCopy() *Kubernetes {
+	if in == nil {
+		return nil
+	}
+	out := new(Kubernetes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesConfig) DeepCopyInto(out *KubernetesConfig) {
+	*out = *in
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesConfig.
+func (in *KubernetesConfig) DeepCopy() *KubernetesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesDashboard) DeepCopyInto(out *KubernetesDashboard) {
+	*out = *in
+	out.Addon = in.Addon
+	if in.AuthenticationMode != nil {
+		in, out := &in.AuthenticationMode, &out.AuthenticationMode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesDashboard.
+func (in *KubernetesDashboard) DeepCopy() *KubernetesDashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesDashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesSettings) DeepCopyInto(out *KubernetesSettings) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ExpirableVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSettings.
+func (in *KubernetesSettings) DeepCopy() *KubernetesSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesVersionPolicy) DeepCopyInto(out *KubernetesVersionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesVersionPolicy.
+func (in *KubernetesVersionPolicy) DeepCopy() *KubernetesVersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesVersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastError) DeepCopyInto(out *LastError) {
+	*out = *in
+	if in.TaskID != nil {
+		in, out := &in.TaskID, &out.TaskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(ErrorCategory)
+		**out = **in
+	}
+	if in.ProviderCodes != nil {
+		in, out := &in.ProviderCodes, &out.ProviderCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemediationHint != nil {
+		in, out := &in.RemediationHint, &out.RemediationHint
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastError.
+func (in *LastError) DeepCopy() *LastError {
+	if in == nil {
+		return nil
+	}
+	out := new(LastError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastMaintenance) DeepCopyInto(out *LastMaintenance) {
+	*out = *in
+	in.TriggeredTime.DeepCopyInto(&out.TriggeredTime)
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastMaintenance.
+func (in *LastMaintenance) DeepCopy() *LastMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(LastMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastOperation) DeepCopyInto(out *LastOperation) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastOperation.
+func (in *LastOperation) DeepCopy() *LastOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(LastOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeftoverResource) DeepCopyInto(out *LeftoverResource) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeftoverResource.
+func (in *LeftoverResource) DeepCopy() *LeftoverResource {
+	if in == nil {
+		return nil
+	}
+	out := new(LeftoverResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeftoverResources) DeepCopyInto(out *LeftoverResources) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]LeftoverResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeftoverResources.
+func (in *LeftoverResources) DeepCopy() *LeftoverResources {
+	if in == nil {
+		return nil
+	}
+	out := new(LeftoverResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerServicesProxyProtocol) DeepCopyInto(out *LoadBalancerServicesProxyProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerServicesProxyProtocol.
+func (in *LoadBalancerServicesProxyProtocol) DeepCopy() *LoadBalancerServicesProxyProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerServicesProxyProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Machine) DeepCopyInto(out *Machine) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ShootMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.Requirements != nil {
+		in, out := &in.Requirements, &out.Requirements
+		*out = new(MachineTypeRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
+func (in *Machine) DeepCopy() *Machine {
+	if in == nil {
+		return nil
+	}
+	out := new(Machine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerManagerSettings) {
+	*out = *in
+	if in.MachineDrainTimeout != nil {
+		in, out := &in.MachineDrainTimeout, &out.MachineDrainTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MachineHealthTimeout != nil {
+		in, out := &in.MachineHealthTimeout, &out.MachineHealthTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MachineCreationTimeout != nil {
+		in, out := &in.MachineCreationTimeout, &out.MachineCreationTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEvictRetries != nil {
+		in, out := &in.MaxEvictRetries, &out.MaxEvictRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeConditions != nil {
+		in, out := &in.NodeConditions, &out.NodeConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineControllerManagerSettings.
+func (in *MachineControllerManagerSettings) DeepCopy() *MachineControllerManagerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineControllerManagerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]MachineImageVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(MachineImageUpdateStrategy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
+func (in *MachineImage) DeepCopy() *MachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageRunningVersion) DeepCopyInto(out *MachineImageRunningVersion) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageRunningVersion.
+func (in *MachineImageRunningVersion) DeepCopy() *MachineImageRunningVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageRunningVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+	*out = *in
+	in.ExpirableVersion.DeepCopyInto(&out.ExpirableVersion)
+	if in.CRI != nil {
+		in, out := &in.CRI, &out.CRI
+		*out = make([]CRI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeletVersionConstraint != nil {
+		in, out := &in.KubeletVersionConstraint, &out.KubeletVersionConstraint
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
+func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersionPolicy) DeepCopyInto(out *MachineImageVersionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersionPolicy.
+func (in *MachineImageVersionPolicy) DeepCopy() *MachineImageVersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRequirements) DeepCopyInto(out *MachineRequirements) {
+	*out = *in
+	out.CPU = in.CPU.DeepCopy()
+	out.GPU = in.GPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(MachineTypeCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRequirements.
+func (in *MachineRequirements) DeepCopy() *MachineRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineType) DeepCopyInto(out *MachineType) {
+	*out = *in
+	out.CPU = in.CPU.DeepCopy()
+	out.GPU = in.GPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(MachineTypeStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Usable != nil {
+		in, out := &in.Usable, &out.Usable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.HourlyPrice != nil {
+		in, out := &in.HourlyPrice, &out.HourlyPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubeReserved != nil {
+		in, out := &in.KubeReserved, &out.KubeReserved
+		*out = new(KubeletConfigReserved)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(MachineTypeCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
+func (in *MachineType) DeepCopy() *MachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTypeCapabilities) DeepCopyInto(out *MachineTypeCapabilities) {
+	*out = *in
+	if in.CPUFamily != nil {
+		in, out := &in.CPUFamily, &out.CPUFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.NVMe != nil {
+		in, out := &in.NVMe, &out.NVMe
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NestedVirtualization != nil {
+		in, out := &in.NestedVirtualization, &out.NestedVirtualization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConfidentialCompute != nil {
+		in, out := &in.ConfidentialCompute, &out.ConfidentialCompute
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypeCapabilities.
+func (in *MachineTypeCapabilities) DeepCopy() *MachineTypeCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTypeCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTypeRequirements) DeepCopyInto(out *MachineTypeRequirements) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(MachineTypeCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypeRequirements.
+func (in *MachineTypeRequirements) DeepCopy() *MachineTypeRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTypeRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTypeStorage) DeepCopyInto(out *MachineTypeStorage) {
+	*out = *in
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypeStorage.
+func (in *MachineTypeStorage) DeepCopy() *MachineTypeStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTypeStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Maintenance) DeepCopyInto(out *Maintenance) {
+	*out = *in
+	if in.AutoUpdate != nil {
+		in, out := &in.AutoUpdate, &out.AutoUpdate
+		*out = new(MaintenanceAutoUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	if in.ConfineSpecUpdateRollout != nil {
+		in, out := &in.ConfineSpecUpdateRollout, &out.ConfineSpecUpdateRollout
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = new(MaintenanceDependency)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Maintenance.
+func (in *Maintenance) DeepCopy() *Maintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(Maintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
+	*out = *in
+	if in.MachineImageVersion != nil {
+		in, out := &in.MachineImageVersion, &out.MachineImageVersion
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoUpdate.
+func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceAutoUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceDependency) DeepCopyInto(out *MaintenanceDependency) {
+	*out = *in
+	if in.MinimumRunDuration != nil {
+		in, out := &in.MinimumRunDuration, &out.MinimumRunDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceDependency.
+func (in *MaintenanceDependency) DeepCopy() *MaintenanceDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePlannedChange) DeepCopyInto(out *MaintenancePlannedChange) {
+	*out = *in
+	if in.WorkerPool != nil {
+		in, out := &in.WorkerPool, &out.WorkerPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.MachineImageName != nil {
+		in, out := &in.MachineImageName, &out.MachineImageName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePlannedChange.
+func (in *MaintenancePlannedChange) DeepCopy() *MaintenancePlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
+func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySwapConfiguration) DeepCopyInto(out *MemorySwapConfiguration) {
+	*out = *in
+	if in.SwapBehavior != nil {
+		in, out := &in.SwapBehavior, &out.SwapBehavior
+		*out = new(SwapBehavior)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySwapConfiguration.
+func (in *MemorySwapConfiguration) DeepCopy() *MemorySwapConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySwapConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(Alerting)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedResourceReference) DeepCopyInto(out *NamedResourceReference) {
+	*out = *in
+	out.ResourceRef = in.ResourceRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedResourceReference.
+func (in *NamedResourceReference) DeepCopy() *NamedResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfile) DeepCopyInto(out *NamespacedCloudProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfile.
+func (in *NamespacedCloudProfile) DeepCopy() *NamespacedCloudProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedCloudProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileList) DeepCopyInto(out *NamespacedCloudProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespacedCloudProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileList.
+func (in *NamespacedCloudProfileList) DeepCopy() *NamespacedCloudProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespacedCloudProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileSpec) DeepCopyInto(out *NamespacedCloudProfileSpec) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]MachineType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]Region, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]VolumeType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Parent = in.Parent
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileSpec.
+func (in *NamespacedCloudProfileSpec) DeepCopy() *NamespacedCloudProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedCloudProfileStatus) DeepCopyInto(out *NamespacedCloudProfileStatus) {
+	*out = *in
+	in.CloudProfileSpec.DeepCopyInto(&out.CloudProfileSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileStatus.
+func (in *NamespacedCloudProfileStatus) DeepCopy() *NamespacedCloudProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedCloudProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Networking) DeepCopyInto(out *Networking) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(string)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(string)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalNodes != nil {
+		in, out := &in.AdditionalNodes, &out.AdditionalNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(PrivateLink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networking.
+func (in *Networking) DeepCopy() *Networking {
+	if in == nil {
+		return nil
+	}
+	out := new(Networking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkingStatus) DeepCopyInto(out *NetworkingStatus) {
+	*out = *in
+	if in.PathMTU != nil {
+		in, out := &in.PathMTU, &out.PathMTU
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingStatus.
+func (in *NetworkingStatus) DeepCopy() *NetworkingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
+	*out = *in
+	out.Addon = in.Addon
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(v1.ServiceExternalTrafficPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxIngress.
+func (in *NginxIngress) DeepCopy() *NginxIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
+	*out = *in
+	if in.ForceTCPToClusterDNS != nil {
+		in, out := &in.ForceTCPToClusterDNS, &out.ForceTCPToClusterDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceTCPToUpstreamDNS != nil {
+		in, out := &in.ForceTCPToUpstreamDNS, &out.ForceTCPToUpstreamDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableForwardToUpstreamDNS != nil {
+		in, out := &in.DisableForwardToUpstreamDNS, &out.DisableForwardToUpstreamDNS
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
+func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRepository) DeepCopyInto(out *OCIRepository) {
+	*out = *in
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Digest != nil {
+		in, out := &in.Digest, &out.Digest
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRepository.
+func (in *OCIRepository) DeepCopy() *OCIRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientAuthentication != nil {
+		in, out := &in.ClientAuthentication, &out.ClientAuthentication
+		*out = new(OpenIDConnectClientAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupsClaim != nil {
+		in, out := &in.GroupsClaim, &out.GroupsClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupsPrefix != nil {
+		in, out := &in.GroupsPrefix, &out.GroupsPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.IssuerURL != nil {
+		in, out := &in.IssuerURL, &out.IssuerURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequiredClaims != nil {
+		in, out := &in.RequiredClaims, &out.RequiredClaims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SigningAlgs != nil {
+		in, out := &in.SigningAlgs, &out.SigningAlgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UsernameClaim != nil {
+		in, out := &in.UsernameClaim, &out.UsernameClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.UsernamePrefix != nil {
+		in, out := &in.UsernamePrefix, &out.UsernamePrefix
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
+func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Observability) DeepCopyInto(out *Observability) {
+	*out = *in
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(ObservabilityLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventArchive != nil {
+		in, out := &in.EventArchive, &out.EventArchive
+		*out = new(EventArchive)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]ObservabilityProbe, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observability.
+func (in *Observability) DeepCopy() *Observability {
+	if in == nil {
+		return nil
+	}
+	out := new(Observability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityLogging) DeepCopyInto(out *ObservabilityLogging) {
+	*out = *in
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IngestionRate != nil {
+		in, out := &in.IngestionRate, &out.IngestionRate
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.IngestionBurstSize != nil {
+		in, out := &in.IngestionBurstSize, &out.IngestionBurstSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityLogging.
+func (in *ObservabilityLogging) DeepCopy() *ObservabilityLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityProbe) DeepCopyInto(out *ObservabilityProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityProbe.
+func (in *ObservabilityProbe) DeepCopy() *ObservabilityProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityRotation) DeepCopyInto(out *ObservabilityRotation) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityRotation.
+func (in *ObservabilityRotation) DeepCopy() *ObservabilityRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectClientAuthentication) DeepCopyInto(out *OpenIDConnectClientAuthentication) {
+	*out = *in
+	if in.ExtraConfig != nil {
+		in, out := &in.ExtraConfig, &out.ExtraConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectClientAuthentication.
+func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectClientAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLink) DeepCopyInto(out *PrivateLink) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLink.
+func (in *PrivateLink) DeepCopy() *PrivateLink {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectCustomRole) DeepCopyInto(out *ProjectCustomRole) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]rbacv1.PolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectCustomRole.
+func (in *ProjectCustomRole) DeepCopy() *ProjectCustomRole {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectCustomRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
+	*out = *in
+	out.Subject = in.Subject
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigEvictionSoftGracePeriod.
-func (in *KubeletConfigEvictionSoftGracePeriod) DeepCopy() *KubeletConfigEvictionSoftGracePeriod {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMember.
+func (in *ProjectMember) DeepCopy() *ProjectMember {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfigEvictionSoftGracePeriod)
+	out := new(ProjectMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfigReserved) DeepCopyInto(out *KubeletConfigReserved) {
+func (in *ProjectNamespaceResources) DeepCopyInto(out *ProjectNamespaceResources) {
 	*out = *in
-	if in.CPU != nil {
-		in, out := &in.CPU, &out.CPU
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.EphemeralStorage != nil {
-		in, out := &in.EphemeralStorage, &out.EphemeralStorage
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
-	if in.PID != nil {
-		in, out := &in.PID, &out.PID
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = make([]v1.LimitRangeItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigReserved.
-func (in *KubeletConfigReserved) DeepCopy() *KubeletConfigReserved {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespaceResources.
+func (in *ProjectNamespaceResources) DeepCopy() *ProjectNamespaceResources {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfigReserved)
+	out := new(ProjectNamespaceResources)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
-	if in.ClusterAutoscaler != nil {
-		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
-		*out = new(ClusterAutoscaler)
-		(*in).DeepCopyInto(*out)
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(rbacv1.Subject)
+		**out = **in
 	}
-	if in.KubeAPIServer != nil {
-		in, out := &in.KubeAPIServer, &out.KubeAPIServer
-		*out = new(KubeAPIServerConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
 	}
-	if in.KubeControllerManager != nil {
-		in, out := &in.KubeControllerManager, &out.KubeControllerManager
-		*out = new(KubeControllerManagerConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(rbacv1.Subject)
+		**out = **in
 	}
-	if in.KubeScheduler != nil {
-		in, out := &in.KubeScheduler, &out.KubeScheduler
-		*out = new(KubeSchedulerConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(string)
+		**out = **in
 	}
-	if in.KubeProxy != nil {
-		in, out := &in.KubeProxy, &out.KubeProxy
-		*out = new(KubeProxyConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ProjectMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Kubelet != nil {
-		in, out := &in.Kubelet, &out.Kubelet
-		*out = new(KubeletConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
 	}
-	if in.VerticalPodAutoscaler != nil {
-		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
-		*out = new(VerticalPodAutoscaler)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = new(ProjectTolerations)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.EnableStaticTokenKubeconfig != nil {
-		in, out := &in.EnableStaticTokenKubeconfig, &out.EnableStaticTokenKubeconfig
-		*out = new(bool)
-		**out = **in
+	if in.DualApprovalForDeletion != nil {
+		in, out := &in.DualApprovalForDeletion, &out.DualApprovalForDeletion
+		*out = make([]DualApprovalForDeletion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kubernetes.
-func (in *Kubernetes) DeepCopy() *Kubernetes {
-	if in == nil {
-		return nil
+	if in.AlertRoutes != nil {
+		in, out := &in.AlertRoutes, &out.AlertRoutes
+		*out = make([]AlertRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(Kubernetes)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesConfig) DeepCopyInto(out *KubernetesConfig) {
-	*out = *in
-	if in.FeatureGates != nil {
-		in, out := &in.FeatureGates, &out.FeatureGates
-		*out = make(map[string]bool, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.NamespaceResources != nil {
+		in, out := &in.NamespaceResources, &out.NamespaceResources
+		*out = new(ProjectNamespaceResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomRoles != nil {
+		in, out := &in.CustomRoles, &out.CustomRoles
+		*out = make([]ProjectCustomRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesConfig.
-func (in *KubernetesConfig) DeepCopy() *KubernetesConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesConfig)
+	out := new(ProjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesDashboard) DeepCopyInto(out *KubernetesDashboard) {
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 	*out = *in
-	out.Addon = in.Addon
-	if in.AuthenticationMode != nil {
-		in, out := &in.AuthenticationMode, &out.AuthenticationMode
-		*out = new(string)
-		**out = **in
+	if in.StaleSinceTimestamp != nil {
+		in, out := &in.StaleSinceTimestamp, &out.StaleSinceTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StaleAutoDeleteTimestamp != nil {
+		in, out := &in.StaleAutoDeleteTimestamp, &out.StaleAutoDeleteTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastActivityTimestamp != nil {
+		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesDashboard.
-func (in *KubernetesDashboard) DeepCopy() *KubernetesDashboard {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesDashboard)
+	out := new(ProjectStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubernetesSettings) DeepCopyInto(out *KubernetesSettings) {
+func (in *ProjectTolerations) DeepCopyInto(out *ProjectTolerations) {
 	*out = *in
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]ExpirableVersion, len(*in))
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = make([]Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Whitelist != nil {
+		in, out := &in.Whitelist, &out.Whitelist
+		*out = make([]Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2610,453 +4845,467 @@ func (in *KubernetesSettings) DeepCopyInto(out *KubernetesSettings) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSettings.
-func (in *KubernetesSettings) DeepCopy() *KubernetesSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTolerations.
+func (in *ProjectTolerations) DeepCopy() *ProjectTolerations {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSettings)
+	out := new(ProjectTolerations)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastError) DeepCopyInto(out *LastError) {
+func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
-	if in.TaskID != nil {
-		in, out := &in.TaskID, &out.TaskID
-		*out = new(string)
-		**out = **in
+	if in.ControlPlaneConfig != nil {
+		in, out := &in.ControlPlaneConfig, &out.ControlPlaneConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Codes != nil {
-		in, out := &in.Codes, &out.Codes
-		*out = make([]ErrorCode, len(*in))
-		copy(*out, *in)
+	if in.InfrastructureConfig != nil {
+		in, out := &in.InfrastructureConfig, &out.InfrastructureConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LastUpdateTime != nil {
-		in, out := &in.LastUpdateTime, &out.LastUpdateTime
-		*out = (*in).DeepCopy()
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]Worker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkersSettings != nil {
+		in, out := &in.WorkersSettings, &out.WorkersSettings
+		*out = new(WorkersSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastError.
-func (in *LastError) DeepCopy() *LastError {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
+func (in *Provider) DeepCopy() *Provider {
 	if in == nil {
 		return nil
 	}
-	out := new(LastError)
+	out := new(Provider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastMaintenance) DeepCopyInto(out *LastMaintenance) {
+func (in *Quota) DeepCopyInto(out *Quota) {
 	*out = *in
-	in.TriggeredTime.DeepCopyInto(&out.TriggeredTime)
-	if in.FailureReason != nil {
-		in, out := &in.FailureReason, &out.FailureReason
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastMaintenance.
-func (in *LastMaintenance) DeepCopy() *LastMaintenance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quota.
+func (in *Quota) DeepCopy() *Quota {
 	if in == nil {
 		return nil
 	}
-	out := new(LastMaintenance)
+	out := new(Quota)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Quota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LastOperation) DeepCopyInto(out *LastOperation) {
+func (in *QuotaList) DeepCopyInto(out *QuotaList) {
 	*out = *in
-	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Quota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastOperation.
-func (in *LastOperation) DeepCopy() *LastOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaList.
+func (in *QuotaList) DeepCopy() *QuotaList {
 	if in == nil {
 		return nil
 	}
-	out := new(LastOperation)
+	out := new(QuotaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerServicesProxyProtocol) DeepCopyInto(out *LoadBalancerServicesProxyProtocol) {
+func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	*out = *in
+	if in.ClusterLifetimeDays != nil {
+		in, out := &in.ClusterLifetimeDays, &out.ClusterLifetimeDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	out.Scope = in.Scope
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerServicesProxyProtocol.
-func (in *LoadBalancerServicesProxyProtocol) DeepCopy() *LoadBalancerServicesProxyProtocol {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSpec.
+func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerServicesProxyProtocol)
+	out := new(QuotaSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Machine) DeepCopyInto(out *Machine) {
+func (in *Region) DeepCopyInto(out *Region) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ShootMachineImage)
-		(*in).DeepCopyInto(*out)
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]AvailabilityZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
-		*out = new(string)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EgressOnlyInternetAccessSupported != nil {
+		in, out := &in.EgressOnlyInternetAccessSupported, &out.EgressOnlyInternetAccessSupported
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
-func (in *Machine) DeepCopy() *Machine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
+func (in *Region) DeepCopy() *Region {
 	if in == nil {
 		return nil
 	}
-	out := new(Machine)
+	out := new(Region)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerManagerSettings) {
+func (in *ResourceData) DeepCopyInto(out *ResourceData) {
 	*out = *in
-	if in.MachineDrainTimeout != nil {
-		in, out := &in.MachineDrainTimeout, &out.MachineDrainTimeout
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.MachineHealthTimeout != nil {
-		in, out := &in.MachineHealthTimeout, &out.MachineHealthTimeout
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.MachineCreationTimeout != nil {
-		in, out := &in.MachineCreationTimeout, &out.MachineCreationTimeout
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.MaxEvictRetries != nil {
-		in, out := &in.MaxEvictRetries, &out.MaxEvictRetries
-		*out = new(int32)
-		**out = **in
-	}
-	if in.NodeConditions != nil {
-		in, out := &in.NodeConditions, &out.NodeConditions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.CrossVersionObjectReference = in.CrossVersionObjectReference
+	in.Data.DeepCopyInto(&out.Data)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineControllerManagerSettings.
-func (in *MachineControllerManagerSettings) DeepCopy() *MachineControllerManagerSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceData.
+func (in *ResourceData) DeepCopy() *ResourceData {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineControllerManagerSettings)
+	out := new(ResourceData)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+func (in *ResourceWatchCacheSize) DeepCopyInto(out *ResourceWatchCacheSize) {
 	*out = *in
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]MachineImageVersion, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.UpdateStrategy != nil {
-		in, out := &in.UpdateStrategy, &out.UpdateStrategy
-		*out = new(MachineImageUpdateStrategy)
+	if in.APIGroup != nil {
+		in, out := &in.APIGroup, &out.APIGroup
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
-func (in *MachineImage) DeepCopy() *MachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceWatchCacheSize.
+func (in *ResourceWatchCacheSize) DeepCopy() *ResourceWatchCacheSize {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImage)
+	out := new(ResourceWatchCacheSize)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+func (in *RunningVersion) DeepCopyInto(out *RunningVersion) {
 	*out = *in
-	in.ExpirableVersion.DeepCopyInto(&out.ExpirableVersion)
-	if in.CRI != nil {
-		in, out := &in.CRI, &out.CRI
-		*out = make([]CRI, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Architectures != nil {
-		in, out := &in.Architectures, &out.Architectures
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.KubeletVersionConstraint != nil {
-		in, out := &in.KubeletVersionConstraint, &out.KubeletVersionConstraint
-		*out = new(string)
-		**out = **in
-	}
+	in.Since.DeepCopyInto(&out.Since)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
-func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunningVersion.
+func (in *RunningVersion) DeepCopy() *RunningVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImageVersion)
+	out := new(RunningVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineType) DeepCopyInto(out *MachineType) {
+func (in *SSHAccess) DeepCopyInto(out *SSHAccess) {
 	*out = *in
-	out.CPU = in.CPU.DeepCopy()
-	out.GPU = in.GPU.DeepCopy()
-	out.Memory = in.Memory.DeepCopy()
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(MachineTypeStorage)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Usable != nil {
-		in, out := &in.Usable, &out.Usable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
-		*out = new(string)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
-func (in *MachineType) DeepCopy() *MachineType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHAccess.
+func (in *SSHAccess) DeepCopy() *SSHAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineType)
+	out := new(SSHAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineTypeStorage) DeepCopyInto(out *MachineTypeStorage) {
+func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
-	if in.StorageSize != nil {
-		in, out := &in.StorageSize, &out.StorageSize
-		x := (*in).DeepCopy()
-		*out = &x
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.SecretRef = in.SecretRef
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
 	}
-	if in.MinSize != nil {
-		in, out := &in.MinSize, &out.MinSize
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(SecretBindingProvider)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypeStorage.
-func (in *MachineTypeStorage) DeepCopy() *MachineTypeStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBinding.
+func (in *SecretBinding) DeepCopy() *SecretBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineTypeStorage)
+	out := new(SecretBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Maintenance) DeepCopyInto(out *Maintenance) {
+func (in *SecretBindingList) DeepCopyInto(out *SecretBindingList) {
 	*out = *in
-	if in.AutoUpdate != nil {
-		in, out := &in.AutoUpdate, &out.AutoUpdate
-		*out = new(MaintenanceAutoUpdate)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TimeWindow != nil {
-		in, out := &in.TimeWindow, &out.TimeWindow
-		*out = new(MaintenanceTimeWindow)
-		**out = **in
-	}
-	if in.ConfineSpecUpdateRollout != nil {
-		in, out := &in.ConfineSpecUpdateRollout, &out.ConfineSpecUpdateRollout
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Maintenance.
-func (in *Maintenance) DeepCopy() *Maintenance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingList.
+func (in *SecretBindingList) DeepCopy() *SecretBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(Maintenance)
+	out := new(SecretBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
+func (in *SecretBindingProvider) DeepCopyInto(out *SecretBindingProvider) {
 	*out = *in
-	if in.MachineImageVersion != nil {
-		in, out := &in.MachineImageVersion, &out.MachineImageVersion
-		*out = new(bool)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceAutoUpdate.
-func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingProvider.
+func (in *SecretBindingProvider) DeepCopy() *SecretBindingProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceAutoUpdate)
+	out := new(SecretBindingProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+func (in *Seed) DeepCopyInto(out *Seed) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
-func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Seed.
+func (in *Seed) DeepCopy() *Seed {
 	if in == nil {
 		return nil
 	}
-	out := new(MaintenanceTimeWindow)
+	out := new(Seed)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Seed) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemorySwapConfiguration) DeepCopyInto(out *MemorySwapConfiguration) {
+func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
 	*out = *in
-	if in.SwapBehavior != nil {
-		in, out := &in.SwapBehavior, &out.SwapBehavior
-		*out = new(SwapBehavior)
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
 		**out = **in
 	}
+	out.SecretRef = in.SecretRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySwapConfiguration.
-func (in *MemorySwapConfiguration) DeepCopy() *MemorySwapConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackup.
+func (in *SeedBackup) DeepCopy() *SeedBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(MemorySwapConfiguration)
+	out := new(SeedBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *SeedDNS) DeepCopyInto(out *SeedDNS) {
 	*out = *in
-	if in.Alerting != nil {
-		in, out := &in.Alerting, &out.Alerting
-		*out = new(Alerting)
-		(*in).DeepCopyInto(*out)
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(SeedDNSProvider)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDNS.
+func (in *SeedDNS) DeepCopy() *SeedDNS {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(SeedDNS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamedResourceReference) DeepCopyInto(out *NamedResourceReference) {
+func (in *SeedDNSProvider) DeepCopyInto(out *SeedDNSProvider) {
 	*out = *in
-	out.ResourceRef = in.ResourceRef
+	out.SecretRef = in.SecretRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedResourceReference.
-func (in *NamedResourceReference) DeepCopy() *NamedResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDNSProvider.
+func (in *SeedDNSProvider) DeepCopy() *SeedDNSProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(NamedResourceReference)
+	out := new(SeedDNSProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedCloudProfile) DeepCopyInto(out *NamespacedCloudProfile) {
+func (in *SeedDecommissionStatus) DeepCopyInto(out *SeedDecommissionStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfile.
-func (in *NamespacedCloudProfile) DeepCopy() *NamespacedCloudProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDecommissionStatus.
+func (in *SeedDecommissionStatus) DeepCopy() *SeedDecommissionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedCloudProfile)
+	out := new(SeedDecommissionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NamespacedCloudProfile) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedCloudProfileList) DeepCopyInto(out *NamespacedCloudProfileList) {
+func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NamespacedCloudProfile, len(*in))
+		*out = make([]Seed, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3064,18 +5313,18 @@ func (in *NamespacedCloudProfileList) DeepCopyInto(out *NamespacedCloudProfileLi
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileList.
-func (in *NamespacedCloudProfileList) DeepCopy() *NamespacedCloudProfileList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedList.
+func (in *SeedList) DeepCopy() *SeedList {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedCloudProfileList)
+	out := new(SeedList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NamespacedCloudProfileList) DeepCopyObject() runtime.Object {
+func (in *SeedList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3083,963 +5332,813 @@ func (in *NamespacedCloudProfileList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedCloudProfileSpec) DeepCopyInto(out *NamespacedCloudProfileSpec) {
+func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 	*out = *in
-	if in.CABundle != nil {
-		in, out := &in.CABundle, &out.CABundle
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
 		*out = new(string)
 		**out = **in
 	}
-	if in.Kubernetes != nil {
-		in, out := &in.Kubernetes, &out.Kubernetes
-		*out = new(KubernetesSettings)
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(ShootNetworks)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.MachineImages != nil {
-		in, out := &in.MachineImages, &out.MachineImages
-		*out = make([]MachineImage, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.MachineTypes != nil {
-		in, out := &in.MachineTypes, &out.MachineTypes
-		*out = make([]MachineType, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Regions != nil {
-		in, out := &in.Regions, &out.Regions
-		*out = make([]Region, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BlockCIDRs != nil {
+		in, out := &in.BlockCIDRs, &out.BlockCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.VolumeTypes != nil {
-		in, out := &in.VolumeTypes, &out.VolumeTypes
-		*out = make([]VolumeType, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
 	}
-	out.Parent = in.Parent
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileSpec.
-func (in *NamespacedCloudProfileSpec) DeepCopy() *NamespacedCloudProfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedNetworks.
+func (in *SeedNetworks) DeepCopy() *SeedNetworks {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedCloudProfileSpec)
+	out := new(SeedNetworks)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedCloudProfileStatus) DeepCopyInto(out *NamespacedCloudProfileStatus) {
+func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
 	*out = *in
-	in.CloudProfileSpec.DeepCopyInto(&out.CloudProfileSpec)
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedCloudProfileStatus.
-func (in *NamespacedCloudProfileStatus) DeepCopy() *NamespacedCloudProfileStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedProvider.
+func (in *SeedProvider) DeepCopy() *SeedProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedCloudProfileStatus)
+	out := new(SeedProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Networking) DeepCopyInto(out *Networking) {
+func (in *SeedSelector) DeepCopyInto(out *SeedSelector) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
-	if in.ProviderConfig != nil {
-		in, out := &in.ProviderConfig, &out.ProviderConfig
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.ProviderTypes != nil {
+		in, out := &in.ProviderTypes, &out.ProviderTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Pods != nil {
-		in, out := &in.Pods, &out.Pods
-		*out = new(string)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSelector.
+func (in *SeedSelector) DeepCopy() *SeedSelector {
+	if in == nil {
+		return nil
 	}
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = new(string)
+	out := new(SeedSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingAPIServerProxy) DeepCopyInto(out *SeedSettingAPIServerProxy) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = new(string)
+	if in.MaxConnectionsPerSecond != nil {
+		in, out := &in.MaxConnectionsPerSecond, &out.MaxConnectionsPerSecond
+		*out = new(int32)
 		**out = **in
 	}
-	if in.IPFamilies != nil {
-		in, out := &in.IPFamilies, &out.IPFamilies
-		*out = make([]IPFamily, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networking.
-func (in *Networking) DeepCopy() *Networking {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingAPIServerProxy.
+func (in *SeedSettingAPIServerProxy) DeepCopy() *SeedSettingAPIServerProxy {
 	if in == nil {
 		return nil
 	}
-	out := new(Networking)
+	out := new(SeedSettingAPIServerProxy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
+func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
 	*out = *in
-	out.Addon = in.Addon
-	if in.LoadBalancerSourceRanges != nil {
-		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Weeder != nil {
+		in, out := &in.Weeder, &out.Weeder
+		*out = new(SeedSettingDependencyWatchdogWeeder)
+		**out = **in
 	}
-	if in.ExternalTrafficPolicy != nil {
-		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
+	if in.Prober != nil {
+		in, out := &in.Prober, &out.Prober
+		*out = new(SeedSettingDependencyWatchdogProber)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxIngress.
-func (in *NginxIngress) DeepCopy() *NginxIngress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDependencyWatchdog.
+func (in *SeedSettingDependencyWatchdog) DeepCopy() *SeedSettingDependencyWatchdog {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxIngress)
+	out := new(SeedSettingDependencyWatchdog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
+func (in *SeedSettingDependencyWatchdogProber) DeepCopyInto(out *SeedSettingDependencyWatchdogProber) {
 	*out = *in
-	if in.ForceTCPToClusterDNS != nil {
-		in, out := &in.ForceTCPToClusterDNS, &out.ForceTCPToClusterDNS
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ForceTCPToUpstreamDNS != nil {
-		in, out := &in.ForceTCPToUpstreamDNS, &out.ForceTCPToUpstreamDNS
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DisableForwardToUpstreamDNS != nil {
-		in, out := &in.DisableForwardToUpstreamDNS, &out.DisableForwardToUpstreamDNS
-		*out = new(bool)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
-func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDependencyWatchdogProber.
+func (in *SeedSettingDependencyWatchdogProber) DeepCopy() *SeedSettingDependencyWatchdogProber {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeLocalDNS)
+	out := new(SeedSettingDependencyWatchdogProber)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OCIRepository) DeepCopyInto(out *OCIRepository) {
+func (in *SeedSettingDependencyWatchdogWeeder) DeepCopyInto(out *SeedSettingDependencyWatchdogWeeder) {
 	*out = *in
-	if in.Ref != nil {
-		in, out := &in.Ref, &out.Ref
-		*out = new(string)
-		**out = **in
-	}
-	if in.Repository != nil {
-		in, out := &in.Repository, &out.Repository
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tag != nil {
-		in, out := &in.Tag, &out.Tag
-		*out = new(string)
-		**out = **in
-	}
-	if in.Digest != nil {
-		in, out := &in.Digest, &out.Digest
-		*out = new(string)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRepository.
-func (in *OCIRepository) DeepCopy() *OCIRepository {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDependencyWatchdogWeeder.
+func (in *SeedSettingDependencyWatchdogWeeder) DeepCopy() *SeedSettingDependencyWatchdogWeeder {
 	if in == nil {
 		return nil
 	}
-	out := new(OCIRepository)
+	out := new(SeedSettingDependencyWatchdogWeeder)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
+func (in *SeedSettingExcessCapacityReservation) DeepCopyInto(out *SeedSettingExcessCapacityReservation) {
 	*out = *in
-	if in.CABundle != nil {
-		in, out := &in.CABundle, &out.CABundle
-		*out = new(string)
-		**out = **in
-	}
-	if in.ClientAuthentication != nil {
-		in, out := &in.ClientAuthentication, &out.ClientAuthentication
-		*out = new(OpenIDConnectClientAuthentication)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ClientID != nil {
-		in, out := &in.ClientID, &out.ClientID
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupsClaim != nil {
-		in, out := &in.GroupsClaim, &out.GroupsClaim
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupsPrefix != nil {
-		in, out := &in.GroupsPrefix, &out.GroupsPrefix
-		*out = new(string)
-		**out = **in
-	}
-	if in.IssuerURL != nil {
-		in, out := &in.IssuerURL, &out.IssuerURL
-		*out = new(string)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.RequiredClaims != nil {
-		in, out := &in.RequiredClaims, &out.RequiredClaims
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Configs != nil {
+		in, out := &in.Configs, &out.Configs
+		*out = make([]SeedSettingExcessCapacityReservationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SigningAlgs != nil {
-		in, out := &in.SigningAlgs, &out.SigningAlgs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.UsernameClaim != nil {
-		in, out := &in.UsernameClaim, &out.UsernameClaim
-		*out = new(string)
-		**out = **in
-	}
-	if in.UsernamePrefix != nil {
-		in, out := &in.UsernamePrefix, &out.UsernamePrefix
-		*out = new(string)
-		**out = **in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]SeedSettingExcessCapacityReservationProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfig.
-func (in *OIDCConfig) DeepCopy() *OIDCConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservation.
+func (in *SeedSettingExcessCapacityReservation) DeepCopy() *SeedSettingExcessCapacityReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OIDCConfig)
+	out := new(SeedSettingExcessCapacityReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityRotation) DeepCopyInto(out *ObservabilityRotation) {
+func (in *SeedSettingExcessCapacityReservationConfig) DeepCopyInto(out *SeedSettingExcessCapacityReservationConfig) {
 	*out = *in
-	if in.LastInitiationTime != nil {
-		in, out := &in.LastInitiationTime, &out.LastInitiationTime
-		*out = (*in).DeepCopy()
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
-	if in.LastCompletionTime != nil {
-		in, out := &in.LastCompletionTime, &out.LastCompletionTime
-		*out = (*in).DeepCopy()
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityRotation.
-func (in *ObservabilityRotation) DeepCopy() *ObservabilityRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservationConfig.
+func (in *SeedSettingExcessCapacityReservationConfig) DeepCopy() *SeedSettingExcessCapacityReservationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityRotation)
+	out := new(SeedSettingExcessCapacityReservationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenIDConnectClientAuthentication) DeepCopyInto(out *OpenIDConnectClientAuthentication) {
+func (in *SeedSettingExcessCapacityReservationProfile) DeepCopyInto(out *SeedSettingExcessCapacityReservationProfile) {
 	*out = *in
-	if in.ExtraConfig != nil {
-		in, out := &in.ExtraConfig, &out.ExtraConfig
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.Secret != nil {
-		in, out := &in.Secret, &out.Secret
-		*out = new(string)
-		**out = **in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectClientAuthentication.
-func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservationProfile.
+func (in *SeedSettingExcessCapacityReservationProfile) DeepCopy() *SeedSettingExcessCapacityReservationProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenIDConnectClientAuthentication)
+	out := new(SeedSettingExcessCapacityReservationProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *SeedSettingLoadBalancerServices) DeepCopyInto(out *SeedSettingLoadBalancerServices) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(v1.ServiceExternalTrafficPolicy)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]SeedSettingLoadBalancerServicesZones, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(LoadBalancerServicesProxyProtocol)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingLoadBalancerServices.
+func (in *SeedSettingLoadBalancerServices) DeepCopy() *SeedSettingLoadBalancerServices {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(SeedSettingLoadBalancerServices)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *SeedSettingLoadBalancerServicesZones) DeepCopyInto(out *SeedSettingLoadBalancerServicesZones) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(v1.ServiceExternalTrafficPolicy)
+		**out = **in
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(LoadBalancerServicesProxyProtocol)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingLoadBalancerServicesZones.
+func (in *SeedSettingLoadBalancerServicesZones) DeepCopy() *SeedSettingLoadBalancerServicesZones {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(SeedSettingLoadBalancerServicesZones)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectMember) DeepCopyInto(out *ProjectMember) {
+func (in *SeedSettingPrivateLink) DeepCopyInto(out *SeedSettingPrivateLink) {
 	*out = *in
-	out.Subject = in.Subject
-	if in.Roles != nil {
-		in, out := &in.Roles, &out.Roles
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMember.
-func (in *ProjectMember) DeepCopy() *ProjectMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingPrivateLink.
+func (in *SeedSettingPrivateLink) DeepCopy() *SeedSettingPrivateLink {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectMember)
+	out := new(SeedSettingPrivateLink)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *SeedSettingScheduling) DeepCopyInto(out *SeedSettingScheduling) {
 	*out = *in
-	if in.CreatedBy != nil {
-		in, out := &in.CreatedBy, &out.CreatedBy
-		*out = new(rbacv1.Subject)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Owner != nil {
-		in, out := &in.Owner, &out.Owner
-		*out = new(rbacv1.Subject)
-		**out = **in
-	}
-	if in.Purpose != nil {
-		in, out := &in.Purpose, &out.Purpose
-		*out = new(string)
-		**out = **in
-	}
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]ProjectMember, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Namespace != nil {
-		in, out := &in.Namespace, &out.Namespace
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = new(ProjectTolerations)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DualApprovalForDeletion != nil {
-		in, out := &in.DualApprovalForDeletion, &out.DualApprovalForDeletion
-		*out = make([]DualApprovalForDeletion, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingScheduling.
+func (in *SeedSettingScheduling) DeepCopy() *SeedSettingScheduling {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(SeedSettingScheduling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *SeedSettingTopologyAwareRouting) DeepCopyInto(out *SeedSettingTopologyAwareRouting) {
 	*out = *in
-	if in.StaleSinceTimestamp != nil {
-		in, out := &in.StaleSinceTimestamp, &out.StaleSinceTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.StaleAutoDeleteTimestamp != nil {
-		in, out := &in.StaleAutoDeleteTimestamp, &out.StaleAutoDeleteTimestamp
-		*out = (*in).DeepCopy()
-	}
-	if in.LastActivityTimestamp != nil {
-		in, out := &in.LastActivityTimestamp, &out.LastActivityTimestamp
-		*out = (*in).DeepCopy()
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingTopologyAwareRouting.
+func (in *SeedSettingTopologyAwareRouting) DeepCopy() *SeedSettingTopologyAwareRouting {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(SeedSettingTopologyAwareRouting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTolerations) DeepCopyInto(out *ProjectTolerations) {
+func (in *SeedSettingVerticalPodAutoscaler) DeepCopyInto(out *SeedSettingVerticalPodAutoscaler) {
 	*out = *in
-	if in.Defaults != nil {
-		in, out := &in.Defaults, &out.Defaults
-		*out = make([]Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Whitelist != nil {
-		in, out := &in.Whitelist, &out.Whitelist
-		*out = make([]Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTolerations.
-func (in *ProjectTolerations) DeepCopy() *ProjectTolerations {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingVerticalPodAutoscaler.
+func (in *SeedSettingVerticalPodAutoscaler) DeepCopy() *SeedSettingVerticalPodAutoscaler {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTolerations)
+	out := new(SeedSettingVerticalPodAutoscaler)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Provider) DeepCopyInto(out *Provider) {
+func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 	*out = *in
-	if in.ControlPlaneConfig != nil {
-		in, out := &in.ControlPlaneConfig, &out.ControlPlaneConfig
-		*out = new(runtime.RawExtension)
+	if in.ExcessCapacityReservation != nil {
+		in, out := &in.ExcessCapacityReservation, &out.ExcessCapacityReservation
+		*out = new(SeedSettingExcessCapacityReservation)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.InfrastructureConfig != nil {
-		in, out := &in.InfrastructureConfig, &out.InfrastructureConfig
-		*out = new(runtime.RawExtension)
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SeedSettingScheduling)
+		**out = **in
+	}
+	if in.LoadBalancerServices != nil {
+		in, out := &in.LoadBalancerServices, &out.LoadBalancerServices
+		*out = new(SeedSettingLoadBalancerServices)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = make([]Worker, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(SeedSettingVerticalPodAutoscaler)
+		**out = **in
 	}
-	if in.WorkersSettings != nil {
-		in, out := &in.WorkersSettings, &out.WorkersSettings
-		*out = new(WorkersSettings)
+	if in.DependencyWatchdog != nil {
+		in, out := &in.DependencyWatchdog, &out.DependencyWatchdog
+		*out = new(SeedSettingDependencyWatchdog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologyAwareRouting != nil {
+		in, out := &in.TopologyAwareRouting, &out.TopologyAwareRouting
+		*out = new(SeedSettingTopologyAwareRouting)
+		**out = **in
+	}
+	if in.APIServerProxy != nil {
+		in, out := &in.APIServerProxy, &out.APIServerProxy
+		*out = new(SeedSettingAPIServerProxy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PrivateLink != nil {
+		in, out := &in.PrivateLink, &out.PrivateLink
+		*out = new(SeedSettingPrivateLink)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
-func (in *Provider) DeepCopy() *Provider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettings.
+func (in *SeedSettings) DeepCopy() *SeedSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(Provider)
+	out := new(SeedSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Quota) DeepCopyInto(out *Quota) {
+func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quota.
-func (in *Quota) DeepCopy() *Quota {
-	if in == nil {
-		return nil
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(SeedBackup)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(Quota)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Quota) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	in.DNS.DeepCopyInto(&out.DNS)
+	in.Networks.DeepCopyInto(&out.Networks)
+	in.Provider.DeepCopyInto(&out.Provider)
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(SeedSettings)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuotaList) DeepCopyInto(out *QuotaList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Quota, len(*in))
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]SeedTaint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(SeedVolume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(Ingress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(SeedOperation)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaList.
-func (in *QuotaList) DeepCopy() *QuotaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
+func (in *SeedSpec) DeepCopy() *SeedSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QuotaList)
+	out := new(SeedSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuotaList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
+func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 	*out = *in
-	if in.ClusterLifetimeDays != nil {
-		in, out := &in.ClusterLifetimeDays, &out.ClusterLifetimeDays
-		*out = new(int32)
+	if in.Gardener != nil {
+		in, out := &in.Gardener, &out.Gardener
+		*out = new(Gardener)
+		**out = **in
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterIdentity != nil {
+		in, out := &in.ClusterIdentity, &out.ClusterIdentity
+		*out = new(string)
 		**out = **in
 	}
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
 		*out = make(v1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
-	out.Scope = in.Scope
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSpec.
-func (in *QuotaSpec) DeepCopy() *QuotaSpec {
-	if in == nil {
-		return nil
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
-	out := new(QuotaSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Region) DeepCopyInto(out *Region) {
-	*out = *in
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]AvailabilityZone, len(*in))
+	if in.ClientCertificateExpirationTimestamp != nil {
+		in, out := &in.ClientCertificateExpirationTimestamp, &out.ClientCertificateExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExposureClassHandlers != nil {
+		in, out := &in.ExposureClassHandlers, &out.ExposureClassHandlers
+		*out = make([]ExposureClassHandlerStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.SystemComponents != nil {
+		in, out := &in.SystemComponents, &out.SystemComponents
+		*out = make([]SeedSystemComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Region.
-func (in *Region) DeepCopy() *Region {
-	if in == nil {
-		return nil
+	if in.Decommission != nil {
+		in, out := &in.Decommission, &out.Decommission
+		*out = new(SeedDecommissionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]FeatureGateStatus, len(*in))
+		copy(*out, *in)
 	}
-	out := new(Region)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceData) DeepCopyInto(out *ResourceData) {
-	*out = *in
-	out.CrossVersionObjectReference = in.CrossVersionObjectReference
-	in.Data.DeepCopyInto(&out.Data)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceData.
-func (in *ResourceData) DeepCopy() *ResourceData {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedStatus.
+func (in *SeedStatus) DeepCopy() *SeedStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceData)
+	out := new(SeedStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceWatchCacheSize) DeepCopyInto(out *ResourceWatchCacheSize) {
+func (in *SeedSystemComponent) DeepCopyInto(out *SeedSystemComponent) {
 	*out = *in
-	if in.APIGroup != nil {
-		in, out := &in.APIGroup, &out.APIGroup
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
 		*out = new(string)
 		**out = **in
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceWatchCacheSize.
-func (in *ResourceWatchCacheSize) DeepCopy() *ResourceWatchCacheSize {
-	if in == nil {
-		return nil
+	if in.ExpectedVersion != nil {
+		in, out := &in.ExpectedVersion, &out.ExpectedVersion
+		*out = new(string)
+		**out = **in
 	}
-	out := new(ResourceWatchCacheSize)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHAccess) DeepCopyInto(out *SSHAccess) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHAccess.
-func (in *SSHAccess) DeepCopy() *SSHAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSystemComponent.
+func (in *SeedSystemComponent) DeepCopy() *SeedSystemComponent {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHAccess)
+	out := new(SeedSystemComponent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
+func (in *SeedTaint) DeepCopyInto(out *SeedTaint) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.SecretRef = in.SecretRef
-	if in.Quotas != nil {
-		in, out := &in.Quotas, &out.Quotas
-		*out = make([]v1.ObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.Provider != nil {
-		in, out := &in.Provider, &out.Provider
-		*out = new(SecretBindingProvider)
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBinding.
-func (in *SecretBinding) DeepCopy() *SecretBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTaint.
+func (in *SeedTaint) DeepCopy() *SeedTaint {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretBinding)
+	out := new(SeedTaint)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretBinding) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretBindingList) DeepCopyInto(out *SecretBindingList) {
+func (in *SeedTemplate) DeepCopyInto(out *SeedTemplate) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]SecretBinding, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingList.
-func (in *SecretBindingList) DeepCopy() *SecretBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTemplate.
+func (in *SeedTemplate) DeepCopy() *SeedTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretBindingList)
+	out := new(SeedTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretBindingList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretBindingProvider) DeepCopyInto(out *SecretBindingProvider) {
+func (in *SeedVolume) DeepCopyInto(out *SeedVolume) {
 	*out = *in
+	if in.MinimumSize != nil {
+		in, out := &in.MinimumSize, &out.MinimumSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]SeedVolumeProvider, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingProvider.
-func (in *SecretBindingProvider) DeepCopy() *SecretBindingProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedVolume.
+func (in *SeedVolume) DeepCopy() *SeedVolume {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretBindingProvider)
+	out := new(SeedVolume)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Seed) DeepCopyInto(out *Seed) {
+func (in *SeedVolumeProvider) DeepCopyInto(out *SeedVolumeProvider) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Seed.
-func (in *Seed) DeepCopy() *Seed {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedVolumeProvider.
+func (in *SeedVolumeProvider) DeepCopy() *SeedVolumeProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(Seed)
+	out := new(SeedVolumeProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Seed) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
+func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
 	*out = *in
-	if in.ProviderConfig != nil {
-		in, out := &in.ProviderConfig, &out.ProviderConfig
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
 		*out = new(string)
 		**out = **in
 	}
-	out.SecretRef = in.SecretRef
+	if in.ExtendTokenExpiration != nil {
+		in, out := &in.ExtendTokenExpiration, &out.ExtendTokenExpiration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxTokenExpiration != nil {
+		in, out := &in.MaxTokenExpiration, &out.MaxTokenExpiration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AcceptedIssuers != nil {
+		in, out := &in.AcceptedIssuers, &out.AcceptedIssuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackup.
-func (in *SeedBackup) DeepCopy() *SeedBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountConfig.
+func (in *ServiceAccountConfig) DeepCopy() *ServiceAccountConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedBackup)
+	out := new(ServiceAccountConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedDNS) DeepCopyInto(out *SeedDNS) {
+func (in *ServiceAccountKeyRotation) DeepCopyInto(out *ServiceAccountKeyRotation) {
 	*out = *in
-	if in.Provider != nil {
-		in, out := &in.Provider, &out.Provider
-		*out = new(SeedDNSProvider)
-		**out = **in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDNS.
-func (in *SeedDNS) DeepCopy() *SeedDNS {
-	if in == nil {
-		return nil
+	if in.LastInitiationFinishedTime != nil {
+		in, out := &in.LastInitiationFinishedTime, &out.LastInitiationFinishedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTriggeredTime != nil {
+		in, out := &in.LastCompletionTriggeredTime, &out.LastCompletionTriggeredTime
+		*out = (*in).DeepCopy()
 	}
-	out := new(SeedDNS)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedDNSProvider) DeepCopyInto(out *SeedDNSProvider) {
-	*out = *in
-	out.SecretRef = in.SecretRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDNSProvider.
-func (in *SeedDNSProvider) DeepCopy() *SeedDNSProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyRotation.
+func (in *ServiceAccountKeyRotation) DeepCopy() *ServiceAccountKeyRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedDNSProvider)
+	out := new(ServiceAccountKeyRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedList) DeepCopyInto(out *SeedList) {
+func (in *Shoot) DeepCopyInto(out *Shoot) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Seed, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedList.
-func (in *SeedList) DeepCopy() *SeedList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Shoot.
+func (in *Shoot) DeepCopy() *Shoot {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedList)
+	out := new(Shoot)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SeedList) DeepCopyObject() runtime.Object {
+func (in *Shoot) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4047,158 +6146,152 @@ func (in *SeedList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
+func (in *ShootAdvertisedAddress) DeepCopyInto(out *ShootAdvertisedAddress) {
 	*out = *in
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = new(string)
-		**out = **in
-	}
-	if in.ShootDefaults != nil {
-		in, out := &in.ShootDefaults, &out.ShootDefaults
-		*out = new(ShootNetworks)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BlockCIDRs != nil {
-		in, out := &in.BlockCIDRs, &out.BlockCIDRs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.IPFamilies != nil {
-		in, out := &in.IPFamilies, &out.IPFamilies
-		*out = make([]IPFamily, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedNetworks.
-func (in *SeedNetworks) DeepCopy() *SeedNetworks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAdvertisedAddress.
+func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedNetworks)
+	out := new(ShootAdvertisedAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
+func (in *ShootAvailability) DeepCopyInto(out *ShootAvailability) {
 	*out = *in
-	if in.ProviderConfig != nil {
-		in, out := &in.ProviderConfig, &out.ProviderConfig
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedProvider.
-func (in *SeedProvider) DeepCopy() *SeedProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailability.
+func (in *ShootAvailability) DeepCopy() *ShootAvailability {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedProvider)
+	out := new(ShootAvailability)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSelector) DeepCopyInto(out *SeedSelector) {
+func (in *ShootClone) DeepCopyInto(out *ShootClone) {
 	*out = *in
-	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
-	if in.ProviderTypes != nil {
-		in, out := &in.ProviderTypes, &out.ProviderTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSelector.
-func (in *SeedSelector) DeepCopy() *SeedSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClone.
+func (in *ShootClone) DeepCopy() *ShootClone {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSelector)
+	out := new(ShootClone)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootClone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
+func (in *ShootCloneSpec) DeepCopyInto(out *ShootCloneSpec) {
 	*out = *in
-	if in.Weeder != nil {
-		in, out := &in.Weeder, &out.Weeder
-		*out = new(SeedSettingDependencyWatchdogWeeder)
+	if in.CopyWorkers != nil {
+		in, out := &in.CopyWorkers, &out.CopyWorkers
+		*out = new(bool)
 		**out = **in
 	}
-	if in.Prober != nil {
-		in, out := &in.Prober, &out.Prober
-		*out = new(SeedSettingDependencyWatchdogProber)
+	if in.CopyNetworking != nil {
+		in, out := &in.CopyNetworking, &out.CopyNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CopyAddons != nil {
+		in, out := &in.CopyAddons, &out.CopyAddons
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDependencyWatchdog.
-func (in *SeedSettingDependencyWatchdog) DeepCopy() *SeedSettingDependencyWatchdog {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCloneSpec.
+func (in *ShootCloneSpec) DeepCopy() *ShootCloneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingDependencyWatchdog)
+	out := new(ShootCloneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingDependencyWatchdogProber) DeepCopyInto(out *SeedSettingDependencyWatchdogProber) {
+func (in *ShootCloneStatus) DeepCopyInto(out *ShootCloneStatus) {
 	*out = *in
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(Shoot)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDependencyWatchdogProber.
-func (in *SeedSettingDependencyWatchdogProber) DeepCopy() *SeedSettingDependencyWatchdogProber {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCloneStatus.
+func (in *ShootCloneStatus) DeepCopy() *ShootCloneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingDependencyWatchdogProber)
+	out := new(ShootCloneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingDependencyWatchdogWeeder) DeepCopyInto(out *SeedSettingDependencyWatchdogWeeder) {
+func (in *ShootCostEstimate) DeepCopyInto(out *ShootCostEstimate) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingDependencyWatchdogWeeder.
-func (in *SeedSettingDependencyWatchdogWeeder) DeepCopy() *SeedSettingDependencyWatchdogWeeder {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostEstimate.
+func (in *ShootCostEstimate) DeepCopy() *ShootCostEstimate {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingDependencyWatchdogWeeder)
+	out := new(ShootCostEstimate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootCostEstimate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingExcessCapacityReservation) DeepCopyInto(out *SeedSettingExcessCapacityReservation) {
+func (in *ShootCostEstimateSpec) DeepCopyInto(out *ShootCostEstimateSpec) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Configs != nil {
-		in, out := &in.Configs, &out.Configs
-		*out = make([]SeedSettingExcessCapacityReservationConfig, len(*in))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]Worker, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -4206,619 +6299,546 @@ func (in *SeedSettingExcessCapacityReservation) DeepCopyInto(out *SeedSettingExc
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservation.
-func (in *SeedSettingExcessCapacityReservation) DeepCopy() *SeedSettingExcessCapacityReservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostEstimateSpec.
+func (in *ShootCostEstimateSpec) DeepCopy() *ShootCostEstimateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingExcessCapacityReservation)
+	out := new(ShootCostEstimateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingExcessCapacityReservationConfig) DeepCopyInto(out *SeedSettingExcessCapacityReservationConfig) {
+func (in *ShootCostEstimateStatus) DeepCopyInto(out *ShootCostEstimateStatus) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerCostEstimate, len(*in))
+		copy(*out, *in)
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingExcessCapacityReservationConfig.
-func (in *SeedSettingExcessCapacityReservationConfig) DeepCopy() *SeedSettingExcessCapacityReservationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCostEstimateStatus.
+func (in *ShootCostEstimateStatus) DeepCopy() *ShootCostEstimateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingExcessCapacityReservationConfig)
+	out := new(ShootCostEstimateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingLoadBalancerServices) DeepCopyInto(out *SeedSettingLoadBalancerServices) {
+func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ExternalTrafficPolicy != nil {
-		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
-		**out = **in
-	}
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]SeedSettingLoadBalancerServicesZones, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ProxyProtocol != nil {
-		in, out := &in.ProxyProtocol, &out.ProxyProtocol
-		*out = new(LoadBalancerServicesProxyProtocol)
-		**out = **in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(ShootCredentialsRotation)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingLoadBalancerServices.
-func (in *SeedSettingLoadBalancerServices) DeepCopy() *SeedSettingLoadBalancerServices {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentials.
+func (in *ShootCredentials) DeepCopy() *ShootCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingLoadBalancerServices)
+	out := new(ShootCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingLoadBalancerServicesZones) DeepCopyInto(out *SeedSettingLoadBalancerServicesZones) {
+func (in *ShootCredentialsRotation) DeepCopyInto(out *ShootCredentialsRotation) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.CertificateAuthorities != nil {
+		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
+		*out = new(CARotation)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ExternalTrafficPolicy != nil {
-		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
-		**out = **in
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(ShootKubeconfigRotation)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ProxyProtocol != nil {
-		in, out := &in.ProxyProtocol, &out.ProxyProtocol
-		*out = new(LoadBalancerServicesProxyProtocol)
-		**out = **in
+	if in.SSHKeypair != nil {
+		in, out := &in.SSHKeypair, &out.SSHKeypair
+		*out = new(ShootSSHKeypairRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(ObservabilityRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountKey != nil {
+		in, out := &in.ServiceAccountKey, &out.ServiceAccountKey
+		*out = new(ServiceAccountKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCDEncryptionKey != nil {
+		in, out := &in.ETCDEncryptionKey, &out.ETCDEncryptionKey
+		*out = new(ETCDEncryptionKeyRotation)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingLoadBalancerServicesZones.
-func (in *SeedSettingLoadBalancerServicesZones) DeepCopy() *SeedSettingLoadBalancerServicesZones {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentialsRotation.
+func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingLoadBalancerServicesZones)
+	out := new(ShootCredentialsRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingScheduling) DeepCopyInto(out *SeedSettingScheduling) {
+func (in *ShootETCDStatus) DeepCopyInto(out *ShootETCDStatus) {
 	*out = *in
+	if in.Main != nil {
+		in, out := &in.Main, &out.Main
+		*out = new(ETCDStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(ETCDStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingScheduling.
-func (in *SeedSettingScheduling) DeepCopy() *SeedSettingScheduling {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootETCDStatus.
+func (in *ShootETCDStatus) DeepCopy() *ShootETCDStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingScheduling)
+	out := new(ShootETCDStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingTopologyAwareRouting) DeepCopyInto(out *SeedSettingTopologyAwareRouting) {
+func (in *ShootExport) DeepCopyInto(out *ShootExport) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingTopologyAwareRouting.
-func (in *SeedSettingTopologyAwareRouting) DeepCopy() *SeedSettingTopologyAwareRouting {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootExport.
+func (in *ShootExport) DeepCopy() *ShootExport {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingTopologyAwareRouting)
+	out := new(ShootExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettingVerticalPodAutoscaler) DeepCopyInto(out *SeedSettingVerticalPodAutoscaler) {
+func (in *ShootExportSpec) DeepCopyInto(out *ShootExportSpec) {
 	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(ShootExportTarget)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingVerticalPodAutoscaler.
-func (in *SeedSettingVerticalPodAutoscaler) DeepCopy() *SeedSettingVerticalPodAutoscaler {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootExportSpec.
+func (in *ShootExportSpec) DeepCopy() *ShootExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettingVerticalPodAutoscaler)
+	out := new(ShootExportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
+func (in *ShootExportStatus) DeepCopyInto(out *ShootExportStatus) {
 	*out = *in
-	if in.ExcessCapacityReservation != nil {
-		in, out := &in.ExcessCapacityReservation, &out.ExcessCapacityReservation
-		*out = new(SeedSettingExcessCapacityReservation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Scheduling != nil {
-		in, out := &in.Scheduling, &out.Scheduling
-		*out = new(SeedSettingScheduling)
-		**out = **in
-	}
-	if in.LoadBalancerServices != nil {
-		in, out := &in.LoadBalancerServices, &out.LoadBalancerServices
-		*out = new(SeedSettingLoadBalancerServices)
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(ShootProfile)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.VerticalPodAutoscaler != nil {
-		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
-		*out = new(SeedSettingVerticalPodAutoscaler)
-		**out = **in
-	}
-	if in.DependencyWatchdog != nil {
-		in, out := &in.DependencyWatchdog, &out.DependencyWatchdog
-		*out = new(SeedSettingDependencyWatchdog)
+	if in.Shoot != nil {
+		in, out := &in.Shoot, &out.Shoot
+		*out = new(Shoot)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TopologyAwareRouting != nil {
-		in, out := &in.TopologyAwareRouting, &out.TopologyAwareRouting
-		*out = new(SeedSettingTopologyAwareRouting)
-		**out = **in
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettings.
-func (in *SeedSettings) DeepCopy() *SeedSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootExportStatus.
+func (in *ShootExportStatus) DeepCopy() *ShootExportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSettings)
+	out := new(ShootExportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
+func (in *ShootExportTarget) DeepCopyInto(out *ShootExportTarget) {
 	*out = *in
-	if in.Backup != nil {
-		in, out := &in.Backup, &out.Backup
-		*out = new(SeedBackup)
-		(*in).DeepCopyInto(*out)
-	}
-	in.DNS.DeepCopyInto(&out.DNS)
-	in.Networks.DeepCopyInto(&out.Networks)
-	in.Provider.DeepCopyInto(&out.Provider)
-	if in.Settings != nil {
-		in, out := &in.Settings, &out.Settings
-		*out = new(SeedSettings)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]SeedTaint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Volume != nil {
-		in, out := &in.Volume, &out.Volume
-		*out = new(SeedVolume)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(Ingress)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
-func (in *SeedSpec) DeepCopy() *SeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootExportTarget.
+func (in *ShootExportTarget) DeepCopy() *ShootExportTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSpec)
+	out := new(ShootExportTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
+func (in *ShootKubeconfigRotation) DeepCopyInto(out *ShootKubeconfigRotation) {
 	*out = *in
-	if in.Gardener != nil {
-		in, out := &in.Gardener, &out.Gardener
-		*out = new(Gardener)
-		**out = **in
-	}
-	if in.KubernetesVersion != nil {
-		in, out := &in.KubernetesVersion, &out.KubernetesVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ClusterIdentity != nil {
-		in, out := &in.ClusterIdentity, &out.ClusterIdentity
-		*out = new(string)
-		**out = **in
-	}
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.Allocatable != nil {
-		in, out := &in.Allocatable, &out.Allocatable
-		*out = make(v1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.ClientCertificateExpirationTimestamp != nil {
-		in, out := &in.ClientCertificateExpirationTimestamp, &out.ClientCertificateExpirationTimestamp
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastOperation != nil {
-		in, out := &in.LastOperation, &out.LastOperation
-		*out = new(LastOperation)
-		(*in).DeepCopyInto(*out)
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedStatus.
-func (in *SeedStatus) DeepCopy() *SeedStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootKubeconfigRotation.
+func (in *ShootKubeconfigRotation) DeepCopy() *ShootKubeconfigRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedStatus)
+	out := new(ShootKubeconfigRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedTaint) DeepCopyInto(out *SeedTaint) {
+func (in *ShootList) DeepCopyInto(out *ShootList) {
 	*out = *in
-	if in.Value != nil {
-		in, out := &in.Value, &out.Value
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Shoot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTaint.
-func (in *SeedTaint) DeepCopy() *SeedTaint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootList.
+func (in *ShootList) DeepCopy() *ShootList {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedTaint)
+	out := new(ShootList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedTemplate) DeepCopyInto(out *SeedTemplate) {
-	*out = *in
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedTemplate.
-func (in *SeedTemplate) DeepCopy() *SeedTemplate {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(SeedTemplate)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedVolume) DeepCopyInto(out *SeedVolume) {
+func (in *ShootMachineImage) DeepCopyInto(out *ShootMachineImage) {
 	*out = *in
-	if in.MinimumSize != nil {
-		in, out := &in.MinimumSize, &out.MinimumSize
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.Providers != nil {
-		in, out := &in.Providers, &out.Providers
-		*out = make([]SeedVolumeProvider, len(*in))
-		copy(*out, *in)
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedVolume.
-func (in *SeedVolume) DeepCopy() *SeedVolume {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMachineImage.
+func (in *ShootMachineImage) DeepCopy() *ShootMachineImage {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedVolume)
+	out := new(ShootMachineImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedVolumeProvider) DeepCopyInto(out *SeedVolumeProvider) {
+func (in *ShootMaintenanceStatus) DeepCopyInto(out *ShootMaintenanceStatus) {
 	*out = *in
+	if in.PlannedChanges != nil {
+		in, out := &in.PlannedChanges, &out.PlannedChanges
+		*out = make([]MaintenancePlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.RunningVersions != nil {
+		in, out := &in.RunningVersions, &out.RunningVersions
+		*out = new(ShootRunningVersions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedVolumeProvider.
-func (in *SeedVolumeProvider) DeepCopy() *SeedVolumeProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMaintenanceStatus.
+func (in *ShootMaintenanceStatus) DeepCopy() *ShootMaintenanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedVolumeProvider)
+	out := new(ShootMaintenanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
+func (in *ShootNetworks) DeepCopyInto(out *ShootNetworks) {
 	*out = *in
-	if in.Issuer != nil {
-		in, out := &in.Issuer, &out.Issuer
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
 		*out = new(string)
 		**out = **in
 	}
-	if in.ExtendTokenExpiration != nil {
-		in, out := &in.ExtendTokenExpiration, &out.ExtendTokenExpiration
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MaxTokenExpiration != nil {
-		in, out := &in.MaxTokenExpiration, &out.MaxTokenExpiration
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.AcceptedIssuers != nil {
-		in, out := &in.AcceptedIssuers, &out.AcceptedIssuers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountConfig.
-func (in *ServiceAccountConfig) DeepCopy() *ServiceAccountConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootNetworks.
+func (in *ShootNetworks) DeepCopy() *ShootNetworks {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountConfig)
+	out := new(ShootNetworks)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountKeyRotation) DeepCopyInto(out *ServiceAccountKeyRotation) {
+func (in *ShootProfile) DeepCopyInto(out *ShootProfile) {
 	*out = *in
-	if in.LastInitiationTime != nil {
-		in, out := &in.LastInitiationTime, &out.LastInitiationTime
-		*out = (*in).DeepCopy()
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(ShootPurpose)
+		**out = **in
 	}
-	if in.LastInitiationFinishedTime != nil {
-		in, out := &in.LastInitiationFinishedTime, &out.LastInitiationFinishedTime
-		*out = (*in).DeepCopy()
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ControlPlane)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LastCompletionTime != nil {
-		in, out := &in.LastCompletionTime, &out.LastCompletionTime
-		*out = (*in).DeepCopy()
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(Networking)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.LastCompletionTriggeredTime != nil {
-		in, out := &in.LastCompletionTriggeredTime, &out.LastCompletionTriggeredTime
-		*out = (*in).DeepCopy()
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(Maintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]WorkerProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyRotation.
-func (in *ServiceAccountKeyRotation) DeepCopy() *ServiceAccountKeyRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootProfile.
+func (in *ShootProfile) DeepCopy() *ShootProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountKeyRotation)
+	out := new(ShootProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Shoot) DeepCopyInto(out *Shoot) {
+func (in *ShootReadinessGate) DeepCopyInto(out *ShootReadinessGate) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(ShootReadinessGateHTTPGet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomResourceDefinition != nil {
+		in, out := &in.CustomResourceDefinition, &out.CustomResourceDefinition
+		*out = new(ShootReadinessGateCustomResourceDefinition)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Shoot.
-func (in *Shoot) DeepCopy() *Shoot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReadinessGate.
+func (in *ShootReadinessGate) DeepCopy() *ShootReadinessGate {
 	if in == nil {
 		return nil
 	}
-	out := new(Shoot)
+	out := new(ShootReadinessGate)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Shoot) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootAdvertisedAddress) DeepCopyInto(out *ShootAdvertisedAddress) {
+func (in *ShootReadinessGateCustomResourceDefinition) DeepCopyInto(out *ShootReadinessGateCustomResourceDefinition) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAdvertisedAddress.
-func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReadinessGateCustomResourceDefinition.
+func (in *ShootReadinessGateCustomResourceDefinition) DeepCopy() *ShootReadinessGateCustomResourceDefinition {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootAdvertisedAddress)
+	out := new(ShootReadinessGateCustomResourceDefinition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
+func (in *ShootReadinessGateHTTPGet) DeepCopyInto(out *ShootReadinessGateHTTPGet) {
 	*out = *in
-	if in.Rotation != nil {
-		in, out := &in.Rotation, &out.Rotation
-		*out = new(ShootCredentialsRotation)
-		(*in).DeepCopyInto(*out)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentials.
-func (in *ShootCredentials) DeepCopy() *ShootCredentials {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReadinessGateHTTPGet.
+func (in *ShootReadinessGateHTTPGet) DeepCopy() *ShootReadinessGateHTTPGet {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootCredentials)
+	out := new(ShootReadinessGateHTTPGet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootCredentialsRotation) DeepCopyInto(out *ShootCredentialsRotation) {
+func (in *ShootReconciliation) DeepCopyInto(out *ShootReconciliation) {
 	*out = *in
-	if in.CertificateAuthorities != nil {
-		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
-		*out = new(CARotation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Kubeconfig != nil {
-		in, out := &in.Kubeconfig, &out.Kubeconfig
-		*out = new(ShootKubeconfigRotation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SSHKeypair != nil {
-		in, out := &in.SSHKeypair, &out.SSHKeypair
-		*out = new(ShootSSHKeypairRotation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Observability != nil {
-		in, out := &in.Observability, &out.Observability
-		*out = new(ObservabilityRotation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServiceAccountKey != nil {
-		in, out := &in.ServiceAccountKey, &out.ServiceAccountKey
-		*out = new(ServiceAccountKeyRotation)
-		(*in).DeepCopyInto(*out)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
 	}
-	if in.ETCDEncryptionKey != nil {
-		in, out := &in.ETCDEncryptionKey, &out.ETCDEncryptionKey
-		*out = new(ETCDEncryptionKeyRotation)
-		(*in).DeepCopyInto(*out)
+	if in.PausedUntil != nil {
+		in, out := &in.PausedUntil, &out.PausedUntil
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCredentialsRotation.
-func (in *ShootCredentialsRotation) DeepCopy() *ShootCredentialsRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReconciliation.
+func (in *ShootReconciliation) DeepCopy() *ShootReconciliation {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootCredentialsRotation)
+	out := new(ShootReconciliation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootKubeconfigRotation) DeepCopyInto(out *ShootKubeconfigRotation) {
+func (in *ShootRevision) DeepCopyInto(out *ShootRevision) {
 	*out = *in
-	if in.LastInitiationTime != nil {
-		in, out := &in.LastInitiationTime, &out.LastInitiationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LastCompletionTime != nil {
-		in, out := &in.LastCompletionTime, &out.LastCompletionTime
-		*out = (*in).DeepCopy()
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]ShootSpecChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootKubeconfigRotation.
-func (in *ShootKubeconfigRotation) DeepCopy() *ShootKubeconfigRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRevision.
+func (in *ShootRevision) DeepCopy() *ShootRevision {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootKubeconfigRotation)
+	out := new(ShootRevision)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootList) DeepCopyInto(out *ShootList) {
+func (in *ShootRevisionList) DeepCopyInto(out *ShootRevisionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Shoot, len(*in))
+		*out = make([]ShootRevision, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -4826,18 +6846,18 @@ func (in *ShootList) DeepCopyInto(out *ShootList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootList.
-func (in *ShootList) DeepCopy() *ShootList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRevisionList.
+func (in *ShootRevisionList) DeepCopy() *ShootRevisionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootList)
+	out := new(ShootRevisionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ShootList) DeepCopyObject() runtime.Object {
+func (in *ShootRevisionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -4845,72 +6865,84 @@ func (in *ShootList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootMachineImage) DeepCopyInto(out *ShootMachineImage) {
+func (in *ShootRunningVersions) DeepCopyInto(out *ShootRunningVersions) {
 	*out = *in
-	if in.ProviderConfig != nil {
-		in, out := &in.ProviderConfig, &out.ProviderConfig
-		*out = new(runtime.RawExtension)
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(RunningVersion)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImageRunningVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMachineImage.
-func (in *ShootMachineImage) DeepCopy() *ShootMachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRunningVersions.
+func (in *ShootRunningVersions) DeepCopy() *ShootRunningVersions {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootMachineImage)
+	out := new(ShootRunningVersions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootNetworks) DeepCopyInto(out *ShootNetworks) {
+func (in *ShootSSHKeypairRotation) DeepCopyInto(out *ShootSSHKeypairRotation) {
 	*out = *in
-	if in.Pods != nil {
-		in, out := &in.Pods, &out.Pods
-		*out = new(string)
-		**out = **in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Services != nil {
-		in, out := &in.Services, &out.Services
-		*out = new(string)
-		**out = **in
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootNetworks.
-func (in *ShootNetworks) DeepCopy() *ShootNetworks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSSHKeypairRotation.
+func (in *ShootSSHKeypairRotation) DeepCopy() *ShootSSHKeypairRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootNetworks)
+	out := new(ShootSSHKeypairRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootSSHKeypairRotation) DeepCopyInto(out *ShootSSHKeypairRotation) {
+func (in *ShootSecurityAdvisory) DeepCopyInto(out *ShootSecurityAdvisory) {
 	*out = *in
-	if in.LastInitiationTime != nil {
-		in, out := &in.LastInitiationTime, &out.LastInitiationTime
-		*out = (*in).DeepCopy()
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(string)
+		**out = **in
 	}
-	if in.LastCompletionTime != nil {
-		in, out := &in.LastCompletionTime, &out.LastCompletionTime
-		*out = (*in).DeepCopy()
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSSHKeypairRotation.
-func (in *ShootSSHKeypairRotation) DeepCopy() *ShootSSHKeypairRotation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSecurityAdvisory.
+func (in *ShootSecurityAdvisory) DeepCopy() *ShootSecurityAdvisory {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootSSHKeypairRotation)
+	out := new(ShootSecurityAdvisory)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5014,6 +7046,23 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(CloudProfileReference)
 		**out = **in
 	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(Observability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]ShootReadinessGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reconciliation != nil {
+		in, out := &in.Reconciliation, &out.Reconciliation
+		*out = new(ShootReconciliation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5027,6 +7076,23 @@ func (in *ShootSpec) DeepCopy() *ShootSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSpecChange) DeepCopyInto(out *ShootSpecChange) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSpecChange.
+func (in *ShootSpecChange) DeepCopy() *ShootSpecChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSpecChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootState) DeepCopyInto(out *ShootState) {
 	*out = *in
@@ -5181,21 +7247,60 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		in, out := &in.MigrationStartTime, &out.MigrationStartTime
 		*out = (*in).DeepCopy()
 	}
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = new(ShootCredentials)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(ShootCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastMaintenance != nil {
+		in, out := &in.LastMaintenance, &out.LastMaintenance
+		*out = new(LastMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EncryptedResources != nil {
+		in, out := &in.EncryptedResources, &out.EncryptedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(ShootAvailability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeftoverResources != nil {
+		in, out := &in.LeftoverResources, &out.LeftoverResources
+		*out = new(LeftoverResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCD != nil {
+		in, out := &in.ETCD, &out.ETCD
+		*out = new(ShootETCDStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkersRollout != nil {
+		in, out := &in.WorkersRollout, &out.WorkersRollout
+		*out = make([]WorkerPoolRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityAdvisories != nil {
+		in, out := &in.SecurityAdvisories, &out.SecurityAdvisories
+		*out = make([]ShootSecurityAdvisory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ShootMaintenanceStatus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LastMaintenance != nil {
-		in, out := &in.LastMaintenance, &out.LastMaintenance
-		*out = new(LastMaintenance)
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(NetworkingStatus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.EncryptedResources != nil {
-		in, out := &in.EncryptedResources, &out.EncryptedResources
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
@@ -5227,6 +7332,27 @@ func (in *ShootTemplate) DeepCopy() *ShootTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackAlertReceiver) DeepCopyInto(out *SlackAlertReceiver) {
+	*out = *in
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackAlertReceiver.
+func (in *SlackAlertReceiver) DeepCopy() *SlackAlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackAlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 	*out = *in
@@ -5240,6 +7366,13 @@ func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 		*out = new(NodeLocalDNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]SystemComponentsPriorityClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5253,6 +7386,27 @@ func (in *SystemComponents) DeepCopy() *SystemComponents {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemComponentsPriorityClass) DeepCopyInto(out *SystemComponentsPriorityClass) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemComponentsPriorityClass.
+func (in *SystemComponentsPriorityClass) DeepCopy() *SystemComponentsPriorityClass {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemComponentsPriorityClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Toleration) DeepCopyInto(out *Toleration) {
 	*out = *in
@@ -5274,6 +7428,92 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionPolicy) DeepCopyInto(out *VersionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionPolicy.
+func (in *VersionPolicy) DeepCopy() *VersionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VersionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionPolicyList) DeepCopyInto(out *VersionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VersionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionPolicyList.
+func (in *VersionPolicyList) DeepCopy() *VersionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VersionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionPolicySpec) DeepCopyInto(out *VersionPolicySpec) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesVersionPolicy)
+		**out = **in
+	}
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]MachineImageVersionPolicy, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionPolicySpec.
+func (in *VersionPolicySpec) DeepCopy() *VersionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -5361,6 +7601,27 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRequirements) DeepCopyInto(out *VolumeRequirements) {
+	*out = *in
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRequirements.
+func (in *VolumeRequirements) DeepCopy() *VolumeRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 	*out = *in
@@ -5374,6 +7635,11 @@ func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MonthlyPricePerGi != nil {
+		in, out := &in.MonthlyPricePerGi, &out.MonthlyPricePerGi
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -5415,6 +7681,22 @@ func (in *WatchCacheSizes) DeepCopy() *WatchCacheSizes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookAlertReceiver) DeepCopyInto(out *WebhookAlertReceiver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookAlertReceiver.
+func (in *WebhookAlertReceiver) DeepCopy() *WebhookAlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookAlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Worker) DeepCopyInto(out *Worker) {
 	*out = *in
@@ -5514,6 +7796,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Confidential != nil {
+		in, out := &in.Confidential, &out.Confidential
+		*out = new(WorkerConfidential)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5527,6 +7814,64 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerConfidential) DeepCopyInto(out *WorkerConfidential) {
+	*out = *in
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(WorkerConfidentialAttestation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerConfidential.
+func (in *WorkerConfidential) DeepCopy() *WorkerConfidential {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerConfidential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerConfidentialAttestation) DeepCopyInto(out *WorkerConfidentialAttestation) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerConfidentialAttestation.
+func (in *WorkerConfidentialAttestation) DeepCopy() *WorkerConfidentialAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerConfidentialAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerCostEstimate) DeepCopyInto(out *WorkerCostEstimate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerCostEstimate.
+func (in *WorkerCostEstimate) DeepCopy() *WorkerCostEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerCostEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 	*out = *in
@@ -5553,6 +7898,78 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolRollout) DeepCopyInto(out *WorkerPoolRollout) {
+	*out = *in
+	if in.CurrentMachineImage != nil {
+		in, out := &in.CurrentMachineImage, &out.CurrentMachineImage
+		*out = new(ShootMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetMachineImage != nil {
+		in, out := &in.TargetMachineImage, &out.TargetMachineImage
+		*out = new(ShootMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CurrentKubeletVersion != nil {
+		in, out := &in.CurrentKubeletVersion, &out.CurrentKubeletVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetKubeletVersion != nil {
+		in, out := &in.TargetKubeletVersion, &out.TargetKubeletVersion
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolRollout.
+func (in *WorkerPoolRollout) DeepCopy() *WorkerPoolRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerProfile) DeepCopyInto(out *WorkerProfile) {
+	*out = *in
+	in.Machine.DeepCopyInto(&out.Machine)
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(VolumeRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerProfile.
+func (in *WorkerProfile) DeepCopy() *WorkerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
@@ -5577,6 +7994,16 @@ func (in *WorkersSettings) DeepCopyInto(out *WorkersSettings) {
 		*out = new(SSHAccess)
 		**out = **in
 	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(ContainerdSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressOnlyInternetAccess != nil {
+		in, out := &in.EgressOnlyInternetAccess, &out.EgressOnlyInternetAccess
+		*out = new(EgressOnlyInternetAccess)
+		**out = **in
+	}
 	return
 }
 
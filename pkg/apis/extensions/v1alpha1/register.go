@@ -57,6 +57,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkList{},
 		&OperatingSystemConfig{},
 		&OperatingSystemConfigList{},
+		&PrivateLink{},
+		&PrivateLinkList{},
 		&Worker{},
 		&WorkerList{},
 	)
@@ -79,6 +79,17 @@ type DNSRecordSpec struct {
 	// TTL is the time to live in seconds. Defaults to 120.
 	// +optional
 	TTL *int64 `json:"ttl,omitempty"`
+	// HealthProbe declares a health probe for the endpoint the DNS record points to. If specified, gardenlet verifies
+	// that the name resolves to the desired values and that the endpoint answers before considering the DNS record
+	// ready. Extension controllers do not need to act on this field. Only supported for A, AAAA, and CNAME records.
+	// +optional
+	HealthProbe *DNSRecordHealthProbe `json:"healthProbe,omitempty"`
+}
+
+// DNSRecordHealthProbe contains the configuration of the health probe for the endpoint a DNS record points to.
+type DNSRecordHealthProbe struct {
+	// Port is the TCP port of the endpoint which must accept connections.
+	Port int32 `json:"port"`
 }
 
 // DNSRecordStatus is the status of a DNSRecord resource.
@@ -68,6 +68,14 @@ type InfrastructureSpec struct {
 	// SSHPublicKey is the public SSH key that should be used with this infrastructure.
 	// +optional
 	SSHPublicKey []byte `json:"sshPublicKey,omitempty"`
+	// ResourceTags is a map of key/value pairs which should be added as tags (or labels, depending on the provider) to
+	// all cloud resources created for this infrastructure.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty"`
+	// EgressOnlyInternetAccess indicates that the worker nodes must be provisioned without public IPs. The extension
+	// controller is responsible for setting up NAT so that the worker nodes can still reach the internet.
+	// +optional
+	EgressOnlyInternetAccess bool `json:"egressOnlyInternetAccess,omitempty"`
 }
 
 // InfrastructureStatus is the status for an Infrastructure resource.
@@ -83,4 +91,9 @@ type InfrastructureStatus struct {
 	// IPs may not be stable in which case the extension controller may opt to not populate this field.
 	// +optional
 	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
+	// EgressOnlyInternetAccess reports whether the extension controller honors the egress-only internet access requested
+	// in the spec. It must be set to true if the worker nodes are provisioned without public IPs and reach the internet
+	// via NAT, and to false if the extension controller cannot honor the request.
+	// +optional
+	EgressOnlyInternetAccess *bool `json:"egressOnlyInternetAccess,omitempty"`
 }
@@ -69,6 +69,15 @@ type NetworkSpec struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/ipv6.md
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
+	// NodeCIDRs defines the CIDRs of the node network, i.e., the primary node network followed by additional node
+	// networks of the shoot.
+	// +optional
+	NodeCIDRs []string `json:"nodeCIDRs,omitempty"`
+	// PathMTU is the smallest maximum transmission unit of the network paths between the seed and the worker nodes of
+	// the shoot as discovered by gardener-node-agent. Extensions should configure the MTU of the pod network such that
+	// packets including the encapsulation overhead do not exceed it.
+	// +optional
+	PathMTU *int32 `json:"pathMTU,omitempty"`
 }
 
 // NetworkStatus is the status for an Network resource.
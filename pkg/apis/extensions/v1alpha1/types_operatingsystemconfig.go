@@ -228,6 +228,30 @@ type OperatingSystemConfigStatus struct {
 	// TODO(rfranzke): Remove this field after v1.95 got released.
 	// +optional
 	Files []string `json:"files,omitempty"`
+	// MachineImage contains metadata about the machine image the operating system config is applied to, e.g., the
+	// versions of the packages installed on it. It is used to determine whether the worker nodes are affected by known
+	// vulnerabilities.
+	// +optional
+	MachineImage *OperatingSystemMachineImage `json:"machineImage,omitempty"`
+}
+
+// OperatingSystemMachineImage contains metadata about a machine image.
+type OperatingSystemMachineImage struct {
+	// Name is the name of the machine image.
+	Name string `json:"name"`
+	// Version is the version of the machine image.
+	Version string `json:"version"`
+	// Packages is a list of packages installed on the machine image.
+	// +optional
+	Packages []OperatingSystemPackage `json:"packages,omitempty"`
+}
+
+// OperatingSystemPackage contains information about a package installed on a machine image.
+type OperatingSystemPackage struct {
+	// Name is the name of the package.
+	Name string `json:"name"`
+	// Version is the version of the package.
+	Version string `json:"version"`
 }
 
 // CloudConfig contains the generated output for the given operating system
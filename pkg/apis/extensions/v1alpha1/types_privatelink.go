@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ Object = (*PrivateLink)(nil)
+
+// PrivateLinkResource is a constant for the name of the PrivateLink resource.
+const PrivateLinkResource = "PrivateLink"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,path=privatelinks,singular=privatelink
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Type,JSONPath=".spec.type",type=string,description="The type of the private link provider."
+// +kubebuilder:printcolumn:name=Address,JSONPath=".status.address",type=string,description="The address of the private endpoint in the shoot network."
+// +kubebuilder:printcolumn:name=Status,JSONPath=".status.lastOperation.state",type=string,description="Status of private link resource."
+// +kubebuilder:printcolumn:name=Age,JSONPath=".metadata.creationTimestamp",type=date,description="creation timestamp"
+
+// PrivateLink is a specification for private connectivity between the network of the shoot nodes and the ingress of
+// the seed cluster hosting the shoot's control plane, e.g., based on private link or private endpoint services of the
+// infrastructure provider.
+type PrivateLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the PrivateLink.
+	// If the object's deletion timestamp is set, this field is immutable.
+	Spec PrivateLinkSpec `json:"spec"`
+	// +optional
+	Status PrivateLinkStatus `json:"status"`
+}
+
+// GetExtensionSpec implements Object.
+func (p *PrivateLink) GetExtensionSpec() Spec {
+	return &p.Spec
+}
+
+// GetExtensionStatus implements Object.
+func (p *PrivateLink) GetExtensionStatus() Status {
+	return &p.Status
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrivateLinkList is a list of PrivateLink resources.
+type PrivateLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items is the list of PrivateLinks.
+	Items []PrivateLink `json:"items"`
+}
+
+// PrivateLinkSpec is the spec of a PrivateLink resource.
+type PrivateLinkSpec struct {
+	// DefaultSpec is a structure containing common fields used by all extension resources.
+	DefaultSpec `json:",inline"`
+	// Region is the region of the shoot. This field is immutable.
+	Region string `json:"region"`
+	// SecretRef is a reference to a secret that contains the cloud provider specific credentials.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+}
+
+// PrivateLinkStatus is the status of a PrivateLink resource.
+type PrivateLinkStatus struct {
+	// DefaultStatus is a structure containing common fields used by all extension resources.
+	DefaultStatus `json:",inline"`
+	// Address is the IP address or hostname of the private endpoint in the shoot network through which the ingress of
+	// the seed, and thus the kube-apiserver of the shoot, can be reached.
+	// +optional
+	Address *string `json:"address,omitempty"`
+}
@@ -96,6 +96,10 @@ type WorkerSpec struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	Pools []WorkerPool `json:"pools" patchStrategy:"merge" patchMergeKey:"name"`
+	// ResourceTags is a map of key/value pairs which should be added as tags (or labels, depending on the provider) to
+	// all cloud resources created for these workers, e.g., machines and disks.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty"`
 }
 
 // WorkerPool is the definition of a specific worker pool.
@@ -169,6 +173,10 @@ type WorkerPool struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty"`
+	// Confidential contains settings for running the machines of this worker pool as confidential VMs. If set, the
+	// provider must create confidential machines and configure their remote attestation accordingly.
+	// +optional
+	Confidential *gardencorev1beta1.WorkerConfidential `json:"confidential,omitempty"`
 }
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
@@ -247,6 +255,33 @@ type WorkerStatus struct {
 	// MachineDeploymentsLastUpdateTime is the timestamp when the status.MachineDeployments slice was last updated.
 	// +optional
 	MachineDeploymentsLastUpdateTime *metav1.Time `json:"machineDeploymentsLastUpdateTime,omitempty"`
+	// WorkersRollout contains information about the rollout progress of the worker pools.
+	// +optional
+	WorkersRollout []WorkerPoolRollout `json:"workersRollout,omitempty"`
+}
+
+// WorkerPoolRollout contains information about the rollout progress of a worker pool.
+type WorkerPoolRollout struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// MachinesUpdated is the number of machines which already run with the target configuration.
+	MachinesUpdated int32 `json:"machinesUpdated"`
+	// MachinesPending is the number of machines which still have to be updated to the target configuration.
+	MachinesPending int32 `json:"machinesPending"`
+	// MachinesFailed is the number of machines which failed.
+	MachinesFailed int32 `json:"machinesFailed"`
+	// CurrentMachineImage is the machine image of the last completed rollout of the worker pool.
+	// +optional
+	CurrentMachineImage *MachineImage `json:"currentMachineImage,omitempty"`
+	// TargetMachineImage is the machine image the machines of the worker pool are updated to.
+	// +optional
+	TargetMachineImage *MachineImage `json:"targetMachineImage,omitempty"`
+	// CurrentKubeletVersion is the kubelet version of the last completed rollout of the worker pool.
+	// +optional
+	CurrentKubeletVersion *string `json:"currentKubeletVersion,omitempty"`
+	// TargetKubeletVersion is the kubelet version the machines of the worker pool are updated to.
+	// +optional
+	TargetKubeletVersion *string `json:"targetKubeletVersion,omitempty"`
 }
 
 // MachineDeployment is a created machine deployment.
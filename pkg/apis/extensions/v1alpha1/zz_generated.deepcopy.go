@@ -747,6 +747,22 @@ func (in *DNSRecord) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordHealthProbe) DeepCopyInto(out *DNSRecordHealthProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordHealthProbe.
+func (in *DNSRecordHealthProbe) DeepCopy() *DNSRecordHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSRecordList) DeepCopyInto(out *DNSRecordList) {
 	*out = *in
@@ -805,6 +821,11 @@ func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(DNSRecordHealthProbe)
+		**out = **in
+	}
 	return
 }
 
@@ -1223,6 +1244,13 @@ func (in *InfrastructureSpec) DeepCopyInto(out *InfrastructureSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1250,6 +1278,11 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EgressOnlyInternetAccess != nil {
+		in, out := &in.EgressOnlyInternetAccess, &out.EgressOnlyInternetAccess
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1365,6 +1398,16 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = make([]IPFamily, len(*in))
 		copy(*out, *in)
 	}
+	if in.NodeCIDRs != nil {
+		in, out := &in.NodeCIDRs, &out.NodeCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PathMTU != nil {
+		in, out := &in.PathMTU, &out.PathMTU
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1558,6 +1601,11 @@ func (in *OperatingSystemConfigStatus) DeepCopyInto(out *OperatingSystemConfigSt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		*out = new(OperatingSystemMachineImage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1571,6 +1619,144 @@ func (in *OperatingSystemConfigStatus) DeepCopy() *OperatingSystemConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemMachineImage) DeepCopyInto(out *OperatingSystemMachineImage) {
+	*out = *in
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]OperatingSystemPackage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemMachineImage.
+func (in *OperatingSystemMachineImage) DeepCopy() *OperatingSystemMachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemMachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemPackage) DeepCopyInto(out *OperatingSystemPackage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemPackage.
+func (in *OperatingSystemPackage) DeepCopy() *OperatingSystemPackage {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemPackage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLink) DeepCopyInto(out *PrivateLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLink.
+func (in *PrivateLink) DeepCopy() *PrivateLink {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrivateLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkList) DeepCopyInto(out *PrivateLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrivateLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkList.
+func (in *PrivateLinkList) DeepCopy() *PrivateLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrivateLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkSpec) DeepCopyInto(out *PrivateLinkSpec) {
+	*out = *in
+	in.DefaultSpec.DeepCopyInto(&out.DefaultSpec)
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkSpec.
+func (in *PrivateLinkSpec) DeepCopy() *PrivateLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkStatus) DeepCopyInto(out *PrivateLinkStatus) {
+	*out = *in
+	in.DefaultStatus.DeepCopyInto(&out.DefaultStatus)
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkStatus.
+func (in *PrivateLinkStatus) DeepCopy() *PrivateLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Unit) DeepCopyInto(out *Unit) {
 	*out = *in
@@ -1793,6 +1979,11 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Confidential != nil {
+		in, out := &in.Confidential, &out.Confidential
+		*out = new(v1beta1.WorkerConfidential)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1806,6 +1997,42 @@ func (in *WorkerPool) DeepCopy() *WorkerPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolRollout) DeepCopyInto(out *WorkerPoolRollout) {
+	*out = *in
+	if in.CurrentMachineImage != nil {
+		in, out := &in.CurrentMachineImage, &out.CurrentMachineImage
+		*out = new(MachineImage)
+		**out = **in
+	}
+	if in.TargetMachineImage != nil {
+		in, out := &in.TargetMachineImage, &out.TargetMachineImage
+		*out = new(MachineImage)
+		**out = **in
+	}
+	if in.CurrentKubeletVersion != nil {
+		in, out := &in.CurrentKubeletVersion, &out.CurrentKubeletVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetKubeletVersion != nil {
+		in, out := &in.TargetKubeletVersion, &out.TargetKubeletVersion
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolRollout.
+func (in *WorkerPoolRollout) DeepCopy() *WorkerPoolRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 	*out = *in
@@ -1828,6 +2055,13 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceTags != nil {
+		in, out := &in.ResourceTags, &out.ResourceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1854,6 +2088,13 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 		in, out := &in.MachineDeploymentsLastUpdateTime, &out.MachineDeploymentsLastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.WorkersRollout != nil {
+		in, out := &in.WorkersRollout, &out.WorkersRollout
+		*out = make([]WorkerPoolRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
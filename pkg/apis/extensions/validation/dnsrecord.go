@@ -87,6 +87,15 @@ func ValidateDNSRecordSpec(spec *extensionsv1alpha1.DNSRecordSpec, fldPath *fiel
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*spec.TTL, fldPath.Child("ttl"))...)
 	}
 
+	if spec.HealthProbe != nil {
+		if spec.RecordType == extensionsv1alpha1.DNSRecordTypeTXT {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "health probes are not supported for TXT records"))
+		}
+		for _, msg := range validation.IsValidPortNum(int(spec.HealthProbe.Port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("healthProbe", "port"), spec.HealthProbe.Port, msg))
+		}
+	}
+
 	return allErrs
 }
 
@@ -163,6 +163,38 @@ var _ = Describe("DNSRecord validation tests", func() {
 			}))))
 		})
 
+		It("should forbid health probes with invalid ports", func() {
+			dns.Spec.HealthProbe = &extensionsv1alpha1.DNSRecordHealthProbe{Port: 0}
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.healthProbe.port"),
+			}))))
+		})
+
+		It("should forbid health probes for TXT records", func() {
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeTXT
+			dns.Spec.Values = []string{"can be anything"}
+			dns.Spec.HealthProbe = &extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.healthProbe"),
+			}))))
+		})
+
+		It("should allow valid resources with health probes", func() {
+			dns.Spec.HealthProbe = &extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}
+
+			errorList := ValidateDNSRecord(dns)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
 		It("should allow valid resources (type A)", func() {
 			errorList := ValidateDNSRecord(dns)
 
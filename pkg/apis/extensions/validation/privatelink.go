@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"github.com/go-test/deep"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ValidatePrivateLink validates a PrivateLink object.
+func ValidatePrivateLink(privateLink *extensionsv1alpha1.PrivateLink) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&privateLink.ObjectMeta, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidatePrivateLinkSpec(&privateLink.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidatePrivateLinkUpdate validates a PrivateLink object before an update.
+func ValidatePrivateLinkUpdate(new, old *extensionsv1alpha1.PrivateLink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidatePrivateLinkSpecUpdate(&new.Spec, &old.Spec, new.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidatePrivateLink(new)...)
+
+	return allErrs
+}
+
+// ValidatePrivateLinkSpec validates the specification of a PrivateLink object.
+func ValidatePrivateLinkSpec(spec *extensionsv1alpha1.PrivateLinkSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "field is required"))
+	}
+
+	if len(spec.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "field is required"))
+	}
+
+	if len(spec.SecretRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "field is required"))
+	}
+
+	return allErrs
+}
+
+// ValidatePrivateLinkSpecUpdate validates the spec of a PrivateLink object before an update.
+func ValidatePrivateLinkSpecUpdate(new, old *extensionsv1alpha1.PrivateLinkSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if deletionTimestampSet && !apiequality.Semantic.DeepEqual(new, old) {
+		if diff := deep.Equal(new, old); diff != nil {
+			return field.ErrorList{field.Forbidden(fldPath, strings.Join(diff, ","))}
+		}
+		return apivalidation.ValidateImmutableField(new, old, fldPath)
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Region, old.Region, fldPath.Child("region"))...)
+
+	return allErrs
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
+)
+
+var _ = Describe("PrivateLink validation tests", func() {
+	var privateLink *extensionsv1alpha1.PrivateLink
+
+	BeforeEach(func() {
+		privateLink = &extensionsv1alpha1.PrivateLink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-privatelink",
+				Namespace: "test-namespace",
+			},
+			Spec: extensionsv1alpha1.PrivateLinkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "provider",
+					ProviderConfig: &runtime.RawExtension{},
+				},
+				Region: "region",
+				SecretRef: corev1.SecretReference{
+					Name: "test",
+				},
+			},
+		}
+	})
+
+	Describe("#ValidPrivateLink", func() {
+		It("should forbid empty PrivateLink resources", func() {
+			errorList := ValidatePrivateLink(&extensionsv1alpha1.PrivateLink{})
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.namespace"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.region"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.secretRef.name"),
+			}))))
+		})
+
+		It("should allow valid private link resources", func() {
+			errorList := ValidatePrivateLink(privateLink)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidPrivateLinkUpdate", func() {
+		It("should prevent updating anything if deletion time stamp is set", func() {
+			now := metav1.Now()
+			privateLink.DeletionTimestamp = &now
+
+			newPrivateLink := preparePrivateLinkForUpdate(privateLink)
+			newPrivateLink.DeletionTimestamp = &now
+			newPrivateLink.Spec.SecretRef.Name = "changed-secretref-name"
+
+			errorList := ValidatePrivateLinkUpdate(newPrivateLink, privateLink)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("spec"),
+				"Detail": Equal("SecretRef.Name: changed-secretref-name != test"),
+			}))))
+		})
+
+		It("should prevent updating the type and region", func() {
+			newPrivateLink := preparePrivateLinkForUpdate(privateLink)
+			newPrivateLink.Spec.Type = "changed-type"
+			newPrivateLink.Spec.Region = "changed-region"
+
+			errorList := ValidatePrivateLinkUpdate(newPrivateLink, privateLink)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.region"),
+			}))))
+		})
+
+		It("should allow updating the name of the referenced secret or the provider config", func() {
+			newPrivateLink := preparePrivateLinkForUpdate(privateLink)
+			newPrivateLink.Spec.SecretRef.Name = "changed-secretref-name"
+			newPrivateLink.Spec.ProviderConfig = nil
+
+			errorList := ValidatePrivateLinkUpdate(newPrivateLink, privateLink)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+})
+
+func preparePrivateLinkForUpdate(obj *extensionsv1alpha1.PrivateLink) *extensionsv1alpha1.PrivateLink {
+	newObj := obj.DeepCopy()
+	newObj.ResourceVersion = "1"
+	return newObj
+}
@@ -13,4 +13,10 @@ const (
 	// BastionShootName is the field selector path for finding
 	// the Shoot name of a operations.gardener.cloud/v1alpha1 Bastion.
 	BastionShootName = "spec.shootRef.name"
+	// AccessRequestShootName is the field selector path for finding
+	// the Shoot name of a operations.gardener.cloud/v1alpha1 AccessRequest.
+	AccessRequestShootName = "spec.shootRef.name"
+	// SSHAccessRequestShootName is the field selector path for finding
+	// the Shoot name of a operations.gardener.cloud/v1alpha1 SSHAccessRequest.
+	SSHAccessRequestShootName = "spec.shootRef.name"
 )
@@ -37,6 +37,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&AccessRequest{},
+		&AccessRequestList{},
+		&SSHAccessRequest{},
+		&SSHAccessRequestList{},
+		&BulkOperation{},
+		&BulkOperationList{},
 	)
 
 	return nil
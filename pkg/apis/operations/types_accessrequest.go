@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest holds details about a request for time-bound admin access to a shoot cluster.
+type AccessRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Specification of the AccessRequest.
+	Spec AccessRequestSpec
+	// Most recently observed status of the AccessRequest.
+	Status AccessRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is a list of AccessRequest objects.
+type AccessRequestList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of AccessRequest.
+	Items []AccessRequest
+}
+
+// AccessRequestSpec is the specification of an AccessRequest. It is immutable.
+type AccessRequestSpec struct {
+	// ShootRef defines the target shoot for an AccessRequest.
+	ShootRef corev1.LocalObjectReference
+	// Duration is the requested duration of the access. It must be between 10 minutes and 24 hours.
+	Duration metav1.Duration
+	// Reason is a human-readable justification for the access request.
+	Reason string
+	// Requester is the name of the user who created the AccessRequest. It is set by the API server.
+	Requester string
+}
+
+// AccessRequestStatus holds the most recently observed status of the AccessRequest.
+type AccessRequestStatus struct {
+	// Phase is the current phase of the AccessRequest.
+	Phase AccessRequestPhase
+	// Approval contains the decision about the AccessRequest. It can only be set via the `approval` subresource.
+	Approval *AccessRequestApproval
+	// KubeconfigSecretName is the name of the secret in the project namespace which contains the kubeconfig for the
+	// granted access. Only the requester is allowed to read it.
+	KubeconfigSecretName *string
+	// GrantedTimestamp is the time when the access was granted.
+	GrantedTimestamp *metav1.Time
+	// ExpirationTimestamp is the time when the granted access expires and is revoked.
+	ExpirationTimestamp *metav1.Time
+}
+
+// AccessRequestApproval contains the decision about an AccessRequest.
+type AccessRequestApproval struct {
+	// Decision is the decision about the AccessRequest.
+	Decision AccessRequestDecision
+	// Message is an optional human-readable comment on the decision.
+	Message *string
+	// DecidedBy is the name of the user who decided about the AccessRequest. It is set by the API server.
+	DecidedBy string
+	// DecisionTimestamp is the time of the decision. It is set by the API server.
+	DecisionTimestamp *metav1.Time
+}
+
+// AccessRequestDecision is a decision about an AccessRequest.
+type AccessRequestDecision string
+
+const (
+	// AccessRequestApproved indicates that the AccessRequest was approved.
+	AccessRequestApproved AccessRequestDecision = "Approved"
+	// AccessRequestDenied indicates that the AccessRequest was denied.
+	AccessRequestDenied AccessRequestDecision = "Denied"
+)
+
+// AccessRequestPhase is a label for the condition of an AccessRequest at the current time.
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending indicates that the AccessRequest awaits a decision.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestGranted indicates that the access was granted and the kubeconfig is available.
+	AccessRequestGranted AccessRequestPhase = "Granted"
+	// AccessRequestRejected indicates that the AccessRequest was denied.
+	AccessRequestRejected AccessRequestPhase = "Rejected"
+	// AccessRequestExpired indicates that the granted access expired and was revoked.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+)
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BulkOperation holds details about an operation which is performed on all shoots matching a selector.
+type BulkOperation struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Specification of the BulkOperation.
+	Spec BulkOperationSpec
+	// Most recently observed status of the BulkOperation.
+	Status BulkOperationStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BulkOperationList is a list of BulkOperation objects.
+type BulkOperationList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of BulkOperation.
+	Items []BulkOperation
+}
+
+// BulkOperationSpec is the specification of a BulkOperation. It is immutable.
+type BulkOperationSpec struct {
+	// ShootSelector selects the shoots in all namespaces on which the operation is performed. An empty selector selects
+	// all shoots.
+	ShootSelector metav1.LabelSelector
+	// Operation is the operation which is performed on the selected shoots.
+	Operation BulkOperationType
+	// RateLimit limits the rate at which the operation is performed on the selected shoots.
+	RateLimit *BulkOperationRateLimit
+	// MaxFailures is the number of shoots for which the operation may fail before the BulkOperation stops performing
+	// the operation on further shoots. If not set, the operation is performed on all selected shoots regardless of
+	// failures.
+	MaxFailures *int32
+}
+
+// BulkOperationRateLimit limits the rate at which a BulkOperation performs its operation on the selected shoots.
+type BulkOperationRateLimit struct {
+	// MaxParallel is the maximum number of shoots on which the operation is performed at the same time.
+	MaxParallel *int32
+	// Interval is the minimum duration between starting the operation on two subsequent shoots.
+	Interval *metav1.Duration
+}
+
+// BulkOperationType is the type of an operation which is performed by a BulkOperation.
+type BulkOperationType string
+
+const (
+	// BulkOperationReconcile triggers a reconciliation of the shoots.
+	BulkOperationReconcile BulkOperationType = "reconcile"
+	// BulkOperationRotateCA starts the rotation of the certificate authorities of the shoots.
+	BulkOperationRotateCA BulkOperationType = "rotate-ca"
+	// BulkOperationRotateObservability rotates the observability credentials of the shoots.
+	BulkOperationRotateObservability BulkOperationType = "rotate-observability"
+)
+
+// BulkOperationStatus holds the most recently observed status of the BulkOperation.
+type BulkOperationStatus struct {
+	// Phase is the current phase of the BulkOperation.
+	Phase BulkOperationPhase
+	// ObservedGeneration is the most recent generation observed for this BulkOperation.
+	ObservedGeneration int64
+	// StartTime is the time when the selected shoots were determined and the BulkOperation started.
+	StartTime *metav1.Time
+	// CompletionTime is the time when the operation was finished on all selected shoots.
+	CompletionTime *metav1.Time
+	// Shoots contains the progress of the operation per selected shoot.
+	Shoots []BulkOperationShootStatus
+}
+
+// BulkOperationShootStatus contains the progress of a BulkOperation for a single shoot.
+type BulkOperationShootStatus struct {
+	// Name is the name of the shoot.
+	Name string
+	// Namespace is the namespace of the shoot.
+	Namespace string
+	// State is the state of the operation for the shoot.
+	State BulkOperationShootState
+	// Message is a human-readable message about the state of the operation for the shoot.
+	Message *string
+	// Generation is the generation of the shoot before the operation was triggered. The operation is finished once the
+	// shoot was reconciled with a newer generation.
+	Generation *int64
+	// StartTime is the time when the operation was triggered for the shoot.
+	StartTime *metav1.Time
+	// CompletionTime is the time when the operation was finished or skipped for the shoot.
+	CompletionTime *metav1.Time
+}
+
+// BulkOperationPhase is a label for the condition of a BulkOperation at the current time.
+type BulkOperationPhase string
+
+const (
+	// BulkOperationRunning indicates that the operation is being performed on the selected shoots.
+	BulkOperationRunning BulkOperationPhase = "Running"
+	// BulkOperationSucceeded indicates that the operation succeeded on all selected shoots which were not skipped.
+	BulkOperationSucceeded BulkOperationPhase = "Succeeded"
+	// BulkOperationFailed indicates that the operation failed on at least one of the selected shoots.
+	BulkOperationFailed BulkOperationPhase = "Failed"
+)
+
+// BulkOperationShootState is the state of a BulkOperation for a single shoot.
+type BulkOperationShootState string
+
+const (
+	// BulkOperationShootPending indicates that the operation was not yet triggered for the shoot.
+	BulkOperationShootPending BulkOperationShootState = "Pending"
+	// BulkOperationShootInProgress indicates that the operation was triggered and is being performed on the shoot.
+	BulkOperationShootInProgress BulkOperationShootState = "InProgress"
+	// BulkOperationShootSucceeded indicates that the operation succeeded on the shoot.
+	BulkOperationShootSucceeded BulkOperationShootState = "Succeeded"
+	// BulkOperationShootFailed indicates that the operation failed on the shoot.
+	BulkOperationShootFailed BulkOperationShootState = "Failed"
+	// BulkOperationShootSkipped indicates that the operation was not performed on the shoot, e.g., because it is being
+	// deleted.
+	BulkOperationShootSkipped BulkOperationShootState = "Skipped"
+)
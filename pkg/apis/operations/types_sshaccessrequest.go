@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHAccessRequest holds details about a request for time-bound SSH access to the worker nodes of a shoot cluster.
+type SSHAccessRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Specification of the SSHAccessRequest.
+	Spec SSHAccessRequestSpec
+	// Most recently observed status of the SSHAccessRequest.
+	Status SSHAccessRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHAccessRequestList is a list of SSHAccessRequest objects.
+type SSHAccessRequestList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of SSHAccessRequest.
+	Items []SSHAccessRequest
+}
+
+// SSHAccessRequestSpec is the specification of an SSHAccessRequest. It is immutable.
+type SSHAccessRequestSpec struct {
+	// ShootRef defines the target shoot for an SSHAccessRequest.
+	ShootRef corev1.LocalObjectReference
+	// SSHPublicKey is the user's public key which is authorized on the worker nodes while the access is granted.
+	SSHPublicKey string
+	// Duration is the requested duration of the access. It must be between 10 minutes and 24 hours.
+	Duration metav1.Duration
+	// Reason is a human-readable justification for the access request.
+	Reason string
+	// Requester is the name of the user who created the SSHAccessRequest. It is set by the API server.
+	Requester string
+}
+
+// SSHAccessRequestStatus holds the most recently observed status of the SSHAccessRequest.
+type SSHAccessRequestStatus struct {
+	// Phase is the current phase of the SSHAccessRequest.
+	Phase AccessRequestPhase
+	// Approval contains the decision about the SSHAccessRequest. It can only be set via the `approval` subresource.
+	Approval *AccessRequestApproval
+	// GrantedTimestamp is the time when the access was granted.
+	GrantedTimestamp *metav1.Time
+	// ExpirationTimestamp is the time when the granted access expires and the public key is removed from the worker
+	// nodes.
+	ExpirationTimestamp *metav1.Time
+}
@@ -6,8 +6,23 @@ package v1alpha1
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_BulkOperationSpec sets default values for BulkOperationSpec objects.
+func SetDefaults_BulkOperationSpec(obj *BulkOperationSpec) {
+	if obj.RateLimit == nil {
+		obj.RateLimit = &BulkOperationRateLimit{}
+	}
+}
+
+// SetDefaults_BulkOperationRateLimit sets default values for BulkOperationRateLimit objects.
+func SetDefaults_BulkOperationRateLimit(obj *BulkOperationRateLimit) {
+	if obj.MaxParallel == nil {
+		obj.MaxParallel = ptr.To[int32](1)
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetObjectDefaults_BulkOperation", func() {
+		var obj *BulkOperation
+
+		BeforeEach(func() {
+			obj = &BulkOperation{}
+		})
+
+		It("should default the rate limit", func() {
+			SetObjectDefaults_BulkOperation(obj)
+
+			Expect(obj.Spec.RateLimit).To(Equal(&BulkOperationRateLimit{MaxParallel: ptr.To[int32](1)}))
+		})
+
+		It("should not overwrite the max parallel setting", func() {
+			obj.Spec.RateLimit = &BulkOperationRateLimit{MaxParallel: ptr.To[int32](10)}
+
+			SetObjectDefaults_BulkOperation(obj)
+
+			Expect(obj.Spec.RateLimit.MaxParallel).To(Equal(ptr.To[int32](10)))
+		})
+	})
+})
@@ -39,6 +39,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&AccessRequest{},
+		&AccessRequestList{},
+		&SSHAccessRequest{},
+		&SSHAccessRequestList{},
+		&BulkOperation{},
+		&BulkOperationList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest holds details about a request for time-bound admin access to a shoot cluster.
+type AccessRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	// Specification of the AccessRequest.
+	Spec AccessRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the AccessRequest.
+	// +optional
+	Status AccessRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is a list of AccessRequest objects.
+type AccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of AccessRequest.
+	Items []AccessRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// AccessRequestSpec is the specification of an AccessRequest. It is immutable.
+type AccessRequestSpec struct {
+	// ShootRef defines the target shoot for an AccessRequest.
+	ShootRef corev1.LocalObjectReference `json:"shootRef" protobuf:"bytes,1,opt,name=shootRef"`
+	// Duration is the requested duration of the access. It must be between 10 minutes and 24 hours.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,2,opt,name=duration"`
+	// Reason is a human-readable justification for the access request.
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
+	// Requester is the name of the user who created the AccessRequest. It is set by the API server.
+	// +optional
+	Requester string `json:"requester,omitempty" protobuf:"bytes,4,opt,name=requester"`
+}
+
+// AccessRequestStatus holds the most recently observed status of the AccessRequest.
+type AccessRequestStatus struct {
+	// Phase is the current phase of the AccessRequest.
+	// +optional
+	Phase AccessRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=AccessRequestPhase"`
+	// Approval contains the decision about the AccessRequest. It can only be set via the `approval` subresource.
+	// +optional
+	Approval *AccessRequestApproval `json:"approval,omitempty" protobuf:"bytes,2,opt,name=approval"`
+	// KubeconfigSecretName is the name of the secret in the project namespace which contains the kubeconfig for the
+	// granted access. Only the requester is allowed to read it.
+	// +optional
+	KubeconfigSecretName *string `json:"kubeconfigSecretName,omitempty" protobuf:"bytes,3,opt,name=kubeconfigSecretName"`
+	// GrantedTimestamp is the time when the access was granted.
+	// +optional
+	GrantedTimestamp *metav1.Time `json:"grantedTimestamp,omitempty" protobuf:"bytes,4,opt,name=grantedTimestamp"`
+	// ExpirationTimestamp is the time when the granted access expires and is revoked.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,5,opt,name=expirationTimestamp"`
+}
+
+// AccessRequestApproval contains the decision about an AccessRequest.
+type AccessRequestApproval struct {
+	// Decision is the decision about the AccessRequest.
+	Decision AccessRequestDecision `json:"decision" protobuf:"bytes,1,opt,name=decision,casttype=AccessRequestDecision"`
+	// Message is an optional human-readable comment on the decision.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// DecidedBy is the name of the user who decided about the AccessRequest. It is set by the API server.
+	// +optional
+	DecidedBy string `json:"decidedBy,omitempty" protobuf:"bytes,3,opt,name=decidedBy"`
+	// DecisionTimestamp is the time of the decision. It is set by the API server.
+	// +optional
+	DecisionTimestamp *metav1.Time `json:"decisionTimestamp,omitempty" protobuf:"bytes,4,opt,name=decisionTimestamp"`
+}
+
+// AccessRequestDecision is a decision about an AccessRequest.
+type AccessRequestDecision string
+
+const (
+	// AccessRequestApproved indicates that the AccessRequest was approved.
+	AccessRequestApproved AccessRequestDecision = "Approved"
+	// AccessRequestDenied indicates that the AccessRequest was denied.
+	AccessRequestDenied AccessRequestDecision = "Denied"
+)
+
+// AccessRequestPhase is a label for the condition of an AccessRequest at the current time.
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending indicates that the AccessRequest awaits a decision.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestGranted indicates that the access was granted and the kubeconfig is available.
+	AccessRequestGranted AccessRequestPhase = "Granted"
+	// AccessRequestRejected indicates that the AccessRequest was denied.
+	AccessRequestRejected AccessRequestPhase = "Rejected"
+	// AccessRequestExpired indicates that the granted access expired and was revoked.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+)
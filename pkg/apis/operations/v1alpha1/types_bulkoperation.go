@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BulkOperation holds details about an operation which is performed on all shoots matching a selector.
+type BulkOperation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	// Specification of the BulkOperation.
+	Spec BulkOperationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the BulkOperation.
+	// +optional
+	Status BulkOperationStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BulkOperationList is a list of BulkOperation objects.
+type BulkOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of BulkOperation.
+	Items []BulkOperation `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// BulkOperationSpec is the specification of a BulkOperation. It is immutable.
+type BulkOperationSpec struct {
+	// ShootSelector selects the shoots in all namespaces on which the operation is performed. An empty selector selects
+	// all shoots.
+	ShootSelector metav1.LabelSelector `json:"shootSelector" protobuf:"bytes,1,opt,name=shootSelector"`
+	// Operation is the operation which is performed on the selected shoots. Supported values are `reconcile`,
+	// `rotate-ca`, and `rotate-observability`.
+	Operation BulkOperationType `json:"operation" protobuf:"bytes,2,opt,name=operation,casttype=BulkOperationType"`
+	// RateLimit limits the rate at which the operation is performed on the selected shoots.
+	// +optional
+	RateLimit *BulkOperationRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,3,opt,name=rateLimit"`
+	// MaxFailures is the number of shoots for which the operation may fail before the BulkOperation stops performing
+	// the operation on further shoots. If not set, the operation is performed on all selected shoots regardless of
+	// failures.
+	// +optional
+	MaxFailures *int32 `json:"maxFailures,omitempty" protobuf:"varint,4,opt,name=maxFailures"`
+}
+
+// BulkOperationRateLimit limits the rate at which a BulkOperation performs its operation on the selected shoots.
+type BulkOperationRateLimit struct {
+	// MaxParallel is the maximum number of shoots on which the operation is performed at the same time. Defaults to 1.
+	// +optional
+	MaxParallel *int32 `json:"maxParallel,omitempty" protobuf:"varint,1,opt,name=maxParallel"`
+	// Interval is the minimum duration between starting the operation on two subsequent shoots.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+}
+
+// BulkOperationType is the type of an operation which is performed by a BulkOperation.
+type BulkOperationType string
+
+const (
+	// BulkOperationReconcile triggers a reconciliation of the shoots.
+	BulkOperationReconcile BulkOperationType = "reconcile"
+	// BulkOperationRotateCA starts the rotation of the certificate authorities of the shoots.
+	BulkOperationRotateCA BulkOperationType = "rotate-ca"
+	// BulkOperationRotateObservability rotates the observability credentials of the shoots.
+	BulkOperationRotateObservability BulkOperationType = "rotate-observability"
+)
+
+// BulkOperationStatus holds the most recently observed status of the BulkOperation.
+type BulkOperationStatus struct {
+	// Phase is the current phase of the BulkOperation.
+	// +optional
+	Phase BulkOperationPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=BulkOperationPhase"`
+	// ObservedGeneration is the most recent generation observed for this BulkOperation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+	// StartTime is the time when the selected shoots were determined and the BulkOperation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,3,opt,name=startTime"`
+	// CompletionTime is the time when the operation was finished on all selected shoots.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,4,opt,name=completionTime"`
+	// Shoots contains the progress of the operation per selected shoot.
+	// +optional
+	Shoots []BulkOperationShootStatus `json:"shoots,omitempty" protobuf:"bytes,5,rep,name=shoots"`
+}
+
+// BulkOperationShootStatus contains the progress of a BulkOperation for a single shoot.
+type BulkOperationShootStatus struct {
+	// Name is the name of the shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// State is the state of the operation for the shoot.
+	State BulkOperationShootState `json:"state" protobuf:"bytes,3,opt,name=state,casttype=BulkOperationShootState"`
+	// Message is a human-readable message about the state of the operation for the shoot.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Generation is the generation of the shoot before the operation was triggered. The operation is finished once the
+	// shoot was reconciled with a newer generation.
+	// +optional
+	Generation *int64 `json:"generation,omitempty" protobuf:"varint,5,opt,name=generation"`
+	// StartTime is the time when the operation was triggered for the shoot.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,6,opt,name=startTime"`
+	// CompletionTime is the time when the operation was finished or skipped for the shoot.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,7,opt,name=completionTime"`
+}
+
+// BulkOperationPhase is a label for the condition of a BulkOperation at the current time.
+type BulkOperationPhase string
+
+const (
+	// BulkOperationRunning indicates that the operation is being performed on the selected shoots.
+	BulkOperationRunning BulkOperationPhase = "Running"
+	// BulkOperationSucceeded indicates that the operation succeeded on all selected shoots which were not skipped.
+	BulkOperationSucceeded BulkOperationPhase = "Succeeded"
+	// BulkOperationFailed indicates that the operation failed on at least one of the selected shoots.
+	BulkOperationFailed BulkOperationPhase = "Failed"
+)
+
+// BulkOperationShootState is the state of a BulkOperation for a single shoot.
+type BulkOperationShootState string
+
+const (
+	// BulkOperationShootPending indicates that the operation was not yet triggered for the shoot.
+	BulkOperationShootPending BulkOperationShootState = "Pending"
+	// BulkOperationShootInProgress indicates that the operation was triggered and is being performed on the shoot.
+	BulkOperationShootInProgress BulkOperationShootState = "InProgress"
+	// BulkOperationShootSucceeded indicates that the operation succeeded on the shoot.
+	BulkOperationShootSucceeded BulkOperationShootState = "Succeeded"
+	// BulkOperationShootFailed indicates that the operation failed on the shoot.
+	BulkOperationShootFailed BulkOperationShootState = "Failed"
+	// BulkOperationShootSkipped indicates that the operation was not performed on the shoot, e.g., because it is being
+	// deleted.
+	BulkOperationShootSkipped BulkOperationShootState = "Skipped"
+)
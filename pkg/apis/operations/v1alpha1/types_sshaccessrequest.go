@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHAccessRequest holds details about a request for time-bound SSH access to the worker nodes of a shoot cluster.
+type SSHAccessRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	// Specification of the SSHAccessRequest.
+	Spec SSHAccessRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the SSHAccessRequest.
+	// +optional
+	Status SSHAccessRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHAccessRequestList is a list of SSHAccessRequest objects.
+type SSHAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of SSHAccessRequest.
+	Items []SSHAccessRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// SSHAccessRequestSpec is the specification of an SSHAccessRequest. It is immutable.
+type SSHAccessRequestSpec struct {
+	// ShootRef defines the target shoot for an SSHAccessRequest.
+	ShootRef corev1.LocalObjectReference `json:"shootRef" protobuf:"bytes,1,opt,name=shootRef"`
+	// SSHPublicKey is the user's public key which is authorized on the worker nodes while the access is granted.
+	SSHPublicKey string `json:"sshPublicKey" protobuf:"bytes,2,opt,name=sshPublicKey"`
+	// Duration is the requested duration of the access. It must be between 10 minutes and 24 hours.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// Reason is a human-readable justification for the access request.
+	Reason string `json:"reason" protobuf:"bytes,4,opt,name=reason"`
+	// Requester is the name of the user who created the SSHAccessRequest. It is set by the API server.
+	// +optional
+	Requester string `json:"requester,omitempty" protobuf:"bytes,5,opt,name=requester"`
+}
+
+// SSHAccessRequestStatus holds the most recently observed status of the SSHAccessRequest.
+type SSHAccessRequestStatus struct {
+	// Phase is the current phase of the SSHAccessRequest.
+	// +optional
+	Phase AccessRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=AccessRequestPhase"`
+	// Approval contains the decision about the SSHAccessRequest. It can only be set via the `approval` subresource.
+	// +optional
+	Approval *AccessRequestApproval `json:"approval,omitempty" protobuf:"bytes,2,opt,name=approval"`
+	// GrantedTimestamp is the time when the access was granted.
+	// +optional
+	GrantedTimestamp *metav1.Time `json:"grantedTimestamp,omitempty" protobuf:"bytes,3,opt,name=grantedTimestamp"`
+	// ExpirationTimestamp is the time when the granted access expires and the public key is removed from the worker
+	// nodes.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,4,opt,name=expirationTimestamp"`
+}
@@ -15,8 +15,8 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operations "github.com/gardener/gardener/pkg/apis/operations"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -28,6 +28,56 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AccessRequest)(nil), (*operations.AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessRequest_To_operations_AccessRequest(a.(*AccessRequest), b.(*operations.AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AccessRequest)(nil), (*AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AccessRequest_To_v1alpha1_AccessRequest(a.(*operations.AccessRequest), b.(*AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestApproval)(nil), (*operations.AccessRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessRequestApproval_To_operations_AccessRequestApproval(a.(*AccessRequestApproval), b.(*operations.AccessRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AccessRequestApproval)(nil), (*AccessRequestApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AccessRequestApproval_To_v1alpha1_AccessRequestApproval(a.(*operations.AccessRequestApproval), b.(*AccessRequestApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestList)(nil), (*operations.AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessRequestList_To_operations_AccessRequestList(a.(*AccessRequestList), b.(*operations.AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AccessRequestList)(nil), (*AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AccessRequestList_To_v1alpha1_AccessRequestList(a.(*operations.AccessRequestList), b.(*AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestSpec)(nil), (*operations.AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessRequestSpec_To_operations_AccessRequestSpec(a.(*AccessRequestSpec), b.(*operations.AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AccessRequestSpec)(nil), (*AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AccessRequestSpec_To_v1alpha1_AccessRequestSpec(a.(*operations.AccessRequestSpec), b.(*AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestStatus)(nil), (*operations.AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessRequestStatus_To_operations_AccessRequestStatus(a.(*AccessRequestStatus), b.(*operations.AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AccessRequestStatus)(nil), (*AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AccessRequestStatus_To_v1alpha1_AccessRequestStatus(a.(*operations.AccessRequestStatus), b.(*AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Bastion)(nil), (*operations.Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Bastion_To_operations_Bastion(a.(*Bastion), b.(*operations.Bastion), scope)
 	}); err != nil {
@@ -78,9 +128,243 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperation)(nil), (*operations.BulkOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperation_To_operations_BulkOperation(a.(*BulkOperation), b.(*operations.BulkOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BulkOperation)(nil), (*BulkOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BulkOperation_To_v1alpha1_BulkOperation(a.(*operations.BulkOperation), b.(*BulkOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperationList)(nil), (*operations.BulkOperationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperationList_To_operations_BulkOperationList(a.(*BulkOperationList), b.(*operations.BulkOperationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BulkOperationList)(nil), (*BulkOperationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BulkOperationList_To_v1alpha1_BulkOperationList(a.(*operations.BulkOperationList), b.(*BulkOperationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperationRateLimit)(nil), (*operations.BulkOperationRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperationRateLimit_To_operations_BulkOperationRateLimit(a.(*BulkOperationRateLimit), b.(*operations.BulkOperationRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BulkOperationRateLimit)(nil), (*BulkOperationRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BulkOperationRateLimit_To_v1alpha1_BulkOperationRateLimit(a.(*operations.BulkOperationRateLimit), b.(*BulkOperationRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperationShootStatus)(nil), (*operations.BulkOperationShootStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperationShootStatus_To_operations_BulkOperationShootStatus(a.(*BulkOperationShootStatus), b.(*operations.BulkOperationShootStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BulkOperationShootStatus)(nil), (*BulkOperationShootStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BulkOperationShootStatus_To_v1alpha1_BulkOperationShootStatus(a.(*operations.BulkOperationShootStatus), b.(*BulkOperationShootStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperationSpec)(nil), (*operations.BulkOperationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperationSpec_To_operations_BulkOperationSpec(a.(*BulkOperationSpec), b.(*operations.BulkOperationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BulkOperationSpec)(nil), (*BulkOperationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BulkOperationSpec_To_v1alpha1_BulkOperationSpec(a.(*operations.BulkOperationSpec), b.(*BulkOperationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperationStatus)(nil), (*operations.BulkOperationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperationStatus_To_operations_BulkOperationStatus(a.(*BulkOperationStatus), b.(*operations.BulkOperationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BulkOperationStatus)(nil), (*BulkOperationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BulkOperationStatus_To_v1alpha1_BulkOperationStatus(a.(*operations.BulkOperationStatus), b.(*BulkOperationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHAccessRequest)(nil), (*operations.SSHAccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHAccessRequest_To_operations_SSHAccessRequest(a.(*SSHAccessRequest), b.(*operations.SSHAccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SSHAccessRequest)(nil), (*SSHAccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SSHAccessRequest_To_v1alpha1_SSHAccessRequest(a.(*operations.SSHAccessRequest), b.(*SSHAccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHAccessRequestList)(nil), (*operations.SSHAccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHAccessRequestList_To_operations_SSHAccessRequestList(a.(*SSHAccessRequestList), b.(*operations.SSHAccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SSHAccessRequestList)(nil), (*SSHAccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SSHAccessRequestList_To_v1alpha1_SSHAccessRequestList(a.(*operations.SSHAccessRequestList), b.(*SSHAccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHAccessRequestSpec)(nil), (*operations.SSHAccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHAccessRequestSpec_To_operations_SSHAccessRequestSpec(a.(*SSHAccessRequestSpec), b.(*operations.SSHAccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SSHAccessRequestSpec)(nil), (*SSHAccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SSHAccessRequestSpec_To_v1alpha1_SSHAccessRequestSpec(a.(*operations.SSHAccessRequestSpec), b.(*SSHAccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHAccessRequestStatus)(nil), (*operations.SSHAccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHAccessRequestStatus_To_operations_SSHAccessRequestStatus(a.(*SSHAccessRequestStatus), b.(*operations.SSHAccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.SSHAccessRequestStatus)(nil), (*SSHAccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_SSHAccessRequestStatus_To_v1alpha1_SSHAccessRequestStatus(a.(*operations.SSHAccessRequestStatus), b.(*SSHAccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AccessRequest_To_operations_AccessRequest(in *AccessRequest, out *operations.AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AccessRequestSpec_To_operations_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AccessRequestStatus_To_operations_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AccessRequest_To_operations_AccessRequest is an autogenerated conversion function.
+func Convert_v1alpha1_AccessRequest_To_operations_AccessRequest(in *AccessRequest, out *operations.AccessRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessRequest_To_operations_AccessRequest(in, out, s)
+}
+
+func autoConvert_operations_AccessRequest_To_v1alpha1_AccessRequest(in *operations.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_AccessRequestSpec_To_v1alpha1_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_AccessRequestStatus_To_v1alpha1_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_AccessRequest_To_v1alpha1_AccessRequest is an autogenerated conversion function.
+func Convert_operations_AccessRequest_To_v1alpha1_AccessRequest(in *operations.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	return autoConvert_operations_AccessRequest_To_v1alpha1_AccessRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_AccessRequestApproval_To_operations_AccessRequestApproval(in *AccessRequestApproval, out *operations.AccessRequestApproval, s conversion.Scope) error {
+	out.Decision = operations.AccessRequestDecision(in.Decision)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.DecidedBy = in.DecidedBy
+	out.DecisionTimestamp = (*v1.Time)(unsafe.Pointer(in.DecisionTimestamp))
+	return nil
+}
+
+// Convert_v1alpha1_AccessRequestApproval_To_operations_AccessRequestApproval is an autogenerated conversion function.
+func Convert_v1alpha1_AccessRequestApproval_To_operations_AccessRequestApproval(in *AccessRequestApproval, out *operations.AccessRequestApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessRequestApproval_To_operations_AccessRequestApproval(in, out, s)
+}
+
+func autoConvert_operations_AccessRequestApproval_To_v1alpha1_AccessRequestApproval(in *operations.AccessRequestApproval, out *AccessRequestApproval, s conversion.Scope) error {
+	out.Decision = AccessRequestDecision(in.Decision)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.DecidedBy = in.DecidedBy
+	out.DecisionTimestamp = (*v1.Time)(unsafe.Pointer(in.DecisionTimestamp))
+	return nil
+}
+
+// Convert_operations_AccessRequestApproval_To_v1alpha1_AccessRequestApproval is an autogenerated conversion function.
+func Convert_operations_AccessRequestApproval_To_v1alpha1_AccessRequestApproval(in *operations.AccessRequestApproval, out *AccessRequestApproval, s conversion.Scope) error {
+	return autoConvert_operations_AccessRequestApproval_To_v1alpha1_AccessRequestApproval(in, out, s)
+}
+
+func autoConvert_v1alpha1_AccessRequestList_To_operations_AccessRequestList(in *AccessRequestList, out *operations.AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_AccessRequestList_To_operations_AccessRequestList is an autogenerated conversion function.
+func Convert_v1alpha1_AccessRequestList_To_operations_AccessRequestList(in *AccessRequestList, out *operations.AccessRequestList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessRequestList_To_operations_AccessRequestList(in, out, s)
+}
+
+func autoConvert_operations_AccessRequestList_To_v1alpha1_AccessRequestList(in *operations.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_AccessRequestList_To_v1alpha1_AccessRequestList is an autogenerated conversion function.
+func Convert_operations_AccessRequestList_To_v1alpha1_AccessRequestList(in *operations.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	return autoConvert_operations_AccessRequestList_To_v1alpha1_AccessRequestList(in, out, s)
+}
+
+func autoConvert_v1alpha1_AccessRequestSpec_To_operations_AccessRequestSpec(in *AccessRequestSpec, out *operations.AccessRequestSpec, s conversion.Scope) error {
+	out.ShootRef = in.ShootRef
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_v1alpha1_AccessRequestSpec_To_operations_AccessRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AccessRequestSpec_To_operations_AccessRequestSpec(in *AccessRequestSpec, out *operations.AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessRequestSpec_To_operations_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_operations_AccessRequestSpec_To_v1alpha1_AccessRequestSpec(in *operations.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	out.ShootRef = in.ShootRef
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_operations_AccessRequestSpec_To_v1alpha1_AccessRequestSpec is an autogenerated conversion function.
+func Convert_operations_AccessRequestSpec_To_v1alpha1_AccessRequestSpec(in *operations.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_operations_AccessRequestSpec_To_v1alpha1_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_AccessRequestStatus_To_operations_AccessRequestStatus(in *AccessRequestStatus, out *operations.AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = operations.AccessRequestPhase(in.Phase)
+	out.Approval = (*operations.AccessRequestApproval)(unsafe.Pointer(in.Approval))
+	out.KubeconfigSecretName = (*string)(unsafe.Pointer(in.KubeconfigSecretName))
+	out.GrantedTimestamp = (*v1.Time)(unsafe.Pointer(in.GrantedTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1alpha1_AccessRequestStatus_To_operations_AccessRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AccessRequestStatus_To_operations_AccessRequestStatus(in *AccessRequestStatus, out *operations.AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessRequestStatus_To_operations_AccessRequestStatus(in, out, s)
+}
+
+func autoConvert_operations_AccessRequestStatus_To_v1alpha1_AccessRequestStatus(in *operations.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = AccessRequestPhase(in.Phase)
+	out.Approval = (*AccessRequestApproval)(unsafe.Pointer(in.Approval))
+	out.KubeconfigSecretName = (*string)(unsafe.Pointer(in.KubeconfigSecretName))
+	out.GrantedTimestamp = (*v1.Time)(unsafe.Pointer(in.GrantedTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	return nil
 }
 
+// Convert_operations_AccessRequestStatus_To_v1alpha1_AccessRequestStatus is an autogenerated conversion function.
+func Convert_operations_AccessRequestStatus_To_v1alpha1_AccessRequestStatus(in *operations.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_operations_AccessRequestStatus_To_v1alpha1_AccessRequestStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Bastion_To_operations_Bastion(in *Bastion, out *operations.Bastion, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -184,10 +468,10 @@ func Convert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.Basti
 }
 
 func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatus, out *operations.BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	return nil
 }
@@ -198,10 +482,10 @@ func Convert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatu
 }
 
 func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
 	return nil
 }
@@ -210,3 +494,273 @@ func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operatio
 func Convert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
 	return autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_BulkOperation_To_operations_BulkOperation(in *BulkOperation, out *operations.BulkOperation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_BulkOperationSpec_To_operations_BulkOperationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_BulkOperationStatus_To_operations_BulkOperationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperation_To_operations_BulkOperation is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperation_To_operations_BulkOperation(in *BulkOperation, out *operations.BulkOperation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperation_To_operations_BulkOperation(in, out, s)
+}
+
+func autoConvert_operations_BulkOperation_To_v1alpha1_BulkOperation(in *operations.BulkOperation, out *BulkOperation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_BulkOperationSpec_To_v1alpha1_BulkOperationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_BulkOperationStatus_To_v1alpha1_BulkOperationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_BulkOperation_To_v1alpha1_BulkOperation is an autogenerated conversion function.
+func Convert_operations_BulkOperation_To_v1alpha1_BulkOperation(in *operations.BulkOperation, out *BulkOperation, s conversion.Scope) error {
+	return autoConvert_operations_BulkOperation_To_v1alpha1_BulkOperation(in, out, s)
+}
+
+func autoConvert_v1alpha1_BulkOperationList_To_operations_BulkOperationList(in *BulkOperationList, out *operations.BulkOperationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.BulkOperation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperationList_To_operations_BulkOperationList is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperationList_To_operations_BulkOperationList(in *BulkOperationList, out *operations.BulkOperationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperationList_To_operations_BulkOperationList(in, out, s)
+}
+
+func autoConvert_operations_BulkOperationList_To_v1alpha1_BulkOperationList(in *operations.BulkOperationList, out *BulkOperationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]BulkOperation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_BulkOperationList_To_v1alpha1_BulkOperationList is an autogenerated conversion function.
+func Convert_operations_BulkOperationList_To_v1alpha1_BulkOperationList(in *operations.BulkOperationList, out *BulkOperationList, s conversion.Scope) error {
+	return autoConvert_operations_BulkOperationList_To_v1alpha1_BulkOperationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_BulkOperationRateLimit_To_operations_BulkOperationRateLimit(in *BulkOperationRateLimit, out *operations.BulkOperationRateLimit, s conversion.Scope) error {
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperationRateLimit_To_operations_BulkOperationRateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperationRateLimit_To_operations_BulkOperationRateLimit(in *BulkOperationRateLimit, out *operations.BulkOperationRateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperationRateLimit_To_operations_BulkOperationRateLimit(in, out, s)
+}
+
+func autoConvert_operations_BulkOperationRateLimit_To_v1alpha1_BulkOperationRateLimit(in *operations.BulkOperationRateLimit, out *BulkOperationRateLimit, s conversion.Scope) error {
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_operations_BulkOperationRateLimit_To_v1alpha1_BulkOperationRateLimit is an autogenerated conversion function.
+func Convert_operations_BulkOperationRateLimit_To_v1alpha1_BulkOperationRateLimit(in *operations.BulkOperationRateLimit, out *BulkOperationRateLimit, s conversion.Scope) error {
+	return autoConvert_operations_BulkOperationRateLimit_To_v1alpha1_BulkOperationRateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_BulkOperationShootStatus_To_operations_BulkOperationShootStatus(in *BulkOperationShootStatus, out *operations.BulkOperationShootStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.State = operations.BulkOperationShootState(in.State)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.Generation = (*int64)(unsafe.Pointer(in.Generation))
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperationShootStatus_To_operations_BulkOperationShootStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperationShootStatus_To_operations_BulkOperationShootStatus(in *BulkOperationShootStatus, out *operations.BulkOperationShootStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperationShootStatus_To_operations_BulkOperationShootStatus(in, out, s)
+}
+
+func autoConvert_operations_BulkOperationShootStatus_To_v1alpha1_BulkOperationShootStatus(in *operations.BulkOperationShootStatus, out *BulkOperationShootStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.State = BulkOperationShootState(in.State)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.Generation = (*int64)(unsafe.Pointer(in.Generation))
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	return nil
+}
+
+// Convert_operations_BulkOperationShootStatus_To_v1alpha1_BulkOperationShootStatus is an autogenerated conversion function.
+func Convert_operations_BulkOperationShootStatus_To_v1alpha1_BulkOperationShootStatus(in *operations.BulkOperationShootStatus, out *BulkOperationShootStatus, s conversion.Scope) error {
+	return autoConvert_operations_BulkOperationShootStatus_To_v1alpha1_BulkOperationShootStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BulkOperationSpec_To_operations_BulkOperationSpec(in *BulkOperationSpec, out *operations.BulkOperationSpec, s conversion.Scope) error {
+	out.ShootSelector = in.ShootSelector
+	out.Operation = operations.BulkOperationType(in.Operation)
+	out.RateLimit = (*operations.BulkOperationRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.MaxFailures = (*int32)(unsafe.Pointer(in.MaxFailures))
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperationSpec_To_operations_BulkOperationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperationSpec_To_operations_BulkOperationSpec(in *BulkOperationSpec, out *operations.BulkOperationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperationSpec_To_operations_BulkOperationSpec(in, out, s)
+}
+
+func autoConvert_operations_BulkOperationSpec_To_v1alpha1_BulkOperationSpec(in *operations.BulkOperationSpec, out *BulkOperationSpec, s conversion.Scope) error {
+	out.ShootSelector = in.ShootSelector
+	out.Operation = BulkOperationType(in.Operation)
+	out.RateLimit = (*BulkOperationRateLimit)(unsafe.Pointer(in.RateLimit))
+	out.MaxFailures = (*int32)(unsafe.Pointer(in.MaxFailures))
+	return nil
+}
+
+// Convert_operations_BulkOperationSpec_To_v1alpha1_BulkOperationSpec is an autogenerated conversion function.
+func Convert_operations_BulkOperationSpec_To_v1alpha1_BulkOperationSpec(in *operations.BulkOperationSpec, out *BulkOperationSpec, s conversion.Scope) error {
+	return autoConvert_operations_BulkOperationSpec_To_v1alpha1_BulkOperationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_BulkOperationStatus_To_operations_BulkOperationStatus(in *BulkOperationStatus, out *operations.BulkOperationStatus, s conversion.Scope) error {
+	out.Phase = operations.BulkOperationPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Shoots = *(*[]operations.BulkOperationShootStatus)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperationStatus_To_operations_BulkOperationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperationStatus_To_operations_BulkOperationStatus(in *BulkOperationStatus, out *operations.BulkOperationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperationStatus_To_operations_BulkOperationStatus(in, out, s)
+}
+
+func autoConvert_operations_BulkOperationStatus_To_v1alpha1_BulkOperationStatus(in *operations.BulkOperationStatus, out *BulkOperationStatus, s conversion.Scope) error {
+	out.Phase = BulkOperationPhase(in.Phase)
+	out.ObservedGeneration = in.ObservedGeneration
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Shoots = *(*[]BulkOperationShootStatus)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_operations_BulkOperationStatus_To_v1alpha1_BulkOperationStatus is an autogenerated conversion function.
+func Convert_operations_BulkOperationStatus_To_v1alpha1_BulkOperationStatus(in *operations.BulkOperationStatus, out *BulkOperationStatus, s conversion.Scope) error {
+	return autoConvert_operations_BulkOperationStatus_To_v1alpha1_BulkOperationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHAccessRequest_To_operations_SSHAccessRequest(in *SSHAccessRequest, out *operations.SSHAccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_SSHAccessRequestSpec_To_operations_SSHAccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SSHAccessRequestStatus_To_operations_SSHAccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SSHAccessRequest_To_operations_SSHAccessRequest is an autogenerated conversion function.
+func Convert_v1alpha1_SSHAccessRequest_To_operations_SSHAccessRequest(in *SSHAccessRequest, out *operations.SSHAccessRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHAccessRequest_To_operations_SSHAccessRequest(in, out, s)
+}
+
+func autoConvert_operations_SSHAccessRequest_To_v1alpha1_SSHAccessRequest(in *operations.SSHAccessRequest, out *SSHAccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_SSHAccessRequestSpec_To_v1alpha1_SSHAccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_SSHAccessRequestStatus_To_v1alpha1_SSHAccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_SSHAccessRequest_To_v1alpha1_SSHAccessRequest is an autogenerated conversion function.
+func Convert_operations_SSHAccessRequest_To_v1alpha1_SSHAccessRequest(in *operations.SSHAccessRequest, out *SSHAccessRequest, s conversion.Scope) error {
+	return autoConvert_operations_SSHAccessRequest_To_v1alpha1_SSHAccessRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHAccessRequestList_To_operations_SSHAccessRequestList(in *SSHAccessRequestList, out *operations.SSHAccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.SSHAccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_SSHAccessRequestList_To_operations_SSHAccessRequestList is an autogenerated conversion function.
+func Convert_v1alpha1_SSHAccessRequestList_To_operations_SSHAccessRequestList(in *SSHAccessRequestList, out *operations.SSHAccessRequestList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHAccessRequestList_To_operations_SSHAccessRequestList(in, out, s)
+}
+
+func autoConvert_operations_SSHAccessRequestList_To_v1alpha1_SSHAccessRequestList(in *operations.SSHAccessRequestList, out *SSHAccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SSHAccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_SSHAccessRequestList_To_v1alpha1_SSHAccessRequestList is an autogenerated conversion function.
+func Convert_operations_SSHAccessRequestList_To_v1alpha1_SSHAccessRequestList(in *operations.SSHAccessRequestList, out *SSHAccessRequestList, s conversion.Scope) error {
+	return autoConvert_operations_SSHAccessRequestList_To_v1alpha1_SSHAccessRequestList(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHAccessRequestSpec_To_operations_SSHAccessRequestSpec(in *SSHAccessRequestSpec, out *operations.SSHAccessRequestSpec, s conversion.Scope) error {
+	out.ShootRef = in.ShootRef
+	out.SSHPublicKey = in.SSHPublicKey
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_v1alpha1_SSHAccessRequestSpec_To_operations_SSHAccessRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_SSHAccessRequestSpec_To_operations_SSHAccessRequestSpec(in *SSHAccessRequestSpec, out *operations.SSHAccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHAccessRequestSpec_To_operations_SSHAccessRequestSpec(in, out, s)
+}
+
+func autoConvert_operations_SSHAccessRequestSpec_To_v1alpha1_SSHAccessRequestSpec(in *operations.SSHAccessRequestSpec, out *SSHAccessRequestSpec, s conversion.Scope) error {
+	out.ShootRef = in.ShootRef
+	out.SSHPublicKey = in.SSHPublicKey
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_operations_SSHAccessRequestSpec_To_v1alpha1_SSHAccessRequestSpec is an autogenerated conversion function.
+func Convert_operations_SSHAccessRequestSpec_To_v1alpha1_SSHAccessRequestSpec(in *operations.SSHAccessRequestSpec, out *SSHAccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_operations_SSHAccessRequestSpec_To_v1alpha1_SSHAccessRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_SSHAccessRequestStatus_To_operations_SSHAccessRequestStatus(in *SSHAccessRequestStatus, out *operations.SSHAccessRequestStatus, s conversion.Scope) error {
+	out.Phase = operations.AccessRequestPhase(in.Phase)
+	out.Approval = (*operations.AccessRequestApproval)(unsafe.Pointer(in.Approval))
+	out.GrantedTimestamp = (*v1.Time)(unsafe.Pointer(in.GrantedTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1alpha1_SSHAccessRequestStatus_To_operations_SSHAccessRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SSHAccessRequestStatus_To_operations_SSHAccessRequestStatus(in *SSHAccessRequestStatus, out *operations.SSHAccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHAccessRequestStatus_To_operations_SSHAccessRequestStatus(in, out, s)
+}
+
+func autoConvert_operations_SSHAccessRequestStatus_To_v1alpha1_SSHAccessRequestStatus(in *operations.SSHAccessRequestStatus, out *SSHAccessRequestStatus, s conversion.Scope) error {
+	out.Phase = AccessRequestPhase(in.Phase)
+	out.Approval = (*AccessRequestApproval)(unsafe.Pointer(in.Approval))
+	out.GrantedTimestamp = (*v1.Time)(unsafe.Pointer(in.GrantedTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_operations_SSHAccessRequestStatus_To_v1alpha1_SSHAccessRequestStatus is an autogenerated conversion function.
+func Convert_operations_SSHAccessRequestStatus_To_v1alpha1_SSHAccessRequestStatus(in *operations.SSHAccessRequestStatus, out *SSHAccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_operations_SSHAccessRequestStatus_To_v1alpha1_SSHAccessRequestStatus(in, out, s)
+}
@@ -17,5 +17,21 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&BulkOperation{}, func(obj interface{}) { SetObjectDefaults_BulkOperation(obj.(*BulkOperation)) })
+	scheme.AddTypeDefaultingFunc(&BulkOperationList{}, func(obj interface{}) { SetObjectDefaults_BulkOperationList(obj.(*BulkOperationList)) })
 	return nil
 }
+
+func SetObjectDefaults_BulkOperation(in *BulkOperation) {
+	SetDefaults_BulkOperationSpec(&in.Spec)
+	if in.Spec.RateLimit != nil {
+		SetDefaults_BulkOperationRateLimit(in.Spec.RateLimit)
+	}
+}
+
+func SetObjectDefaults_BulkOperationList(in *BulkOperationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_BulkOperation(a)
+	}
+}
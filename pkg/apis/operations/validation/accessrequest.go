@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+const (
+	accessRequestMinDuration = 10 * time.Minute
+	accessRequestMaxDuration = 24 * time.Hour
+)
+
+var (
+	availableAccessRequestDecisions = sets.New(
+		string(operations.AccessRequestApproved),
+		string(operations.AccessRequestDenied),
+	)
+	availableAccessRequestPhases = sets.New(
+		string(operations.AccessRequestPending),
+		string(operations.AccessRequestGranted),
+		string(operations.AccessRequestRejected),
+		string(operations.AccessRequestExpired),
+	)
+)
+
+// ValidateAccessRequest validates an AccessRequest object.
+func ValidateAccessRequest(accessRequest *operations.AccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&accessRequest.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateAccessRequestSpec(&accessRequest.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateAccessRequestUpdate validates an AccessRequest object before an update.
+func ValidateAccessRequestUpdate(newAccessRequest, oldAccessRequest *operations.AccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newAccessRequest.ObjectMeta, &oldAccessRequest.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newAccessRequest.Spec, oldAccessRequest.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateAccessRequest(newAccessRequest)...)
+
+	return allErrs
+}
+
+// ValidateAccessRequestSpec validates the specification of an AccessRequest object.
+func ValidateAccessRequestSpec(spec *operations.AccessRequestSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ShootRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("shootRef", "name"), "shoot reference must not be empty"))
+	}
+
+	allErrs = append(allErrs, validateAccessRequestDuration(spec.Duration.Duration, fldPath.Child("duration"))...)
+
+	if len(spec.Reason) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), "must provide a reason for the access request"))
+	}
+
+	if len(spec.Requester) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requester"), "requester must be set"))
+	}
+
+	return allErrs
+}
+
+// ValidateAccessRequestStatusUpdate validates the status field of an AccessRequest object.
+func ValidateAccessRequestStatusUpdate(newAccessRequest, oldAccessRequest *operations.AccessRequest) field.ErrorList {
+	return validateAccessRequestPhaseUpdate(newAccessRequest.Status.Phase, oldAccessRequest.Status.Phase, newAccessRequest.Status.Approval, field.NewPath("status", "phase"))
+}
+
+// ValidateAccessRequestApprovalUpdate validates the approval of an AccessRequest object.
+func ValidateAccessRequestApprovalUpdate(newAccessRequest, oldAccessRequest *operations.AccessRequest) field.ErrorList {
+	return validateAccessRequestApprovalUpdate(newAccessRequest.Status.Approval, oldAccessRequest.Status.Approval, newAccessRequest.Spec.Requester, field.NewPath("status", "approval"))
+}
+
+func validateAccessRequestDuration(duration time.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if duration < accessRequestMinDuration || duration > accessRequestMaxDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.String(), "duration must be between "+accessRequestMinDuration.String()+" and "+accessRequestMaxDuration.String()))
+	}
+
+	return allErrs
+}
+
+func validateAccessRequestPhaseUpdate(newPhase, oldPhase operations.AccessRequestPhase, approval *operations.AccessRequestApproval, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(newPhase) > 0 && !availableAccessRequestPhases.Has(string(newPhase)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, newPhase, sets.List(availableAccessRequestPhases)))
+	}
+
+	if newPhase == operations.AccessRequestGranted && (approval == nil || approval.Decision != operations.AccessRequestApproved) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "access can only be granted for approved access requests"))
+	}
+
+	if oldPhase == operations.AccessRequestExpired || oldPhase == operations.AccessRequestRejected {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newPhase, oldPhase, fldPath)...)
+	}
+
+	return allErrs
+}
+
+func validateAccessRequestApprovalUpdate(newApproval, oldApproval *operations.AccessRequestApproval, requester string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldApproval != nil {
+		return append(allErrs, apivalidation.ValidateImmutableField(newApproval, oldApproval, fldPath)...)
+	}
+
+	if newApproval == nil {
+		return append(allErrs, field.Required(fldPath, "must provide a decision"))
+	}
+
+	if !availableAccessRequestDecisions.Has(string(newApproval.Decision)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("decision"), newApproval.Decision, sets.List(availableAccessRequestDecisions)))
+	}
+
+	if len(newApproval.DecidedBy) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("decidedBy"), "decider must be set"))
+	} else if newApproval.DecidedBy == requester {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("decidedBy"), "requester must not decide about their own access request"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+var _ = Describe("AccessRequest validation", func() {
+	var accessRequest *operations.AccessRequest
+
+	BeforeEach(func() {
+		accessRequest = &operations.AccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example-access",
+				Namespace: "garden-dev",
+			},
+			Spec: operations.AccessRequestSpec{
+				ShootRef:  corev1.LocalObjectReference{Name: "example-shoot"},
+				Duration:  metav1.Duration{Duration: time.Hour},
+				Reason:    "investigate incident",
+				Requester: "alice",
+			},
+		}
+	})
+
+	Describe("#ValidateAccessRequest", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateAccessRequest(accessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid AccessRequest resources with empty spec", func() {
+			accessRequest.Spec = operations.AccessRequestSpec{}
+
+			Expect(ValidateAccessRequest(accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.shootRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.reason"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.requester"),
+				})),
+			))
+		})
+
+		DescribeTable("duration",
+			func(duration time.Duration, matcher gomegatypes.GomegaMatcher) {
+				accessRequest.Spec.Duration = metav1.Duration{Duration: duration}
+
+				Expect(ValidateAccessRequest(accessRequest)).To(matcher)
+			},
+
+			Entry("too short", 9*time.Minute, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.duration")})))),
+			Entry("minimum", 10*time.Minute, BeEmpty()),
+			Entry("maximum", 24*time.Hour, BeEmpty()),
+			Entry("too long", 25*time.Hour, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.duration")})))),
+		)
+	})
+
+	Describe("#ValidateAccessRequestUpdate", func() {
+		It("should forbid changing the spec", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.ResourceVersion = "1"
+			accessRequest.ResourceVersion = "1"
+			newAccessRequest.Spec.Duration = metav1.Duration{Duration: 2 * time.Hour}
+
+			Expect(ValidateAccessRequestUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAccessRequestStatusUpdate", func() {
+		It("should allow granting approved access requests", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestApproved, DecidedBy: "bob"}
+			newAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid granting access requests which are not approved", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("status.phase"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported phases and leaving final phases", func() {
+			accessRequest.Status.Phase = operations.AccessRequestExpired
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Phase = "foo"
+
+			Expect(ValidateAccessRequestStatusUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.phase"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.phase"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAccessRequestApprovalUpdate", func() {
+		var newAccessRequest *operations.AccessRequest
+
+		BeforeEach(func() {
+			newAccessRequest = accessRequest.DeepCopy()
+			newAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestApproved, DecidedBy: "bob"}
+		})
+
+		It("should allow deciding about an access request", func() {
+			Expect(ValidateAccessRequestApprovalUpdate(newAccessRequest, accessRequest)).To(BeEmpty())
+		})
+
+		It("should require a decision", func() {
+			newAccessRequest.Status.Approval = nil
+
+			Expect(ValidateAccessRequestApprovalUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.approval"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported decisions", func() {
+			newAccessRequest.Status.Approval.Decision = "Maybe"
+
+			Expect(ValidateAccessRequestApprovalUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.approval.decision"),
+				})),
+			))
+		})
+
+		It("should forbid the requester to decide about their own access request", func() {
+			newAccessRequest.Status.Approval.DecidedBy = "alice"
+
+			Expect(ValidateAccessRequestApprovalUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("status.approval.decidedBy"),
+				})),
+			))
+		})
+
+		It("should forbid changing a decision", func() {
+			accessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestDenied, DecidedBy: "bob"}
+
+			Expect(ValidateAccessRequestApprovalUpdate(newAccessRequest, accessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.approval"),
+				})),
+			))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var (
+	availableBulkOperationTypes = sets.New(
+		string(operations.BulkOperationReconcile),
+		string(operations.BulkOperationRotateCA),
+		string(operations.BulkOperationRotateObservability),
+	)
+	availableBulkOperationPhases = sets.New(
+		string(operations.BulkOperationRunning),
+		string(operations.BulkOperationSucceeded),
+		string(operations.BulkOperationFailed),
+	)
+	availableBulkOperationShootStates = sets.New(
+		string(operations.BulkOperationShootPending),
+		string(operations.BulkOperationShootInProgress),
+		string(operations.BulkOperationShootSucceeded),
+		string(operations.BulkOperationShootFailed),
+		string(operations.BulkOperationShootSkipped),
+	)
+)
+
+// ValidateBulkOperation validates a BulkOperation object.
+func ValidateBulkOperation(bulkOperation *operations.BulkOperation) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&bulkOperation.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateBulkOperationSpec(&bulkOperation.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateBulkOperationUpdate validates a BulkOperation object before an update.
+func ValidateBulkOperationUpdate(newBulkOperation, oldBulkOperation *operations.BulkOperation) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newBulkOperation.ObjectMeta, &oldBulkOperation.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newBulkOperation.Spec, oldBulkOperation.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateBulkOperation(newBulkOperation)...)
+
+	return allErrs
+}
+
+// ValidateBulkOperationSpec validates the specification of a BulkOperation object.
+func ValidateBulkOperationSpec(spec *operations.BulkOperationSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("shootSelector"))...)
+
+	if len(spec.Operation) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("operation"), "must provide an operation"))
+	} else if !availableBulkOperationTypes.Has(string(spec.Operation)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operation"), spec.Operation, sets.List(availableBulkOperationTypes)))
+	}
+
+	if rateLimit := spec.RateLimit; rateLimit != nil {
+		if rateLimit.MaxParallel != nil && *rateLimit.MaxParallel <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimit", "maxParallel"), *rateLimit.MaxParallel, "must be greater than 0"))
+		}
+		if rateLimit.Interval != nil && rateLimit.Interval.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimit", "interval"), rateLimit.Interval.Duration.String(), "must not be negative"))
+		}
+	}
+
+	if spec.MaxFailures != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.MaxFailures), fldPath.Child("maxFailures"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateBulkOperationStatusUpdate validates the status field of a BulkOperation object.
+func ValidateBulkOperationStatusUpdate(newBulkOperation, _ *operations.BulkOperation) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("status")
+		status  = newBulkOperation.Status
+	)
+
+	if len(status.Phase) > 0 && !availableBulkOperationPhases.Has(string(status.Phase)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), status.Phase, sets.List(availableBulkOperationPhases)))
+	}
+
+	shoots := sets.New[string]()
+	for i, shoot := range status.Shoots {
+		idxPath := fldPath.Child("shoots").Index(i)
+
+		if len(shoot.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the shoot"))
+		}
+		if len(shoot.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide the namespace of the shoot"))
+		}
+		if key := shoot.Namespace + "/" + shoot.Name; shoots.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		} else {
+			shoots.Insert(key)
+		}
+
+		if !availableBulkOperationShootStates.Has(string(shoot.State)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("state"), shoot.State, sets.List(availableBulkOperationShootStates)))
+		}
+	}
+
+	return allErrs
+}
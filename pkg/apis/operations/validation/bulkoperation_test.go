@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+var _ = Describe("BulkOperation validation", func() {
+	var bulkOperation *operations.BulkOperation
+
+	BeforeEach(func() {
+		bulkOperation = &operations.BulkOperation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "rotate-ca-dev",
+			},
+			Spec: operations.BulkOperationSpec{
+				ShootSelector: metav1.LabelSelector{MatchLabels: map[string]string{"stage": "dev"}},
+				Operation:     operations.BulkOperationRotateCA,
+				RateLimit: &operations.BulkOperationRateLimit{
+					MaxParallel: ptr.To[int32](5),
+					Interval:    &metav1.Duration{Duration: time.Minute},
+				},
+				MaxFailures: ptr.To[int32](2),
+			},
+		}
+	})
+
+	Describe("#ValidateBulkOperation", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateBulkOperation(bulkOperation)).To(BeEmpty())
+		})
+
+		It("should allow an empty shoot selector", func() {
+			bulkOperation.Spec.ShootSelector = metav1.LabelSelector{}
+
+			Expect(ValidateBulkOperation(bulkOperation)).To(BeEmpty())
+		})
+
+		It("should forbid BulkOperation resources with empty metadata and operation", func() {
+			bulkOperation.ObjectMeta = metav1.ObjectMeta{}
+			bulkOperation.Spec.Operation = ""
+
+			Expect(ValidateBulkOperation(bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("metadata.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.operation"),
+				})),
+			))
+		})
+
+		It("should forbid namespaced BulkOperation resources", func() {
+			bulkOperation.Namespace = "garden-dev"
+
+			Expect(ValidateBulkOperation(bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.namespace"),
+				})),
+			))
+		})
+
+		It("should forbid unsupported operations", func() {
+			bulkOperation.Spec.Operation = "delete"
+
+			Expect(ValidateBulkOperation(bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.operation"),
+				})),
+			))
+		})
+
+		It("should forbid invalid shoot selectors", func() {
+			bulkOperation.Spec.ShootSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "stage", Operator: "Foo"}}}
+
+			Expect(ValidateBulkOperation(bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+				})),
+			))
+		})
+
+		It("should forbid invalid rate limits and max failures", func() {
+			bulkOperation.Spec.RateLimit = &operations.BulkOperationRateLimit{
+				MaxParallel: ptr.To[int32](0),
+				Interval:    &metav1.Duration{Duration: -time.Second},
+			}
+			bulkOperation.Spec.MaxFailures = ptr.To[int32](-1)
+
+			Expect(ValidateBulkOperation(bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.rateLimit.maxParallel"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.rateLimit.interval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maxFailures"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateBulkOperationUpdate", func() {
+		It("should forbid changing the spec", func() {
+			newBulkOperation := bulkOperation.DeepCopy()
+			newBulkOperation.ResourceVersion = "1"
+			bulkOperation.ResourceVersion = "1"
+			newBulkOperation.Spec.Operation = operations.BulkOperationReconcile
+
+			Expect(ValidateBulkOperationUpdate(newBulkOperation, bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateBulkOperationStatusUpdate", func() {
+		It("should allow valid status updates", func() {
+			newBulkOperation := bulkOperation.DeepCopy()
+			newBulkOperation.Status = operations.BulkOperationStatus{
+				Phase: operations.BulkOperationRunning,
+				Shoots: []operations.BulkOperationShootStatus{
+					{Name: "foo", Namespace: "garden-dev", State: operations.BulkOperationShootInProgress},
+					{Name: "foo", Namespace: "garden-prod", State: operations.BulkOperationShootPending},
+				},
+			}
+
+			Expect(ValidateBulkOperationStatusUpdate(newBulkOperation, bulkOperation)).To(BeEmpty())
+		})
+
+		It("should forbid invalid status updates", func() {
+			newBulkOperation := bulkOperation.DeepCopy()
+			newBulkOperation.Status = operations.BulkOperationStatus{
+				Phase: "Foo",
+				Shoots: []operations.BulkOperationShootStatus{
+					{Name: "foo", Namespace: "garden-dev", State: operations.BulkOperationShootInProgress},
+					{Name: "foo", Namespace: "garden-dev", State: operations.BulkOperationShootPending},
+					{State: "Bar"},
+				},
+			}
+
+			Expect(ValidateBulkOperationStatusUpdate(newBulkOperation, bulkOperation)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.phase"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.shoots[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.shoots[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.shoots[2].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.shoots[2].state"),
+				})),
+			))
+		})
+	})
+})
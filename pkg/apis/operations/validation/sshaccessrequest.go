@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"golang.org/x/crypto/ssh"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+// ValidateSSHAccessRequest validates an SSHAccessRequest object.
+func ValidateSSHAccessRequest(sshAccessRequest *operations.SSHAccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&sshAccessRequest.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateSSHAccessRequestSpec(&sshAccessRequest.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateSSHAccessRequestUpdate validates an SSHAccessRequest object before an update.
+func ValidateSSHAccessRequestUpdate(newSSHAccessRequest, oldSSHAccessRequest *operations.SSHAccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newSSHAccessRequest.ObjectMeta, &oldSSHAccessRequest.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSSHAccessRequest.Spec, oldSSHAccessRequest.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateSSHAccessRequest(newSSHAccessRequest)...)
+
+	return allErrs
+}
+
+// ValidateSSHAccessRequestSpec validates the specification of an SSHAccessRequest object.
+func ValidateSSHAccessRequestSpec(spec *operations.SSHAccessRequestSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ShootRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("shootRef", "name"), "shoot reference must not be empty"))
+	}
+
+	if len(spec.SSHPublicKey) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sshPublicKey"), "sshPublicKey must not be empty"))
+	} else if _, _, _, rest, err := ssh.ParseAuthorizedKey([]byte(spec.SSHPublicKey)); err != nil || len(rest) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sshPublicKey"), spec.SSHPublicKey, "must be exactly one valid public key in authorized_keys format"))
+	}
+
+	allErrs = append(allErrs, validateAccessRequestDuration(spec.Duration.Duration, fldPath.Child("duration"))...)
+
+	if len(spec.Reason) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), "must provide a reason for the access request"))
+	}
+
+	if len(spec.Requester) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requester"), "requester must be set"))
+	}
+
+	return allErrs
+}
+
+// ValidateSSHAccessRequestStatusUpdate validates the status field of an SSHAccessRequest object.
+func ValidateSSHAccessRequestStatusUpdate(newSSHAccessRequest, oldSSHAccessRequest *operations.SSHAccessRequest) field.ErrorList {
+	return validateAccessRequestPhaseUpdate(newSSHAccessRequest.Status.Phase, oldSSHAccessRequest.Status.Phase, newSSHAccessRequest.Status.Approval, field.NewPath("status", "phase"))
+}
+
+// ValidateSSHAccessRequestApprovalUpdate validates the approval of an SSHAccessRequest object.
+func ValidateSSHAccessRequestApprovalUpdate(newSSHAccessRequest, oldSSHAccessRequest *operations.SSHAccessRequest) field.ErrorList {
+	return validateAccessRequestApprovalUpdate(newSSHAccessRequest.Status.Approval, oldSSHAccessRequest.Status.Approval, newSSHAccessRequest.Spec.Requester, field.NewPath("status", "approval"))
+}
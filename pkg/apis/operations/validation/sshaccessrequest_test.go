@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+var _ = Describe("SSHAccessRequest validation", func() {
+	const publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIETlsKq/fYx9c3shC3rT0f7z+b3BbcEYGEtl9TUiXHuV you@example.com"
+
+	var sshAccessRequest *operations.SSHAccessRequest
+
+	BeforeEach(func() {
+		sshAccessRequest = &operations.SSHAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example-ssh-access",
+				Namespace: "garden-dev",
+			},
+			Spec: operations.SSHAccessRequestSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: "example-shoot"},
+				SSHPublicKey: publicKey,
+				Duration:     metav1.Duration{Duration: time.Hour},
+				Reason:       "investigate kernel issue",
+				Requester:    "alice",
+			},
+		}
+	})
+
+	Describe("#ValidateSSHAccessRequest", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateSSHAccessRequest(sshAccessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid SSHAccessRequest resources with empty spec", func() {
+			sshAccessRequest.Spec = operations.SSHAccessRequestSpec{}
+
+			Expect(ValidateSSHAccessRequest(sshAccessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.shootRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.sshPublicKey"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.reason"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.requester"),
+				})),
+			))
+		})
+
+		DescribeTable("public key",
+			func(key string, matcher gomegatypes.GomegaMatcher) {
+				sshAccessRequest.Spec.SSHPublicKey = key
+
+				Expect(ValidateSSHAccessRequest(sshAccessRequest)).To(matcher)
+			},
+
+			Entry("valid key", publicKey, BeEmpty()),
+			Entry("invalid key", "ssh-ed25519 foo", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.sshPublicKey")})))),
+			Entry("multiple keys", publicKey+"\n"+publicKey, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.sshPublicKey")})))),
+		)
+
+		DescribeTable("duration",
+			func(duration time.Duration, matcher gomegatypes.GomegaMatcher) {
+				sshAccessRequest.Spec.Duration = metav1.Duration{Duration: duration}
+
+				Expect(ValidateSSHAccessRequest(sshAccessRequest)).To(matcher)
+			},
+
+			Entry("too short", 9*time.Minute, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.duration")})))),
+			Entry("minimum", 10*time.Minute, BeEmpty()),
+			Entry("maximum", 24*time.Hour, BeEmpty()),
+			Entry("too long", 25*time.Hour, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.duration")})))),
+		)
+	})
+
+	Describe("#ValidateSSHAccessRequestUpdate", func() {
+		It("should forbid changing the spec", func() {
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.ResourceVersion = "1"
+			sshAccessRequest.ResourceVersion = "1"
+			newSSHAccessRequest.Spec.Duration = metav1.Duration{Duration: 2 * time.Hour}
+
+			Expect(ValidateSSHAccessRequestUpdate(newSSHAccessRequest, sshAccessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateSSHAccessRequestStatusUpdate", func() {
+		It("should allow granting approved access requests", func() {
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestApproved, DecidedBy: "bob"}
+			newSSHAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Expect(ValidateSSHAccessRequestStatusUpdate(newSSHAccessRequest, sshAccessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid granting access requests which are not approved", func() {
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Expect(ValidateSSHAccessRequestStatusUpdate(newSSHAccessRequest, sshAccessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("status.phase"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateSSHAccessRequestApprovalUpdate", func() {
+		var newSSHAccessRequest *operations.SSHAccessRequest
+
+		BeforeEach(func() {
+			newSSHAccessRequest = sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestApproved, DecidedBy: "bob"}
+		})
+
+		It("should allow deciding about an access request", func() {
+			Expect(ValidateSSHAccessRequestApprovalUpdate(newSSHAccessRequest, sshAccessRequest)).To(BeEmpty())
+		})
+
+		It("should forbid the requester to decide about their own access request", func() {
+			newSSHAccessRequest.Status.Approval.DecidedBy = "alice"
+
+			Expect(ValidateSSHAccessRequestApprovalUpdate(newSSHAccessRequest, sshAccessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("status.approval.decidedBy"),
+				})),
+			))
+		})
+
+		It("should forbid changing a decision", func() {
+			sshAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestDenied, DecidedBy: "bob"}
+
+			Expect(ValidateSSHAccessRequestApprovalUpdate(newSSHAccessRequest, sshAccessRequest)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.approval"),
+				})),
+			))
+		})
+	})
+})
@@ -13,4 +13,8 @@ const (
 	// SecretNameCAGardener is a constant for the name of a Kubernetes secret object that contains the CA
 	// certificate of the Gardener control plane.
 	SecretNameCAGardener = "ca-gardener"
+
+	// PriorityAndFairnessPresetNamePrefix is the prefix for the names of the PriorityLevelConfigurations and
+	// FlowSchemas deployed for the API priority and fairness presets of the virtual garden kube-apiserver.
+	PriorityAndFairnessPresetNamePrefix = "gardener-preset-"
 )
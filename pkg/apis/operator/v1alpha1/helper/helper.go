@@ -95,6 +95,58 @@ func MutateETCDEncryptionKeyRotation(garden *operatorv1alpha1.Garden, f func(*ga
 	f(garden.Status.Credentials.Rotation.ETCDEncryptionKey)
 }
 
+// MutateETCDEncryptionKeyRotationAutomation mutates the .status.credentials.rotation.etcdEncryptionKeyAutomation field
+// based on the provided mutation function. If the field is nil then it is initialized.
+func MutateETCDEncryptionKeyRotationAutomation(garden *operatorv1alpha1.Garden, f func(*operatorv1alpha1.ETCDEncryptionKeyRotationAutomation)) {
+	if f == nil {
+		return
+	}
+
+	if garden.Status.Credentials == nil {
+		garden.Status.Credentials = &operatorv1alpha1.Credentials{}
+	}
+	if garden.Status.Credentials.Rotation == nil {
+		garden.Status.Credentials.Rotation = &operatorv1alpha1.CredentialsRotation{}
+	}
+	if garden.Status.Credentials.Rotation.ETCDEncryptionKeyAutomation == nil {
+		garden.Status.Credentials.Rotation.ETCDEncryptionKeyAutomation = &operatorv1alpha1.ETCDEncryptionKeyRotationAutomation{}
+	}
+
+	f(garden.Status.Credentials.Rotation.ETCDEncryptionKeyAutomation)
+}
+
+// GetETCDEncryptionKeyRotationTriggerID returns the trigger ID for the automated ETCD encryption key rotation
+// specified in the Garden or an empty string.
+func GetETCDEncryptionKeyRotationTriggerID(garden *operatorv1alpha1.Garden) string {
+	if etcd := garden.Spec.VirtualCluster.ETCD; etcd != nil && etcd.EncryptionKeyRotation != nil {
+		return etcd.EncryptionKeyRotation.TriggerID
+	}
+	return ""
+}
+
+// GetETCDEncryptionKeyRotationAutomationTriggerID returns the trigger ID of the most recent automated ETCD encryption
+// key rotation which was started or an empty string.
+func GetETCDEncryptionKeyRotationAutomationTriggerID(credentials *operatorv1alpha1.Credentials) string {
+	if credentials != nil && credentials.Rotation != nil && credentials.Rotation.ETCDEncryptionKeyAutomation != nil {
+		return credentials.Rotation.ETCDEncryptionKeyAutomation.TriggerID
+	}
+	return ""
+}
+
+// IsETCDEncryptionKeyRotationTriggered returns true if an automated ETCD encryption key rotation is requested in the
+// Garden which has not been started yet.
+func IsETCDEncryptionKeyRotationTriggered(garden *operatorv1alpha1.Garden) bool {
+	triggerID := GetETCDEncryptionKeyRotationTriggerID(garden)
+	return len(triggerID) > 0 && triggerID != GetETCDEncryptionKeyRotationAutomationTriggerID(garden.Status.Credentials)
+}
+
+// IsETCDEncryptionKeyRotationAutomated returns true if the current ETCD encryption key rotation was started
+// automatically because of the trigger ID specified in the Garden.
+func IsETCDEncryptionKeyRotationAutomated(garden *operatorv1alpha1.Garden) bool {
+	triggerID := GetETCDEncryptionKeyRotationTriggerID(garden)
+	return len(triggerID) > 0 && triggerID == GetETCDEncryptionKeyRotationAutomationTriggerID(garden.Status.Credentials)
+}
+
 // IsObservabilityRotationInitiationTimeAfterLastCompletionTime returns true when the lastInitiationTime in the
 // .status.credentials.rotation.observability field is newer than the lastCompletionTime. This is also true if the
 // lastCompletionTime is unset.
@@ -139,3 +191,11 @@ func HighAvailabilityEnabled(garden *operatorv1alpha1.Garden) bool {
 func TopologyAwareRoutingEnabled(settings *operatorv1alpha1.Settings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
+
+// GetMetricsFederation returns the metrics federation settings of the garden, or nil if they are not configured.
+func GetMetricsFederation(garden *operatorv1alpha1.Garden) *operatorv1alpha1.MetricsFederation {
+	if garden.Spec.RuntimeCluster.Monitoring == nil {
+		return nil
+	}
+	return garden.Spec.RuntimeCluster.Monitoring.MetricsFederation
+}
@@ -121,6 +121,52 @@ var _ = Describe("helper", func() {
 		)
 	})
 
+	Describe("#MutateETCDEncryptionKeyRotationAutomation", func() {
+		It("should do nothing when mutate function is nil", func() {
+			garden := &operatorv1alpha1.Garden{}
+			MutateETCDEncryptionKeyRotationAutomation(garden, nil)
+			Expect(GetETCDEncryptionKeyRotationAutomationTriggerID(garden.Status.Credentials)).To(BeEmpty())
+		})
+
+		DescribeTable("mutate function not nil",
+			func(garden *operatorv1alpha1.Garden) {
+				MutateETCDEncryptionKeyRotationAutomation(garden, func(automation *operatorv1alpha1.ETCDEncryptionKeyRotationAutomation) {
+					automation.TriggerID = "foo"
+				})
+				Expect(GetETCDEncryptionKeyRotationAutomationTriggerID(garden.Status.Credentials)).To(Equal("foo"))
+			},
+
+			Entry("credentials nil", &operatorv1alpha1.Garden{}),
+			Entry("rotation nil", &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{Credentials: &operatorv1alpha1.Credentials{}}}),
+			Entry("etcdEncryptionKeyAutomation nil", &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{Credentials: &operatorv1alpha1.Credentials{Rotation: &operatorv1alpha1.CredentialsRotation{}}}}),
+			Entry("etcdEncryptionKeyAutomation non-nil", &operatorv1alpha1.Garden{Status: operatorv1alpha1.GardenStatus{Credentials: &operatorv1alpha1.Credentials{Rotation: &operatorv1alpha1.CredentialsRotation{ETCDEncryptionKeyAutomation: &operatorv1alpha1.ETCDEncryptionKeyRotationAutomation{TriggerID: "bar"}}}}}),
+		)
+	})
+
+	DescribeTable("#IsETCDEncryptionKeyRotationTriggered and #IsETCDEncryptionKeyRotationAutomated",
+		func(specTriggerID, statusTriggerID string, triggered, automated bool) {
+			garden := &operatorv1alpha1.Garden{}
+			if len(specTriggerID) > 0 {
+				garden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{EncryptionKeyRotation: &operatorv1alpha1.ETCDEncryptionKeyRotation{TriggerID: specTriggerID}}
+			}
+			if len(statusTriggerID) > 0 {
+				MutateETCDEncryptionKeyRotationAutomation(garden, func(automation *operatorv1alpha1.ETCDEncryptionKeyRotationAutomation) {
+					automation.TriggerID = statusTriggerID
+				})
+			}
+
+			Expect(GetETCDEncryptionKeyRotationTriggerID(garden)).To(Equal(specTriggerID))
+			Expect(IsETCDEncryptionKeyRotationTriggered(garden)).To(Equal(triggered))
+			Expect(IsETCDEncryptionKeyRotationAutomated(garden)).To(Equal(automated))
+		},
+
+		Entry("no trigger ID", "", "", false, false),
+		Entry("no trigger ID in spec", "", "foo", false, false),
+		Entry("new trigger ID", "foo", "", true, false),
+		Entry("changed trigger ID", "bar", "foo", true, false),
+		Entry("same trigger ID", "foo", "foo", false, true),
+	)
+
 	DescribeTable("#IsShootObservabilityRotationInitiationTimeAfterLastCompletionTime",
 		func(credentials *operatorv1alpha1.Credentials, matcher gomegatypes.GomegaMatcher) {
 			Expect(IsObservabilityRotationInitiationTimeAfterLastCompletionTime(credentials)).To(matcher)
@@ -181,4 +227,15 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing enabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: true}}, true),
 		Entry("topology-aware routing disabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: false}}, false),
 	)
+
+	DescribeTable("#GetMetricsFederation",
+		func(monitoring *operatorv1alpha1.Monitoring, expected *operatorv1alpha1.MetricsFederation) {
+			garden := &operatorv1alpha1.Garden{Spec: operatorv1alpha1.GardenSpec{RuntimeCluster: operatorv1alpha1.RuntimeCluster{Monitoring: monitoring}}}
+			Expect(GetMetricsFederation(garden)).To(Equal(expected))
+		},
+
+		Entry("no monitoring settings", nil, nil),
+		Entry("no metrics federation settings", &operatorv1alpha1.Monitoring{}, nil),
+		Entry("metrics federation settings", &operatorv1alpha1.Monitoring{MetricsFederation: &operatorv1alpha1.MetricsFederation{AdditionalMatchers: []string{"{foo=\"bar\"}"}}}, &operatorv1alpha1.MetricsFederation{AdditionalMatchers: []string{"{foo=\"bar\"}"}}),
+	)
 })
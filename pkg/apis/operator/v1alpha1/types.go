@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -73,6 +74,31 @@ type RuntimeCluster struct {
 	// Volume contains settings for persistent volumes created in the runtime cluster.
 	// +optional
 	Volume *Volume `json:"volume,omitempty"`
+	// Monitoring contains settings for the monitoring components deployed in the runtime cluster.
+	// +optional
+	Monitoring *Monitoring `json:"monitoring,omitempty"`
+}
+
+// Monitoring contains settings for the monitoring components deployed in the runtime cluster.
+type Monitoring struct {
+	// MetricsFederation configures the federation of metrics from the aggregate Prometheus instances of the seeds into
+	// the garden Prometheus.
+	// +optional
+	MetricsFederation *MetricsFederation `json:"metricsFederation,omitempty"`
+}
+
+// MetricsFederation configures the federation of metrics from the aggregate Prometheus instances of the seeds into the
+// garden Prometheus. Series federated from a seed carry the `seed` label, and series related to shoots additionally
+// carry the `project` and `name` labels of the respective shoot.
+type MetricsFederation struct {
+	// SeedSelector restricts the seeds whose aggregate Prometheus instances are federated. Seeds in private networks are
+	// never federated since they are not reachable from the runtime cluster. If not set, all other seeds are federated.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+	// AdditionalMatchers is a list of series selectors (e.g. `{__name__="seed:foo:sum"}`) for metrics which are
+	// federated in addition to the default set of shoot and seed health metrics.
+	// +optional
+	AdditionalMatchers []string `json:"additionalMatchers,omitempty"`
 }
 
 // Ingress configures the Ingress specific settings of the runtime cluster.
@@ -204,6 +230,20 @@ type ETCD struct {
 	// Events contains configuration for the events etcd.
 	// +optional
 	Events *ETCDEvents `json:"events,omitempty"`
+	// EncryptionKeyRotation contains configuration for the automated rotation of the ETCD encryption keys of the
+	// virtual garden cluster.
+	// +optional
+	EncryptionKeyRotation *ETCDEncryptionKeyRotation `json:"encryptionKeyRotation,omitempty"`
+}
+
+// ETCDEncryptionKeyRotation contains configuration for the automated rotation of the ETCD encryption keys.
+type ETCDEncryptionKeyRotation struct {
+	// TriggerID is an arbitrary identifier for requesting an automated rotation of the ETCD encryption keys. Whenever it
+	// is changed, gardener-operator starts the rotation (new keys are generated and all encrypted resources are
+	// re-encrypted with them) and automatically completes it (old keys are retired) once the re-encryption has been
+	// verified. It cannot be changed while an ETCD encryption key rotation is in progress.
+	// +kubebuilder:validation:MinLength=1
+	TriggerID string `json:"triggerID"`
 }
 
 // ETCDMain contains configuration for the main etcd.
@@ -296,6 +336,56 @@ type KubeAPIServerConfig struct {
 	// SNI contains configuration options for the TLS SNI settings.
 	// +optional
 	SNI *SNI `json:"sni,omitempty"`
+	// PriorityAndFairness contains configuration for the API priority and fairness of the virtual garden
+	// kube-apiserver. It requires Kubernetes version 1.29 or higher.
+	// +optional
+	PriorityAndFairness *PriorityAndFairness `json:"priorityAndFairness,omitempty"`
+}
+
+// PriorityAndFairness contains configuration for the API priority and fairness of the virtual garden kube-apiserver.
+type PriorityAndFairness struct {
+	// Presets is a list of presets maintained by gardener-operator which shall be deployed to the virtual garden
+	// cluster. Each preset consists of a PriorityLevelConfiguration and a FlowSchema.
+	// +optional
+	Presets []PriorityAndFairnessPreset `json:"presets,omitempty"`
+	// PriorityLevels is a list of custom PriorityLevelConfigurations which shall be deployed to the virtual garden
+	// cluster.
+	// +optional
+	PriorityLevels []PriorityLevel `json:"priorityLevels,omitempty"`
+	// FlowSchemas is a list of custom FlowSchemas which shall be deployed to the virtual garden cluster.
+	// +optional
+	FlowSchemas []FlowSchema `json:"flowSchemas,omitempty"`
+}
+
+// PriorityAndFairnessPreset is a name of a preset for API priority and fairness maintained by gardener-operator.
+// +kubebuilder:validation:Enum=gardenlets;gardener-dashboard
+type PriorityAndFairnessPreset string
+
+const (
+	// PriorityAndFairnessPresetGardenlets is a preset which assigns the requests of all gardenlets to a dedicated
+	// priority level such that they are isolated from the traffic of other clients.
+	PriorityAndFairnessPresetGardenlets PriorityAndFairnessPreset = "gardenlets"
+	// PriorityAndFairnessPresetGardenerDashboard is a preset which assigns the requests of the gardener-dashboard to a
+	// dedicated priority level such that heavy dashboard usage cannot starve other clients.
+	PriorityAndFairnessPresetGardenerDashboard PriorityAndFairnessPreset = "gardener-dashboard"
+)
+
+// PriorityLevel contains the specification of a custom PriorityLevelConfiguration.
+type PriorityLevel struct {
+	// Name is the name of the PriorityLevelConfiguration.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Spec is the specification of the PriorityLevelConfiguration.
+	Spec flowcontrolv1.PriorityLevelConfigurationSpec `json:"spec"`
+}
+
+// FlowSchema contains the specification of a custom FlowSchema.
+type FlowSchema struct {
+	// Name is the name of the FlowSchema.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Spec is the specification of the FlowSchema.
+	Spec flowcontrolv1.FlowSchemaSpec `json:"spec"`
 }
 
 // AuditWebhook contains settings related to an audit webhook configuration.
@@ -492,6 +582,9 @@ type GardenerControllerManagerConfig struct {
 	// specified.
 	// +optional
 	DefaultProjectQuotas []ProjectQuotaConfiguration `json:"defaultProjectQuotas,omitempty"`
+	// DefaultProjectLimitRanges is the default configuration matching projects are set up with.
+	// +optional
+	DefaultProjectLimitRanges []ProjectLimitRangeConfiguration `json:"defaultProjectLimitRanges,omitempty"`
 	// LogLevel is the configured log level for the gardener-controller-manager. Must be one of [info,debug,error].
 	// Defaults to info.
 	// +kubebuilder:validation:Enum=info;debug;error
@@ -511,6 +604,17 @@ type ProjectQuotaConfiguration struct {
 	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
 }
 
+// ProjectLimitRangeConfiguration defines limit range configurations.
+type ProjectLimitRangeConfiguration struct {
+	// Config is the limit range specification used for the project set-up.
+	// Only v1.LimitRange resources are supported.
+	Config runtime.RawExtension `json:"config"`
+	// ProjectSelector is an optional setting to select the projects considered for limit ranges.
+	// Defaults to empty LabelSelector, which matches all projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+}
+
 // GardenerSchedulerConfig contains configuration settings for the gardener-scheduler.
 type GardenerSchedulerConfig struct {
 	gardencorev1beta1.KubernetesConfig `json:",inline"`
@@ -659,6 +763,20 @@ type CredentialsRotation struct {
 	// Observability contains information about the observability credential rotation.
 	// +optional
 	Observability *gardencorev1beta1.ObservabilityRotation `json:"observability,omitempty"`
+	// ETCDEncryptionKeyAutomation contains information about the automated ETCD encryption key rotation.
+	// +optional
+	ETCDEncryptionKeyAutomation *ETCDEncryptionKeyRotationAutomation `json:"etcdEncryptionKeyAutomation,omitempty"`
+}
+
+// ETCDEncryptionKeyRotationAutomation contains information about the automated ETCD encryption key rotation.
+type ETCDEncryptionKeyRotationAutomation struct {
+	// TriggerID is the identifier of the most recent automated ETCD encryption key rotation which was started by
+	// gardener-operator.
+	TriggerID string `json:"triggerID"`
+	// LastVerificationTime is the most recent time when it was verified that all encrypted resources were re-encrypted
+	// with the new ETCD encryption key.
+	// +optional
+	LastVerificationTime *metav1.Time `json:"lastVerificationTime,omitempty"`
 }
 
 const (
@@ -670,6 +788,9 @@ const (
 	VirtualGardenAPIServerAvailable gardencorev1beta1.ConditionType = "VirtualGardenAPIServerAvailable"
 	// ObservabilityComponentsHealthy is a constant for a condition type indicating the health of observability components.
 	ObservabilityComponentsHealthy gardencorev1beta1.ConditionType = v1beta1constants.ObservabilityComponentsHealthy
+	// ConfigurationInSync is a constant for a condition type indicating whether the objects deployed for the garden
+	// components match their desired state, i.e., whether they were not modified outside of gardener-operator.
+	ConfigurationInSync gardencorev1beta1.ConditionType = "ConfigurationInSync"
 )
 
 // AvailableOperationAnnotations is the set of available operation annotations for Garden resources.
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"strings"
 
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
@@ -37,6 +38,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
@@ -120,6 +122,12 @@ func validateVirtualClusterUpdate(oldGarden, newGarden *operatorv1alpha1.Garden)
 	allErrs = append(allErrs, gardencorevalidation.ValidateKubernetesVersionUpdate(newVirtualCluster.Kubernetes.Version, oldVirtualCluster.Kubernetes.Version, false, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateEncryptionConfigUpdate(oldGarden, newGarden)...)
 
+	if oldTriggerID, newTriggerID := helper.GetETCDEncryptionKeyRotationTriggerID(oldGarden), helper.GetETCDEncryptionKeyRotationTriggerID(newGarden); oldTriggerID != newTriggerID {
+		if phase := helper.GetETCDEncryptionKeyRotationPhase(newGarden.Status.Credentials); len(phase) > 0 && phase != gardencorev1beta1.RotationCompleted {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("etcd", "encryptionKeyRotation", "triggerID"), "cannot change trigger ID if .status.credentials.rotation.etcdEncryptionKey.phase is not 'Completed'"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -147,6 +155,33 @@ func validateRuntimeCluster(runtimeCluster operatorv1alpha1.RuntimeCluster, fldP
 		domains.Insert(domain)
 	}
 
+	if runtimeCluster.Monitoring != nil && runtimeCluster.Monitoring.MetricsFederation != nil {
+		allErrs = append(allErrs, validateMetricsFederation(runtimeCluster.Monitoring.MetricsFederation, fldPath.Child("monitoring", "metricsFederation"))...)
+	}
+
+	return allErrs
+}
+
+func validateMetricsFederation(metricsFederation *operatorv1alpha1.MetricsFederation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if metricsFederation.SeedSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(metricsFederation.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
+	}
+
+	matchers := sets.New[string]()
+	for i, matcher := range metricsFederation.AdditionalMatchers {
+		idxPath := fldPath.Child("additionalMatchers").Index(i)
+
+		if !strings.HasPrefix(matcher, "{") || !strings.HasSuffix(matcher, "}") || len(strings.TrimSpace(matcher[1:len(matcher)-1])) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath, matcher, "must be a non-empty series selector enclosed in curly braces, e.g. {__name__=\"foo\"}"))
+		}
+		if matchers.Has(matcher) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, matcher))
+		}
+		matchers.Insert(matcher)
+	}
+
 	return allErrs
 }
 
@@ -177,6 +212,10 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeAPIServer(coreKubeAPIServerConfig, virtualCluster.Kubernetes.Version, true, gardenerutils.DefaultResourcesForEncryption(), path)...)
 	}
 
+	if kubeAPIServer := virtualCluster.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.PriorityAndFairness != nil {
+		allErrs = append(allErrs, validatePriorityAndFairness(kubeAPIServer.PriorityAndFairness, virtualCluster.Kubernetes.Version, fldPath.Child("kubernetes", "kubeAPIServer", "priorityAndFairness"))...)
+	}
+
 	if kubeControllerManager := virtualCluster.Kubernetes.KubeControllerManager; kubeControllerManager != nil && kubeControllerManager.KubeControllerManagerConfig != nil {
 		path := fldPath.Child("kubernetes", "kubeControllerManager")
 
@@ -206,6 +245,71 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 	return allErrs
 }
 
+// reservedPriorityAndFairnessNames are the names of the mandatory PriorityLevelConfigurations and FlowSchemas which
+// are maintained by the kube-apiserver itself.
+var reservedPriorityAndFairnessNames = sets.New(flowcontrolv1.PriorityLevelConfigurationNameExempt, flowcontrolv1.PriorityLevelConfigurationNameCatchAll)
+
+func validatePriorityAndFairness(priorityAndFairness *operatorv1alpha1.PriorityAndFairness, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if k8sGreaterEqual129, _ := versionutils.CheckVersionMeetsConstraint(kubernetesVersion, ">= 1.29"); !k8sGreaterEqual129 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "API priority and fairness configuration is only supported for Kubernetes versions >= 1.29"))
+	}
+
+	presets := sets.New[operatorv1alpha1.PriorityAndFairnessPreset]()
+	for i, preset := range priorityAndFairness.Presets {
+		if presets.Has(preset) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("presets").Index(i), preset))
+		}
+		presets.Insert(preset)
+	}
+
+	validateName := func(name string, names sets.Set[string], fldPath *field.Path) field.ErrorList {
+		allErrs := field.ErrorList{}
+
+		allErrs = append(allErrs, gardencorevalidation.ValidateDNS1123Subdomain(name, fldPath)...)
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, name))
+		}
+		if reservedPriorityAndFairnessNames.Has(name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "name is reserved for objects maintained by the kube-apiserver"))
+		}
+		if strings.HasPrefix(name, operatorv1alpha1.PriorityAndFairnessPresetNamePrefix) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("name must not start with %q since this prefix is reserved for presets", operatorv1alpha1.PriorityAndFairnessPresetNamePrefix)))
+		}
+		names.Insert(name)
+
+		return allErrs
+	}
+
+	priorityLevelNames := sets.New[string]()
+	for i, priorityLevel := range priorityAndFairness.PriorityLevels {
+		idxPath := fldPath.Child("priorityLevels").Index(i)
+
+		allErrs = append(allErrs, validateName(priorityLevel.Name, priorityLevelNames, idxPath.Child("name"))...)
+
+		if priorityLevel.Spec.Type != flowcontrolv1.PriorityLevelEnablementLimited {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("spec", "type"), priorityLevel.Spec.Type, []string{string(flowcontrolv1.PriorityLevelEnablementLimited)}))
+		}
+	}
+
+	flowSchemaNames := sets.New[string]()
+	for i, flowSchema := range priorityAndFairness.FlowSchemas {
+		idxPath := fldPath.Child("flowSchemas").Index(i)
+
+		allErrs = append(allErrs, validateName(flowSchema.Name, flowSchemaNames, idxPath.Child("name"))...)
+
+		if len(flowSchema.Spec.PriorityLevelConfiguration.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("spec", "priorityLevelConfiguration", "name"), "must provide the name of a priority level"))
+		}
+		if len(flowSchema.Spec.Rules) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("spec", "rules"), "must provide at least one rule"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateGardener(gardener operatorv1alpha1.Gardener, kubernetes operatorv1alpha1.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -330,6 +434,9 @@ func validateGardenerControllerManagerConfig(config *operatorv1alpha1.GardenerCo
 	for i, quota := range config.DefaultProjectQuotas {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(quota.ProjectSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("defaultProjectQuotas").Index(i).Child("projectSelector"))...)
 	}
+	for i, limitRange := range config.DefaultProjectLimitRanges {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(limitRange.ProjectSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("defaultProjectLimitRanges").Index(i).Child("projectSelector"))...)
+	}
 
 	return allErrs
 }
@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -873,6 +874,55 @@ var _ = Describe("Validation Tests", func() {
 					))
 				})
 			})
+
+			Context("metrics federation", func() {
+				BeforeEach(func() {
+					garden.Spec.RuntimeCluster.Monitoring = &operatorv1alpha1.Monitoring{
+						MetricsFederation: &operatorv1alpha1.MetricsFederation{},
+					}
+				})
+
+				It("should allow a valid configuration", func() {
+					garden.Spec.RuntimeCluster.Monitoring.MetricsFederation.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}}
+					garden.Spec.RuntimeCluster.Monitoring.MetricsFederation.AdditionalMatchers = []string{`{__name__="seed:foo:sum"}`, `{__name__=~"shoot:bar:(.+)",project="garden"}`}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain about an invalid seed selector", func() {
+					garden.Spec.RuntimeCluster.Monitoring.MetricsFederation.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"-foo": "bar"}}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.monitoring.metricsFederation.seedSelector.matchLabels"),
+						})),
+					))
+				})
+
+				It("should complain about invalid and duplicate matchers", func() {
+					garden.Spec.RuntimeCluster.Monitoring.MetricsFederation.AdditionalMatchers = []string{"", "{}", "seed:foo:sum", `{__name__="foo"}`, `{__name__="foo"}`}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.monitoring.metricsFederation.additionalMatchers[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.monitoring.metricsFederation.additionalMatchers[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.monitoring.metricsFederation.additionalMatchers[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.runtimeCluster.monitoring.metricsFederation.additionalMatchers[4]"),
+						})),
+					))
+				})
+			})
 		})
 
 		Context("virtual cluster", func() {
@@ -942,6 +992,110 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("Priority and fairness", func() {
+				BeforeEach(func() {
+					garden.Spec.VirtualCluster.Kubernetes.Version = "1.29.0"
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{
+						PriorityAndFairness: &operatorv1alpha1.PriorityAndFairness{
+							Presets: []operatorv1alpha1.PriorityAndFairnessPreset{operatorv1alpha1.PriorityAndFairnessPresetGardenlets},
+							PriorityLevels: []operatorv1alpha1.PriorityLevel{{
+								Name: "dashboard-users",
+								Spec: flowcontrolv1.PriorityLevelConfigurationSpec{
+									Type:    flowcontrolv1.PriorityLevelEnablementLimited,
+									Limited: &flowcontrolv1.LimitedPriorityLevelConfiguration{NominalConcurrencyShares: ptr.To[int32](10)},
+								},
+							}},
+							FlowSchemas: []operatorv1alpha1.FlowSchema{{
+								Name: "dashboard-users",
+								Spec: flowcontrolv1.FlowSchemaSpec{
+									PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: "dashboard-users"},
+									Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+										Subjects: []flowcontrolv1.Subject{{Kind: flowcontrolv1.SubjectKindGroup, Group: &flowcontrolv1.GroupSubject{Name: "dashboard-users"}}},
+									}},
+								},
+							}},
+						},
+					}
+				})
+
+				It("should allow a valid configuration", func() {
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should forbid the configuration for Kubernetes versions < 1.29", func() {
+					garden.Spec.VirtualCluster.Kubernetes.Version = "1.28.5"
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness"),
+					}))))
+				})
+
+				It("should complain about duplicate presets", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.PriorityAndFairness.Presets = append(garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.PriorityAndFairness.Presets, operatorv1alpha1.PriorityAndFairnessPresetGardenlets)
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.presets[1]"),
+					}))))
+				})
+
+				It("should complain about invalid priority levels", func() {
+					priorityAndFairness := garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.PriorityAndFairness
+					priorityAndFairness.PriorityLevels = append(priorityAndFairness.PriorityLevels,
+						operatorv1alpha1.PriorityLevel{Name: "dashboard-users", Spec: priorityAndFairness.PriorityLevels[0].Spec},
+						operatorv1alpha1.PriorityLevel{Name: "exempt", Spec: flowcontrolv1.PriorityLevelConfigurationSpec{Type: flowcontrolv1.PriorityLevelEnablementExempt}},
+						operatorv1alpha1.PriorityLevel{Name: "gardener-preset-foo", Spec: priorityAndFairness.PriorityLevels[0].Spec},
+					)
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.priorityLevels[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.priorityLevels[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.priorityLevels[2].spec.type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.priorityLevels[3].name"),
+						})),
+					))
+				})
+
+				It("should complain about invalid flow schemas", func() {
+					priorityAndFairness := garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.PriorityAndFairness
+					priorityAndFairness.FlowSchemas = append(priorityAndFairness.FlowSchemas,
+						operatorv1alpha1.FlowSchema{Name: "Foo"},
+						operatorv1alpha1.FlowSchema{Name: "catch-all", Spec: priorityAndFairness.FlowSchemas[0].Spec},
+					)
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.flowSchemas[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.flowSchemas[1].spec.priorityLevelConfiguration.name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.flowSchemas[1].spec.rules"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.priorityAndFairness.flowSchemas[2].name"),
+						})),
+					))
+				})
+			})
+
 			Context("Gardener", func() {
 				Context("APIServer", func() {
 					BeforeEach(func() {
@@ -1562,6 +1716,21 @@ var _ = Describe("Validation Tests", func() {
 							}))))
 						})
 					})
+
+					Context("Default Project Limit Ranges", func() {
+						It("should complain when invalid label selectors were specified", func() {
+							garden.Spec.VirtualCluster.Gardener.ControllerManager = &operatorv1alpha1.GardenerControllerManagerConfig{
+								DefaultProjectLimitRanges: []operatorv1alpha1.ProjectLimitRangeConfiguration{{
+									ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"-": "!"}},
+								}},
+							}
+
+							Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerControllerManager.defaultProjectLimitRanges[0].projectSelector.matchLabels"),
+							}))))
+						})
+					})
 				})
 
 				Context("Scheduler", func() {
@@ -1784,6 +1953,38 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("etcd encryption key rotation", func() {
+				BeforeEach(func() {
+					newGarden.Spec.VirtualCluster.ETCD = &operatorv1alpha1.ETCD{EncryptionKeyRotation: &operatorv1alpha1.ETCDEncryptionKeyRotation{TriggerID: "foo"}}
+				})
+
+				It("should allow changing the trigger ID if no rotation was performed yet", func() {
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+
+				It("should allow changing the trigger ID if the rotation is completed", func() {
+					newGarden.Status.Credentials = &operatorv1alpha1.Credentials{Rotation: &operatorv1alpha1.CredentialsRotation{ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationCompleted}}}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+
+				It("should forbid changing the trigger ID if the rotation is in progress", func() {
+					newGarden.Status.Credentials = &operatorv1alpha1.Credentials{Rotation: &operatorv1alpha1.CredentialsRotation{ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationPrepared}}}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.etcd.encryptionKeyRotation.triggerID"),
+					}))))
+				})
+
+				It("should allow other changes if the rotation is in progress", func() {
+					oldGarden.Spec.VirtualCluster.ETCD = newGarden.Spec.VirtualCluster.ETCD.DeepCopy()
+					newGarden.Status.Credentials = &operatorv1alpha1.Credentials{Rotation: &operatorv1alpha1.CredentialsRotation{ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationPreparing}}}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(BeEmpty())
+				})
+			})
+
 			Context("kubernetes", func() {
 				It("should not not allow version downgrade", func() {
 					version := semver.MustParse(newGarden.Spec.VirtualCluster.Kubernetes.Version)
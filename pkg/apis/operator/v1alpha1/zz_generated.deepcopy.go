@@ -172,6 +172,11 @@ func (in *CredentialsRotation) DeepCopyInto(out *CredentialsRotation) {
 		*out = new(v1beta1.ObservabilityRotation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ETCDEncryptionKeyAutomation != nil {
+		in, out := &in.ETCDEncryptionKeyAutomation, &out.ETCDEncryptionKeyAutomation
+		*out = new(ETCDEncryptionKeyRotationAutomation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -311,6 +316,11 @@ func (in *ETCD) DeepCopyInto(out *ETCD) {
 		*out = new(ETCDEvents)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EncryptionKeyRotation != nil {
+		in, out := &in.EncryptionKeyRotation, &out.EncryptionKeyRotation
+		*out = new(ETCDEncryptionKeyRotation)
+		**out = **in
+	}
 	return
 }
 
@@ -324,6 +334,42 @@ func (in *ETCD) DeepCopy() *ETCD {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDEncryptionKeyRotation) DeepCopyInto(out *ETCDEncryptionKeyRotation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDEncryptionKeyRotation.
+func (in *ETCDEncryptionKeyRotation) DeepCopy() *ETCDEncryptionKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDEncryptionKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDEncryptionKeyRotationAutomation) DeepCopyInto(out *ETCDEncryptionKeyRotationAutomation) {
+	*out = *in
+	if in.LastVerificationTime != nil {
+		in, out := &in.LastVerificationTime, &out.LastVerificationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDEncryptionKeyRotationAutomation.
+func (in *ETCDEncryptionKeyRotationAutomation) DeepCopy() *ETCDEncryptionKeyRotationAutomation {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDEncryptionKeyRotationAutomation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDEvents) DeepCopyInto(out *ETCDEvents) {
 	*out = *in
@@ -371,6 +417,23 @@ func (in *ETCDMain) DeepCopy() *ETCDMain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowSchema) DeepCopyInto(out *FlowSchema) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowSchema.
+func (in *FlowSchema) DeepCopy() *FlowSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Garden) DeepCopyInto(out *Garden) {
 	*out = *in
@@ -630,6 +693,13 @@ func (in *GardenerControllerManagerConfig) DeepCopyInto(out *GardenerControllerM
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DefaultProjectLimitRanges != nil {
+		in, out := &in.DefaultProjectLimitRanges, &out.DefaultProjectLimitRanges
+		*out = make([]ProjectLimitRangeConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LogLevel != nil {
 		in, out := &in.LogLevel, &out.LogLevel
 		*out = new(string)
@@ -819,6 +889,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(SNI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PriorityAndFairness != nil {
+		in, out := &in.PriorityAndFairness, &out.PriorityAndFairness
+		*out = new(PriorityAndFairness)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -901,6 +976,53 @@ func (in *Maintenance) DeepCopy() *Maintenance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsFederation) DeepCopyInto(out *MetricsFederation) {
+	*out = *in
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalMatchers != nil {
+		in, out := &in.AdditionalMatchers, &out.AdditionalMatchers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsFederation.
+func (in *MetricsFederation) DeepCopy() *MetricsFederation {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsFederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	if in.MetricsFederation != nil {
+		in, out := &in.MetricsFederation, &out.MetricsFederation
+		*out = new(MetricsFederation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Networking) DeepCopyInto(out *Networking) {
 	*out = *in
@@ -917,6 +1039,80 @@ func (in *Networking) DeepCopy() *Networking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityAndFairness) DeepCopyInto(out *PriorityAndFairness) {
+	*out = *in
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]PriorityAndFairnessPreset, len(*in))
+		copy(*out, *in)
+	}
+	if in.PriorityLevels != nil {
+		in, out := &in.PriorityLevels, &out.PriorityLevels
+		*out = make([]PriorityLevel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlowSchemas != nil {
+		in, out := &in.FlowSchemas, &out.FlowSchemas
+		*out = make([]FlowSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityAndFairness.
+func (in *PriorityAndFairness) DeepCopy() *PriorityAndFairness {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityAndFairness)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityLevel) DeepCopyInto(out *PriorityLevel) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityLevel.
+func (in *PriorityLevel) DeepCopy() *PriorityLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLimitRangeConfiguration) DeepCopyInto(out *ProjectLimitRangeConfiguration) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLimitRangeConfiguration.
+func (in *ProjectLimitRangeConfiguration) DeepCopy() *ProjectLimitRangeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLimitRangeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectQuotaConfiguration) DeepCopyInto(out *ProjectQuotaConfiguration) {
 	*out = *in
@@ -1041,6 +1237,11 @@ func (in *RuntimeCluster) DeepCopyInto(out *RuntimeCluster) {
 		*out = new(Volume)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -126,6 +126,10 @@ const (
 	// HighAvailabilityConfigZonePinning is a constant for an annotation on a Namespace which enables pinning of
 	// workload to the specified zones.
 	HighAvailabilityConfigZonePinning = "high-availability-config.resources.gardener.cloud/zone-pinning"
+	// HighAvailabilityConfigUnavailableZones is a constant for an annotation on a Namespace which describes the
+	// availability zones which are currently affected by an outage. These zones are temporarily excluded from the node
+	// affinity and the topology spread constraints of Deployments.
+	HighAvailabilityConfigUnavailableZones = "high-availability-config.resources.gardener.cloud/unavailable-zones"
 	// HighAvailabilityConfigType is a constant for a label on a resource which describes which component type it is.
 	HighAvailabilityConfigType = "high-availability-config.resources.gardener.cloud/type"
 	// HighAvailabilityConfigHostSpread is a constant for an annotation on a resource which enforces a topology spread
@@ -252,6 +256,27 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// CRDManagement configures the lifecycle management of the CustomResourceDefinitions which are part of this
+	// managed resource.
+	// +optional
+	CRDManagement *CRDManagement `json:"crdManagement,omitempty"`
+}
+
+// CRDManagement configures the lifecycle management of CustomResourceDefinitions which are part of a managed resource.
+type CRDManagement struct {
+	// WaitForEstablished specifies whether the remaining resources should only be applied after all
+	// CustomResourceDefinitions are established (defaults to false).
+	// +optional
+	WaitForEstablished *bool `json:"waitForEstablished,omitempty"`
+	// ProtectFromDeletion specifies whether CustomResourceDefinitions should only be deleted once no custom resources of
+	// the defined kinds exist anymore (defaults to false).
+	// +optional
+	ProtectFromDeletion *bool `json:"protectFromDeletion,omitempty"`
+	// ConversionWebhookCABundleSecretRef is a reference to a secret in the namespace of the managed resource. The
+	// certificate bundle in its `bundle.crt` data key is injected into the conversion webhook client configuration of
+	// all CustomResourceDefinitions which use the `Webhook` conversion strategy.
+	// +optional
+	ConversionWebhookCABundleSecretRef *corev1.LocalObjectReference `json:"conversionWebhookCABundleSecretRef,omitempty"`
 }
 
 // ManagedResourceStatus is the status of a managed resource.
@@ -16,6 +16,37 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRDManagement) DeepCopyInto(out *CRDManagement) {
+	*out = *in
+	if in.WaitForEstablished != nil {
+		in, out := &in.WaitForEstablished, &out.WaitForEstablished
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProtectFromDeletion != nil {
+		in, out := &in.ProtectFromDeletion, &out.ProtectFromDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConversionWebhookCABundleSecretRef != nil {
+		in, out := &in.ConversionWebhookCABundleSecretRef, &out.ConversionWebhookCABundleSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRDManagement.
+func (in *CRDManagement) DeepCopy() *CRDManagement {
+	if in == nil {
+		return nil
+	}
+	out := new(CRDManagement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedResource) DeepCopyInto(out *ManagedResource) {
 	*out = *in
@@ -128,6 +159,11 @@ func (in *ManagedResourceSpec) DeepCopyInto(out *ManagedResourceSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CRDManagement != nil {
+		in, out := &in.CRDManagement, &out.CRDManagement
+		*out = new(CRDManagement)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6,6 +6,7 @@ package seedmanagement
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 )
@@ -55,6 +56,9 @@ type ManagedSeedSetSpec struct {
 	// RevisionHistoryLimit is the maximum number of revisions that will be maintained
 	// in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.
 	RevisionHistoryLimit *int32
+	// Paused indicates that updates of ManagedSeeds / Shoots to a new revision of Template / ShootTemplate are held back.
+	// Scaling is not affected.
+	Paused bool
 }
 
 // UpdateStrategy specifies the strategy that the ManagedSeedSet
@@ -76,12 +80,21 @@ const (
 	// applied to all ManagedSeeds / Shoots in the ManagedSeedSet with respect to the ManagedSeedSet
 	// ordering constraints.
 	RollingUpdateStrategyType UpdateStrategyType = "RollingUpdate"
+	// OnDeleteStrategyType indicates that ManagedSeeds / Shoots in the ManagedSeedSet are not updated automatically.
+	// Only replicas which are created after they were deleted use the new revision of Template / ShootTemplate.
+	OnDeleteStrategyType UpdateStrategyType = "OnDelete"
 )
 
 // RollingUpdateStrategy is used to communicate parameter for RollingUpdateStrategyType.
 type RollingUpdateStrategy struct {
 	// Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.
 	Partition *int32
+	// MaxUnavailable is the maximum number of replicas that can be unavailable during the update. Value can be an
+	// absolute number or a percentage of the desired replicas (rounded down). Defaults to 1.
+	MaxUnavailable *intstr.IntOrString
+	// MaxSurge is the maximum number of replicas that can be created in addition to the desired replicas during the
+	// update. Value can be an absolute number or a percentage of the desired replicas (rounded up). Defaults to 0.
+	MaxSurge *intstr.IntOrString
 }
 
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
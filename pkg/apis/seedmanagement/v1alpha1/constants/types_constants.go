@@ -8,4 +8,7 @@ const (
 	// AnnotationProtectFromDeletion is a constant for an annotation on a replica of a ManagedSeedSet
 	//(either ManagedSeed or Shoot) to protect it from deletion..
 	AnnotationProtectFromDeletion = "seedmanagement.gardener.cloud/protect-from-deletion"
+	// LabelManagedSeedSetRevision is a constant for a label on a replica of a ManagedSeedSet (either ManagedSeed or
+	// Shoot) that contains the revision of the ManagedSeedSet templates the replica was created or last updated from.
+	LabelManagedSeedSetRevision = "seedmanagement.gardener.cloud/managedseedset-revision"
 )
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
 
@@ -41,4 +42,12 @@ func SetDefaults_RollingUpdateStrategy(obj *RollingUpdateStrategy) {
 	if obj.Partition == nil {
 		obj.Partition = ptr.To[int32](0)
 	}
+
+	// Set default maxUnavailable and maxSurge
+	if obj.MaxUnavailable == nil {
+		obj.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+	}
+	if obj.MaxSurge == nil {
+		obj.MaxSurge = ptr.To(intstr.FromInt32(0))
+	}
 }
@@ -7,6 +7,7 @@ package v1alpha1_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
@@ -64,27 +65,33 @@ var _ = Describe("Defaults", func() {
 	})
 
 	Describe("RollingUpdateStrategy defaulting", func() {
-		It("should default partition to 0", func() {
+		It("should default partition to 0, maxUnavailable to 1, and maxSurge to 0", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				RollingUpdate: &RollingUpdateStrategy{},
 			}
 			SetObjectDefaults_ManagedSeedSet(obj)
 
 			Expect(obj.Spec.UpdateStrategy.RollingUpdate).To(Equal(&RollingUpdateStrategy{
-				Partition: ptr.To[int32](0),
+				Partition:      ptr.To[int32](0),
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				MaxSurge:       ptr.To(intstr.FromInt32(0)),
 			}))
 		})
 
 		It("should not overwrote the already set values for RollingUpdateStrategy", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				RollingUpdate: &RollingUpdateStrategy{
-					Partition: ptr.To[int32](1),
+					Partition:      ptr.To[int32](1),
+					MaxUnavailable: ptr.To(intstr.FromString("50%")),
+					MaxSurge:       ptr.To(intstr.FromInt32(2)),
 				},
 			}
 			SetObjectDefaults_ManagedSeedSet(obj)
 
 			Expect(obj.Spec.UpdateStrategy.RollingUpdate).To(Equal(&RollingUpdateStrategy{
-				Partition: ptr.To[int32](1),
+				Partition:      ptr.To[int32](1),
+				MaxUnavailable: ptr.To(intstr.FromString("50%")),
+				MaxSurge:       ptr.To(intstr.FromInt32(2)),
 			}))
 		})
 	})
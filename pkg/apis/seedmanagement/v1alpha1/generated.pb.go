@@ -22,6 +22,8 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -509,116 +511,122 @@ func init() {
 }
 
 var fileDescriptor_d64c05a219673fe5 = []byte{
-	// 1732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x9f, 0x9e, 0x19, 0xcf, 0xb8, 0x9f, 0x33, 0x9e, 0x4c, 0x65, 0x36, 0x78, 0x47, 0xc2, 0x1e,
-	0x59, 0x02, 0x0d, 0x1f, 0xdb, 0x26, 0xc3, 0x0a, 0x85, 0x85, 0xac, 0xe4, 0x9e, 0x84, 0xec, 0xae,
-	0x32, 0x89, 0x29, 0xcf, 0x04, 0x09, 0x71, 0xa0, 0xdc, 0x5d, 0xf1, 0x34, 0x71, 0x7f, 0x6c, 0x57,
-	0xd9, 0x1b, 0x0b, 0x09, 0xad, 0xb8, 0x71, 0x40, 0x42, 0xfb, 0x2f, 0x20, 0xf1, 0xb7, 0xe4, 0xb8,
-	0x42, 0x1c, 0x56, 0x20, 0x59, 0x89, 0x41, 0x48, 0x70, 0xe1, 0x3e, 0x07, 0x84, 0xaa, 0xba, 0xfa,
-	0xd3, 0x36, 0x19, 0x62, 0x93, 0xc3, 0xde, 0xba, 0xde, 0xc7, 0xef, 0xbd, 0x7a, 0xf5, 0xab, 0xaa,
-	0x57, 0x0d, 0xa7, 0x7d, 0x87, 0x5f, 0x0c, 0x7b, 0x86, 0xe5, 0xbb, 0xad, 0x3e, 0x09, 0x6d, 0xea,
-	0xd1, 0x30, 0xfd, 0x08, 0x9e, 0xf6, 0x5b, 0x24, 0x70, 0x58, 0x8b, 0x51, 0x6a, 0xbb, 0xc4, 0x23,
-	0x7d, 0xea, 0x52, 0x8f, 0xb7, 0x46, 0xb7, 0xc8, 0x20, 0xb8, 0x20, 0xb7, 0x5a, 0x7d, 0x61, 0x46,
-	0x38, 0xb5, 0x8d, 0x20, 0xf4, 0xb9, 0x8f, 0xee, 0xa4, 0x70, 0x46, 0x8c, 0x92, 0x7e, 0x04, 0x4f,
-	0xfb, 0x86, 0x80, 0x33, 0xf2, 0x70, 0x46, 0x0c, 0x77, 0x60, 0x5e, 0x2d, 0x1b, 0xcb, 0x0f, 0x69,
-	0x6b, 0x74, 0xab, 0x47, 0xf9, 0x6c, 0x0a, 0x07, 0xef, 0x64, 0x31, 0xfc, 0xbe, 0xdf, 0x92, 0xe2,
-	0xde, 0xf0, 0x89, 0x1c, 0xc9, 0x81, 0xfc, 0x52, 0xe6, 0xcd, 0xa7, 0xb7, 0x99, 0xe1, 0xf8, 0x02,
-	0x38, 0xc6, 0x9d, 0x81, 0x7c, 0x37, 0xb5, 0x71, 0x89, 0x75, 0xe1, 0x78, 0x34, 0x1c, 0xa7, 0xd9,
-	0xb8, 0x94, 0x93, 0x79, 0x5e, 0xad, 0x45, 0x5e, 0xe1, 0xd0, 0xe3, 0x8e, 0x4b, 0x67, 0x1c, 0xbe,
-	0xf7, 0x2a, 0x07, 0x66, 0x5d, 0x50, 0x97, 0x14, 0xfd, 0x9a, 0x2f, 0xd6, 0x41, 0xbf, 0x2f, 0x8b,
-	0x34, 0xa0, 0x1c, 0xfd, 0x5a, 0x03, 0xb0, 0x69, 0x30, 0xf0, 0xc7, 0xa2, 0xb6, 0x35, 0xed, 0x50,
-	0x3b, 0xaa, 0x1c, 0x63, 0x63, 0xa9, 0x85, 0x31, 0x12, 0xf8, 0xbb, 0x09, 0xb2, 0x59, 0x9d, 0x4e,
-	0x1a, 0x90, 0x8e, 0x71, 0x26, 0x2a, 0x3a, 0x87, 0x2d, 0xcb, 0xf7, 0x9e, 0x38, 0xfd, 0xda, 0xba,
-	0x8c, 0xff, 0x8e, 0x11, 0xcd, 0xcd, 0xc8, 0xce, 0x4d, 0x86, 0x55, 0x73, 0x33, 0x30, 0xf9, 0xe4,
-	0xde, 0x33, 0x4e, 0x3d, 0xe6, 0xf8, 0x9e, 0x59, 0x7d, 0x3e, 0x69, 0xac, 0x4d, 0x27, 0x8d, 0xad,
-	0x13, 0x09, 0x82, 0x15, 0x18, 0xba, 0x0d, 0x7a, 0xcf, 0xf7, 0x39, 0xe3, 0x21, 0x09, 0x6a, 0x1b,
-	0x87, 0xda, 0x91, 0x6e, 0x1e, 0x4c, 0x27, 0x0d, 0xdd, 0x8c, 0x85, 0x97, 0xd9, 0x01, 0x4e, 0x8d,
-	0xd1, 0x1d, 0xd8, 0x75, 0x69, 0xd8, 0xa7, 0x3f, 0x71, 0xf8, 0x45, 0x87, 0x84, 0xa2, 0x32, 0x9b,
-	0x87, 0xda, 0x51, 0xd9, 0xbc, 0x31, 0x9d, 0x34, 0x76, 0x4f, 0xf3, 0x2a, 0x5c, 0xb4, 0x6d, 0x7e,
-	0xa6, 0xc3, 0x8d, 0x39, 0x35, 0x40, 0xef, 0xc2, 0xb5, 0x90, 0x06, 0x03, 0xc7, 0x22, 0x27, 0xfe,
-	0x50, 0x55, 0xbb, 0x64, 0x5e, 0x9f, 0x4e, 0x1a, 0xd7, 0x70, 0x46, 0x8e, 0x73, 0x56, 0xe8, 0x01,
-	0xec, 0x87, 0x74, 0xe4, 0x88, 0xa9, 0x7e, 0xe0, 0x30, 0xee, 0x87, 0xe3, 0x07, 0x8e, 0xeb, 0x70,
-	0x59, 0xab, 0x92, 0x59, 0x9b, 0x4e, 0x1a, 0xfb, 0x78, 0x8e, 0x1e, 0xcf, 0xf5, 0x42, 0x3f, 0x02,
-	0xc4, 0x68, 0x38, 0x72, 0x2c, 0xda, 0xb6, 0x2c, 0x81, 0xff, 0x90, 0xb8, 0x54, 0x55, 0xe7, 0xe6,
-	0x74, 0xd2, 0x40, 0xdd, 0x19, 0x2d, 0x9e, 0xe3, 0x81, 0x28, 0x94, 0x1c, 0x97, 0xf4, 0xa9, 0x2c,
-	0x4c, 0xe5, 0xf8, 0xee, 0x92, 0x94, 0xf9, 0x50, 0x60, 0x99, 0xfa, 0x74, 0xd2, 0x28, 0xc9, 0x4f,
-	0x1c, 0xa1, 0xa3, 0x73, 0xd0, 0x43, 0xca, 0xfc, 0x61, 0x68, 0x51, 0x56, 0x2b, 0xc9, 0x50, 0x47,
-	0x19, 0x76, 0x18, 0x62, 0x13, 0x1a, 0xa3, 0x5b, 0x06, 0x56, 0x46, 0x98, 0x7e, 0x3c, 0x74, 0x42,
-	0x09, 0xce, 0xcc, 0x1d, 0xb1, 0xda, 0xb1, 0x86, 0xe1, 0x14, 0x09, 0x7d, 0xa6, 0x81, 0x1e, 0xf8,
-	0xf6, 0x03, 0xd2, 0xa3, 0x03, 0x56, 0xdb, 0x3a, 0xdc, 0x38, 0xaa, 0x1c, 0x93, 0xd5, 0xb3, 0xde,
-	0xe8, 0xc4, 0x31, 0xee, 0x79, 0x3c, 0x1c, 0x9b, 0x7b, 0x8a, 0xa9, 0x7a, 0x22, 0xc7, 0x69, 0x1a,
-	0xe8, 0x0f, 0x1a, 0x54, 0x03, 0xdf, 0x6e, 0x7b, 0x9e, 0xcf, 0x09, 0x77, 0x7c, 0x8f, 0xd5, 0xb6,
-	0x65, 0x66, 0x4f, 0xfe, 0x3f, 0x99, 0x65, 0x02, 0x45, 0xe9, 0xdd, 0x54, 0xe9, 0x55, 0xf3, 0x4a,
-	0x5c, 0xc8, 0x0a, 0x59, 0xb0, 0x47, 0x6c, 0xdb, 0x11, 0x03, 0x32, 0x78, 0xec, 0x0f, 0x86, 0x2e,
-	0x65, 0xb5, 0xb2, 0x4c, 0xf5, 0x60, 0xde, 0xe2, 0x44, 0x26, 0xe6, 0xdb, 0x0a, 0x7e, 0xaf, 0x5d,
-	0x74, 0xc6, 0xb3, 0x78, 0xe8, 0x13, 0xb8, 0x59, 0x14, 0x9e, 0x0a, 0xf6, 0xb1, 0x9a, 0x2e, 0x23,
-	0x35, 0x16, 0x47, 0x92, 0x76, 0x66, 0x5d, 0x85, 0xbb, 0xd9, 0x9e, 0x0b, 0x83, 0x17, 0xc0, 0xa3,
-	0xef, 0xc3, 0x06, 0xf5, 0x46, 0x35, 0x58, 0x3c, 0x9f, 0x7b, 0xde, 0xe8, 0x31, 0x09, 0xcd, 0x8a,
-	0x0a, 0xb0, 0x71, 0xcf, 0x1b, 0x61, 0xe1, 0x83, 0xde, 0x86, 0x8d, 0x51, 0x40, 0x6a, 0x15, 0x79,
-	0x56, 0x6c, 0x0b, 0xd5, 0xe3, 0x4e, 0x1b, 0x0b, 0xd9, 0xc1, 0x0f, 0xa1, 0x9a, 0x27, 0x03, 0xba,
-	0x0e, 0x1b, 0x4f, 0xe9, 0x58, 0x1e, 0x02, 0x3a, 0x16, 0x9f, 0x68, 0x1f, 0x4a, 0x23, 0x32, 0x18,
-	0x52, 0xb9, 0xb5, 0x75, 0x1c, 0x0d, 0xde, 0x5b, 0xbf, 0xad, 0x1d, 0xb4, 0xe1, 0xc6, 0x9c, 0x05,
-	0xfb, 0x5f, 0x20, 0x9a, 0xbf, 0xd7, 0x20, 0xda, 0x5a, 0xc8, 0x00, 0x08, 0x69, 0xe0, 0x33, 0x47,
-	0x9c, 0x0a, 0x91, 0x73, 0x74, 0x3c, 0xe3, 0x44, 0x8a, 0x33, 0x16, 0x62, 0x56, 0x9c, 0x44, 0x67,
-	0xb3, 0x1e, 0xcd, 0xea, 0x8c, 0xf4, 0xb1, 0x90, 0xa1, 0x47, 0x00, 0xc1, 0x70, 0x30, 0xe8, 0xf8,
-	0x03, 0xc7, 0x1a, 0xab, 0x53, 0xa4, 0x25, 0xa0, 0x3a, 0x89, 0xf4, 0x72, 0xd2, 0xf8, 0xea, 0xec,
-	0xad, 0x69, 0xa4, 0x06, 0x38, 0x03, 0xd1, 0xfc, 0xcb, 0x3a, 0x54, 0x4e, 0x25, 0x85, 0xed, 0x2e,
-	0xa5, 0x36, 0xfa, 0x39, 0x94, 0xc5, 0x8d, 0x69, 0x13, 0x4e, 0xd4, 0xe5, 0xf4, 0x9d, 0x85, 0x97,
-	0x83, 0xdc, 0x03, 0xc2, 0x5a, 0xac, 0xd1, 0xa3, 0xde, 0x2f, 0xa8, 0xc5, 0x4f, 0x29, 0x27, 0x26,
-	0x52, 0xeb, 0x04, 0xa9, 0x0c, 0x27, 0xa8, 0x28, 0x80, 0x4d, 0x16, 0x50, 0x4b, 0x5d, 0x3d, 0x0f,
-	0x97, 0xdc, 0x6a, 0x99, 0xdc, 0xbb, 0x01, 0xb5, 0xcc, 0x6b, 0x2a, 0xf6, 0xa6, 0x18, 0x61, 0x19,
-	0x09, 0x3d, 0x83, 0x2d, 0xc6, 0x09, 0x1f, 0x32, 0x59, 0xb0, 0xca, 0x71, 0x67, 0x85, 0x31, 0x25,
-	0x6e, 0x7a, 0x23, 0x46, 0x63, 0xac, 0xe2, 0x35, 0x5f, 0x68, 0xb0, 0x9b, 0xb1, 0x7e, 0xe0, 0x30,
-	0x8e, 0x7e, 0x36, 0x53, 0x61, 0xe3, 0x6a, 0x15, 0x16, 0xde, 0xb2, 0xbe, 0xd7, 0x55, 0xb4, 0x72,
-	0x2c, 0xc9, 0x54, 0xd7, 0x87, 0x92, 0xc3, 0xa9, 0xcb, 0x6a, 0xeb, 0x72, 0x3b, 0x7d, 0xb4, 0xba,
-	0xa9, 0x9a, 0x3b, 0x2a, 0x6c, 0xe9, 0x43, 0x11, 0x00, 0x47, 0x71, 0x9a, 0x7f, 0x5b, 0x87, 0x6a,
-	0xb6, 0x20, 0x94, 0xbf, 0x01, 0x0e, 0xb1, 0x1c, 0x87, 0x7e, 0xbc, 0xc2, 0xf5, 0xa4, 0x7c, 0x21,
-	0x8d, 0x7e, 0x59, 0xa0, 0x51, 0x77, 0xb5, 0x61, 0xff, 0x3b, 0x93, 0xfe, 0xae, 0x01, 0xca, 0x3b,
-	0xbc, 0x01, 0x32, 0x85, 0x79, 0x32, 0x9d, 0xae, 0x74, 0xc2, 0x0b, 0xf8, 0xf4, 0xef, 0xcd, 0xe2,
-	0x44, 0xc5, 0x12, 0xa0, 0x23, 0x28, 0xab, 0x26, 0x8d, 0xa9, 0x36, 0xee, 0x9a, 0x48, 0x5a, 0xb5,
-	0x71, 0x0c, 0x27, 0x5a, 0x44, 0xa0, 0xcc, 0xe8, 0x80, 0x5a, 0xdc, 0x0f, 0x15, 0x3f, 0xbe, 0x7b,
-	0xc5, 0x92, 0x88, 0xbb, 0xa2, 0xab, 0x5c, 0xd3, 0xba, 0xc4, 0x12, 0x9c, 0xc0, 0xa2, 0x4f, 0x35,
-	0x28, 0x73, 0xea, 0x06, 0x03, 0xc2, 0xa9, 0x22, 0x03, 0x5e, 0x5d, 0x6d, 0xce, 0x14, 0x72, 0x9a,
-	0x42, 0x2c, 0xc1, 0x49, 0x54, 0xf4, 0x2b, 0xd8, 0x61, 0x17, 0xbe, 0xcf, 0x63, 0x95, 0x6a, 0x0b,
-	0xdb, 0x57, 0x4c, 0x43, 0xdd, 0xac, 0xf2, 0x8d, 0x66, 0x74, 0xb3, 0x40, 0xe6, 0x5b, 0x2a, 0xea,
-	0x4e, 0x4e, 0x8c, 0xf3, 0xe1, 0xd0, 0x6f, 0x34, 0xa8, 0x0e, 0x03, 0x9b, 0x70, 0xda, 0xe5, 0xe2,
-	0xb5, 0xd3, 0x1f, 0xab, 0x6e, 0x71, 0x59, 0x92, 0x9c, 0xe7, 0x40, 0x4d, 0x24, 0xda, 0xa3, 0xbc,
-	0x0c, 0x17, 0x02, 0x2f, 0x6c, 0xd8, 0xb7, 0x5e, 0xa7, 0x61, 0x6f, 0xfe, 0x79, 0x0b, 0xf6, 0xe7,
-	0x6d, 0x4d, 0xf4, 0x11, 0x20, 0xbf, 0x27, 0x3a, 0x73, 0x6a, 0xdf, 0x8f, 0xde, 0x78, 0x8e, 0xef,
-	0x49, 0x32, 0x6e, 0x98, 0x07, 0xaa, 0x68, 0xe8, 0xd1, 0x8c, 0x05, 0x9e, 0xe3, 0x85, 0xbe, 0x9d,
-	0xa1, 0x73, 0xf4, 0xae, 0x48, 0x16, 0x7b, 0x0e, 0xa5, 0x7f, 0x00, 0x3b, 0x21, 0x25, 0xf6, 0x38,
-	0x56, 0x49, 0xce, 0x95, 0xd2, 0x95, 0xc2, 0x59, 0x25, 0xce, 0xdb, 0xa2, 0xfb, 0xb0, 0xe7, 0xd1,
-	0x67, 0x5c, 0x8d, 0x1f, 0x0e, 0xdd, 0x1e, 0x0d, 0x25, 0x5b, 0x4a, 0x69, 0x83, 0xf8, 0xb0, 0x68,
-	0x80, 0x67, 0x7d, 0x50, 0x1b, 0x76, 0xad, 0x61, 0x28, 0x5f, 0x60, 0x71, 0x1e, 0x25, 0x09, 0xf3,
-	0x15, 0x05, 0xb3, 0x7b, 0x92, 0x57, 0xe3, 0xa2, 0xbd, 0x80, 0x88, 0xd6, 0xce, 0x4e, 0x20, 0xb6,
-	0xf2, 0x10, 0xe7, 0x79, 0x35, 0x2e, 0xda, 0xe7, 0xb2, 0x88, 0x56, 0xaf, 0xb6, 0x2d, 0xdb, 0xa0,
-	0xd9, 0x2c, 0x22, 0x35, 0x2e, 0xda, 0xa3, 0xf7, 0x63, 0xea, 0x26, 0x08, 0xe5, 0xe8, 0x39, 0x16,
-	0xb7, 0xe3, 0xe7, 0x39, 0x2d, 0x2e, 0x58, 0xa3, 0xf7, 0xa0, 0x6a, 0xf9, 0x83, 0x81, 0x1c, 0x44,
-	0x0f, 0x4b, 0x5d, 0x4e, 0x42, 0x72, 0xf5, 0x24, 0xa7, 0xc1, 0x05, 0x4b, 0xf4, 0x31, 0x80, 0xe5,
-	0x7b, 0x51, 0x1f, 0xcc, 0x54, 0xcf, 0x7b, 0xe7, 0x75, 0x36, 0xed, 0x49, 0x8c, 0x92, 0x5e, 0x95,
-	0x89, 0x88, 0xe1, 0x4c, 0x10, 0xb9, 0x55, 0x03, 0xea, 0xd9, 0x8e, 0xd7, 0x57, 0x55, 0x94, 0x0d,
-	0xf3, 0xf2, 0x5b, 0xb5, 0x93, 0x03, 0x8d, 0xa6, 0x9f, 0x97, 0xe1, 0x42, 0xe0, 0xe6, 0xbf, 0xf2,
-	0x0d, 0x91, 0x3c, 0xda, 0x29, 0x94, 0xe4, 0xd9, 0xa2, 0x2e, 0xb0, 0x65, 0x5f, 0xb6, 0xf2, 0xd8,
-	0x8a, 0x5e, 0xb6, 0xf2, 0x13, 0x47, 0xe8, 0x68, 0x08, 0x7a, 0x3f, 0x7e, 0x97, 0xa9, 0x43, 0xfb,
-	0x83, 0x55, 0xbd, 0xf3, 0xa2, 0x97, 0x6f, 0x32, 0xc4, 0x69, 0xa4, 0xe6, 0x1f, 0x35, 0xd8, 0x9b,
-	0x69, 0x18, 0x0b, 0x34, 0xd0, 0xde, 0x04, 0x0d, 0xe6, 0x1f, 0x5f, 0xeb, 0xaf, 0x73, 0x7c, 0x35,
-	0xff, 0xa1, 0xc1, 0x8d, 0x39, 0x37, 0xd6, 0x97, 0xf1, 0xf5, 0xd0, 0xfc, 0xa7, 0x06, 0x05, 0x56,
-	0xa3, 0x43, 0xd8, 0xf4, 0x88, 0x4b, 0xd5, 0x53, 0x2e, 0x71, 0x92, 0xff, 0x6e, 0xa4, 0x06, 0xbd,
-	0x0f, 0x5b, 0x21, 0x25, 0x4c, 0x15, 0x58, 0x37, 0xbf, 0x1e, 0xb7, 0x75, 0x58, 0x4a, 0x2f, 0x27,
-	0x8d, 0xfd, 0xc2, 0x4e, 0x91, 0x72, 0xac, 0xbc, 0xd0, 0x23, 0x28, 0x31, 0xc7, 0xb3, 0xe2, 0xee,
-	0xe2, 0x9b, 0x57, 0xab, 0xe2, 0x99, 0xe3, 0xd2, 0xb4, 0xad, 0xea, 0x0a, 0x00, 0x1c, 0xe1, 0xa0,
-	0xaf, 0xc1, 0x76, 0x48, 0x79, 0xe8, 0x50, 0xa6, 0xce, 0xfe, 0xca, 0x74, 0xd2, 0xd8, 0xc6, 0x91,
-	0x08, 0xc7, 0xba, 0xe6, 0x5d, 0x78, 0x0b, 0x8b, 0x03, 0xcb, 0xeb, 0xe7, 0xef, 0x5c, 0xf4, 0x2d,
-	0xd0, 0x03, 0x12, 0x72, 0x27, 0xb9, 0xf3, 0x4a, 0x11, 0xe7, 0x3b, 0xb1, 0x10, 0xa7, 0xfa, 0xe6,
-	0x37, 0x20, 0xda, 0x7a, 0xaf, 0x2e, 0x54, 0xf3, 0x4f, 0x1a, 0x14, 0xae, 0x77, 0x74, 0x0c, 0x9b,
-	0x7c, 0x1c, 0xc4, 0x4e, 0x75, 0xe1, 0x70, 0x36, 0x0e, 0xe8, 0xe5, 0xa4, 0x81, 0xf2, 0x96, 0x42,
-	0x8a, 0xa5, 0x2d, 0xfa, 0xad, 0x06, 0x3b, 0x61, 0x36, 0x71, 0x45, 0x90, 0xb3, 0x25, 0x09, 0x32,
-	0xb7, 0x18, 0xe6, 0x9e, 0xbc, 0x74, 0xb3, 0x2a, 0x9c, 0x8f, 0x6e, 0x5a, 0xcf, 0x5f, 0xd6, 0xd7,
-	0x3e, 0x7f, 0x59, 0x5f, 0xfb, 0xe2, 0x65, 0x7d, 0xed, 0xd3, 0x69, 0x5d, 0x7b, 0x3e, 0xad, 0x6b,
-	0x9f, 0x4f, 0xeb, 0xda, 0x17, 0xd3, 0xba, 0xf6, 0x62, 0x5a, 0xd7, 0x7e, 0xf7, 0xd7, 0xfa, 0xda,
-	0x4f, 0xef, 0x2c, 0xf5, 0x7b, 0xff, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x51, 0x54, 0xe6,
-	0x1e, 0x18, 0x00, 0x00,
+	// 1826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x4a, 0x22, 0xc5, 0x7d, 0xb2, 0x28, 0x6b, 0xac, 0xb8, 0x8c, 0x80, 0x92, 0x02, 0x81,
+	0x06, 0xea, 0x47, 0x96, 0xb5, 0x12, 0x14, 0x6e, 0x5a, 0x07, 0xd0, 0x2a, 0xae, 0xe3, 0xc0, 0xb2,
+	0xd8, 0xa1, 0xe4, 0x02, 0x41, 0x0f, 0x1d, 0xee, 0x8e, 0x57, 0x5b, 0xef, 0x57, 0x76, 0x67, 0x19,
+	0x11, 0x05, 0x8a, 0xa0, 0xb7, 0x1e, 0x5a, 0x14, 0xf9, 0x17, 0x0a, 0xe4, 0x6f, 0xf1, 0x31, 0x28,
+	0x7a, 0x08, 0x5a, 0x80, 0xb0, 0xd9, 0xa2, 0x40, 0x7b, 0xe9, 0xdd, 0xa7, 0x62, 0x66, 0x67, 0x3f,
+	0x49, 0x36, 0xb2, 0xa5, 0xfa, 0xd0, 0xdb, 0xce, 0xfb, 0xf8, 0xbd, 0x99, 0xf7, 0x7e, 0x33, 0xf3,
+	0x86, 0x84, 0x23, 0xcb, 0x66, 0x67, 0xf1, 0x50, 0x33, 0x7c, 0xb7, 0x67, 0x91, 0xd0, 0xa4, 0x1e,
+	0x0d, 0xf3, 0x8f, 0xe0, 0x89, 0xd5, 0x23, 0x81, 0x1d, 0xf5, 0x22, 0x4a, 0x4d, 0x97, 0x78, 0xc4,
+	0xa2, 0x2e, 0xf5, 0x58, 0x6f, 0x74, 0x8b, 0x38, 0xc1, 0x19, 0xb9, 0xd5, 0xb3, 0xb8, 0x19, 0x61,
+	0xd4, 0xd4, 0x82, 0xd0, 0x67, 0x3e, 0xba, 0x93, 0xc3, 0x69, 0x29, 0x4a, 0xfe, 0x11, 0x3c, 0xb1,
+	0x34, 0x0e, 0xa7, 0x95, 0xe1, 0xb4, 0x14, 0x6e, 0x47, 0xbf, 0xd8, 0x6c, 0x0c, 0x3f, 0xa4, 0xbd,
+	0xd1, 0xad, 0x21, 0x65, 0xb3, 0x53, 0xd8, 0x79, 0xbb, 0x88, 0xe1, 0x5b, 0x7e, 0x4f, 0x88, 0x87,
+	0xf1, 0x63, 0x31, 0x12, 0x03, 0xf1, 0x25, 0xcd, 0xbb, 0x4f, 0x6e, 0x47, 0x9a, 0xed, 0x73, 0xe0,
+	0x14, 0x77, 0x06, 0xf2, 0xdd, 0xdc, 0xc6, 0x25, 0xc6, 0x99, 0xed, 0xd1, 0x70, 0x9c, 0xcf, 0xc6,
+	0xa5, 0x8c, 0xcc, 0xf3, 0xea, 0x2d, 0xf2, 0x0a, 0x63, 0x8f, 0xd9, 0x2e, 0x9d, 0x71, 0xf8, 0xc1,
+	0xd7, 0x39, 0x44, 0xc6, 0x19, 0x75, 0xc9, 0x8c, 0xdf, 0x3b, 0x8b, 0xfc, 0x62, 0x66, 0x3b, 0x3d,
+	0xdb, 0x63, 0x11, 0x0b, 0xab, 0x4e, 0xdd, 0x67, 0xcb, 0xa0, 0xde, 0x13, 0x99, 0x75, 0x28, 0x43,
+	0xbf, 0x51, 0x00, 0x4c, 0x1a, 0x38, 0xfe, 0x98, 0x17, 0xa4, 0xa5, 0xec, 0x2a, 0x7b, 0xeb, 0xfb,
+	0x58, 0xbb, 0x54, 0x35, 0xb5, 0x0c, 0xfe, 0x83, 0x0c, 0x59, 0x6f, 0x4e, 0x27, 0x1d, 0xc8, 0xc7,
+	0xb8, 0x10, 0x15, 0x9d, 0x42, 0xdd, 0xf0, 0xbd, 0xc7, 0xb6, 0xd5, 0x5a, 0x16, 0xf1, 0xdf, 0xd6,
+	0x92, 0x85, 0x69, 0xc5, 0x85, 0x89, 0xb0, 0x32, 0x21, 0x1a, 0x26, 0x9f, 0xde, 0x3d, 0x67, 0xd4,
+	0x8b, 0x6c, 0xdf, 0xd3, 0x9b, 0x4f, 0x27, 0x9d, 0xa5, 0xe9, 0xa4, 0x53, 0x3f, 0x14, 0x20, 0x58,
+	0x82, 0xa1, 0xdb, 0xa0, 0x0e, 0x7d, 0x9f, 0x27, 0x81, 0x04, 0xad, 0x95, 0x5d, 0x65, 0x4f, 0xd5,
+	0x77, 0xa6, 0x93, 0x8e, 0xaa, 0xa7, 0xc2, 0x17, 0xc5, 0x01, 0xce, 0x8d, 0xd1, 0x1d, 0xd8, 0x74,
+	0x69, 0x68, 0xd1, 0x9f, 0xd9, 0xec, 0xac, 0x4f, 0x42, 0x9e, 0x99, 0xd5, 0x5d, 0x65, 0xaf, 0xa1,
+	0xdf, 0x98, 0x4e, 0x3a, 0x9b, 0x47, 0x65, 0x15, 0xae, 0xda, 0x76, 0x3f, 0x57, 0xe1, 0xc6, 0x9c,
+	0x1c, 0xa0, 0x77, 0xe1, 0x5a, 0x48, 0x03, 0xc7, 0x36, 0xc8, 0xa1, 0x1f, 0xcb, 0x6c, 0xd7, 0xf4,
+	0xeb, 0xd3, 0x49, 0xe7, 0x1a, 0x2e, 0xc8, 0x71, 0xc9, 0x0a, 0x3d, 0x80, 0xed, 0x90, 0x8e, 0x6c,
+	0xbe, 0xd4, 0x0f, 0xed, 0x88, 0xf9, 0xe1, 0xf8, 0x81, 0xed, 0xda, 0x4c, 0xe4, 0xaa, 0xa6, 0xb7,
+	0xa6, 0x93, 0xce, 0x36, 0x9e, 0xa3, 0xc7, 0x73, 0xbd, 0xd0, 0x4f, 0x00, 0x45, 0x34, 0x1c, 0xd9,
+	0x06, 0x3d, 0x30, 0x0c, 0x8e, 0xff, 0x90, 0xb8, 0x54, 0x66, 0xe7, 0xe6, 0x74, 0xd2, 0x41, 0x83,
+	0x19, 0x2d, 0x9e, 0xe3, 0x81, 0x28, 0xd4, 0x6c, 0x97, 0x58, 0x54, 0x24, 0x66, 0x7d, 0xff, 0x83,
+	0x4b, 0x52, 0xe6, 0x3e, 0xc7, 0xd2, 0xd5, 0xe9, 0xa4, 0x53, 0x13, 0x9f, 0x38, 0x41, 0x47, 0xa7,
+	0xa0, 0x86, 0x34, 0xf2, 0xe3, 0xd0, 0xa0, 0x51, 0xab, 0x26, 0x42, 0xed, 0x15, 0xd8, 0xa1, 0xf1,
+	0x9d, 0xab, 0x8d, 0x6e, 0x69, 0x58, 0x1a, 0x61, 0xfa, 0x49, 0x6c, 0x87, 0x02, 0x3c, 0xd2, 0x37,
+	0x78, 0xb5, 0x53, 0x4d, 0x84, 0x73, 0x24, 0xf4, 0xb9, 0x02, 0x6a, 0xe0, 0x9b, 0x0f, 0xc8, 0x90,
+	0x3a, 0x51, 0xab, 0xbe, 0xbb, 0xb2, 0xb7, 0xbe, 0x4f, 0xae, 0x9e, 0xf5, 0x5a, 0x3f, 0x8d, 0x71,
+	0xd7, 0x63, 0xe1, 0x58, 0xdf, 0x92, 0x4c, 0x55, 0x33, 0x39, 0xce, 0xa7, 0x81, 0xbe, 0x50, 0xa0,
+	0x19, 0xf8, 0xe6, 0x81, 0xe7, 0xf9, 0x8c, 0x30, 0xdb, 0xf7, 0xa2, 0xd6, 0x9a, 0x98, 0xd9, 0xe3,
+	0xff, 0xcd, 0xcc, 0x0a, 0x81, 0x92, 0xe9, 0xdd, 0x94, 0xd3, 0x6b, 0x96, 0x95, 0xb8, 0x32, 0x2b,
+	0x64, 0xc0, 0x16, 0x31, 0x4d, 0x9b, 0x0f, 0x88, 0xf3, 0xc8, 0x77, 0x62, 0x97, 0x46, 0xad, 0x86,
+	0x98, 0xea, 0xce, 0xbc, 0xe2, 0x24, 0x26, 0xfa, 0x9b, 0x12, 0x7e, 0xeb, 0xa0, 0xea, 0x8c, 0x67,
+	0xf1, 0xd0, 0xa7, 0x70, 0xb3, 0x2a, 0x3c, 0xe2, 0xec, 0x8b, 0x5a, 0xaa, 0x88, 0xd4, 0x59, 0x1c,
+	0x49, 0xd8, 0xe9, 0x6d, 0x19, 0xee, 0xe6, 0xc1, 0x5c, 0x18, 0xbc, 0x00, 0x1e, 0xfd, 0x10, 0x56,
+	0xa8, 0x37, 0x6a, 0xc1, 0xe2, 0xf5, 0xdc, 0xf5, 0x46, 0x8f, 0x48, 0xa8, 0xaf, 0xcb, 0x00, 0x2b,
+	0x77, 0xbd, 0x11, 0xe6, 0x3e, 0xe8, 0x4d, 0x58, 0x19, 0x05, 0xa4, 0xb5, 0x2e, 0xce, 0x8a, 0x35,
+	0xae, 0x7a, 0xd4, 0x3f, 0xc0, 0x5c, 0xb6, 0xf3, 0x63, 0x68, 0x96, 0xc9, 0x80, 0xae, 0xc3, 0xca,
+	0x13, 0x3a, 0x16, 0x87, 0x80, 0x8a, 0xf9, 0x27, 0xda, 0x86, 0xda, 0x88, 0x38, 0x31, 0x15, 0x5b,
+	0x5b, 0xc5, 0xc9, 0xe0, 0xbd, 0xe5, 0xdb, 0xca, 0xce, 0x01, 0xdc, 0x98, 0x53, 0xb0, 0x97, 0x81,
+	0xe8, 0xfe, 0x51, 0x81, 0x64, 0x6b, 0x21, 0x0d, 0x20, 0xa4, 0x81, 0x1f, 0xd9, 0xfc, 0x54, 0x48,
+	0x9c, 0x93, 0xe3, 0x19, 0x67, 0x52, 0x5c, 0xb0, 0xe0, 0xab, 0x62, 0x24, 0x39, 0x9b, 0xd5, 0x64,
+	0x55, 0x27, 0xc4, 0xc2, 0x5c, 0x86, 0x8e, 0x01, 0x82, 0xd8, 0x71, 0xfa, 0xbe, 0x63, 0x1b, 0x63,
+	0x79, 0x8a, 0xf4, 0x38, 0x54, 0x3f, 0x93, 0xbe, 0x98, 0x74, 0xbe, 0x39, 0x7b, 0xd5, 0x6a, 0xb9,
+	0x01, 0x2e, 0x40, 0x74, 0xff, 0xba, 0x0c, 0xeb, 0x47, 0x82, 0xc2, 0xe6, 0x80, 0x52, 0x13, 0xfd,
+	0x02, 0x1a, 0xfc, 0x9a, 0x35, 0x09, 0x23, 0xf2, 0x72, 0xfa, 0xfe, 0xc2, 0xcb, 0x41, 0xec, 0x01,
+	0x6e, 0xcd, 0x6b, 0x74, 0x3c, 0xfc, 0x25, 0x35, 0xd8, 0x11, 0x65, 0x44, 0x47, 0xb2, 0x4e, 0x90,
+	0xcb, 0x70, 0x86, 0x8a, 0x02, 0x58, 0x8d, 0x02, 0x6a, 0xc8, 0xab, 0xe7, 0xe1, 0x25, 0xb7, 0x5a,
+	0x61, 0xee, 0x83, 0x80, 0x1a, 0xfa, 0x35, 0x19, 0x7b, 0x95, 0x8f, 0xb0, 0x88, 0x84, 0xce, 0xa1,
+	0x1e, 0x31, 0xc2, 0xe2, 0x48, 0x24, 0x6c, 0x7d, 0xbf, 0x7f, 0x85, 0x31, 0x05, 0x6e, 0x7e, 0x23,
+	0x26, 0x63, 0x2c, 0xe3, 0x75, 0x9f, 0x29, 0xb0, 0x59, 0xb0, 0x7e, 0x60, 0x47, 0x0c, 0xfd, 0x7c,
+	0x26, 0xc3, 0xda, 0xc5, 0x32, 0xcc, 0xbd, 0x45, 0x7e, 0xaf, 0xcb, 0x68, 0x8d, 0x54, 0x52, 0xc8,
+	0xae, 0x0f, 0x35, 0x9b, 0x51, 0x37, 0x6a, 0x2d, 0x8b, 0xed, 0xf4, 0xd1, 0xd5, 0x2d, 0x55, 0xdf,
+	0x90, 0x61, 0x6b, 0xf7, 0x79, 0x00, 0x9c, 0xc4, 0xe9, 0xfe, 0x7d, 0x19, 0x9a, 0xc5, 0x84, 0x50,
+	0xf6, 0x1a, 0x38, 0x14, 0x95, 0x38, 0xf4, 0xd3, 0x2b, 0xac, 0x27, 0x65, 0x0b, 0x69, 0xf4, 0xab,
+	0x0a, 0x8d, 0x06, 0x57, 0x1b, 0xf6, 0xbf, 0x33, 0xe9, 0x1f, 0x0a, 0xa0, 0xb2, 0xc3, 0x6b, 0x20,
+	0x53, 0x58, 0x26, 0xd3, 0xd1, 0x95, 0x2e, 0x78, 0x01, 0x9f, 0xbe, 0xa8, 0x55, 0x17, 0xca, 0x4b,
+	0x80, 0xf6, 0xa0, 0x21, 0x9b, 0xb4, 0x48, 0xb6, 0x71, 0xd7, 0xf8, 0xa4, 0x65, 0x1b, 0x17, 0xe1,
+	0x4c, 0x8b, 0x08, 0x34, 0x22, 0xea, 0x50, 0x83, 0xf9, 0xa1, 0xe4, 0xc7, 0x3b, 0x17, 0x4c, 0x09,
+	0xbf, 0x2b, 0x06, 0xd2, 0x35, 0xcf, 0x4b, 0x2a, 0xc1, 0x19, 0x2c, 0xfa, 0x4c, 0x81, 0x06, 0xa3,
+	0x6e, 0xe0, 0x10, 0x46, 0x25, 0x19, 0xf0, 0xd5, 0xe5, 0xe6, 0x44, 0x22, 0xe7, 0x53, 0x48, 0x25,
+	0x38, 0x8b, 0x8a, 0x7e, 0x0d, 0x1b, 0xd1, 0x99, 0xef, 0xb3, 0x54, 0x25, 0xdb, 0xc2, 0x83, 0x0b,
+	0x4e, 0x43, 0xde, 0xac, 0xe2, 0x61, 0xa7, 0x0d, 0x8a, 0x40, 0xfa, 0x1b, 0x32, 0xea, 0x46, 0x49,
+	0x8c, 0xcb, 0xe1, 0xd0, 0x6f, 0x15, 0x68, 0xc6, 0x81, 0x49, 0x18, 0x1d, 0x30, 0xfe, 0xda, 0xb1,
+	0xc6, 0xb2, 0x5b, 0xbc, 0x2c, 0x49, 0x4e, 0x4b, 0xa0, 0x3a, 0xe2, 0xed, 0x51, 0x59, 0x86, 0x2b,
+	0x81, 0x17, 0x36, 0xec, 0xf5, 0x57, 0x6a, 0xd8, 0xdf, 0x82, 0x7a, 0x40, 0xe2, 0x88, 0x9a, 0xad,
+	0x35, 0xd1, 0x56, 0x64, 0x3b, 0xb2, 0x2f, 0xa4, 0x58, 0x6a, 0xbb, 0x7f, 0xa9, 0xc3, 0xf6, 0xbc,
+	0x2d, 0x8c, 0x3e, 0x02, 0xe4, 0x0f, 0x79, 0x07, 0x4f, 0xcd, 0x7b, 0xc9, 0x5b, 0xd0, 0xf6, 0x3d,
+	0x41, 0xda, 0x15, 0x7d, 0x47, 0x82, 0xa1, 0xe3, 0x19, 0x0b, 0x3c, 0xc7, 0x0b, 0x7d, 0xaf, 0x40,
+	0xfb, 0xe4, 0xfd, 0x91, 0x91, 0x62, 0x0e, 0xf5, 0x7f, 0x04, 0x1b, 0x21, 0x25, 0xe6, 0x38, 0x55,
+	0x09, 0x6e, 0xd6, 0xf2, 0x8a, 0xe2, 0xa2, 0x12, 0x97, 0x6d, 0xd1, 0x3d, 0xd8, 0xf2, 0xe8, 0x39,
+	0x93, 0xe3, 0x87, 0xb1, 0x3b, 0xa4, 0xa1, 0x60, 0x55, 0x2d, 0x6f, 0x24, 0x1f, 0x56, 0x0d, 0xf0,
+	0xac, 0x0f, 0x3a, 0x80, 0x4d, 0x23, 0x0e, 0xc5, 0x4b, 0x2d, 0x9d, 0x47, 0x4d, 0xc0, 0x7c, 0x43,
+	0xc2, 0x6c, 0x1e, 0x96, 0xd5, 0xb8, 0x6a, 0xcf, 0x21, 0x92, 0x1a, 0x9b, 0x19, 0x44, 0xbd, 0x0c,
+	0x71, 0x5a, 0x56, 0xe3, 0xaa, 0x7d, 0x69, 0x16, 0x49, 0x95, 0x45, 0x3d, 0xd5, 0x39, 0xb3, 0x48,
+	0xd4, 0xb8, 0x6a, 0x8f, 0xde, 0x4f, 0x29, 0x9e, 0x21, 0x34, 0x92, 0x67, 0x5b, 0xda, 0xb6, 0x9f,
+	0x96, 0xb4, 0xb8, 0x62, 0x8d, 0xde, 0x83, 0xa6, 0xe1, 0x3b, 0x8e, 0x18, 0x24, 0x0f, 0x50, 0x55,
+	0x2c, 0x42, 0x70, 0xfa, 0xb0, 0xa4, 0xc1, 0x15, 0x4b, 0xf4, 0x09, 0x80, 0xe1, 0x7b, 0x49, 0xbf,
+	0x1c, 0xc9, 0xde, 0xf8, 0xce, 0xab, 0x6c, 0xee, 0xc3, 0x14, 0x25, 0xbf, 0x52, 0x33, 0x51, 0x84,
+	0x0b, 0x41, 0xc4, 0x96, 0x0e, 0xa8, 0x67, 0xda, 0x9e, 0x25, 0xb3, 0x28, 0x1a, 0xeb, 0xcb, 0x6f,
+	0xe9, 0x7e, 0x09, 0x34, 0x59, 0x7e, 0x59, 0x86, 0x2b, 0x81, 0xbb, 0xff, 0x2e, 0x37, 0x4e, 0xe2,
+	0x0a, 0xa0, 0x50, 0x13, 0x67, 0x90, 0xbc, 0xe8, 0x2e, 0xfb, 0x02, 0x16, 0xc7, 0x5b, 0xf2, 0x02,
+	0x16, 0x9f, 0x38, 0x41, 0x47, 0x31, 0xa8, 0x56, 0xfa, 0x7e, 0x93, 0x87, 0xfb, 0x87, 0x57, 0xf5,
+	0x1e, 0x4c, 0x5e, 0xc8, 0xd9, 0x10, 0xe7, 0x91, 0xba, 0x7f, 0x52, 0x60, 0x6b, 0xa6, 0xb1, 0xac,
+	0xd0, 0x40, 0x79, 0x1d, 0x34, 0x98, 0x7f, 0x7c, 0x2d, 0xbf, 0xca, 0xf1, 0xd5, 0xfd, 0xa7, 0x02,
+	0x37, 0xe6, 0xdc, 0x6c, 0xff, 0x8f, 0xaf, 0x8c, 0xee, 0xbf, 0x14, 0xa8, 0xb0, 0x1a, 0xed, 0xc2,
+	0xaa, 0x47, 0x5c, 0x2a, 0x9f, 0x7c, 0x99, 0x93, 0xf8, 0x8d, 0x47, 0x68, 0xd0, 0xfb, 0x50, 0x0f,
+	0x29, 0x89, 0x64, 0x82, 0x55, 0xfd, 0xad, 0xf4, 0xb2, 0xc1, 0x42, 0xfa, 0x62, 0xd2, 0xd9, 0xae,
+	0xec, 0x14, 0x21, 0xc7, 0xd2, 0x0b, 0x1d, 0x43, 0x2d, 0xb2, 0x3d, 0x23, 0xed, 0x42, 0xbe, 0x73,
+	0xb1, 0x2c, 0x9e, 0xd8, 0x2e, 0xcd, 0xdb, 0xaf, 0x01, 0x07, 0xc0, 0x09, 0x0e, 0xfa, 0x16, 0xac,
+	0x85, 0x94, 0x85, 0x36, 0x8d, 0xe4, 0xd9, 0xbf, 0x3e, 0x9d, 0x74, 0xd6, 0x70, 0x22, 0xc2, 0xa9,
+	0xae, 0xfb, 0xfb, 0x65, 0x78, 0x03, 0xf3, 0x13, 0xcb, 0xb3, 0xca, 0x97, 0x33, 0xfa, 0x2e, 0xa8,
+	0x01, 0x09, 0x99, 0x9d, 0x5d, 0x7a, 0xb5, 0x84, 0xf4, 0xfd, 0x54, 0x88, 0x73, 0x3d, 0x72, 0xa0,
+	0xe9, 0x92, 0xf3, 0x53, 0x8f, 0x8c, 0x88, 0xed, 0x90, 0xa1, 0x43, 0x65, 0xbd, 0x16, 0xb3, 0x21,
+	0x66, 0xb6, 0xa3, 0x25, 0xbf, 0xb4, 0x6a, 0xf7, 0x3d, 0x76, 0x1c, 0x0e, 0x58, 0x68, 0x7b, 0x56,
+	0x72, 0xa8, 0x1c, 0x95, 0xb0, 0x70, 0x05, 0x1b, 0x7d, 0x0c, 0x0d, 0x97, 0x9c, 0x0f, 0xe2, 0xd0,
+	0x4a, 0xf3, 0xf5, 0xf2, 0x71, 0x44, 0xd7, 0x79, 0x24, 0x51, 0x70, 0x86, 0xd7, 0xfd, 0x36, 0x24,
+	0xa7, 0xc8, 0xd7, 0xd7, 0xbc, 0xfb, 0x67, 0x05, 0x2a, 0x1d, 0x0d, 0xda, 0x87, 0x55, 0x36, 0x0e,
+	0x52, 0xa7, 0x36, 0x77, 0x38, 0x19, 0x07, 0xf4, 0xc5, 0xa4, 0x83, 0xca, 0x96, 0x5c, 0x8a, 0x85,
+	0x2d, 0xfa, 0x9d, 0x02, 0x1b, 0x61, 0xb1, 0x04, 0x32, 0x77, 0x27, 0x97, 0xe4, 0xfa, 0xdc, 0xb2,
+	0xea, 0x5b, 0xa2, 0x7f, 0x28, 0xaa, 0x70, 0x39, 0xba, 0x6e, 0x3c, 0x7d, 0xde, 0x5e, 0xfa, 0xf2,
+	0x79, 0x7b, 0xe9, 0xab, 0xe7, 0xed, 0xa5, 0xcf, 0xa6, 0x6d, 0xe5, 0xe9, 0xb4, 0xad, 0x7c, 0x39,
+	0x6d, 0x2b, 0x5f, 0x4d, 0xdb, 0xca, 0xb3, 0x69, 0x5b, 0xf9, 0xc3, 0xdf, 0xda, 0x4b, 0x1f, 0xdf,
+	0xb9, 0xd4, 0xdf, 0x20, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xe5, 0xad, 0x8d, 0x46, 0x19,
+	0x00, 0x00,
 }
 
 func (m *Gardenlet) Marshal() (dAtA []byte, err error) {
@@ -1111,6 +1119,14 @@ func (m *ManagedSeedSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Paused {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
 	if m.RevisionHistoryLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
 		i--
@@ -1446,6 +1462,30 @@ func (m *RollingUpdateStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSurge != nil {
+		{
+			size, err := m.MaxSurge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxUnavailable != nil {
+		{
+			size, err := m.MaxUnavailable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Partition != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Partition))
 		i--
@@ -1728,6 +1768,7 @@ func (m *ManagedSeedSetSpec) Size() (n int) {
 	if m.RevisionHistoryLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
+	n += 2
 	return n
 }
 
@@ -1836,6 +1877,14 @@ func (m *RollingUpdateStrategy) Size() (n int) {
 	if m.Partition != nil {
 		n += 1 + sovGenerated(uint64(*m.Partition))
 	}
+	if m.MaxUnavailable != nil {
+		l = m.MaxUnavailable.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxSurge != nil {
+		l = m.MaxSurge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2020,6 +2069,7 @@ func (this *ManagedSeedSetSpec) String() string {
 		`ShootTemplate:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ShootTemplate), "ShootTemplate", "v1beta1.ShootTemplate", 1), `&`, ``, 1) + `,`,
 		`UpdateStrategy:` + strings.Replace(this.UpdateStrategy.String(), "UpdateStrategy", "UpdateStrategy", 1) + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2106,6 +2156,8 @@ func (this *RollingUpdateStrategy) String() string {
 	}
 	s := strings.Join([]string{`&RollingUpdateStrategy{`,
 		`Partition:` + valueToStringGenerated(this.Partition) + `,`,
+		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`MaxSurge:` + strings.Replace(fmt.Sprintf("%v", this.MaxSurge), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3769,6 +3821,26 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RevisionHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4665,6 +4737,78 @@ func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Partition = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
+			}
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSurge == nil {
+				m.MaxSurge = &intstr.IntOrString{}
+			}
+			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
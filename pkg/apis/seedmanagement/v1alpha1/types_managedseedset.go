@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
@@ -62,6 +63,10 @@ type ManagedSeedSetSpec struct {
 	// in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.
 	// +optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,6,opt,name=revisionHistoryLimit"`
+	// Paused indicates that updates of ManagedSeeds / Shoots to a new revision of Template / ShootTemplate are held back.
+	// Scaling is not affected.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
 }
 
 // UpdateStrategy specifies the strategy that the ManagedSeedSet
@@ -85,6 +90,9 @@ const (
 	// applied to all ManagedSeeds / Shoots in the ManagedSeedSet with respect to the ManagedSeedSet
 	// ordering constraints.
 	RollingUpdateStrategyType UpdateStrategyType = "RollingUpdate"
+	// OnDeleteStrategyType indicates that ManagedSeeds / Shoots in the ManagedSeedSet are not updated automatically.
+	// Only replicas which are created after they were deleted use the new revision of Template / ShootTemplate.
+	OnDeleteStrategyType UpdateStrategyType = "OnDelete"
 )
 
 // RollingUpdateStrategy is used to communicate parameters for RollingUpdateStrategyType.
@@ -92,6 +100,14 @@ type RollingUpdateStrategy struct {
 	// Partition indicates the ordinal at which the ManagedSeedSet should be partitioned. Defaults to 0.
 	// +optional
 	Partition *int32 `json:"partition,omitempty" protobuf:"varint,1,opt,name=partition"`
+	// MaxUnavailable is the maximum number of replicas that can be unavailable during the update. Value can be an
+	// absolute number or a percentage of the desired replicas (rounded down). Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"bytes,2,opt,name=maxUnavailable"`
+	// MaxSurge is the maximum number of replicas that can be created in addition to the desired replicas during the
+	// update. Value can be an absolute number or a percentage of the desired replicas (rounded up). Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty" protobuf:"bytes,3,opt,name=maxSurge"`
 }
 
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
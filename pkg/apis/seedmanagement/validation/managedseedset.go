@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -119,9 +120,10 @@ func validateUpdateStrategy(updateStrategy *seedmanagement.UpdateStrategy, fldPa
 			if updateStrategy.RollingUpdate != nil {
 				allErrs = append(allErrs, validateRollingUpdateStrategy(updateStrategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
 			}
+		case seedmanagement.OnDeleteStrategyType:
 		default:
 			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"),
-				*updateStrategy.Type, []string{string(seedmanagement.RollingUpdateStrategyType)}))
+				*updateStrategy.Type, []string{string(seedmanagement.RollingUpdateStrategyType), string(seedmanagement.OnDeleteStrategyType)}))
 		}
 	}
 
@@ -136,9 +138,25 @@ func validateRollingUpdateStrategy(rus *seedmanagement.RollingUpdateStrategy, fl
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*rus.Partition), fldPath.Child("partition"))...)
 	}
 
+	// Ensure maxUnavailable and maxSurge are non-negative integers or percentages and not both zero
+	allErrs = append(allErrs, gardencorevalidation.ValidatePositiveIntOrPercent(rus.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	allErrs = append(allErrs, gardencorevalidation.IsNotMoreThan100Percent(rus.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	allErrs = append(allErrs, gardencorevalidation.ValidatePositiveIntOrPercent(rus.MaxSurge, fldPath.Child("maxSurge"))...)
+	if isZeroIntOrPercent(rus.MaxUnavailable) && isZeroIntOrPercent(rus.MaxSurge) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rus.MaxUnavailable, "may not be 0 when `maxSurge` is 0"))
+	}
+
 	return allErrs
 }
 
+func isZeroIntOrPercent(intOrPercent *intstr.IntOrString) bool {
+	if intOrPercent == nil {
+		return false
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(intOrPercent, 100, false)
+	return err == nil && value == 0
+}
+
 // ValidateManagedSeedSetSpecUpdate validates a ManagedSeedSetSpec object before an update.
 func ValidateManagedSeedSetSpecUpdate(newSpec, oldSpec *seedmanagement.ManagedSeedSetSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -11,6 +11,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/utils/ptr"
@@ -221,8 +222,53 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should allow updateStrategy.type OnDelete", func() {
+			managedSeedSet.Spec.UpdateStrategy.Type = ptr.To(seedmanagement.OnDeleteStrategyType)
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
+		It("should allow valid updateStrategy.rollingUpdate.maxSurge and maxUnavailable", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromString("50%"))
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(0))
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
+		It("should forbid invalid updateStrategy.rollingUpdate.maxSurge and maxUnavailable", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(-1))
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("150%"))
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.rollingUpdate.maxSurge"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.rollingUpdate.maxUnavailable"),
+				})),
+			))
+		})
+
+		It("should forbid updateStrategy.rollingUpdate.maxSurge and maxUnavailable both being zero", func() {
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(0))
+			managedSeedSet.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("0%"))
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.rollingUpdate.maxUnavailable"),
+				})),
+			))
+		})
+
 		It("should forbid unsupported updateStrategy.type", func() {
-			managedSeedSet.Spec.UpdateStrategy.Type = ptr.To(seedmanagement.UpdateStrategyType("OnDelete"))
+			managedSeedSet.Spec.UpdateStrategy.Type = ptr.To(seedmanagement.UpdateStrategyType("Foo"))
 
 			errorList := ValidateManagedSeedSet(managedSeedSet)
 
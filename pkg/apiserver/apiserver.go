@@ -28,6 +28,7 @@ type ExtraConfig struct {
 	AdminKubeconfigMaxExpiration  time.Duration
 	ViewerKubeconfigMaxExpiration time.Duration
 	CredentialsRotationInterval   time.Duration
+	ShootRevisionHistoryLimit     int
 }
 
 // Config contains Gardener API server configuration.
@@ -81,6 +82,7 @@ func (c completedConfig) New() (*GardenerServer, error) {
 			AdminKubeconfigMaxExpiration:  c.ExtraConfig.AdminKubeconfigMaxExpiration,
 			ViewerKubeconfigMaxExpiration: c.ExtraConfig.ViewerKubeconfigMaxExpiration,
 			CredentialsRotationInterval:   c.ExtraConfig.CredentialsRotationInterval,
+			RevisionHistoryLimit:          c.ExtraConfig.ShootRevisionHistoryLimit,
 			KubeInformerFactory:           c.kubeInformerFactory,
 			CoreInformerFactory:           c.coreInformerFactory,
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -103,6 +105,7 @@ type ExtraOptions struct {
 	AdminKubeconfigMaxExpiration  time.Duration
 	ViewerKubeconfigMaxExpiration time.Duration
 	CredentialsRotationInterval   time.Duration
+	ShootRevisionHistoryLimit     int
 
 	LogLevel  string
 	LogFormat string
@@ -130,6 +133,10 @@ func (o *ExtraOptions) Validate() []error {
 		allErrors = append(allErrors, errors.New("--shoot-credentials-rotation-interval must be between 24 hours and 2^32 seconds"))
 	}
 
+	if o.ShootRevisionHistoryLimit < 0 || o.ShootRevisionHistoryLimit > 100 {
+		allErrors = append(allErrors, errors.New("--shoot-revision-history-limit must be between 0 and 100"))
+	}
+
 	if !sets.New(logger.AllLogLevels...).Has(o.LogLevel) {
 		allErrors = append(allErrors, fmt.Errorf("invalid --log-level: %s", o.LogLevel))
 	}
@@ -147,6 +154,7 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&o.AdminKubeconfigMaxExpiration, "shoot-admin-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an AdminKubeconfigRequest. If an otherwise valid AdminKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.ViewerKubeconfigMaxExpiration, "shoot-viewer-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an ViewerKubeconfigRequest. If an otherwise valid ViewerKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.CredentialsRotationInterval, "shoot-credentials-rotation-interval", time.Hour*24*90, "The duration after the initial shoot creation or the last credentials rotation when a client warning for the next credentials rotation is issued.")
+	fs.IntVar(&o.ShootRevisionHistoryLimit, "shoot-revision-history-limit", 0, "The maximum number of changes of the specification of a Shoot which are recorded in its ShootRevision. If set to 0, the changes are not recorded.")
 
 	fs.StringVar(&o.LogLevel, "log-level", "info", "The level/severity for the logs. Must be one of [info,debug,error]")
 	fs.StringVar(&o.LogFormat, "log-format", "json", "The format for the logs. Must be one of [json,text]")
@@ -157,6 +165,7 @@ func (o *ExtraOptions) ApplyTo(c *Config) error {
 	c.ExtraConfig.AdminKubeconfigMaxExpiration = o.AdminKubeconfigMaxExpiration
 	c.ExtraConfig.ViewerKubeconfigMaxExpiration = o.ViewerKubeconfigMaxExpiration
 	c.ExtraConfig.CredentialsRotationInterval = o.CredentialsRotationInterval
+	c.ExtraConfig.ShootRevisionHistoryLimit = o.ShootRevisionHistoryLimit
 
 	return nil
 }
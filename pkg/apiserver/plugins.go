@@ -23,10 +23,12 @@ import (
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
 	shootdnsrewriting "github.com/gardener/gardener/plugin/pkg/shoot/dnsrewriting"
 	shootexposureclass "github.com/gardener/gardener/plugin/pkg/shoot/exposureclass"
+	shootkubernetesversion "github.com/gardener/gardener/plugin/pkg/shoot/kubernetesversion"
 	shootmanagedseed "github.com/gardener/gardener/plugin/pkg/shoot/managedseed"
 	shootnodelocaldns "github.com/gardener/gardener/plugin/pkg/shoot/nodelocaldns"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootproviderquotapreflight "github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootresourcereservation "github.com/gardener/gardener/plugin/pkg/shoot/resourcereservation"
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
@@ -48,6 +50,8 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	shootnodelocaldns.Register(plugins)
 	shootdnsrewriting.Register(plugins)
 	shootvalidator.Register(plugins)
+	shootkubernetesversion.Register(plugins)
+	shootproviderquotapreflight.Register(plugins)
 	seedvalidator.Register(plugins)
 	controllerregistrationresources.Register(plugins)
 	namespacedcloudprofilevalidator.Register(plugins)
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/imagevectoroverride"
+)
+
+// REST implements a RESTStorage for ImageVectorOverride.
+type REST struct {
+	*genericregistry.Store
+}
+
+// ImageVectorOverrideStorage implements the storage for ImageVectorOverride.
+type ImageVectorOverrideStorage struct {
+	ImageVectorOverride *REST
+}
+
+// NewStorage creates a new ImageVectorOverrideStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) ImageVectorOverrideStorage {
+	return ImageVectorOverrideStorage{
+		ImageVectorOverride: NewREST(optsGetter),
+	}
+}
+
+// NewREST returns a RESTStorage object that will work with ImageVectorOverride objects.
+func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+	strategy := imagevectoroverride.NewStrategy()
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &core.ImageVectorOverride{} },
+		NewListFunc:               func() runtime.Object { return &core.ImageVectorOverrideList{} },
+		DefaultQualifiedResource:  core.Resource("imagevectoroverrides"),
+		SingularQualifiedResource: core.Resource("imagevectoroverride"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+	return &REST{store}
+}
+
+// Implement ShortNamesProvider.
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"ivo"}
+}
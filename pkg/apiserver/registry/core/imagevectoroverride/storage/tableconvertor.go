@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Seed Selector", Type: "string", Description: "The label selector for seeds."},
+			{Name: "Shoot Selector", Type: "string", Description: "The label selector for shoots."},
+			{Name: "Images", Type: "integer", Description: "The number of overridden images."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			imageVectorOverride = obj.(*core.ImageVectorOverride)
+			cells               = []any{}
+		)
+		cells = append(cells, imageVectorOverride.Name)
+		cells = append(cells, labelSelectorString(imageVectorOverride.Spec.SeedSelector))
+		cells = append(cells, labelSelectorString(imageVectorOverride.Spec.ShootSelector))
+		cells = append(cells, len(imageVectorOverride.Spec.Images))
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(imageVectorOverride.CreationTimestamp))
+		return cells, nil
+	})
+
+	return table, err
+}
+
+func labelSelectorString(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return "<none>"
+	}
+	return metav1.FormatLabelSelector(selector)
+}
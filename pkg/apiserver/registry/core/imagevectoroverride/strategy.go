@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevectoroverride
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// ImageVectorOverrideStrategy defines the strategy for storing imagevectoroverrides.
+type ImageVectorOverrideStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy returns a storage strategy for imagevectoroverrides.
+func NewStrategy() ImageVectorOverrideStrategy {
+	return ImageVectorOverrideStrategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+// NamespaceScoped indicates if the object is namespaced scoped.
+func (ImageVectorOverrideStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate mutates the object before creation.
+// It is called before Validate.
+func (ImageVectorOverrideStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	imageVectorOverride := obj.(*core.ImageVectorOverride)
+
+	imageVectorOverride.Generation = 1
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (ImageVectorOverrideStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newImageVectorOverride := obj.(*core.ImageVectorOverride)
+	oldImageVectorOverride := old.(*core.ImageVectorOverride)
+
+	if !apiequality.Semantic.DeepEqual(oldImageVectorOverride.Spec, newImageVectorOverride.Spec) {
+		newImageVectorOverride.Generation = oldImageVectorOverride.Generation + 1
+	}
+}
+
+// Validate allow to validate the object.
+func (ImageVectorOverrideStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	imageVectorOverride := obj.(*core.ImageVectorOverride)
+	return validation.ValidateImageVectorOverride(imageVectorOverride)
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (ImageVectorOverrideStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldImageVectorOverride, newImageVectorOverride := oldObj.(*core.ImageVectorOverride), newObj.(*core.ImageVectorOverride)
+	return validation.ValidateImageVectorOverrideUpdate(newImageVectorOverride, oldImageVectorOverride)
+}
+
+// Canonicalize can be used to transform the object into its canonical format.
+func (ImageVectorOverrideStrategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate indicates if the object can be created via a PUT operation.
+func (ImageVectorOverrideStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate indicates if the object can be updated
+// independently of the resource version.
+func (ImageVectorOverrideStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (ImageVectorOverrideStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (ImageVectorOverrideStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
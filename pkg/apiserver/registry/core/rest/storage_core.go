@@ -24,6 +24,7 @@ import (
 	controllerinstallationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerinstallation/storage"
 	controllerregistrationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/exposureclass/storage"
+	imagevectoroverridestore "github.com/gardener/gardener/pkg/apiserver/registry/core/imagevectoroverride/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/apiserver/registry/core/internalsecret/storage"
 	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/namespacedcloudprofile/storage"
 	projectstore "github.com/gardener/gardener/pkg/apiserver/registry/core/project/storage"
@@ -31,7 +32,9 @@ import (
 	secretbindingstore "github.com/gardener/gardener/pkg/apiserver/registry/core/secretbinding/storage"
 	seedstore "github.com/gardener/gardener/pkg/apiserver/registry/core/seed/storage"
 	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
+	shootrevisionstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootrevision/storage"
 	shootstatestore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootstate/storage"
+	versionpolicystore "github.com/gardener/gardener/pkg/apiserver/registry/core/versionpolicy/storage"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 )
 
@@ -40,6 +43,7 @@ type StorageProvider struct {
 	AdminKubeconfigMaxExpiration  time.Duration
 	ViewerKubeconfigMaxExpiration time.Duration
 	CredentialsRotationInterval   time.Duration
+	RevisionHistoryLimit          int
 	KubeInformerFactory           kubeinformers.SharedInformerFactory
 	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
 }
@@ -97,6 +101,9 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	exposureClassStorage := exposureclassstore.NewStorage(restOptionsGetter)
 	storage["exposureclasses"] = exposureClassStorage.ExposureClass
 
+	imageVectorOverrideStorage := imagevectoroverridestore.NewStorage(restOptionsGetter)
+	storage["imagevectoroverrides"] = imageVectorOverrideStorage.ImageVectorOverride
+
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
@@ -116,20 +123,36 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	shootStateStorage := shootstatestore.NewStorage(restOptionsGetter)
 	storage["shootstates"] = shootStateStorage.ShootState
 
+	shootRevisionStorage := shootrevisionstore.NewStorage(restOptionsGetter, p.RevisionHistoryLimit)
+	storage["shootrevisions"] = shootRevisionStorage.ShootRevision
+
+	var revisionRecorder shootstore.RevisionRecorder
+	if p.RevisionHistoryLimit > 0 {
+		revisionRecorder = shootRevisionStorage.Recorder
+	}
+
 	shootStorage := shootstore.NewStorage(
 		restOptionsGetter,
 		p.CoreInformerFactory.Core().V1beta1().InternalSecrets().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().CloudProfiles().Lister(),
 		p.KubeInformerFactory.Core().V1().Secrets().Lister(),
 		p.KubeInformerFactory.Core().V1().ConfigMaps().Lister(),
 		p.AdminKubeconfigMaxExpiration,
 		p.ViewerKubeconfigMaxExpiration,
 		p.CredentialsRotationInterval,
+		revisionRecorder,
 	)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
 	storage["shoots/binding"] = shootStorage.Binding
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/cost"] = shootStorage.Cost
+	storage["shoots/clone"] = shootStorage.Clone
+	storage["shoots/export"] = shootStorage.Export
+
+	versionPolicyStorage := versionpolicystore.NewStorage(restOptionsGetter)
+	storage["versionpolicies"] = versionPolicyStorage.VersionPolicy
 
 	return storage
 }
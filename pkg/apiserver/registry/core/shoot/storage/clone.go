@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// CloneREST implements a RESTStorage for generating the manifest of a new Shoot based on an existing Shoot.
+type CloneREST struct {
+	shootStorage getter
+}
+
+var (
+	_ = rest.NamedCreater(&CloneREST{})
+	_ = rest.GroupVersionKindProvider(&CloneREST{})
+)
+
+// NewCloneREST returns a new CloneREST.
+func NewCloneREST(shootGetter getter) *CloneREST {
+	return &CloneREST{
+		shootStorage: shootGetter,
+	}
+}
+
+// New returns an instance of the object.
+func (r *CloneREST) New() runtime.Object {
+	return &core.ShootClone{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *CloneREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns the manifest of a new Shoot based on the specification of the Shoot with the given name. The new
+// Shoot is not created, i.e., it is subject to the usual admission of Shoots once the client submits it.
+func (r *CloneREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	clone, ok := obj.(*core.ShootClone)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootClone: %T", obj))
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	if errs := validation.ValidateShootClone(clone, shoot.Name); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(core.Kind("ShootClone"), shoot.Name, errs)
+	}
+
+	clone.Status.Shoot = cloneShoot(shoot, clone.Spec)
+	return clone, nil
+}
+
+// GroupVersionKind returns the GVK for the ShootClone type.
+func (r *CloneREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootClone")
+}
+
+// cloneShoot copies the specification of the given Shoot. Metadata, status, and fields identifying the Shoot or its
+// placement (DNS domain, seed, service account issuer) are not copied so that they are generated anew for the new
+// Shoot.
+func cloneShoot(shoot *core.Shoot, spec core.ShootCloneSpec) *core.Shoot {
+	clone := &core.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.Name,
+			Namespace: shoot.Namespace,
+		},
+		Spec: *shoot.Spec.DeepCopy(),
+	}
+
+	clone.Spec.SeedName = nil
+	if clone.Spec.DNS != nil {
+		clone.Spec.DNS.Domain = nil
+	}
+	if kubeAPIServer := clone.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.ServiceAccountConfig != nil {
+		kubeAPIServer.ServiceAccountConfig.Issuer = nil
+		kubeAPIServer.ServiceAccountConfig.AcceptedIssuers = nil
+	}
+
+	if !ptr.Deref(spec.CopyWorkers, true) {
+		clone.Spec.Provider.Workers = nil
+	}
+
+	if !ptr.Deref(spec.CopyNetworking, true) && clone.Spec.Networking != nil {
+		clone.Spec.Networking.Nodes = nil
+		clone.Spec.Networking.Pods = nil
+		clone.Spec.Networking.Services = nil
+	}
+
+	if !ptr.Deref(spec.CopyAddons, true) {
+		clone.Spec.Addons = nil
+		clone.Spec.Extensions = nil
+	}
+
+	return clone
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		ctx = context.TODO()
+
+		shoot     *gardencore.Shoot
+		cloneREST *CloneREST
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "shoot",
+				Namespace:   "garden-dev",
+				UID:         "1234",
+				Labels:      map[string]string{"shoot.gardener.cloud/status": "healthy"},
+				Annotations: map[string]string{"gardener.cloud/operation": "reconcile"},
+			},
+			Spec: gardencore.ShootSpec{
+				CloudProfileName:  "profile",
+				Region:            "region",
+				SecretBindingName: ptr.To("secret"),
+				SeedName:          ptr.To("seed"),
+				Addons:            &gardencore.Addons{NginxIngress: &gardencore.NginxIngress{Addon: gardencore.Addon{Enabled: true}}},
+				DNS:               &gardencore.DNS{Domain: ptr.To("shoot.dev.example.com")},
+				Extensions:        []gardencore.Extension{{Type: "foo"}},
+				Kubernetes: gardencore.Kubernetes{
+					Version: "1.28.2",
+					KubeAPIServer: &gardencore.KubeAPIServerConfig{
+						ServiceAccountConfig: &gardencore.ServiceAccountConfig{
+							Issuer:          ptr.To("https://issuer.example.com"),
+							AcceptedIssuers: []string{"https://old-issuer.example.com"},
+						},
+					},
+				},
+				Networking: &gardencore.Networking{
+					Type:     ptr.To("calico"),
+					Nodes:    ptr.To("10.250.0.0/16"),
+					Pods:     ptr.To("100.96.0.0/11"),
+					Services: ptr.To("100.64.0.0/13"),
+				},
+				Provider: gardencore.Provider{
+					Type:    "local",
+					Workers: []gardencore.Worker{{Name: "worker"}},
+				},
+			},
+			Status: gardencore.ShootStatus{TechnicalID: "shoot--dev--shoot"},
+		}
+
+		cloneREST = NewCloneREST(&fakeGetter{obj: shoot})
+	})
+
+	Describe("#Create", func() {
+		It("should generate a new shoot with the copied specification", func() {
+			clone := &gardencore.ShootClone{Spec: gardencore.ShootCloneSpec{Name: "clone"}}
+
+			obj, err := cloneREST.Create(ctx, shoot.Name, clone, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expectedSpec := shoot.Spec.DeepCopy()
+			expectedSpec.SeedName = nil
+			expectedSpec.DNS.Domain = nil
+			expectedSpec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &gardencore.ServiceAccountConfig{}
+
+			Expect(obj.(*gardencore.ShootClone).Status.Shoot).To(Equal(&gardencore.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: "garden-dev"},
+				Spec:       *expectedSpec,
+			}))
+		})
+
+		It("should not copy the workers, networks, and addons if not desired", func() {
+			clone := &gardencore.ShootClone{Spec: gardencore.ShootCloneSpec{
+				Name:           "clone",
+				CopyWorkers:    ptr.To(false),
+				CopyNetworking: ptr.To(false),
+				CopyAddons:     ptr.To(false),
+			}}
+
+			obj, err := cloneREST.Create(ctx, shoot.Name, clone, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			spec := obj.(*gardencore.ShootClone).Status.Shoot.Spec
+			Expect(spec.Provider).To(Equal(gardencore.Provider{Type: "local"}))
+			Expect(spec.Networking).To(Equal(&gardencore.Networking{Type: ptr.To("calico")}))
+			Expect(spec.Addons).To(BeNil())
+			Expect(spec.Extensions).To(BeNil())
+			Expect(spec.SecretBindingName).To(Equal(ptr.To("secret")))
+		})
+
+		It("should not modify the cloned shoot", func() {
+			original := shoot.DeepCopy()
+
+			_, err := cloneREST.Create(ctx, shoot.Name, &gardencore.ShootClone{Spec: gardencore.ShootCloneSpec{Name: "clone", CopyWorkers: ptr.To(false)}}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot).To(Equal(original))
+		})
+
+		It("should reject invalid requests", func() {
+			_, err := cloneREST.Create(ctx, shoot.Name, &gardencore.ShootClone{Spec: gardencore.ShootCloneSpec{Name: shoot.Name}}, nil, nil)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should return the error of the shoot getter", func() {
+			cloneREST = NewCloneREST(&fakeGetter{err: apierrors.NewNotFound(gardencore.Resource("shoots"), shoot.Name)})
+
+			_, err := cloneREST.Create(ctx, shoot.Name, &gardencore.ShootClone{Spec: gardencore.ShootCloneSpec{Name: "clone"}}, nil, nil)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
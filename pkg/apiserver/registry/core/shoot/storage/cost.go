@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+)
+
+// hoursPerMonth is the average number of hours per month used for estimating the monthly cost of machines.
+const hoursPerMonth = 730
+
+// CostREST implements a RESTStorage for estimating the cost of a Shoot.
+type CostREST struct {
+	shootStorage       getter
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister
+}
+
+var (
+	_ = rest.NamedCreater(&CostREST{})
+	_ = rest.GroupVersionKindProvider(&CostREST{})
+)
+
+// NewCostREST returns a new CostREST.
+func NewCostREST(shootGetter getter, cloudProfileLister gardencorev1beta1listers.CloudProfileLister) *CostREST {
+	return &CostREST{
+		shootStorage:       shootGetter,
+		cloudProfileLister: cloudProfileLister,
+	}
+}
+
+// New returns an instance of the object.
+func (r *CostREST) New() runtime.Object {
+	return &core.ShootCostEstimate{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *CostREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns a cost estimate for the worker pools given in the request or, if none are given, for the worker pools
+// of the Shoot. The estimate is based on the prices maintained in the CloudProfile referenced by the Shoot.
+func (r *CostREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	estimate, ok := obj.(*core.ShootCostEstimate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootCostEstimate: %T", obj))
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	if errs := validation.ValidateShootCostEstimate(estimate, shoot.Spec.Kubernetes); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(core.Kind("ShootCostEstimate"), shoot.Name, errs)
+	}
+
+	cloudProfile, err := r.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get cloud profile %q: %w", shoot.Spec.CloudProfileName, err))
+	}
+
+	workers := estimate.Spec.Workers
+	if len(workers) == 0 {
+		workers = shoot.Spec.Provider.Workers
+	}
+
+	estimate.Status = estimateCost(cloudProfile, workers)
+	return estimate, nil
+}
+
+// GroupVersionKind returns the GVK for the ShootCostEstimate type.
+func (r *CostREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootCostEstimate")
+}
+
+func estimateCost(cloudProfile *gardencorev1beta1.CloudProfile, workers []core.Worker) core.ShootCostEstimateStatus {
+	var (
+		status = core.ShootCostEstimateStatus{}
+
+		machinePrices = make(map[string]*big.Rat, len(cloudProfile.Spec.MachineTypes))
+		volumePrices  = make(map[string]*big.Rat, len(cloudProfile.Spec.VolumeTypes))
+
+		totalMinimum = new(big.Rat)
+		totalMaximum = new(big.Rat)
+	)
+
+	for _, machineType := range cloudProfile.Spec.MachineTypes {
+		if price, ok := parsePrice(machineType.HourlyPrice); ok {
+			machinePrices[machineType.Name] = price.Mul(price, big.NewRat(hoursPerMonth, 1))
+		}
+	}
+	for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+		if price, ok := parsePrice(volumeType.MonthlyPricePerGi); ok {
+			volumePrices[volumeType.Name] = price
+		}
+	}
+
+	volumeCost := func(workerName string, volumeType *string, volumeSize string) *big.Rat {
+		if volumeType == nil {
+			status.Warnings = append(status.Warnings, fmt.Sprintf("Volume of worker pool %q has no type, hence its cost is not considered.", workerName))
+			return new(big.Rat)
+		}
+
+		price, ok := volumePrices[*volumeType]
+		if !ok {
+			status.Warnings = append(status.Warnings, fmt.Sprintf("No price is maintained for volume type %q of worker pool %q.", *volumeType, workerName))
+			return new(big.Rat)
+		}
+
+		size, err := resource.ParseQuantity(volumeSize)
+		if err != nil {
+			status.Warnings = append(status.Warnings, fmt.Sprintf("Size %q of volume of worker pool %q cannot be parsed.", volumeSize, workerName))
+			return new(big.Rat)
+		}
+
+		return new(big.Rat).Mul(price, big.NewRat(size.Value(), 1<<30))
+	}
+
+	for _, worker := range workers {
+		costPerMachine := new(big.Rat)
+
+		if price, ok := machinePrices[worker.Machine.Type]; ok {
+			costPerMachine.Add(costPerMachine, price)
+		} else {
+			status.Warnings = append(status.Warnings, fmt.Sprintf("No price is maintained for machine type %q of worker pool %q.", worker.Machine.Type, worker.Name))
+		}
+
+		if worker.Volume != nil {
+			costPerMachine.Add(costPerMachine, volumeCost(worker.Name, worker.Volume.Type, worker.Volume.VolumeSize))
+		}
+		for _, dataVolume := range worker.DataVolumes {
+			costPerMachine.Add(costPerMachine, volumeCost(worker.Name, dataVolume.Type, dataVolume.VolumeSize))
+		}
+
+		var (
+			minimum = new(big.Rat).Mul(costPerMachine, big.NewRat(int64(worker.Minimum), 1))
+			maximum = new(big.Rat).Mul(costPerMachine, big.NewRat(int64(worker.Maximum), 1))
+		)
+
+		totalMinimum.Add(totalMinimum, minimum)
+		totalMaximum.Add(totalMaximum, maximum)
+
+		status.Workers = append(status.Workers, core.WorkerCostEstimate{
+			Name:               worker.Name,
+			MinimumMonthlyCost: minimum.FloatString(2),
+			MaximumMonthlyCost: maximum.FloatString(2),
+		})
+	}
+
+	status.MinimumMonthlyCost = totalMinimum.FloatString(2)
+	status.MaximumMonthlyCost = totalMaximum.FloatString(2)
+	return status
+}
+
+func parsePrice(price *string) (*big.Rat, bool) {
+	if price == nil {
+		return nil, false
+	}
+	return new(big.Rat).SetString(*price)
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+)
+
+var _ = Describe("Cost", func() {
+	var (
+		ctx = context.TODO()
+
+		shoot        *gardencore.Shoot
+		cloudProfile *gardencorev1beta1.CloudProfile
+		indexer      cache.Indexer
+		costREST     *CostREST
+
+		newWorker = func(name, machineType string, minimum, maximum int32, volume *gardencore.Volume) gardencore.Worker {
+			return gardencore.Worker{
+				Name:           name,
+				Machine:        gardencore.Machine{Type: machineType},
+				Minimum:        minimum,
+				Maximum:        maximum,
+				MaxSurge:       &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+				MaxUnavailable: &intstr.IntOrString{Type: intstr.Int, IntVal: 0},
+				Volume:         volume,
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencore.ShootSpec{
+				CloudProfileName: "profile",
+				Kubernetes:       gardencore.Kubernetes{Version: "1.28.2"},
+				Provider: gardencore.Provider{
+					Workers: []gardencore.Worker{
+						newWorker("small", "small", 1, 2, &gardencore.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"}),
+					},
+				},
+			},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{Name: "small", HourlyPrice: ptr.To("0.1")},
+					{Name: "large", HourlyPrice: ptr.To("0.4")},
+					{Name: "unpriced"},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{
+					{Name: "standard", MonthlyPricePerGi: ptr.To("0.05")},
+					{Name: "fast", MonthlyPricePerGi: ptr.To("0.045")},
+					{Name: "premium"},
+				},
+			},
+		}
+
+		indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(indexer.Add(cloudProfile)).To(Succeed())
+
+		costREST = NewCostREST(&fakeGetter{obj: shoot}, gardencorev1beta1listers.NewCloudProfileLister(indexer))
+	})
+
+	Describe("#Create", func() {
+		It("should estimate the cost of the shoot's worker pools", func() {
+			obj, err := costREST.Create(ctx, shoot.Name, &gardencore.ShootCostEstimate{}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			// 0.1 * 730 + 0.05 * 20 = 74 per machine
+			Expect(obj.(*gardencore.ShootCostEstimate).Status).To(Equal(gardencore.ShootCostEstimateStatus{
+				Workers: []gardencore.WorkerCostEstimate{
+					{Name: "small", MinimumMonthlyCost: "74.00", MaximumMonthlyCost: "148.00"},
+				},
+				MinimumMonthlyCost: "74.00",
+				MaximumMonthlyCost: "148.00",
+			}))
+		})
+
+		It("should estimate the cost of the requested worker pools", func() {
+			estimate := &gardencore.ShootCostEstimate{
+				Spec: gardencore.ShootCostEstimateSpec{
+					Workers: []gardencore.Worker{
+						newWorker("large", "large", 2, 3, &gardencore.Volume{Type: ptr.To("fast"), VolumeSize: "1Gi"}),
+						newWorker("small", "small", 0, 1, nil),
+					},
+				},
+			}
+
+			obj, err := costREST.Create(ctx, shoot.Name, estimate, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			// large: 0.4 * 730 + 0.045 * 1 = 292.045 per machine
+			Expect(obj.(*gardencore.ShootCostEstimate).Status).To(Equal(gardencore.ShootCostEstimateStatus{
+				Workers: []gardencore.WorkerCostEstimate{
+					{Name: "large", MinimumMonthlyCost: "584.09", MaximumMonthlyCost: "876.14"},
+					{Name: "small", MinimumMonthlyCost: "0.00", MaximumMonthlyCost: "73.00"},
+				},
+				MinimumMonthlyCost: "584.09",
+				MaximumMonthlyCost: "949.14",
+			}))
+		})
+
+		It("should report warnings for cost which cannot be considered", func() {
+			worker := newWorker("unpriced", "unpriced", 1, 1, &gardencore.Volume{Type: ptr.To("premium"), VolumeSize: "20Gi"})
+			worker.DataVolumes = []gardencore.DataVolume{{Name: "data", VolumeSize: "10Gi"}}
+			estimate := &gardencore.ShootCostEstimate{
+				Spec: gardencore.ShootCostEstimateSpec{Workers: []gardencore.Worker{worker}},
+			}
+
+			obj, err := costREST.Create(ctx, shoot.Name, estimate, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			status := obj.(*gardencore.ShootCostEstimate).Status
+			Expect(status.MaximumMonthlyCost).To(Equal("0.00"))
+			Expect(status.Warnings).To(ConsistOf(
+				`No price is maintained for machine type "unpriced" of worker pool "unpriced".`,
+				`No price is maintained for volume type "premium" of worker pool "unpriced".`,
+				`Volume of worker pool "unpriced" has no type, hence its cost is not considered.`,
+			))
+		})
+
+		It("should reject invalid requests", func() {
+			estimate := &gardencore.ShootCostEstimate{
+				Spec: gardencore.ShootCostEstimateSpec{
+					Workers: []gardencore.Worker{newWorker("small", "", 1, 2, nil)},
+				},
+			}
+
+			_, err := costREST.Create(ctx, shoot.Name, estimate, nil, nil)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should fail if the cloud profile does not exist", func() {
+			Expect(indexer.Delete(cloudProfile)).To(Succeed())
+
+			_, err := costREST.Create(ctx, shoot.Name, &gardencore.ShootCostEstimate{}, nil, nil)
+			Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		})
+
+		It("should return the error of the shoot getter", func() {
+			costREST = NewCostREST(&fakeGetter{err: apierrors.NewNotFound(gardencore.Resource("shoots"), shoot.Name)}, gardencorev1beta1listers.NewCloudProfileLister(indexer))
+
+			_, err := costREST.Create(ctx, shoot.Name, &gardencore.ShootCostEstimate{}, nil, nil)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
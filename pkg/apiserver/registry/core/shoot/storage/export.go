@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootprofile"
+)
+
+// ExportREST implements a RESTStorage for exporting a Shoot into a provider-agnostic profile.
+type ExportREST struct {
+	shootStorage       getter
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister
+}
+
+var (
+	_ = rest.NamedCreater(&ExportREST{})
+	_ = rest.GroupVersionKindProvider(&ExportREST{})
+)
+
+// NewExportREST returns a new ExportREST.
+func NewExportREST(shootGetter getter, cloudProfileLister gardencorev1beta1listers.CloudProfileLister) *ExportREST {
+	return &ExportREST{
+		shootStorage:       shootGetter,
+		cloudProfileLister: cloudProfileLister,
+	}
+}
+
+// New returns an instance of the object.
+func (r *ExportREST) New() runtime.Object {
+	return &core.ShootExport{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ExportREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create exports the Shoot with the given name into a provider-agnostic profile. If a target is given, the profile is
+// additionally imported against the target CloudProfile and region. The imported Shoot is not created.
+func (r *ExportREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	export, ok := obj.(*core.ShootExport)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootExport: %T", obj))
+	}
+
+	if errs := validation.ValidateShootExport(export); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(core.Kind("ShootExport"), name, errs)
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(shootObj, shoot, nil); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not convert shoot: %w", err))
+	}
+
+	cloudProfile, err := r.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get cloud profile %q: %w", shoot.Spec.CloudProfileName, err))
+	}
+
+	profile, err := shootprofile.Export(shoot, &cloudProfile.Spec)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not export shoot: %w", err))
+	}
+
+	export.Status = core.ShootExportStatus{Profile: &core.ShootProfile{}}
+	if err := api.Scheme.Convert(profile, export.Status.Profile, nil); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not convert profile: %w", err))
+	}
+
+	if target := export.Spec.Target; target != nil {
+		targetCloudProfile, err := r.cloudProfileLister.Get(target.CloudProfileName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, apierrors.NewBadRequest(fmt.Sprintf("target cloud profile %q not found", target.CloudProfileName))
+			}
+			return nil, apierrors.NewInternalError(fmt.Errorf("could not get cloud profile %q: %w", target.CloudProfileName, err))
+		}
+
+		imported, warnings, err := shootprofile.Import(profile, target.CloudProfileName, &targetCloudProfile.Spec, target.Region)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("could not import profile against cloud profile %q: %v", target.CloudProfileName, err))
+		}
+
+		export.Status.Shoot = &core.Shoot{}
+		if err := api.Scheme.Convert(imported, export.Status.Shoot, nil); err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("could not convert shoot: %w", err))
+		}
+		export.Status.Warnings = warnings
+	}
+
+	return export, nil
+}
+
+// GroupVersionKind returns the GVK for the ShootExport type.
+func (r *ExportREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return gardencorev1beta1.SchemeGroupVersion.WithKind("ShootExport")
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+)
+
+var _ = Describe("Export", func() {
+	var (
+		ctx = context.TODO()
+
+		shoot      *gardencore.Shoot
+		indexer    cache.Indexer
+		exportREST *ExportREST
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencore.ShootSpec{
+				CloudProfileName: "aws",
+				Region:           "eu-west-1",
+				Kubernetes:       gardencore.Kubernetes{Version: "1.29.1"},
+				Networking:       &gardencore.Networking{Type: ptr.To("calico"), Nodes: ptr.To("10.250.0.0/16")},
+				Provider: gardencore.Provider{
+					Type: "aws",
+					Workers: []gardencore.Worker{{
+						Name:    "worker",
+						Machine: gardencore.Machine{Type: "m5.large", Architecture: ptr.To("amd64")},
+						Minimum: 1,
+						Maximum: 3,
+						Zones:   []string{"eu-west-1a"},
+					}},
+				},
+			},
+		}
+
+		indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(indexer.Add(&gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type:         "aws",
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "m5.large", CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi")}},
+			},
+		})).To(Succeed())
+		Expect(indexer.Add(&gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "gcp"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type:         "gcp",
+				Kubernetes:   gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{{Version: "1.29.1"}}},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: "n1-standard-2", CPU: resource.MustParse("2"), Memory: resource.MustParse("7500Mi")}, {Name: "n2-standard-2", CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi")}},
+				Regions:      []gardencorev1beta1.Region{{Name: "europe-west1", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "europe-west1-b"}}}},
+			},
+		})).To(Succeed())
+
+		exportREST = NewExportREST(&fakeGetter{obj: shoot}, gardencorev1beta1listers.NewCloudProfileLister(indexer))
+	})
+
+	Describe("#Create", func() {
+		It("should export the shoot into a profile", func() {
+			obj, err := exportREST.Create(ctx, shoot.Name, &gardencore.ShootExport{}, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			status := obj.(*gardencore.ShootExport).Status
+			Expect(status.Shoot).To(BeNil())
+			Expect(status.Profile).To(Equal(&gardencore.ShootProfile{
+				Version:           "v1",
+				KubernetesVersion: "1.29.1",
+				Networking:        &gardencore.Networking{Type: ptr.To("calico"), Nodes: ptr.To("10.250.0.0/16")},
+				Workers: []gardencore.WorkerProfile{{
+					Name:      "worker",
+					Machine:   gardencore.MachineRequirements{CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi"), Architecture: ptr.To("amd64")},
+					Minimum:   1,
+					Maximum:   3,
+					ZoneCount: 1,
+				}},
+			}))
+		})
+
+		It("should import the profile against the target cloud profile", func() {
+			export := &gardencore.ShootExport{Spec: gardencore.ShootExportSpec{Target: &gardencore.ShootExportTarget{CloudProfileName: "gcp", Region: "europe-west1"}}}
+
+			obj, err := exportREST.Create(ctx, shoot.Name, export, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			status := obj.(*gardencore.ShootExport).Status
+			Expect(status.Warnings).To(BeEmpty())
+			Expect(status.Shoot).NotTo(BeNil())
+			Expect(status.Shoot.Spec.CloudProfileName).To(Equal("gcp"))
+			Expect(status.Shoot.Spec.Region).To(Equal("europe-west1"))
+			Expect(status.Shoot.Spec.Provider.Type).To(Equal("gcp"))
+			Expect(status.Shoot.Spec.Provider.Workers).To(ConsistOf(gardencore.Worker{
+				Name:    "worker",
+				Machine: gardencore.Machine{Type: "n2-standard-2", Architecture: ptr.To("amd64")},
+				Minimum: 1,
+				Maximum: 3,
+				Zones:   []string{"europe-west1-b"},
+			}))
+		})
+
+		It("should reject invalid requests", func() {
+			export := &gardencore.ShootExport{Spec: gardencore.ShootExportSpec{Target: &gardencore.ShootExportTarget{}}}
+
+			_, err := exportREST.Create(ctx, shoot.Name, export, nil, nil)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+
+		It("should reject unknown target cloud profiles", func() {
+			export := &gardencore.ShootExport{Spec: gardencore.ShootExportSpec{Target: &gardencore.ShootExportTarget{CloudProfileName: "azure", Region: "westeurope"}}}
+
+			_, err := exportREST.Create(ctx, shoot.Name, export, nil, nil)
+			Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		})
+
+		It("should reject profiles which cannot be imported", func() {
+			export := &gardencore.ShootExport{Spec: gardencore.ShootExportSpec{Target: &gardencore.ShootExportTarget{CloudProfileName: "gcp", Region: "us-east1"}}}
+
+			_, err := exportREST.Create(ctx, shoot.Name, export, nil, nil)
+			Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		})
+
+		It("should fail if the machine type is not defined in the cloud profile", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := exportREST.Create(ctx, shoot.Name, &gardencore.ShootExport{}, nil, nil)
+			Expect(apierrors.IsInternalError(err)).To(BeTrue())
+		})
+
+		It("should return the error of the shoot getter", func() {
+			exportREST = NewExportREST(&fakeGetter{err: apierrors.NewNotFound(gardencore.Resource("shoots"), shoot.Name)}, gardencorev1beta1listers.NewCloudProfileLister(indexer))
+
+			_, err := exportREST.Create(ctx, shoot.Name, &gardencore.ShootExport{}, nil, nil)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
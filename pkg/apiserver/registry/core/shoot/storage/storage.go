@@ -10,11 +10,13 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apiserver/registry/core/shoot"
@@ -24,6 +26,13 @@ import (
 // REST implements a RESTStorage for shoots against etcd
 type REST struct {
 	*genericregistry.Store
+	revisionRecorder RevisionRecorder
+}
+
+// RevisionRecorder records the changes of the specification of Shoots.
+type RevisionRecorder interface {
+	// Record records the change from the specification of the old Shoot to the specification of the new Shoot.
+	Record(ctx context.Context, oldShoot, newShoot *core.Shoot) error
 }
 
 // ShootStorage implements the storage for Shoots and all their subresources.
@@ -33,19 +42,24 @@ type ShootStorage struct {
 	AdminKubeconfig  *KubeconfigREST
 	ViewerKubeconfig *KubeconfigREST
 	Binding          *BindingREST
+	Cost             *CostREST
+	Clone            *CloneREST
+	Export           *ExportREST
 }
 
 // NewStorage creates a new ShootStorage object.
 func NewStorage(
 	optsGetter generic.RESTOptionsGetter,
 	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
+	cloudProfileLister gardencorev1beta1listers.CloudProfileLister,
 	secretLister kubecorev1listers.SecretLister,
 	configMapLister kubecorev1listers.ConfigMapLister,
 	adminKubeconfigMaxExpiration time.Duration,
 	viewerKubeconfigMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
+	revisionRecorder RevisionRecorder,
 ) ShootStorage {
-	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
+	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval, revisionRecorder)
 
 	return ShootStorage{
 		Shoot:            shootRest,
@@ -53,11 +67,15 @@ func NewStorage(
 		Binding:          bindingREST,
 		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration),
 		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration),
+		Cost:             NewCostREST(shootRest, cloudProfileLister),
+		Clone:            NewCloneREST(shootRest),
+		Export:           NewExportREST(shootRest, cloudProfileLister),
 	}
 }
 
-// NewREST returns a RESTStorage object that will work against shoots.
-func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration) (*REST, *StatusREST, *BindingREST) {
+// NewREST returns a RESTStorage object that will work against shoots. If revisionRecorder is not nil, the changes of
+// the specification of shoots are recorded with it.
+func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration, revisionRecorder RevisionRecorder) (*REST, *StatusREST, *BindingREST) {
 	var (
 		shootStrategy = shoot.NewStrategy(credentialsRotationInterval)
 		store         = &genericregistry.Store{
@@ -78,6 +96,12 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 			RESTOptions: optsGetter,
 			AttrFunc:    shoot.GetAttrs,
 			TriggerFunc: map[string]storage.IndexerFunc{core.ShootSeedName: shoot.SeedNameTriggerFunc},
+			Indexers: &cache.Indexers{
+				storage.FieldIndex(core.ShootSeedName):                 shoot.SeedNameIndexFunc,
+				storage.FieldIndex(core.ShootCloudProfileName):         shoot.CloudProfileNameIndexFunc,
+				storage.FieldIndex(core.ShootStatusLastOperationState): shoot.LastOperationStateIndexFunc,
+				storage.FieldIndex(core.ShootStatusConditionsHealth):   shoot.ConditionsHealthIndexFunc,
+			},
 		}
 	)
 
@@ -89,7 +113,43 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 	statusStore.UpdateStrategy = shoot.NewStatusStrategy()
 	bindingStore := *store
 	bindingStore.UpdateStrategy = shoot.NewBindingStrategy()
-	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+	return &REST{Store: store, revisionRecorder: revisionRecorder}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+}
+
+// Update alters the object. If a RevisionRecorder is configured, changes of the specification are recorded after the
+// update succeeded. Failures to record a change do not fail the update.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	if r.revisionRecorder == nil || len(options.DryRun) > 0 {
+		return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+	}
+
+	info := &oldObjectCapturingInfo{UpdatedObjectInfo: objInfo}
+	obj, created, err := r.Store.Update(ctx, name, info, createValidation, updateValidation, forceAllowCreate, options)
+	if err != nil || created {
+		return obj, created, err
+	}
+
+	oldShoot, ok1 := info.oldObj.(*core.Shoot)
+	newShoot, ok2 := obj.(*core.Shoot)
+	if ok1 && ok2 {
+		if err := r.revisionRecorder.Record(ctx, oldShoot, newShoot); err != nil {
+			utilruntime.HandleError(err)
+		}
+	}
+
+	return obj, created, err
+}
+
+// oldObjectCapturingInfo remembers the last object the wrapped UpdatedObjectInfo was applied to, i.e., the object
+// stored before a successful update.
+type oldObjectCapturingInfo struct {
+	rest.UpdatedObjectInfo
+	oldObj runtime.Object
+}
+
+func (i *oldObjectCapturingInfo) UpdatedObject(ctx context.Context, oldObj runtime.Object) (runtime.Object, error) {
+	i.oldObj = oldObj
+	return i.UpdatedObjectInfo.UpdatedObject(ctx, oldObj)
 }
 
 // Implement CategoriesProvider
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/api/core/shoot"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/features"
@@ -260,10 +261,12 @@ func ToSelectableFields(shoot *core.Shoot) fields.Set {
 	// amount of allocations needed to create the fields.Set. If you add any
 	// field here or the number of object-meta related fields changes, this should
 	// be adjusted.
-	shootSpecificFieldsSet := make(fields.Set, 5)
+	shootSpecificFieldsSet := make(fields.Set, 7)
 	shootSpecificFieldsSet[core.ShootSeedName] = getSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootStatusSeedName] = getStatusSeedName(shoot)
 	shootSpecificFieldsSet[core.ShootCloudProfileName] = shoot.Spec.CloudProfileName
+	shootSpecificFieldsSet[core.ShootStatusLastOperationState] = getLastOperationState(shoot)
+	shootSpecificFieldsSet[core.ShootStatusConditionsHealth] = getConditionsHealth(shoot)
 	return generic.AddObjectMetaFieldsSet(shootSpecificFieldsSet, &shoot.ObjectMeta, true)
 }
 
@@ -282,10 +285,50 @@ func MatchShoot(label labels.Selector, field fields.Selector) storage.SelectionP
 		Label:       label,
 		Field:       field,
 		GetAttrs:    GetAttrs,
-		IndexFields: []string{core.ShootSeedName},
+		IndexFields: []string{core.ShootSeedName, core.ShootCloudProfileName, core.ShootStatusLastOperationState, core.ShootStatusConditionsHealth},
 	}
 }
 
+// SeedNameIndexFunc returns spec.seedName of given Shoot.
+func SeedNameIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getSeedName(shoot)}, nil
+}
+
+// CloudProfileNameIndexFunc returns spec.cloudProfileName of given Shoot.
+func CloudProfileNameIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{shoot.Spec.CloudProfileName}, nil
+}
+
+// LastOperationStateIndexFunc returns status.lastOperation.state of given Shoot.
+func LastOperationStateIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getLastOperationState(shoot)}, nil
+}
+
+// ConditionsHealthIndexFunc returns the aggregated health of the status.conditions of given Shoot.
+func ConditionsHealthIndexFunc(obj any) ([]string, error) {
+	shoot, ok := obj.(*core.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *core.Shoot but got %T", obj)
+	}
+
+	return []string{getConditionsHealth(shoot)}, nil
+}
+
 // SeedNameTriggerFunc returns spec.seedName of given Shoot.
 func SeedNameTriggerFunc(obj runtime.Object) string {
 	shoot, ok := obj.(*core.Shoot)
@@ -309,3 +352,25 @@ func getStatusSeedName(shoot *core.Shoot) string {
 	}
 	return *shoot.Status.SeedName
 }
+
+func getLastOperationState(shoot *core.Shoot) string {
+	if shoot.Status.LastOperation == nil {
+		return ""
+	}
+	return string(shoot.Status.LastOperation.State)
+}
+
+// getConditionsHealth returns the worst status of the Shoot's conditions (healthy, progressing, unknown, or
+// unhealthy). It returns an empty string if the Shoot does not have any conditions yet, i.e., it was not yet checked
+// by the care controller.
+func getConditionsHealth(shoot *core.Shoot) string {
+	if len(shoot.Status.Conditions) == 0 {
+		return ""
+	}
+
+	status := gardenerutils.ShootStatusHealthy
+	for _, condition := range shoot.Status.Conditions {
+		status = status.OrWorse(gardenerutils.ConditionStatusToShootStatus(gardencorev1beta1.ConditionStatus(condition.Status)))
+	}
+	return string(status)
+}
@@ -6,6 +6,7 @@ package shoot_test
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -525,13 +526,17 @@ var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := ToSelectableFields(newShoot("foo"))
 
-		Expect(result).To(HaveLen(5))
+		Expect(result).To(HaveLen(7))
 		Expect(result.Has(core.ShootSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootSeedName)).To(Equal("foo"))
 		Expect(result.Has(core.ShootCloudProfileName)).To(BeTrue())
 		Expect(result.Get(core.ShootCloudProfileName)).To(Equal("baz"))
 		Expect(result.Has(core.ShootStatusSeedName)).To(BeTrue())
 		Expect(result.Get(core.ShootStatusSeedName)).To(Equal("foo"))
+		Expect(result.Has(core.ShootStatusLastOperationState)).To(BeTrue())
+		Expect(result.Get(core.ShootStatusLastOperationState)).To(Equal("Succeeded"))
+		Expect(result.Has(core.ShootStatusConditionsHealth)).To(BeTrue())
+		Expect(result.Get(core.ShootStatusConditionsHealth)).To(BeEmpty())
 	})
 })
 
@@ -558,6 +563,80 @@ var _ = Describe("SeedNameTriggerFunc", func() {
 	})
 })
 
+var _ = Describe("SeedNameIndexFunc", func() {
+	It("should return spec.seedName", func() {
+		result, err := SeedNameIndexFunc(newShoot("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf("foo"))
+	})
+})
+
+var _ = Describe("CloudProfileNameIndexFunc", func() {
+	It("should return spec.cloudProfileName", func() {
+		result, err := CloudProfileNameIndexFunc(newShoot("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf("baz"))
+	})
+})
+
+var _ = Describe("LastOperationStateIndexFunc", func() {
+	It("should return status.lastOperation.state", func() {
+		result, err := LastOperationStateIndexFunc(newShoot("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf("Succeeded"))
+	})
+
+	It("should return an empty value if there is no last operation", func() {
+		shoot := newShoot("foo")
+		shoot.Status.LastOperation = nil
+
+		result, err := LastOperationStateIndexFunc(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf(""))
+	})
+
+	It("should return an error for other objects", func() {
+		_, err := LastOperationStateIndexFunc(&core.Seed{})
+		Expect(err).To(MatchError("expected *core.Shoot but got *core.Seed"))
+	})
+})
+
+var _ = Describe("ConditionsHealthIndexFunc", func() {
+	var shoot *core.Shoot
+
+	BeforeEach(func() {
+		shoot = newShoot("foo")
+	})
+
+	It("should return an empty value if there are no conditions", func() {
+		result, err := ConditionsHealthIndexFunc(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ConsistOf(""))
+	})
+
+	DescribeTable("should return the worst status of all conditions",
+		func(expected string, statuses ...core.ConditionStatus) {
+			for i, status := range statuses {
+				shoot.Status.Conditions = append(shoot.Status.Conditions, core.Condition{Type: core.ConditionType(fmt.Sprintf("Condition%d", i)), Status: status})
+			}
+
+			result, err := ConditionsHealthIndexFunc(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ConsistOf(expected))
+		},
+
+		Entry("all conditions true", "healthy", core.ConditionTrue, core.ConditionTrue),
+		Entry("one condition progressing", "progressing", core.ConditionTrue, core.ConditionProgressing),
+		Entry("one condition unknown", "unknown", core.ConditionProgressing, core.ConditionUnknown),
+		Entry("one condition false", "unhealthy", core.ConditionFalse, core.ConditionUnknown, core.ConditionProgressing),
+	)
+
+	It("should return an error for other objects", func() {
+		_, err := ConditionsHealthIndexFunc(&core.Seed{})
+		Expect(err).To(MatchError("expected *core.Shoot but got *core.Seed"))
+	})
+})
+
 var _ = Describe("MatchShoot", func() {
 	It("should return correct predicate", func() {
 		ls, _ := labels.Parse("app=test")
@@ -567,7 +646,7 @@ var _ = Describe("MatchShoot", func() {
 
 		Expect(result.Label).To(Equal(ls))
 		Expect(result.Field).To(Equal(fs))
-		Expect(result.IndexFields).To(ConsistOf(core.ShootSeedName))
+		Expect(result.IndexFields).To(ConsistOf(core.ShootSeedName, core.ShootCloudProfileName, core.ShootStatusLastOperationState, core.ShootStatusConditionsHealth))
 	})
 })
 
@@ -583,7 +662,8 @@ func newShoot(seedName string) *core.Shoot {
 			SeedName:         &seedName,
 		},
 		Status: core.ShootStatus{
-			SeedName: &seedName,
+			SeedName:      &seedName,
+			LastOperation: &core.LastOperation{State: core.LastOperationStateSucceeded},
 		},
 	}
 }
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gomodules.xyz/jsonpatch/v2"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// Recorder records the changes of the specification of Shoots in the ShootRevision with the same name.
+type Recorder struct {
+	store        *genericregistry.Store
+	historyLimit int
+	clock        clock.Clock
+}
+
+// NewRecorder creates a new Recorder which keeps at most historyLimit changes per Shoot in the given store.
+func NewRecorder(store *genericregistry.Store, historyLimit int) *Recorder {
+	return &Recorder{
+		store:        store,
+		historyLimit: historyLimit,
+		clock:        clock.RealClock{},
+	}
+}
+
+// Record appends the change from the specification of the old Shoot to the specification of the new Shoot to the
+// ShootRevision of the Shoot. Nothing is recorded if the specifications are equal.
+func (r *Recorder) Record(ctx context.Context, oldShoot, newShoot *core.Shoot) error {
+	if apiequality.Semantic.DeepEqual(oldShoot.Spec, newShoot.Spec) {
+		return nil
+	}
+
+	patch, err := specPatch(oldShoot, newShoot)
+	if err != nil {
+		return fmt.Errorf("failed computing patch for specification of shoot %s/%s: %w", newShoot.Namespace, newShoot.Name, err)
+	}
+
+	var userName string
+	if user, ok := request.UserFrom(ctx); ok {
+		userName = user.GetName()
+	}
+
+	change := core.ShootSpecChange{
+		Generation: newShoot.Generation,
+		Timestamp:  metav1.NewTime(r.clock.Now().UTC().Truncate(time.Second)),
+		User:       userName,
+		Patch:      patch,
+	}
+
+	ownerReference := metav1.OwnerReference{
+		APIVersion:         gardencorev1beta1.SchemeGroupVersion.String(),
+		Kind:               "Shoot",
+		Name:               newShoot.Name,
+		UID:                newShoot.UID,
+		BlockOwnerDeletion: ptr.To(false),
+	}
+
+	transformer := func(_ context.Context, _, existing runtime.Object) (runtime.Object, error) {
+		shootRevision := existing.(*core.ShootRevision).DeepCopy()
+		shootRevision.Name = newShoot.Name
+		shootRevision.Namespace = newShoot.Namespace
+
+		// The history belongs to a former Shoot with the same name, hence, it must not be continued.
+		if len(shootRevision.OwnerReferences) > 0 && shootRevision.OwnerReferences[0].UID != newShoot.UID {
+			shootRevision.Revisions = nil
+		}
+		shootRevision.OwnerReferences = []metav1.OwnerReference{ownerReference}
+
+		shootRevision.Revisions = append(shootRevision.Revisions, change)
+		if len(shootRevision.Revisions) > r.historyLimit {
+			shootRevision.Revisions = shootRevision.Revisions[len(shootRevision.Revisions)-r.historyLimit:]
+		}
+
+		return shootRevision, nil
+	}
+
+	ctx = request.WithNamespace(ctx, newShoot.Namespace)
+	if _, _, err := r.store.Update(ctx, newShoot.Name, rest.DefaultUpdatedObjectInfo(nil, transformer), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, true, &metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed recording change of specification of shoot %s/%s: %w", newShoot.Namespace, newShoot.Name, err)
+	}
+
+	return nil
+}
+
+// specPatch computes the JSON patch transforming the external representation of the specification of the old Shoot
+// into the one of the new Shoot.
+func specPatch(oldShoot, newShoot *core.Shoot) (string, error) {
+	oldSpec, err := externalSpecJSON(oldShoot)
+	if err != nil {
+		return "", err
+	}
+	newSpec, err := externalSpecJSON(newShoot)
+	if err != nil {
+		return "", err
+	}
+
+	operations, err := jsonpatch.CreatePatch(oldSpec, newSpec)
+	if err != nil {
+		return "", err
+	}
+
+	patch, err := json.Marshal(operations)
+	if err != nil {
+		return "", err
+	}
+
+	return string(patch), nil
+}
+
+func externalSpecJSON(shoot *core.Shoot) ([]byte, error) {
+	external := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, external, nil); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(external.Spec)
+}
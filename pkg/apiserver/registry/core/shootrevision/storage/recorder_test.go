@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+var _ = Describe("Recorder", func() {
+	Describe("#specPatch", func() {
+		var oldShoot *core.Shoot
+
+		BeforeEach(func() {
+			oldShoot = &core.Shoot{
+				Spec: core.ShootSpec{
+					Kubernetes: core.Kubernetes{Version: "1.30.0"},
+					Purpose:    ptr.To(core.ShootPurposeEvaluation),
+				},
+			}
+		})
+
+		It("should return an empty patch if the specifications are equal", func() {
+			Expect(specPatch(oldShoot, oldShoot.DeepCopy())).To(Equal("[]"))
+		})
+
+		It("should return the patch relative to the external specification", func() {
+			newShoot := oldShoot.DeepCopy()
+			newShoot.Spec.Kubernetes.Version = "1.30.1"
+
+			Expect(specPatch(oldShoot, newShoot)).To(Equal(`[{"op":"replace","path":"/kubernetes/version","value":"1.30.1"}]`))
+		})
+
+		It("should return a patch removing fields", func() {
+			newShoot := oldShoot.DeepCopy()
+			newShoot.Spec.Purpose = nil
+
+			Expect(specPatch(oldShoot, newShoot)).To(Equal(`[{"op":"remove","path":"/purpose"}]`))
+		})
+	})
+})
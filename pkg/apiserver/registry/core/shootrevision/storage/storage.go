@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/shootrevision"
+)
+
+// REST implements a RESTStorage for ShootRevisions against etcd. ShootRevisions are maintained by the gardener-apiserver
+// itself, hence, the REST endpoint only allows reading and deleting them.
+type REST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Getter               = &REST{}
+	_ rest.Lister               = &REST{}
+	_ rest.Watcher              = &REST{}
+	_ rest.GracefulDeleter      = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// ShootRevisionStorage implements the storage for ShootRevisions.
+type ShootRevisionStorage struct {
+	ShootRevision *REST
+	// Recorder records the changes of Shoot specifications in ShootRevisions.
+	Recorder *Recorder
+}
+
+// NewStorage creates a new ShootRevisionStorage object. At most historyLimit changes are kept per Shoot.
+func NewStorage(optsGetter generic.RESTOptionsGetter, historyLimit int) ShootRevisionStorage {
+	store := newStore(optsGetter)
+
+	return ShootRevisionStorage{
+		ShootRevision: &REST{store: store},
+		Recorder:      NewRecorder(store, historyLimit),
+	}
+}
+
+func newStore(optsGetter generic.RESTOptionsGetter) *genericregistry.Store {
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &core.ShootRevision{} },
+		NewListFunc:               func() runtime.Object { return &core.ShootRevisionList{} },
+		DefaultQualifiedResource:  core.Resource("shootrevisions"),
+		SingularQualifiedResource: core.Resource("shootrevision"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: shootrevision.Strategy,
+		UpdateStrategy: shootrevision.Strategy,
+		DeleteStrategy: shootrevision.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	return store
+}
+
+// New creates a new (empty) internal ShootRevision object.
+func (r *REST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *REST) Destroy() {
+	r.store.Destroy()
+}
+
+// NamespaceScoped returns true because ShootRevisions are namespaced.
+func (r *REST) NamespaceScoped() bool {
+	return r.store.NamespaceScoped()
+}
+
+// GetSingularName returns the singular name of the resource.
+func (r *REST) GetSingularName() string {
+	return r.store.GetSingularName()
+}
+
+// Get retrieves the ShootRevision with the given name.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// NewList returns a new (empty) internal ShootRevisionList object.
+func (r *REST) NewList() runtime.Object {
+	return r.store.NewList()
+}
+
+// List returns the ShootRevisions matching the given options.
+func (r *REST) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	return r.store.List(ctx, options)
+}
+
+// ConvertToTable converts the given object(s) to a table.
+func (r *REST) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	return r.store.ConvertToTable(ctx, object, tableOptions)
+}
+
+// Watch watches the ShootRevisions matching the given options.
+func (r *REST) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	return r.store.Watch(ctx, options)
+}
+
+// Delete deletes the ShootRevision with the given name. This is required for the garbage collection of ShootRevisions
+// whose Shoot is gone.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	return r.store.Delete(ctx, name, deleteValidation, options)
+}
+
+// Implement CategoriesProvider
+var _ rest.CategoriesProvider = &REST{}
+
+// Categories implements the CategoriesProvider interface. Returns a list of categories a resource is part of.
+func (r *REST) Categories() []string {
+	return []string{"all"}
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootrevision
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+type shootRevisionStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootRevisions.
+var Strategy = shootRevisionStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootRevisionStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootRevisionStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	shootRevision := obj.(*core.ShootRevision)
+
+	shootRevision.Generation = 1
+}
+
+func (shootRevisionStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShootRevision := obj.(*core.ShootRevision)
+	oldShootRevision := old.(*core.ShootRevision)
+
+	if !apiequality.Semantic.DeepEqual(oldShootRevision.Revisions, newShootRevision.Revisions) {
+		newShootRevision.Generation = oldShootRevision.Generation + 1
+	}
+}
+
+func (shootRevisionStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	shootRevision := obj.(*core.ShootRevision)
+	return validation.ValidateShootRevision(shootRevision)
+}
+
+func (shootRevisionStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (shootRevisionStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootRevisionStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newShootRevision := newObj.(*core.ShootRevision)
+	oldShootRevision := oldObj.(*core.ShootRevision)
+	return validation.ValidateShootRevisionUpdate(newShootRevision, oldShootRevision)
+}
+
+func (shootRevisionStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (shootRevisionStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (shootRevisionStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootrevision_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	shootrevisionregistry "github.com/gardener/gardener/pkg/apiserver/registry/core/shootrevision"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx           = context.TODO()
+		shootRevision *core.ShootRevision
+	)
+
+	BeforeEach(func() {
+		shootRevision = &core.ShootRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot",
+				Namespace: "garden-dev",
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation to 1", func() {
+			shootRevision.Generation = 5
+
+			shootrevisionregistry.Strategy.PrepareForCreate(ctx, shootRevision)
+
+			Expect(shootRevision.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		var oldShootRevision *core.ShootRevision
+
+		BeforeEach(func() {
+			shootRevision.Generation = 1
+			oldShootRevision = shootRevision.DeepCopy()
+		})
+
+		It("should not increase the generation if the revisions are unchanged", func() {
+			shootrevisionregistry.Strategy.PrepareForUpdate(ctx, shootRevision, oldShootRevision)
+
+			Expect(shootRevision.Generation).To(Equal(int64(1)))
+		})
+
+		It("should increase the generation if the revisions changed", func() {
+			shootRevision.Revisions = append(shootRevision.Revisions, core.ShootSpecChange{Generation: 2, User: "foo", Patch: "[]"})
+
+			shootrevisionregistry.Strategy.PrepareForUpdate(ctx, shootRevision, oldShootRevision)
+
+			Expect(shootRevision.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#AllowCreateOnUpdate", func() {
+		It("should not allow creating on update", func() {
+			Expect(shootrevisionregistry.Strategy.AllowCreateOnUpdate()).To(BeFalse())
+		})
+	})
+})
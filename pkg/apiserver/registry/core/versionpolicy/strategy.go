@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionpolicy
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// VersionPolicyStrategy defines the strategy for storing versionpolicies.
+type VersionPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy returns a storage strategy for versionpolicies.
+func NewStrategy() VersionPolicyStrategy {
+	return VersionPolicyStrategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+// NamespaceScoped indicates if the object is namespaced scoped.
+func (VersionPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate mutates the object before creation.
+// It is called before Validate.
+func (VersionPolicyStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	versionPolicy := obj.(*core.VersionPolicy)
+
+	versionPolicy.Generation = 1
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (VersionPolicyStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newVersionPolicy := obj.(*core.VersionPolicy)
+	oldVersionPolicy := old.(*core.VersionPolicy)
+
+	if !apiequality.Semantic.DeepEqual(oldVersionPolicy.Spec, newVersionPolicy.Spec) {
+		newVersionPolicy.Generation = oldVersionPolicy.Generation + 1
+	}
+}
+
+// Validate allow to validate the object.
+func (VersionPolicyStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	versionPolicy := obj.(*core.VersionPolicy)
+	return validation.ValidateVersionPolicy(versionPolicy)
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (VersionPolicyStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldVersionPolicy, newVersionPolicy := oldObj.(*core.VersionPolicy), newObj.(*core.VersionPolicy)
+	return validation.ValidateVersionPolicyUpdate(newVersionPolicy, oldVersionPolicy)
+}
+
+// Canonicalize can be used to transform the object into its canonical format.
+func (VersionPolicyStrategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate indicates if the object can be created via a PUT operation.
+func (VersionPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate indicates if the object can be updated
+// independently of the resource version.
+func (VersionPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (VersionPolicyStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (VersionPolicyStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	"github.com/gardener/gardener/pkg/apiserver/registry/operations/accessrequest"
+)
+
+// REST implements a RESTStorage for AccessRequests against etcd
+type REST struct {
+	*genericregistry.Store
+}
+
+// AccessRequestStorage implements the storage for AccessRequests and their status and approval subresources.
+type AccessRequestStorage struct {
+	AccessRequest *REST
+	Status        *StatusREST
+	Approval      *ApprovalREST
+}
+
+// NewStorage creates a new AccessRequestStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) AccessRequestStorage {
+	accessRequestRest, accessRequestStatusRest, accessRequestApprovalRest := NewREST(optsGetter)
+
+	return AccessRequestStorage{
+		AccessRequest: accessRequestRest,
+		Status:        accessRequestStatusRest,
+		Approval:      accessRequestApprovalRest,
+	}
+}
+
+// NewREST returns a RESTStorage object that will work against access requests.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST, *ApprovalREST) {
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &operations.AccessRequest{} },
+		NewListFunc:               func() runtime.Object { return &operations.AccessRequestList{} },
+		DefaultQualifiedResource:  operations.Resource("accessrequests"),
+		SingularQualifiedResource: operations.Resource("accessrequest"),
+		EnableGarbageCollection:   true,
+		PredicateFunc:             accessrequest.MatchAccessRequest,
+
+		CreateStrategy: accessrequest.Strategy,
+		UpdateStrategy: accessrequest.Strategy,
+		DeleteStrategy: accessrequest.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    accessrequest.GetAttrs,
+	}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = accessrequest.StatusStrategy
+
+	approvalStore := *store
+	approvalStore.UpdateStrategy = accessrequest.ApprovalStrategy
+
+	return &REST{store}, &StatusREST{store: &statusStore}, &ApprovalREST{store: &approvalStore}
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"ar"}
+}
+
+// StatusREST implements the REST endpoint for changing the status of an AccessRequest.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal AccessRequest object.
+func (r *StatusREST) New() runtime.Object {
+	return &operations.AccessRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+// ApprovalREST implements the REST endpoint for deciding about an AccessRequest.
+type ApprovalREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &ApprovalREST{}
+	_ rest.Getter  = &ApprovalREST{}
+	_ rest.Updater = &ApprovalREST{}
+)
+
+// New creates a new (empty) internal AccessRequest object.
+func (r *ApprovalREST) New() runtime.Object {
+	return &operations.AccessRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ApprovalREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ApprovalREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the approval subset of an object.
+func (r *ApprovalREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, _ bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
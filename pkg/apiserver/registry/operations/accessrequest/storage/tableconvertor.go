@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Shoot", Type: "string", Format: "name", Description: "The Shoot to which access is requested."},
+			{Name: "Requester", Type: "string", Description: "The user who requested the access."},
+			{Name: "Duration", Type: "string", Description: "The requested duration of the access."},
+			{Name: "Phase", Type: "string", Description: "The current phase of the access request."},
+			{Name: "Decided By", Type: "string", Description: "The user who decided about the access request."},
+			{Name: "Expires", Type: "string", Description: "The time and date after which the granted access is revoked."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			accessRequest = obj.(*operations.AccessRequest)
+			cells         = []any{}
+		)
+
+		cells = append(cells, accessRequest.Name)
+		cells = append(cells, accessRequest.Spec.ShootRef.Name)
+		cells = append(cells, accessRequest.Spec.Requester)
+		cells = append(cells, accessRequest.Spec.Duration.Duration.String())
+
+		if phase := accessRequest.Status.Phase; len(phase) > 0 {
+			cells = append(cells, string(phase))
+		} else {
+			cells = append(cells, "<pending>")
+		}
+
+		if approval := accessRequest.Status.Approval; approval != nil {
+			cells = append(cells, approval.DecidedBy)
+		} else {
+			cells = append(cells, "<none>")
+		}
+
+		expires := "<none>"
+		if !accessRequest.Status.ExpirationTimestamp.IsZero() {
+			remaining := time.Until(accessRequest.Status.ExpirationTimestamp.Time)
+			if remaining < 0 {
+				expires = "<expired>"
+			} else {
+				expires = duration.HumanDuration(remaining)
+			}
+		}
+		cells = append(cells, expires)
+
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(accessRequest.CreationTimestamp))
+
+		return cells, nil
+	})
+
+	return table, err
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsvalidation "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+type accessRequestStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for AccessRequests.
+var Strategy = accessRequestStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (accessRequestStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (accessRequestStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	accessRequest := obj.(*operations.AccessRequest)
+	accessRequest.Generation = 1
+	accessRequest.Status = operations.AccessRequestStatus{}
+
+	// the requester is always the creator of the object and cannot be chosen freely
+	accessRequest.Spec.Requester = ""
+	if user, ok := request.UserFrom(ctx); ok {
+		accessRequest.Spec.Requester = user.GetName()
+	}
+}
+
+func (accessRequestStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newAccessRequest := obj.(*operations.AccessRequest)
+	oldAccessRequest := old.(*operations.AccessRequest)
+	newAccessRequest.Status = oldAccessRequest.Status
+
+	if oldAccessRequest.DeletionTimestamp == nil && newAccessRequest.DeletionTimestamp != nil {
+		newAccessRequest.Generation = oldAccessRequest.Generation + 1
+	}
+}
+
+func (accessRequestStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	accessRequest := obj.(*operations.AccessRequest)
+	return operationsvalidation.ValidateAccessRequest(accessRequest)
+}
+
+func (accessRequestStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (accessRequestStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (accessRequestStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldAccessRequest, newAccessRequest := oldObj.(*operations.AccessRequest), newObj.(*operations.AccessRequest)
+	return operationsvalidation.ValidateAccessRequestUpdate(newAccessRequest, oldAccessRequest)
+}
+
+func (accessRequestStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (accessRequestStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (accessRequestStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type accessRequestStatusStrategy struct {
+	accessRequestStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of AccessRequests.
+var StatusStrategy = accessRequestStatusStrategy{Strategy}
+
+func (accessRequestStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newAccessRequest := obj.(*operations.AccessRequest)
+	oldAccessRequest := old.(*operations.AccessRequest)
+	newAccessRequest.Spec = oldAccessRequest.Spec
+	// the approval can only be changed via the approval subresource
+	newAccessRequest.Status.Approval = oldAccessRequest.Status.Approval
+}
+
+func (accessRequestStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateAccessRequestStatusUpdate(obj.(*operations.AccessRequest), old.(*operations.AccessRequest))
+}
+
+type accessRequestApprovalStrategy struct {
+	accessRequestStrategy
+}
+
+// ApprovalStrategy defines the storage strategy for the approval subresource of AccessRequests.
+var ApprovalStrategy = accessRequestApprovalStrategy{Strategy}
+
+func (accessRequestApprovalStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newAccessRequest := obj.(*operations.AccessRequest)
+	oldAccessRequest := old.(*operations.AccessRequest)
+
+	newAccessRequest.Spec = oldAccessRequest.Spec
+	approval := newAccessRequest.Status.Approval
+	newAccessRequest.Status = oldAccessRequest.Status
+
+	// the decision can only be taken once, its author and time are recorded by the API server
+	if oldAccessRequest.Status.Approval == nil && approval != nil {
+		approval.DecidedBy = ""
+		if user, ok := request.UserFrom(ctx); ok {
+			approval.DecidedBy = user.GetName()
+		}
+		now := metav1.Now()
+		approval.DecisionTimestamp = &now
+	}
+	newAccessRequest.Status.Approval = approval
+}
+
+func (accessRequestApprovalStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateAccessRequestApprovalUpdate(obj.(*operations.AccessRequest), old.(*operations.AccessRequest))
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(accessRequest *operations.AccessRequest) fields.Set {
+	// The purpose of allocation with a given number of elements is to reduce
+	// amount of allocations needed to create the fields.Set. If you add any
+	// field here or the number of object-meta related fields changes, this should
+	// be adjusted.
+	accessRequestSpecificFieldsSet := make(fields.Set, 3)
+	accessRequestSpecificFieldsSet[operations.AccessRequestShootName] = accessRequest.Spec.ShootRef.Name
+	return generic.AddObjectMetaFieldsSet(accessRequestSpecificFieldsSet, &accessRequest.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	accessRequest, ok := obj.(*operations.AccessRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("not an access request")
+	}
+	return labels.Set(accessRequest.ObjectMeta.Labels), ToSelectableFields(accessRequest), nil
+}
+
+// MatchAccessRequest returns a generic matcher for a given label and field selector.
+func MatchAccessRequest(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
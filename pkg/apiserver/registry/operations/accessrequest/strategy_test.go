@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/operations/accessrequest"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx           context.Context
+		accessRequest *operations.AccessRequest
+	)
+
+	BeforeEach(func() {
+		ctx = request.WithUser(context.TODO(), &user.DefaultInfo{Name: "alice"})
+		accessRequest = &operations.AccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test-namespace",
+				Labels:    map[string]string{"foo": "bar"},
+			},
+			Spec: operations.AccessRequestSpec{
+				ShootRef: corev1.LocalObjectReference{Name: "shoot"},
+				Duration: metav1.Duration{Duration: time.Hour},
+				Reason:   "incident",
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should record the requester and reset the status", func() {
+			accessRequest.Spec.Requester = "mallory"
+			accessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Strategy.PrepareForCreate(ctx, accessRequest)
+
+			Expect(accessRequest.Spec.Requester).To(Equal("alice"))
+			Expect(accessRequest.Status).To(Equal(operations.AccessRequestStatus{}))
+			Expect(accessRequest.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should not allow changing the status", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Strategy.PrepareForUpdate(ctx, newAccessRequest, accessRequest)
+
+			Expect(newAccessRequest.Status).To(Equal(accessRequest.Status))
+		})
+	})
+
+	Describe("StatusStrategy#PrepareForUpdate", func() {
+		It("should not allow changing the spec and the approval", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Spec.Reason = "foo"
+			newAccessRequest.Status.Phase = operations.AccessRequestPending
+			newAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestApproved}
+
+			StatusStrategy.PrepareForUpdate(ctx, newAccessRequest, accessRequest)
+
+			Expect(newAccessRequest.Spec).To(Equal(accessRequest.Spec))
+			Expect(newAccessRequest.Status.Approval).To(BeNil())
+			Expect(newAccessRequest.Status.Phase).To(Equal(operations.AccessRequestPending))
+		})
+	})
+
+	Describe("ApprovalStrategy#PrepareForUpdate", func() {
+		BeforeEach(func() {
+			ctx = request.WithUser(context.TODO(), &user.DefaultInfo{Name: "bob"})
+			accessRequest.Spec.Requester = "alice"
+			accessRequest.Status.Phase = operations.AccessRequestPending
+		})
+
+		It("should record the decider and the decision time and keep the rest of the status", func() {
+			newAccessRequest := accessRequest.DeepCopy()
+			newAccessRequest.Spec.Reason = "foo"
+			newAccessRequest.Status.Phase = operations.AccessRequestGranted
+			newAccessRequest.Status.Approval = &operations.AccessRequestApproval{
+				Decision:  operations.AccessRequestApproved,
+				Message:   ptr.To("looks good"),
+				DecidedBy: "alice",
+			}
+
+			ApprovalStrategy.PrepareForUpdate(ctx, newAccessRequest, accessRequest)
+
+			Expect(newAccessRequest.Spec).To(Equal(accessRequest.Spec))
+			Expect(newAccessRequest.Status.Phase).To(Equal(operations.AccessRequestPending))
+			Expect(newAccessRequest.Status.Approval.Decision).To(Equal(operations.AccessRequestApproved))
+			Expect(newAccessRequest.Status.Approval.Message).To(Equal(ptr.To("looks good")))
+			Expect(newAccessRequest.Status.Approval.DecidedBy).To(Equal("bob"))
+			Expect(newAccessRequest.Status.Approval.DecisionTimestamp).NotTo(BeNil())
+		})
+
+		It("should not overwrite the recorded decider of an existing decision", func() {
+			accessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestDenied, DecidedBy: "carol"}
+			newAccessRequest := accessRequest.DeepCopy()
+
+			ApprovalStrategy.PrepareForUpdate(ctx, newAccessRequest, accessRequest)
+
+			Expect(newAccessRequest.Status.Approval.DecidedBy).To(Equal("carol"))
+		})
+	})
+
+	Describe("#ToSelectableFields", func() {
+		It("should return correct fields", func() {
+			result := ToSelectableFields(accessRequest)
+
+			Expect(result).To(HaveLen(3))
+			Expect(result.Get("metadata.name")).To(Equal("test"))
+			Expect(result.Get("metadata.namespace")).To(Equal("test-namespace"))
+			Expect(result.Get(operations.AccessRequestShootName)).To(Equal("shoot"))
+		})
+	})
+
+	Describe("#GetAttrs", func() {
+		It("should return error when object is not AccessRequest", func() {
+			_, _, err := GetAttrs(&gardencore.Seed{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return correct result", func() {
+			ls, fs, err := GetAttrs(accessRequest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ls.Get("foo")).To(Equal("bar"))
+			Expect(fs.Get(operations.AccessRequestShootName)).To(Equal("shoot"))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Operation", Type: "string", Description: "The operation which is performed on the selected shoots."},
+			{Name: "Phase", Type: "string", Description: "The current phase of the bulk operation."},
+			{Name: "Progress", Type: "string", Description: "The number of selected shoots on which the operation was finished."},
+			{Name: "Failed", Type: "integer", Description: "The number of selected shoots on which the operation failed."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			bulkOperation = obj.(*operations.BulkOperation)
+			cells         = []any{}
+		)
+
+		cells = append(cells, bulkOperation.Name)
+		cells = append(cells, string(bulkOperation.Spec.Operation))
+
+		if phase := bulkOperation.Status.Phase; len(phase) > 0 {
+			cells = append(cells, string(phase))
+		} else {
+			cells = append(cells, "<pending>")
+		}
+
+		var finished, failed int
+		for _, shoot := range bulkOperation.Status.Shoots {
+			switch shoot.State {
+			case operations.BulkOperationShootSucceeded, operations.BulkOperationShootSkipped:
+				finished++
+			case operations.BulkOperationShootFailed:
+				finished++
+				failed++
+			}
+		}
+		cells = append(cells, fmt.Sprintf("%d/%d", finished, len(bulkOperation.Status.Shoots)))
+		cells = append(cells, failed)
+
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(bulkOperation.CreationTimestamp))
+
+		return cells, nil
+	})
+
+	return table, err
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulkoperation
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsvalidation "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+type bulkOperationStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for BulkOperations.
+var Strategy = bulkOperationStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (bulkOperationStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (bulkOperationStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	bulkOperation := obj.(*operations.BulkOperation)
+	bulkOperation.Generation = 1
+	bulkOperation.Status = operations.BulkOperationStatus{}
+}
+
+func (bulkOperationStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newBulkOperation := obj.(*operations.BulkOperation)
+	oldBulkOperation := old.(*operations.BulkOperation)
+	newBulkOperation.Status = oldBulkOperation.Status
+
+	if oldBulkOperation.DeletionTimestamp == nil && newBulkOperation.DeletionTimestamp != nil {
+		newBulkOperation.Generation = oldBulkOperation.Generation + 1
+	}
+}
+
+func (bulkOperationStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	bulkOperation := obj.(*operations.BulkOperation)
+	return operationsvalidation.ValidateBulkOperation(bulkOperation)
+}
+
+func (bulkOperationStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (bulkOperationStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (bulkOperationStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldBulkOperation, newBulkOperation := oldObj.(*operations.BulkOperation), newObj.(*operations.BulkOperation)
+	return operationsvalidation.ValidateBulkOperationUpdate(newBulkOperation, oldBulkOperation)
+}
+
+func (bulkOperationStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (bulkOperationStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (bulkOperationStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type bulkOperationStatusStrategy struct {
+	bulkOperationStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of BulkOperations.
+var StatusStrategy = bulkOperationStatusStrategy{Strategy}
+
+func (bulkOperationStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newBulkOperation := obj.(*operations.BulkOperation)
+	oldBulkOperation := old.(*operations.BulkOperation)
+	newBulkOperation.Spec = oldBulkOperation.Spec
+}
+
+func (bulkOperationStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateBulkOperationStatusUpdate(obj.(*operations.BulkOperation), old.(*operations.BulkOperation))
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(bulkOperation *operations.BulkOperation) fields.Set {
+	return generic.ObjectMetaFieldsSet(&bulkOperation.ObjectMeta, false)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	bulkOperation, ok := obj.(*operations.BulkOperation)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a bulk operation")
+	}
+	return labels.Set(bulkOperation.ObjectMeta.Labels), ToSelectableFields(bulkOperation), nil
+}
+
+// MatchBulkOperation returns a generic matcher for a given label and field selector.
+func MatchBulkOperation(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
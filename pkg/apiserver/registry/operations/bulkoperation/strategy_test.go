@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulkoperation_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/operations/bulkoperation"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx           context.Context
+		bulkOperation *operations.BulkOperation
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		bulkOperation = &operations.BulkOperation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test",
+				Labels: map[string]string{"foo": "bar"},
+			},
+			Spec: operations.BulkOperationSpec{
+				ShootSelector: metav1.LabelSelector{MatchLabels: map[string]string{"stage": "dev"}},
+				Operation:     operations.BulkOperationReconcile,
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should reset the status and set the generation", func() {
+			bulkOperation.Status.Phase = operations.BulkOperationSucceeded
+
+			Strategy.PrepareForCreate(ctx, bulkOperation)
+
+			Expect(bulkOperation.Status).To(Equal(operations.BulkOperationStatus{}))
+			Expect(bulkOperation.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should not allow changing the status", func() {
+			newBulkOperation := bulkOperation.DeepCopy()
+			newBulkOperation.Status.Phase = operations.BulkOperationRunning
+
+			Strategy.PrepareForUpdate(ctx, newBulkOperation, bulkOperation)
+
+			Expect(newBulkOperation.Status).To(Equal(bulkOperation.Status))
+		})
+
+		It("should increase the generation when the deletion timestamp is set", func() {
+			bulkOperation.Generation = 1
+			newBulkOperation := bulkOperation.DeepCopy()
+			newBulkOperation.DeletionTimestamp = &metav1.Time{}
+
+			Strategy.PrepareForUpdate(ctx, newBulkOperation, bulkOperation)
+
+			Expect(newBulkOperation.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("StatusStrategy#PrepareForUpdate", func() {
+		It("should not allow changing the spec", func() {
+			newBulkOperation := bulkOperation.DeepCopy()
+			newBulkOperation.Spec.Operation = operations.BulkOperationRotateCA
+			newBulkOperation.Status.Phase = operations.BulkOperationRunning
+
+			StatusStrategy.PrepareForUpdate(ctx, newBulkOperation, bulkOperation)
+
+			Expect(newBulkOperation.Spec).To(Equal(bulkOperation.Spec))
+			Expect(newBulkOperation.Status.Phase).To(Equal(operations.BulkOperationRunning))
+		})
+	})
+
+	Describe("#ToSelectableFields", func() {
+		It("should return correct fields", func() {
+			result := ToSelectableFields(bulkOperation)
+
+			Expect(result).To(HaveLen(1))
+			Expect(result.Get("metadata.name")).To(Equal("test"))
+		})
+	})
+
+	Describe("#GetAttrs", func() {
+		It("should return error when object is not BulkOperation", func() {
+			_, _, err := GetAttrs(&gardencore.Seed{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return correct result", func() {
+			ls, fs, err := GetAttrs(bulkOperation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ls.Get("foo")).To(Equal("bar"))
+			Expect(fs.Get("metadata.name")).To(Equal("test"))
+		})
+	})
+})
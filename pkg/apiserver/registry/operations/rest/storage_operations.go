@@ -13,7 +13,10 @@ import (
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	accessrequeststore "github.com/gardener/gardener/pkg/apiserver/registry/operations/accessrequest/storage"
 	bastionstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/bastion/storage"
+	bulkoperationstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/bulkoperation/storage"
+	sshaccessrequeststore "github.com/gardener/gardener/pkg/apiserver/registry/operations/sshaccessrequest/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -38,5 +41,19 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["bastions"] = bastionStorage.Bastion
 	storage["bastions/status"] = bastionStorage.Status
 
+	accessRequestStorage := accessrequeststore.NewStorage(restOptionsGetter)
+	storage["accessrequests"] = accessRequestStorage.AccessRequest
+	storage["accessrequests/status"] = accessRequestStorage.Status
+	storage["accessrequests/approval"] = accessRequestStorage.Approval
+
+	sshAccessRequestStorage := sshaccessrequeststore.NewStorage(restOptionsGetter)
+	storage["sshaccessrequests"] = sshAccessRequestStorage.SSHAccessRequest
+	storage["sshaccessrequests/status"] = sshAccessRequestStorage.Status
+	storage["sshaccessrequests/approval"] = sshAccessRequestStorage.Approval
+
+	bulkOperationStorage := bulkoperationstore.NewStorage(restOptionsGetter)
+	storage["bulkoperations"] = bulkOperationStorage.BulkOperation
+	storage["bulkoperations/status"] = bulkOperationStorage.Status
+
 	return storage
 }
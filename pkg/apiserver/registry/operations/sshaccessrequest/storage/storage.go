@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	"github.com/gardener/gardener/pkg/apiserver/registry/operations/sshaccessrequest"
+)
+
+// REST implements a RESTStorage for SSHAccessRequests against etcd
+type REST struct {
+	*genericregistry.Store
+}
+
+// SSHAccessRequestStorage implements the storage for SSHAccessRequests and their status and approval subresources.
+type SSHAccessRequestStorage struct {
+	SSHAccessRequest *REST
+	Status           *StatusREST
+	Approval         *ApprovalREST
+}
+
+// NewStorage creates a new SSHAccessRequestStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) SSHAccessRequestStorage {
+	sshAccessRequestRest, sshAccessRequestStatusRest, sshAccessRequestApprovalRest := NewREST(optsGetter)
+
+	return SSHAccessRequestStorage{
+		SSHAccessRequest: sshAccessRequestRest,
+		Status:           sshAccessRequestStatusRest,
+		Approval:         sshAccessRequestApprovalRest,
+	}
+}
+
+// NewREST returns a RESTStorage object that will work against SSH access requests.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST, *ApprovalREST) {
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &operations.SSHAccessRequest{} },
+		NewListFunc:               func() runtime.Object { return &operations.SSHAccessRequestList{} },
+		DefaultQualifiedResource:  operations.Resource("sshaccessrequests"),
+		SingularQualifiedResource: operations.Resource("sshaccessrequest"),
+		EnableGarbageCollection:   true,
+		PredicateFunc:             sshaccessrequest.MatchSSHAccessRequest,
+
+		CreateStrategy: sshaccessrequest.Strategy,
+		UpdateStrategy: sshaccessrequest.Strategy,
+		DeleteStrategy: sshaccessrequest.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    sshaccessrequest.GetAttrs,
+	}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = sshaccessrequest.StatusStrategy
+
+	approvalStore := *store
+	approvalStore.UpdateStrategy = sshaccessrequest.ApprovalStrategy
+
+	return &REST{store}, &StatusREST{store: &statusStore}, &ApprovalREST{store: &approvalStore}
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"sshar"}
+}
+
+// StatusREST implements the REST endpoint for changing the status of an SSHAccessRequest.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal SSHAccessRequest object.
+func (r *StatusREST) New() runtime.Object {
+	return &operations.SSHAccessRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+// ApprovalREST implements the REST endpoint for deciding about an SSHAccessRequest.
+type ApprovalREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &ApprovalREST{}
+	_ rest.Getter  = &ApprovalREST{}
+	_ rest.Updater = &ApprovalREST{}
+)
+
+// New creates a new (empty) internal SSHAccessRequest object.
+func (r *ApprovalREST) New() runtime.Object {
+	return &operations.SSHAccessRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ApprovalREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ApprovalREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the approval subset of an object.
+func (r *ApprovalREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, _ bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sshaccessrequest
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsvalidation "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+type sshAccessRequestStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for SSHAccessRequests.
+var Strategy = sshAccessRequestStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (sshAccessRequestStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (sshAccessRequestStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	sshAccessRequest := obj.(*operations.SSHAccessRequest)
+	sshAccessRequest.Generation = 1
+	sshAccessRequest.Status = operations.SSHAccessRequestStatus{}
+
+	// the requester is always the creator of the object and cannot be chosen freely
+	sshAccessRequest.Spec.Requester = ""
+	if user, ok := request.UserFrom(ctx); ok {
+		sshAccessRequest.Spec.Requester = user.GetName()
+	}
+}
+
+func (sshAccessRequestStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newSSHAccessRequest := obj.(*operations.SSHAccessRequest)
+	oldSSHAccessRequest := old.(*operations.SSHAccessRequest)
+	newSSHAccessRequest.Status = oldSSHAccessRequest.Status
+
+	if oldSSHAccessRequest.DeletionTimestamp == nil && newSSHAccessRequest.DeletionTimestamp != nil {
+		newSSHAccessRequest.Generation = oldSSHAccessRequest.Generation + 1
+	}
+}
+
+func (sshAccessRequestStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	sshAccessRequest := obj.(*operations.SSHAccessRequest)
+	return operationsvalidation.ValidateSSHAccessRequest(sshAccessRequest)
+}
+
+func (sshAccessRequestStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (sshAccessRequestStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (sshAccessRequestStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldSSHAccessRequest, newSSHAccessRequest := oldObj.(*operations.SSHAccessRequest), newObj.(*operations.SSHAccessRequest)
+	return operationsvalidation.ValidateSSHAccessRequestUpdate(newSSHAccessRequest, oldSSHAccessRequest)
+}
+
+func (sshAccessRequestStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (sshAccessRequestStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (sshAccessRequestStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type sshAccessRequestStatusStrategy struct {
+	sshAccessRequestStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of SSHAccessRequests.
+var StatusStrategy = sshAccessRequestStatusStrategy{Strategy}
+
+func (sshAccessRequestStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newSSHAccessRequest := obj.(*operations.SSHAccessRequest)
+	oldSSHAccessRequest := old.(*operations.SSHAccessRequest)
+	newSSHAccessRequest.Spec = oldSSHAccessRequest.Spec
+	// the approval can only be changed via the approval subresource
+	newSSHAccessRequest.Status.Approval = oldSSHAccessRequest.Status.Approval
+}
+
+func (sshAccessRequestStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateSSHAccessRequestStatusUpdate(obj.(*operations.SSHAccessRequest), old.(*operations.SSHAccessRequest))
+}
+
+type sshAccessRequestApprovalStrategy struct {
+	sshAccessRequestStrategy
+}
+
+// ApprovalStrategy defines the storage strategy for the approval subresource of SSHAccessRequests.
+var ApprovalStrategy = sshAccessRequestApprovalStrategy{Strategy}
+
+func (sshAccessRequestApprovalStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSSHAccessRequest := obj.(*operations.SSHAccessRequest)
+	oldSSHAccessRequest := old.(*operations.SSHAccessRequest)
+
+	newSSHAccessRequest.Spec = oldSSHAccessRequest.Spec
+	approval := newSSHAccessRequest.Status.Approval
+	newSSHAccessRequest.Status = oldSSHAccessRequest.Status
+
+	// the decision can only be taken once, its author and time are recorded by the API server
+	if oldSSHAccessRequest.Status.Approval == nil && approval != nil {
+		approval.DecidedBy = ""
+		if user, ok := request.UserFrom(ctx); ok {
+			approval.DecidedBy = user.GetName()
+		}
+		now := metav1.Now()
+		approval.DecisionTimestamp = &now
+	}
+	newSSHAccessRequest.Status.Approval = approval
+}
+
+func (sshAccessRequestApprovalStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateSSHAccessRequestApprovalUpdate(obj.(*operations.SSHAccessRequest), old.(*operations.SSHAccessRequest))
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(sshAccessRequest *operations.SSHAccessRequest) fields.Set {
+	// The purpose of allocation with a given number of elements is to reduce
+	// amount of allocations needed to create the fields.Set. If you add any
+	// field here or the number of object-meta related fields changes, this should
+	// be adjusted.
+	sshAccessRequestSpecificFieldsSet := make(fields.Set, 3)
+	sshAccessRequestSpecificFieldsSet[operations.SSHAccessRequestShootName] = sshAccessRequest.Spec.ShootRef.Name
+	return generic.AddObjectMetaFieldsSet(sshAccessRequestSpecificFieldsSet, &sshAccessRequest.ObjectMeta, true)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	sshAccessRequest, ok := obj.(*operations.SSHAccessRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("not an SSH access request")
+	}
+	return labels.Set(sshAccessRequest.ObjectMeta.Labels), ToSelectableFields(sshAccessRequest), nil
+}
+
+// MatchSSHAccessRequest returns a generic matcher for a given label and field selector.
+func MatchSSHAccessRequest(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
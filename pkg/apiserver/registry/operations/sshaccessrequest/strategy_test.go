@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sshaccessrequest_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/operations/sshaccessrequest"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx              context.Context
+		sshAccessRequest *operations.SSHAccessRequest
+	)
+
+	BeforeEach(func() {
+		ctx = request.WithUser(context.TODO(), &user.DefaultInfo{Name: "alice"})
+		sshAccessRequest = &operations.SSHAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test-namespace",
+				Labels:    map[string]string{"foo": "bar"},
+			},
+			Spec: operations.SSHAccessRequestSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: "shoot"},
+				SSHPublicKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIETlsKq/fYx9c3shC3rT0f7z+b3BbcEYGEtl9TUiXHuV",
+				Duration:     metav1.Duration{Duration: time.Hour},
+				Reason:       "incident",
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should record the requester and reset the status", func() {
+			sshAccessRequest.Spec.Requester = "mallory"
+			sshAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Strategy.PrepareForCreate(ctx, sshAccessRequest)
+
+			Expect(sshAccessRequest.Spec.Requester).To(Equal("alice"))
+			Expect(sshAccessRequest.Status).To(Equal(operations.SSHAccessRequestStatus{}))
+			Expect(sshAccessRequest.Generation).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should not allow changing the status", func() {
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.Status.Phase = operations.AccessRequestGranted
+
+			Strategy.PrepareForUpdate(ctx, newSSHAccessRequest, sshAccessRequest)
+
+			Expect(newSSHAccessRequest.Status).To(Equal(sshAccessRequest.Status))
+		})
+	})
+
+	Describe("StatusStrategy#PrepareForUpdate", func() {
+		It("should not allow changing the spec and the approval", func() {
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.Spec.Reason = "foo"
+			newSSHAccessRequest.Status.Phase = operations.AccessRequestPending
+			newSSHAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestApproved}
+
+			StatusStrategy.PrepareForUpdate(ctx, newSSHAccessRequest, sshAccessRequest)
+
+			Expect(newSSHAccessRequest.Spec).To(Equal(sshAccessRequest.Spec))
+			Expect(newSSHAccessRequest.Status.Approval).To(BeNil())
+			Expect(newSSHAccessRequest.Status.Phase).To(Equal(operations.AccessRequestPending))
+		})
+	})
+
+	Describe("ApprovalStrategy#PrepareForUpdate", func() {
+		BeforeEach(func() {
+			ctx = request.WithUser(context.TODO(), &user.DefaultInfo{Name: "bob"})
+			sshAccessRequest.Spec.Requester = "alice"
+			sshAccessRequest.Status.Phase = operations.AccessRequestPending
+		})
+
+		It("should record the decider and the decision time and keep the rest of the status", func() {
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+			newSSHAccessRequest.Spec.Reason = "foo"
+			newSSHAccessRequest.Status.Phase = operations.AccessRequestGranted
+			newSSHAccessRequest.Status.Approval = &operations.AccessRequestApproval{
+				Decision:  operations.AccessRequestApproved,
+				Message:   ptr.To("looks good"),
+				DecidedBy: "alice",
+			}
+
+			ApprovalStrategy.PrepareForUpdate(ctx, newSSHAccessRequest, sshAccessRequest)
+
+			Expect(newSSHAccessRequest.Spec).To(Equal(sshAccessRequest.Spec))
+			Expect(newSSHAccessRequest.Status.Phase).To(Equal(operations.AccessRequestPending))
+			Expect(newSSHAccessRequest.Status.Approval.Decision).To(Equal(operations.AccessRequestApproved))
+			Expect(newSSHAccessRequest.Status.Approval.Message).To(Equal(ptr.To("looks good")))
+			Expect(newSSHAccessRequest.Status.Approval.DecidedBy).To(Equal("bob"))
+			Expect(newSSHAccessRequest.Status.Approval.DecisionTimestamp).NotTo(BeNil())
+		})
+
+		It("should not overwrite the recorded decider of an existing decision", func() {
+			sshAccessRequest.Status.Approval = &operations.AccessRequestApproval{Decision: operations.AccessRequestDenied, DecidedBy: "carol"}
+			newSSHAccessRequest := sshAccessRequest.DeepCopy()
+
+			ApprovalStrategy.PrepareForUpdate(ctx, newSSHAccessRequest, sshAccessRequest)
+
+			Expect(newSSHAccessRequest.Status.Approval.DecidedBy).To(Equal("carol"))
+		})
+	})
+
+	Describe("#ToSelectableFields", func() {
+		It("should return correct fields", func() {
+			result := ToSelectableFields(sshAccessRequest)
+
+			Expect(result).To(HaveLen(3))
+			Expect(result.Get("metadata.name")).To(Equal("test"))
+			Expect(result.Get("metadata.namespace")).To(Equal("test-namespace"))
+			Expect(result.Get(operations.SSHAccessRequestShootName)).To(Equal("shoot"))
+		})
+	})
+
+	Describe("#GetAttrs", func() {
+		It("should return error when object is not SSHAccessRequest", func() {
+			_, _, err := GetAttrs(&gardencore.Seed{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return correct result", func() {
+			ls, fs, err := GetAttrs(sshAccessRequest)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ls.Get("foo")).To(Equal("bar"))
+			Expect(fs.Get(operations.SSHAccessRequestShootName)).To(Equal("shoot"))
+		})
+	})
+})
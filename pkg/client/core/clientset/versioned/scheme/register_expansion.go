@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheme
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+)
+
+// The `shoots/adminkubeconfig` and `shoots/viewerkubeconfig` subresources consume and return types of the
+// authentication.gardener.cloud API group, hence, they must be known to the scheme of this clientset.
+func init() {
+	utilruntime.Must(authenticationv1alpha1.AddToScheme(Scheme))
+}
@@ -23,6 +23,7 @@ type CoreV1beta1Interface interface {
 	ControllerInstallationsGetter
 	ControllerRegistrationsGetter
 	ExposureClassesGetter
+	ImageVectorOverridesGetter
 	InternalSecretsGetter
 	NamespacedCloudProfilesGetter
 	ProjectsGetter
@@ -30,7 +31,9 @@ type CoreV1beta1Interface interface {
 	SecretBindingsGetter
 	SeedsGetter
 	ShootsGetter
+	ShootRevisionsGetter
 	ShootStatesGetter
+	VersionPoliciesGetter
 }
 
 // CoreV1beta1Client is used to interact with features provided by the core.gardener.cloud group.
@@ -66,6 +69,10 @@ func (c *CoreV1beta1Client) ExposureClasses() ExposureClassInterface {
 	return newExposureClasses(c)
 }
 
+func (c *CoreV1beta1Client) ImageVectorOverrides() ImageVectorOverrideInterface {
+	return newImageVectorOverrides(c)
+}
+
 func (c *CoreV1beta1Client) InternalSecrets(namespace string) InternalSecretInterface {
 	return newInternalSecrets(c, namespace)
 }
@@ -94,10 +101,18 @@ func (c *CoreV1beta1Client) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *CoreV1beta1Client) ShootRevisions(namespace string) ShootRevisionInterface {
+	return newShootRevisions(c, namespace)
+}
+
 func (c *CoreV1beta1Client) ShootStates(namespace string) ShootStateInterface {
 	return newShootStates(c, namespace)
 }
 
+func (c *CoreV1beta1Client) VersionPolicies() VersionPolicyInterface {
+	return newVersionPolicies(c)
+}
+
 // NewForConfig creates a new CoreV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -44,6 +44,10 @@ func (c *FakeCoreV1beta1) ExposureClasses() v1beta1.ExposureClassInterface {
 	return &FakeExposureClasses{c}
 }
 
+func (c *FakeCoreV1beta1) ImageVectorOverrides() v1beta1.ImageVectorOverrideInterface {
+	return &FakeImageVectorOverrides{c}
+}
+
 func (c *FakeCoreV1beta1) InternalSecrets(namespace string) v1beta1.InternalSecretInterface {
 	return &FakeInternalSecrets{c, namespace}
 }
@@ -72,10 +76,18 @@ func (c *FakeCoreV1beta1) Shoots(namespace string) v1beta1.ShootInterface {
 	return &FakeShoots{c, namespace}
 }
 
+func (c *FakeCoreV1beta1) ShootRevisions(namespace string) v1beta1.ShootRevisionInterface {
+	return &FakeShootRevisions{c, namespace}
+}
+
 func (c *FakeCoreV1beta1) ShootStates(namespace string) v1beta1.ShootStateInterface {
 	return &FakeShootStates{c, namespace}
 }
 
+func (c *FakeCoreV1beta1) VersionPolicies() v1beta1.VersionPolicyInterface {
+	return &FakeVersionPolicies{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCoreV1beta1) RESTClient() rest.Interface {
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1beta1 "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
+)
+
+// UpdateBinding updates the `.spec.seedName` of the given shoot via the `shoots/binding` subresource.
+func (c *FakeShoots) UpdateBinding(_ context.Context, shoot *gardencorev1beta1.Shoot, _ metav1.UpdateOptions) (*gardencorev1beta1.Shoot, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(shootsResource, "binding", c.ns, shoot), &gardencorev1beta1.Shoot{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gardencorev1beta1.Shoot), err
+}
+
+// CreateAdminKubeconfigRequest requests a kubeconfig with admin privileges for the given shoot via the
+// `shoots/adminkubeconfig` subresource.
+func (c *FakeShoots) CreateAdminKubeconfigRequest(_ context.Context, shootName string, adminKubeconfigRequest *authenticationv1alpha1.AdminKubeconfigRequest, _ metav1.CreateOptions) (*authenticationv1alpha1.AdminKubeconfigRequest, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(shootsResource, shootName, "adminkubeconfig", c.ns, adminKubeconfigRequest), &authenticationv1alpha1.AdminKubeconfigRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authenticationv1alpha1.AdminKubeconfigRequest), err
+}
+
+// CreateViewerKubeconfigRequest requests a kubeconfig with read-only privileges for the given shoot via the
+// `shoots/viewerkubeconfig` subresource.
+func (c *FakeShoots) CreateViewerKubeconfigRequest(_ context.Context, shootName string, viewerKubeconfigRequest *authenticationv1alpha1.ViewerKubeconfigRequest, _ metav1.CreateOptions) (*authenticationv1alpha1.ViewerKubeconfigRequest, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(shootsResource, shootName, "viewerkubeconfig", c.ns, viewerKubeconfigRequest), &authenticationv1alpha1.ViewerKubeconfigRequest{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authenticationv1alpha1.ViewerKubeconfigRequest), err
+}
+
+// EstimateCost estimates the monthly cost of the worker pools of the given shoot via the `shoots/cost` subresource.
+func (c *FakeShoots) EstimateCost(_ context.Context, shootName string, shootCostEstimate *gardencorev1beta1.ShootCostEstimate, _ metav1.CreateOptions) (*gardencorev1beta1.ShootCostEstimate, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(shootsResource, shootName, "cost", c.ns, shootCostEstimate), &gardencorev1beta1.ShootCostEstimate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gardencorev1beta1.ShootCostEstimate), err
+}
+
+// Clone generates the manifest of a new shoot based on the given shoot via the `shoots/clone` subresource.
+func (c *FakeShoots) Clone(_ context.Context, shootName string, shootClone *gardencorev1beta1.ShootClone, _ metav1.CreateOptions) (*gardencorev1beta1.ShootClone, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(shootsResource, shootName, "clone", c.ns, shootClone), &gardencorev1beta1.ShootClone{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gardencorev1beta1.ShootClone), err
+}
+
+// Export exports the given shoot into a provider-agnostic profile via the `shoots/export` subresource.
+func (c *FakeShoots) Export(_ context.Context, shootName string, shootExport *gardencorev1beta1.ShootExport, _ metav1.CreateOptions) (*gardencorev1beta1.ShootExport, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceAction(shootsResource, shootName, "export", c.ns, shootExport), &gardencorev1beta1.ShootExport{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*gardencorev1beta1.ShootExport), err
+}
+
+// TriggerOperation annotates the given shoot with the `gardener.cloud/operation` annotation and the given operation.
+func (c *FakeShoots) TriggerOperation(ctx context.Context, name, operation string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	data, err := corev1beta1.OperationPatch(operation)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.MergePatchType, data, opts)
+}
+
+// Retry triggers a retry of the last failed operation of the given shoot.
+func (c *FakeShoots) Retry(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	return c.TriggerOperation(ctx, name, v1beta1constants.ShootOperationRetry, opts)
+}
+
+// StartCredentialsRotation starts the rotation of all credentials of the given shoot.
+func (c *FakeShoots) StartCredentialsRotation(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	return c.TriggerOperation(ctx, name, v1beta1constants.OperationRotateCredentialsStart, opts)
+}
+
+// CompleteCredentialsRotation completes the rotation of all credentials of the given shoot.
+func (c *FakeShoots) CompleteCredentialsRotation(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	return c.TriggerOperation(ctx, name, v1beta1constants.OperationRotateCredentialsComplete, opts)
+}
@@ -20,6 +20,8 @@ type ControllerRegistrationExpansion interface{}
 
 type ExposureClassExpansion interface{}
 
+type ImageVectorOverrideExpansion interface{}
+
 type InternalSecretExpansion interface{}
 
 type NamespacedCloudProfileExpansion interface{}
@@ -32,6 +34,8 @@ type SecretBindingExpansion interface{}
 
 type SeedExpansion interface{}
 
-type ShootExpansion interface{}
+type ShootRevisionExpansion interface{}
 
 type ShootStateExpansion interface{}
+
+type VersionPolicyExpansion interface{}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta10 "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
 	gomock "go.uber.org/mock/gomock"
@@ -143,6 +144,20 @@ func (mr *MockCoreV1beta1InterfaceMockRecorder) ExposureClasses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExposureClasses", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).ExposureClasses))
 }
 
+// ImageVectorOverrides mocks base method.
+func (m *MockCoreV1beta1Interface) ImageVectorOverrides() v1beta10.ImageVectorOverrideInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageVectorOverrides")
+	ret0, _ := ret[0].(v1beta10.ImageVectorOverrideInterface)
+	return ret0
+}
+
+// ImageVectorOverrides indicates an expected call of ImageVectorOverrides.
+func (mr *MockCoreV1beta1InterfaceMockRecorder) ImageVectorOverrides() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageVectorOverrides", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).ImageVectorOverrides))
+}
+
 // InternalSecrets mocks base method.
 func (m *MockCoreV1beta1Interface) InternalSecrets(arg0 string) v1beta10.InternalSecretInterface {
 	m.ctrl.T.Helper()
@@ -241,6 +256,20 @@ func (mr *MockCoreV1beta1InterfaceMockRecorder) Seeds() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seeds", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).Seeds))
 }
 
+// ShootRevisions mocks base method.
+func (m *MockCoreV1beta1Interface) ShootRevisions(arg0 string) v1beta10.ShootRevisionInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShootRevisions", arg0)
+	ret0, _ := ret[0].(v1beta10.ShootRevisionInterface)
+	return ret0
+}
+
+// ShootRevisions indicates an expected call of ShootRevisions.
+func (mr *MockCoreV1beta1InterfaceMockRecorder) ShootRevisions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShootRevisions", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).ShootRevisions), arg0)
+}
+
 // ShootStates mocks base method.
 func (m *MockCoreV1beta1Interface) ShootStates(arg0 string) v1beta10.ShootStateInterface {
 	m.ctrl.T.Helper()
@@ -269,6 +298,20 @@ func (mr *MockCoreV1beta1InterfaceMockRecorder) Shoots(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shoots", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).Shoots), arg0)
 }
 
+// VersionPolicies mocks base method.
+func (m *MockCoreV1beta1Interface) VersionPolicies() v1beta10.VersionPolicyInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VersionPolicies")
+	ret0, _ := ret[0].(v1beta10.VersionPolicyInterface)
+	return ret0
+}
+
+// VersionPolicies indicates an expected call of VersionPolicies.
+func (mr *MockCoreV1beta1InterfaceMockRecorder) VersionPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VersionPolicies", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).VersionPolicies))
+}
+
 // MockShootInterface is a mock of ShootInterface interface.
 type MockShootInterface struct {
 	ctrl     *gomock.Controller
@@ -292,6 +335,36 @@ func (m *MockShootInterface) EXPECT() *MockShootInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Clone mocks base method.
+func (m *MockShootInterface) Clone(arg0 context.Context, arg1 string, arg2 *v1beta1.ShootClone, arg3 v1.CreateOptions) (*v1beta1.ShootClone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1beta1.ShootClone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockShootInterfaceMockRecorder) Clone(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockShootInterface)(nil).Clone), arg0, arg1, arg2, arg3)
+}
+
+// CompleteCredentialsRotation mocks base method.
+func (m *MockShootInterface) CompleteCredentialsRotation(arg0 context.Context, arg1 string, arg2 v1.PatchOptions) (*v1beta1.Shoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteCredentialsRotation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Shoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteCredentialsRotation indicates an expected call of CompleteCredentialsRotation.
+func (mr *MockShootInterfaceMockRecorder) CompleteCredentialsRotation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteCredentialsRotation", reflect.TypeOf((*MockShootInterface)(nil).CompleteCredentialsRotation), arg0, arg1, arg2)
+}
+
 // Create mocks base method.
 func (m *MockShootInterface) Create(arg0 context.Context, arg1 *v1beta1.Shoot, arg2 v1.CreateOptions) (*v1beta1.Shoot, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +380,36 @@ func (mr *MockShootInterfaceMockRecorder) Create(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockShootInterface)(nil).Create), arg0, arg1, arg2)
 }
 
+// CreateAdminKubeconfigRequest mocks base method.
+func (m *MockShootInterface) CreateAdminKubeconfigRequest(arg0 context.Context, arg1 string, arg2 *v1alpha1.AdminKubeconfigRequest, arg3 v1.CreateOptions) (*v1alpha1.AdminKubeconfigRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminKubeconfigRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1alpha1.AdminKubeconfigRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminKubeconfigRequest indicates an expected call of CreateAdminKubeconfigRequest.
+func (mr *MockShootInterfaceMockRecorder) CreateAdminKubeconfigRequest(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminKubeconfigRequest", reflect.TypeOf((*MockShootInterface)(nil).CreateAdminKubeconfigRequest), arg0, arg1, arg2, arg3)
+}
+
+// CreateViewerKubeconfigRequest mocks base method.
+func (m *MockShootInterface) CreateViewerKubeconfigRequest(arg0 context.Context, arg1 string, arg2 *v1alpha1.ViewerKubeconfigRequest, arg3 v1.CreateOptions) (*v1alpha1.ViewerKubeconfigRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateViewerKubeconfigRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1alpha1.ViewerKubeconfigRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateViewerKubeconfigRequest indicates an expected call of CreateViewerKubeconfigRequest.
+func (mr *MockShootInterfaceMockRecorder) CreateViewerKubeconfigRequest(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateViewerKubeconfigRequest", reflect.TypeOf((*MockShootInterface)(nil).CreateViewerKubeconfigRequest), arg0, arg1, arg2, arg3)
+}
+
 // Delete mocks base method.
 func (m *MockShootInterface) Delete(arg0 context.Context, arg1 string, arg2 v1.DeleteOptions) error {
 	m.ctrl.T.Helper()
@@ -335,6 +438,36 @@ func (mr *MockShootInterfaceMockRecorder) DeleteCollection(arg0, arg1, arg2 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCollection", reflect.TypeOf((*MockShootInterface)(nil).DeleteCollection), arg0, arg1, arg2)
 }
 
+// EstimateCost mocks base method.
+func (m *MockShootInterface) EstimateCost(arg0 context.Context, arg1 string, arg2 *v1beta1.ShootCostEstimate, arg3 v1.CreateOptions) (*v1beta1.ShootCostEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateCost", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1beta1.ShootCostEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateCost indicates an expected call of EstimateCost.
+func (mr *MockShootInterfaceMockRecorder) EstimateCost(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateCost", reflect.TypeOf((*MockShootInterface)(nil).EstimateCost), arg0, arg1, arg2, arg3)
+}
+
+// Export mocks base method.
+func (m *MockShootInterface) Export(arg0 context.Context, arg1 string, arg2 *v1beta1.ShootExport, arg3 v1.CreateOptions) (*v1beta1.ShootExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1beta1.ShootExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockShootInterfaceMockRecorder) Export(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockShootInterface)(nil).Export), arg0, arg1, arg2, arg3)
+}
+
 // Get mocks base method.
 func (m *MockShootInterface) Get(arg0 context.Context, arg1 string, arg2 v1.GetOptions) (*v1beta1.Shoot, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +518,51 @@ func (mr *MockShootInterfaceMockRecorder) Patch(arg0, arg1, arg2, arg3, arg4 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockShootInterface)(nil).Patch), varargs...)
 }
 
+// Retry mocks base method.
+func (m *MockShootInterface) Retry(arg0 context.Context, arg1 string, arg2 v1.PatchOptions) (*v1beta1.Shoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Shoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockShootInterfaceMockRecorder) Retry(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockShootInterface)(nil).Retry), arg0, arg1, arg2)
+}
+
+// StartCredentialsRotation mocks base method.
+func (m *MockShootInterface) StartCredentialsRotation(arg0 context.Context, arg1 string, arg2 v1.PatchOptions) (*v1beta1.Shoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCredentialsRotation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Shoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartCredentialsRotation indicates an expected call of StartCredentialsRotation.
+func (mr *MockShootInterfaceMockRecorder) StartCredentialsRotation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCredentialsRotation", reflect.TypeOf((*MockShootInterface)(nil).StartCredentialsRotation), arg0, arg1, arg2)
+}
+
+// TriggerOperation mocks base method.
+func (m *MockShootInterface) TriggerOperation(arg0 context.Context, arg1, arg2 string, arg3 v1.PatchOptions) (*v1beta1.Shoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerOperation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1beta1.Shoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerOperation indicates an expected call of TriggerOperation.
+func (mr *MockShootInterfaceMockRecorder) TriggerOperation(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerOperation", reflect.TypeOf((*MockShootInterface)(nil).TriggerOperation), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
 func (m *MockShootInterface) Update(arg0 context.Context, arg1 *v1beta1.Shoot, arg2 v1.UpdateOptions) (*v1beta1.Shoot, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +578,21 @@ func (mr *MockShootInterfaceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockShootInterface)(nil).Update), arg0, arg1, arg2)
 }
 
+// UpdateBinding mocks base method.
+func (m *MockShootInterface) UpdateBinding(arg0 context.Context, arg1 *v1beta1.Shoot, arg2 v1.UpdateOptions) (*v1beta1.Shoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBinding", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Shoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBinding indicates an expected call of UpdateBinding.
+func (mr *MockShootInterfaceMockRecorder) UpdateBinding(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBinding", reflect.TypeOf((*MockShootInterface)(nil).UpdateBinding), arg0, arg1, arg2)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockShootInterface) UpdateStatus(arg0 context.Context, arg1 *v1beta1.Shoot, arg2 v1.UpdateOptions) (*v1beta1.Shoot, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+)
+
+// The ShootExpansion interface allows manually adding extra methods to the ShootInterface.
+type ShootExpansion interface {
+	// UpdateBinding updates the `.spec.seedName` of the given shoot via the `shoots/binding` subresource.
+	UpdateBinding(ctx context.Context, shoot *gardencorev1beta1.Shoot, opts metav1.UpdateOptions) (*gardencorev1beta1.Shoot, error)
+	// CreateAdminKubeconfigRequest requests a kubeconfig with admin privileges for the given shoot via the
+	// `shoots/adminkubeconfig` subresource.
+	CreateAdminKubeconfigRequest(ctx context.Context, shootName string, adminKubeconfigRequest *authenticationv1alpha1.AdminKubeconfigRequest, opts metav1.CreateOptions) (*authenticationv1alpha1.AdminKubeconfigRequest, error)
+	// CreateViewerKubeconfigRequest requests a kubeconfig with read-only privileges for the given shoot via the
+	// `shoots/viewerkubeconfig` subresource.
+	CreateViewerKubeconfigRequest(ctx context.Context, shootName string, viewerKubeconfigRequest *authenticationv1alpha1.ViewerKubeconfigRequest, opts metav1.CreateOptions) (*authenticationv1alpha1.ViewerKubeconfigRequest, error)
+	// EstimateCost estimates the monthly cost of the worker pools of the given shoot via the `shoots/cost` subresource.
+	EstimateCost(ctx context.Context, shootName string, shootCostEstimate *gardencorev1beta1.ShootCostEstimate, opts metav1.CreateOptions) (*gardencorev1beta1.ShootCostEstimate, error)
+	// Clone generates the manifest of a new shoot based on the given shoot via the `shoots/clone` subresource.
+	Clone(ctx context.Context, shootName string, shootClone *gardencorev1beta1.ShootClone, opts metav1.CreateOptions) (*gardencorev1beta1.ShootClone, error)
+	// Export exports the given shoot into a provider-agnostic profile via the `shoots/export` subresource.
+	Export(ctx context.Context, shootName string, shootExport *gardencorev1beta1.ShootExport, opts metav1.CreateOptions) (*gardencorev1beta1.ShootExport, error)
+	// TriggerOperation annotates the given shoot with the `gardener.cloud/operation` annotation and the given operation.
+	TriggerOperation(ctx context.Context, name, operation string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error)
+	// Retry triggers a retry of the last failed operation of the given shoot.
+	Retry(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error)
+	// StartCredentialsRotation starts the rotation of all credentials of the given shoot.
+	StartCredentialsRotation(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error)
+	// CompleteCredentialsRotation completes the rotation of all credentials of the given shoot.
+	CompleteCredentialsRotation(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error)
+}
+
+// UpdateBinding updates the `.spec.seedName` of the given shoot via the `shoots/binding` subresource.
+func (c *shoots) UpdateBinding(ctx context.Context, shoot *gardencorev1beta1.Shoot, opts metav1.UpdateOptions) (result *gardencorev1beta1.Shoot, err error) {
+	result = &gardencorev1beta1.Shoot{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shoot.Name).
+		SubResource("binding").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shoot).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// CreateAdminKubeconfigRequest requests a kubeconfig with admin privileges for the given shoot via the
+// `shoots/adminkubeconfig` subresource.
+func (c *shoots) CreateAdminKubeconfigRequest(ctx context.Context, shootName string, adminKubeconfigRequest *authenticationv1alpha1.AdminKubeconfigRequest, opts metav1.CreateOptions) (result *authenticationv1alpha1.AdminKubeconfigRequest, err error) {
+	result = &authenticationv1alpha1.AdminKubeconfigRequest{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("adminkubeconfig").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(adminKubeconfigRequest).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// CreateViewerKubeconfigRequest requests a kubeconfig with read-only privileges for the given shoot via the
+// `shoots/viewerkubeconfig` subresource.
+func (c *shoots) CreateViewerKubeconfigRequest(ctx context.Context, shootName string, viewerKubeconfigRequest *authenticationv1alpha1.ViewerKubeconfigRequest, opts metav1.CreateOptions) (result *authenticationv1alpha1.ViewerKubeconfigRequest, err error) {
+	result = &authenticationv1alpha1.ViewerKubeconfigRequest{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("viewerkubeconfig").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(viewerKubeconfigRequest).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// EstimateCost estimates the monthly cost of the worker pools of the given shoot via the `shoots/cost` subresource.
+func (c *shoots) EstimateCost(ctx context.Context, shootName string, shootCostEstimate *gardencorev1beta1.ShootCostEstimate, opts metav1.CreateOptions) (result *gardencorev1beta1.ShootCostEstimate, err error) {
+	result = &gardencorev1beta1.ShootCostEstimate{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("cost").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shootCostEstimate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Clone generates the manifest of a new shoot based on the given shoot via the `shoots/clone` subresource.
+func (c *shoots) Clone(ctx context.Context, shootName string, shootClone *gardencorev1beta1.ShootClone, opts metav1.CreateOptions) (result *gardencorev1beta1.ShootClone, err error) {
+	result = &gardencorev1beta1.ShootClone{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("clone").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shootClone).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Export exports the given shoot into a provider-agnostic profile via the `shoots/export` subresource.
+func (c *shoots) Export(ctx context.Context, shootName string, shootExport *gardencorev1beta1.ShootExport, opts metav1.CreateOptions) (result *gardencorev1beta1.ShootExport, err error) {
+	result = &gardencorev1beta1.ShootExport{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("shoots").
+		Name(shootName).
+		SubResource("export").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(shootExport).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// TriggerOperation annotates the given shoot with the `gardener.cloud/operation` annotation and the given operation.
+func (c *shoots) TriggerOperation(ctx context.Context, name, operation string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	data, err := OperationPatch(operation)
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(ctx, name, types.MergePatchType, data, opts)
+}
+
+// Retry triggers a retry of the last failed operation of the given shoot.
+func (c *shoots) Retry(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	return c.TriggerOperation(ctx, name, v1beta1constants.ShootOperationRetry, opts)
+}
+
+// StartCredentialsRotation starts the rotation of all credentials of the given shoot.
+func (c *shoots) StartCredentialsRotation(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	return c.TriggerOperation(ctx, name, v1beta1constants.OperationRotateCredentialsStart, opts)
+}
+
+// CompleteCredentialsRotation completes the rotation of all credentials of the given shoot.
+func (c *shoots) CompleteCredentialsRotation(ctx context.Context, name string, opts metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	return c.TriggerOperation(ctx, name, v1beta1constants.OperationRotateCredentialsComplete, opts)
+}
+
+// OperationPatch returns a JSON merge patch which sets the `gardener.cloud/operation` annotation to the given operation.
+func OperationPatch(operation string) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				v1beta1constants.GardenerOperation: operation,
+			},
+		},
+	})
+}
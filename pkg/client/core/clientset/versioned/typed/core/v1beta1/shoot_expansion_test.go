@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
+)
+
+var _ = Describe("ShootExpansion", func() {
+	var (
+		ctx = context.TODO()
+
+		server *httptest.Server
+		shoots ShootInterface
+
+		method, path, contentType string
+		body                      []byte
+		response                  any
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			var err error
+			method, path, contentType = r.Method, r.URL.Path, r.Header.Get("Content-Type")
+			body, err = io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			w.Header().Set("Content-Type", "application/json")
+			Expect(json.NewEncoder(w).Encode(response)).To(Succeed())
+		}))
+		DeferCleanup(server.Close)
+
+		client, err := NewForConfig(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+		shoots = client.Shoots("garden-foo")
+
+		response = &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+		}
+	})
+
+	Describe("#UpdateBinding", func() {
+		It("should update the binding subresource", func() {
+			shoot := &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			}
+
+			result, err := shoots.UpdateBinding(ctx, shoot, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal("bar"))
+
+			Expect(method).To(Equal(http.MethodPut))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar/binding"))
+			Expect(string(body)).To(ContainSubstring(`"seedName":"seed"`))
+		})
+	})
+
+	Describe("#CreateAdminKubeconfigRequest", func() {
+		It("should create an admin kubeconfig request", func() {
+			response = &authenticationv1alpha1.AdminKubeconfigRequest{
+				TypeMeta: metav1.TypeMeta{APIVersion: authenticationv1alpha1.SchemeGroupVersion.String(), Kind: "AdminKubeconfigRequest"},
+				Status:   authenticationv1alpha1.AdminKubeconfigRequestStatus{Kubeconfig: []byte("kubeconfig")},
+			}
+
+			result, err := shoots.CreateAdminKubeconfigRequest(ctx, "bar", &authenticationv1alpha1.AdminKubeconfigRequest{
+				Spec: authenticationv1alpha1.AdminKubeconfigRequestSpec{ExpirationSeconds: ptr.To[int64](600)},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status.Kubeconfig).To(Equal([]byte("kubeconfig")))
+
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar/adminkubeconfig"))
+			Expect(string(body)).To(ContainSubstring(`"expirationSeconds":600`))
+		})
+	})
+
+	Describe("#CreateViewerKubeconfigRequest", func() {
+		It("should create a viewer kubeconfig request", func() {
+			response = &authenticationv1alpha1.ViewerKubeconfigRequest{
+				TypeMeta: metav1.TypeMeta{APIVersion: authenticationv1alpha1.SchemeGroupVersion.String(), Kind: "ViewerKubeconfigRequest"},
+				Status:   authenticationv1alpha1.ViewerKubeconfigRequestStatus{Kubeconfig: []byte("kubeconfig")},
+			}
+
+			result, err := shoots.CreateViewerKubeconfigRequest(ctx, "bar", &authenticationv1alpha1.ViewerKubeconfigRequest{}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status.Kubeconfig).To(Equal([]byte("kubeconfig")))
+
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar/viewerkubeconfig"))
+		})
+	})
+
+	Describe("#EstimateCost", func() {
+		It("should estimate the cost of the shoot", func() {
+			response = &gardencorev1beta1.ShootCostEstimate{
+				TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "ShootCostEstimate"},
+				Status:   gardencorev1beta1.ShootCostEstimateStatus{MinimumMonthlyCost: "73.00", MaximumMonthlyCost: "146.00"},
+			}
+
+			result, err := shoots.EstimateCost(ctx, "bar", &gardencorev1beta1.ShootCostEstimate{}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status.MinimumMonthlyCost).To(Equal("73.00"))
+			Expect(result.Status.MaximumMonthlyCost).To(Equal("146.00"))
+
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar/cost"))
+		})
+	})
+
+	Describe("#Clone", func() {
+		It("should generate the manifest of a new shoot", func() {
+			response = &gardencorev1beta1.ShootClone{
+				TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "ShootClone"},
+				Status:   gardencorev1beta1.ShootCloneStatus{Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "baz"}}},
+			}
+
+			result, err := shoots.Clone(ctx, "bar", &gardencorev1beta1.ShootClone{Spec: gardencorev1beta1.ShootCloneSpec{Name: "baz"}}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status.Shoot.Name).To(Equal("baz"))
+
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar/clone"))
+		})
+	})
+
+	Describe("#Export", func() {
+		It("should export the shoot into a profile", func() {
+			response = &gardencorev1beta1.ShootExport{
+				TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "ShootExport"},
+				Status:   gardencorev1beta1.ShootExportStatus{Profile: &gardencorev1beta1.ShootProfile{Version: "v1", KubernetesVersion: "1.29.1"}},
+			}
+
+			result, err := shoots.Export(ctx, "bar", &gardencorev1beta1.ShootExport{}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status.Profile.KubernetesVersion).To(Equal("1.29.1"))
+
+			Expect(method).To(Equal(http.MethodPost))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar/export"))
+		})
+	})
+
+	DescribeTable("should annotate the shoot with the operation",
+		func(trigger func() (*gardencorev1beta1.Shoot, error), operation string) {
+			result, err := trigger()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal("bar"))
+
+			Expect(method).To(Equal(http.MethodPatch))
+			Expect(path).To(Equal("/apis/core.gardener.cloud/v1beta1/namespaces/garden-foo/shoots/bar"))
+			Expect(contentType).To(Equal("application/merge-patch+json"))
+			Expect(string(body)).To(Equal(`{"metadata":{"annotations":{"gardener.cloud/operation":"` + operation + `"}}}`))
+		},
+
+		Entry("arbitrary operation", func() (*gardencorev1beta1.Shoot, error) {
+			return shoots.TriggerOperation(ctx, "bar", "reconcile", metav1.PatchOptions{})
+		}, "reconcile"),
+		Entry("retry", func() (*gardencorev1beta1.Shoot, error) {
+			return shoots.Retry(ctx, "bar", metav1.PatchOptions{})
+		}, "retry"),
+		Entry("start credentials rotation", func() (*gardencorev1beta1.Shoot, error) {
+			return shoots.StartCredentialsRotation(ctx, "bar", metav1.PatchOptions{})
+		}, "rotate-credentials-start"),
+		Entry("complete credentials rotation", func() (*gardencorev1beta1.Shoot, error) {
+			return shoots.CompleteCredentialsRotation(ctx, "bar", metav1.PatchOptions{})
+		}, "rotate-credentials-complete"),
+	)
+})
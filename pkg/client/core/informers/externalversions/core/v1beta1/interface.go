@@ -26,6 +26,8 @@ type Interface interface {
 	ControllerRegistrations() ControllerRegistrationInformer
 	// ExposureClasses returns a ExposureClassInformer.
 	ExposureClasses() ExposureClassInformer
+	// ImageVectorOverrides returns a ImageVectorOverrideInformer.
+	ImageVectorOverrides() ImageVectorOverrideInformer
 	// InternalSecrets returns a InternalSecretInformer.
 	InternalSecrets() InternalSecretInformer
 	// NamespacedCloudProfiles returns a NamespacedCloudProfileInformer.
@@ -40,8 +42,12 @@ type Interface interface {
 	Seeds() SeedInformer
 	// Shoots returns a ShootInformer.
 	Shoots() ShootInformer
+	// ShootRevisions returns a ShootRevisionInformer.
+	ShootRevisions() ShootRevisionInformer
 	// ShootStates returns a ShootStateInformer.
 	ShootStates() ShootStateInformer
+	// VersionPolicies returns a VersionPolicyInformer.
+	VersionPolicies() VersionPolicyInformer
 }
 
 type version struct {
@@ -90,6 +96,11 @@ func (v *version) ExposureClasses() ExposureClassInformer {
 	return &exposureClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ImageVectorOverrides returns a ImageVectorOverrideInformer.
+func (v *version) ImageVectorOverrides() ImageVectorOverrideInformer {
+	return &imageVectorOverrideInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // InternalSecrets returns a InternalSecretInformer.
 func (v *version) InternalSecrets() InternalSecretInformer {
 	return &internalSecretInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -125,7 +136,17 @@ func (v *version) Shoots() ShootInformer {
 	return &shootInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ShootRevisions returns a ShootRevisionInformer.
+func (v *version) ShootRevisions() ShootRevisionInformer {
+	return &shootRevisionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ShootStates returns a ShootStateInformer.
 func (v *version) ShootStates() ShootStateInformer {
 	return &shootStateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VersionPolicies returns a VersionPolicyInformer.
+func (v *version) VersionPolicies() VersionPolicyInformer {
+	return &versionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -60,6 +60,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ControllerRegistrations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("exposureclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ExposureClasses().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("imagevectoroverrides"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ImageVectorOverrides().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("internalsecrets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().InternalSecrets().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("namespacedcloudprofiles"):
@@ -74,8 +76,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().Seeds().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shoots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().Shoots().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("shootrevisions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ShootRevisions().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shootstates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().ShootStates().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("versionpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1beta1().VersionPolicies().Informer()}, nil
 
 	}
 
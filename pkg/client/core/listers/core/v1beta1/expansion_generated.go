@@ -38,6 +38,10 @@ type ControllerRegistrationListerExpansion interface{}
 // ExposureClassLister.
 type ExposureClassListerExpansion interface{}
 
+// ImageVectorOverrideListerExpansion allows custom methods to be added to
+// ImageVectorOverrideLister.
+type ImageVectorOverrideListerExpansion interface{}
+
 // InternalSecretListerExpansion allows custom methods to be added to
 // InternalSecretLister.
 type InternalSecretListerExpansion interface{}
@@ -86,10 +90,22 @@ type ShootListerExpansion interface{}
 // ShootNamespaceLister.
 type ShootNamespaceListerExpansion interface{}
 
+// ShootRevisionListerExpansion allows custom methods to be added to
+// ShootRevisionLister.
+type ShootRevisionListerExpansion interface{}
+
 // ShootStateListerExpansion allows custom methods to be added to
 // ShootStateLister.
 type ShootStateListerExpansion interface{}
 
+// ShootRevisionNamespaceListerExpansion allows custom methods to be added to
+// ShootRevisionNamespaceLister.
+type ShootRevisionNamespaceListerExpansion interface{}
+
 // ShootStateNamespaceListerExpansion allows custom methods to be added to
 // ShootStateNamespaceLister.
 type ShootStateNamespaceListerExpansion interface{}
+
+// VersionPolicyListerExpansion allows custom methods to be added to
+// VersionPolicyLister.
+type VersionPolicyListerExpansion interface{}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// maxFieldManagerLength is the maximum length of a field manager accepted by the API server.
+const maxFieldManagerLength = 128
+
+var (
+	mutatingMethods = sets.New(http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete)
+	// fieldManagerMethods are the methods of requests which accept a field manager.
+	fieldManagerMethods = sets.New(http.MethodPost, http.MethodPut, http.MethodPatch)
+)
+
+// AttributeMutations configures the given REST config to attribute mutating requests to the controller and the
+// reconciliation performing them. The controller is determined based on the logger in the context of the request which
+// controller-runtime passes to reconcilers, hence, requests outside of reconciliations are not touched.
+// For attributed requests, the controller name is used as field manager (unless the request specifies a field manager
+// already), i.e., it is visible in the managed fields of the mutated object. Additionally, the controller name and the
+// reconcile ID are appended to the user agent which is recorded in the audit logs of the API server.
+func AttributeMutations(restConfig *rest.Config) {
+	restConfig.WrapTransport = transport.Wrappers(restConfig.WrapTransport, func(rt http.RoundTripper) http.RoundTripper {
+		return &attributingRoundTripper{delegate: rt}
+	})
+}
+
+// attributingRoundTripper attributes mutating requests to the controller and the reconciliation performing them.
+type attributingRoundTripper struct {
+	delegate http.RoundTripper
+}
+
+func (a *attributingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !mutatingMethods.Has(req.Method) {
+		return a.delegate.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	controllerName := logger.ControllerName(logf.FromContext(ctx))
+	if controllerName == "" {
+		return a.delegate.RoundTrip(req)
+	}
+
+	// round trippers must not modify the original request
+	req = req.Clone(ctx)
+	userAgent := req.UserAgent()
+
+	if fieldManagerMethods.Has(req.Method) {
+		query := req.URL.Query()
+		if !query.Has("fieldManager") {
+			query.Set("fieldManager", fieldManager(userAgent, controllerName))
+			req.URL.RawQuery = query.Encode()
+		}
+	}
+
+	userAgent = strings.TrimSpace(userAgent + " controller/" + controllerName)
+	if reconcileID := controller.ReconcileIDFromContext(ctx); reconcileID != "" {
+		userAgent += " reconcileID/" + string(reconcileID)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	return a.delegate.RoundTrip(req)
+}
+
+// fieldManager returns the field manager for the given controller. Similar to the API server's default for requests
+// without a field manager, the component name is taken from the user agent, e.g., 'gardenlet/shoot-care'.
+func fieldManager(userAgent, controllerName string) string {
+	manager := controllerName
+	if component, _, _ := strings.Cut(userAgent, "/"); component != "" {
+		manager = component + "/" + controllerName
+	}
+
+	if len(manager) > maxFieldManagerLength {
+		manager = manager[:maxFieldManagerLength]
+	}
+	return manager
+}
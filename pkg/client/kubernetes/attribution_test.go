@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("Attribution", func() {
+	var (
+		ctx        context.Context
+		recorder   *recordingRoundTripper
+		rt         http.RoundTripper
+		restConfig *rest.Config
+	)
+
+	BeforeEach(func() {
+		log := logger.MustNewZapLogger(logger.InfoLevel, logger.FormatJSON, logger.WithLevels(logger.NewLevels()), logzap.WriteTo(io.Discard))
+		ctx = logf.IntoContext(context.Background(), log.WithValues("controller", "shoot-care"))
+
+		recorder = &recordingRoundTripper{}
+		restConfig = &rest.Config{}
+		AttributeMutations(restConfig)
+		rt = restConfig.WrapTransport(recorder)
+	})
+
+	newRequest := func(ctx context.Context, method, url string) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, method, url, nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("User-Agent", "gardenlet/v1.97.0 (linux/amd64) kubernetes/$Format")
+		return req
+	}
+
+	It("should attribute mutating requests to the controller", func() {
+		req := newRequest(ctx, http.MethodPatch, "https://garden/api/v1/namespaces/garden/configmaps/foo")
+		_, err := rt.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.request.URL.Query().Get("fieldManager")).To(Equal("gardenlet/shoot-care"))
+		Expect(recorder.request.UserAgent()).To(Equal("gardenlet/v1.97.0 (linux/amd64) kubernetes/$Format controller/shoot-care"))
+
+		By("Ensure the original request is not modified")
+		Expect(req.URL.RawQuery).To(BeEmpty())
+		Expect(req.UserAgent()).NotTo(ContainSubstring("controller/"))
+	})
+
+	It("should not overwrite the field manager of the request", func() {
+		_, err := rt.RoundTrip(newRequest(ctx, http.MethodPatch, "https://garden/api/v1/namespaces/garden/configmaps/foo?fieldManager=gardenlet"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.request.URL.Query().Get("fieldManager")).To(Equal("gardenlet"))
+		Expect(recorder.request.UserAgent()).To(HaveSuffix(" controller/shoot-care"))
+	})
+
+	It("should not set a field manager for delete requests", func() {
+		_, err := rt.RoundTrip(newRequest(ctx, http.MethodDelete, "https://garden/api/v1/namespaces/garden/configmaps/foo"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.request.URL.Query().Has("fieldManager")).To(BeFalse())
+		Expect(recorder.request.UserAgent()).To(HaveSuffix(" controller/shoot-care"))
+	})
+
+	It("should truncate too long field managers", func() {
+		ctx = logf.IntoContext(ctx, logf.FromContext(ctx).WithValues("controller", strings.Repeat("a", 200)))
+
+		_, err := rt.RoundTrip(newRequest(ctx, http.MethodPost, "https://garden/api/v1/namespaces/garden/configmaps"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.request.URL.Query().Get("fieldManager")).To(HaveLen(128))
+	})
+
+	It("should not touch read requests", func() {
+		_, err := rt.RoundTrip(newRequest(ctx, http.MethodGet, "https://garden/api/v1/namespaces/garden/configmaps/foo"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.request.URL.RawQuery).To(BeEmpty())
+		Expect(recorder.request.UserAgent()).NotTo(ContainSubstring("controller/"))
+	})
+
+	It("should not touch requests outside of reconciliations", func() {
+		_, err := rt.RoundTrip(newRequest(logr.NewContext(context.Background(), logr.Discard()), http.MethodPatch, "https://garden/api/v1/namespaces/garden/configmaps/foo"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(recorder.request.URL.RawQuery).To(BeEmpty())
+		Expect(recorder.request.UserAgent()).NotTo(ContainSubstring("controller/"))
+	})
+})
+
+type recordingRoundTripper struct {
+	request *http.Request
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.request = req
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiter
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// decreaseFactor is the factor by which the rate is multiplied when the API server throttles requests.
+	decreaseFactor = 0.5
+	// increaseFactor is the factor by which the rate is multiplied while requests are processed successfully.
+	increaseFactor = 1.1
+	// AdjustmentInterval is the minimum duration between two adjustments of the rate in the same direction. It prevents
+	// that a burst of throttled responses immediately collapses the rate to the minimum.
+	AdjustmentInterval = 5 * time.Second
+)
+
+var _ flowcontrol.RateLimiter = &Adaptive{}
+
+// Adaptive is a client-side rate limiter whose rate is tuned based on the responses of the API server. The rate is
+// increased while requests are processed successfully and decreased when the API server rejects requests with
+// '429 Too Many Requests', e.g., because the request was rejected by API Priority and Fairness.
+type Adaptive struct {
+	clock    clock.PassiveClock
+	limiter  *rate.Limiter
+	minQPS   float64
+	maxQPS   float64
+	onChange func(qps float32)
+
+	lock         sync.Mutex
+	lastIncrease time.Time
+	lastDecrease time.Time
+	backoffUntil time.Time
+}
+
+// NewAdaptive returns a new adaptive rate limiter which starts with the given qps. The effective rate is always kept
+// between minQPS and maxQPS. The onChange function (if non-nil) is called with the new effective rate whenever it
+// changes.
+func NewAdaptive(clock clock.PassiveClock, qps, minQPS, maxQPS float32, burst int, onChange func(qps float32)) *Adaptive {
+	a := &Adaptive{
+		clock:        clock,
+		limiter:      rate.NewLimiter(rate.Limit(qps), burst),
+		minQPS:       float64(minQPS),
+		maxQPS:       float64(maxQPS),
+		onChange:     onChange,
+		lastIncrease: clock.Now(),
+	}
+
+	if onChange != nil {
+		onChange(qps)
+	}
+
+	return a
+}
+
+// Apply configures the given REST config to use the adaptive rate limiter and to report the responses of the API
+// server to it. The QPS and Burst settings of the REST config are ignored afterwards.
+func (a *Adaptive) Apply(restConfig *rest.Config) {
+	restConfig.RateLimiter = a
+	restConfig.WrapTransport = transport.Wrappers(restConfig.WrapTransport, a.wrapTransport)
+}
+
+// TryAccept returns true if a token is taken immediately.
+func (a *Adaptive) TryAccept() bool {
+	return a.limiter.AllowN(a.clock.Now(), 1)
+}
+
+// Accept returns once a token becomes available.
+func (a *Adaptive) Accept() {
+	_ = a.limiter.Wait(context.Background())
+}
+
+// Wait returns nil if a token is taken before the context is done.
+func (a *Adaptive) Wait(ctx context.Context) error {
+	return a.limiter.Wait(ctx)
+}
+
+// Stop stops the rate limiter. It is a no-op since the adaptive rate limiter does not hold any resources.
+func (a *Adaptive) Stop() {}
+
+// QPS returns the current effective queries per second.
+func (a *Adaptive) QPS() float32 {
+	return float32(a.limiter.Limit())
+}
+
+// Succeeded reports that the API server processed a request without throttling it. The rate is increased if it was
+// neither increased within the last AdjustmentInterval nor the API server asked to back off.
+func (a *Adaptive) Succeeded() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := a.clock.Now()
+	if now.Before(a.backoffUntil) || now.Sub(a.lastIncrease) < AdjustmentInterval || now.Sub(a.lastDecrease) < AdjustmentInterval {
+		return
+	}
+
+	a.lastIncrease = now
+	a.setLimit(now, float64(a.limiter.Limit())*increaseFactor)
+}
+
+// Throttled reports that the API server rejected a request with '429 Too Many Requests'. The rate is decreased if it
+// was not decreased within the last AdjustmentInterval. Further increases are suspended for the given retryAfter
+// duration.
+func (a *Adaptive) Throttled(retryAfter time.Duration) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := a.clock.Now()
+	if backoffUntil := now.Add(retryAfter); backoffUntil.After(a.backoffUntil) {
+		a.backoffUntil = backoffUntil
+	}
+
+	if now.Sub(a.lastDecrease) < AdjustmentInterval {
+		return
+	}
+
+	a.lastDecrease = now
+	a.setLimit(now, float64(a.limiter.Limit())*decreaseFactor)
+}
+
+func (a *Adaptive) setLimit(now time.Time, qps float64) {
+	qps = max(a.minQPS, min(a.maxQPS, qps))
+	if rate.Limit(qps) == a.limiter.Limit() {
+		return
+	}
+
+	a.limiter.SetLimitAt(now, rate.Limit(qps))
+	if a.onChange != nil {
+		a.onChange(float32(qps))
+	}
+}
+
+func (a *Adaptive) wrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &observingRoundTripper{delegate: rt, limiter: a}
+}
+
+// observingRoundTripper reports the responses of the API server to the adaptive rate limiter.
+type observingRoundTripper struct {
+	delegate http.RoundTripper
+	limiter  *Adaptive
+}
+
+func (o *observingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := o.delegate.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		o.limiter.Throttled(retryAfter(resp))
+	case resp.StatusCode < http.StatusInternalServerError:
+		o.limiter.Succeeded()
+	}
+
+	return resp, nil
+}
+
+// retryAfter returns the duration the API server asked to wait before retrying the request. Rejections by API Priority
+// and Fairness always carry a 'Retry-After' header.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
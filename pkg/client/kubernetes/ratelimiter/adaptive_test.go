@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiter_test
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/client/kubernetes/ratelimiter"
+)
+
+var _ = Describe("Adaptive", func() {
+	var (
+		fakeClock    *testclock.FakeClock
+		limiter      *Adaptive
+		reportedQPS  []float32
+		responseCode int
+		responseHdr  http.Header
+		roundTripper http.RoundTripper
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+		reportedQPS = nil
+		responseCode = http.StatusOK
+		responseHdr = http.Header{}
+
+		limiter = NewAdaptive(fakeClock, 20, 5, 30, 10, func(qps float32) { reportedQPS = append(reportedQPS, qps) })
+
+		restConfig := &rest.Config{}
+		limiter.Apply(restConfig)
+		Expect(restConfig.RateLimiter).To(BeIdenticalTo(limiter))
+
+		roundTripper = restConfig.WrapTransport(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: responseCode, Header: responseHdr}, nil
+		}))
+	})
+
+	do := func() {
+		_, err := roundTripper.RoundTrip(&http.Request{})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	It("should report the initial rate", func() {
+		Expect(limiter.QPS()).To(Equal(float32(20)))
+		Expect(reportedQPS).To(Equal([]float32{20}))
+	})
+
+	It("should increase the rate at most once per adjustment interval while requests succeed", func() {
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(20)))
+
+		fakeClock.Step(AdjustmentInterval)
+		do()
+		do()
+		Expect(limiter.QPS()).To(BeNumerically("~", 22, 0.01))
+	})
+
+	It("should not increase the rate beyond the maximum", func() {
+		for i := 0; i < 10; i++ {
+			fakeClock.Step(AdjustmentInterval)
+			do()
+		}
+
+		Expect(limiter.QPS()).To(Equal(float32(30)))
+		Expect(reportedQPS[len(reportedQPS)-1]).To(Equal(float32(30)))
+	})
+
+	It("should decrease the rate at most once per adjustment interval when requests are throttled", func() {
+		responseCode = http.StatusTooManyRequests
+
+		do()
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(10)))
+
+		fakeClock.Step(AdjustmentInterval)
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(5)))
+
+		fakeClock.Step(AdjustmentInterval)
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(5)))
+		Expect(reportedQPS).To(Equal([]float32{20, 10, 5}))
+	})
+
+	It("should not increase the rate before the duration requested by the API server has passed", func() {
+		responseCode = http.StatusTooManyRequests
+		responseHdr.Set("Retry-After", "30")
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(10)))
+
+		responseCode = http.StatusOK
+		fakeClock.Step(10 * time.Second)
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(10)))
+
+		fakeClock.Step(20 * time.Second)
+		do()
+		Expect(limiter.QPS()).To(BeNumerically("~", 11, 0.01))
+	})
+
+	It("should not adjust the rate for server errors", func() {
+		responseCode = http.StatusInternalServerError
+
+		fakeClock.Step(AdjustmentInterval)
+		do()
+		Expect(limiter.QPS()).To(Equal(float32(20)))
+	})
+})
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
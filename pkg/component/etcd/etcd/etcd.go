@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -153,6 +154,8 @@ type Values struct {
 	StorageCapacity             string
 	StorageClassName            *string
 	DefragmentationSchedule     *string
+	AutoCompactionMode          *druidv1alpha1.CompactionMode
+	AutoCompactionRetention     *string
 	CARotationPhase             gardencorev1beta1.CredentialsRotationPhase
 	RuntimeKubernetesVersion    *semver.Version
 	BackupConfig                *BackupConfig
@@ -316,6 +319,10 @@ func (e *etcd) Deploy(ctx context.Context) error {
 				v1beta1constants.LabelApp: LabelAppValue,
 			}),
 		}
+		e.etcd.Spec.Common = druidv1alpha1.SharedConfig{
+			AutoCompactionMode:      e.values.AutoCompactionMode,
+			AutoCompactionRetention: e.values.AutoCompactionRetention,
+		}
 		e.etcd.Spec.Etcd = druidv1alpha1.EtcdConfig{
 			Resources: resourcesEtcd,
 			ClientUrlTLS: &druidv1alpha1.TLSConfig{
@@ -1213,11 +1220,24 @@ func (e *etcd) computeReplicas(existingEtcd *druidv1alpha1.Etcd) int32 {
 func (e *etcd) computeDefragmentationSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
 	defragmentationSchedule := e.values.DefragmentationSchedule
 	if existingEtcd != nil && existingEtcd.Spec.Etcd.DefragmentationSchedule != nil {
-		defragmentationSchedule = existingEtcd.Spec.Etcd.DefragmentationSchedule
+		// Keep the existing schedule unless the days on which the defragmentation shall run have changed, e.g., because
+		// the interval was reconfigured or because the database exceeded its size threshold.
+		if defragmentationSchedule == nil || !scheduleDaysChanged(*existingEtcd.Spec.Etcd.DefragmentationSchedule, *defragmentationSchedule) {
+			defragmentationSchedule = existingEtcd.Spec.Etcd.DefragmentationSchedule
+		}
 	}
 	return defragmentationSchedule
 }
 
+// scheduleDaysChanged returns true if the day-of-month, month or day-of-week fields of the given cron schedules differ.
+func scheduleDaysChanged(oldSchedule, newSchedule string) bool {
+	oldFields, newFields := strings.Fields(oldSchedule), strings.Fields(newSchedule)
+	if len(oldFields) != 5 || len(newFields) != 5 {
+		return false
+	}
+	return !slices.Equal(oldFields[2:], newFields[2:])
+}
+
 func (e *etcd) computeFullSnapshotSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
 	fullSnapshotSchedule := &e.values.BackupConfig.FullSnapshotSchedule
 	if existingEtcd != nil && existingEtcd.Spec.Backup.FullSnapshotSchedule != nil {
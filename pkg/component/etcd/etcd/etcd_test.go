@@ -1297,6 +1297,103 @@ var _ = Describe("Etcd", func() {
 			Expect(etcd.Deploy(ctx)).To(Succeed())
 		})
 
+		It("should successfully deploy (normal etcd) and update the defragmentation schedule if its days changed and configure the compaction", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			var (
+				existingDefragmentationSchedule = "0 1 */3 * *"
+				newDefragmentationSchedule      = "5 1 * * *"
+			)
+
+			etcd = New(log, c, testNamespace, sm, Values{
+				Role:                    testRole,
+				Class:                   class,
+				Replicas:                replicas,
+				StorageCapacity:         storageCapacity,
+				StorageClassName:        &storageClassName,
+				DefragmentationSchedule: &newDefragmentationSchedule,
+				AutoCompactionMode:      ptr.To(druidv1alpha1.Revision),
+				AutoCompactionRetention: ptr.To("1000"),
+				CARotationPhase:         caRotationPhase,
+				PriorityClassName:       priorityClassName,
+				HVPAEnabled:             hvpaEnabled,
+				MaintenanceTimeWindow:   maintenanceTimeWindow,
+				ScaleDownUpdateMode:     scaleDownUpdateMode,
+				VPAEnabled:              vpaEnabled,
+				HighAvailabilityEnabled: highAvailabilityEnabled,
+				BackupConfig:            backupConfig,
+			})
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					(&druidv1alpha1.Etcd{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      etcdName,
+							Namespace: testNamespace,
+						},
+						Spec: druidv1alpha1.EtcdSpec{
+							Etcd: druidv1alpha1.EtcdConfig{
+								DefragmentationSchedule: &existingDefragmentationSchedule,
+							},
+						},
+						Status: druidv1alpha1.EtcdStatus{
+							Etcd: &druidv1alpha1.CrossVersionObjectReference{
+								Name: etcdName,
+							},
+						},
+					}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+					return nil
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&appsv1.StatefulSet{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+					// ignore status when comparing
+					obj.Status = druidv1alpha1.EtcdStatus{}
+
+					expected := etcdObjFor(
+						class,
+						1,
+						nil,
+						newDefragmentationSchedule,
+						"",
+						nil,
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)
+					expected.Spec.Common = druidv1alpha1.SharedConfig{
+						AutoCompactionMode:      ptr.To(druidv1alpha1.Revision),
+						AutoCompactionRetention: ptr.To("1000"),
+					}
+					Expect(obj).To(DeepEqual(expected))
+				}),
+				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "etcd-" + testRole, Namespace: testNamespace}}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: hvpaName}, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&hvpav1alpha1.Hvpa{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(hvpaFor(class, 1, updateModeMaintenanceWindow)))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(serviceMonitor("shoot", "etcd-client")))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-druid"}, gomock.AssignableToTypeOf(&monitoringv1alpha1.ScrapeConfig{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1alpha1.ScrapeConfig{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(scrapeConfig))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(prometheusRule("shoot", class, 1, false)))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
 		It("should successfully deploy (normal etcd) and keep the existing resource request settings (but not limits) to not interfer with HVPA controller", func() {
 			oldTimeNow := TimeNow
 			defer func() { TimeNow = oldTimeNow }()
@@ -36,6 +36,8 @@ var (
 	networkCRD string
 	//go:embed assets/crd-extensions.gardener.cloud_operatingsystemconfigs.yaml
 	operatingSystemConfigCRD string
+	//go:embed assets/crd-extensions.gardener.cloud_privatelinks.yaml
+	privateLinkCRD string
 	//go:embed assets/crd-extensions.gardener.cloud_workers.yaml
 	workerCRD string
 
@@ -55,6 +57,7 @@ func init() {
 		infrastructureCRD,
 		networkCRD,
 		operatingSystemConfigCRD,
+		privateLinkCRD,
 		workerCRD,
 	)
 }
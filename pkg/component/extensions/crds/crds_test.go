@@ -66,6 +66,7 @@ var _ = Describe("#CRDs", func() {
 		Entry("Infrastructure", "infrastructures.extensions.gardener.cloud"),
 		Entry("Network", "networks.extensions.gardener.cloud"),
 		Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud"),
+		Entry("PrivateLink", "privatelinks.extensions.gardener.cloud"),
 		Entry("Worker", "workers.extensions.gardener.cloud"),
 	)
 
@@ -88,6 +89,7 @@ var _ = Describe("#CRDs", func() {
 		Entry("Infrastructure", "infrastructures.extensions.gardener.cloud"),
 		Entry("Network", "networks.extensions.gardener.cloud"),
 		Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud"),
+		Entry("PrivateLink", "privatelinks.extensions.gardener.cloud"),
 		Entry("Worker", "workers.extensions.gardener.cloud"),
 	)
 })
@@ -6,7 +6,12 @@ package dnsrecord
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -23,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
 const (
@@ -36,8 +42,14 @@ const (
 	DefaultTimeout = 2 * time.Minute
 )
 
-// TimeNow returns the current time. Exposed for testing.
-var TimeNow = time.Now
+var (
+	// TimeNow returns the current time. Exposed for testing.
+	TimeNow = time.Now
+	// LookupHost resolves the given host to its addresses. Exposed for testing.
+	LookupHost = net.DefaultResolver.LookupHost
+	// DialContext connects to the given address. Exposed for testing.
+	DialContext = (&net.Dialer{Timeout: 5 * time.Second}).DialContext
+)
 
 // Interface is an interface for managing DNSRecords
 type Interface interface {
@@ -78,6 +90,9 @@ type Values struct {
 	TTL *int64
 	// IPStack is the indication of the IP stack used for the DNSRecord. It can be ipv4, ipv6 or dual-stack.
 	IPStack string
+	// HealthProbe is the health probe of the DNSRecord. If set, Wait additionally waits until the DNS name resolves to
+	// the desired values and the endpoint answers.
+	HealthProbe *extensionsv1alpha1.DNSRecordHealthProbe
 }
 
 // New creates a new instance that implements component.DeployMigrateWaiter.
@@ -159,8 +174,9 @@ func (d *dnsRecord) deploy(ctx context.Context, operation string) (extensionsv1a
 			Zone:       d.values.Zone,
 			Name:       d.values.DNSName,
 			RecordType: d.values.RecordType,
-			Values:     d.values.Values,
-			TTL:        d.values.TTL,
+			Values:      d.values.Values,
+			TTL:         d.values.TTL,
+			HealthProbe: d.values.HealthProbe,
 		}
 
 		return nil
@@ -245,9 +261,10 @@ func (d *dnsRecord) Destroy(ctx context.Context) error {
 // WaitUntilExtensionObjectReady is an alias for extensions.WaitUntilExtensionObjectReady. Exposed for tests.
 var WaitUntilExtensionObjectReady = extensions.WaitUntilExtensionObjectReady
 
-// Wait waits until the DNSRecord resource is ready.
+// Wait waits until the DNSRecord resource is ready. If a health probe is configured, it additionally waits until the
+// DNS name resolves to the desired values and the endpoint answers.
 func (d *dnsRecord) Wait(ctx context.Context) error {
-	return WaitUntilExtensionObjectReady(
+	if err := WaitUntilExtensionObjectReady(
 		ctx,
 		d.client,
 		d.log,
@@ -257,7 +274,58 @@ func (d *dnsRecord) Wait(ctx context.Context) error {
 		d.waitSevereThreshold,
 		d.waitTimeout,
 		nil,
-	)
+	); err != nil {
+		return err
+	}
+
+	if d.values.HealthProbe == nil {
+		return nil
+	}
+
+	if err := retryutils.UntilTimeout(ctx, d.waitInterval, d.waitTimeout, func(ctx context.Context) (bool, error) {
+		if err := d.probe(ctx); err != nil {
+			d.log.Info("Health probe of DNSRecord not successful yet", "dnsName", d.values.DNSName, "reason", err.Error())
+			return retryutils.MinorError(err)
+		}
+		return retryutils.Ok()
+	}); err != nil {
+		return fmt.Errorf("health probe of DNSRecord %s failed, the DNS record might not have been propagated yet: %w", client.ObjectKeyFromObject(d.dnsRecord), err)
+	}
+
+	return nil
+}
+
+// probe checks that the DNS name resolves to the desired values and that the endpoint accepts connections.
+func (d *dnsRecord) probe(ctx context.Context) error {
+	addresses, err := LookupHost(ctx, d.values.DNSName)
+	if err != nil {
+		return fmt.Errorf("DNS name %q does not resolve: %w", d.values.DNSName, err)
+	}
+
+	switch d.values.RecordType {
+	case extensionsv1alpha1.DNSRecordTypeCNAME:
+		targetAddresses, err := LookupHost(ctx, d.values.Values[0])
+		if err != nil {
+			return fmt.Errorf("target %q of DNS name %q does not resolve: %w", d.values.Values[0], d.values.DNSName, err)
+		}
+		if !slices.ContainsFunc(addresses, func(address string) bool { return slices.Contains(targetAddresses, address) }) {
+			return fmt.Errorf("DNS name %q resolves to %s which does not match the addresses %s of its target %q",
+				d.values.DNSName, strings.Join(addresses, ", "), strings.Join(targetAddresses, ", "), d.values.Values[0])
+		}
+	default:
+		for _, value := range d.values.Values {
+			if !slices.ContainsFunc(addresses, func(address string) bool { return net.ParseIP(address).Equal(net.ParseIP(value)) }) {
+				return fmt.Errorf("DNS name %q resolves to %s but not to the desired address %s", d.values.DNSName, strings.Join(addresses, ", "), value)
+			}
+		}
+	}
+
+	endpoint := net.JoinHostPort(d.values.DNSName, strconv.Itoa(int(d.values.HealthProbe.Port)))
+	conn, err := DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint %s does not answer: %w", endpoint, err)
+	}
+	return conn.Close()
 }
 
 // WaitMigrate waits until the DNSRecord resource is migrated successfully.
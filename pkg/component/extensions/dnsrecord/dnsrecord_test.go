@@ -7,6 +7,7 @@ package dnsrecord_test
 import (
 	"context"
 	"errors"
+	"net"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -589,6 +590,94 @@ var _ = Describe("DNSRecord", func() {
 
 			Expect(dnsRecord.Wait(ctx)).To(Succeed(), "dnsrecord is ready")
 		})
+
+		Context("with health probe", func() {
+			var (
+				resolved    map[string][]string
+				dialErr     error
+				dialAddress string
+			)
+
+			BeforeEach(func() {
+				values.HealthProbe = &extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}
+				resolved = map[string][]string{dnsName: {address}}
+				dialErr = nil
+				dialAddress = ""
+
+				DeferCleanup(test.WithVars(
+					&dnsrecord.LookupHost, func(_ context.Context, host string) ([]string, error) {
+						if addresses, ok := resolved[host]; ok {
+							return addresses, nil
+						}
+						return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+					},
+					&dnsrecord.DialContext, func(_ context.Context, _, address string) (net.Conn, error) {
+						dialAddress = address
+						if dialErr != nil {
+							return nil, dialErr
+						}
+						conn, _ := net.Pipe()
+						return conn, nil
+					},
+				))
+
+				Expect(dnsRecord.Deploy(ctx)).To(Succeed())
+
+				patch := client.MergeFrom(dns.DeepCopy())
+				dns.ObjectMeta.Annotations = map[string]string{
+					v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+				}
+				dns.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					LastUpdateTime: metav1.Time{Time: now.UTC().Add(time.Second)},
+				}
+				Expect(c.Patch(ctx, dns, patch)).To(Succeed(), "patching dnsrecord succeeds")
+			})
+
+			It("should deploy the health probe", func() {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(dns), dns)).To(Succeed())
+				Expect(dns.Spec.HealthProbe).To(Equal(&extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}))
+			})
+
+			It("should succeed if the name resolves and the endpoint answers", func() {
+				Expect(dnsRecord.Wait(ctx)).To(Succeed())
+				Expect(dialAddress).To(Equal(dnsName + ":443"))
+			})
+
+			It("should fail if the name does not resolve", func() {
+				delete(resolved, dnsName)
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring("does not resolve")))
+			})
+
+			It("should fail if the name does not resolve to the desired addresses yet", func() {
+				resolved[dnsName] = []string{"5.6.7.8"}
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring("resolves to 5.6.7.8 but not to the desired address 1.2.3.4")))
+			})
+
+			It("should fail if the endpoint does not answer", func() {
+				dialErr = testErr
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring("endpoint " + dnsName + ":443 does not answer")))
+			})
+
+			It("should succeed for CNAME records if the name resolves to the addresses of the target", func() {
+				values.RecordType = extensionsv1alpha1.DNSRecordTypeCNAME
+				values.Values = []string{"lb.example.com"}
+				resolved["lb.example.com"] = []string{address}
+
+				Expect(dnsRecord.Wait(ctx)).To(Succeed())
+			})
+
+			It("should fail for CNAME records if the name does not resolve to the addresses of the target yet", func() {
+				values.RecordType = extensionsv1alpha1.DNSRecordTypeCNAME
+				values.Values = []string{"lb.example.com"}
+				resolved["lb.example.com"] = []string{"5.6.7.8"}
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring("does not match the addresses 5.6.7.8 of its target")))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -48,6 +48,8 @@ type Interface interface {
 	NodesCIDR() *string
 	// EgressCIDRs returns a list of CIDRs used as source IP by any traffic originating from the shoot's worker nodes.
 	EgressCIDRs() []string
+	// EgressOnlyInternetAccess returns whether the provider honors the requested egress-only internet access.
+	EgressOnlyInternetAccess() *bool
 }
 
 // Values contains the values used to create an Infrastructure resources.
@@ -64,6 +66,10 @@ type Values struct {
 	Region string
 	// SSHPublicKey is the to-be-used SSH public key of the shoot.
 	SSHPublicKey []byte
+	// ResourceTags is a map of tags which shall be added to all cloud resources created for the infrastructure.
+	ResourceTags map[string]string
+	// EgressOnlyInternetAccess indicates whether the worker nodes shall be provisioned without public IPs.
+	EgressOnlyInternetAccess bool
 	// AnnotateOperation indicates if the Infrastructure resource shall be annotated with the
 	// respective "gardener.cloud/operation" (forcing a reconciliation or restoration). If this is false
 	// then the Infrastructure object will be created/updated but the extension controller will not
@@ -109,6 +115,8 @@ type infrastructure struct {
 	providerStatus *runtime.RawExtension
 	nodesCIDR      *string
 	egressCIDRs    []string
+
+	egressOnlyInternetAccess *bool
 }
 
 // Deploy uses the seed client to create or update the Infrastructure resource.
@@ -144,6 +152,8 @@ func (i *infrastructure) deploy(ctx context.Context, operation string) (extensio
 				Name:      v1beta1constants.SecretNameCloudProvider,
 				Namespace: i.infrastructure.Namespace,
 			},
+			ResourceTags:             i.values.ResourceTags,
+			EgressOnlyInternetAccess: i.values.EgressOnlyInternetAccess,
 		}
 		return nil
 	})
@@ -252,11 +262,17 @@ func (i *infrastructure) EgressCIDRs() []string {
 	return i.egressCIDRs
 }
 
+// EgressOnlyInternetAccess returns whether the provider honors the requested egress-only internet access.
+func (i *infrastructure) EgressOnlyInternetAccess() *bool {
+	return i.egressOnlyInternetAccess
+}
+
 func (i *infrastructure) extractStatus(status extensionsv1alpha1.InfrastructureStatus) {
 	i.providerStatus = status.ProviderStatus
 	i.nodesCIDR = status.NodesCIDR
 	i.egressCIDRs = make([]string, len(status.EgressCIDRs))
 	copy(i.egressCIDRs, status.EgressCIDRs)
+	i.egressOnlyInternetAccess = status.EgressOnlyInternetAccess
 }
 
 func (i *infrastructure) lastOperationNotSuccessful() bool {
@@ -94,6 +94,7 @@ var _ = Describe("#Interface", func() {
 			Type:           providerType,
 			ProviderConfig: providerConfig,
 			Region:         region,
+			ResourceTags:   map[string]string{"cost-center": "1234"},
 		}
 
 		empty = &extensionsv1alpha1.Infrastructure{
@@ -123,6 +124,7 @@ var _ = Describe("#Interface", func() {
 					Name:      v1beta1constants.SecretNameCloudProvider,
 					Namespace: namespace,
 				},
+				ResourceTags: map[string]string{"cost-center": "1234"},
 			},
 		}
 
@@ -176,6 +178,23 @@ var _ = Describe("#Interface", func() {
 			Expect(actual).To(DeepEqual(expected))
 		})
 
+		It("correct Infrastructure is created (EgressOnlyInternetAccess=true)", func() {
+			defer test.WithVars(
+				&infrastructure.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			values.AnnotateOperation = true
+			values.EgressOnlyInternetAccess = true
+			deployWaiter.SetSSHPublicKey(sshPublicKey)
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.Infrastructure{}
+			expected.Spec.EgressOnlyInternetAccess = true
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(expected), actual)).To(Succeed())
+			Expect(actual).To(DeepEqual(expected))
+		})
+
 		It("should deploy the Infrastructure with operation annotation if it is in error state", func() {
 			defer test.WithVars(
 				&infrastructure.TimeNow, mockNow.Do,
@@ -341,12 +360,14 @@ var _ = Describe("#Interface", func() {
 			expected.Status.NodesCIDR = nodesCIDR
 			expected.Status.ProviderStatus = providerStatus
 			expected.Status.EgressCIDRs = egressCIDRs
+			expected.Status.EgressOnlyInternetAccess = ptr.To(true)
 
 			Expect(c.Create(ctx, expected)).To(Succeed(), "creating infrastructure succeeds")
 			Expect(deployWaiter.Wait(ctx)).To(Succeed())
 			Expect(deployWaiter.ProviderStatus()).To(Equal(providerStatus))
 			Expect(deployWaiter.NodesCIDR()).To(Equal(nodesCIDR))
 			Expect(deployWaiter.EgressCIDRs()).To(Equal(egressCIDRs))
+			Expect(deployWaiter.EgressOnlyInternetAccess()).To(Equal(ptr.To(true)))
 		})
 	})
 
@@ -84,6 +84,20 @@ func (mr *MockInterfaceMockRecorder) EgressCIDRs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressCIDRs", reflect.TypeOf((*MockInterface)(nil).EgressCIDRs))
 }
 
+// EgressOnlyInternetAccess mocks base method.
+func (m *MockInterface) EgressOnlyInternetAccess() *bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressOnlyInternetAccess")
+	ret0, _ := ret[0].(*bool)
+	return ret0
+}
+
+// EgressOnlyInternetAccess indicates an expected call of EgressOnlyInternetAccess.
+func (mr *MockInterfaceMockRecorder) EgressOnlyInternetAccess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressOnlyInternetAccess", reflect.TypeOf((*MockInterface)(nil).EgressOnlyInternetAccess))
+}
+
 // Get mocks base method.
 func (m *MockInterface) Get(arg0 context.Context) (*v1alpha1.Infrastructure, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +51,10 @@ type Values struct {
 	PodCIDR *net.IPNet
 	// ServiceCIDR is the Shoot's service CIDR in the Shoot VPC
 	ServiceCIDR *net.IPNet
+	// NodeCIDRs are the Shoot's node CIDRs in the Shoot VPC, i.e., the primary node CIDR followed by additional ones.
+	NodeCIDRs []string
+	// PathMTU is the smallest MTU of the network paths between the seed and the Shoot's nodes.
+	PathMTU *int32
 }
 
 // New creates a new instance of DeployWaiter for a Network.
@@ -178,6 +182,8 @@ func (n *network) deploy(ctx context.Context, operation string) (extensionsv1alp
 			IPFamilies:  n.values.IPFamilies,
 			PodCIDR:     n.values.PodCIDR.String(),
 			ServiceCIDR: n.values.ServiceCIDR.String(),
+			NodeCIDRs:   n.values.NodeCIDRs,
+			PathMTU:     n.values.PathMTU,
 		}
 
 		return nil
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -159,6 +160,28 @@ var _ = Describe("#Network", func() {
 			})
 		})
 
+		Context("with node CIDRs", func() {
+			BeforeEach(func() {
+				values.NodeCIDRs = []string{"10.250.0.0/16", "10.251.0.0/16"}
+				expected.Spec.NodeCIDRs = []string{"10.250.0.0/16", "10.251.0.0/16"}
+			})
+
+			It("should create correct Network", func() {
+				testFunc()
+			})
+		})
+
+		Context("with path MTU", func() {
+			BeforeEach(func() {
+				values.PathMTU = ptr.To[int32](1400)
+				expected.Spec.PathMTU = ptr.To[int32](1400)
+			})
+
+			It("should create correct Network", func() {
+				testFunc()
+			})
+		})
+
 		Context("IPv6", func() {
 			BeforeEach(func() {
 				podCIDR := net.IPNet{
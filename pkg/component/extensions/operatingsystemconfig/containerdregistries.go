@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// SecretNameContainerdRegistries is the name of the secret in the shoot namespace in the seed which contains the
+	// rendered containerd registry hosts configurations.
+	SecretNameContainerdRegistries = "containerd-registries"
+
+	// PathContainerdHostsDirectory is the directory containing the containerd registry hosts configurations.
+	PathContainerdHostsDirectory = "/etc/containerd/certs.d"
+
+	dataKeyRegistryCA       = "ca.crt"
+	dataKeyRegistryUsername = "username"
+	dataKeyRegistryPassword = "password"
+	dataKeyRegistryToken    = "token"
+)
+
+// ContainerdRegistry contains the containerd hosts configuration for an upstream registry.
+type ContainerdRegistry struct {
+	// Upstream is the upstream registry host (and optional port).
+	Upstream string
+	// Server is the URL of the upstream registry.
+	Server string
+	// Hosts is the list of hosts which are tried in the given order before falling back to the server.
+	Hosts []ContainerdRegistryHost
+}
+
+// ContainerdRegistryHost contains the containerd configuration for a registry host.
+type ContainerdRegistryHost struct {
+	// URL is the URL of the registry host.
+	URL string
+	// Capabilities are the operations the host is capable of performing.
+	Capabilities []string
+	// SecretName is the name of a secret in the shoot namespace in the seed which contains the CA bundle and/or the
+	// credentials for the registry host.
+	SecretName *string
+}
+
+// reconcileContainerdRegistries renders the containerd registry hosts configurations into a secret in the shoot
+// namespace in the seed and computes the files referencing it. This way, credentials do not end up in the
+// OperatingSystemConfig resources.
+func (o *operatingSystemConfig) reconcileContainerdRegistries(ctx context.Context) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameContainerdRegistries, Namespace: o.values.Namespace}}
+
+	if len(o.values.ContainerdRegistries) == 0 {
+		o.containerdRegistryFiles = nil
+		return client.IgnoreNotFound(o.client.Delete(ctx, secret))
+	}
+
+	var (
+		data  = make(map[string][]byte)
+		files []extensionsv1alpha1.File
+	)
+
+	for _, registry := range o.values.ContainerdRegistries {
+		var (
+			directory = path.Join(PathContainerdHostsDirectory, registry.Upstream)
+			keyPrefix = strings.ReplaceAll(registry.Upstream, ":", "_")
+			hostsTOML strings.Builder
+		)
+
+		fmt.Fprintf(&hostsTOML, "server = %q\n", registry.Server)
+
+		for i, host := range registry.Hosts {
+			capabilities := make([]string, 0, len(host.Capabilities))
+			for _, capability := range host.Capabilities {
+				capabilities = append(capabilities, fmt.Sprintf("%q", capability))
+			}
+
+			fmt.Fprintf(&hostsTOML, "\n[host.%q]\n  capabilities = [%s]\n", host.URL, strings.Join(capabilities, ", "))
+
+			if host.SecretName == nil {
+				continue
+			}
+
+			hostSecret := &corev1.Secret{}
+			if err := o.client.Get(ctx, client.ObjectKey{Name: *host.SecretName, Namespace: o.values.Namespace}, hostSecret); err != nil {
+				return fmt.Errorf("failed reading secret %q for registry host %q: %w", *host.SecretName, host.URL, err)
+			}
+
+			if ca, ok := hostSecret.Data[dataKeyRegistryCA]; ok {
+				var (
+					caKey  = fmt.Sprintf("%s.%d.ca.crt", keyPrefix, i)
+					caPath = path.Join(directory, fmt.Sprintf("%d-ca.crt", i))
+				)
+
+				data[caKey] = ca
+				files = append(files, containerdRegistryFile(caPath, caKey))
+				fmt.Fprintf(&hostsTOML, "  ca = %q\n", caPath)
+			}
+
+			if authorization := registryAuthorizationHeader(hostSecret.Data); authorization != "" {
+				fmt.Fprintf(&hostsTOML, "  [host.%q.header]\n    Authorization = %q\n", host.URL, authorization)
+			}
+		}
+
+		hostsKey := keyPrefix + ".hosts.toml"
+		data[hostsKey] = []byte(hostsTOML.String())
+		files = append(files, containerdRegistryFile(path.Join(directory, "hosts.toml"), hostsKey))
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, o.client, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = data
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	o.containerdRegistryFiles = files
+	return nil
+}
+
+func registryAuthorizationHeader(data map[string][]byte) string {
+	if token, ok := data[dataKeyRegistryToken]; ok {
+		return "Bearer " + string(token)
+	}
+
+	username, hasUsername := data[dataKeyRegistryUsername]
+	password, hasPassword := data[dataKeyRegistryPassword]
+	if hasUsername && hasPassword {
+		return "Basic " + utils.EncodeBase64([]byte(string(username)+":"+string(password)))
+	}
+
+	return ""
+}
+
+func containerdRegistryFile(filePath, dataKey string) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        filePath,
+		Permissions: ptr.To[int32](0600),
+		Content: extensionsv1alpha1.FileContent{
+			SecretRef: &extensionsv1alpha1.FileContentSecretRef{
+				Name:    SecretNameContainerdRegistries,
+				DataKey: dataKey,
+			},
+		},
+	}
+}
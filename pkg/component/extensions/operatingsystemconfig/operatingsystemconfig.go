@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
@@ -103,8 +104,9 @@ type OriginalValues struct {
 	ClusterDNSAddress string
 	// ClusterDomain is the Kubernetes cluster domain.
 	ClusterDomain string
-	// Images is a map containing the necessary container images for the systemd units (hyperkube and pause-container).
-	Images map[string]*imagevectorutils.Image
+	// Images maps CPU architectures to the container images necessary for the systemd units (e.g., pause-container) of
+	// worker pools with the respective architecture. The hyperkube image is resolved per worker pool.
+	Images map[string]map[string]*imagevectorutils.Image
 	// KubeletConfig is the default kubelet configuration for all worker pools. Individual worker pools might overwrite
 	// this configuration.
 	KubeletConfig *gardencorev1beta1.KubeletConfig
@@ -122,6 +124,8 @@ type OriginalValues struct {
 	NodeLocalDNSEnabled bool
 	// PrimaryIPFamily represents the preferred IP family (IPv4 or IPv6) to be used.
 	PrimaryIPFamily gardencorev1beta1.IPFamily
+	// ContainerdRegistries is the list of containerd registry hosts configurations applied on all worker pools.
+	ContainerdRegistries []ContainerdRegistry
 }
 
 // New creates a new instance of Interface.
@@ -166,6 +170,8 @@ type operatingSystemConfig struct {
 	lock             sync.Mutex
 	workerNameToOSCs map[string]*OperatingSystemConfigs
 	oscs             map[string]*extensionsv1alpha1.OperatingSystemConfig
+
+	containerdRegistryFiles []extensionsv1alpha1.File
 }
 
 // OperatingSystemConfigs contains operating system configs for the init script as well as for the original config.
@@ -220,6 +226,10 @@ func (o *operatingSystemConfig) reconcile(ctx context.Context, reconcileFn func(
 		return err
 	}
 
+	if err := o.reconcileContainerdRegistries(ctx); err != nil {
+		return fmt.Errorf("failed reconciling containerd registries: %w", err)
+	}
+
 	fns := o.forEachWorkerPoolAndPurposeTaskFn(func(_ context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, worker gardencorev1beta1.Worker, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) error {
 		d, err := o.newDeployer(osc, worker, purpose)
 		if err != nil {
@@ -493,27 +503,36 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		return deployer{}, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAKubelet)
 	}
 
-	kubeletConfigParameters := components.KubeletConfigParametersFromCoreV1beta1KubeletConfig(o.values.KubeletConfig)
-	kubeletCLIFlags := components.KubeletCLIFlagsFromCoreV1beta1KubeletConfig(o.values.KubeletConfig)
+	kubeletConfig := o.values.KubeletConfig
 	if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
-		kubeletConfigParameters = components.KubeletConfigParametersFromCoreV1beta1KubeletConfig(worker.Kubernetes.Kubelet)
-		kubeletCLIFlags = components.KubeletCLIFlagsFromCoreV1beta1KubeletConfig(worker.Kubernetes.Kubelet)
+		kubeletConfig = worker.Kubernetes.Kubelet
 	}
+	kubeletConfigParameters := components.KubeletConfigParametersFromCoreV1beta1KubeletConfig(kubeletConfig)
+	kubeletCLIFlags := components.KubeletCLIFlagsFromCoreV1beta1KubeletConfig(kubeletConfig)
 	setDefaultEvictionMemoryAvailable(kubeletConfigParameters.EvictionHard, kubeletConfigParameters.EvictionSoft, o.values.MachineTypes, worker.Machine.Type)
+	if kubeletConfig != nil && ptr.Deref(kubeletConfig.ReservationPolicy, gardencorev1beta1.KubeletReservationPolicyStatic) == gardencorev1beta1.KubeletReservationPolicyAuto {
+		kubeletConfigParameters.KubeReserved = setAutomaticKubeReserved(kubeletConfigParameters.KubeReserved, o.values.MachineTypes, worker.Machine.Type)
+	}
 
 	kubernetesVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(o.values.KubernetesVersion, worker.Kubernetes)
 	if err != nil {
 		return deployer{}, err
 	}
 
-	images := make(map[string]*imagevectorutils.Image, len(o.values.Images))
-	for imageName, image := range o.values.Images {
+	architecture := v1beta1helper.WorkerArchitecture(worker)
+	architectureImages, ok := o.values.Images[architecture]
+	if !ok {
+		return deployer{}, fmt.Errorf("no images found for architecture %q of worker pool %q", architecture, worker.Name)
+	}
+
+	images := make(map[string]*imagevectorutils.Image, len(architectureImages)+1)
+	for imageName, image := range architectureImages {
 		images[imageName] = image
 	}
 
-	images[imagevector.ImageNameHyperkube], err = imagevector.ImageVector().FindImage(imagevector.ImageNameHyperkube, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
+	images[imagevector.ImageNameHyperkube], err = imagevector.ImageVector().FindImage(imagevector.ImageNameHyperkube, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()), imagevectorutils.Architecture(architecture))
 	if err != nil {
-		return deployer{}, fmt.Errorf("failed finding hyperkube image for version %s: %w", kubernetesVersion.String(), err)
+		return deployer{}, fmt.Errorf("failed finding hyperkube image for version %s and architecture %s: %w", kubernetesVersion.String(), architecture, err)
 	}
 
 	return deployer{
@@ -542,6 +561,7 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
 		primaryIPFamily:         o.values.PrimaryIPFamily,
 		taints:                  worker.Taints,
+		containerdRegistryFiles: o.containerdRegistryFiles,
 	}, nil
 }
 
@@ -575,6 +595,33 @@ func setDefaultEvictionMemoryAvailable(evictionHard, evictionSoft map[string]str
 	}
 }
 
+// setAutomaticKubeReserved sets the resources reserved for kubernetes node components which are not explicitly
+// configured to the values derived from the given machine type.
+func setAutomaticKubeReserved(kubeReserved map[string]string, machineTypes []gardencorev1beta1.MachineType, machineType string) map[string]string {
+	machine := v1beta1helper.FindMachineTypeByName(machineTypes, machineType)
+	if machine == nil {
+		return kubeReserved
+	}
+
+	if kubeReserved == nil {
+		kubeReserved = make(map[string]string)
+	}
+
+	reserved := v1beta1helper.KubeReservedForMachineType(*machine)
+	for name, quantity := range map[string]*resource.Quantity{
+		"cpu":               reserved.CPU,
+		"memory":            reserved.Memory,
+		"ephemeral-storage": reserved.EphemeralStorage,
+		"pid":               reserved.PID,
+	} {
+		if quantity != nil && kubeReserved[name] == "" {
+			kubeReserved[name] = quantity.String()
+		}
+	}
+
+	return kubeReserved
+}
+
 type deployer struct {
 	client client.Client
 	osc    *extensionsv1alpha1.OperatingSystemConfig
@@ -606,6 +653,7 @@ type deployer struct {
 	nodeLocalDNSEnabled     bool
 	primaryIPFamily         gardencorev1beta1.IPFamily
 	taints                  []corev1.Taint
+	containerdRegistryFiles []extensionsv1alpha1.File
 }
 
 // exposed for testing
@@ -675,6 +723,10 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			return nil, err
 		}
 
+		if d.criName == extensionsv1alpha1.CRINameContainerD {
+			files = append(files, d.containerdRegistryFiles...)
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown purpose: %q", d.purpose)
 	}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -19,6 +20,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -306,7 +308,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 					CABundle:          caBundle,
 					ClusterDNSAddress: clusterDNSAddress,
 					ClusterDomain:     clusterDomain,
-					Images:            images,
+					Images:            map[string]map[string]*imagevector.Image{"amd64": images},
 					KubeletConfig:     kubeletConfig,
 					MachineTypes:      machineTypes,
 					SSHPublicKeys:     sshPublicKeys,
@@ -396,6 +398,148 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should calculate the kube reserved resources for worker pools with the auto reservation policy", func() {
+				var (
+					lock         sync.Mutex
+					kubeReserved = make(map[string]map[string]string)
+				)
+
+				workersWithMachineTypes := make([]gardencorev1beta1.Worker, 0, len(workers))
+				for _, worker := range workers {
+					worker.Machine.Type = "m1"
+					workersWithMachineTypes = append(workersWithMachineTypes, worker)
+				}
+				workersWithMachineTypes[1].Kubernetes = &gardencorev1beta1.WorkerKubernetes{
+					Version: &workerKubernetesVersion,
+					Kubelet: &gardencorev1beta1.KubeletConfig{ReservationPolicy: ptr.To(gardencorev1beta1.KubeletReservationPolicyStatic)},
+				}
+
+				DeferCleanup(test.WithVars(
+					&OriginalConfigFn, func(cctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						lock.Lock()
+						defer lock.Unlock()
+						kubeReserved[cctx.Key] = cctx.KubeletConfigParameters.KubeReserved
+						return nil, nil, nil
+					},
+					&values.Workers, workersWithMachineTypes,
+					&values.MachineTypes, []gardencorev1beta1.MachineType{{
+						Name:         "m1",
+						CPU:          resource.MustParse("4"),
+						Memory:       resource.MustParse("8Gi"),
+						KubeReserved: &gardencorev1beta1.KubeletConfigReserved{PID: ptr.To(resource.MustParse("10k"))},
+					}},
+					&values.KubeletConfig, &gardencorev1beta1.KubeletConfig{
+						ReservationPolicy: ptr.To(gardencorev1beta1.KubeletReservationPolicyAuto),
+						KubeReserved:      &gardencorev1beta1.KubeletConfigReserved{Memory: ptr.To(resource.MustParse("1Gi"))},
+					},
+				))
+
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+
+				Expect(kubeReserved).To(Equal(map[string]map[string]string{
+					Key(worker1Name, kubernetesVersion, nil): {
+						"cpu":    "80m",
+						"memory": "1Gi",
+						"pid":    "10k",
+					},
+					Key(worker2Name, semver.MustParse(workerKubernetesVersion), workers[1].CRI): nil,
+				}))
+			})
+
+			It("should render the containerd registries into a secret and reference it in the original configs", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+					&values.ContainerdRegistries, []ContainerdRegistry{
+						{
+							Upstream: "docker.io",
+							Server:   "https://registry-1.docker.io",
+							Hosts: []ContainerdRegistryHost{{
+								URL:          "https://mirror.example.com",
+								Capabilities: []string{"pull", "resolve"},
+								SecretName:   ptr.To("ref-mirror"),
+							}},
+						},
+						{
+							Upstream: "registry.example.com:5000",
+							Server:   "https://registry.example.com:5000",
+							Hosts:    []ContainerdRegistryHost{{URL: "http://10.0.0.1:5000", Capabilities: []string{"pull"}}},
+						},
+					},
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ref-mirror", Namespace: namespace},
+					Data: map[string][]byte{
+						"ca.crt":   []byte("mirror-ca"),
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				})).To(Succeed())
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "containerd-registries", Namespace: namespace}, secret)).To(Succeed())
+				Expect(secret.Data).To(Equal(map[string][]byte{
+					"docker.io.0.ca.crt": []byte("mirror-ca"),
+					"docker.io.hosts.toml": []byte(`server = "https://registry-1.docker.io"
+
+[host."https://mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+  ca = "/etc/containerd/certs.d/docker.io/0-ca.crt"
+  [host."https://mirror.example.com".header]
+    Authorization = "Basic dXNlcjpwYXNz"
+`),
+					"registry.example.com_5000.hosts.toml": []byte(`server = "https://registry.example.com:5000"
+
+[host."http://10.0.0.1:5000"]
+  capabilities = ["pull"]
+`),
+				}))
+
+				registryFile := func(path, dataKey string) extensionsv1alpha1.File {
+					return extensionsv1alpha1.File{
+						Path:        path,
+						Permissions: ptr.To[int32](0600),
+						Content:     extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: "containerd-registries", DataKey: dataKey}},
+					}
+				}
+
+				for _, e := range expected {
+					if e.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+						e.Spec.Files = append(e.Spec.Files,
+							registryFile("/etc/containerd/certs.d/docker.io/0-ca.crt", "docker.io.0.ca.crt"),
+							registryFile("/etc/containerd/certs.d/docker.io/hosts.toml", "docker.io.hosts.toml"),
+							registryFile("/etc/containerd/certs.d/registry.example.com:5000/hosts.toml", "registry.example.com_5000.hosts.toml"),
+						)
+					}
+
+					actual := &extensionsv1alpha1.OperatingSystemConfig{}
+					Expect(c.Get(ctx, client.ObjectKey{Name: e.Name, Namespace: e.Namespace}, actual)).To(Succeed())
+
+					obj := e.DeepCopy()
+					obj.ResourceVersion = "1"
+
+					Expect(actual).To(Equal(obj))
+				}
+			})
+
+			It("should delete the containerd registries secret if no registries are configured", func() {
+				DeferCleanup(test.WithVars(
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "containerd-registries", Namespace: namespace}})).To(Succeed())
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKey{Name: "containerd-registries", Namespace: namespace}, &corev1.Secret{})).To(BeNotFoundError())
+			})
+
 			It("should exclude the bootstrap token file if purpose is not provision", func() {
 				bootstrapTokenFile := extensionsv1alpha1.File{Path: "/var/lib/gardener-node-agent/credentials/bootstrap-token"}
 				initConfigFnWithBootstrapToken := func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
@@ -428,6 +572,51 @@ var _ = Describe("OperatingSystemConfig", func() {
 					Expect(actual).To(Equal(obj))
 				}
 			})
+			Context("worker pools with different architectures", func() {
+				BeforeEach(func() {
+					values.Workers = []gardencorev1beta1.Worker{*workers[0].DeepCopy(), *workers[1].DeepCopy()}
+					values.Workers[1].Machine.Architecture = ptr.To(v1beta1constants.ArchitectureARM64)
+				})
+
+				It("should use the images of the worker pools' architectures", func() {
+					defer test.WithVars(
+						&TimeNow, mockNow.Do,
+						&InitConfigFn, initConfigFn,
+						&OriginalConfigFn, originalConfigFn,
+					)()
+					mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+					values.Images = map[string]map[string]*imagevector.Image{
+						"amd64": {"gardener-node-agent": {Repository: "node-agent-amd64", Tag: ptr.To("v1")}},
+						"arm64": {"gardener-node-agent": {Repository: "node-agent-arm64", Tag: ptr.To("v1")}},
+					}
+
+					Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+
+					for _, worker := range values.Workers {
+						k8sVersion := values.KubernetesVersion
+						if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+							k8sVersion = semver.MustParse(*worker.Kubernetes.Version)
+						}
+
+						osc := &extensionsv1alpha1.OperatingSystemConfig{}
+						Expect(c.Get(ctx, client.ObjectKey{Name: Key(worker.Name, k8sVersion, worker.CRI) + "-" + worker.Machine.Image.Name + "-init", Namespace: namespace}, osc)).To(Succeed())
+						Expect(osc.Spec.Units).To(ContainElement(extensionsv1alpha1.Unit{
+							Name:    "gardener-node-init.service",
+							Content: ptr.To("node-agent-" + *worker.Machine.Architecture + ":v1"),
+						}))
+					}
+				})
+
+				It("should fail if there are no images for the architecture of a worker pool", func() {
+					defer test.WithVars(
+						&TimeNow, mockNow.Do,
+					)()
+					mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+					Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(MatchError(ContainSubstring(`no images found for architecture "arm64" of worker pool "worker2"`)))
+				})
+			})
 		})
 
 		Describe("#Restore", func() {
@@ -501,6 +690,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 					},
 					Type: corev1.SecretTypeOpaque,
 				})
+				mc.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "containerd-registries", Namespace: namespace}}).Return(apierrors.NewNotFound(schema.GroupResource{}, ""))
 
 				for i := range expected {
 					var state []byte
@@ -13,12 +13,20 @@ import (
 )
 
 // ConfigurableKubeletCLIFlags is the set of configurable kubelet command line parameters.
-type ConfigurableKubeletCLIFlags struct{}
+type ConfigurableKubeletCLIFlags struct {
+	CredentialProviders []gardencorev1beta1.KubeletCredentialProvider
+}
 
 // KubeletCLIFlagsFromCoreV1beta1KubeletConfig computes the ConfigurableKubeletCLIFlags based on the provided
 // gardencorev1beta1.KubeletConfig.
-func KubeletCLIFlagsFromCoreV1beta1KubeletConfig(_ *gardencorev1beta1.KubeletConfig) ConfigurableKubeletCLIFlags {
-	return ConfigurableKubeletCLIFlags{}
+func KubeletCLIFlagsFromCoreV1beta1KubeletConfig(kubeletConfig *gardencorev1beta1.KubeletConfig) ConfigurableKubeletCLIFlags {
+	var out ConfigurableKubeletCLIFlags
+
+	if kubeletConfig != nil {
+		out.CredentialProviders = kubeletConfig.CredentialProviders
+	}
+
+	return out
 }
 
 // ConfigurableKubeletConfigParameters is the set of configurable kubelet config parameters.
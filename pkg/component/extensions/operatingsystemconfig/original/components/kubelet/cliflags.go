@@ -42,6 +42,13 @@ func CLIFlags(kubernetesVersion *semver.Version, nodeLabels map[string]string, c
 		}
 	}
 
+	if len(cliFlags.CredentialProviders) > 0 {
+		flags = append(flags,
+			"--image-credential-provider-config="+PathCredentialProviderConfig,
+			"--image-credential-provider-bin-dir="+PathCredentialProviderBinaryDirectory,
+		)
+	}
+
 	flags = append(flags, "--v=2")
 	// This is needed to prefer the ipv6 address over the ipv4 address in case the node has two addresses.
 	// It's important for ipv6-only services with pods in the host network and for vpn, so that the ipv6 address of a node is used.
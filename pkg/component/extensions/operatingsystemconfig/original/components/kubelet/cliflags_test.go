@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
@@ -98,5 +99,28 @@ var _ = Describe("CLIFlags", func() {
 				"--node-ip=\"::\"",
 			),
 		),
+		Entry(
+			"kubernetes 1.27 w/ containerd w/ credential providers",
+			"1.27.0",
+			extensionsv1alpha1.CRINameContainerD,
+			components.ConfigurableKubeletCLIFlags{CredentialProviders: []gardencorev1beta1.KubeletCredentialProvider{{Name: "foo"}}},
+			false,
+			ConsistOf(
+				"--bootstrap-kubeconfig=/var/lib/kubelet/kubeconfig-bootstrap",
+				"--config=/var/lib/kubelet/config/kubelet",
+				"--kubeconfig=/var/lib/kubelet/kubeconfig-real",
+				"--node-labels=worker.gardener.cloud/kubernetes-version=1.27.0",
+				"--node-labels=containerruntime.worker.gardener.cloud/gvisor=true",
+				"--node-labels=kubernetes.io/arch=amd64",
+				"--node-labels=test=foo",
+				"--node-labels=test2=bar",
+				"--node-labels=worker.gardener.cloud/pool=worker",
+				"--container-runtime-endpoint=unix:///run/containerd/containerd.sock",
+				"--runtime-cgroups=/system.slice/containerd.service",
+				"--image-credential-provider-config=/var/lib/kubelet/credential-provider-config.yaml",
+				"--image-credential-provider-bin-dir=/opt/bin/kubelet-credential-providers",
+				"--v=2",
+			),
+		),
 	)
 })
@@ -88,6 +88,12 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		},
 	}
 
+	credentialProviderFiles, err := getCredentialProviderFiles(ctx.KubeletCLIFlags.CredentialProviders)
+	if err != nil {
+		return nil, nil, err
+	}
+	kubeletFiles = append(kubeletFiles, credentialProviderFiles...)
+
 	cliFlags := CLIFlags(ctx.KubernetesVersion, ctx.NodeLabels, ctx.CRIName, ctx.KubeletCLIFlags, ctx.PreferIPv6)
 
 	kubeletUnit := extensionsv1alpha1.Unit{
@@ -6,12 +6,15 @@ package kubelet_test
 
 import (
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
@@ -87,6 +90,69 @@ var _ = Describe("Component", func() {
 			true,
 		),
 	)
+
+	It("should add the files for the credential providers", func() {
+		ctx.CRIName = extensionsv1alpha1.CRINameContainerD
+		ctx.KubernetesVersion = semver.MustParse("1.29.1")
+		ctx.Images = map[string]*imagevector.Image{
+			"hyperkube": {Name: "hyperkube", Repository: hyperkubeImageRepo, Tag: ptr.To(hyperkubeImageTag)},
+		}
+		ctx.KubeletCLIFlags = components.ConfigurableKubeletCLIFlags{CredentialProviders: []gardencorev1beta1.KubeletCredentialProvider{{
+			Name:                 "ecr-credential-provider",
+			Image:                "registry.example.com/ecr-credential-provider:v1.0.0",
+			FilePathInImage:      ptr.To("/bin/ecr-credential-provider"),
+			MatchImages:          []string{"*.dkr.ecr.*.amazonaws.com"},
+			DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+			APIVersion:           ptr.To("credentialprovider.kubelet.k8s.io/v1"),
+			Args:                 []string{"get-credentials"},
+			Env:                  []gardencorev1beta1.KubeletCredentialProviderEnvVar{{Name: "AWS_PROFILE", Value: "default"}},
+		}}}
+
+		units, files, err := component.Config(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(files).To(ContainElements(
+			extensionsv1alpha1.File{
+				Path:        "/var/lib/kubelet/credential-provider-config.yaml",
+				Permissions: ptr.To[int32](0644),
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Encoding: "b64",
+						Data: utils.EncodeBase64([]byte(`apiVersion: kubelet.config.k8s.io/v1
+kind: CredentialProviderConfig
+providers:
+- apiVersion: credentialprovider.kubelet.k8s.io/v1
+  args:
+  - get-credentials
+  defaultCacheDuration: 1m0s
+  env:
+  - name: AWS_PROFILE
+    value: default
+  matchImages:
+  - '*.dkr.ecr.*.amazonaws.com'
+  name: ecr-credential-provider
+`)),
+					},
+				},
+			},
+			extensionsv1alpha1.File{
+				Path:        "/opt/bin/kubelet-credential-providers/ecr-credential-provider",
+				Permissions: ptr.To[int32](0755),
+				Content: extensionsv1alpha1.FileContent{
+					ImageRef: &extensionsv1alpha1.FileContentImageRef{
+						Image:           "registry.example.com/ecr-credential-provider:v1.0.0",
+						FilePathInImage: "/bin/ecr-credential-provider",
+					},
+				},
+			},
+		))
+		Expect(units).To(HaveLen(1))
+		Expect(units[0].FilePaths).To(ContainElements(
+			"/var/lib/kubelet/credential-provider-config.yaml",
+			"/opt/bin/kubelet-credential-providers/ecr-credential-provider",
+		))
+		Expect(*units[0].Content).To(ContainSubstring("--image-credential-provider-bin-dir=/opt/bin/kubelet-credential-providers"))
+	})
 })
 
 const (
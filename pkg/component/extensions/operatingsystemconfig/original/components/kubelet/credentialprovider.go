@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeletconfigv1 "k8s.io/kubelet/config/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// PathCredentialProviderConfig is the path for the kubelet's image credential provider config file.
+	PathCredentialProviderConfig = PathKubeletDirectory + "/credential-provider-config.yaml"
+	// PathCredentialProviderBinaryDirectory is the path for the directory containing the kubelet's image credential
+	// provider executables.
+	PathCredentialProviderBinaryDirectory = v1beta1constants.OperatingSystemConfigFilePathBinaries + "/kubelet-credential-providers"
+)
+
+// getCredentialProviderFiles returns the files for the kubelet's image credential provider config and the provider
+// executables. The executables are extracted from the configured images by gardener-node-agent.
+func getCredentialProviderFiles(providers []gardencorev1beta1.KubeletCredentialProvider) ([]extensionsv1alpha1.File, error) {
+	if len(providers) == 0 {
+		return nil, nil
+	}
+
+	config := &kubeletconfigv1.CredentialProviderConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubeletconfigv1.SchemeGroupVersion.String(),
+			Kind:       "CredentialProviderConfig",
+		},
+	}
+
+	var files []extensionsv1alpha1.File
+
+	for _, provider := range providers {
+		credentialProvider := kubeletconfigv1.CredentialProvider{
+			Name:                 provider.Name,
+			MatchImages:          provider.MatchImages,
+			DefaultCacheDuration: ptr.To(ptr.Deref(provider.DefaultCacheDuration, metav1.Duration{})),
+			APIVersion:           ptr.Deref(provider.APIVersion, ""),
+			Args:                 provider.Args,
+		}
+		for _, env := range provider.Env {
+			credentialProvider.Env = append(credentialProvider.Env, kubeletconfigv1.ExecEnvVar{Name: env.Name, Value: env.Value})
+		}
+		config.Providers = append(config.Providers, credentialProvider)
+
+		files = append(files, extensionsv1alpha1.File{
+			Path:        PathCredentialProviderBinaryDirectory + "/" + provider.Name,
+			Permissions: ptr.To[int32](0755),
+			Content: extensionsv1alpha1.FileContent{
+				ImageRef: &extensionsv1alpha1.FileContentImageRef{
+					Image:           provider.Image,
+					FilePathInImage: ptr.Deref(provider.FilePathInImage, "/"+provider.Name),
+				},
+			},
+		})
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling credential provider config: %w", err)
+	}
+
+	return append([]extensionsv1alpha1.File{{
+		Path:        PathCredentialProviderConfig,
+		Permissions: ptr.To[int32](0644),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     utils.EncodeBase64(data),
+			},
+		},
+	}}, files...), nil
+}
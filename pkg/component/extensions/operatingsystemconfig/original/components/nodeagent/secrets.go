@@ -15,6 +15,7 @@ import (
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -69,13 +70,23 @@ func OperatingSystemConfigSecret(
 		return nil, fmt.Errorf("failed encoding OperatingSystemConfig: %w", err)
 	}
 
+	annotations := map[string]string{
+		nodeagentv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: utils.ComputeSHA256Hex(operatingSystemConfigRaw),
+	}
+
+	kubeletConfigChecksum, err := kubeletConfigChecksum(operatingSystemConfig)
+	if err != nil {
+		return nil, err
+	}
+	if kubeletConfigChecksum != "" {
+		annotations[nodeagentv1alpha1.AnnotationKeyChecksumDesiredKubeletConfig] = kubeletConfigChecksum
+	}
+
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      secretName,
-			Namespace: metav1.NamespaceSystem,
-			Annotations: map[string]string{
-				nodeagentv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: utils.ComputeSHA256Hex(operatingSystemConfigRaw),
-			},
+			Name:        secretName,
+			Namespace:   metav1.NamespaceSystem,
+			Annotations: annotations,
 			Labels: map[string]string{
 				v1beta1constants.GardenRole:      v1beta1constants.GardenRoleOperatingSystemConfig,
 				v1beta1constants.LabelWorkerPool: workerPoolName,
@@ -84,3 +95,24 @@ func OperatingSystemConfigSecret(
 		Data: map[string][]byte{nodeagentv1alpha1.DataKeyOperatingSystemConfig: operatingSystemConfigRaw},
 	}, nil
 }
+
+// kubeletConfigChecksum computes the checksum of the kubelet configuration file contained in the given
+// OperatingSystemConfig. It returns an empty string if the OperatingSystemConfig does not contain such a file.
+func kubeletConfigChecksum(osc *extensionsv1alpha1.OperatingSystemConfig) (string, error) {
+	for _, files := range [][]extensionsv1alpha1.File{osc.Spec.Files, osc.Status.ExtensionFiles} {
+		for _, file := range files {
+			if file.Path != v1beta1constants.OperatingSystemConfigFilePathKubeletConfig || file.Content.Inline == nil {
+				continue
+			}
+
+			data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+			if err != nil {
+				return "", fmt.Errorf("failed decoding content of kubelet configuration file: %w", err)
+			}
+
+			return utils.ComputeSHA256Hex(data), nil
+		}
+	}
+
+	return "", nil
+}
@@ -132,6 +132,22 @@ status:
 			}))
 		})
 
+		It("should annotate the secret with the checksum of the kubelet configuration", func() {
+			osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
+				Path: "/var/lib/kubelet/config/kubelet",
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Encoding: "b64",
+						Data:     utils.EncodeBase64([]byte("kubelet-config")),
+					},
+				},
+			})
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Annotations).To(HaveKeyWithValue("checksum/desired-kubelet-config", utils.ComputeSHA256Hex([]byte("kubelet-config"))))
+		})
+
 		It("should return an error because a referenced secret cannot be found", func() {
 			osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
 				Path: "/non/existing/path",
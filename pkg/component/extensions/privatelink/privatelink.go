@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package privatelink
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+)
+
+const (
+	// DefaultInterval is the default interval for retry operations.
+	DefaultInterval = 5 * time.Second
+	// DefaultSevereThreshold is the default threshold until an error reported by another component is treated as 'severe'.
+	DefaultSevereThreshold = time.Minute
+	// DefaultTimeout is the default timeout and defines how long Gardener should wait
+	// for a successful reconciliation of a private link resource.
+	DefaultTimeout = 5 * time.Minute
+)
+
+// TimeNow returns the current time. Exposed for testing.
+var TimeNow = time.Now
+
+// Interface is an interface for managing PrivateLinks.
+type Interface interface {
+	component.DeployMigrateWaiter
+	// Get retrieves and returns the PrivateLink resource based on the configured values.
+	Get(context.Context) (*extensionsv1alpha1.PrivateLink, error)
+	// Address returns the address of the private endpoint reported by the provider.
+	Address() *string
+}
+
+// Values contains the values used to create a PrivateLink resource.
+type Values struct {
+	// Namespace is the Shoot namespace in the seed.
+	Namespace string
+	// Name is the name of the PrivateLink resource. Commonly the Shoot's name.
+	Name string
+	// Type is the type of the private link provider.
+	Type string
+	// ProviderConfig contains the provider config for the PrivateLink provider.
+	ProviderConfig *runtime.RawExtension
+	// Region is the region of the shoot.
+	Region string
+}
+
+// New creates a new instance of Interface.
+func New(
+	log logr.Logger,
+	client client.Client,
+	values *Values,
+	waitInterval time.Duration,
+	waitSevereThreshold time.Duration,
+	waitTimeout time.Duration,
+) Interface {
+	return &privateLink{
+		log:                 log,
+		client:              client,
+		values:              values,
+		waitInterval:        waitInterval,
+		waitSevereThreshold: waitSevereThreshold,
+		waitTimeout:         waitTimeout,
+
+		privateLink: &extensionsv1alpha1.PrivateLink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      values.Name,
+				Namespace: values.Namespace,
+			},
+		},
+	}
+}
+
+type privateLink struct {
+	values              *Values
+	log                 logr.Logger
+	client              client.Client
+	waitInterval        time.Duration
+	waitSevereThreshold time.Duration
+	waitTimeout         time.Duration
+
+	privateLink *extensionsv1alpha1.PrivateLink
+	address     *string
+}
+
+// Deploy uses the seed client to create or update the PrivateLink resource.
+func (p *privateLink) Deploy(ctx context.Context) error {
+	_, err := p.deploy(ctx, v1beta1constants.GardenerOperationReconcile)
+	return err
+}
+
+func (p *privateLink) deploy(ctx context.Context, operation string) (extensionsv1alpha1.Object, error) {
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, p.client, p.privateLink, func() error {
+		metav1.SetMetaDataAnnotation(&p.privateLink.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+		metav1.SetMetaDataAnnotation(&p.privateLink.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+
+		p.privateLink.Spec = extensionsv1alpha1.PrivateLinkSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type:           p.values.Type,
+				ProviderConfig: p.values.ProviderConfig,
+			},
+			Region: p.values.Region,
+			SecretRef: corev1.SecretReference{
+				Name:      v1beta1constants.SecretNameCloudProvider,
+				Namespace: p.privateLink.Namespace,
+			},
+		}
+		return nil
+	})
+
+	return p.privateLink, err
+}
+
+// Restore uses the seed client and the ShootState to create the PrivateLink resource and restore its state.
+func (p *privateLink) Restore(ctx context.Context, shootState *gardencorev1beta1.ShootState) error {
+	return extensions.RestoreExtensionWithDeployFunction(
+		ctx,
+		p.client,
+		shootState,
+		extensionsv1alpha1.PrivateLinkResource,
+		p.deploy,
+	)
+}
+
+// Migrate migrates the PrivateLink resource.
+func (p *privateLink) Migrate(ctx context.Context) error {
+	return extensions.MigrateExtensionObject(
+		ctx,
+		p.client,
+		p.privateLink,
+	)
+}
+
+// Destroy deletes the PrivateLink resource.
+func (p *privateLink) Destroy(ctx context.Context) error {
+	return extensions.DeleteExtensionObject(
+		ctx,
+		p.client,
+		p.privateLink,
+	)
+}
+
+// Wait waits until the PrivateLink resource is ready.
+func (p *privateLink) Wait(ctx context.Context) error {
+	return extensions.WaitUntilExtensionObjectReady(
+		ctx,
+		p.client,
+		p.log,
+		p.privateLink,
+		extensionsv1alpha1.PrivateLinkResource,
+		p.waitInterval,
+		p.waitSevereThreshold,
+		p.waitTimeout,
+		func() error {
+			p.address = p.privateLink.Status.Address
+			return nil
+		})
+}
+
+// WaitMigrate waits until the PrivateLink resource is migrated successfully.
+func (p *privateLink) WaitMigrate(ctx context.Context) error {
+	return extensions.WaitUntilExtensionObjectMigrated(
+		ctx,
+		p.client,
+		p.privateLink,
+		extensionsv1alpha1.PrivateLinkResource,
+		p.waitInterval,
+		p.waitTimeout,
+	)
+}
+
+// WaitCleanup waits until the PrivateLink resource is deleted.
+func (p *privateLink) WaitCleanup(ctx context.Context) error {
+	return extensions.WaitUntilExtensionObjectDeleted(
+		ctx,
+		p.client,
+		p.log,
+		p.privateLink,
+		extensionsv1alpha1.PrivateLinkResource,
+		p.waitInterval,
+		p.waitTimeout,
+	)
+}
+
+// Get retrieves and returns the PrivateLink resource based on the configured values.
+func (p *privateLink) Get(ctx context.Context) (*extensionsv1alpha1.PrivateLink, error) {
+	if err := p.client.Get(ctx, client.ObjectKeyFromObject(p.privateLink), p.privateLink); err != nil {
+		return nil, err
+	}
+
+	p.address = p.privateLink.Status.Address
+	return p.privateLink, nil
+}
+
+// Address returns the address of the private endpoint reported by the provider.
+func (p *privateLink) Address() *string {
+	return p.address
+}
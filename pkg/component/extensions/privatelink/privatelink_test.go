@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package privatelink_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/privatelink"
+	"github.com/gardener/gardener/pkg/extensions"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	mocktime "github.com/gardener/gardener/third_party/mock/go/time"
+)
+
+var _ = Describe("#PrivateLink", func() {
+	const (
+		namespace    = "test-namespace"
+		name         = "test-deploy"
+		providerType = "foo"
+		region       = "europe"
+	)
+
+	var (
+		ctrl *gomock.Controller
+
+		ctx             context.Context
+		c               client.Client
+		expected, empty *extensionsv1alpha1.PrivateLink
+		values          *privatelink.Values
+		log             logr.Logger
+		deployWaiter    privatelink.Interface
+
+		mockNow *mocktime.MockNow
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockNow = mocktime.NewMockNow(ctrl)
+		now = time.Now()
+
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		s := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(s)).To(Succeed())
+
+		c = fake.NewClientBuilder().WithScheme(s).Build()
+
+		values = &privatelink.Values{
+			Name:           name,
+			Namespace:      namespace,
+			Type:           providerType,
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+			Region:         region,
+		}
+
+		empty = &extensionsv1alpha1.PrivateLink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		expected = &extensionsv1alpha1.PrivateLink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile,
+					v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+				},
+			},
+			Spec: extensionsv1alpha1.PrivateLinkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           providerType,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				},
+				Region: region,
+				SecretRef: corev1.SecretReference{
+					Name:      "cloudprovider",
+					Namespace: namespace,
+				},
+			},
+		}
+
+		deployWaiter = privatelink.New(log, c, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Deploy", func() {
+		It("should create the correct PrivateLink", func() {
+			defer test.WithVars(
+				&privatelink.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			actual := &extensionsv1alpha1.PrivateLink{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, actual)).To(Succeed())
+			Expect(actual).To(DeepDerivativeEqual(expected))
+		})
+	})
+
+	Describe("#Wait", func() {
+		It("should return error when it's not found", func() {
+			Expect(deployWaiter.Wait(ctx)).To(HaveOccurred())
+		})
+
+		It("should return error when it's not ready", func() {
+			expected.Status.LastError = &gardencorev1beta1.LastError{
+				Description: "Some error",
+			}
+
+			Expect(c.Create(ctx, expected)).To(Succeed(), "creating private link succeeds")
+			Expect(deployWaiter.Wait(ctx)).To(HaveOccurred(), "private link indicates error")
+		})
+
+		It("should return no error and extract the address when it's ready", func() {
+			defer test.WithVars(
+				&privatelink.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			By("Deploy")
+			Expect(deployWaiter.Deploy(ctx)).To(Succeed())
+
+			By("Patch object")
+			patch := client.MergeFrom(expected.DeepCopy())
+			expected.Status.LastError = nil
+			expected.ObjectMeta.Annotations = map[string]string{
+				v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+			}
+			expected.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State:          gardencorev1beta1.LastOperationStateSucceeded,
+				LastUpdateTime: metav1.Time{Time: now.UTC().Add(time.Second)},
+			}
+			expected.Status.Address = ptr.To("10.250.0.42")
+			Expect(c.Patch(ctx, expected, patch)).To(Succeed(), "patching private link succeeds")
+
+			By("Wait")
+			Expect(deployWaiter.Wait(ctx)).To(Succeed(), "private link is ready")
+			Expect(deployWaiter.Address()).To(Equal(ptr.To("10.250.0.42")))
+		})
+	})
+
+	Describe("#Get", func() {
+		It("should return an error when it's not found", func() {
+			_, err := deployWaiter.Get(ctx)
+			Expect(err).To(BeNotFoundError())
+		})
+
+		It("should return the PrivateLink and extract the address", func() {
+			expected.Status.Address = ptr.To("10.250.0.42")
+			Expect(c.Create(ctx, expected)).To(Succeed())
+
+			actual, err := deployWaiter.Get(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.Spec).To(Equal(expected.Spec))
+			Expect(deployWaiter.Address()).To(Equal(ptr.To("10.250.0.42")))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should not return error when it's not found", func() {
+			Expect(deployWaiter.Destroy(ctx)).To(Succeed())
+		})
+
+		It("should not return error when it's deleted successfully", func() {
+			Expect(c.Create(ctx, expected)).To(Succeed(), "adding pre-existing private link succeeds")
+
+			Expect(deployWaiter.Destroy(ctx)).To(Succeed())
+		})
+
+		It("should return error when it's not deleted successfully", func() {
+			defer test.WithVars(
+				&extensions.TimeNow, mockNow.Do,
+				&gardenerutils.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			expected := extensionsv1alpha1.PrivateLink{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Annotations: map[string]string{
+						v1beta1constants.ConfirmationDeletion: "true",
+						v1beta1constants.GardenerTimestamp:    now.UTC().Format(time.RFC3339Nano),
+					},
+				}}
+
+			mc := mockclient.NewMockClient(ctrl)
+			mc.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.PrivateLink{}), gomock.Any())
+			mc.EXPECT().Delete(ctx, &expected).Times(1).Return(fmt.Errorf("some random error"))
+
+			deployWaiter = privatelink.New(log, mc, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(deployWaiter.Destroy(ctx)).To(HaveOccurred())
+		})
+	})
+
+	Describe("#WaitCleanup", func() {
+		It("should not return error when it's already removed", func() {
+			Expect(deployWaiter.WaitCleanup(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#Restore", func() {
+		It("should restore the private link state if it exists in the shoot state", func() {
+			defer test.WithVars(
+				&privatelink.TimeNow, mockNow.Do,
+				&extensions.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			shootState := &gardencorev1beta1.ShootState{
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Extensions: []gardencorev1beta1.ExtensionResourceState{
+						{
+							Name:  &expected.Name,
+							Kind:  extensionsv1alpha1.PrivateLinkResource,
+							State: &runtime.RawExtension{Raw: []byte(`{"dummy":"state"}`)},
+						},
+					},
+				},
+			}
+
+			mc := mockclient.NewMockClient(ctrl)
+			mockStatusWriter := mockclient.NewMockStatusWriter(ctrl)
+
+			mc.EXPECT().Status().Return(mockStatusWriter)
+
+			mc.EXPECT().Get(ctx, client.ObjectKeyFromObject(empty), gomock.AssignableToTypeOf(empty)).
+				Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("privatelinks"), name))
+
+			// deploy with wait-for-state annotation
+			obj := expected.DeepCopy()
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/operation", "wait-for-state")
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/timestamp", now.UTC().Format(time.RFC3339Nano))
+			obj.TypeMeta = metav1.TypeMeta{}
+			mc.EXPECT().Create(ctx, test.HasObjectKeyOf(obj)).
+				DoAndReturn(func(_ context.Context, actual client.Object, _ ...client.CreateOption) error {
+					Expect(actual).To(DeepEqual(obj))
+					return nil
+				})
+
+			// restore state
+			expectedWithState := obj.DeepCopy()
+			expectedWithState.Status.State = &runtime.RawExtension{Raw: []byte(`{"dummy":"state"}`)}
+			test.EXPECTStatusPatch(ctx, mockStatusWriter, expectedWithState, obj, types.MergePatchType)
+
+			// annotate with restore annotation
+			expectedWithRestore := expectedWithState.DeepCopy()
+			metav1.SetMetaDataAnnotation(&expectedWithRestore.ObjectMeta, "gardener.cloud/operation", "restore")
+			test.EXPECTPatch(ctx, mc, expectedWithRestore, expectedWithState, types.MergePatchType)
+
+			deployWaiter = privatelink.New(log, mc, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(deployWaiter.Restore(ctx, shootState)).To(Succeed())
+		})
+	})
+
+	Describe("#Migrate", func() {
+		It("should migrate the resource", func() {
+			defer test.WithVars(
+				&extensions.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+			mc := mockclient.NewMockClient(ctrl)
+
+			expectedCopy := empty.DeepCopy()
+			metav1.SetMetaDataAnnotation(&expectedCopy.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationMigrate)
+			metav1.SetMetaDataAnnotation(&expectedCopy.ObjectMeta, v1beta1constants.GardenerTimestamp, now.UTC().Format(time.RFC3339Nano))
+			test.EXPECTPatch(ctx, mc, expectedCopy, empty, types.MergePatchType)
+
+			deployWaiter = privatelink.New(log, mc, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(deployWaiter.Migrate(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#WaitMigrate", func() {
+		It("should not return error when resource does not exist", func() {
+			Expect(deployWaiter.WaitMigrate(ctx)).To(Succeed())
+		})
+	})
+})
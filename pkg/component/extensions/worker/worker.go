@@ -79,6 +79,8 @@ type Values struct {
 	WorkerNameToOperatingSystemConfigsMap map[string]*operatingsystemconfig.OperatingSystemConfigs
 	// NodeLocalDNSEnabled indicates whether node local dns is enabled or not.
 	NodeLocalDNSEnabled bool
+	// ResourceTags is a map of tags which shall be added to all cloud resources created for the worker nodes.
+	ResourceTags map[string]string
 }
 
 // New creates a new instance of Interface.
@@ -252,6 +254,7 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			MachineControllerManagerSettings: workerPool.MachineControllerManagerSettings,
 			Architecture:                     workerPool.Machine.Architecture,
 			ClusterAutoscaler:                autoscalerOptions,
+			Confidential:                     workerPool.Confidential,
 		})
 	}
 
@@ -275,6 +278,7 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			SSHPublicKey:                 w.values.SSHPublicKey,
 			InfrastructureProviderStatus: w.values.InfrastructureProviderStatus,
 			Pools:                        pools,
+			ResourceTags:                 w.values.ResourceTags,
 		}
 
 		return nil
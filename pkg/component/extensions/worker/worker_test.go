@@ -163,6 +163,7 @@ var _ = Describe("Worker", func() {
 			MachineTypes:                 machineTypes,
 			SSHPublicKey:                 sshPublicKey,
 			InfrastructureProviderStatus: infrastructureProviderStatus,
+			ResourceTags:                 map[string]string{"cost-center": "1234"},
 			WorkerNameToOperatingSystemConfigsMap: map[string]*operatingsystemconfig.OperatingSystemConfigs{
 				worker1Name: {
 					Init: operatingsystemconfig.Data{
@@ -267,6 +268,7 @@ var _ = Describe("Worker", func() {
 			},
 			SSHPublicKey:                 sshPublicKey,
 			InfrastructureProviderStatus: infrastructureProviderStatus,
+			ResourceTags:                 map[string]string{"cost-center": "1234"},
 			Pools: []extensionsv1alpha1.WorkerPool{
 				{
 					Name:           worker1Name,
@@ -522,6 +524,49 @@ var _ = Describe("Worker", func() {
 				Spec: *expectedWorkerSpec,
 			}))
 		})
+
+		It("should successfully deploy the Worker resource with confidential settings when present", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			confidential := &gardencorev1beta1.WorkerConfidential{
+				Attestation: &gardencorev1beta1.WorkerConfidentialAttestation{
+					Endpoint: "https://attestation.example.com",
+					Policy:   ptr.To("strict"),
+				},
+			}
+
+			newValues := *values
+			newValues.Workers[0].Confidential = confidential
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools[0].Confidential = confidential
+			expectedWorkerSpec.Pools[0].Labels["worker.gardener.cloud/confidential"] = "true"
+
+			existingWorker := w.DeepCopy()
+			existingWorker.Spec.Pools = expectedWorkerSpec.Pools
+
+			Expect(c.Create(ctx, existingWorker)).To(Succeed(), "creating worker succeeds")
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+
+			Expect(obj).To(DeepEqual(&extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Annotations: map[string]string{
+						"gardener.cloud/operation": "reconcile",
+						"gardener.cloud/timestamp": now.UTC().Format(time.RFC3339Nano),
+					},
+					ResourceVersion: "2",
+				},
+				Spec: *expectedWorkerSpec,
+			}))
+		})
 	})
 
 	Describe("#Wait", func() {
@@ -31,16 +31,18 @@ const (
 	namePrefixSpecificProjectUAM        = "gardener.cloud:system:project-uam:"
 	namePrefixSpecificProjectViewer     = "gardener.cloud:system:project-viewer:"
 	namePrefixSpecificProjectExtensions = "gardener.cloud:extension:project:"
+	namePrefixSpecificProjectCustom     = "gardener.cloud:custom:project:"
 
 	nameProjectMember                = "gardener.cloud:system:project-member"
 	nameProjectViewer                = "gardener.cloud:system:project-viewer"
 	nameProjectServiceAccountManager = "gardener.cloud:system:project-serviceaccountmanager"
 )
 
-// Interface extends component.Deployer with a function to delete stale extension roles resources.
+// Interface extends component.Deployer with functions to delete stale extension and custom roles resources.
 type Interface interface {
 	component.Deployer
 	DeleteStaleExtensionRolesResources(context.Context) error
+	DeleteStaleCustomRolesResources(context.Context) error
 }
 
 // New creates a new instance of Interface for the RBAC resources required to interact with Projects.
@@ -70,6 +72,8 @@ func (p *projectRBAC) Deploy(ctx context.Context) error {
 
 		extensionRolesNameToSubjects = map[string][]rbacv1.Subject{}
 		extensionRolesNames          = sets.New[string]()
+
+		customRolesNameToSubjects = map[string][]rbacv1.Subject{}
 	)
 
 	if p.project.Spec.Owner != nil {
@@ -97,6 +101,11 @@ func (p *projectRBAC) Deploy(ctx context.Context) error {
 				extensionRolesNameToSubjects[extensionRoleName] = append(extensionRolesNameToSubjects[extensionRoleName], member.Subject)
 				extensionRolesNames.Insert(extensionRoleName)
 			}
+
+			if strings.HasPrefix(role, gardencorev1beta1.ProjectMemberCustomPrefix) {
+				customRoleName := strings.TrimPrefix(role, gardencorev1beta1.ProjectMemberCustomPrefix)
+				customRolesNameToSubjects[customRoleName] = append(customRolesNameToSubjects[customRoleName], member.Subject)
+			}
 		}
 	}
 
@@ -235,6 +244,28 @@ func (p *projectRBAC) Deploy(ctx context.Context) error {
 		})
 	}
 
+	// project custom roles resources
+	for _, customRole := range p.project.Spec.CustomRoles {
+		var (
+			name     = fmt.Sprintf("%s%s:%s", namePrefixSpecificProjectCustom, p.project.Name, customRole.Name)
+			subjects = customRolesNameToSubjects[customRole.Name]
+			rules    = customRole.Rules
+		)
+
+		fns = append(fns, func(ctx context.Context) error {
+			return p.reconcileResources(
+				ctx,
+				name,
+				false,
+				&name,
+				p.getCustomRolesResourceLabels(),
+				subjects,
+				nil,
+				rules,
+			)
+		})
+	}
+
 	return flow.Parallel(fns...)(ctx)
 }
 
@@ -327,6 +358,10 @@ func (p *projectRBAC) Destroy(ctx context.Context) error {
 		return err
 	}
 
+	if err := p.deleteCustomRolesResources(ctx, sets.New[string]()); err != nil {
+		return err
+	}
+
 	return kubernetesutils.DeleteObjects(ctx, p.client,
 		emptyClusterRole(namePrefixSpecificProjectAdmin+p.project.Name),
 		emptyClusterRoleBinding(namePrefixSpecificProjectAdmin+p.project.Name),
@@ -362,17 +397,35 @@ func (p *projectRBAC) DeleteStaleExtensionRolesResources(ctx context.Context) er
 }
 
 func (p *projectRBAC) deleteExtensionRolesResources(ctx context.Context, wantedExtensionRolesNames sets.Set[string]) error {
+	return p.deleteRolesResources(ctx, p.getExtensionRolesResourceLabels(), namePrefixSpecificProjectExtensions, wantedExtensionRolesNames)
+}
+
+func (p *projectRBAC) DeleteStaleCustomRolesResources(ctx context.Context) error {
+	wantedCustomRolesNames := sets.New[string]()
+
+	for _, customRole := range p.project.Spec.CustomRoles {
+		wantedCustomRolesNames.Insert(customRole.Name)
+	}
+
+	return p.deleteCustomRolesResources(ctx, wantedCustomRolesNames)
+}
+
+func (p *projectRBAC) deleteCustomRolesResources(ctx context.Context, wantedCustomRolesNames sets.Set[string]) error {
+	return p.deleteRolesResources(ctx, p.getCustomRolesResourceLabels(), namePrefixSpecificProjectCustom, wantedCustomRolesNames)
+}
+
+func (p *projectRBAC) deleteRolesResources(ctx context.Context, labels map[string]string, namePrefix string, wantedRolesNames sets.Set[string]) error {
 	for _, list := range []client.ObjectList{
 		&rbacv1.RoleBindingList{},
 		&rbacv1.ClusterRoleList{},
 	} {
-		if err := p.client.List(ctx, list, client.InNamespace(*p.project.Spec.Namespace), client.MatchingLabels(p.getExtensionRolesResourceLabels())); err != nil {
+		if err := p.client.List(ctx, list, client.InNamespace(*p.project.Spec.Namespace), client.MatchingLabels(labels)); err != nil {
 			return err
 		}
 
 		if err := meta.EachListItem(list, func(obj runtime.Object) error {
 			o := obj.(client.Object)
-			if wantedExtensionRolesNames.Has(getExtensionRoleNameFromRBAC(o.GetName(), p.project.Name)) {
+			if wantedRolesNames.Has(getRoleNameFromRBAC(o.GetName(), namePrefix, p.project.Name)) {
 				return nil
 			}
 
@@ -392,8 +445,15 @@ func (p *projectRBAC) getExtensionRolesResourceLabels() map[string]string {
 	}
 }
 
-func getExtensionRoleNameFromRBAC(resourceName, projectName string) string {
-	return strings.TrimPrefix(resourceName, namePrefixSpecificProjectExtensions+projectName+":")
+func (p *projectRBAC) getCustomRolesResourceLabels() map[string]string {
+	return map[string]string{
+		v1beta1constants.GardenRole:  v1beta1constants.LabelCustomProjectRole,
+		v1beta1constants.ProjectName: p.project.Name,
+	}
+}
+
+func getRoleNameFromRBAC(resourceName, namePrefix, projectName string) string {
+	return strings.TrimPrefix(resourceName, namePrefix+projectName+":")
 }
 
 func getExtensionRoleNameFromRole(role string) string {
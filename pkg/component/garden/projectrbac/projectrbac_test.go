@@ -40,6 +40,11 @@ var _ = Describe("ProjectRBAC", func() {
 		extensionRole1      = "foo"
 		extensionRole2      = "bar"
 
+		customRolePrefix = "gardener.cloud:custom:project:" + projectName + ":"
+		customRole1      = "deployer"
+		customRole2      = "auditor"
+		customRoleRules  = []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "update"}}}
+
 		member1 = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "member1"}
 		member2 = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "member2"}
 		member3 = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "member3"}
@@ -64,6 +69,9 @@ var _ = Describe("ProjectRBAC", func() {
 		clusterRoleProjectExtensionRole1 *rbacv1.ClusterRole
 		roleBindingProjectExtensionRole1 *rbacv1.RoleBinding
 
+		clusterRoleProjectCustomRole1 *rbacv1.ClusterRole
+		roleBindingProjectCustomRole1 *rbacv1.RoleBinding
+
 		extensionRoleListOptions = []client.ListOption{
 			client.InNamespace(namespace),
 			client.MatchingLabels{
@@ -94,6 +102,37 @@ var _ = Describe("ProjectRBAC", func() {
 				Name: extensionRolePrefix + extensionRole2,
 			},
 		}
+
+		customRoleListOptions = []client.ListOption{
+			client.InNamespace(namespace),
+			client.MatchingLabels{
+				v1beta1constants.GardenRole:  v1beta1constants.LabelCustomProjectRole,
+				v1beta1constants.ProjectName: projectName,
+			},
+		}
+
+		emptyCustomRoleBinding1 = rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      customRolePrefix + customRole1,
+				Namespace: namespace,
+			},
+		}
+		emptyCustomRoleBinding2 = rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      customRolePrefix + customRole2,
+				Namespace: namespace,
+			},
+		}
+		emptyCustomClusterRole1 = rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: customRolePrefix + customRole1,
+			},
+		}
+		emptyCustomClusterRole2 = rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: customRolePrefix + customRole2,
+			},
+		}
 	)
 
 	BeforeEach(func() {
@@ -379,6 +418,46 @@ var _ = Describe("ProjectRBAC", func() {
 				Name:     extensionRolePrefix + extensionRole1,
 			},
 		}
+
+		clusterRoleProjectCustomRole1 = &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: customRolePrefix + customRole1,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion:         "core.gardener.cloud/v1beta1",
+					Kind:               "Project",
+					Name:               projectName,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(false),
+				}},
+				Labels: map[string]string{
+					"gardener.cloud/role":         "custom-project-role",
+					"project.gardener.cloud/name": projectName,
+				},
+			},
+			Rules: customRoleRules,
+		}
+		roleBindingProjectCustomRole1 = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      customRolePrefix + customRole1,
+				Namespace: namespace,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion:         "core.gardener.cloud/v1beta1",
+					Kind:               "Project",
+					Name:               projectName,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(false),
+				}},
+				Labels: map[string]string{
+					"gardener.cloud/role":         "custom-project-role",
+					"project.gardener.cloud/name": projectName,
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "ClusterRole",
+				Name:     customRolePrefix + customRole1,
+			},
+		}
 	})
 
 	AfterEach(func() {
@@ -404,9 +483,10 @@ var _ = Describe("ProjectRBAC", func() {
 				},
 				{
 					Subject: member4,
-					Roles:   []string{"serviceaccountmanager"},
+					Roles:   []string{"serviceaccountmanager", "custom:" + customRole1},
 				},
 			}
+			project.Spec.CustomRoles = []gardencorev1beta1.ProjectCustomRole{{Name: customRole1, Rules: customRoleRules}}
 
 			clusterRoleBindingProjectAdmin.Subjects = []rbacv1.Subject{member3}
 			clusterRoleBindingProjectUAM.Subjects = []rbacv1.Subject{member2}
@@ -416,6 +496,7 @@ var _ = Describe("ProjectRBAC", func() {
 			clusterRoleBindingProjectViewer.Subjects = []rbacv1.Subject{member1, member3}
 			roleBindingProjectViewer.Subjects = []rbacv1.Subject{member1, member3}
 			roleBindingProjectExtensionRole1.Subjects = []rbacv1.Subject{member1}
+			roleBindingProjectCustomRole1.Subjects = []rbacv1.Subject{member4}
 
 			// project admin
 			c.EXPECT().Get(ctx, client.ObjectKey{Name: clusterRoleProjectAdmin.Name}, gomock.AssignableToTypeOf(&rbacv1.ClusterRole{}))
@@ -455,6 +536,12 @@ var _ = Describe("ProjectRBAC", func() {
 			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: roleBindingProjectExtensionRole1.Namespace, Name: roleBindingProjectExtensionRole1.Name}, gomock.AssignableToTypeOf(&rbacv1.RoleBinding{}))
 			c.EXPECT().Patch(ctx, roleBindingProjectExtensionRole1, gomock.Any())
 
+			// project custom roles
+			c.EXPECT().Get(ctx, client.ObjectKey{Name: clusterRoleProjectCustomRole1.Name}, gomock.AssignableToTypeOf(&rbacv1.ClusterRole{}))
+			c.EXPECT().Patch(ctx, clusterRoleProjectCustomRole1, gomock.Any())
+			c.EXPECT().Get(ctx, client.ObjectKey{Namespace: roleBindingProjectCustomRole1.Namespace, Name: roleBindingProjectCustomRole1.Name}, gomock.AssignableToTypeOf(&rbacv1.RoleBinding{}))
+			c.EXPECT().Patch(ctx, roleBindingProjectCustomRole1, gomock.Any())
+
 			Expect(projectRBAC.Deploy(ctx)).To(Succeed())
 		})
 	})
@@ -477,6 +564,18 @@ var _ = Describe("ProjectRBAC", func() {
 			c.EXPECT().Delete(ctx, &emptyExtensionClusterRole1)
 			c.EXPECT().Delete(ctx, &emptyExtensionClusterRole2)
 
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.RoleBindingList{}), customRoleListOptions).DoAndReturn(func(_ context.Context, list *rbacv1.RoleBindingList, _ ...client.ListOption) error {
+				list.Items = []rbacv1.RoleBinding{emptyCustomRoleBinding1}
+				return nil
+			})
+			c.EXPECT().Delete(ctx, &emptyCustomRoleBinding1)
+
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.ClusterRoleList{}), customRoleListOptions).DoAndReturn(func(_ context.Context, list *rbacv1.ClusterRoleList, _ ...client.ListOption) error {
+				list.Items = []rbacv1.ClusterRole{emptyCustomClusterRole1}
+				return nil
+			})
+			c.EXPECT().Delete(ctx, &emptyCustomClusterRole1)
+
 			c.EXPECT().Delete(ctx, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:system:project:" + projectName}})
 			c.EXPECT().Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:system:project:" + projectName}})
 
@@ -569,4 +668,39 @@ var _ = Describe("ProjectRBAC", func() {
 			Expect(projectRBAC.DeleteStaleExtensionRolesResources(ctx)).To(Succeed())
 		})
 	})
+
+	Describe("#DeleteStaleCustomRolesResources", func() {
+		It("should do nothing because neither rolebindings nor clusterroles exist", func() {
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.RoleBindingList{}), customRoleListOptions)
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.ClusterRoleList{}), customRoleListOptions)
+
+			Expect(projectRBAC.DeleteStaleCustomRolesResources(ctx)).To(Succeed())
+		})
+
+		It("should return an error because listing the rolebindings failed", func() {
+			c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.RoleBindingList{}), customRoleListOptions).Return(fakeErr)
+
+			Expect(projectRBAC.DeleteStaleCustomRolesResources(ctx)).To(MatchError(fakeErr))
+		})
+
+		It("should succeed deleting the rolebindings and clusterroles of custom roles which are no longer defined", func() {
+			project.Spec.CustomRoles = []gardencorev1beta1.ProjectCustomRole{{Name: customRole1, Rules: customRoleRules}}
+
+			gomock.InOrder(
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.RoleBindingList{}), customRoleListOptions).DoAndReturn(func(_ context.Context, list *rbacv1.RoleBindingList, _ ...client.ListOption) error {
+					list.Items = []rbacv1.RoleBinding{emptyCustomRoleBinding1, emptyCustomRoleBinding2}
+					return nil
+				}),
+				c.EXPECT().Delete(ctx, &emptyCustomRoleBinding2),
+
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&rbacv1.ClusterRoleList{}), customRoleListOptions).DoAndReturn(func(_ context.Context, list *rbacv1.ClusterRoleList, _ ...client.ListOption) error {
+					list.Items = []rbacv1.ClusterRole{emptyCustomClusterRole1, emptyCustomClusterRole2}
+					return nil
+				}),
+				c.EXPECT().Delete(ctx, &emptyCustomClusterRole2),
+			)
+
+			Expect(projectRBAC.DeleteStaleCustomRolesResources(ctx)).To(Succeed())
+		})
+	})
 })
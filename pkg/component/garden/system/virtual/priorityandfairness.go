@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package virtual
+
+import (
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+)
+
+// presetMatchingPrecedence is the matching precedence of the FlowSchemas of the presets. It is lower than the one of
+// the 'kube-system-service-accounts' FlowSchema maintained by the kube-apiserver (900) such that the presets also
+// apply to service accounts in the kube-system namespace.
+const presetMatchingPrecedence = 850
+
+var presetSubjects = map[operatorv1alpha1.PriorityAndFairnessPreset][]flowcontrolv1.Subject{
+	operatorv1alpha1.PriorityAndFairnessPresetGardenlets: {{
+		Kind:  flowcontrolv1.SubjectKindGroup,
+		Group: &flowcontrolv1.GroupSubject{Name: v1beta1constants.SeedsGroup},
+	}},
+	operatorv1alpha1.PriorityAndFairnessPresetGardenerDashboard: {{
+		Kind:           flowcontrolv1.SubjectKindServiceAccount,
+		ServiceAccount: &flowcontrolv1.ServiceAccountSubject{Namespace: metav1.NamespaceSystem, Name: "gardener-dashboard"},
+	}},
+}
+
+var presetNominalConcurrencyShares = map[operatorv1alpha1.PriorityAndFairnessPreset]int32{
+	operatorv1alpha1.PriorityAndFairnessPresetGardenlets:        40,
+	operatorv1alpha1.PriorityAndFairnessPresetGardenerDashboard: 10,
+}
+
+func priorityAndFairnessResources(config *operatorv1alpha1.PriorityAndFairness) []client.Object {
+	if config == nil {
+		return nil
+	}
+
+	var objects []client.Object
+
+	for _, preset := range config.Presets {
+		name := operatorv1alpha1.PriorityAndFairnessPresetNamePrefix + string(preset)
+
+		objects = append(objects,
+			&flowcontrolv1.PriorityLevelConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: flowcontrolv1.PriorityLevelConfigurationSpec{
+					Type: flowcontrolv1.PriorityLevelEnablementLimited,
+					Limited: &flowcontrolv1.LimitedPriorityLevelConfiguration{
+						NominalConcurrencyShares: ptr.To(presetNominalConcurrencyShares[preset]),
+						LendablePercent:          ptr.To[int32](0),
+						LimitResponse: flowcontrolv1.LimitResponse{
+							Type: flowcontrolv1.LimitResponseTypeQueue,
+							Queuing: &flowcontrolv1.QueuingConfiguration{
+								Queues:           64,
+								HandSize:         6,
+								QueueLengthLimit: 50,
+							},
+						},
+					},
+				},
+			},
+			&flowcontrolv1.FlowSchema{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: flowcontrolv1.FlowSchemaSpec{
+					PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: name},
+					MatchingPrecedence:         presetMatchingPrecedence,
+					DistinguisherMethod:        &flowcontrolv1.FlowDistinguisherMethod{Type: flowcontrolv1.FlowDistinguisherMethodByUserType},
+					Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+						Subjects: presetSubjects[preset],
+						ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+							Verbs:        []string{flowcontrolv1.VerbAll},
+							APIGroups:    []string{flowcontrolv1.APIGroupAll},
+							Resources:    []string{flowcontrolv1.ResourceAll},
+							ClusterScope: true,
+							Namespaces:   []string{flowcontrolv1.NamespaceEvery},
+						}},
+						NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{{
+							Verbs:           []string{flowcontrolv1.VerbAll},
+							NonResourceURLs: []string{flowcontrolv1.NonResourceAll},
+						}},
+					}},
+				},
+			},
+		)
+	}
+
+	for _, priorityLevel := range config.PriorityLevels {
+		objects = append(objects, &flowcontrolv1.PriorityLevelConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: priorityLevel.Name},
+			Spec:       priorityLevel.Spec,
+		})
+	}
+
+	for _, flowSchema := range config.FlowSchemas {
+		objects = append(objects, &flowcontrolv1.FlowSchema{
+			ObjectMeta: metav1.ObjectMeta{Name: flowSchema.Name},
+			Spec:       flowSchema.Spec,
+		})
+	}
+
+	return objects
+}
@@ -57,6 +57,8 @@ type gardenSystem struct {
 type Values struct {
 	// SeedAuthorizerEnabled determines whether the seed authorizer is enabled.
 	SeedAuthorizerEnabled bool
+	// PriorityAndFairness contains the API priority and fairness configuration of the virtual garden kube-apiserver.
+	PriorityAndFairness *operatorv1alpha1.PriorityAndFairness
 }
 
 func (g *gardenSystem) Deploy(ctx context.Context) error {
@@ -236,6 +238,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 						"projects",
 						"seeds",
 						"shoots",
+						"shootrevisions",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
@@ -405,6 +408,11 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					},
 					Verbs: []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shootrevisions"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{settingsv1alpha1.GroupName},
 					Resources: []string{"openidconnectpresets"},
@@ -415,6 +423,26 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{"bastions"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"accessrequests"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
+				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"accessrequests/approval"},
+					Verbs:     []string{"patch", "update"},
+				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"sshaccessrequests"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
+				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"sshaccessrequests/approval"},
+					Verbs:     []string{"patch", "update"},
+				},
 				{
 					APIGroups: []string{rbacv1.GroupName},
 					Resources: []string{
@@ -428,6 +456,9 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/cost",
+						"shoots/clone",
+						"shoots/export",
 					},
 					Verbs: []string{"create"},
 				},
@@ -500,6 +531,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{
 						"shoots",
+						"shootrevisions",
 						"secretbindings",
 						"quotas",
 					},
@@ -515,6 +547,16 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{"bastions"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"accessrequests"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{operationsv1alpha1.GroupName},
+					Resources: []string{"sshaccessrequests"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{rbacv1.GroupName},
 					Resources: []string{
@@ -525,7 +567,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
-					Resources: []string{"shoots/viewerkubeconfig"},
+					Resources: []string{"shoots/viewerkubeconfig", "shoots/cost", "shoots/clone", "shoots/export"},
 					Verbs:     []string{"create"},
 				},
 			},
@@ -634,5 +676,9 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 		}
 	}
 
+	if err := registry.Add(priorityAndFairnessResources(g.values.PriorityAndFairness)...); err != nil {
+		return nil, err
+	}
+
 	return registry.SerializedObjects(), nil
 }
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -19,6 +20,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	. "github.com/gardener/gardener/pkg/component/garden/system/virtual"
@@ -256,6 +258,7 @@ var _ = Describe("Virtual", func() {
 						"projects",
 						"seeds",
 						"shoots",
+						"shootrevisions",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
@@ -425,6 +428,11 @@ var _ = Describe("Virtual", func() {
 					},
 					Verbs: []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shootrevisions"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{"settings.gardener.cloud"},
 					Resources: []string{"openidconnectpresets"},
@@ -435,6 +443,26 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{"bastions"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"accessrequests"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
+				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"accessrequests/approval"},
+					Verbs:     []string{"patch", "update"},
+				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"sshaccessrequests"},
+					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
+				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"sshaccessrequests/approval"},
+					Verbs:     []string{"patch", "update"},
+				},
 				{
 					APIGroups: []string{"rbac.authorization.k8s.io"},
 					Resources: []string{
@@ -448,6 +476,9 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/cost",
+						"shoots/clone",
+						"shoots/export",
 					},
 					Verbs: []string{"create"},
 				},
@@ -518,6 +549,7 @@ var _ = Describe("Virtual", func() {
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{
 						"shoots",
+						"shootrevisions",
 						"secretbindings",
 						"quotas",
 					},
@@ -533,6 +565,16 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{"bastions"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"accessrequests"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{"operations.gardener.cloud"},
+					Resources: []string{"sshaccessrequests"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
 				{
 					APIGroups: []string{"rbac.authorization.k8s.io"},
 					Resources: []string{
@@ -543,7 +585,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"core.gardener.cloud"},
-					Resources: []string{"shoots/viewerkubeconfig"},
+					Resources: []string{"shoots/viewerkubeconfig", "shoots/cost", "shoots/clone", "shoots/export"},
 					Verbs:     []string{"create"},
 				},
 			},
@@ -661,6 +703,112 @@ var _ = Describe("Virtual", func() {
 				Expect(managedResourceSecret.Data).NotTo(HaveKey("clusterrolebinding____gardener.cloud_system_seeds.yaml"))
 			})
 		})
+
+		Context("when priority and fairness is configured", func() {
+			var (
+				customPriorityLevel = operatorv1alpha1.PriorityLevel{
+					Name: "dashboard-users",
+					Spec: flowcontrolv1.PriorityLevelConfigurationSpec{
+						Type:    flowcontrolv1.PriorityLevelEnablementLimited,
+						Limited: &flowcontrolv1.LimitedPriorityLevelConfiguration{NominalConcurrencyShares: ptr.To[int32](5)},
+					},
+				}
+				customFlowSchema = operatorv1alpha1.FlowSchema{
+					Name: "dashboard-users",
+					Spec: flowcontrolv1.FlowSchemaSpec{
+						PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: "dashboard-users"},
+						MatchingPrecedence:         1000,
+						Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+							Subjects: []flowcontrolv1.Subject{{Kind: flowcontrolv1.SubjectKindGroup, Group: &flowcontrolv1.GroupSubject{Name: "dashboard-users"}}},
+						}},
+					},
+				}
+			)
+
+			BeforeEach(func() {
+				values.PriorityAndFairness = &operatorv1alpha1.PriorityAndFairness{
+					Presets:        []operatorv1alpha1.PriorityAndFairnessPreset{operatorv1alpha1.PriorityAndFairnessPresetGardenerDashboard},
+					PriorityLevels: []operatorv1alpha1.PriorityLevel{customPriorityLevel},
+					FlowSchemas:    []operatorv1alpha1.FlowSchema{customFlowSchema},
+				}
+				component = New(c, namespace, values)
+			})
+
+			It("should successfully deploy the priority and fairness resources", func() {
+				Expect(managedResource).To(consistOf(
+					namespaceGarden,
+					clusterRoleSeedBootstrapper,
+					clusterRoleBindingSeedBootstrapper,
+					clusterRoleSeeds,
+					clusterRoleBindingSeeds,
+					clusterRoleGardenerAdmin,
+					clusterRoleBindingGardenerAdmin,
+					clusterRoleGardenerAdminAggregated,
+					clusterRoleGardenerViewer,
+					clusterRoleGardenerViewerAggregated,
+					clusterRoleReadGlobalResources,
+					clusterRoleBindingReadGlobalResources,
+					clusterRoleUserAuth,
+					clusterRoleBindingUserAuth,
+					clusterRoleProjectCreation,
+					clusterRoleProjectMemberAggregated,
+					clusterRoleProjectMember,
+					clusterRoleProjectServiceAccountManagerAggregated,
+					clusterRoleProjectServiceAccountManager,
+					clusterRoleProjectViewerAggregated,
+					clusterRoleProjectViewer,
+					roleReadClusterIdentityConfigMap,
+					roleBindingReadClusterIdentityConfigMap,
+					&flowcontrolv1.PriorityLevelConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Name: "gardener-preset-gardener-dashboard"},
+						Spec: flowcontrolv1.PriorityLevelConfigurationSpec{
+							Type: flowcontrolv1.PriorityLevelEnablementLimited,
+							Limited: &flowcontrolv1.LimitedPriorityLevelConfiguration{
+								NominalConcurrencyShares: ptr.To[int32](10),
+								LendablePercent:          ptr.To[int32](0),
+								LimitResponse: flowcontrolv1.LimitResponse{
+									Type:    flowcontrolv1.LimitResponseTypeQueue,
+									Queuing: &flowcontrolv1.QueuingConfiguration{Queues: 64, HandSize: 6, QueueLengthLimit: 50},
+								},
+							},
+						},
+					},
+					&flowcontrolv1.FlowSchema{
+						ObjectMeta: metav1.ObjectMeta{Name: "gardener-preset-gardener-dashboard"},
+						Spec: flowcontrolv1.FlowSchemaSpec{
+							PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: "gardener-preset-gardener-dashboard"},
+							MatchingPrecedence:         850,
+							DistinguisherMethod:        &flowcontrolv1.FlowDistinguisherMethod{Type: flowcontrolv1.FlowDistinguisherMethodByUserType},
+							Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+								Subjects: []flowcontrolv1.Subject{{
+									Kind:           flowcontrolv1.SubjectKindServiceAccount,
+									ServiceAccount: &flowcontrolv1.ServiceAccountSubject{Namespace: "kube-system", Name: "gardener-dashboard"},
+								}},
+								ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+									Verbs:        []string{"*"},
+									APIGroups:    []string{"*"},
+									Resources:    []string{"*"},
+									ClusterScope: true,
+									Namespaces:   []string{"*"},
+								}},
+								NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{{
+									Verbs:           []string{"*"},
+									NonResourceURLs: []string{"*"},
+								}},
+							}},
+						},
+					},
+					&flowcontrolv1.PriorityLevelConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Name: customPriorityLevel.Name},
+						Spec:       customPriorityLevel.Spec,
+					},
+					&flowcontrolv1.FlowSchema{
+						ObjectMeta: metav1.ObjectMeta{Name: customFlowSchema.Name},
+						Spec:       customFlowSchema.Spec,
+					},
+				))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -865,6 +865,7 @@ func clusterRole() *rbacv1.ClusterRole {
 				APIGroups: []string{"operations.gardener.cloud"},
 				Resources: []string{
 					"bastions",
+					"sshaccessrequests",
 				},
 				Verbs: []string{"get", "list", "watch"},
 			},
@@ -52,6 +52,7 @@ func (a *gardenerAdmissionController) clusterRole() *rbacv1.ClusterRole {
 				APIGroups: []string{operationsv1alpha1.GroupName},
 				Resources: []string{
 					"bastions",
+					"sshaccessrequests",
 				},
 				Verbs: []string{"get", "list", "watch"},
 			},
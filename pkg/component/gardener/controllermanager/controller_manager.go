@@ -48,6 +48,8 @@ type Values struct {
 	LogLevel string
 	// Quotas is the default configuration matching projects are set up with if a quota is not already specified.
 	Quotas []controllermanagerv1alpha1.QuotaConfiguration
+	// LimitRanges is the default configuration matching projects are set up with.
+	LimitRanges []controllermanagerv1alpha1.LimitRangeConfiguration
 	// RuntimeVersion is the Kubernetes version of the runtime cluster.
 	RuntimeVersion *semver.Version
 	// FeatureGates is the set of feature gates.
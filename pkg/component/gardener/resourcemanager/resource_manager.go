@@ -302,6 +302,9 @@ type Values struct {
 	VPA *VPAConfig
 	// SchedulingProfile is the kube-scheduler profile configured for the Shoot.
 	SchedulingProfile *gardencorev1beta1.SchedulingProfile
+	// PodPolicies are policies (e.g., runtime class, seccomp profile, priority class) which are applied to pods in the
+	// target cluster by GRM's pod-scheduler-name webhook.
+	PodPolicies []resourcemanagerv1alpha1.PodPolicy
 	// DefaultSeccompProfileEnabled specifies if the defaulting seccomp profile webhook of GRM should be enabled or not.
 	DefaultSeccompProfileEnabled bool
 	// EndpointSliceHintsEnabled specifies if the EndpointSlice hints webhook of GRM should be enabled or not.
@@ -630,6 +633,11 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		config.Webhooks.PodSchedulerName.SchedulerName = ptr.To(kubescheduler.BinPackingSchedulerName)
 	}
 
+	if len(r.values.PodPolicies) > 0 {
+		config.Webhooks.PodSchedulerName.Enabled = true
+		config.Webhooks.PodSchedulerName.Policies = r.values.PodPolicies
+	}
+
 	if r.values.KubernetesServiceHost != nil {
 		config.Webhooks.KubernetesServiceHost.Enabled = true
 		config.Webhooks.KubernetesServiceHost.Host = *r.values.KubernetesServiceHost
@@ -1282,7 +1290,7 @@ func (r *resourceManager) getMutatingWebhookConfigurationWebhooks(
 		GetHighAvailabilityConfigMutatingWebhook(namespaceSelector, objectSelector, secretServerCA, buildClientConfigFn),
 	}
 
-	if r.values.SchedulingProfile != nil && *r.values.SchedulingProfile == gardencorev1beta1.SchedulingProfileBinPacking {
+	if (r.values.SchedulingProfile != nil && *r.values.SchedulingProfile == gardencorev1beta1.SchedulingProfileBinPacking) || len(r.values.PodPolicies) > 0 {
 		// pod scheduler name webhook should be active on all namespaces
 		webhooks = append(webhooks, GetPodSchedulerNameMutatingWebhook(&metav1.LabelSelector{}, secretServerCA, buildClientConfigFn))
 	}
@@ -1412,6 +1420,7 @@ func GetCRDDeletionProtectionValidatingWebhooks(secretServerCA *corev1.Secret, b
 							"infrastructures",
 							"networks",
 							"operatingsystemconfigs",
+							"privatelinks",
 							"workers",
 						},
 					},
@@ -1543,6 +1552,15 @@ func GetExtensionValidationValidatingWebhooks(secretServerCA *corev1.Secret, bui
 			},
 			path: extensionvalidation.WebhookPathOperatingSystemConfig,
 		},
+		{
+			resource: "privatelinks",
+			rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+				APIVersions: []string{extensionsv1alpha1.SchemeGroupVersion.Version},
+				Resources:   []string{"privatelinks"},
+			},
+			path: extensionvalidation.WebhookPathPrivateLink,
+		},
 		{
 			resource: "workers",
 			rule: admissionregistrationv1.Rule{
@@ -1516,6 +1516,7 @@ subjects:
 									"infrastructures",
 									"networks",
 									"operatingsystemconfigs",
+									"privatelinks",
 									"workers",
 								},
 							},
@@ -1822,6 +1823,32 @@ subjects:
 					SideEffects:             &sideEffect,
 					TimeoutSeconds:          ptr.To[int32](10),
 				},
+				{
+					Name: "validation.extensions.privatelinks.resources.gardener.cloud",
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{"extensions.gardener.cloud"},
+								APIVersions: []string{"v1alpha1"},
+								Resources:   []string{"privatelinks"},
+							},
+							Operations: []admissionregistrationv1.OperationType{"CREATE", "UPDATE"},
+						},
+					},
+					FailurePolicy:     &failurePolicyFail,
+					NamespaceSelector: &metav1.LabelSelector{},
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{
+							Name:      "gardener-resource-manager",
+							Namespace: deployNamespace,
+							Path:      ptr.To("/validate-extensions-gardener-cloud-v1alpha1-privatelink"),
+						},
+					},
+					AdmissionReviewVersions: []string{"v1beta1", "v1"},
+					MatchPolicy:             &matchPolicyExact,
+					SideEffects:             &sideEffect,
+					TimeoutSeconds:          ptr.To[int32](10),
+				},
 				{
 					Name: "validation.extensions.workers.resources.gardener.cloud",
 					Rules: []admissionregistrationv1.RuleWithOperations{
@@ -111,6 +111,9 @@ type Values struct {
 	ServiceNetworkCIDR string
 	// SNI contains information for configuring SNI settings for the kube-apiserver.
 	SNI SNIConfig
+	// SlowRequestLogging states whether audit events should be written to the standard output of the kube-apiserver so
+	// that slow requests can be captured by the logging stack.
+	SlowRequestLogging bool
 	// StaticTokenKubeconfigEnabled indicates whether static token kubeconfig secret will be created for shoot.
 	StaticTokenKubeconfigEnabled *bool
 	// Version is the Kubernetes version for the kube-apiserver.
@@ -160,6 +163,10 @@ type VPNConfig struct {
 	PodNetworkCIDR string
 	// NodeNetworkCIDR is the CIDR of the node network.
 	NodeNetworkCIDR *string
+	// AdditionalNodeNetworkCIDRs are the CIDRs of additional node networks.
+	AdditionalNodeNetworkCIDRs []string
+	// PathMTU is the smallest MTU of the network paths between the seed and the shoot nodes.
+	PathMTU *int32
 	// HighAvailabilityEnabled states if VPN uses HA configuration.
 	HighAvailabilityEnabled bool
 	// HighAvailabilityNumberOfSeedServers is the number of VPN seed servers used for HA
@@ -325,7 +332,7 @@ func (k *kubeAPIServer) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if err := apiserver.ReconcileConfigMapAuditPolicy(ctx, k.client.Client(), configMapAuditPolicy, k.values.Audit); err != nil {
+	if err := apiserver.ReconcileConfigMapAuditPolicy(ctx, k.client.Client(), configMapAuditPolicy, k.computeAuditConfig()); err != nil {
 		return err
 	}
 	if err := apiserver.ReconcileSecretAuditWebhookKubeconfig(ctx, k.client.Client(), secretAuditWebhookKubeconfig, k.values.Audit); err != nil {
@@ -583,6 +590,32 @@ func getLabels() map[string]string {
 	}
 }
 
+// ComputeKubeAPIServerServerCertificateConfig computes the [ServerCertificateConfig] needed to configure a
+// kube-apiserver. The given IP addresses and DNS names are extended with the additional SANs configured by the user.
+func ComputeKubeAPIServerServerCertificateConfig(
+	ipAddresses []net.IP,
+	dnsNames []string,
+	additionalSANs *gardencorev1beta1.KubeAPIServerAdditionalSANs,
+) ServerCertificateConfig {
+	out := ServerCertificateConfig{
+		ExtraIPAddresses: ipAddresses,
+		ExtraDNSNames:    dnsNames,
+	}
+
+	if additionalSANs == nil {
+		return out
+	}
+
+	for _, ipAddress := range additionalSANs.IPAddresses {
+		if ip := net.ParseIP(ipAddress); ip != nil {
+			out.ExtraIPAddresses = append(out.ExtraIPAddresses, ip)
+		}
+	}
+	out.ExtraDNSNames = append(out.ExtraDNSNames, additionalSANs.DNSNames...)
+
+	return out
+}
+
 // ComputeKubeAPIServerServiceAccountConfig computes the [ServiceAccountConfig]
 // needed to configure a kube-apiserver.
 func ComputeKubeAPIServerServiceAccountConfig(
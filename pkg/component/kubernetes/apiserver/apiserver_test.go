@@ -7,6 +7,7 @@ package apiserver_test
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -2030,6 +2031,64 @@ rules:
 				})
 			})
 
+			Context("audit policy with slow request logging", func() {
+				It("should successfully deploy the configmap resource w/ slow request policy", func() {
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							RuntimeVersion: runtimeVersion,
+						},
+						SlowRequestLogging: true,
+						Version:            version,
+					})
+
+					configMapAuditPolicy = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "audit-policy-config", Namespace: namespace},
+						Data: map[string]string{"audit-policy.yaml": `apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages:
+- RequestReceived
+- ResponseStarted
+rules:
+- level: None
+  verbs:
+  - watch
+- level: None
+  nonResourceURLs:
+  - /healthz*
+  - /livez*
+  - /readyz*
+- level: Metadata
+`},
+					}
+					Expect(kubernetesutils.MakeUnique(configMapAuditPolicy)).To(Succeed())
+
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuditPolicy), configMapAuditPolicy)).To(Succeed())
+				})
+
+				It("should successfully deploy the configmap resource w/ custom policy", func() {
+					policy := "some-audit-policy"
+
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							Audit:          &apiserver.AuditConfig{Policy: &policy},
+							RuntimeVersion: runtimeVersion,
+						},
+						SlowRequestLogging: true,
+						Version:            version,
+					})
+
+					configMapAuditPolicy = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "audit-policy-config", Namespace: namespace},
+						Data:       map[string]string{"audit-policy.yaml": policy},
+					}
+					Expect(kubernetesutils.MakeUnique(configMapAuditPolicy)).To(Succeed())
+
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(configMapAuditPolicy), configMapAuditPolicy)).To(Succeed())
+				})
+			})
+
 			Context("egress selector", func() {
 				It("should successfully deploy the configmap resource", func() {
 					kapi = New(kubernetesInterface, namespace, sm, Values{
@@ -2686,6 +2745,61 @@ rules:
 				))
 			})
 
+			It("should configure additional node networks for the vpn-seed-client sidecar and vpn-path-controller containers", func() {
+				values := Values{
+					Values: apiserver.Values{
+						RuntimeVersion: runtimeVersion,
+					},
+					Images:             Images{VPNClient: "vpn-client-image:really-latest"},
+					ServiceNetworkCIDR: "4.5.6.0/24",
+					VPN: VPNConfig{
+						Enabled:                              true,
+						HighAvailabilityEnabled:              true,
+						HighAvailabilityNumberOfSeedServers:  2,
+						HighAvailabilityNumberOfShootClients: 3,
+						PodNetworkCIDR:                       "1.2.3.0/24",
+						NodeNetworkCIDR:                      ptr.To("7.8.9.0/24"),
+						AdditionalNodeNetworkCIDRs:           []string{"7.8.10.0/24", "7.8.11.0/24"},
+					},
+					Version: version,
+				}
+				kapi = New(kubernetesInterface, namespace, sm, values)
+				deployAndRead()
+
+				for _, container := range deployment.Spec.Template.Spec.Containers[1:] {
+					Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "ADDITIONAL_NODE_NETWORKS", Value: "7.8.10.0/24,7.8.11.0/24"}), container.Name)
+				}
+				Expect(deployment.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(HaveField("Name", "ADDITIONAL_NODE_NETWORKS")))
+			})
+
+			It("should configure the path MTU for the vpn-seed-client sidecar containers", func() {
+				values := Values{
+					Values: apiserver.Values{
+						RuntimeVersion: runtimeVersion,
+					},
+					Images:             Images{VPNClient: "vpn-client-image:really-latest"},
+					ServiceNetworkCIDR: "4.5.6.0/24",
+					VPN: VPNConfig{
+						Enabled:                              true,
+						HighAvailabilityEnabled:              true,
+						HighAvailabilityNumberOfSeedServers:  2,
+						HighAvailabilityNumberOfShootClients: 3,
+						PodNetworkCIDR:                       "1.2.3.0/24",
+						NodeNetworkCIDR:                      ptr.To("7.8.9.0/24"),
+						PathMTU:                              ptr.To[int32](1400),
+					},
+					Version: version,
+				}
+				kapi = New(kubernetesInterface, namespace, sm, values)
+				deployAndRead()
+
+				containers := deployment.Spec.Template.Spec.Containers
+				for _, container := range containers[1 : len(containers)-1] {
+					Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "PATH_MTU", Value: "1400"}), container.Name)
+				}
+				Expect(containers[0].Env).NotTo(ContainElement(HaveField("Name", "PATH_MTU")))
+			})
+
 			Context("kube-apiserver container", func() {
 				var (
 					acceptedIssuers  = []string{"issuer1", "issuer2"}
@@ -3747,6 +3861,26 @@ rules:
 						},
 					))
 				})
+
+				It("should not write audit events to the standard output if slow request logging is disabled", func() {
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElements(
+						"--audit-log-path=-",
+						"--audit-log-format=json",
+					))
+				})
+
+				It("should write audit events to the standard output if slow request logging is enabled", func() {
+					values.SlowRequestLogging = true
+					kapi = New(kubernetesInterface, namespace, sm, values)
+					deployAndRead()
+
+					Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+						"--audit-log-path=-",
+						"--audit-log-format=json",
+					))
+				})
 			})
 		})
 
@@ -4048,6 +4182,30 @@ rules:
 		})
 	})
 
+	Describe("#ComputeKubeAPIServerServerCertificateConfig", func() {
+		var (
+			ipAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+			dnsNames    = []string{"api.foo.bar"}
+		)
+
+		It("should return the given SANs if no additional SANs are configured", func() {
+			Expect(ComputeKubeAPIServerServerCertificateConfig(ipAddresses, dnsNames, nil)).To(Equal(ServerCertificateConfig{
+				ExtraIPAddresses: ipAddresses,
+				ExtraDNSNames:    dnsNames,
+			}))
+		})
+
+		It("should add the additional SANs", func() {
+			Expect(ComputeKubeAPIServerServerCertificateConfig(ipAddresses, dnsNames, &gardencorev1beta1.KubeAPIServerAdditionalSANs{
+				DNSNames:    []string{"api.example.com", "*.example.com"},
+				IPAddresses: []string{"10.1.2.3", "2001:db8::1"},
+			})).To(Equal(ServerCertificateConfig{
+				ExtraIPAddresses: []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("10.1.2.3"), net.ParseIP("2001:db8::1")},
+				ExtraDNSNames:    []string{"api.foo.bar", "api.example.com", "*.example.com"},
+			}))
+		})
+	})
+
 	Describe("#ComputeKubeAPIServerServiceAccountConfig", func() {
 		externalHostname := "foo.bar"
 		DescribeTable("should have the expected ServiceAccountConfig config", func(
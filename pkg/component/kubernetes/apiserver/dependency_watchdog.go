@@ -5,12 +5,7 @@
 package apiserver
 
 import (
-	"time"
-
-	proberapi "github.com/gardener/dependency-watchdog/api/prober"
 	weederapi "github.com/gardener/dependency-watchdog/api/weeder"
-	appsv1 "k8s.io/api/apps/v1"
-	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -40,53 +35,3 @@ func NewDependencyWatchdogWeederConfiguration() (map[string]weederapi.DependantS
 		},
 	}, nil
 }
-
-// NewDependencyWatchdogProberConfiguration returns the configuration for the dependency watchdog (probe role)
-// ensuring that its dependant pods are scaled as soon a prober fails.
-func NewDependencyWatchdogProberConfiguration() ([]proberapi.DependentResourceInfo, error) {
-	return []proberapi.DependentResourceInfo{
-		{
-			Ref: &autoscalingv1.CrossVersionObjectReference{
-				Kind:       "Deployment",
-				Name:       v1beta1constants.DeploymentNameKubeControllerManager,
-				APIVersion: appsv1.SchemeGroupVersion.String(),
-			},
-			Optional: false,
-			ScaleUpInfo: &proberapi.ScaleInfo{
-				Level: 0,
-			},
-			ScaleDownInfo: &proberapi.ScaleInfo{
-				Level: 1,
-			},
-		},
-		{
-			Ref: &autoscalingv1.CrossVersionObjectReference{
-				Kind:       "Deployment",
-				Name:       v1beta1constants.DeploymentNameMachineControllerManager,
-				APIVersion: appsv1.SchemeGroupVersion.String(),
-			},
-			Optional: false,
-			ScaleUpInfo: &proberapi.ScaleInfo{
-				Level:        1,
-				InitialDelay: &metav1.Duration{Duration: 30 * time.Second},
-			},
-			ScaleDownInfo: &proberapi.ScaleInfo{
-				Level: 0,
-			},
-		},
-		{
-			Ref: &autoscalingv1.CrossVersionObjectReference{
-				Kind:       "Deployment",
-				Name:       v1beta1constants.DeploymentNameClusterAutoscaler,
-				APIVersion: appsv1.SchemeGroupVersion.String(),
-			},
-			Optional: true,
-			ScaleUpInfo: &proberapi.ScaleInfo{
-				Level: 2,
-			},
-			ScaleDownInfo: &proberapi.ScaleInfo{
-				Level: 0,
-			},
-		},
-	}, nil
-}
@@ -372,6 +372,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 		k.handleServiceAccountSigningKeySettings(deployment)
 		k.handleAuthenticationSettings(deployment, secretAuthenticationWebhookKubeconfig)
 		k.handleAuthorizationSettings(deployment, secretAuthorizationWebhookKubeconfig)
+		k.handleSlowRequestLoggingSettings(deployment)
 		if err := k.handleVPNSettings(deployment, serviceAccount, configMapEgressSelector, secretHTTPProxy, secretHAVPNSeedClient, secretHAVPNSeedClientSeedTLSAuth); err != nil {
 			return err
 		}
@@ -824,9 +825,27 @@ func (k *kubeAPIServer) vpnSeedClientContainer(index int) *corev1.Container {
 			},
 		},
 	}
+	container.Env = append(container.Env, k.additionalNodeNetworksEnvVars()...)
+	if k.values.VPN.PathMTU != nil {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "PATH_MTU",
+			Value: strconv.Itoa(int(*k.values.VPN.PathMTU)),
+		})
+	}
 	return container
 }
 
+func (k *kubeAPIServer) additionalNodeNetworksEnvVars() []corev1.EnvVar {
+	if len(k.values.VPN.AdditionalNodeNetworkCIDRs) == 0 {
+		return nil
+	}
+
+	return []corev1.EnvVar{{
+		Name:  "ADDITIONAL_NODE_NETWORKS",
+		Value: strings.Join(k.values.VPN.AdditionalNodeNetworkCIDRs, ","),
+	}}
+}
+
 func (k *kubeAPIServer) vpnSeedPathControllerContainer() *corev1.Container {
 	container := &corev1.Container{
 		Name:            containerNameVPNPathController,
@@ -870,6 +889,7 @@ func (k *kubeAPIServer) vpnSeedPathControllerContainer() *corev1.Container {
 		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
 		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 	}
+	container.Env = append(container.Env, k.additionalNodeNetworksEnvVars()...)
 	return container
 }
 
@@ -1058,3 +1078,16 @@ func (k *kubeAPIServer) handleAuthorizationSettings(deployment *appsv1.Deploymen
 
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--authorization-mode="+strings.Join(authModes, ","))
 }
+
+func (k *kubeAPIServer) handleSlowRequestLoggingSettings(deployment *appsv1.Deployment) {
+	if !k.values.SlowRequestLogging {
+		return
+	}
+
+	// Audit events are written to the standard output and filtered by their latency by the logging stack, see
+	// SlowRequestLoggingConfiguration.
+	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args,
+		"--audit-log-path=-",
+		"--audit-log-format=json",
+	)
+}
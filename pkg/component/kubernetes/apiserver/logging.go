@@ -6,6 +6,7 @@ package apiserver
 
 import (
 	"fmt"
+	"time"
 
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
 	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
@@ -15,6 +16,86 @@ import (
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+)
+
+const (
+	slowRequestsFilterName = "slow-requests"
+	auditParserName        = ContainerNameKubeAPIServer + "-audit-parser"
+
+	// slowRequestAuditPolicy is the audit policy used for capturing slow requests if no custom audit policy is
+	// configured. Only the final stage of requests is logged on metadata level. Watches and health checks are not
+	// logged since they are long-running or of no interest.
+	slowRequestAuditPolicy = `apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages:
+- RequestReceived
+- ResponseStarted
+rules:
+- level: None
+  verbs:
+  - watch
+- level: None
+  nonResourceURLs:
+  - /healthz*
+  - /livez*
+  - /readyz*
+- level: Metadata
+`
+
+	// slowRequestsLuaScript drops all audit events whose latency is below the threshold and turns the remaining ones
+	// into concise log messages. Log lines which are not audit events are passed through unchanged.
+	slowRequestsLuaScript = `
+local audit_keys = {"kind", "apiVersion", "level", "auditID", "stage", "requestURI", "verb", "user", "impersonatedUser",
+  "sourceIPs", "userAgent", "objectRef", "responseStatus", "requestObject", "responseObject",
+  "requestReceivedTimestamp", "stageTimestamp", "annotations"}
+
+function capture_slow_requests(tag, timestamp, record)
+  if record["kind"] ~= "Event" or record["apiVersion"] ~= "audit.k8s.io/v1" then
+    return 0, timestamp, record
+  end
+
+  local received = to_seconds(record["requestReceivedTimestamp"])
+  local completed = to_seconds(record["stageTimestamp"])
+  if received == nil or completed == nil then
+    return -1, timestamp, record
+  end
+
+  local latency_milliseconds = math.floor((completed - received) * 1000)
+  if latency_milliseconds < threshold_milliseconds then
+    return -1, timestamp, record
+  end
+
+  local user = record["user"] or {}
+  local response_status = record["responseStatus"] or {}
+  record["log"] = string.format("Slow request: latency=%dms verb=%s requestURI=%s user=%s userAgent=%s code=%s auditID=%s",
+    latency_milliseconds, tostring(record["verb"]), tostring(record["requestURI"]), tostring(user["username"]),
+    tostring(record["userAgent"]), tostring(response_status["code"]), tostring(record["auditID"]))
+  record["severity"] = "WARN"
+  for _, key in ipairs(audit_keys) do
+    record[key] = nil
+  end
+
+  return 1, timestamp, record
+end
+
+function to_seconds(timestamp)
+  if type(timestamp) ~= "string" then
+    return nil
+  end
+
+  local year, month, day, hour, min, sec, fraction = string.match(timestamp, "^(%d+)-(%d+)-(%d+)T(%d+):(%d+):(%d+)%.?(%d*)Z$")
+  if year == nil then
+    return nil
+  end
+
+  local seconds = os.time({year = tonumber(year), month = tonumber(month), day = tonumber(day), hour = tonumber(hour), min = tonumber(min), sec = tonumber(sec)})
+  if fraction ~= "" then
+    seconds = seconds + tonumber("0." .. fraction)
+  end
+  return seconds
+end
+`
 )
 
 // CentralLoggingConfiguration returns a fluent-bit parser and filter for the kube-apiserver logs.
@@ -62,3 +143,68 @@ func generateClusterParsers() []*fluentbitv1alpha2.ClusterParser {
 		},
 	}
 }
+
+// SlowRequestLoggingConfiguration returns a fluent-bit parser and filter which capture requests to kube-apiservers
+// whose latency exceeds the given threshold. The kube-apiservers must write their audit events to the standard output,
+// see Values.SlowRequestLogging.
+func SlowRequestLoggingConfiguration(threshold time.Duration) component.CentralLoggingConfiguration {
+	return func() (component.CentralLoggingConfig, error) {
+		return component.CentralLoggingConfig{
+			Filters: []*fluentbitv1alpha2.ClusterFilter{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						// The name ensures that this filter is applied after the filter parsing the kube-apiserver logs
+						// because the operator orders them by name.
+						Name:   fmt.Sprintf("%s--%s", v1beta1constants.DeploymentNameKubeAPIServer, slowRequestsFilterName),
+						Labels: map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource},
+					},
+					Spec: fluentbitv1alpha2.FilterSpec{
+						Match: fmt.Sprintf("kubernetes.*%s*%s*", v1beta1constants.DeploymentNameKubeAPIServer, ContainerNameKubeAPIServer),
+						FilterItems: []fluentbitv1alpha2.FilterItem{
+							{
+								Parser: &fluentbitv1alpha2filter.Parser{
+									KeyName:     "log",
+									Parser:      auditParserName,
+									ReserveData: ptr.To(true),
+								},
+							},
+							{
+								Lua: &fluentbitv1alpha2filter.Lua{
+									Call: "capture_slow_requests",
+									Code: fmt.Sprintf("local threshold_milliseconds = %d\n", threshold.Milliseconds()) + slowRequestsLuaScript,
+								},
+							},
+						},
+					},
+				},
+			},
+			Parsers: []*fluentbitv1alpha2.ClusterParser{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   auditParserName,
+						Labels: map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource},
+					},
+					Spec: fluentbitv1alpha2.ParserSpec{
+						JSON: &fluentbitv1alpha2parser.JSON{},
+					},
+				},
+			},
+		}, nil
+	}
+}
+
+// computeAuditConfig returns the audit configuration for the kube-apiserver. If slow requests shall be captured and no
+// custom audit policy is configured, a policy logging the metadata of all requests is used.
+func (k *kubeAPIServer) computeAuditConfig() *apiserver.AuditConfig {
+	if !k.values.SlowRequestLogging || (k.values.Audit != nil && k.values.Audit.Policy != nil) {
+		return k.values.Audit
+	}
+
+	auditConfig := &apiserver.AuditConfig{}
+	if k.values.Audit != nil {
+		*auditConfig = *k.values.Audit
+	}
+	auditConfig.Policy = ptr.To(slowRequestAuditPolicy)
+
+	return auditConfig
+}
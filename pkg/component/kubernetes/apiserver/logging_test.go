@@ -5,6 +5,8 @@
 package apiserver_test
 
 import (
+	"time"
+
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
 	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/filter"
 	fluentbitv1alpha2parser "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2/plugins/parser"
@@ -62,4 +64,42 @@ var _ = Describe("Logging", func() {
 			Expect(loggingConfig.Inputs).To(BeNil())
 		})
 	})
+
+	Describe("#SlowRequestLoggingConfiguration", func() {
+		It("should return the expected logging parser and filter", func() {
+			loggingConfig, err := SlowRequestLoggingConfiguration(1500 * time.Millisecond)()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loggingConfig.Filters).To(HaveLen(1))
+			Expect(loggingConfig.Filters[0].ObjectMeta).To(Equal(metav1.ObjectMeta{
+				Name:   "kube-apiserver--slow-requests",
+				Labels: map[string]string{"fluentbit.gardener/type": "seed"},
+			}))
+			Expect(loggingConfig.Filters[0].Spec.Match).To(Equal("kubernetes.*kube-apiserver*kube-apiserver*"))
+			Expect(loggingConfig.Filters[0].Spec.FilterItems).To(HaveLen(2))
+			Expect(loggingConfig.Filters[0].Spec.FilterItems[0].Parser).To(Equal(&fluentbitv1alpha2filter.Parser{
+				KeyName:     "log",
+				Parser:      "kube-apiserver-audit-parser",
+				ReserveData: ptr.To(true),
+			}))
+			Expect(loggingConfig.Filters[0].Spec.FilterItems[1].Lua.Call).To(Equal("capture_slow_requests"))
+			Expect(loggingConfig.Filters[0].Spec.FilterItems[1].Lua.Code).To(And(
+				HavePrefix("local threshold_milliseconds = 1500\n"),
+				ContainSubstring("function capture_slow_requests(tag, timestamp, record)"),
+			))
+			Expect(loggingConfig.Parsers).To(Equal(
+				[]*fluentbitv1alpha2.ClusterParser{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "kube-apiserver-audit-parser",
+							Labels: map[string]string{"fluentbit.gardener/type": "seed"},
+						},
+						Spec: fluentbitv1alpha2.ParserSpec{
+							JSON: &fluentbitv1alpha2parser.JSON{},
+						},
+					},
+				}))
+			Expect(loggingConfig.Inputs).To(BeNil())
+		})
+	})
 })
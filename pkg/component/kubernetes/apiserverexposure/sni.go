@@ -56,6 +56,12 @@ type SNIValues struct {
 type APIServerProxy struct {
 	NamespaceUID       types.UID
 	APIServerClusterIP string
+	// MaxConnections is the maximum number of concurrent connections on the apiserver-proxy path. If nil, the number of
+	// connections is not limited.
+	MaxConnections *int32
+	// MaxConnectionsPerSecond is the maximum number of new connections per second on the apiserver-proxy path. If nil,
+	// the connection rate is not limited.
+	MaxConnectionsPerSecond *int32
 }
 
 // IstioIngressGateway contains the values for istio ingress gateway configuration.
@@ -243,6 +243,42 @@ var _ = Describe("#SNI", func() {
 			It("should succeed deploying", func() {
 				test()
 			})
+
+			It("should not limit the connections by default", func() {
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				data := getEnvoyFilterData(ctx, c, expectedManagedResource)
+				Expect(data).NotTo(ContainSubstring("envoy.filters.network.connection_limit"))
+				Expect(data).NotTo(ContainSubstring("envoy.filters.network.local_ratelimit"))
+			})
+
+			Context("when connection limits are configured", func() {
+				BeforeEach(func() {
+					apiServerProxyValues.MaxConnections = ptr.To[int32](1000)
+					apiServerProxyValues.MaxConnectionsPerSecond = ptr.To[int32](50)
+				})
+
+				It("should add the connection limit filters before the tcp proxy", func() {
+					test()
+
+					data := getEnvoyFilterData(ctx, c, expectedManagedResource)
+					Expect(data).To(ContainSubstring(`        - name: envoy.filters.network.local_ratelimit
+          typed_config:
+            "@type": type.googleapis.com/envoy.extensions.filters.network.local_ratelimit.v3.LocalRateLimit
+            stat_prefix: "apiserver_proxy.test-namespace"
+            token_bucket:
+              max_tokens: 50
+              tokens_per_fill: 50
+              fill_interval: 1s
+        - name: envoy.filters.network.connection_limit
+          typed_config:
+            "@type": type.googleapis.com/envoy.extensions.filters.network.connection_limit.v3.ConnectionLimit
+            stat_prefix: "apiserver_proxy.test-namespace"
+            max_connections: 1000
+        - name: envoy.filters.network.tcp_proxy
+`))
+				})
+			})
 		})
 
 		Context("when APIServer Proxy is not configured", func() {
@@ -288,3 +324,11 @@ var _ = Describe("#SNI", func() {
 		})
 	})
 })
+
+func getEnvoyFilterData(ctx context.Context, c client.Client, expectedManagedResource *resourcesv1alpha1.ManagedResource) string {
+	managedResource := &resourcesv1alpha1.ManagedResource{}
+	ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(expectedManagedResource), managedResource)).To(Succeed())
+	managedResourceSecret := &corev1.Secret{}
+	ExpectWithOffset(1, c.Get(ctx, client.ObjectKey{Namespace: managedResource.Namespace, Name: managedResource.Spec.SecretRefs[0].Name}, managedResourceSecret)).To(Succeed())
+	return string(managedResourceSecret.Data["envoyfilter__istio-foo__test-namespace.yaml"])
+}
@@ -88,6 +88,8 @@ type Values struct {
 	PodNetworkCIDR string
 	// NodeNetworkCIDR is the CIDR of the node network.
 	NodeNetworkCIDR *string
+	// AdditionalNodeNetworkCIDRs are the CIDRs of additional node networks.
+	AdditionalNodeNetworkCIDRs []string
 	// AutoscalingMode indicates whether cluster proportional autoscaling is enabled.
 	AutoscalingMode gardencorev1beta1.CoreDNSAutoscalingMode
 	// ClusterProportionalAutoscalerImage is the container image used for the cluster proportional autoscaler.
@@ -764,6 +766,12 @@ import custom/*.server
 		})
 	}
 
+	for _, cidr := range c.values.AdditionalNodeNetworkCIDRs {
+		networkPolicy.Spec.Ingress[0].From = append(networkPolicy.Spec.Ingress[0].From, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+
 	if c.values.AutoscalingMode == gardencorev1beta1.CoreDNSAutoscalingModeClusterProportional {
 		managedObjects = append(managedObjects,
 			clusterProportionalDNSAutoscalerServiceAccount,
@@ -201,7 +201,8 @@ spec:
 status:
   loadBalancer: {}
 `
-		networkPolicyYAML = `apiVersion: networking.k8s.io/v1
+		networkPolicyYAMLFor = func(additionalNodeNetworkCIDRs []string) string {
+			out := `apiVersion: networking.k8s.io/v1
 kind: NetworkPolicy
 metadata:
   annotations:
@@ -228,7 +229,13 @@ spec:
         cidr: ` + podNetworkCIDR + `
     - ipBlock:
         cidr: ` + nodeNetworkCIDR + `
-    ports:
+`
+			for _, cidr := range additionalNodeNetworkCIDRs {
+				out += `    - ipBlock:
+        cidr: ` + cidr + `
+`
+			}
+			out += `    ports:
     - port: 9153
       protocol: TCP
     - port: 8053
@@ -245,6 +252,8 @@ spec:
   - Ingress
   - Egress
 `
+			return out
+		}
 		deploymentYAMLFor = func(apiserverHost string, podAnnotations map[string]string, keepReplicas bool, useHALabel bool) string {
 			out := `apiVersion: apps/v1
 kind: Deployment
@@ -725,6 +734,8 @@ status: {}
 			rewritingEnabled    bool
 			commonSuffixes      []string
 			k8sGreaterEquals126 bool
+
+			additionalNodeNetworkCIDRs []string
 		)
 
 		BeforeEach(func() {
@@ -733,6 +744,7 @@ status: {}
 			rewritingEnabled = false
 			commonSuffixes = []string{}
 			k8sGreaterEquals126 = false
+			additionalNodeNetworkCIDRs = nil
 		})
 
 		JustBeforeEach(func() {
@@ -780,7 +792,7 @@ status: {}
 			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns.yaml"])).To(Equal(configMapYAML(rewritingEnabled, commonSuffixes)))
 			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns-custom.yaml"])).To(Equal(configMapCustomYAML))
 			Expect(string(managedResourceSecret.Data["service__kube-system__kube-dns.yaml"])).To(Equal(serviceYAML))
-			Expect(string(managedResourceSecret.Data["networkpolicy__kube-system__gardener.cloud--allow-dns.yaml"])).To(Equal(networkPolicyYAML))
+			Expect(string(managedResourceSecret.Data["networkpolicy__kube-system__gardener.cloud--allow-dns.yaml"])).To(Equal(networkPolicyYAMLFor(additionalNodeNetworkCIDRs)))
 			if k8sGreaterEquals126 {
 				Expect(string(managedResourceSecret.Data["poddisruptionbudget__kube-system__coredns.yaml"])).To(Equal(pdbYAMLFor(true)))
 			} else {
@@ -851,6 +863,17 @@ status: {}
 			})
 		})
 
+		Context("w/ additional node networks", func() {
+			BeforeEach(func() {
+				additionalNodeNetworkCIDRs = []string{"10.20.0.0/16", "10.21.0.0/16"}
+				values.AdditionalNodeNetworkCIDRs = additionalNodeNetworkCIDRs
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(string(managedResourceSecret.Data["deployment__kube-system__coredns.yaml"])).To(Equal(deploymentYAMLFor("", nil, true, true)))
+			})
+		})
+
 		Context("w/ rewriting enabled", func() {
 			BeforeEach(func() {
 				rewritingEnabled = true
@@ -33,6 +33,7 @@ type IngressGatewayValues struct {
 	LoadBalancerIP                     *string
 	MaxReplicas                        *int
 	MinReplicas                        *int
+	TargetConnections                  *int
 	Namespace                          string
 	PriorityClassName                  string
 	TrustDomain                        string
@@ -84,6 +85,9 @@ func (i *istiod) generateIstioIngressGatewayChart() (*chartrenderer.RenderedChar
 		if istioIngressGateway.MaxReplicas != nil {
 			values["maxReplicas"] = *istioIngressGateway.MaxReplicas
 		}
+		if istioIngressGateway.TargetConnections != nil {
+			values["targetConnections"] = *istioIngressGateway.TargetConnections
+		}
 
 		renderedIngressChart, err := i.chartRenderer.RenderEmbeddedFS(chartIngress, chartPathIngress, releaseName, istioIngressGateway.Namespace, values)
 		if err != nil {
@@ -48,6 +48,10 @@ const (
 
 	istiodServicePortNameMetrics = "metrics"
 	releaseName                  = "istio"
+
+	// apiServerProxyStatsRegex matches the stats of the connection limit filters on the apiserver-proxy path, e.g.,
+	// 'envoy_connection_limit_apiserver_proxy_shoot__foo__bar_active_connections'.
+	apiServerProxyStatsRegex = `envoy_(connection_limit|local_rate_limit)_apiserver_proxy_(.+)_(active_connections|limited_connections|rate_limited)`
 )
 
 var (
@@ -261,7 +265,25 @@ func (i *istiod) Deploy(ctx context.Context) error {
 					Regex:        `(.+)`,
 					Replacement:  ptr.To("${1}:15020"),
 				}},
-				MetricRelabelConfigs: monitoringutils.StandardMetricRelabelConfig(
+				MetricRelabelConfigs: append([]monitoringv1.RelabelConfig{
+					// The stats of the connection limit filters on the apiserver-proxy path contain the shoot namespace in
+					// their names, see the EnvoyFilter of the kube-apiserver SNI. It is moved into a label to keep the
+					// number of metric names constant.
+					{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Action:       "replace",
+						Regex:        apiServerProxyStatsRegex,
+						TargetLabel:  "shoot_namespace",
+						Replacement:  ptr.To("${2}"),
+					},
+					{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Action:       "replace",
+						Regex:        apiServerProxyStatsRegex,
+						TargetLabel:  "__name__",
+						Replacement:  ptr.To("envoy_${1}_apiserver_proxy_${3}"),
+					},
+				}, monitoringutils.StandardMetricRelabelConfig(
 					"envoy_cluster_upstream_cx_active",
 					"envoy_cluster_upstream_cx_connect_fail",
 					"envoy_cluster_upstream_cx_total",
@@ -289,7 +311,13 @@ func (i *istiod) Deploy(ctx context.Context) error {
 					"process_open_fds",
 					"process_resident_memory_bytes",
 					"process_virtual_memory_bytes",
-				),
+					"envoy_connection_limit_apiserver_proxy_active_connections",
+					"envoy_connection_limit_apiserver_proxy_limited_connections",
+					"envoy_local_rate_limit_apiserver_proxy_rate_limited",
+					"envoy_tcp_downstream_cx_total",
+					"envoy_tcp_downstream_cx_rx_bytes_total",
+					"envoy_tcp_downstream_cx_tx_bytes_total",
+				)...),
 			}},
 		},
 	}); err != nil {
@@ -104,6 +104,10 @@ type NetworkValues struct {
 	ServiceCIDR string
 	// NodeCIDR is the CIDR of the node network.
 	NodeCIDR string
+	// AdditionalNodeCIDRs are the CIDRs of additional node networks.
+	AdditionalNodeCIDRs []string
+	// PathMTU is the smallest MTU of the network paths between the seed and the shoot nodes.
+	PathMTU *int32
 	// IPFamilies are the IPFamilies of the shoot
 	IPFamilies []gardencorev1beta1.IPFamily
 }
@@ -418,6 +422,20 @@ func (v *vpnSeedServer) podTemplate(configMap *corev1.ConfigMap, secretCAVPN, se
 		},
 	}
 
+	if len(v.values.Network.AdditionalNodeCIDRs) > 0 {
+		template.Spec.Containers[0].Env = append(template.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "ADDITIONAL_NODE_NETWORKS",
+			Value: strings.Join(v.values.Network.AdditionalNodeCIDRs, ","),
+		})
+	}
+
+	if v.values.Network.PathMTU != nil {
+		template.Spec.Containers[0].Env = append(template.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "PATH_MTU",
+			Value: strconv.Itoa(int(*v.values.Network.PathMTU)),
+		})
+	}
+
 	if !v.values.HighAvailabilityEnabled {
 		template.Spec.Containers = append(template.Spec.Containers, corev1.Container{
 			Name:            envoyProxyContainerName,
@@ -836,6 +836,40 @@ var _ = Describe("VpnSeedServer", func() {
 					Expect(actualDeployment).To(DeepEqual(expectedDeployment))
 				})
 
+				Context("w/ additional node networks", func() {
+					BeforeEach(func() {
+						values.Network.AdditionalNodeCIDRs = []string{"10.0.3.0/24", "10.0.4.0/24"}
+					})
+
+					It("should successfully deploy all resources", func() {
+						actualDeployment := &appsv1.Deployment{}
+						expectedDeployment := deployment(values.Network.NodeCIDR)
+						expectedDeployment.Spec.Template.Spec.Containers[0].Env = append(expectedDeployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+							Name:  "ADDITIONAL_NODE_NETWORKS",
+							Value: "10.0.3.0/24,10.0.4.0/24",
+						})
+						Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedDeployment.Namespace, Name: expectedDeployment.Name}, actualDeployment)).To(Succeed())
+						Expect(actualDeployment).To(DeepEqual(expectedDeployment))
+					})
+				})
+
+				Context("w/ path MTU", func() {
+					BeforeEach(func() {
+						values.Network.PathMTU = ptr.To[int32](1400)
+					})
+
+					It("should successfully deploy all resources", func() {
+						actualDeployment := &appsv1.Deployment{}
+						expectedDeployment := deployment(values.Network.NodeCIDR)
+						expectedDeployment.Spec.Template.Spec.Containers[0].Env = append(expectedDeployment.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+							Name:  "PATH_MTU",
+							Value: "1400",
+						})
+						Expect(c.Get(ctx, client.ObjectKey{Namespace: expectedDeployment.Namespace, Name: expectedDeployment.Name}, actualDeployment)).To(Succeed())
+						Expect(actualDeployment).To(DeepEqual(expectedDeployment))
+					})
+				})
+
 				Context("IPv6", func() {
 					BeforeEach(func() {
 						listenAddress = "0.0.0.0"
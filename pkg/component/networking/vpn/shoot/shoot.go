@@ -94,6 +94,8 @@ type Values struct {
 	HighAvailabilityNumberOfSeedServers int
 	// HighAvailabilityNumberOfShootClients is the number of VPN shoot clients used for HA
 	HighAvailabilityNumberOfShootClients int
+	// PathMTU is the smallest MTU of the network paths between the seed and the shoot nodes.
+	PathMTU *int32
 }
 
 // New creates a new instance of DeployWaiter for vpnshoot
@@ -707,6 +709,13 @@ func (v *vpnShoot) getEnvVars(index *int) []corev1.EnvVar {
 		},
 	)
 
+	if v.values.PathMTU != nil {
+		envVariables = append(envVariables, corev1.EnvVar{
+			Name:  "PATH_MTU",
+			Value: strconv.Itoa(int(*v.values.PathMTU)),
+		})
+	}
+
 	if index != nil {
 		envVariables = append(envVariables,
 			[]corev1.EnvVar{
@@ -421,6 +421,13 @@ spec:
 					},
 				)
 
+				if values.PathMTU != nil {
+					env = append(env, corev1.EnvVar{
+						Name:  "PATH_MTU",
+						Value: strconv.Itoa(int(*values.PathMTU)),
+					})
+				}
+
 				volumeMounts = append(volumeMounts,
 					corev1.VolumeMount{
 						Name:      "dev-net-tun",
@@ -802,6 +809,26 @@ spec:
 			})
 		})
 
+		Context("w/ path MTU", func() {
+			BeforeEach(func() {
+				values.VPAEnabled = false
+				values.PathMTU = ptr.To[int32](1400)
+			})
+
+			It("should successfully deploy all resources", func() {
+				var (
+					secretNameClient  = expectVPNShootSecret(managedResourceSecret.Data)
+					secretNameCA      = expectCASecret(managedResourceSecret.Data)
+					secretNameTLSAuth = expectTLSAuthSecret(managedResourceSecret.Data)
+				)
+
+				deployment := &appsv1.Deployment{}
+				Expect(runtime.DecodeInto(newCodec(), managedResourceSecret.Data["deployment__kube-system__vpn-shoot.yaml"], deployment)).To(Succeed())
+				Expect(deployment).To(DeepEqual(deploymentFor(secretNameCA, secretNameClient, secretNameTLSAuth, values.VPAEnabled)))
+				Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "PATH_MTU", Value: "1400"}))
+			})
+		})
+
 		Context("VPNShoot with ReversedVPN enabled", func() {
 			Context("w/o VPA", func() {
 				BeforeEach(func() {
@@ -5,7 +5,6 @@
 package dependencywatchdog
 
 import (
-	proberapi "github.com/gardener/dependency-watchdog/api/prober"
 	weederapi "github.com/gardener/dependency-watchdog/api/weeder"
 )
 
@@ -13,8 +12,4 @@ type (
 	// WeederConfigurationFunc is a function alias for returning configuration for the dependency-watchdog
 	// (weeder role).
 	WeederConfigurationFunc func() (map[string]weederapi.DependantSelectors, error)
-
-	// ProberConfigurationFunc is a function alias for returning configuration for the dependency-watchdog
-	// (prober role).
-	ProberConfigurationFunc func() ([]proberapi.DependentResourceInfo, error)
 )
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -37,6 +38,9 @@ const (
 	vpaName             = "event-logger-vpa"
 	managedResourceName = "shoot-event-logger"
 	roleName            = "gardener.cloud:logging:event-logger"
+
+	volumeNameArchiveStorage      = "archive-storage"
+	volumeMountPathArchiveStorage = "/var/run/secrets/gardener.cloud/event-logger/archive-storage"
 )
 
 // Values are the values for the event-logger.
@@ -45,6 +49,21 @@ type Values struct {
 	Image string
 	// Replicas is the number of pod replicas.
 	Replicas int32
+	// Archive contains the settings for exporting the events to object storage. If nil, events are not archived.
+	Archive *ArchiveValues
+}
+
+// ArchiveValues are the values for archiving events to object storage.
+type ArchiveValues struct {
+	// StorageProvider is the provider type of the object storage.
+	StorageProvider string
+	// SecretName is the name of the secret in the control plane namespace which contains the credentials and the
+	// bucket name of the object storage.
+	SecretName string
+	// Prefix is the prefix of the objects to which the events are written in the bucket.
+	Prefix string
+	// Retention is the duration for which archived events are retained.
+	Retention time.Duration
 }
 type eventLogger struct {
 	client         client.Client
@@ -231,6 +250,17 @@ func (l *eventLogger) reconcileDeployment(ctx context.Context) error {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
 	}
 
+	podLabels := utils.MergeStringMaps(getLabels(), map[string]string{
+		v1beta1constants.LabelNetworkPolicyToDNS:                                                                    v1beta1constants.LabelNetworkPolicyAllowed,
+		v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer:                                                       v1beta1constants.LabelNetworkPolicyAllowed,
+		gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
+	})
+	if l.values.Archive != nil {
+		// The object storage is reached via the public or private networks of the seed.
+		podLabels[v1beta1constants.LabelNetworkPolicyToPublicNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+		podLabels[v1beta1constants.LabelNetworkPolicyToPrivateNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+	}
+
 	deployment := l.emptyDeployment()
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, l.client, deployment, func() error {
 		deployment.Labels = getLabels()
@@ -242,11 +272,7 @@ func (l *eventLogger) reconcileDeployment(ctx context.Context) error {
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: utils.MergeStringMaps(getLabels(), map[string]string{
-						v1beta1constants.LabelNetworkPolicyToDNS:                                                                    v1beta1constants.LabelNetworkPolicyAllowed,
-						v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer:                                                       v1beta1constants.LabelNetworkPolicyAllowed,
-						gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
-					}),
+					Labels: podLabels,
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: name,
@@ -269,6 +295,22 @@ func (l *eventLogger) reconcileDeployment(ctx context.Context) error {
 			},
 		}
 
+		if l.values.Archive != nil {
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      volumeNameArchiveStorage,
+				MountPath: volumeMountPathArchiveStorage,
+				ReadOnly:  true,
+			})
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: volumeNameArchiveStorage,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: l.values.Archive.SecretName,
+					},
+				},
+			})
+		}
+
 		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, gardenerutils.SecretNamePrefixShootAccess+name))
 
 		return nil
@@ -344,12 +386,23 @@ func (l *eventLogger) emptyVPA() *vpaautoscalingv1.VerticalPodAutoscaler {
 }
 
 func (l *eventLogger) computeCommand() []string {
-	return []string{
+	command := []string{
 		"./event-logger",
 		"--seed-event-namespaces=" + l.namespace,
 		"--shoot-kubeconfig=" + gardenerutils.PathGenericKubeconfig,
 		"--shoot-event-namespaces=" + metav1.NamespaceSystem + "," + metav1.NamespaceDefault,
 	}
+
+	if l.values.Archive != nil {
+		command = append(command,
+			"--archive-storage-provider="+l.values.Archive.StorageProvider,
+			"--archive-storage-secret-dir="+volumeMountPathArchiveStorage,
+			"--archive-storage-prefix="+l.values.Archive.Prefix,
+			"--archive-retention="+l.values.Archive.Retention.String(),
+		)
+	}
+
+	return command
 }
 
 func getLabels() map[string]string {
@@ -6,6 +6,7 @@ package eventlogger_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -499,6 +500,50 @@ var _ = Describe("EventLogger", func() {
 				},
 			}))
 		})
+		It("should configure the archiving of events", func() {
+			var err error
+			eventLoggerDeployer, err = New(
+				c,
+				namespace,
+				fakeSecretManager,
+				Values{
+					Image:    image,
+					Replicas: 1,
+					Archive: &ArchiveValues{
+						StorageProvider: "aws",
+						SecretName:      "etcd-backup",
+						Prefix:          "shoot--foo--bar--uid/events",
+						Retention:       30 * 24 * time.Hour,
+					},
+				},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(eventLoggerDeployer.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(eventLoggerDeployment), eventLoggerDeployment)).To(Succeed())
+			Expect(eventLoggerDeployment.Spec.Template.Labels).To(And(
+				HaveKeyWithValue("networking.gardener.cloud/to-public-networks", "allowed"),
+				HaveKeyWithValue("networking.gardener.cloud/to-private-networks", "allowed"),
+			))
+			Expect(eventLoggerDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+				"--archive-storage-provider=aws",
+				"--archive-storage-secret-dir=/var/run/secrets/gardener.cloud/event-logger/archive-storage",
+				"--archive-storage-prefix=shoot--foo--bar--uid/events",
+				"--archive-retention=720h0m0s",
+			))
+			Expect(eventLoggerDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "archive-storage",
+				MountPath: "/var/run/secrets/gardener.cloud/event-logger/archive-storage",
+				ReadOnly:  true,
+			}))
+			Expect(eventLoggerDeployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "archive-storage",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "etcd-backup"},
+				},
+			}))
+		})
 	})
 
 	Describe("#Destroy", func() {
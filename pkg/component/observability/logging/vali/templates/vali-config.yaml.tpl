@@ -16,6 +16,12 @@ limits_config:
   enforce_metric_name: false
   reject_old_samples: true
   reject_old_samples_max_age: 168h
+{{- if .IngestionRateMB }}
+  ingestion_rate_mb: {{ .IngestionRateMB }}
+{{- end }}
+{{- if .IngestionBurstSizeMB }}
+  ingestion_burst_size_mb: {{ .IngestionBurstSizeMB }}
+{{- end }}
 schema_config:
   configs:
   - from: 2018-04-15
@@ -33,7 +39,7 @@ storage_config:
   filesystem:
     directory: /data/vali/chunks
 chunk_store_config:
-  max_look_back_period: 360h
+  max_look_back_period: {{ .RetentionPeriod }}
 table_manager:
   retention_deletes_enabled: true
-  retention_period: 360h
+  retention_period: {{ .RetentionPeriod }}
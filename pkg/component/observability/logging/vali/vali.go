@@ -9,7 +9,9 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"strconv"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -59,6 +61,7 @@ const (
 	valiMountPathData             = "/data"
 	valiMountPathConfig           = "/etc/vali"
 	valiMountPathInitScript       = "/"
+	defaultRetentionPeriod        = 15 * 24 * time.Hour
 
 	valitailName            = "gardener-valitail"
 	valitailClusterRoleName = "gardener.cloud:logging:valitail"
@@ -88,8 +91,9 @@ var (
 	//go:embed templates/vali-init.sh
 	valiInitScript string
 
-	//go:embed templates/vali-config.yaml
-	valiConfig string
+	//go:embed templates/vali-config.yaml.tpl
+	valiConfigTplContent string
+	valiConfigTemplate   *template.Template
 
 	//go:embed templates/telegraf-config.tpl
 	telegrafConfigTplContent string
@@ -101,6 +105,7 @@ var (
 )
 
 func init() {
+	valiConfigTemplate = template.Must(template.New("vali-config").Funcs(sprig.TxtFuncMap()).Parse(valiConfigTplContent))
 	telegrafStartScriptTemplate = template.Must(template.New("telegraf-config").Funcs(sprig.TxtFuncMap()).Parse(telegrafStartScriptTplContent))
 	telegrafConfigTemplate = template.Must(template.New("telegraf-start").Funcs(sprig.TxtFuncMap()).Parse(telegrafConfigTplContent))
 }
@@ -119,6 +124,13 @@ type Values struct {
 	IngressHost             string
 	ShootNodeLoggingEnabled bool
 	Storage                 *resource.Quantity
+	// RetentionPeriod is the period for which logs are retained. It is rounded down to full days. Defaults to 15 days.
+	RetentionPeriod *time.Duration
+	// IngestionRateMB is the amount of log data in megabytes per second which is ingested. Defaults to Vali's default.
+	IngestionRateMB *float64
+	// IngestionBurstSizeMB is the amount of log data in megabytes which may be ingested at once. Defaults to Vali's
+	// default.
+	IngestionBurstSizeMB *float64
 }
 
 type vali struct {
@@ -227,7 +239,10 @@ func (v *vali) Deploy(ctx context.Context) error {
 		}
 	}
 
-	valiConfigMap := v.getValiConfigMap()
+	valiConfigMap, err := v.getValiConfigMap()
+	if err != nil {
+		return err
+	}
 
 	resources = append(resources,
 		valiConfigMap,
@@ -434,7 +449,30 @@ func (v *vali) getService() *corev1.Service {
 	return service
 }
 
-func (v *vali) getValiConfigMap() *corev1.ConfigMap {
+func (v *vali) getValiConfigMap() (*corev1.ConfigMap, error) {
+	retentionPeriod := defaultRetentionPeriod
+	if v.values.RetentionPeriod != nil {
+		retentionPeriod = *v.values.RetentionPeriod
+	}
+	// The retention period must be a multiple of the index period (24h).
+	retentionPeriod = max(retentionPeriod.Truncate(24*time.Hour), 24*time.Hour)
+
+	values := map[string]any{
+		// Vali does not understand durations like "360h0m0s", hence the retention period is rendered in full hours.
+		"RetentionPeriod": fmt.Sprintf("%dh", int64(retentionPeriod.Hours())),
+	}
+	if v.values.IngestionRateMB != nil {
+		values["IngestionRateMB"] = strconv.FormatFloat(*v.values.IngestionRateMB, 'f', -1, 64)
+	}
+	if v.values.IngestionBurstSizeMB != nil {
+		values["IngestionBurstSizeMB"] = strconv.FormatFloat(*v.values.IngestionBurstSizeMB, 'f', -1, 64)
+	}
+
+	var valiConfig bytes.Buffer
+	if err := valiConfigTemplate.Execute(&valiConfig, values); err != nil {
+		return nil, fmt.Errorf("failed to render vali configuration: %w", err)
+	}
+
 	configMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "vali-config",
@@ -442,14 +480,14 @@ func (v *vali) getValiConfigMap() *corev1.ConfigMap {
 			Labels:    getLabels(),
 		},
 		Data: map[string]string{
-			valiDataKeyConfig:     valiConfig,
+			valiDataKeyConfig:     valiConfig.String(),
 			curatorDataKeyConfig:  curatorConfig,
 			valiDataKeyInitScript: valiInitScript,
 		},
 	}
 
 	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
-	return configMap
+	return configMap, nil
 }
 
 func (v *vali) getTelegrafConfigMap() (*corev1.ConfigMap, error) {
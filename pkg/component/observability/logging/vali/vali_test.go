@@ -7,6 +7,8 @@ package vali_test
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,6 +30,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -217,6 +220,50 @@ var _ = Describe("Vali", func() {
 			test.PrometheusRule(getPrometheusRule("shoot"), "testdata/shoot-vali.prometheusrule.test.yaml")
 		})
 
+		It("should render the configured retention period and ingestion limits", func() {
+			valiDeployer := New(
+				c,
+				namespace,
+				fakeSecretManager,
+				Values{
+					Replicas:             1,
+					Storage:              &storage,
+					ValiImage:            valiImage,
+					CuratorImage:         curatorImage,
+					InitLargeDirImage:    initLargeDirImage,
+					TelegrafImage:        telegrafImage,
+					KubeRBACProxyImage:   kubeRBACProxyImage,
+					PriorityClassName:    priorityClassName,
+					ClusterType:          "shoot",
+					IngressHost:          valiHost,
+					RetentionPeriod:      ptr.To(7*24*time.Hour + 5*time.Hour),
+					IngestionRateMB:      ptr.To(2.5),
+					IngestionBurstSizeMB: ptr.To(float64(5)),
+				},
+			)
+
+			Expect(valiDeployer.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			var valiConfig string
+			for key, data := range managedResourceSecret.Data {
+				if strings.HasPrefix(key, "configmap__"+namespace+"__vali-config-") {
+					configMap := &corev1.ConfigMap{}
+					Expect(yaml.Unmarshal(data, configMap)).To(Succeed())
+					valiConfig = configMap.Data["vali.yaml"]
+				}
+			}
+
+			Expect(valiConfig).To(And(
+				ContainSubstring("  reject_old_samples_max_age: 168h\n  ingestion_rate_mb: 2.5\n  ingestion_burst_size_mb: 5\n"),
+				ContainSubstring("  max_look_back_period: 168h\n"),
+				ContainSubstring("  retention_period: 168h\n"),
+			))
+		})
+
 		It("should successfully deploy all resources for seed", func() {
 			valiDeployer := New(
 				c,
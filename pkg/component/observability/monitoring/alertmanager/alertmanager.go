@@ -61,7 +61,7 @@ func (a *alertManager) alertManager() *monitoringv1.Alertmanager {
 		},
 	}
 
-	if a.hasSMTPSecret() {
+	if a.hasConfig() {
 		obj.Spec.AlertmanagerConfiguration = &monitoringv1.AlertmanagerConfiguration{Name: a.name()}
 	}
 
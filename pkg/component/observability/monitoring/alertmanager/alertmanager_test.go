@@ -532,6 +532,103 @@ var _ = Describe("Alertmanager", func() {
 				})
 			})
 
+			When("external receivers are configured", func() {
+				var externalReceiversSecret *corev1.Secret
+
+				BeforeEach(func() {
+					values.ExternalReceivers = []ExternalReceiver{
+						{Name: "mail", Severities: []string{"critical", "blocker"}, EmailReceivers: []string{"ops@example.com"}},
+						{Name: "webhook", WebhookURL: "https://webhook.example.com"},
+						{Name: "slack", SlackURL: "https://hooks.slack.example.com/foo", SlackChannel: "#alerts"},
+					}
+
+					externalReceiversSecret = &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "alertmanager-" + name + "-external-receivers",
+							Namespace: namespace,
+						},
+						Type: corev1.SecretTypeOpaque,
+						Data: map[string][]byte{
+							"webhook": []byte("https://webhook.example.com"),
+							"slack":   []byte("https://hooks.slack.example.com/foo"),
+						},
+					}
+
+					config.Spec.Route.Routes = append([]apiextensionsv1.JSON{
+						{Raw: []byte(`{"receiver":"external-mail","matchers":[{"name":"visibility","value":"all|operator","matchType":"=~"},{"name":"severity","value":"critical|blocker","matchType":"=~"}],"continue":true}`)},
+						{Raw: []byte(`{"receiver":"external-webhook","matchers":[{"name":"visibility","value":"all|operator","matchType":"=~"}],"continue":true}`)},
+						{Raw: []byte(`{"receiver":"external-slack","matchers":[{"name":"visibility","value":"all|operator","matchType":"=~"}],"continue":true}`)},
+					}, config.Spec.Route.Routes...)
+					config.Spec.Receivers = append(config.Spec.Receivers,
+						monitoringv1alpha1.Receiver{
+							Name: "external-mail",
+							EmailConfigs: []monitoringv1alpha1.EmailConfig{{
+								To:           "ops@example.com",
+								From:         string(alertingSMTPSecret.Data["from"]),
+								Smarthost:    string(alertingSMTPSecret.Data["smarthost"]),
+								AuthUsername: string(alertingSMTPSecret.Data["auth_username"]),
+								AuthIdentity: string(alertingSMTPSecret.Data["auth_identity"]),
+								AuthPassword: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "alertmanager-" + name + "-smtp"},
+									Key:                  "auth_password",
+								},
+							}},
+						},
+						monitoringv1alpha1.Receiver{
+							Name: "external-webhook",
+							WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+								URLSecret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "alertmanager-" + name + "-external-receivers"},
+									Key:                  "webhook",
+								},
+								SendResolved: ptr.To(true),
+							}},
+						},
+						monitoringv1alpha1.Receiver{
+							Name: "external-slack",
+							SlackConfigs: []monitoringv1alpha1.SlackConfig{{
+								APIURL: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "alertmanager-" + name + "-external-receivers"},
+									Key:                  "slack",
+								},
+								Channel:      "#alerts",
+								SendResolved: ptr.To(true),
+							}},
+						},
+					)
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResource).To(consistOf(
+						service,
+						alertManager,
+						vpa,
+						config,
+						smtpSecret,
+						externalReceiversSecret,
+					))
+				})
+
+				When("no alerting smtp secret is configured", func() {
+					BeforeEach(func() {
+						values.AlertingSMTPSecret = nil
+					})
+
+					It("should deploy the configuration without email receivers", func() {
+						config.Spec.Route.Routes = config.Spec.Route.Routes[1:3]
+						config.Spec.Receivers = []monitoringv1alpha1.Receiver{config.Spec.Receivers[0], config.Spec.Receivers[3], config.Spec.Receivers[4]}
+
+						Expect(managedResource).To(consistOf(
+							service,
+							alertManager,
+							vpa,
+							config,
+							externalReceiversSecret,
+						))
+					})
+				})
+			})
+
 			When("there are more than 1 replicas", func() {
 				BeforeEach(func() {
 					values.Replicas = 2
@@ -34,6 +34,8 @@ type Interface interface {
 	SetIngressAuthSecret(*corev1.Secret)
 	// SetIngressWildcardCertSecret sets the ingress wildcard certificate secret name.
 	SetIngressWildcardCertSecret(*corev1.Secret)
+	// SetExternalReceivers sets the external receivers to which the alerts are forwarded.
+	SetExternalReceivers([]ExternalReceiver)
 }
 
 // Values contains configuration values for the AlertManager resources.
@@ -59,10 +61,30 @@ type Values struct {
 	// EmailReceivers is a list of email addresses to which alerts should be sent. If this list is empty, the alerts
 	// will be sent to the email address in `.data.to` in the alerting SMTP secret.
 	EmailReceivers []string
+	// ExternalReceivers is a list of external receivers to which the alerts are forwarded in addition.
+	ExternalReceivers []ExternalReceiver
 	// Ingress contains configuration for exposing this AlertManager instance via an Ingress resource.
 	Ingress *IngressValues
 }
 
+// ExternalReceiver contains the configuration for forwarding alerts to an external receiver. Exactly one of
+// EmailReceivers, WebhookURL or SlackURL is expected to be set.
+type ExternalReceiver struct {
+	// Name is the name of the receiver.
+	Name string
+	// Severities is a list of alert severities which are forwarded to the receiver. If empty, alerts of all severities
+	// are forwarded.
+	Severities []string
+	// EmailReceivers is a list of email addresses to which the alerts are sent. This requires the alerting SMTP secret.
+	EmailReceivers []string
+	// WebhookURL is the URL of a generic webhook to which the alerts are sent.
+	WebhookURL string
+	// SlackURL is the URL of a Slack-compatible incoming webhook to which the alerts are sent.
+	SlackURL string
+	// SlackChannel is the channel or user to which the alerts are sent via the Slack-compatible incoming webhook.
+	SlackChannel string
+}
+
 // IngressValues contains configuration for exposing this AlertManager instance via an Ingress resource.
 type IngressValues struct {
 	// AuthSecretName is the name of the auth secret.
@@ -105,13 +127,19 @@ func (a *alertManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	config, err := a.config()
+	if err != nil {
+		return err
+	}
+
 	resources, err := registry.AddAllAndSerialize(
 		a.service(),
 		a.alertManager(),
 		a.vpa(),
 		a.podDisruptionBudget(),
-		a.config(),
+		config,
 		a.smtpSecret(),
+		a.externalReceiversSecret(),
 		ingress,
 	)
 	if err != nil {
@@ -155,6 +183,10 @@ func (a *alertManager) SetIngressWildcardCertSecret(secret *corev1.Secret) {
 	}
 }
 
+func (a *alertManager) SetExternalReceivers(externalReceivers []ExternalReceiver) {
+	a.values.ExternalReceivers = externalReceivers
+}
+
 func (a *alertManager) name() string {
 	return "alertmanager-" + a.values.Name
 }
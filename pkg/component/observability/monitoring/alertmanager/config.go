@@ -5,30 +5,76 @@
 package alertmanager
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/component"
 )
 
-const dataKeyAuthPassword = "auth_password"
+const (
+	dataKeyAuthPassword = "auth_password"
 
-func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
-	if !a.hasSMTPSecret() {
-		return nil
+	externalReceiverNamePrefix = "external-"
+)
+
+func (a *alertManager) config() (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	if !a.hasConfig() {
+		return nil, nil
 	}
 
 	var (
 		emailReceiverName = "email-kubernetes-ops"
 		visibility        = "operator"
+		routes            []apiextensionsv1.JSON
+		receivers         = []monitoringv1alpha1.Receiver{{Name: "dev-null"}}
+		externalReceivers []monitoringv1alpha1.Receiver
 	)
 
 	if a.values.ClusterType == component.ClusterTypeShoot {
 		visibility = "owner"
 	}
 
+	for _, externalReceiver := range a.externalReceivers() {
+		receiverName := externalReceiverNamePrefix + externalReceiver.Name
+
+		matchers := []monitoringv1alpha1.Matcher{{Name: "visibility", Value: "all|" + visibility, MatchType: monitoringv1alpha1.MatchRegexp}}
+		if len(externalReceiver.Severities) > 0 {
+			matchers = append(matchers, monitoringv1alpha1.Matcher{Name: "severity", Value: strings.Join(externalReceiver.Severities, "|"), MatchType: monitoringv1alpha1.MatchRegexp})
+		}
+
+		// Routes to external receivers are evaluated first and continue matching so that the alerts are still sent to
+		// the email receiver.
+		route, err := json.Marshal(monitoringv1alpha1.Route{Receiver: receiverName, Matchers: matchers, Continue: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed marshalling route for external receiver %q: %w", externalReceiver.Name, err)
+		}
+
+		routes = append(routes, apiextensionsv1.JSON{Raw: route})
+		externalReceivers = append(externalReceivers, a.externalReceiver(receiverName, externalReceiver))
+	}
+
+	if a.hasSMTPSecret() {
+		// email only for critical and blocker
+		routes = append(routes, apiextensionsv1.JSON{Raw: []byte(`
+				  {"matchers": [{"name": "visibility",
+				                 "matchType": "=~",
+				                 "value": "all|` + visibility + `"}],
+				   "receiver": "` + emailReceiverName + `"}`)})
+		receivers = append(receivers, monitoringv1alpha1.Receiver{
+			Name:         emailReceiverName,
+			EmailConfigs: a.emailConfigs(),
+		})
+	}
+
+	receivers = append(receivers, externalReceivers...)
+
 	return &monitoringv1alpha1.AlertmanagerConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      a.name(),
@@ -51,12 +97,7 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 				RepeatInterval: "72h",
 				// Send alerts by default to nowhere
 				Receiver: "dev-null",
-				// email only for critical and blocker
-				Routes: []apiextensionsv1.JSON{{Raw: []byte(`
-				  {"matchers": [{"name": "visibility",
-				                 "matchType": "=~",
-				                 "value": "all|` + visibility + `"}],
-				   "receiver": "` + emailReceiverName + `"}`)}},
+				Routes:   routes,
 			},
 			InhibitRules: []monitoringv1alpha1.InhibitRule{
 				// Apply inhibition if the alert name is the same.
@@ -97,15 +138,9 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 					Equal:       []string{"cluster"},
 				},
 			},
-			Receivers: []monitoringv1alpha1.Receiver{
-				{Name: "dev-null"},
-				{
-					Name:         emailReceiverName,
-					EmailConfigs: a.emailConfigs(),
-				},
-			},
+			Receivers: receivers,
 		},
-	}
+	}, nil
 }
 
 func (a *alertManager) smtpSecret() *corev1.Secret {
@@ -127,12 +162,92 @@ func (a *alertManager) hasSMTPSecret() bool {
 	return a.values.AlertingSMTPSecret != nil && string(a.values.AlertingSMTPSecret.Data["auth_type"]) == "smtp"
 }
 
+func (a *alertManager) hasConfig() bool {
+	return a.hasSMTPSecret() || len(a.externalReceivers()) > 0
+}
+
+// externalReceivers returns the external receivers which can be configured. Email receivers are skipped if no alerting
+// SMTP secret is available.
+func (a *alertManager) externalReceivers() []ExternalReceiver {
+	var externalReceivers []ExternalReceiver
+
+	for _, externalReceiver := range a.values.ExternalReceivers {
+		if len(externalReceiver.EmailReceivers) > 0 && !a.hasSMTPSecret() {
+			continue
+		}
+		externalReceivers = append(externalReceivers, externalReceiver)
+	}
+
+	return externalReceivers
+}
+
+func (a *alertManager) externalReceiversSecret() *corev1.Secret {
+	data := make(map[string][]byte)
+
+	for _, externalReceiver := range a.externalReceivers() {
+		switch {
+		case externalReceiver.WebhookURL != "":
+			data[externalReceiver.Name] = []byte(externalReceiver.WebhookURL)
+		case externalReceiver.SlackURL != "":
+			data[externalReceiver.Name] = []byte(externalReceiver.SlackURL)
+		}
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.name() + "-external-receivers",
+			Namespace: a.namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+func (a *alertManager) externalReceiver(name string, externalReceiver ExternalReceiver) monitoringv1alpha1.Receiver {
+	receiver := monitoringv1alpha1.Receiver{Name: name}
+
+	switch {
+	case len(externalReceiver.EmailReceivers) > 0:
+		receiver.EmailConfigs = a.emailConfigsFor(externalReceiver.EmailReceivers)
+	case externalReceiver.WebhookURL != "":
+		receiver.WebhookConfigs = []monitoringv1alpha1.WebhookConfig{{
+			URLSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: a.externalReceiversSecret().Name},
+				Key:                  externalReceiver.Name,
+			},
+			SendResolved: ptr.To(true),
+		}}
+	case externalReceiver.SlackURL != "":
+		slackConfig := monitoringv1alpha1.SlackConfig{
+			APIURL: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: a.externalReceiversSecret().Name},
+				Key:                  externalReceiver.Name,
+			},
+			SendResolved: ptr.To(true),
+		}
+		if externalReceiver.SlackChannel != "" {
+			slackConfig.Channel = externalReceiver.SlackChannel
+		}
+		receiver.SlackConfigs = []monitoringv1alpha1.SlackConfig{slackConfig}
+	}
+
+	return receiver
+}
+
 func (a *alertManager) emailConfigs() []monitoringv1alpha1.EmailConfig {
 	emailReceivers := []string{string(a.values.AlertingSMTPSecret.Data["to"])}
 	if len(a.values.EmailReceivers) > 0 {
 		emailReceivers = a.values.EmailReceivers
 	}
 
+	return a.emailConfigsFor(emailReceivers)
+}
+
+func (a *alertManager) emailConfigsFor(emailReceivers []string) []monitoringv1alpha1.EmailConfig {
 	var configs []monitoringv1alpha1.EmailConfig
 	for _, email := range emailReceivers {
 		configs = append(configs, monitoringv1alpha1.EmailConfig{
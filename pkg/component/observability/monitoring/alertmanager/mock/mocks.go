@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	alertmanager "github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 )
@@ -68,6 +69,18 @@ func (mr *MockInterfaceMockRecorder) Destroy(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), arg0)
 }
 
+// SetExternalReceivers mocks base method.
+func (m *MockInterface) SetExternalReceivers(arg0 []alertmanager.ExternalReceiver) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetExternalReceivers", arg0)
+}
+
+// SetExternalReceivers indicates an expected call of SetExternalReceivers.
+func (mr *MockInterfaceMockRecorder) SetExternalReceivers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternalReceivers", reflect.TypeOf((*MockInterface)(nil).SetExternalReceivers), arg0)
+}
+
 // SetIngressAuthSecret mocks base method.
 func (m *MockInterface) SetIngressAuthSecret(arg0 *v1.Secret) {
 	m.ctrl.T.Helper()
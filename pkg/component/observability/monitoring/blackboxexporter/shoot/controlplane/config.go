@@ -15,7 +15,11 @@ import (
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
-const moduleName = "http_apiserver"
+const (
+	moduleName          = "http_apiserver"
+	moduleNameHTTPProbe = "http_probe"
+	moduleNameTCPProbe  = "tcp_probe"
+)
 
 // Config returns the blackbox-exporter config for the shoot control plane use-case.
 func Config() blackboxexporterconfig.Config {
@@ -35,5 +39,22 @@ func Config() blackboxexporterconfig.Config {
 				IPProtocol: "ipv4",
 			},
 		},
+		moduleNameHTTPProbe: {
+			Prober:  "http",
+			Timeout: 10 * time.Second,
+			HTTP: blackboxexporterconfig.HTTPProbe{
+				HTTPClientConfig: prometheuscommonconfig.HTTPClientConfig{
+					FollowRedirects: true,
+				},
+				IPProtocol: "ipv4",
+			},
+		},
+		moduleNameTCPProbe: {
+			Prober:  "tcp",
+			Timeout: 10 * time.Second,
+			TCP: blackboxexporterconfig.TCPProbe{
+				IPProtocol: "ipv4",
+			},
+		},
 	}}
 }
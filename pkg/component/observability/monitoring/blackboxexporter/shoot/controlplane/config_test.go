@@ -34,6 +34,23 @@ var _ = Describe("Config", func() {
 						IPProtocol: "ipv4",
 					},
 				},
+				"http_probe": {
+					Prober:  "http",
+					Timeout: 10 * time.Second,
+					HTTP: blackboxexporterconfig.HTTPProbe{
+						HTTPClientConfig: prometheuscommonconfig.HTTPClientConfig{
+							FollowRedirects: true,
+						},
+						IPProtocol: "ipv4",
+					},
+				},
+				"tcp_probe": {
+					Prober:  "tcp",
+					Timeout: 10 * time.Second,
+					TCP: blackboxexporterconfig.TCPProbe{
+						IPProtocol: "ipv4",
+					},
+				},
 			}}))
 		})
 	})
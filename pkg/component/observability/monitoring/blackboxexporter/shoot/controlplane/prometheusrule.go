@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
+)
+
+// PrometheusRule returns the prometheus rules related to the blackbox-exporter for the shoot control plane use-case.
+func PrometheusRule(namespace string, probes []gardencorev1beta1.ObservabilityProbe) []*monitoringv1.PrometheusRule {
+	if len(probes) == 0 {
+		return nil
+	}
+
+	return []*monitoringv1.PrometheusRule{{
+		ObjectMeta: monitoringutils.ConfigObjectMeta(ProbesJobName, namespace, shootprometheus.Label),
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{{
+				Name: "observability-probes.rules",
+				Rules: []monitoringv1.Rule{{
+					Alert: "ObservabilityProbeFailed",
+					Expr:  intstr.FromString(`probe_success{job="` + ProbesJobName + `"} == 0`),
+					For:   ptr.To(monitoringv1.Duration("5m")),
+					Labels: map[string]string{
+						"service":    "observability-probes",
+						"severity":   "warning",
+						"type":       "shoot",
+						"visibility": "owner",
+					},
+					Annotations: map[string]string{
+						"summary":     "Observability probe {{$labels.probe}} is failing.",
+						"description": "The observability probe {{$labels.probe}} against {{$labels.instance}} has been failing for 5 minutes.",
+					},
+				}},
+			}},
+		},
+	}}
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/observability/monitoring/blackboxexporter/shoot/controlplane"
+	componenttest "github.com/gardener/gardener/pkg/component/test"
+)
+
+var _ = Describe("PrometheusRule", func() {
+	Describe("#PrometheusRule", func() {
+		namespace := "namespace"
+
+		It("should not return any rules if no probes are configured", func() {
+			Expect(PrometheusRule(namespace, nil)).To(BeEmpty())
+		})
+
+		It("should compute the prometheus rules for the observability probes", func() {
+			expectedPrometheusRule := &monitoringv1.PrometheusRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-blackbox-probes",
+					Namespace: namespace,
+					Labels:    map[string]string{"prometheus": "shoot"},
+				},
+				Spec: monitoringv1.PrometheusRuleSpec{
+					Groups: []monitoringv1.RuleGroup{{
+						Name: "observability-probes.rules",
+						Rules: []monitoringv1.Rule{{
+							Alert: "ObservabilityProbeFailed",
+							Expr:  intstr.FromString(`probe_success{job="blackbox-probes"} == 0`),
+							For:   ptr.To(monitoringv1.Duration("5m")),
+							Labels: map[string]string{
+								"service":    "observability-probes",
+								"severity":   "warning",
+								"type":       "shoot",
+								"visibility": "owner",
+							},
+							Annotations: map[string]string{
+								"summary":     "Observability probe {{$labels.probe}} is failing.",
+								"description": "The observability probe {{$labels.probe}} against {{$labels.instance}} has been failing for 5 minutes.",
+							},
+						}},
+					}},
+				},
+			}
+
+			Expect(PrometheusRule(namespace, []gardencorev1beta1.ObservabilityProbe{{Name: "frontend"}})).To(ConsistOf(expectedPrometheusRule))
+
+			componenttest.PrometheusRule(expectedPrometheusRule, "testdata/shoot-blackbox-probes.prometheusrule.test.yaml")
+		})
+	})
+})
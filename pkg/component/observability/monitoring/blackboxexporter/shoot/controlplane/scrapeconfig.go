@@ -9,14 +9,24 @@ import (
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 )
 
+const (
+	// APIServerJobName is the name of the job scraping the results of the probes of the shoot's API server.
+	APIServerJobName = "blackbox-apiserver"
+	// ProbesJobName is the name of the job scraping the results of the observability probes of the shoot.
+	ProbesJobName = "blackbox-probes"
+	// ProbeLabel is the name of the label containing the name of the observability probe.
+	ProbeLabel = "probe"
+)
+
 // ScrapeConfig returns the scrape configs related to the blackbox-exporter for the shoot control plane use-case.
-func ScrapeConfig(namespace string, kubeAPIServerTarget monitoringv1alpha1.Target) []*monitoringv1alpha1.ScrapeConfig {
-	return []*monitoringv1alpha1.ScrapeConfig{{
-		ObjectMeta: monitoringutils.ConfigObjectMeta("blackbox-apiserver", namespace, shootprometheus.Label),
+func ScrapeConfig(namespace string, kubeAPIServerTarget monitoringv1alpha1.Target, probes []gardencorev1beta1.ObservabilityProbe) []*monitoringv1alpha1.ScrapeConfig {
+	scrapeConfigs := []*monitoringv1alpha1.ScrapeConfig{{
+		ObjectMeta: monitoringutils.ConfigObjectMeta(APIServerJobName, namespace, shootprometheus.Label),
 		Spec: monitoringv1alpha1.ScrapeConfigSpec{
 			Params:      map[string][]string{"module": {moduleName}},
 			MetricsPath: ptr.To("/probe"),
@@ -45,10 +55,68 @@ func ScrapeConfig(namespace string, kubeAPIServerTarget monitoringv1alpha1.Targe
 				},
 				{
 					Action:      "replace",
-					Replacement: ptr.To("blackbox-apiserver"),
+					Replacement: ptr.To(APIServerJobName),
 					TargetLabel: "job",
 				},
 			},
 		},
 	}}
+
+	if len(probes) > 0 {
+		scrapeConfigs = append(scrapeConfigs, probesScrapeConfig(namespace, probes))
+	}
+
+	return scrapeConfigs
+}
+
+func probesScrapeConfig(namespace string, probes []gardencorev1beta1.ObservabilityProbe) *monitoringv1alpha1.ScrapeConfig {
+	staticConfigs := make([]monitoringv1alpha1.StaticConfig, 0, len(probes))
+	for _, probe := range probes {
+		module := moduleNameHTTPProbe
+		if probe.Protocol == gardencorev1beta1.ObservabilityProbeProtocolTCP {
+			module = moduleNameTCPProbe
+		}
+
+		staticConfigs = append(staticConfigs, monitoringv1alpha1.StaticConfig{
+			Targets: []monitoringv1alpha1.Target{monitoringv1alpha1.Target(probe.Target)},
+			Labels: map[monitoringv1.LabelName]string{
+				"__param_module": module,
+				ProbeLabel:       probe.Name,
+			},
+		})
+	}
+
+	return &monitoringv1alpha1.ScrapeConfig{
+		ObjectMeta: monitoringutils.ConfigObjectMeta(ProbesJobName, namespace, shootprometheus.Label),
+		Spec: monitoringv1alpha1.ScrapeConfigSpec{
+			MetricsPath:   ptr.To("/probe"),
+			StaticConfigs: staticConfigs,
+			RelabelConfigs: []monitoringv1.RelabelConfig{
+				{
+					TargetLabel: "type",
+					Replacement: ptr.To("shoot"),
+				},
+				{
+					SourceLabels: []monitoringv1.LabelName{"__address__"},
+					TargetLabel:  "__param_target",
+					Action:       "replace",
+				},
+				{
+					SourceLabels: []monitoringv1.LabelName{"__param_target"},
+					TargetLabel:  "instance",
+					Action:       "replace",
+				},
+				{
+					TargetLabel: "__address__",
+					Replacement: ptr.To("blackbox-exporter:9115"),
+					Action:      "replace",
+				},
+				{
+					Action:      "replace",
+					Replacement: ptr.To(ProbesJobName),
+					TargetLabel: "job",
+				},
+			},
+		},
+	}
 }
@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/component/observability/monitoring/blackboxexporter/shoot/controlplane"
 )
 
@@ -23,7 +24,7 @@ var _ = Describe("ScrapeConfig", func() {
 		)
 
 		It("should compute the scrape configs", func() {
-			Expect(ScrapeConfig(namespace, kubeAPIServerTarget)).To(ContainElements(
+			Expect(ScrapeConfig(namespace, kubeAPIServerTarget, nil)).To(ConsistOf(
 				&monitoringv1alpha1.ScrapeConfig{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      "shoot-blackbox-apiserver",
@@ -66,5 +67,61 @@ var _ = Describe("ScrapeConfig", func() {
 				},
 			))
 		})
+
+		It("should compute the scrape config for the observability probes", func() {
+			probes := []gardencorev1beta1.ObservabilityProbe{
+				{Name: "frontend", Protocol: gardencorev1beta1.ObservabilityProbeProtocolHTTP, Target: "https://shop.example.com/healthz"},
+				{Name: "database", Protocol: gardencorev1beta1.ObservabilityProbeProtocolTCP, Target: "10.0.1.12:5432"},
+			}
+
+			scrapeConfigs := ScrapeConfig(namespace, kubeAPIServerTarget, probes)
+			Expect(scrapeConfigs).To(HaveLen(2))
+			Expect(scrapeConfigs[1]).To(Equal(&monitoringv1alpha1.ScrapeConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-blackbox-probes",
+					Namespace: namespace,
+					Labels:    map[string]string{"prometheus": "shoot"},
+				},
+				Spec: monitoringv1alpha1.ScrapeConfigSpec{
+					MetricsPath: ptr.To("/probe"),
+					StaticConfigs: []monitoringv1alpha1.StaticConfig{
+						{
+							Targets: []monitoringv1alpha1.Target{"https://shop.example.com/healthz"},
+							Labels:  map[monitoringv1.LabelName]string{"__param_module": "http_probe", "probe": "frontend"},
+						},
+						{
+							Targets: []monitoringv1alpha1.Target{"10.0.1.12:5432"},
+							Labels:  map[monitoringv1.LabelName]string{"__param_module": "tcp_probe", "probe": "database"},
+						},
+					},
+					RelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							TargetLabel: "type",
+							Replacement: ptr.To("shoot"),
+						},
+						{
+							SourceLabels: []monitoringv1.LabelName{"__address__"},
+							TargetLabel:  "__param_target",
+							Action:       "replace",
+						},
+						{
+							SourceLabels: []monitoringv1.LabelName{"__param_target"},
+							TargetLabel:  "instance",
+							Action:       "replace",
+						},
+						{
+							TargetLabel: "__address__",
+							Replacement: ptr.To("blackbox-exporter:9115"),
+							Action:      "replace",
+						},
+						{
+							Action:      "replace",
+							Replacement: ptr.To("blackbox-probes"),
+							TargetLabel: "job",
+						},
+					},
+				},
+			}))
+		})
 	})
 })
@@ -26,8 +26,17 @@ func AdditionalScrapeConfigs() []string {
 	return []string{cAdvisor}
 }
 
-// CentralScrapeConfigs returns the central ScrapeConfig resources for the garden prometheus.
-func CentralScrapeConfigs(prometheusAggregateTargets []monitoringv1alpha1.Target, globalMonitoringSecret *corev1.Secret) []*monitoringv1alpha1.ScrapeConfig {
+// AggregateTarget is the aggregate prometheus of a seed whose metrics are federated into the garden prometheus.
+type AggregateTarget struct {
+	// SeedName is the name of the seed. It is added as `seed` label to the series of the target.
+	SeedName string
+	// Address is the address of the aggregate prometheus of the seed.
+	Address monitoringv1alpha1.Target
+}
+
+// CentralScrapeConfigs returns the central ScrapeConfig resources for the garden prometheus. The additional matchers
+// are federated from the aggregate prometheis in addition to the default set of metrics.
+func CentralScrapeConfigs(prometheusAggregateTargets []AggregateTarget, globalMonitoringSecret *corev1.Secret, additionalMatchers []string) []*monitoringv1alpha1.ScrapeConfig {
 	out := []*monitoringv1alpha1.ScrapeConfig{{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "prometheus",
@@ -46,6 +55,14 @@ func CentralScrapeConfigs(prometheusAggregateTargets []monitoringv1alpha1.Target
 	}}
 
 	if len(prometheusAggregateTargets) > 0 && globalMonitoringSecret != nil {
+		staticConfigs := make([]monitoringv1alpha1.StaticConfig, 0, len(prometheusAggregateTargets))
+		for _, target := range prometheusAggregateTargets {
+			staticConfigs = append(staticConfigs, monitoringv1alpha1.StaticConfig{
+				Targets: []monitoringv1alpha1.Target{target.Address},
+				Labels:  map[monitoringv1.LabelName]string{"seed": target.SeedName},
+			})
+		}
+
 		out = append(out, &monitoringv1alpha1.ScrapeConfig{
 			ObjectMeta: metav1.ObjectMeta{Name: "prometheus-" + aggregate.Label},
 			Spec: monitoringv1alpha1.ScrapeConfigSpec{
@@ -54,7 +71,7 @@ func CentralScrapeConfigs(prometheusAggregateTargets []monitoringv1alpha1.Target
 				MetricsPath:     ptr.To("/federate"),
 				Scheme:          ptr.To("HTTPS"),
 				Params: map[string][]string{
-					"match[]": {
+					"match[]": append([]string{
 						`{__name__=~"seed:(.+):count"}`,
 						`{__name__=~"seed:(.+):sum"}`,
 						`{__name__=~"seed:(.+):sum_cp"}`,
@@ -68,14 +85,14 @@ func CentralScrapeConfigs(prometheusAggregateTargets []monitoringv1alpha1.Target
 						`{__name__="seed:persistentvolume:inconsistent_size"}`,
 						`{__name__="seed:kube_pod_container_status_restarts_total:max_by_namespace"}`,
 						`{__name__=~"metering:.+:(sum_by_namespace|sum_by_instance_type)"}`,
-					},
+					}, additionalMatchers...),
 				},
 				TLSConfig: &monitoringv1.SafeTLSConfig{InsecureSkipVerify: ptr.To(true)},
 				BasicAuth: &monitoringv1.BasicAuth{
 					Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: globalMonitoringSecret.Name}, Key: secretsutils.DataKeyUserName},
 					Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: globalMonitoringSecret.Name}, Key: secretsutils.DataKeyPassword},
 				},
-				StaticConfigs: []monitoringv1alpha1.StaticConfig{{Targets: prometheusAggregateTargets}},
+				StaticConfigs: staticConfigs,
 				RelabelConfigs: []monitoringv1.RelabelConfig{{
 					Action:      "replace",
 					Replacement: ptr.To("prometheus-" + aggregate.Label),
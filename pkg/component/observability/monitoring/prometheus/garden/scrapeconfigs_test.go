@@ -79,26 +79,20 @@ metric_relabel_configs:
 
 		When("no global monitoring secret provided", func() {
 			It("should only contain the prometheus-garden scrape config", func() {
-				Expect(garden.CentralScrapeConfigs(nil, nil)).To(HaveExactElements(scrapeConfigPrometheus))
+				Expect(garden.CentralScrapeConfigs(nil, nil, nil)).To(HaveExactElements(scrapeConfigPrometheus))
 			})
 		})
 
 		When("global monitoring secret provided", func() {
 			var (
-				prometheusAggregateTargets = []monitoringv1alpha1.Target{"foo", "bar"}
-				globalMonitoringSecret     = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "global-monitoring-secret"}}
-			)
-
-			When("there are no aggregate targets", func() {
-				It("should only contain the prometheus-garden scrape config", func() {
-					Expect(garden.CentralScrapeConfigs(nil, globalMonitoringSecret)).To(HaveExactElements(scrapeConfigPrometheus))
-				})
-			})
+				prometheusAggregateTargets = []garden.AggregateTarget{
+					{SeedName: "foo", Address: "p-seed.ingress.foo"},
+					{SeedName: "bar", Address: "p-seed.ingress.bar"},
+				}
+				globalMonitoringSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "global-monitoring-secret"}}
 
-			It("should also contain the aggregate prometheus scrape config", func() {
-				Expect(garden.CentralScrapeConfigs(prometheusAggregateTargets, globalMonitoringSecret)).To(HaveExactElements(
-					scrapeConfigPrometheus,
-					&monitoringv1alpha1.ScrapeConfig{
+				scrapeConfigAggregate = func(additionalMatchers ...string) *monitoringv1alpha1.ScrapeConfig {
+					return &monitoringv1alpha1.ScrapeConfig{
 						ObjectMeta: metav1.ObjectMeta{Name: "prometheus-aggregate"},
 						Spec: monitoringv1alpha1.ScrapeConfigSpec{
 							HonorLabels:     ptr.To(true),
@@ -106,7 +100,7 @@ metric_relabel_configs:
 							MetricsPath:     ptr.To("/federate"),
 							Scheme:          ptr.To("HTTPS"),
 							Params: map[string][]string{
-								"match[]": {
+								"match[]": append([]string{
 									`{__name__=~"seed:(.+):count"}`,
 									`{__name__=~"seed:(.+):sum"}`,
 									`{__name__=~"seed:(.+):sum_cp"}`,
@@ -120,14 +114,17 @@ metric_relabel_configs:
 									`{__name__="seed:persistentvolume:inconsistent_size"}`,
 									`{__name__="seed:kube_pod_container_status_restarts_total:max_by_namespace"}`,
 									`{__name__=~"metering:.+:(sum_by_namespace|sum_by_instance_type)"}`,
-								},
+								}, additionalMatchers...),
 							},
 							TLSConfig: &monitoringv1.SafeTLSConfig{InsecureSkipVerify: ptr.To(true)},
 							BasicAuth: &monitoringv1.BasicAuth{
 								Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: globalMonitoringSecret.Name}, Key: "username"},
 								Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: globalMonitoringSecret.Name}, Key: "password"},
 							},
-							StaticConfigs: []monitoringv1alpha1.StaticConfig{{Targets: prometheusAggregateTargets}},
+							StaticConfigs: []monitoringv1alpha1.StaticConfig{
+								{Targets: []monitoringv1alpha1.Target{"p-seed.ingress.foo"}, Labels: map[monitoringv1.LabelName]string{"seed": "foo"}},
+								{Targets: []monitoringv1alpha1.Target{"p-seed.ingress.bar"}, Labels: map[monitoringv1.LabelName]string{"seed": "bar"}},
+							},
 							RelabelConfigs: []monitoringv1.RelabelConfig{{
 								Action:      "replace",
 								Replacement: ptr.To("prometheus-aggregate"),
@@ -138,7 +135,27 @@ metric_relabel_configs:
 								TargetLabel:  "shoot_alertname",
 							}},
 						},
-					},
+					}
+				}
+			)
+
+			When("there are no aggregate targets", func() {
+				It("should only contain the prometheus-garden scrape config", func() {
+					Expect(garden.CentralScrapeConfigs(nil, globalMonitoringSecret, nil)).To(HaveExactElements(scrapeConfigPrometheus))
+				})
+			})
+
+			It("should also contain the aggregate prometheus scrape config", func() {
+				Expect(garden.CentralScrapeConfigs(prometheusAggregateTargets, globalMonitoringSecret, nil)).To(HaveExactElements(
+					scrapeConfigPrometheus,
+					scrapeConfigAggregate(),
+				))
+			})
+
+			It("should federate the additional matchers", func() {
+				Expect(garden.CentralScrapeConfigs(prometheusAggregateTargets, globalMonitoringSecret, []string{`{__name__="foo"}`})).To(HaveExactElements(
+					scrapeConfigPrometheus,
+					scrapeConfigAggregate(`{__name__="foo"}`),
 				))
 			})
 		})
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plutono
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// DashboardTarget is the Plutono instance dashboards are provisioned to.
+type DashboardTarget string
+
+const (
+	// DashboardTargetGarden is the Plutono instance in the garden runtime cluster.
+	DashboardTargetGarden DashboardTarget = "garden"
+	// DashboardTargetSeed is the Plutono instance in the garden namespace of seed clusters.
+	DashboardTargetSeed DashboardTarget = "seed"
+	// DashboardTargetShoot is the Plutono instance in the control plane namespace of shoot clusters.
+	DashboardTargetShoot DashboardTarget = "shoot"
+)
+
+// dashboardFolderRegex is the regular expression which folder names of dashboards must match. Folders are mapped to
+// directories by the dashboard refresher, hence path separators and relative path elements are forbidden.
+var dashboardFolderRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9 _.-]*[a-zA-Z0-9])?$`)
+
+// DashboardLabel returns the key of the label (with value `true`) which makes Plutono of the given target pick up
+// the dashboards contained in a ConfigMap in the same namespace.
+func DashboardLabel(target DashboardTarget) string {
+	return v1beta1constants.LabelPrefixMonitoringDashboard + string(target)
+}
+
+// NewDashboardConfigMap returns a ConfigMap containing the given dashboards (JSON documents keyed by their file name)
+// which is picked up by the Plutono instance of the given target. If a folder is given, the dashboards are organized in
+// a Plutono folder with this name, otherwise they are placed in the `General` folder. The dashboards are compacted to
+// prevent hitting the size limit of ConfigMaps.
+func NewDashboardConfigMap(name, namespace string, target DashboardTarget, folder string, dashboards map[string]string) (*corev1.ConfigMap, error) {
+	if err := ValidateDashboardFolder(folder); err != nil {
+		return nil, err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{DashboardLabel(target): dashboardLabelValue},
+		},
+		Data: make(map[string]string, len(dashboards)),
+	}
+
+	if folder != "" {
+		metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, v1beta1constants.AnnotationMonitoringDashboardFolder, folder)
+	}
+
+	for key, dashboard := range dashboards {
+		if !strings.HasSuffix(key, ".json") {
+			return nil, fmt.Errorf("key %q of dashboard must have the suffix .json", key)
+		}
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return nil, fmt.Errorf("key %q of dashboard is invalid: %s", key, strings.Join(errs, ", "))
+		}
+
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, []byte(dashboard)); err != nil {
+			return nil, fmt.Errorf("dashboard %q is no valid JSON document: %w", key, err)
+		}
+		configMap.Data[key] = compacted.String()
+	}
+
+	return configMap, nil
+}
+
+// ValidateDashboardFolder returns an error if the given folder name is not suitable for organizing dashboards.
+func ValidateDashboardFolder(folder string) error {
+	if folder != "" && !dashboardFolderRegex.MatchString(folder) {
+		return fmt.Errorf("dashboard folder %q is invalid, it must match %s", folder, dashboardFolderRegex.String())
+	}
+	return nil
+}
+
+// CheckDashboardConflicts returns an error if the dashboards of the given ConfigMaps conflict with each other, i.e., if
+// multiple dashboards would be written to the same file in the same folder, or if multiple dashboards share the same
+// UID. Plutono would silently drop all but one of such dashboards otherwise.
+func CheckDashboardConflicts(configMaps []corev1.ConfigMap) error {
+	var (
+		conflicts    []string
+		filesToOwner = map[string]string{}
+		uidsToOwner  = map[string]string{}
+	)
+
+	configMaps = slices.Clone(configMaps)
+	slices.SortFunc(configMaps, func(a, b corev1.ConfigMap) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, configMap := range configMaps {
+		folder := configMap.Annotations[v1beta1constants.AnnotationMonitoringDashboardFolder]
+		if err := ValidateDashboardFolder(folder); err != nil {
+			conflicts = append(conflicts, fmt.Sprintf("ConfigMap %s: %s", configMap.Name, err))
+			continue
+		}
+
+		keys := make([]string, 0, len(configMap.Data))
+		for key := range configMap.Data {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			owner := configMap.Name + "/" + key
+
+			file := path.Join(folder, key)
+			if other, ok := filesToOwner[file]; ok {
+				conflicts = append(conflicts, fmt.Sprintf("dashboards %s and %s are both written to file %q", other, owner, file))
+			} else {
+				filesToOwner[file] = owner
+			}
+
+			uid := dashboardUID(configMap.Data[key])
+			if uid == "" {
+				continue
+			}
+			if other, ok := uidsToOwner[uid]; ok {
+				conflicts = append(conflicts, fmt.Sprintf("dashboards %s and %s have the same UID %q", other, owner, uid))
+			} else {
+				uidsToOwner[uid] = owner
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("found conflicting dashboards: %s", strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+func dashboardUID(dashboard string) string {
+	var metadata struct {
+		UID string `json:"uid"`
+	}
+	// Dashboards which cannot be parsed are not subject to UID conflicts, Plutono will reject them anyway.
+	if err := json.Unmarshal([]byte(dashboard), &metadata); err != nil {
+		return ""
+	}
+	return metadata.UID
+}
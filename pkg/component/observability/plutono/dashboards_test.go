@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plutono_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/component/observability/plutono"
+)
+
+var _ = Describe("Dashboards", func() {
+	Describe("#DashboardLabel", func() {
+		It("should return the label for the respective target", func() {
+			Expect(DashboardLabel(DashboardTargetGarden)).To(Equal("dashboard.monitoring.gardener.cloud/garden"))
+			Expect(DashboardLabel(DashboardTargetSeed)).To(Equal("dashboard.monitoring.gardener.cloud/seed"))
+			Expect(DashboardLabel(DashboardTargetShoot)).To(Equal("dashboard.monitoring.gardener.cloud/shoot"))
+		})
+	})
+
+	Describe("#NewDashboardConfigMap", func() {
+		It("should return a ConfigMap with compacted dashboards", func() {
+			configMap, err := NewDashboardConfigMap("extension-foo-dashboards", "shoot--foo--bar", DashboardTargetShoot, "", map[string]string{
+				"foo.json": "{\n  \"title\": \"Foo\"\n}",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMap).To(Equal(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "extension-foo-dashboards",
+					Namespace: "shoot--foo--bar",
+					Labels:    map[string]string{"dashboard.monitoring.gardener.cloud/shoot": "true"},
+				},
+				Data: map[string]string{"foo.json": `{"title":"Foo"}`},
+			}))
+		})
+
+		It("should annotate the ConfigMap with the folder", func() {
+			configMap, err := NewDashboardConfigMap("foo", "garden", DashboardTargetSeed, "Extension Foo", map[string]string{"foo.json": "{}"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMap.Labels).To(HaveKeyWithValue("dashboard.monitoring.gardener.cloud/seed", "true"))
+			Expect(configMap.Annotations).To(HaveKeyWithValue("dashboard.monitoring.gardener.cloud/folder", "Extension Foo"))
+		})
+
+		It("should fail for an invalid folder", func() {
+			_, err := NewDashboardConfigMap("foo", "garden", DashboardTargetSeed, "../foo", nil)
+			Expect(err).To(MatchError(ContainSubstring(`dashboard folder "../foo" is invalid`)))
+		})
+
+		It("should fail for keys without JSON suffix", func() {
+			_, err := NewDashboardConfigMap("foo", "garden", DashboardTargetSeed, "", map[string]string{"foo.yaml": "{}"})
+			Expect(err).To(MatchError(ContainSubstring("must have the suffix .json")))
+		})
+
+		It("should fail for invalid keys", func() {
+			_, err := NewDashboardConfigMap("foo", "garden", DashboardTargetSeed, "", map[string]string{"foo/bar.json": "{}"})
+			Expect(err).To(MatchError(ContainSubstring(`key "foo/bar.json" of dashboard is invalid`)))
+		})
+
+		It("should fail for invalid JSON documents", func() {
+			_, err := NewDashboardConfigMap("foo", "garden", DashboardTargetSeed, "", map[string]string{"foo.json": "{"})
+			Expect(err).To(MatchError(ContainSubstring(`dashboard "foo.json" is no valid JSON document`)))
+		})
+	})
+
+	Describe("#CheckDashboardConflicts", func() {
+		newConfigMap := func(name, folder string, data map[string]string) corev1.ConfigMap {
+			configMap := corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name}, Data: data}
+			if folder != "" {
+				configMap.Annotations = map[string]string{"dashboard.monitoring.gardener.cloud/folder": folder}
+			}
+			return configMap
+		}
+
+		It("should succeed if there are no conflicts", func() {
+			Expect(CheckDashboardConflicts([]corev1.ConfigMap{
+				newConfigMap("a", "", map[string]string{"foo.json": `{"uid":"foo"}`, "bar.json": `{"uid":"bar"}`}),
+				newConfigMap("b", "B", map[string]string{"foo.json": `{"uid":"b-foo"}`}),
+				newConfigMap("c", "", map[string]string{"baz.json": `{}`, "qux.json": `invalid`}),
+			})).To(Succeed())
+		})
+
+		It("should detect dashboards written to the same file", func() {
+			Expect(CheckDashboardConflicts([]corev1.ConfigMap{
+				newConfigMap("b", "Foo", map[string]string{"foo.json": `{}`}),
+				newConfigMap("a", "Foo", map[string]string{"foo.json": `{}`}),
+			})).To(MatchError(`found conflicting dashboards: dashboards a/foo.json and b/foo.json are both written to file "Foo/foo.json"`))
+		})
+
+		It("should detect dashboards with the same UID", func() {
+			Expect(CheckDashboardConflicts([]corev1.ConfigMap{
+				newConfigMap("a", "", map[string]string{"foo.json": `{"uid":"foo"}`}),
+				newConfigMap("b", "B", map[string]string{"bar.json": `{"uid":"foo"}`}),
+			})).To(MatchError(`found conflicting dashboards: dashboards a/foo.json and b/bar.json have the same UID "foo"`))
+		})
+
+		It("should report invalid folders", func() {
+			Expect(CheckDashboardConflicts([]corev1.ConfigMap{
+				newConfigMap("a", "/etc", map[string]string{"foo.json": `{}`}),
+			})).To(MatchError(ContainSubstring(`ConfigMap a: dashboard folder "/etc" is invalid`)))
+		})
+	})
+})
@@ -162,6 +162,10 @@ func (p *plutono) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if err := p.checkDashboardConflicts(ctx, dashboardConfigMap); err != nil {
+		return err
+	}
+
 	// dashboards configmap is not deployed as part of MR because it can breach the secret size limit.
 	if dashboardConfigMap != nil {
 		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: dashboardConfigMap.Name, Namespace: dashboardConfigMap.Namespace}}
@@ -299,7 +303,8 @@ providers:
   disableDeletion: false
   editable: false
   options:
-    path: ` + volumeMountPathDashboards
+    path: ` + volumeMountPathDashboards + `
+    foldersFromFilesStructure: true`
 }
 
 func (p *plutono) getDataSource() string {
@@ -638,6 +643,7 @@ func (p *plutono) getDeployment(providerConfigMap, dataSourceConfigMap *corev1.C
 								{Name: "FOLDER", Value: volumeMountPathDashboards},
 								{Name: "LABEL", Value: p.dashboardLabel()},
 								{Name: "LABEL_VALUE", Value: dashboardLabelValue},
+								{Name: "FOLDER_ANNOTATION", Value: v1beta1constants.AnnotationMonitoringDashboardFolder},
 								{Name: "METHOD", Value: "WATCH"},
 								{Name: "REQ_URL", Value: "http://localhost:" + strconv.Itoa(port) + "/api/admin/provisioning/dashboards/reload"},
 								{Name: "REQ_METHOD", Value: "POST"},
@@ -826,17 +832,34 @@ func (p *plutono) getIngress(ctx context.Context) (*networkingv1.Ingress, error)
 }
 
 func (p *plutono) dashboardLabel() string {
-	label := v1beta1constants.LabelPrefixMonitoringDashboard
+	return DashboardLabel(p.dashboardTarget())
+}
 
+func (p *plutono) dashboardTarget() DashboardTarget {
 	if p.values.IsGardenCluster {
-		label += "garden"
+		return DashboardTargetGarden
 	} else if p.values.ClusterType == component.ClusterTypeSeed {
-		label += "seed"
-	} else if p.values.ClusterType == component.ClusterTypeShoot {
-		label += "shoot"
+		return DashboardTargetSeed
+	}
+	return DashboardTargetShoot
+}
+
+// checkDashboardConflicts checks the dashboards provided by other parties (e.g., extensions) via ConfigMaps in the
+// namespace for conflicts with each other and with the dashboards provided by Gardener.
+func (p *plutono) checkDashboardConflicts(ctx context.Context, dashboardConfigMap *corev1.ConfigMap) error {
+	configMapList := &corev1.ConfigMapList{}
+	if err := p.client.List(ctx, configMapList, client.InNamespace(p.namespace), client.MatchingLabels{p.dashboardLabel(): dashboardLabelValue}); err != nil {
+		return fmt.Errorf("failed listing dashboard ConfigMaps: %w", err)
+	}
+
+	configMaps := []corev1.ConfigMap{*dashboardConfigMap}
+	for _, configMap := range configMapList.Items {
+		if configMap.Name != dashboardConfigMap.Name {
+			configMaps = append(configMaps, configMap)
+		}
 	}
 
-	return label
+	return CheckDashboardConflicts(configMaps)
 }
 
 func getLabels() map[string]string {
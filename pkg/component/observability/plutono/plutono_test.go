@@ -171,6 +171,7 @@ data:
       editable: false
       options:
         path: /var/lib/plutono/dashboards
+        foldersFromFilesStructure: true
 immutable: true
 kind: ConfigMap
 metadata:
@@ -178,7 +179,7 @@ metadata:
   labels:
     component: plutono
     resources.gardener.cloud/garbage-collectable-reference: "true"
-  name: plutono-dashboard-providers-140e41f3
+  name: plutono-dashboard-providers-7170488b
   namespace: some-namespace
 `
 
@@ -373,6 +374,7 @@ metadata:
 											{Name: "FOLDER", Value: "/var/lib/plutono/dashboards"},
 											{Name: "LABEL", Value: "dashboard.monitoring.gardener.cloud/" + labelKey},
 											{Name: "LABEL_VALUE", Value: "true"},
+											{Name: "FOLDER_ANNOTATION", Value: "dashboard.monitoring.gardener.cloud/folder"},
 											{Name: "METHOD", Value: "WATCH"},
 											{Name: "REQ_URL", Value: "http://localhost:3000/api/admin/provisioning/dashboards/reload"},
 											{Name: "REQ_METHOD", Value: "POST"},
@@ -411,7 +413,7 @@ metadata:
 										VolumeSource: corev1.VolumeSource{
 											ConfigMap: &corev1.ConfigMapVolumeSource{
 												LocalObjectReference: corev1.LocalObjectReference{
-													Name: "plutono-dashboard-providers-140e41f3",
+													Name: "plutono-dashboard-providers-7170488b",
 												},
 											},
 										},
@@ -611,7 +613,7 @@ status:
 					Expect(string(managedResourceSecret.Data["serviceaccount__some-namespace__plutono.yaml"])).To(Equal(testruntime.Serialize(serviceAccount, c.Scheme())))
 					Expect(string(managedResourceSecret.Data["role__some-namespace__plutono-dashboard-refresher.yaml"])).To(Equal(testruntime.Serialize(role, c.Scheme())))
 					Expect(string(managedResourceSecret.Data["rolebinding__some-namespace__plutono-dashboard-refresher.yaml"])).To(Equal(testruntime.Serialize(roleBinding, c.Scheme())))
-					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-140e41f3.yaml"])).To(Equal(providerConfigMapYAML))
+					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-7170488b.yaml"])).To(Equal(providerConfigMapYAML))
 					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-datasources-be28eaa6.yaml"])).To(Equal(dataSourceConfigMapYAMLFor(values)))
 					dashboardsConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-dashboards", Namespace: namespace}}
 					Expect(c.Get(ctx, client.ObjectKeyFromObject(dashboardsConfigMap), dashboardsConfigMap)).To(Succeed())
@@ -633,7 +635,7 @@ status:
 
 				It("should successfully deploy all resources", func() {
 					Expect(string(managedResourceSecret.Data["secret__some-namespace__plutono-config-fd97f886.yaml"])).To(Equal(testruntime.Serialize(plutonoConfigSecret, c.Scheme())))
-					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-140e41f3.yaml"])).To(Equal(providerConfigMapYAML))
+					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-7170488b.yaml"])).To(Equal(providerConfigMapYAML))
 					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-datasources-b320ffed.yaml"])).To(Equal(dataSourceConfigMapYAMLFor(values)))
 					dashboardsConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-dashboards-garden", Namespace: namespace}}
 					Expect(c.Get(ctx, client.ObjectKeyFromObject(dashboardsConfigMap), dashboardsConfigMap)).To(Succeed())
@@ -657,7 +659,7 @@ status:
 
 			It("should successfully deploy all resources", func() {
 				Expect(string(managedResourceSecret.Data["secret__some-namespace__plutono-config-fd97f886.yaml"])).To(Equal(testruntime.Serialize(plutonoConfigSecret, c.Scheme())))
-				Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-140e41f3.yaml"])).To(Equal(providerConfigMapYAML))
+				Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-7170488b.yaml"])).To(Equal(providerConfigMapYAML))
 				Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-datasources-f82429ca.yaml"])).To(Equal(dataSourceConfigMapYAMLFor(values)))
 				dashboardsConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-dashboards", Namespace: namespace}}
 				Expect(c.Get(ctx, client.ObjectKeyFromObject(dashboardsConfigMap), dashboardsConfigMap)).To(Succeed())
@@ -681,7 +683,7 @@ status:
 
 				It("should successfully deploy all resources", func() {
 					Expect(string(managedResourceSecret.Data["secret__some-namespace__plutono-config-fd97f886.yaml"])).To(Equal(testruntime.Serialize(plutonoConfigSecret, c.Scheme())))
-					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-140e41f3.yaml"])).To(Equal(providerConfigMapYAML))
+					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-7170488b.yaml"])).To(Equal(providerConfigMapYAML))
 					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-datasources-f82429ca.yaml"])).To(Equal(dataSourceConfigMapYAMLFor(values)))
 					dashboardsConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-dashboards", Namespace: namespace}}
 					Expect(c.Get(ctx, client.ObjectKeyFromObject(dashboardsConfigMap), dashboardsConfigMap)).To(Succeed())
@@ -696,6 +698,33 @@ status:
 				})
 			})
 
+			It("should fail if dashboards provided via ConfigMaps conflict with the Gardener dashboards", func() {
+				Expect(c.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "extension-foo-dashboards",
+						Namespace: namespace,
+						Labels:    map[string]string{"dashboard.monitoring.gardener.cloud/shoot": "true"},
+					},
+					Data: map[string]string{"kubernetes-pods-dashboard.json": `{"title":"Foo"}`},
+				})).To(Succeed())
+
+				Expect(component.Deploy(ctx)).To(MatchError(ContainSubstring(`dashboards extension-foo-dashboards/kubernetes-pods-dashboard.json and plutono-dashboards/kubernetes-pods-dashboard.json are both written to file "kubernetes-pods-dashboard.json"`)))
+			})
+
+			It("should succeed if dashboards provided via ConfigMaps are organized in a folder", func() {
+				Expect(c.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "extension-foo-dashboards",
+						Namespace:   namespace,
+						Labels:      map[string]string{"dashboard.monitoring.gardener.cloud/shoot": "true"},
+						Annotations: map[string]string{"dashboard.monitoring.gardener.cloud/folder": "Foo"},
+					},
+					Data: map[string]string{"kubernetes-pods-dashboard.json": `{"title":"Foo"}`},
+				})).To(Succeed())
+
+				Expect(component.Deploy(ctx)).To(Succeed())
+			})
+
 			Context("shoot is workerless", func() {
 				BeforeEach(func() {
 					values.IsWorkerless = true
@@ -703,7 +732,7 @@ status:
 
 				It("should successfully deploy all resources", func() {
 					Expect(string(managedResourceSecret.Data["secret__some-namespace__plutono-config-fd97f886.yaml"])).To(Equal(testruntime.Serialize(plutonoConfigSecret, c.Scheme())))
-					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-140e41f3.yaml"])).To(Equal(providerConfigMapYAML))
+					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-dashboard-providers-7170488b.yaml"])).To(Equal(providerConfigMapYAML))
 					Expect(string(managedResourceSecret.Data["configmap__some-namespace__plutono-datasources-f82429ca.yaml"])).To(Equal(dataSourceConfigMapYAMLFor(values)))
 					dashboardsConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "plutono-dashboards", Namespace: namespace}}
 					Expect(c.Get(ctx, client.ObjectKeyFromObject(dashboardsConfigMap), dashboardsConfigMap)).To(Succeed())
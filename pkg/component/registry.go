@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ComponentRegistry is a registry for additional components which are deployed alongside the built-in components,
+// e.g., system components which shall be deployed into every shoot cluster. The registered components are deployed,
+// destroyed and waited for in the order of their names.
+type ComponentRegistry struct {
+	lock       sync.RWMutex
+	components map[string]DeployWaiter
+}
+
+var _ DeployWaiter = &ComponentRegistry{}
+
+// NewComponentRegistry returns a new, empty ComponentRegistry.
+func NewComponentRegistry() *ComponentRegistry {
+	return &ComponentRegistry{components: make(map[string]DeployWaiter)}
+}
+
+// Register adds the given component with the given name to the registry. It returns an error if the name is empty or
+// if a component with the same name is already registered.
+func (r *ComponentRegistry) Register(name string, component DeployWaiter) error {
+	if name == "" {
+		return fmt.Errorf("component name must not be empty")
+	}
+	if component == nil {
+		return fmt.Errorf("component %q must not be nil", name)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.components[name]; ok {
+		return fmt.Errorf("component %q is already registered", name)
+	}
+
+	r.components[name] = component
+	return nil
+}
+
+// Get returns the component registered with the given name or nil if no such component exists.
+func (r *ComponentRegistry) Get(name string) DeployWaiter {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.components[name]
+}
+
+// Names returns the sorted names of all registered components.
+func (r *ComponentRegistry) Names() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	names := make([]string, 0, len(r.components))
+	for name := range r.components {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Deploy deploys all registered components.
+func (r *ComponentRegistry) Deploy(ctx context.Context) error {
+	return r.forEach(func(name string, component DeployWaiter) error {
+		if err := component.Deploy(ctx); err != nil {
+			return fmt.Errorf("failed deploying component %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// Destroy destroys all registered components.
+func (r *ComponentRegistry) Destroy(ctx context.Context) error {
+	return r.forEach(func(name string, component DeployWaiter) error {
+		if err := component.Destroy(ctx); err != nil {
+			return fmt.Errorf("failed destroying component %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// Wait waits until all registered components are ready.
+func (r *ComponentRegistry) Wait(ctx context.Context) error {
+	return r.forEach(func(name string, component DeployWaiter) error {
+		if err := component.Wait(ctx); err != nil {
+			return fmt.Errorf("failed waiting for component %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// WaitCleanup waits until all registered components are destroyed.
+func (r *ComponentRegistry) WaitCleanup(ctx context.Context) error {
+	return r.forEach(func(name string, component DeployWaiter) error {
+		if err := component.WaitCleanup(ctx); err != nil {
+			return fmt.Errorf("failed waiting for cleanup of component %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+func (r *ComponentRegistry) forEach(fn func(string, DeployWaiter) error) error {
+	for _, name := range r.Names() {
+		if err := fn(name, r.Get(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package component_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gardener/gardener/pkg/component"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+)
+
+var _ = Describe("ComponentRegistry", func() {
+	var (
+		ctx = context.TODO()
+		err = errors.New("some error")
+
+		ctrl     *gomock.Controller
+		foo, bar *mockcomponent.MockDeployWaiter
+		registry *ComponentRegistry
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		foo = mockcomponent.NewMockDeployWaiter(ctrl)
+		bar = mockcomponent.NewMockDeployWaiter(ctrl)
+
+		registry = NewComponentRegistry()
+		Expect(registry.Register("foo", foo)).To(Succeed())
+		Expect(registry.Register("bar", bar)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Register", func() {
+		It("should return the registered components", func() {
+			Expect(registry.Names()).To(Equal([]string{"bar", "foo"}))
+			Expect(registry.Get("foo")).To(BeIdenticalTo(foo))
+			Expect(registry.Get("baz")).To(BeNil())
+		})
+
+		It("should forbid registering a component twice", func() {
+			Expect(registry.Register("foo", bar)).To(MatchError(`component "foo" is already registered`))
+		})
+
+		It("should forbid registering invalid components", func() {
+			Expect(registry.Register("", foo)).To(MatchError("component name must not be empty"))
+			Expect(registry.Register("baz", nil)).To(MatchError(`component "baz" must not be nil`))
+		})
+	})
+
+	Describe("#Deploy", func() {
+		It("should deploy all components in the order of their names", func() {
+			gomock.InOrder(
+				bar.EXPECT().Deploy(ctx),
+				foo.EXPECT().Deploy(ctx),
+			)
+
+			Expect(registry.Deploy(ctx)).To(Succeed())
+		})
+
+		It("should stop at the first error", func() {
+			bar.EXPECT().Deploy(ctx).Return(err)
+
+			Expect(registry.Deploy(ctx)).To(MatchError(`failed deploying component "bar": some error`))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should destroy all components", func() {
+			gomock.InOrder(
+				bar.EXPECT().Destroy(ctx),
+				foo.EXPECT().Destroy(ctx),
+			)
+
+			Expect(registry.Destroy(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#Wait", func() {
+		It("should wait for all components", func() {
+			gomock.InOrder(
+				bar.EXPECT().Wait(ctx),
+				foo.EXPECT().Wait(ctx).Return(err),
+			)
+
+			Expect(registry.Wait(ctx)).To(MatchError(`failed waiting for component "foo": some error`))
+		})
+	})
+
+	Describe("#WaitCleanup", func() {
+		It("should wait for the cleanup of all components", func() {
+			gomock.InOrder(
+				bar.EXPECT().WaitCleanup(ctx),
+				foo.EXPECT().WaitCleanup(ctx),
+			)
+
+			Expect(registry.WaitCleanup(ctx)).To(Succeed())
+		})
+	})
+})
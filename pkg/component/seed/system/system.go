@@ -43,6 +43,9 @@ type ReserveExcessCapacityValues struct {
 	Replicas int32
 	// Configs configures additional excess capacity reservation deployments for shoot control planes in the seed.
 	Configs []gardencorev1beta1.SeedSettingExcessCapacityReservationConfig
+	// Profiles configures excess capacity reservation deployments for shoot control planes of certain size classes in
+	// the seed. Profiles without replicas use the default number of replicas.
+	Profiles []gardencorev1beta1.SeedSettingExcessCapacityReservationProfile
 }
 
 // New creates a new instance of DeployWaiter for seed system resources.
@@ -101,7 +104,22 @@ func (s *seedSystem) computeResourcesData() (map[string][]byte, error) {
 	if s.values.ReserveExcessCapacity.Enabled {
 		for i, config := range s.values.ReserveExcessCapacity.Configs {
 			name := fmt.Sprintf("reserve-excess-capacity-%d", i)
-			if err := s.addReserveExcessCapacityDeployment(registry, name, config); err != nil {
+			if err := s.addReserveExcessCapacityDeployment(registry, name, s.values.ReserveExcessCapacity.Replicas, config); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, profile := range s.values.ReserveExcessCapacity.Profiles {
+			var (
+				name   = "reserve-excess-capacity-profile-" + profile.Name
+				config = gardencorev1beta1.SeedSettingExcessCapacityReservationConfig{
+					Resources:    profile.Resources,
+					NodeSelector: profile.NodeSelector,
+					Tolerations:  profile.Tolerations,
+				}
+			)
+
+			if err := s.addReserveExcessCapacityDeployment(registry, name, ptr.Deref(profile.Replicas, s.values.ReserveExcessCapacity.Replicas), config); err != nil {
 				return nil, err
 			}
 		}
@@ -114,7 +132,7 @@ func (s *seedSystem) computeResourcesData() (map[string][]byte, error) {
 	return registry.SerializedObjects(), nil
 }
 
-func (s *seedSystem) addReserveExcessCapacityDeployment(registry *managedresources.Registry, name string, config gardencorev1beta1.SeedSettingExcessCapacityReservationConfig) error {
+func (s *seedSystem) addReserveExcessCapacityDeployment(registry *managedresources.Registry, name string, replicas int32, config gardencorev1beta1.SeedSettingExcessCapacityReservationConfig) error {
 	return registry.Add(
 		&appsv1.Deployment{
 			ObjectMeta: metav1.ObjectMeta{
@@ -126,7 +144,7 @@ func (s *seedSystem) addReserveExcessCapacityDeployment(registry *managedresourc
 				},
 			},
 			Spec: appsv1.DeploymentSpec{
-				Replicas:             &s.values.ReserveExcessCapacity.Replicas,
+				Replicas:             &replicas,
 				RevisionHistoryLimit: ptr.To[int32](2),
 				Selector:             &metav1.LabelSelector{MatchLabels: getExcessCapacityReservationLabels()},
 				Template: corev1.PodTemplateSpec{
@@ -7,6 +7,7 @@ package system_test
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -133,6 +134,54 @@ spec:
         operator: Equal
         value: foo
 status: {}
+`
+		deploymentProfileYAML = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  annotations:
+    resources.gardener.cloud/skip-health-check: "true"
+  creationTimestamp: null
+  labels:
+    app: kubernetes
+    role: reserve-excess-capacity
+  name: reserve-excess-capacity-profile-large
+  namespace: ` + namespace + `
+spec:
+  replicas: 1
+  revisionHistoryLimit: 2
+  selector:
+    matchLabels:
+      app: kubernetes
+      role: reserve-excess-capacity
+  strategy: {}
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        app: kubernetes
+        role: reserve-excess-capacity
+    spec:
+      containers:
+      - image: ` + reserveExcessCapacityImage + `
+        imagePullPolicy: IfNotPresent
+        name: pause-container
+        resources:
+          limits:
+            cpu: "8"
+            memory: 32Gi
+          requests:
+            cpu: "8"
+            memory: 32Gi
+      nodeSelector:
+        foo: bar
+      priorityClassName: gardener-reserve-excess-capacity
+      terminationGracePeriodSeconds: 5
+      tolerations:
+      - effect: NoExecute
+        key: bar
+        operator: Equal
+        value: foo
+status: {}
 `
 	)
 
@@ -229,6 +278,41 @@ status: {}
 			})
 		})
 
+		Context("in case of reserve-excess-capacity profiles", func() {
+			BeforeEach(func() {
+				values.ReserveExcessCapacity.Profiles = []gardencorev1beta1.SeedSettingExcessCapacityReservationProfile{
+					{
+						Name: "small",
+						Resources: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("6Gi"),
+						},
+					},
+					{
+						Name:     "large",
+						Replicas: ptr.To[int32](1),
+						Resources: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("8"),
+							corev1.ResourceMemory: resource.MustParse("32Gi"),
+						},
+						NodeSelector: map[string]string{"foo": "bar"},
+						Tolerations: []corev1.Toleration{
+							{Key: "bar", Value: "foo", Operator: "Equal", Effect: corev1.TaintEffectNoExecute},
+						},
+					},
+				}
+				component = New(c, namespace, values)
+			})
+
+			It("should successfully deploy the resources", func() {
+				Expect(managedResourceSecret.Data).To(HaveLen(13))
+				Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__reserve-excess-capacity-0.yaml"])).To(Equal(deployment0YAML))
+				Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__reserve-excess-capacity-profile-small.yaml"])).To(Equal(strings.ReplaceAll(deployment0YAML, "reserve-excess-capacity-0", "reserve-excess-capacity-profile-small")))
+				Expect(string(managedResourceSecret.Data["deployment__"+namespace+"__reserve-excess-capacity-profile-large.yaml"])).To(Equal(deploymentProfileYAML))
+				expectPriorityClasses(managedResourceSecret.Data)
+			})
+		})
+
 		Context("in case reserve-excess-capacity is disabled", func() {
 			BeforeEach(func() {
 				values.ReserveExcessCapacity.Enabled = false
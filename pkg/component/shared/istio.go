@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/networking/nginxingress"
 	vpnauthzserver "github.com/gardener/gardener/pkg/component/networking/vpn/authzserver"
 	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -136,7 +137,8 @@ func NewIstio(
 
 // AddIstioIngressGateway adds an Istio ingress gateway to the given deployer. It uses the first Ingress Gateway
 // to fill out common chart values. Hence, it is assumed that at least one Ingress Gateway was added to the given
-// `istioDeployer` before calling this function.
+// `istioDeployer` before calling this function. The optional autoscaling settings take precedence over the replica
+// settings of the first Ingress Gateway.
 func AddIstioIngressGateway(
 	ctx context.Context,
 	cl client.Client,
@@ -149,6 +151,7 @@ func AddIstioIngressGateway(
 	zone *string,
 	dualStack bool,
 	terminateLoadBalancerProxyProtocol *bool,
+	autoscaling *gardenletconfig.ExposureClassHandlerAutoscaling,
 ) error {
 	gatewayValues := istioDeployer.GetValues().IngressGateway
 	if len(gatewayValues) < 1 {
@@ -162,6 +165,7 @@ func AddIstioIngressGateway(
 		zones                    []string
 		minReplicas              *int
 		maxReplicas              *int
+		targetConnections        *int
 		enforceSpreadAcrossHosts bool
 		err                      error
 	)
@@ -179,6 +183,18 @@ func AddIstioIngressGateway(
 		}
 	}
 
+	if autoscaling != nil {
+		if autoscaling.MinReplicas != nil {
+			minReplicas = ptr.To(int(*autoscaling.MinReplicas))
+		}
+		if autoscaling.MaxReplicas != nil {
+			maxReplicas = ptr.To(int(*autoscaling.MaxReplicas))
+		}
+		if autoscaling.TargetConnections != nil {
+			targetConnections = ptr.To(int(*autoscaling.TargetConnections))
+		}
+	}
+
 	istioDeployer.AddIngressGateway(istio.IngressGatewayValues{
 		Annotations:                        utils.MergeStringMaps(annotations),
 		Labels:                             utils.MergeStringMaps(labels),
@@ -186,6 +202,7 @@ func AddIstioIngressGateway(
 		Namespace:                          namespace,
 		MinReplicas:                        minReplicas,
 		MaxReplicas:                        maxReplicas,
+		TargetConnections:                  targetConnections,
 		ExternalTrafficPolicy:              externalTrafficPolicy,
 		LoadBalancerIP:                     serviceExternalIP,
 		Image:                              templateValues.Image,
@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/networking/istio"
 	. "github.com/gardener/gardener/pkg/component/shared"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
@@ -345,7 +347,8 @@ var _ = Describe("Istio", func() {
 				serviceExternalIP,
 				zone,
 				false,
-				&proxyProtoclLB)).To(MatchError("at least one ingress gateway must be present before adding further ones"))
+				&proxyProtoclLB,
+				nil)).To(MatchError("at least one ingress gateway must be present before adding further ones"))
 		})
 
 		Context("without zone", func() {
@@ -365,7 +368,8 @@ var _ = Describe("Istio", func() {
 					serviceExternalIP,
 					zone,
 					false,
-					&proxyProtoclLB)).To(Succeed())
+					&proxyProtoclLB,
+					nil)).To(Succeed())
 
 				checkAdditionalIstioGateway(
 					testValues.client,
@@ -381,6 +385,37 @@ var _ = Describe("Istio", func() {
 			})
 		})
 
+		Context("with autoscaling settings", func() {
+			BeforeEach(func() {
+				zone = ptr.To("1")
+			})
+
+			It("should successfully add an additional ingress gateway with the given autoscaling settings", func() {
+				Expect(AddIstioIngressGateway(
+					context.Background(),
+					testValues.client,
+					istioDeploy,
+					namespace,
+					annotations,
+					labels,
+					&externalTrafficPolicy,
+					serviceExternalIP,
+					zone,
+					false,
+					&proxyProtoclLB,
+					&gardenletconfig.ExposureClassHandlerAutoscaling{
+						MinReplicas:       ptr.To[int32](3),
+						MaxReplicas:       ptr.To[int32](9),
+						TargetConnections: ptr.To[int32](500),
+					})).To(Succeed())
+
+				ingressValues := istioDeploy.GetValues().IngressGateway
+				Expect(ingressValues[len(ingressValues)-1].MinReplicas).To(PointTo(Equal(3)))
+				Expect(ingressValues[len(ingressValues)-1].MaxReplicas).To(PointTo(Equal(9)))
+				Expect(ingressValues[len(ingressValues)-1].TargetConnections).To(PointTo(Equal(500)))
+			})
+		})
+
 		Context("with zone", func() {
 			BeforeEach(func() {
 				zone = ptr.To("1")
@@ -398,7 +433,8 @@ var _ = Describe("Istio", func() {
 					serviceExternalIP,
 					zone,
 					false,
-					&proxyProtoclLB)).To(Succeed())
+					&proxyProtoclLB,
+					nil)).To(Succeed())
 
 				checkAdditionalIstioGateway(
 					testValues.client,
@@ -434,7 +470,8 @@ var _ = Describe("Istio", func() {
 						serviceExternalIP,
 						zone,
 						false,
-						&proxyProtoclLB)).To(Succeed())
+						&proxyProtoclLB,
+						nil)).To(Succeed())
 
 					checkAdditionalIstioGateway(
 						testValues.client,
@@ -468,7 +505,8 @@ var _ = Describe("Istio", func() {
 					serviceExternalIP,
 					zone,
 					true,
-					&proxyProtoclLB)).To(Succeed())
+					&proxyProtoclLB,
+					nil)).To(Succeed())
 
 				checkAdditionalIstioGateway(
 					testValues.client,
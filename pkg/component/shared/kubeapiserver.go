@@ -75,6 +75,7 @@ func NewKubeAPIServer(
 	objectMeta metav1.ObjectMeta,
 	runtimeVersion *semver.Version,
 	targetVersion *semver.Version,
+	imageVector imagevectorutils.ImageVector,
 	secretsManager secretsmanager.Interface,
 	namePrefix string,
 	apiServerConfig *gardencorev1beta1.KubeAPIServerConfig,
@@ -88,11 +89,12 @@ func NewKubeAPIServer(
 	authenticationWebhookConfig *kubeapiserver.AuthenticationWebhook,
 	authorizationWebhookConfig *kubeapiserver.AuthorizationWebhook,
 	resourcesToStoreInETCDEvents []schema.GroupResource,
+	slowRequestLogging bool,
 ) (
 	kubeapiserver.Interface,
 	error,
 ) {
-	images, err := computeKubeAPIServerImages(runtimeVersion, targetVersion, vpnConfig)
+	images, err := computeKubeAPIServerImages(imageVector, runtimeVersion, targetVersion, vpnConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +183,7 @@ func NewKubeAPIServer(
 			ResourcesToStoreInETCDEvents:        resourcesToStoreInETCDEvents,
 			RuntimeConfig:                       runtimeConfig,
 			ServiceNetworkCIDR:                  serviceNetworkCIDR,
+			SlowRequestLogging:                  slowRequestLogging,
 			StaticTokenKubeconfigEnabled:        staticTokenKubeconfigEnabled,
 			Version:                             targetVersion,
 			VPN:                                 vpnConfig,
@@ -269,6 +272,7 @@ func DeployKubeAPIServer(
 }
 
 func computeKubeAPIServerImages(
+	imageVector imagevectorutils.ImageVector,
 	runtimeVersion *semver.Version,
 	targetVersion *semver.Version,
 	vpnConfig kubeapiserver.VPNConfig,
@@ -278,14 +282,14 @@ func computeKubeAPIServerImages(
 ) {
 	var result kubeapiserver.Images
 
-	imageKubeAPIServer, err := imagevector.ImageVector().FindImage(imagevector.ImageNameKubeApiserver, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+	imageKubeAPIServer, err := imageVector.FindImage(imagevector.ImageNameKubeApiserver, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
 	if err != nil {
 		return kubeapiserver.Images{}, err
 	}
 	result.KubeAPIServer = imageKubeAPIServer.String()
 
 	if vpnConfig.HighAvailabilityEnabled {
-		imageVPNClient, err := imagevector.ImageVector().FindImage(imagevector.ImageNameVpnShootClient, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+		imageVPNClient, err := imageVector.FindImage(imagevector.ImageNameVpnShootClient, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
 		if err != nil {
 			return kubeapiserver.Images{}, err
 		}
@@ -26,6 +26,7 @@ func NewKubeControllerManager(
 	runtimeNamespace string,
 	runtimeVersion *semver.Version,
 	targetVersion *semver.Version,
+	imageVector imagevectorutils.ImageVector,
 	secretsManager secretsmanager.Interface,
 	namePrefix string,
 	config *gardencorev1beta1.KubeControllerManagerConfig,
@@ -42,7 +43,7 @@ func NewKubeControllerManager(
 	kubecontrollermanager.Interface,
 	error,
 ) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameKubeControllerManager, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+	image, err := imageVector.FindImage(imagevector.ImageNameKubeControllerManager, imagevectorutils.RuntimeVersion(runtimeVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
 	if err != nil {
 		return nil, err
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/networking/nginxingress"
 	resourcemanagerv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
@@ -113,6 +114,7 @@ func NewTargetGardenerResourceManager(
 	defaultNotReadyTolerationSeconds *int64,
 	defaultUnreachableTolerationSeconds *int64,
 	kubernetesVersion *semver.Version,
+	imageVector imagevectorutils.ImageVector,
 	logLevel, logFormat string,
 	namePrefix string,
 	podTopologySpreadConstraintsEnabled bool,
@@ -129,7 +131,7 @@ func NewTargetGardenerResourceManager(
 	resourcemanager.Interface,
 	error,
 ) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameGardenerResourceManager)
+	image, err := imageVector.FindImage(imagevector.ImageNameGardenerResourceManager)
 	if err != nil {
 		return nil, err
 	}
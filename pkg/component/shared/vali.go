@@ -5,10 +5,14 @@
 package shared
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/vali"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -25,6 +29,7 @@ func NewVali(
 	priorityClassName string,
 	storage *resource.Quantity,
 	ingressHost string,
+	logging *gardencorev1beta1.ObservabilityLogging,
 ) (
 	component.Deployer,
 	error,
@@ -54,7 +59,7 @@ func NewVali(
 		return nil, err
 	}
 
-	deployer := vali.New(c, namespace, secretsManager, vali.Values{
+	values := vali.Values{
 		ValiImage:               valiImage.String(),
 		CuratorImage:            curatorImage.String(),
 		InitLargeDirImage:       tune2fsImage.String(),
@@ -66,7 +71,24 @@ func NewVali(
 		Storage:                 storage,
 		ClusterType:             clusterType,
 		IngressHost:             ingressHost,
-	})
+	}
+
+	if logging != nil {
+		if logging.RetentionDays != nil {
+			values.RetentionPeriod = ptr.To(time.Duration(*logging.RetentionDays) * 24 * time.Hour)
+		}
+		if logging.IngestionRate != nil {
+			values.IngestionRateMB = ptr.To(quantityToMB(*logging.IngestionRate))
+		}
+		if logging.IngestionBurstSize != nil {
+			values.IngestionBurstSizeMB = ptr.To(quantityToMB(*logging.IngestionBurstSize))
+		}
+	}
+
+	return vali.New(c, namespace, secretsManager, values), nil
+}
 
-	return deployer, nil
+// quantityToMB converts the given quantity of bytes to megabytes as understood by Vali (1 MB = 2^20 bytes).
+func quantityToMB(quantity resource.Quantity) float64 {
+	return float64(quantity.Value()) / (1 << 20)
 }
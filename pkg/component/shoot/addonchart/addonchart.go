@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package addonchart
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources of addon charts.
+const ManagedResourceNamePrefix = "addon-chart-"
+
+// Values is a set of configuration values for an addon chart.
+type Values struct {
+	// Name is the name of the addon. It is used as release name when rendering the chart.
+	Name string
+	// Namespace is the namespace in the shoot cluster into which the chart is rendered.
+	Namespace string
+	// OCIRepository defines where to pull the chart from.
+	OCIRepository gardencorev1.OCIRepository
+	// ChartValues are the values used for rendering the chart.
+	ChartValues map[string]any
+}
+
+// New creates a new instance of DeployWaiter for an addon chart. The chart is pulled from the OCI registry, rendered
+// and deployed into the shoot cluster via a ManagedResource.
+func New(
+	client client.Client,
+	chartRenderer chartrenderer.Interface,
+	helmRegistry oci.Interface,
+	namespace string,
+	values Values,
+) component.DeployWaiter {
+	return &addonChart{
+		client:              client,
+		chartRenderer:       chartRenderer,
+		helmRegistry:        helmRegistry,
+		namespace:           namespace,
+		managedResourceName: ManagedResourceNamePrefix + values.Name,
+		values:              values,
+	}
+}
+
+type addonChart struct {
+	client              client.Client
+	chartRenderer       chartrenderer.Interface
+	helmRegistry        oci.Interface
+	namespace           string
+	managedResourceName string
+	values              Values
+}
+
+func (a *addonChart) Deploy(ctx context.Context) error {
+	archive, err := a.helmRegistry.Pull(ctx, &a.values.OCIRepository)
+	if err != nil {
+		return fmt.Errorf("failed pulling chart of addon %q: %w", a.values.Name, err)
+	}
+
+	release, err := a.chartRenderer.RenderArchive(archive, a.values.Name, a.values.Namespace, a.values.ChartValues)
+	if err != nil {
+		return fmt.Errorf("failed rendering chart of addon %q: %w", a.values.Name, err)
+	}
+
+	data := release.AsSecretData()
+
+	if a.values.Namespace != metav1.NamespaceSystem {
+		registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+		namespaceData, err := registry.AddAllAndSerialize(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: a.values.Namespace}})
+		if err != nil {
+			return err
+		}
+		data = utils.MergeStringMaps(data, namespaceData)
+	}
+
+	return managedresources.CreateForShootWithLabels(ctx, a.client, a.namespace, a.managedResourceName, managedresources.LabelValueGardener, false, map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShootAddonChart}, data)
+}
+
+func (a *addonChart) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, a.client, a.namespace, a.managedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (a *addonChart) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, a.client, a.namespace, a.managedResourceName)
+}
+
+func (a *addonChart) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, a.client, a.namespace, a.managedResourceName)
+}
+
+// DeleteStale deletes the ManagedResources of addon charts in the given namespace whose names are not contained in
+// the given set of addon names.
+func DeleteStale(ctx context.Context, c client.Client, namespace string, names sets.Set[string]) error {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := c.List(ctx, managedResourceList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShootAddonChart}); err != nil {
+		return err
+	}
+
+	var fns []flow.TaskFn
+	for _, managedResource := range managedResourceList.Items {
+		if names.Has(strings.TrimPrefix(managedResource.Name, ManagedResourceNamePrefix)) {
+			continue
+		}
+
+		name := managedResource.Name
+		fns = append(fns, func(ctx context.Context) error {
+			return managedresources.DeleteForShoot(ctx, c, namespace, name)
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
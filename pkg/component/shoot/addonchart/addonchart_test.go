@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package addonchart_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/shoot/addonchart"
+	ocifake "github.com/gardener/gardener/pkg/utils/oci/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("AddonChart", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		fakeClient    client.Client
+		fakeRegistry  *ocifake.Registry
+		chartRenderer chartrenderer.Interface
+		addon         component.DeployWaiter
+		values        Values
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeRegistry = ocifake.NewRegistry()
+		chartRenderer = chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.31.1"})
+
+		values = Values{
+			Name:          "agent",
+			Namespace:     "kube-system",
+			OCIRepository: gardencorev1.OCIRepository{Ref: ptr.To("registry.example.com/charts/agent:1.0.0")},
+			ChartValues:   map[string]any{"foo": "baz"},
+		}
+		fakeRegistry.AddArtifact(&values.OCIRepository, chartArchive())
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "addon-chart-agent",
+				Namespace: namespace,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		addon = New(fakeClient, chartRenderer, fakeRegistry, namespace, values)
+	})
+
+	Describe("#Deploy", func() {
+		It("should deploy the ManagedResource containing the rendered chart", func() {
+			Expect(addon.Deploy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(Equal(map[string]string{
+				"origin":              "gardener",
+				"gardener.cloud/role": "shoot-addon-chart",
+			}))
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(false)))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			managedResourceSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}, managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Data).To(HaveLen(1))
+			Expect(string(managedResourceSecret.Data["agent_templates_configmap.yaml"])).To(And(
+				ContainSubstring("name: agent"),
+				ContainSubstring("namespace: kube-system"),
+				ContainSubstring("foo: baz"),
+			))
+		})
+
+		Context("custom namespace", func() {
+			BeforeEach(func() {
+				values.Namespace = "agent"
+			})
+
+			It("should additionally deploy the namespace", func() {
+				Expect(addon.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				managedResourceSecret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}, managedResourceSecret)).To(Succeed())
+				Expect(managedResourceSecret.Data).To(HaveKey("namespace____agent.yaml"))
+				Expect(string(managedResourceSecret.Data["agent_templates_configmap.yaml"])).To(ContainSubstring("namespace: agent"))
+			})
+		})
+
+		It("should fail when the chart cannot be pulled", func() {
+			values.OCIRepository = gardencorev1.OCIRepository{Ref: ptr.To("registry.example.com/charts/unknown:1.0.0")}
+			addon = New(fakeClient, chartRenderer, fakeRegistry, namespace, values)
+
+			Expect(addon.Deploy(ctx)).To(MatchError(ContainSubstring(`failed pulling chart of addon "agent"`)))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the ManagedResource", func() {
+			Expect(addon.Deploy(ctx)).To(Succeed())
+			Expect(addon.Destroy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#DeleteStale", func() {
+		It("should delete the ManagedResources of addons which are no longer configured", func() {
+			Expect(addon.Deploy(ctx)).To(Succeed())
+
+			otherValues := values
+			otherValues.Name = "other"
+			Expect(New(fakeClient, chartRenderer, fakeRegistry, namespace, otherValues).Deploy(ctx)).To(Succeed())
+
+			otherManagedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-system", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, otherManagedResource)).To(Succeed())
+
+			Expect(DeleteStale(ctx, fakeClient, namespace, sets.New("agent"))).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "addon-chart-other", Namespace: namespace}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherManagedResource), otherManagedResource)).To(Succeed())
+		})
+	})
+})
+
+func chartArchive() []byte {
+	files := map[string]string{
+		"agent/Chart.yaml":               "apiVersion: v2\nname: agent\nversion: 1.0.0\n",
+		"agent/values.yaml":              "foo: bar\n",
+		"agent/templates/configmap.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Release.Name }}\n  namespace: {{ .Release.Namespace }}\ndata:\n  foo: {{ .Values.foo }}\n",
+	}
+
+	var (
+		buf        bytes.Buffer
+		gzipWriter = gzip.NewWriter(&buf)
+		tarWriter  = tar.NewWriter(gzipWriter)
+	)
+
+	for name, content := range files {
+		ExpectWithOffset(1, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))})).To(Succeed())
+		_, err := tarWriter.Write([]byte(content))
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	ExpectWithOffset(1, tarWriter.Close()).To(Succeed())
+	ExpectWithOffset(1, gzipWriter.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
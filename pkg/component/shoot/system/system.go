@@ -272,7 +272,7 @@ func (s *shootSystem) computeResourcesData() (map[string][]byte, error) {
 			return nil, err
 		}
 
-		if err := registry.Add(priorityClassResources()...); err != nil {
+		if err := registry.Add(priorityClassResources(s.values.Object.Spec.SystemComponents)...); err != nil {
 			return nil, err
 		}
 	}
@@ -375,7 +375,7 @@ var gardenletManagedPriorityClasses = []struct {
 	{v1beta1constants.PriorityClassNameShootSystem600, 999999600, "PriorityClass for Shoot system components"},
 }
 
-func priorityClassResources() []client.Object {
+func priorityClassResources(systemComponents *gardencorev1beta1.SystemComponents) []client.Object {
 	out := make([]client.Object, 0, len(gardenletManagedPriorityClasses))
 
 	for _, class := range gardenletManagedPriorityClasses {
@@ -389,6 +389,19 @@ func priorityClassResources() []client.Object {
 		})
 	}
 
+	if systemComponents != nil {
+		for _, class := range systemComponents.PriorityClasses {
+			out = append(out, &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: class.Name,
+				},
+				Description:   ptr.Deref(class.Description, "PriorityClass for system components of the Shoot owner"),
+				GlobalDefault: false,
+				Value:         class.Value,
+			})
+		}
+	}
+
 	return out
 }
 
@@ -297,6 +297,37 @@ metadata:
 			It("should successfully deploy all well-known PriorityClasses", func() {
 				expectPriorityClasses(managedResourceSecret.Data)
 			})
+
+			When("additional PriorityClasses are configured", func() {
+				BeforeEach(func() {
+					values.Object = values.Object.DeepCopy()
+					values.Object.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{PriorityClasses: []gardencorev1beta1.SystemComponentsPriorityClass{
+						{Name: "logging-agent", Value: 1000, Description: ptr.To("PriorityClass for logging agents")},
+						{Name: "security-agent", Value: 900},
+					}}
+				})
+
+				It("should deploy the additional PriorityClasses", func() {
+					expectPriorityClasses(managedResourceSecret.Data)
+
+					Expect(managedResourceSecret.Data).To(HaveKeyWithValue("priorityclass____logging-agent.yaml", []byte(`apiVersion: scheduling.k8s.io/v1
+description: PriorityClass for logging agents
+kind: PriorityClass
+metadata:
+  creationTimestamp: null
+  name: logging-agent
+value: 1000
+`)))
+					Expect(managedResourceSecret.Data).To(HaveKeyWithValue("priorityclass____security-agent.yaml", []byte(`apiVersion: scheduling.k8s.io/v1
+description: PriorityClass for system components of the Shoot owner
+kind: PriorityClass
+metadata:
+  creationTimestamp: null
+  name: security-agent
+value: 900
+`)))
+				})
+			})
 		})
 
 		Context("NetworkPolicies", func() {
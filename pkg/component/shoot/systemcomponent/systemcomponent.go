@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemcomponent
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources of additional shoot system components.
+const ManagedResourceNamePrefix = "shoot-system-component-"
+
+// New creates a new instance of DeployWaiter for an additional shoot system component. The given data contains the
+// manifests of the component which are deployed into the shoot cluster via a ManagedResource.
+func New(
+	client client.Client,
+	namespace string,
+	name string,
+	data map[string][]byte,
+) component.DeployWaiter {
+	return &systemComponent{
+		client:              client,
+		namespace:           namespace,
+		managedResourceName: ManagedResourceNamePrefix + name,
+		data:                data,
+	}
+}
+
+type systemComponent struct {
+	client              client.Client
+	namespace           string
+	managedResourceName string
+	data                map[string][]byte
+}
+
+func (s *systemComponent) Deploy(ctx context.Context) error {
+	return managedresources.CreateForShootWithLabels(ctx, s.client, s.namespace, s.managedResourceName, managedresources.LabelValueGardener, false, map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShootSystemComponent}, s.data)
+}
+
+func (s *systemComponent) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForShoot(ctx, s.client, s.namespace, s.managedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (s *systemComponent) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, s.client, s.namespace, s.managedResourceName)
+}
+
+func (s *systemComponent) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, s.client, s.namespace, s.managedResourceName)
+}
+
+// DeleteStale deletes the ManagedResources of additional shoot system components in the given namespace whose names
+// are not contained in the given set of component names.
+func DeleteStale(ctx context.Context, c client.Client, namespace string, names sets.Set[string]) error {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := c.List(ctx, managedResourceList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShootSystemComponent}); err != nil {
+		return err
+	}
+
+	var fns []flow.TaskFn
+	for _, managedResource := range managedResourceList.Items {
+		if names.Has(strings.TrimPrefix(managedResource.Name, ManagedResourceNamePrefix)) {
+			continue
+		}
+
+		name := managedResource.Name
+		fns = append(fns, func(ctx context.Context) error {
+			return managedresources.DeleteForShoot(ctx, c, namespace, name)
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
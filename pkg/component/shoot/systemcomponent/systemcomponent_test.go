@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemcomponent_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/shoot/systemcomponent"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("SystemComponent", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		fakeClient      client.Client
+		systemComponent component.DeployWaiter
+
+		data = map[string][]byte{"agent.yaml": []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: agent\n  namespace: kube-system\n")}
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		systemComponent = New(fakeClient, namespace, "agent", data)
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot-system-component-agent",
+				Namespace: namespace,
+			},
+		}
+	})
+
+	Describe("#Deploy", func() {
+		It("should deploy the ManagedResource containing the manifests", func() {
+			Expect(systemComponent.Deploy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(Equal(map[string]string{
+				"origin":              "gardener",
+				"gardener.cloud/role": "shoot-system-component",
+			}))
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(false)))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			managedResourceSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}, managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Data).To(Equal(data))
+			Expect(managedResourceSecret.Immutable).To(Equal(ptr.To(true)))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete the ManagedResource", func() {
+			Expect(systemComponent.Deploy(ctx)).To(Succeed())
+			Expect(systemComponent.Destroy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#DeleteStale", func() {
+		It("should delete the ManagedResources of components which are no longer configured", func() {
+			Expect(systemComponent.Deploy(ctx)).To(Succeed())
+			Expect(New(fakeClient, namespace, "other", data).Deploy(ctx)).To(Succeed())
+
+			otherManagedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-system", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, otherManagedResource)).To(Succeed())
+
+			Expect(DeleteStale(ctx, fakeClient, namespace, sets.New("agent"))).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "shoot-system-component-other", Namespace: namespace}, &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherManagedResource), otherManagedResource)).To(Succeed())
+		})
+	})
+
+	Context("waiting functions", func() {
+		var fakeOps *retryfake.Ops
+
+		BeforeEach(func() {
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			DeferCleanup(test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			))
+		})
+
+		Describe("#Wait", func() {
+			It("should fail because the ManagedResource is not healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: managedResource.Name, Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionFalse},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionFalse},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(systemComponent.Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should succeed because the ManagedResource is healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: managedResource.Name, Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(systemComponent.Wait(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#WaitCleanup", func() {
+			It("should succeed when the ManagedResource is gone", func() {
+				Expect(systemComponent.WaitCleanup(ctx)).To(Succeed())
+			})
+		})
+	})
+})
@@ -377,6 +377,7 @@ func (r *Reconciler) reconcileNetworkPolicyAllowToPrivateNetworks(ctx context.Co
 			if v := shoot.Spec.Networking.Nodes; v != nil {
 				shootNetworks = append(shootNetworks, *v)
 			}
+			shootNetworks = append(shootNetworks, shoot.Spec.Networking.AdditionalNodes...)
 			if v := shoot.Spec.Networking.Pods; v != nil {
 				shootNetworks = append(shootNetworks, *v)
 			}
@@ -29,17 +29,48 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks the object in the given request for Secret or ConfiGMap references to further objects in order to
-// protect them from deletions as long as they are still referenced.
+// Reconciler checks the object in the given request for references to further objects (e.g., Secrets or ConfigMaps) in
+// order to protect them from deletions as long as they are still referenced.
 type Reconciler struct {
-	Client                      client.Client
-	ConcurrentSyncs             *int
-	NewObjectFunc               func() client.Object
-	NewObjectListFunc           func() client.ObjectList
-	GetNamespace                func(client.Object) string
-	GetReferencedSecretNames    func(client.Object) []string
-	GetReferencedConfigMapNames func(client.Object) []string
-	ReferenceChangedPredicate   func(oldObj, newObj client.Object) bool
+	Client                    client.Client
+	ConcurrentSyncs           *int
+	NewObjectFunc             func() client.Object
+	NewObjectListFunc         func() client.ObjectList
+	GetNamespace              func(client.Object) string
+	ReferencedKinds           []ReferencedKind
+	ReferenceChangedPredicate func(oldObj, newObj client.Object) bool
+}
+
+// ReferencedKind describes a kind of resources which can be referenced by the reconciled objects.
+type ReferencedKind struct {
+	// Kind is the kind of the referenced resources.
+	Kind string
+	// NewObjectFunc returns a new object of the kind.
+	NewObjectFunc func() client.Object
+	// NewObjectListFunc returns a new list of objects of the kind.
+	NewObjectListFunc func() client.ObjectList
+	// GetReferencedNames returns the names of the resources of the kind which are referenced by the given object.
+	GetReferencedNames func(client.Object) []string
+}
+
+// SecretKind returns a ReferencedKind for Secrets whose names are returned by the given function.
+func SecretKind(getReferencedNames func(client.Object) []string) ReferencedKind {
+	return ReferencedKind{
+		Kind:               "Secret",
+		NewObjectFunc:      func() client.Object { return &corev1.Secret{} },
+		NewObjectListFunc:  func() client.ObjectList { return &corev1.SecretList{} },
+		GetReferencedNames: getReferencedNames,
+	}
+}
+
+// ConfigMapKind returns a ReferencedKind for ConfigMaps whose names are returned by the given function.
+func ConfigMapKind(getReferencedNames func(client.Object) []string) ReferencedKind {
+	return ReferencedKind{
+		Kind:               "ConfigMap",
+		NewObjectFunc:      func() client.Object { return &corev1.ConfigMap{} },
+		NewObjectListFunc:  func() client.ObjectList { return &corev1.ConfigMapList{} },
+		GetReferencedNames: getReferencedNames,
+	}
 }
 
 // Reconcile performs the check.
@@ -58,16 +89,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
 	}
 
-	unreferencedSecrets, err := r.getUnreferencedResources(ctx, obj, &corev1.SecretList{}, r.GetReferencedSecretNames)
-	if err != nil {
-		return reconcile.Result{}, err
-	}
-	unreferencedConfigMaps, err := r.getUnreferencedResources(ctx, obj, &corev1.ConfigMapList{}, r.GetReferencedConfigMapNames)
-	if err != nil {
-		return reconcile.Result{}, err
+	var unreferencedResources []client.Object
+	for _, kind := range r.ReferencedKinds {
+		unreferenced, err := r.getUnreferencedResources(ctx, obj, kind.NewObjectListFunc(), kind.GetReferencedNames)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		unreferencedResources = append(unreferencedResources, unreferenced...)
 	}
 
-	if err := r.releaseUnreferencedResources(ctx, log, append(unreferencedSecrets, unreferencedConfigMaps...)...); err != nil {
+	if err := r.releaseUnreferencedResources(ctx, log, unreferencedResources...); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -82,19 +113,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	addedFinalizerToSecret, err := r.handleReferencedResources(ctx, log, "Secret", func() client.Object { return &corev1.Secret{} }, r.GetNamespace(obj), r.GetReferencedSecretNames(obj)...)
-	if err != nil {
-		return reconcile.Result{}, err
-	}
-	addedFinalizerToConfigMap, err := r.handleReferencedResources(ctx, log, "ConfigMap", func() client.Object { return &corev1.ConfigMap{} }, r.GetNamespace(obj), r.GetReferencedConfigMapNames(obj)...)
-	if err != nil {
-		return reconcile.Result{}, err
+	var needsFinalizer bool
+	for _, kind := range r.ReferencedKinds {
+		addedFinalizer, err := r.handleReferencedResources(ctx, log, kind.Kind, kind.NewObjectFunc, r.GetNamespace(obj), kind.GetReferencedNames(obj)...)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		needsFinalizer = needsFinalizer || addedFinalizer
 	}
 
-	var (
-		hasFinalizer   = controllerutil.ContainsFinalizer(obj, v1beta1constants.ReferenceProtectionFinalizerName)
-		needsFinalizer = addedFinalizerToSecret || addedFinalizerToConfigMap
-	)
+	hasFinalizer := controllerutil.ContainsFinalizer(obj, v1beta1constants.ReferenceProtectionFinalizerName)
 
 	if needsFinalizer && !hasFinalizer {
 		log.Info("Adding finalizer")
@@ -24,6 +24,9 @@ type ControllerManagerConfiguration struct {
 	LeaderElection *componentbaseconfig.LeaderElectionConfiguration
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string
+	// LogLevelOverrides maps names of controllers to log levels which override the global log level for the respective
+	// controller, e.g., `shoot-maintenance: debug`. Each level must be one of [info,debug,error].
+	LogLevelOverrides map[string]string
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat string
 	// Server defines the configuration of the HTTP server.
@@ -39,8 +42,12 @@ type ControllerManagerConfiguration struct {
 
 // ControllerManagerControllerConfiguration defines the configuration of the controllers.
 type ControllerManagerControllerConfiguration struct {
+	// AccessRequest defines the configuration of the AccessRequest controller.
+	AccessRequest *AccessRequestControllerConfiguration
 	// Bastion defines the configuration of the Bastion controller.
 	Bastion *BastionControllerConfiguration
+	// BulkOperation defines the configuration of the BulkOperation controller.
+	BulkOperation *BulkOperationControllerConfiguration
 	// CertificateSigningRequest defines the configuration of the CertificateSigningRequest controller.
 	CertificateSigningRequest *CertificateSigningRequestControllerConfiguration
 	// CloudProfile defines the configuration of the CloudProfile controller.
@@ -57,6 +64,8 @@ type ControllerManagerControllerConfiguration struct {
 	Project *ProjectControllerConfiguration
 	// Quota defines the configuration of the Quota controller.
 	Quota *QuotaControllerConfiguration
+	// SSHAccessRequest defines the configuration of the SSHAccessRequest controller.
+	SSHAccessRequest *SSHAccessRequestControllerConfiguration
 	// SecretBinding defines the configuration of the SecretBinding controller.
 	SecretBinding *SecretBindingControllerConfiguration
 	// Seed defines the configuration of the Seed controller.
@@ -65,6 +74,10 @@ type ControllerManagerControllerConfiguration struct {
 	SeedExtensionsCheck *SeedExtensionsCheckControllerConfiguration
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration
+	// SeedRestoration defines the configuration of the SeedRestoration controller.
+	SeedRestoration *SeedRestorationControllerConfiguration
+	// SeedDecommission defines the configuration of the SeedDecommission controller.
+	SeedDecommission *SeedDecommissionControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -79,10 +92,25 @@ type ControllerManagerControllerConfiguration struct {
 	ShootConditions *ShootConditionsControllerConfiguration
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration
+	// ShootVersionPolicy defines the configuration of the ShootVersionPolicy controller.
+	ShootVersionPolicy *ShootVersionPolicyControllerConfiguration
+	// ShootReconciliationPause defines the configuration of the ShootReconciliationPause controller.
+	ShootReconciliationPause *ShootReconciliationPauseControllerConfiguration
+	// ShootCertificateTransparency defines the configuration of the ShootCertificateTransparency controller. If not
+	// set, the controller is disabled.
+	ShootCertificateTransparency *ShootCertificateTransparencyControllerConfiguration
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration
 }
 
+// AccessRequestControllerConfiguration defines the configuration of the AccessRequest
+// controller.
+type AccessRequestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
 // BastionControllerConfiguration defines the configuration of the Bastion
 // controller.
 type BastionControllerConfiguration struct {
@@ -94,6 +122,16 @@ type BastionControllerConfiguration struct {
 	MaxLifetime *metav1.Duration
 }
 
+// BulkOperationControllerConfiguration defines the configuration of the BulkOperation
+// controller.
+type BulkOperationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the progress of running BulkOperations is checked.
+	SyncPeriod *metav1.Duration
+}
+
 // CertificateSigningRequestControllerConfiguration defines the configuration of the CertificateSigningRequest
 // controller.
 type CertificateSigningRequestControllerConfiguration struct {
@@ -154,6 +192,8 @@ type ProjectControllerConfiguration struct {
 	MinimumLifetimeDays *int
 	// Quotas is the default configuration matching projects are set up with if a quota is not already specified.
 	Quotas []QuotaConfiguration
+	// LimitRanges is the default configuration matching projects are set up with.
+	LimitRanges []LimitRangeConfiguration
 	// StaleGracePeriodDays is the number of days a `Project` may be unused/stale before a
 	// timestamp for an auto deletion is computed.
 	StaleGracePeriodDays *int
@@ -162,6 +202,13 @@ type ProjectControllerConfiguration struct {
 	StaleExpirationTimeDays *int
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	StaleSyncPeriod *metav1.Duration
+	// UsageReportSyncPeriod is the duration how often the usage reports of Projects are updated. If it is not set, no
+	// usage reports are written.
+	UsageReportSyncPeriod *metav1.Duration
+	// DeletionGracePeriodDays is the number of days a deleted `Project` is archived before its namespace and shoots are
+	// irreversibly deleted. While being archived, the shoots of the project are hibernated and the access of the project
+	// members is removed. If it is not set, deleted Projects are not archived.
+	DeletionGracePeriodDays *int
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -174,6 +221,16 @@ type QuotaConfiguration struct {
 	ProjectSelector *metav1.LabelSelector
 }
 
+// LimitRangeConfiguration defines limit range configurations.
+type LimitRangeConfiguration struct {
+	// Config is the limit range specification used for the project set-up.
+	// Only v1.LimitRange resources are supported.
+	Config runtime.Object
+	// ProjectSelector is an optional setting to select the projects considered for limit ranges.
+	// Defaults to empty LabelSelector, which matches all projects.
+	ProjectSelector *metav1.LabelSelector
+}
+
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
 type QuotaControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
@@ -181,6 +238,14 @@ type QuotaControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// SSHAccessRequestControllerConfiguration defines the configuration of the SSHAccessRequest
+// controller.
+type SSHAccessRequestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
 // SecretBindingControllerConfiguration defines the configuration of the
 // SecretBinding controller.
 type SecretBindingControllerConfiguration struct {
@@ -232,6 +297,32 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// SeedRestorationControllerConfiguration defines the configuration of the
+// SeedRestoration controller.
+type SeedRestorationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the progress of the restoration of Shoots is checked.
+	SyncPeriod *metav1.Duration
+	// ParallelShootRestorations is the maximum number of Shoots per destroyed Seed whose restoration is in
+	// progress at the same time.
+	ParallelShootRestorations *int
+}
+
+// SeedDecommissionControllerConfiguration defines the configuration of the
+// SeedDecommission controller.
+type SeedDecommissionControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the progress of the decommissioning of Seeds is checked.
+	SyncPeriod *metav1.Duration
+	// ParallelShootMigrations is the maximum number of Shoots per decommissioned Seed whose migration is in
+	// progress at the same time.
+	ParallelShootMigrations *int
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -242,6 +333,9 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootControlPlaneRestarter *bool
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	EnableShootCoreAddonRestarter *bool
+	// PlannedChangesSyncPeriod is the duration how often the version updates planned for the next maintenance are
+	// computed and published in the Shoot status.
+	PlannedChangesSyncPeriod *metav1.Duration
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -304,6 +398,41 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// ShootVersionPolicyControllerConfiguration defines the configuration of the
+// ShootVersionPolicy controller.
+type ShootVersionPolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
+// ShootReconciliationPauseControllerConfiguration defines the configuration of the
+// ShootReconciliationPause controller.
+type ShootReconciliationPauseControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// WarningThreshold is the duration after which the ReconciliationPauseAcceptable constraint of a Shoot whose
+	// reconciliation is paused is set to False.
+	WarningThreshold *metav1.Duration
+}
+
+// ShootCertificateTransparencyControllerConfiguration defines the configuration of the
+// ShootCertificateTransparency controller.
+type ShootCertificateTransparencyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the certificate transparency logs are searched for certificates issued for
+	// the API server domains of a Shoot.
+	SyncPeriod *metav1.Duration
+	// Endpoint is the URL of the crt.sh compatible certificate transparency log search API.
+	Endpoint *string
+	// ExpectedIssuers is a list of regular expressions matching the distinguished names of the issuers of certificates
+	// which are expected to be issued for the API server domains of Shoots.
+	ExpectedIssuers []string
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
@@ -17,12 +17,12 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 )
 
-var quotaDecoder runtime.Decoder
+var decoder runtime.Decoder
 
 func init() {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(corev1.AddToScheme(scheme))
-	quotaDecoder = serializer.NewCodecFactory(scheme).UniversalDecoder(corev1.SchemeGroupVersion)
+	decoder = serializer.NewCodecFactory(scheme).UniversalDecoder(corev1.SchemeGroupVersion)
 }
 
 func Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in *QuotaConfiguration, out *config.QuotaConfiguration, s conversion.Scope) error {
@@ -32,7 +32,7 @@ func Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in *QuotaC
 	}
 
 	if out.Config != nil {
-		quotaObj, gvk, err := quotaDecoder.Decode(in.Config.Raw, nil, nil)
+		quotaObj, gvk, err := decoder.Decode(in.Config.Raw, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -46,3 +46,25 @@ func Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in *QuotaC
 	}
 	return nil
 }
+
+func Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(in *LimitRangeConfiguration, out *config.LimitRangeConfiguration, s conversion.Scope) error {
+	err := autoConvert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(in, out, s)
+	if err != nil {
+		return err
+	}
+
+	if out.Config != nil {
+		limitRangeObj, gvk, err := decoder.Decode(in.Config.Raw, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		limitRange, ok := limitRangeObj.(*corev1.LimitRange)
+		if !ok {
+			return fmt.Errorf("%v is not a supported LimitRange configuration", gvk)
+		}
+
+		out.Config = limitRange
+	}
+	return nil
+}
@@ -79,6 +79,70 @@ var _ = Describe("Conversion", func() {
 
 				Expect(Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in, out, nil)).To(matchers.BeMissingKindError())
 			})
+
+			It("should fail to convert a LimitRange", func() {
+				data, err := json.Marshal(&corev1.LimitRange{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "LimitRange"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				in.Config = runtime.RawExtension{
+					Raw: data,
+				}
+
+				Expect(Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(in, out, nil)).To(MatchError(ContainSubstring("is not a supported ResourceQuota configuration")))
+			})
+		})
+
+		Describe("#Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration", func() {
+			var (
+				out *config.LimitRangeConfiguration
+				in  *LimitRangeConfiguration
+			)
+
+			BeforeEach(func() {
+				in = &LimitRangeConfiguration{}
+				out = &config.LimitRangeConfiguration{}
+			})
+
+			It("should successfully convert the containing LimitRange", func() {
+				limitRange := &corev1.LimitRange{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "LimitRange",
+					},
+					Spec: corev1.LimitRangeSpec{
+						Limits: []corev1.LimitRangeItem{{
+							Type:    corev1.LimitTypeContainer,
+							Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+						}},
+					},
+				}
+
+				data, err := json.Marshal(limitRange)
+				Expect(err).ToNot(HaveOccurred())
+
+				in.Config = runtime.RawExtension{
+					Raw: data,
+				}
+
+				Expect(Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(in, out, nil)).To(Succeed())
+				Expect(out.Config).To(Equal(limitRange))
+			})
+
+			It("should skip the conversion", func() {
+				Expect(Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(in, out, nil)).To(Succeed())
+				Expect(out.Config).To(BeNil())
+			})
+
+			It("should fail to convert a ResourceQuota", func() {
+				data, err := json.Marshal(&corev1.ResourceQuota{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				in.Config = runtime.RawExtension{
+					Raw: data,
+				}
+
+				Expect(Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(in, out, nil)).To(MatchError(ContainSubstring("is not a supported LimitRange configuration")))
+			})
 		})
 	})
 })
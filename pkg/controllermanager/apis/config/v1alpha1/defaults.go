@@ -108,6 +108,12 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 			obj.Quotas[i].ProjectSelector = &metav1.LabelSelector{}
 		}
 	}
+
+	for i, limitRange := range obj.LimitRanges {
+		if limitRange.ProjectSelector == nil {
+			obj.LimitRanges[i].ProjectSelector = &metav1.LabelSelector{}
+		}
+	}
 }
 
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
@@ -127,6 +133,13 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	}
 }
 
+// SetDefaults_AccessRequestControllerConfiguration sets defaults for the AccessRequestControllerConfiguration.
+func SetDefaults_AccessRequestControllerConfiguration(obj *AccessRequestControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_BastionControllerConfiguration sets defaults for the BastionControllerConfiguration.
 func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -137,6 +150,16 @@ func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfigurat
 	}
 }
 
+// SetDefaults_BulkOperationControllerConfiguration sets defaults for the BulkOperationControllerConfiguration.
+func SetDefaults_BulkOperationControllerConfiguration(obj *BulkOperationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
 // SetDefaults_CertificateSigningRequestControllerConfiguration sets defaults for the CertificateSigningRequestControllerConfiguration.
 func SetDefaults_CertificateSigningRequestControllerConfiguration(obj *CertificateSigningRequestControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -206,6 +229,32 @@ func SetDefaults_SeedBackupBucketsCheckControllerConfiguration(obj *SeedBackupBu
 	}
 }
 
+// SetDefaults_SeedRestorationControllerConfiguration sets defaults for the SeedRestorationControllerConfiguration.
+func SetDefaults_SeedRestorationControllerConfiguration(obj *SeedRestorationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+	if obj.ParallelShootRestorations == nil {
+		obj.ParallelShootRestorations = ptr.To(10)
+	}
+}
+
+// SetDefaults_SeedDecommissionControllerConfiguration sets defaults for the SeedDecommissionControllerConfiguration.
+func SetDefaults_SeedDecommissionControllerConfiguration(obj *SeedDecommissionControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+	if obj.ParallelShootMigrations == nil {
+		obj.ParallelShootMigrations = ptr.To(5)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -224,6 +273,9 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	if obj.EnableShootControlPlaneRestarter == nil {
 		obj.EnableShootControlPlaneRestarter = ptr.To(true)
 	}
+	if obj.PlannedChangesSyncPeriod == nil {
+		obj.PlannedChangesSyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
 }
 
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
@@ -262,6 +314,13 @@ func SetDefaults_EventControllerConfiguration(obj *EventControllerConfiguration)
 	}
 }
 
+// SetDefaults_SSHAccessRequestControllerConfiguration sets defaults for the SSHAccessRequestControllerConfiguration.
+func SetDefaults_SSHAccessRequestControllerConfiguration(obj *SSHAccessRequestControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ShootStatusLabelControllerConfiguration sets defaults for the ShootStatusLabelControllerConfiguration.
 func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -269,6 +328,36 @@ func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelCo
 	}
 }
 
+// SetDefaults_ShootVersionPolicyControllerConfiguration sets defaults for the ShootVersionPolicyControllerConfiguration.
+func SetDefaults_ShootVersionPolicyControllerConfiguration(obj *ShootVersionPolicyControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
+// SetDefaults_ShootReconciliationPauseControllerConfiguration sets defaults for the ShootReconciliationPauseControllerConfiguration.
+func SetDefaults_ShootReconciliationPauseControllerConfiguration(obj *ShootReconciliationPauseControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.WarningThreshold == nil {
+		obj.WarningThreshold = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+}
+
+// SetDefaults_ShootCertificateTransparencyControllerConfiguration sets defaults for the ShootCertificateTransparencyControllerConfiguration.
+func SetDefaults_ShootCertificateTransparencyControllerConfiguration(obj *ShootCertificateTransparencyControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(1)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 6 * time.Hour}
+	}
+	if obj.Endpoint == nil {
+		obj.Endpoint = ptr.To("https://crt.sh")
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -281,9 +370,15 @@ func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetContro
 
 // SetDefaults_ControllerManagerControllerConfiguration sets defaults for the ControllerManagerControllerConfiguration.
 func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManagerControllerConfiguration) {
+	if obj.AccessRequest == nil {
+		obj.AccessRequest = &AccessRequestControllerConfiguration{}
+	}
 	if obj.Bastion == nil {
 		obj.Bastion = &BastionControllerConfiguration{}
 	}
+	if obj.BulkOperation == nil {
+		obj.BulkOperation = &BulkOperationControllerConfiguration{}
+	}
 	if obj.CertificateSigningRequest == nil {
 		obj.CertificateSigningRequest = &CertificateSigningRequestControllerConfiguration{}
 	}
@@ -305,6 +400,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.Quota == nil {
 		obj.Quota = &QuotaControllerConfiguration{}
 	}
+	if obj.SSHAccessRequest == nil {
+		obj.SSHAccessRequest = &SSHAccessRequestControllerConfiguration{}
+	}
 	if obj.SecretBinding == nil {
 		obj.SecretBinding = &SecretBindingControllerConfiguration{}
 	}
@@ -317,6 +415,12 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedBackupBucketsCheck == nil {
 		obj.SeedBackupBucketsCheck = &SeedBackupBucketsCheckControllerConfiguration{}
 	}
+	if obj.SeedRestoration == nil {
+		obj.SeedRestoration = &SeedRestorationControllerConfiguration{}
+	}
+	if obj.SeedDecommission == nil {
+		obj.SeedDecommission = &SeedDecommissionControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
@@ -332,6 +436,12 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ShootStatusLabel == nil {
 		obj.ShootStatusLabel = &ShootStatusLabelControllerConfiguration{}
 	}
+	if obj.ShootVersionPolicy == nil {
+		obj.ShootVersionPolicy = &ShootVersionPolicyControllerConfiguration{}
+	}
+	if obj.ShootReconciliationPause == nil {
+		obj.ShootReconciliationPause = &ShootReconciliationPauseControllerConfiguration{}
+	}
 
 	if obj.ManagedSeedSet == nil {
 		obj.ManagedSeedSet = &ManagedSeedSetControllerConfiguration{
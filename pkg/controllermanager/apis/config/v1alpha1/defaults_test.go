@@ -213,6 +213,25 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Project.Quotas).To(Equal(expected.Quotas))
 		})
 
+		It("should default ProjectControllerConfiguration unset LimitRangeConfiguration correctly", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Project: &ProjectControllerConfiguration{
+						LimitRanges: []LimitRangeConfiguration{
+							{},
+							{ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+						},
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Project.LimitRanges).To(Equal([]LimitRangeConfiguration{
+				{ProjectSelector: &metav1.LabelSelector{}},
+				{ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+			}))
+		})
+
 		It("should not default fields that are set", func() {
 			obj = &ControllerManagerConfiguration{
 				Controllers: ControllerManagerControllerConfiguration{
@@ -267,6 +286,56 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("AccessRequestControllerConfiguration defaulting", func() {
+		It("should default AccessRequestControllerConfiguration correctly", func() {
+			expected := &AccessRequestControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.AccessRequest).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					AccessRequest: &AccessRequestControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.AccessRequest.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.AccessRequest).To(Equal(expected))
+		})
+	})
+
+	Describe("SSHAccessRequestControllerConfiguration defaulting", func() {
+		It("should default SSHAccessRequestControllerConfiguration correctly", func() {
+			expected := &SSHAccessRequestControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SSHAccessRequest).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SSHAccessRequest: &SSHAccessRequestControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.SSHAccessRequest.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SSHAccessRequest).To(Equal(expected))
+		})
+	})
+
 	Describe("BastionControllerConfiguration defaulting", func() {
 		It("should default BastionControllerConfiguration correctly", func() {
 			expected := &BastionControllerConfiguration{
@@ -294,6 +363,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("BulkOperationControllerConfiguration defaulting", func() {
+		It("should default BulkOperationControllerConfiguration correctly", func() {
+			expected := &BulkOperationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 30 * time.Second},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.BulkOperation).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					BulkOperation: &BulkOperationControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.BulkOperation.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.BulkOperation).To(Equal(expected))
+		})
+	})
+
 	Describe("CertificateSigningRequestControllerConfiguration defaulting", func() {
 		It("should default CertificateSigningRequestControllerConfiguration correctly", func() {
 			expected := &CertificateSigningRequestControllerConfiguration{
@@ -523,6 +619,64 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedRestorationControllerConfiguration defaulting", func() {
+		It("should default SeedRestorationControllerConfiguration correctly", func() {
+			expected := &SeedRestorationControllerConfiguration{
+				ConcurrentSyncs:           ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:                &metav1.Duration{Duration: 30 * time.Second},
+				ParallelShootRestorations: ptr.To(10),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRestoration).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedRestoration: &SeedRestorationControllerConfiguration{
+						ConcurrentSyncs:           ptr.To(10),
+						SyncPeriod:                &metav1.Duration{Duration: time.Minute},
+						ParallelShootRestorations: ptr.To(3),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedRestoration.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRestoration).To(Equal(expected))
+		})
+	})
+
+	Describe("SeedDecommissionControllerConfiguration defaulting", func() {
+		It("should default SeedDecommissionControllerConfiguration correctly", func() {
+			expected := &SeedDecommissionControllerConfiguration{
+				ConcurrentSyncs:         ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:              &metav1.Duration{Duration: 30 * time.Second},
+				ParallelShootMigrations: ptr.To(5),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDecommission).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedDecommission: &SeedDecommissionControllerConfiguration{
+						ConcurrentSyncs:         ptr.To(10),
+						SyncPeriod:              &metav1.Duration{Duration: time.Minute},
+						ParallelShootMigrations: ptr.To(3),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedDecommission.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDecommission).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -555,6 +709,7 @@ var _ = Describe("Defaults", func() {
 			expected := &ShootMaintenanceControllerConfiguration{
 				ConcurrentSyncs:                  ptr.To(DefaultControllerConcurrentSyncs),
 				EnableShootControlPlaneRestarter: ptr.To(true),
+				PlannedChangesSyncPeriod:         &metav1.Duration{Duration: time.Hour},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -567,6 +722,7 @@ var _ = Describe("Defaults", func() {
 					ShootMaintenance: ShootMaintenanceControllerConfiguration{
 						ConcurrentSyncs:                  ptr.To(10),
 						EnableShootControlPlaneRestarter: ptr.To(false),
+						PlannedChangesSyncPeriod:         &metav1.Duration{Duration: time.Minute},
 					},
 				},
 			}
@@ -722,6 +878,91 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootVersionPolicyControllerConfiguration defaulting", func() {
+		It("should default ShootVersionPolicyControllerConfiguration correctly", func() {
+			expected := &ShootVersionPolicyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootVersionPolicy).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootVersionPolicy: &ShootVersionPolicyControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootVersionPolicy.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootVersionPolicy).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootReconciliationPauseControllerConfiguration defaulting", func() {
+		It("should default ShootReconciliationPauseControllerConfiguration correctly", func() {
+			expected := &ShootReconciliationPauseControllerConfiguration{
+				ConcurrentSyncs:  ptr.To(DefaultControllerConcurrentSyncs),
+				WarningThreshold: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootReconciliationPause).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootReconciliationPause: &ShootReconciliationPauseControllerConfiguration{
+						ConcurrentSyncs:  ptr.To(10),
+						WarningThreshold: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootReconciliationPause.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootReconciliationPause).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootCertificateTransparencyControllerConfiguration defaulting", func() {
+		It("should not default ShootCertificateTransparencyControllerConfiguration if nil", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificateTransparency).To(BeNil())
+		})
+
+		It("should default ShootCertificateTransparencyControllerConfiguration correctly", func() {
+			obj.Controllers.ShootCertificateTransparency = &ShootCertificateTransparencyControllerConfiguration{}
+			expected := &ShootCertificateTransparencyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
+				Endpoint:        ptr.To("https://crt.sh"),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificateTransparency).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj.Controllers.ShootCertificateTransparency = &ShootCertificateTransparencyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(2),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+				Endpoint:        ptr.To("https://ct.example.com"),
+				ExpectedIssuers: []string{"O=Let's Encrypt"},
+			}
+			expected := obj.Controllers.ShootCertificateTransparency.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCertificateTransparency).To(Equal(expected))
+		})
+	})
+
 	Describe("ManagedSeedSetControllerConfiguration defaulting", func() {
 		It("should default ManagedSeedSetControllerConfiguration correctly if nil", func() {
 			expected := &ManagedSeedSetControllerConfiguration{
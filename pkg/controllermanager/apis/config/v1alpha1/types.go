@@ -25,6 +25,10 @@ type ControllerManagerConfiguration struct {
 	LeaderElection *componentbaseconfigv1alpha1.LeaderElectionConfiguration `json:"leaderElection,omitempty"`
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string `json:"logLevel"`
+	// LogLevelOverrides maps names of controllers to log levels which override the global log level for the respective
+	// controller, e.g., `shoot-maintenance: debug`. Each level must be one of [info,debug,error].
+	// +optional
+	LogLevelOverrides map[string]string `json:"logLevelOverrides,omitempty"`
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat string `json:"logFormat"`
 	// Server defines the configuration of the HTTP server.
@@ -42,9 +46,15 @@ type ControllerManagerConfiguration struct {
 
 // ControllerManagerControllerConfiguration defines the configuration of the controllers.
 type ControllerManagerControllerConfiguration struct {
+	// AccessRequest defines the configuration of the AccessRequest controller.
+	// +optional
+	AccessRequest *AccessRequestControllerConfiguration `json:"accessRequest,omitempty"`
 	// Bastion defines the configuration of the Bastion controller.
 	// +optional
 	Bastion *BastionControllerConfiguration `json:"bastion,omitempty"`
+	// BulkOperation defines the configuration of the BulkOperation controller.
+	// +optional
+	BulkOperation *BulkOperationControllerConfiguration `json:"bulkOperation,omitempty"`
 	// CertificateSigningRequest defines the configuration of the CertificateSigningRequest controller.
 	// +optional
 	CertificateSigningRequest *CertificateSigningRequestControllerConfiguration `json:"certificateSigningRequest,omitempty"`
@@ -69,6 +79,9 @@ type ControllerManagerControllerConfiguration struct {
 	// Quota defines the configuration of the Quota controller.
 	// +optional
 	Quota *QuotaControllerConfiguration `json:"quota,omitempty"`
+	// SSHAccessRequest defines the configuration of the SSHAccessRequest controller.
+	// +optional
+	SSHAccessRequest *SSHAccessRequestControllerConfiguration `json:"sshAccessRequest,omitempty"`
 	// SecretBinding defines the configuration of the SecretBinding controller.
 	// +optional
 	SecretBinding *SecretBindingControllerConfiguration `json:"secretBinding,omitempty"`
@@ -81,6 +94,12 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	// +optional
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration `json:"seedBackupBucketsCheck,omitempty"`
+	// SeedRestoration defines the configuration of the SeedRestoration controller.
+	// +optional
+	SeedRestoration *SeedRestorationControllerConfiguration `json:"seedRestoration,omitempty"`
+	// SeedDecommission defines the configuration of the SeedDecommission controller.
+	// +optional
+	SeedDecommission *SeedDecommissionControllerConfiguration `json:"seedDecommission,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -100,11 +119,30 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootVersionPolicy defines the configuration of the ShootVersionPolicy controller.
+	// +optional
+	ShootVersionPolicy *ShootVersionPolicyControllerConfiguration `json:"shootVersionPolicy,omitempty"`
+	// ShootReconciliationPause defines the configuration of the ShootReconciliationPause controller.
+	// +optional
+	ShootReconciliationPause *ShootReconciliationPauseControllerConfiguration `json:"shootReconciliationPause,omitempty"`
+	// ShootCertificateTransparency defines the configuration of the ShootCertificateTransparency controller. If not
+	// set, the controller is disabled.
+	// +optional
+	ShootCertificateTransparency *ShootCertificateTransparencyControllerConfiguration `json:"shootCertificateTransparency,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
 }
 
+// AccessRequestControllerConfiguration defines the configuration of the AccessRequest
+// controller.
+type AccessRequestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // BastionControllerConfiguration defines the configuration of the Bastion
 // controller.
 type BastionControllerConfiguration struct {
@@ -118,6 +156,18 @@ type BastionControllerConfiguration struct {
 	MaxLifetime *metav1.Duration `json:"maxLifetime,omitempty"`
 }
 
+// BulkOperationControllerConfiguration defines the configuration of the BulkOperation
+// controller.
+type BulkOperationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of running BulkOperations is checked (defaults to '30s').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // CertificateSigningRequestControllerConfiguration defines the configuration of the CertificateSigningRequest
 // controller.
 type CertificateSigningRequestControllerConfiguration struct {
@@ -188,6 +238,9 @@ type ProjectControllerConfiguration struct {
 	// Quotas is the default configuration matching projects are set up with if a quota is not already specified.
 	// +optional
 	Quotas []QuotaConfiguration `json:"quotas,omitempty"`
+	// LimitRanges is the default configuration matching projects are set up with.
+	// +optional
+	LimitRanges []LimitRangeConfiguration `json:"limitRanges,omitempty"`
 	// StaleGracePeriodDays is the number of days a `Project` may be unused before it will
 	// be considered for checks whether it is actively used or got stale.
 	// +optional
@@ -199,6 +252,15 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// UsageReportSyncPeriod is the duration how often the usage reports of Projects are updated. If it is not set, no
+	// usage reports are written.
+	// +optional
+	UsageReportSyncPeriod *metav1.Duration `json:"usageReportSyncPeriod,omitempty"`
+	// DeletionGracePeriodDays is the number of days a deleted `Project` is archived before its namespace and shoots are
+	// irreversibly deleted. While being archived, the shoots of the project are hibernated and the access of the project
+	// members is removed. If it is not set, deleted Projects are not archived.
+	// +optional
+	DeletionGracePeriodDays *int `json:"deletionGracePeriodDays,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -212,6 +274,17 @@ type QuotaConfiguration struct {
 	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
 }
 
+// LimitRangeConfiguration defines limit range configurations.
+type LimitRangeConfiguration struct {
+	// Config is the limit range specification used for the project set-up.
+	// Only v1.LimitRange resources are supported.
+	Config runtime.RawExtension `json:"config"`
+	// ProjectSelector is an optional setting to select the projects considered for limit ranges.
+	// Defaults to empty LabelSelector, which matches all projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+}
+
 // QuotaControllerConfiguration defines the configuration of the Quota controller.
 type QuotaControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
@@ -220,6 +293,15 @@ type QuotaControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// SSHAccessRequestControllerConfiguration defines the configuration of the SSHAccessRequest
+// controller.
+type SSHAccessRequestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // SecretBindingControllerConfiguration defines the configuration of the
 // SecretBinding controller.
 type SecretBindingControllerConfiguration struct {
@@ -282,6 +364,38 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// SeedRestorationControllerConfiguration defines the configuration of the
+// SeedRestoration controller.
+type SeedRestorationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of the restoration of Shoots is checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ParallelShootRestorations is the maximum number of Shoots per destroyed Seed whose restoration is in
+	// progress at the same time. Defaults to 10.
+	// +optional
+	ParallelShootRestorations *int `json:"parallelShootRestorations,omitempty"`
+}
+
+// SeedDecommissionControllerConfiguration defines the configuration of the
+// SeedDecommission controller.
+type SeedDecommissionControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of the decommissioning of Seeds is checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// ParallelShootMigrations is the maximum number of Shoots per decommissioned Seed whose migration is in
+	// progress at the same time. Defaults to 5.
+	// +optional
+	ParallelShootMigrations *int `json:"parallelShootMigrations,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -295,6 +409,10 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// PlannedChangesSyncPeriod is the duration how often the version updates planned for the next maintenance are
+	// computed and published in the Shoot status.
+	// +optional
+	PlannedChangesSyncPeriod *metav1.Duration `json:"plannedChangesSyncPeriod,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -368,6 +486,50 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootVersionPolicyControllerConfiguration defines the configuration of the
+// ShootVersionPolicy controller.
+type ShootVersionPolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
+// ShootReconciliationPauseControllerConfiguration defines the configuration of the
+// ShootReconciliationPause controller.
+type ShootReconciliationPauseControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// WarningThreshold is the duration after which the ReconciliationPauseAcceptable constraint of a Shoot whose
+	// reconciliation is paused is set to False. Defaults to 168h (7 days).
+	// +optional
+	WarningThreshold *metav1.Duration `json:"warningThreshold,omitempty"`
+}
+
+// ShootCertificateTransparencyControllerConfiguration defines the configuration of the
+// ShootCertificateTransparency controller.
+type ShootCertificateTransparencyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events. Defaults to 1 in order to not exceed the rate limits of the certificate transparency log search API.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the certificate transparency logs are searched for certificates issued for
+	// the API server domains of a Shoot. Defaults to 6h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Endpoint is the URL of the crt.sh compatible certificate transparency log search API. Defaults to
+	// https://crt.sh.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+	// ExpectedIssuers is a list of regular expressions matching the distinguished names of the issuers of certificates
+	// which are expected to be issued for the API server domains of Shoots, e.g. 'O=Let's Encrypt' if the operator
+	// issues such certificates on purpose.
+	// +optional
+	ExpectedIssuers []string `json:"expectedIssuers,omitempty"`
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
@@ -27,6 +27,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AccessRequestControllerConfiguration)(nil), (*config.AccessRequestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AccessRequestControllerConfiguration_To_config_AccessRequestControllerConfiguration(a.(*AccessRequestControllerConfiguration), b.(*config.AccessRequestControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AccessRequestControllerConfiguration)(nil), (*AccessRequestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AccessRequestControllerConfiguration_To_v1alpha1_AccessRequestControllerConfiguration(a.(*config.AccessRequestControllerConfiguration), b.(*AccessRequestControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionControllerConfiguration)(nil), (*config.BastionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionControllerConfiguration_To_config_BastionControllerConfiguration(a.(*BastionControllerConfiguration), b.(*config.BastionControllerConfiguration), scope)
 	}); err != nil {
@@ -37,6 +47,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BulkOperationControllerConfiguration)(nil), (*config.BulkOperationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BulkOperationControllerConfiguration_To_config_BulkOperationControllerConfiguration(a.(*BulkOperationControllerConfiguration), b.(*config.BulkOperationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BulkOperationControllerConfiguration)(nil), (*BulkOperationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BulkOperationControllerConfiguration_To_v1alpha1_BulkOperationControllerConfiguration(a.(*config.BulkOperationControllerConfiguration), b.(*BulkOperationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateSigningRequestControllerConfiguration)(nil), (*config.CertificateSigningRequestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CertificateSigningRequestControllerConfiguration_To_config_CertificateSigningRequestControllerConfiguration(a.(*CertificateSigningRequestControllerConfiguration), b.(*config.CertificateSigningRequestControllerConfiguration), scope)
 	}); err != nil {
@@ -127,6 +147,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*config.LimitRangeConfiguration)(nil), (*LimitRangeConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LimitRangeConfiguration_To_v1alpha1_LimitRangeConfiguration(a.(*config.LimitRangeConfiguration), b.(*LimitRangeConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSetControllerConfiguration)(nil), (*config.ManagedSeedSetControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(a.(*ManagedSeedSetControllerConfiguration), b.(*config.ManagedSeedSetControllerConfiguration), scope)
 	}); err != nil {
@@ -162,6 +187,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SSHAccessRequestControllerConfiguration)(nil), (*config.SSHAccessRequestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SSHAccessRequestControllerConfiguration_To_config_SSHAccessRequestControllerConfiguration(a.(*SSHAccessRequestControllerConfiguration), b.(*config.SSHAccessRequestControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SSHAccessRequestControllerConfiguration)(nil), (*SSHAccessRequestControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SSHAccessRequestControllerConfiguration_To_v1alpha1_SSHAccessRequestControllerConfiguration(a.(*config.SSHAccessRequestControllerConfiguration), b.(*SSHAccessRequestControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBindingControllerConfiguration)(nil), (*config.SecretBindingControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretBindingControllerConfiguration_To_config_SecretBindingControllerConfiguration(a.(*SecretBindingControllerConfiguration), b.(*config.SecretBindingControllerConfiguration), scope)
 	}); err != nil {
@@ -192,6 +227,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDecommissionControllerConfiguration)(nil), (*config.SeedDecommissionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedDecommissionControllerConfiguration_To_config_SeedDecommissionControllerConfiguration(a.(*SeedDecommissionControllerConfiguration), b.(*config.SeedDecommissionControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedDecommissionControllerConfiguration)(nil), (*SeedDecommissionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedDecommissionControllerConfiguration_To_v1alpha1_SeedDecommissionControllerConfiguration(a.(*config.SeedDecommissionControllerConfiguration), b.(*SeedDecommissionControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedExtensionsCheckControllerConfiguration)(nil), (*config.SeedExtensionsCheckControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedExtensionsCheckControllerConfiguration_To_config_SeedExtensionsCheckControllerConfiguration(a.(*SeedExtensionsCheckControllerConfiguration), b.(*config.SeedExtensionsCheckControllerConfiguration), scope)
 	}); err != nil {
@@ -202,6 +247,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedRestorationControllerConfiguration)(nil), (*config.SeedRestorationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedRestorationControllerConfiguration_To_config_SeedRestorationControllerConfiguration(a.(*SeedRestorationControllerConfiguration), b.(*config.SeedRestorationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedRestorationControllerConfiguration)(nil), (*SeedRestorationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedRestorationControllerConfiguration_To_v1alpha1_SeedRestorationControllerConfiguration(a.(*config.SeedRestorationControllerConfiguration), b.(*SeedRestorationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -222,6 +277,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCertificateTransparencyControllerConfiguration)(nil), (*config.ShootCertificateTransparencyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCertificateTransparencyControllerConfiguration_To_config_ShootCertificateTransparencyControllerConfiguration(a.(*ShootCertificateTransparencyControllerConfiguration), b.(*config.ShootCertificateTransparencyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootCertificateTransparencyControllerConfiguration)(nil), (*ShootCertificateTransparencyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootCertificateTransparencyControllerConfiguration_To_v1alpha1_ShootCertificateTransparencyControllerConfiguration(a.(*config.ShootCertificateTransparencyControllerConfiguration), b.(*ShootCertificateTransparencyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootConditionsControllerConfiguration)(nil), (*config.ShootConditionsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(a.(*ShootConditionsControllerConfiguration), b.(*config.ShootConditionsControllerConfiguration), scope)
 	}); err != nil {
@@ -262,6 +327,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootReconciliationPauseControllerConfiguration)(nil), (*config.ShootReconciliationPauseControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootReconciliationPauseControllerConfiguration_To_config_ShootReconciliationPauseControllerConfiguration(a.(*ShootReconciliationPauseControllerConfiguration), b.(*config.ShootReconciliationPauseControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootReconciliationPauseControllerConfiguration)(nil), (*ShootReconciliationPauseControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootReconciliationPauseControllerConfiguration_To_v1alpha1_ShootReconciliationPauseControllerConfiguration(a.(*config.ShootReconciliationPauseControllerConfiguration), b.(*ShootReconciliationPauseControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootReferenceControllerConfiguration)(nil), (*config.ShootReferenceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootReferenceControllerConfiguration_To_config_ShootReferenceControllerConfiguration(a.(*ShootReferenceControllerConfiguration), b.(*config.ShootReferenceControllerConfiguration), scope)
 	}); err != nil {
@@ -292,6 +367,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootVersionPolicyControllerConfiguration)(nil), (*config.ShootVersionPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootVersionPolicyControllerConfiguration_To_config_ShootVersionPolicyControllerConfiguration(a.(*ShootVersionPolicyControllerConfiguration), b.(*config.ShootVersionPolicyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootVersionPolicyControllerConfiguration)(nil), (*ShootVersionPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootVersionPolicyControllerConfiguration_To_v1alpha1_ShootVersionPolicyControllerConfiguration(a.(*config.ShootVersionPolicyControllerConfiguration), b.(*ShootVersionPolicyControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*LimitRangeConfiguration)(nil), (*config.LimitRangeConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(a.(*LimitRangeConfiguration), b.(*config.LimitRangeConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*QuotaConfiguration)(nil), (*config.QuotaConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_QuotaConfiguration_To_config_QuotaConfiguration(a.(*QuotaConfiguration), b.(*config.QuotaConfiguration), scope)
 	}); err != nil {
@@ -300,6 +390,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AccessRequestControllerConfiguration_To_config_AccessRequestControllerConfiguration(in *AccessRequestControllerConfiguration, out *config.AccessRequestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_AccessRequestControllerConfiguration_To_config_AccessRequestControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_AccessRequestControllerConfiguration_To_config_AccessRequestControllerConfiguration(in *AccessRequestControllerConfiguration, out *config.AccessRequestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AccessRequestControllerConfiguration_To_config_AccessRequestControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_AccessRequestControllerConfiguration_To_v1alpha1_AccessRequestControllerConfiguration(in *config.AccessRequestControllerConfiguration, out *AccessRequestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_AccessRequestControllerConfiguration_To_v1alpha1_AccessRequestControllerConfiguration is an autogenerated conversion function.
+func Convert_config_AccessRequestControllerConfiguration_To_v1alpha1_AccessRequestControllerConfiguration(in *config.AccessRequestControllerConfiguration, out *AccessRequestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_AccessRequestControllerConfiguration_To_v1alpha1_AccessRequestControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionControllerConfiguration_To_config_BastionControllerConfiguration(in *BastionControllerConfiguration, out *config.BastionControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxLifetime = (*v1.Duration)(unsafe.Pointer(in.MaxLifetime))
@@ -322,6 +432,28 @@ func Convert_config_BastionControllerConfiguration_To_v1alpha1_BastionController
 	return autoConvert_config_BastionControllerConfiguration_To_v1alpha1_BastionControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_BulkOperationControllerConfiguration_To_config_BulkOperationControllerConfiguration(in *BulkOperationControllerConfiguration, out *config.BulkOperationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_BulkOperationControllerConfiguration_To_config_BulkOperationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_BulkOperationControllerConfiguration_To_config_BulkOperationControllerConfiguration(in *BulkOperationControllerConfiguration, out *config.BulkOperationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BulkOperationControllerConfiguration_To_config_BulkOperationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_BulkOperationControllerConfiguration_To_v1alpha1_BulkOperationControllerConfiguration(in *config.BulkOperationControllerConfiguration, out *BulkOperationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_BulkOperationControllerConfiguration_To_v1alpha1_BulkOperationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_BulkOperationControllerConfiguration_To_v1alpha1_BulkOperationControllerConfiguration(in *config.BulkOperationControllerConfiguration, out *BulkOperationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_BulkOperationControllerConfiguration_To_v1alpha1_BulkOperationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_CertificateSigningRequestControllerConfiguration_To_config_CertificateSigningRequestControllerConfiguration(in *CertificateSigningRequestControllerConfiguration, out *config.CertificateSigningRequestControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -421,6 +553,7 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerMan
 		out.LeaderElection = nil
 	}
 	out.LogLevel = in.LogLevel
+	out.LogLevelOverrides = *(*map[string]string)(unsafe.Pointer(&in.LogLevelOverrides))
 	out.LogFormat = in.LogFormat
 	if err := Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -460,6 +593,7 @@ func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerMan
 		out.LeaderElection = nil
 	}
 	out.LogLevel = in.LogLevel
+	out.LogLevelOverrides = *(*map[string]string)(unsafe.Pointer(&in.LogLevelOverrides))
 	out.LogFormat = in.LogFormat
 	if err := Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -483,7 +617,9 @@ func Convert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerManager
 }
 
 func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_ControllerManagerControllerConfiguration(in *ControllerManagerControllerConfiguration, out *config.ControllerManagerControllerConfiguration, s conversion.Scope) error {
+	out.AccessRequest = (*config.AccessRequestControllerConfiguration)(unsafe.Pointer(in.AccessRequest))
 	out.Bastion = (*config.BastionControllerConfiguration)(unsafe.Pointer(in.Bastion))
+	out.BulkOperation = (*config.BulkOperationControllerConfiguration)(unsafe.Pointer(in.BulkOperation))
 	out.CertificateSigningRequest = (*config.CertificateSigningRequestControllerConfiguration)(unsafe.Pointer(in.CertificateSigningRequest))
 	out.CloudProfile = (*config.CloudProfileControllerConfiguration)(unsafe.Pointer(in.CloudProfile))
 	out.ControllerDeployment = (*config.ControllerDeploymentControllerConfiguration)(unsafe.Pointer(in.ControllerDeployment))
@@ -500,10 +636,13 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 		out.Project = nil
 	}
 	out.Quota = (*config.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
+	out.SSHAccessRequest = (*config.SSHAccessRequestControllerConfiguration)(unsafe.Pointer(in.SSHAccessRequest))
 	out.SecretBinding = (*config.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*config.SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*config.SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedRestoration = (*config.SeedRestorationControllerConfiguration)(unsafe.Pointer(in.SeedRestoration))
+	out.SeedDecommission = (*config.SeedDecommissionControllerConfiguration)(unsafe.Pointer(in.SeedDecommission))
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -515,6 +654,9 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ShootRetry = (*config.ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*config.ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*config.ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootVersionPolicy = (*config.ShootVersionPolicyControllerConfiguration)(unsafe.Pointer(in.ShootVersionPolicy))
+	out.ShootReconciliationPause = (*config.ShootReconciliationPauseControllerConfiguration)(unsafe.Pointer(in.ShootReconciliationPause))
+	out.ShootCertificateTransparency = (*config.ShootCertificateTransparencyControllerConfiguration)(unsafe.Pointer(in.ShootCertificateTransparency))
 	out.ManagedSeedSet = (*config.ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -525,7 +667,9 @@ func Convert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Control
 }
 
 func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration(in *config.ControllerManagerControllerConfiguration, out *ControllerManagerControllerConfiguration, s conversion.Scope) error {
+	out.AccessRequest = (*AccessRequestControllerConfiguration)(unsafe.Pointer(in.AccessRequest))
 	out.Bastion = (*BastionControllerConfiguration)(unsafe.Pointer(in.Bastion))
+	out.BulkOperation = (*BulkOperationControllerConfiguration)(unsafe.Pointer(in.BulkOperation))
 	out.CertificateSigningRequest = (*CertificateSigningRequestControllerConfiguration)(unsafe.Pointer(in.CertificateSigningRequest))
 	out.CloudProfile = (*CloudProfileControllerConfiguration)(unsafe.Pointer(in.CloudProfile))
 	out.ControllerDeployment = (*ControllerDeploymentControllerConfiguration)(unsafe.Pointer(in.ControllerDeployment))
@@ -542,10 +686,13 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 		out.Project = nil
 	}
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
+	out.SSHAccessRequest = (*SSHAccessRequestControllerConfiguration)(unsafe.Pointer(in.SSHAccessRequest))
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedRestoration = (*SeedRestorationControllerConfiguration)(unsafe.Pointer(in.SeedRestoration))
+	out.SeedDecommission = (*SeedDecommissionControllerConfiguration)(unsafe.Pointer(in.SeedDecommission))
 	if err := Convert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -557,6 +704,9 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ShootRetry = (*ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootVersionPolicy = (*ShootVersionPolicyControllerConfiguration)(unsafe.Pointer(in.ShootVersionPolicy))
+	out.ShootReconciliationPause = (*ShootReconciliationPauseControllerConfiguration)(unsafe.Pointer(in.ShootReconciliationPause))
+	out.ShootCertificateTransparency = (*ShootCertificateTransparencyControllerConfiguration)(unsafe.Pointer(in.ShootCertificateTransparency))
 	out.ManagedSeedSet = (*ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -628,6 +778,27 @@ func Convert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureCla
 	return autoConvert_config_ExposureClassControllerConfiguration_To_v1alpha1_ExposureClassControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(in *LimitRangeConfiguration, out *config.LimitRangeConfiguration, s conversion.Scope) error {
+	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+func autoConvert_config_LimitRangeConfiguration_To_v1alpha1_LimitRangeConfiguration(in *config.LimitRangeConfiguration, out *LimitRangeConfiguration, s conversion.Scope) error {
+	if err := runtime.Convert_runtime_Object_To_runtime_RawExtension(&in.Config, &out.Config, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_config_LimitRangeConfiguration_To_v1alpha1_LimitRangeConfiguration is an autogenerated conversion function.
+func Convert_config_LimitRangeConfiguration_To_v1alpha1_LimitRangeConfiguration(in *config.LimitRangeConfiguration, out *LimitRangeConfiguration, s conversion.Scope) error {
+	return autoConvert_config_LimitRangeConfiguration_To_v1alpha1_LimitRangeConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSetControllerConfiguration_To_config_ManagedSeedSetControllerConfiguration(in *ManagedSeedSetControllerConfiguration, out *config.ManagedSeedSetControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MaxShootRetries = (*int)(unsafe.Pointer(in.MaxShootRetries))
@@ -666,9 +837,22 @@ func autoConvert_v1alpha1_ProjectControllerConfiguration_To_config_ProjectContro
 	} else {
 		out.Quotas = nil
 	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]config.LimitRangeConfiguration, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LimitRangeConfiguration_To_config_LimitRangeConfiguration(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LimitRanges = nil
+	}
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.UsageReportSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.UsageReportSyncPeriod))
+	out.DeletionGracePeriodDays = (*int)(unsafe.Pointer(in.DeletionGracePeriodDays))
 	return nil
 }
 
@@ -691,9 +875,22 @@ func autoConvert_config_ProjectControllerConfiguration_To_v1alpha1_ProjectContro
 	} else {
 		out.Quotas = nil
 	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]LimitRangeConfiguration, len(*in))
+		for i := range *in {
+			if err := Convert_config_LimitRangeConfiguration_To_v1alpha1_LimitRangeConfiguration(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LimitRanges = nil
+	}
 	out.StaleGracePeriodDays = (*int)(unsafe.Pointer(in.StaleGracePeriodDays))
 	out.StaleExpirationTimeDays = (*int)(unsafe.Pointer(in.StaleExpirationTimeDays))
 	out.StaleSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.StaleSyncPeriod))
+	out.UsageReportSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.UsageReportSyncPeriod))
+	out.DeletionGracePeriodDays = (*int)(unsafe.Pointer(in.DeletionGracePeriodDays))
 	return nil
 }
 
@@ -743,6 +940,26 @@ func Convert_config_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConf
 	return autoConvert_config_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SSHAccessRequestControllerConfiguration_To_config_SSHAccessRequestControllerConfiguration(in *SSHAccessRequestControllerConfiguration, out *config.SSHAccessRequestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_SSHAccessRequestControllerConfiguration_To_config_SSHAccessRequestControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SSHAccessRequestControllerConfiguration_To_config_SSHAccessRequestControllerConfiguration(in *SSHAccessRequestControllerConfiguration, out *config.SSHAccessRequestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SSHAccessRequestControllerConfiguration_To_config_SSHAccessRequestControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SSHAccessRequestControllerConfiguration_To_v1alpha1_SSHAccessRequestControllerConfiguration(in *config.SSHAccessRequestControllerConfiguration, out *SSHAccessRequestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_SSHAccessRequestControllerConfiguration_To_v1alpha1_SSHAccessRequestControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SSHAccessRequestControllerConfiguration_To_v1alpha1_SSHAccessRequestControllerConfiguration(in *config.SSHAccessRequestControllerConfiguration, out *SSHAccessRequestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SSHAccessRequestControllerConfiguration_To_v1alpha1_SSHAccessRequestControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretBindingControllerConfiguration_To_config_SecretBindingControllerConfiguration(in *SecretBindingControllerConfiguration, out *config.SecretBindingControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -813,6 +1030,30 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedDecommissionControllerConfiguration_To_config_SeedDecommissionControllerConfiguration(in *SeedDecommissionControllerConfiguration, out *config.SeedDecommissionControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ParallelShootMigrations = (*int)(unsafe.Pointer(in.ParallelShootMigrations))
+	return nil
+}
+
+// Convert_v1alpha1_SeedDecommissionControllerConfiguration_To_config_SeedDecommissionControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedDecommissionControllerConfiguration_To_config_SeedDecommissionControllerConfiguration(in *SeedDecommissionControllerConfiguration, out *config.SeedDecommissionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedDecommissionControllerConfiguration_To_config_SeedDecommissionControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedDecommissionControllerConfiguration_To_v1alpha1_SeedDecommissionControllerConfiguration(in *config.SeedDecommissionControllerConfiguration, out *SeedDecommissionControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ParallelShootMigrations = (*int)(unsafe.Pointer(in.ParallelShootMigrations))
+	return nil
+}
+
+// Convert_config_SeedDecommissionControllerConfiguration_To_v1alpha1_SeedDecommissionControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedDecommissionControllerConfiguration_To_v1alpha1_SeedDecommissionControllerConfiguration(in *config.SeedDecommissionControllerConfiguration, out *SeedDecommissionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedDecommissionControllerConfiguration_To_v1alpha1_SeedDecommissionControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedExtensionsCheckControllerConfiguration_To_config_SeedExtensionsCheckControllerConfiguration(in *SeedExtensionsCheckControllerConfiguration, out *config.SeedExtensionsCheckControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -837,6 +1078,30 @@ func Convert_config_SeedExtensionsCheckControllerConfiguration_To_v1alpha1_SeedE
 	return autoConvert_config_SeedExtensionsCheckControllerConfiguration_To_v1alpha1_SeedExtensionsCheckControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedRestorationControllerConfiguration_To_config_SeedRestorationControllerConfiguration(in *SeedRestorationControllerConfiguration, out *config.SeedRestorationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ParallelShootRestorations = (*int)(unsafe.Pointer(in.ParallelShootRestorations))
+	return nil
+}
+
+// Convert_v1alpha1_SeedRestorationControllerConfiguration_To_config_SeedRestorationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedRestorationControllerConfiguration_To_config_SeedRestorationControllerConfiguration(in *SeedRestorationControllerConfiguration, out *config.SeedRestorationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedRestorationControllerConfiguration_To_config_SeedRestorationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedRestorationControllerConfiguration_To_v1alpha1_SeedRestorationControllerConfiguration(in *config.SeedRestorationControllerConfiguration, out *SeedRestorationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.ParallelShootRestorations = (*int)(unsafe.Pointer(in.ParallelShootRestorations))
+	return nil
+}
+
+// Convert_config_SeedRestorationControllerConfiguration_To_v1alpha1_SeedRestorationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedRestorationControllerConfiguration_To_v1alpha1_SeedRestorationControllerConfiguration(in *config.SeedRestorationControllerConfiguration, out *SeedRestorationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedRestorationControllerConfiguration_To_v1alpha1_SeedRestorationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -881,6 +1146,32 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootCertificateTransparencyControllerConfiguration_To_config_ShootCertificateTransparencyControllerConfiguration(in *ShootCertificateTransparencyControllerConfiguration, out *config.ShootCertificateTransparencyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	out.ExpectedIssuers = *(*[]string)(unsafe.Pointer(&in.ExpectedIssuers))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCertificateTransparencyControllerConfiguration_To_config_ShootCertificateTransparencyControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCertificateTransparencyControllerConfiguration_To_config_ShootCertificateTransparencyControllerConfiguration(in *ShootCertificateTransparencyControllerConfiguration, out *config.ShootCertificateTransparencyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCertificateTransparencyControllerConfiguration_To_config_ShootCertificateTransparencyControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootCertificateTransparencyControllerConfiguration_To_v1alpha1_ShootCertificateTransparencyControllerConfiguration(in *config.ShootCertificateTransparencyControllerConfiguration, out *ShootCertificateTransparencyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	out.ExpectedIssuers = *(*[]string)(unsafe.Pointer(&in.ExpectedIssuers))
+	return nil
+}
+
+// Convert_config_ShootCertificateTransparencyControllerConfiguration_To_v1alpha1_ShootCertificateTransparencyControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootCertificateTransparencyControllerConfiguration_To_v1alpha1_ShootCertificateTransparencyControllerConfiguration(in *config.ShootCertificateTransparencyControllerConfiguration, out *ShootCertificateTransparencyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootCertificateTransparencyControllerConfiguration_To_v1alpha1_ShootCertificateTransparencyControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootConditionsControllerConfiguration_To_config_ShootConditionsControllerConfiguration(in *ShootConditionsControllerConfiguration, out *config.ShootConditionsControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -927,6 +1218,7 @@ func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.PlannedChangesSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.PlannedChangesSyncPeriod))
 	return nil
 }
 
@@ -939,6 +1231,7 @@ func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_Shoo
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.EnableShootControlPlaneRestarter = (*bool)(unsafe.Pointer(in.EnableShootControlPlaneRestarter))
 	out.EnableShootCoreAddonRestarter = (*bool)(unsafe.Pointer(in.EnableShootCoreAddonRestarter))
+	out.PlannedChangesSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.PlannedChangesSyncPeriod))
 	return nil
 }
 
@@ -969,6 +1262,28 @@ func Convert_config_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaCont
 	return autoConvert_config_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootReconciliationPauseControllerConfiguration_To_config_ShootReconciliationPauseControllerConfiguration(in *ShootReconciliationPauseControllerConfiguration, out *config.ShootReconciliationPauseControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.WarningThreshold = (*v1.Duration)(unsafe.Pointer(in.WarningThreshold))
+	return nil
+}
+
+// Convert_v1alpha1_ShootReconciliationPauseControllerConfiguration_To_config_ShootReconciliationPauseControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootReconciliationPauseControllerConfiguration_To_config_ShootReconciliationPauseControllerConfiguration(in *ShootReconciliationPauseControllerConfiguration, out *config.ShootReconciliationPauseControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootReconciliationPauseControllerConfiguration_To_config_ShootReconciliationPauseControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootReconciliationPauseControllerConfiguration_To_v1alpha1_ShootReconciliationPauseControllerConfiguration(in *config.ShootReconciliationPauseControllerConfiguration, out *ShootReconciliationPauseControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.WarningThreshold = (*v1.Duration)(unsafe.Pointer(in.WarningThreshold))
+	return nil
+}
+
+// Convert_config_ShootReconciliationPauseControllerConfiguration_To_v1alpha1_ShootReconciliationPauseControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootReconciliationPauseControllerConfiguration_To_v1alpha1_ShootReconciliationPauseControllerConfiguration(in *config.ShootReconciliationPauseControllerConfiguration, out *ShootReconciliationPauseControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootReconciliationPauseControllerConfiguration_To_v1alpha1_ShootReconciliationPauseControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootReferenceControllerConfiguration_To_config_ShootReferenceControllerConfiguration(in *ShootReferenceControllerConfiguration, out *config.ShootReferenceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -1032,3 +1347,23 @@ func autoConvert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_Shoo
 func Convert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_ShootStatusLabelControllerConfiguration(in *config.ShootStatusLabelControllerConfiguration, out *ShootStatusLabelControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ShootStatusLabelControllerConfiguration_To_v1alpha1_ShootStatusLabelControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootVersionPolicyControllerConfiguration_To_config_ShootVersionPolicyControllerConfiguration(in *ShootVersionPolicyControllerConfiguration, out *config.ShootVersionPolicyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ShootVersionPolicyControllerConfiguration_To_config_ShootVersionPolicyControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootVersionPolicyControllerConfiguration_To_config_ShootVersionPolicyControllerConfiguration(in *ShootVersionPolicyControllerConfiguration, out *config.ShootVersionPolicyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootVersionPolicyControllerConfiguration_To_config_ShootVersionPolicyControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootVersionPolicyControllerConfiguration_To_v1alpha1_ShootVersionPolicyControllerConfiguration(in *config.ShootVersionPolicyControllerConfiguration, out *ShootVersionPolicyControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ShootVersionPolicyControllerConfiguration_To_v1alpha1_ShootVersionPolicyControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootVersionPolicyControllerConfiguration_To_v1alpha1_ShootVersionPolicyControllerConfiguration(in *config.ShootVersionPolicyControllerConfiguration, out *ShootVersionPolicyControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootVersionPolicyControllerConfiguration_To_v1alpha1_ShootVersionPolicyControllerConfiguration(in, out, s)
+}
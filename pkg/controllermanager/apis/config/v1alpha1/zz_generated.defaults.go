@@ -27,9 +27,15 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	SetDefaults_ControllerManagerConfiguration(in)
 	SetDefaults_ClientConnectionConfiguration(&in.GardenClientConnection)
 	SetDefaults_ControllerManagerControllerConfiguration(&in.Controllers)
+	if in.Controllers.AccessRequest != nil {
+		SetDefaults_AccessRequestControllerConfiguration(in.Controllers.AccessRequest)
+	}
 	if in.Controllers.Bastion != nil {
 		SetDefaults_BastionControllerConfiguration(in.Controllers.Bastion)
 	}
+	if in.Controllers.BulkOperation != nil {
+		SetDefaults_BulkOperationControllerConfiguration(in.Controllers.BulkOperation)
+	}
 	if in.Controllers.CertificateSigningRequest != nil {
 		SetDefaults_CertificateSigningRequestControllerConfiguration(in.Controllers.CertificateSigningRequest)
 	}
@@ -54,6 +60,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
 	}
+	if in.Controllers.SSHAccessRequest != nil {
+		SetDefaults_SSHAccessRequestControllerConfiguration(in.Controllers.SSHAccessRequest)
+	}
 	if in.Controllers.SecretBinding != nil {
 		SetDefaults_SecretBindingControllerConfiguration(in.Controllers.SecretBinding)
 	}
@@ -66,6 +75,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedBackupBucketsCheck != nil {
 		SetDefaults_SeedBackupBucketsCheckControllerConfiguration(in.Controllers.SeedBackupBucketsCheck)
 	}
+	if in.Controllers.SeedRestoration != nil {
+		SetDefaults_SeedRestorationControllerConfiguration(in.Controllers.SeedRestoration)
+	}
+	if in.Controllers.SeedDecommission != nil {
+		SetDefaults_SeedDecommissionControllerConfiguration(in.Controllers.SeedDecommission)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
@@ -83,6 +98,15 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootVersionPolicy != nil {
+		SetDefaults_ShootVersionPolicyControllerConfiguration(in.Controllers.ShootVersionPolicy)
+	}
+	if in.Controllers.ShootReconciliationPause != nil {
+		SetDefaults_ShootReconciliationPauseControllerConfiguration(in.Controllers.ShootReconciliationPause)
+	}
+	if in.Controllers.ShootCertificateTransparency != nil {
+		SetDefaults_ShootCertificateTransparencyControllerConfiguration(in.Controllers.ShootCertificateTransparency)
+	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
 	}
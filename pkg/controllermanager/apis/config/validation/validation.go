@@ -5,6 +5,10 @@
 package validation
 
 import (
+	"net/url"
+	"regexp"
+	"time"
+
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -23,6 +27,12 @@ func ValidateControllerManagerConfiguration(conf *config.ControllerManagerConfig
 		}
 	}
 
+	for controller, level := range conf.LogLevelOverrides {
+		if !sets.New(logger.AllLogLevels...).Has(level) {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("logLevelOverrides").Key(controller), level, logger.AllLogLevels))
+		}
+	}
+
 	if conf.LogFormat != "" {
 		if !sets.New(logger.AllLogFormats...).Has(conf.LogFormat) {
 			allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
@@ -41,6 +51,10 @@ func validateControllerManagerControllerConfiguration(conf config.ControllerMana
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	if conf.ShootCertificateTransparency != nil {
+		allErrs = append(allErrs, validateShootCertificateTransparencyControllerConfiguration(conf.ShootCertificateTransparency, fldPath.Child("shootCertificateTransparency"))...)
+	}
+
 	return allErrs
 }
 
@@ -49,6 +63,15 @@ func validateProjectControllerConfiguration(conf *config.ProjectControllerConfig
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+	for i, limitRangeConfig := range conf.LimitRanges {
+		allErrs = append(allErrs, validateProjectLimitRangeConfiguration(limitRangeConfig, fldPath.Child("limitRanges").Index(i))...)
+	}
+	if conf.UsageReportSyncPeriod != nil && conf.UsageReportSyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("usageReportSyncPeriod"), conf.UsageReportSyncPeriod.Duration.String(), "must be positive"))
+	}
+	if conf.DeletionGracePeriodDays != nil && *conf.DeletionGracePeriodDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deletionGracePeriodDays"), *conf.DeletionGracePeriodDays, "must be positive"))
+	}
 	return allErrs
 }
 
@@ -63,3 +86,39 @@ func validateProjectQuotaConfiguration(conf config.QuotaConfiguration, fldPath *
 
 	return allErrs
 }
+
+func validateProjectLimitRangeConfiguration(conf config.LimitRangeConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(conf.ProjectSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("projectSelector"))...)
+
+	if conf.Config == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("config"), "must provide a limit range config"))
+	}
+
+	return allErrs
+}
+
+func validateShootCertificateTransparencyControllerConfiguration(conf *config.ShootCertificateTransparencyControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration < time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration.String(), "must be at least 1h"))
+	}
+
+	if conf.Endpoint != nil {
+		if u, err := url.Parse(*conf.Endpoint); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), *conf.Endpoint, err.Error()))
+		} else if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("endpoint"), *conf.Endpoint, "must be an absolute http or https URL"))
+		}
+	}
+
+	for i, issuer := range conf.ExpectedIssuers {
+		if _, err := regexp.Compile(issuer); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("expectedIssuers").Index(i), issuer, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -5,12 +5,15 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -25,6 +28,23 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		}
 	})
 
+	Context("log level overrides", func() {
+		It("should allow valid log levels", func() {
+			conf.LogLevelOverrides = map[string]string{"shoot-maintenance": "debug"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported log levels", func() {
+			conf.LogLevelOverrides = map[string]string{"shoot-maintenance": "verbose"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("logLevelOverrides[shoot-maintenance]"),
+			}))))
+		})
+	})
+
 	Context("ProjectControllerConfiguration", func() {
 		Context("ProjectQuotaConfiguration", func() {
 			BeforeEach(func() {
@@ -104,5 +124,130 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("ProjectLimitRangeConfiguration", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{}
+			})
+
+			It("should pass because limit range configuration is valid", func() {
+				conf.Controllers.Project.LimitRanges = []config.LimitRangeConfiguration{
+					{
+						ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "user"}},
+						Config:          &corev1.LimitRange{},
+					},
+				}
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because limit range config is not specified or label selector is invalid", func() {
+				conf.Controllers.Project.LimitRanges = []config.LimitRangeConfiguration{
+					{
+						ProjectSelector: &metav1.LabelSelector{},
+					},
+					{
+						ProjectSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "role"}},
+						},
+						Config: &corev1.LimitRange{},
+					},
+				}
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.project.limitRanges[0].config"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.limitRanges[1].projectSelector.matchExpressions[0].operator"),
+					})),
+				))
+			})
+		})
+
+		Context("UsageReportSyncPeriod", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{}
+			})
+
+			It("should pass because the usage report sync period is not set", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should pass because the usage report sync period is positive", func() {
+				conf.Controllers.Project.UsageReportSyncPeriod = &metav1.Duration{Duration: time.Hour}
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because the usage report sync period is not positive", func() {
+				conf.Controllers.Project.UsageReportSyncPeriod = &metav1.Duration{}
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.usageReportSyncPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("DeletionGracePeriodDays", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &config.ProjectControllerConfiguration{}
+			})
+
+			It("should pass because the deletion grace period is not set", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should pass because the deletion grace period is positive", func() {
+				conf.Controllers.Project.DeletionGracePeriodDays = ptr.To(30)
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because the deletion grace period is not positive", func() {
+				conf.Controllers.Project.DeletionGracePeriodDays = ptr.To(0)
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.deletionGracePeriodDays"),
+					})),
+				))
+			})
+		})
+	})
+
+	Context("ShootCertificateTransparencyControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootCertificateTransparency = &config.ShootCertificateTransparencyControllerConfiguration{}
+		})
+
+		It("should pass with a valid configuration", func() {
+			conf.Controllers.ShootCertificateTransparency.SyncPeriod = &metav1.Duration{Duration: 6 * time.Hour}
+			conf.Controllers.ShootCertificateTransparency.Endpoint = ptr.To("https://crt.sh")
+			conf.Controllers.ShootCertificateTransparency.ExpectedIssuers = []string{"O=Let's Encrypt"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with an invalid configuration", func() {
+			conf.Controllers.ShootCertificateTransparency.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+			conf.Controllers.ShootCertificateTransparency.Endpoint = ptr.To("crt.sh")
+			conf.Controllers.ShootCertificateTransparency.ExpectedIssuers = []string{"O=Let's Encrypt", "CN=(foo"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootCertificateTransparency.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootCertificateTransparency.endpoint"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootCertificateTransparency.expectedIssuers[1]"),
+				})),
+			))
+		})
 	})
 })
@@ -15,6 +15,27 @@ import (
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestControllerConfiguration) DeepCopyInto(out *AccessRequestControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestControllerConfiguration.
+func (in *AccessRequestControllerConfiguration) DeepCopy() *AccessRequestControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionControllerConfiguration) DeepCopyInto(out *BastionControllerConfiguration) {
 	*out = *in
@@ -41,6 +62,32 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkOperationControllerConfiguration) DeepCopyInto(out *BulkOperationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkOperationControllerConfiguration.
+func (in *BulkOperationControllerConfiguration) DeepCopy() *BulkOperationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkOperationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSigningRequestControllerConfiguration) DeepCopyInto(out *CertificateSigningRequestControllerConfiguration) {
 	*out = *in
@@ -132,6 +179,13 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 		*out = new(componentbaseconfig.LeaderElectionConfiguration)
 		**out = **in
 	}
+	if in.LogLevelOverrides != nil {
+		in, out := &in.LogLevelOverrides, &out.LogLevelOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.Server.DeepCopyInto(&out.Server)
 	if in.Debugging != nil {
 		in, out := &in.Debugging, &out.Debugging
@@ -169,11 +223,21 @@ func (in *ControllerManagerConfiguration) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *ControllerManagerControllerConfiguration) {
 	*out = *in
+	if in.AccessRequest != nil {
+		in, out := &in.AccessRequest, &out.AccessRequest
+		*out = new(AccessRequestControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Bastion != nil {
 		in, out := &in.Bastion, &out.Bastion
 		*out = new(BastionControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BulkOperation != nil {
+		in, out := &in.BulkOperation, &out.BulkOperation
+		*out = new(BulkOperationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CertificateSigningRequest != nil {
 		in, out := &in.CertificateSigningRequest, &out.CertificateSigningRequest
 		*out = new(CertificateSigningRequestControllerConfiguration)
@@ -214,6 +278,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(QuotaControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SSHAccessRequest != nil {
+		in, out := &in.SSHAccessRequest, &out.SSHAccessRequest
+		*out = new(SSHAccessRequestControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecretBinding != nil {
 		in, out := &in.SecretBinding, &out.SecretBinding
 		*out = new(SecretBindingControllerConfiguration)
@@ -234,6 +303,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedBackupBucketsCheckControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedRestoration != nil {
+		in, out := &in.SeedRestoration, &out.SeedRestoration
+		*out = new(SeedRestorationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedDecommission != nil {
+		in, out := &in.SeedDecommission, &out.SeedDecommission
+		*out = new(SeedDecommissionControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -261,6 +340,21 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootVersionPolicy != nil {
+		in, out := &in.ShootVersionPolicy, &out.ShootVersionPolicy
+		*out = new(ShootVersionPolicyControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootReconciliationPause != nil {
+		in, out := &in.ShootReconciliationPause, &out.ShootReconciliationPause
+		*out = new(ShootReconciliationPauseControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootCertificateTransparency != nil {
+		in, out := &in.ShootCertificateTransparency, &out.ShootCertificateTransparency
+		*out = new(ShootCertificateTransparencyControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -347,6 +441,30 @@ func (in *ExposureClassControllerConfiguration) DeepCopy() *ExposureClassControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitRangeConfiguration) DeepCopyInto(out *LimitRangeConfiguration) {
+	*out = *in
+	if in.Config != nil {
+		out.Config = in.Config.DeepCopyObject()
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitRangeConfiguration.
+func (in *LimitRangeConfiguration) DeepCopy() *LimitRangeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitRangeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedSetControllerConfiguration) DeepCopyInto(out *ManagedSeedSetControllerConfiguration) {
 	*out = *in
@@ -394,6 +512,13 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]LimitRangeConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.StaleGracePeriodDays != nil {
 		in, out := &in.StaleGracePeriodDays, &out.StaleGracePeriodDays
 		*out = new(int)
@@ -409,6 +534,16 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.UsageReportSyncPeriod != nil {
+		in, out := &in.UsageReportSyncPeriod, &out.UsageReportSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeletionGracePeriodDays != nil {
+		in, out := &in.DeletionGracePeriodDays, &out.DeletionGracePeriodDays
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -467,6 +602,27 @@ func (in *QuotaControllerConfiguration) DeepCopy() *QuotaControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHAccessRequestControllerConfiguration) DeepCopyInto(out *SSHAccessRequestControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHAccessRequestControllerConfiguration.
+func (in *SSHAccessRequestControllerConfiguration) DeepCopy() *SSHAccessRequestControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHAccessRequestControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBindingControllerConfiguration) DeepCopyInto(out *SecretBindingControllerConfiguration) {
 	*out = *in
@@ -555,6 +711,37 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDecommissionControllerConfiguration) DeepCopyInto(out *SeedDecommissionControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ParallelShootMigrations != nil {
+		in, out := &in.ParallelShootMigrations, &out.ParallelShootMigrations
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDecommissionControllerConfiguration.
+func (in *SeedDecommissionControllerConfiguration) DeepCopy() *SeedDecommissionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDecommissionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedExtensionsCheckControllerConfiguration) DeepCopyInto(out *SeedExtensionsCheckControllerConfiguration) {
 	*out = *in
@@ -586,6 +773,37 @@ func (in *SeedExtensionsCheckControllerConfiguration) DeepCopy() *SeedExtensions
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRestorationControllerConfiguration) DeepCopyInto(out *SeedRestorationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ParallelShootRestorations != nil {
+		in, out := &in.ParallelShootRestorations, &out.ParallelShootRestorations
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRestorationControllerConfiguration.
+func (in *SeedRestorationControllerConfiguration) DeepCopy() *SeedRestorationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRestorationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -628,6 +846,42 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCertificateTransparencyControllerConfiguration) DeepCopyInto(out *ShootCertificateTransparencyControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpectedIssuers != nil {
+		in, out := &in.ExpectedIssuers, &out.ExpectedIssuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCertificateTransparencyControllerConfiguration.
+func (in *ShootCertificateTransparencyControllerConfiguration) DeepCopy() *ShootCertificateTransparencyControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCertificateTransparencyControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootConditionsControllerConfiguration) DeepCopyInto(out *ShootConditionsControllerConfiguration) {
 	*out = *in
@@ -693,6 +947,11 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PlannedChangesSyncPeriod != nil {
+		in, out := &in.PlannedChangesSyncPeriod, &out.PlannedChangesSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -732,6 +991,32 @@ func (in *ShootQuotaControllerConfiguration) DeepCopy() *ShootQuotaControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootReconciliationPauseControllerConfiguration) DeepCopyInto(out *ShootReconciliationPauseControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.WarningThreshold != nil {
+		in, out := &in.WarningThreshold, &out.WarningThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootReconciliationPauseControllerConfiguration.
+func (in *ShootReconciliationPauseControllerConfiguration) DeepCopy() *ShootReconciliationPauseControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootReconciliationPauseControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootReferenceControllerConfiguration) DeepCopyInto(out *ShootReferenceControllerConfiguration) {
 	*out = *in
@@ -804,3 +1089,24 @@ func (in *ShootStatusLabelControllerConfiguration) DeepCopy() *ShootStatusLabelC
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootVersionPolicyControllerConfiguration) DeepCopyInto(out *ShootVersionPolicyControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootVersionPolicyControllerConfiguration.
+func (in *ShootVersionPolicyControllerConfiguration) DeepCopy() *ShootVersionPolicyControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootVersionPolicyControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
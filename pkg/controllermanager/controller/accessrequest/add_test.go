@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/accessrequest"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#AccessRequestPredicate", func() {
+		var (
+			p             predicate.Predicate
+			accessRequest *operationsv1alpha1.AccessRequest
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).AccessRequestPredicate()
+			accessRequest = &operationsv1alpha1.AccessRequest{}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: accessRequest})).To(BeTrue())
+		})
+
+		It("should return false for update events without a new decision", func() {
+			oldAccessRequest := accessRequest.DeepCopy()
+			accessRequest.Status.Phase = operationsv1alpha1.AccessRequestPending
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldAccessRequest, ObjectNew: accessRequest})).To(BeFalse())
+		})
+
+		It("should return true for update events with a new decision", func() {
+			oldAccessRequest := accessRequest.DeepCopy()
+			accessRequest.Status.Approval = &operationsv1alpha1.AccessRequestApproval{Decision: operationsv1alpha1.AccessRequestApproved}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldAccessRequest, ObjectNew: accessRequest})).To(BeTrue())
+		})
+
+		It("should return false for update events with unexpected objects", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: accessRequest, ObjectNew: &operationsv1alpha1.Bastion{}})).To(BeFalse())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: accessRequest})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: accessRequest})).To(BeFalse())
+		})
+	})
+})
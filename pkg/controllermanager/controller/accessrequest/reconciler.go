@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// DataKeyKubeconfig is the key in the data of the access secret which contains the kubeconfig.
+	DataKeyKubeconfig = "kubeconfig"
+
+	eventReasonAccessGranted  = "AccessGranted"
+	eventReasonAccessRejected = "AccessRejected"
+	eventReasonAccessRevoked  = "AccessRevoked"
+)
+
+// Reconciler reconciles AccessRequests. It grants time-bound admin access to shoots for approved AccessRequests and
+// revokes it once the access expires.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.AccessRequestControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reacts to decisions about AccessRequests and revokes expired accesses.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	accessRequest := &operationsv1alpha1.AccessRequest{}
+	if err := r.Client.Get(ctx, request.NamespacedName, accessRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// the owned access resources are garbage-collected once the object is gone
+	if accessRequest.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	switch accessRequest.Status.Phase {
+	case operationsv1alpha1.AccessRequestExpired, operationsv1alpha1.AccessRequestRejected:
+		return reconcile.Result{}, nil
+
+	case operationsv1alpha1.AccessRequestGranted:
+		if expiresIn := accessRequest.Status.ExpirationTimestamp.Sub(r.Clock.Now()); expiresIn > 0 {
+			log.V(1).Info("Requeuing AccessRequest until access expires", "requeueAfter", expiresIn)
+			return reconcile.Result{RequeueAfter: expiresIn}, nil
+		}
+
+		log.Info("Revoking expired access", "expirationTimestamp", accessRequest.Status.ExpirationTimestamp.Time)
+		return reconcile.Result{}, r.revoke(ctx, accessRequest)
+	}
+
+	approval := accessRequest.Status.Approval
+	if approval == nil {
+		return reconcile.Result{}, r.patchStatus(ctx, accessRequest, func(status *operationsv1alpha1.AccessRequestStatus) {
+			status.Phase = operationsv1alpha1.AccessRequestPending
+		})
+	}
+
+	if approval.Decision != operationsv1alpha1.AccessRequestApproved {
+		log.Info("Access request was denied", "decidedBy", approval.DecidedBy)
+		r.Recorder.Eventf(accessRequest, corev1.EventTypeNormal, eventReasonAccessRejected, "Access to shoot %q for %q was denied by %q", accessRequest.Spec.ShootRef.Name, accessRequest.Spec.Requester, approval.DecidedBy)
+		return reconcile.Result{}, r.patchStatus(ctx, accessRequest, func(status *operationsv1alpha1.AccessRequestStatus) {
+			status.Phase = operationsv1alpha1.AccessRequestRejected
+		})
+	}
+
+	log.Info("Granting access", "decidedBy", approval.DecidedBy, "duration", accessRequest.Spec.Duration.Duration)
+	if err := r.grant(ctx, accessRequest); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: accessRequest.Spec.Duration.Duration}, nil
+}
+
+func (r *Reconciler) grant(ctx context.Context, accessRequest *operationsv1alpha1.AccessRequest) error {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: accessRequest.Namespace, Name: accessRequest.Spec.ShootRef.Name}, shoot); err != nil {
+		return fmt.Errorf("could not get shoot %q: %w", accessRequest.Spec.ShootRef.Name, err)
+	}
+
+	var (
+		now        = r.Clock.Now()
+		expiration = now.Add(accessRequest.Spec.Duration.Duration)
+	)
+
+	adminKubeconfigRequest := &authenticationv1alpha1.AdminKubeconfigRequest{
+		Spec: authenticationv1alpha1.AdminKubeconfigRequestSpec{
+			ExpirationSeconds: ptr.To(int64(accessRequest.Spec.Duration.Duration / time.Second)),
+		},
+	}
+	if err := r.Client.SubResource("adminkubeconfig").Create(ctx, shoot, adminKubeconfigRequest); err != nil {
+		return fmt.Errorf("failed requesting admin kubeconfig for shoot %q: %w", shoot.Name, err)
+	}
+
+	secret, role, roleBinding := accessResources(accessRequest)
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{DataKeyKubeconfig: adminKubeconfigRequest.Status.Kubeconfig}
+		return controllerutil.SetControllerReference(accessRequest, secret, r.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed reconciling secret %q: %w", secret.Name, err)
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{{
+			APIGroups:     []string{corev1.GroupName},
+			Resources:     []string{"secrets"},
+			ResourceNames: []string{secret.Name},
+			Verbs:         []string{"get"},
+		}}
+		return controllerutil.SetControllerReference(accessRequest, role, r.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed reconciling role %q: %w", role.Name, err)
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{{
+			APIGroup: rbacv1.GroupName,
+			Kind:     rbacv1.UserKind,
+			Name:     accessRequest.Spec.Requester,
+		}}
+		return controllerutil.SetControllerReference(accessRequest, roleBinding, r.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed reconciling role binding %q: %w", roleBinding.Name, err)
+	}
+
+	r.Recorder.Eventf(accessRequest, corev1.EventTypeNormal, eventReasonAccessGranted, "Admin access to shoot %q was granted to %q until %s, approved by %q", shoot.Name, accessRequest.Spec.Requester, expiration.UTC().Format(time.RFC3339), accessRequest.Status.Approval.DecidedBy)
+
+	return r.patchStatus(ctx, accessRequest, func(status *operationsv1alpha1.AccessRequestStatus) {
+		status.Phase = operationsv1alpha1.AccessRequestGranted
+		status.KubeconfigSecretName = &secret.Name
+		status.GrantedTimestamp = &metav1.Time{Time: now}
+		status.ExpirationTimestamp = &metav1.Time{Time: expiration}
+	})
+}
+
+func (r *Reconciler) revoke(ctx context.Context, accessRequest *operationsv1alpha1.AccessRequest) error {
+	secret, role, roleBinding := accessResources(accessRequest)
+	if err := kubernetesutils.DeleteObjects(ctx, r.Client, roleBinding, role, secret); err != nil {
+		return fmt.Errorf("failed deleting access resources: %w", err)
+	}
+
+	r.Recorder.Eventf(accessRequest, corev1.EventTypeNormal, eventReasonAccessRevoked, "Admin access to shoot %q for %q expired and was revoked", accessRequest.Spec.ShootRef.Name, accessRequest.Spec.Requester)
+
+	return r.patchStatus(ctx, accessRequest, func(status *operationsv1alpha1.AccessRequestStatus) {
+		status.Phase = operationsv1alpha1.AccessRequestExpired
+		status.KubeconfigSecretName = nil
+	})
+}
+
+func (r *Reconciler) patchStatus(ctx context.Context, accessRequest *operationsv1alpha1.AccessRequest, mutate func(*operationsv1alpha1.AccessRequestStatus)) error {
+	patch := client.MergeFrom(accessRequest.DeepCopy())
+	mutate(&accessRequest.Status)
+	return r.Client.Status().Patch(ctx, accessRequest, patch)
+}
+
+// AccessResourcesName returns the name of the secret, role and role binding which are created in the project
+// namespace for a granted AccessRequest.
+func AccessResourcesName(accessRequestName string) string {
+	return "access-request-" + accessRequestName
+}
+
+func accessResources(accessRequest *operationsv1alpha1.AccessRequest) (*corev1.Secret, *rbacv1.Role, *rbacv1.RoleBinding) {
+	objectMeta := metav1.ObjectMeta{Name: AccessResourcesName(accessRequest.Name), Namespace: accessRequest.Namespace}
+	return &corev1.Secret{ObjectMeta: objectMeta}, &rbacv1.Role{ObjectMeta: objectMeta}, &rbacv1.RoleBinding{ObjectMeta: objectMeta}
+}
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package accessrequest_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/accessrequest"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		expirationSeconds *int64
+
+		namespace     = "garden-dev"
+		shoot         *gardencorev1beta1.Shoot
+		accessRequest *operationsv1alpha1.AccessRequest
+		request       reconcile.Request
+	)
+
+	BeforeEach(func() {
+		expirationSeconds = nil
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&operationsv1alpha1.AccessRequest{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceCreate: func(_ context.Context, _ client.Client, subResourceName string, _ client.Object, subResource client.Object, _ ...client.SubResourceCreateOption) error {
+					Expect(subResourceName).To(Equal("adminkubeconfig"))
+					adminKubeconfigRequest := subResource.(*authenticationv1alpha1.AdminKubeconfigRequest)
+					expirationSeconds = adminKubeconfigRequest.Spec.ExpirationSeconds
+					adminKubeconfigRequest.Status.Kubeconfig = []byte("kubeconfig-data")
+					return nil
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: record.NewFakeRecorder(10),
+		}
+
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: namespace}}
+		accessRequest = &operationsv1alpha1.AccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "incident-42", Namespace: namespace},
+			Spec: operationsv1alpha1.AccessRequestSpec{
+				ShootRef:  corev1.LocalObjectReference{Name: shoot.Name},
+				Duration:  metav1.Duration{Duration: time.Hour},
+				Reason:    "incident 42",
+				Requester: "alice",
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(accessRequest)}
+
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	It("should do nothing if the object is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should set the phase to pending if no decision was taken yet", func() {
+		Expect(fakeClient.Create(ctx, accessRequest)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+		Expect(accessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestPending))
+	})
+
+	It("should reject the access request if it was denied", func() {
+		accessRequest.Status.Approval = &operationsv1alpha1.AccessRequestApproval{Decision: operationsv1alpha1.AccessRequestDenied, DecidedBy: "bob"}
+		Expect(fakeClient.Create(ctx, accessRequest)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+		Expect(accessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestRejected))
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: AccessResourcesName(accessRequest.Name)}, &corev1.Secret{})).To(BeNotFoundError())
+	})
+
+	Context("approved access request", func() {
+		var accessName string
+
+		BeforeEach(func() {
+			accessName = AccessResourcesName(accessRequest.Name)
+			accessRequest.Status.Approval = &operationsv1alpha1.AccessRequestApproval{Decision: operationsv1alpha1.AccessRequestApproved, DecidedBy: "bob"}
+			Expect(fakeClient.Create(ctx, accessRequest)).To(Succeed())
+		})
+
+		It("should fail if the shoot does not exist", func() {
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(BeNotFoundError())
+		})
+
+		It("should grant access and revoke it after expiration", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(expirationSeconds).To(PointTo(Equal(int64(3600))))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+			Expect(accessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestGranted))
+			Expect(accessRequest.Status.KubeconfigSecretName).To(PointTo(Equal(accessName)))
+			Expect(accessRequest.Status.GrantedTimestamp.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Expect(accessRequest.Status.ExpirationTimestamp.Time.Equal(fakeClock.Now().Add(time.Hour))).To(BeTrue())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: accessName}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("kubeconfig", []byte("kubeconfig-data")))
+			Expect(secret.OwnerReferences).To(ConsistOf(HaveField("Name", accessRequest.Name)))
+
+			role := &rbacv1.Role{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: accessName}, role)).To(Succeed())
+			Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+				APIGroups:     []string{""},
+				Resources:     []string{"secrets"},
+				ResourceNames: []string{accessName},
+				Verbs:         []string{"get"},
+			}))
+
+			roleBinding := &rbacv1.RoleBinding{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: accessName}, roleBinding)).To(Succeed())
+			Expect(roleBinding.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: accessName}))
+			Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "alice"}))
+
+			By("Requeue until expiration")
+			fakeClock.Step(20 * time.Minute)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 40 * time.Minute}))
+
+			By("Revoke access after expiration")
+			fakeClock.Step(40 * time.Minute)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, accessRequest)).To(Succeed())
+			Expect(accessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestExpired))
+			Expect(accessRequest.Status.KubeconfigSecretName).To(BeNil())
+			Expect(accessRequest.Status.Approval).NotTo(BeNil())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: accessName}, &corev1.Secret{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: accessName}, &rbacv1.Role{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: accessName}, &rbacv1.RoleBinding{})).To(BeNotFoundError())
+
+			By("Ignore expired access requests")
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(expirationSeconds).To(PointTo(Equal(int64(3600))))
+		})
+	})
+})
@@ -12,7 +12,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/accessrequest"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/bastion"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/bulkoperation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/certificatesigningrequest"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/controllerdeployment"
@@ -25,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/sshaccessrequest"
 )
 
 // AddToManager adds all controller-manager controllers to the given manager.
@@ -34,12 +37,24 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed creating Kubernetes client: %w", err)
 	}
 
+	if err := (&accessrequest.Reconciler{
+		Config: *cfg.Controllers.AccessRequest,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding AccessRequest controller: %w", err)
+	}
+
 	if err := (&bastion.Reconciler{
 		Config: *cfg.Controllers.Bastion,
 	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
+	if err := (&bulkoperation.Reconciler{
+		Config: *cfg.Controllers.BulkOperation,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding BulkOperation controller: %w", err)
+	}
+
 	if err := (&certificatesigningrequest.Reconciler{
 		CertificatesClient: kubernetesClient.CertificatesV1().CertificateSigningRequests(),
 		Config:             *cfg.Controllers.CertificateSigningRequest,
@@ -99,6 +114,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding SecretBinding controller: %w", err)
 	}
 
+	if err := (&sshaccessrequest.Reconciler{
+		Config: *cfg.Controllers.SSHAccessRequest,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding SSHAccessRequest controller: %w", err)
+	}
+
 	if err := seed.AddToManager(ctx, mgr, *cfg); err != nil {
 		return fmt.Errorf("failed adding Seed controller: %w", err)
 	}
@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulkoperation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	eventReasonStarted   = "BulkOperationStarted"
+	eventReasonSucceeded = "BulkOperationSucceeded"
+	eventReasonFailed    = "BulkOperationFailed"
+)
+
+// operationAnnotations maps the operations of BulkOperations to the values of the operation annotation which trigger
+// them on a shoot.
+var operationAnnotations = map[operationsv1alpha1.BulkOperationType]string{
+	operationsv1alpha1.BulkOperationReconcile:           v1beta1constants.GardenerOperationReconcile,
+	operationsv1alpha1.BulkOperationRotateCA:            v1beta1constants.OperationRotateCAStart,
+	operationsv1alpha1.BulkOperationRotateObservability: v1beta1constants.OperationRotateObservabilityCredentials,
+}
+
+// Reconciler reconciles BulkOperations. It determines the shoots matching the selector once when a BulkOperation is
+// started, triggers the operation on them by annotating them while respecting the rate limit, and tracks the progress
+// of the operation per shoot.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.BulkOperationControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile performs the operation of a BulkOperation on the selected shoots.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	bulkOperation := &operationsv1alpha1.BulkOperation{}
+	if err := r.Client.Get(ctx, request.NamespacedName, bulkOperation); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if bulkOperation.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	switch bulkOperation.Status.Phase {
+	case operationsv1alpha1.BulkOperationSucceeded, operationsv1alpha1.BulkOperationFailed:
+		return reconcile.Result{}, nil
+	}
+
+	now := r.Clock.Now()
+
+	// The shoots on which the operation is started are recorded in the status before the operation is triggered. This
+	// ensures that the operation is triggered at most once per shoot, even if the status cannot be updated afterwards.
+	patch := client.MergeFrom(bulkOperation.DeepCopy())
+	bulkOperation.Status.ObservedGeneration = bulkOperation.Generation
+
+	if bulkOperation.Status.StartTime == nil {
+		if err := r.selectShoots(ctx, bulkOperation, now); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log.Info("Starting bulk operation", "operation", bulkOperation.Spec.Operation, "shoots", len(bulkOperation.Status.Shoots))
+		r.Recorder.Eventf(bulkOperation, corev1.EventTypeNormal, eventReasonStarted, "Performing operation %q on %d shoots", bulkOperation.Spec.Operation, len(bulkOperation.Status.Shoots))
+	}
+
+	if err := r.trackProgress(ctx, bulkOperation, now); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter, err := r.startShoots(ctx, log, bulkOperation, now)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.Client.Status().Patch(ctx, bulkOperation, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+
+	patch = client.MergeFrom(bulkOperation.DeepCopy())
+
+	triggerErr := r.triggerOperation(ctx, bulkOperation, now)
+	done := updatePhase(bulkOperation, now)
+
+	if err := r.Client.Status().Patch(ctx, bulkOperation, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+	if triggerErr != nil {
+		return reconcile.Result{}, triggerErr
+	}
+
+	if done {
+		r.recordCompletion(log, bulkOperation)
+		return reconcile.Result{}, nil
+	}
+
+	syncPeriod := r.Config.SyncPeriod.Duration
+	if requeueAfter > 0 && requeueAfter < syncPeriod {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return reconcile.Result{RequeueAfter: syncPeriod}, nil
+}
+
+// selectShoots records all shoots matching the selector of the BulkOperation in its status.
+func (r *Reconciler) selectShoots(ctx context.Context, bulkOperation *operationsv1alpha1.BulkOperation, now time.Time) error {
+	selector, err := metav1.LabelSelectorAsSelector(&bulkOperation.Spec.ShootSelector)
+	if err != nil {
+		return fmt.Errorf("failed parsing shoot selector: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	shoots := make([]operationsv1alpha1.BulkOperationShootStatus, 0, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		shoots = append(shoots, operationsv1alpha1.BulkOperationShootStatus{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+			State:     operationsv1alpha1.BulkOperationShootPending,
+		})
+	}
+	slices.SortFunc(shoots, func(a, b operationsv1alpha1.BulkOperationShootStatus) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	bulkOperation.Status.Phase = operationsv1alpha1.BulkOperationRunning
+	bulkOperation.Status.StartTime = &metav1.Time{Time: now}
+	bulkOperation.Status.Shoots = shoots
+	return nil
+}
+
+// trackProgress checks whether the operation finished on the shoots on which it was triggered.
+func (r *Reconciler) trackProgress(ctx context.Context, bulkOperation *operationsv1alpha1.BulkOperation, now time.Time) error {
+	for i := range bulkOperation.Status.Shoots {
+		shootStatus := &bulkOperation.Status.Shoots[i]
+		if shootStatus.State != operationsv1alpha1.BulkOperationShootInProgress {
+			continue
+		}
+
+		shoot, err := r.getShoot(ctx, shootStatus)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case shoot == nil:
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSkipped, "Shoot does not exist anymore", now)
+		case shoot.Generation <= ptr.Deref(shootStatus.Generation, 0):
+			// The operation was not yet triggered, see triggerOperation.
+			continue
+		case shoot.DeletionTimestamp != nil:
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSkipped, "Shoot is being deleted", now)
+		case shoot.Status.ObservedGeneration <= ptr.Deref(shootStatus.Generation, 0) || shoot.Status.LastOperation == nil:
+			continue
+		case shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded:
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSucceeded, "", now)
+		case shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed:
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootFailed, shoot.Status.LastOperation.Description, now)
+		}
+	}
+
+	return nil
+}
+
+// startShoots records the shoots on which the operation is started next while respecting the rate limit and the
+// maximum number of failures of the BulkOperation. It returns the duration after which further shoots can be started
+// if the interval of the rate limit prevents starting them now.
+func (r *Reconciler) startShoots(ctx context.Context, log logr.Logger, bulkOperation *operationsv1alpha1.BulkOperation, now time.Time) (time.Duration, error) {
+	var (
+		maxParallel int32 = 1
+		interval    time.Duration
+		inProgress  int32
+		failed      int32
+		lastStart   time.Time
+	)
+
+	if rateLimit := bulkOperation.Spec.RateLimit; rateLimit != nil {
+		maxParallel = ptr.Deref(rateLimit.MaxParallel, maxParallel)
+		if rateLimit.Interval != nil {
+			interval = rateLimit.Interval.Duration
+		}
+	}
+
+	for _, shootStatus := range bulkOperation.Status.Shoots {
+		switch shootStatus.State {
+		case operationsv1alpha1.BulkOperationShootInProgress:
+			inProgress++
+		case operationsv1alpha1.BulkOperationShootFailed:
+			failed++
+		}
+		if shootStatus.StartTime != nil && shootStatus.StartTime.After(lastStart) {
+			lastStart = shootStatus.StartTime.Time
+		}
+	}
+
+	maxFailuresReached := bulkOperation.Spec.MaxFailures != nil && failed > *bulkOperation.Spec.MaxFailures
+
+	for i := range bulkOperation.Status.Shoots {
+		shootStatus := &bulkOperation.Status.Shoots[i]
+		if shootStatus.State != operationsv1alpha1.BulkOperationShootPending {
+			continue
+		}
+
+		if maxFailuresReached {
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSkipped, fmt.Sprintf("Operation failed on more than %d shoots", *bulkOperation.Spec.MaxFailures), now)
+			continue
+		}
+
+		if inProgress >= maxParallel {
+			return 0, nil
+		}
+		if elapsed := now.Sub(lastStart); interval > 0 && elapsed < interval {
+			return interval - elapsed, nil
+		}
+
+		shoot, err := r.getShoot(ctx, shootStatus)
+		if err != nil {
+			return 0, err
+		}
+		if shoot == nil {
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSkipped, "Shoot does not exist anymore", now)
+			continue
+		}
+		if reason := skipReason(shoot, now); reason != "" {
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSkipped, reason, now)
+			continue
+		}
+
+		log.Info("Starting operation on shoot", "shoot", client.ObjectKeyFromObject(shoot))
+		shootStatus.State = operationsv1alpha1.BulkOperationShootInProgress
+		shootStatus.Generation = ptr.To(shoot.Generation)
+		shootStatus.StartTime = &metav1.Time{Time: now}
+		inProgress++
+		lastStart = now
+	}
+
+	return 0, nil
+}
+
+// triggerOperation annotates the shoots on which the operation was started but not yet triggered with the operation.
+// The API server increases the generation of a shoot when it accepts the operation.
+func (r *Reconciler) triggerOperation(ctx context.Context, bulkOperation *operationsv1alpha1.BulkOperation, now time.Time) error {
+	for i := range bulkOperation.Status.Shoots {
+		shootStatus := &bulkOperation.Status.Shoots[i]
+		if shootStatus.State != operationsv1alpha1.BulkOperationShootInProgress {
+			continue
+		}
+
+		shoot, err := r.getShoot(ctx, shootStatus)
+		if err != nil {
+			return err
+		}
+		if shoot == nil {
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootSkipped, "Shoot does not exist anymore", now)
+			continue
+		}
+
+		generation := ptr.Deref(shootStatus.Generation, 0)
+		if shoot.Generation > generation {
+			continue
+		}
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operationAnnotations[bulkOperation.Spec.Operation])
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) {
+				finish(shootStatus, operationsv1alpha1.BulkOperationShootFailed, fmt.Sprintf("Operation was rejected: %s", err.Error()), now)
+				continue
+			}
+			return fmt.Errorf("failed triggering operation on shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+
+		if shoot.Generation <= generation {
+			finish(shootStatus, operationsv1alpha1.BulkOperationShootFailed, "Operation was not accepted for the shoot", now)
+		}
+	}
+
+	return nil
+}
+
+// updatePhase sets the final phase of the BulkOperation once the operation finished on all selected shoots. It returns
+// true if the BulkOperation is finished.
+func updatePhase(bulkOperation *operationsv1alpha1.BulkOperation, now time.Time) bool {
+	phase := operationsv1alpha1.BulkOperationSucceeded
+	for _, shootStatus := range bulkOperation.Status.Shoots {
+		switch shootStatus.State {
+		case operationsv1alpha1.BulkOperationShootPending, operationsv1alpha1.BulkOperationShootInProgress:
+			return false
+		case operationsv1alpha1.BulkOperationShootFailed:
+			phase = operationsv1alpha1.BulkOperationFailed
+		}
+	}
+
+	bulkOperation.Status.Phase = phase
+	bulkOperation.Status.CompletionTime = &metav1.Time{Time: now}
+	return true
+}
+
+func (r *Reconciler) recordCompletion(log logr.Logger, bulkOperation *operationsv1alpha1.BulkOperation) {
+	counts := make(map[operationsv1alpha1.BulkOperationShootState]int)
+	for _, shootStatus := range bulkOperation.Status.Shoots {
+		counts[shootStatus.State]++
+	}
+
+	var (
+		eventType = corev1.EventTypeNormal
+		reason    = eventReasonSucceeded
+		message   = fmt.Sprintf("Operation %q finished: %d succeeded, %d failed, %d skipped", bulkOperation.Spec.Operation,
+			counts[operationsv1alpha1.BulkOperationShootSucceeded], counts[operationsv1alpha1.BulkOperationShootFailed], counts[operationsv1alpha1.BulkOperationShootSkipped])
+	)
+
+	if bulkOperation.Status.Phase == operationsv1alpha1.BulkOperationFailed {
+		eventType, reason = corev1.EventTypeWarning, eventReasonFailed
+	}
+
+	log.Info("Bulk operation finished", "phase", bulkOperation.Status.Phase)
+	r.Recorder.Event(bulkOperation, eventType, reason, message)
+}
+
+func (r *Reconciler) getShoot(ctx context.Context, shootStatus *operationsv1alpha1.BulkOperationShootStatus) (*gardencorev1beta1.Shoot, error) {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: shootStatus.Namespace, Name: shootStatus.Name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed getting shoot %s/%s: %w", shootStatus.Namespace, shootStatus.Name, err)
+	}
+	return shoot, nil
+}
+
+// skipReason returns the reason why the operation must not be triggered on the given shoot, or an empty string if it
+// can be triggered.
+func skipReason(shoot *gardencorev1beta1.Shoot, now time.Time) string {
+	switch {
+	case shoot.DeletionTimestamp != nil:
+		return "Shoot is being deleted"
+	case v1beta1helper.IsShootReconciliationPaused(shoot, now):
+		return "Reconciliation of the shoot is paused"
+	case shoot.Status.LastOperation == nil:
+		return "Shoot was not reconciled yet"
+	case shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed:
+		return "Last operation of the shoot failed"
+	}
+
+	if operation, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; ok {
+		return fmt.Sprintf("Shoot has a pending operation %q", operation)
+	}
+
+	return ""
+}
+
+func finish(shootStatus *operationsv1alpha1.BulkOperationShootStatus, state operationsv1alpha1.BulkOperationShootState, message string, now time.Time) {
+	shootStatus.State = state
+	shootStatus.Message = nil
+	if message != "" {
+		shootStatus.Message = ptr.To(message)
+	}
+	shootStatus.CompletionTime = &metav1.Time{Time: now}
+}
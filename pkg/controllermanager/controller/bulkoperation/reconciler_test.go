@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulkoperation_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/bulkoperation"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx         = context.TODO()
+		fakeClient  client.Client
+		fakeClock   *testclock.FakeClock
+		reconciler  *Reconciler
+		patchErr    error
+		syncPeriod  = 30 * time.Second
+		request     reconcile.Request
+		bulkOp      *operationsv1alpha1.BulkOperation
+		shootFoo    *gardencorev1beta1.Shoot
+		shootBar    *gardencorev1beta1.Shoot
+		shootOther  *gardencorev1beta1.Shoot
+		newShoot    func(namespace, name string, labels map[string]string) *gardencorev1beta1.Shoot
+		getBulkOp   func() *operationsv1alpha1.BulkOperation
+		finishShoot func(shoot *gardencorev1beta1.Shoot, state gardencorev1beta1.LastOperationState)
+	)
+
+	BeforeEach(func() {
+		patchErr = nil
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&operationsv1alpha1.BulkOperation{}, &gardencorev1beta1.Shoot{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// simulate the API server which increases the generation of shoots when an operation is triggered
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					shoot, ok := obj.(*gardencorev1beta1.Shoot)
+					if !ok {
+						return c.Patch(ctx, obj, patch, opts...)
+					}
+					if patchErr != nil {
+						return patchErr
+					}
+					if err := c.Patch(ctx, shoot, patch, opts...); err != nil {
+						return err
+					}
+					shoot.Generation++
+					return c.Update(ctx, shoot)
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: record.NewFakeRecorder(10),
+			Config:   config.BulkOperationControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+		}
+
+		newShoot = func(namespace, name string, labels map[string]string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, Generation: 1},
+				Status: gardencorev1beta1.ShootStatus{
+					ObservedGeneration: 1,
+					LastOperation:      &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: gardencorev1beta1.LastOperationStateSucceeded},
+				},
+			}
+		}
+
+		shootFoo = newShoot("garden-b", "foo", map[string]string{"stage": "dev"})
+		shootBar = newShoot("garden-a", "bar", map[string]string{"stage": "dev"})
+		shootOther = newShoot("garden-a", "other", map[string]string{"stage": "prod"})
+
+		bulkOp = &operationsv1alpha1.BulkOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "rotate-ca", Generation: 1},
+			Spec: operationsv1alpha1.BulkOperationSpec{
+				ShootSelector: metav1.LabelSelector{MatchLabels: map[string]string{"stage": "dev"}},
+				Operation:     operationsv1alpha1.BulkOperationRotateCA,
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(bulkOp)}
+
+		getBulkOp = func() *operationsv1alpha1.BulkOperation {
+			obj := &operationsv1alpha1.BulkOperation{}
+			ExpectWithOffset(1, fakeClient.Get(ctx, request.NamespacedName, obj)).To(Succeed())
+			return obj
+		}
+
+		finishShoot = func(shoot *gardencorev1beta1.Shoot, state gardencorev1beta1.LastOperationState) {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			shoot.Status.ObservedGeneration = shoot.Generation
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile, State: state, Description: "some description"}
+			ExpectWithOffset(1, fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+		}
+	})
+
+	JustBeforeEach(func() {
+		for _, shoot := range []*gardencorev1beta1.Shoot{shootFoo, shootBar, shootOther} {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+		Expect(fakeClient.Create(ctx, bulkOp)).To(Succeed())
+	})
+
+	It("should do nothing if the object is gone", func() {
+		Expect(fakeClient.Delete(ctx, bulkOp)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("finished bulk operation", func() {
+		BeforeEach(func() {
+			bulkOp.Status.Phase = operationsv1alpha1.BulkOperationSucceeded
+		})
+
+		It("should do nothing", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(getBulkOp().Status.Shoots).To(BeEmpty())
+		})
+	})
+
+	Context("no matching shoots", func() {
+		BeforeEach(func() {
+			bulkOp.Spec.ShootSelector = metav1.LabelSelector{MatchLabels: map[string]string{"stage": "qa"}}
+		})
+
+		It("should succeed immediately", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			status := getBulkOp().Status
+			Expect(status.Phase).To(Equal(operationsv1alpha1.BulkOperationSucceeded))
+			Expect(status.StartTime).NotTo(BeNil())
+			Expect(status.CompletionTime).NotTo(BeNil())
+		})
+	})
+
+	It("should perform the operation on the selected shoots one after another", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		status := getBulkOp().Status
+		Expect(status.Phase).To(Equal(operationsv1alpha1.BulkOperationRunning))
+		Expect(status.ObservedGeneration).To(Equal(int64(1)))
+		Expect(status.Shoots).To(HaveLen(2))
+		Expect(status.Shoots[0].Namespace).To(Equal("garden-a"))
+		Expect(status.Shoots[0].Name).To(Equal("bar"))
+		Expect(status.Shoots[0].State).To(Equal(operationsv1alpha1.BulkOperationShootInProgress))
+		Expect(status.Shoots[0].Generation).To(Equal(ptr.To[int64](1)))
+		Expect(status.Shoots[0].StartTime).NotTo(BeNil())
+		Expect(status.Shoots[1].Name).To(Equal("foo"))
+		Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootPending))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootBar), shootBar)).To(Succeed())
+		Expect(shootBar.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAStart))
+		Expect(shootBar.Generation).To(Equal(int64(2)))
+
+		By("Ensure nothing happens while the operation is running")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(getBulkOp().Status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootPending))
+
+		By("Finish operation on first shoot")
+		finishShoot(shootBar, gardencorev1beta1.LastOperationStateSucceeded)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		status = getBulkOp().Status
+		Expect(status.Shoots[0].State).To(Equal(operationsv1alpha1.BulkOperationShootSucceeded))
+		Expect(status.Shoots[0].CompletionTime).NotTo(BeNil())
+		Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootInProgress))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootOther), shootOther)).To(Succeed())
+		Expect(shootOther.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+
+		By("Fail operation on second shoot")
+		finishShoot(shootFoo, gardencorev1beta1.LastOperationStateFailed)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		status = getBulkOp().Status
+		Expect(status.Phase).To(Equal(operationsv1alpha1.BulkOperationFailed))
+		Expect(status.CompletionTime).NotTo(BeNil())
+		Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootFailed))
+		Expect(status.Shoots[1].Message).To(Equal(ptr.To("some description")))
+	})
+
+	Context("rate limit", func() {
+		BeforeEach(func() {
+			bulkOp.Spec.RateLimit = &operationsv1alpha1.BulkOperationRateLimit{
+				MaxParallel: ptr.To[int32](2),
+				Interval:    &metav1.Duration{Duration: 10 * time.Second},
+			}
+		})
+
+		It("should respect the interval between starting the operation on two shoots", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			status := getBulkOp().Status
+			Expect(status.Shoots[0].State).To(Equal(operationsv1alpha1.BulkOperationShootInProgress))
+			Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootPending))
+
+			fakeClock.Step(10 * time.Second)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			status = getBulkOp().Status
+			Expect(status.Shoots[0].State).To(Equal(operationsv1alpha1.BulkOperationShootInProgress))
+			Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootInProgress))
+		})
+	})
+
+	Context("shoots which must be skipped", func() {
+		BeforeEach(func() {
+			shootBar.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("incident")}
+			shootFoo.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.ShootOperationMaintain}
+		})
+
+		It("should skip the shoots", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			status := getBulkOp().Status
+			Expect(status.Phase).To(Equal(operationsv1alpha1.BulkOperationSucceeded))
+			Expect(status.Shoots[0].State).To(Equal(operationsv1alpha1.BulkOperationShootSkipped))
+			Expect(status.Shoots[0].Message).To(Equal(ptr.To("Reconciliation of the shoot is paused")))
+			Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootSkipped))
+			Expect(status.Shoots[1].Message).To(Equal(ptr.To(`Shoot has a pending operation "maintain"`)))
+		})
+	})
+
+	Context("rejected operations", func() {
+		BeforeEach(func() {
+			bulkOp.Spec.MaxFailures = ptr.To[int32](0)
+			patchErr = apierrors.NewForbidden(schema.GroupResource{Resource: "shoots"}, "bar", nil)
+		})
+
+		It("should stop performing the operation when the maximum number of failures is exceeded", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			status := getBulkOp().Status
+			Expect(status.Phase).To(Equal(operationsv1alpha1.BulkOperationRunning))
+			Expect(status.Shoots[0].State).To(Equal(operationsv1alpha1.BulkOperationShootFailed))
+			Expect(*status.Shoots[0].Message).To(HavePrefix("Operation was rejected: "))
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			status = getBulkOp().Status
+			Expect(status.Phase).To(Equal(operationsv1alpha1.BulkOperationFailed))
+			Expect(status.Shoots[1].State).To(Equal(operationsv1alpha1.BulkOperationShootSkipped))
+			Expect(status.Shoots[1].Message).To(Equal(ptr.To("Operation failed on more than 0 shoots")))
+		})
+	})
+})
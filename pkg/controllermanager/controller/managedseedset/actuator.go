@@ -13,6 +13,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -79,12 +80,26 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	// Sort replicas by ascending ordinal
 	sort.Sort(ascendingOrdinal(replicas))
 
+	// Label replicas without revision (e.g., created before revisions were introduced) with the current revision
+	revision := ComputeRevision(managedSeedSet)
+	for _, r := range replicas {
+		if r.GetRevision() == "" {
+			log.V(1).Info("Adopting current revision", "replica", r.GetObjectKey(), "revision", revision)
+			if err := r.AdoptRevision(ctx, a.gardenClient); err != nil {
+				return status, false, err
+			}
+		}
+	}
+
 	// Get the pending replica, if any
 	pendingReplica := getPendingReplica(replicas, status)
 
-	// Determine ready, postponed, and deletable replicas
-	var readyReplicas, postponedReplicas, deletableReplicas []Replica
+	// Determine ready, postponed, deletable, and outdated replicas
+	var readyReplicas, postponedReplicas, deletableReplicas, outdatedReplicas []Replica
 	for _, r := range replicas {
+		if r.GetRevision() != revision && r.GetOrdinal() >= int(getPartition(managedSeedSet)) {
+			outdatedReplicas = append(outdatedReplicas, r)
+		}
 		if replicaIsReady(r) {
 			readyReplicas = append(readyReplicas, r)
 		} else if r != pendingReplica {
@@ -97,15 +112,21 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	}
 	log.V(1).Info("Current replicas of ManagedSeedSet", "readyReplicas", readyReplicas, "postponedReplicas", postponedReplicas, "deletableReplicas", deletableReplicas)
 
-	// Update replicas and readyReplicas in status
+	// Update replicas, readyReplicas, and revisions in status
 	status.Replicas = int32(len(replicas))
 	status.ReadyReplicas = int32(len(readyReplicas))
+	updateRevisionStatus(status, replicas, revision)
 
 	// Determine the actual and target replica counts
+	// While a rolling update is in progress, up to maxSurge additional replicas are created
 	count := len(replicas)
-	targetCount := 0
+	targetCount, desiredCount := 0, 0
 	if managedSeedSet.DeletionTimestamp == nil {
-		targetCount = int(*managedSeedSet.Spec.Replicas)
+		desiredCount = int(*managedSeedSet.Spec.Replicas)
+		targetCount = desiredCount
+		if isRollingUpdate(managedSeedSet) && len(outdatedReplicas) > 0 {
+			targetCount += getMaxSurge(managedSeedSet, desiredCount)
+		}
 	}
 
 	// Determine whether scaling out or in
@@ -126,8 +147,12 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 			return status, false, err
 		}
 
-		// Increment Replicas and NextReplicaNumber in status
+		// Increment Replicas, UpdatedReplicas, CurrentReplicas, and NextReplicaNumber in status
 		status.Replicas++
+		status.UpdatedReplicas++
+		if status.CurrentRevision == revision {
+			status.CurrentReplicas++
+		}
 		status.NextReplicaNumber = int32(ordinal + 1)
 
 		return status, false, nil
@@ -154,6 +179,14 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		return status, false, nil
 	}
 
+	// Update outdated replicas as long as enough replicas stay ready
+	if isRollingUpdate(managedSeedSet) && len(outdatedReplicas) > 0 {
+		budget := len(readyReplicas) - (desiredCount - getMaxUnavailable(managedSeedSet, desiredCount))
+		if updated, err := a.updateReplicas(ctx, log, managedSeedSet, outdatedReplicas, budget); err != nil || updated {
+			return status, false, err
+		}
+	}
+
 	// Reconcile postponed replicas
 	for _, r := range postponedReplicas {
 		if pending, err := a.reconcileReplica(ctx, log, managedSeedSet, status, r, scalingIn); err != nil || pending {
@@ -182,6 +215,7 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventUpdatingReplica                 = "UpdatingReplica"
 )
 
 func (a *actuator) reconcileReplica(
@@ -342,6 +376,39 @@ func (a *actuator) deleteReplica(
 	return nil
 }
 
+func (a *actuator) updateReplicas(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	outdatedReplicas []Replica,
+	budget int,
+) (bool, error) {
+	var updated bool
+
+	// Update replicas with the highest ordinals first
+	// Replicas that are not ready can be updated without making further replicas unavailable
+	for i := len(outdatedReplicas) - 1; i >= 0; i-- {
+		r := outdatedReplicas[i]
+		ready := replicaIsReady(r)
+		if ready && budget <= 0 {
+			continue
+		}
+
+		log.Info("Updating replica", "replica", r.GetObjectKey())
+		a.infoEventf(managedSeedSet, EventUpdatingReplica, "Updating replica %s", r.GetFullName())
+		if err := r.Update(ctx, a.gardenClient); err != nil {
+			return updated, err
+		}
+		updated = true
+
+		if ready {
+			budget--
+		}
+	}
+
+	return updated, nil
+}
+
 func (a *actuator) infoEventf(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, reason, fmt string, args ...any) {
 	a.recorder.Eventf(managedSeedSet, corev1.EventTypeNormal, reason, fmt, args...)
 }
@@ -390,6 +457,60 @@ func getNextOrdinal(replicas []Replica, status *seedmanagementv1alpha1.ManagedSe
 	return int(status.NextReplicaNumber)
 }
 
+func updateRevisionStatus(status *seedmanagementv1alpha1.ManagedSeedSetStatus, replicas []Replica, revision string) {
+	var updatedReplicas, currentReplicas int32
+	for _, r := range replicas {
+		if r.GetRevision() == revision {
+			updatedReplicas++
+		} else if status.CurrentRevision == "" {
+			status.CurrentRevision = r.GetRevision()
+		}
+	}
+	if updatedReplicas == int32(len(replicas)) {
+		status.CurrentRevision = revision
+	}
+	for _, r := range replicas {
+		if r.GetRevision() == status.CurrentRevision {
+			currentReplicas++
+		}
+	}
+
+	status.UpdateRevision = revision
+	status.UpdatedReplicas = updatedReplicas
+	status.CurrentReplicas = currentReplicas
+}
+
+func isRollingUpdate(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) bool {
+	updateStrategy := managedSeedSet.Spec.UpdateStrategy
+	return !managedSeedSet.Spec.Paused && updateStrategy != nil &&
+		(updateStrategy.Type == nil || *updateStrategy.Type == seedmanagementv1alpha1.RollingUpdateStrategyType)
+}
+
+func getPartition(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) int32 {
+	if updateStrategy := managedSeedSet.Spec.UpdateStrategy; updateStrategy != nil && updateStrategy.RollingUpdate != nil && updateStrategy.RollingUpdate.Partition != nil {
+		return *updateStrategy.RollingUpdate.Partition
+	}
+	return 0
+}
+
+func getMaxSurge(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, desiredCount int) int {
+	if updateStrategy := managedSeedSet.Spec.UpdateStrategy; updateStrategy != nil && updateStrategy.RollingUpdate != nil && updateStrategy.RollingUpdate.MaxSurge != nil {
+		if value, err := intstr.GetScaledValueFromIntOrPercent(updateStrategy.RollingUpdate.MaxSurge, desiredCount, true); err == nil {
+			return value
+		}
+	}
+	return 0
+}
+
+func getMaxUnavailable(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, desiredCount int) int {
+	if updateStrategy := managedSeedSet.Spec.UpdateStrategy; updateStrategy != nil && updateStrategy.RollingUpdate != nil && updateStrategy.RollingUpdate.MaxUnavailable != nil {
+		if value, err := intstr.GetScaledValueFromIntOrPercent(updateStrategy.RollingUpdate.MaxUnavailable, desiredCount, false); err == nil {
+			return value
+		}
+	}
+	return 1
+}
+
 func replicaIsReady(r Replica) bool {
 	return r.GetStatus() == StatusManagedSeedRegistered && r.IsSeedReady() && r.GetShootHealthStatus() == gardenerutils.ShootStatusHealthy
 }
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -52,9 +53,10 @@ var _ = Describe("Actuator", func() {
 		ctx context.Context
 		log logr.Logger
 
-		before  = metav1.Now()
-		now     = metav1.Now()
-		cleanup func()
+		before   = metav1.Now()
+		now      = metav1.Now()
+		revision = ComputeRevision(&seedmanagementv1alpha1.ManagedSeedSet{})
+		cleanup  func()
 	)
 
 	BeforeEach(func() {
@@ -136,21 +138,29 @@ var _ = Describe("Actuator", func() {
 				ObservedGeneration: 1,
 				Replicas:           replicas,
 				ReadyReplicas:      readyReplicas,
+				CurrentReplicas:    replicas,
+				UpdatedReplicas:    replicas,
+				CurrentRevision:    revision,
+				UpdateRevision:     revision,
 				NextReplicaNumber:  nextReplicaNumber,
 				PendingReplica:     pendingReplica,
 			}
 		}
 
-		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int, status ReplicaStatus, seedReady bool, shs gardenerutils.ShootStatus, deletable bool) {
+		expectReplicaWithRevision = func(r *mockmanagedseedset.MockReplica, ordinal int, revision string, status ReplicaStatus, seedReady bool, shs gardenerutils.ShootStatus, deletable bool) {
 			r.EXPECT().GetName().Return(getReplicaName(ordinal)).AnyTimes()
 			r.EXPECT().GetFullName().Return(getReplicaFullName(ordinal)).AnyTimes()
 			r.EXPECT().GetObjectKey().Return(getReplicaObjectKey(ordinal)).AnyTimes()
 			r.EXPECT().GetOrdinal().Return(ordinal).AnyTimes()
+			r.EXPECT().GetRevision().Return(revision).AnyTimes()
 			r.EXPECT().GetStatus().Return(status).AnyTimes()
 			r.EXPECT().IsSeedReady().Return(seedReady).AnyTimes()
 			r.EXPECT().GetShootHealthStatus().Return(shs).AnyTimes()
 			r.EXPECT().IsDeletable().Return(deletable).AnyTimes()
 		}
+		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int, status ReplicaStatus, seedReady bool, shs gardenerutils.ShootStatus, deletable bool) {
+			expectReplicaWithRevision(r, ordinal, revision, status, seedReady, shs, deletable)
+		}
 	)
 
 	Context("not scaling in or out", func() {
@@ -271,6 +281,131 @@ var _ = Describe("Actuator", func() {
 		)
 	})
 
+	Context("updating", func() {
+		var (
+			r1, r2 *mockmanagedseedset.MockReplica
+			mss    *seedmanagementv1alpha1.ManagedSeedSet
+		)
+
+		BeforeEach(func() {
+			r1 = mockmanagedseedset.NewMockReplica(ctrl)
+			r2 = mockmanagedseedset.NewMockReplica(ctrl)
+
+			mss = managedSeedSet(3, 3, "", "", nil)
+			mss.Spec.UpdateStrategy = &seedmanagementv1alpha1.UpdateStrategy{
+				Type: ptr.To(seedmanagementv1alpha1.RollingUpdateStrategyType),
+				RollingUpdate: &seedmanagementv1alpha1.RollingUpdateStrategy{
+					Partition:      ptr.To[int32](0),
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+					MaxSurge:       ptr.To(intstr.FromInt32(0)),
+				},
+			}
+			mss.Status.CurrentRevision = "old"
+		})
+
+		expectOutdatedReplicas := func() {
+			for i, r := range []*mockmanagedseedset.MockReplica{r0, r1, r2} {
+				expectReplicaWithRevision(r, i, "old", StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			}
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1, r2}, nil)
+		}
+
+		It("should update the replica with the highest ordinal", func() {
+			expectOutdatedReplicas()
+			r2.EXPECT().Update(ctx, gc)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventUpdatingReplica, "Updating replica %s", []any{getReplicaFullName(2)})
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeFalse())
+			Expect(s.CurrentRevision).To(Equal("old"))
+			Expect(s.UpdateRevision).To(Equal(revision))
+			Expect(s.CurrentReplicas).To(Equal(int32(3)))
+			Expect(s.UpdatedReplicas).To(BeZero())
+		})
+
+		It("should update as many replicas as allowed by maxUnavailable", func() {
+			mss.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("67%"))
+			expectOutdatedReplicas()
+			r2.EXPECT().Update(ctx, gc)
+			r1.EXPECT().Update(ctx, gc)
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventUpdatingReplica, "Updating replica %s", []any{getReplicaFullName(2)})
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventUpdatingReplica, "Updating replica %s", []any{getReplicaFullName(1)})
+
+			_, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not update replicas below the partition", func() {
+			mss.Spec.UpdateStrategy.RollingUpdate.Partition = ptr.To[int32](3)
+			expectOutdatedReplicas()
+
+			_, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeTrue())
+		})
+
+		It("should not update replicas if the ManagedSeedSet is paused", func() {
+			mss.Spec.Paused = true
+			expectOutdatedReplicas()
+
+			_, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeTrue())
+		})
+
+		It("should not update replicas if the update strategy is OnDelete", func() {
+			mss.Spec.UpdateStrategy.Type = ptr.To(seedmanagementv1alpha1.OnDeleteStrategyType)
+			expectOutdatedReplicas()
+
+			_, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeTrue())
+		})
+
+		It("should create a surge replica before updating replicas", func() {
+			mss.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(1))
+			mss.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(0))
+			expectOutdatedReplicas()
+			r3 := mockmanagedseedset.NewMockReplica(ctrl)
+			rf.EXPECT().NewReplica(mss, nil, nil, nil, false).Return(r3)
+			r3.EXPECT().CreateShoot(ctx, gc, 3)
+			r3.EXPECT().GetName().Return(getReplicaName(3))
+			recorder.EXPECT().Eventf(mss, corev1.EventTypeNormal, EventCreatingShoot, "Creating Shoot %s", []any{getReplicaFullName(3)})
+
+			s, _, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Replicas).To(Equal(int32(4)))
+			Expect(s.UpdatedReplicas).To(Equal(int32(1)))
+		})
+
+		It("should adopt the current revision for replicas without revision", func() {
+			for i, r := range []*mockmanagedseedset.MockReplica{r0, r1, r2} {
+				expectReplica(r, i, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			}
+			r3 := mockmanagedseedset.NewMockReplica(ctrl)
+			gomock.InOrder(
+				r3.EXPECT().GetRevision().Return(""),
+				r3.EXPECT().AdoptRevision(ctx, gc),
+				r3.EXPECT().GetRevision().Return(revision).AnyTimes(),
+			)
+			r3.EXPECT().GetObjectKey().Return(getReplicaObjectKey(3)).AnyTimes()
+			r3.EXPECT().GetOrdinal().Return(3).AnyTimes()
+			r3.EXPECT().GetStatus().Return(StatusManagedSeedRegistered).AnyTimes()
+			r3.EXPECT().IsSeedReady().Return(true).AnyTimes()
+			r3.EXPECT().GetShootHealthStatus().Return(gardenerutils.ShootStatusHealthy).AnyTimes()
+			r3.EXPECT().IsDeletable().Return(true).AnyTimes()
+			mss.Spec.Replicas = ptr.To[int32](4)
+			rg.EXPECT().GetReplicas(ctx, mss).Return([]Replica{r0, r1, r2, r3}, nil)
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, mss)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeTrue())
+			Expect(s.CurrentRevision).To(Equal(revision))
+			Expect(s.UpdatedReplicas).To(Equal(int32(4)))
+		})
+	})
+
 	Context("scaling out", func() {
 		DescribeTable("#Reconcile",
 			func(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, setupReplicas func(), status *seedmanagementv1alpha1.ManagedSeedSetStatus, reason, fmt string, args ...any) {
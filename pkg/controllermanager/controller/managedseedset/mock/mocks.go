@@ -85,6 +85,20 @@ func (m *MockReplica) EXPECT() *MockReplicaMockRecorder {
 	return m.recorder
 }
 
+// AdoptRevision mocks base method.
+func (m *MockReplica) AdoptRevision(arg0 context.Context, arg1 client.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptRevision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdoptRevision indicates an expected call of AdoptRevision.
+func (mr *MockReplicaMockRecorder) AdoptRevision(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptRevision", reflect.TypeOf((*MockReplica)(nil).AdoptRevision), arg0, arg1)
+}
+
 // CreateManagedSeed mocks base method.
 func (m *MockReplica) CreateManagedSeed(arg0 context.Context, arg1 client.Client) error {
 	m.ctrl.T.Helper()
@@ -197,6 +211,20 @@ func (mr *MockReplicaMockRecorder) GetOrdinal() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdinal", reflect.TypeOf((*MockReplica)(nil).GetOrdinal))
 }
 
+// GetRevision mocks base method.
+func (m *MockReplica) GetRevision() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockReplicaMockRecorder) GetRevision() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockReplica)(nil).GetRevision))
+}
+
 // GetShootHealthStatus mocks base method.
 func (m *MockReplica) GetShootHealthStatus() gardener.ShootStatus {
 	m.ctrl.T.Helper()
@@ -267,6 +295,20 @@ func (mr *MockReplicaMockRecorder) RetryShoot(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryShoot", reflect.TypeOf((*MockReplica)(nil).RetryShoot), arg0, arg1)
 }
 
+// Update mocks base method.
+func (m *MockReplica) Update(arg0 context.Context, arg1 client.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReplicaMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReplica)(nil).Update), arg0, arg1)
+}
+
 // MockReplicaFactory is a mock of ReplicaFactory interface.
 type MockReplicaFactory struct {
 	ctrl     *gomock.Controller
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -83,6 +84,9 @@ type Replica interface {
 	GetObjectKey() client.ObjectKey
 	// GetOrdinal returns this replica's ordinal. If the replica has no ordinal, -1 is returned.
 	GetOrdinal() int
+	// GetRevision returns the revision of the ManagedSeedSet templates this replica was created or last updated from.
+	// If the replica has no revision, an empty string is returned.
+	GetRevision() string
 	// GetStatus returns this replica's status. If the replica's managed seed doesn't exist,
 	// it returns one of the StatusShoot* statuses, depending on the shoot state.
 	// Otherwise, it returns one of the ManagedSeed* statuses, depending on the managed seed state.
@@ -104,6 +108,12 @@ type Replica interface {
 	DeleteManagedSeed(ctx context.Context, c client.Client) error
 	// RetryShoot retries this replica's shoot using the given context and client.
 	RetryShoot(ctx context.Context, c client.Client) error
+	// Update updates this replica's shoot and managed seed (if it exists) to the current revision of the
+	// ManagedSeedSet templates using the given context and client.
+	Update(ctx context.Context, c client.Client) error
+	// AdoptRevision labels this replica's shoot and managed seed (if it exists) with the current revision of the
+	// ManagedSeedSet templates without changing them, using the given context and client.
+	AdoptRevision(ctx context.Context, c client.Client) error
 }
 
 // ReplicaFactory provides a method for creating new replicas.
@@ -185,6 +195,15 @@ func (r *replica) GetOrdinal() int {
 	return getOrdinal(r.shoot.Name)
 }
 
+// GetRevision returns the revision of the ManagedSeedSet templates this replica was created or last updated from.
+// If the replica has no revision, an empty string is returned.
+func (r *replica) GetRevision() string {
+	if r.shoot == nil {
+		return ""
+	}
+	return r.shoot.Labels[seedmanagementv1alpha1constants.LabelManagedSeedSetRevision]
+}
+
 // GetStatus returns this replica's status. If the replica's managed seed doesn't exit,
 // it returns one of the StatusShoot* statuses, depending on the shoot state.
 // Otherwise, it returns one of the ManagedSeed* statuses, depending on the managed seed state.
@@ -286,6 +305,64 @@ func (r *replica) RetryShoot(ctx context.Context, c client.Client) error {
 	return kubernetesutils.SetAnnotationAndUpdate(ctx, c, r.shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRetry)
 }
 
+// Update updates this replica's shoot and managed seed (if it exists) to the current revision of the
+// ManagedSeedSet templates using the given context and client.
+func (r *replica) Update(ctx context.Context, c client.Client) error {
+	if r.shoot == nil {
+		return nil
+	}
+	ordinal := r.GetOrdinal()
+
+	desiredShoot := newShoot(r.managedSeedSet, ordinal)
+	patch := client.MergeFrom(r.shoot.DeepCopy())
+	r.shoot.Labels = utils.MergeStringMaps(r.shoot.Labels, desiredShoot.Labels)
+	r.shoot.Annotations = utils.MergeStringMaps(r.shoot.Annotations, desiredShoot.Annotations)
+	if desiredShoot.Spec.SeedName == nil {
+		// The seed name is immutable once the shoot was scheduled, hence keep the one chosen by the scheduler
+		desiredShoot.Spec.SeedName = r.shoot.Spec.SeedName
+	}
+	r.shoot.Spec = desiredShoot.Spec
+	if err := c.Patch(ctx, r.shoot, patch); err != nil {
+		return err
+	}
+
+	if r.managedSeed == nil {
+		return nil
+	}
+
+	desiredManagedSeed, err := newManagedSeed(r.managedSeedSet, ordinal)
+	if err != nil {
+		return err
+	}
+	patch = client.MergeFrom(r.managedSeed.DeepCopy())
+	r.managedSeed.Labels = utils.MergeStringMaps(r.managedSeed.Labels, desiredManagedSeed.Labels)
+	r.managedSeed.Annotations = utils.MergeStringMaps(r.managedSeed.Annotations, desiredManagedSeed.Annotations)
+	r.managedSeed.Spec.Gardenlet = desiredManagedSeed.Spec.Gardenlet
+	return client.IgnoreNotFound(c.Patch(ctx, r.managedSeed, patch))
+}
+
+// AdoptRevision labels this replica's shoot and managed seed (if it exists) with the current revision of the
+// ManagedSeedSet templates without changing them, using the given context and client.
+func (r *replica) AdoptRevision(ctx context.Context, c client.Client) error {
+	if r.shoot == nil {
+		return nil
+	}
+	revision := ComputeRevision(r.managedSeedSet)
+
+	patch := client.MergeFrom(r.shoot.DeepCopy())
+	metav1.SetMetaDataLabel(&r.shoot.ObjectMeta, seedmanagementv1alpha1constants.LabelManagedSeedSetRevision, revision)
+	if err := c.Patch(ctx, r.shoot, patch); err != nil {
+		return err
+	}
+
+	if r.managedSeed == nil {
+		return nil
+	}
+	patch = client.MergeFrom(r.managedSeed.DeepCopy())
+	metav1.SetMetaDataLabel(&r.managedSeed.ObjectMeta, seedmanagementv1alpha1constants.LabelManagedSeedSetRevision, revision)
+	return client.IgnoreNotFound(c.Patch(ctx, r.managedSeed, patch))
+}
+
 func shootReconcileSucceeded(shoot *gardencorev1beta1.Shoot) bool {
 	lastOp := shoot.Status.LastOperation
 	return shoot.Generation == shoot.Status.ObservedGeneration && shoot.DeletionTimestamp == nil && lastOp != nil &&
@@ -324,6 +401,10 @@ func seedReady(seed *gardencorev1beta1.Seed) bool {
 }
 
 func shootHealthStatus(shoot *gardencorev1beta1.Shoot) gardenerutils.ShootStatus {
+	if shoot.Generation != shoot.Status.ObservedGeneration {
+		// The shoot spec was changed (e.g., by an update of the replica) but not yet reconciled
+		return gardenerutils.ShootStatusProgressing
+	}
 	if value, ok := shoot.Labels[v1beta1constants.ShootStatus]; ok {
 		return gardenerutils.ShootStatus(value)
 	}
@@ -339,13 +420,13 @@ func newShoot(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordinal int
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
 			Namespace:   managedSeedSet.Namespace,
-			Labels:      managedSeedSet.Spec.ShootTemplate.Labels,
+			Labels:      utils.MergeStringMaps(managedSeedSet.Spec.ShootTemplate.Labels, revisionLabels(managedSeedSet)),
 			Annotations: managedSeedSet.Spec.ShootTemplate.Annotations,
 			OwnerReferences: []metav1.OwnerReference{
 				*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
 			},
 		},
-		Spec: managedSeedSet.Spec.ShootTemplate.Spec,
+		Spec: *managedSeedSet.Spec.ShootTemplate.Spec.DeepCopy(),
 	}
 
 	// Replace placeholders in shoot spec with the actual replica name
@@ -363,7 +444,7 @@ func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordin
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
 			Namespace:   managedSeedSet.Namespace,
-			Labels:      managedSeedSet.Spec.Template.Labels,
+			Labels:      utils.MergeStringMaps(managedSeedSet.Spec.Template.Labels, revisionLabels(managedSeedSet)),
 			Annotations: managedSeedSet.Spec.Template.Annotations,
 			OwnerReferences: []metav1.OwnerReference{
 				*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
@@ -373,7 +454,7 @@ func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordin
 			Shoot: &seedmanagementv1alpha1.Shoot{
 				Name: name,
 			},
-			Gardenlet: managedSeedSet.Spec.Template.Spec.Gardenlet,
+			Gardenlet: managedSeedSet.Spec.Template.Spec.Gardenlet.DeepCopy(),
 		},
 	}
 
@@ -390,6 +471,19 @@ func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordin
 	return managedSeed, nil
 }
 
+// ComputeRevision returns the revision of the templates of the given set. It changes whenever the shoot template or
+// the managed seed template changes.
+func ComputeRevision(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) string {
+	return utils.ComputeChecksum(struct {
+		Template      seedmanagementv1alpha1.ManagedSeedTemplate `json:"template"`
+		ShootTemplate gardencorev1beta1.ShootTemplate            `json:"shootTemplate"`
+	}{managedSeedSet.Spec.Template, managedSeedSet.Spec.ShootTemplate})[:10]
+}
+
+func revisionLabels(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) map[string]string {
+	return map[string]string{seedmanagementv1alpha1constants.LabelManagedSeedSetRevision: ComputeRevision(managedSeedSet)}
+}
+
 const placeholder = "replica-name"
 
 func replacePlaceholdersInShootSpec(spec *gardencorev1beta1.ShootSpec, name string) {
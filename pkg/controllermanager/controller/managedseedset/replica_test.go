@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -280,6 +281,41 @@ var _ = Describe("Replica", func() {
 			shoot(nil, "", "", gardenerutils.ShootStatusUnknown, false), gardenerutils.ShootStatusUnknown),
 	)
 
+	Describe("#GetShootHealthStatus", func() {
+		It("should return progressing if the shoot generation was not yet observed", func() {
+			shoot := shoot(nil, "", "", gardenerutils.ShootStatusHealthy, false)
+			shoot.Generation = 2
+
+			replica := NewReplica(managedSeedSet, shoot, nil, nil, false)
+			Expect(replica.GetShootHealthStatus()).To(Equal(gardenerutils.ShootStatusProgressing))
+		})
+	})
+
+	DescribeTable("#GetRevision",
+		func(shoot *gardencorev1beta1.Shoot, revision string) {
+			replica := NewReplica(managedSeedSet, shoot, nil, nil, false)
+			Expect(replica.GetRevision()).To(Equal(revision))
+		},
+		Entry("should return an empty string", nil, ""),
+		Entry("should return an empty string if the shoot has no revision", shoot(nil, "", "", "", false), ""),
+		Entry("should return the revision of the shoot", &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{seedmanagementv1alpha1constants.LabelManagedSeedSetRevision: "foo"},
+		}}, "foo"),
+	)
+
+	Describe("#ComputeRevision", func() {
+		It("should change if the templates change", func() {
+			revision := ComputeRevision(managedSeedSet)
+			Expect(revision).To(HaveLen(10))
+
+			managedSeedSet.Spec.Replicas = ptr.To[int32](3)
+			Expect(ComputeRevision(managedSeedSet)).To(Equal(revision))
+
+			managedSeedSet.Spec.ShootTemplate.Spec.Kubernetes.Version = "1.30.0"
+			Expect(ComputeRevision(managedSeedSet)).NotTo(Equal(revision))
+		})
+	})
+
 	DescribeTable("#IsDeletable",
 		func(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, hasScheduledShoots, deletable bool) {
 			replica := NewReplica(managedSeedSet, shoot, managedSeed, nil, hasScheduledShoots)
@@ -309,6 +345,7 @@ var _ = Describe("Replica", func() {
 							Namespace: namespace,
 							Labels: map[string]string{
 								"foo": "bar",
+								seedmanagementv1alpha1constants.LabelManagedSeedSetRevision: ComputeRevision(managedSeedSet),
 							},
 							OwnerReferences: []metav1.OwnerReference{
 								*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
@@ -341,6 +378,7 @@ var _ = Describe("Replica", func() {
 							Namespace: namespace,
 							Labels: map[string]string{
 								"foo": "bar",
+								seedmanagementv1alpha1constants.LabelManagedSeedSetRevision: ComputeRevision(managedSeedSet),
 							},
 							OwnerReferences: []metav1.OwnerReference{
 								*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
@@ -416,6 +454,63 @@ var _ = Describe("Replica", func() {
 		})
 	})
 
+	Describe("#Update", func() {
+		It("should update the shoot and the managed seed to the current revision", func() {
+			shoot := shoot(nil, "", "", "", false)
+			shoot.Spec.SeedName = ptr.To("seed")
+			managedSeed := managedSeed(nil, true, false)
+			managedSeedSet.Spec.ShootTemplate.Spec.Kubernetes.Version = "1.30.0"
+
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, s *gardencorev1beta1.Shoot, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(s.Labels).To(HaveKeyWithValue("foo", "bar"))
+					Expect(s.Labels).To(HaveKeyWithValue(seedmanagementv1alpha1constants.LabelManagedSeedSetRevision, ComputeRevision(managedSeedSet)))
+					Expect(s.Spec.Kubernetes.Version).To(Equal("1.30.0"))
+					Expect(s.Spec.DNS.Domain).To(PointTo(Equal(replicaName + ".example.com")))
+					Expect(s.Spec.SeedName).To(PointTo(Equal("seed")))
+					return nil
+				},
+			)
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeed{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, ms *seedmanagementv1alpha1.ManagedSeed, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(ms.Labels).To(HaveKeyWithValue("foo", "bar"))
+					Expect(ms.Labels).To(HaveKeyWithValue(seedmanagementv1alpha1constants.LabelManagedSeedSetRevision, ComputeRevision(managedSeedSet)))
+					Expect(ms.Spec.Gardenlet.Config.Object.(*gardenletv1alpha1.GardenletConfiguration).SeedConfig.Spec.Ingress.Domain).To(Equal("ingress." + replicaName + ".example.com"))
+					return nil
+				},
+			)
+
+			replica := NewReplica(managedSeedSet, shoot, managedSeed, nil, false)
+			Expect(replica.Update(ctx, c)).To(Succeed())
+			Expect(replica.GetRevision()).To(Equal(ComputeRevision(managedSeedSet)))
+		})
+	})
+
+	Describe("#AdoptRevision", func() {
+		It("should label the shoot and the managed seed with the current revision", func() {
+			shoot := shoot(nil, "", "", "", false)
+			managedSeed := managedSeed(nil, true, false)
+
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, s *gardencorev1beta1.Shoot, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(s.Labels).To(HaveKeyWithValue(seedmanagementv1alpha1constants.LabelManagedSeedSetRevision, ComputeRevision(managedSeedSet)))
+					Expect(s.Spec).To(Equal(gardencorev1beta1.ShootSpec{}))
+					return nil
+				},
+			)
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeed{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, ms *seedmanagementv1alpha1.ManagedSeed, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(ms.Labels).To(HaveKeyWithValue(seedmanagementv1alpha1constants.LabelManagedSeedSetRevision, ComputeRevision(managedSeedSet)))
+					return nil
+				},
+			)
+
+			replica := NewReplica(managedSeedSet, shoot, managedSeed, nil, false)
+			Expect(replica.AdoptRevision(ctx, c)).To(Succeed())
+			Expect(replica.GetRevision()).To(Equal(ComputeRevision(managedSeedSet)))
+		})
+	})
+
 	Describe("#RetryShoot", func() {
 		It("should managedSeedSet the operation to retry and the retries to 1", func() {
 			shoot := shoot(nil, "", "", "", false)
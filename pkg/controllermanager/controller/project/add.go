@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
 )
 
 // AddToManager adds all Project controllers to the given manager.
@@ -36,5 +37,13 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding stale reconciler: %w", err)
 	}
 
+	if cfg.Controllers.Project.UsageReportSyncPeriod != nil {
+		if err := (&usage.Reconciler{
+			Config: *cfg.Controllers.Project,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding usage reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -30,6 +31,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
 	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -37,6 +41,8 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		For(&gardencorev1beta1.Project{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Owns(&corev1.Namespace{}, builder.WithPredicates(predicateutils.ForEventTypes(predicateutils.Delete))).
 		Owns(&rbacv1.RoleBinding{}, builder.WithPredicates(r.RoleBindingPredicate())).
+		Owns(&corev1.ResourceQuota{}, builder.WithPredicates(predicateutils.HasName(ResourceQuotaName), r.NamespaceResourcePredicate())).
+		Owns(&corev1.LimitRange{}, builder.WithPredicates(predicateutils.HasName(LimitRangeName), r.NamespaceResourcePredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 			RateLimiter:             r.RateLimiter,
@@ -71,3 +77,31 @@ func (r *Reconciler) RoleBindingPredicate() predicate.Predicate {
 		GenericFunc: func(_ event.GenericEvent) bool { return false },
 	}
 }
+
+// NamespaceResourcePredicate filters for events for the default ResourceQuota and LimitRange in project namespaces that
+// we might need to reconcile back.
+func (r *Reconciler) NamespaceResourcePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// enqueue on periodic cache resyncs
+			if e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion() {
+				return true
+			}
+
+			switch obj := e.ObjectNew.(type) {
+			case *corev1.ResourceQuota:
+				oldObj, ok := e.ObjectOld.(*corev1.ResourceQuota)
+				// The status of ResourceQuotas changes frequently, hence only consider changes to the spec.
+				return ok && !apiequality.Semantic.DeepEqual(oldObj.Spec, obj.Spec)
+			case *corev1.LimitRange:
+				oldObj, ok := e.ObjectOld.(*corev1.LimitRange)
+				return ok && !apiequality.Semantic.DeepEqual(oldObj.Spec, obj.Spec)
+			}
+
+			return false
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return true },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
@@ -7,7 +7,9 @@ package project_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -92,3 +94,79 @@ var _ = Describe("RoleBindingPredicate", func() {
 		})
 	})
 })
+
+var _ = Describe("NamespaceResourcePredicate", func() {
+	var (
+		p             predicate.Predicate
+		resourceQuota *corev1.ResourceQuota
+		limitRange    *corev1.LimitRange
+	)
+
+	BeforeEach(func() {
+		p = (&project.Reconciler{}).NamespaceResourcePredicate()
+		resourceQuota = &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+			Spec:       corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")}},
+		}
+		limitRange = &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+			Spec:       corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypeContainer}}},
+		}
+	})
+
+	Describe("#Create", func() {
+		It("should return false", func() {
+			Expect(p.Create(event.CreateEvent{})).To(BeFalse())
+		})
+	})
+
+	Describe("#Update", func() {
+		It("should return true for periodic cache resyncs", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectNew: resourceQuota, ObjectOld: resourceQuota.DeepCopy()})).To(BeTrue())
+		})
+
+		It("should return true if the ResourceQuota spec changed", func() {
+			oldResourceQuota := resourceQuota.DeepCopy()
+			resourceQuota.ResourceVersion = "2"
+			resourceQuota.Spec.Hard["count/shoots.core.gardener.cloud"] = resource.MustParse("20")
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: resourceQuota, ObjectOld: oldResourceQuota})).To(BeTrue())
+		})
+
+		It("should return false if the ResourceQuota status changed", func() {
+			oldResourceQuota := resourceQuota.DeepCopy()
+			resourceQuota.ResourceVersion = "2"
+			resourceQuota.Status.Used = corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("1")}
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: resourceQuota, ObjectOld: oldResourceQuota})).To(BeFalse())
+		})
+
+		It("should return true if the LimitRange spec changed", func() {
+			oldLimitRange := limitRange.DeepCopy()
+			limitRange.ResourceVersion = "2"
+			limitRange.Spec.Limits = nil
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: limitRange, ObjectOld: oldLimitRange})).To(BeTrue())
+		})
+
+		It("should return false if something else of the LimitRange changed", func() {
+			oldLimitRange := limitRange.DeepCopy()
+			limitRange.ResourceVersion = "2"
+			metav1.SetMetaDataLabel(&limitRange.ObjectMeta, "foo", "bar")
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: limitRange, ObjectOld: oldLimitRange})).To(BeFalse())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should return true", func() {
+			Expect(p.Delete(event.DeleteEvent{})).To(BeTrue())
+		})
+	})
+
+	Describe("#Generic", func() {
+		It("should return false", func() {
+			Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+		})
+	})
+})
@@ -15,10 +15,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -42,6 +42,7 @@ type Reconciler struct {
 	Client   client.Client
 	Config   config.ProjectControllerConfiguration
 	Recorder record.EventRecorder
+	Clock    clock.Clock
 
 	// RateLimiter allows limiting exponential backoff for testing purposes
 	RateLimiter ratelimiter.RateLimiter
@@ -131,8 +132,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return reconcile.Result{}, err
 	}
 
-	if quotaConfig != nil {
-		if err := createOrUpdateResourceQuota(ctx, r.Client, namespace.Name, ownerReference, *quotaConfig); err != nil {
+	if quotaConfig != nil || len(resourceQuotaOverrides(project)) > 0 {
+		if err := createOrUpdateResourceQuota(ctx, r.Client, namespace.Name, ownerReference, quotaConfig, resourceQuotaOverrides(project)); err != nil {
 			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up ResourceQuota: %+v", err)
 			if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
 				log.Error(err, "Failed to update Project status")
@@ -141,6 +142,26 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		}
 	}
 
+	// Create LimitRange for project if configured.
+	limitRangeConfig, err := limitRangeConfigurationForProject(r.Config, project)
+	if err != nil {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up LimitRange: %+v", err)
+		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
+			log.Error(err, "Failed to update Project status")
+		}
+		return reconcile.Result{}, err
+	}
+
+	if limitRangeConfig != nil || len(limitRangeOverrides(project)) > 0 {
+		if err := createOrUpdateLimitRange(ctx, r.Client, namespace.Name, ownerReference, limitRangeConfig, limitRangeOverrides(project)); err != nil {
+			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while setting up LimitRange: %+v", err)
+			if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
+				log.Error(err, "Failed to update Project status")
+			}
+			return reconcile.Result{}, err
+		}
+	}
+
 	// Create RBAC rules to allow project members to interact with it.
 	rbac, err := projectrbac.New(r.Client, project)
 	if err != nil {
@@ -167,6 +188,14 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return reconcile.Result{}, err
 	}
 
+	if err := rbac.DeleteStaleCustomRolesResources(ctx); err != nil {
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while deleting stale RBAC rules for custom roles: %+v", err)
+		if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
+			log.Error(err, "Failed to update Project status")
+		}
+		return reconcile.Result{}, err
+	}
+
 	// Update the project status to mark it as 'ready'.
 	if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectReady); err != nil {
 		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while trying to mark project as ready: %+v", err)
@@ -266,10 +295,16 @@ func quotaConfigurationForProject(config config.ProjectControllerConfiguration,
 // ResourceQuotaName is the name of the default ResourceQuota resource that is created by Gardener in the project namespace.
 const ResourceQuotaName = "gardener"
 
-func createOrUpdateResourceQuota(ctx context.Context, c client.Client, projectNamespace string, ownerReference *metav1.OwnerReference, config config.QuotaConfiguration) error {
-	resourceQuota, ok := config.Config.(*corev1.ResourceQuota)
-	if !ok {
-		return fmt.Errorf("failure while reading ResourceQuota from configuration: %v", resourceQuota)
+// createOrUpdateResourceQuota reconciles the default ResourceQuota in the project namespace. Its hard limits are taken
+// from the given configuration and overridden by the given overrides of the project. Any other modification of the
+// ResourceQuota is reverted.
+func createOrUpdateResourceQuota(ctx context.Context, c client.Client, projectNamespace string, ownerReference *metav1.OwnerReference, config *config.QuotaConfiguration, overrides corev1.ResourceList) error {
+	resourceQuota := &corev1.ResourceQuota{}
+	if config != nil {
+		var ok bool
+		if resourceQuota, ok = config.Config.(*corev1.ResourceQuota); !ok {
+			return fmt.Errorf("failure while reading ResourceQuota from configuration: %v", config.Config)
+		}
 	}
 
 	projectResourceQuota := &corev1.ResourceQuota{
@@ -279,26 +314,88 @@ func createOrUpdateResourceQuota(ctx context.Context, c client.Client, projectNa
 		},
 	}
 
-	if _, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, c, projectResourceQuota, func() error {
+	_, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, c, projectResourceQuota, func() error {
 		projectResourceQuota.SetOwnerReferences(kubernetesutils.MergeOwnerReferences(projectResourceQuota.GetOwnerReferences(), *ownerReference))
 		projectResourceQuota.Labels = utils.MergeStringMaps(projectResourceQuota.Labels, resourceQuota.Labels)
 		projectResourceQuota.Annotations = utils.MergeStringMaps(projectResourceQuota.Annotations, resourceQuota.Annotations)
-		quotas := make(map[corev1.ResourceName]resource.Quantity)
+		quotas := make(corev1.ResourceList, len(resourceQuota.Spec.Hard)+len(overrides))
 		for resourceName, quantity := range resourceQuota.Spec.Hard {
-			if val, ok := projectResourceQuota.Spec.Hard[resourceName]; ok {
-				// Do not overwrite already existing quotas.
-				quotas[resourceName] = val
-				continue
-			}
+			quotas[resourceName] = quantity
+		}
+		for resourceName, quantity := range overrides {
 			quotas[resourceName] = quantity
 		}
 		projectResourceQuota.Spec.Hard = quotas
 		return nil
-	}); err != nil {
-		return err
+	})
+	return err
+}
+
+// limitRangeConfigurationForProject returns the first matching limit range configuration if one is configured for the
+// given project.
+func limitRangeConfigurationForProject(config config.ProjectControllerConfiguration, project *gardencorev1beta1.Project) (*config.LimitRangeConfiguration, error) {
+	for _, c := range config.LimitRanges {
+		limitRangeConfig := c
+		selector, err := metav1.LabelSelectorAsSelector(limitRangeConfig.ProjectSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		if selector.Matches(labels.Set(project.GetLabels())) {
+			return &limitRangeConfig, nil
+		}
 	}
 
-	return nil
+	return nil, nil
+}
+
+// LimitRangeName is the name of the default LimitRange resource that is created by Gardener in the project namespace.
+const LimitRangeName = "gardener"
+
+// createOrUpdateLimitRange reconciles the default LimitRange in the project namespace. Its limits are taken from the
+// given configuration unless the project specifies overrides which replace them. Any other modification of the
+// LimitRange is reverted.
+func createOrUpdateLimitRange(ctx context.Context, c client.Client, projectNamespace string, ownerReference *metav1.OwnerReference, config *config.LimitRangeConfiguration, overrides []corev1.LimitRangeItem) error {
+	limitRange := &corev1.LimitRange{}
+	if config != nil {
+		var ok bool
+		if limitRange, ok = config.Config.(*corev1.LimitRange); !ok {
+			return fmt.Errorf("failure while reading LimitRange from configuration: %v", config.Config)
+		}
+	}
+
+	projectLimitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LimitRangeName,
+			Namespace: projectNamespace,
+		},
+	}
+
+	_, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, c, projectLimitRange, func() error {
+		projectLimitRange.SetOwnerReferences(kubernetesutils.MergeOwnerReferences(projectLimitRange.GetOwnerReferences(), *ownerReference))
+		projectLimitRange.Labels = utils.MergeStringMaps(projectLimitRange.Labels, limitRange.Labels)
+		projectLimitRange.Annotations = utils.MergeStringMaps(projectLimitRange.Annotations, limitRange.Annotations)
+		projectLimitRange.Spec.Limits = limitRange.Spec.Limits
+		if len(overrides) > 0 {
+			projectLimitRange.Spec.Limits = overrides
+		}
+		return nil
+	})
+	return err
+}
+
+func resourceQuotaOverrides(project *gardencorev1beta1.Project) corev1.ResourceList {
+	if project.Spec.NamespaceResources == nil {
+		return nil
+	}
+	return project.Spec.NamespaceResources.ResourceQuota
+}
+
+func limitRangeOverrides(project *gardencorev1beta1.Project) []corev1.LimitRangeItem {
+	if project.Spec.NamespaceResources == nil {
+		return nil
+	}
+	return project.Spec.NamespaceResources.LimitRange
 }
 
 func namespaceLabelsFromProject(project *gardencorev1beta1.Project) map[string]string {
@@ -322,12 +419,44 @@ func (r *Reconciler) delete(ctx context.Context, log logr.Logger, project *garde
 	if namespace := project.Spec.Namespace; namespace != nil {
 		log = log.WithValues("namespaceName", *namespace)
 
+		var keepNamespace bool
+		if r.Config.DeletionGracePeriodDays != nil {
+			if remaining := project.DeletionTimestamp.Add(time.Hour * 24 * time.Duration(*r.Config.DeletionGracePeriodDays)).Sub(r.Clock.Now()); remaining > 0 {
+				if err := r.archive(ctx, log, project, *namespace); err != nil {
+					r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventArchivalFailed, "Failed to archive project: %v", err)
+					if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectFailed); err != nil {
+						log.Error(err, "Failed to update Project status")
+					}
+					return reconcile.Result{}, fmt.Errorf("failed to archive project: %w", err)
+				}
+
+				if project.Status.Phase != gardencorev1beta1.ProjectArchived {
+					r.Recorder.Eventf(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventArchived, "Project is archived, its namespace %q will be deleted in %s", *namespace, remaining.Round(time.Minute))
+				}
+				return reconcile.Result{RequeueAfter: remaining}, patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectArchived)
+			}
+
+			// The deletion grace period has passed. If the namespace should be kept, it is released together with the
+			// archived shoots so that it can be adopted by another project. Otherwise, the archived shoots are deleted.
+			var err error
+			keepNamespace, err = r.isNamespaceKept(ctx, *namespace)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+
+			if !keepNamespace {
+				if err := deleteShoots(ctx, log, r.Client, *namespace); err != nil {
+					return reconcile.Result{}, fmt.Errorf("failed to delete archived shoots: %w", err)
+				}
+			}
+		}
+
 		inUse, err := kubernetesutils.ResourcesExist(ctx, r.Client, &gardencorev1beta1.ShootList{}, r.Client.Scheme(), client.InNamespace(*namespace))
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to check if namespace is empty: %w", err)
 		}
 
-		if inUse {
+		if inUse && !keepNamespace {
 			r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceNotEmpty, "Cannot release namespace %q because it still contains Shoots", *namespace)
 			log.Info("Cannot release Project Namespace because it still contains Shoots")
 			return reconcile.Result{Requeue: true}, patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectTerminating)
@@ -409,3 +538,79 @@ func (r *Reconciler) releaseNamespace(ctx context.Context, log logr.Logger, proj
 	err := r.Client.Delete(ctx, namespace, kubernetes.DefaultDeleteOptions...)
 	return false, client.IgnoreNotFound(err)
 }
+
+// archive removes the access of the project members and hibernates all shoots in the project namespace.
+func (r *Reconciler) archive(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, namespaceName string) error {
+	rbac, err := projectrbac.New(r.Client, project)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Removing access of project members")
+	if err := rbac.Destroy(ctx); err != nil {
+		return fmt.Errorf("failed removing RBAC resources: %w", err)
+	}
+
+	return hibernateShoots(ctx, log, r.Client, namespaceName)
+}
+
+// hibernateShoots hibernates all shoots in the given namespace which are neither hibernated nor already being deleted.
+func hibernateShoots(ctx context.Context, log logr.Logger, c client.Client, namespaceName string) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shootList, client.InNamespace(namespaceName)); err != nil {
+		return err
+	}
+
+	for _, shoot := range shootList.Items {
+		if shoot.DeletionTimestamp != nil || (shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.Enabled, false)) {
+			continue
+		}
+
+		log.Info("Hibernating Shoot of archived Project", "shoot", client.ObjectKeyFromObject(&shoot))
+		patch := client.MergeFrom(shoot.DeepCopy())
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Enabled = ptr.To(true)
+		if err := c.Patch(ctx, &shoot, patch); err != nil {
+			return fmt.Errorf("failed hibernating shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+	}
+
+	return nil
+}
+
+// deleteShoots confirms the deletion of and deletes all shoots in the given namespace which are not already being
+// deleted.
+func deleteShoots(ctx context.Context, log logr.Logger, c client.Client, namespaceName string) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := c.List(ctx, shootList, client.InNamespace(namespaceName)); err != nil {
+		return err
+	}
+
+	for _, shoot := range shootList.Items {
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		log.Info("Deleting Shoot of archived Project", "shoot", client.ObjectKeyFromObject(&shoot))
+		if err := gardenerutils.ConfirmDeletion(ctx, c, &shoot); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed confirming deletion of shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+		if err := c.Delete(ctx, &shoot); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) isNamespaceKept(ctx context.Context, namespaceName string) (bool, error) {
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	keepNamespace, _ := strconv.ParseBool(namespace.Annotations[v1beta1constants.NamespaceKeepAfterProjectDeletion])
+	return keepNamespace, nil
+}
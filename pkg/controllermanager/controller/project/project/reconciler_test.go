@@ -6,18 +6,29 @@ package project
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -210,10 +221,28 @@ var _ = Describe("Default Resource Quota", func() {
 
 			c.EXPECT().Create(gomock.Any(), expectedResourceQuota).Return(nil)
 
-			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, config)).To(Succeed())
+			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, &config, nil)).To(Succeed())
 		})
 
-		It("should update a existing ResourceQuota", func() {
+		It("should create a new ResourceQuota from the project overrides only", func() {
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: ResourceQuotaName}, gomock.AssignableToTypeOf(&corev1.ResourceQuota{})).
+				Return(apierrors.NewNotFound(corev1.Resource("resourcequota"), "resourcequota"))
+
+			c.EXPECT().Create(gomock.Any(), &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            ResourceQuotaName,
+					Namespace:       namespace,
+					OwnerReferences: []metav1.OwnerReference{*ownerRef},
+				},
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{shoots: resource.MustParse("20")},
+				},
+			}).Return(nil)
+
+			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, nil, corev1.ResourceList{shoots: resource.MustParse("20")})).To(Succeed())
+		})
+
+		It("should update a existing ResourceQuota and revert drift", func() {
 			config := config.QuotaConfiguration{
 				Config: resourceQuota,
 			}
@@ -227,7 +256,8 @@ var _ = Describe("Default Resource Quota", func() {
 				},
 				Spec: corev1.ResourceQuotaSpec{
 					Hard: map[corev1.ResourceName]resource.Quantity{
-						shoots: resource.MustParse("50"),
+						shoots:             resource.MustParse("50"),
+						"count/configmaps": resource.MustParse("5"),
 					},
 				},
 			}
@@ -242,11 +272,40 @@ var _ = Describe("Default Resource Quota", func() {
 			expectedResourceQuota.SetOwnerReferences([]metav1.OwnerReference{existingOwnerRef, *ownerRef})
 			expectedResourceQuota.ObjectMeta.Labels = map[string]string{"bar": "baz"}
 			expectedResourceQuota.ObjectMeta.Annotations = map[string]string{"foo": "bar"}
-			expectedResourceQuota.Spec.Hard[secrets] = quantity
+			expectedResourceQuota.Spec.Hard = corev1.ResourceList{
+				shoots:  quantity,
+				secrets: quantity,
+			}
 
 			c.EXPECT().Patch(gomock.Any(), expectedResourceQuota, gomock.Any()).Return(nil)
 
-			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, config)).To(Succeed())
+			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, &config, nil)).To(Succeed())
+		})
+
+		It("should apply the project overrides on top of the configured ResourceQuota", func() {
+			config := config.QuotaConfiguration{
+				Config: resourceQuota,
+			}
+
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: ResourceQuotaName}, gomock.AssignableToTypeOf(&corev1.ResourceQuota{})).
+				Return(apierrors.NewNotFound(corev1.Resource("resourcequota"), "resourcequota"))
+
+			expectedResourceQuota := resourceQuota.DeepCopy()
+			expectedResourceQuota.SetOwnerReferences([]metav1.OwnerReference{*ownerRef})
+			expectedResourceQuota.SetName(ResourceQuotaName)
+			expectedResourceQuota.SetNamespace(namespace)
+			expectedResourceQuota.Spec.Hard = corev1.ResourceList{
+				shoots:             resource.MustParse("100"),
+				secrets:            quantity,
+				"count/configmaps": resource.MustParse("5"),
+			}
+
+			c.EXPECT().Create(gomock.Any(), expectedResourceQuota).Return(nil)
+
+			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, &config, corev1.ResourceList{
+				shoots:             resource.MustParse("100"),
+				"count/configmaps": resource.MustParse("5"),
+			})).To(Succeed())
 		})
 
 		It("should fail because invalid ResourceQuota specified", func() {
@@ -254,7 +313,248 @@ var _ = Describe("Default Resource Quota", func() {
 				Config: nil,
 			}
 
-			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, config)).NotTo(Succeed())
+			Expect(createOrUpdateResourceQuota(ctx, c, namespace, ownerRef, &config, nil)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#limitRangeConfigurationForProject", func() {
+		var (
+			project    *gardencorev1beta1.Project
+			conf       config.ProjectControllerConfiguration
+			limitRange *corev1.LimitRange
+		)
+
+		BeforeEach(func() {
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   projectName,
+					Labels: map[string]string{"role": "foo"},
+				},
+			}
+			limitRange = &corev1.LimitRange{}
+			conf = config.ProjectControllerConfiguration{}
+		})
+
+		It("should return no limit range configuration because no limit range config is specified", func() {
+			Expect(limitRangeConfigurationForProject(conf, project)).To(BeNil())
+		})
+
+		It("should return no limit range configuration because label selector does not match project", func() {
+			conf.LimitRanges = []config.LimitRangeConfiguration{
+				{Config: limitRange, ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "bar"}}},
+			}
+			Expect(limitRangeConfigurationForProject(conf, project)).To(BeNil())
+		})
+
+		It("should return the first matching limit range configuration", func() {
+			conf.LimitRanges = []config.LimitRangeConfiguration{
+				{Config: limitRange, ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "bar"}}},
+				{Config: limitRange, ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"role": "foo"}}},
+				{Config: limitRange, ProjectSelector: &metav1.LabelSelector{}},
+			}
+			Expect(limitRangeConfigurationForProject(conf, project)).To(Equal(&conf.LimitRanges[1]))
+		})
+	})
+
+	Describe("#createOrUpdateLimitRange", func() {
+		var (
+			project    *gardencorev1beta1.Project
+			ownerRef   *metav1.OwnerReference
+			limitRange *corev1.LimitRange
+		)
+
+		BeforeEach(func() {
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: projectName,
+					UID:  "1",
+				},
+			}
+			ownerRef = metav1.NewControllerRef(project, gardencorev1beta1.SchemeGroupVersion.WithKind("Project"))
+			limitRange = &corev1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"bar": "baz"},
+				},
+				Spec: corev1.LimitRangeSpec{
+					Limits: []corev1.LimitRangeItem{{
+						Type:    corev1.LimitTypeContainer,
+						Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+					}},
+				},
+			}
+		})
+
+		It("should create a new LimitRange", func() {
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: LimitRangeName}, gomock.AssignableToTypeOf(&corev1.LimitRange{})).
+				Return(apierrors.NewNotFound(corev1.Resource("limitrange"), "limitrange"))
+
+			expectedLimitRange := limitRange.DeepCopy()
+			expectedLimitRange.SetOwnerReferences([]metav1.OwnerReference{*ownerRef})
+			expectedLimitRange.SetName(LimitRangeName)
+			expectedLimitRange.SetNamespace(namespace)
+
+			c.EXPECT().Create(gomock.Any(), expectedLimitRange).Return(nil)
+
+			Expect(createOrUpdateLimitRange(ctx, c, namespace, ownerRef, &config.LimitRangeConfiguration{Config: limitRange}, nil)).To(Succeed())
+		})
+
+		It("should update an existing LimitRange with the project overrides and revert drift", func() {
+			existingLimitRange := &corev1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      LimitRangeName,
+					Namespace: namespace,
+				},
+				Spec: corev1.LimitRangeSpec{
+					Limits: []corev1.LimitRangeItem{{Type: corev1.LimitTypePod}},
+				},
+			}
+			overrides := []corev1.LimitRangeItem{{
+				Type: corev1.LimitTypePersistentVolumeClaim,
+				Max:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			}}
+
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: LimitRangeName}, gomock.AssignableToTypeOf(&corev1.LimitRange{})).
+				DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.LimitRange, _ ...client.GetOption) error {
+					*obj = *existingLimitRange
+					return nil
+				})
+
+			expectedLimitRange := existingLimitRange.DeepCopy()
+			expectedLimitRange.SetOwnerReferences([]metav1.OwnerReference{*ownerRef})
+			expectedLimitRange.Labels = map[string]string{"bar": "baz"}
+			expectedLimitRange.Spec.Limits = overrides
+
+			c.EXPECT().Patch(gomock.Any(), expectedLimitRange, gomock.Any()).Return(nil)
+
+			Expect(createOrUpdateLimitRange(ctx, c, namespace, ownerRef, &config.LimitRangeConfiguration{Config: limitRange}, overrides)).To(Succeed())
+		})
+
+		It("should fail because invalid LimitRange specified", func() {
+			Expect(createOrUpdateLimitRange(ctx, c, namespace, ownerRef, &config.LimitRangeConfiguration{}, nil)).NotTo(Succeed())
+		})
+	})
+})
+
+var _ = Describe("Deletion Grace Period", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		namespaceName = "garden-foo"
+		project       *gardencorev1beta1.Project
+		namespace     *corev1.Namespace
+		shoot1        *gardencorev1beta1.Shoot
+		shoot2        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "foo",
+				UID:               "1",
+				Finalizers:        []string{gardencorev1beta1.GardenerName},
+				DeletionTimestamp: &metav1.Time{Time: fakeClock.Now()},
+			},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To(namespaceName),
+			},
+			Status: gardencorev1beta1.ProjectStatus{
+				Phase: gardencorev1beta1.ProjectReady,
+			},
+		}
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        namespaceName,
+				Labels:      namespaceLabelsFromProject(project),
+				Annotations: namespaceAnnotationsFromProject(project),
+			},
+		}
+		shoot1 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: namespaceName, Finalizers: []string{gardencorev1beta1.GardenerName}}}
+		shoot2 = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot2", Namespace: namespaceName},
+			Spec:       gardencorev1beta1.ShootSpec{Hibernation: &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(project, namespace, shoot1, shoot2,
+				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "gardener.cloud:system:project-member", Namespace: namespaceName}},
+			).
+			WithStatusSubresource(&gardencorev1beta1.Project{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Config:   config.ProjectControllerConfiguration{DeletionGracePeriodDays: ptr.To(7)},
+			Recorder: &record.FakeRecorder{},
+			Clock:    fakeClock,
+		}
+	})
+
+	It("should archive the project during the deletion grace period", func() {
+		fakeClock.Step(24 * time.Hour)
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: 6 * 24 * time.Hour}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Phase).To(Equal(gardencorev1beta1.ProjectArchived))
+		Expect(project.Finalizers).To(ConsistOf(gardencorev1beta1.GardenerName))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+		Expect(shoot1.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+		Expect(shoot1.DeletionTimestamp).To(BeNil())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardener.cloud:system:project-member", Namespace: namespaceName}, &rbacv1.RoleBinding{})).To(BeNotFoundError())
+	})
+
+	It("should delete the archived shoots after the deletion grace period", func() {
+		fakeClock.Step(7*24*time.Hour + time.Second)
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{Requeue: true}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		Expect(project.Status.Phase).To(Equal(gardencorev1beta1.ProjectTerminating))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+		Expect(shoot1.DeletionTimestamp).NotTo(BeNil())
+		Expect(shoot1.Annotations).To(HaveKeyWithValue(v1beta1constants.ConfirmationDeletion, "true"))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(BeNotFoundError())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.DeletionTimestamp).To(BeNil())
+	})
+
+	Context("namespace should be kept", func() {
+		BeforeEach(func() {
+			metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, v1beta1constants.NamespaceKeepAfterProjectDeletion, "true")
+		})
+
+		It("should release the namespace together with the archived shoots after the deletion grace period", func() {
+			fakeClock.Step(7*24*time.Hour + time.Second)
+
+			result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(project), project)).To(BeNotFoundError())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+			Expect(namespace.Labels).NotTo(HaveKey(v1beta1constants.ProjectName))
+			Expect(namespace.Annotations).NotTo(HaveKey(v1beta1constants.NamespaceProject))
 		})
 	})
 })
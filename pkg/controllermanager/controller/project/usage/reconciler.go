@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the usage report.
+	ConfigMapName = "usage-report"
+	// DataKeyReport is the key in the data of the usage report ConfigMap which contains the report in JSON format.
+	DataKeyReport = "report"
+	// VolumeTypeUnspecified is the key used in the report for volumes which do not specify a type.
+	VolumeTypeUnspecified = "unspecified"
+)
+
+// ProjectUsage is the usage of all Shoots of a project.
+type ProjectUsage struct {
+	// LastUpdateTime is the time when the report was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Shoots is the number of Shoots in the project.
+	Shoots int `json:"shoots"`
+	// HibernatedShoots is the number of hibernated Shoots in the project. Their worker pools are not counted.
+	HibernatedShoots int `json:"hibernatedShoots"`
+	// Nodes is the number of nodes of all worker pools.
+	Nodes NodeCount `json:"nodes"`
+	// MachineTypes is the number of nodes per machine type.
+	MachineTypes map[string]NodeCount `json:"machineTypes,omitempty"`
+	// VolumeTypes is the size of the root and data volumes of all nodes per volume type.
+	VolumeTypes map[string]VolumeSize `json:"volumeTypes,omitempty"`
+}
+
+// NodeCount is the minimum and maximum number of nodes according to the auto-scaling settings of the worker pools.
+type NodeCount struct {
+	// Minimum is the minimum number of nodes.
+	Minimum int32 `json:"minimum"`
+	// Maximum is the maximum number of nodes.
+	Maximum int32 `json:"maximum"`
+}
+
+// VolumeSize is the minimum and maximum size of volumes according to the auto-scaling settings of the worker pools.
+type VolumeSize struct {
+	// Minimum is the size of the volumes of the minimum number of nodes.
+	Minimum resource.Quantity `json:"minimum"`
+	// Maximum is the size of the volumes of the maximum number of nodes.
+	Maximum resource.Quantity `json:"maximum"`
+}
+
+// Reconciler aggregates the usage of all Shoots of a project and writes it to a ConfigMap in the project namespace.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile aggregates the usage of all Shoots of a project and writes it to a ConfigMap in the project namespace.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return reconcile.Result{}, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	report := computeReport(shootList.Items)
+	report.LastUpdateTime = metav1.NewTime(r.Clock.Now().UTC())
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed marshalling usage report: %w", err)
+	}
+
+	log.V(1).Info("Updating usage report", "shoots", report.Shoots)
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: *project.Spec.Namespace}}
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.Client, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, v1beta1constants.GardenRole, v1beta1constants.GardenRoleUsageReport)
+		configMap.Data = map[string]string{DataKeyReport: string(data)}
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating usage report ConfigMap: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.UsageReportSyncPeriod.Duration}, nil
+}
+
+func computeReport(shoots []gardencorev1beta1.Shoot) ProjectUsage {
+	report := ProjectUsage{
+		Shoots:       len(shoots),
+		MachineTypes: map[string]NodeCount{},
+		VolumeTypes:  map[string]VolumeSize{},
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Status.IsHibernated {
+			report.HibernatedShoots++
+			continue
+		}
+
+		for _, worker := range shoot.Spec.Provider.Workers {
+			report.Nodes.Minimum += worker.Minimum
+			report.Nodes.Maximum += worker.Maximum
+
+			machineType := report.MachineTypes[worker.Machine.Type]
+			machineType.Minimum += worker.Minimum
+			machineType.Maximum += worker.Maximum
+			report.MachineTypes[worker.Machine.Type] = machineType
+
+			if worker.Volume != nil {
+				addVolume(report.VolumeTypes, worker.Volume.Type, worker.Volume.VolumeSize, worker.Minimum, worker.Maximum)
+			}
+			for _, dataVolume := range worker.DataVolumes {
+				addVolume(report.VolumeTypes, dataVolume.Type, dataVolume.VolumeSize, worker.Minimum, worker.Maximum)
+			}
+		}
+	}
+
+	return report
+}
+
+func addVolume(volumeTypes map[string]VolumeSize, volumeType *string, size string, minimum, maximum int32) {
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return
+	}
+
+	name := ptr.Deref(volumeType, VolumeTypeUnspecified)
+	volumeSize, ok := volumeTypes[name]
+	if !ok {
+		volumeSize = VolumeSize{Minimum: *resource.NewQuantity(0, resource.BinarySI), Maximum: *resource.NewQuantity(0, resource.BinarySI)}
+	}
+	volumeSize.Minimum.Add(*resource.NewQuantity(quantity.Value()*int64(minimum), resource.BinarySI))
+	volumeSize.Maximum.Add(*resource.NewQuantity(quantity.Value()*int64(maximum), resource.BinarySI))
+	volumeTypes[name] = volumeSize
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/usage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		project   *gardencorev1beta1.Project
+		namespace = "garden-foo"
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ProjectControllerConfiguration{UsageReportSyncPeriod: &metav1.Duration{Duration: time.Hour}},
+			Clock:  fakeClock,
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		}
+	})
+
+	readReport := func() ProjectUsage {
+		configMap := &corev1.ConfigMap{}
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKey{Name: ConfigMapName, Namespace: namespace}, configMap)).To(Succeed())
+		ExpectWithOffset(1, configMap.Labels).To(HaveKeyWithValue("gardener.cloud/role", "usage-report"))
+
+		report := ProjectUsage{}
+		ExpectWithOffset(1, json.Unmarshal([]byte(configMap.Data[DataKeyReport]), &report)).To(Succeed())
+		return report
+	}
+
+	It("should do nothing if the project is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "foo"}})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the project has no namespace", func() {
+		project.Spec.Namespace = nil
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{}))
+
+		configMapList := &corev1.ConfigMapList{}
+		Expect(fakeClient.List(ctx, configMapList)).To(Succeed())
+		Expect(configMapList.Items).To(BeEmpty())
+	})
+
+	It("should write an empty report if the project has no shoots", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		report := readReport()
+		Expect(report.Shoots).To(BeZero())
+		Expect(report.Nodes).To(Equal(NodeCount{}))
+		Expect(report.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+	})
+
+	It("should aggregate the usage of all shoots", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				{
+					Name:    "worker1",
+					Machine: gardencorev1beta1.Machine{Type: "large"},
+					Minimum: 1,
+					Maximum: 3,
+					Volume:  &gardencorev1beta1.Volume{Type: ptr.To("fast"), VolumeSize: "50Gi"},
+					DataVolumes: []gardencorev1beta1.DataVolume{
+						{Name: "data", Type: ptr.To("slow"), VolumeSize: "100Gi"},
+					},
+				},
+				{
+					Name:    "worker2",
+					Machine: gardencorev1beta1.Machine{Type: "small"},
+					Minimum: 2,
+					Maximum: 2,
+					Volume:  &gardencorev1beta1.Volume{VolumeSize: "20Gi"},
+				},
+			}}},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot2", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				{
+					Name:    "worker1",
+					Machine: gardencorev1beta1.Machine{Type: "large"},
+					Minimum: 2,
+					Maximum: 4,
+					Volume:  &gardencorev1beta1.Volume{Type: ptr.To("fast"), VolumeSize: "50Gi"},
+				},
+			}}},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "hibernated", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				{Name: "worker1", Machine: gardencorev1beta1.Machine{Type: "large"}, Minimum: 5, Maximum: 5},
+			}}},
+			Status: gardencorev1beta1.ShootStatus{IsHibernated: true},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-project", Namespace: "garden-bar"},
+			Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+				{Name: "worker1", Machine: gardencorev1beta1.Machine{Type: "large"}, Minimum: 5, Maximum: 5},
+			}}},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+		report := readReport()
+		Expect(report.Shoots).To(Equal(3))
+		Expect(report.HibernatedShoots).To(Equal(1))
+		Expect(report.Nodes).To(Equal(NodeCount{Minimum: 5, Maximum: 9}))
+		Expect(report.MachineTypes).To(Equal(map[string]NodeCount{
+			"large": {Minimum: 3, Maximum: 7},
+			"small": {Minimum: 2, Maximum: 2},
+		}))
+		Expect(report.VolumeTypes).To(HaveLen(3))
+		fast, slow, unspecified := report.VolumeTypes["fast"], report.VolumeTypes["slow"], report.VolumeTypes[VolumeTypeUnspecified]
+		Expect(fast.Minimum.String()).To(Equal("150Gi"))
+		Expect(fast.Maximum.String()).To(Equal("350Gi"))
+		Expect(slow.Minimum.String()).To(Equal("100Gi"))
+		Expect(slow.Maximum.String()).To(Equal("300Gi"))
+		Expect(unspecified.Minimum.String()).To(Equal("40Gi"))
+		Expect(unspecified.Maximum.String()).To(Equal("40Gi"))
+	})
+
+	It("should update an existing report", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(readReport().Shoots).To(BeZero())
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: namespace}})).To(Succeed())
+		fakeClock.Step(time.Hour)
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		report := readReport()
+		Expect(report.Shoots).To(Equal(1))
+		Expect(report.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+	})
+})
@@ -12,8 +12,11 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupbucketscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/decommission"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/extensionscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/featuregates"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/lifecycle"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/restoration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/secrets"
 )
 
@@ -31,12 +34,28 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding extensions check reconciler: %w", err)
 	}
 
+	if err := (&featuregates.Reconciler{}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding feature gates reconciler: %w", err)
+	}
+
 	if err := (&lifecycle.Reconciler{
 		Config: *cfg.Controllers.Seed,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding lifecycle reconciler: %w", err)
 	}
 
+	if err := (&restoration.Reconciler{
+		Config: *cfg.Controllers.SeedRestoration,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding restoration reconciler: %w", err)
+	}
+
+	if err := (&decommission.Reconciler{
+		Config: *cfg.Controllers.SeedDecommission,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding decommission reconciler: %w", err)
+	}
+
 	if err := (&secrets.Reconciler{}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding secrets reconciler: %w", err)
 	}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package decommission_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/decommission"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+	})
+
+	Describe("#SeedPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.SeedPredicate()
+		})
+
+		It("should return false because seed is not being decommissioned", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeFalse())
+		})
+
+		It("should return true because seed is being decommissioned", func() {
+			seed.Spec.Operation = ptr.To(gardencorev1beta1.SeedOperationDecommission)
+
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeTrue())
+		})
+
+		It("should return true because seed still has a decommission status", func() {
+			seed.Status.Decommission = &gardencorev1beta1.SeedDecommissionStatus{}
+
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: seed})).To(BeTrue())
+			Expect(p.Generic(event.GenericEvent{Object: seed})).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package decommission
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// EventShootMigrationTriggered is an event reason for a triggered migration of a shoot away from a decommissioned
+	// seed.
+	EventShootMigrationTriggered = "ShootMigrationTriggered"
+	// EventShootMigrationSkipped is an event reason for a shoot which cannot be migrated automatically.
+	EventShootMigrationSkipped = "ShootMigrationSkipped"
+)
+
+// Reconciler reconciles Seeds with `.spec.operation=Decommission`. It triggers the rescheduling of all Shoots scheduled
+// to the Seed in batches of limited size. The gardener-scheduler then binds them to other eligible Seeds, and the
+// gardenlets perform the control plane migrations. The progress is reported in the status of the Seed.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.SeedDecommissionControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Seeds with `.spec.operation=Decommission`. It triggers the rescheduling of all Shoots scheduled
+// to the Seed in batches of limited size and reports the progress in the status of the Seed.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !v1beta1helper.IsSeedDecommissioning(seed) {
+		if seed.Status.Decommission == nil {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Seed is no longer being decommissioned, removing decommission status")
+		patch := client.MergeFrom(seed.DeepCopy())
+		seed.Status.Decommission = nil
+		return reconcile.Result{}, r.Client.Status().Patch(ctx, seed, patch)
+	}
+
+	progress, err := r.computeProgress(ctx, seed.Name)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	for _, shoot := range progress.skipped {
+		r.Recorder.Eventf(seed, corev1.EventTypeWarning, EventShootMigrationSkipped, "Shoot %s cannot be migrated automatically since it is not managed by the %s", client.ObjectKeyFromObject(shoot), v1beta1constants.DefaultSchedulerName)
+	}
+
+	for _, shoot := range progress.toTrigger(ptr.Deref(r.Config.ParallelShootMigrations, 0)) {
+		log.Info("Triggering rescheduling of shoot", "shoot", client.ObjectKeyFromObject(shoot))
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingReschedule, "true")
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed triggering rescheduling of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+
+		r.Recorder.Eventf(seed, corev1.EventTypeNormal, EventShootMigrationTriggered, "Triggered migration of shoot %s", client.ObjectKeyFromObject(shoot))
+		progress.pending = slices.DeleteFunc(progress.pending, func(s *gardencorev1beta1.Shoot) bool { return s == shoot })
+		progress.inProgress = append(progress.inProgress, shoot)
+	}
+
+	now := metav1.NewTime(r.Clock.Now())
+	patch := client.MergeFrom(seed.DeepCopy())
+	if seed.Status.Decommission == nil {
+		seed.Status.Decommission = &gardencorev1beta1.SeedDecommissionStatus{StartTime: now}
+	}
+	seed.Status.Decommission.LastUpdateTime = now
+	seed.Status.Decommission.RemainingShoots = int32(progress.remaining())
+	seed.Status.Decommission.MigratingShoots = int32(len(progress.inProgress))
+	seed.Status.Decommission.Message = ptr.To(progress.String())
+	if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching decommission status: %w", err)
+	}
+
+	if progress.remaining() > 0 {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	log.Info("Seed does not host any shoots anymore and can be deleted")
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) computeProgress(ctx context.Context, seedName string) (*decommissionProgress, error) {
+	progress := &decommissionProgress{}
+
+	shootsScheduledToSeed := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootsScheduledToSeed, client.MatchingFields{core.ShootSeedName: seedName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots scheduled to seed %s: %w", seedName, err)
+	}
+
+	for _, shoot := range shootsScheduledToSeed.Items {
+		switch {
+		case shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true":
+			progress.inProgress = append(progress.inProgress, shoot.DeepCopy())
+		case shoot.DeletionTimestamp != nil:
+			progress.deleting = append(progress.deleting, shoot.DeepCopy())
+		case ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) != v1beta1constants.DefaultSchedulerName:
+			progress.skipped = append(progress.skipped, shoot.DeepCopy())
+		default:
+			progress.pending = append(progress.pending, shoot.DeepCopy())
+		}
+	}
+
+	shootsHostedBySeed := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootsHostedBySeed, client.MatchingFields{core.ShootStatusSeedName: seedName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots hosted by seed %s: %w", seedName, err)
+	}
+
+	for _, shoot := range shootsHostedBySeed.Items {
+		// Shoots which are already scheduled to another seed are being migrated by the gardenlets.
+		if ptr.Deref(shoot.Spec.SeedName, "") != seedName {
+			progress.inProgress = append(progress.inProgress, shoot.DeepCopy())
+		}
+	}
+
+	return progress, nil
+}
+
+type decommissionProgress struct {
+	pending, inProgress, deleting, skipped []*gardencorev1beta1.Shoot
+}
+
+// toTrigger returns the pending shoots for which the rescheduling can be triggered without exceeding the given limit of
+// parallel migrations.
+func (p *decommissionProgress) toTrigger(limit int) []*gardencorev1beta1.Shoot {
+	free := limit - len(p.inProgress)
+	if free <= 0 {
+		return nil
+	}
+
+	slices.SortFunc(p.pending, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+	return slices.Clone(p.pending[:min(free, len(p.pending))])
+}
+
+func (p *decommissionProgress) remaining() int {
+	return len(p.pending) + len(p.inProgress) + len(p.deleting) + len(p.skipped)
+}
+
+func (p *decommissionProgress) String() string {
+	if p.remaining() == 0 {
+		return "Seed does not host any shoots anymore and can be deleted."
+	}
+	return fmt.Sprintf("%d shoots remaining, %d migrating, %d pending, %d deleting, %d skipped (not managed by the %s).", p.remaining(), len(p.inProgress), len(p.pending), len(p.deleting), len(p.skipped), v1beta1constants.DefaultSchedulerName)
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package decommission_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/decommission"
+)
+
+var _ = Describe("Reconciler", func() {
+	const syncPeriod = 30 * time.Second
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		seed *gardencorev1beta1.Seed
+	)
+
+	newShoot := func(name, specSeedName, statusSeedName string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "garden-project",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To(specSeedName),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName: ptr.To(statusSeedName),
+			},
+		}
+	}
+
+	getShoot := func(name string) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{}
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKey{Namespace: "garden-project", Name: name}, shoot)).To(Succeed())
+		return shoot
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		recorder = record.NewFakeRecorder(10)
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Operation: ptr.To(gardencorev1beta1.SeedOperationDecommission),
+			},
+		}
+
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(seed).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}, &gardencorev1beta1.Shoot{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: config.SeedDecommissionControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: syncPeriod},
+				ParallelShootMigrations: ptr.To(2),
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	It("should do nothing if the seed is not being decommissioned", func() {
+		seed.Spec.Operation = nil
+		Expect(c.Update(ctx, seed)).To(Succeed())
+		Expect(c.Create(ctx, newShoot("shoot", seed.Name, seed.Name))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(getShoot("shoot").Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Decommission).To(BeNil())
+	})
+
+	It("should remove the decommission status if the seed is no longer being decommissioned", func() {
+		seed.Spec.Operation = nil
+		Expect(c.Update(ctx, seed)).To(Succeed())
+		seed.Status.Decommission = &gardencorev1beta1.SeedDecommissionStatus{RemainingShoots: 1}
+		Expect(c.Status().Update(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Decommission).To(BeNil())
+	})
+
+	It("should report that an empty seed can be deleted", func() {
+		Expect(c.Create(ctx, newShoot("other", "other-seed", "other-seed"))).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Decommission).To(PointTo(MatchAllFields(Fields{
+			"StartTime":       Equal(metav1.NewTime(fakeClock.Now())),
+			"LastUpdateTime":  Equal(metav1.NewTime(fakeClock.Now())),
+			"RemainingShoots": BeEquivalentTo(0),
+			"MigratingShoots": BeEquivalentTo(0),
+			"Message":         PointTo(Equal("Seed does not host any shoots anymore and can be deleted.")),
+		})))
+	})
+
+	It("should trigger the rescheduling of shoots in batches and report the progress", func() {
+		for _, name := range []string{"shoot-c", "shoot-a", "shoot-b"} {
+			Expect(c.Create(ctx, newShoot(name, seed.Name, seed.Name))).To(Succeed())
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getShoot("shoot-a").Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingReschedule, "true"))
+		Expect(getShoot("shoot-b").Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingReschedule, "true"))
+		Expect(getShoot("shoot-c").Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Triggered migration of shoot garden-project/shoot-a")))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Triggered migration of shoot garden-project/shoot-b")))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		startTime := metav1.NewTime(fakeClock.Now())
+		Expect(seed.Status.Decommission).To(PointTo(MatchAllFields(Fields{
+			"StartTime":       Equal(startTime),
+			"LastUpdateTime":  Equal(startTime),
+			"RemainingShoots": BeEquivalentTo(3),
+			"MigratingShoots": BeEquivalentTo(2),
+			"Message":         PointTo(Equal("3 shoots remaining, 2 migrating, 1 pending, 0 deleting, 0 skipped (not managed by the default-scheduler).")),
+		})))
+
+		By("Simulate rescheduling of shoot-a by the scheduler")
+		shoot := getShoot("shoot-a")
+		shoot.Spec.SeedName = ptr.To("target")
+		delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingReschedule)
+		Expect(c.Update(ctx, shoot)).To(Succeed())
+
+		fakeClock.Step(syncPeriod)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getShoot("shoot-c").Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+
+		By("Simulate finished migration of shoot-a by the gardenlets")
+		shoot = getShoot("shoot-a")
+		shoot.Status.SeedName = ptr.To("target")
+		Expect(c.Status().Update(ctx, shoot)).To(Succeed())
+
+		fakeClock.Step(syncPeriod)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getShoot("shoot-c").Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationSchedulingReschedule, "true"))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Decommission).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"StartTime":       Equal(startTime),
+			"LastUpdateTime":  Equal(metav1.NewTime(fakeClock.Now())),
+			"RemainingShoots": BeEquivalentTo(2),
+			"MigratingShoots": BeEquivalentTo(2),
+		})))
+	})
+
+	It("should neither trigger the rescheduling of deleting shoots nor of shoots managed by another scheduler", func() {
+		deletingShoot := newShoot("deleting", seed.Name, seed.Name)
+		deletingShoot.Finalizers = []string{"gardener"}
+		Expect(c.Create(ctx, deletingShoot)).To(Succeed())
+		Expect(c.Delete(ctx, deletingShoot)).To(Succeed())
+
+		otherSchedulerShoot := newShoot("other-scheduler", seed.Name, seed.Name)
+		otherSchedulerShoot.Spec.SchedulerName = ptr.To("foo-scheduler")
+		Expect(c.Create(ctx, otherSchedulerShoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getShoot("deleting").Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+		Expect(getShoot("other-scheduler").Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Shoot garden-project/other-scheduler cannot be migrated automatically")))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Decommission).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"RemainingShoots": BeEquivalentTo(2),
+			"MigratingShoots": BeEquivalentTo(0),
+			"Message":         PointTo(Equal("2 shoots remaining, 0 migrating, 0 pending, 1 deleting, 1 skipped (not managed by the default-scheduler).")),
+		})))
+	})
+})
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensionscheck
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardener_controller_manager"
+
+var (
+	metricsFactory = promauto.With(runtimemetrics.Registry)
+
+	// ExtensionReady defines the gauge seed_extension_ready.
+	ExtensionReady = metricsFactory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "seed_extension_ready",
+			Help:      "Readiness of the extensions installed into a seed cluster (1 = ready, 0 = not ready) by ControllerInstallation. The reason label explains why an extension is not ready.",
+		},
+		[]string{"seed", "controller_installation", "controller_registration", "reason"},
+	)
+)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +50,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.Name)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -105,6 +107,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	// drop the series of ControllerInstallations which are gone or whose reason has changed
+	deleteMetrics(seed.Name)
+	for _, controllerInstallation := range controllerInstallationList.Items {
+		reason := extensionReadyReason(controllerInstallation.Name, notValid, notInstalled, notHealthy, progressing)
+
+		ready := 0.0
+		if reason == ReasonExtensionReady {
+			ready = 1
+		}
+		ExtensionReady.WithLabelValues(seed.Name, controllerInstallation.Name, controllerInstallation.Spec.RegistrationRef.Name, reason).Set(ready)
+	}
+
 	condition := helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedExtensionsReady)
 	extensionsReadyThreshold := utils.GetThresholdForCondition(r.Config.ConditionThresholds, gardencorev1beta1.SeedExtensionsReady)
 
@@ -127,3 +141,40 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
+
+const (
+	// ReasonExtensionReady is the reason of ready extensions in the seed_extension_ready metric.
+	ReasonExtensionReady = "Ready"
+	// ReasonExtensionNotValid is the reason of invalid extensions in the seed_extension_ready metric.
+	ReasonExtensionNotValid = "NotValid"
+	// ReasonExtensionNotInstalled is the reason of extensions which are not installed in the seed_extension_ready metric.
+	ReasonExtensionNotInstalled = "NotInstalled"
+	// ReasonExtensionNotHealthy is the reason of unhealthy extensions in the seed_extension_ready metric.
+	ReasonExtensionNotHealthy = "NotHealthy"
+	// ReasonExtensionProgressing is the reason of progressing extensions in the seed_extension_ready metric.
+	ReasonExtensionProgressing = "Progressing"
+)
+
+// extensionReadyReason returns the reason for the readiness of the given ControllerInstallation. It uses the same
+// precedence as the ExtensionsReady condition.
+func extensionReadyReason(name string, notValid, notInstalled, notHealthy, progressing map[string]string) string {
+	for _, r := range []struct {
+		reason       string
+		installation map[string]string
+	}{
+		{ReasonExtensionNotValid, notValid},
+		{ReasonExtensionNotInstalled, notInstalled},
+		{ReasonExtensionNotHealthy, notHealthy},
+		{ReasonExtensionProgressing, progressing},
+	} {
+		if _, ok := r.installation[name]; ok {
+			return r.reason
+		}
+	}
+
+	return ReasonExtensionReady
+}
+
+func deleteMetrics(seedName string) {
+	ExtensionReady.DeletePartialMatch(prometheus.Labels{"seed": seedName})
+}
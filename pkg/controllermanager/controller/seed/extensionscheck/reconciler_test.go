@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclock "k8s.io/utils/clock/testing"
@@ -92,6 +93,18 @@ var _ = Describe("Reconciler", func() {
 		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
 	})
 
+	It("should delete the metrics once the Seed is gone", func() {
+		ExtensionReady.WithLabelValues(seedName, "foo-1", "foo", ReasonExtensionReady).Set(1)
+		ExtensionReady.WithLabelValues("other-seed", "bar-1", "bar", ReasonExtensionReady).Set(1)
+		DeferCleanup(ExtensionReady.Reset)
+
+		Expect(c.Delete(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(testutil.CollectAndCount(ExtensionReady)).To(Equal(1))
+		Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues("other-seed", "bar-1", "bar", ReasonExtensionReady))).To(Equal(1.0))
+	})
+
 	Context("no ControllerInstallations exist", func() {
 		It("should set ExtensionsReady to True (AllExtensionsReady)", func() {
 			result, err := reconciler.Reconcile(ctx, request)
@@ -120,6 +133,10 @@ var _ = Describe("Reconciler", func() {
 
 		It("should set ExtensionsReady to False (NotAllExtensionsInstalled)", func() {
 			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+			Expect(testutil.CollectAndCount(ExtensionReady)).To(Equal(2))
+			Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues(seedName, "foo-1", "", ReasonExtensionNotInstalled))).To(BeZero())
+			Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues(seedName, "foo-3", "", ReasonExtensionNotInstalled))).To(BeZero())
 		})
 	})
 
@@ -128,6 +145,7 @@ var _ = Describe("Reconciler", func() {
 			c1 := &gardencorev1beta1.ControllerInstallation{}
 			c1.SetName("foo-1")
 			c1.Spec.SeedRef.Name = seedName
+			c1.Spec.RegistrationRef.Name = "foo"
 			c1.Status.Conditions = []gardencorev1beta1.Condition{
 				{Type: "Valid", Status: gardencorev1beta1.ConditionTrue},
 				{Type: "Installed", Status: gardencorev1beta1.ConditionTrue},
@@ -145,6 +163,10 @@ var _ = Describe("Reconciler", func() {
 
 		It("should set ExtensionsReady to True (AllExtensionsReady)", func() {
 			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+			Expect(testutil.CollectAndCount(ExtensionReady)).To(Equal(2))
+			Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues(seedName, "foo-1", "foo", ReasonExtensionReady))).To(Equal(1.0))
+			Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues(seedName, "foo-2", "foo", ReasonExtensionReady))).To(Equal(1.0))
 		})
 
 		It("should update ExtensionsReady condition if it already exists", func() {
@@ -164,11 +186,12 @@ var _ = Describe("Reconciler", func() {
 	})
 
 	Context("when ControllerInstallation conditions are not successful", func() {
-		var tests = func(failedCondition gardencorev1beta1.Condition, reason, message string) {
+		var tests = func(failedCondition gardencorev1beta1.Condition, reason, message, metricReason string) {
 			BeforeEach(func() {
 				c1 := &gardencorev1beta1.ControllerInstallation{}
 				c1.SetName("foo-1")
 				c1.Spec.SeedRef.Name = seedName
+				c1.Spec.RegistrationRef.Name = "foo"
 				c1.Status.Conditions = []gardencorev1beta1.Condition{
 					{Type: "Valid", Status: gardencorev1beta1.ConditionTrue},
 					{Type: "Installed", Status: gardencorev1beta1.ConditionTrue},
@@ -180,6 +203,7 @@ var _ = Describe("Reconciler", func() {
 
 				c2 := c1.DeepCopy()
 				c2.SetName("foo-2")
+				c2.Spec.RegistrationRef.Name = "bar"
 				for i, condition := range c2.Status.Conditions {
 					if condition.Type == failedCondition.Type {
 						c2.Status.Conditions[i].Status = failedCondition.Status
@@ -194,6 +218,15 @@ var _ = Describe("Reconciler", func() {
 				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
 			})
 
+			It("should report the readiness of each extension as metric", func() {
+				matchExpectedCondition = matchConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionFalse, reason, message)
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(testutil.CollectAndCount(ExtensionReady)).To(Equal(2))
+				Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues(seedName, "foo-1", "foo", ReasonExtensionReady))).To(Equal(1.0))
+				Expect(testutil.ToFloat64(ExtensionReady.WithLabelValues(seedName, "foo-2", "bar", metricReason))).To(BeZero())
+			})
+
 			Context("when ExtensionsReady condition threshold is set", func() {
 				BeforeEach(func() {
 					conf := config.SeedExtensionsCheckControllerConfiguration{
@@ -249,6 +282,7 @@ var _ = Describe("Reconciler", func() {
 				gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationValid, Status: gardencorev1beta1.ConditionFalse},
 				"NotAllExtensionsValid",
 				`Some extensions are not valid: map[foo-2:]`,
+				ReasonExtensionNotValid,
 			)
 		})
 
@@ -257,6 +291,7 @@ var _ = Describe("Reconciler", func() {
 				gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationInstalled, Status: gardencorev1beta1.ConditionFalse},
 				"NotAllExtensionsInstalled",
 				`Some extensions are not installed: map[foo-2:]`,
+				ReasonExtensionNotInstalled,
 			)
 		})
 
@@ -265,6 +300,7 @@ var _ = Describe("Reconciler", func() {
 				gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationHealthy, Status: gardencorev1beta1.ConditionFalse},
 				"NotAllExtensionsHealthy",
 				`Some extensions are not healthy: map[foo-2:]`,
+				ReasonExtensionNotHealthy,
 			)
 		})
 
@@ -273,6 +309,7 @@ var _ = Describe("Reconciler", func() {
 				gardencorev1beta1.Condition{Type: gardencorev1beta1.ControllerInstallationProgressing, Status: gardencorev1beta1.ConditionTrue},
 				"SomeExtensionsProgressing",
 				`Some extensions are progressing: map[foo-2:]`,
+				ReasonExtensionProgressing,
 			)
 		})
 	})
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregates_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/featuregates"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#FeatureGatesChanged", func() {
+		var (
+			p       predicate.Predicate
+			oldSeed *gardencorev1beta1.Seed
+			newSeed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).FeatureGatesChanged()
+			oldSeed = &gardencorev1beta1.Seed{
+				Status: gardencorev1beta1.SeedStatus{
+					FeatureGates: []gardencorev1beta1.FeatureGateStatus{{Name: "Foo", Enabled: true}},
+				},
+			}
+			newSeed = oldSeed.DeepCopy()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: newSeed})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: newSeed})).To(BeTrue())
+		})
+
+		It("should return false if the feature gates did not change", func() {
+			newSeed.Status.ObservedGeneration = 2
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: newSeed})).To(BeFalse())
+		})
+
+		It("should return true if the feature gates changed", func() {
+			newSeed.Status.FeatureGates[0].Enabled = false
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSeed, ObjectNew: newSeed})).To(BeTrue())
+		})
+	})
+})
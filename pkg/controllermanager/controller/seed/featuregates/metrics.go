@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregates
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardener_controller_manager"
+
+var (
+	metricsFactory = promauto.With(runtimemetrics.Registry)
+
+	// FeatureGateEnabled defines the gauge seed_feature_gate_enabled.
+	FeatureGateEnabled = metricsFactory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "seed_feature_gate_enabled",
+			Help:      "State of the feature gates of the gardenlets (1 = enabled, 0 = disabled) as reported in the status of their seeds.",
+		},
+		[]string{"seed", "feature_gate", "lock_to_default"},
+	)
+)
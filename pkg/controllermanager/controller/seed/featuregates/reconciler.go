@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregates
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// Reconciler reconciles Seeds and exports the states of the feature gates reported by the responsible gardenlets as
+// metrics. This allows auditing the feature gate configuration across all seeds of the landscape.
+type Reconciler struct {
+	Client client.Client
+}
+
+// Reconcile reconciles Seeds and exports the states of the feature gates reported by the responsible gardenlets as
+// metrics.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// drop the series of feature gates which are gone or whose lock status has changed
+	deleteMetrics(seed.Name)
+	for _, featureGate := range seed.Status.FeatureGates {
+		enabled := 0.0
+		if featureGate.Enabled {
+			enabled = 1
+		}
+		FeatureGateEnabled.WithLabelValues(seed.Name, featureGate.Name, strconv.FormatBool(featureGate.LockToDefault)).Set(enabled)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func deleteMetrics(seedName string) {
+	FeatureGateEnabled.DeletePartialMatch(prometheus.Labels{"seed": seedName})
+}
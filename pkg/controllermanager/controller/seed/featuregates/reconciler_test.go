@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregates_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/featuregates"
+)
+
+var _ = Describe("Reconciler", func() {
+	const seedName = "test"
+
+	var (
+		ctx        context.Context
+		c          client.Client
+		seed       *gardencorev1beta1.Seed
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Status: gardencorev1beta1.SeedStatus{
+				FeatureGates: []gardencorev1beta1.FeatureGateStatus{
+					{Name: "Foo", Enabled: true},
+					{Name: "Bar", Enabled: false, LockToDefault: true},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		reconciler = &Reconciler{Client: c}
+
+		FeatureGateEnabled.WithLabelValues("other-seed", "Foo", "false").Set(0)
+		DeferCleanup(FeatureGateEnabled.Reset)
+	})
+
+	It("should export the feature gates of the seed", func() {
+		Expect(c.Create(ctx, seed)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.CollectAndCount(FeatureGateEnabled)).To(Equal(3))
+		Expect(testutil.ToFloat64(FeatureGateEnabled.WithLabelValues(seedName, "Foo", "false"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(FeatureGateEnabled.WithLabelValues(seedName, "Bar", "true"))).To(BeZero())
+	})
+
+	It("should drop the series of feature gates which are no longer reported", func() {
+		FeatureGateEnabled.WithLabelValues(seedName, "Baz", "false").Set(1)
+		Expect(c.Create(ctx, seed)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.CollectAndCount(FeatureGateEnabled)).To(Equal(3))
+	})
+
+	It("should delete the metrics of the seed when it is gone", func() {
+		FeatureGateEnabled.WithLabelValues(seedName, "Foo", "false").Set(1)
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.CollectAndCount(FeatureGateEnabled)).To(Equal(1))
+		Expect(testutil.ToFloat64(FeatureGateEnabled.WithLabelValues("other-seed", "Foo", "false"))).To(BeZero())
+	})
+})
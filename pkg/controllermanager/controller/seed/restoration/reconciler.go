@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restoration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/utils"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// EventShootRestorationTriggered is an event reason for a triggered restoration of a shoot.
+	EventShootRestorationTriggered = "ShootRestorationTriggered"
+	// EventShootRestorationSkipped is an event reason for a shoot which cannot be restored.
+	EventShootRestorationSkipped = "ShootRestorationSkipped"
+)
+
+// Reconciler reconciles destroyed Seeds annotated with the name of a replacement Seed. It restores the control planes
+// of all Shoots hosted by the destroyed Seed on the replacement Seed by force-migrating them in batches of limited size
+// and reports the progress in the ShootsRestored condition of the destroyed Seed.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.SeedRestorationControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles destroyed Seeds annotated with the name of a replacement Seed. It restores the control planes
+// of all Shoots hosted by the destroyed Seed on the replacement Seed by force-migrating them in batches of limited size
+// and reports the progress in the ShootsRestored condition of the destroyed Seed.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	targetSeedName := seed.Annotations[v1beta1constants.AnnotationSeedRestoreShootsTo]
+	if targetSeedName == "" {
+		return reconcile.Result{}, nil
+	}
+	log = log.WithValues("targetSeed", targetSeedName)
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedShootsRestored)
+
+	if msg, err := r.checkRestorationPossible(ctx, seed, targetSeedName); err != nil {
+		return reconcile.Result{}, err
+	} else if msg != "" {
+		log.Info("Restoration of shoots is not possible", "reason", msg)
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "RestorationNotPossible", msg)
+		if err := utils.PatchSeedCondition(ctx, log, r.Client.Status(), seed, condition); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	progress, err := r.computeProgress(ctx, seed.Name, targetSeedName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	for _, shoot := range progress.skipped {
+		r.Recorder.Eventf(seed, corev1.EventTypeWarning, EventShootRestorationSkipped, "Shoot %s cannot be restored since its ShootState does not exist", client.ObjectKeyFromObject(shoot))
+	}
+
+	for _, shoot := range progress.toTrigger(ptr.Deref(r.Config.ParallelShootRestorations, 0)) {
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+		if err := r.triggerRestoration(ctx, shootLog, shoot, seed.Name, targetSeedName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed triggering restoration of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+		r.Recorder.Eventf(seed, corev1.EventTypeNormal, EventShootRestorationTriggered, "Triggered restoration of shoot %s on seed %s", client.ObjectKeyFromObject(shoot), targetSeedName)
+		progress.pending = slices.DeleteFunc(progress.pending, func(s *gardencorev1beta1.Shoot) bool { return s == shoot })
+		progress.inProgress = append(progress.inProgress, shoot)
+	}
+
+	switch {
+	case !progress.done():
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionProgressing, "RestorationProgressing", progress.String())
+	case len(progress.failed) > 0:
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "RestorationFailed", progress.String())
+	default:
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "RestorationSucceeded", progress.String())
+	}
+
+	if err := utils.PatchSeedCondition(ctx, log, r.Client.Status(), seed, condition); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if condition.Status != gardencorev1beta1.ConditionTrue {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	log.Info("Restoration of shoots succeeded, removing restoration annotations")
+	for _, shoot := range progress.restored {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.AnnotationShootRestoredFrom)
+		if err := r.Client.Patch(ctx, shoot, patch); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing annotation from shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+	}
+
+	patch := client.MergeFrom(seed.DeepCopy())
+	delete(seed.Annotations, v1beta1constants.AnnotationSeedRestoreShootsTo)
+	return reconcile.Result{}, r.Client.Patch(ctx, seed, patch)
+}
+
+// checkRestorationPossible returns a message explaining why the restoration cannot be started. An empty message means
+// that the restoration is possible.
+func (r *Reconciler) checkRestorationPossible(ctx context.Context, seed *gardencorev1beta1.Seed, targetSeedName string) (string, error) {
+	if targetSeedName == seed.Name {
+		return "Shoots cannot be restored to the same seed.", nil
+	}
+
+	if condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); condition != nil && condition.Status == gardencorev1beta1.ConditionTrue {
+		return fmt.Sprintf("Condition %s is %s, shoots of a seed with a running gardenlet must be migrated regularly.", gardencorev1beta1.SeedGardenletReady, gardencorev1beta1.ConditionTrue), nil
+	}
+
+	targetSeed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: targetSeedName}, targetSeed); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		return fmt.Sprintf("Target seed %s does not exist.", targetSeedName), nil
+	}
+
+	if targetSeed.DeletionTimestamp != nil {
+		return fmt.Sprintf("Target seed %s is being deleted.", targetSeedName), nil
+	}
+
+	if condition := v1beta1helper.GetCondition(targetSeed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); condition == nil || condition.Status != gardencorev1beta1.ConditionTrue {
+		return fmt.Sprintf("Condition %s of target seed %s is not %s.", gardencorev1beta1.SeedGardenletReady, targetSeedName, gardencorev1beta1.ConditionTrue), nil
+	}
+
+	return "", nil
+}
+
+func (r *Reconciler) computeProgress(ctx context.Context, sourceSeedName, targetSeedName string) (*restorationProgress, error) {
+	progress := &restorationProgress{}
+
+	shootsOnSourceSeed := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootsOnSourceSeed, client.MatchingFields{core.ShootStatusSeedName: sourceSeedName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots on seed %s: %w", sourceSeedName, err)
+	}
+
+	for _, shoot := range shootsOnSourceSeed.Items {
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(&shoot), &gardencorev1beta1.ShootState{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed reading ShootState for shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+			}
+			progress.skipped = append(progress.skipped, shoot.DeepCopy())
+			continue
+		}
+		progress.pending = append(progress.pending, shoot.DeepCopy())
+	}
+
+	shootsOnTargetSeed := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootsOnTargetSeed, client.MatchingFields{core.ShootStatusSeedName: targetSeedName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots on seed %s: %w", targetSeedName, err)
+	}
+
+	for _, shoot := range shootsOnTargetSeed.Items {
+		if shoot.Annotations[v1beta1constants.AnnotationShootRestoredFrom] != sourceSeedName {
+			continue
+		}
+
+		lastOperation := shoot.Status.LastOperation
+		switch {
+		case lastOperation != nil && lastOperation.Type != gardencorev1beta1.LastOperationTypeMigrate && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded:
+			progress.restored = append(progress.restored, shoot.DeepCopy())
+		case lastOperation != nil && lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore && lastOperation.State == gardencorev1beta1.LastOperationStateFailed:
+			progress.failed = append(progress.failed, shoot.DeepCopy())
+		default:
+			progress.inProgress = append(progress.inProgress, shoot.DeepCopy())
+		}
+	}
+
+	return progress, nil
+}
+
+// triggerRestoration force-migrates the given shoot and its backup entries from the destroyed source seed to the
+// target seed. The gardenlet of the target seed then restores the shoot control plane based on the ShootState and the
+// backups since the last operation is a succeeded migration.
+func (r *Reconciler) triggerRestoration(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, sourceSeedName, targetSeedName string) error {
+	log.Info("Triggering restoration of shoot")
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootRestoredFrom, sourceSeedName)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed adding annotation: %w", err)
+	}
+
+	if err := r.migrateBackupEntries(ctx, log, shoot, sourceSeedName); err != nil {
+		return err
+	}
+
+	shoot.Spec.SeedName = &targetSeedName
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return fmt.Errorf("failed binding shoot to target seed: %w", err)
+	}
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.SeedName = &targetSeedName
+	shoot.Status.LastErrors = nil
+	shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+		Type:           gardencorev1beta1.LastOperationTypeMigrate,
+		State:          gardencorev1beta1.LastOperationStateSucceeded,
+		Progress:       100,
+		Description:    fmt.Sprintf("Shoot cluster state has been forcefully migrated since seed %s was destroyed.", sourceSeedName),
+		LastUpdateTime: metav1.NewTime(r.Clock.Now()),
+	}
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching shoot status: %w", err)
+	}
+
+	return nil
+}
+
+// migrateBackupEntries marks the backup entries of the given shoot which are still managed by the destroyed source seed
+// as migrated. This allows the gardenlet of the target seed to restore them during the restoration of the shoot.
+func (r *Reconciler) migrateBackupEntries(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, sourceSeedName string) error {
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := r.Client.List(ctx, backupEntryList, client.InNamespace(shoot.Namespace)); err != nil {
+		return fmt.Errorf("failed listing backup entries: %w", err)
+	}
+
+	for _, backupEntry := range backupEntryList.Items {
+		if !metav1.IsControlledBy(&backupEntry, shoot) || ptr.Deref(backupEntry.Status.SeedName, ptr.Deref(backupEntry.Spec.SeedName, "")) != sourceSeedName {
+			continue
+		}
+
+		log.Info("Marking backup entry as migrated", "backupEntry", client.ObjectKeyFromObject(&backupEntry))
+
+		patch := client.MergeFrom(backupEntry.DeepCopy())
+		backupEntry.Status.SeedName = nil
+		backupEntry.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:           gardencorev1beta1.LastOperationTypeMigrate,
+			State:          gardencorev1beta1.LastOperationStateSucceeded,
+			Progress:       100,
+			Description:    fmt.Sprintf("BackupEntry has been forcefully migrated since seed %s was destroyed.", sourceSeedName),
+			LastUpdateTime: metav1.NewTime(r.Clock.Now()),
+		}
+		if err := r.Client.Status().Patch(ctx, &backupEntry, patch); err != nil {
+			return fmt.Errorf("failed patching status of backup entry %s: %w", client.ObjectKeyFromObject(&backupEntry), err)
+		}
+	}
+
+	return nil
+}
+
+type restorationProgress struct {
+	pending, inProgress, restored, failed, skipped []*gardencorev1beta1.Shoot
+}
+
+// toTrigger returns the pending shoots for which the restoration can be triggered without exceeding the given limit of
+// parallel restorations.
+func (p *restorationProgress) toTrigger(limit int) []*gardencorev1beta1.Shoot {
+	free := limit - len(p.inProgress)
+	if free <= 0 {
+		return nil
+	}
+
+	slices.SortFunc(p.pending, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+	return slices.Clone(p.pending[:min(free, len(p.pending))])
+}
+
+func (p *restorationProgress) done() bool {
+	return len(p.pending) == 0 && len(p.inProgress) == 0
+}
+
+func (p *restorationProgress) String() string {
+	total := len(p.pending) + len(p.inProgress) + len(p.restored) + len(p.failed) + len(p.skipped)
+	return fmt.Sprintf("%d/%d shoots restored, %d in progress, %d pending, %d failed, %d skipped.", len(p.restored), total, len(p.inProgress), len(p.pending), len(p.failed), len(p.skipped))
+}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restoration_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/restoration"
+)
+
+var _ = Describe("Reconciler", func() {
+	const syncPeriod = 30 * time.Second
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		sourceSeed *gardencorev1beta1.Seed
+		targetSeed *gardencorev1beta1.Seed
+	)
+
+	newShoot := func(name, seedName string, withShootState bool) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "garden-project",
+				UID:       types.UID(name),
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To(seedName),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName: ptr.To(seedName),
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+		ExpectWithOffset(1, c.Create(ctx, shoot)).To(Succeed())
+
+		if withShootState {
+			ExpectWithOffset(1, c.Create(ctx, &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shoot.Namespace}})).To(Succeed())
+		}
+
+		return shoot
+	}
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(sourceSeed), sourceSeed)).To(Succeed())
+		return v1beta1helper.GetCondition(sourceSeed.Status.Conditions, gardencorev1beta1.SeedShootsRestored)
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		recorder = record.NewFakeRecorder(32)
+
+		sourceSeed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "source",
+				Annotations: map[string]string{v1beta1constants.AnnotationSeedRestoreShootsTo: "target"},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionUnknown}},
+			},
+		}
+		targetSeed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "target",
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+			},
+		}
+
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(sourceSeed, targetSeed).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}, &gardencorev1beta1.Shoot{}, &gardencorev1beta1.BackupEntry{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName == "binding" {
+						return c.Update(ctx, obj)
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: config.SeedRestorationControllerConfiguration{
+				SyncPeriod:                &metav1.Duration{Duration: syncPeriod},
+				ParallelShootRestorations: ptr.To(2),
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(sourceSeed)}
+	})
+
+	It("should do nothing if the seed is not annotated", func() {
+		delete(sourceSeed.Annotations, v1beta1constants.AnnotationSeedRestoreShootsTo)
+		Expect(c.Update(ctx, sourceSeed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getCondition()).To(BeNil())
+	})
+
+	DescribeTable("should not restore the shoots",
+		func(mutate func(), message string) {
+			mutate()
+			shoot := newShoot("shoot", "source", true)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Reason":  Equal("RestorationNotPossible"),
+				"Message": ContainSubstring(message),
+			})))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.SeedName).To(PointTo(Equal("source")))
+		},
+
+		Entry("when the target is the seed itself", func() {
+			sourceSeed.Annotations[v1beta1constants.AnnotationSeedRestoreShootsTo] = "source"
+			Expect(c.Update(ctx, sourceSeed)).To(Succeed())
+		}, "same seed"),
+		Entry("when the gardenlet of the seed is ready", func() {
+			sourceSeed.Status.Conditions[0].Status = gardencorev1beta1.ConditionTrue
+			Expect(c.Status().Update(ctx, sourceSeed)).To(Succeed())
+		}, "must be migrated regularly"),
+		Entry("when the target seed does not exist", func() {
+			Expect(c.Delete(ctx, targetSeed)).To(Succeed())
+		}, "does not exist"),
+		Entry("when the gardenlet of the target seed is not ready", func() {
+			targetSeed.Status.Conditions[0].Status = gardencorev1beta1.ConditionUnknown
+			Expect(c.Status().Update(ctx, targetSeed)).To(Succeed())
+		}, "is not True"),
+	)
+
+	It("should trigger the restoration of the shoots in batches and report the progress", func() {
+		shoot1 := newShoot("shoot1", "source", true)
+		shoot2 := newShoot("shoot2", "source", true)
+		shoot3 := newShoot("shoot3", "source", true)
+		shoot4 := newShoot("shoot4", "source", false)
+		otherShoot := newShoot("other", "target", true)
+
+		backupEntry := &gardencorev1beta1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "backupentry1",
+				Namespace:       shoot1.Namespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(shoot1, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))},
+			},
+			Spec:   gardencorev1beta1.BackupEntrySpec{SeedName: ptr.To("source")},
+			Status: gardencorev1beta1.BackupEntryStatus{SeedName: ptr.To("source")},
+		}
+		Expect(c.Create(ctx, backupEntry)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionProgressing),
+			"Reason":  Equal("RestorationProgressing"),
+			"Message": Equal("0/4 shoots restored, 2 in progress, 1 pending, 0 failed, 1 skipped."),
+		})))
+
+		for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2} {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationShootRestoredFrom, "source"))
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal("target")))
+			Expect(shoot.Status.SeedName).To(PointTo(Equal("target")))
+			Expect(shoot.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(gardencorev1beta1.LastOperationTypeMigrate),
+				"State": Equal(gardencorev1beta1.LastOperationStateSucceeded),
+			})))
+		}
+
+		for _, shoot := range []*gardencorev1beta1.Shoot{shoot3, shoot4} {
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootRestoredFrom))
+			Expect(shoot.Status.SeedName).To(PointTo(Equal("source")))
+		}
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(otherShoot), otherShoot)).To(Succeed())
+		Expect(otherShoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootRestoredFrom))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
+		Expect(backupEntry.Status.SeedName).To(BeNil())
+		Expect(backupEntry.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(gardencorev1beta1.LastOperationTypeMigrate),
+			"State": Equal(gardencorev1beta1.LastOperationStateSucceeded),
+		})))
+
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventShootRestorationSkipped)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventShootRestorationTriggered)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(EventShootRestorationTriggered)))
+
+		By("Do not trigger further restorations while the limit is reached")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot3), shoot3)).To(Succeed())
+		Expect(shoot3.Status.SeedName).To(PointTo(Equal("source")))
+
+		By("Trigger next restoration after a shoot was restored")
+		shoot1.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateSucceeded}
+		Expect(c.Status().Update(ctx, shoot1)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot3), shoot3)).To(Succeed())
+		Expect(shoot3.Status.SeedName).To(PointTo(Equal("target")))
+		Expect(getCondition().Message).To(Equal("1/4 shoots restored, 2 in progress, 0 pending, 0 failed, 1 skipped."))
+	})
+
+	It("should report failed restorations", func() {
+		shoot := newShoot("shoot", "target", true)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootRestoredFrom, "source")
+		Expect(c.Update(ctx, shoot)).To(Succeed())
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeRestore, State: gardencorev1beta1.LastOperationStateFailed}
+		Expect(c.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionFalse),
+			"Reason":  Equal("RestorationFailed"),
+			"Message": Equal("0/1 shoots restored, 0 in progress, 0 pending, 1 failed, 0 skipped."),
+		})))
+		Expect(sourceSeed.Annotations).To(HaveKey(v1beta1constants.AnnotationSeedRestoreShootsTo))
+	})
+
+	It("should finish the restoration when all shoots were restored", func() {
+		shoot := newShoot("shoot", "target", true)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootRestoredFrom, "source")
+		Expect(c.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionTrue),
+			"Reason":  Equal("RestorationSucceeded"),
+			"Message": Equal("1/1 shoots restored, 0 in progress, 0 pending, 0 failed, 0 skipped."),
+		})))
+		Expect(sourceSeed.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSeedRestoreShootsTo))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationShootRestoredFrom))
+	})
+})
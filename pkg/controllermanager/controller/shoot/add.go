@@ -11,17 +11,28 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/certificatetransparency"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reconciliationpause"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/versionpolicy"
 )
 
 // AddToManager adds all Shoot controllers to the given manager.
 func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration) error {
+	if config := cfg.Controllers.ShootCertificateTransparency; config != nil {
+		if err := (&certificatetransparency.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding certificate transparency reconciler: %w", err)
+		}
+	}
+
 	if err := (&conditions.Reconciler{
 		Config: *cfg.Controllers.ShootConditions,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -40,12 +51,24 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding maintenance reconciler: %w", err)
 	}
 
+	if err := (&maintenance.PlannedChangesReconciler{
+		Config: cfg.Controllers.ShootMaintenance,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding maintenance planned changes reconciler: %w", err)
+	}
+
 	if err := (&quota.Reconciler{
 		Config: *cfg.Controllers.ShootQuota,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding quota reconciler: %w", err)
 	}
 
+	if err := (&reconciliationpause.Reconciler{
+		Config: *cfg.Controllers.ShootReconciliationPause,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding reconciliation pause reconciler: %w", err)
+	}
+
 	if err := reference.AddToManager(mgr, *cfg.Controllers.ShootReference); err != nil {
 		return fmt.Errorf("failed adding reference reconciler: %w", err)
 	}
@@ -62,5 +85,11 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding statuslabel reconciler: %w", err)
 	}
 
+	if err := (&versionpolicy.Reconciler{
+		Config: *cfg.Controllers.ShootVersionPolicy,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding versionpolicy reconciler: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificatetransparency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Certificate is a certificate found in the certificate transparency logs.
+type Certificate struct {
+	// ID is the identifier of the certificate in the certificate transparency log search API.
+	ID int64 `json:"id"`
+	// IssuerName is the distinguished name of the issuer of the certificate.
+	IssuerName string `json:"issuer_name"`
+	// NameValue contains the newline-separated names the certificate was issued for.
+	NameValue string `json:"name_value"`
+	// NotBefore is the start of the validity of the certificate.
+	NotBefore string `json:"not_before"`
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber string `json:"serial_number"`
+}
+
+// Names returns the names the certificate was issued for.
+func (c Certificate) Names() []string {
+	var names []string
+	for _, name := range strings.Split(c.NameValue, "\n") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// NotBeforeTime parses the start of the validity of the certificate.
+func (c Certificate) NotBeforeTime() (time.Time, error) {
+	return time.Parse("2006-01-02T15:04:05", c.NotBefore)
+}
+
+// LogSearcher searches the certificate transparency logs for certificates issued for a given domain.
+type LogSearcher interface {
+	// Search returns the unexpired certificates issued for the given domain.
+	Search(ctx context.Context, domain string) ([]Certificate, error)
+}
+
+// NewCrtShLogSearcher returns a LogSearcher for the crt.sh compatible certificate transparency log search API at the
+// given endpoint.
+func NewCrtShLogSearcher(endpoint string, httpClient *http.Client) LogSearcher {
+	return &crtShLogSearcher{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: httpClient,
+	}
+}
+
+type crtShLogSearcher struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+func (c *crtShLogSearcher) Search(ctx context.Context, domain string) ([]Certificate, error) {
+	query := url.Values{}
+	query.Set("q", domain)
+	query.Set("output", "json")
+	query.Set("exclude", "expired")
+	query.Set("deduplicate", "Y")
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %w", err)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed searching certificates for domain %q: %w", domain, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed searching certificates for domain %q: unexpected status code %d", domain, response.StatusCode)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading response for domain %q: %w", domain, err)
+	}
+
+	var certificates []Certificate
+	if err := json.Unmarshal(body, &certificates); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling response for domain %q: %w", domain, err)
+	}
+
+	return certificates, nil
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificatetransparency_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/certificatetransparency"
+)
+
+var _ = Describe("LogSearcher", func() {
+	Describe("Certificate", func() {
+		It("should return the normalized names", func() {
+			certificate := Certificate{NameValue: "API.example.com\n\n *.example.com "}
+			Expect(certificate.Names()).To(Equal([]string{"api.example.com", "*.example.com"}))
+		})
+
+		It("should parse the not before time", func() {
+			certificate := Certificate{NotBefore: "2024-03-01T10:20:30"}
+			Expect(certificate.NotBeforeTime()).To(Equal(time.Date(2024, 3, 1, 10, 20, 30, 0, time.UTC)))
+		})
+
+		It("should fail parsing an invalid not before time", func() {
+			certificate := Certificate{NotBefore: "foo"}
+			_, err := certificate.NotBeforeTime()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#NewCrtShLogSearcher", func() {
+		var (
+			ctx = context.TODO()
+
+			server   *httptest.Server
+			query    url.Values
+			status   int
+			response string
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			response = `[{"id":1,"issuer_name":"C=US, O=Let's Encrypt, CN=R3","name_value":"api.example.com","not_before":"2024-03-01T10:20:30","serial_number":"0123"}]`
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(response))
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should search the certificates for the given domain", func() {
+			Expect(NewCrtShLogSearcher(server.URL+"/", server.Client()).Search(ctx, "api.example.com")).To(ConsistOf(Certificate{
+				ID:           1,
+				IssuerName:   "C=US, O=Let's Encrypt, CN=R3",
+				NameValue:    "api.example.com",
+				NotBefore:    "2024-03-01T10:20:30",
+				SerialNumber: "0123",
+			}))
+
+			Expect(query).To(Equal(url.Values{
+				"q":           {"api.example.com"},
+				"output":      {"json"},
+				"exclude":     {"expired"},
+				"deduplicate": {"Y"},
+			}))
+		})
+
+		It("should fail if the search API responds with an unexpected status code", func() {
+			status = http.StatusBadGateway
+
+			_, err := NewCrtShLogSearcher(server.URL, server.Client()).Search(ctx, "api.example.com")
+			Expect(err).To(MatchError(ContainSubstring("unexpected status code 502")))
+		})
+
+		It("should fail if the response cannot be unmarshalled", func() {
+			response = "foo"
+
+			_, err := NewCrtShLogSearcher(server.URL, server.Client()).Search(ctx, "api.example.com")
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling response")))
+		})
+	})
+})
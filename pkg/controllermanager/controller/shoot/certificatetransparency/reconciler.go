@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificatetransparency
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// RetryPeriod is the duration after which the search is retried if the certificate transparency logs could not be
+	// searched.
+	RetryPeriod = 15 * time.Minute
+
+	// maxReportedCertificates is the maximum number of unexpected certificates listed in the condition message.
+	maxReportedCertificates = 5
+)
+
+// Reconciler reconciles Shoots and maintains their CertificateIssuanceExpected condition based on the certificates
+// found in the public certificate transparency logs for their API server domains.
+type Reconciler struct {
+	Client      client.Client
+	Config      config.ShootCertificateTransparencyControllerConfiguration
+	Clock       clock.Clock
+	Recorder    record.EventRecorder
+	LogSearcher LogSearcher
+}
+
+// Reconcile reconciles Shoots and maintains their CertificateIssuanceExpected condition. Certificates issued before the
+// creation of the Shoot and certificates of expected issuers are ignored.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		log.V(1).Info("Shoot is being deleted, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	result := reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+
+	domains := apiServerDomains(shoot)
+	if len(domains) == 0 {
+		log.V(1).Info("Shoot does not advertise any API server domains yet")
+		return result, nil
+	}
+
+	expectedIssuers := make([]*regexp.Regexp, 0, len(r.Config.ExpectedIssuers))
+	for _, issuer := range r.Config.ExpectedIssuers {
+		expression, err := regexp.Compile(issuer)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed compiling expected issuer %q: %w", issuer, err)
+		}
+		expectedIssuers = append(expectedIssuers, expression)
+	}
+
+	unexpectedCertificates, err := r.unexpectedCertificates(ctx, shoot, domains, expectedIssuers)
+	if err != nil {
+		// Errors of the (public) search API are not reflected in the condition since they are not related to the Shoot.
+		log.Error(err, "Failed searching the certificate transparency logs, retrying later", "retryPeriod", RetryPeriod)
+		return reconcile.Result{RequeueAfter: RetryPeriod}, nil
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Conditions, gardencorev1beta1.ShootCertificateIssuanceExpected)
+	if len(unexpectedCertificates) == 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "NoUnexpectedCertificates",
+			fmt.Sprintf("No unexpected certificates were found in the certificate transparency logs for the API server domains %s.", strings.Join(domains, ", ")))
+	} else {
+		message := unexpectedCertificatesMessage(unexpectedCertificates)
+		if condition.Status != gardencorev1beta1.ConditionFalse || condition.Message != message {
+			r.Recorder.Event(shoot, corev1.EventTypeWarning, "UnexpectedCertificatesIssued", message)
+		}
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "UnexpectedCertificatesIssued", message)
+	}
+
+	conditions := v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+	if !v1beta1helper.ConditionsNeedUpdate(shoot.Status.Conditions, conditions) {
+		return result, nil
+	}
+
+	log.V(1).Info("Updating CertificateIssuanceExpected condition", "status", condition.Status)
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	shoot.Status.Conditions = conditions
+	return result, r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+func (r *Reconciler) unexpectedCertificates(ctx context.Context, shoot *gardencorev1beta1.Shoot, domains []string, expectedIssuers []*regexp.Regexp) ([]Certificate, error) {
+	var (
+		unexpected []Certificate
+		seenIDs    = sets.New[int64]()
+	)
+
+	for _, domain := range domains {
+		for _, name := range searchNames(domain) {
+			certificates, err := r.LogSearcher.Search(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, certificate := range certificates {
+				if seenIDs.Has(certificate.ID) || !coversDomain(certificate, domain) {
+					continue
+				}
+				seenIDs.Insert(certificate.ID)
+
+				notBefore, err := certificate.NotBeforeTime()
+				if err != nil {
+					return nil, fmt.Errorf("failed parsing not before time of certificate %d: %w", certificate.ID, err)
+				}
+
+				// Certificates issued before the Shoot was created might have been issued for a previous owner of the
+				// domain.
+				if notBefore.Before(shoot.CreationTimestamp.Time) {
+					continue
+				}
+
+				if slices.ContainsFunc(expectedIssuers, func(expression *regexp.Regexp) bool { return expression.MatchString(certificate.IssuerName) }) {
+					continue
+				}
+
+				unexpected = append(unexpected, certificate)
+			}
+		}
+	}
+
+	slices.SortFunc(unexpected, func(a, b Certificate) int { return cmp.Compare(a.ID, b.ID) })
+	return unexpected, nil
+}
+
+// apiServerDomains returns the domains of the external and internal addresses advertised for the API server of the
+// given Shoot.
+func apiServerDomains(shoot *gardencorev1beta1.Shoot) []string {
+	domains := sets.New[string]()
+
+	for _, address := range shoot.Status.AdvertisedAddresses {
+		if address.Name != v1beta1constants.AdvertisedAddressExternal && address.Name != v1beta1constants.AdvertisedAddressInternal {
+			continue
+		}
+
+		u, err := url.Parse(address.URL)
+		if err != nil || u.Hostname() == "" || net.ParseIP(u.Hostname()) != nil {
+			continue
+		}
+
+		domains.Insert(strings.ToLower(u.Hostname()))
+	}
+
+	return sets.List(domains)
+}
+
+// searchNames returns the names which must be searched in order to find all certificates covering the given domain,
+// i.e., the domain itself and the wildcard name of its parent domain.
+func searchNames(domain string) []string {
+	names := []string{domain}
+	if _, parent, ok := strings.Cut(domain, "."); ok && strings.Contains(parent, ".") {
+		names = append(names, "*."+parent)
+	}
+	return names
+}
+
+func coversDomain(certificate Certificate, domain string) bool {
+	_, parent, _ := strings.Cut(domain, ".")
+
+	for _, name := range certificate.Names() {
+		if name == domain || (parent != "" && name == "*."+parent) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func unexpectedCertificatesMessage(certificates []Certificate) string {
+	descriptions := make([]string, 0, maxReportedCertificates)
+	for i, certificate := range certificates {
+		if i == maxReportedCertificates {
+			descriptions = append(descriptions, fmt.Sprintf("and %d more", len(certificates)-maxReportedCertificates))
+			break
+		}
+		descriptions = append(descriptions, fmt.Sprintf("certificate %d (serial number %s) for %s issued by %q at %s",
+			certificate.ID, certificate.SerialNumber, strings.Join(certificate.Names(), ", "), certificate.IssuerName, certificate.NotBefore))
+	}
+
+	return fmt.Sprintf("Found %d unexpected certificate(s) for the API server domains in the certificate transparency logs, "+
+		"this might indicate that a domain was hijacked: %s", len(certificates), strings.Join(descriptions, "; "))
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package certificatetransparency_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/certificatetransparency"
+)
+
+type fakeLogSearcher struct {
+	certificates map[string][]Certificate
+	err          error
+	searches     []string
+}
+
+func (f *fakeLogSearcher) Search(_ context.Context, domain string) ([]Certificate, error) {
+	f.searches = append(f.searches, domain)
+	return f.certificates[domain], f.err
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		fakeClient   client.Client
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *record.FakeRecorder
+		logSearcher  *fakeLogSearcher
+		reconciler   *Reconciler
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+
+		syncPeriod = 6 * time.Hour
+		creation   = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		letsEncrypt = "C=US, O=Let's Encrypt, CN=R3"
+		rogue       = "C=XX, O=Rogue, CN=Rogue CA"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(creation.Add(24 * time.Hour))
+		fakeRecorder = record.NewFakeRecorder(10)
+		logSearcher = &fakeLogSearcher{certificates: map[string][]Certificate{}}
+		reconciler = &Reconciler{
+			Client:      fakeClient,
+			Clock:       fakeClock,
+			Recorder:    fakeRecorder,
+			LogSearcher: logSearcher,
+			Config: config.ShootCertificateTransparencyControllerConfiguration{
+				SyncPeriod:      &metav1.Duration{Duration: syncPeriod},
+				ExpectedIssuers: []string{"O=Let's Encrypt"},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev", CreationTimestamp: metav1.NewTime(creation)},
+			Status: gardencorev1beta1.ShootStatus{
+				AdvertisedAddresses: []gardencorev1beta1.ShootAdvertisedAddress{
+					{Name: "external", URL: "https://api.shoot.dev.example.com"},
+					{Name: "internal", URL: "https://api.shoot.dev.internal.example.com"},
+					{Name: "service-account-issuer", URL: "https://discovery.example.com/projects/dev/shoots/1234/issuer"},
+				},
+			},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		return v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootCertificateIssuanceExpected)
+	}
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(logSearcher.searches).To(BeEmpty())
+	})
+
+	It("should do nothing if the shoot does not advertise API server domains", func() {
+		shoot.Status.AdvertisedAddresses = []gardencorev1beta1.ShootAdvertisedAddress{{Name: "external", URL: "https://10.0.0.1"}}
+		Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(logSearcher.searches).To(BeEmpty())
+		Expect(getCondition()).To(BeNil())
+	})
+
+	It("should search the domains and the wildcard names of their parent domains", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(logSearcher.searches).To(ConsistOf(
+			"api.shoot.dev.example.com",
+			"*.shoot.dev.example.com",
+			"api.shoot.dev.internal.example.com",
+			"*.shoot.dev.internal.example.com",
+		))
+	})
+
+	It("should set the condition to True if no unexpected certificates were found", func() {
+		logSearcher.certificates["api.shoot.dev.example.com"] = []Certificate{
+			{ID: 1, IssuerName: letsEncrypt, NameValue: "api.shoot.dev.example.com", NotBefore: "2024-03-01T10:00:00"},
+			{ID: 2, IssuerName: rogue, NameValue: "api.shoot.dev.example.com", NotBefore: "2024-02-01T10:00:00"},
+			{ID: 3, IssuerName: rogue, NameValue: "foo.shoot.dev.example.com", NotBefore: "2024-03-01T10:00:00"},
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("NoUnexpectedCertificates"))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	It("should set the condition to False and emit an event if unexpected certificates were found", func() {
+		logSearcher.certificates["*.shoot.dev.example.com"] = []Certificate{
+			{ID: 4, IssuerName: rogue, NameValue: "*.shoot.dev.example.com", NotBefore: "2024-03-01T10:00:00", SerialNumber: "abcd"},
+		}
+		logSearcher.certificates["api.shoot.dev.example.com"] = logSearcher.certificates["*.shoot.dev.example.com"]
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("UnexpectedCertificatesIssued"))
+		Expect(condition.Message).To(And(
+			ContainSubstring("Found 1 unexpected certificate(s)"),
+			ContainSubstring(`certificate 4 (serial number abcd) for *.shoot.dev.example.com issued by "C=XX, O=Rogue, CN=Rogue CA"`),
+		))
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+
+		By("Reconcile again without changes")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+	})
+
+	It("should not change the condition and retry later if the logs cannot be searched", func() {
+		logSearcher.err = errors.New("fake")
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: RetryPeriod}))
+		Expect(getCondition()).To(BeNil())
+	})
+
+	It("should fail if an expected issuer is not a valid regular expression", func() {
+		reconciler.Config.ExpectedIssuers = []string{"("}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed compiling expected issuer")))
+	})
+})
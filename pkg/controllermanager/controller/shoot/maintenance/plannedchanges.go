@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// PlannedChangesControllerName is the name of the controller publishing the planned maintenance changes.
+const PlannedChangesControllerName = "shoot-maintenance-planned-changes"
+
+// PlannedChangesReconciler computes the version updates which the next maintenance of Shoots would apply and publishes
+// them in the Shoot status. It also records the versions which Shoots successfully run for evaluating the maintenance
+// dependencies of other Shoots.
+type PlannedChangesReconciler struct {
+	Client client.Client
+	Config config.ShootMaintenanceControllerConfiguration
+	Clock  clock.Clock
+}
+
+// AddToManager adds PlannedChangesReconciler to the given manager.
+func (r *PlannedChangesReconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(PlannedChangesControllerName).
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(r.ShootPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Complete(r)
+}
+
+// ShootPredicate returns the predicates for the core.gardener.cloud/v1beta1.Shoot watch. Changes of the Shoot status
+// other than the last operation are ignored since they are caused by this reconciler. CloudProfile, VersionPolicy and
+// maintenance dependency changes are picked up periodically.
+func (r *PlannedChangesReconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return shoot.Generation != oldShoot.Generation ||
+				!apiequality.Semantic.DeepEqual(shoot.Status.LastOperation, oldShoot.Status.LastOperation) ||
+				shoot.Annotations[v1beta1constants.ShootMaintenanceVetoPlannedChanges] != oldShoot.Annotations[v1beta1constants.ShootMaintenanceVetoPlannedChanges]
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// Reconcile computes the version updates which the next maintenance of the Shoot would apply and publishes them in the
+// Shoot status.
+func (r *PlannedChangesReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Shoot because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	cloudProfile, minimumVersions, err := getVersionMaintenanceInputs(ctx, r.Client, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	dependency, err := getMaintenanceDependency(ctx, r.Client, shoot, r.Clock)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// the version maintenance is performed on a copy of the Shoot which is never persisted
+	updates, err := maintainVersions(logr.Discard(), shoot.DeepCopy(), cloudProfile, minimumVersions, dependency)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing planned maintenance changes: %w", err)
+	}
+
+	runningVersions, err := computeRunningVersions(shoot, r.Clock.Now())
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing running versions: %w", err)
+	}
+
+	var (
+		plannedChanges    = updates.plannedChanges(shoot)
+		maintenanceStatus = &gardencorev1beta1.ShootMaintenanceStatus{}
+	)
+
+	if shoot.Status.Maintenance != nil {
+		maintenanceStatus = shoot.Status.Maintenance.DeepCopy()
+	}
+
+	if shoot.Status.Maintenance == nil || !apiequality.Semantic.DeepEqual(maintenanceStatus.PlannedChanges, plannedChanges) {
+		log.Info("Updating planned maintenance changes", "plannedChanges", len(plannedChanges))
+		maintenanceStatus.PlannedChanges = plannedChanges
+		maintenanceStatus.LastUpdateTime = metav1.Time{Time: r.Clock.Now()}
+	}
+	maintenanceStatus.RunningVersions = runningVersions
+
+	if !apiequality.Semantic.DeepEqual(shoot.Status.Maintenance, maintenanceStatus) {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.Maintenance = maintenanceStatus
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating maintenance status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.PlannedChangesSyncPeriod.Duration}, nil
+}
+
+// plannedChanges returns the successful version updates in the order of the control plane and the worker pools of the
+// given Shoot.
+func (u versionUpdates) plannedChanges(shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.MaintenancePlannedChange {
+	var plannedChanges []gardencorev1beta1.MaintenancePlannedChange
+
+	if u.kubernetesControlPlane != nil && u.kubernetesControlPlane.isSuccessful {
+		plannedChanges = append(plannedChanges, newPlannedChange(gardencorev1beta1.MaintenancePlannedChangeTypeKubernetesVersion, *u.kubernetesControlPlane))
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if result, ok := u.workerToKubernetes[worker.Name]; ok && result.isSuccessful {
+			plannedChange := newPlannedChange(gardencorev1beta1.MaintenancePlannedChangeTypeKubernetesVersion, result)
+			plannedChange.WorkerPool = ptr.To(worker.Name)
+			plannedChanges = append(plannedChanges, plannedChange)
+		}
+
+		if result, ok := u.workerToMachineImage[worker.Name]; ok && result.isSuccessful {
+			plannedChange := newPlannedChange(gardencorev1beta1.MaintenancePlannedChangeTypeMachineImageVersion, result)
+			plannedChange.WorkerPool = ptr.To(worker.Name)
+			if worker.Machine.Image != nil {
+				plannedChange.MachineImageName = ptr.To(worker.Machine.Image.Name)
+			}
+			plannedChanges = append(plannedChanges, plannedChange)
+		}
+	}
+
+	return plannedChanges
+}
+
+func newPlannedChange(changeType gardencorev1beta1.MaintenancePlannedChangeType, result updateResult) gardencorev1beta1.MaintenancePlannedChange {
+	return gardencorev1beta1.MaintenancePlannedChange{
+		Type:           changeType,
+		CurrentVersion: result.currentVersion,
+		TargetVersion:  result.targetVersion,
+		Reason:         result.reason,
+		Forced:         result.isForced,
+	}
+}
+
+// computeRunningVersions returns the lowest Kubernetes version and the lowest version of each machine image which the
+// given Shoot successfully runs. The previously recorded versions are kept as long as the Shoot is not successfully
+// reconciled, and the time since when a version is run is kept as long as the version does not change.
+func computeRunningVersions(shoot *gardencorev1beta1.Shoot, now time.Time) (*gardencorev1beta1.ShootRunningVersions, error) {
+	var current *gardencorev1beta1.ShootRunningVersions
+	if shoot.Status.Maintenance != nil {
+		current = shoot.Status.Maintenance.RunningVersions
+	}
+
+	if !isSuccessfullyReconciled(shoot) {
+		return current, nil
+	}
+
+	kubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, err
+	}
+	machineImageVersions := make(map[string]*semver.Version)
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			workerKubernetesVersion, err := semver.NewVersion(*worker.Kubernetes.Version)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing Kubernetes version of worker pool %q: %w", worker.Name, err)
+			}
+			if workerKubernetesVersion.LessThan(kubernetesVersion) {
+				kubernetesVersion = workerKubernetesVersion
+			}
+		}
+
+		if worker.Machine.Image == nil || worker.Machine.Image.Version == nil {
+			continue
+		}
+
+		machineImageVersion, err := semver.NewVersion(*worker.Machine.Image.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing machine image version of worker pool %q: %w", worker.Name, err)
+		}
+		if lowestVersion, ok := machineImageVersions[worker.Machine.Image.Name]; !ok || machineImageVersion.LessThan(lowestVersion) {
+			machineImageVersions[worker.Machine.Image.Name] = machineImageVersion
+		}
+	}
+
+	runningVersions := &gardencorev1beta1.ShootRunningVersions{
+		Kubernetes: &gardencorev1beta1.RunningVersion{
+			Version: kubernetesVersion.Original(),
+			Since:   metav1.Time{Time: now},
+		},
+	}
+	if current != nil && current.Kubernetes != nil && current.Kubernetes.Version == runningVersions.Kubernetes.Version {
+		runningVersions.Kubernetes.Since = current.Kubernetes.Since
+	}
+
+	names := make([]string, 0, len(machineImageVersions))
+	for name := range machineImageVersions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		machineImage := gardencorev1beta1.MachineImageRunningVersion{
+			Name:    name,
+			Version: machineImageVersions[name].Original(),
+			Since:   metav1.Time{Time: now},
+		}
+
+		if current != nil {
+			for _, currentMachineImage := range current.MachineImages {
+				if currentMachineImage.Name == machineImage.Name && currentMachineImage.Version == machineImage.Version {
+					machineImage.Since = currentMachineImage.Since
+				}
+			}
+		}
+
+		runningVersions.MachineImages = append(runningVersions.MachineImages, machineImage)
+	}
+
+	return runningVersions, nil
+}
+
+func isSuccessfullyReconciled(shoot *gardencorev1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+
+	return !shoot.Status.IsHibernated &&
+		shoot.Status.ObservedGeneration == shoot.Generation &&
+		lastOperation != nil &&
+		(lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate || lastOperation.Type == gardencorev1beta1.LastOperationTypeReconcile) &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
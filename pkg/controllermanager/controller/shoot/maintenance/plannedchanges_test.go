@@ -0,0 +1,348 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
+)
+
+var _ = Describe("PlannedChangesReconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *PlannedChangesReconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+
+		syncPeriod = time.Hour
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &PlannedChangesReconciler{
+			Client: fakeClient,
+			Config: config.ShootMaintenanceControllerConfiguration{PlannedChangesSyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:  fakeClock,
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{
+					{Version: "1.30.1"},
+					{Version: "1.30.3"},
+				}},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name:           "gardenlinux",
+					UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyMajor),
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{
+							ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"},
+							CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+							Architectures:    []string{"amd64"},
+						},
+						{
+							ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"},
+							CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+							Architectures:    []string{"amd64"},
+						},
+					},
+				}},
+			},
+		}
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: cloudProfile.Name,
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+						KubernetesVersion:   true,
+						MachineImageVersion: ptr.To(true),
+					},
+				},
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{
+					Name: "worker",
+					Machine: gardencorev1beta1.Machine{
+						Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.0.0")},
+						Architecture: ptr.To("amd64"),
+					},
+				}}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should publish the planned changes", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.30.1"))
+		Expect(shoot.Spec.Provider.Workers[0].Machine.Image.Version).To(PointTo(Equal("1.0.0")))
+		Expect(shoot.Status.Maintenance).NotTo(BeNil())
+		Expect(shoot.Status.Maintenance.LastUpdateTime.Time).To(Equal(fakeClock.Now()))
+		Expect(shoot.Status.Maintenance.PlannedChanges).To(Equal([]gardencorev1beta1.MaintenancePlannedChange{
+			{
+				Type:           gardencorev1beta1.MaintenancePlannedChangeTypeKubernetesVersion,
+				CurrentVersion: "1.30.1",
+				TargetVersion:  "1.30.3",
+				Reason:         "Automatic update of Kubernetes version configured",
+			},
+			{
+				Type:             gardencorev1beta1.MaintenancePlannedChangeTypeMachineImageVersion,
+				WorkerPool:       ptr.To("worker"),
+				MachineImageName: ptr.To("gardenlinux"),
+				CurrentVersion:   "1.0.0",
+				TargetVersion:    "1.1.0",
+				Reason:           "Automatic update of the machine image version is configured (image update strategy: major)",
+			},
+		}))
+	})
+
+	It("should only publish forced changes if the planned changes were vetoed", func() {
+		cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+		Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.shoot.gardener.cloud/veto-planned-changes", "true")
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Maintenance.PlannedChanges).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":          Equal(gardencorev1beta1.MaintenancePlannedChangeTypeKubernetesVersion),
+			"TargetVersion": Equal("1.30.3"),
+			"Forced":        BeTrue(),
+		})))
+	})
+
+	It("should only update the last update time when the planned changes changed", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Maintenance.PlannedChanges).To(HaveLen(2))
+		lastUpdateTime := shoot.Status.Maintenance.LastUpdateTime
+
+		fakeClock.Step(time.Minute)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Maintenance.LastUpdateTime).To(Equal(lastUpdateTime))
+
+		shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+		shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Maintenance.PlannedChanges).To(BeEmpty())
+		Expect(shoot.Status.Maintenance.LastUpdateTime.Time).To(Equal(fakeClock.Now()))
+	})
+
+	Context("running versions", func() {
+		BeforeEach(func() {
+			shoot.Generation = 1
+			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{
+				Name:       "worker-old",
+				Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.29.5")},
+				Machine: gardencorev1beta1.Machine{
+					Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("0.9.0")},
+					Architecture: ptr.To("amd64"),
+				},
+			})
+			shoot.Status = gardencorev1beta1.ShootStatus{
+				ObservedGeneration: 1,
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			}
+		})
+
+		It("should record the lowest versions of a successfully reconciled shoot", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.RunningVersions).To(Equal(&gardencorev1beta1.ShootRunningVersions{
+				Kubernetes:    &gardencorev1beta1.RunningVersion{Version: "1.29.5", Since: metav1.Time{Time: fakeClock.Now()}},
+				MachineImages: []gardencorev1beta1.MachineImageRunningVersion{{Name: "gardenlinux", Version: "0.9.0", Since: metav1.Time{Time: fakeClock.Now()}}},
+			}))
+		})
+
+		It("should keep the time as long as the versions do not change", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			since := fakeClock.Now()
+
+			fakeClock.Step(time.Hour)
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			shoot.Spec.Provider.Workers[1].Machine.Image.Version = ptr.To("1.0.0")
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			shoot.Status.ObservedGeneration = shoot.Generation
+			Expect(fakeClient.Status().Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.RunningVersions.Kubernetes.Since.Time).To(Equal(since))
+			Expect(shoot.Status.Maintenance.RunningVersions.MachineImages).To(ConsistOf(gardencorev1beta1.MachineImageRunningVersion{
+				Name: "gardenlinux", Version: "1.0.0", Since: metav1.Time{Time: fakeClock.Now()},
+			}))
+		})
+
+		It("should not record the versions if the shoot was not successfully reconciled", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.RunningVersions).To(BeNil())
+		})
+	})
+
+	Context("maintenance dependency", func() {
+		var dependencyShoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot.Spec.Maintenance.DependsOn = &gardencorev1beta1.MaintenanceDependency{
+				ShootName:          "staging",
+				MinimumRunDuration: &metav1.Duration{Duration: 72 * time.Hour},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			dependencyShoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "staging", Namespace: shoot.Namespace},
+				Status: gardencorev1beta1.ShootStatus{Maintenance: &gardencorev1beta1.ShootMaintenanceStatus{
+					RunningVersions: &gardencorev1beta1.ShootRunningVersions{
+						Kubernetes:    &gardencorev1beta1.RunningVersion{Version: "1.30.3", Since: metav1.Time{Time: fakeClock.Now()}},
+						MachineImages: []gardencorev1beta1.MachineImageRunningVersion{{Name: "gardenlinux", Version: "1.1.0", Since: metav1.Time{Time: fakeClock.Now().Add(-96 * time.Hour)}}},
+					},
+				}},
+			}
+		})
+
+		It("should defer all automatic updates if the referenced shoot does not exist", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.PlannedChanges).To(BeEmpty())
+		})
+
+		It("should only plan updates to versions which the referenced shoot ran for the minimum duration", func() {
+			Expect(fakeClient.Create(ctx, dependencyShoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.PlannedChanges).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":          Equal(gardencorev1beta1.MaintenancePlannedChangeTypeMachineImageVersion),
+				"TargetVersion": Equal("1.1.0"),
+			})))
+
+			fakeClock.Step(73 * time.Hour)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.PlannedChanges).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":          Equal(gardencorev1beta1.MaintenancePlannedChangeTypeKubernetesVersion),
+					"TargetVersion": Equal("1.30.3"),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":          Equal(gardencorev1beta1.MaintenancePlannedChangeTypeMachineImageVersion),
+					"TargetVersion": Equal("1.1.0"),
+				}),
+			))
+		})
+
+		It("should not defer forced updates", func() {
+			cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.Maintenance.PlannedChanges).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardencorev1beta1.MaintenancePlannedChangeTypeKubernetesVersion),
+				"Forced": BeTrue(),
+			})))
+		})
+	})
+
+	Describe("#ShootPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false if neither the generation nor the veto annotation changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.Maintenance = &gardencorev1beta1.ShootMaintenanceStatus{}
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+		})
+
+		It("should return true if the generation changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Generation++
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+
+		It("should return true if the last operation changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+
+		It("should return true if the veto annotation changed", func() {
+			oldShoot := shoot.DeepCopy()
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.shoot.gardener.cloud/veto-planned-changes", "true")
+			Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+		})
+	})
+})
@@ -72,6 +72,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	if v1beta1helper.IsShootReconciliationPaused(shoot, r.Clock.Now()) {
+		log.Info("Skipping Shoot maintenance because its reconciliation is paused")
+		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootMaintenanceSkipped, "Maintenance was skipped because the reconciliation of the Shoot is paused")
+		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -95,9 +102,12 @@ func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.T
 // Such maintenance operations can fail if a version must be updated, but the GCM cannot find a suitable version to update to.
 // Note: the updates might still be rejected by APIServer validation.
 type updateResult struct {
-	description  string
-	reason       string
-	isSuccessful bool
+	description    string
+	reason         string
+	isSuccessful   bool
+	currentVersion string
+	targetVersion  string
+	isForced       bool
 }
 
 func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
@@ -110,30 +120,21 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		err        error
 	)
 
-	workerToKubernetesUpdate := make(map[string]updateResult)
-	workerToMachineImageUpdate := make(map[string]updateResult)
-
-	cloudProfile := &gardencorev1beta1.CloudProfile{}
-	if err = r.Client.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, cloudProfile); err != nil {
+	cloudProfile, minimumVersions, err := getVersionMaintenanceInputs(ctx, r.Client, shoot)
+	if err != nil {
 		return err
 	}
 
-	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
-		if err != nil {
-			// continue execution to allow the kubernetes version update
-			log.Error(err, "Failed to maintain Shoot machine images")
-		}
+	dependency, err := getMaintenanceDependency(ctx, r.Client, shoot, r.Clock)
+	if err != nil {
+		return err
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-		maintainedShoot.Spec.Kubernetes.Version = v
-		return v, nil
-	})
+	updates, err := maintainVersions(log, maintainedShoot, cloudProfile, minimumVersions, dependency)
 	if err != nil {
-		// continue execution to allow the machine image version update and Kubernetes updates to worker pools
-		log.Error(err, "Failed to maintain Shoot kubernetes version")
+		return err
 	}
+	kubernetesControlPlaneUpdate, workerToKubernetesUpdate, workerToMachineImageUpdate := updates.kubernetesControlPlane, updates.workerToKubernetes, updates.workerToMachineImage
 
 	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
@@ -158,41 +159,6 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		operations = append(operations, reasonsForIncreasingMaxWorkers...)
 	}
 
-	// Now it's time to update worker pool kubernetes version if specified
-	for i, pool := range maintainedShoot.Spec.Provider.Workers {
-		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
-			continue
-		}
-
-		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-			workerPoolSemver, err := semver.NewVersion(v)
-			if err != nil {
-				return "", err
-			}
-			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
-			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
-				workerPoolSemver = shootKubernetesVersion
-			}
-			v = workerPoolSemver.String()
-			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
-			return v, nil
-		})
-		if err != nil {
-			// continue execution to allow other maintenance activities to continue
-			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
-		}
-
-		if workerKubernetesUpdate != nil {
-			result := updateResult{
-				reason: workerKubernetesUpdate.reason,
-			}
-			result.isSuccessful = workerKubernetesUpdate.isSuccessful
-			result.description = workerKubernetesUpdate.description
-			workerToKubernetesUpdate[pool.Name] = result
-		}
-	}
-
 	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
 		operations = append(operations, reasons...)
 	}
@@ -291,6 +257,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
 	_ = maintainOperation(shoot)
 	maintainTasks(shoot, r.Config)
+	// a veto only applies to the planned changes of a single maintenance
+	delete(shoot.Annotations, v1beta1constants.ShootMaintenanceVetoPlannedChanges)
 
 	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
 	// data (e.g. when calculating the updated k8s version), so rather return error and backoff
@@ -324,6 +292,178 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	return nil
 }
 
+// getVersionMaintenanceInputs returns the CloudProfile of the given Shoot and the minimum versions of all VersionPolicies
+// requesting forceful updates.
+func getVersionMaintenanceInputs(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.CloudProfile, gardenerutils.MinimumVersions, error) {
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := c.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, cloudProfile); err != nil {
+		return nil, gardenerutils.MinimumVersions{}, err
+	}
+
+	versionPolicyList := &gardencorev1beta1.VersionPolicyList{}
+	if err := c.List(ctx, versionPolicyList); err != nil {
+		return nil, gardenerutils.MinimumVersions{}, err
+	}
+
+	// versions lower than the minimum versions of VersionPolicies requesting forceful updates are treated like expired
+	// versions of the CloudProfile
+	minimumVersions, err := gardenerutils.ComputeMinimumVersions(versionPolicyList.Items, true)
+	if err != nil {
+		return nil, gardenerutils.MinimumVersions{}, err
+	}
+
+	return cloudProfile, minimumVersions, nil
+}
+
+// maintenanceDependency restricts automatic version updates to versions which the Shoot referenced in
+// .spec.maintenance.dependsOn has successfully run for the configured minimum duration.
+type maintenanceDependency struct {
+	shootName          string
+	runningVersions    *gardencorev1beta1.ShootRunningVersions
+	minimumRunDuration time.Duration
+	now                time.Time
+}
+
+// getMaintenanceDependency returns the maintenance dependency of the given Shoot or nil if it does not depend on another
+// Shoot.
+func getMaintenanceDependency(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, clock clock.Clock) (*maintenanceDependency, error) {
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.DependsOn == nil {
+		return nil, nil
+	}
+
+	dependency := &maintenanceDependency{
+		shootName:          shoot.Spec.Maintenance.DependsOn.ShootName,
+		minimumRunDuration: ptr.Deref(shoot.Spec.Maintenance.DependsOn.MinimumRunDuration, metav1.Duration{}).Duration,
+		now:                clock.Now(),
+	}
+
+	dependencyShoot := &gardencorev1beta1.Shoot{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: dependency.shootName}, dependencyShoot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading shoot %q referenced in maintenance dependency: %w", dependency.shootName, err)
+		}
+		// automatic updates are deferred as long as the referenced Shoot does not exist
+		return dependency, nil
+	}
+
+	if dependencyShoot.Status.Maintenance != nil {
+		dependency.runningVersions = dependencyShoot.Status.Maintenance.RunningVersions
+	}
+
+	return dependency, nil
+}
+
+func (d *maintenanceDependency) allowsKubernetesVersion(version string) (bool, error) {
+	if d == nil {
+		return true, nil
+	}
+	if d.runningVersions == nil || d.runningVersions.Kubernetes == nil {
+		return false, nil
+	}
+
+	return d.allowsVersion(version, d.runningVersions.Kubernetes.Version, d.runningVersions.Kubernetes.Since)
+}
+
+func (d *maintenanceDependency) allowsMachineImageVersion(name, version string) (bool, error) {
+	if d == nil {
+		return true, nil
+	}
+	if d.runningVersions == nil {
+		return false, nil
+	}
+
+	for _, machineImage := range d.runningVersions.MachineImages {
+		if machineImage.Name == name {
+			return d.allowsVersion(version, machineImage.Version, machineImage.Since)
+		}
+	}
+
+	return false, nil
+}
+
+func (d *maintenanceDependency) allowsVersion(version, runningVersion string, since metav1.Time) (bool, error) {
+	if since.Add(d.minimumRunDuration).After(d.now) {
+		return false, nil
+	}
+
+	return versionutils.CompareVersions(runningVersion, ">=", version)
+}
+
+// versionUpdates contains the results of the Kubernetes and machine image version maintenance of a Shoot.
+type versionUpdates struct {
+	kubernetesControlPlane *updateResult
+	workerToKubernetes     map[string]updateResult
+	workerToMachineImage   map[string]updateResult
+}
+
+// maintainVersions updates the machine image versions, the Kubernetes version of the control plane and the Kubernetes
+// versions of the worker pools of the given Shoot if necessary.
+func maintainVersions(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, minimumVersions gardenerutils.MinimumVersions, dependency *maintenanceDependency) (versionUpdates, error) {
+	var (
+		updates = versionUpdates{
+			workerToKubernetes:   make(map[string]updateResult),
+			workerToMachineImage: make(map[string]updateResult),
+		}
+		// automatic updates are skipped if the planned changes were vetoed, forceful updates are applied nevertheless
+		autoUpdateKubernetesVersion = shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion && !hasVetoPlannedChangesAnnotation(shoot)
+		err                         error
+	)
+
+	if !v1beta1helper.IsWorkerless(shoot) {
+		updates.workerToMachineImage, err = maintainMachineImages(log, shoot, cloudProfile, minimumVersions, dependency)
+		if err != nil {
+			// continue execution to allow the kubernetes version update
+			log.Error(err, "Failed to maintain Shoot machine images")
+		}
+	}
+
+	updates.kubernetesControlPlane, err = maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, minimumVersions, dependency, func(v string) (string, error) {
+		shoot.Spec.Kubernetes.Version = v
+		return v, nil
+	})
+	if err != nil {
+		// continue execution to allow the machine image version update and Kubernetes updates to worker pools
+		log.Error(err, "Failed to maintain Shoot kubernetes version")
+	}
+
+	shootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return versionUpdates{}, err
+	}
+
+	// Now it's time to update worker pool kubernetes version if specified
+	for i, pool := range shoot.Spec.Provider.Workers {
+		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
+			continue
+		}
+
+		workerLog := log.WithValues("worker", pool.Name)
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, minimumVersions, dependency, func(v string) (string, error) {
+			workerPoolSemver, err := semver.NewVersion(v)
+			if err != nil {
+				return "", err
+			}
+			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
+			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
+				workerPoolSemver = shootKubernetesVersion
+			}
+			v = workerPoolSemver.String()
+			shoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
+			return v, nil
+		})
+		if err != nil {
+			// continue execution to allow other maintenance activities to continue
+			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
+		}
+
+		if workerKubernetesUpdate != nil {
+			updates.workerToKubernetes[pool.Name] = *workerKubernetesUpdate
+		}
+	}
+
+	return updates, nil
+}
+
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
 // contains an indication for the failure and the reason the update was triggered. Details for failed maintenance operations are returned in the second return string.
 func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, workerToKubernetesUpdate map[string]updateResult, workerToMachineImageUpdate map[string]updateResult) (string, string) {
@@ -438,8 +578,12 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanc
 }
 
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
-	maintenanceResults := make(map[string]updateResult)
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, minimumVersions gardenerutils.MinimumVersions, dependency *maintenanceDependency) (map[string]updateResult, error) {
+	var (
+		maintenanceResults = make(map[string]updateResult)
+		// automatic updates are skipped if the planned changes were vetoed, forceful updates are applied nevertheless
+		autoUpdate = *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion && !hasVetoPlannedChangesAnnotation(shoot)
+	)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
@@ -464,8 +608,13 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
 		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
 
+		belowMinimum, err := minimumVersions.MachineImageVersionBelowMinimum(workerImage.Name, ptr.Deref(workerImage.Version, ""))
+		if err != nil {
+			return nil, err
+		}
+
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, autoUpdate, belowMinimum)
 		if !shouldBeUpdated {
 			continue
 		}
@@ -485,11 +634,26 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			continue
 		}
 
+		// automatic updates are deferred until the Shoot this Shoot depends on has successfully run the version
+		if !isExpired {
+			allowed, err := dependency.allowsMachineImageVersion(workerImage.Name, updatedMachineImageVersion)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				workerLog.Info("MachineImage update is deferred because of maintenance dependency", "newVersion", updatedMachineImageVersion, "dependency", dependency.shootName)
+				continue
+			}
+		}
+
 		workerLog.Info("MachineImage will be updated", "newVersion", updatedMachineImageVersion, "reason", reason)
 		maintenanceResults[worker.Name] = updateResult{
-			description:  fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
-			reason:       reason,
-			isSuccessful: true,
+			description:    fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
+			reason:         reason,
+			isSuccessful:   true,
+			currentVersion: *workerImage.Version,
+			targetVersion:  updatedMachineImageVersion,
+			isForced:       isExpired,
 		}
 
 		// update the machine image version
@@ -500,8 +664,8 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 }
 
 // maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
-	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, minimumVersions gardenerutils.MinimumVersions, dependency *maintenanceDependency, updateFunc func(string) (string, error)) (*updateResult, error) {
+	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile, minimumVersions)
 	if err != nil {
 		return nil, err
 	}
@@ -522,6 +686,18 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		return nil, nil
 	}
 
+	// automatic updates are deferred until the Shoot this Shoot depends on has successfully run the version
+	if !isExpired {
+		allowed, err := dependency.allowsKubernetesVersion(updatedKubernetesVersion)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			log.Info("Kubernetes version update is deferred because of maintenance dependency", "version", kubernetesVersion, "newVersion", updatedKubernetesVersion, "dependency", dependency.shootName)
+			return nil, nil
+		}
+	}
+
 	// In case the updatedKubernetesVersion for workerpool is higher than the controlplane version, actualUpdatedKubernetesVersion is set to controlplane version
 	actualUpdatedKubernetesVersion, err := updateFunc(updatedKubernetesVersion)
 	if err != nil {
@@ -534,9 +710,12 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 
 	log.Info("Kubernetes version will be updated", "version", kubernetesVersion, "newVersion", actualUpdatedKubernetesVersion, "reason", reason)
 	return &updateResult{
-		description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
-		reason:       reason,
-		isSuccessful: true,
+		description:    fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
+		reason:         reason,
+		isSuccessful:   true,
+		currentVersion: kubernetesVersion,
+		targetVersion:  actualUpdatedKubernetesVersion,
+		isForced:       isExpired,
 	}, nil
 }
 
@@ -551,7 +730,7 @@ func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1b
 	return version, nil
 }
 
-func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
+func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, minimumVersions gardenerutils.MinimumVersions) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
 	versionExistsInCloudProfile, version, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(profile, kubernetesVersion)
 	if err != nil {
 		return false, "", false, err
@@ -568,6 +747,15 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 		return true, updateReason, true, nil
 	}
 
+	belowMinimum, err := minimumVersions.KubernetesVersionBelowMinimum(kubernetesVersion)
+	if err != nil {
+		return false, "", false, err
+	}
+	if belowMinimum {
+		updateReason = "Kubernetes version is lower than the minimum version of a VersionPolicy - force update required"
+		return true, updateReason, true, nil
+	}
+
 	if autoUpdate {
 		updateReason = "Automatic update of Kubernetes version configured"
 		return true, updateReason, false, nil
@@ -585,6 +773,11 @@ func hasMaintainNowAnnotation(shoot *gardencorev1beta1.Shoot) bool {
 	return ok && operation == v1beta1constants.ShootOperationMaintain
 }
 
+func hasVetoPlannedChangesAnnotation(shoot *gardencorev1beta1.Shoot) bool {
+	veto, _ := strconv.ParseBool(shoot.Annotations[v1beta1constants.ShootMaintenanceVetoPlannedChanges])
+	return veto
+}
+
 func needsRetry(shoot *gardencorev1beta1.Shoot) bool {
 	needsRetryOperation := false
 
@@ -712,7 +905,7 @@ func determineMachineImage(cloudProfile *gardencorev1beta1.CloudProfile, shootMa
 	return machineImageFromCloudProfile, nil
 }
 
-func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate bool) (shouldBeUpdated bool, reason string, isExpired bool) {
+func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate, belowMinimum bool) (shouldBeUpdated bool, reason string, isExpired bool) {
 	versionExistsInCloudProfile, versionIndex := v1beta1helper.ShootMachineImageVersionExists(*machineImage, *shootMachineImage)
 
 	var updateReason string
@@ -726,6 +919,11 @@ func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.Sho
 		return true, updateReason, true
 	}
 
+	if belowMinimum {
+		updateReason = fmt.Sprintf("Machine image version is lower than the minimum version of a VersionPolicy - force update required (image update strategy: %s)", *machineImage.UpdateStrategy)
+		return true, updateReason, true
+	}
+
 	if autoUpdate {
 		updateReason = fmt.Sprintf("Automatic update of the machine image version is configured (image update strategy: %s)", *machineImage.UpdateStrategy)
 		return true, updateReason, false
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciliationpause
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Shoots and maintains their ReconciliationPauseAcceptable constraint.
+type Reconciler struct {
+	Client client.Client
+	Config config.ShootReconciliationPauseControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Shoots and maintains their ReconciliationPauseAcceptable constraint. The constraint is only
+// present while the reconciliation of a Shoot is paused. It turns False once the reconciliation has been paused for
+// longer than the configured warning threshold.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	var (
+		now         = r.Clock.Now()
+		result      reconcile.Result
+		constraints []gardencorev1beta1.Condition
+	)
+
+	if !v1beta1helper.IsShootReconciliationPaused(shoot, now) {
+		constraints = v1beta1helper.RemoveConditions(shoot.Status.Constraints, gardencorev1beta1.ShootReconciliationPauseAcceptable)
+	} else {
+		var (
+			threshold  = r.Config.WarningThreshold.Duration
+			reason     = ptr.Deref(shoot.Spec.Reconciliation.Reason, "")
+			constraint = v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Constraints, gardencorev1beta1.ShootReconciliationPauseAcceptable)
+			pausedFor  time.Duration
+		)
+
+		// The constraint is set to True when the pause is observed first, hence, its last transition time tells since when
+		// the reconciliation is paused.
+		if constraint.Status == gardencorev1beta1.ConditionTrue {
+			pausedFor = now.Sub(constraint.LastTransitionTime.Time)
+		}
+
+		if constraint.Status == gardencorev1beta1.ConditionFalse || pausedFor >= threshold {
+			constraint = v1beta1helper.UpdatedConditionWithClock(r.Clock, constraint, gardencorev1beta1.ConditionFalse, "ReconciliationPausedTooLong", fmt.Sprintf("The reconciliation of the Shoot has been paused for more than %s: %s", threshold, reason))
+		} else {
+			constraint = v1beta1helper.UpdatedConditionWithClock(r.Clock, constraint, gardencorev1beta1.ConditionTrue, "ReconciliationPaused", fmt.Sprintf("The reconciliation of the Shoot is paused: %s", reason))
+			result.RequeueAfter = threshold - pausedFor
+		}
+
+		if pausedUntil := shoot.Spec.Reconciliation.PausedUntil; pausedUntil != nil {
+			if untilEnd := pausedUntil.Sub(now); result.RequeueAfter == 0 || untilEnd < result.RequeueAfter {
+				result.RequeueAfter = untilEnd
+			}
+		}
+
+		constraints = v1beta1helper.MergeConditions(shoot.Status.Constraints, constraint)
+	}
+
+	if apiequality.Semantic.DeepEqual(shoot.Status.Constraints, constraints) {
+		return result, nil
+	}
+
+	log.V(1).Info("Updating ReconciliationPauseAcceptable constraint")
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	shoot.Status.Constraints = constraints
+	return result, r.Client.Status().Patch(ctx, shoot, patch)
+}
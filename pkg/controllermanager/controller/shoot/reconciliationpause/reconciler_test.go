@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciliationpause_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reconciliationpause"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+		request    reconcile.Request
+
+		threshold = 7 * 24 * time.Hour
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: config.ShootReconciliationPauseControllerConfiguration{WarningThreshold: &metav1.Duration{Duration: threshold}},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Status: gardencorev1beta1.ShootStatus{
+				Constraints: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootHibernationPossible, Status: gardencorev1beta1.ConditionTrue}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	getConstraint := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		return v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootReconciliationPauseAcceptable)
+	}
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not add the constraint if the reconciliation is not paused", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(getConstraint()).To(BeNil())
+		Expect(shoot.Status.Constraints).To(HaveLen(1))
+	})
+
+	It("should remove the constraint if the reconciliation is no longer paused", func() {
+		shoot.Status.Constraints = append(shoot.Status.Constraints, gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootReconciliationPauseAcceptable, Status: gardencorev1beta1.ConditionFalse})
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(getConstraint()).To(BeNil())
+		Expect(shoot.Status.Constraints).To(HaveLen(1))
+	})
+
+	Context("reconciliation is paused", func() {
+		BeforeEach(func() {
+			shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("investigating an incident")}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should add a true constraint and requeue when the threshold is reached", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: threshold}))
+
+			constraint := getConstraint()
+			Expect(constraint).NotTo(BeNil())
+			Expect(constraint.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(constraint.Reason).To(Equal("ReconciliationPaused"))
+			Expect(constraint.Message).To(ContainSubstring("investigating an incident"))
+		})
+
+		It("should set the constraint to false once the threshold is reached", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: threshold}))
+
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: threshold - time.Hour}))
+			Expect(getConstraint().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+			fakeClock.Step(threshold)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			constraint := getConstraint()
+			Expect(constraint.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(constraint.Reason).To(Equal("ReconciliationPausedTooLong"))
+
+			By("Ensure constraint stays false")
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(getConstraint().Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		})
+
+		It("should requeue when the pause ends", func() {
+			shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(getConstraint().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(getConstraint()).To(BeNil())
+		})
+	})
+})
@@ -5,114 +5,37 @@
 package reference
 
 import (
-	corev1 "k8s.io/api/core/v1"
-	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controller/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 )
 
-// AddToManager adds the shoot-reference controller to the given manager.
+// AddToManager adds the shoot-reference controller to the given manager. It protects all resources which are
+// referenced by Shoots according to the default registry.
 func AddToManager(mgr manager.Manager, cfg config.ShootReferenceControllerConfiguration) error {
+	return AddToManagerWithRegistry(mgr, cfg, NewDefaultRegistry())
+}
+
+// AddToManagerWithRegistry adds the shoot-reference controller to the given manager. It protects all resources which
+// are referenced by Shoots according to the given registry.
+func AddToManagerWithRegistry(mgr manager.Manager, cfg config.ShootReferenceControllerConfiguration, registry *Registry) error {
 	return (&reference.Reconciler{
-		ConcurrentSyncs:             cfg.ConcurrentSyncs,
-		NewObjectFunc:               func() client.Object { return &gardencorev1beta1.Shoot{} },
-		NewObjectListFunc:           func() client.ObjectList { return &gardencorev1beta1.ShootList{} },
-		GetNamespace:                func(obj client.Object) string { return obj.GetNamespace() },
-		GetReferencedSecretNames:    getReferencedSecretNames,
-		GetReferencedConfigMapNames: getReferencedConfigMapNames,
-		ReferenceChangedPredicate:   Predicate,
+		ConcurrentSyncs:           cfg.ConcurrentSyncs,
+		NewObjectFunc:             func() client.Object { return &gardencorev1beta1.Shoot{} },
+		NewObjectListFunc:         func() client.ObjectList { return &gardencorev1beta1.ShootList{} },
+		GetNamespace:              func(obj client.Object) string { return obj.GetNamespace() },
+		ReferencedKinds:           registry.Kinds(),
+		ReferenceChangedPredicate: registry.Predicate,
 	}).AddToManager(mgr)
 }
 
-// Predicate is a predicate function for checking whether a reference changed in the Shoot
-// specification.
+// Predicate is a predicate function for checking whether a reference changed in the Shoot specification according to
+// the default registry.
 func Predicate(oldObj, newObj client.Object) bool {
-	newShoot, ok := newObj.(*gardencorev1beta1.Shoot)
-	if !ok {
-		return false
-	}
-
-	oldShoot, ok := oldObj.(*gardencorev1beta1.Shoot)
-	if !ok {
-		return false
-	}
-
-	return shootDNSFieldChanged(oldShoot, newShoot) ||
-		shootKubeAPIServerAuditConfigFieldChanged(oldShoot, newShoot) || !v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources)
-}
-
-func shootDNSFieldChanged(oldShoot, newShoot *gardencorev1beta1.Shoot) bool {
-	return !apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.DNS, newShoot.Spec.DNS)
-}
-
-func shootKubeAPIServerAuditConfigFieldChanged(oldShoot, newShoot *gardencorev1beta1.Shoot) bool {
-	return !apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig, newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig)
-}
-
-func getReferencedSecretNames(obj client.Object) []string {
-	shoot, ok := obj.(*gardencorev1beta1.Shoot)
-	if !ok {
-		return nil
-	}
-
-	var out []string
-	out = append(out, secretNamesForDNSProviders(shoot)...)
-	out = append(out, namesForReferencedResources(shoot, "Secret")...)
-	return out
+	return defaultRegistry.Predicate(oldObj, newObj)
 }
 
-func getReferencedConfigMapNames(obj client.Object) []string {
-	shoot, ok := obj.(*gardencorev1beta1.Shoot)
-	if !ok {
-		return nil
-	}
-
-	var out []string
-	if configMapRef := getAuditPolicyConfigMapRef(shoot.Spec.Kubernetes.KubeAPIServer); configMapRef != nil {
-		out = append(out, configMapRef.Name)
-	}
-	out = append(out, namesForReferencedResources(shoot, "ConfigMap")...)
-	return out
-}
-
-func secretNamesForDNSProviders(shoot *gardencorev1beta1.Shoot) []string {
-	if shoot.Spec.DNS == nil {
-		return nil
-	}
-
-	var names = make([]string, 0, len(shoot.Spec.DNS.Providers))
-	for _, provider := range shoot.Spec.DNS.Providers {
-		if provider.SecretName == nil {
-			continue
-		}
-		names = append(names, *provider.SecretName)
-	}
-
-	return names
-}
-
-func namesForReferencedResources(shoot *gardencorev1beta1.Shoot, kind string) []string {
-	var names []string
-	for _, ref := range shoot.Spec.Resources {
-		if ref.ResourceRef.APIVersion == "v1" && ref.ResourceRef.Kind == kind {
-			names = append(names, ref.ResourceRef.Name)
-		}
-	}
-	return names
-}
-
-func getAuditPolicyConfigMapRef(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) *corev1.ObjectReference {
-	if apiServerConfig != nil &&
-		apiServerConfig.AuditConfig != nil &&
-		apiServerConfig.AuditConfig.AuditPolicy != nil &&
-		apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef != nil {
-		return apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef
-	}
-
-	return nil
-}
+var defaultRegistry = NewDefaultRegistry()
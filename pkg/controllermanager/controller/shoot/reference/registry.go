@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reference
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controller/reference"
+)
+
+// Extractor returns the names of the resources of a specific kind which are referenced by the given Shoot.
+type Extractor func(shoot *gardencorev1beta1.Shoot) []string
+
+// Registry contains the kinds of resources which can be referenced by Shoots together with the extractors for finding
+// the references in the Shoot specification. All referenced resources of the registered kinds are protected from
+// deletion as long as they are still referenced.
+type Registry struct {
+	kinds      []reference.ReferencedKind
+	extractors map[string][]Extractor
+}
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{extractors: make(map[string][]Extractor)}
+}
+
+// NewDefaultRegistry returns a new Registry containing the extractors for all Secrets and ConfigMaps referenced by
+// Shoots.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(reference.SecretKind(nil),
+		secretNamesForDNSProviders,
+		secretNamesForAdmissionPlugins,
+		NamesForReferencedResources("v1", "Secret"),
+	)
+	r.Register(reference.ConfigMapKind(nil),
+		configMapNamesForAuditPolicy,
+		NamesForReferencedResources("v1", "ConfigMap"),
+	)
+	return r
+}
+
+// Register adds the given extractors for the given kind. If the kind is already registered, the extractors are added
+// to the existing ones. The GetReferencedNames function of the given kind is ignored, the referenced names are
+// computed by the registered extractors instead.
+func (r *Registry) Register(kind reference.ReferencedKind, extractors ...Extractor) {
+	if _, ok := r.extractors[kind.Kind]; !ok {
+		kind.GetReferencedNames = func(obj client.Object) []string {
+			shoot, ok := obj.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return nil
+			}
+			return r.ReferencedNames(kind.Kind, shoot)
+		}
+		r.kinds = append(r.kinds, kind)
+	}
+
+	r.extractors[kind.Kind] = append(r.extractors[kind.Kind], extractors...)
+}
+
+// Kinds returns the registered kinds.
+func (r *Registry) Kinds() []reference.ReferencedKind {
+	return r.kinds
+}
+
+// ReferencedNames returns the names of the resources of the given kind which are referenced by the given Shoot.
+func (r *Registry) ReferencedNames(kind string, shoot *gardencorev1beta1.Shoot) []string {
+	var names []string
+	for _, extractor := range r.extractors[kind] {
+		names = append(names, extractor(shoot)...)
+	}
+	return names
+}
+
+// Predicate is a predicate function for checking whether a reference changed in the Shoot specification, i.e., whether
+// any of the registered extractors returns different names for the old and the new Shoot.
+func (r *Registry) Predicate(oldObj, newObj client.Object) bool {
+	newShoot, ok := newObj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return false
+	}
+
+	oldShoot, ok := oldObj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return false
+	}
+
+	for _, kind := range r.kinds {
+		if !sets.New(r.ReferencedNames(kind.Kind, oldShoot)...).Equal(sets.New(r.ReferencedNames(kind.Kind, newShoot)...)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NamesForReferencedResources returns an Extractor for the names of the resources with the given API version and kind
+// which are referenced in the `.spec.resources` field of Shoots.
+func NamesForReferencedResources(apiVersion, kind string) Extractor {
+	return func(shoot *gardencorev1beta1.Shoot) []string {
+		var names []string
+		for _, ref := range shoot.Spec.Resources {
+			if ref.ResourceRef.APIVersion == apiVersion && ref.ResourceRef.Kind == kind {
+				names = append(names, ref.ResourceRef.Name)
+			}
+		}
+		return names
+	}
+}
+
+func secretNamesForDNSProviders(shoot *gardencorev1beta1.Shoot) []string {
+	if shoot.Spec.DNS == nil {
+		return nil
+	}
+
+	var names = make([]string, 0, len(shoot.Spec.DNS.Providers))
+	for _, provider := range shoot.Spec.DNS.Providers {
+		if provider.SecretName == nil {
+			continue
+		}
+		names = append(names, *provider.SecretName)
+	}
+
+	return names
+}
+
+func secretNamesForAdmissionPlugins(shoot *gardencorev1beta1.Shoot) []string {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		return nil
+	}
+
+	var names []string
+	for _, plugin := range shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
+		if plugin.KubeconfigSecretName != nil {
+			names = append(names, *plugin.KubeconfigSecretName)
+		}
+	}
+
+	return names
+}
+
+func configMapNamesForAuditPolicy(shoot *gardencorev1beta1.Shoot) []string {
+	apiServerConfig := shoot.Spec.Kubernetes.KubeAPIServer
+	if apiServerConfig != nil &&
+		apiServerConfig.AuditConfig != nil &&
+		apiServerConfig.AuditConfig.AuditPolicy != nil &&
+		apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef != nil {
+		return []string{apiServerConfig.AuditConfig.AuditPolicy.ConfigMapRef.Name}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reference_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controller/reference"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
+)
+
+var _ = Describe("Registry", func() {
+	var shoot *gardencorev1beta1.Shoot
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				DNS: &gardencorev1beta1.DNS{
+					Providers: []gardencorev1beta1.DNSProvider{
+						{SecretName: ptr.To("dns-secret")},
+						{},
+					},
+				},
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{
+							{Name: "foo", KubeconfigSecretName: ptr.To("admission-kubeconfig")},
+							{Name: "bar"},
+						},
+						AuditConfig: &gardencorev1beta1.AuditConfig{
+							AuditPolicy: &gardencorev1beta1.AuditPolicy{
+								ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"},
+							},
+						},
+					},
+				},
+				Resources: []gardencorev1beta1.NamedResourceReference{
+					{Name: "secret", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "resource-secret"}},
+					{Name: "configmap", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "resource-configmap"}},
+					{Name: "custom", ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "example.com/v1", Kind: "Custom", Name: "resource-custom"}},
+				},
+			},
+		}
+	})
+
+	Describe("#NewDefaultRegistry", func() {
+		var registry *Registry
+
+		BeforeEach(func() {
+			registry = NewDefaultRegistry()
+		})
+
+		It("should register Secrets and ConfigMaps", func() {
+			kinds := registry.Kinds()
+			Expect(kinds).To(HaveLen(2))
+			Expect(kinds[0].Kind).To(Equal("Secret"))
+			Expect(kinds[0].NewObjectFunc()).To(BeAssignableToTypeOf(&corev1.Secret{}))
+			Expect(kinds[0].NewObjectListFunc()).To(BeAssignableToTypeOf(&corev1.SecretList{}))
+			Expect(kinds[1].Kind).To(Equal("ConfigMap"))
+			Expect(kinds[1].NewObjectFunc()).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+			Expect(kinds[1].NewObjectListFunc()).To(BeAssignableToTypeOf(&corev1.ConfigMapList{}))
+		})
+
+		It("should return the names of all referenced Secrets", func() {
+			Expect(registry.ReferencedNames("Secret", shoot)).To(ConsistOf("dns-secret", "admission-kubeconfig", "resource-secret"))
+			Expect(registry.Kinds()[0].GetReferencedNames(shoot)).To(ConsistOf("dns-secret", "admission-kubeconfig", "resource-secret"))
+		})
+
+		It("should return the names of all referenced ConfigMaps", func() {
+			Expect(registry.ReferencedNames("ConfigMap", shoot)).To(ConsistOf("audit-policy", "resource-configmap"))
+			Expect(registry.Kinds()[1].GetReferencedNames(shoot)).To(ConsistOf("audit-policy", "resource-configmap"))
+		})
+
+		It("should return nothing for unregistered kinds", func() {
+			Expect(registry.ReferencedNames("Custom", shoot)).To(BeEmpty())
+		})
+
+		It("should return nothing if the object is no Shoot", func() {
+			Expect(registry.Kinds()[0].GetReferencedNames(&corev1.Pod{})).To(BeEmpty())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should add further kinds", func() {
+			registry := NewDefaultRegistry()
+			registry.Register(reference.ReferencedKind{
+				Kind:              "Custom",
+				NewObjectFunc:     func() client.Object { return &corev1.Pod{} },
+				NewObjectListFunc: func() client.ObjectList { return &corev1.PodList{} },
+			}, NamesForReferencedResources("example.com/v1", "Custom"))
+
+			Expect(registry.Kinds()).To(HaveLen(3))
+			Expect(registry.ReferencedNames("Custom", shoot)).To(ConsistOf("resource-custom"))
+		})
+
+		It("should add further extractors to existing kinds", func() {
+			registry := NewDefaultRegistry()
+			registry.Register(reference.SecretKind(nil), func(*gardencorev1beta1.Shoot) []string { return []string{"extra"} })
+
+			Expect(registry.Kinds()).To(HaveLen(2))
+			Expect(registry.ReferencedNames("Secret", shoot)).To(ConsistOf("dns-secret", "admission-kubeconfig", "resource-secret", "extra"))
+		})
+	})
+
+	Describe("#Predicate", func() {
+		var registry *Registry
+
+		BeforeEach(func() {
+			registry = NewDefaultRegistry()
+		})
+
+		It("should return false because the referenced names did not change", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.DNS.Providers[1].Primary = ptr.To(true)
+			Expect(registry.Predicate(shoot, newShoot)).To(BeFalse())
+		})
+
+		It("should return false because the order of the references changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Resources[0], newShoot.Spec.Resources[1] = newShoot.Spec.Resources[1], newShoot.Spec.Resources[0]
+			Expect(registry.Predicate(shoot, newShoot)).To(BeFalse())
+		})
+
+		It("should return true because an admission plugin kubeconfig secret changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins[1].KubeconfigSecretName = ptr.To("other-kubeconfig")
+			Expect(registry.Predicate(shoot, newShoot)).To(BeTrue())
+		})
+
+		It("should return true because a reference of a registered custom kind changed", func() {
+			registry.Register(reference.ReferencedKind{Kind: "Custom"}, NamesForReferencedResources("example.com/v1", "Custom"))
+
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Resources[2].ResourceRef.Name = "other-custom"
+			Expect(registry.Predicate(shoot, newShoot)).To(BeTrue())
+		})
+
+		It("should return false because a reference of an unregistered kind changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Spec.Resources[2].ResourceRef.Name = "other-custom"
+			Expect(registry.Predicate(shoot, newShoot)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionpolicy_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/versionpolicy"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#MapVersionPolicyToShoots", func() {
+		It("should map to all shoots", func() {
+			ctx := context.Background()
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: "garden-foo"}})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot2", Namespace: "garden-bar"}})).To(Succeed())
+
+			Expect((&Reconciler{}).MapVersionPolicyToShoots(ctx, logr.Discard(), fakeClient, &gardencorev1beta1.VersionPolicy{})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "shoot1", Namespace: "garden-foo"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "shoot2", Namespace: "garden-bar"}},
+			))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionpolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Shoots and maintains their VersionPolicyCompliant constraint.
+type Reconciler struct {
+	Client client.Client
+	Config config.ShootVersionPolicyControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Shoots and maintains their VersionPolicyCompliant constraint.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Shoot because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	versionPolicyList := &gardencorev1beta1.VersionPolicyList{}
+	if err := r.Client.List(ctx, versionPolicyList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing VersionPolicies: %w", err)
+	}
+
+	var constraints []gardencorev1beta1.Condition
+	if len(versionPolicyList.Items) == 0 {
+		constraints = v1beta1helper.RemoveConditions(shoot.Status.Constraints, gardencorev1beta1.ShootVersionPolicyCompliant)
+	} else {
+		minimumVersions, err := gardenerutils.ComputeMinimumVersions(versionPolicyList.Items, false)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		violations, err := minimumVersions.ShootViolations(shoot)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		constraint := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Constraints, gardencorev1beta1.ShootVersionPolicyCompliant)
+		if len(violations) == 0 {
+			constraint = v1beta1helper.UpdatedConditionWithClock(r.Clock, constraint, gardencorev1beta1.ConditionTrue, "VersionsCompliant", "The Kubernetes and machine image versions satisfy all VersionPolicies.")
+		} else {
+			constraint = v1beta1helper.UpdatedConditionWithClock(r.Clock, constraint, gardencorev1beta1.ConditionFalse, "VersionsBelowMinimum", fmt.Sprintf("The Shoot violates VersionPolicies: %s.", strings.Join(violations, ", ")))
+		}
+		constraints = v1beta1helper.MergeConditions(shoot.Status.Constraints, constraint)
+	}
+
+	if apiequality.Semantic.DeepEqual(shoot.Status.Constraints, constraints) {
+		return reconcile.Result{}, nil
+	}
+
+	log.V(1).Info("Updating VersionPolicyCompliant constraint")
+	patch := client.StrategicMergeFrom(shoot.DeepCopy())
+	shoot.Status.Constraints = constraints
+	return reconcile.Result{}, r.Client.Status().Patch(ctx, shoot, patch)
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package versionpolicy_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/versionpolicy"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+		request    reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.29.3"},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1443.3.0")}},
+					}},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				Constraints: []gardencorev1beta1.Condition{{Type: gardencorev1beta1.ShootHibernationPossible, Status: gardencorev1beta1.ConditionTrue}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not add the constraint if there are no VersionPolicies", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Constraints).To(HaveLen(1))
+		Expect(v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootVersionPolicyCompliant)).To(BeNil())
+	})
+
+	It("should remove the constraint if all VersionPolicies were deleted", func() {
+		shoot.Status.Constraints = append(shoot.Status.Constraints, gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootVersionPolicyCompliant, Status: gardencorev1beta1.ConditionFalse})
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Constraints).To(HaveLen(1))
+		Expect(v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootVersionPolicyCompliant)).To(BeNil())
+	})
+
+	It("should mark compliant shoots", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.VersionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "baseline"},
+			Spec:       gardencorev1beta1.VersionPolicySpec{Kubernetes: &gardencorev1beta1.KubernetesVersionPolicy{MinimumVersion: "1.29.0"}},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.Constraints).To(HaveLen(2))
+		constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootVersionPolicyCompliant)
+		Expect(constraint).NotTo(BeNil())
+		Expect(constraint.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(constraint.Reason).To(Equal("VersionsCompliant"))
+	})
+
+	It("should mark non-compliant shoots", func() {
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.VersionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "baseline"},
+			Spec: gardencorev1beta1.VersionPolicySpec{
+				Kubernetes:    &gardencorev1beta1.KubernetesVersionPolicy{MinimumVersion: "1.30.0"},
+				MachineImages: []gardencorev1beta1.MachineImageVersionPolicy{{Name: "gardenlinux", MinimumVersion: "1592.1.0"}},
+			},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootVersionPolicyCompliant)
+		Expect(constraint).NotTo(BeNil())
+		Expect(constraint.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(constraint.Reason).To(Equal("VersionsBelowMinimum"))
+		Expect(constraint.Message).To(Equal(`The Shoot violates VersionPolicies: Kubernetes version "1.29.3" of the control plane is lower than the minimum version "1.30.0", version "1443.3.0" of machine image "gardenlinux" of worker pool "worker" is lower than the minimum version "1592.1.0".`))
+	})
+})
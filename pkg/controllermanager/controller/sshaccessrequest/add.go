@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sshaccessrequest
+
+import (
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "sshaccessrequest"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&operationsv1alpha1.SSHAccessRequest{}, builder.WithPredicates(r.SSHAccessRequestPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Complete(r)
+}
+
+// SSHAccessRequestPredicate returns true for all SSHAccessRequest 'create' events and for 'update' events in which a
+// decision about the SSHAccessRequest was taken or in which the SSHAccessRequest was marked for deletion. The spec of
+// SSHAccessRequests is immutable and status updates performed by this controller must not trigger a new reconciliation.
+func (r *Reconciler) SSHAccessRequestPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSSHAccessRequest, ok := e.ObjectOld.(*operationsv1alpha1.SSHAccessRequest)
+			if !ok {
+				return false
+			}
+			newSSHAccessRequest, ok := e.ObjectNew.(*operationsv1alpha1.SSHAccessRequest)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldSSHAccessRequest.Status.Approval, newSSHAccessRequest.Status.Approval) ||
+				(oldSSHAccessRequest.DeletionTimestamp == nil && newSSHAccessRequest.DeletionTimestamp != nil)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
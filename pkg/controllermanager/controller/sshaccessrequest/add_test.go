@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sshaccessrequest_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/sshaccessrequest"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SSHAccessRequestPredicate", func() {
+		var (
+			p                predicate.Predicate
+			sshAccessRequest *operationsv1alpha1.SSHAccessRequest
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).SSHAccessRequestPredicate()
+			sshAccessRequest = &operationsv1alpha1.SSHAccessRequest{}
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: sshAccessRequest})).To(BeTrue())
+		})
+
+		It("should return false for update events without a new decision", func() {
+			oldSSHAccessRequest := sshAccessRequest.DeepCopy()
+			sshAccessRequest.Status.Phase = operationsv1alpha1.AccessRequestPending
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSSHAccessRequest, ObjectNew: sshAccessRequest})).To(BeFalse())
+		})
+
+		It("should return true for update events with a new decision", func() {
+			oldSSHAccessRequest := sshAccessRequest.DeepCopy()
+			sshAccessRequest.Status.Approval = &operationsv1alpha1.AccessRequestApproval{Decision: operationsv1alpha1.AccessRequestApproved}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSSHAccessRequest, ObjectNew: sshAccessRequest})).To(BeTrue())
+		})
+
+		It("should return true for update events which mark the object for deletion", func() {
+			oldSSHAccessRequest := sshAccessRequest.DeepCopy()
+			sshAccessRequest.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldSSHAccessRequest, ObjectNew: sshAccessRequest})).To(BeTrue())
+		})
+
+		It("should return false for update events with unexpected objects", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: sshAccessRequest, ObjectNew: &operationsv1alpha1.Bastion{}})).To(BeFalse())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: sshAccessRequest})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: sshAccessRequest})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sshaccessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// FinalizerName is the finalizer which is added to granted SSHAccessRequests. It ensures that the public key is
+	// removed from the worker nodes when a granted SSHAccessRequest is deleted before it expires.
+	FinalizerName = "gardener.cloud/ssh-access-request"
+
+	eventReasonAccessGranted  = "SSHAccessGranted"
+	eventReasonAccessRejected = "SSHAccessRejected"
+	eventReasonAccessRevoked  = "SSHAccessRevoked"
+	eventReasonAccessPending  = "SSHAccessPending"
+)
+
+// Reconciler reconciles SSHAccessRequests. It grants time-bound SSH access to the worker nodes of shoots for approved
+// SSHAccessRequests and revokes it once the access expires. The public keys of granted SSHAccessRequests are added to
+// the worker nodes by gardenlet, hence the shoot is reconciled whenever access is granted or revoked.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.SSHAccessRequestControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reacts to decisions about SSHAccessRequests and revokes expired accesses.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	sshAccessRequest := &operationsv1alpha1.SSHAccessRequest{}
+	if err := r.Client.Get(ctx, request.NamespacedName, sshAccessRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if sshAccessRequest.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(sshAccessRequest, FinalizerName) {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Revoking access of deleted SSHAccessRequest")
+		if err := r.triggerShootReconciliation(ctx, sshAccessRequest); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log.Info("Removing finalizer")
+		return reconcile.Result{}, controllerutils.RemoveFinalizers(ctx, r.Client, sshAccessRequest, FinalizerName)
+	}
+
+	switch sshAccessRequest.Status.Phase {
+	case operationsv1alpha1.AccessRequestExpired, operationsv1alpha1.AccessRequestRejected:
+		return reconcile.Result{}, nil
+
+	case operationsv1alpha1.AccessRequestGranted:
+		if expiresIn := sshAccessRequest.Status.ExpirationTimestamp.Sub(r.Clock.Now()); expiresIn > 0 {
+			log.V(1).Info("Requeuing SSHAccessRequest until access expires", "requeueAfter", expiresIn)
+			return reconcile.Result{RequeueAfter: expiresIn}, nil
+		}
+
+		log.Info("Revoking expired access", "expirationTimestamp", sshAccessRequest.Status.ExpirationTimestamp.Time)
+		return reconcile.Result{}, r.revoke(ctx, sshAccessRequest)
+	}
+
+	approval := sshAccessRequest.Status.Approval
+	if approval == nil {
+		return reconcile.Result{}, r.patchStatus(ctx, sshAccessRequest, func(status *operationsv1alpha1.SSHAccessRequestStatus) {
+			status.Phase = operationsv1alpha1.AccessRequestPending
+		})
+	}
+
+	if approval.Decision != operationsv1alpha1.AccessRequestApproved {
+		log.Info("SSH access request was denied", "decidedBy", approval.DecidedBy)
+		r.Recorder.Eventf(sshAccessRequest, corev1.EventTypeNormal, eventReasonAccessRejected, "SSH access to shoot %q for %q was denied by %q", sshAccessRequest.Spec.ShootRef.Name, sshAccessRequest.Spec.Requester, approval.DecidedBy)
+		return reconcile.Result{}, r.patchStatus(ctx, sshAccessRequest, func(status *operationsv1alpha1.SSHAccessRequestStatus) {
+			status.Phase = operationsv1alpha1.AccessRequestRejected
+		})
+	}
+
+	log.Info("Granting SSH access", "decidedBy", approval.DecidedBy, "duration", sshAccessRequest.Spec.Duration.Duration)
+	if err := r.grant(ctx, sshAccessRequest); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: sshAccessRequest.Spec.Duration.Duration}, nil
+}
+
+func (r *Reconciler) grant(ctx context.Context, sshAccessRequest *operationsv1alpha1.SSHAccessRequest) error {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: sshAccessRequest.Namespace, Name: sshAccessRequest.Spec.ShootRef.Name}, shoot); err != nil {
+		return fmt.Errorf("could not get shoot %q: %w", sshAccessRequest.Spec.ShootRef.Name, err)
+	}
+
+	if !v1beta1helper.ShootEnablesSSHAccess(shoot) {
+		r.Recorder.Eventf(sshAccessRequest, corev1.EventTypeWarning, eventReasonAccessPending, "SSH access to the worker nodes of shoot %q is disabled", shoot.Name)
+		return fmt.Errorf("SSH access to the worker nodes of shoot %q is disabled", shoot.Name)
+	}
+
+	if err := controllerutils.AddFinalizers(ctx, r.Client, sshAccessRequest, FinalizerName); err != nil {
+		return fmt.Errorf("failed adding finalizer: %w", err)
+	}
+
+	var (
+		now        = r.Clock.Now()
+		expiration = now.Add(sshAccessRequest.Spec.Duration.Duration)
+	)
+
+	if err := r.patchStatus(ctx, sshAccessRequest, func(status *operationsv1alpha1.SSHAccessRequestStatus) {
+		status.Phase = operationsv1alpha1.AccessRequestGranted
+		status.GrantedTimestamp = &metav1.Time{Time: now}
+		status.ExpirationTimestamp = &metav1.Time{Time: expiration}
+	}); err != nil {
+		return err
+	}
+
+	if err := r.triggerShootReconciliation(ctx, sshAccessRequest); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(sshAccessRequest, corev1.EventTypeNormal, eventReasonAccessGranted, "SSH access to the worker nodes of shoot %q was granted to %q until %s, approved by %q", shoot.Name, sshAccessRequest.Spec.Requester, expiration.UTC().Format(time.RFC3339), sshAccessRequest.Status.Approval.DecidedBy)
+	return nil
+}
+
+func (r *Reconciler) revoke(ctx context.Context, sshAccessRequest *operationsv1alpha1.SSHAccessRequest) error {
+	if err := r.patchStatus(ctx, sshAccessRequest, func(status *operationsv1alpha1.SSHAccessRequestStatus) {
+		status.Phase = operationsv1alpha1.AccessRequestExpired
+	}); err != nil {
+		return err
+	}
+
+	if err := r.triggerShootReconciliation(ctx, sshAccessRequest); err != nil {
+		return err
+	}
+
+	r.Recorder.Eventf(sshAccessRequest, corev1.EventTypeNormal, eventReasonAccessRevoked, "SSH access to the worker nodes of shoot %q for %q expired and was revoked", sshAccessRequest.Spec.ShootRef.Name, sshAccessRequest.Spec.Requester)
+
+	return controllerutils.RemoveFinalizers(ctx, r.Client, sshAccessRequest, FinalizerName)
+}
+
+// triggerShootReconciliation annotates the shoot referenced by the SSHAccessRequest with the reconcile operation so
+// that gardenlet updates the authorized SSH keys on the worker nodes. If another operation is already pending, it is
+// not overwritten since it reconciles the shoot anyway.
+func (r *Reconciler) triggerShootReconciliation(ctx context.Context, sshAccessRequest *operationsv1alpha1.SSHAccessRequest) error {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: sshAccessRequest.Namespace, Name: sshAccessRequest.Spec.ShootRef.Name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get shoot %q: %w", sshAccessRequest.Spec.ShootRef.Name, err)
+	}
+
+	if _, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed triggering reconciliation of shoot %q: %w", shoot.Name, err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) patchStatus(ctx context.Context, sshAccessRequest *operationsv1alpha1.SSHAccessRequest, mutate func(*operationsv1alpha1.SSHAccessRequestStatus)) error {
+	patch := client.MergeFrom(sshAccessRequest.DeepCopy())
+	mutate(&sshAccessRequest.Status)
+	return r.Client.Status().Patch(ctx, sshAccessRequest, patch)
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sshaccessrequest_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/sshaccessrequest"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+
+		namespace        = "garden-dev"
+		shoot            *gardencorev1beta1.Shoot
+		sshAccessRequest *operationsv1alpha1.SSHAccessRequest
+		request          reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&operationsv1alpha1.SSHAccessRequest{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: record.NewFakeRecorder(10),
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-shoot", Namespace: namespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: "worker"}}},
+			},
+		}
+		sshAccessRequest = &operationsv1alpha1.SSHAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "incident-42", Namespace: namespace},
+			Spec: operationsv1alpha1.SSHAccessRequestSpec{
+				ShootRef:     corev1.LocalObjectReference{Name: shoot.Name},
+				SSHPublicKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIETlsKq/fYx9c3shC3rT0f7z+b3BbcEYGEtl9TUiXHuV",
+				Duration:     metav1.Duration{Duration: time.Hour},
+				Reason:       "incident 42",
+				Requester:    "alice",
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(sshAccessRequest)}
+
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	It("should do nothing if the object is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should set the phase to pending if no decision was taken yet", func() {
+		Expect(fakeClient.Create(ctx, sshAccessRequest)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(Succeed())
+		Expect(sshAccessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestPending))
+	})
+
+	It("should reject the SSH access request if it was denied", func() {
+		sshAccessRequest.Status.Approval = &operationsv1alpha1.AccessRequestApproval{Decision: operationsv1alpha1.AccessRequestDenied, DecidedBy: "bob"}
+		Expect(fakeClient.Create(ctx, sshAccessRequest)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(Succeed())
+		Expect(sshAccessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestRejected))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+	})
+
+	Context("approved SSH access request", func() {
+		BeforeEach(func() {
+			sshAccessRequest.Status.Approval = &operationsv1alpha1.AccessRequestApproval{Decision: operationsv1alpha1.AccessRequestApproved, DecidedBy: "bob"}
+			Expect(fakeClient.Create(ctx, sshAccessRequest)).To(Succeed())
+		})
+
+		It("should fail if the shoot does not exist", func() {
+			Expect(fakeClient.Delete(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(BeNotFoundError())
+		})
+
+		It("should fail if the shoot does not allow SSH access", func() {
+			shoot.Spec.Provider.WorkersSettings = &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}}
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("SSH access to the worker nodes of shoot \"my-shoot\" is disabled")))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(Succeed())
+			Expect(sshAccessRequest.Status.Phase).To(BeEmpty())
+		})
+
+		It("should grant the access and trigger a reconciliation of the shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(Succeed())
+			Expect(sshAccessRequest.Finalizers).To(ConsistOf(FinalizerName))
+			Expect(sshAccessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestGranted))
+			Expect(sshAccessRequest.Status.GrantedTimestamp.Time).To(Equal(fakeClock.Now()))
+			Expect(sshAccessRequest.Status.ExpirationTimestamp.Time).To(Equal(fakeClock.Now().Add(time.Hour)))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+
+		It("should not overwrite another pending operation of the shoot", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, "rotate-ssh-keypair")
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, "rotate-ssh-keypair"))
+		})
+
+		Context("granted access", func() {
+			BeforeEach(func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+				Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+			})
+
+			It("should requeue until the access expires", func() {
+				fakeClock.Step(20 * time.Minute)
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 40 * time.Minute}))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(Succeed())
+				Expect(sshAccessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestGranted))
+			})
+
+			It("should revoke the access once it expired", func() {
+				fakeClock.Step(time.Hour)
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(Succeed())
+				Expect(sshAccessRequest.Status.Phase).To(Equal(operationsv1alpha1.AccessRequestExpired))
+				Expect(sshAccessRequest.Finalizers).To(BeEmpty())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			})
+
+			It("should revoke the access and release the object when it is deleted", func() {
+				Expect(fakeClient.Delete(ctx, sshAccessRequest)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				Expect(fakeClient.Get(ctx, request.NamespacedName, sshAccessRequest)).To(BeNotFoundError())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+			})
+		})
+	})
+})
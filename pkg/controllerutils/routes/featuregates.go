@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/features"
+)
+
+var (
+	// FeatureGatesHandlers is the list of endpoints exposing the states of the Gardener feature gates of the component.
+	FeatureGatesHandlers = map[string]http.Handler{
+		"/featuregates": http.HandlerFunc(featureGates),
+	}
+)
+
+func featureGates(rw http.ResponseWriter, _ *http.Request) {
+	data, err := json.Marshal(features.GetStates(features.DefaultFeatureGate))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(data)
+}
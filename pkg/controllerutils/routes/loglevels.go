@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+var (
+	// LogLevelHandlers is the list of endpoints for inspecting and changing the log levels of the component at runtime.
+	LogLevelHandlers = map[string]http.Handler{
+		"/debug/loglevels": http.HandlerFunc(logLevels),
+	}
+)
+
+// LogLevels is the payload of the log levels endpoint.
+type LogLevels struct {
+	// Level is the global log level.
+	Level string `json:"level"`
+	// Overrides are the log levels for individual controllers which override the global log level.
+	Overrides map[string]string `json:"overrides,omitempty"`
+}
+
+func logLevels(rw http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		desired := &LogLevels{}
+		if err := json.NewDecoder(req.Body).Decode(desired); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// validate the complete payload before changing anything
+		if err := logger.NewLevels().SetGlobal(desired.Level); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := logger.NewLevels().SetOverrides(desired.Overrides); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		_ = logger.DefaultLevels.SetGlobal(desired.Level)
+		_ = logger.DefaultLevels.SetOverrides(desired.Overrides)
+	default:
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := json.Marshal(LogLevels{Level: logger.DefaultLevels.Global(), Overrides: logger.DefaultLevels.Overrides()})
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(data)
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/controllerutils/routes"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("LogLevels", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		handler = LogLevelHandlers["/debug/loglevels"]
+
+		DeferCleanup(func() {
+			Expect(logger.DefaultLevels.SetGlobal(logger.InfoLevel)).To(Succeed())
+			Expect(logger.DefaultLevels.SetOverrides(nil)).To(Succeed())
+		})
+	})
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, "/debug/loglevels", strings.NewReader(body)))
+		return recorder
+	}
+
+	It("should return the current log levels", func() {
+		Expect(logger.DefaultLevels.SetOverrides(map[string]string{"shoot-care": logger.DebugLevel})).To(Succeed())
+
+		recorder := serve(http.MethodGet, "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"level":"info","overrides":{"shoot-care":"debug"}}`))
+	})
+
+	It("should change the log levels", func() {
+		recorder := serve(http.MethodPut, `{"level":"error","overrides":{"shoot-care":"debug"}}`)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"level":"error","overrides":{"shoot-care":"debug"}}`))
+
+		Expect(logger.DefaultLevels.Global()).To(Equal(logger.ErrorLevel))
+		Expect(logger.DefaultLevels.Overrides()).To(Equal(map[string]string{"shoot-care": logger.DebugLevel}))
+	})
+
+	It("should not change anything if the payload is invalid", func() {
+		recorder := serve(http.MethodPut, `{"level":"error","overrides":{"shoot-care":"verbose"}}`)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+
+		Expect(logger.DefaultLevels.Global()).To(Equal(logger.InfoLevel))
+		Expect(logger.DefaultLevels.Overrides()).To(BeEmpty())
+	})
+
+	It("should reject other methods", func() {
+		Expect(serve(http.MethodPost, "").Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -221,7 +221,7 @@ func WaitUntilExtensionObjectDeleted(
 
 		if lastErr := obj.GetExtensionStatus().GetLastError(); lastErr != nil {
 			log.Info("Object did not get deleted yet", "description", lastErr.Description)
-			lastObservedError = v1beta1helper.NewErrorWithCodes(errors.New(lastErr.Description), lastErr.Codes...)
+			lastObservedError = v1beta1helper.NewErrorWithProviderCodes(errors.New(lastErr.Description), lastErr.ProviderCodes, lastErr.Codes...)
 		}
 
 		var message = extensionKey(kind, namespace, name) + " is still present"
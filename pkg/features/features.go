@@ -5,6 +5,9 @@
 package features
 
 import (
+	"slices"
+	"strings"
+
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/component-base/featuregate"
 )
@@ -83,6 +86,20 @@ const (
 	// owner: @ialidzhikov
 	// alpha: v1.95.0
 	VPAAndHPAForAPIServer = "VPAAndHPAForAPIServer"
+
+	// MutationAttribution attributes mutating requests of controllers to the garden cluster to the controller and the
+	// reconciliation performing them. The controller name is used as field manager and the controller name and the
+	// reconcile ID are appended to the user agent which is recorded in the audit logs of the API server.
+	// owner: @rfranzke
+	// alpha: v1.97.0
+	MutationAttribution featuregate.Feature = "MutationAttribution"
+
+	// DNSRecordHealthProbes enables health probes for the DNSRecords of the shoot API servers. gardenlet waits until the
+	// DNS names resolve to the desired values and the API server endpoints answer before the DNS records are considered
+	// ready.
+	// owner: @rfranzke
+	// alpha: v1.97.0
+	DNSRecordHealthProbes featuregate.Feature = "DNSRecordHealthProbes"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -121,6 +138,8 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	ShootForceDeletion:              {Default: true, PreRelease: featuregate.Beta},
 	UseNamespacedCloudProfile:       {Default: false, PreRelease: featuregate.Alpha},
 	VPAAndHPAForAPIServer:           {Default: false, PreRelease: featuregate.Alpha},
+	MutationAttribution:             {Default: false, PreRelease: featuregate.Alpha},
+	DNSRecordHealthProbes:           {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
@@ -135,3 +154,43 @@ func GetFeatures(featureGates ...featuregate.Feature) map[featuregate.Feature]fe
 
 	return out
 }
+
+// FeatureGateState describes the state of a feature gate of a Gardener component.
+type FeatureGateState struct {
+	// Name is the name of the feature gate.
+	Name string `json:"name"`
+	// Enabled specifies whether the feature gate is enabled.
+	Enabled bool `json:"enabled"`
+	// Default is the default value of the feature gate.
+	Default bool `json:"default"`
+	// LockToDefault specifies whether the feature gate is locked to its default value.
+	LockToDefault bool `json:"lockToDefault"`
+	// PreRelease is the maturity level of the feature gate.
+	PreRelease string `json:"preRelease,omitempty"`
+}
+
+// GetStates returns the states of all Gardener feature gates registered in the given feature gate, sorted by name.
+// Feature gates not defined by Gardener (e.g., those of the generic API server library) are omitted.
+func GetStates(featureGate featuregate.MutableFeatureGate) []FeatureGateState {
+	var states []FeatureGateState
+
+	for name, spec := range featureGate.GetAll() {
+		if _, ok := AllFeatureGates[name]; !ok {
+			continue
+		}
+
+		states = append(states, FeatureGateState{
+			Name:          string(name),
+			Enabled:       featureGate.Enabled(name),
+			Default:       spec.Default,
+			LockToDefault: spec.LockToDefault,
+			PreRelease:    string(spec.PreRelease),
+		})
+	}
+
+	slices.SortFunc(states, func(a, b FeatureGateState) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return states
+}
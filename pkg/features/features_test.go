@@ -21,4 +21,20 @@ var _ = Describe("Features", func() {
 			}))
 		})
 	})
+
+	Describe("#GetStates", func() {
+		It("should return the sorted states of the registered Gardener feature gates", func() {
+			featureGate := featuregate.NewFeatureGate()
+			Expect(featureGate.Add(GetFeatures(IPv6SingleStack, CoreDNSQueryRewriting))).To(Succeed())
+			Expect(featureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+				"Foo": {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
+			})).To(Succeed())
+			Expect(featureGate.SetFromMap(map[string]bool{string(IPv6SingleStack): true})).To(Succeed())
+
+			Expect(GetStates(featureGate)).To(Equal([]FeatureGateState{
+				{Name: "CoreDNSQueryRewriting", Enabled: true, Default: true, PreRelease: "BETA"},
+				{Name: "IPv6SingleStack", Enabled: true, Default: false, PreRelease: "ALPHA"},
+			}))
+		})
+	})
 })
@@ -6,15 +6,20 @@ package helper
 
 import (
 	"errors"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // SeedNameFromSeedConfig returns an empty string if the given seed config is nil, or the
@@ -99,6 +104,25 @@ func IsEventLoggingEnabled(c *config.GardenletConfiguration) bool {
 		*c.Logging.ShootEventLogging.Enabled
 }
 
+// IsSlowRequestLoggingEnabled returns true if capturing slow requests of shoot kube-apiservers is enabled.
+func IsSlowRequestLoggingEnabled(c *config.GardenletConfiguration) bool {
+	return c != nil && c.Logging != nil &&
+		c.Logging.ShootSlowRequestLogging != nil &&
+		c.Logging.ShootSlowRequestLogging.Enabled != nil &&
+		*c.Logging.ShootSlowRequestLogging.Enabled
+}
+
+// GetSlowRequestLoggingThreshold returns the minimum latency of requests to shoot kube-apiservers to be captured.
+// It defaults to 1s if the threshold is not configured.
+func GetSlowRequestLoggingThreshold(c *config.GardenletConfiguration) time.Duration {
+	if c != nil && c.Logging != nil &&
+		c.Logging.ShootSlowRequestLogging != nil &&
+		c.Logging.ShootSlowRequestLogging.Threshold != nil {
+		return c.Logging.ShootSlowRequestLogging.Threshold.Duration
+	}
+	return time.Second
+}
+
 // IsMonitoringEnabled returns true if the monitoring stack for shoot clusters is enabled. Default is enabled.
 func IsMonitoringEnabled(c *config.GardenletConfiguration) bool {
 	if c != nil && c.Monitoring != nil && c.Monitoring.Shoot != nil &&
@@ -115,3 +139,70 @@ func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *m
 	}
 	return nil
 }
+
+// EventCorrelatorOptions returns the options for the correlator which aggregates and deduplicates the events emitted
+// to the garden cluster. Options which are not configured are left empty so that the defaults of client-go apply.
+func EventCorrelatorOptions(c *config.GardenletConfiguration) record.CorrelatorOptions {
+	var options record.CorrelatorOptions
+	if c == nil || c.Events == nil {
+		return options
+	}
+
+	if c.Events.MaxSimilarEvents != nil {
+		options.MaxEvents = int(*c.Events.MaxSimilarEvents)
+	}
+	if c.Events.AggregationInterval != nil {
+		options.MaxIntervalInSeconds = int(c.Events.AggregationInterval.Duration.Seconds())
+	}
+	if c.Events.CacheSize != nil {
+		options.LRUCacheSize = int(*c.Events.CacheSize)
+	}
+	if c.Events.SpamFilterQPS != nil {
+		options.QPS = *c.Events.SpamFilterQPS
+	}
+	if c.Events.SpamFilterBurst != nil {
+		options.BurstSize = int(*c.Events.SpamFilterBurst)
+	}
+
+	return options
+}
+
+// CertificateValidities returns the certificate validities per certificate type which are passed to the secrets manager.
+// Certificate types which are not configured are omitted so that the defaults of the secrets manager apply.
+func CertificateValidities(c *config.GardenletConfiguration) map[secretsutils.CertType]secretsmanager.CertificateValidity {
+	if c == nil || c.SecretsManager == nil {
+		return nil
+	}
+
+	validities := make(map[secretsutils.CertType]secretsmanager.CertificateValidity)
+	for certType, validityConfig := range map[secretsutils.CertType]*config.CertificateValidityConfiguration{
+		secretsutils.CACert:     c.SecretsManager.CertificateAuthorities,
+		secretsutils.ServerCert: c.SecretsManager.ServerCertificates,
+		secretsutils.ClientCert: c.SecretsManager.ClientCertificates,
+	} {
+		if validityConfig == nil {
+			continue
+		}
+
+		var validity secretsmanager.CertificateValidity
+		if validityConfig.Validity != nil {
+			validity.Validity = &validityConfig.Validity.Duration
+		}
+		if validityConfig.RenewAfterValidityPercentage != nil {
+			validity.RenewAfterValidityPercentage = int(*validityConfig.RenewAfterValidityPercentage)
+		}
+		validities[certType] = validity
+	}
+
+	return validities
+}
+
+// ArtifactMirror returns the registry mirror to which the artifacts required by gardenlet are synced. It returns nil if
+// the artifact pre-sync is not configured.
+func ArtifactMirror(c *config.GardenletConfiguration) (*oci.Mirror, error) {
+	if c == nil || c.ArtifactPreSync == nil {
+		return nil, nil
+	}
+
+	return oci.NewMirror(c.ArtifactPreSync.Registry, c.ArtifactPreSync.Insecure)
+}
@@ -10,12 +10,15 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 var _ = Describe("helper", func() {
@@ -266,6 +269,38 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#SlowRequestLoggingConfiguration", func() {
+		It("should return false and the default threshold when the GardenletConfiguration is nil", func() {
+			Expect(IsSlowRequestLoggingEnabled(nil)).To(BeFalse())
+			Expect(GetSlowRequestLoggingThreshold(nil)).To(Equal(time.Second))
+		})
+
+		It("should return false and the default threshold when ShootSlowRequestLogging is empty", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Logging: &config.Logging{
+					ShootSlowRequestLogging: &config.ShootSlowRequestLogging{},
+				},
+			}
+
+			Expect(IsSlowRequestLoggingEnabled(gardenletConfig)).To(BeFalse())
+			Expect(GetSlowRequestLoggingThreshold(gardenletConfig)).To(Equal(time.Second))
+		})
+
+		It("should return true and the configured threshold when the slow request logging is enabled", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Logging: &config.Logging{
+					ShootSlowRequestLogging: &config.ShootSlowRequestLogging{
+						Enabled:   ptr.To(true),
+						Threshold: &metav1.Duration{Duration: 5 * time.Second},
+					},
+				},
+			}
+
+			Expect(IsSlowRequestLoggingEnabled(gardenletConfig)).To(BeTrue())
+			Expect(GetSlowRequestLoggingThreshold(gardenletConfig)).To(Equal(5 * time.Second))
+		})
+	})
+
 	Describe("#GetManagedResourceProgressingThreshold", func() {
 		It("should return nil the GardenletConfiguration is nil", func() {
 			Expect(GetManagedResourceProgressingThreshold(nil)).To(BeNil())
@@ -308,4 +343,88 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#EventCorrelatorOptions", func() {
+		It("should return empty options when the GardenletConfiguration is nil", func() {
+			Expect(EventCorrelatorOptions(nil)).To(Equal(record.CorrelatorOptions{}))
+		})
+
+		It("should return empty options when the events configuration is not set", func() {
+			Expect(EventCorrelatorOptions(&config.GardenletConfiguration{})).To(Equal(record.CorrelatorOptions{}))
+		})
+
+		It("should return the configured options", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Events: &config.EventsConfiguration{
+					MaxSimilarEvents:    ptr.To[int32](5),
+					AggregationInterval: &metav1.Duration{Duration: 30 * time.Minute},
+					CacheSize:           ptr.To[int32](8192),
+					SpamFilterQPS:       ptr.To[float32](0.01),
+					SpamFilterBurst:     ptr.To[int32](10),
+				},
+			}
+
+			Expect(EventCorrelatorOptions(gardenletConfig)).To(Equal(record.CorrelatorOptions{
+				MaxEvents:            5,
+				MaxIntervalInSeconds: 1800,
+				LRUCacheSize:         8192,
+				QPS:                  0.01,
+				BurstSize:            10,
+			}))
+		})
+	})
+
+	Describe("#CertificateValidities", func() {
+		It("should return nil when the GardenletConfiguration is nil", func() {
+			Expect(CertificateValidities(nil)).To(BeNil())
+		})
+
+		It("should return nil when the secrets manager configuration is not set", func() {
+			Expect(CertificateValidities(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return the configured validities", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				SecretsManager: &config.SecretsManagerConfiguration{
+					CertificateAuthorities: &config.CertificateValidityConfiguration{
+						Validity: &metav1.Duration{Duration: 365 * 24 * time.Hour},
+					},
+					ServerCertificates: &config.CertificateValidityConfiguration{
+						Validity:                     &metav1.Duration{Duration: 30 * 24 * time.Hour},
+						RenewAfterValidityPercentage: ptr.To[int32](50),
+					},
+				},
+			}
+
+			Expect(CertificateValidities(gardenletConfig)).To(Equal(map[secretsutils.CertType]secretsmanager.CertificateValidity{
+				secretsutils.CACert:     {Validity: ptr.To(365 * 24 * time.Hour)},
+				secretsutils.ServerCert: {Validity: ptr.To(30 * 24 * time.Hour), RenewAfterValidityPercentage: 50},
+			}))
+		})
+	})
+
+	Describe("#ArtifactMirror", func() {
+		It("should return nil when the GardenletConfiguration is nil", func() {
+			Expect(ArtifactMirror(nil)).To(BeNil())
+		})
+
+		It("should return nil when the artifact pre-sync is not configured", func() {
+			Expect(ArtifactMirror(&config.GardenletConfiguration{})).To(BeNil())
+		})
+
+		It("should return the registry mirror", func() {
+			mirror, err := ArtifactMirror(&config.GardenletConfiguration{
+				ArtifactPreSync: &config.ArtifactPreSyncConfiguration{Registry: "registry.garden.svc:5000"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mirror).NotTo(BeNil())
+		})
+
+		It("should fail for an invalid registry mirror address", func() {
+			_, err := ArtifactMirror(&config.GardenletConfiguration{
+				ArtifactPreSync: &config.ArtifactPreSyncConfiguration{Registry: "registry.garden.svc:5000/Mirror"},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
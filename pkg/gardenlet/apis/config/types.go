@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfig "k8s.io/component-base/config"
+	tracingv1 "k8s.io/component-base/tracing/api/v1"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 )
@@ -35,6 +36,9 @@ type GardenletConfiguration struct {
 	LeaderElection *componentbaseconfig.LeaderElectionConfiguration
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string
+	// LogLevelOverrides maps names of controllers to log levels which override the global log level for the respective
+	// controller, e.g., `shoot-care: debug`. Each level must be one of [info,debug,error].
+	LogLevelOverrides map[string]string
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat string
 	// Server defines the configuration of the HTTP server.
@@ -63,6 +67,69 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// Tracing contains an optional configuration for exporting OpenTelemetry spans of the reconciliation flows via
+	// OTLP. If not set, no spans are exported.
+	Tracing *tracingv1.TracingConfiguration
+	// Events contains optional settings for the aggregation and deduplication of events which are emitted to the garden
+	// cluster.
+	Events *EventsConfiguration
+	// SecretsManager contains optional settings for the certificates generated for the seed and the shoot control
+	// planes.
+	SecretsManager *SecretsManagerConfiguration
+	// ArtifactPreSync contains optional settings for mirroring the artifacts required by gardenlet to a registry in the
+	// seed cluster, enabling the operation of seeds without access to the upstream registries.
+	ArtifactPreSync *ArtifactPreSyncConfiguration
+}
+
+// ArtifactPreSyncConfiguration contains settings for mirroring the container images and Helm charts required for
+// operating the seed to a registry in the seed cluster.
+type ArtifactPreSyncConfiguration struct {
+	// Registry is the address of the registry mirror (`host[:port][/path]`). The artifacts are stored below this address
+	// in repositories consisting of the host and the repository of their upstream registry.
+	Registry string
+	// Insecure specifies whether the registry mirror is accessed via plain HTTP.
+	Insecure bool
+	// KubernetesVersions is the set of Kubernetes versions for which the container images are mirrored. It must contain
+	// all Kubernetes versions of the shoots hosted by the seed, including versions they are about to be upgraded to.
+	KubernetesVersions []string
+	// SyncPeriod is the duration how often the artifacts are synced to the registry mirror.
+	SyncPeriod *metav1.Duration
+}
+
+// SecretsManagerConfiguration contains settings for the certificates generated for the seed and the shoot control
+// planes.
+type SecretsManagerConfiguration struct {
+	// CertificateAuthorities contains the validity settings for CA certificates. They are only considered for CAs which
+	// are rotated automatically (i.e., not for the CAs of shoot clusters).
+	CertificateAuthorities *CertificateValidityConfiguration
+	// ServerCertificates contains the validity settings for server certificates.
+	ServerCertificates *CertificateValidityConfiguration
+	// ClientCertificates contains the validity settings for client certificates.
+	ClientCertificates *CertificateValidityConfiguration
+}
+
+// CertificateValidityConfiguration contains validity settings for certificates.
+type CertificateValidityConfiguration struct {
+	// Validity is the maximum validity of generated certificates.
+	Validity *metav1.Duration
+	// RenewAfterValidityPercentage is the percentage of the validity after which certificates are renewed.
+	RenewAfterValidityPercentage *int32
+}
+
+// EventsConfiguration contains settings for the aggregation and deduplication of events which are emitted to the
+// garden cluster.
+type EventsConfiguration struct {
+	// MaxSimilarEvents is the number of events with the same reason for the same involved object but with different
+	// messages after which further events are aggregated into a single event.
+	MaxSimilarEvents *int32
+	// AggregationInterval is the time window in which similar events are aggregated.
+	AggregationInterval *metav1.Duration
+	// CacheSize is the number of fingerprints of recently emitted events which are remembered for deduplication.
+	CacheSize *int32
+	// SpamFilterQPS is the rate at which events for the same involved object may be emitted once the burst is exhausted.
+	SpamFilterQPS *float32
+	// SpamFilterBurst is the number of events for the same involved object which may be emitted at once.
+	SpamFilterBurst *int32
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -86,6 +153,9 @@ type GardenClientConnection struct {
 	// KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig
 	// secrets.
 	KubeconfigValidity *KubeconfigValidity
+	// AdaptiveRateLimiting configures the adaptive tuning of the client-side rate limit for requests to the garden
+	// cluster. If set, the configured qps is only the initial rate.
+	AdaptiveRateLimiting *AdaptiveRateLimiting
 }
 
 // KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig secrets.
@@ -110,6 +180,19 @@ type KubeconfigValidity struct {
 // for the proxy server to use when communicating with the seed apiserver.
 type SeedClientConnection struct {
 	componentbaseconfig.ClientConnectionConfiguration
+	// AdaptiveRateLimiting configures the adaptive tuning of the client-side rate limit for requests to the seed
+	// cluster. If set, the configured qps is only the initial rate.
+	AdaptiveRateLimiting *AdaptiveRateLimiting
+}
+
+// AdaptiveRateLimiting configures the adaptive tuning of a client-side rate limit. The rate is increased while
+// requests are processed successfully and decreased when the API server rejects requests with '429 Too Many Requests',
+// e.g., because of API Priority and Fairness.
+type AdaptiveRateLimiting struct {
+	// MinQPS is the lower bound for the effective queries per second.
+	MinQPS float32
+	// MaxQPS is the upper bound for the effective queries per second.
+	MaxQPS float32
 }
 
 // ShootClientConnection specifies the client connection settings
@@ -136,12 +219,22 @@ type GardenletControllerConfiguration struct {
 	Seed *SeedControllerConfiguration
 	// SeedCare defines the configuration of the SeedCare controller.
 	SeedCare *SeedCareControllerConfiguration
+	// SeedGarbageCollection defines the configuration of the SeedGarbageCollection controller.
+	SeedGarbageCollection *SeedGarbageCollectionControllerConfiguration
+	// SeedZoneOutage defines the configuration of the SeedZoneOutage controller.
+	SeedZoneOutage *SeedZoneOutageControllerConfiguration
 	// Shoot defines the configuration of the Shoot controller.
 	Shoot *ShootControllerConfiguration
 	// ShootCare defines the configuration of the ShootCare controller.
 	ShootCare *ShootCareControllerConfiguration
 	// ShootState defines the configuration of the ShootState controller.
 	ShootState *ShootStateControllerConfiguration
+	// ShootAvailability defines the configuration of the ShootAvailability controller.
+	ShootAvailability *ShootAvailabilityControllerConfiguration
+	// ShootLeftover defines the configuration of the ShootLeftover controller.
+	ShootLeftover *ShootLeftoverControllerConfiguration
+	// ShootSecurityAdvisory defines the configuration of the ShootSecurityAdvisory controller.
+	ShootSecurityAdvisory *ShootSecurityAdvisoryControllerConfiguration
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller.
 	NetworkPolicy *NetworkPolicyControllerConfiguration
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
@@ -185,6 +278,16 @@ type ControllerInstallationControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs *int
+	// OCIVerification contains settings for verifying the signatures of Helm charts which are pulled from OCI
+	// registries for ControllerDeployments.
+	OCIVerification *OCIVerification
+}
+
+// OCIVerification contains settings for verifying the cosign signatures of Helm charts pulled from OCI registries.
+type OCIVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys. Charts pulled from OCI registries are only deployed if they
+	// carry a valid cosign signature of one of these keys.
+	PublicKeys []string
 }
 
 // ControllerInstallationCareControllerConfiguration defines the configuration of the ControllerInstallationCare
@@ -196,6 +299,21 @@ type ControllerInstallationCareControllerConfiguration struct {
 	// SyncPeriod is the duration how often the existing resources are reconciled (how
 	// often the health check of ControllerInstallations is performed.
 	SyncPeriod *metav1.Duration
+	// HeartbeatRestart configures the automatic restart of extensions whose heartbeat is stale.
+	HeartbeatRestart *ExtensionHeartbeatRestart
+}
+
+// ExtensionHeartbeatRestart defines the configuration of the automatic restart of extensions whose heartbeat is stale.
+type ExtensionHeartbeatRestart struct {
+	// Enabled specifies whether extensions whose heartbeat is stale are restarted automatically.
+	Enabled bool
+	// Threshold configures the duration after which gardenlet considers the heartbeat of an extension as stale.
+	// Defaults to 5m.
+	Threshold *metav1.Duration
+	// MaxRestartsPerHour is the maximum number of restarts of an extension within one hour. It prevents restarting an
+	// extension over and over again if the restart does not help.
+	// Defaults to 3.
+	MaxRestartsPerHour *int32
 }
 
 // ControllerInstallationRequiredControllerConfiguration defines the configuration of the ControllerInstallationRequired
@@ -244,6 +362,40 @@ type ShootControllerConfiguration struct {
 	// DNSEntryTTLSeconds is the TTL in seconds that is being used for DNS entries when reconciling shoots.
 	// Default: 120s
 	DNSEntryTTLSeconds *int64
+	// Prioritization configures the priority bands which allow user-triggered operations to take precedence over
+	// periodic syncs of healthy shoots.
+	Prioritization *ShootPrioritization
+}
+
+// ShootPriorityBandName is the name of a priority band of the shoot controller.
+type ShootPriorityBandName string
+
+const (
+	// ShootPriorityBandUserTriggered is the priority band for operations triggered by users, i.e., creations, deletions,
+	// spec changes, reconcile or retry operations, migrations and restorations.
+	ShootPriorityBandUserTriggered ShootPriorityBandName = "UserTriggered"
+	// ShootPriorityBandRetry is the priority band for retries of operations which were not successful.
+	ShootPriorityBandRetry ShootPriorityBandName = "Retry"
+	// ShootPriorityBandPeriodicSync is the priority band for periodic syncs of shoots whose last operation succeeded.
+	ShootPriorityBandPeriodicSync ShootPriorityBandName = "PeriodicSync"
+)
+
+// ShootPrioritization configures the priority bands of the shoot controller. Each reconciliation request is assigned
+// to a priority band. Requests of a band which already uses its maximum number of workers are deferred, so that
+// workers remain available for requests of other bands.
+type ShootPrioritization struct {
+	// Bands is the list of priority bands and their limits. Bands which are not listed are not limited.
+	Bands []ShootPriorityBand
+	// DeferralPeriod is the duration after which deferred requests are enqueued again.
+	DeferralPeriod *metav1.Duration
+}
+
+// ShootPriorityBand configures the limit of a priority band of the shoot controller.
+type ShootPriorityBand struct {
+	// Name is the name of the priority band.
+	Name ShootPriorityBandName
+	// MaxConcurrentReconciles is the maximum number of workers which reconcile shoots of this band at the same time.
+	MaxConcurrentReconciles int
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -268,6 +420,47 @@ type ShootCareControllerConfiguration struct {
 	// practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings)
 	// is enabled.
 	WebhookRemediatorEnabled *bool
+	// Remediations configures the remediations of problematic configurations in shoot clusters.
+	Remediations *ShootCareRemediations
+	// NodeLeaseProbe configures the probe which scales down kube-controller-manager, machine-controller-manager and
+	// cluster-autoscaler of shoot clusters whose nodes can no longer renew their leases, e.g., because they cannot
+	// reach the kube-apiserver. The probe is only active if the prober is enabled in the dependency-watchdog settings
+	// of the seed.
+	NodeLeaseProbe *NodeLeaseProbe
+}
+
+// NodeLeaseProbe configures the probe for the node leases of shoot clusters.
+type NodeLeaseProbe struct {
+	// Timeout is the timeout for the requests to the kube-apiserver of the shoot cluster.
+	Timeout *metav1.Duration
+	// NodeMonitorGracePeriod is the duration after which a node lease which has not been renewed is considered as
+	// expired. It should match the node monitor grace period of kube-controller-manager.
+	NodeMonitorGracePeriod *metav1.Duration
+	// FailurePercentage is the percentage of expired node leases at which the controllers are scaled down.
+	FailurePercentage *int32
+}
+
+// ShootCareRemediations configures the remediations of problematic configurations in shoot clusters which are
+// performed by the ShootCare controller.
+type ShootCareRemediations struct {
+	// WebhookBestPractices specifies whether webhooks not following the Kubernetes best practices are remediated.
+	// If not set, the value of WebhookRemediatorEnabled is used.
+	WebhookBestPractices *bool
+	// UnavailableAPIServices configures the deletion of aggregated APIServices which are unavailable for a long time.
+	// Such APIServices block the garbage collection and the deletion of namespaces in the shoot cluster.
+	UnavailableAPIServices *UnavailableAPIServicesRemediation
+	// OrphanedKubeSystemWebhooks specifies whether mutating webhooks intercepting requests in the kube-system
+	// namespace are removed if the service backing them does not exist.
+	OrphanedKubeSystemWebhooks *bool
+}
+
+// UnavailableAPIServicesRemediation configures the remediation of unavailable aggregated APIServices.
+type UnavailableAPIServicesRemediation struct {
+	// Enabled specifies whether unavailable aggregated APIServices are deleted.
+	Enabled bool
+	// Threshold is the duration an aggregated APIService must be unavailable before it is deleted.
+	// Defaults to 1h.
+	Threshold *metav1.Duration
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -280,6 +473,27 @@ type SeedCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// SeedGarbageCollectionControllerConfiguration defines the configuration of the SeedGarbageCollection controller.
+type SeedGarbageCollectionControllerConfiguration struct {
+	// SyncPeriod is the duration how often the seed cluster is checked for orphaned resources.
+	SyncPeriod *metav1.Duration
+	// GracePeriod is the duration resources must be orphaned before they are cleaned up.
+	GracePeriod *metav1.Duration
+	// DryRun specifies whether orphaned resources are only reported instead of being cleaned up.
+	DryRun *bool
+}
+
+// SeedZoneOutageControllerConfiguration defines the configuration of the SeedZoneOutage controller.
+type SeedZoneOutageControllerConfiguration struct {
+	// SyncPeriod is the duration how often the availability of the seed cluster's zones is checked.
+	SyncPeriod *metav1.Duration
+	// OutageThreshold is the duration all nodes of a zone must not be ready before the zone is considered unavailable.
+	OutageThreshold *metav1.Duration
+	// DegradationMode specifies whether control plane pods of highly available shoots are proactively moved away from
+	// unavailable zones.
+	DegradationMode *bool
+}
+
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
 type ShootStateControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
@@ -289,6 +503,36 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootAvailabilityControllerConfiguration defines the configuration of the ShootAvailability controller.
+type ShootAvailabilityControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the availability of the Shoots' API servers is computed.
+	SyncPeriod *metav1.Duration
+	// Objective is the monthly availability objective of the Shoots' API servers in percent. It is used to compute
+	// the error budget.
+	Objective *float64
+}
+
+// ShootSecurityAdvisoryControllerConfiguration defines the configuration of the ShootSecurityAdvisory controller.
+type ShootSecurityAdvisoryControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the security advisories of Shoots are recomputed.
+	SyncPeriod *metav1.Duration
+	// FeedConfigMapName is the name of the ConfigMap in the garden namespace of the seed cluster which contains the CVE
+	// feed. The controller is only enabled if the name is set.
+	FeedConfigMapName *string
+}
+
+// ShootLeftoverControllerConfiguration defines the configuration of the ShootLeftover controller.
+type ShootLeftoverControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the inventory of leftover resources of Shoots in deletion is refreshed.
+	SyncPeriod *metav1.Duration
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -306,6 +550,13 @@ type ConditionThreshold struct {
 	Type string
 	// Duration is the duration how long the condition can stay in the progressing state.
 	Duration metav1.Duration
+	// StaleDuration overrides the threshold when gardenlet considers a health check report of an extension CRD
+	// contributing to this condition as outdated. Only evaluated by the ShootCare controller.
+	StaleDuration *metav1.Duration
+	// Severity is the severity of the condition if its health checks fail. With severity `Warning`, the condition is
+	// kept in the progressing state instead of being set to `False`. Only evaluated by the ShootCare controller.
+	// Defaults to `Error`.
+	Severity *string
 }
 
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy
@@ -392,6 +643,14 @@ type ShootEventLogging struct {
 	Enabled *bool
 }
 
+// ShootSlowRequestLogging contains configurations for capturing slow requests of shoot kube-apiservers.
+type ShootSlowRequestLogging struct {
+	// Enabled is used to enable or disable capturing slow requests of shoot kube-apiservers.
+	Enabled *bool
+	// Threshold is the minimum latency of a request to be captured.
+	Threshold *metav1.Duration
+}
+
 // Logging contains configuration for the logging stack.
 type Logging struct {
 	// Enabled is used to enable or disable logging stack for clusters.
@@ -402,6 +661,8 @@ type Logging struct {
 	ShootNodeLogging *ShootNodeLogging
 	// ShootEventLogging contains configurations for the shoot event logger.
 	ShootEventLogging *ShootEventLogging
+	// ShootSlowRequestLogging contains configurations for capturing slow requests of shoot kube-apiservers.
+	ShootSlowRequestLogging *ShootSlowRequestLogging
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -514,6 +775,21 @@ type ExposureClassHandler struct {
 	// SNI contains optional configuration for a dedicated ingressgateway belonging to
 	// an exposure class handler.
 	SNI *SNI
+	// Autoscaling contains optional autoscaling settings for the dedicated ingressgateway belonging to
+	// an exposure class handler.
+	Autoscaling *ExposureClassHandlerAutoscaling
+}
+
+// ExposureClassHandlerAutoscaling contains autoscaling settings for the ingressgateway of an exposure class handler.
+type ExposureClassHandlerAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the ingressgateway.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of replicas of the ingressgateway.
+	MaxReplicas *int32
+	// TargetConnections is the average number of active downstream connections per replica the ingressgateway is
+	// scaled on. This requires a custom metrics API serving the `envoy_server_total_connections` pod metric in the
+	// seed cluster.
+	TargetConnections *int32
 }
 
 // LoadBalancerServiceConfig contains configuration which is used to configure the underlying
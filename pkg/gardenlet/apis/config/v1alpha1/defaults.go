@@ -158,9 +158,24 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.SeedCare == nil {
 		obj.SeedCare = &SeedCareControllerConfiguration{}
 	}
+	if obj.SeedGarbageCollection == nil {
+		obj.SeedGarbageCollection = &SeedGarbageCollectionControllerConfiguration{}
+	}
+	if obj.SeedZoneOutage == nil {
+		obj.SeedZoneOutage = &SeedZoneOutageControllerConfiguration{}
+	}
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootAvailability == nil {
+		obj.ShootAvailability = &ShootAvailabilityControllerConfiguration{}
+	}
+	if obj.ShootLeftover == nil {
+		obj.ShootLeftover = &ShootLeftoverControllerConfiguration{}
+	}
+	if obj.ShootSecurityAdvisory == nil {
+		obj.ShootSecurityAdvisory = &ShootSecurityAdvisoryControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -268,6 +283,18 @@ func SetDefaults_ControllerInstallationCareControllerConfiguration(obj *Controll
 	}
 }
 
+// SetDefaults_ExtensionHeartbeatRestart sets defaults for the automatic restart of extensions whose heartbeat is stale.
+func SetDefaults_ExtensionHeartbeatRestart(obj *ExtensionHeartbeatRestart) {
+	if obj.Threshold == nil {
+		v := metav1.Duration{Duration: 5 * time.Minute}
+		obj.Threshold = &v
+	}
+
+	if obj.MaxRestartsPerHour == nil {
+		obj.MaxRestartsPerHour = ptr.To[int32](3)
+	}
+}
+
 // SetDefaults_ControllerInstallationRequiredControllerConfiguration sets defaults for the ControllerInstallationRequired controller.
 func SetDefaults_ControllerInstallationRequiredControllerConfiguration(obj *ControllerInstallationRequiredControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -302,6 +329,32 @@ func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfigur
 	}
 }
 
+// SetDefaults_SeedGarbageCollectionControllerConfiguration sets defaults for the seed garbage collection controller.
+func SetDefaults_SeedGarbageCollectionControllerConfiguration(obj *SeedGarbageCollectionControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.GracePeriod == nil {
+		obj.GracePeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+	if obj.DryRun == nil {
+		obj.DryRun = ptr.To(true)
+	}
+}
+
+// SetDefaults_SeedZoneOutageControllerConfiguration sets defaults for the seed zone outage controller.
+func SetDefaults_SeedZoneOutageControllerConfiguration(obj *SeedZoneOutageControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+	if obj.OutageThreshold == nil {
+		obj.OutageThreshold = &metav1.Duration{Duration: 2 * time.Minute}
+	}
+	if obj.DegradationMode == nil {
+		obj.DegradationMode = ptr.To(true)
+	}
+}
+
 // SetDefaults_ShootControllerConfiguration sets defaults for the shoot controller.
 func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -334,6 +387,13 @@ func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration)
 	}
 }
 
+// SetDefaults_ShootPrioritization sets defaults for the prioritization of the shoot controller.
+func SetDefaults_ShootPrioritization(obj *ShootPrioritization) {
+	if obj.DeferralPeriod == nil {
+		obj.DeferralPeriod = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_ShootCareControllerConfiguration sets defaults for the shoot care controller.
 func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -350,6 +410,32 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 		v := StaleExtensionHealthChecks{Enabled: true}
 		obj.StaleExtensionHealthChecks = &v
 	}
+
+	if obj.NodeLeaseProbe == nil {
+		obj.NodeLeaseProbe = &NodeLeaseProbe{}
+	}
+}
+
+// SetDefaults_NodeLeaseProbe sets defaults for the node lease probe of the shoot care controller.
+func SetDefaults_NodeLeaseProbe(obj *NodeLeaseProbe) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+
+	if obj.NodeMonitorGracePeriod == nil {
+		obj.NodeMonitorGracePeriod = &metav1.Duration{Duration: 40 * time.Second}
+	}
+
+	if obj.FailurePercentage == nil {
+		obj.FailurePercentage = ptr.To[int32](60)
+	}
+}
+
+// SetDefaults_UnavailableAPIServicesRemediation sets defaults for the remediation of unavailable APIServices.
+func SetDefaults_UnavailableAPIServicesRemediation(obj *UnavailableAPIServicesRemediation) {
+	if obj.Threshold == nil {
+		obj.Threshold = &metav1.Duration{Duration: time.Hour}
+	}
 }
 
 // SetDefaults_StaleExtensionHealthChecks sets defaults for the stale extension health checks.
@@ -370,6 +456,39 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootAvailabilityControllerConfiguration sets defaults for the shoot availability controller.
+func SetDefaults_ShootAvailabilityControllerConfiguration(obj *ShootAvailabilityControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 15 * time.Minute}
+	}
+	if obj.Objective == nil {
+		obj.Objective = ptr.To(99.5)
+	}
+}
+
+// SetDefaults_ShootLeftoverControllerConfiguration sets defaults for the shoot leftover controller.
+func SetDefaults_ShootLeftoverControllerConfiguration(obj *ShootLeftoverControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
+// SetDefaults_ShootSecurityAdvisoryControllerConfiguration sets defaults for the shoot security advisory controller.
+func SetDefaults_ShootSecurityAdvisoryControllerConfiguration(obj *ShootSecurityAdvisoryControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -472,6 +591,15 @@ func SetDefaults_Logging(obj *Logging) {
 	if obj.ShootEventLogging.Enabled == nil {
 		obj.ShootEventLogging.Enabled = obj.Enabled
 	}
+	if obj.ShootSlowRequestLogging == nil {
+		obj.ShootSlowRequestLogging = &ShootSlowRequestLogging{}
+	}
+	if obj.ShootSlowRequestLogging.Enabled == nil {
+		obj.ShootSlowRequestLogging.Enabled = ptr.To(false)
+	}
+	if obj.ShootSlowRequestLogging.Threshold == nil {
+		obj.ShootSlowRequestLogging.Threshold = &metav1.Duration{Duration: time.Second}
+	}
 }
 
 // SetDefaults_ETCDConfig sets defaults for the ETCD.
@@ -516,3 +644,10 @@ func SetDefaults_BackupCompactionController(obj *BackupCompactionController) {
 		obj.MetricsScrapeWaitDuration = &metav1.Duration{Duration: 60 * time.Second}
 	}
 }
+
+// SetDefaults_ArtifactPreSyncConfiguration sets defaults for the artifact pre-sync configuration.
+func SetDefaults_ArtifactPreSyncConfiguration(obj *ArtifactPreSyncConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
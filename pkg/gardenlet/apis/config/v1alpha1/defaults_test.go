@@ -44,7 +44,12 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot).NotTo(BeNil())
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
+			Expect(obj.Controllers.SeedGarbageCollection).NotTo(BeNil())
+			Expect(obj.Controllers.SeedZoneOutage).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootAvailability).NotTo(BeNil())
+			Expect(obj.Controllers.ShootLeftover).NotTo(BeNil())
+			Expect(obj.Controllers.ShootSecurityAdvisory).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
@@ -272,6 +277,24 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ControllerInstallationCare.ConcurrentSyncs).To(PointTo(Equal(10)))
 			Expect(obj.Controllers.ControllerInstallationCare.SyncPeriod).To(PointTo(Equal(v)))
 		})
+
+		It("should not default the heartbeat restart configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ControllerInstallationCare.HeartbeatRestart).To(BeNil())
+		})
+
+		It("should default the heartbeat restart configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ControllerInstallationCare: &ControllerInstallationCareControllerConfiguration{
+					HeartbeatRestart: &ExtensionHeartbeatRestart{Enabled: true},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ControllerInstallationCare.HeartbeatRestart.Threshold).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.Controllers.ControllerInstallationCare.HeartbeatRestart.MaxRestartsPerHour).To(PointTo(Equal(int32(3))))
+		})
 	})
 
 	Describe("ControllerInstallationRequiredControllerConfiguration defaulting", func() {
@@ -335,6 +358,62 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedGarbageCollectionControllerConfiguration defaulting", func() {
+		It("should default the seed garbage collection controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedGarbageCollection.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.SeedGarbageCollection.GracePeriod).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+			Expect(obj.Controllers.SeedGarbageCollection.DryRun).To(PointTo(BeTrue()))
+		})
+
+		It("should not overwrite already set values for the seed garbage collection controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: 10 * time.Minute}
+			gracePeriod := metav1.Duration{Duration: 2 * time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedGarbageCollection: &SeedGarbageCollectionControllerConfiguration{
+					SyncPeriod:  &syncPeriod,
+					GracePeriod: &gracePeriod,
+					DryRun:      ptr.To(false),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedGarbageCollection.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+			Expect(obj.Controllers.SeedGarbageCollection.GracePeriod).To(PointTo(Equal(gracePeriod)))
+			Expect(obj.Controllers.SeedGarbageCollection.DryRun).To(PointTo(BeFalse()))
+		})
+	})
+
+	Describe("SeedZoneOutageControllerConfiguration defaulting", func() {
+		It("should default the seed zone outage controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedZoneOutage.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+			Expect(obj.Controllers.SeedZoneOutage.OutageThreshold).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Minute})))
+			Expect(obj.Controllers.SeedZoneOutage.DegradationMode).To(PointTo(BeTrue()))
+		})
+
+		It("should not overwrite already set values for the seed zone outage controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Minute}
+			outageThreshold := metav1.Duration{Duration: 5 * time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				SeedZoneOutage: &SeedZoneOutageControllerConfiguration{
+					SyncPeriod:      &syncPeriod,
+					OutageThreshold: &outageThreshold,
+					DegradationMode: ptr.To(false),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.SeedZoneOutage.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+			Expect(obj.Controllers.SeedZoneOutage.OutageThreshold).To(PointTo(Equal(outageThreshold)))
+			Expect(obj.Controllers.SeedZoneOutage.DegradationMode).To(PointTo(BeFalse()))
+		})
+	})
+
 	Describe("ShootControllerConfiguration defaulting", func() {
 		It("should default the shoot controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -368,6 +447,17 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot.RetryDuration).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
 			Expect(obj.Controllers.Shoot.DNSEntryTTLSeconds).To(PointTo(Equal(int64(60))))
 		})
+
+		It("should default the deferral period of the prioritization", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				Shoot: &ShootControllerConfiguration{
+					Prioritization: &ShootPrioritization{},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.Shoot.Prioritization.DeferralPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+		})
 	})
 
 	Describe("ShootCareControllerConfiguration defaulting", func() {
@@ -418,6 +508,60 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("UnavailableAPIServicesRemediation defaulting", func() {
+		It("should default the threshold of the remediation of unavailable APIServices", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					Remediations: &ShootCareRemediations{UnavailableAPIServices: &UnavailableAPIServicesRemediation{Enabled: true}},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.Remediations.UnavailableAPIServices.Threshold).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
+		It("should not overwrite already set values for the remediation of unavailable APIServices", func() {
+			threshold := metav1.Duration{Duration: 2 * time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					Remediations: &ShootCareRemediations{UnavailableAPIServices: &UnavailableAPIServicesRemediation{Enabled: true, Threshold: &threshold}},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.Remediations.UnavailableAPIServices.Threshold).To(PointTo(Equal(threshold)))
+		})
+	})
+
+	Describe("NodeLeaseProbe defaulting", func() {
+		It("should default the node lease probe", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.NodeLeaseProbe).To(Equal(&NodeLeaseProbe{
+				Timeout:                &metav1.Duration{Duration: 10 * time.Second},
+				NodeMonitorGracePeriod: &metav1.Duration{Duration: 40 * time.Second},
+				FailurePercentage:      ptr.To[int32](60),
+			}))
+		})
+
+		It("should not overwrite already set values for the node lease probe", func() {
+			nodeLeaseProbe := &NodeLeaseProbe{
+				Timeout:                &metav1.Duration{Duration: 5 * time.Second},
+				NodeMonitorGracePeriod: &metav1.Duration{Duration: time.Minute},
+				FailurePercentage:      ptr.To[int32](80),
+			}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{NodeLeaseProbe: nodeLeaseProbe.DeepCopy()},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.NodeLeaseProbe).To(Equal(nodeLeaseProbe))
+		})
+	})
+
 	Describe("ShootStateControllerConfiguration defaulting", func() {
 		It("should default the shoot state controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -442,6 +586,84 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootAvailabilityControllerConfiguration defaulting", func() {
+		It("should default the shoot availability controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAvailability.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootAvailability.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+			Expect(obj.Controllers.ShootAvailability.Objective).To(PointTo(Equal(99.5)))
+		})
+
+		It("should not overwrite already set values for the shoot availability controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootAvailability: &ShootAvailabilityControllerConfiguration{
+					ConcurrentSyncs: ptr.To(10),
+					SyncPeriod:      &syncPeriod,
+					Objective:       ptr.To(99.95),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAvailability.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootAvailability.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+			Expect(obj.Controllers.ShootAvailability.Objective).To(PointTo(Equal(99.95)))
+		})
+	})
+
+	Describe("ShootLeftoverControllerConfiguration defaulting", func() {
+		It("should default the shoot leftover controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootLeftover.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootLeftover.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+		})
+
+		It("should not overwrite already set values for the shoot leftover controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootLeftover: &ShootLeftoverControllerConfiguration{
+					ConcurrentSyncs: ptr.To(10),
+					SyncPeriod:      &syncPeriod,
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootLeftover.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootLeftover.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
+	})
+
+	Describe("ShootSecurityAdvisoryControllerConfiguration defaulting", func() {
+		It("should default the shoot security advisory controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootSecurityAdvisory.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootSecurityAdvisory.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootSecurityAdvisory.FeedConfigMapName).To(BeNil())
+		})
+
+		It("should not overwrite already set values for the shoot security advisory controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Minute}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootSecurityAdvisory: &ShootSecurityAdvisoryControllerConfiguration{
+					ConcurrentSyncs:   ptr.To(10),
+					SyncPeriod:        &syncPeriod,
+					FeedConfigMapName: ptr.To("cve-feed"),
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootSecurityAdvisory.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootSecurityAdvisory.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+			Expect(obj.Controllers.ShootSecurityAdvisory.FeedConfigMapName).To(PointTo(Equal("cve-feed")))
+		})
+	})
+
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -597,6 +819,9 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Logging.Vali.Garden.Storage).To(PointTo(Equal(resource.MustParse("100Gi"))))
 			Expect(obj.Logging.ShootEventLogging).NotTo(BeNil())
 			Expect(obj.Logging.ShootEventLogging.Enabled).To(PointTo(Equal(false)))
+			Expect(obj.Logging.ShootSlowRequestLogging).NotTo(BeNil())
+			Expect(obj.Logging.ShootSlowRequestLogging.Enabled).To(PointTo(Equal(false)))
+			Expect(obj.Logging.ShootSlowRequestLogging.Threshold).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 		})
 
 		It("should not overwrite already set values for the logging configuration", func() {
@@ -618,6 +843,10 @@ var _ = Describe("Defaults", func() {
 				ShootEventLogging: &ShootEventLogging{
 					Enabled: ptr.To(false),
 				},
+				ShootSlowRequestLogging: &ShootSlowRequestLogging{
+					Enabled:   ptr.To(true),
+					Threshold: &metav1.Duration{Duration: 3 * time.Second},
+				},
 			}
 
 			obj.Logging = expectedLogging.DeepCopy()
@@ -824,6 +1053,28 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Monitoring.Shoot.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("ArtifactPreSyncConfiguration defaulting", func() {
+		It("should not default the artifact pre-sync configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ArtifactPreSync).To(BeNil())
+		})
+
+		It("should default the sync period", func() {
+			obj.ArtifactPreSync = &ArtifactPreSyncConfiguration{Registry: "registry.garden.svc:5000"}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ArtifactPreSync.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
+		It("should not overwrite an already set sync period", func() {
+			obj.ArtifactPreSync = &ArtifactPreSyncConfiguration{Registry: "registry.garden.svc:5000", SyncPeriod: &metav1.Duration{Duration: time.Minute}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ArtifactPreSync.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
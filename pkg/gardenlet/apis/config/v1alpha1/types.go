@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	tracingv1 "k8s.io/component-base/tracing/api/v1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
@@ -43,6 +44,10 @@ type GardenletConfiguration struct {
 	LeaderElection *componentbaseconfigv1alpha1.LeaderElectionConfiguration `json:"leaderElection,omitempty"`
 	// LogLevel is the level/severity for the logs. Must be one of [info,debug,error].
 	LogLevel string `json:"logLevel"`
+	// LogLevelOverrides maps names of controllers to log levels which override the global log level for the respective
+	// controller, e.g., `shoot-care: debug`. Each level must be one of [info,debug,error].
+	// +optional
+	LogLevelOverrides map[string]string `json:"logLevelOverrides,omitempty"`
 	// LogFormat is the output format for the logs. Must be one of [text,json].
 	LogFormat string `json:"logFormat"`
 	// Server defines the configuration of the HTTP server.
@@ -80,6 +85,93 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// Tracing contains an optional configuration for exporting OpenTelemetry spans of the reconciliation flows via
+	// OTLP. If not set, no spans are exported.
+	// +optional
+	Tracing *tracingv1.TracingConfiguration `json:"tracing,omitempty"`
+	// Events contains optional settings for the aggregation and deduplication of events which are emitted to the garden
+	// cluster.
+	// +optional
+	Events *EventsConfiguration `json:"events,omitempty"`
+	// SecretsManager contains optional settings for the certificates generated for the seed and the shoot control
+	// planes.
+	// +optional
+	SecretsManager *SecretsManagerConfiguration `json:"secretsManager,omitempty"`
+	// ArtifactPreSync contains optional settings for mirroring the artifacts required by gardenlet to a registry in the
+	// seed cluster, enabling the operation of seeds without access to the upstream registries.
+	// +optional
+	ArtifactPreSync *ArtifactPreSyncConfiguration `json:"artifactPreSync,omitempty"`
+}
+
+// ArtifactPreSyncConfiguration contains settings for mirroring the container images and Helm charts required for
+// operating the seed to a registry in the seed cluster.
+type ArtifactPreSyncConfiguration struct {
+	// Registry is the address of the registry mirror (`host[:port][/path]`). The artifacts are stored below this address
+	// in repositories consisting of the host and the repository of their upstream registry.
+	Registry string `json:"registry"`
+	// Insecure specifies whether the registry mirror is accessed via plain HTTP.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// KubernetesVersions is the set of Kubernetes versions for which the container images are mirrored. It must contain
+	// all Kubernetes versions of the shoots hosted by the seed, including versions they are about to be upgraded to.
+	// +optional
+	KubernetesVersions []string `json:"kubernetesVersions,omitempty"`
+	// SyncPeriod is the duration how often the artifacts are synced to the registry mirror.
+	// Default: 1h
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// SecretsManagerConfiguration contains settings for the certificates generated for the seed and the shoot control
+// planes.
+type SecretsManagerConfiguration struct {
+	// CertificateAuthorities contains the validity settings for CA certificates. They are only considered for CAs which
+	// are rotated automatically (i.e., not for the CAs of shoot clusters).
+	// +optional
+	CertificateAuthorities *CertificateValidityConfiguration `json:"certificateAuthorities,omitempty"`
+	// ServerCertificates contains the validity settings for server certificates.
+	// +optional
+	ServerCertificates *CertificateValidityConfiguration `json:"serverCertificates,omitempty"`
+	// ClientCertificates contains the validity settings for client certificates.
+	// +optional
+	ClientCertificates *CertificateValidityConfiguration `json:"clientCertificates,omitempty"`
+}
+
+// CertificateValidityConfiguration contains validity settings for certificates.
+type CertificateValidityConfiguration struct {
+	// Validity is the maximum validity of generated certificates. Certificates which do not specify a validity
+	// themselves (by default, 10 years) or which specify a longer validity are generated with this validity. Changed
+	// values only apply to certificates generated afterwards, e.g., when they are renewed or rotated. It must be longer
+	// than 10 days since certificates are always renewed at the latest 10 days before they expire.
+	// +optional
+	Validity *metav1.Duration `json:"validity,omitempty"`
+	// RenewAfterValidityPercentage is the percentage of the validity after which certificates are renewed. It is only
+	// applied if the component requesting the certificate does not specify a percentage itself. Defaults to 80.
+	// +optional
+	RenewAfterValidityPercentage *int32 `json:"renewAfterValidityPercentage,omitempty"`
+}
+
+// EventsConfiguration contains settings for the aggregation and deduplication of events which are emitted to the
+// garden cluster.
+type EventsConfiguration struct {
+	// MaxSimilarEvents is the number of events with the same reason for the same involved object but with different
+	// messages after which further events are aggregated into a single event. Defaults to 10.
+	// +optional
+	MaxSimilarEvents *int32 `json:"maxSimilarEvents,omitempty"`
+	// AggregationInterval is the time window in which similar events are aggregated. Defaults to 10m.
+	// +optional
+	AggregationInterval *metav1.Duration `json:"aggregationInterval,omitempty"`
+	// CacheSize is the number of fingerprints of recently emitted events which are remembered for deduplication.
+	// Defaults to 4096.
+	// +optional
+	CacheSize *int32 `json:"cacheSize,omitempty"`
+	// SpamFilterQPS is the rate at which events for the same involved object may be emitted once the burst is exhausted.
+	// Defaults to one event every five minutes.
+	// +optional
+	SpamFilterQPS *float32 `json:"spamFilterQPS,omitempty"`
+	// SpamFilterBurst is the number of events for the same involved object which may be emitted at once. Defaults to 25.
+	// +optional
+	SpamFilterBurst *int32 `json:"spamFilterBurst,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -108,6 +200,10 @@ type GardenClientConnection struct {
 	// secrets.
 	// +optional
 	KubeconfigValidity *KubeconfigValidity `json:"kubeconfigValidity,omitempty"`
+	// AdaptiveRateLimiting configures the adaptive tuning of the client-side rate limit for requests to the garden
+	// cluster. If set, the configured qps is only the initial rate.
+	// +optional
+	AdaptiveRateLimiting *AdaptiveRateLimiting `json:"adaptiveRateLimiting,omitempty"`
 }
 
 // KubeconfigValidity allows configuring certain settings related to the validity and rotation of kubeconfig secrets.
@@ -135,6 +231,20 @@ type KubeconfigValidity struct {
 // for the proxy server to use when communicating with the seed apiserver.
 type SeedClientConnection struct {
 	componentbaseconfigv1alpha1.ClientConnectionConfiguration `json:",inline"`
+	// AdaptiveRateLimiting configures the adaptive tuning of the client-side rate limit for requests to the seed
+	// cluster. If set, the configured qps is only the initial rate.
+	// +optional
+	AdaptiveRateLimiting *AdaptiveRateLimiting `json:"adaptiveRateLimiting,omitempty"`
+}
+
+// AdaptiveRateLimiting configures the adaptive tuning of a client-side rate limit. The rate is increased while
+// requests are processed successfully and decreased when the API server rejects requests with '429 Too Many Requests',
+// e.g., because of API Priority and Fairness.
+type AdaptiveRateLimiting struct {
+	// MinQPS is the lower bound for the effective queries per second.
+	MinQPS float32 `json:"minQPS"`
+	// MaxQPS is the upper bound for the effective queries per second.
+	MaxQPS float32 `json:"maxQPS"`
 }
 
 // ShootClientConnection specifies the client connection settings
@@ -169,6 +279,12 @@ type GardenletControllerConfiguration struct {
 	// SeedCare defines the configuration of the SeedCare controller.
 	// +optional
 	SeedCare *SeedCareControllerConfiguration `json:"seedCare,omitempty"`
+	// SeedGarbageCollection defines the configuration of the SeedGarbageCollection controller.
+	// +optional
+	SeedGarbageCollection *SeedGarbageCollectionControllerConfiguration `json:"seedGarbageCollection,omitempty"`
+	// SeedZoneOutage defines the configuration of the SeedZoneOutage controller.
+	// +optional
+	SeedZoneOutage *SeedZoneOutageControllerConfiguration `json:"seedZoneOutage,omitempty"`
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootControllerConfiguration `json:"shoot,omitempty"`
@@ -178,6 +294,15 @@ type GardenletControllerConfiguration struct {
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
+	// ShootAvailability defines the configuration of the ShootAvailability controller.
+	// +optional
+	ShootAvailability *ShootAvailabilityControllerConfiguration `json:"shootAvailability,omitempty"`
+	// ShootLeftover defines the configuration of the ShootLeftover controller.
+	// +optional
+	ShootLeftover *ShootLeftoverControllerConfiguration `json:"shootLeftover,omitempty"`
+	// ShootSecurityAdvisory defines the configuration of the ShootSecurityAdvisory controller.
+	// +optional
+	ShootSecurityAdvisory *ShootSecurityAdvisoryControllerConfiguration `json:"shootSecurityAdvisory,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -231,6 +356,17 @@ type ControllerInstallationControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// OCIVerification contains settings for verifying the signatures of Helm charts which are pulled from OCI
+	// registries for ControllerDeployments.
+	// +optional
+	OCIVerification *OCIVerification `json:"ociVerification,omitempty"`
+}
+
+// OCIVerification contains settings for verifying the cosign signatures of Helm charts pulled from OCI registries.
+type OCIVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys. Charts pulled from OCI registries are only deployed if they
+	// carry a valid cosign signature of one of these keys.
+	PublicKeys []string `json:"publicKeys"`
 }
 
 // ControllerInstallationCareControllerConfiguration defines the configuration of the ControllerInstallationCare
@@ -244,6 +380,24 @@ type ControllerInstallationCareControllerConfiguration struct {
 	// often the health check of ControllerInstallations is performed.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// HeartbeatRestart configures the automatic restart of extensions whose heartbeat is stale.
+	// +optional
+	HeartbeatRestart *ExtensionHeartbeatRestart `json:"heartbeatRestart,omitempty"`
+}
+
+// ExtensionHeartbeatRestart defines the configuration of the automatic restart of extensions whose heartbeat is stale.
+type ExtensionHeartbeatRestart struct {
+	// Enabled specifies whether extensions whose heartbeat is stale are restarted automatically.
+	Enabled bool `json:"enabled"`
+	// Threshold configures the duration after which gardenlet considers the heartbeat of an extension as stale.
+	// Defaults to 5m.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
+	// MaxRestartsPerHour is the maximum number of restarts of an extension within one hour. It prevents restarting an
+	// extension over and over again if the restart does not help.
+	// Defaults to 3.
+	// +optional
+	MaxRestartsPerHour *int32 `json:"maxRestartsPerHour,omitempty"`
 }
 
 // ControllerInstallationRequiredControllerConfiguration defines the configuration of the ControllerInstallationRequired
@@ -303,6 +457,44 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// Prioritization configures the priority bands which allow user-triggered operations to take precedence over
+	// periodic syncs of healthy shoots.
+	// +optional
+	Prioritization *ShootPrioritization `json:"prioritization,omitempty"`
+}
+
+// ShootPriorityBandName is the name of a priority band of the shoot controller.
+type ShootPriorityBandName string
+
+const (
+	// ShootPriorityBandUserTriggered is the priority band for operations triggered by users, i.e., creations, deletions,
+	// spec changes, reconcile or retry operations, migrations and restorations.
+	ShootPriorityBandUserTriggered ShootPriorityBandName = "UserTriggered"
+	// ShootPriorityBandRetry is the priority band for retries of operations which were not successful.
+	ShootPriorityBandRetry ShootPriorityBandName = "Retry"
+	// ShootPriorityBandPeriodicSync is the priority band for periodic syncs of shoots whose last operation succeeded.
+	ShootPriorityBandPeriodicSync ShootPriorityBandName = "PeriodicSync"
+)
+
+// ShootPrioritization configures the priority bands of the shoot controller. Each reconciliation request is assigned
+// to a priority band. Requests of a band which already uses its maximum number of workers are deferred, so that
+// workers remain available for requests of other bands.
+type ShootPrioritization struct {
+	// Bands is the list of priority bands and their limits. Bands which are not listed are not limited.
+	// +optional
+	Bands []ShootPriorityBand `json:"bands,omitempty"`
+	// DeferralPeriod is the duration after which deferred requests are enqueued again.
+	// Default: 10s
+	// +optional
+	DeferralPeriod *metav1.Duration `json:"deferralPeriod,omitempty"`
+}
+
+// ShootPriorityBand configures the limit of a priority band of the shoot controller.
+type ShootPriorityBand struct {
+	// Name is the name of the priority band. Must be one of [UserTriggered,Retry,PeriodicSync].
+	Name ShootPriorityBandName `json:"name"`
+	// MaxConcurrentReconciles is the maximum number of workers which reconcile shoots of this band at the same time.
+	MaxConcurrentReconciles int `json:"maxConcurrentReconciles"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -333,6 +525,59 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// Remediations configures the remediations of problematic configurations in shoot clusters.
+	// +optional
+	Remediations *ShootCareRemediations `json:"remediations,omitempty"`
+	// NodeLeaseProbe configures the probe which scales down kube-controller-manager, machine-controller-manager and
+	// cluster-autoscaler of shoot clusters whose nodes can no longer renew their leases, e.g., because they cannot
+	// reach the kube-apiserver. The probe is only active if the prober is enabled in the dependency-watchdog settings
+	// of the seed.
+	// +optional
+	NodeLeaseProbe *NodeLeaseProbe `json:"nodeLeaseProbe,omitempty"`
+}
+
+// NodeLeaseProbe configures the probe for the node leases of shoot clusters.
+type NodeLeaseProbe struct {
+	// Timeout is the timeout for the requests to the kube-apiserver of the shoot cluster.
+	// Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// NodeMonitorGracePeriod is the duration after which a node lease which has not been renewed is considered as
+	// expired. It should match the node monitor grace period of kube-controller-manager.
+	// Defaults to 40s.
+	// +optional
+	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty"`
+	// FailurePercentage is the percentage of expired node leases at which the controllers are scaled down.
+	// Defaults to 60.
+	// +optional
+	FailurePercentage *int32 `json:"failurePercentage,omitempty"`
+}
+
+// ShootCareRemediations configures the remediations of problematic configurations in shoot clusters which are
+// performed by the ShootCare controller.
+type ShootCareRemediations struct {
+	// WebhookBestPractices specifies whether webhooks not following the Kubernetes best practices are remediated.
+	// If not set, the value of WebhookRemediatorEnabled is used.
+	// +optional
+	WebhookBestPractices *bool `json:"webhookBestPractices,omitempty"`
+	// UnavailableAPIServices configures the deletion of aggregated APIServices which are unavailable for a long time.
+	// Such APIServices block the garbage collection and the deletion of namespaces in the shoot cluster.
+	// +optional
+	UnavailableAPIServices *UnavailableAPIServicesRemediation `json:"unavailableAPIServices,omitempty"`
+	// OrphanedKubeSystemWebhooks specifies whether mutating webhooks intercepting requests in the kube-system
+	// namespace are removed if the service backing them does not exist.
+	// +optional
+	OrphanedKubeSystemWebhooks *bool `json:"orphanedKubeSystemWebhooks,omitempty"`
+}
+
+// UnavailableAPIServicesRemediation configures the remediation of unavailable aggregated APIServices.
+type UnavailableAPIServicesRemediation struct {
+	// Enabled specifies whether unavailable aggregated APIServices are deleted.
+	Enabled bool `json:"enabled"`
+	// Threshold is the duration an aggregated APIService must be unavailable before it is deleted.
+	// Defaults to 1h.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -347,6 +592,36 @@ type SeedCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// SeedGarbageCollectionControllerConfiguration defines the configuration of the SeedGarbageCollection controller.
+type SeedGarbageCollectionControllerConfiguration struct {
+	// SyncPeriod is the duration how often the seed cluster is checked for orphaned resources. Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// GracePeriod is the duration resources must be orphaned before they are cleaned up. Defaults to 24h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+	// DryRun specifies whether orphaned resources are only reported (via logs and events on the Seed) instead of
+	// being cleaned up. Defaults to true.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// SeedZoneOutageControllerConfiguration defines the configuration of the SeedZoneOutage controller.
+type SeedZoneOutageControllerConfiguration struct {
+	// SyncPeriod is the duration how often the availability of the seed cluster's zones is checked. Defaults to 30s.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// OutageThreshold is the duration all nodes of a zone must not be ready before the zone is considered unavailable.
+	// Defaults to 2m.
+	// +optional
+	OutageThreshold *metav1.Duration `json:"outageThreshold,omitempty"`
+	// DegradationMode specifies whether control plane pods of highly available shoots are proactively moved away from
+	// unavailable zones. If disabled, zone outages are only reported via the ZoneOutage condition of the Seed.
+	// Defaults to true.
+	// +optional
+	DegradationMode *bool `json:"degradationMode,omitempty"`
+}
+
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
 type ShootStateControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
@@ -358,6 +633,44 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ShootAvailabilityControllerConfiguration defines the configuration of the ShootAvailability controller.
+type ShootAvailabilityControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the availability of the Shoots' API servers is computed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Objective is the monthly availability objective of the Shoots' API servers in percent. It is used to compute
+	// the error budget. Defaults to 99.5.
+	// +optional
+	Objective *float64 `json:"objective,omitempty"`
+}
+
+// ShootSecurityAdvisoryControllerConfiguration defines the configuration of the ShootSecurityAdvisory controller.
+type ShootSecurityAdvisoryControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the security advisories of Shoots are recomputed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// FeedConfigMapName is the name of the ConfigMap in the garden namespace of the seed cluster which contains the CVE
+	// feed. The controller is only enabled if the name is set.
+	// +optional
+	FeedConfigMapName *string `json:"feedConfigMapName,omitempty"`
+}
+
+// ShootLeftoverControllerConfiguration defines the configuration of the ShootLeftover controller.
+type ShootLeftoverControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the inventory of leftover resources of Shoots in deletion is refreshed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -376,6 +689,15 @@ type ConditionThreshold struct {
 	Type string `json:"type"`
 	// Duration is the duration how long the condition can stay in the progressing state.
 	Duration metav1.Duration `json:"duration"`
+	// StaleDuration overrides the threshold when gardenlet considers a health check report of an extension CRD
+	// contributing to this condition as outdated. Only evaluated by the ShootCare controller.
+	// +optional
+	StaleDuration *metav1.Duration `json:"staleDuration,omitempty"`
+	// Severity is the severity of the condition if its health checks fail. With severity `Warning`, the condition is
+	// kept in the progressing state instead of being set to `False`. Only evaluated by the ShootCare controller.
+	// Defaults to `Error`.
+	// +optional
+	Severity *string `json:"severity,omitempty"`
 }
 
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy
@@ -477,6 +799,20 @@ type ShootEventLogging struct {
 	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 }
 
+// ShootSlowRequestLogging contains configurations for capturing slow requests of shoot kube-apiservers. If enabled,
+// the kube-apiservers write audit events to their standard output which are filtered by their latency and shipped
+// into the logging stack.
+type ShootSlowRequestLogging struct {
+	// Enabled is used to enable or disable capturing slow requests of shoot kube-apiservers.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Threshold is the minimum latency of a request to be captured.
+	// Defaults to 1s.
+	// +optional
+	Threshold *metav1.Duration `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+}
+
 // Logging contains configuration for the logging stack.
 type Logging struct {
 	// Enabled is used to enable or disable logging stack for clusters.
@@ -491,6 +827,9 @@ type Logging struct {
 	// ShootEventLogging contains configurations for the shoot event logger.
 	// +optional
 	ShootEventLogging *ShootEventLogging `json:"shootEventLogging,omitempty" yaml:"shootEventLogging,omitempty"`
+	// ShootSlowRequestLogging contains configurations for capturing slow requests of shoot kube-apiservers.
+	// +optional
+	ShootSlowRequestLogging *ShootSlowRequestLogging `json:"shootSlowRequestLogging,omitempty" yaml:"shootSlowRequestLogging,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -626,6 +965,25 @@ type ExposureClassHandler struct {
 	// an exposure class handler.
 	// +optional
 	SNI *SNI `json:"sni,omitempty"`
+	// Autoscaling contains optional autoscaling settings for the dedicated ingressgateway belonging to
+	// an exposure class handler.
+	// +optional
+	Autoscaling *ExposureClassHandlerAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// ExposureClassHandlerAutoscaling contains autoscaling settings for the ingressgateway of an exposure class handler.
+type ExposureClassHandlerAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the ingressgateway.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas of the ingressgateway.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// TargetConnections is the average number of active downstream connections per replica the ingressgateway is
+	// scaled on. This requires a custom metrics API serving the `envoy_server_total_connections` pod metric in the
+	// seed cluster.
+	// +optional
+	TargetConnections *int32 `json:"targetConnections,omitempty"`
 }
 
 // LoadBalancerServiceConfig contains configuration which is used to configure the underlying
@@ -22,6 +22,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	apiv1 "k8s.io/component-base/tracing/api/v1"
 )
 
 func init() {
@@ -31,6 +32,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdaptiveRateLimiting)(nil), (*config.AdaptiveRateLimiting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(a.(*AdaptiveRateLimiting), b.(*config.AdaptiveRateLimiting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AdaptiveRateLimiting)(nil), (*AdaptiveRateLimiting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(a.(*config.AdaptiveRateLimiting), b.(*AdaptiveRateLimiting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ArtifactPreSyncConfiguration)(nil), (*config.ArtifactPreSyncConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ArtifactPreSyncConfiguration_To_config_ArtifactPreSyncConfiguration(a.(*ArtifactPreSyncConfiguration), b.(*config.ArtifactPreSyncConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ArtifactPreSyncConfiguration)(nil), (*ArtifactPreSyncConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ArtifactPreSyncConfiguration_To_v1alpha1_ArtifactPreSyncConfiguration(a.(*config.ArtifactPreSyncConfiguration), b.(*ArtifactPreSyncConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketControllerConfiguration)(nil), (*config.BackupBucketControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BackupBucketControllerConfiguration_To_config_BackupBucketControllerConfiguration(a.(*BackupBucketControllerConfiguration), b.(*config.BackupBucketControllerConfiguration), scope)
 	}); err != nil {
@@ -71,6 +92,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateValidityConfiguration)(nil), (*config.CertificateValidityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificateValidityConfiguration_To_config_CertificateValidityConfiguration(a.(*CertificateValidityConfiguration), b.(*config.CertificateValidityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CertificateValidityConfiguration)(nil), (*CertificateValidityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CertificateValidityConfiguration_To_v1alpha1_CertificateValidityConfiguration(a.(*config.CertificateValidityConfiguration), b.(*CertificateValidityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -151,6 +182,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EventsConfiguration)(nil), (*config.EventsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EventsConfiguration_To_config_EventsConfiguration(a.(*EventsConfiguration), b.(*config.EventsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EventsConfiguration)(nil), (*EventsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EventsConfiguration_To_v1alpha1_EventsConfiguration(a.(*config.EventsConfiguration), b.(*EventsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassHandler)(nil), (*config.ExposureClassHandler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(a.(*ExposureClassHandler), b.(*config.ExposureClassHandler), scope)
 	}); err != nil {
@@ -161,6 +202,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExposureClassHandlerAutoscaling)(nil), (*config.ExposureClassHandlerAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExposureClassHandlerAutoscaling_To_config_ExposureClassHandlerAutoscaling(a.(*ExposureClassHandlerAutoscaling), b.(*config.ExposureClassHandlerAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExposureClassHandlerAutoscaling)(nil), (*ExposureClassHandlerAutoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExposureClassHandlerAutoscaling_To_v1alpha1_ExposureClassHandlerAutoscaling(a.(*config.ExposureClassHandlerAutoscaling), b.(*ExposureClassHandlerAutoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExtensionHeartbeatRestart)(nil), (*config.ExtensionHeartbeatRestart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExtensionHeartbeatRestart_To_config_ExtensionHeartbeatRestart(a.(*ExtensionHeartbeatRestart), b.(*config.ExtensionHeartbeatRestart), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ExtensionHeartbeatRestart)(nil), (*ExtensionHeartbeatRestart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ExtensionHeartbeatRestart_To_v1alpha1_ExtensionHeartbeatRestart(a.(*config.ExtensionHeartbeatRestart), b.(*ExtensionHeartbeatRestart), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenClientConnection)(nil), (*config.GardenClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenClientConnection_To_config_GardenClientConnection(a.(*GardenClientConnection), b.(*config.GardenClientConnection), scope)
 	}); err != nil {
@@ -261,6 +322,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeLeaseProbe)(nil), (*config.NodeLeaseProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeLeaseProbe_To_config_NodeLeaseProbe(a.(*NodeLeaseProbe), b.(*config.NodeLeaseProbe), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeLeaseProbe)(nil), (*NodeLeaseProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeLeaseProbe_To_v1alpha1_NodeLeaseProbe(a.(*config.NodeLeaseProbe), b.(*NodeLeaseProbe), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeToleration)(nil), (*config.NodeToleration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeToleration_To_config_NodeToleration(a.(*NodeToleration), b.(*config.NodeToleration), scope)
 	}); err != nil {
@@ -271,6 +342,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCIVerification)(nil), (*config.OCIVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCIVerification_To_config_OCIVerification(a.(*OCIVerification), b.(*config.OCIVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OCIVerification)(nil), (*OCIVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OCIVerification_To_v1alpha1_OCIVerification(a.(*config.OCIVerification), b.(*OCIVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteWriteMonitoringConfig)(nil), (*config.RemoteWriteMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteWriteMonitoringConfig_To_config_RemoteWriteMonitoringConfig(a.(*RemoteWriteMonitoringConfig), b.(*config.RemoteWriteMonitoringConfig), scope)
 	}); err != nil {
@@ -311,6 +392,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecretsManagerConfiguration)(nil), (*config.SecretsManagerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(a.(*SecretsManagerConfiguration), b.(*config.SecretsManagerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SecretsManagerConfiguration)(nil), (*SecretsManagerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(a.(*config.SecretsManagerConfiguration), b.(*SecretsManagerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedCareControllerConfiguration)(nil), (*config.SeedCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(a.(*SeedCareControllerConfiguration), b.(*config.SeedCareControllerConfiguration), scope)
 	}); err != nil {
@@ -351,6 +442,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedGarbageCollectionControllerConfiguration)(nil), (*config.SeedGarbageCollectionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedGarbageCollectionControllerConfiguration_To_config_SeedGarbageCollectionControllerConfiguration(a.(*SeedGarbageCollectionControllerConfiguration), b.(*config.SeedGarbageCollectionControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedGarbageCollectionControllerConfiguration)(nil), (*SeedGarbageCollectionControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedGarbageCollectionControllerConfiguration_To_v1alpha1_SeedGarbageCollectionControllerConfiguration(a.(*config.SeedGarbageCollectionControllerConfiguration), b.(*SeedGarbageCollectionControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedZoneOutageControllerConfiguration)(nil), (*config.SeedZoneOutageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedZoneOutageControllerConfiguration_To_config_SeedZoneOutageControllerConfiguration(a.(*SeedZoneOutageControllerConfiguration), b.(*config.SeedZoneOutageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedZoneOutageControllerConfiguration)(nil), (*SeedZoneOutageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedZoneOutageControllerConfiguration_To_v1alpha1_SeedZoneOutageControllerConfiguration(a.(*config.SeedZoneOutageControllerConfiguration), b.(*SeedZoneOutageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -371,6 +482,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAvailabilityControllerConfiguration)(nil), (*config.ShootAvailabilityControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootAvailabilityControllerConfiguration_To_config_ShootAvailabilityControllerConfiguration(a.(*ShootAvailabilityControllerConfiguration), b.(*config.ShootAvailabilityControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootAvailabilityControllerConfiguration)(nil), (*ShootAvailabilityControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootAvailabilityControllerConfiguration_To_v1alpha1_ShootAvailabilityControllerConfiguration(a.(*config.ShootAvailabilityControllerConfiguration), b.(*ShootAvailabilityControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCareControllerConfiguration)(nil), (*config.ShootCareControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(a.(*ShootCareControllerConfiguration), b.(*config.ShootCareControllerConfiguration), scope)
 	}); err != nil {
@@ -381,6 +502,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootCareRemediations)(nil), (*config.ShootCareRemediations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCareRemediations_To_config_ShootCareRemediations(a.(*ShootCareRemediations), b.(*config.ShootCareRemediations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootCareRemediations)(nil), (*ShootCareRemediations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootCareRemediations_To_v1alpha1_ShootCareRemediations(a.(*config.ShootCareRemediations), b.(*ShootCareRemediations), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootClientConnection)(nil), (*config.ShootClientConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootClientConnection_To_config_ShootClientConnection(a.(*ShootClientConnection), b.(*config.ShootClientConnection), scope)
 	}); err != nil {
@@ -411,6 +542,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootLeftoverControllerConfiguration)(nil), (*config.ShootLeftoverControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootLeftoverControllerConfiguration_To_config_ShootLeftoverControllerConfiguration(a.(*ShootLeftoverControllerConfiguration), b.(*config.ShootLeftoverControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootLeftoverControllerConfiguration)(nil), (*ShootLeftoverControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootLeftoverControllerConfiguration_To_v1alpha1_ShootLeftoverControllerConfiguration(a.(*config.ShootLeftoverControllerConfiguration), b.(*ShootLeftoverControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootMonitoringConfig)(nil), (*config.ShootMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(a.(*ShootMonitoringConfig), b.(*config.ShootMonitoringConfig), scope)
 	}); err != nil {
@@ -431,6 +572,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootPrioritization)(nil), (*config.ShootPrioritization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPrioritization_To_config_ShootPrioritization(a.(*ShootPrioritization), b.(*config.ShootPrioritization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootPrioritization)(nil), (*ShootPrioritization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootPrioritization_To_v1alpha1_ShootPrioritization(a.(*config.ShootPrioritization), b.(*ShootPrioritization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPriorityBand)(nil), (*config.ShootPriorityBand)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPriorityBand_To_config_ShootPriorityBand(a.(*ShootPriorityBand), b.(*config.ShootPriorityBand), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootPriorityBand)(nil), (*ShootPriorityBand)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootPriorityBand_To_v1alpha1_ShootPriorityBand(a.(*config.ShootPriorityBand), b.(*ShootPriorityBand), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootSecurityAdvisoryControllerConfiguration)(nil), (*config.ShootSecurityAdvisoryControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootSecurityAdvisoryControllerConfiguration_To_config_ShootSecurityAdvisoryControllerConfiguration(a.(*ShootSecurityAdvisoryControllerConfiguration), b.(*config.ShootSecurityAdvisoryControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootSecurityAdvisoryControllerConfiguration)(nil), (*ShootSecurityAdvisoryControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootSecurityAdvisoryControllerConfiguration_To_v1alpha1_ShootSecurityAdvisoryControllerConfiguration(a.(*config.ShootSecurityAdvisoryControllerConfiguration), b.(*ShootSecurityAdvisoryControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootSlowRequestLogging)(nil), (*config.ShootSlowRequestLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootSlowRequestLogging_To_config_ShootSlowRequestLogging(a.(*ShootSlowRequestLogging), b.(*config.ShootSlowRequestLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootSlowRequestLogging)(nil), (*ShootSlowRequestLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootSlowRequestLogging_To_v1alpha1_ShootSlowRequestLogging(a.(*config.ShootSlowRequestLogging), b.(*ShootSlowRequestLogging), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootStateControllerConfiguration)(nil), (*config.ShootStateControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(a.(*ShootStateControllerConfiguration), b.(*config.ShootStateControllerConfiguration), scope)
 	}); err != nil {
@@ -461,6 +642,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*UnavailableAPIServicesRemediation)(nil), (*config.UnavailableAPIServicesRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UnavailableAPIServicesRemediation_To_config_UnavailableAPIServicesRemediation(a.(*UnavailableAPIServicesRemediation), b.(*config.UnavailableAPIServicesRemediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.UnavailableAPIServicesRemediation)(nil), (*UnavailableAPIServicesRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_UnavailableAPIServicesRemediation_To_v1alpha1_UnavailableAPIServicesRemediation(a.(*config.UnavailableAPIServicesRemediation), b.(*UnavailableAPIServicesRemediation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VPAEvictionRequirementsControllerConfiguration)(nil), (*config.VPAEvictionRequirementsControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VPAEvictionRequirementsControllerConfiguration_To_config_VPAEvictionRequirementsControllerConfiguration(a.(*VPAEvictionRequirementsControllerConfiguration), b.(*config.VPAEvictionRequirementsControllerConfiguration), scope)
 	}); err != nil {
@@ -494,6 +685,54 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(in *AdaptiveRateLimiting, out *config.AdaptiveRateLimiting, s conversion.Scope) error {
+	out.MinQPS = in.MinQPS
+	out.MaxQPS = in.MaxQPS
+	return nil
+}
+
+// Convert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting is an autogenerated conversion function.
+func Convert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(in *AdaptiveRateLimiting, out *config.AdaptiveRateLimiting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdaptiveRateLimiting_To_config_AdaptiveRateLimiting(in, out, s)
+}
+
+func autoConvert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(in *config.AdaptiveRateLimiting, out *AdaptiveRateLimiting, s conversion.Scope) error {
+	out.MinQPS = in.MinQPS
+	out.MaxQPS = in.MaxQPS
+	return nil
+}
+
+// Convert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting is an autogenerated conversion function.
+func Convert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(in *config.AdaptiveRateLimiting, out *AdaptiveRateLimiting, s conversion.Scope) error {
+	return autoConvert_config_AdaptiveRateLimiting_To_v1alpha1_AdaptiveRateLimiting(in, out, s)
+}
+
+func autoConvert_v1alpha1_ArtifactPreSyncConfiguration_To_config_ArtifactPreSyncConfiguration(in *ArtifactPreSyncConfiguration, out *config.ArtifactPreSyncConfiguration, s conversion.Scope) error {
+	out.Registry = in.Registry
+	out.Insecure = in.Insecure
+	out.KubernetesVersions = *(*[]string)(unsafe.Pointer(&in.KubernetesVersions))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ArtifactPreSyncConfiguration_To_config_ArtifactPreSyncConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ArtifactPreSyncConfiguration_To_config_ArtifactPreSyncConfiguration(in *ArtifactPreSyncConfiguration, out *config.ArtifactPreSyncConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArtifactPreSyncConfiguration_To_config_ArtifactPreSyncConfiguration(in, out, s)
+}
+
+func autoConvert_config_ArtifactPreSyncConfiguration_To_v1alpha1_ArtifactPreSyncConfiguration(in *config.ArtifactPreSyncConfiguration, out *ArtifactPreSyncConfiguration, s conversion.Scope) error {
+	out.Registry = in.Registry
+	out.Insecure = in.Insecure
+	out.KubernetesVersions = *(*[]string)(unsafe.Pointer(&in.KubernetesVersions))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ArtifactPreSyncConfiguration_To_v1alpha1_ArtifactPreSyncConfiguration is an autogenerated conversion function.
+func Convert_config_ArtifactPreSyncConfiguration_To_v1alpha1_ArtifactPreSyncConfiguration(in *config.ArtifactPreSyncConfiguration, out *ArtifactPreSyncConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ArtifactPreSyncConfiguration_To_v1alpha1_ArtifactPreSyncConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_BackupBucketControllerConfiguration_To_config_BackupBucketControllerConfiguration(in *BackupBucketControllerConfiguration, out *config.BackupBucketControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -586,9 +825,33 @@ func Convert_config_BastionControllerConfiguration_To_v1alpha1_BastionController
 	return autoConvert_config_BastionControllerConfiguration_To_v1alpha1_BastionControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CertificateValidityConfiguration_To_config_CertificateValidityConfiguration(in *CertificateValidityConfiguration, out *config.CertificateValidityConfiguration, s conversion.Scope) error {
+	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
+	out.RenewAfterValidityPercentage = (*int32)(unsafe.Pointer(in.RenewAfterValidityPercentage))
+	return nil
+}
+
+// Convert_v1alpha1_CertificateValidityConfiguration_To_config_CertificateValidityConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CertificateValidityConfiguration_To_config_CertificateValidityConfiguration(in *CertificateValidityConfiguration, out *config.CertificateValidityConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateValidityConfiguration_To_config_CertificateValidityConfiguration(in, out, s)
+}
+
+func autoConvert_config_CertificateValidityConfiguration_To_v1alpha1_CertificateValidityConfiguration(in *config.CertificateValidityConfiguration, out *CertificateValidityConfiguration, s conversion.Scope) error {
+	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
+	out.RenewAfterValidityPercentage = (*int32)(unsafe.Pointer(in.RenewAfterValidityPercentage))
+	return nil
+}
+
+// Convert_config_CertificateValidityConfiguration_To_v1alpha1_CertificateValidityConfiguration is an autogenerated conversion function.
+func Convert_config_CertificateValidityConfiguration_To_v1alpha1_CertificateValidityConfiguration(in *config.CertificateValidityConfiguration, out *CertificateValidityConfiguration, s conversion.Scope) error {
+	return autoConvert_config_CertificateValidityConfiguration_To_v1alpha1_CertificateValidityConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
+	out.StaleDuration = (*v1.Duration)(unsafe.Pointer(in.StaleDuration))
+	out.Severity = (*string)(unsafe.Pointer(in.Severity))
 	return nil
 }
 
@@ -600,6 +863,8 @@ func Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *Condit
 func autoConvert_config_ConditionThreshold_To_v1alpha1_ConditionThreshold(in *config.ConditionThreshold, out *ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
+	out.StaleDuration = (*v1.Duration)(unsafe.Pointer(in.StaleDuration))
+	out.Severity = (*string)(unsafe.Pointer(in.Severity))
 	return nil
 }
 
@@ -611,6 +876,7 @@ func Convert_config_ConditionThreshold_To_v1alpha1_ConditionThreshold(in *config
 func autoConvert_v1alpha1_ControllerInstallationCareControllerConfiguration_To_config_ControllerInstallationCareControllerConfiguration(in *ControllerInstallationCareControllerConfiguration, out *config.ControllerInstallationCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.HeartbeatRestart = (*config.ExtensionHeartbeatRestart)(unsafe.Pointer(in.HeartbeatRestart))
 	return nil
 }
 
@@ -622,6 +888,7 @@ func Convert_v1alpha1_ControllerInstallationCareControllerConfiguration_To_confi
 func autoConvert_config_ControllerInstallationCareControllerConfiguration_To_v1alpha1_ControllerInstallationCareControllerConfiguration(in *config.ControllerInstallationCareControllerConfiguration, out *ControllerInstallationCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.HeartbeatRestart = (*ExtensionHeartbeatRestart)(unsafe.Pointer(in.HeartbeatRestart))
 	return nil
 }
 
@@ -632,6 +899,7 @@ func Convert_config_ControllerInstallationCareControllerConfiguration_To_v1alpha
 
 func autoConvert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_ControllerInstallationControllerConfiguration(in *ControllerInstallationControllerConfiguration, out *config.ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.OCIVerification = (*config.OCIVerification)(unsafe.Pointer(in.OCIVerification))
 	return nil
 }
 
@@ -642,6 +910,7 @@ func Convert_v1alpha1_ControllerInstallationControllerConfiguration_To_config_Co
 
 func autoConvert_config_ControllerInstallationControllerConfiguration_To_v1alpha1_ControllerInstallationControllerConfiguration(in *config.ControllerInstallationControllerConfiguration, out *ControllerInstallationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.OCIVerification = (*OCIVerification)(unsafe.Pointer(in.OCIVerification))
 	return nil
 }
 
@@ -762,12 +1031,41 @@ func Convert_config_ETCDController_To_v1alpha1_ETCDController(in *config.ETCDCon
 	return autoConvert_config_ETCDController_To_v1alpha1_ETCDController(in, out, s)
 }
 
+func autoConvert_v1alpha1_EventsConfiguration_To_config_EventsConfiguration(in *EventsConfiguration, out *config.EventsConfiguration, s conversion.Scope) error {
+	out.MaxSimilarEvents = (*int32)(unsafe.Pointer(in.MaxSimilarEvents))
+	out.AggregationInterval = (*v1.Duration)(unsafe.Pointer(in.AggregationInterval))
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
+	out.SpamFilterQPS = (*float32)(unsafe.Pointer(in.SpamFilterQPS))
+	out.SpamFilterBurst = (*int32)(unsafe.Pointer(in.SpamFilterBurst))
+	return nil
+}
+
+// Convert_v1alpha1_EventsConfiguration_To_config_EventsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_EventsConfiguration_To_config_EventsConfiguration(in *EventsConfiguration, out *config.EventsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EventsConfiguration_To_config_EventsConfiguration(in, out, s)
+}
+
+func autoConvert_config_EventsConfiguration_To_v1alpha1_EventsConfiguration(in *config.EventsConfiguration, out *EventsConfiguration, s conversion.Scope) error {
+	out.MaxSimilarEvents = (*int32)(unsafe.Pointer(in.MaxSimilarEvents))
+	out.AggregationInterval = (*v1.Duration)(unsafe.Pointer(in.AggregationInterval))
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
+	out.SpamFilterQPS = (*float32)(unsafe.Pointer(in.SpamFilterQPS))
+	out.SpamFilterBurst = (*int32)(unsafe.Pointer(in.SpamFilterBurst))
+	return nil
+}
+
+// Convert_config_EventsConfiguration_To_v1alpha1_EventsConfiguration is an autogenerated conversion function.
+func Convert_config_EventsConfiguration_To_v1alpha1_EventsConfiguration(in *config.EventsConfiguration, out *EventsConfiguration, s conversion.Scope) error {
+	return autoConvert_config_EventsConfiguration_To_v1alpha1_EventsConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(in *ExposureClassHandler, out *config.ExposureClassHandler, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_LoadBalancerServiceConfig_To_config_LoadBalancerServiceConfig(&in.LoadBalancerService, &out.LoadBalancerService, s); err != nil {
 		return err
 	}
 	out.SNI = (*config.SNI)(unsafe.Pointer(in.SNI))
+	out.Autoscaling = (*config.ExposureClassHandlerAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -782,6 +1080,7 @@ func autoConvert_config_ExposureClassHandler_To_v1alpha1_ExposureClassHandler(in
 		return err
 	}
 	out.SNI = (*SNI)(unsafe.Pointer(in.SNI))
+	out.Autoscaling = (*ExposureClassHandlerAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -790,6 +1089,54 @@ func Convert_config_ExposureClassHandler_To_v1alpha1_ExposureClassHandler(in *co
 	return autoConvert_config_ExposureClassHandler_To_v1alpha1_ExposureClassHandler(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExposureClassHandlerAutoscaling_To_config_ExposureClassHandlerAutoscaling(in *ExposureClassHandlerAutoscaling, out *config.ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.TargetConnections = (*int32)(unsafe.Pointer(in.TargetConnections))
+	return nil
+}
+
+// Convert_v1alpha1_ExposureClassHandlerAutoscaling_To_config_ExposureClassHandlerAutoscaling is an autogenerated conversion function.
+func Convert_v1alpha1_ExposureClassHandlerAutoscaling_To_config_ExposureClassHandlerAutoscaling(in *ExposureClassHandlerAutoscaling, out *config.ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExposureClassHandlerAutoscaling_To_config_ExposureClassHandlerAutoscaling(in, out, s)
+}
+
+func autoConvert_config_ExposureClassHandlerAutoscaling_To_v1alpha1_ExposureClassHandlerAutoscaling(in *config.ExposureClassHandlerAutoscaling, out *ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	out.TargetConnections = (*int32)(unsafe.Pointer(in.TargetConnections))
+	return nil
+}
+
+// Convert_config_ExposureClassHandlerAutoscaling_To_v1alpha1_ExposureClassHandlerAutoscaling is an autogenerated conversion function.
+func Convert_config_ExposureClassHandlerAutoscaling_To_v1alpha1_ExposureClassHandlerAutoscaling(in *config.ExposureClassHandlerAutoscaling, out *ExposureClassHandlerAutoscaling, s conversion.Scope) error {
+	return autoConvert_config_ExposureClassHandlerAutoscaling_To_v1alpha1_ExposureClassHandlerAutoscaling(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExtensionHeartbeatRestart_To_config_ExtensionHeartbeatRestart(in *ExtensionHeartbeatRestart, out *config.ExtensionHeartbeatRestart, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	out.MaxRestartsPerHour = (*int32)(unsafe.Pointer(in.MaxRestartsPerHour))
+	return nil
+}
+
+// Convert_v1alpha1_ExtensionHeartbeatRestart_To_config_ExtensionHeartbeatRestart is an autogenerated conversion function.
+func Convert_v1alpha1_ExtensionHeartbeatRestart_To_config_ExtensionHeartbeatRestart(in *ExtensionHeartbeatRestart, out *config.ExtensionHeartbeatRestart, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExtensionHeartbeatRestart_To_config_ExtensionHeartbeatRestart(in, out, s)
+}
+
+func autoConvert_config_ExtensionHeartbeatRestart_To_v1alpha1_ExtensionHeartbeatRestart(in *config.ExtensionHeartbeatRestart, out *ExtensionHeartbeatRestart, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	out.MaxRestartsPerHour = (*int32)(unsafe.Pointer(in.MaxRestartsPerHour))
+	return nil
+}
+
+// Convert_config_ExtensionHeartbeatRestart_To_v1alpha1_ExtensionHeartbeatRestart is an autogenerated conversion function.
+func Convert_config_ExtensionHeartbeatRestart_To_v1alpha1_ExtensionHeartbeatRestart(in *config.ExtensionHeartbeatRestart, out *ExtensionHeartbeatRestart, s conversion.Scope) error {
+	return autoConvert_config_ExtensionHeartbeatRestart_To_v1alpha1_ExtensionHeartbeatRestart(in, out, s)
+}
+
 func autoConvert_v1alpha1_GardenClientConnection_To_config_GardenClientConnection(in *GardenClientConnection, out *config.GardenClientConnection, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
@@ -799,6 +1146,7 @@ func autoConvert_v1alpha1_GardenClientConnection_To_config_GardenClientConnectio
 	out.BootstrapKubeconfig = (*corev1.SecretReference)(unsafe.Pointer(in.BootstrapKubeconfig))
 	out.KubeconfigSecret = (*corev1.SecretReference)(unsafe.Pointer(in.KubeconfigSecret))
 	out.KubeconfigValidity = (*config.KubeconfigValidity)(unsafe.Pointer(in.KubeconfigValidity))
+	out.AdaptiveRateLimiting = (*config.AdaptiveRateLimiting)(unsafe.Pointer(in.AdaptiveRateLimiting))
 	return nil
 }
 
@@ -816,6 +1164,7 @@ func autoConvert_config_GardenClientConnection_To_v1alpha1_GardenClientConnectio
 	out.BootstrapKubeconfig = (*corev1.SecretReference)(unsafe.Pointer(in.BootstrapKubeconfig))
 	out.KubeconfigSecret = (*corev1.SecretReference)(unsafe.Pointer(in.KubeconfigSecret))
 	out.KubeconfigValidity = (*KubeconfigValidity)(unsafe.Pointer(in.KubeconfigValidity))
+	out.AdaptiveRateLimiting = (*AdaptiveRateLimiting)(unsafe.Pointer(in.AdaptiveRateLimiting))
 	return nil
 }
 
@@ -884,6 +1233,7 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 		out.LeaderElection = nil
 	}
 	out.LogLevel = in.LogLevel
+	out.LogLevelOverrides = *(*map[string]string)(unsafe.Pointer(&in.LogLevelOverrides))
 	out.LogFormat = in.LogFormat
 	if err := Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -913,6 +1263,10 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.Tracing = (*apiv1.TracingConfiguration)(unsafe.Pointer(in.Tracing))
+	out.Events = (*config.EventsConfiguration)(unsafe.Pointer(in.Events))
+	out.SecretsManager = (*config.SecretsManagerConfiguration)(unsafe.Pointer(in.SecretsManager))
+	out.ArtifactPreSync = (*config.ArtifactPreSyncConfiguration)(unsafe.Pointer(in.ArtifactPreSync))
 	return nil
 }
 
@@ -961,6 +1315,7 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 		out.LeaderElection = nil
 	}
 	out.LogLevel = in.LogLevel
+	out.LogLevelOverrides = *(*map[string]string)(unsafe.Pointer(&in.LogLevelOverrides))
 	out.LogFormat = in.LogFormat
 	if err := Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
@@ -990,6 +1345,10 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.Tracing = (*apiv1.TracingConfiguration)(unsafe.Pointer(in.Tracing))
+	out.Events = (*EventsConfiguration)(unsafe.Pointer(in.Events))
+	out.SecretsManager = (*SecretsManagerConfiguration)(unsafe.Pointer(in.SecretsManager))
+	out.ArtifactPreSync = (*ArtifactPreSyncConfiguration)(unsafe.Pointer(in.ArtifactPreSync))
 	return nil
 }
 
@@ -1007,9 +1366,14 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.ControllerInstallationRequired = (*config.ControllerInstallationRequiredControllerConfiguration)(unsafe.Pointer(in.ControllerInstallationRequired))
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedCare = (*config.SeedCareControllerConfiguration)(unsafe.Pointer(in.SeedCare))
+	out.SeedGarbageCollection = (*config.SeedGarbageCollectionControllerConfiguration)(unsafe.Pointer(in.SeedGarbageCollection))
+	out.SeedZoneOutage = (*config.SeedZoneOutageControllerConfiguration)(unsafe.Pointer(in.SeedZoneOutage))
 	out.Shoot = (*config.ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*config.ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*config.ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootAvailability = (*config.ShootAvailabilityControllerConfiguration)(unsafe.Pointer(in.ShootAvailability))
+	out.ShootLeftover = (*config.ShootLeftoverControllerConfiguration)(unsafe.Pointer(in.ShootLeftover))
+	out.ShootSecurityAdvisory = (*config.ShootSecurityAdvisoryControllerConfiguration)(unsafe.Pointer(in.ShootSecurityAdvisory))
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*config.TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1031,9 +1395,14 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.ControllerInstallationRequired = (*ControllerInstallationRequiredControllerConfiguration)(unsafe.Pointer(in.ControllerInstallationRequired))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedCare = (*SeedCareControllerConfiguration)(unsafe.Pointer(in.SeedCare))
+	out.SeedGarbageCollection = (*SeedGarbageCollectionControllerConfiguration)(unsafe.Pointer(in.SeedGarbageCollection))
+	out.SeedZoneOutage = (*SeedZoneOutageControllerConfiguration)(unsafe.Pointer(in.SeedZoneOutage))
 	out.Shoot = (*ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootAvailability = (*ShootAvailabilityControllerConfiguration)(unsafe.Pointer(in.ShootAvailability))
+	out.ShootLeftover = (*ShootLeftoverControllerConfiguration)(unsafe.Pointer(in.ShootLeftover))
+	out.ShootSecurityAdvisory = (*ShootSecurityAdvisoryControllerConfiguration)(unsafe.Pointer(in.ShootSecurityAdvisory))
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestor = (*TokenRequestorControllerConfiguration)(unsafe.Pointer(in.TokenRequestor))
@@ -1095,6 +1464,7 @@ func autoConvert_v1alpha1_Logging_To_config_Logging(in *Logging, out *config.Log
 	out.Vali = (*config.Vali)(unsafe.Pointer(in.Vali))
 	out.ShootNodeLogging = (*config.ShootNodeLogging)(unsafe.Pointer(in.ShootNodeLogging))
 	out.ShootEventLogging = (*config.ShootEventLogging)(unsafe.Pointer(in.ShootEventLogging))
+	out.ShootSlowRequestLogging = (*config.ShootSlowRequestLogging)(unsafe.Pointer(in.ShootSlowRequestLogging))
 	return nil
 }
 
@@ -1108,6 +1478,7 @@ func autoConvert_config_Logging_To_v1alpha1_Logging(in *config.Logging, out *Log
 	out.Vali = (*Vali)(unsafe.Pointer(in.Vali))
 	out.ShootNodeLogging = (*ShootNodeLogging)(unsafe.Pointer(in.ShootNodeLogging))
 	out.ShootEventLogging = (*ShootEventLogging)(unsafe.Pointer(in.ShootEventLogging))
+	out.ShootSlowRequestLogging = (*ShootSlowRequestLogging)(unsafe.Pointer(in.ShootSlowRequestLogging))
 	return nil
 }
 
@@ -1186,6 +1557,30 @@ func Convert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPoli
 	return autoConvert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPolicyControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeLeaseProbe_To_config_NodeLeaseProbe(in *NodeLeaseProbe, out *config.NodeLeaseProbe, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.FailurePercentage = (*int32)(unsafe.Pointer(in.FailurePercentage))
+	return nil
+}
+
+// Convert_v1alpha1_NodeLeaseProbe_To_config_NodeLeaseProbe is an autogenerated conversion function.
+func Convert_v1alpha1_NodeLeaseProbe_To_config_NodeLeaseProbe(in *NodeLeaseProbe, out *config.NodeLeaseProbe, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeLeaseProbe_To_config_NodeLeaseProbe(in, out, s)
+}
+
+func autoConvert_config_NodeLeaseProbe_To_v1alpha1_NodeLeaseProbe(in *config.NodeLeaseProbe, out *NodeLeaseProbe, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.NodeMonitorGracePeriod = (*v1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.FailurePercentage = (*int32)(unsafe.Pointer(in.FailurePercentage))
+	return nil
+}
+
+// Convert_config_NodeLeaseProbe_To_v1alpha1_NodeLeaseProbe is an autogenerated conversion function.
+func Convert_config_NodeLeaseProbe_To_v1alpha1_NodeLeaseProbe(in *config.NodeLeaseProbe, out *NodeLeaseProbe, s conversion.Scope) error {
+	return autoConvert_config_NodeLeaseProbe_To_v1alpha1_NodeLeaseProbe(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeToleration_To_config_NodeToleration(in *NodeToleration, out *config.NodeToleration, s conversion.Scope) error {
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
@@ -1208,6 +1603,26 @@ func Convert_config_NodeToleration_To_v1alpha1_NodeToleration(in *config.NodeTol
 	return autoConvert_config_NodeToleration_To_v1alpha1_NodeToleration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OCIVerification_To_config_OCIVerification(in *OCIVerification, out *config.OCIVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_v1alpha1_OCIVerification_To_config_OCIVerification is an autogenerated conversion function.
+func Convert_v1alpha1_OCIVerification_To_config_OCIVerification(in *OCIVerification, out *config.OCIVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCIVerification_To_config_OCIVerification(in, out, s)
+}
+
+func autoConvert_config_OCIVerification_To_v1alpha1_OCIVerification(in *config.OCIVerification, out *OCIVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_config_OCIVerification_To_v1alpha1_OCIVerification is an autogenerated conversion function.
+func Convert_config_OCIVerification_To_v1alpha1_OCIVerification(in *config.OCIVerification, out *OCIVerification, s conversion.Scope) error {
+	return autoConvert_config_OCIVerification_To_v1alpha1_OCIVerification(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteWriteMonitoringConfig_To_config_RemoteWriteMonitoringConfig(in *RemoteWriteMonitoringConfig, out *config.RemoteWriteMonitoringConfig, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Keep = *(*[]string)(unsafe.Pointer(&in.Keep))
@@ -1298,6 +1713,30 @@ func Convert_config_SNIIngress_To_v1alpha1_SNIIngress(in *config.SNIIngress, out
 	return autoConvert_config_SNIIngress_To_v1alpha1_SNIIngress(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(in *SecretsManagerConfiguration, out *config.SecretsManagerConfiguration, s conversion.Scope) error {
+	out.CertificateAuthorities = (*config.CertificateValidityConfiguration)(unsafe.Pointer(in.CertificateAuthorities))
+	out.ServerCertificates = (*config.CertificateValidityConfiguration)(unsafe.Pointer(in.ServerCertificates))
+	out.ClientCertificates = (*config.CertificateValidityConfiguration)(unsafe.Pointer(in.ClientCertificates))
+	return nil
+}
+
+// Convert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(in *SecretsManagerConfiguration, out *config.SecretsManagerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretsManagerConfiguration_To_config_SecretsManagerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(in *config.SecretsManagerConfiguration, out *SecretsManagerConfiguration, s conversion.Scope) error {
+	out.CertificateAuthorities = (*CertificateValidityConfiguration)(unsafe.Pointer(in.CertificateAuthorities))
+	out.ServerCertificates = (*CertificateValidityConfiguration)(unsafe.Pointer(in.ServerCertificates))
+	out.ClientCertificates = (*CertificateValidityConfiguration)(unsafe.Pointer(in.ClientCertificates))
+	return nil
+}
+
+// Convert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration is an autogenerated conversion function.
+func Convert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(in *config.SecretsManagerConfiguration, out *SecretsManagerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SecretsManagerConfiguration_To_v1alpha1_SecretsManagerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedCareControllerConfiguration_To_config_SeedCareControllerConfiguration(in *SeedCareControllerConfiguration, out *config.SeedCareControllerConfiguration, s conversion.Scope) error {
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
@@ -1324,6 +1763,7 @@ func autoConvert_v1alpha1_SeedClientConnection_To_config_SeedClientConnection(in
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
 	}
+	out.AdaptiveRateLimiting = (*config.AdaptiveRateLimiting)(unsafe.Pointer(in.AdaptiveRateLimiting))
 	return nil
 }
 
@@ -1336,6 +1776,7 @@ func autoConvert_config_SeedClientConnection_To_v1alpha1_SeedClientConnection(in
 	if err := configv1alpha1.Convert_config_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
 	}
+	out.AdaptiveRateLimiting = (*AdaptiveRateLimiting)(unsafe.Pointer(in.AdaptiveRateLimiting))
 	return nil
 }
 
@@ -1392,6 +1833,54 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedGarbageCollectionControllerConfiguration_To_config_SeedGarbageCollectionControllerConfiguration(in *SeedGarbageCollectionControllerConfiguration, out *config.SeedGarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	return nil
+}
+
+// Convert_v1alpha1_SeedGarbageCollectionControllerConfiguration_To_config_SeedGarbageCollectionControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedGarbageCollectionControllerConfiguration_To_config_SeedGarbageCollectionControllerConfiguration(in *SeedGarbageCollectionControllerConfiguration, out *config.SeedGarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedGarbageCollectionControllerConfiguration_To_config_SeedGarbageCollectionControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedGarbageCollectionControllerConfiguration_To_v1alpha1_SeedGarbageCollectionControllerConfiguration(in *config.SeedGarbageCollectionControllerConfiguration, out *SeedGarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	return nil
+}
+
+// Convert_config_SeedGarbageCollectionControllerConfiguration_To_v1alpha1_SeedGarbageCollectionControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedGarbageCollectionControllerConfiguration_To_v1alpha1_SeedGarbageCollectionControllerConfiguration(in *config.SeedGarbageCollectionControllerConfiguration, out *SeedGarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedGarbageCollectionControllerConfiguration_To_v1alpha1_SeedGarbageCollectionControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeedZoneOutageControllerConfiguration_To_config_SeedZoneOutageControllerConfiguration(in *SeedZoneOutageControllerConfiguration, out *config.SeedZoneOutageControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.OutageThreshold = (*v1.Duration)(unsafe.Pointer(in.OutageThreshold))
+	out.DegradationMode = (*bool)(unsafe.Pointer(in.DegradationMode))
+	return nil
+}
+
+// Convert_v1alpha1_SeedZoneOutageControllerConfiguration_To_config_SeedZoneOutageControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedZoneOutageControllerConfiguration_To_config_SeedZoneOutageControllerConfiguration(in *SeedZoneOutageControllerConfiguration, out *config.SeedZoneOutageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedZoneOutageControllerConfiguration_To_config_SeedZoneOutageControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedZoneOutageControllerConfiguration_To_v1alpha1_SeedZoneOutageControllerConfiguration(in *config.SeedZoneOutageControllerConfiguration, out *SeedZoneOutageControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.OutageThreshold = (*v1.Duration)(unsafe.Pointer(in.OutageThreshold))
+	out.DegradationMode = (*bool)(unsafe.Pointer(in.DegradationMode))
+	return nil
+}
+
+// Convert_config_SeedZoneOutageControllerConfiguration_To_v1alpha1_SeedZoneOutageControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedZoneOutageControllerConfiguration_To_v1alpha1_SeedZoneOutageControllerConfiguration(in *config.SeedZoneOutageControllerConfiguration, out *SeedZoneOutageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedZoneOutageControllerConfiguration_To_v1alpha1_SeedZoneOutageControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -1436,6 +1925,30 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 	return autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootAvailabilityControllerConfiguration_To_config_ShootAvailabilityControllerConfiguration(in *ShootAvailabilityControllerConfiguration, out *config.ShootAvailabilityControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Objective = (*float64)(unsafe.Pointer(in.Objective))
+	return nil
+}
+
+// Convert_v1alpha1_ShootAvailabilityControllerConfiguration_To_config_ShootAvailabilityControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootAvailabilityControllerConfiguration_To_config_ShootAvailabilityControllerConfiguration(in *ShootAvailabilityControllerConfiguration, out *config.ShootAvailabilityControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootAvailabilityControllerConfiguration_To_config_ShootAvailabilityControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootAvailabilityControllerConfiguration_To_v1alpha1_ShootAvailabilityControllerConfiguration(in *config.ShootAvailabilityControllerConfiguration, out *ShootAvailabilityControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.Objective = (*float64)(unsafe.Pointer(in.Objective))
+	return nil
+}
+
+// Convert_config_ShootAvailabilityControllerConfiguration_To_v1alpha1_ShootAvailabilityControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootAvailabilityControllerConfiguration_To_v1alpha1_ShootAvailabilityControllerConfiguration(in *config.ShootAvailabilityControllerConfiguration, out *ShootAvailabilityControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootAvailabilityControllerConfiguration_To_v1alpha1_ShootAvailabilityControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareControllerConfiguration(in *ShootCareControllerConfiguration, out *config.ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -1443,6 +1956,8 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.Remediations = (*config.ShootCareRemediations)(unsafe.Pointer(in.Remediations))
+	out.NodeLeaseProbe = (*config.NodeLeaseProbe)(unsafe.Pointer(in.NodeLeaseProbe))
 	return nil
 }
 
@@ -1458,6 +1973,8 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.Remediations = (*ShootCareRemediations)(unsafe.Pointer(in.Remediations))
+	out.NodeLeaseProbe = (*NodeLeaseProbe)(unsafe.Pointer(in.NodeLeaseProbe))
 	return nil
 }
 
@@ -1466,6 +1983,30 @@ func Convert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareContro
 	return autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootCareRemediations_To_config_ShootCareRemediations(in *ShootCareRemediations, out *config.ShootCareRemediations, s conversion.Scope) error {
+	out.WebhookBestPractices = (*bool)(unsafe.Pointer(in.WebhookBestPractices))
+	out.UnavailableAPIServices = (*config.UnavailableAPIServicesRemediation)(unsafe.Pointer(in.UnavailableAPIServices))
+	out.OrphanedKubeSystemWebhooks = (*bool)(unsafe.Pointer(in.OrphanedKubeSystemWebhooks))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCareRemediations_To_config_ShootCareRemediations is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCareRemediations_To_config_ShootCareRemediations(in *ShootCareRemediations, out *config.ShootCareRemediations, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCareRemediations_To_config_ShootCareRemediations(in, out, s)
+}
+
+func autoConvert_config_ShootCareRemediations_To_v1alpha1_ShootCareRemediations(in *config.ShootCareRemediations, out *ShootCareRemediations, s conversion.Scope) error {
+	out.WebhookBestPractices = (*bool)(unsafe.Pointer(in.WebhookBestPractices))
+	out.UnavailableAPIServices = (*UnavailableAPIServicesRemediation)(unsafe.Pointer(in.UnavailableAPIServices))
+	out.OrphanedKubeSystemWebhooks = (*bool)(unsafe.Pointer(in.OrphanedKubeSystemWebhooks))
+	return nil
+}
+
+// Convert_config_ShootCareRemediations_To_v1alpha1_ShootCareRemediations is an autogenerated conversion function.
+func Convert_config_ShootCareRemediations_To_v1alpha1_ShootCareRemediations(in *config.ShootCareRemediations, out *ShootCareRemediations, s conversion.Scope) error {
+	return autoConvert_config_ShootCareRemediations_To_v1alpha1_ShootCareRemediations(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootClientConnection_To_config_ShootClientConnection(in *ShootClientConnection, out *config.ShootClientConnection, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnectionConfiguration, &out.ClientConnectionConfiguration, s); err != nil {
 		return err
@@ -1498,6 +2039,7 @@ func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.Prioritization = (*config.ShootPrioritization)(unsafe.Pointer(in.Prioritization))
 	return nil
 }
 
@@ -1514,6 +2056,7 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.Prioritization = (*ShootPrioritization)(unsafe.Pointer(in.Prioritization))
 	return nil
 }
 
@@ -1542,6 +2085,28 @@ func Convert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in *config.S
 	return autoConvert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootLeftoverControllerConfiguration_To_config_ShootLeftoverControllerConfiguration(in *ShootLeftoverControllerConfiguration, out *config.ShootLeftoverControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootLeftoverControllerConfiguration_To_config_ShootLeftoverControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootLeftoverControllerConfiguration_To_config_ShootLeftoverControllerConfiguration(in *ShootLeftoverControllerConfiguration, out *config.ShootLeftoverControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootLeftoverControllerConfiguration_To_config_ShootLeftoverControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootLeftoverControllerConfiguration_To_v1alpha1_ShootLeftoverControllerConfiguration(in *config.ShootLeftoverControllerConfiguration, out *ShootLeftoverControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ShootLeftoverControllerConfiguration_To_v1alpha1_ShootLeftoverControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootLeftoverControllerConfiguration_To_v1alpha1_ShootLeftoverControllerConfiguration(in *config.ShootLeftoverControllerConfiguration, out *ShootLeftoverControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootLeftoverControllerConfiguration_To_v1alpha1_ShootLeftoverControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(in *ShootMonitoringConfig, out *config.ShootMonitoringConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.RemoteWrite = (*config.RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
@@ -1586,6 +2151,96 @@ func Convert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in *config.Sho
 	return autoConvert_config_ShootNodeLogging_To_v1alpha1_ShootNodeLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootPrioritization_To_config_ShootPrioritization(in *ShootPrioritization, out *config.ShootPrioritization, s conversion.Scope) error {
+	out.Bands = *(*[]config.ShootPriorityBand)(unsafe.Pointer(&in.Bands))
+	out.DeferralPeriod = (*v1.Duration)(unsafe.Pointer(in.DeferralPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPrioritization_To_config_ShootPrioritization is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPrioritization_To_config_ShootPrioritization(in *ShootPrioritization, out *config.ShootPrioritization, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPrioritization_To_config_ShootPrioritization(in, out, s)
+}
+
+func autoConvert_config_ShootPrioritization_To_v1alpha1_ShootPrioritization(in *config.ShootPrioritization, out *ShootPrioritization, s conversion.Scope) error {
+	out.Bands = *(*[]ShootPriorityBand)(unsafe.Pointer(&in.Bands))
+	out.DeferralPeriod = (*v1.Duration)(unsafe.Pointer(in.DeferralPeriod))
+	return nil
+}
+
+// Convert_config_ShootPrioritization_To_v1alpha1_ShootPrioritization is an autogenerated conversion function.
+func Convert_config_ShootPrioritization_To_v1alpha1_ShootPrioritization(in *config.ShootPrioritization, out *ShootPrioritization, s conversion.Scope) error {
+	return autoConvert_config_ShootPrioritization_To_v1alpha1_ShootPrioritization(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPriorityBand_To_config_ShootPriorityBand(in *ShootPriorityBand, out *config.ShootPriorityBand, s conversion.Scope) error {
+	out.Name = config.ShootPriorityBandName(in.Name)
+	out.MaxConcurrentReconciles = in.MaxConcurrentReconciles
+	return nil
+}
+
+// Convert_v1alpha1_ShootPriorityBand_To_config_ShootPriorityBand is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPriorityBand_To_config_ShootPriorityBand(in *ShootPriorityBand, out *config.ShootPriorityBand, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPriorityBand_To_config_ShootPriorityBand(in, out, s)
+}
+
+func autoConvert_config_ShootPriorityBand_To_v1alpha1_ShootPriorityBand(in *config.ShootPriorityBand, out *ShootPriorityBand, s conversion.Scope) error {
+	out.Name = ShootPriorityBandName(in.Name)
+	out.MaxConcurrentReconciles = in.MaxConcurrentReconciles
+	return nil
+}
+
+// Convert_config_ShootPriorityBand_To_v1alpha1_ShootPriorityBand is an autogenerated conversion function.
+func Convert_config_ShootPriorityBand_To_v1alpha1_ShootPriorityBand(in *config.ShootPriorityBand, out *ShootPriorityBand, s conversion.Scope) error {
+	return autoConvert_config_ShootPriorityBand_To_v1alpha1_ShootPriorityBand(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootSecurityAdvisoryControllerConfiguration_To_config_ShootSecurityAdvisoryControllerConfiguration(in *ShootSecurityAdvisoryControllerConfiguration, out *config.ShootSecurityAdvisoryControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.FeedConfigMapName = (*string)(unsafe.Pointer(in.FeedConfigMapName))
+	return nil
+}
+
+// Convert_v1alpha1_ShootSecurityAdvisoryControllerConfiguration_To_config_ShootSecurityAdvisoryControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootSecurityAdvisoryControllerConfiguration_To_config_ShootSecurityAdvisoryControllerConfiguration(in *ShootSecurityAdvisoryControllerConfiguration, out *config.ShootSecurityAdvisoryControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootSecurityAdvisoryControllerConfiguration_To_config_ShootSecurityAdvisoryControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootSecurityAdvisoryControllerConfiguration_To_v1alpha1_ShootSecurityAdvisoryControllerConfiguration(in *config.ShootSecurityAdvisoryControllerConfiguration, out *ShootSecurityAdvisoryControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.FeedConfigMapName = (*string)(unsafe.Pointer(in.FeedConfigMapName))
+	return nil
+}
+
+// Convert_config_ShootSecurityAdvisoryControllerConfiguration_To_v1alpha1_ShootSecurityAdvisoryControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootSecurityAdvisoryControllerConfiguration_To_v1alpha1_ShootSecurityAdvisoryControllerConfiguration(in *config.ShootSecurityAdvisoryControllerConfiguration, out *ShootSecurityAdvisoryControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootSecurityAdvisoryControllerConfiguration_To_v1alpha1_ShootSecurityAdvisoryControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootSlowRequestLogging_To_config_ShootSlowRequestLogging(in *ShootSlowRequestLogging, out *config.ShootSlowRequestLogging, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	return nil
+}
+
+// Convert_v1alpha1_ShootSlowRequestLogging_To_config_ShootSlowRequestLogging is an autogenerated conversion function.
+func Convert_v1alpha1_ShootSlowRequestLogging_To_config_ShootSlowRequestLogging(in *ShootSlowRequestLogging, out *config.ShootSlowRequestLogging, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootSlowRequestLogging_To_config_ShootSlowRequestLogging(in, out, s)
+}
+
+func autoConvert_config_ShootSlowRequestLogging_To_v1alpha1_ShootSlowRequestLogging(in *config.ShootSlowRequestLogging, out *ShootSlowRequestLogging, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	return nil
+}
+
+// Convert_config_ShootSlowRequestLogging_To_v1alpha1_ShootSlowRequestLogging is an autogenerated conversion function.
+func Convert_config_ShootSlowRequestLogging_To_v1alpha1_ShootSlowRequestLogging(in *config.ShootSlowRequestLogging, out *ShootSlowRequestLogging, s conversion.Scope) error {
+	return autoConvert_config_ShootSlowRequestLogging_To_v1alpha1_ShootSlowRequestLogging(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootStateControllerConfiguration_To_config_ShootStateControllerConfiguration(in *ShootStateControllerConfiguration, out *config.ShootStateControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -1650,6 +2305,28 @@ func Convert_config_TokenRequestorControllerConfiguration_To_v1alpha1_TokenReque
 	return autoConvert_config_TokenRequestorControllerConfiguration_To_v1alpha1_TokenRequestorControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_UnavailableAPIServicesRemediation_To_config_UnavailableAPIServicesRemediation(in *UnavailableAPIServicesRemediation, out *config.UnavailableAPIServicesRemediation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	return nil
+}
+
+// Convert_v1alpha1_UnavailableAPIServicesRemediation_To_config_UnavailableAPIServicesRemediation is an autogenerated conversion function.
+func Convert_v1alpha1_UnavailableAPIServicesRemediation_To_config_UnavailableAPIServicesRemediation(in *UnavailableAPIServicesRemediation, out *config.UnavailableAPIServicesRemediation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UnavailableAPIServicesRemediation_To_config_UnavailableAPIServicesRemediation(in, out, s)
+}
+
+func autoConvert_config_UnavailableAPIServicesRemediation_To_v1alpha1_UnavailableAPIServicesRemediation(in *config.UnavailableAPIServicesRemediation, out *UnavailableAPIServicesRemediation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
+	return nil
+}
+
+// Convert_config_UnavailableAPIServicesRemediation_To_v1alpha1_UnavailableAPIServicesRemediation is an autogenerated conversion function.
+func Convert_config_UnavailableAPIServicesRemediation_To_v1alpha1_UnavailableAPIServicesRemediation(in *config.UnavailableAPIServicesRemediation, out *UnavailableAPIServicesRemediation, s conversion.Scope) error {
+	return autoConvert_config_UnavailableAPIServicesRemediation_To_v1alpha1_UnavailableAPIServicesRemediation(in, out, s)
+}
+
 func autoConvert_v1alpha1_VPAEvictionRequirementsControllerConfiguration_To_config_VPAEvictionRequirementsControllerConfiguration(in *VPAEvictionRequirementsControllerConfiguration, out *config.VPAEvictionRequirementsControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
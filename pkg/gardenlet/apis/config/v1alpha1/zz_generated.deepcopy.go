@@ -15,8 +15,51 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	apiv1 "k8s.io/component-base/tracing/api/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveRateLimiting) DeepCopyInto(out *AdaptiveRateLimiting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveRateLimiting.
+func (in *AdaptiveRateLimiting) DeepCopy() *AdaptiveRateLimiting {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveRateLimiting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactPreSyncConfiguration) DeepCopyInto(out *ArtifactPreSyncConfiguration) {
+	*out = *in
+	if in.KubernetesVersions != nil {
+		in, out := &in.KubernetesVersions, &out.KubernetesVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactPreSyncConfiguration.
+func (in *ArtifactPreSyncConfiguration) DeepCopy() *ArtifactPreSyncConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactPreSyncConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketControllerConfiguration) DeepCopyInto(out *BackupBucketControllerConfiguration) {
 	*out = *in
@@ -131,10 +174,46 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateValidityConfiguration) DeepCopyInto(out *CertificateValidityConfiguration) {
+	*out = *in
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewAfterValidityPercentage != nil {
+		in, out := &in.RenewAfterValidityPercentage, &out.RenewAfterValidityPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateValidityConfiguration.
+func (in *CertificateValidityConfiguration) DeepCopy() *CertificateValidityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateValidityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
 	out.Duration = in.Duration
+	if in.StaleDuration != nil {
+		in, out := &in.StaleDuration, &out.StaleDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -161,6 +240,11 @@ func (in *ControllerInstallationCareControllerConfiguration) DeepCopyInto(out *C
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.HeartbeatRestart != nil {
+		in, out := &in.HeartbeatRestart, &out.HeartbeatRestart
+		*out = new(ExtensionHeartbeatRestart)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -182,6 +266,11 @@ func (in *ControllerInstallationControllerConfiguration) DeepCopyInto(out *Contr
 		*out = new(int)
 		**out = **in
 	}
+	if in.OCIVerification != nil {
+		in, out := &in.OCIVerification, &out.OCIVerification
+		*out = new(OCIVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -332,6 +421,47 @@ func (in *ETCDController) DeepCopy() *ETCDController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventsConfiguration) DeepCopyInto(out *EventsConfiguration) {
+	*out = *in
+	if in.MaxSimilarEvents != nil {
+		in, out := &in.MaxSimilarEvents, &out.MaxSimilarEvents
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AggregationInterval != nil {
+		in, out := &in.AggregationInterval, &out.AggregationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpamFilterQPS != nil {
+		in, out := &in.SpamFilterQPS, &out.SpamFilterQPS
+		*out = new(float32)
+		**out = **in
+	}
+	if in.SpamFilterBurst != nil {
+		in, out := &in.SpamFilterBurst, &out.SpamFilterBurst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsConfiguration.
+func (in *EventsConfiguration) DeepCopy() *EventsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposureClassHandler) DeepCopyInto(out *ExposureClassHandler) {
 	*out = *in
@@ -341,6 +471,11 @@ func (in *ExposureClassHandler) DeepCopyInto(out *ExposureClassHandler) {
 		*out = new(SNI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ExposureClassHandlerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -354,6 +489,63 @@ func (in *ExposureClassHandler) DeepCopy() *ExposureClassHandler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureClassHandlerAutoscaling) DeepCopyInto(out *ExposureClassHandlerAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetConnections != nil {
+		in, out := &in.TargetConnections, &out.TargetConnections
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassHandlerAutoscaling.
+func (in *ExposureClassHandlerAutoscaling) DeepCopy() *ExposureClassHandlerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureClassHandlerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionHeartbeatRestart) DeepCopyInto(out *ExtensionHeartbeatRestart) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRestartsPerHour != nil {
+		in, out := &in.MaxRestartsPerHour, &out.MaxRestartsPerHour
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionHeartbeatRestart.
+func (in *ExtensionHeartbeatRestart) DeepCopy() *ExtensionHeartbeatRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionHeartbeatRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 	*out = *in
@@ -383,6 +575,11 @@ func (in *GardenClientConnection) DeepCopyInto(out *GardenClientConnection) {
 		*out = new(KubeconfigValidity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdaptiveRateLimiting != nil {
+		in, out := &in.AdaptiveRateLimiting, &out.AdaptiveRateLimiting
+		*out = new(AdaptiveRateLimiting)
+		**out = **in
+	}
 	return
 }
 
@@ -429,7 +626,7 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 	if in.SeedClientConnection != nil {
 		in, out := &in.SeedClientConnection, &out.SeedClientConnection
 		*out = new(SeedClientConnection)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ShootClientConnection != nil {
 		in, out := &in.ShootClientConnection, &out.ShootClientConnection
@@ -451,6 +648,13 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(configv1alpha1.LeaderElectionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogLevelOverrides != nil {
+		in, out := &in.LogLevelOverrides, &out.LogLevelOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.Server.DeepCopyInto(&out.Server)
 	if in.Debugging != nil {
 		in, out := &in.Debugging, &out.Debugging
@@ -501,6 +705,26 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(apiv1.TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(EventsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsManager != nil {
+		in, out := &in.SecretsManager, &out.SecretsManager
+		*out = new(SecretsManagerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactPreSync != nil {
+		in, out := &in.ArtifactPreSync, &out.ArtifactPreSync
+		*out = new(ArtifactPreSyncConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -565,6 +789,16 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(SeedCareControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedGarbageCollection != nil {
+		in, out := &in.SeedGarbageCollection, &out.SeedGarbageCollection
+		*out = new(SeedGarbageCollectionControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedZoneOutage != nil {
+		in, out := &in.SeedZoneOutage, &out.SeedZoneOutage
+		*out = new(SeedZoneOutageControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootControllerConfiguration)
@@ -580,6 +814,21 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootAvailability != nil {
+		in, out := &in.ShootAvailability, &out.ShootAvailability
+		*out = new(ShootAvailabilityControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootLeftover != nil {
+		in, out := &in.ShootLeftover, &out.ShootLeftover
+		*out = new(ShootLeftoverControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootSecurityAdvisory != nil {
+		in, out := &in.ShootSecurityAdvisory, &out.ShootSecurityAdvisory
+		*out = new(ShootSecurityAdvisoryControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -690,6 +939,11 @@ func (in *Logging) DeepCopyInto(out *Logging) {
 		*out = new(ShootEventLogging)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootSlowRequestLogging != nil {
+		in, out := &in.ShootSlowRequestLogging, &out.ShootSlowRequestLogging
+		*out = new(ShootSlowRequestLogging)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -793,6 +1047,37 @@ func (in *NetworkPolicyControllerConfiguration) DeepCopy() *NetworkPolicyControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLeaseProbe) DeepCopyInto(out *NodeLeaseProbe) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailurePercentage != nil {
+		in, out := &in.FailurePercentage, &out.FailurePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLeaseProbe.
+func (in *NodeLeaseProbe) DeepCopy() *NodeLeaseProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLeaseProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeToleration) DeepCopyInto(out *NodeToleration) {
 	*out = *in
@@ -819,6 +1104,27 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIVerification) DeepCopyInto(out *OCIVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIVerification.
+func (in *OCIVerification) DeepCopy() *OCIVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
@@ -929,6 +1235,37 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsManagerConfiguration) DeepCopyInto(out *SecretsManagerConfiguration) {
+	*out = *in
+	if in.CertificateAuthorities != nil {
+		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
+		*out = new(CertificateValidityConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerCertificates != nil {
+		in, out := &in.ServerCertificates, &out.ServerCertificates
+		*out = new(CertificateValidityConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificates != nil {
+		in, out := &in.ClientCertificates, &out.ClientCertificates
+		*out = new(CertificateValidityConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagerConfiguration.
+func (in *SecretsManagerConfiguration) DeepCopy() *SecretsManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -940,7 +1277,9 @@ func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerC
 	if in.ConditionThresholds != nil {
 		in, out := &in.ConditionThresholds, &out.ConditionThresholds
 		*out = make([]ConditionThreshold, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -959,6 +1298,11 @@ func (in *SeedCareControllerConfiguration) DeepCopy() *SeedCareControllerConfigu
 func (in *SeedClientConnection) DeepCopyInto(out *SeedClientConnection) {
 	*out = *in
 	out.ClientConnectionConfiguration = in.ClientConnectionConfiguration
+	if in.AdaptiveRateLimiting != nil {
+		in, out := &in.AdaptiveRateLimiting, &out.AdaptiveRateLimiting
+		*out = new(AdaptiveRateLimiting)
+		**out = **in
+	}
 	return
 }
 
@@ -1020,6 +1364,68 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedGarbageCollectionControllerConfiguration) DeepCopyInto(out *SeedGarbageCollectionControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedGarbageCollectionControllerConfiguration.
+func (in *SeedGarbageCollectionControllerConfiguration) DeepCopy() *SeedGarbageCollectionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedGarbageCollectionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedZoneOutageControllerConfiguration) DeepCopyInto(out *SeedZoneOutageControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OutageThreshold != nil {
+		in, out := &in.OutageThreshold, &out.OutageThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DegradationMode != nil {
+		in, out := &in.DegradationMode, &out.DegradationMode
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedZoneOutageControllerConfiguration.
+func (in *SeedZoneOutageControllerConfiguration) DeepCopy() *SeedZoneOutageControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedZoneOutageControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -1062,6 +1468,37 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAvailabilityControllerConfiguration) DeepCopyInto(out *ShootAvailabilityControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Objective != nil {
+		in, out := &in.Objective, &out.Objective
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAvailabilityControllerConfiguration.
+func (in *ShootAvailabilityControllerConfiguration) DeepCopy() *ShootAvailabilityControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAvailabilityControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -1088,13 +1525,25 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 	if in.ConditionThresholds != nil {
 		in, out := &in.ConditionThresholds, &out.ConditionThresholds
 		*out = make([]ConditionThreshold, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.WebhookRemediatorEnabled != nil {
 		in, out := &in.WebhookRemediatorEnabled, &out.WebhookRemediatorEnabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = new(ShootCareRemediations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeLeaseProbe != nil {
+		in, out := &in.NodeLeaseProbe, &out.NodeLeaseProbe
+		*out = new(NodeLeaseProbe)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1108,6 +1557,37 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCareRemediations) DeepCopyInto(out *ShootCareRemediations) {
+	*out = *in
+	if in.WebhookBestPractices != nil {
+		in, out := &in.WebhookBestPractices, &out.WebhookBestPractices
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnavailableAPIServices != nil {
+		in, out := &in.UnavailableAPIServices, &out.UnavailableAPIServices
+		*out = new(UnavailableAPIServicesRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedKubeSystemWebhooks != nil {
+		in, out := &in.OrphanedKubeSystemWebhooks, &out.OrphanedKubeSystemWebhooks
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCareRemediations.
+func (in *ShootCareRemediations) DeepCopy() *ShootCareRemediations {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCareRemediations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootClientConnection) DeepCopyInto(out *ShootClientConnection) {
 	*out = *in
@@ -1163,6 +1643,11 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Prioritization != nil {
+		in, out := &in.Prioritization, &out.Prioritization
+		*out = new(ShootPrioritization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1197,6 +1682,32 @@ func (in *ShootEventLogging) DeepCopy() *ShootEventLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootLeftoverControllerConfiguration) DeepCopyInto(out *ShootLeftoverControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootLeftoverControllerConfiguration.
+func (in *ShootLeftoverControllerConfiguration) DeepCopy() *ShootLeftoverControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootLeftoverControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 	*out = *in
@@ -1251,6 +1762,105 @@ func (in *ShootNodeLogging) DeepCopy() *ShootNodeLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPrioritization) DeepCopyInto(out *ShootPrioritization) {
+	*out = *in
+	if in.Bands != nil {
+		in, out := &in.Bands, &out.Bands
+		*out = make([]ShootPriorityBand, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeferralPeriod != nil {
+		in, out := &in.DeferralPeriod, &out.DeferralPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPrioritization.
+func (in *ShootPrioritization) DeepCopy() *ShootPrioritization {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPrioritization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPriorityBand) DeepCopyInto(out *ShootPriorityBand) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPriorityBand.
+func (in *ShootPriorityBand) DeepCopy() *ShootPriorityBand {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPriorityBand)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSecurityAdvisoryControllerConfiguration) DeepCopyInto(out *ShootSecurityAdvisoryControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FeedConfigMapName != nil {
+		in, out := &in.FeedConfigMapName, &out.FeedConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSecurityAdvisoryControllerConfiguration.
+func (in *ShootSecurityAdvisoryControllerConfiguration) DeepCopy() *ShootSecurityAdvisoryControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSecurityAdvisoryControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSlowRequestLogging) DeepCopyInto(out *ShootSlowRequestLogging) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSlowRequestLogging.
+func (in *ShootSlowRequestLogging) DeepCopy() *ShootSlowRequestLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSlowRequestLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootStateControllerConfiguration) DeepCopyInto(out *ShootStateControllerConfiguration) {
 	*out = *in
@@ -1319,6 +1929,27 @@ func (in *TokenRequestorControllerConfiguration) DeepCopy() *TokenRequestorContr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnavailableAPIServicesRemediation) DeepCopyInto(out *UnavailableAPIServicesRemediation) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnavailableAPIServicesRemediation.
+func (in *UnavailableAPIServicesRemediation) DeepCopy() *UnavailableAPIServicesRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(UnavailableAPIServicesRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPAEvictionRequirementsControllerConfiguration) DeepCopyInto(out *VPAEvictionRequirementsControllerConfiguration) {
 	*out = *in
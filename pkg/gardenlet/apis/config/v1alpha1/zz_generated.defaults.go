@@ -52,6 +52,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.ControllerInstallationCare != nil {
 			SetDefaults_ControllerInstallationCareControllerConfiguration(in.Controllers.ControllerInstallationCare)
+			if in.Controllers.ControllerInstallationCare.HeartbeatRestart != nil {
+				SetDefaults_ExtensionHeartbeatRestart(in.Controllers.ControllerInstallationCare.HeartbeatRestart)
+			}
 		}
 		if in.Controllers.ControllerInstallationRequired != nil {
 			SetDefaults_ControllerInstallationRequiredControllerConfiguration(in.Controllers.ControllerInstallationRequired)
@@ -62,18 +65,44 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.SeedCare != nil {
 			SetDefaults_SeedCareControllerConfiguration(in.Controllers.SeedCare)
 		}
+		if in.Controllers.SeedGarbageCollection != nil {
+			SetDefaults_SeedGarbageCollectionControllerConfiguration(in.Controllers.SeedGarbageCollection)
+		}
+		if in.Controllers.SeedZoneOutage != nil {
+			SetDefaults_SeedZoneOutageControllerConfiguration(in.Controllers.SeedZoneOutage)
+		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
+			if in.Controllers.Shoot.Prioritization != nil {
+				SetDefaults_ShootPrioritization(in.Controllers.Shoot.Prioritization)
+			}
 		}
 		if in.Controllers.ShootCare != nil {
 			SetDefaults_ShootCareControllerConfiguration(in.Controllers.ShootCare)
 			if in.Controllers.ShootCare.StaleExtensionHealthChecks != nil {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
+			if in.Controllers.ShootCare.Remediations != nil {
+				if in.Controllers.ShootCare.Remediations.UnavailableAPIServices != nil {
+					SetDefaults_UnavailableAPIServicesRemediation(in.Controllers.ShootCare.Remediations.UnavailableAPIServices)
+				}
+			}
+			if in.Controllers.ShootCare.NodeLeaseProbe != nil {
+				SetDefaults_NodeLeaseProbe(in.Controllers.ShootCare.NodeLeaseProbe)
+			}
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootAvailability != nil {
+			SetDefaults_ShootAvailabilityControllerConfiguration(in.Controllers.ShootAvailability)
+		}
+		if in.Controllers.ShootLeftover != nil {
+			SetDefaults_ShootLeftoverControllerConfiguration(in.Controllers.ShootLeftover)
+		}
+		if in.Controllers.ShootSecurityAdvisory != nil {
+			SetDefaults_ShootSecurityAdvisoryControllerConfiguration(in.Controllers.ShootSecurityAdvisory)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
@@ -127,4 +156,7 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.ArtifactPreSync != nil {
+		SetDefaults_ArtifactPreSyncConfiguration(in.ArtifactPreSync)
+	}
 }
@@ -9,17 +9,27 @@ import (
 	"net"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	tracingv1 "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/utils/ptr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/oci"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+var availableCareConditionSeverities = sets.New(
+	v1beta1constants.CareConditionSeverityError,
+	v1beta1constants.CareConditionSeverityWarning,
 )
 
 // ValidateGardenletConfiguration validates a GardenletConfiguration object.
@@ -46,19 +56,47 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		}
 	}
 
+	if cfg.GardenClientConnection != nil && cfg.GardenClientConnection.AdaptiveRateLimiting != nil {
+		allErrs = append(allErrs, validateAdaptiveRateLimiting(cfg.GardenClientConnection.AdaptiveRateLimiting, cfg.GardenClientConnection.QPS, field.NewPath("gardenClientConnection"))...)
+	}
+	if cfg.SeedClientConnection != nil && cfg.SeedClientConnection.AdaptiveRateLimiting != nil {
+		allErrs = append(allErrs, validateAdaptiveRateLimiting(cfg.SeedClientConnection.AdaptiveRateLimiting, cfg.SeedClientConnection.QPS, field.NewPath("seedClientConnection"))...)
+	}
+
 	if cfg.Controllers != nil {
+		if cfg.Controllers.ControllerInstallationCare != nil {
+			allErrs = append(allErrs, validateControllerInstallationCareControllerConfiguration(cfg.Controllers.ControllerInstallationCare, fldPath.Child("controllers", "controllerInstallationCare"))...)
+		}
+		if cfg.Controllers.ControllerInstallation != nil {
+			allErrs = append(allErrs, validateControllerInstallationControllerConfiguration(cfg.Controllers.ControllerInstallation, fldPath.Child("controllers", "controllerInstallation"))...)
+		}
 		if cfg.Controllers.BackupEntry != nil {
 			allErrs = append(allErrs, validateBackupEntryControllerConfiguration(cfg.Controllers.BackupEntry, fldPath.Child("controllers", "backupEntry"))...)
 		}
 		if cfg.Controllers.Bastion != nil {
 			allErrs = append(allErrs, validateBastionControllerConfiguration(cfg.Controllers.Bastion, fldPath.Child("controllers", "bastion"))...)
 		}
+		if cfg.Controllers.SeedGarbageCollection != nil {
+			allErrs = append(allErrs, validateSeedGarbageCollectionControllerConfiguration(cfg.Controllers.SeedGarbageCollection, fldPath.Child("controllers", "seedGarbageCollection"))...)
+		}
+		if cfg.Controllers.SeedZoneOutage != nil {
+			allErrs = append(allErrs, validateSeedZoneOutageControllerConfiguration(cfg.Controllers.SeedZoneOutage, fldPath.Child("controllers", "seedZoneOutage"))...)
+		}
 		if cfg.Controllers.Shoot != nil {
 			allErrs = append(allErrs, validateShootControllerConfiguration(cfg.Controllers.Shoot, fldPath.Child("controllers", "shoot"))...)
 		}
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
+		if cfg.Controllers.ShootAvailability != nil {
+			allErrs = append(allErrs, validateShootAvailabilityControllerConfiguration(cfg.Controllers.ShootAvailability, fldPath.Child("controllers", "shootAvailability"))...)
+		}
+		if cfg.Controllers.ShootLeftover != nil {
+			allErrs = append(allErrs, validateShootLeftoverControllerConfiguration(cfg.Controllers.ShootLeftover, fldPath.Child("controllers", "shootLeftover"))...)
+		}
+		if cfg.Controllers.ShootSecurityAdvisory != nil {
+			allErrs = append(allErrs, validateShootSecurityAdvisoryControllerConfiguration(cfg.Controllers.ShootSecurityAdvisory, fldPath.Child("controllers", "shootSecurityAdvisory"))...)
+		}
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
@@ -73,6 +111,12 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		}
 	}
 
+	for controller, level := range cfg.LogLevelOverrides {
+		if !sets.New(logger.AllLogLevels...).Has(level) {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("logLevelOverrides").Key(controller), level, logger.AllLogLevels))
+		}
+	}
+
 	if cfg.LogFormat != "" {
 		if !sets.New(logger.AllLogFormats...).Has(cfg.LogFormat) {
 			allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), cfg.LogFormat, logger.AllLogFormats))
@@ -121,6 +165,10 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 				allErrs = append(allErrs, field.Invalid(handlerPath.Child("sni", "ingress", "serviceExternalIP"), handler.SNI.Ingress.ServiceExternalIP, "external service ip is invalid"))
 			}
 		}
+
+		if handler.Autoscaling != nil {
+			allErrs = append(allErrs, validateExposureClassHandlerAutoscaling(handler.Autoscaling, handlerPath.Child("autoscaling"))...)
+		}
 	}
 
 	if nodeTolerationCfg := cfg.NodeToleration; nodeTolerationCfg != nil {
@@ -130,6 +178,98 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.Tracing != nil {
+		allErrs = append(allErrs, tracingv1.ValidateTracingConfiguration(cfg.Tracing, nil, fldPath.Child("tracing"))...)
+	}
+
+	if cfg.Events != nil {
+		allErrs = append(allErrs, validateEventsConfiguration(cfg.Events, fldPath.Child("events"))...)
+	}
+
+	if cfg.SecretsManager != nil {
+		allErrs = append(allErrs, validateSecretsManagerConfiguration(cfg.SecretsManager, fldPath.Child("secretsManager"))...)
+	}
+
+	if cfg.ArtifactPreSync != nil {
+		allErrs = append(allErrs, validateArtifactPreSyncConfiguration(cfg.ArtifactPreSync, fldPath.Child("artifactPreSync"))...)
+	}
+
+	if cfg.Logging != nil && cfg.Logging.ShootSlowRequestLogging != nil {
+		if threshold := cfg.Logging.ShootSlowRequestLogging.Threshold; threshold != nil && threshold.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("logging", "shootSlowRequestLogging", "threshold"), threshold.Duration.String(), "threshold must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateEventsConfiguration(cfg *config.EventsConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.MaxSimilarEvents != nil && *cfg.MaxSimilarEvents < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSimilarEvents"), *cfg.MaxSimilarEvents, "must be at least 1"))
+	}
+	if cfg.AggregationInterval != nil && cfg.AggregationInterval.Duration < time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("aggregationInterval"), cfg.AggregationInterval.Duration.String(), "must be at least 1s"))
+	}
+	if cfg.CacheSize != nil && *cfg.CacheSize < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cacheSize"), *cfg.CacheSize, "must be at least 1"))
+	}
+	if cfg.SpamFilterQPS != nil && *cfg.SpamFilterQPS <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("spamFilterQPS"), *cfg.SpamFilterQPS, "must be positive"))
+	}
+	if cfg.SpamFilterBurst != nil && *cfg.SpamFilterBurst < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("spamFilterBurst"), *cfg.SpamFilterBurst, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
+func validateSecretsManagerConfiguration(cfg *config.SecretsManagerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateCertificateValidityConfiguration(cfg.CertificateAuthorities, fldPath.Child("certificateAuthorities"))...)
+	allErrs = append(allErrs, validateCertificateValidityConfiguration(cfg.ServerCertificates, fldPath.Child("serverCertificates"))...)
+	allErrs = append(allErrs, validateCertificateValidityConfiguration(cfg.ClientCertificates, fldPath.Child("clientCertificates"))...)
+
+	return allErrs
+}
+
+func validateCertificateValidityConfiguration(cfg *config.CertificateValidityConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.Validity != nil && cfg.Validity.Duration <= secretsmanager.RenewBeforeExpiration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("validity"), cfg.Validity.Duration.String(), fmt.Sprintf("must be longer than %s", secretsmanager.RenewBeforeExpiration)))
+	}
+	if cfg.RenewAfterValidityPercentage != nil && (*cfg.RenewAfterValidityPercentage < 1 || *cfg.RenewAfterValidityPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewAfterValidityPercentage"), *cfg.RenewAfterValidityPercentage, "must be between 1 and 100"))
+	}
+
+	return allErrs
+}
+
+func validateExposureClassHandlerAutoscaling(autoscaling *config.ExposureClassHandlerAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be at least 1"))
+	}
+	if autoscaling.MaxReplicas != nil {
+		if *autoscaling.MaxReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must be at least 1"))
+		}
+		if autoscaling.MinReplicas != nil && *autoscaling.MaxReplicas < *autoscaling.MinReplicas {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must be greater than or equal to minReplicas"))
+		}
+	}
+	if autoscaling.TargetConnections != nil && *autoscaling.TargetConnections < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetConnections"), *autoscaling.TargetConnections, "must be at least 1"))
+	}
+
 	return allErrs
 }
 
@@ -144,6 +284,71 @@ func ValidateGardenletConfigurationUpdate(newCfg, oldCfg *config.GardenletConfig
 	return allErrs
 }
 
+func validateControllerInstallationControllerConfiguration(cfg *config.ControllerInstallationControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.OCIVerification != nil {
+		publicKeysPath := fldPath.Child("ociVerification", "publicKeys")
+
+		if len(cfg.OCIVerification.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(publicKeysPath, "at least one public key must be provided"))
+		}
+		for i, publicKey := range cfg.OCIVerification.PublicKeys {
+			if _, err := oci.ParsePublicKey(publicKey); err != nil {
+				allErrs = append(allErrs, field.Invalid(publicKeysPath.Index(i), publicKey, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateArtifactPreSyncConfiguration(cfg *config.ArtifactPreSyncConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(cfg.Registry) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("registry"), "registry mirror address must be provided"))
+	} else if _, err := oci.NewMirror(cfg.Registry, cfg.Insecure); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("registry"), cfg.Registry, err.Error()))
+	}
+
+	versions := sets.New[string]()
+	for i, version := range cfg.KubernetesVersions {
+		idxPath := fldPath.Child("kubernetesVersions").Index(i)
+
+		if _, err := semver.NewVersion(version); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, version, err.Error()))
+		}
+		if versions.Has(version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, version))
+		}
+		versions.Insert(version)
+	}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "sync period must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateControllerInstallationCareControllerConfiguration(cfg *config.ControllerInstallationCareControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.HeartbeatRestart != nil {
+		heartbeatRestartPath := fldPath.Child("heartbeatRestart")
+
+		if cfg.HeartbeatRestart.Threshold != nil && cfg.HeartbeatRestart.Threshold.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(heartbeatRestartPath.Child("threshold"), cfg.HeartbeatRestart.Threshold.Duration, "threshold must be positive"))
+		}
+		if cfg.HeartbeatRestart.MaxRestartsPerHour != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.HeartbeatRestart.MaxRestartsPerHour), heartbeatRestartPath.Child("maxRestartsPerHour"))...)
+		}
+	}
+
+	return allErrs
+}
+
 func validateShootControllerConfiguration(cfg *config.ShootControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -174,6 +379,72 @@ func validateShootControllerConfiguration(cfg *config.ShootControllerConfigurati
 		}
 	}
 
+	if cfg.Prioritization != nil {
+		allErrs = append(allErrs, validateShootPrioritization(cfg.Prioritization, fldPath.Child("prioritization"))...)
+	}
+
+	return allErrs
+}
+
+var availableShootPriorityBands = sets.New(
+	string(config.ShootPriorityBandUserTriggered),
+	string(config.ShootPriorityBandRetry),
+	string(config.ShootPriorityBandPeriodicSync),
+)
+
+func validateShootPrioritization(cfg *config.ShootPrioritization, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[config.ShootPriorityBandName]()
+	)
+
+	for i, band := range cfg.Bands {
+		idxPath := fldPath.Child("bands").Index(i)
+
+		if !availableShootPriorityBands.Has(string(band.Name)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), band.Name, sets.List(availableShootPriorityBands)))
+		} else if names.Has(band.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), band.Name))
+		}
+		names.Insert(band.Name)
+
+		if band.MaxConcurrentReconciles < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxConcurrentReconciles"), band.MaxConcurrentReconciles, "must be at least 1"))
+		}
+	}
+
+	if cfg.DeferralPeriod != nil && cfg.DeferralPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deferralPeriod"), cfg.DeferralPeriod.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateSeedGarbageCollectionControllerConfiguration(cfg *config.SeedGarbageCollectionControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "sync period must be positive"))
+	}
+
+	if cfg.GracePeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.GracePeriod.Duration), fldPath.Child("gracePeriod"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedZoneOutageControllerConfiguration(cfg *config.SeedZoneOutageControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "sync period must be positive"))
+	}
+
+	if cfg.OutageThreshold != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.OutageThreshold.Duration), fldPath.Child("outageThreshold"))...)
+	}
+
 	return allErrs
 }
 
@@ -196,8 +467,96 @@ func validateShootCareControllerConfiguration(cfg *config.ShootCareControllerCon
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ManagedResourceProgressingThreshold.Duration), fldPath.Child("managedResourceProgressingThreshold"))...)
 	}
 
-	for i := range cfg.ConditionThresholds {
-		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
+	for i, threshold := range cfg.ConditionThresholds {
+		idxPath := fldPath.Child("conditionThresholds").Index(i)
+
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(threshold.Duration.Duration), idxPath.Child("duration"))...)
+
+		if threshold.StaleDuration != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(threshold.StaleDuration.Duration), idxPath.Child("staleDuration"))...)
+		}
+
+		if threshold.Severity != nil && !availableCareConditionSeverities.Has(*threshold.Severity) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("severity"), *threshold.Severity, sets.List(availableCareConditionSeverities)))
+		}
+	}
+
+	if cfg.Remediations != nil && cfg.Remediations.UnavailableAPIServices != nil && cfg.Remediations.UnavailableAPIServices.Threshold != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.Remediations.UnavailableAPIServices.Threshold.Duration), fldPath.Child("remediations", "unavailableAPIServices", "threshold"))...)
+	}
+
+	if cfg.NodeLeaseProbe != nil {
+		allErrs = append(allErrs, validateNodeLeaseProbe(cfg.NodeLeaseProbe, fldPath.Child("nodeLeaseProbe"))...)
+	}
+
+	return allErrs
+}
+
+func validateNodeLeaseProbe(cfg *config.NodeLeaseProbe, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Timeout != nil && cfg.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), cfg.Timeout.Duration.String(), "must be positive"))
+	}
+
+	if cfg.NodeMonitorGracePeriod != nil && cfg.NodeMonitorGracePeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeMonitorGracePeriod"), cfg.NodeMonitorGracePeriod.Duration.String(), "must be positive"))
+	}
+
+	if cfg.FailurePercentage != nil && (*cfg.FailurePercentage <= 0 || *cfg.FailurePercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failurePercentage"), *cfg.FailurePercentage, "must be in the range (0, 100]"))
+	}
+
+	return allErrs
+}
+
+func validateShootAvailabilityControllerConfiguration(cfg *config.ShootAvailabilityControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if cfg.SyncPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
+	}
+
+	if cfg.Objective != nil && (*cfg.Objective <= 0 || *cfg.Objective >= 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("objective"), *cfg.Objective, "objective must be greater than 0 and less than 100"))
+	}
+
+	return allErrs
+}
+
+func validateShootLeftoverControllerConfiguration(cfg *config.ShootLeftoverControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if cfg.SyncPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
+	}
+
+	return allErrs
+}
+
+func validateShootSecurityAdvisoryControllerConfiguration(cfg *config.ShootSecurityAdvisoryControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if cfg.SyncPeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
+	}
+
+	if cfg.FeedConfigMapName != nil {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(*cfg.FeedConfigMapName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("feedConfigMapName"), *cfg.FeedConfigMapName, msg))
+		}
 	}
 
 	return allErrs
@@ -270,3 +629,19 @@ func validateBastionControllerConfiguration(cfg *config.BastionControllerConfigu
 
 	return allErrs
 }
+
+func validateAdaptiveRateLimiting(cfg *config.AdaptiveRateLimiting, qps float32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.MinQPS <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("adaptiveRateLimiting", "minQPS"), cfg.MinQPS, "must be greater than 0"))
+	}
+	if cfg.MaxQPS < cfg.MinQPS {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("adaptiveRateLimiting", "maxQPS"), cfg.MaxQPS, "must not be less than minQPS"))
+	}
+	if qps < cfg.MinQPS || qps > cfg.MaxQPS {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("qps"), qps, "must be between minQPS and maxQPS when adaptive rate limiting is configured"))
+	}
+
+	return allErrs
+}
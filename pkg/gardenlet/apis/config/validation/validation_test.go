@@ -14,6 +14,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	componentbaseconfig "k8s.io/component-base/config"
+	tracingv1 "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/utils/ptr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
@@ -51,7 +53,7 @@ var _ = Describe("GardenletConfiguration", func() {
 					SyncPeriod:                          &metav1.Duration{Duration: time.Hour},
 					StaleExtensionHealthChecks:          &config.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: time.Hour}},
 					ManagedResourceProgressingThreshold: &metav1.Duration{Duration: time.Hour},
-					ConditionThresholds:                 []config.ConditionThreshold{{Duration: metav1.Duration{Duration: time.Hour}}},
+					ConditionThresholds:                 []config.ConditionThreshold{{Duration: metav1.Duration{Duration: time.Hour}, StaleDuration: &metav1.Duration{Duration: time.Minute}, Severity: ptr.To("Warning")}},
 				},
 				ManagedSeed: &config.ManagedSeedControllerConfiguration{
 					ConcurrentSyncs:  &concurrentSyncs,
@@ -114,6 +116,23 @@ var _ = Describe("GardenletConfiguration", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		Context("log level overrides", func() {
+			It("should allow valid log levels", func() {
+				cfg.LogLevelOverrides = map[string]string{"shoot-care": "debug", "shoot": "error"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid unsupported log levels", func() {
+				cfg.LogLevelOverrides = map[string]string{"shoot-care": "verbose"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("logLevelOverrides[shoot-care]"),
+				}))))
+			})
+		})
+
 		Context("garden client connection", func() {
 			Context("kubeconfig validity", func() {
 				It("should allow when config is not set", func() {
@@ -204,6 +223,139 @@ var _ = Describe("GardenletConfiguration", func() {
 					}))))
 				})
 			})
+
+			Context("adaptive rate limiting", func() {
+				BeforeEach(func() {
+					cfg.GardenClientConnection = &config.GardenClientConnection{
+						ClientConnectionConfiguration: componentbaseconfig.ClientConnectionConfiguration{QPS: 50},
+					}
+				})
+
+				It("should allow valid configurations", func() {
+					cfg.GardenClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{MinQPS: 10, MaxQPS: 200}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+				})
+
+				It("should forbid non-positive minimum QPS", func() {
+					cfg.GardenClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{MinQPS: 0, MaxQPS: 200}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("gardenClientConnection.adaptiveRateLimiting.minQPS"),
+					}))))
+				})
+
+				It("should forbid maximum QPS lower than minimum QPS", func() {
+					cfg.GardenClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{MinQPS: 50, MaxQPS: 40}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("gardenClientConnection.adaptiveRateLimiting.maxQPS"),
+					}))))
+				})
+
+				It("should forbid QPS outside of the adaptive range", func() {
+					cfg.GardenClientConnection.AdaptiveRateLimiting = &config.AdaptiveRateLimiting{MinQPS: 100, MaxQPS: 200}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("gardenClientConnection.qps"),
+					}))))
+				})
+			})
+		})
+
+		Context("seed client connection", func() {
+			It("should forbid invalid adaptive rate limiting configurations", func() {
+				cfg.SeedClientConnection = &config.SeedClientConnection{
+					ClientConnectionConfiguration: componentbaseconfig.ClientConnectionConfiguration{QPS: 50},
+					AdaptiveRateLimiting:          &config.AdaptiveRateLimiting{MinQPS: 10, MaxQPS: 20},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("seedClientConnection.qps"),
+				}))))
+			})
+		})
+
+		Context("controllerInstallation controller", func() {
+			const publicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEONbw6Cf6mcsIezpFKg3CPQ+FspUb
+fAorDj5osm0TI7Npy01g3rZsovRHxivNbwYIjLfmXvHkVmrXM0GcLjqXiw==
+-----END PUBLIC KEY-----
+`
+
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ControllerInstallation = &config.ControllerInstallationControllerConfiguration{
+					OCIVerification: &config.OCIVerification{PublicKeys: []string{publicKey}},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid configuring OCI verification without public keys", func() {
+				cfg.Controllers.ControllerInstallation = &config.ControllerInstallationControllerConfiguration{
+					OCIVerification: &config.OCIVerification{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.controllerInstallation.ociVerification.publicKeys"),
+					})),
+				))
+			})
+
+			It("should forbid invalid public keys", func() {
+				cfg.Controllers.ControllerInstallation = &config.ControllerInstallationControllerConfiguration{
+					OCIVerification: &config.OCIVerification{PublicKeys: []string{publicKey, "foo"}},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.controllerInstallation.ociVerification.publicKeys[1]"),
+						"Detail": Equal("no PEM block found"),
+					})),
+				))
+			})
+		})
+
+		Context("controllerInstallationCare controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ControllerInstallationCare = &config.ControllerInstallationCareControllerConfiguration{
+					HeartbeatRestart: &config.ExtensionHeartbeatRestart{
+						Enabled:            true,
+						Threshold:          &metav1.Duration{Duration: 5 * time.Minute},
+						MaxRestartsPerHour: ptr.To[int32](3),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ControllerInstallationCare = &config.ControllerInstallationCareControllerConfiguration{
+					HeartbeatRestart: &config.ExtensionHeartbeatRestart{
+						Enabled:            true,
+						Threshold:          &metav1.Duration{},
+						MaxRestartsPerHour: ptr.To[int32](-1),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.controllerInstallationCare.heartbeatRestart.threshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.controllerInstallationCare.heartbeatRestart.maxRestartsPerHour"),
+					})),
+				))
+			})
 		})
 
 		Context("shoot controller", func() {
@@ -258,6 +410,48 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow valid priority bands", func() {
+				cfg.Controllers.Shoot.Prioritization = &config.ShootPrioritization{
+					Bands: []config.ShootPriorityBand{
+						{Name: config.ShootPriorityBandRetry, MaxConcurrentReconciles: 5},
+						{Name: config.ShootPriorityBandPeriodicSync, MaxConcurrentReconciles: 10},
+					},
+					DeferralPeriod: &metav1.Duration{Duration: 10 * time.Second},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid priority bands", func() {
+				cfg.Controllers.Shoot.Prioritization = &config.ShootPrioritization{
+					Bands: []config.ShootPriorityBand{
+						{Name: "Foo", MaxConcurrentReconciles: 5},
+						{Name: config.ShootPriorityBandPeriodicSync, MaxConcurrentReconciles: 0},
+						{Name: config.ShootPriorityBandPeriodicSync, MaxConcurrentReconciles: 10},
+					},
+					DeferralPeriod: &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shoot.prioritization.bands[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.prioritization.bands[1].maxConcurrentReconciles"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shoot.prioritization.bands[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.prioritization.deferralPeriod"),
+					})),
+				))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -268,7 +462,19 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ShootCare.SyncPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &config.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: -1}}
 				cfg.Controllers.ShootCare.ManagedResourceProgressingThreshold = &metav1.Duration{Duration: -1}
-				cfg.Controllers.ShootCare.ConditionThresholds = []config.ConditionThreshold{{Duration: metav1.Duration{Duration: -1}}}
+				cfg.Controllers.ShootCare.ConditionThresholds = []config.ConditionThreshold{{
+					Duration:      metav1.Duration{Duration: -1},
+					StaleDuration: &metav1.Duration{Duration: -1},
+					Severity:      ptr.To("Info"),
+				}}
+				cfg.Controllers.ShootCare.Remediations = &config.ShootCareRemediations{
+					UnavailableAPIServices: &config.UnavailableAPIServicesRemediation{Threshold: &metav1.Duration{Duration: -1}},
+				}
+				cfg.Controllers.ShootCare.NodeLeaseProbe = &config.NodeLeaseProbe{
+					Timeout:                &metav1.Duration{},
+					NodeMonitorGracePeriod: &metav1.Duration{Duration: -1},
+					FailurePercentage:      ptr.To[int32](101),
+				}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -293,6 +499,195 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shootCare.conditionThresholds[0].duration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.conditionThresholds[0].staleDuration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.conditionThresholds[0].severity"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.remediations.unavailableAPIServices.threshold"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.nodeLeaseProbe.timeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.nodeLeaseProbe.nodeMonitorGracePeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.nodeLeaseProbe.failurePercentage"),
+					})),
+				))
+			})
+		})
+
+		Context("seedGarbageCollection controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.SeedGarbageCollection = &config.SeedGarbageCollectionControllerConfiguration{
+					SyncPeriod:  &metav1.Duration{Duration: time.Hour},
+					GracePeriod: &metav1.Duration{Duration: 0},
+					DryRun:      ptr.To(false),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.SeedGarbageCollection = &config.SeedGarbageCollectionControllerConfiguration{
+					SyncPeriod:  &metav1.Duration{Duration: 0},
+					GracePeriod: &metav1.Duration{Duration: -1},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedGarbageCollection.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedGarbageCollection.gracePeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("seedZoneOutage controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.SeedZoneOutage = &config.SeedZoneOutageControllerConfiguration{
+					SyncPeriod:      &metav1.Duration{Duration: 30 * time.Second},
+					OutageThreshold: &metav1.Duration{Duration: 0},
+					DegradationMode: ptr.To(false),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.SeedZoneOutage = &config.SeedZoneOutageControllerConfiguration{
+					SyncPeriod:      &metav1.Duration{Duration: 0},
+					OutageThreshold: &metav1.Duration{Duration: -1},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedZoneOutage.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.seedZoneOutage.outageThreshold"),
+					})),
+				))
+			})
+		})
+
+		Context("shootAvailability controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ShootAvailability = &config.ShootAvailabilityControllerConfiguration{
+					ConcurrentSyncs: ptr.To(5),
+					SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					Objective:       ptr.To(99.95),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootAvailability = &config.ShootAvailabilityControllerConfiguration{
+					ConcurrentSyncs: ptr.To(-1),
+					SyncPeriod:      &metav1.Duration{Duration: -1},
+					Objective:       ptr.To(100.0),
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootAvailability.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootAvailability.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootAvailability.objective"),
+					})),
+				))
+			})
+		})
+
+		Context("shootLeftover controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ShootLeftover = &config.ShootLeftoverControllerConfiguration{
+					ConcurrentSyncs: ptr.To(5),
+					SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootLeftover = &config.ShootLeftoverControllerConfiguration{
+					ConcurrentSyncs: ptr.To(-1),
+					SyncPeriod:      &metav1.Duration{Duration: -1},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootLeftover.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootLeftover.syncPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("shootSecurityAdvisory controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ShootSecurityAdvisory = &config.ShootSecurityAdvisoryControllerConfiguration{
+					ConcurrentSyncs:   ptr.To(5),
+					SyncPeriod:        &metav1.Duration{Duration: time.Hour},
+					FeedConfigMapName: ptr.To("cve-feed"),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootSecurityAdvisory = &config.ShootSecurityAdvisoryControllerConfiguration{
+					ConcurrentSyncs:   ptr.To(-1),
+					SyncPeriod:        &metav1.Duration{Duration: -1},
+					FeedConfigMapName: ptr.To("CVE_Feed"),
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil, false)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootSecurityAdvisory.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootSecurityAdvisory.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootSecurityAdvisory.feedConfigMapName"),
+					})),
 				))
 			})
 		})
@@ -585,6 +980,54 @@ var _ = Describe("GardenletConfiguration", func() {
 					}))))
 				})
 			})
+
+			Context("autoscaling", func() {
+				It("should allow valid autoscaling settings", func() {
+					cfg.ExposureClassHandlers[0].Autoscaling = &config.ExposureClassHandlerAutoscaling{
+						MinReplicas:       ptr.To[int32](2),
+						MaxReplicas:       ptr.To[int32](6),
+						TargetConnections: ptr.To[int32](1000),
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+				})
+
+				It("should forbid invalid replica counts and connection targets", func() {
+					cfg.ExposureClassHandlers[0].Autoscaling = &config.ExposureClassHandlerAutoscaling{
+						MinReplicas:       ptr.To[int32](0),
+						MaxReplicas:       ptr.To[int32](0),
+						TargetConnections: ptr.To[int32](0),
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("exposureClassHandlers[0].autoscaling.minReplicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("exposureClassHandlers[0].autoscaling.maxReplicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("exposureClassHandlers[0].autoscaling.targetConnections"),
+						})),
+					))
+				})
+
+				It("should forbid maxReplicas lower than minReplicas", func() {
+					cfg.ExposureClassHandlers[0].Autoscaling = &config.ExposureClassHandlerAutoscaling{
+						MinReplicas: ptr.To[int32](3),
+						MaxReplicas: ptr.To[int32](2),
+					}
+
+					Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("exposureClassHandlers[0].autoscaling.maxReplicas"),
+						"Detail": Equal("must be greater than or equal to minReplicas"),
+					}))))
+				})
+			})
 		})
 
 		Context("nodeToleration", func() {
@@ -632,6 +1075,210 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("tracing", func() {
+			It("should pass with valid tracing configuration", func() {
+				cfg.Tracing = &tracingv1.TracingConfiguration{
+					Endpoint:               ptr.To("otel-collector.garden.svc:4317"),
+					SamplingRatePerMillion: ptr.To[int32](1000),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid tracing configuration", func() {
+				cfg.Tracing = &tracingv1.TracingConfiguration{
+					Endpoint:               ptr.To("https://otel-collector:4317"),
+					SamplingRatePerMillion: ptr.To[int32](1000001),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("tracing.endpoint"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("tracing.samplingRatePerMillion"),
+					})),
+				))
+			})
+		})
+
+		Context("events", func() {
+			It("should pass with valid events configuration", func() {
+				cfg.Events = &config.EventsConfiguration{
+					MaxSimilarEvents:    ptr.To[int32](5),
+					AggregationInterval: &metav1.Duration{Duration: 30 * time.Minute},
+					CacheSize:           ptr.To[int32](8192),
+					SpamFilterQPS:       ptr.To[float32](0.01),
+					SpamFilterBurst:     ptr.To[int32](10),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid events configuration", func() {
+				cfg.Events = &config.EventsConfiguration{
+					MaxSimilarEvents:    ptr.To[int32](0),
+					AggregationInterval: &metav1.Duration{Duration: 500 * time.Millisecond},
+					CacheSize:           ptr.To[int32](-1),
+					SpamFilterQPS:       ptr.To[float32](0),
+					SpamFilterBurst:     ptr.To[int32](0),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("events.maxSimilarEvents"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("events.aggregationInterval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("events.cacheSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("events.spamFilterQPS"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("events.spamFilterBurst"),
+					})),
+				))
+			})
+		})
+
+		Context("secrets manager", func() {
+			It("should pass with valid secrets manager configuration", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfiguration{
+					CertificateAuthorities: &config.CertificateValidityConfiguration{
+						Validity:                     &metav1.Duration{Duration: 365 * 24 * time.Hour},
+						RenewAfterValidityPercentage: ptr.To[int32](50),
+					},
+					ServerCertificates: &config.CertificateValidityConfiguration{
+						Validity: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					},
+					ClientCertificates: &config.CertificateValidityConfiguration{
+						RenewAfterValidityPercentage: ptr.To[int32](100),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid secrets manager configuration", func() {
+				cfg.SecretsManager = &config.SecretsManagerConfiguration{
+					CertificateAuthorities: &config.CertificateValidityConfiguration{
+						Validity: &metav1.Duration{Duration: 10 * 24 * time.Hour},
+					},
+					ServerCertificates: &config.CertificateValidityConfiguration{
+						RenewAfterValidityPercentage: ptr.To[int32](0),
+					},
+					ClientCertificates: &config.CertificateValidityConfiguration{
+						RenewAfterValidityPercentage: ptr.To[int32](101),
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManager.certificateAuthorities.validity"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManager.serverCertificates.renewAfterValidityPercentage"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManager.clientCertificates.renewAfterValidityPercentage"),
+					})),
+				))
+			})
+		})
+
+		Context("artifact pre-sync", func() {
+			It("should pass with valid artifact pre-sync configuration", func() {
+				cfg.ArtifactPreSync = &config.ArtifactPreSyncConfiguration{
+					Registry:           "registry.garden.svc:5000/mirror",
+					Insecure:           true,
+					KubernetesVersions: []string{"1.30.4", "1.31.1"},
+					SyncPeriod:         &metav1.Duration{Duration: time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail without registry", func() {
+				cfg.ArtifactPreSync = &config.ArtifactPreSyncConfiguration{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("artifactPreSync.registry"),
+					})),
+				))
+			})
+
+			It("should fail with invalid artifact pre-sync configuration", func() {
+				cfg.ArtifactPreSync = &config.ArtifactPreSyncConfiguration{
+					Registry:           "registry.garden.svc:5000/Mirror",
+					KubernetesVersions: []string{"1.30.4", "foo", "1.30.4"},
+					SyncPeriod:         &metav1.Duration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactPreSync.registry"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactPreSync.kubernetesVersions[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("artifactPreSync.kubernetesVersions[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("artifactPreSync.syncPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("logging", func() {
+			It("should pass with valid slow request logging configuration", func() {
+				cfg.Logging = &config.Logging{
+					ShootSlowRequestLogging: &config.ShootSlowRequestLogging{
+						Enabled:   ptr.To(true),
+						Threshold: &metav1.Duration{Duration: 500 * time.Millisecond},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with non-positive slow request logging threshold", func() {
+				cfg.Logging = &config.Logging{
+					ShootSlowRequestLogging: &config.ShootSlowRequestLogging{
+						Enabled:   ptr.To(true),
+						Threshold: &metav1.Duration{},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("logging.shootSlowRequestLogging.threshold"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -23,10 +23,12 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/controller/tokenrequestor"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/artifactpresync"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupbucket"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/backupentry"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/imagevectoroverride"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
@@ -71,6 +73,15 @@ func AddToManager(
 		return fmt.Errorf("failed creating seed clientset: %w", err)
 	}
 
+	if cfg.ArtifactPreSync != nil {
+		if err := (&artifactpresync.Reconciler{
+			Config:   *cfg.ArtifactPreSync,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster); err != nil {
+			return fmt.Errorf("failed adding ArtifactPreSync controller: %w", err)
+		}
+	}
+
 	if err := (&backupbucket.Reconciler{
 		Config:   *cfg.Controllers.BackupBucket,
 		SeedName: cfg.SeedConfig.Name,
@@ -95,6 +106,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding ControllerInstallation controller: %w", err)
 	}
 
+	if err := (&imagevectoroverride.Reconciler{
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(ctx, mgr, gardenCluster); err != nil {
+		return fmt.Errorf("failed adding ImageVectorOverride controller: %w", err)
+	}
+
 	if err := (&managedseed.Reconciler{
 		Config:         *cfg,
 		ShootClientMap: shootClientMap,
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artifactpresync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// Reconciler syncs the container images and Helm charts required for operating the seed and its shoots to the registry
+// mirror in the seed cluster.
+type Reconciler struct {
+	GardenClient client.Client
+	Config       config.ArtifactPreSyncConfiguration
+	SeedName     string
+	Mirror       oci.ArtifactMirror
+	// ImageVector returns the image vector of the gardenlet.
+	ImageVector func() imagevector.ImageVector
+}
+
+// Reconcile syncs the required artifacts to the registry mirror.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	artifacts, err := r.requiredArtifacts(ctx, seed)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing required artifacts: %w", err)
+	}
+
+	log.Info("Syncing artifacts to registry mirror", "registry", r.Config.Registry, "artifacts", len(artifacts))
+
+	var errs []error
+	for _, artifact := range artifacts {
+		if err := r.Mirror.Sync(ctx, artifact); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed syncing %d of %d artifacts to registry mirror: %w", len(errs), len(artifacts), err)
+	}
+
+	log.Info("Successfully synced artifacts to registry mirror", "registry", r.Config.Registry, "artifacts", len(artifacts))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// requiredArtifacts returns the references of all container images and Helm charts required for operating the seed
+// and its shoots. The container images are computed for the configured Kubernetes versions as well as for the versions
+// of the seed and of all shoots hosted by it, respecting the ImageVectorOverrides which apply to them.
+func (r *Reconciler) requiredArtifacts(ctx context.Context, seed *gardencorev1beta1.Seed) ([]string, error) {
+	if seed.Status.KubernetesVersion == nil {
+		return nil, fmt.Errorf("kubernetes version of seed %q is not yet reported", seed.Name)
+	}
+
+	var (
+		seedVersion = *seed.Status.KubernetesVersion
+		artifacts   = sets.New[string]()
+	)
+
+	images, err := gardenerutils.ImageReferences(r.ImageVector(), seedVersion, append([]string{seedVersion}, r.Config.KubernetesVersions...)...)
+	if err != nil {
+		return nil, err
+	}
+	artifacts.Insert(images...)
+
+	imageVectorOverrideList := &gardencorev1beta1.ImageVectorOverrideList{}
+	if err := r.GardenClient.List(ctx, imageVectorOverrideList); err != nil {
+		return nil, fmt.Errorf("failed listing ImageVectorOverrides: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seed.Name}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	for _, shoot := range shootList.Items {
+		override, err := gardenerutils.ShootImageVectorOverride(imageVectorOverrideList.Items, seed, &shoot)
+		if err != nil {
+			return nil, err
+		}
+
+		vector := r.ImageVector()
+		if len(override) > 0 {
+			vector = imagevector.Merge(vector, override)
+		}
+
+		images, err := gardenerutils.ImageReferences(vector, seedVersion, append([]string{shoot.Spec.Kubernetes.Version}, r.Config.KubernetesVersions...)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing images for shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+		artifacts.Insert(images...)
+		artifacts.Insert(gardenerutils.AddonChartReferences(&shoot)...)
+	}
+
+	controllerInstallationList := &gardencorev1beta1.ControllerInstallationList{}
+	if err := r.GardenClient.List(ctx, controllerInstallationList, client.MatchingFields{core.SeedRefName: seed.Name}); err != nil {
+		return nil, fmt.Errorf("failed listing ControllerInstallations: %w", err)
+	}
+
+	for _, controllerInstallation := range controllerInstallationList.Items {
+		if controllerInstallation.Spec.DeploymentRef == nil {
+			continue
+		}
+
+		controllerDeployment := &gardencorev1.ControllerDeployment{}
+		if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: controllerInstallation.Spec.DeploymentRef.Name}, controllerDeployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed reading ControllerDeployment %s: %w", controllerInstallation.Spec.DeploymentRef.Name, err)
+		}
+
+		if controllerDeployment.Helm != nil && controllerDeployment.Helm.OCIRepository != nil {
+			artifacts.Insert(controllerDeployment.Helm.OCIRepository.GetURL())
+		}
+	}
+
+	return sets.List(artifacts), nil
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package artifactpresync_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/artifactpresync"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	fakeoci "github.com/gardener/gardener/pkg/utils/oci/fake"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		mirror       *fakeoci.Mirror
+
+		seed    *gardencorev1beta1.Seed
+		request reconcile.Request
+
+		reconciler *Reconciler
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.ControllerInstallation{}, core.SeedRefName, indexer.ControllerInstallationSeedRefNameIndexerFunc).
+			Build()
+		mirror = fakeoci.NewMirror()
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Status:     gardencorev1beta1.SeedStatus{KubernetesVersion: ptr.To("1.30.0")},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			Config: config.ArtifactPreSyncConfiguration{
+				Registry:           "registry.garden.svc:5000",
+				KubernetesVersions: []string{"1.31.0"},
+				SyncPeriod:         &metav1.Duration{Duration: time.Hour},
+			},
+			SeedName: seed.Name,
+			Mirror:   mirror,
+			ImageVector: func() imagevector.ImageVector {
+				return imagevector.ImageVector{
+					{Name: "foo", Repository: "registry.example.com/foo", Tag: ptr.To("v1")},
+					{Name: "hyperkube", Repository: "registry.example.com/hyperkube"},
+				}
+			},
+		}
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(mirror.Artifacts()).To(BeEmpty())
+	})
+
+	It("should fail if the Kubernetes version of the seed is not yet known", func() {
+		seed.Status.KubernetesVersion = nil
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("kubernetes version of seed \"seed\" is not yet reported")))
+	})
+
+	It("should sync the images for the seed and the configured Kubernetes versions", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(mirror.Artifacts()).To(ConsistOf(
+			"registry.example.com/foo:v1",
+			"registry.example.com/hyperkube:v1.30.0",
+			"registry.example.com/hyperkube:v1.31.0",
+		))
+	})
+
+	It("should sync the images and charts for the shoots and extensions on the seed", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project", Labels: map[string]string{"hotfix": "true"}},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName:   ptr.To(seed.Name),
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.29.5"},
+				Addons: &gardencorev1beta1.Addons{
+					Charts: []gardencorev1beta1.AddonChart{{Name: "addon", OCIRepository: gardencorev1beta1.OCIRepository{Ref: ptr.To("registry.example.com/charts/addon:1.0.0")}}},
+				},
+			},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName:   ptr.To("other-seed"),
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.28.0"},
+			},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.ImageVectorOverride{
+			ObjectMeta: metav1.ObjectMeta{Name: "hotfix"},
+			Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+				ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"hotfix": "true"}},
+				Images:        []gardencorev1beta1.ImageOverride{{Name: "foo", Repository: "registry.example.com/foo", Tag: ptr.To("v1-hotfix")}},
+			},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1.ControllerDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider"},
+			Helm: &gardencorev1.HelmControllerDeployment{
+				OCIRepository: &gardencorev1.OCIRepository{Repository: ptr.To("registry.example.com/charts/provider"), Tag: ptr.To("1.2.3")},
+			},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.ControllerInstallation{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider"},
+			Spec: gardencorev1beta1.ControllerInstallationSpec{
+				SeedRef:       corev1.ObjectReference{Name: seed.Name},
+				DeploymentRef: &corev1.ObjectReference{Name: "provider"},
+			},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(mirror.Artifacts()).To(ConsistOf(
+			"registry.example.com/foo:v1",
+			"registry.example.com/foo:v1-hotfix",
+			"registry.example.com/hyperkube:v1.29.5",
+			"registry.example.com/hyperkube:v1.30.0",
+			"registry.example.com/hyperkube:v1.31.0",
+			"registry.example.com/charts/addon:1.0.0",
+			"registry.example.com/charts/provider:1.2.3",
+		))
+	})
+
+	It("should sync the remaining artifacts and return an error if an artifact cannot be synced", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		mirror.SetFailure("registry.example.com/hyperkube:v1.30.0", errors.New("fake"))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed syncing 1 of 3 artifacts to registry mirror")))
+		Expect(mirror.Artifacts()).To(ConsistOf(
+			"registry.example.com/foo:v1",
+			"registry.example.com/hyperkube:v1.31.0",
+		))
+	})
+})
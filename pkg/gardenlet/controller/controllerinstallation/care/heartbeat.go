@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/extensions"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventExtensionRestarted is the event reason for the restart of an extension whose heartbeat is stale.
+	EventExtensionRestarted = "ExtensionRestarted"
+	// EventExtensionRestartBudgetExhausted is the event reason for an extension whose heartbeat is stale but which was
+	// not restarted because it was already restarted too often within the last hour.
+	EventExtensionRestartBudgetExhausted = "ExtensionRestartBudgetExhausted"
+
+	restartBudgetPeriod = time.Hour
+)
+
+// restartExtensionIfHeartbeatStale restarts the pods of all deployments of the extension if its heartbeat lease has
+// not been renewed within the configured threshold. Restarts are bounded by the configured number of restarts per hour.
+// Extensions which do not maintain a heartbeat lease are not restarted.
+func (r *Reconciler) restartExtensionIfHeartbeatStale(ctx context.Context, log logr.Logger, controllerInstallation *gardencorev1beta1.ControllerInstallation) error {
+	var (
+		namespace = gardenerutils.NamespaceNameForControllerInstallation(controllerInstallation)
+		threshold = r.Config.HeartbeatRestart.Threshold.Duration
+	)
+
+	heartbeatLease := &coordinationv1.Lease{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: extensions.HeartBeatResourceName, Namespace: namespace}, heartbeatLease); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get heartbeat lease: %w", err)
+	}
+
+	if heartbeatLease.Spec.RenewTime == nil {
+		return nil
+	}
+
+	staleFor := r.Clock.Since(heartbeatLease.Spec.RenewTime.Time)
+	if staleFor <= threshold {
+		return nil
+	}
+
+	r.restartsLock.Lock()
+	defer r.restartsLock.Unlock()
+
+	now := r.Clock.Now()
+	restarts := r.recentRestarts(controllerInstallation.Name, now)
+
+	// give the restarted pods some time to renew the heartbeat lease before restarting them again
+	if len(restarts) > 0 && now.Sub(restarts[len(restarts)-1]) <= threshold {
+		return nil
+	}
+
+	if int32(len(restarts)) >= ptr.Deref(r.Config.HeartbeatRestart.MaxRestartsPerHour, 0) {
+		log.Info("Heartbeat of extension is stale but restart budget is exhausted", "staleFor", staleFor.Round(time.Second), "restarts", len(restarts))
+		r.Recorder.Eventf(controllerInstallation, corev1.EventTypeWarning, EventExtensionRestartBudgetExhausted, "Heartbeat of extension has not been renewed for %s but it was already restarted %d times within the last hour", staleFor.Round(time.Second), len(restarts))
+		return nil
+	}
+
+	deploymentList := &appsv1.DeploymentList{}
+	if err := r.SeedClient.List(ctx, deploymentList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list deployments of extension: %w", err)
+	}
+
+	for _, deployment := range deploymentList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return fmt.Errorf("failed to parse selector of deployment %q: %w", client.ObjectKeyFromObject(&deployment), err)
+		}
+
+		if err := r.SeedClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return fmt.Errorf("failed to restart pods of deployment %q: %w", client.ObjectKeyFromObject(&deployment), err)
+		}
+	}
+
+	r.restarts[controllerInstallation.Name] = append(restarts, now)
+
+	log.Info("Restarted extension because its heartbeat is stale", "staleFor", staleFor.Round(time.Second))
+	r.Recorder.Eventf(controllerInstallation, corev1.EventTypeWarning, EventExtensionRestarted, "Restarted extension because its heartbeat has not been renewed for %s", staleFor.Round(time.Second))
+	return nil
+}
+
+// recentRestarts returns the restarts of the given ControllerInstallation within the restart budget period and drops
+// older ones. The caller must hold restartsLock.
+func (r *Reconciler) recentRestarts(name string, now time.Time) []time.Time {
+	if r.restarts == nil {
+		r.restarts = make(map[string][]time.Time)
+	}
+
+	var restarts []time.Time
+	for _, t := range r.restarts[name] {
+		if now.Sub(t) < restartBudgetPeriod {
+			restarts = append(restarts, t)
+		}
+	}
+
+	r.restarts[name] = restarts
+	return restarts
+}
@@ -7,10 +7,12 @@ package care
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -30,7 +32,11 @@ type Reconciler struct {
 	SeedClient      client.Client
 	Config          config.ControllerInstallationCareControllerConfiguration
 	Clock           clock.Clock
+	Recorder        record.EventRecorder
 	GardenNamespace string
+
+	restartsLock sync.Mutex
+	restarts     map[string][]time.Time
 }
 
 // Reconcile reconciles ControllerInstallations, checks their health status and reports it via conditions.
@@ -113,5 +119,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to patch conditions: %w", err)
 	}
 
+	if r.Config.HeartbeatRestart != nil && r.Config.HeartbeatRestart.Enabled && conditionControllerInstallationInstalled.Status == gardencorev1beta1.ConditionTrue {
+		if err := r.restartExtensionIfHeartbeatStale(seedCtx, log, controllerInstallation); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
@@ -11,10 +11,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -152,6 +156,122 @@ var _ = Describe("Reconciler", func() {
 				),
 			),
 		)
+
+		Context("heartbeat restart", func() {
+			const extensionNamespace = "extension-" + controllerInstallationName
+
+			var (
+				recorder       *record.FakeRecorder
+				heartbeatLease *coordinationv1.Lease
+				pod            *corev1.Pod
+				otherPod       *corev1.Pod
+			)
+
+			BeforeEach(func() {
+				recorder = record.NewFakeRecorder(10)
+				reconciler = &Reconciler{
+					GardenClient: gardenClient,
+					SeedClient:   seedClient,
+					Config: config.ControllerInstallationCareControllerConfiguration{
+						SyncPeriod: &metav1.Duration{Duration: syncPeriodDuration},
+						HeartbeatRestart: &config.ExtensionHeartbeatRestart{
+							Enabled:            true,
+							Threshold:          &metav1.Duration{Duration: 5 * time.Minute},
+							MaxRestartsPerHour: ptr.To[int32](2),
+						},
+					},
+					Clock:           fakeClock,
+					Recorder:        recorder,
+					GardenNamespace: gardenNamespace,
+				}
+
+				heartbeatLease = &coordinationv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-heartbeat", Namespace: extensionNamespace},
+					Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: fakeClock.Now().Add(-10 * time.Minute)}},
+				}
+				pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "extension-abcde", Namespace: extensionNamespace, Labels: map[string]string{"app": "extension"}}}
+				otherPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: extensionNamespace, Labels: map[string]string{"app": "other"}}}
+
+				Expect(seedClient.Create(ctx, healthyManagedResource())).To(Succeed())
+				Expect(seedClient.Create(ctx, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "extension", Namespace: extensionNamespace},
+					Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "extension"}}},
+				})).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				Expect(seedClient.Create(ctx, pod)).To(Succeed())
+				Expect(seedClient.Create(ctx, otherPod)).To(Succeed())
+			})
+
+			restartPod := func() {
+				pod.ResourceVersion = ""
+				ExpectWithOffset(1, seedClient.Create(ctx, pod)).To(Succeed())
+			}
+
+			It("should not restart the extension if it does not maintain a heartbeat lease", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should not restart the extension if the heartbeat is not stale", func() {
+				heartbeatLease.Spec.RenewTime = &metav1.MicroTime{Time: fakeClock.Now().Add(-time.Minute)}
+				Expect(seedClient.Create(ctx, heartbeatLease)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should not restart the extension if the heartbeat restart is disabled", func() {
+				reconciler.(*Reconciler).Config.HeartbeatRestart.Enabled = false
+				Expect(seedClient.Create(ctx, heartbeatLease)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should restart the extension if the heartbeat is stale and respect the restart budget", func() {
+				Expect(seedClient.Create(ctx, heartbeatLease)).To(Succeed())
+
+				By("Restart extension")
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(otherPod), otherPod)).To(Succeed())
+				Expect(recorder.Events).To(Receive(Equal("Warning ExtensionRestarted Restarted extension because its heartbeat has not been renewed for 10m0s")))
+
+				By("Wait for restarted pods to renew the heartbeat")
+				restartPod()
+				fakeClock.Step(time.Minute)
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(recorder.Events).To(BeEmpty())
+
+				By("Restart extension again")
+				fakeClock.Step(5 * time.Minute)
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+				Expect(recorder.Events).To(Receive(Equal("Warning ExtensionRestarted Restarted extension because its heartbeat has not been renewed for 16m0s")))
+
+				By("Do not restart extension once the budget is exhausted")
+				restartPod()
+				fakeClock.Step(10 * time.Minute)
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+				Expect(recorder.Events).To(Receive(Equal("Warning ExtensionRestartBudgetExhausted Heartbeat of extension has not been renewed for 26m0s but it was already restarted 2 times within the last hour")))
+
+				By("Restart extension once earlier restarts are older than one hour")
+				fakeClock.Step(time.Hour)
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriodDuration}))
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+				Expect(recorder.Events).To(Receive(ContainSubstring("ExtensionRestarted")))
+			})
+		})
 	})
 })
 
@@ -22,6 +22,7 @@ import (
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
@@ -40,10 +41,27 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 		r.Clock = clock.RealClock{}
 	}
 	if r.HelmRegistry == nil {
-		helmRegisty, err := oci.NewHelmRegistry()
+		var (
+			helmRegisty *oci.HelmRegistry
+			err         error
+		)
+
+		if ociVerification := r.Config.Controllers.ControllerInstallation.OCIVerification; ociVerification != nil {
+			helmRegisty, err = oci.NewHelmRegistryWithSignatureVerification(ociVerification.PublicKeys)
+		} else {
+			helmRegisty, err = oci.NewHelmRegistry()
+		}
+		if err != nil {
+			return err
+		}
+
+		mirror, err := gardenlethelper.ArtifactMirror(&r.Config)
 		if err != nil {
 			return err
 		}
+		if mirror != nil {
+			helmRegisty.WithMirror(mirror)
+		}
 		r.HelmRegistry = helmRegisty
 	}
 
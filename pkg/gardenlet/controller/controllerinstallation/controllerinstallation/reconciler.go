@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -249,6 +250,10 @@ func (r *Reconciler) reconcile(
 		var err error
 		archive, err = r.HelmRegistry.Pull(seedCtx, helmDeployment.OCIRepository)
 		if err != nil {
+			if errors.Is(err, oci.ErrSignatureVerificationFailed) {
+				conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartSignatureInvalid", fmt.Sprintf("chart signature verification failed: %+v", err))
+				return reconcile.Result{}, err
+			}
 			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartCannotBePulled", fmt.Sprintf("chart pulling process failed: %+v", err))
 			return reconcile.Result{}, err
 		}
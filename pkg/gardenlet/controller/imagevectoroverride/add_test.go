@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevectoroverride_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/imagevectoroverride"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#MapImageVectorOverrideToSeed", func() {
+		It("should map to the seed of the gardenlet", func() {
+			reconciler := &Reconciler{SeedName: "seed"}
+			override := &gardencorev1beta1.ImageVectorOverride{ObjectMeta: metav1.ObjectMeta{Name: "hotfix"}}
+
+			Expect(reconciler.MapImageVectorOverrideToSeed(context.Background(), logr.Discard(), nil, override)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "seed"}},
+			))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevectoroverride
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// Reconciler reconciles ImageVectorOverrides and applies those selecting the seed of this gardenlet to the image vector
+// used by all of its components.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedName     string
+	// SetOverride replaces the image vector override of the gardenlet process.
+	SetOverride func(imagevector.ImageVector)
+}
+
+// Reconcile computes the image vector override for the seed and applies it.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	imageVectorOverrideList := &gardencorev1beta1.ImageVectorOverrideList{}
+	if err := r.GardenClient.List(ctx, imageVectorOverrideList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing ImageVectorOverrides: %w", err)
+	}
+
+	override, err := gardenerutils.SeedImageVectorOverride(imageVectorOverrideList.Items, seed)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Applying image vector override", "images", len(override))
+	r.SetOverride(override)
+
+	return reconcile.Result{}, nil
+}
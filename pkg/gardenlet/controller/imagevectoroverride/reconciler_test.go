@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagevectoroverride_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/imagevectoroverride"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+
+		seed    *gardencorev1beta1.Seed
+		request reconcile.Request
+
+		reconciler      *Reconciler
+		appliedOverride imagevector.ImageVector
+		overrideApplied bool
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed", Labels: map[string]string{"region": "eu"}}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		appliedOverride, overrideApplied = nil, false
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedName:     seed.Name,
+			SetOverride: func(override imagevector.ImageVector) {
+				appliedOverride, overrideApplied = override, true
+			},
+		}
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(overrideApplied).To(BeFalse())
+	})
+
+	It("should reset the override if no ImageVectorOverride exists", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(overrideApplied).To(BeTrue())
+		Expect(appliedOverride).To(BeEmpty())
+	})
+
+	It("should apply the ImageVectorOverrides selecting the seed", func() {
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.ImageVectorOverride{
+			ObjectMeta: metav1.ObjectMeta{Name: "eu"},
+			Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+				SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+				Images:       []gardencorev1beta1.ImageOverride{{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v1")}},
+			},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.ImageVectorOverride{
+			ObjectMeta: metav1.ObjectMeta{Name: "us"},
+			Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+				SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "us"}},
+				Images:       []gardencorev1beta1.ImageOverride{{Name: "bar", Repository: "registry/bar", Tag: ptr.To("v1")}},
+			},
+		})).To(Succeed())
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.ImageVectorOverride{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoots"},
+			Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+				ShootSelector: &metav1.LabelSelector{},
+				Images:        []gardencorev1beta1.ImageOverride{{Name: "baz", Repository: "registry/baz", Tag: ptr.To("v1")}},
+			},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(overrideApplied).To(BeTrue())
+		Expect(appliedOverride).To(Equal(imagevector.ImageVector{
+			{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v1")},
+		}))
+	})
+})
@@ -292,12 +292,12 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			},
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
-				Resources: []string{"backupbuckets", "backupentries", "bastions", "clusters", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "workers"},
+				Resources: []string{"backupbuckets", "backupentries", "bastions", "clusters", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "privatelinks", "workers"},
 				Verbs:     []string{"create", "delete", "get", "list", "watch", "patch", "update"},
 			},
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
-				Resources: []string{"backupbuckets/status", "backupentries/status", "containerruntimes/status", "controlplanes/status", "dnsrecords/status", "extensions/status", "infrastructures/status", "networks/status", "operatingsystemconfigs/status", "workers/status"},
+				Resources: []string{"backupbuckets/status", "backupentries/status", "containerruntimes/status", "controlplanes/status", "dnsrecords/status", "extensions/status", "infrastructures/status", "networks/status", "operatingsystemconfigs/status", "privatelinks/status", "workers/status"},
 				Verbs:     []string{"patch", "update"},
 			},
 			{
@@ -762,6 +762,11 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 				WebhookRemediatorEnabled: ptr.To(false),
+				NodeLeaseProbe: &gardenletv1alpha1.NodeLeaseProbe{
+					Timeout:                &metav1.Duration{Duration: 10 * time.Second},
+					NodeMonitorGracePeriod: &metav1.Duration{Duration: 40 * time.Second},
+					FailurePercentage:      ptr.To[int32](60),
+				},
 			},
 			SeedCare: &gardenletv1alpha1.SeedCareControllerConfiguration{
 				SyncPeriod: &metav1.Duration{
@@ -776,10 +781,33 @@ func ComputeExpectedGardenletConfiguration(
 					},
 				},
 			},
+			SeedGarbageCollection: &gardenletv1alpha1.SeedGarbageCollectionControllerConfiguration{
+				SyncPeriod:  &metav1.Duration{Duration: time.Hour},
+				GracePeriod: &metav1.Duration{Duration: 24 * time.Hour},
+				DryRun:      ptr.To(true),
+			},
+			SeedZoneOutage: &gardenletv1alpha1.SeedZoneOutageControllerConfiguration{
+				SyncPeriod:      &metav1.Duration{Duration: 30 * time.Second},
+				OutageThreshold: &metav1.Duration{Duration: 2 * time.Minute},
+				DegradationMode: ptr.To(true),
+			},
 			ShootState: &gardenletv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ShootAvailability: &gardenletv1alpha1.ShootAvailabilityControllerConfiguration{
+				ConcurrentSyncs: &five,
+				SyncPeriod:      &metav1.Duration{Duration: 15 * time.Minute},
+				Objective:       ptr.To(99.5),
+			},
+			ShootLeftover: &gardenletv1alpha1.ShootLeftoverControllerConfiguration{
+				ConcurrentSyncs: &five,
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+			},
+			ShootSecurityAdvisory: &gardenletv1alpha1.ShootSecurityAdvisoryControllerConfiguration{
+				ConcurrentSyncs: &five,
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+			},
 			TokenRequestor: &gardenletv1alpha1.TokenRequestorControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
@@ -822,6 +850,10 @@ func ComputeExpectedGardenletConfiguration(
 			ShootEventLogging: &gardenletv1alpha1.ShootEventLogging{
 				Enabled: ptr.To(false),
 			},
+			ShootSlowRequestLogging: &gardenletv1alpha1.ShootSlowRequestLogging{
+				Enabled:   ptr.To(false),
+				Threshold: &metav1.Duration{Duration: time.Second},
+			},
 		},
 		Server: gardenletv1alpha1.ServerConfiguration{
 			HealthProbes: &gardenletv1alpha1.Server{
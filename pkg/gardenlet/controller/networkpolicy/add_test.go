@@ -143,6 +143,20 @@ var _ = Describe("Add", func() {
 			Expect(p.Delete(event.DeleteEvent{Object: cluster})).To(BeFalse())
 			Expect(p.Generic(event.GenericEvent{Object: cluster})).To(BeFalse())
 		})
+
+		It("should return true if additional nodes cidrs are changed for shoot with workers", func() {
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{Nodes: ptr.To("foo")}
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "test"}}
+			cluster.Spec.Shoot.Raw = encode(shoot)
+			newCluster := cluster.DeepCopy()
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{Nodes: ptr.To("foo"), AdditionalNodes: []string{"bar"}}
+			newCluster.Spec.Shoot.Raw = encode(shoot)
+
+			Expect(p.Create(event.CreateEvent{Object: cluster})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: newCluster, ObjectOld: cluster})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: cluster})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: cluster})).To(BeFalse())
+		})
 	})
 })
 
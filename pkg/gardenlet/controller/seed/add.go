@@ -16,8 +16,10 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/garbagecollection"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/seed/zoneoutage"
 	"github.com/gardener/gardener/pkg/healthz"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
@@ -52,6 +54,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if err := (&garbagecollection.Reconciler{
+		Config:   *cfg.Controllers.SeedGarbageCollection,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding garbage collection reconciler: %w", err)
+	}
+
 	if err := (&lease.Reconciler{
 		SeedRESTClient: seedClientSet.RESTClient(),
 		Config:         *cfg.Controllers.Seed,
@@ -70,5 +79,12 @@ func AddToManager(
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
 
+	if err := (&zoneoutage.Reconciler{
+		Config:   *cfg.Controllers.SeedZoneOutage,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding zone outage reconciler: %w", err)
+	}
+
 	return nil
 }
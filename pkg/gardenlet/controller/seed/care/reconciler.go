@@ -9,12 +9,15 @@ import (
 	"fmt"
 	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
@@ -72,15 +75,33 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		seedConditions,
 	)
 
+	patch := client.StrategicMergeFrom(seed.DeepCopy())
+	needsUpdate := false
+
 	// Update Seed status conditions if necessary
 	if v1beta1helper.ConditionsNeedUpdate(seedConditions.ConvertToSlice(), updatedConditions) {
 		// Rebuild seed conditions to ensure that only the conditions with the
 		// correct types will be updated, and any other conditions will remain intact
-		conditions := v1beta1helper.BuildConditions(seed.Status.Conditions, updatedConditions, seedConditions.ConditionTypes())
-
 		log.Info("Updating seed status conditions")
-		patch := client.StrategicMergeFrom(seed.DeepCopy())
-		seed.Status.Conditions = conditions
+		seed.Status.Conditions = v1beta1helper.BuildConditions(seed.Status.Conditions, updatedConditions, seedConditions.ConditionTypes())
+		needsUpdate = true
+	}
+
+	// Update versions of Seed system components if necessary
+	systemComponents, err := SystemComponentVersions(ctx, r.SeedClient, r.Namespace, imagevector.ImageVector(), seed.Status.KubernetesVersion)
+	if err != nil {
+		log.Error(err, "Could not determine versions of seed system components")
+	} else if !apiequality.Semantic.DeepEqual(seed.Status.SystemComponents, systemComponents) {
+		for _, component := range systemComponents {
+			if component.VersionSkew {
+				log.Info("Detected version skew of seed system component", "component", component.Name, "version", ptr.Deref(component.Version, ""), "expectedVersion", ptr.Deref(component.ExpectedVersion, ""))
+			}
+		}
+		seed.Status.SystemComponents = systemComponents
+		needsUpdate = true
+	}
+
+	if needsUpdate {
 		if err := r.GardenClient.Status().Patch(ctx, seed, patch); err != nil {
 			log.Error(err, "Could not update Seed status")
 			return reconcile.Result{}, err
@@ -14,11 +14,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
@@ -183,6 +185,31 @@ var _ = Describe("Seed Care Control", func() {
 					Expect(updatedSeed.Status.Conditions).To(ConsistOf(conditions))
 				})
 			})
+
+			Context("when system components are deployed", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithVars(&NewHealthCheck,
+						healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+
+					Expect(seedClient.Create(ctx, newDeployment("garden", "vpa-updater", "updater", "registry/vpa-updater:0.0.1"))).To(Succeed())
+				})
+
+				It("should update the versions of the system components", func() {
+					image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameVpaUpdater)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedSeed := &gardencorev1beta1.Seed{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+					Expect(updatedSeed.Status.SystemComponents).To(ConsistOf(gardencorev1beta1.SeedSystemComponent{
+						Name:            "vpa-updater",
+						Version:         ptr.To("0.0.1"),
+						ExpectedVersion: image.Tag,
+						VersionSkew:     true,
+					}))
+				})
+			})
 		})
 	})
 })
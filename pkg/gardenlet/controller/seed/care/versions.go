@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// systemComponent describes a workload of a seed system component whose version is reported in the Seed status.
+type systemComponent struct {
+	// name is the name of the workload. For DaemonSets, it is the value of the 'app' label.
+	name          string
+	namespace     string
+	daemonSet     bool
+	containerName string
+	imageName     string
+}
+
+var systemComponents = []systemComponent{
+	{name: "istiod", namespace: v1beta1constants.IstioSystemNamespace, containerName: "discovery", imageName: imagevector.ImageNameIstioIstiod},
+	{name: v1beta1constants.DeploymentNameVPAAdmissionController, namespace: v1beta1constants.GardenNamespace, containerName: "admission-controller", imageName: imagevector.ImageNameVpaAdmissionController},
+	{name: v1beta1constants.DeploymentNameVPARecommender, namespace: v1beta1constants.GardenNamespace, containerName: "recommender", imageName: imagevector.ImageNameVpaRecommender},
+	{name: v1beta1constants.DeploymentNameVPAUpdater, namespace: v1beta1constants.GardenNamespace, containerName: "updater", imageName: imagevector.ImageNameVpaUpdater},
+	{name: v1beta1constants.DeploymentNameDependencyWatchdogWeeder, namespace: v1beta1constants.GardenNamespace, containerName: "dependency-watchdog", imageName: imagevector.ImageNameDependencyWatchdog},
+	{name: v1beta1constants.DaemonSetNameFluentBit, namespace: v1beta1constants.GardenNamespace, daemonSet: true, containerName: "fluent-bit", imageName: imagevector.ImageNameFluentBit},
+}
+
+// SystemComponentVersions computes the deployed and expected versions of the system components in the seed cluster.
+// The expected versions are taken from the given image vector. Components which are not deployed are omitted.
+func SystemComponentVersions(
+	ctx context.Context,
+	seedClient client.Client,
+	namespace *string,
+	imageVector imagevectorutils.ImageVector,
+	kubernetesVersion *string,
+) ([]gardencorev1beta1.SeedSystemComponent, error) {
+	var (
+		findOptions []imagevectorutils.FindOptionFunc
+		out         []gardencorev1beta1.SeedSystemComponent
+	)
+
+	if kubernetesVersion != nil {
+		findOptions = append(findOptions, imagevectorutils.RuntimeVersion(*kubernetesVersion), imagevectorutils.TargetVersion(*kubernetesVersion))
+	}
+
+	for _, component := range systemComponents {
+		podSpec, err := component.podSpec(ctx, seedClient, ptr.Deref(namespace, component.namespace))
+		if err != nil {
+			return nil, fmt.Errorf("failed reading workload of system component %q: %w", component.name, err)
+		}
+		if podSpec == nil {
+			continue
+		}
+
+		status := gardencorev1beta1.SeedSystemComponent{Name: component.name}
+
+		for _, container := range podSpec.Containers {
+			if container.Name == component.containerName {
+				status.Version = imageVersion(container.Image)
+				break
+			}
+		}
+
+		if image, err := imageVector.FindImage(component.imageName, findOptions...); err == nil {
+			status.ExpectedVersion = image.Tag
+		}
+
+		status.VersionSkew = status.Version != nil && status.ExpectedVersion != nil && *status.Version != *status.ExpectedVersion
+		out = append(out, status)
+	}
+
+	return out, nil
+}
+
+// podSpec returns the pod spec of the workload of the system component or nil if the workload does not exist.
+func (s systemComponent) podSpec(ctx context.Context, c client.Client, namespace string) (*corev1.PodSpec, error) {
+	if s.daemonSet {
+		daemonSetList := &appsv1.DaemonSetList{}
+		if err := c.List(ctx, daemonSetList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.LabelApp: s.name}); err != nil {
+			return nil, err
+		}
+		if len(daemonSetList.Items) == 0 {
+			return nil, nil
+		}
+		return &daemonSetList.Items[0].Spec.Template.Spec, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: s.name}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &deployment.Spec.Template.Spec, nil
+}
+
+// imageVersion returns the tag or digest of the given image reference.
+func imageVersion(image string) *string {
+	if _, digest, ok := strings.Cut(image, "@"); ok {
+		return &digest
+	}
+
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return ptr.To(image[i+1:])
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("SystemComponentVersions", func() {
+	const namespace = "garden"
+
+	var (
+		ctx         context.Context
+		seedClient  client.Client
+		imageVector imagevector.ImageVector
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		imageVector = imagevector.ImageVector{
+			{Name: "istio-istiod", Repository: "registry/pilot", Tag: ptr.To("1.21.2-distroless")},
+			{Name: "vpa-recommender", Repository: "registry/vpa-recommender", Tag: ptr.To("1.1.2")},
+			{Name: "dependency-watchdog", Repository: "registry/dependency-watchdog", Tag: ptr.To("v1.2.3"), RuntimeVersion: ptr.To(">= 1.29")},
+			{Name: "dependency-watchdog", Repository: "registry/dependency-watchdog", Tag: ptr.To("v1.2.2"), RuntimeVersion: ptr.To("< 1.29")},
+			{Name: "fluent-bit", Repository: "registry/fluent-bit", Tag: ptr.To("v2.2.2")},
+		}
+	})
+
+	It("should return nothing if no system component is deployed", func() {
+		Expect(SystemComponentVersions(ctx, seedClient, ptr.To(namespace), imageVector, ptr.To("1.30.1"))).To(BeEmpty())
+	})
+
+	It("should report the deployed and expected versions and flag skews", func() {
+		Expect(seedClient.Create(ctx, newDeployment(namespace, "istiod", "discovery", "registry/pilot:1.21.2-distroless"))).To(Succeed())
+		Expect(seedClient.Create(ctx, newDeployment(namespace, "vpa-recommender", "recommender", "registry/vpa-recommender:1.1.1"))).To(Succeed())
+		Expect(seedClient.Create(ctx, newDeployment(namespace, "dependency-watchdog-weeder", "dependency-watchdog", "registry/dependency-watchdog@sha256:0123"))).To(Succeed())
+		Expect(seedClient.Create(ctx, newDeployment(namespace, "vpa-updater", "updater", "registry/vpa-updater:1.1.2"))).To(Succeed())
+
+		daemonSet := &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "fluent-bit-8259c", Namespace: namespace, Labels: map[string]string{"app": "fluent-bit"}},
+			Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "install-plugin", Image: "registry/plugin-installer:v0.1.0"}},
+				Containers:     []corev1.Container{{Name: "fluent-bit", Image: "registry/fluent-bit:v2.2.2"}},
+			}}},
+		}
+		Expect(seedClient.Create(ctx, daemonSet)).To(Succeed())
+
+		Expect(SystemComponentVersions(ctx, seedClient, ptr.To(namespace), imageVector, ptr.To("1.30.1"))).To(Equal([]gardencorev1beta1.SeedSystemComponent{
+			{Name: "istiod", Version: ptr.To("1.21.2-distroless"), ExpectedVersion: ptr.To("1.21.2-distroless")},
+			{Name: "vpa-recommender", Version: ptr.To("1.1.1"), ExpectedVersion: ptr.To("1.1.2"), VersionSkew: true},
+			{Name: "vpa-updater", Version: ptr.To("1.1.2")},
+			{Name: "dependency-watchdog-weeder", Version: ptr.To("sha256:0123"), ExpectedVersion: ptr.To("v1.2.3"), VersionSkew: true},
+			{Name: "fluent-bit", Version: ptr.To("v2.2.2"), ExpectedVersion: ptr.To("v2.2.2")},
+		}))
+	})
+
+	It("should consider the Kubernetes version of the seed", func() {
+		Expect(seedClient.Create(ctx, newDeployment(namespace, "dependency-watchdog-weeder", "dependency-watchdog", "registry:5000/dependency-watchdog:v1.2.2"))).To(Succeed())
+
+		Expect(SystemComponentVersions(ctx, seedClient, ptr.To(namespace), imageVector, ptr.To("1.28.5"))).To(Equal([]gardencorev1beta1.SeedSystemComponent{
+			{Name: "dependency-watchdog-weeder", Version: ptr.To("v1.2.2"), ExpectedVersion: ptr.To("v1.2.2")},
+		}))
+	})
+})
+
+func newDeployment(namespace, name, containerName, image string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: containerName, Image: image}},
+		}}},
+	}
+}
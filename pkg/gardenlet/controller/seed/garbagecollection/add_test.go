@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garbagecollection_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/garbagecollection"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SeedPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = (&Reconciler{SeedName: "seed"}).SeedPredicate()
+		})
+
+		It("should return true for create events", func() {
+			Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+		})
+
+		It("should return false for update events", func() {
+			Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+		})
+	})
+})
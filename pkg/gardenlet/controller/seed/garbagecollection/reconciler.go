@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garbagecollection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// EventOrphanedResourcesDetected is the event reason used when orphaned resources are reported in dry-run mode.
+	EventOrphanedResourcesDetected = "OrphanedResourcesDetected"
+	// EventOrphanedResourcesCleanedUp is the event reason used when the cleanup of orphaned resources was triggered.
+	EventOrphanedResourcesCleanedUp = "OrphanedResourcesCleanedUp"
+
+	// cleanupRequeueInterval is the duration after which the reconciler checks again while the cleanup of orphaned
+	// resources is still in progress.
+	cleanupRequeueInterval = 30 * time.Second
+)
+
+// Reconciler detects resources in the seed cluster which no longer belong to any existing Shoot and cleans them up
+// after a grace period.
+type Reconciler struct {
+	GardenClient client.Reader
+	SeedClient   client.Client
+	Config       config.SeedGarbageCollectionControllerConfiguration
+	Clock        clock.Clock
+	Recorder     record.EventRecorder
+	SeedName     string
+
+	// orphanedSince maps the names of orphaned shoot namespaces to the time they were detected first.
+	orphanedSince map[string]time.Time
+}
+
+// Reconcile detects resources in the seed cluster which no longer belong to any existing Shoot and cleans them up
+// after a grace period.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	orphaned, err := r.orphanedShootNamespaces(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if r.orphanedSince == nil {
+		r.orphanedSince = make(map[string]time.Time)
+	}
+	for name := range r.orphanedSince {
+		if !orphaned.Has(name) {
+			delete(r.orphanedSince, name)
+		}
+	}
+
+	var (
+		now         = r.Clock.Now()
+		gracePeriod = r.Config.GracePeriod.Duration
+		dryRun      = ptr.Deref(r.Config.DryRun, true)
+		requeueSoon bool
+		errs        []error
+	)
+
+	for _, name := range sets.List(orphaned) {
+		log := log.WithValues("namespace", name)
+
+		since, ok := r.orphanedSince[name]
+		if !ok {
+			since = now
+			r.orphanedSince[name] = now
+			log.Info("Detected resources which do not belong to any existing Shoot", "gracePeriod", gracePeriod)
+		}
+
+		if now.Sub(since) < gracePeriod {
+			continue
+		}
+
+		summary, err := r.summarize(ctx, name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed summarizing orphaned resources in namespace %s: %w", name, err))
+			continue
+		}
+
+		if dryRun {
+			log.Info("Orphaned resources detected, not cleaning up because dry-run mode is enabled", "orphanedSince", since, "resources", summary)
+			r.Recorder.Eventf(seed, corev1.EventTypeWarning, EventOrphanedResourcesDetected, "Resources for shoot namespace %q (%s) do not belong to any existing Shoot since %s, not cleaning up because dry-run mode is enabled", name, summary, since.UTC().Format(time.RFC3339))
+			continue
+		}
+
+		log.Info("Cleaning up orphaned resources", "orphanedSince", since, "resources", summary)
+		done, err := r.cleanup(ctx, log, name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed cleaning up orphaned resources in namespace %s: %w", name, err))
+			continue
+		}
+		if !done {
+			requeueSoon = true
+			continue
+		}
+
+		r.Recorder.Eventf(seed, corev1.EventTypeNormal, EventOrphanedResourcesCleanedUp, "Triggered deletion of resources for shoot namespace %q (%s) which do not belong to any existing Shoot since %s", name, summary, since.UTC().Format(time.RFC3339))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if requeueSoon {
+		return reconcile.Result{RequeueAfter: cleanupRequeueInterval}, nil
+	}
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// orphanedShootNamespaces returns the names of all shoot namespaces in the seed cluster (or of their extensions
+// Cluster resources) which do not belong to any Shoot that is scheduled to this seed.
+func (r *Reconciler) orphanedShootNamespaces(ctx context.Context) (sets.Set[string], error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	technicalIDs := sets.New[string]()
+	for _, shoot := range shootList.Items {
+		if shoot.Status.TechnicalID != "" {
+			technicalIDs.Insert(shoot.Status.TechnicalID)
+		}
+	}
+
+	orphaned := sets.New[string]()
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+	for _, namespace := range namespaceList.Items {
+		if !technicalIDs.Has(namespace.Name) {
+			orphaned.Insert(namespace.Name)
+		}
+	}
+
+	clusterList := &extensionsv1alpha1.ClusterList{}
+	if err := r.SeedClient.List(ctx, clusterList); err != nil {
+		return nil, fmt.Errorf("failed listing clusters: %w", err)
+	}
+	for _, cluster := range clusterList.Items {
+		if !technicalIDs.Has(cluster.Name) {
+			orphaned.Insert(cluster.Name)
+		}
+	}
+
+	return orphaned, nil
+}
+
+// summarize returns a human-readable summary of the orphaned resources for the given shoot namespace.
+func (r *Reconciler) summarize(ctx context.Context, namespace string) (string, error) {
+	var extensionObjects, dnsRecords int
+	for _, extension := range extensionKinds {
+		list := extension.newObjectListFunc()
+		if err := r.SeedClient.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return "", fmt.Errorf("failed listing extension resources of kind %s: %w", extension.objKind, err)
+		}
+
+		if extension.objKind == extensionsv1alpha1.DNSRecordResource {
+			dnsRecords += meta.LenList(list)
+		} else {
+			extensionObjects += meta.LenList(list)
+		}
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(namespace)); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d extension resources, %d DNS records, %d secrets", extensionObjects, dnsRecords, len(secretList.Items)), nil
+}
+
+// cleanup deletes the orphaned resources for the given shoot namespace. Extension resources are migrated before they
+// are deleted so that extension controllers only remove their finalizers and do not delete any infrastructure, DNS
+// records, etc. which might still be in use (e.g., by the same shoot after it was migrated to another seed).
+// It returns false while extension resources still need to be migrated or deleted.
+func (r *Reconciler) cleanup(ctx context.Context, log logr.Logger, namespace string) (bool, error) {
+	pending := false
+
+	for _, extension := range extensionKinds {
+		list := extension.newObjectListFunc()
+		if err := r.SeedClient.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return false, fmt.Errorf("failed listing extension resources of kind %s: %w", extension.objKind, err)
+		}
+
+		if err := meta.EachListItem(list, func(o runtime.Object) error {
+			obj, ok := o.(extensionsv1alpha1.Object)
+			if !ok {
+				return fmt.Errorf("expected extensionsv1alpha1.Object but got %T", o)
+			}
+			pending = true
+
+			if obj.GetDeletionTimestamp() != nil {
+				return nil
+			}
+
+			if !isMigrated(obj) {
+				if obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationMigrate {
+					return nil
+				}
+
+				log.Info("Migrating orphaned extension resource", "kind", extension.objKind, "object", client.ObjectKeyFromObject(obj))
+				return extensions.MigrateExtensionObject(ctx, r.SeedClient, obj)
+			}
+
+			log.Info("Deleting orphaned extension resource", "kind", extension.objKind, "object", client.ObjectKeyFromObject(obj))
+			return extensions.DeleteExtensionObject(ctx, r.SeedClient, obj)
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	if pending {
+		log.Info("Waiting for orphaned extension resources to be migrated and deleted")
+		return false, nil
+	}
+
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClient.List(ctx, managedResourceList, client.InNamespace(namespace)); err != nil {
+		return false, err
+	}
+	for _, managedResource := range managedResourceList.Items {
+		if ptr.Deref(managedResource.Spec.KeepObjects, false) {
+			continue
+		}
+		if err := managedresources.SetKeepObjects(ctx, r.SeedClient, managedResource.Namespace, managedResource.Name, true); err != nil {
+			return false, err
+		}
+	}
+
+	log.Info("Deleting orphaned shoot namespace and cluster")
+	if err := client.IgnoreNotFound(r.SeedClient.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})); err != nil {
+		return false, err
+	}
+	if err := client.IgnoreNotFound(r.SeedClient.Delete(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}})); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+var extensionKinds = []struct {
+	objKind           string
+	newObjectListFunc func() client.ObjectList
+}{
+	{extensionsv1alpha1.ContainerRuntimeResource, func() client.ObjectList { return &extensionsv1alpha1.ContainerRuntimeList{} }},
+	{extensionsv1alpha1.ControlPlaneResource, func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} }},
+	{extensionsv1alpha1.DNSRecordResource, func() client.ObjectList { return &extensionsv1alpha1.DNSRecordList{} }},
+	{extensionsv1alpha1.ExtensionResource, func() client.ObjectList { return &extensionsv1alpha1.ExtensionList{} }},
+	{extensionsv1alpha1.InfrastructureResource, func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} }},
+	{extensionsv1alpha1.NetworkResource, func() client.ObjectList { return &extensionsv1alpha1.NetworkList{} }},
+	{extensionsv1alpha1.OperatingSystemConfigResource, func() client.ObjectList { return &extensionsv1alpha1.OperatingSystemConfigList{} }},
+	{extensionsv1alpha1.PrivateLinkResource, func() client.ObjectList { return &extensionsv1alpha1.PrivateLinkList{} }},
+	{extensionsv1alpha1.WorkerResource, func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} }},
+}
+
+func isMigrated(obj extensionsv1alpha1.Object) bool {
+	lastOperation := obj.GetExtensionStatus().GetLastOperation()
+	return lastOperation != nil &&
+		lastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garbagecollection_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/garbagecollection"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		recorder     *record.FakeRecorder
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		seed            *gardencorev1beta1.Seed
+		shoot           *gardencorev1beta1.Shoot
+		shootNamespace  *corev1.Namespace
+		orphanNamespace *corev1.Namespace
+		orphanCluster   *extensionsv1alpha1.Cluster
+		infrastructure  *extensionsv1alpha1.Infrastructure
+		dnsRecord       *extensionsv1alpha1.DNSRecord
+		managedResource *resourcesv1alpha1.ManagedResource
+		orphanName      = "shoot--dev--gone"
+
+		syncPeriod  = time.Hour
+		gracePeriod = 24 * time.Hour
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, time.March, 16, 12, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.SeedGarbageCollectionControllerConfiguration{
+				SyncPeriod:  &metav1.Duration{Duration: syncPeriod},
+				GracePeriod: &metav1.Duration{Duration: gracePeriod},
+				DryRun:      ptr.To(false),
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+			SeedName: "seed",
+		}
+
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--dev--shoot"},
+		}
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		shootNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   shoot.Status.TechnicalID,
+			Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot},
+		}}
+		Expect(seedClient.Create(ctx, shootNamespace)).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: shoot.Status.TechnicalID}})).To(Succeed())
+
+		orphanNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   orphanName,
+			Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot},
+		}}
+		Expect(seedClient.Create(ctx, orphanNamespace)).To(Succeed())
+		orphanCluster = &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: orphanName}}
+		Expect(seedClient.Create(ctx, orphanCluster)).To(Succeed())
+
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: orphanName, Finalizers: []string{"extensions.gardener.cloud/test"}},
+		}
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+		dnsRecord = &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: orphanName},
+			Status: extensionsv1alpha1.DNSRecordStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded},
+			}},
+		}
+		Expect(seedClient.Create(ctx, dnsRecord)).To(Succeed())
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: orphanName}}
+		Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not clean up orphaned resources before the grace period has passed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		fakeClock.Step(gracePeriod - time.Second)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanNamespace), orphanNamespace)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		Expect(infrastructure.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should only report orphaned resources in dry-run mode", func() {
+		reconciler.Config.DryRun = ptr.To(true)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		fakeClock.Step(gracePeriod)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanNamespace), orphanNamespace)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanCluster), orphanCluster)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		Expect(infrastructure.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+
+		Expect(recorder.Events).To(Receive(And(
+			ContainSubstring(EventOrphanedResourcesDetected),
+			ContainSubstring(orphanName),
+			ContainSubstring("1 extension resources, 1 DNS records, 0 secrets"),
+		)))
+	})
+
+	It("should forget orphaned resources which belong to a shoot again", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		otherShoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "gone", Namespace: "garden-dev"},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: orphanName},
+		}
+		Expect(gardenClient.Create(ctx, otherShoot)).To(Succeed())
+		fakeClock.Step(gracePeriod / 2)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Delete(ctx, otherShoot)).To(Succeed())
+		fakeClock.Step(gracePeriod / 2)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanNamespace), orphanNamespace)).To(Succeed())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should migrate and delete extension resources before deleting the namespace and the cluster", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		fakeClock.Step(gracePeriod)
+
+		By("Migrate extension resources which were not yet migrated and delete migrated ones")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		Expect(infrastructure.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationMigrate))
+		Expect(infrastructure.DeletionTimestamp).To(BeNil())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanNamespace), orphanNamespace)).To(Succeed())
+
+		By("Delete extension resources once they were migrated")
+		infrastructure.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded}
+		Expect(seedClient.Update(ctx, infrastructure)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		Expect(infrastructure.DeletionTimestamp).NotTo(BeNil())
+		Expect(infrastructure.Annotations).To(HaveKeyWithValue(v1beta1constants.ConfirmationDeletion, "true"))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanNamespace), orphanNamespace)).To(Succeed())
+
+		By("Delete namespace and cluster once all extension resources are gone")
+		infrastructure.Finalizers = nil
+		Expect(seedClient.Update(ctx, infrastructure)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+		Expect(managedResource.Spec.KeepObjects).To(PointTo(BeTrue()))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanNamespace), orphanNamespace)).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(orphanCluster), orphanCluster)).To(BeNotFoundError())
+		Expect(recorder.Events).To(Receive(And(
+			ContainSubstring(EventOrphanedResourcesCleanedUp),
+			ContainSubstring(orphanName),
+		)))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(shootNamespace), shootNamespace)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: shoot.Status.TechnicalID}, &extensionsv1alpha1.Cluster{})).To(Succeed())
+	})
+})
@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v2/apis/fluentbit/v1alpha2"
-	proberapi "github.com/gardener/dependency-watchdog/api/prober"
 	weederapi "github.com/gardener/dependency-watchdog/api/weeder"
 	"github.com/go-logr/logr"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -315,6 +314,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 				&zone,
 				seed.IsDualStack(),
 				seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+				nil,
 			); err != nil {
 				return nil, nil, "", err
 			}
@@ -336,6 +336,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 			nil,
 			seed.IsDualStack(),
 			seed.GetLoadBalancerServiceProxyProtocolTermination(),
+			handler.Autoscaling,
 		); err != nil {
 			return nil, nil, "", err
 		}
@@ -356,6 +357,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 					&zone,
 					seed.IsDualStack(),
 					seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+					handler.Autoscaling,
 				); err != nil {
 					return nil, nil, "", err
 				}
@@ -415,6 +417,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 						zone,
 						seed.IsDualStack(),
 						proxyProtocolTermination,
+						nil,
 					); err != nil {
 						return nil, nil, "", err
 					}
@@ -438,6 +441,8 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 	)
 
 	dwdWeeder = component.OpDestroyWithoutWait(dependencywatchdog.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace, dwdWeederValues))
+	// The dependency-watchdog prober is superseded by the node lease probe of the shoot care controller, hence it is
+	// always removed from the seed.
 	dwdProber = component.OpDestroyWithoutWait(dependencywatchdog.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace, dwdProberValues))
 
 	if v1beta1helper.SeedSettingDependencyWatchdogWeederEnabled(seedSettings) {
@@ -469,31 +474,6 @@ func (r *Reconciler) newDependencyWatchdogs(seedSettings *gardencorev1beta1.Seed
 		dwdWeeder = dependencywatchdog.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace, dwdWeederValues)
 	}
 
-	if v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(seedSettings) {
-		// Fetch component-specific dependency-watchdog configuration
-		var (
-			dependencyWatchdogProberConfigurationFuncs = []dependencywatchdog.ProberConfigurationFunc{
-				kubeapiserver.NewDependencyWatchdogProberConfiguration,
-			}
-			dependencyWatchdogProberConfiguration = proberapi.Config{
-				KubeConfigSecretName:   dependencywatchdog.KubeConfigSecretName,
-				ProbeInterval:          &metav1.Duration{Duration: dependencywatchdog.DefaultProbeInterval},
-				DependentResourceInfos: make([]proberapi.DependentResourceInfo, 0, len(dependencyWatchdogProberConfigurationFuncs)),
-			}
-		)
-
-		for _, componentFn := range dependencyWatchdogProberConfigurationFuncs {
-			dwdConfig, err := componentFn()
-			if err != nil {
-				return nil, nil, err
-			}
-			dependencyWatchdogProberConfiguration.DependentResourceInfos = append(dependencyWatchdogProberConfiguration.DependentResourceInfos, dwdConfig...)
-		}
-
-		dwdProberValues.ProberConfig = dependencyWatchdogProberConfiguration
-		dwdProber = dependencywatchdog.NewBootstrapper(r.SeedClientSet.Client(), r.GardenNamespace, dwdProberValues)
-	}
-
 	return
 }
 
@@ -531,6 +511,7 @@ func (r *Reconciler) newSystem(seed *gardencorev1beta1.Seed) (component.DeployWa
 				Image:    image.String(),
 				Replicas: replicasExcessCapacityReservation,
 				Configs:  seed.Spec.Settings.ExcessCapacityReservation.Configs,
+				Profiles: seed.Spec.Settings.ExcessCapacityReservation.Profiles,
 			},
 		},
 	), nil
@@ -552,6 +533,7 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 		v1beta1constants.PriorityClassNameSeedSystem600,
 		storage,
 		"",
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -740,6 +722,9 @@ func (r *Reconciler) newFluentCustomResources(seedIsGarden bool) (deployer compo
 	if gardenlethelper.IsEventLoggingEnabled(&r.Config) {
 		centralLoggingConfigurations = append(centralLoggingConfigurations, eventlogger.CentralLoggingConfiguration)
 	}
+	if gardenlethelper.IsSlowRequestLoggingEnabled(&r.Config) {
+		centralLoggingConfigurations = append(centralLoggingConfigurations, kubeapiserver.SlowRequestLoggingConfiguration(gardenlethelper.GetSlowRequestLoggingThreshold(&r.Config)))
+	}
 
 	var output *fluentbitv1alpha2.ClusterOutput
 	if gardenlethelper.IsValiEnabled(&r.Config) {
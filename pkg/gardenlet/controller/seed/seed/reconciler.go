@@ -158,6 +158,7 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 		LastUpdateTime: now,
 	}
 	seed.Status.Gardener = r.Identity
+	seed.Status.FeatureGates = featureGateStatuses()
 	seed.Status.ObservedGeneration = seed.Generation
 	seed.Status.ClientCertificateExpirationTimestamp = r.ClientCertificateExpirationTimestamp
 	seed.Status.KubernetesVersion = ptr.To(r.SeedClientSet.Version())
@@ -227,6 +228,10 @@ func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, seed *gar
 		}
 	}
 
+	if operationType == gardencorev1beta1.LastOperationTypeReconcile {
+		seed.Status.ExposureClassHandlers = exposureClassHandlersStatus(r.Config.ExposureClassHandlers)
+	}
+
 	seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
 		Type:           operationType,
 		State:          gardencorev1beta1.LastOperationStateSucceeded,
@@ -276,6 +281,25 @@ func determineClusterIdentity(ctx context.Context, c client.Client) (string, err
 	return clusterIdentity.Data[v1beta1constants.ClusterIdentity], nil
 }
 
+// exposureClassHandlersStatus computes the status of the exposure class handlers from the gardenlet configuration.
+func exposureClassHandlersStatus(handlers []config.ExposureClassHandler) []gardencorev1beta1.ExposureClassHandlerStatus {
+	var status []gardencorev1beta1.ExposureClassHandlerStatus
+
+	for _, handler := range handlers {
+		handlerStatus := gardencorev1beta1.ExposureClassHandlerStatus{Name: handler.Name}
+		if handler.Autoscaling != nil {
+			handlerStatus.Autoscaling = &gardencorev1beta1.ExposureClassHandlerAutoscaling{
+				MinReplicas:       handler.Autoscaling.MinReplicas,
+				MaxReplicas:       handler.Autoscaling.MaxReplicas,
+				TargetConnections: handler.Autoscaling.TargetConnections,
+			}
+		}
+		status = append(status, handlerStatus)
+	}
+
+	return status
+}
+
 func vpaEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	return settings == nil || settings.VerticalPodAutoscaler == nil || settings.VerticalPodAutoscaler.Enabled
 }
@@ -283,3 +307,18 @@ func vpaEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 func hvpaEnabled() bool {
 	return features.DefaultFeatureGate.Enabled(features.HVPA)
 }
+
+// featureGateStatuses returns the states of the Gardener feature gates of this gardenlet.
+func featureGateStatuses() []gardencorev1beta1.FeatureGateStatus {
+	var statuses []gardencorev1beta1.FeatureGateStatus
+
+	for _, state := range features.GetStates(features.DefaultFeatureGate) {
+		statuses = append(statuses, gardencorev1beta1.FeatureGateStatus{
+			Name:          state.Name,
+			Enabled:       state.Enabled,
+			LockToDefault: state.LockToDefault,
+		})
+	}
+
+	return statuses
+}
@@ -27,6 +27,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/clusteridentity"
@@ -35,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -145,7 +147,11 @@ func (r *Reconciler) runReconcileSeedFlow(
 		r.SeedClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.SecretManagerIdentityGardenlet,
-		secretsmanager.Config{CASecretAutoRotation: true},
+		secretsmanager.Config{
+			CASecretAutoRotation:  true,
+			CertificateValidities: gardenlethelper.CertificateValidities(&r.Config),
+			RecordRotationHistory: true,
+		},
 	)
 	if err != nil {
 		return err
@@ -329,7 +335,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
 		})
 		_ = g.Add(flow.Task{
-			Name:         "Deploying dependency-watchdog-prober",
+			Name:         "Destroying dependency-watchdog-prober",
 			Fn:           c.dwdProber.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
 		})
@@ -690,7 +696,13 @@ func (r *Reconciler) deployNginxIngressAndWaitForIstioServiceAndGetDNSComponent(
 	error,
 ) {
 	if !seedIsGarden {
-		if err := component.OpWait(nginxIngress).Deploy(ctx); err != nil {
+		// Ingress controllers other than nginx are provided by extensions, hence the Gardener managed nginx ingress
+		// controller is removed in this case.
+		if v1beta1helper.SeedIngressControllerProvidedByExtension(seed.GetInfo().Spec.Ingress) {
+			if err := component.OpDestroyAndWait(nginxIngress).Destroy(ctx); err != nil {
+				return nil, err
+			}
+		} else if err := component.OpWait(nginxIngress).Deploy(ctx); err != nil {
 			return nil, err
 		}
 	}
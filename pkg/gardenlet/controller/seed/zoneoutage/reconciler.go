@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zoneoutage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// Reconciler detects outages of availability zones of the seed cluster based on the readiness of the nodes per zone and
+// reports them via the ZoneOutage condition of the Seed. In degradation mode, control plane pods of highly available
+// shoots are proactively moved away from unavailable zones.
+type Reconciler struct {
+	GardenClient  client.Client
+	SeedClient    client.Client
+	SeedAPIReader client.Reader
+	Config        config.SeedZoneOutageControllerConfiguration
+	Clock         clock.Clock
+	SeedName      string
+}
+
+// Reconcile detects outages of availability zones of the seed cluster and reports them via the ZoneOutage condition of
+// the Seed. In degradation mode, control plane pods of highly available shoots are proactively moved away from
+// unavailable zones.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	zones, unavailableZones, err := r.computeZoneAvailability(ctx, seed)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.patchZoneOutageCondition(ctx, log, seed, unavailableZones); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching %s condition: %w", gardencorev1beta1.SeedZoneOutage, err)
+	}
+
+	// Workload can only be moved away from unavailable zones if at least one zone is still available.
+	excludedZones := sets.New[string]()
+	if ptr.Deref(r.Config.DegradationMode, false) && zones.Len() > unavailableZones.Len() {
+		excludedZones = unavailableZones
+	}
+
+	if err := r.reconcileNamespaces(ctx, log, excludedZones); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// computeZoneAvailability returns all zones of the seed cluster and those which are considered unavailable. A zone is
+// unavailable if none of its nodes has been ready for at least the configured outage threshold.
+func (r *Reconciler) computeZoneAvailability(ctx context.Context, seed *gardencorev1beta1.Seed) (sets.Set[string], sets.Set[string], error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.SeedClient.List(ctx, nodeList); err != nil {
+		return nil, nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	var (
+		zones          = sets.New[string]()
+		zonesWithReady = sets.New[string]()
+		seedZones      = sets.New(seed.Spec.Provider.Zones...)
+		threshold      = r.Clock.Now().Add(-r.Config.OutageThreshold.Duration)
+	)
+
+	for _, node := range nodeList.Items {
+		zone := node.Labels[corev1.LabelTopologyZone]
+		if zone == "" || (seedZones.Len() > 0 && !seedZones.Has(zone)) {
+			continue
+		}
+		zones.Insert(zone)
+
+		if notReadySince := nodeNotReadySince(node); notReadySince == nil || notReadySince.After(threshold) {
+			zonesWithReady.Insert(zone)
+		}
+	}
+
+	return zones, zones.Difference(zonesWithReady), nil
+}
+
+// nodeNotReadySince returns the time since when the given node is not ready, or nil if it is ready.
+func nodeNotReadySince(node corev1.Node) *time.Time {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != corev1.NodeReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return nil
+		}
+		return &condition.LastTransitionTime.Time
+	}
+	return &node.CreationTimestamp.Time
+}
+
+func (r *Reconciler) patchZoneOutageCondition(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed, unavailableZones sets.Set[string]) error {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedZoneOutage)
+
+	if unavailableZones.Len() > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "ZoneOutageDetected",
+			fmt.Sprintf("None of the nodes in the following zones is ready: %s", strings.Join(sets.List(unavailableZones), ", ")))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "AllZonesAvailable", "All zones of the seed cluster are available.")
+	}
+
+	patch := client.StrategicMergeFrom(seed.DeepCopy())
+	conditions := v1beta1helper.MergeConditions(seed.Status.Conditions, condition)
+	if !v1beta1helper.ConditionsNeedUpdate(seed.Status.Conditions, conditions) {
+		return nil
+	}
+
+	seed.Status.Conditions = conditions
+	if err := r.GardenClient.Status().Patch(ctx, seed, patch); err != nil {
+		return err
+	}
+
+	log.Info("Successfully patched condition", "conditionType", condition.Type, "conditionStatus", condition.Status)
+	return nil
+}
+
+// reconcileNamespaces annotates the namespaces of highly available workload with the zones to be excluded and removes
+// the annotation once they are available again.
+func (r *Reconciler) reconcileNamespaces(ctx context.Context, log logr.Logger, excludedZones sets.Set[string]) error {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{resourcesv1alpha1.HighAvailabilityConfigConsider: "true"}); err != nil {
+		return fmt.Errorf("failed listing namespaces: %w", err)
+	}
+
+	for _, namespace := range namespaceList.Items {
+		var (
+			namespaceZones = sets.New(strings.Split(namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigZones], ",")...).Delete("")
+			unavailable    = namespaceZones.Intersection(excludedZones)
+			namespaceLog   = log.WithValues("namespace", namespace.Name)
+		)
+
+		// The workload is not moved if it would not have any zone left.
+		desired := ""
+		if unavailable.Len() > 0 && unavailable.Len() < namespaceZones.Len() {
+			desired = strings.Join(sets.List(unavailable), ",")
+		}
+
+		if current := namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigUnavailableZones]; current != desired {
+			namespaceLog.Info("Updating unavailable zones of namespace", "unavailableZones", desired)
+
+			patch := client.MergeFrom(namespace.DeepCopy())
+			setOrRemoveUnavailableZones(&namespace.ObjectMeta, desired)
+			if err := r.SeedClient.Patch(ctx, &namespace, patch); err != nil {
+				return fmt.Errorf("failed patching namespace %s: %w", namespace.Name, err)
+			}
+		}
+
+		if err := r.reconcileDeployments(ctx, namespaceLog, namespace.Name, desired); err != nil {
+			return err
+		}
+
+		if desired != "" {
+			if err := r.deletePodsInUnavailableZones(ctx, namespaceLog, namespace.Name, unavailable); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcileDeployments propagates the unavailable zones to the Deployments in the given namespace. The update makes the
+// high-availability-config webhook of gardener-resource-manager mutate their topology constraints again.
+func (r *Reconciler) reconcileDeployments(ctx context.Context, log logr.Logger, namespace, unavailableZones string) error {
+	deploymentList := &appsv1.DeploymentList{}
+	if err := r.SeedClient.List(ctx, deploymentList, client.InNamespace(namespace), client.HasLabels{resourcesv1alpha1.HighAvailabilityConfigType}); err != nil {
+		return fmt.Errorf("failed listing deployments in namespace %s: %w", namespace, err)
+	}
+
+	for _, deployment := range deploymentList.Items {
+		if deployment.Annotations[resourcesv1alpha1.HighAvailabilityConfigUnavailableZones] == unavailableZones {
+			continue
+		}
+
+		log.Info("Updating unavailable zones of deployment", "deployment", client.ObjectKeyFromObject(&deployment), "unavailableZones", unavailableZones)
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+		setOrRemoveUnavailableZones(&deployment.ObjectMeta, unavailableZones)
+		if err := r.SeedClient.Patch(ctx, &deployment, patch); err != nil {
+			return fmt.Errorf("failed patching deployment %s: %w", client.ObjectKeyFromObject(&deployment), err)
+		}
+	}
+
+	return nil
+}
+
+// deletePodsInUnavailableZones deletes the pods of Deployments in the given namespace which run on nodes in unavailable
+// zones, so that they are recreated in the remaining zones right away instead of after their tolerations expired.
+func (r *Reconciler) deletePodsInUnavailableZones(ctx context.Context, log logr.Logger, namespace string, unavailableZones sets.Set[string]) error {
+	nodeList := &corev1.NodeList{}
+	if err := r.SeedClient.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	nodesInUnavailableZones := sets.New[string]()
+	for _, node := range nodeList.Items {
+		if unavailableZones.Has(node.Labels[corev1.LabelTopologyZone]) {
+			nodesInUnavailableZones.Insert(node.Name)
+		}
+	}
+
+	// Pods are read directly from the API server to avoid caching all pods of the seed cluster.
+	podList := &corev1.PodList{}
+	if err := r.SeedAPIReader.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed listing pods in namespace %s: %w", namespace, err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil ||
+			!nodesInUnavailableZones.Has(pod.Spec.NodeName) ||
+			!slices.ContainsFunc(pod.OwnerReferences, func(ref metav1.OwnerReference) bool { return ref.Kind == "ReplicaSet" }) {
+			continue
+		}
+
+		log.Info("Deleting pod running in unavailable zone", "pod", client.ObjectKeyFromObject(&pod), "node", pod.Spec.NodeName)
+		if err := r.SeedClient.Delete(ctx, &pod); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+		}
+	}
+
+	return nil
+}
+
+func setOrRemoveUnavailableZones(obj *metav1.ObjectMeta, unavailableZones string) {
+	if unavailableZones == "" {
+		delete(obj.Annotations, resourcesv1alpha1.HighAvailabilityConfigUnavailableZones)
+		return
+	}
+	metav1.SetMetaDataAnnotation(obj, resourcesv1alpha1.HighAvailabilityConfigUnavailableZones, unavailableZones)
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package zoneoutage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/zoneoutage"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		seed       *gardencorev1beta1.Seed
+		namespace  *corev1.Namespace
+		deployment *appsv1.Deployment
+		podZoneA   *corev1.Pod
+		podZoneB   *corev1.Pod
+		etcdPod    *corev1.Pod
+
+		syncPeriod      = 30 * time.Second
+		outageThreshold = 2 * time.Minute
+	)
+
+	newNode := func(name, zone string, ready bool, lastTransitionTime time.Time) *corev1.Node {
+		status := corev1.ConditionTrue
+		if !ready {
+			status = corev1.ConditionFalse
+		}
+
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{corev1.LabelTopologyZone: zone},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{
+					Type:               corev1.NodeReady,
+					Status:             status,
+					LastTransitionTime: metav1.NewTime(lastTransitionTime),
+				}},
+			},
+		}
+	}
+
+	newPod := func(name, nodeName, ownerKind string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace.Name,
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: ownerKind, Name: "owner", UID: "uid"}},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), seed)).To(Succeed())
+		return v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedZoneOutage)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Seed{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, time.March, 16, 12, 0, 0, 0, time.UTC))
+
+		reconciler = &Reconciler{
+			GardenClient:  gardenClient,
+			SeedClient:    seedClient,
+			SeedAPIReader: seedClient,
+			Config: config.SeedZoneOutageControllerConfiguration{
+				SyncPeriod:      &metav1.Duration{Duration: syncPeriod},
+				OutageThreshold: &metav1.Duration{Duration: outageThreshold},
+				DegradationMode: ptr.To(true),
+			},
+			Clock:    fakeClock,
+			SeedName: "seed",
+		}
+
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "shoot--foo--bar",
+			Labels:      map[string]string{resourcesv1alpha1.HighAvailabilityConfigConsider: "true"},
+			Annotations: map[string]string{resourcesv1alpha1.HighAvailabilityConfigZones: "a,b,c"},
+		}}
+		Expect(seedClient.Create(ctx, namespace)).To(Succeed())
+
+		deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      "kube-apiserver",
+			Namespace: namespace.Name,
+			Labels:    map[string]string{resourcesv1alpha1.HighAvailabilityConfigType: resourcesv1alpha1.HighAvailabilityConfigTypeServer},
+		}}
+		Expect(seedClient.Create(ctx, deployment)).To(Succeed())
+
+		for _, node := range []*corev1.Node{
+			newNode("node-a", "a", true, fakeClock.Now().Add(-time.Hour)),
+			newNode("node-b", "b", false, fakeClock.Now().Add(-5*time.Minute)),
+			newNode("node-c", "c", true, fakeClock.Now().Add(-time.Hour)),
+		} {
+			Expect(seedClient.Create(ctx, node)).To(Succeed())
+		}
+
+		podZoneA = newPod("kube-apiserver-a", "node-a", "ReplicaSet")
+		podZoneB = newPod("kube-apiserver-b", "node-b", "ReplicaSet")
+		etcdPod = newPod("etcd-main-1", "node-b", "StatefulSet")
+		for _, pod := range []*corev1.Pod{podZoneA, podZoneB, etcdPod} {
+			Expect(seedClient.Create(ctx, pod)).To(Succeed())
+		}
+	})
+
+	It("should report a zone outage and move the workload away from the unavailable zone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status":  Equal(gardencorev1beta1.ConditionTrue),
+			"Reason":  Equal("ZoneOutageDetected"),
+			"Message": Equal("None of the nodes in the following zones is ready: b"),
+		})))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.HighAvailabilityConfigUnavailableZones, "b"))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.HighAvailabilityConfigUnavailableZones, "b"))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(podZoneA), podZoneA)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(podZoneB), podZoneB)).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(etcdPod), etcdPod)).To(Succeed())
+	})
+
+	It("should remove the unavailable zones once the zone is available again", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		node := &corev1.Node{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "node-b"}, node)).To(Succeed())
+		node.Status.Conditions[0].Status = corev1.ConditionTrue
+		Expect(seedClient.Status().Update(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionFalse),
+			"Reason": Equal("AllZonesAvailable"),
+		})))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).NotTo(HaveKey(resourcesv1alpha1.HighAvailabilityConfigUnavailableZones))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Annotations).NotTo(HaveKey(resourcesv1alpha1.HighAvailabilityConfigUnavailableZones))
+	})
+
+	It("should not consider a zone unavailable before the outage threshold has passed", func() {
+		node := &corev1.Node{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "node-b"}, node)).To(Succeed())
+		node.Status.Conditions[0].LastTransitionTime = metav1.NewTime(fakeClock.Now().Add(-time.Minute))
+		Expect(seedClient.Status().Update(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionFalse),
+			"Reason": Equal("AllZonesAvailable"),
+		})))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(podZoneB), podZoneB)).To(Succeed())
+	})
+
+	It("should only report the zone outage if the degradation mode is disabled", func() {
+		reconciler.Config.DegradationMode = ptr.To(false)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getCondition().Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).NotTo(HaveKey(resourcesv1alpha1.HighAvailabilityConfigUnavailableZones))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(podZoneB), podZoneB)).To(Succeed())
+	})
+
+	It("should not move workload which is not spread across an available zone", func() {
+		namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigZones] = "b"
+		Expect(seedClient.Update(ctx, namespace)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).NotTo(HaveKey(resourcesv1alpha1.HighAvailabilityConfigUnavailableZones))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(podZoneB), podZoneB)).To(Succeed())
+	})
+})
@@ -20,7 +20,10 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/availability"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/leftover"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/securityadvisory"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
 )
@@ -69,6 +72,33 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if ptr.Deref(cfg.Controllers.ShootAvailability.ConcurrentSyncs, 0) > 0 {
+		if err := (&availability.Reconciler{
+			Config:   *cfg.Controllers.ShootAvailability,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster); err != nil {
+			return fmt.Errorf("failed adding availability reconciler: %w", err)
+		}
+	}
+
+	if ptr.Deref(cfg.Controllers.ShootLeftover.ConcurrentSyncs, 0) > 0 {
+		if err := (&leftover.Reconciler{
+			Config:   *cfg.Controllers.ShootLeftover,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding leftover reconciler: %w", err)
+		}
+	}
+
+	if ptr.Deref(cfg.Controllers.ShootSecurityAdvisory.ConcurrentSyncs, 0) > 0 && cfg.Controllers.ShootSecurityAdvisory.FeedConfigMapName != nil {
+		if err := (&securityadvisory.Reconciler{
+			Config:   *cfg.Controllers.ShootSecurityAdvisory,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding security advisory reconciler: %w", err)
+		}
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-22).
 	if shootStateControllerEnabled {
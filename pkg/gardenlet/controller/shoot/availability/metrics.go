@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availability
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardenlet"
+
+var (
+	metricsFactory = promauto.With(runtimemetrics.Registry)
+	metricsLabels  = []string{"shoot_namespace", "shoot_name", "period"}
+
+	// APIServerAvailability defines the gauge shoot_apiserver_availability_percent.
+	APIServerAvailability = metricsFactory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "shoot_apiserver_availability_percent",
+			Help:      "Availability of the shoot's API server in percent within the current month.",
+		},
+		metricsLabels,
+	)
+
+	// APIServerErrorBudgetRemaining defines the gauge shoot_apiserver_error_budget_remaining_percent.
+	APIServerErrorBudgetRemaining = metricsFactory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "shoot_apiserver_error_budget_remaining_percent",
+			Help:      "Remaining error budget of the shoot's API server in percent of the monthly error budget.",
+		},
+		metricsLabels,
+	)
+)
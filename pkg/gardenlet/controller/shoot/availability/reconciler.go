@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availability
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	prometheusapi "github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	controlplaneblackboxexporter "github.com/gardener/gardener/pkg/component/observability/monitoring/blackboxexporter/shoot/controlplane"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// PeriodFormat is the layout of the period the availability of a Shoot's API server is computed for.
+const PeriodFormat = "2006-01"
+
+// APIServerAvailabilityFunc is a function used to retrieve the ratio of successful probes of the API server of the
+// shoot in the given control plane namespace within the given window ending at the given time. The returned boolean
+// is false if no probe results are available for the window.
+type APIServerAvailabilityFunc func(ctx context.Context, namespace string, window time.Duration, now time.Time) (float64, bool, error)
+
+var (
+	// QueryAPIServerAvailability is the function used to retrieve the availability of a Shoot's API server. Exposed
+	// for testing.
+	QueryAPIServerAvailability APIServerAvailabilityFunc = defaultQueryAPIServerAvailability
+	// MinimumWindow is the minimum duration of the window the availability is computed for. It applies right after
+	// the beginning of a new period.
+	MinimumWindow = 5 * time.Minute
+)
+
+// Reconciler computes the availability of the API servers of Shoots and their remaining error budgets.
+type Reconciler struct {
+	GardenClient client.Client
+	Config       config.ShootAvailabilityControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile computes the availability of the Shoot's API server within the current month based on the probes of the
+// blackbox-exporter in the control plane. The result is written to the Shoot status and exposed as metrics.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		deleteMetrics(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.Status.LastOperation == nil || shoot.Status.IsHibernated {
+		log.V(1).Info("Skipping availability computation because shoot was not yet created or is hibernated")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	var (
+		now         = r.Clock.Now().UTC()
+		periodStart = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		window      = max(now.Sub(periodStart), MinimumWindow)
+	)
+
+	ratio, ok, err := QueryAPIServerAvailability(ctx, shoot.Status.TechnicalID, window, now)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed querying API server availability: %w", err)
+	}
+	if !ok {
+		log.Info("No API server probe results available yet, requeuing", "requeueAfter", r.Config.SyncPeriod.Duration)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	var (
+		period               = now.Format(PeriodFormat)
+		objective            = ptr.Deref(r.Config.Objective, 0)
+		availability         = ratio * 100
+		errorBudgetRemaining = ErrorBudgetRemaining(objective, availability)
+	)
+
+	setMetrics(request.NamespacedName, period, availability, errorBudgetRemaining)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.Availability = &gardencorev1beta1.ShootAvailability{
+		Period:               period,
+		Objective:            formatPercentage(objective),
+		APIServer:            formatPercentage(availability),
+		ErrorBudgetRemaining: formatPercentage(errorBudgetRemaining),
+		LastUpdateTime:       metav1.NewTime(now),
+	}
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating availability in shoot status: %w", err)
+	}
+
+	log.Info("Computed API server availability", "period", period, "availability", availability, "errorBudgetRemaining", errorBudgetRemaining)
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// ErrorBudgetRemaining returns the remaining error budget in percent of the total error budget for the given
+// objective and measured availability (both in percent). The result is negative if the error budget is exhausted.
+func ErrorBudgetRemaining(objective, availability float64) float64 {
+	errorBudget := 100 - objective
+	if errorBudget <= 0 {
+		return 0
+	}
+	return (errorBudget - (100 - availability)) / errorBudget * 100
+}
+
+func formatPercentage(value float64) string {
+	return strconv.FormatFloat(value, 'f', 3, 64)
+}
+
+func setMetrics(key types.NamespacedName, period string, availability, errorBudgetRemaining float64) {
+	// drop the series of previous periods
+	deleteMetrics(key)
+
+	APIServerAvailability.WithLabelValues(key.Namespace, key.Name, period).Set(availability)
+	APIServerErrorBudgetRemaining.WithLabelValues(key.Namespace, key.Name, period).Set(errorBudgetRemaining)
+}
+
+func deleteMetrics(key types.NamespacedName) {
+	labels := prometheus.Labels{"shoot_namespace": key.Namespace, "shoot_name": key.Name}
+	APIServerAvailability.DeletePartialMatch(labels)
+	APIServerErrorBudgetRemaining.DeletePartialMatch(labels)
+}
+
+// defaultQueryAPIServerAvailability is the default function to retrieve the availability of a Shoot's API server. It
+// queries the Prometheus instance of the shoot for the results of the API server probes of the blackbox-exporter.
+func defaultQueryAPIServerAvailability(ctx context.Context, namespace string, window time.Duration, now time.Time) (float64, bool, error) {
+	prometheusClient, err := prometheusapi.NewClient(prometheusapi.Config{Address: fmt.Sprintf("http://prometheus-%s.%s.svc", shootprometheus.Label, namespace)})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed creating Prometheus client: %w", err)
+	}
+
+	query := fmt.Sprintf(`avg(avg_over_time(probe_success{job=%q}[%s]))`, controlplaneblackboxexporter.APIServerJobName, model.Duration(window.Truncate(time.Second)))
+	value, _, err := prometheusv1.NewAPI(prometheusClient).Query(ctx, query, now)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed querying probe results: %w", err)
+	}
+
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected type %s of query result", value.Type())
+	}
+	if len(vector) == 0 {
+		return 0, false, nil
+	}
+
+	return float64(vector[0].Value), true, nil
+}
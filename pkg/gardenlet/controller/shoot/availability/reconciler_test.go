@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package availability_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/availability"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+
+		seedName   = "seed"
+		syncPeriod = 15 * time.Minute
+		now        = time.Date(2024, time.March, 16, 12, 0, 0, 0, time.UTC)
+
+		queriedNamespace string
+		queriedWindow    time.Duration
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			Config: config.ShootAvailabilityControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: syncPeriod},
+				Objective:  ptr.To(99.5),
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID:   "shoot--dev--shoot",
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		queriedNamespace, queriedWindow = "", 0
+		DeferCleanup(test.WithVar(&QueryAPIServerAvailability, APIServerAvailabilityFunc(func(_ context.Context, namespace string, window time.Duration, _ time.Time) (float64, bool, error) {
+			queriedNamespace, queriedWindow = namespace, window
+			return 0.998, true, nil
+		})))
+		DeferCleanup(func() {
+			APIServerAvailability.Reset()
+			APIServerErrorBudgetRemaining.Reset()
+		})
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(queriedNamespace).To(BeEmpty())
+	})
+
+	It("should do nothing if the shoot is managed by another seed", func() {
+		shoot.Spec.SeedName = ptr.To("other-seed")
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(queriedNamespace).To(BeEmpty())
+	})
+
+	It("should requeue if the shoot is hibernated", func() {
+		shoot.Status.IsHibernated = true
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(queriedNamespace).To(BeEmpty())
+	})
+
+	It("should requeue without updating the status if no probe results are available", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		DeferCleanup(test.WithVar(&QueryAPIServerAvailability, APIServerAvailabilityFunc(func(context.Context, string, time.Duration, time.Time) (float64, bool, error) {
+			return 0, false, nil
+		})))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.Availability).To(BeNil())
+	})
+
+	It("should return an error if the query fails", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		DeferCleanup(test.WithVar(&QueryAPIServerAvailability, APIServerAvailabilityFunc(func(context.Context, string, time.Duration, time.Time) (float64, bool, error) {
+			return 0, false, errors.New("fake")
+		})))
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("fake")))
+	})
+
+	It("should compute the availability since the beginning of the month", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(queriedNamespace).To(Equal("shoot--dev--shoot"))
+		Expect(queriedWindow).To(Equal(15*24*time.Hour + 12*time.Hour))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.Availability.LastUpdateTime.Time).To(BeTemporally("==", now))
+		shoot.Status.Availability.LastUpdateTime = metav1.NewTime(now)
+		Expect(shoot.Status.Availability).To(Equal(&gardencorev1beta1.ShootAvailability{
+			Period:               "2024-03",
+			Objective:            "99.500",
+			APIServer:            "99.800",
+			ErrorBudgetRemaining: "60.000",
+			LastUpdateTime:       metav1.NewTime(now),
+		}))
+
+		Expect(testutil.ToFloat64(APIServerAvailability.WithLabelValues("garden-dev", "shoot", "2024-03"))).To(BeNumerically("~", 99.8))
+		Expect(testutil.ToFloat64(APIServerErrorBudgetRemaining.WithLabelValues("garden-dev", "shoot", "2024-03"))).To(BeNumerically("~", 60))
+	})
+
+	It("should use the minimum window right after the beginning of the month and drop metrics of the previous period", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		fakeClock.SetTime(time.Date(2024, time.April, 1, 0, 1, 0, 0, time.UTC))
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(queriedWindow).To(Equal(MinimumWindow))
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Status.Availability.Period).To(Equal("2024-04"))
+		Expect(testutil.CollectAndCount(APIServerAvailability)).To(Equal(1))
+	})
+
+	It("should delete the metrics once the shoot is gone", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(testutil.CollectAndCount(APIServerAvailability)).To(Equal(1))
+
+		Expect(gardenClient.Delete(ctx, shoot)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(APIServerAvailability)).To(Equal(0))
+		Expect(testutil.CollectAndCount(APIServerErrorBudgetRemaining)).To(Equal(0))
+	})
+
+	Describe("#ErrorBudgetRemaining", func() {
+		It("should compute the remaining error budget", func() {
+			Expect(ErrorBudgetRemaining(99.5, 100)).To(BeNumerically("~", 100))
+			Expect(ErrorBudgetRemaining(99.5, 99.75)).To(BeNumerically("~", 50))
+			Expect(ErrorBudgetRemaining(99.5, 99.5)).To(BeNumerically("~", 0))
+			Expect(ErrorBudgetRemaining(99.5, 99)).To(BeNumerically("~", -100))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+const (
+	// ETCDStatusUpdateInterval is the minimum duration between two updates of the information about the etcds in the
+	// Shoot status.
+	ETCDStatusUpdateInterval = 5 * time.Minute
+	// maxETCDStatusWindow is the maximum window in which defragmentations are detected. It corresponds to the retention
+	// of the Prometheus instance of the shoot.
+	maxETCDStatusWindow = 24 * time.Hour
+	// maxLastDefragmentations is the maximum number of defragmentations which are kept in the Shoot status.
+	maxLastDefragmentations = 5
+)
+
+// ComputeETCDStatus returns the updated information about the etcds of the given shoot based on the metrics in the
+// given control plane namespace. It returns nil if the information in the Shoot status is still up-to-date.
+func ComputeETCDStatus(ctx context.Context, clock clock.Clock, shoot *gardencorev1beta1.Shoot, namespace string) (*gardencorev1beta1.ShootETCDStatus, error) {
+	var (
+		now     = clock.Now().UTC()
+		current = shoot.Status.ETCD
+		window  = ETCDStatusUpdateInterval
+	)
+
+	if lastUpdateTime := lastETCDStatusUpdateTime(current); !lastUpdateTime.IsZero() {
+		if now.Sub(lastUpdateTime) < ETCDStatusUpdateInterval {
+			return nil, nil
+		}
+		window = min(now.Sub(lastUpdateTime), maxETCDStatusWindow)
+	}
+
+	metrics, err := ETCDMetrics(ctx, namespace, window)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		current = &gardencorev1beta1.ShootETCDStatus{}
+	}
+
+	return &gardencorev1beta1.ShootETCDStatus{
+		Main:   computeETCDStatus(current.Main, metrics, v1beta1constants.ETCDMain, now),
+		Events: computeETCDStatus(current.Events, metrics, v1beta1constants.ETCDEvents, now),
+	}, nil
+}
+
+func computeETCDStatus(current *gardencorev1beta1.ETCDStatus, metrics map[string]ETCDMetricValues, name string, now time.Time) *gardencorev1beta1.ETCDStatus {
+	values, ok := metrics[name]
+	if !ok {
+		// Keep the last known information if no metrics are available, e.g., because Prometheus was restarted.
+		return current
+	}
+
+	status := &gardencorev1beta1.ETCDStatus{
+		DBSize:         values.DBSize,
+		DBSizeInUse:    values.DBSizeInUse,
+		LastUpdateTime: metav1.NewTime(now),
+	}
+
+	var lastDefragmentations []gardencorev1beta1.ETCDDefragmentationStatus
+	if current != nil {
+		lastDefragmentations = current.LastDefragmentations
+	}
+
+	if values.Defragmentations > 0 {
+		defragmentation := gardencorev1beta1.ETCDDefragmentationStatus{Time: metav1.NewTime(now)}
+		if current != nil {
+			defragmentation.DBSizeBefore = current.DBSize
+		}
+		lastDefragmentations = append([]gardencorev1beta1.ETCDDefragmentationStatus{defragmentation}, lastDefragmentations...)
+	}
+
+	if len(lastDefragmentations) > maxLastDefragmentations {
+		lastDefragmentations = lastDefragmentations[:maxLastDefragmentations]
+	}
+	status.LastDefragmentations = lastDefragmentations
+
+	return status
+}
+
+func lastETCDStatusUpdateTime(status *gardencorev1beta1.ShootETCDStatus) time.Time {
+	var lastUpdateTime time.Time
+	if status == nil {
+		return lastUpdateTime
+	}
+
+	for _, etcdStatus := range []*gardencorev1beta1.ETCDStatus{status.Main, status.Events} {
+		if etcdStatus != nil && etcdStatus.LastUpdateTime.After(lastUpdateTime) {
+			lastUpdateTime = etcdStatus.LastUpdateTime.Time
+		}
+	}
+	return lastUpdateTime
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("ETCDStatus", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx       = context.Background()
+		fakeClock *testclock.FakeClock
+		shoot     *gardencorev1beta1.Shoot
+
+		metrics     map[string]ETCDMetricValues
+		queryWindow time.Duration
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+		shoot = &gardencorev1beta1.Shoot{}
+
+		metrics = map[string]ETCDMetricValues{
+			"etcd-main":   {DBSize: ptr.To(resource.MustParse("2Gi")), DBSizeInUse: ptr.To(resource.MustParse("1Gi"))},
+			"etcd-events": {DBSize: ptr.To(resource.MustParse("100Mi")), DBSizeInUse: ptr.To(resource.MustParse("50Mi"))},
+		}
+		queryWindow = 0
+
+		DeferCleanup(test.WithVar(&ETCDMetrics, func(_ context.Context, ns string, window time.Duration) (map[string]ETCDMetricValues, error) {
+			Expect(ns).To(Equal(namespace))
+			queryWindow = window
+			return metrics, nil
+		}))
+	})
+
+	It("should initialize the status", func() {
+		status, err := ComputeETCDStatus(ctx, fakeClock, shoot, namespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(queryWindow).To(Equal(ETCDStatusUpdateInterval))
+		Expect(status).To(Equal(&gardencorev1beta1.ShootETCDStatus{
+			Main: &gardencorev1beta1.ETCDStatus{
+				DBSize:         ptr.To(resource.MustParse("2Gi")),
+				DBSizeInUse:    ptr.To(resource.MustParse("1Gi")),
+				LastUpdateTime: metav1.NewTime(fakeClock.Now()),
+			},
+			Events: &gardencorev1beta1.ETCDStatus{
+				DBSize:         ptr.To(resource.MustParse("100Mi")),
+				DBSizeInUse:    ptr.To(resource.MustParse("50Mi")),
+				LastUpdateTime: metav1.NewTime(fakeClock.Now()),
+			},
+		}))
+	})
+
+	It("should not update the status if it is still up-to-date", func() {
+		shoot.Status.ETCD = &gardencorev1beta1.ShootETCDStatus{
+			Main: &gardencorev1beta1.ETCDStatus{LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(-time.Minute))},
+		}
+
+		Expect(ComputeETCDStatus(ctx, fakeClock, shoot, namespace)).To(BeNil())
+		Expect(queryWindow).To(BeZero())
+	})
+
+	It("should record defragmentations since the last update", func() {
+		lastUpdateTime := metav1.NewTime(fakeClock.Now().Add(-10 * time.Minute))
+		shoot.Status.ETCD = &gardencorev1beta1.ShootETCDStatus{
+			Main: &gardencorev1beta1.ETCDStatus{
+				DBSize:         ptr.To(resource.MustParse("6Gi")),
+				LastUpdateTime: lastUpdateTime,
+				LastDefragmentations: []gardencorev1beta1.ETCDDefragmentationStatus{
+					{Time: metav1.NewTime(fakeClock.Now().Add(-24 * time.Hour))},
+					{Time: metav1.NewTime(fakeClock.Now().Add(-48 * time.Hour))},
+					{Time: metav1.NewTime(fakeClock.Now().Add(-72 * time.Hour))},
+					{Time: metav1.NewTime(fakeClock.Now().Add(-96 * time.Hour))},
+					{Time: metav1.NewTime(fakeClock.Now().Add(-120 * time.Hour))},
+				},
+			},
+		}
+		main := metrics["etcd-main"]
+		main.Defragmentations = 1
+		metrics["etcd-main"] = main
+
+		status, err := ComputeETCDStatus(ctx, fakeClock, shoot, namespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(queryWindow).To(Equal(10 * time.Minute))
+		Expect(status.Main.DBSize).To(Equal(ptr.To(resource.MustParse("2Gi"))))
+		Expect(status.Main.LastDefragmentations).To(Equal([]gardencorev1beta1.ETCDDefragmentationStatus{
+			{Time: metav1.NewTime(fakeClock.Now()), DBSizeBefore: ptr.To(resource.MustParse("6Gi"))},
+			{Time: metav1.NewTime(fakeClock.Now().Add(-24 * time.Hour))},
+			{Time: metav1.NewTime(fakeClock.Now().Add(-48 * time.Hour))},
+			{Time: metav1.NewTime(fakeClock.Now().Add(-72 * time.Hour))},
+			{Time: metav1.NewTime(fakeClock.Now().Add(-96 * time.Hour))},
+		}))
+		Expect(status.Events.LastDefragmentations).To(BeEmpty())
+	})
+
+	It("should keep the last known information if no metrics are available", func() {
+		shoot.Status.ETCD = &gardencorev1beta1.ShootETCDStatus{
+			Events: &gardencorev1beta1.ETCDStatus{
+				DBSize:         ptr.To(resource.MustParse("1Gi")),
+				LastUpdateTime: metav1.NewTime(fakeClock.Now().Add(-48 * time.Hour)),
+			},
+		}
+		delete(metrics, "etcd-events")
+
+		status, err := ComputeETCDStatus(ctx, fakeClock, shoot, namespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(queryWindow).To(Equal(24 * time.Hour))
+		Expect(status.Events).To(Equal(shoot.Status.ETCD.Events))
+		Expect(status.Main).NotTo(BeNil())
+	})
+
+	It("should return the error if the metrics cannot be retrieved", func() {
+		fakeErr := errors.New("fake")
+		DeferCleanup(test.WithVar(&ETCDMetrics, func(_ context.Context, _ string, _ time.Duration) (map[string]ETCDMetricValues, error) {
+			return nil, fakeErr
+		}))
+
+		_, err := ComputeETCDStatus(ctx, fakeClock, shoot, namespace)
+		Expect(err).To(MatchError(fakeErr))
+	})
+})
@@ -7,6 +7,7 @@ package care
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,7 +37,6 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
-	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/extensions"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
@@ -65,6 +66,9 @@ var (
 	)
 )
 
+// readinessGateHTTPGetTimeout is the timeout for HTTP requests of readiness gates.
+const readinessGateHTTPGetTimeout = 10 * time.Second
+
 // Health contains information needed to execute shoot health checks.
 type Health struct {
 	shoot        *shoot.Shoot
@@ -80,6 +84,7 @@ type Health struct {
 	clock                                     clock.Clock
 	controllerRegistrationToLastHeartbeatTime map[string]*metav1.MicroTime
 	conditionThresholds                       map[gardencorev1beta1.ConditionType]time.Duration
+	staleDurations                            map[gardencorev1beta1.ConditionType]time.Duration
 	healthChecker                             *healthchecker.HealthChecker
 }
 
@@ -97,6 +102,7 @@ func NewHealth(
 	clock clock.Clock,
 	gardenletConfig *gardenletconfig.GardenletConfiguration,
 	conditionThresholds map[gardencorev1beta1.ConditionType]time.Duration,
+	staleDurations map[gardencorev1beta1.ConditionType]time.Duration,
 ) *Health {
 	return &Health{
 		shoot:                  shoot,
@@ -109,6 +115,7 @@ func NewHealth(
 		gardenletConfiguration: gardenletConfig,
 		controllerRegistrationToLastHeartbeatTime: map[string]*metav1.MicroTime{},
 		conditionThresholds:                       conditionThresholds,
+		staleDurations:                            staleDurations,
 		healthChecker:                             healthchecker.NewHealthChecker(seedClientSet.Client(), clock, conditionThresholds, shoot.GetInfo().Status.LastOperation),
 	}
 }
@@ -144,16 +151,25 @@ func (h *Health) Check(
 	// Health checks that can be executed in all cases.
 	taskFns := []flow.TaskFn{
 		func(ctx context.Context) error {
-			newControlPlane, err := h.checkControlPlane(ctx, conditions.controlPlaneHealthy, extensionConditionsControlPlaneHealthy, managedResourceList.Items, healthCheckOutdatedThreshold)
+			newControlPlane, err := h.checkControlPlane(ctx, conditions.controlPlaneHealthy, extensionConditionsControlPlaneHealthy, managedResourceList.Items, h.extensionHealthCheckOutdatedThreshold(gardencorev1beta1.ShootControlPlaneHealthy, healthCheckOutdatedThreshold))
 			conditions.controlPlaneHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.controlPlaneHealthy, newControlPlane, err)
 			return nil
 		}, func(ctx context.Context) error {
-			newObservabilityComponents, err := h.checkObservabilityComponents(ctx, conditions.observabilityComponentsHealthy, extensionConditionsObservabilityComponentsHealthy, managedResourceList.Items, healthCheckOutdatedThreshold)
+			newObservabilityComponents, err := h.checkObservabilityComponents(ctx, conditions.observabilityComponentsHealthy, extensionConditionsObservabilityComponentsHealthy, managedResourceList.Items, h.extensionHealthCheckOutdatedThreshold(gardencorev1beta1.ShootObservabilityComponentsHealthy, healthCheckOutdatedThreshold))
 			conditions.observabilityComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.observabilityComponentsHealthy, newObservabilityComponents, err)
 			return nil
 		},
 	}
 
+	if conditions.observabilityProbesHealthy != nil {
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			newObservabilityProbes, err := h.CheckObservabilityProbes(ctx, *conditions.observabilityProbesHealthy)
+			probesCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.observabilityProbesHealthy, newObservabilityProbes, err)
+			conditions.observabilityProbesHealthy = &probesCondition
+			return nil
+		})
+	}
+
 	// Health checks with dependencies to the Kube-Apiserver.
 	shootClient, apiServerRunning, err := h.initializeShootClients()
 	if apiServerRunning && err == nil {
@@ -163,7 +179,7 @@ func (h *Health) Check(
 				return nil
 			},
 			func(ctx context.Context) error {
-				newSystemComponents, err := h.checkSystemComponents(ctx, shootClient, conditions.systemComponentsHealthy, extensionConditionsSystemComponentsHealthy, managedResourceList.Items, healthCheckOutdatedThreshold)
+				newSystemComponents, err := h.checkSystemComponents(ctx, shootClient, conditions.systemComponentsHealthy, extensionConditionsSystemComponentsHealthy, managedResourceList.Items, h.extensionHealthCheckOutdatedThreshold(gardencorev1beta1.ShootSystemComponentsHealthy, healthCheckOutdatedThreshold))
 				conditions.systemComponentsHealthy = v1beta1helper.NewConditionOrError(h.clock, conditions.systemComponentsHealthy, newSystemComponents, err)
 				return nil
 			},
@@ -171,12 +187,21 @@ func (h *Health) Check(
 		if conditions.everyNodeReady != nil {
 			taskFns = append(taskFns,
 				func(ctx context.Context) error {
-					newNodes, err := h.checkWorkers(ctx, shootClient, *conditions.everyNodeReady, extensionConditionsEveryNodeReady, healthCheckOutdatedThreshold)
+					newNodes, err := h.checkWorkers(ctx, shootClient, *conditions.everyNodeReady, extensionConditionsEveryNodeReady, h.extensionHealthCheckOutdatedThreshold(gardencorev1beta1.ShootEveryNodeReady, healthCheckOutdatedThreshold))
 					nodeCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.everyNodeReady, newNodes, err)
 					conditions.everyNodeReady = &nodeCondition
 					return nil
 				})
 		}
+		if conditions.readinessGatesPassed != nil {
+			taskFns = append(taskFns,
+				func(ctx context.Context) error {
+					newReadinessGates, err := h.CheckReadinessGates(ctx, shootClient, *conditions.readinessGatesPassed)
+					readinessGatesCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.readinessGatesPassed, newReadinessGates, err)
+					conditions.readinessGatesPassed = &readinessGatesCondition
+					return nil
+				})
+		}
 	} else {
 		// Some health checks cannot be executed when the API server is not running.
 		// Maintain the affected conditions here.
@@ -192,6 +217,10 @@ func (h *Health) Check(
 			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
 			conditions.everyNodeReady = &nodeCondition
 		}
+		if conditions.readinessGatesPassed != nil {
+			readinessGatesCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.readinessGatesPassed, message)
+			conditions.readinessGatesPassed = &readinessGatesCondition
+		}
 	}
 
 	// Execute all relevant health checks.
@@ -200,6 +229,18 @@ func (h *Health) Check(
 	return PardonConditions(h.clock, conditions.ConvertToSlice(), lastOp, lastErrors)
 }
 
+// extensionHealthCheckOutdatedThreshold returns the threshold when health check reports of extensions contributing to
+// the condition with the given type are considered outdated. A nil threshold disables the check.
+func (h *Health) extensionHealthCheckOutdatedThreshold(conditionType gardencorev1beta1.ConditionType, threshold *metav1.Duration) *metav1.Duration {
+	if threshold == nil {
+		return nil
+	}
+	if staleDuration, ok := h.staleDurations[conditionType]; ok {
+		return &metav1.Duration{Duration: staleDuration}
+	}
+	return threshold
+}
+
 func (h *Health) getAllExtensionConditions(ctx context.Context) ([]healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, []healthchecker.ExtensionCondition, error) {
 	objs, err := h.retrieveExtensions(ctx)
 	if err != nil {
@@ -411,10 +452,10 @@ func (h *Health) checkControlPlane(
 	}
 
 	if !h.shoot.IsWorkerless && v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(h.seed.GetInfo().Spec.Settings) {
-		if scaledDownDeploymentNames, err := CheckIfDependencyWatchdogProberScaledDownControllers(ctx, h.seedClient.Client(), h.shoot.SeedNamespace); err != nil {
+		if scaledDownDeploymentNames, err := CheckIfNodeLeaseProbeScaledDownControllers(ctx, h.seedClient.Client(), h.shoot.SeedNamespace); err != nil {
 			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ControllersScaledDownCheckError", err.Error())), nil
 		} else if len(scaledDownDeploymentNames) > 0 {
-			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ControllersScaledDown", fmt.Sprintf("The following deployments have been scaled down to 0 replicas (perhaps by dependency-watchdog-prober): %s", strings.Join(scaledDownDeploymentNames, ", ")))), nil
+			return ptr.To(v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ControllersScaledDown", fmt.Sprintf("The following deployments have been scaled down to 0 replicas by the node lease probe because too many node leases are expired: %s", strings.Join(scaledDownDeploymentNames, ", ")))), nil
 		}
 	}
 
@@ -433,23 +474,14 @@ func (h *Health) checkControlPlane(
 	return &c, nil
 }
 
-// CheckIfDependencyWatchdogProberScaledDownControllers checks if controllers have been scaled down by dependency-watchdog-prober.
-func CheckIfDependencyWatchdogProberScaledDownControllers(ctx context.Context, seedClient client.Client, shootNamespace string) ([]string, error) {
+// CheckIfNodeLeaseProbeScaledDownControllers checks if controllers have been scaled down by the node lease probe.
+func CheckIfNodeLeaseProbeScaledDownControllers(ctx context.Context, seedClient client.Client, shootNamespace string) ([]string, error) {
 	var scaledDownDeploymentNames []string
 
-	proberConfig, err := kubeapiserver.NewDependencyWatchdogProberConfiguration()
-	if err != nil {
-		return nil, fmt.Errorf("failed getting dependency-watchdog-prober config: %w", err)
-	}
-
-	for _, dependentResourceInfo := range proberConfig {
-		if dependentResourceInfo.Ref == nil || dependentResourceInfo.Ref.Kind != "Deployment" {
-			continue
-		}
-
-		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: dependentResourceInfo.Ref.Name, Namespace: shootNamespace}}
+	for _, name := range scaleUpOrder {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shootNamespace}}
 		if err := seedClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment); err != nil {
-			if apierrors.IsNotFound(err) && dependentResourceInfo.Optional {
+			if apierrors.IsNotFound(err) {
 				// If the deployment does not exist then we don't care about it (e.g., some clusters don't have a
 				// cluster-autoscaler deployment when all their worker pools have min=max configuration).
 				continue
@@ -457,7 +489,7 @@ func CheckIfDependencyWatchdogProberScaledDownControllers(ctx context.Context, s
 			return nil, fmt.Errorf("failed reading Deployment %s for scale-down check: %w", deployment.Name, err)
 		}
 
-		if ptr.Deref(deployment.Spec.Replicas, 0) == 0 {
+		if _, ok := deployment.Annotations[v1beta1constants.AnnotationScaledDownReplicas]; ok {
 			scaledDownDeploymentNames = append(scaledDownDeploymentNames, deployment.Name)
 		}
 	}
@@ -515,6 +547,109 @@ func (h *Health) checkObservabilityComponents(
 	return &c, nil
 }
 
+// CheckObservabilityProbes checks whether the observability probes of the Shoot succeed.
+func (h *Health) CheckObservabilityProbes(ctx context.Context, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	if h.shoot.Purpose == gardencorev1beta1.ShootPurposeTesting || !gardenlethelper.IsMonitoringEnabled(h.gardenletConfiguration) {
+		c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionUnknown, "ObservabilityProbesNotExecuted", "Observability probes are not executed because monitoring is disabled for the shoot.")
+		return &c, nil
+	}
+
+	results, err := ObservabilityProbeResults(ctx, h.shoot.SeedNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var failedProbes, pendingProbes []string
+	for _, probe := range v1beta1helper.GetShootObservabilityProbes(h.shoot.GetInfo()) {
+		success, ok := results[probe.Name]
+		switch {
+		case !ok:
+			pendingProbes = append(pendingProbes, probe.Name)
+		case !success:
+			failedProbes = append(failedProbes, probe.Name)
+		}
+	}
+
+	if len(failedProbes) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ObservabilityProbesFailing", fmt.Sprintf("Observability probes are failing: %s", strings.Join(failedProbes, ", ")))
+		return &c, nil
+	}
+
+	if len(pendingProbes) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ObservabilityProbesPending", fmt.Sprintf("No results available yet for observability probes: %s", strings.Join(pendingProbes, ", ")))
+		return &c, nil
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "ObservabilityProbesSucceeded", "All observability probes succeed.")
+	return &c, nil
+}
+
+// CheckReadinessGates checks whether the user-defined readiness gates of the Shoot pass.
+func (h *Health) CheckReadinessGates(ctx context.Context, shootClient kubernetes.Interface, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	var failedGates []string
+
+	for _, gate := range h.shoot.GetInfo().Spec.ReadinessGates {
+		var err error
+
+		switch {
+		case gate.HTTPGet != nil:
+			err = checkReadinessGateHTTPGet(ctx, shootClient, gate.HTTPGet)
+		case gate.CustomResourceDefinition != nil:
+			err = checkReadinessGateCustomResourceDefinition(ctx, shootClient.Client(), gate.CustomResourceDefinition)
+		default:
+			continue
+		}
+
+		if err != nil {
+			failedGates = append(failedGates, fmt.Sprintf("%s (%s)", gate.Name, err))
+		}
+	}
+
+	if len(failedGates) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "ReadinessGatesFailing", fmt.Sprintf("Readiness gates are failing: %s", strings.Join(failedGates, ", ")))
+		return &c, nil
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "ReadinessGatesPassed", "All readiness gates pass.")
+	return &c, nil
+}
+
+func checkReadinessGateHTTPGet(ctx context.Context, shootClient kubernetes.Interface, httpGet *gardencorev1beta1.ShootReadinessGateHTTPGet) error {
+	ctx, cancel := context.WithTimeout(ctx, readinessGateHTTPGetTimeout)
+	defer cancel()
+
+	// The request is sent through the service proxy of the shoot's kube-apiserver which only forwards 2xx responses
+	// without an error.
+	_, err := shootClient.Kubernetes().CoreV1().Services(httpGet.Namespace).ProxyGet(
+		ptr.Deref(httpGet.Scheme, "http"),
+		httpGet.Service,
+		strconv.Itoa(int(httpGet.Port)),
+		ptr.Deref(httpGet.Path, "/"),
+		nil,
+	).DoRaw(ctx)
+	if err != nil {
+		return fmt.Errorf("request to service %s/%s failed: %w", httpGet.Namespace, httpGet.Service, err)
+	}
+	return nil
+}
+
+func checkReadinessGateCustomResourceDefinition(ctx context.Context, shootClient client.Client, crd *gardencorev1beta1.ShootReadinessGateCustomResourceDefinition) error {
+	customResourceDefinition := &apiextensionsv1.CustomResourceDefinition{}
+	if err := shootClient.Get(ctx, client.ObjectKey{Name: crd.Name}, customResourceDefinition); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("CustomResourceDefinition %s does not exist", crd.Name)
+		}
+		return err
+	}
+
+	for _, condition := range customResourceDefinition.Status.Conditions {
+		if condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue {
+			return nil
+		}
+	}
+	return fmt.Errorf("CustomResourceDefinition %s is not established", crd.Name)
+}
+
 // checkSystemComponents checks whether the system components of a Shoot are running.
 func (h *Health) checkSystemComponents(
 	ctx context.Context,
@@ -640,6 +775,11 @@ func (h *Health) CheckClusterNodes(
 		return &c, nil
 	}
 
+	if err := botanist.KubeletConfigUpdatedForAllWorkerPools(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); err != nil {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "KubeletConfigurationOutdated", err.Error())
+		return &c, nil
+	}
+
 	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
 	if err := h.seedClient.Client().List(ctx, machineDeploymentList, client.InNamespace(h.shoot.SeedNamespace)); err != nil {
 		return nil, err
@@ -758,8 +898,8 @@ func CheckNodeAgentLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.L
 }
 
 // CheckForExpiredNodeLeases checks if the number of expired node Leases surpasses 20% of all existing Leases. If yes,
-// an error will be returned. The motivation is that dependency-watchdog is starting to scale down controllers when 60%
-// of the Leases are expired.
+// an error will be returned. The motivation is that the node lease probe is starting to scale down controllers when
+// 60% (by default) of the Leases are expired.
 func CheckForExpiredNodeLeases(nodeList *corev1.NodeList, leaseList *coordinationv1.LeaseList, clock clock.Clock) error {
 	if len(leaseList.Items) == 0 || len(nodeList.Items) == 0 {
 		return nil
@@ -780,7 +920,7 @@ func CheckForExpiredNodeLeases(nodeList *corev1.NodeList, leaseList *coordinatio
 	}
 
 	if expiredLeasesPercentage := 100 * expiredLeases / len(leaseList.Items); expiredLeasesPercentage >= 20 {
-		return fmt.Errorf("%d%% of all Leases in %s namespace are expired - dependency-watchdog-prober might start scaling down controllers", expiredLeasesPercentage, corev1.NamespaceNodeLease)
+		return fmt.Errorf("%d%% of all Leases in %s namespace are expired - the node lease probe might start scaling down controllers", expiredLeasesPercentage, corev1.NamespaceNodeLease)
 	}
 
 	return nil
@@ -969,6 +1109,31 @@ func isUnstableOperationType(lastOperationType gardencorev1beta1.LastOperationTy
 	return ok
 }
 
+// ApplyConditionSeverities keeps the given updated conditions in the progressing state instead of `False` if their
+// severity is `Warning`. The previous conditions are used to retain the last transition time of conditions which have
+// already been progressing before.
+func ApplyConditionSeverities(
+	clock clock.Clock,
+	previousConditions []gardencorev1beta1.Condition,
+	updatedConditions []gardencorev1beta1.Condition,
+	severities map[gardencorev1beta1.ConditionType]string,
+) []gardencorev1beta1.Condition {
+	out := make([]gardencorev1beta1.Condition, 0, len(updatedConditions))
+	for _, cond := range updatedConditions {
+		if cond.Status != gardencorev1beta1.ConditionFalse || severities[cond.Type] != v1beta1constants.CareConditionSeverityWarning {
+			out = append(out, cond)
+			continue
+		}
+
+		previous := cond
+		if c := v1beta1helper.GetCondition(previousConditions, cond.Type); c != nil {
+			previous = *c
+		}
+		out = append(out, v1beta1helper.UpdatedConditionWithClock(clock, previous, gardencorev1beta1.ConditionProgressing, cond.Reason, cond.Message, cond.Codes...))
+	}
+	return out
+}
+
 // ShootConditions contains all shoot related conditions of the shoot status subresource.
 type ShootConditions struct {
 	apiServerAvailable             gardencorev1beta1.Condition
@@ -976,6 +1141,8 @@ type ShootConditions struct {
 	observabilityComponentsHealthy gardencorev1beta1.Condition
 	systemComponentsHealthy        gardencorev1beta1.Condition
 	everyNodeReady                 *gardencorev1beta1.Condition
+	observabilityProbesHealthy     *gardencorev1beta1.Condition
+	readinessGatesPassed           *gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot conditions as a slice.
@@ -990,7 +1157,17 @@ func (s ShootConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 		conditions = append(conditions, *s.everyNodeReady)
 	}
 
-	return append(conditions, s.systemComponentsHealthy)
+	conditions = append(conditions, s.systemComponentsHealthy)
+
+	if s.observabilityProbesHealthy != nil {
+		conditions = append(conditions, *s.observabilityProbesHealthy)
+	}
+
+	if s.readinessGatesPassed != nil {
+		conditions = append(conditions, *s.readinessGatesPassed)
+	}
+
+	return conditions
 }
 
 // ConditionTypes returns all shoot condition types.
@@ -1005,7 +1182,9 @@ func (s ShootConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 		types = append(types, gardencorev1beta1.ShootEveryNodeReady)
 	}
 
-	return append(types, s.systemComponentsHealthy.Type)
+	// The conditions for the observability probes and the readiness gates are always listed so that they are removed
+	// once the probes or gates have been removed from the shoot.
+	return append(types, s.systemComponentsHealthy.Type, gardencorev1beta1.ShootObservabilityProbesHealthy, gardencorev1beta1.ShootReadinessGatesPassed)
 }
 
 // NewShootConditions returns a new instance of ShootConditions.
@@ -1023,5 +1202,15 @@ func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoot
 		shootConditions.everyNodeReady = &nodeCondition
 	}
 
+	if len(v1beta1helper.GetShootObservabilityProbes(shoot)) > 0 {
+		probesCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootObservabilityProbesHealthy)
+		shootConditions.observabilityProbesHealthy = &probesCondition
+	}
+
+	if len(shoot.Spec.ReadinessGates) > 0 {
+		readinessGatesCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootReadinessGatesPassed)
+		shootConditions.readinessGatesPassed = &readinessGatesCondition
+	}
+
 	return shootConditions
 }
@@ -7,6 +7,7 @@ package care_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -20,8 +21,12 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	kubernetesclientsetfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +40,7 @@ import (
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
@@ -159,6 +165,38 @@ var _ = Describe("health check", func() {
 		})
 	})
 
+	Describe("#ApplyConditionSeverities", func() {
+		var previousConditions []gardencorev1beta1.Condition
+
+		BeforeEach(func() {
+			previousConditions = []gardencorev1beta1.Condition{
+				{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionProgressing, LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour))},
+				{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionTrue},
+			}
+		})
+
+		It("should keep failed conditions with severity Warning in progressing state", func() {
+			updatedConditions := ApplyConditionSeverities(fakeClock, previousConditions, []gardencorev1beta1.Condition{
+				{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionFalse, Reason: "foo"},
+				{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionFalse, Reason: "bar", Message: "bar"},
+				{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionFalse, Reason: "baz"},
+				{Type: gardencorev1beta1.ShootEveryNodeReady, Status: gardencorev1beta1.ConditionTrue},
+			}, map[gardencorev1beta1.ConditionType]string{
+				gardencorev1beta1.ShootAPIServerAvailable:      "Error",
+				gardencorev1beta1.ShootControlPlaneHealthy:     "Warning",
+				gardencorev1beta1.ShootSystemComponentsHealthy: "Warning",
+				gardencorev1beta1.ShootEveryNodeReady:          "Warning",
+			})
+
+			Expect(updatedConditions).To(HaveExactElements(
+				And(HaveField("Type", gardencorev1beta1.ShootAPIServerAvailable), beConditionWithStatus(gardencorev1beta1.ConditionFalse)),
+				And(HaveField("Type", gardencorev1beta1.ShootControlPlaneHealthy), beConditionWithStatus(gardencorev1beta1.ConditionProgressing), HaveField("Message", "bar"), HaveField("LastTransitionTime", previousConditions[0].LastTransitionTime)),
+				And(HaveField("Type", gardencorev1beta1.ShootSystemComponentsHealthy), beConditionWithStatus(gardencorev1beta1.ConditionProgressing), HaveField("Reason", "baz"), HaveField("LastTransitionTime.Time", fakeClock.Now())),
+				And(HaveField("Type", gardencorev1beta1.ShootEveryNodeReady), beConditionWithStatus(gardencorev1beta1.ConditionTrue)),
+			))
+		})
+	})
+
 	DescribeTable("#PardonCondition",
 		func(condition gardencorev1beta1.Condition, lastOp *gardencorev1beta1.LastOperation, lastErrors []gardencorev1beta1.LastError, expected types.GomegaMatcher) {
 			conditions := []gardencorev1beta1.Condition{condition}
@@ -316,6 +354,7 @@ var _ = Describe("health check", func() {
 					fakeClock,
 					nil,
 					nil,
+					nil,
 				)
 
 				exitCondition, err := health.CheckClusterNodes(ctx, kubernetesfake.NewClientSetBuilder().WithClient(c).Build(), condition)
@@ -419,47 +458,71 @@ var _ = Describe("health check", func() {
 					},
 				},
 				PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "OperatingSystemConfigOutdated", fmt.Sprintf("the last successfully applied operating system config on node %q is outdated", nodeName)))),
+			Entry("outdated effective kubelet configuration for a worker pool",
+				kubernetesVersion,
+				[]corev1.Node{
+					newNode(labels.Set{"worker.gardener.cloud/pool": workerPoolName1, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()}, map[string]string{
+						nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: cloudConfigSecretChecksum1,
+						nodeagentv1alpha1.AnnotationKeyChecksumEffectiveKubeletConfig:       "outdated",
+					}, kubernetesVersion.Original()),
+				},
+				[]gardencorev1beta1.Worker{
+					{
+						Name:    workerPoolName1,
+						Maximum: 10,
+						Minimum: 1,
+					},
+				},
+				map[string]metav1.ObjectMeta{
+					workerPoolName1: {
+						Name: operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil),
+						Annotations: map[string]string{
+							"checksum/data-script":            cloudConfigSecretChecksum1,
+							"checksum/desired-kubelet-config": "desired",
+						},
+						Labels: map[string]string{"worker.gardener.cloud/pool": workerPoolName1},
+					},
+				},
+				PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "KubeletConfigurationOutdated", fmt.Sprintf("the effective kubelet configuration of worker pool %q is outdated on nodes %s", workerPoolName1, nodeName)))),
 		)
 	})
 
-	Describe("#CheckIfDependencyWatchdogProberScaledDownControllers", func() {
+	Describe("#CheckIfNodeLeaseProbeScaledDownControllers", func() {
 		var (
-			deploymentCA  *appsv1.Deployment
 			deploymentKCM *appsv1.Deployment
 			deploymentMCM *appsv1.Deployment
 		)
 
 		BeforeEach(func() {
-			deploymentCA = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "cluster-autoscaler", Namespace: seedNamespace}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}}
 			deploymentKCM = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: seedNamespace}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}}
 			deploymentMCM = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: seedNamespace}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}}
 		})
 
-		It("should report an error because a required relevant deployment does not exist", func() {
-			scaledDownDeploymentNames, err := CheckIfDependencyWatchdogProberScaledDownControllers(ctx, fakeClient, seedNamespace)
-			Expect(err).To(BeNotFoundError())
+		It("should report nothing because no relevant deployment exists", func() {
+			scaledDownDeploymentNames, err := CheckIfNodeLeaseProbeScaledDownControllers(ctx, fakeClient, seedNamespace)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(scaledDownDeploymentNames).To(BeEmpty())
 		})
 
-		It("should report nothing because all relevant deployment have replicas > 0", func() {
-			Expect(fakeClient.Create(ctx, deploymentCA)).To(Succeed())
+		It("should report nothing because no relevant deployment has been scaled down", func() {
+			deploymentMCM.Spec.Replicas = ptr.To[int32](0)
+
 			Expect(fakeClient.Create(ctx, deploymentKCM)).To(Succeed())
 			Expect(fakeClient.Create(ctx, deploymentMCM)).To(Succeed())
 
-			scaledDownDeploymentNames, err := CheckIfDependencyWatchdogProberScaledDownControllers(ctx, fakeClient, seedNamespace)
+			scaledDownDeploymentNames, err := CheckIfNodeLeaseProbeScaledDownControllers(ctx, fakeClient, seedNamespace)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(scaledDownDeploymentNames).To(BeEmpty())
 		})
 
-		It("should report names because some relevant deployment have replicas == 0", func() {
-			deploymentKCM.Spec.Replicas = nil
-			deploymentMCM.Spec.Replicas = ptr.To[int32](0)
+		It("should report names because some relevant deployments have been scaled down", func() {
+			metav1.SetMetaDataAnnotation(&deploymentKCM.ObjectMeta, "care.gardener.cloud/scaled-down-replicas", "1")
+			metav1.SetMetaDataAnnotation(&deploymentMCM.ObjectMeta, "care.gardener.cloud/scaled-down-replicas", "1")
 
-			Expect(fakeClient.Create(ctx, deploymentCA)).To(Succeed())
 			Expect(fakeClient.Create(ctx, deploymentKCM)).To(Succeed())
 			Expect(fakeClient.Create(ctx, deploymentMCM)).To(Succeed())
 
-			scaledDownDeploymentNames, err := CheckIfDependencyWatchdogProberScaledDownControllers(ctx, fakeClient, seedNamespace)
+			scaledDownDeploymentNames, err := CheckIfNodeLeaseProbeScaledDownControllers(ctx, fakeClient, seedNamespace)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(scaledDownDeploymentNames).To(HaveExactElements(deploymentKCM.Name, deploymentMCM.Name))
 		})
@@ -838,10 +901,228 @@ var _ = Describe("health check", func() {
 					gardencorev1beta1.ConditionType("ObservabilityComponentsHealthy"),
 					gardencorev1beta1.ConditionType("EveryNodeReady"),
 					gardencorev1beta1.ConditionType("SystemComponentsHealthy"),
+					gardencorev1beta1.ConditionType("ObservabilityProbesHealthy"),
+					gardencorev1beta1.ConditionType("ReadinessGatesPassed"),
+				))
+			})
+		})
+
+		Context("with observability probes", func() {
+			var shootWithProbes *gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				shootWithProbes = &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Observability: &gardencorev1beta1.Observability{
+							Probes: []gardencorev1beta1.ObservabilityProbe{{Name: "frontend", Protocol: "HTTP", Target: "https://shop.example.com"}},
+						},
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
+					},
+				}
+			})
+
+			It("should additionally return the condition for the observability probes", func() {
+				conditions := NewShootConditions(fakeClock, shootWithProbes)
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("APIServerAvailable"),
+					OfType("ControlPlaneHealthy"),
+					OfType("ObservabilityComponentsHealthy"),
+					OfType("EveryNodeReady"),
+					OfType("SystemComponentsHealthy"),
+					OfType("ObservabilityProbesHealthy"),
+				))
+			})
+		})
+
+		Context("with readiness gates", func() {
+			It("should additionally return the condition for the readiness gates", func() {
+				conditions := NewShootConditions(fakeClock, &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						ReadinessGates: []gardencorev1beta1.ShootReadinessGate{{Name: "crd", CustomResourceDefinition: &gardencorev1beta1.ShootReadinessGateCustomResourceDefinition{Name: "foos.example.com"}}},
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
+					},
+				})
+
+				Expect(conditions.ConvertToSlice()).To(HaveExactElements(
+					OfType("APIServerAvailable"),
+					OfType("ControlPlaneHealthy"),
+					OfType("ObservabilityComponentsHealthy"),
+					OfType("EveryNodeReady"),
+					OfType("SystemComponentsHealthy"),
+					OfType("ReadinessGatesPassed"),
 				))
 			})
 		})
 	})
+
+	Describe("#CheckReadinessGates", func() {
+		var (
+			health          *Health
+			condition       gardencorev1beta1.Condition
+			shootClient     client.Client
+			shootKubernetes *kubernetesclientsetfake.Clientset
+			shootClientSet  kubernetes.Interface
+
+			proxyErr error
+		)
+
+		BeforeEach(func() {
+			shootObj := &shootpkg.Shoot{SeedNamespace: seedNamespace}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					ReadinessGates: []gardencorev1beta1.ShootReadinessGate{
+						{Name: "app", HTTPGet: &gardencorev1beta1.ShootReadinessGateHTTPGet{Namespace: "default", Service: "app", Port: 8080, Path: ptr.To("/healthz")}},
+						{Name: "crd", CustomResourceDefinition: &gardencorev1beta1.ShootReadinessGateCustomResourceDefinition{Name: "foos.example.com"}},
+					},
+				},
+			})
+			seedObj := &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			proxyErr = nil
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			shootKubernetes = kubernetesclientsetfake.NewSimpleClientset()
+			shootKubernetes.PrependProxyReactor("services", func(action k8stesting.Action) (bool, rest.ResponseWrapper, error) {
+				proxyAction := action.(k8stesting.ProxyGetAction)
+				Expect(proxyAction.GetNamespace()).To(Equal("default"))
+				Expect(proxyAction.GetScheme()).To(Equal("http"))
+				Expect(proxyAction.GetName()).To(Equal("app"))
+				Expect(proxyAction.GetPort()).To(Equal("8080"))
+				Expect(proxyAction.GetPath()).To(Equal("/healthz"))
+				return true, &fakeResponseWrapper{err: proxyErr}, nil
+			})
+			shootClientSet = kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithKubernetes(shootKubernetes).Build()
+
+			health = NewHealth(
+				logr.Discard(),
+				shootObj,
+				seedObj,
+				kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				nil,
+				nil,
+				fakeClock,
+				nil,
+				nil,
+				nil,
+			)
+			condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootReadinessGatesPassed}
+		})
+
+		It("should return true if all readiness gates pass", func() {
+			Expect(shootClient.Create(ctx, &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+				Status: apiextensionsv1.CustomResourceDefinitionStatus{
+					Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue}},
+				},
+			})).To(Succeed())
+
+			exitCondition, err := health.CheckReadinessGates(ctx, shootClientSet, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionTrue, "ReadinessGatesPassed", "All readiness gates pass.")))
+		})
+
+		It("should return false if readiness gates fail", func() {
+			proxyErr = fmt.Errorf("fake")
+			Expect(shootClient.Create(ctx, &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+			})).To(Succeed())
+
+			exitCondition, err := health.CheckReadinessGates(ctx, shootClientSet, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "ReadinessGatesFailing",
+				"Readiness gates are failing: app (request to service default/app failed: fake), crd (CustomResourceDefinition foos.example.com is not established)")))
+		})
+
+		It("should return false if the CustomResourceDefinition does not exist", func() {
+			exitCondition, err := health.CheckReadinessGates(ctx, shootClientSet, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "ReadinessGatesFailing",
+				"Readiness gates are failing: crd (CustomResourceDefinition foos.example.com does not exist)")))
+		})
+	})
+
+	Describe("#CheckObservabilityProbes", func() {
+		var (
+			health    *Health
+			condition gardencorev1beta1.Condition
+		)
+
+		BeforeEach(func() {
+			shootObj := &shootpkg.Shoot{SeedNamespace: seedNamespace}
+			shootObj.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Observability: &gardencorev1beta1.Observability{
+						Probes: []gardencorev1beta1.ObservabilityProbe{
+							{Name: "frontend", Protocol: "HTTP", Target: "https://shop.example.com"},
+							{Name: "database", Protocol: "TCP", Target: "10.0.1.12:5432"},
+						},
+					},
+				},
+			})
+			seedObj := &seedpkg.Seed{}
+			seedObj.SetInfo(&gardencorev1beta1.Seed{})
+
+			health = NewHealth(
+				logr.Discard(),
+				shootObj,
+				seedObj,
+				kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				nil,
+				nil,
+				fakeClock,
+				nil,
+				nil,
+				nil,
+			)
+			condition = gardencorev1beta1.Condition{Type: gardencorev1beta1.ShootObservabilityProbesHealthy}
+		})
+
+		It("should return true if all probes succeed", func() {
+			DeferCleanup(test.WithVar(&ObservabilityProbeResults, func(_ context.Context, namespace string) (map[string]bool, error) {
+				Expect(namespace).To(Equal(seedNamespace))
+				return map[string]bool{"frontend": true, "database": true}, nil
+			}))
+
+			exitCondition, err := health.CheckObservabilityProbes(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionTrue, "ObservabilityProbesSucceeded", "All observability probes succeed.")))
+		})
+
+		It("should return false if probes fail", func() {
+			DeferCleanup(test.WithVar(&ObservabilityProbeResults, func(_ context.Context, _ string) (map[string]bool, error) {
+				return map[string]bool{"frontend": false, "database": true}, nil
+			}))
+
+			exitCondition, err := health.CheckObservabilityProbes(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "ObservabilityProbesFailing", "Observability probes are failing: frontend")))
+		})
+
+		It("should return false if results are missing for probes", func() {
+			DeferCleanup(test.WithVar(&ObservabilityProbeResults, func(_ context.Context, _ string) (map[string]bool, error) {
+				return map[string]bool{"frontend": true}, nil
+			}))
+
+			exitCondition, err := health.CheckObservabilityProbes(ctx, condition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCondition).To(PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionFalse, "ObservabilityProbesPending", "No results available yet for observability probes: database")))
+		})
+
+		It("should return an error if the results cannot be retrieved", func() {
+			DeferCleanup(test.WithVar(&ObservabilityProbeResults, func(_ context.Context, _ string) (map[string]bool, error) {
+				return nil, fmt.Errorf("fake")
+			}))
+
+			exitCondition, err := health.CheckObservabilityProbes(ctx, condition)
+			Expect(err).To(MatchError("fake"))
+			Expect(exitCondition).To(BeNil())
+		})
+	})
 })
 
 func newNode(labels labels.Set, annotations map[string]string, kubeletVersion string) corev1.Node {
@@ -872,3 +1153,15 @@ func beConditionWithStatus(status gardencorev1beta1.ConditionStatus) types.Gomeg
 func beConditionWithStatusAndMsg(status gardencorev1beta1.ConditionStatus, reason, message string) types.GomegaMatcher {
 	return And(WithStatus(status), WithReason(reason), WithMessage(message))
 }
+
+type fakeResponseWrapper struct {
+	err error
+}
+
+func (f *fakeResponseWrapper) DoRaw(context.Context) ([]byte, error) {
+	return nil, f.err
+}
+
+func (f *fakeResponseWrapper) Stream(context.Context) (io.ReadCloser, error) {
+	return nil, f.err
+}
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var (
+	// scaleDownOrder is the order in which the controllers are scaled down when too many node leases are expired.
+	// machine-controller-manager and cluster-autoscaler are scaled down first so that they do not replace nodes which
+	// are only considered unhealthy because the kubelets cannot reach the kube-apiserver.
+	scaleDownOrder = []string{
+		v1beta1constants.DeploymentNameMachineControllerManager,
+		v1beta1constants.DeploymentNameClusterAutoscaler,
+		v1beta1constants.DeploymentNameKubeControllerManager,
+	}
+	// scaleUpOrder is the order in which the controllers are scaled up again once the node leases are renewed.
+	// kube-controller-manager is scaled up first so that it can update the status of the nodes before
+	// machine-controller-manager and cluster-autoscaler act on them.
+	scaleUpOrder = []string{
+		v1beta1constants.DeploymentNameKubeControllerManager,
+		v1beta1constants.DeploymentNameMachineControllerManager,
+		v1beta1constants.DeploymentNameClusterAutoscaler,
+	}
+)
+
+// NodeLeaseProbe contains required information for probing the node leases of a shoot cluster. If too many node leases
+// are expired, e.g., because the kubelets cannot reach the kube-apiserver via its external endpoint, the controllers
+// which would act on the unhealthy nodes are scaled down to prevent a melt-down of the cluster. They are scaled up
+// again as soon as the node leases are renewed.
+type NodeLeaseProbe struct {
+	log                    logr.Logger
+	shoot                  *shoot.Shoot
+	seedClient             client.Client
+	initializeShootClients ShootClientInit
+	clock                  clock.Clock
+	config                 gardenletconfig.NodeLeaseProbe
+}
+
+// NewNodeLeaseProbe creates a new instance for probing the node leases of a shoot cluster.
+func NewNodeLeaseProbe(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	shootClientInit ShootClientInit,
+	clock clock.Clock,
+	config gardenletconfig.NodeLeaseProbe,
+) *NodeLeaseProbe {
+	return &NodeLeaseProbe{
+		log:                    log,
+		shoot:                  shoot,
+		seedClient:             seedClient,
+		initializeShootClients: shootClientInit,
+		clock:                  clock,
+		config:                 config,
+	}
+}
+
+// Probe checks the node leases of the shoot cluster and scales the controllers down or up accordingly.
+func (p *NodeLeaseProbe) Probe(ctx context.Context) error {
+	if !p.shouldProbe() {
+		return nil
+	}
+
+	shootClient, apiServerRunning, err := p.initializeShootClients()
+	if err != nil {
+		return err
+	}
+	if !apiServerRunning {
+		// Without a running kube-apiserver, the controllers cannot act on the nodes anyway.
+		return nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, ptr.Deref(p.config.Timeout, metav1.Duration{}).Duration)
+	defer cancel()
+
+	leaseList := &coordinationv1.LeaseList{}
+	if err := shootClient.Client().List(timeoutCtx, leaseList, client.InNamespace(corev1.NamespaceNodeLease)); err != nil {
+		// If the kube-apiserver is not reachable via its in-cluster endpoint, then the controllers are not able to act
+		// on the nodes either, hence there is nothing to do.
+		return fmt.Errorf("failed listing node leases: %w", err)
+	}
+
+	var (
+		gracePeriod   = ptr.Deref(p.config.NodeMonitorGracePeriod, metav1.Duration{}).Duration
+		now           = p.clock.Now()
+		nodeLeases    int
+		expiredLeases int
+	)
+
+	for _, lease := range leaseList.Items {
+		if !isNodeLease(lease) {
+			continue
+		}
+
+		nodeLeases++
+		if lease.Spec.RenewTime == nil || lease.Spec.RenewTime.Add(gracePeriod).Before(now) {
+			expiredLeases++
+		}
+	}
+
+	if nodeLeases > 0 && 100*expiredLeases >= int(ptr.Deref(p.config.FailurePercentage, 100))*nodeLeases {
+		p.log.Info("Too many node leases are expired, scaling down controllers", "expiredLeases", expiredLeases, "nodeLeases", nodeLeases)
+		return p.scaleDown(ctx)
+	}
+
+	return p.scaleUp(ctx)
+}
+
+// shouldProbe returns false if the controllers of the shoot are scaled by other means, e.g., during hibernation or
+// while the shoot is being created, migrated or deleted.
+func (p *NodeLeaseProbe) shouldProbe() bool {
+	shoot := p.shoot.GetInfo()
+
+	if p.shoot.IsWorkerless || p.shoot.HibernationEnabled || shoot.Status.IsHibernated || shoot.DeletionTimestamp != nil {
+		return false
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil {
+		return false
+	}
+
+	switch lastOperation.Type {
+	case gardencorev1beta1.LastOperationTypeCreate, gardencorev1beta1.LastOperationTypeRestore:
+		return lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+	case gardencorev1beta1.LastOperationTypeMigrate, gardencorev1beta1.LastOperationTypeDelete:
+		return false
+	}
+
+	return true
+}
+
+func (p *NodeLeaseProbe) scaleDown(ctx context.Context) error {
+	for _, name := range scaleDownOrder {
+		deployment, err := p.getDeployment(ctx, name)
+		if err != nil {
+			return err
+		}
+		if deployment == nil {
+			continue
+		}
+
+		if _, ok := deployment.Annotations[v1beta1constants.AnnotationScaledDownReplicas]; ok {
+			continue
+		}
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+		metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, v1beta1constants.AnnotationScaledDownReplicas, strconv.Itoa(int(ptr.Deref(deployment.Spec.Replicas, 1))))
+		deployment.Spec.Replicas = ptr.To[int32](0)
+		if err := p.seedClient.Patch(ctx, deployment, patch); err != nil {
+			return fmt.Errorf("failed scaling down deployment %s: %w", name, err)
+		}
+
+		p.log.Info("Scaled down deployment", "deploymentName", name)
+	}
+
+	return nil
+}
+
+func (p *NodeLeaseProbe) scaleUp(ctx context.Context) error {
+	for _, name := range scaleUpOrder {
+		deployment, err := p.getDeployment(ctx, name)
+		if err != nil {
+			return err
+		}
+		if deployment == nil {
+			continue
+		}
+
+		value, ok := deployment.Annotations[v1beta1constants.AnnotationScaledDownReplicas]
+		if !ok {
+			continue
+		}
+
+		patch := client.MergeFrom(deployment.DeepCopy())
+		delete(deployment.Annotations, v1beta1constants.AnnotationScaledDownReplicas)
+		// Only restore the replicas if the deployment was not scaled up by other means in the meantime.
+		if ptr.Deref(deployment.Spec.Replicas, 0) == 0 {
+			replicas, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				p.log.Error(err, "Invalid value of annotation, restoring one replica", "deploymentName", name, "annotation", v1beta1constants.AnnotationScaledDownReplicas)
+				replicas = 1
+			}
+			deployment.Spec.Replicas = ptr.To(int32(replicas))
+		}
+		if err := p.seedClient.Patch(ctx, deployment, patch); err != nil {
+			return fmt.Errorf("failed scaling up deployment %s: %w", name, err)
+		}
+
+		p.log.Info("Scaled up deployment", "deploymentName", name)
+	}
+
+	return nil
+}
+
+// getDeployment returns the deployment with the given name in the control plane namespace of the shoot or nil if it
+// does not exist, e.g., cluster-autoscaler is only deployed if at least one worker pool has min < max.
+func (p *NodeLeaseProbe) getDeployment(ctx context.Context, name string) (*appsv1.Deployment, error) {
+	deployment := &appsv1.Deployment{}
+	if err := p.seedClient.Get(ctx, client.ObjectKey{Namespace: p.shoot.SeedNamespace, Name: name}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading deployment %s: %w", name, err)
+	}
+	return deployment, nil
+}
+
+// isNodeLease returns true if the given lease is maintained by a kubelet, i.e., it is owned by a node and not a lease of
+// gardener-node-agent.
+func isNodeLease(lease coordinationv1.Lease) bool {
+	if strings.HasPrefix(lease.Name, gardenerutils.NodeLeasePrefix) {
+		return false
+	}
+
+	for _, ownerReference := range lease.OwnerReferences {
+		if ownerReference.APIVersion == corev1.SchemeGroupVersion.String() && ownerReference.Kind == "Node" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("NodeLeaseProbe", func() {
+	const (
+		namespace  = "shoot--foo--bar"
+		annotation = "care.gardener.cloud/scaled-down-replicas"
+	)
+
+	var (
+		ctx = context.Background()
+
+		seedClient      client.Client
+		shootClient     client.Client
+		shootClientInit ShootClientInit
+		fakeClock       *testclock.FakeClock
+		config          gardenletconfig.NodeLeaseProbe
+
+		shoot *shootpkg.Shoot
+		probe *NodeLeaseProbe
+
+		deploymentKCM *appsv1.Deployment
+		deploymentMCM *appsv1.Deployment
+		deploymentCA  *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+		}
+		fakeClock = testclock.NewFakeClock(time.Now())
+		config = gardenletconfig.NodeLeaseProbe{
+			Timeout:                &metav1.Duration{Duration: 10 * time.Second},
+			NodeMonitorGracePeriod: &metav1.Duration{Duration: 40 * time.Second},
+			FailurePercentage:      ptr.To[int32](60),
+		}
+
+		shoot = &shootpkg.Shoot{SeedNamespace: namespace}
+		shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Status: gardencorev1beta1.ShootStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		})
+
+		deploymentKCM = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}}
+		deploymentMCM = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: namespace}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)}}
+		deploymentCA = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "cluster-autoscaler", Namespace: namespace}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)}}
+	})
+
+	JustBeforeEach(func() {
+		probe = NewNodeLeaseProbe(logr.Discard(), shoot, seedClient, shootClientInit, fakeClock, config)
+	})
+
+	createLeases := func(renewed, expired int) {
+		for i := 0; i < renewed+expired; i++ {
+			renewTime := fakeClock.Now()
+			if i >= renewed {
+				renewTime = renewTime.Add(-time.Minute)
+			}
+
+			name := "node-" + string(rune('a'+i))
+			Expect(shootClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       "kube-node-lease",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Node", Name: name}},
+				},
+				Spec: coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: renewTime}},
+			})).To(Succeed())
+		}
+	}
+
+	expectReplicas := func(deployment *appsv1.Deployment, replicas int32, annotationValue *string) {
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		ExpectWithOffset(1, deployment.Spec.Replicas).To(PointTo(Equal(replicas)))
+		if annotationValue == nil {
+			ExpectWithOffset(1, deployment.Annotations).NotTo(HaveKey(annotation))
+		} else {
+			ExpectWithOffset(1, deployment.Annotations).To(HaveKeyWithValue(annotation, *annotationValue))
+		}
+	}
+
+	Context("deployments are running", func() {
+		BeforeEach(func() {
+			Expect(seedClient.Create(ctx, deploymentKCM)).To(Succeed())
+			Expect(seedClient.Create(ctx, deploymentMCM)).To(Succeed())
+			Expect(seedClient.Create(ctx, deploymentCA)).To(Succeed())
+		})
+
+		It("should scale down the controllers if too many node leases are expired", func() {
+			createLeases(1, 2)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 0, ptr.To("1"))
+			expectReplicas(deploymentMCM, 0, ptr.To("1"))
+			expectReplicas(deploymentCA, 0, ptr.To("2"))
+		})
+
+		It("should not scale down the controllers if only few node leases are expired", func() {
+			createLeases(2, 1)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 1, nil)
+			expectReplicas(deploymentMCM, 1, nil)
+			expectReplicas(deploymentCA, 2, nil)
+		})
+
+		It("should ignore leases which are not maintained by kubelets", func() {
+			createLeases(1, 0)
+			Expect(shootClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-node-a", Namespace: "kube-node-lease", OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Node", Name: "node-a"}}},
+			})).To(Succeed())
+			Expect(shootClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "kube-node-lease"},
+			})).To(Succeed())
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 1, nil)
+		})
+
+		It("should not scale down the controllers if the shoot is hibernated", func() {
+			shoot.HibernationEnabled = true
+			createLeases(0, 3)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 1, nil)
+		})
+
+		It("should not scale down the controllers if the shoot is being created", func() {
+			shoot.GetInfo().Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeCreate,
+				State: gardencorev1beta1.LastOperationStateProcessing,
+			}
+			createLeases(0, 3)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 1, nil)
+		})
+
+		It("should do nothing if the kube-apiserver is not running", func() {
+			shootClientInit = func() (kubernetes.Interface, bool, error) {
+				return nil, false, nil
+			}
+			probe = NewNodeLeaseProbe(logr.Discard(), shoot, seedClient, shootClientInit, fakeClock, config)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 1, nil)
+		})
+
+		It("should return the error if the shoot client cannot be initialized", func() {
+			fakeErr := errors.New("fake")
+			probe = NewNodeLeaseProbe(logr.Discard(), shoot, seedClient, func() (kubernetes.Interface, bool, error) {
+				return nil, false, fakeErr
+			}, fakeClock, config)
+
+			Expect(probe.Probe(ctx)).To(MatchError(fakeErr))
+		})
+	})
+
+	Context("deployments are scaled down", func() {
+		BeforeEach(func() {
+			deploymentKCM.Spec.Replicas = ptr.To[int32](0)
+			metav1.SetMetaDataAnnotation(&deploymentKCM.ObjectMeta, annotation, "1")
+			deploymentMCM.Spec.Replicas = ptr.To[int32](0)
+			metav1.SetMetaDataAnnotation(&deploymentMCM.ObjectMeta, annotation, "1")
+
+			Expect(seedClient.Create(ctx, deploymentKCM)).To(Succeed())
+			Expect(seedClient.Create(ctx, deploymentMCM)).To(Succeed())
+		})
+
+		It("should scale up the controllers once the node leases are renewed", func() {
+			createLeases(3, 0)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 1, nil)
+			expectReplicas(deploymentMCM, 1, nil)
+		})
+
+		It("should keep the controllers scaled down while too many node leases are expired", func() {
+			createLeases(0, 3)
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 0, ptr.To("1"))
+			expectReplicas(deploymentMCM, 0, ptr.To("1"))
+		})
+
+		It("should not overwrite the replicas if the deployment was scaled up in the meantime", func() {
+			patch := client.MergeFrom(deploymentKCM.DeepCopy())
+			deploymentKCM.Spec.Replicas = ptr.To[int32](3)
+			Expect(seedClient.Patch(ctx, deploymentKCM, patch)).To(Succeed())
+
+			Expect(probe.Probe(ctx)).To(Succeed())
+
+			expectReplicas(deploymentKCM, 3, nil)
+			expectReplicas(deploymentMCM, 1, nil)
+		})
+	})
+})
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// ComputePathMTU returns the smallest path MTU between the seed and the nodes of the shoot as reported by
+// gardener-node-agent. It returns nil if no node reports a path MTU yet.
+func ComputePathMTU(ctx context.Context, shootClient client.Client) (*int32, error) {
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return nil, err
+	}
+
+	var pathMTU *int32
+	for _, node := range nodeList.Items {
+		value, ok := node.Annotations[nodeagentv1alpha1.AnnotationKeyPathMTU]
+		if !ok {
+			continue
+		}
+
+		mtu, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || mtu <= 0 {
+			continue
+		}
+
+		if pathMTU == nil || int32(mtu) < *pathMTU {
+			pathMTU = ptr.To(int32(mtu))
+		}
+	}
+
+	return pathMTU, nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("PathMTU", func() {
+	var (
+		ctx         = context.Background()
+		shootClient client.Client
+	)
+
+	BeforeEach(func() {
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+	})
+
+	createNode := func(name string, annotations map[string]string) {
+		ExpectWithOffset(1, shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}})).To(Succeed())
+	}
+
+	It("should return nil if there are no nodes", func() {
+		Expect(ComputePathMTU(ctx, shootClient)).To(BeNil())
+	})
+
+	It("should return nil if no node reports a path MTU", func() {
+		createNode("node1", nil)
+		createNode("node2", map[string]string{"node-agent.gardener.cloud/path-mtu": "invalid"})
+
+		Expect(ComputePathMTU(ctx, shootClient)).To(BeNil())
+	})
+
+	It("should return the smallest path MTU reported by the nodes", func() {
+		createNode("node1", map[string]string{"node-agent.gardener.cloud/path-mtu": "1500"})
+		createNode("node2", map[string]string{"node-agent.gardener.cloud/path-mtu": "1380"})
+		createNode("node3", nil)
+		createNode("node4", map[string]string{"node-agent.gardener.cloud/path-mtu": "0"})
+
+		Expect(ComputePathMTU(ctx, shootClient)).To(Equal(ptr.To[int32](1380)))
+	})
+})
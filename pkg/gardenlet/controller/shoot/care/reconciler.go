@@ -10,7 +10,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -19,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
@@ -42,6 +45,14 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewNodeLeaseProber is used to create a new node lease probe instance.
+	NewNodeLeaseProber = defaultNewNodeLeaseProber
+	// ObservabilityProbeResults is used to retrieve the results of the observability probes of a shoot.
+	ObservabilityProbeResults = defaultObservabilityProbeResults
+	// ETCDMetrics is used to retrieve the metrics of the etcds of a shoot.
+	ETCDMetrics = defaultETCDMetrics
+	// PathMTU is used to retrieve the path MTU between the seed and the nodes of a shoot.
+	PathMTU = defaultPathMTU
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -129,6 +140,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		updatedETCDStatus                     *gardencorev1beta1.ShootETCDStatus
+		updatedWorkersRollout                 []gardencorev1beta1.WorkerPoolRollout
+		workersRolloutComputed                bool
+		updatedPathMTU                        *int32
 	)
 
 	if err := flow.Parallel(
@@ -143,12 +158,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				initializeShootClients,
 				r.Clock,
 				&r.Config,
-				r.conditionThresholdsToProgressingMapping(),
+				r.conditionThresholdsToProgressingMapping(log, shoot),
+				r.conditionStaleDurations(log, shoot),
 			).Check(
 				ctx,
 				staleExtensionHealthCheckThreshold,
 				shootConditions,
 			)
+			updatedConditions = ApplyConditionSeverities(r.Clock, shootConditions.ConvertToSlice(), updatedConditions, r.conditionSeverities(log, shoot))
 			return nil
 		},
 		// Trigger constraint checks
@@ -171,11 +188,64 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// errors during garbage collection are only being logged and do not cause the care operation to fail
 			return nil
 		},
-		// Trigger webhook remediation
+		// Trigger remediation of problematic configurations
 		func(ctx context.Context) error {
-			if ptr.Deref(r.Config.Controllers.ShootCare.WebhookRemediatorEnabled, false) {
-				_ = NewWebhookRemediator(log, shoot, initializeShootClients).Remediate(ctx)
-				// errors during webhook remediation are only being logged and do not cause the care operation to fail
+			if err := NewWebhookRemediator(log, shoot, initializeShootClients, r.Clock, r.remediations()).Remediate(ctx); err != nil {
+				// errors during remediation are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error during remediation of problematic configurations")
+			}
+			return nil
+		},
+		// Trigger node lease probe
+		func(ctx context.Context) error {
+			if r.Config.Controllers.ShootCare.NodeLeaseProbe == nil || !v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(o.Seed.GetInfo().Spec.Settings) {
+				return nil
+			}
+
+			if err := NewNodeLeaseProber(log, o.Shoot, r.SeedClientSet.Client(), initializeShootClients, r.Clock, *r.Config.Controllers.ShootCare.NodeLeaseProbe).Probe(ctx); err != nil {
+				// errors during the node lease probe are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error during node lease probe")
+			}
+			return nil
+		},
+		// Update information about the etcds
+		func(ctx context.Context) error {
+			if o.Shoot.Purpose == gardencorev1beta1.ShootPurposeTesting || !gardenlethelper.IsMonitoringEnabled(&r.Config) || o.Shoot.HibernationEnabled || shoot.Status.IsHibernated {
+				return nil
+			}
+
+			var err error
+			if updatedETCDStatus, err = ComputeETCDStatus(ctx, r.Clock, shoot, o.Shoot.SeedNamespace); err != nil {
+				// errors when computing the etcd status are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error when computing the etcd status")
+			}
+			return nil
+		},
+		// Update information about the rollout of the worker pools
+		func(ctx context.Context) error {
+			if v1beta1helper.IsWorkerless(shoot) {
+				return nil
+			}
+
+			var err error
+			if updatedWorkersRollout, err = ComputeWorkersRollout(ctx, r.SeedClientSet.Client(), shoot, o.Shoot.SeedNamespace); err != nil {
+				// errors when computing the workers rollout are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error when computing the workers rollout")
+				return nil
+			}
+			workersRolloutComputed = true
+			return nil
+		},
+		// Update information about the path MTU between the seed and the nodes
+		func(ctx context.Context) error {
+			if v1beta1helper.IsWorkerless(shoot) || o.Shoot.HibernationEnabled || shoot.Status.IsHibernated {
+				return nil
+			}
+
+			var err error
+			if updatedPathMTU, err = PathMTU(ctx, initializeShootClients); err != nil {
+				// errors when computing the path MTU are only being logged and do not cause the care operation to fail
+				log.Error(err, "Error when computing the path MTU")
 			}
 			return nil
 		},
@@ -198,14 +268,101 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
+	if updatedETCDStatus != nil {
+		log.V(1).Info("Updating etcd status")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.ETCD = updatedETCDStatus
+		if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+			log.Error(err, "Error when trying to update the etcd status")
+			return reconcile.Result{}, err
+		}
+	}
+
+	if workersRolloutComputed && !apiequality.Semantic.DeepEqual(shoot.Status.WorkersRollout, updatedWorkersRollout) {
+		log.V(1).Info("Updating workers rollout")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.WorkersRollout = updatedWorkersRollout
+		if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+			log.Error(err, "Error when trying to update the workers rollout")
+			return reconcile.Result{}, err
+		}
+	}
+
+	// The path MTU is kept if no node reports it (anymore), e.g., during rolling updates of the worker pools.
+	if updatedPathMTU != nil && (shoot.Status.Networking == nil || !ptr.Equal(shoot.Status.Networking.PathMTU, updatedPathMTU)) {
+		log.V(1).Info("Updating path MTU", "pathMTU", *updatedPathMTU)
+		patch := client.MergeFrom(shoot.DeepCopy())
+		if shoot.Status.Networking == nil {
+			shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{}
+		}
+		shoot.Status.Networking.PathMTU = updatedPathMTU
+		if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+			log.Error(err, "Error when trying to update the path MTU")
+			return reconcile.Result{}, err
+		}
+	}
+
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
 }
 
-func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
+func (r *Reconciler) conditionThresholdsToProgressingMapping(log logr.Logger, shoot *gardencorev1beta1.Shoot) map[gardencorev1beta1.ConditionType]time.Duration {
 	out := make(map[gardencorev1beta1.ConditionType]time.Duration)
 	for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholds {
 		out[gardencorev1beta1.ConditionType(threshold.Type)] = threshold.Duration.Duration
 	}
+	mergeConditionOverrides(log, out, shoot, v1beta1constants.AnnotationShootCareConditionThresholds, gardenerutils.ParseCareConditionDurations)
+	return out
+}
+
+func (r *Reconciler) conditionStaleDurations(log logr.Logger, shoot *gardencorev1beta1.Shoot) map[gardencorev1beta1.ConditionType]time.Duration {
+	out := make(map[gardencorev1beta1.ConditionType]time.Duration)
+	for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholds {
+		if threshold.StaleDuration != nil {
+			out[gardencorev1beta1.ConditionType(threshold.Type)] = threshold.StaleDuration.Duration
+		}
+	}
+	mergeConditionOverrides(log, out, shoot, v1beta1constants.AnnotationShootCareConditionStaleDurations, gardenerutils.ParseCareConditionDurations)
+	return out
+}
+
+func (r *Reconciler) conditionSeverities(log logr.Logger, shoot *gardencorev1beta1.Shoot) map[gardencorev1beta1.ConditionType]string {
+	out := make(map[gardencorev1beta1.ConditionType]string)
+	for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholds {
+		if threshold.Severity != nil {
+			out[gardencorev1beta1.ConditionType(threshold.Type)] = *threshold.Severity
+		}
+	}
+	mergeConditionOverrides(log, out, shoot, v1beta1constants.AnnotationShootCareConditionSeverities, gardenerutils.ParseCareConditionSeverities)
+	return out
+}
+
+// mergeConditionOverrides merges the per-condition values configured via the given annotation of the shoot into the
+// given map. Invalid annotation values are ignored, i.e., the configuration of the gardenlet is used.
+func mergeConditionOverrides[T any](log logr.Logger, out map[gardencorev1beta1.ConditionType]T, shoot *gardencorev1beta1.Shoot, annotation string, parse func(string) (map[gardencorev1beta1.ConditionType]T, error)) {
+	value, ok := shoot.Annotations[annotation]
+	if !ok {
+		return
+	}
+
+	overrides, err := parse(value)
+	if err != nil {
+		log.Error(err, "Ignoring invalid annotation", "annotation", annotation)
+		return
+	}
+
+	for conditionType, v := range overrides {
+		out[conditionType] = v
+	}
+}
+
+func (r *Reconciler) remediations() config.ShootCareRemediations {
+	var out config.ShootCareRemediations
+	if r.Config.Controllers.ShootCare.Remediations != nil {
+		out = *r.Config.Controllers.ShootCare.Remediations.DeepCopy()
+	}
+	if out.WebhookBestPractices == nil {
+		out.WebhookBestPractices = r.Config.Controllers.ShootCare.WebhookRemediatorEnabled
+	}
 	return out
 }
 
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -25,6 +26,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
@@ -190,18 +192,20 @@ var _ = Describe("Shoot Care Control", func() {
 		Context("when health check setup is successful", func() {
 			var (
 				shootClientMap clientmap.ClientMap
+				seedClient     client.Client
 				managedSeed    *seedmanagementv1alpha1.ManagedSeed
 				operationFunc  NewOperationFunc
 			)
 
 			JustBeforeEach(func() {
 				shootClientMap = fakeclientmap.NewClientMapBuilder().Build()
+				seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
 
 				op := &operation.Operation{
 					GardenClient:  gardenClient,
 					SeedClientSet: kubernetesfake.NewClientSetBuilder().Build(),
 					ManagedSeed:   managedSeed,
-					Shoot:         &shootpkg.Shoot{},
+					Shoot:         &shootpkg.Shoot{SeedNamespace: "shoot--foo--bar"},
 					Logger:        logr.Discard(),
 				}
 				op.Shoot.SetInfo(shoot)
@@ -210,10 +214,11 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&PathMTU, pathMTUFunc(nil),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
-					SeedClientSet:  kubernetesfake.NewClientSet(),
+					SeedClientSet:  kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
 					ShootClientMap: shootClientMap,
 					Config:         gardenletConf,
 					Clock:          fakeClock,
@@ -266,6 +271,42 @@ var _ = Describe("Shoot Care Control", func() {
 					Expect(updatedShoot.Status.Conditions).To(BeEmpty())
 					Expect(updatedShoot.Status.Constraints).To(BeEmpty())
 				})
+
+				It("should update the workers rollout", func() {
+					Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+						ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: "shoot--foo--bar"},
+						Status: extensionsv1alpha1.WorkerStatus{
+							WorkersRollout: []extensionsv1alpha1.WorkerPoolRollout{{Name: "pool1", MachinesUpdated: 1, MachinesPending: 2}},
+						},
+					})).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.WorkersRollout).To(ConsistOf(gardencorev1beta1.WorkerPoolRollout{Name: "pool1", MachinesUpdated: 1, MachinesPending: 2}))
+				})
+
+				It("should update the path MTU", func() {
+					DeferCleanup(test.WithVar(&PathMTU, pathMTUFunc(ptr.To[int32](1400))))
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Networking).To(Equal(&gardencorev1beta1.NetworkingStatus{PathMTU: ptr.To[int32](1400)}))
+				})
+
+				It("should keep the path MTU if it is not known", func() {
+					shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{PathMTU: ptr.To[int32](1400)}
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Networking).To(Equal(&gardencorev1beta1.NetworkingStatus{PathMTU: ptr.To[int32](1400)}))
+				})
 			})
 
 			Context("when conditions / constraints are returned unchanged", func() {
@@ -498,6 +539,90 @@ var _ = Describe("Shoot Care Control", func() {
 					})
 				})
 			})
+
+			Context("when condition settings are configured", func() {
+				var conditionThresholds, staleDurations map[gardencorev1beta1.ConditionType]time.Duration
+
+				BeforeEach(func() {
+					gardenletConf.Controllers.ShootCare.ConditionThresholds = []gardenletconfig.ConditionThreshold{
+						{Type: "APIServerAvailable", Duration: metav1.Duration{Duration: time.Minute}, StaleDuration: &metav1.Duration{Duration: 2 * time.Minute}},
+						{Type: "ControlPlaneHealthy", Duration: metav1.Duration{Duration: time.Minute}, Severity: ptr.To("Error")},
+						{Type: "SystemComponentsHealthy", Duration: metav1.Duration{Duration: time.Minute}, Severity: ptr.To("Warning")},
+					}
+					shoot.Annotations = map[string]string{
+						"care.gardener.cloud/condition-thresholds":      "ControlPlaneHealthy=5m,EveryNodeReady=10m",
+						"care.gardener.cloud/condition-stale-durations": "EveryNodeReady=15m",
+						"care.gardener.cloud/condition-severities":      "ControlPlaneHealthy=Warning",
+					}
+					shoot.Status = gardencorev1beta1.ShootStatus{
+						LastOperation: &gardencorev1beta1.LastOperation{
+							Type:  gardencorev1beta1.LastOperationTypeReconcile,
+							State: gardencorev1beta1.LastOperationStateSucceeded,
+						},
+					}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, NewHealthCheckFunc(func(
+							_ logr.Logger,
+							_ *shootpkg.Shoot,
+							_ *seedpkg.Seed,
+							_ kubernetes.Interface,
+							_ client.Client,
+							_ ShootClientInit,
+							_ clock.Clock,
+							_ *gardenletconfig.GardenletConfiguration,
+							thresholds map[gardencorev1beta1.ConditionType]time.Duration,
+							stale map[gardencorev1beta1.ConditionType]time.Duration,
+						) HealthCheck {
+							conditionThresholds, staleDurations = thresholds, stale
+							return resultingConditionFunc(func(_ ShootConditions) []gardencorev1beta1.Condition {
+								return []gardencorev1beta1.Condition{
+									{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionFalse, Reason: "foo"},
+									{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionFalse, Reason: "bar"},
+									{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionFalse, Reason: "baz"},
+								}
+							})
+						}),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+					))
+				})
+
+				It("should merge the configuration with the overrides of the shoot", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(conditionThresholds).To(Equal(map[gardencorev1beta1.ConditionType]time.Duration{
+						gardencorev1beta1.ShootAPIServerAvailable:      time.Minute,
+						gardencorev1beta1.ShootControlPlaneHealthy:     5 * time.Minute,
+						gardencorev1beta1.ShootSystemComponentsHealthy: time.Minute,
+						gardencorev1beta1.ShootEveryNodeReady:          10 * time.Minute,
+					}))
+					Expect(staleDurations).To(Equal(map[gardencorev1beta1.ConditionType]time.Duration{
+						gardencorev1beta1.ShootAPIServerAvailable: 2 * time.Minute,
+						gardencorev1beta1.ShootEveryNodeReady:     15 * time.Minute,
+					}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Conditions).To(ConsistOf(
+						And(HaveField("Type", gardencorev1beta1.ShootAPIServerAvailable), HaveField("Status", gardencorev1beta1.ConditionFalse)),
+						And(HaveField("Type", gardencorev1beta1.ShootControlPlaneHealthy), HaveField("Status", gardencorev1beta1.ConditionProgressing), HaveField("Reason", "bar")),
+						And(HaveField("Type", gardencorev1beta1.ShootSystemComponentsHealthy), HaveField("Status", gardencorev1beta1.ConditionProgressing), HaveField("Reason", "baz")),
+					))
+				})
+
+				It("should ignore invalid overrides of the shoot", func() {
+					shoot.Annotations["care.gardener.cloud/condition-thresholds"] = "foo"
+					Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(conditionThresholds).To(Equal(map[gardencorev1beta1.ConditionType]time.Duration{
+						gardencorev1beta1.ShootAPIServerAvailable:      time.Minute,
+						gardencorev1beta1.ShootControlPlaneHealthy:     time.Minute,
+						gardencorev1beta1.ShootSystemComponentsHealthy: time.Minute,
+					}))
+				})
+			})
 		})
 	})
 })
@@ -519,6 +644,7 @@ func healthCheckFunc(fn resultingConditionFunc) NewHealthCheckFunc {
 		_ clock.Clock,
 		_ *gardenletconfig.GardenletConfiguration,
 		_ map[gardencorev1beta1.ConditionType]time.Duration,
+		_ map[gardencorev1beta1.ConditionType]time.Duration,
 	) HealthCheck {
 		return fn
 	}
@@ -568,6 +694,12 @@ func nopGarbageCollectorFunc() NewGarbageCollectorFunc {
 	}
 }
 
+func pathMTUFunc(pathMTU *int32) PathMTUFunc {
+	return func(_ context.Context, _ ShootClientInit) (*int32, error) {
+		return pathMTU, nil
+	}
+}
+
 func consistOfConditionsInUnknownStatus(message string, isWorkerless bool) types.GomegaMatcher {
 	var expectedLength = 4
 	matcher := And(
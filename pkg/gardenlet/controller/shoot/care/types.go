@@ -6,10 +6,16 @@ package care
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/go-logr/logr"
+	prometheusapi "github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,6 +23,8 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	controlplaneblackboxexporter "github.com/gardener/gardener/pkg/component/observability/monitoring/blackboxexporter/shoot/controlplane"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
@@ -39,6 +47,7 @@ type NewHealthCheckFunc func(
 	clock clock.Clock,
 	gardenletConfig *gardenletconfig.GardenletConfiguration,
 	conditionThresholds map[gardencorev1beta1.ConditionType]time.Duration,
+	staleDurations map[gardencorev1beta1.ConditionType]time.Duration,
 ) HealthCheck
 
 // defaultNewHealthCheck is the default function to create a new instance for performing health checks.
@@ -52,6 +61,7 @@ var defaultNewHealthCheck NewHealthCheckFunc = func(
 	clock clock.Clock,
 	gardenletConfig *gardenletconfig.GardenletConfiguration,
 	conditionThresholds map[gardencorev1beta1.ConditionType]time.Duration,
+	staleDurations map[gardencorev1beta1.ConditionType]time.Duration,
 ) HealthCheck {
 	return NewHealth(
 		log,
@@ -63,9 +73,110 @@ var defaultNewHealthCheck NewHealthCheckFunc = func(
 		clock,
 		gardenletConfig,
 		conditionThresholds,
+		staleDurations,
 	)
 }
 
+// ObservabilityProbeResultsFunc is a function used to retrieve the latest results of the observability probes of the
+// shoot in the given control plane namespace. The returned map contains whether a probe succeeded by its name.
+type ObservabilityProbeResultsFunc func(ctx context.Context, namespace string) (map[string]bool, error)
+
+// defaultObservabilityProbeResults is the default function to retrieve the results of the observability probes. It
+// queries the Prometheus instance of the shoot for the metrics exported by the blackbox-exporter.
+var defaultObservabilityProbeResults ObservabilityProbeResultsFunc = func(ctx context.Context, namespace string) (map[string]bool, error) {
+	prometheusClient, err := prometheusapi.NewClient(prometheusapi.Config{Address: fmt.Sprintf("http://prometheus-%s.%s.svc", shootprometheus.Label, namespace)})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating Prometheus client: %w", err)
+	}
+
+	value, _, err := prometheusv1.NewAPI(prometheusClient).Query(ctx, fmt.Sprintf(`probe_success{job=%q}`, controlplaneblackboxexporter.ProbesJobName), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed querying probe results: %w", err)
+	}
+
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %s of query result", value.Type())
+	}
+
+	results := make(map[string]bool, len(vector))
+	for _, sample := range vector {
+		results[string(sample.Metric[controlplaneblackboxexporter.ProbeLabel])] = sample.Value == 1
+	}
+	return results, nil
+}
+
+// ETCDMetricValues contains the values of the metrics of an etcd.
+type ETCDMetricValues struct {
+	// DBSize is the physically allocated size of the database.
+	DBSize *resource.Quantity
+	// DBSizeInUse is the logically used size of the database.
+	DBSizeInUse *resource.Quantity
+	// Defragmentations is the number of successful defragmentations within the queried window.
+	Defragmentations int
+}
+
+// ETCDMetricsFunc is a function used to retrieve the latest metrics of the etcds of the shoot in the given control plane
+// namespace. The returned map contains the metric values by the name of the etcd, e.g. `etcd-main`. Defragmentations
+// are counted within the given window.
+type ETCDMetricsFunc func(ctx context.Context, namespace string, window time.Duration) (map[string]ETCDMetricValues, error)
+
+// defaultETCDMetrics is the default function to retrieve the metrics of the etcds. It queries the Prometheus instance of
+// the shoot for the metrics exported by etcd and etcd-backup-restore.
+var defaultETCDMetrics ETCDMetricsFunc = func(ctx context.Context, namespace string, window time.Duration) (map[string]ETCDMetricValues, error) {
+	prometheusClient, err := prometheusapi.NewClient(prometheusapi.Config{Address: fmt.Sprintf("http://prometheus-%s.%s.svc", shootprometheus.Label, namespace)})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating Prometheus client: %w", err)
+	}
+
+	var (
+		api     = prometheusv1.NewAPI(prometheusClient)
+		now     = time.Now()
+		results = make(map[string]ETCDMetricValues)
+	)
+
+	query := func(query string, set func(values *ETCDMetricValues, value float64)) error {
+		value, _, err := api.Query(ctx, query, now)
+		if err != nil {
+			return fmt.Errorf("failed querying etcd metrics: %w", err)
+		}
+
+		vector, ok := value.(model.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %s of query result", value.Type())
+		}
+
+		for _, sample := range vector {
+			name := string(sample.Metric["role"])
+			values := results[name]
+			set(&values, float64(sample.Value))
+			results[name] = values
+		}
+		return nil
+	}
+
+	if err := query(`max by (role) (etcd_mvcc_db_total_size_in_bytes)`, func(values *ETCDMetricValues, value float64) {
+		values.DBSize = resource.NewQuantity(int64(value), resource.BinarySI)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := query(`max by (role) (etcd_mvcc_db_total_size_in_use_in_bytes)`, func(values *ETCDMetricValues, value float64) {
+		values.DBSizeInUse = resource.NewQuantity(int64(value), resource.BinarySI)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := query(fmt.Sprintf(`max by (role) (increase(etcdbr_defragmentation_duration_seconds_count{succeeded="true"}[%s]))`, model.Duration(window.Truncate(time.Second))), func(values *ETCDMetricValues, value float64) {
+		// Prometheus extrapolates the increase, hence it is not necessarily an integer.
+		values.Defragmentations = int(math.Round(value))
+	}); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // ConstraintCheck is an interface used to perform constraint checks.
 type ConstraintCheck interface {
 	Check(context.Context, ShootConstraints) []gardencorev1beta1.Condition
@@ -116,11 +227,50 @@ type WebhookRemediator interface {
 }
 
 // NewWebhookRemediatorFunc is a function used to create a new instance to perform webhook remediation.
-type NewWebhookRemediatorFunc func(op *operation.Operation, init ShootClientInit) WebhookRemediator
+type NewWebhookRemediatorFunc func(
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	init ShootClientInit,
+	clock clock.Clock,
+	remediations gardenletconfig.ShootCareRemediations,
+) WebhookRemediator
 
 // defaultNewWebhookRemediator is the default function to create a new instance to perform webhook remediation.
-var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit) WebhookRemediator {
-	return NewWebhookRemediation(log, shoot, init)
+var defaultNewWebhookRemediator NewWebhookRemediatorFunc = func(
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	init ShootClientInit,
+	clock clock.Clock,
+	remediations gardenletconfig.ShootCareRemediations,
+) WebhookRemediator {
+	return NewWebhookRemediation(log, shoot, init, clock, remediations)
+}
+
+// NodeLeaseProber is an interface used to probe the node leases of a shoot cluster.
+type NodeLeaseProber interface {
+	Probe(ctx context.Context) error
+}
+
+// NewNodeLeaseProberFunc is a function used to create a new instance to probe the node leases of a shoot cluster.
+type NewNodeLeaseProberFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	init ShootClientInit,
+	clock clock.Clock,
+	config gardenletconfig.NodeLeaseProbe,
+) NodeLeaseProber
+
+// defaultNewNodeLeaseProber is the default function to create a new instance to probe the node leases of a shoot cluster.
+var defaultNewNodeLeaseProber NewNodeLeaseProberFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	init ShootClientInit,
+	clock clock.Clock,
+	config gardenletconfig.NodeLeaseProbe,
+) NodeLeaseProber {
+	return NewNodeLeaseProbe(log, shoot, seedClient, init, clock, config)
 }
 
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
@@ -167,3 +317,21 @@ var defaultNewOperationFunc = func(
 		WithShootFromCluster(gardenClient, seedClientSet, shoot).
 		Build(ctx, gardenClient, seedClientSet, shootClientMap)
 }
+
+// PathMTUFunc is a function used to retrieve the smallest path MTU between the seed and the nodes of the shoot. It
+// returns nil if the path MTU is not known.
+type PathMTUFunc func(ctx context.Context, shootClientInit ShootClientInit) (*int32, error)
+
+// defaultPathMTU is the default function to retrieve the path MTU. It reads the values reported by gardener-node-agent
+// on the nodes of the shoot.
+var defaultPathMTU PathMTUFunc = func(ctx context.Context, shootClientInit ShootClientInit) (*int32, error) {
+	shootClient, apiServerRunning, err := shootClientInit()
+	if err != nil {
+		return nil, fmt.Errorf("failed initializing shoot client: %w", err)
+	}
+	if !apiServerRunning {
+		return nil, nil
+	}
+
+	return ComputePathMTU(ctx, shootClient.Client())
+}
@@ -6,44 +6,102 @@ package care
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	apiregistrationv1helper "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1/helper"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	webhookmatchers "github.com/gardener/gardener/pkg/gardenlet/operation/botanist/matchers"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// WebhookRemediation contains required information for shoot webhook remediation.
+// WebhookRemediation contains required information for the remediation of problematic configurations in shoot
+// clusters, e.g., webhooks not following the Kubernetes best practices.
 type WebhookRemediation struct {
 	log                    logr.Logger
 	initializeShootClients ShootClientInit
 	shoot                  *gardencorev1beta1.Shoot
+	clock                  clock.Clock
+	remediations           gardenletconfig.ShootCareRemediations
 }
 
 // NewWebhookRemediation creates a new instance for webhook remediation.
-func NewWebhookRemediation(log logr.Logger, shoot *gardencorev1beta1.Shoot, shootClientInit ShootClientInit) *WebhookRemediation {
+func NewWebhookRemediation(
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	shootClientInit ShootClientInit,
+	clock clock.Clock,
+	remediations gardenletconfig.ShootCareRemediations,
+) *WebhookRemediation {
 	return &WebhookRemediation{
 		log:                    log,
 		initializeShootClients: shootClientInit,
 		shoot:                  shoot,
+		clock:                  clock,
+		remediations:           remediations,
 	}
 }
 
-// Remediate mutates shoot webhooks not following the best practices documented by Kubernetes.
+// remediationPolicy detects and remediates a certain kind of problematic configuration in a shoot cluster.
+type remediationPolicy struct {
+	name      string
+	enabled   bool
+	remediate func(context.Context, client.Client) error
+}
+
+func (r *WebhookRemediation) policies() []remediationPolicy {
+	return []remediationPolicy{
+		// Orphaned webhooks are removed first, so that they are not mutated by the remediation of the best practices.
+		{
+			name:      "OrphanedKubeSystemWebhooks",
+			enabled:   ptr.Deref(r.remediations.OrphanedKubeSystemWebhooks, false),
+			remediate: r.remediateOrphanedKubeSystemWebhooks,
+		},
+		{
+			name:      "WebhookBestPractices",
+			enabled:   ptr.Deref(r.remediations.WebhookBestPractices, false),
+			remediate: r.remediateWebhookBestPractices,
+		},
+		{
+			name:      "UnavailableAPIServices",
+			enabled:   r.remediations.UnavailableAPIServices != nil && r.remediations.UnavailableAPIServices.Enabled,
+			remediate: r.remediateUnavailableAPIServices,
+		},
+	}
+}
+
+// Remediate detects and remediates problematic configurations in the shoot cluster according to the enabled
+// remediation policies.
 func (r *WebhookRemediation) Remediate(ctx context.Context) error {
+	var policies []remediationPolicy
+	for _, policy := range r.policies() {
+		if policy.enabled {
+			policies = append(policies, policy)
+		}
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
 	shootClient, apiServerRunning, err := r.initializeShootClients()
 	if err != nil {
 		return err
@@ -52,16 +110,34 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 		return nil
 	}
 
-	var (
-		fns []flow.TaskFn
+	var errs []error
+	for _, policy := range policies {
+		if err := policy.remediate(ctx, shootClient.Client()); err != nil {
+			errs = append(errs, fmt.Errorf("failed remediating with policy %s: %w", policy.name, err))
+		}
+	}
 
+	return errors.Join(errs...)
+}
+
+func webhookRemediationLabelSelector() client.MatchingLabelsSelector {
+	var (
 		notExcluded          = utils.MustNewRequirement(v1beta1constants.LabelExcludeWebhookFromRemediation, selection.NotIn, "true")
 		notManagedByGardener = utils.MustNewRequirement(resourcesv1alpha1.ManagedBy, selection.NotIn, resourcesv1alpha1.GardenerManager)
-		labelSelector        = client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(notExcluded).Add(notManagedByGardener)}
+	)
+	return client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(notExcluded).Add(notManagedByGardener)}
+}
+
+// remediateWebhookBestPractices mutates shoot webhooks not following the best practices documented by Kubernetes.
+func (r *WebhookRemediation) remediateWebhookBestPractices(ctx context.Context, shootClient client.Client) error {
+	var (
+		fns []flow.TaskFn
+
+		labelSelector = webhookRemediationLabelSelector()
 	)
 
 	validatingWebhookConfigs := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
-	if err := shootClient.Client().List(ctx, validatingWebhookConfigs, labelSelector); err != nil {
+	if err := shootClient.List(ctx, validatingWebhookConfigs, labelSelector); err != nil {
 		return fmt.Errorf("could not get ValidatingWebhookConfigurations of Shoot cluster to remediate problematic webhooks: %w", err)
 	}
 
@@ -107,12 +183,12 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 		}
 
 		if mustPatch {
-			fns = append(fns, newPatchFunc(shootClient.Client(), webhookConfig, patch, remediations))
+			fns = append(fns, newPatchFunc(shootClient, webhookConfig, patch, remediations))
 		}
 	}
 
 	mutatingWebhookConfigs := &admissionregistrationv1.MutatingWebhookConfigurationList{}
-	if err := shootClient.Client().List(ctx, mutatingWebhookConfigs, labelSelector); err != nil {
+	if err := shootClient.List(ctx, mutatingWebhookConfigs, labelSelector); err != nil {
 		return fmt.Errorf("could not get MutatingWebhookConfigurations of Shoot cluster to remediate problematic webhooks: %w", err)
 	}
 
@@ -158,13 +234,142 @@ func (r *WebhookRemediation) Remediate(ctx context.Context) error {
 		}
 
 		if mustPatch {
-			fns = append(fns, newPatchFunc(shootClient.Client(), webhookConfig, patch, remediations))
+			fns = append(fns, newPatchFunc(shootClient, webhookConfig, patch, remediations))
 		}
 	}
 
 	return flow.Parallel(fns...)(ctx)
 }
 
+// remediateOrphanedKubeSystemWebhooks removes mutating webhooks intercepting requests in the kube-system namespace
+// whose backing service does not exist. Such webhooks block the system components of the shoot cluster. Webhook
+// configurations without any remaining webhooks are deleted.
+func (r *WebhookRemediation) remediateOrphanedKubeSystemWebhooks(ctx context.Context, shootClient client.Client) error {
+	kubeSystem := &corev1.Namespace{}
+	if err := shootClient.Get(ctx, client.ObjectKey{Name: metav1.NamespaceSystem}, kubeSystem); err != nil {
+		return fmt.Errorf("could not get namespace %s: %w", metav1.NamespaceSystem, err)
+	}
+
+	mutatingWebhookConfigs := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := shootClient.List(ctx, mutatingWebhookConfigs, webhookRemediationLabelSelector()); err != nil {
+		return fmt.Errorf("could not get MutatingWebhookConfigurations of Shoot cluster to remediate orphaned webhooks: %w", err)
+	}
+
+	var fns []flow.TaskFn
+
+	for _, config := range mutatingWebhookConfigs.Items {
+		var (
+			webhookConfig = config.DeepCopy()
+			patch         = client.StrategicMergeFrom(webhookConfig.DeepCopy())
+			remediations  []string
+			webhooks      []admissionregistrationv1.MutatingWebhook
+		)
+
+		for _, w := range webhookConfig.Webhooks {
+			orphaned, err := isOrphanedKubeSystemWebhook(ctx, shootClient, kubeSystem, w.ClientConfig, w.NamespaceSelector)
+			if err != nil {
+				return err
+			}
+
+			if !orphaned {
+				webhooks = append(webhooks, w)
+				continue
+			}
+
+			remediate := newRemediator(r.log, "MutatingWebhookConfiguration", webhookConfig.Name, w.Name, &remediations)
+			remediate.removal(w.ClientConfig.Service)
+		}
+
+		if len(remediations) == 0 {
+			continue
+		}
+
+		if len(webhooks) == 0 {
+			r.log.Info("Deleting MutatingWebhookConfiguration since all its webhooks are orphaned", "webhookConfigName", webhookConfig.Name)
+			fns = append(fns, func(ctx context.Context) error {
+				return client.IgnoreNotFound(shootClient.Delete(ctx, webhookConfig))
+			})
+			continue
+		}
+
+		webhookConfig.Webhooks = webhooks
+		fns = append(fns, newPatchFunc(shootClient, webhookConfig, patch, remediations))
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+func isOrphanedKubeSystemWebhook(
+	ctx context.Context,
+	shootClient client.Client,
+	kubeSystem *corev1.Namespace,
+	clientConfig admissionregistrationv1.WebhookClientConfig,
+	namespaceSelector *metav1.LabelSelector,
+) (
+	bool,
+	error,
+) {
+	// The availability of webhooks served via URLs cannot be determined.
+	if clientConfig.Service == nil {
+		return false, nil
+	}
+
+	// A webhook without namespace selector intercepts requests in all namespaces.
+	if namespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+		if err != nil || !selector.Matches(labels.Set(kubeSystem.Labels)) {
+			return false, nil
+		}
+	}
+
+	if err := shootClient.Get(ctx, client.ObjectKey{Namespace: clientConfig.Service.Namespace, Name: clientConfig.Service.Name}, &corev1.Service{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("could not get service %s/%s of webhook: %w", clientConfig.Service.Namespace, clientConfig.Service.Name, err)
+	}
+
+	return false, nil
+}
+
+// remediateUnavailableAPIServices deletes aggregated APIServices which are unavailable for longer than the configured
+// threshold. Such APIServices break the discovery of the API server, which blocks the garbage collection and the
+// deletion of namespaces.
+func (r *WebhookRemediation) remediateUnavailableAPIServices(ctx context.Context, shootClient client.Client) error {
+	var (
+		notExcluded          = utils.MustNewRequirement(v1beta1constants.LabelExcludeAPIServiceFromRemediation, selection.NotIn, "true")
+		notManagedByGardener = utils.MustNewRequirement(resourcesv1alpha1.ManagedBy, selection.NotIn, resourcesv1alpha1.GardenerManager)
+		labelSelector        = client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(notExcluded).Add(notManagedByGardener)}
+		threshold            = ptr.Deref(r.remediations.UnavailableAPIServices.Threshold, metav1.Duration{Duration: time.Hour}).Duration
+	)
+
+	apiServiceList := &apiregistrationv1.APIServiceList{}
+	if err := shootClient.List(ctx, apiServiceList, labelSelector); err != nil {
+		return fmt.Errorf("could not get APIServices of Shoot cluster to remediate unavailable APIServices: %w", err)
+	}
+
+	var fns []flow.TaskFn
+
+	for _, apiService := range apiServiceList.Items {
+		// Local APIServices are served by the kube-apiserver itself.
+		if apiService.Spec.Service == nil {
+			continue
+		}
+
+		condition := apiregistrationv1helper.GetAPIServiceConditionByType(&apiService, apiregistrationv1.Available)
+		if condition == nil || condition.Status != apiregistrationv1.ConditionFalse || r.clock.Since(condition.LastTransitionTime.Time) < threshold {
+			continue
+		}
+
+		r.log.Info("Deleting APIService since it is unavailable for too long", "apiService", apiService.Name, "reason", condition.Reason, "lastTransitionTime", condition.LastTransitionTime)
+		fns = append(fns, func(ctx context.Context) error {
+			return client.IgnoreNotFound(shootClient.Delete(ctx, &apiService))
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
 func getMatchingRules(
 	rules []admissionregistrationv1.RuleWithOperations,
 	objectSelector, namespaceSelector *metav1.LabelSelector,
@@ -276,6 +481,11 @@ func (r *remediator) failurePolicy() *admissionregistrationv1.FailurePolicyType
 	return &ignore
 }
 
+func (r *remediator) removal(service *admissionregistrationv1.ServiceReference) {
+	r.log.Info("Remediating", "fieldName", "webhooks")
+	*r.remediations = append(*r.remediations, fmt.Sprintf("webhook %q was removed since its service %s/%s does not exist", r.webhookName, service.Namespace, service.Name))
+}
+
 func (r *remediator) reportf(fieldName string, messageFmt string, args ...any) {
 	r.log.Info("Remediating", "fieldName", fieldName)
 	*r.remediations = append(*r.remediations, fmt.Sprintf("%s of webhook %q was %s", fieldName, r.webhookName, fmt.Sprintf(messageFmt, args...)))
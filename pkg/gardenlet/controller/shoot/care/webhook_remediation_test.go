@@ -6,6 +6,8 @@ package care_test
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -14,6 +16,8 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -21,9 +25,11 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist/matchers"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("WebhookRemediation", func() {
@@ -34,7 +40,8 @@ var _ = Describe("WebhookRemediation", func() {
 		fakeKubernetesInterface kubernetes.Interface
 		shootClientInit         func() (kubernetes.Interface, bool, error)
 
-		shoot *gardencorev1beta1.Shoot
+		shoot     *gardencorev1beta1.Shoot
+		fakeClock *testclock.FakeClock
 
 		remediator *WebhookRemediation
 	)
@@ -48,8 +55,17 @@ var _ = Describe("WebhookRemediation", func() {
 		}
 
 		shoot = &gardencorev1beta1.Shoot{}
+		fakeClock = testclock.NewFakeClock(time.Now())
 
-		remediator = NewWebhookRemediation(logr.Discard(), shoot, shootClientInit)
+		remediator = NewWebhookRemediation(logr.Discard(), shoot, shootClientInit, fakeClock, gardenletconfig.ShootCareRemediations{WebhookBestPractices: ptr.To(true)})
+	})
+
+	It("should do nothing if no remediation is enabled", func() {
+		remediator = NewWebhookRemediation(logr.Discard(), shoot, func() (kubernetes.Interface, bool, error) {
+			return nil, false, errors.New("fake")
+		}, fakeClock, gardenletconfig.ShootCareRemediations{})
+
+		Expect(remediator.Remediate(ctx)).To(Succeed())
 	})
 
 	Describe("#Remediate", func() {
@@ -577,4 +593,136 @@ var _ = Describe("WebhookRemediation", func() {
 			})
 		})
 	})
+
+	Describe("#Remediate orphaned kube-system webhooks", func() {
+		var (
+			fail = admissionregistrationv1.Fail
+
+			mutatingWebhookConfiguration *admissionregistrationv1.MutatingWebhookConfiguration
+			service                      *corev1.Service
+		)
+
+		BeforeEach(func() {
+			remediator = NewWebhookRemediation(logr.Discard(), shoot, shootClientInit, fakeClock, gardenletconfig.ShootCareRemediations{OrphanedKubeSystemWebhooks: ptr.To(true)})
+
+			Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "kube-system",
+				Labels: map[string]string{"kubernetes.io/metadata.name": "kube-system"},
+			}})).To(Succeed())
+
+			service = &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "foo"}}
+			Expect(fakeClient.Create(ctx, service)).To(Succeed())
+
+			mutatingWebhookConfiguration = &admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "mutating"},
+			}
+		})
+
+		newWebhook := func(name, serviceName string, namespaceSelector *metav1.LabelSelector) admissionregistrationv1.MutatingWebhook {
+			return admissionregistrationv1.MutatingWebhook{
+				Name:              name,
+				FailurePolicy:     &fail,
+				NamespaceSelector: namespaceSelector,
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Name: serviceName, Namespace: "foo"},
+				},
+			}
+		}
+
+		It("should remove orphaned webhooks intercepting requests in kube-system", func() {
+			mutatingWebhookConfiguration.Webhooks = []admissionregistrationv1.MutatingWebhook{
+				newWebhook("orphaned.example.com", "missing", nil),
+				newWebhook("existing.example.com", "existing", nil),
+				newWebhook("other-namespaces.example.com", "missing", &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "bar"}}),
+				{
+					Name:          "url.example.com",
+					FailurePolicy: &fail,
+					ClientConfig:  admissionregistrationv1.WebhookClientConfig{URL: ptr.To("https://example.com")},
+				},
+			}
+			Expect(fakeClient.Create(ctx, mutatingWebhookConfiguration)).To(Succeed())
+
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mutatingWebhookConfiguration), mutatingWebhookConfiguration)).To(Succeed())
+			Expect(mutatingWebhookConfiguration.Annotations).To(HaveKeyWithValue("gardener.cloud/warning", ContainSubstring(`webhook "orphaned.example.com" was removed since its service foo/missing does not exist`)))
+			Expect(mutatingWebhookConfiguration.Webhooks).To(HaveExactElements(
+				HaveField("Name", "existing.example.com"),
+				HaveField("Name", "other-namespaces.example.com"),
+				HaveField("Name", "url.example.com"),
+			))
+		})
+
+		It("should delete the webhook configuration if all webhooks are orphaned", func() {
+			mutatingWebhookConfiguration.Webhooks = []admissionregistrationv1.MutatingWebhook{
+				newWebhook("orphaned.example.com", "missing", &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "kube-system"}}),
+			}
+			Expect(fakeClient.Create(ctx, mutatingWebhookConfiguration)).To(Succeed())
+
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mutatingWebhookConfiguration), mutatingWebhookConfiguration)).To(BeNotFoundError())
+		})
+
+		It("should not remove webhooks of excluded webhook configurations", func() {
+			metav1.SetMetaDataLabel(&mutatingWebhookConfiguration.ObjectMeta, "remediation.webhook.shoot.gardener.cloud/exclude", "true")
+			mutatingWebhookConfiguration.Webhooks = []admissionregistrationv1.MutatingWebhook{newWebhook("orphaned.example.com", "missing", nil)}
+			Expect(fakeClient.Create(ctx, mutatingWebhookConfiguration)).To(Succeed())
+
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mutatingWebhookConfiguration), mutatingWebhookConfiguration)).To(Succeed())
+			Expect(mutatingWebhookConfiguration.Webhooks).To(HaveLen(1))
+		})
+	})
+
+	Describe("#Remediate unavailable APIServices", func() {
+		var threshold = time.Hour
+
+		BeforeEach(func() {
+			remediator = NewWebhookRemediation(logr.Discard(), shoot, shootClientInit, fakeClock, gardenletconfig.ShootCareRemediations{
+				UnavailableAPIServices: &gardenletconfig.UnavailableAPIServicesRemediation{
+					Enabled:   true,
+					Threshold: &metav1.Duration{Duration: threshold},
+				},
+			})
+		})
+
+		newAPIService := func(name string, service *apiregistrationv1.ServiceReference, status apiregistrationv1.ConditionStatus, unavailableFor time.Duration, labels map[string]string) *apiregistrationv1.APIService {
+			return &apiregistrationv1.APIService{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+				Spec:       apiregistrationv1.APIServiceSpec{Service: service},
+				Status: apiregistrationv1.APIServiceStatus{Conditions: []apiregistrationv1.APIServiceCondition{{
+					Type:               apiregistrationv1.Available,
+					Status:             status,
+					LastTransitionTime: metav1.NewTime(fakeClock.Now().Add(-unavailableFor)),
+				}}},
+			}
+		}
+
+		It("should delete APIServices which are unavailable for longer than the threshold", func() {
+			var (
+				serviceRef = &apiregistrationv1.ServiceReference{Name: "foo", Namespace: "bar"}
+
+				unavailable          = newAPIService("v1.unavailable.example.com", serviceRef, apiregistrationv1.ConditionFalse, 2*threshold, nil)
+				recentlyFailed       = newAPIService("v1.recently-failed.example.com", serviceRef, apiregistrationv1.ConditionFalse, threshold/2, nil)
+				available            = newAPIService("v1.available.example.com", serviceRef, apiregistrationv1.ConditionTrue, 2*threshold, nil)
+				local                = newAPIService("v1.local.example.com", nil, apiregistrationv1.ConditionFalse, 2*threshold, nil)
+				excluded             = newAPIService("v1.excluded.example.com", serviceRef, apiregistrationv1.ConditionFalse, 2*threshold, map[string]string{"remediation.apiservice.shoot.gardener.cloud/exclude": "true"})
+				managedByGardener    = newAPIService("v1.managed.example.com", serviceRef, apiregistrationv1.ConditionFalse, 2*threshold, map[string]string{"resources.gardener.cloud/managed-by": "gardener"})
+				remainingAPIServices = []*apiregistrationv1.APIService{recentlyFailed, available, local, excluded, managedByGardener}
+			)
+
+			for _, apiService := range append(remainingAPIServices, unavailable) {
+				Expect(fakeClient.Create(ctx, apiService)).To(Succeed())
+			}
+
+			Expect(remediator.Remediate(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unavailable), unavailable)).To(BeNotFoundError())
+			for _, apiService := range remainingAPIServices {
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(apiService), apiService)).To(Succeed())
+			}
+		})
+	})
 })
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ComputeWorkersRollout returns the rollout progress of the worker pools of the given shoot as reported by its Worker
+// extension resource in the given control plane namespace. It returns nil if the Worker resource does not exist.
+func ComputeWorkersRollout(ctx context.Context, c client.Client, shoot *gardencorev1beta1.Shoot, namespace string) ([]gardencorev1beta1.WorkerPoolRollout, error) {
+	worker := &extensionsv1alpha1.Worker{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: shoot.Name}, worker); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var workersRollout []gardencorev1beta1.WorkerPoolRollout
+	for _, rollout := range worker.Status.WorkersRollout {
+		workersRollout = append(workersRollout, gardencorev1beta1.WorkerPoolRollout{
+			Name:                  rollout.Name,
+			MachinesUpdated:       rollout.MachinesUpdated,
+			MachinesPending:       rollout.MachinesPending,
+			MachinesFailed:        rollout.MachinesFailed,
+			CurrentMachineImage:   toShootMachineImage(rollout.CurrentMachineImage),
+			TargetMachineImage:    toShootMachineImage(rollout.TargetMachineImage),
+			CurrentKubeletVersion: rollout.CurrentKubeletVersion,
+			TargetKubeletVersion:  rollout.TargetKubeletVersion,
+		})
+	}
+
+	return workersRollout, nil
+}
+
+func toShootMachineImage(machineImage *extensionsv1alpha1.MachineImage) *gardencorev1beta1.ShootMachineImage {
+	if machineImage == nil {
+		return nil
+	}
+	return &gardencorev1beta1.ShootMachineImage{Name: machineImage.Name, Version: ptr.To(machineImage.Version)}
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("WorkersRollout", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx        = context.Background()
+		seedClient client.Client
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+	})
+
+	It("should return nil if the worker does not exist", func() {
+		Expect(ComputeWorkersRollout(ctx, seedClient, shoot, namespace)).To(BeNil())
+	})
+
+	It("should return the rollout progress reported by the worker", func() {
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+			Status: extensionsv1alpha1.WorkerStatus{
+				WorkersRollout: []extensionsv1alpha1.WorkerPoolRollout{
+					{
+						Name:                  "pool1",
+						MachinesUpdated:       2,
+						MachinesPending:       1,
+						MachinesFailed:        1,
+						CurrentMachineImage:   &extensionsv1alpha1.MachineImage{Name: "gardenlinux", Version: "1.0.0"},
+						TargetMachineImage:    &extensionsv1alpha1.MachineImage{Name: "gardenlinux", Version: "1.1.0"},
+						CurrentKubeletVersion: ptr.To("1.30.0"),
+						TargetKubeletVersion:  ptr.To("1.31.0"),
+					},
+					{
+						Name:                 "pool2",
+						TargetMachineImage:   &extensionsv1alpha1.MachineImage{Name: "gardenlinux", Version: "1.1.0"},
+						TargetKubeletVersion: ptr.To("1.31.0"),
+					},
+				},
+			},
+		})).To(Succeed())
+
+		Expect(ComputeWorkersRollout(ctx, seedClient, shoot, namespace)).To(Equal([]gardencorev1beta1.WorkerPoolRollout{
+			{
+				Name:                  "pool1",
+				MachinesUpdated:       2,
+				MachinesPending:       1,
+				MachinesFailed:        1,
+				CurrentMachineImage:   &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.0.0")},
+				TargetMachineImage:    &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.1.0")},
+				CurrentKubeletVersion: ptr.To("1.30.0"),
+				TargetKubeletVersion:  ptr.To("1.31.0"),
+			},
+			{
+				Name:                 "pool2",
+				TargetMachineImage:   &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.1.0")},
+				TargetKubeletVersion: ptr.To("1.31.0"),
+			},
+		}))
+	})
+})
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leftover
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler maintains the inventory of resources which were created for Shoots in deletion and still exist.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.ShootLeftoverControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile inventories the extension resources and machine deployments which were created for the Shoot in the seed
+// and still exist, and writes the result to the Shoot status. This allows users to see which resources will be
+// destroyed by the deletion of the Shoot and which might be orphaned.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp == nil || gardenerutils.GetResponsibleSeedName(shoot.Spec.SeedName, shoot.Status.SeedName) != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.Status.TechnicalID == "" {
+		log.V(1).Info("Skipping inventory because shoot has no technical ID yet")
+		return reconcile.Result{}, nil
+	}
+
+	resources, err := r.inventory(ctx, shoot.Status.TechnicalID)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if shoot.Status.LeftoverResources == nil || !apiequality.Semantic.DeepEqual(shoot.Status.LeftoverResources.Resources, resources) {
+		log.Info("Updating inventory of leftover resources", "count", len(resources))
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LeftoverResources = &gardencorev1beta1.LeftoverResources{
+			Resources:      resources,
+			LastUpdateTime: metav1.NewTime(r.Clock.Now()),
+		}
+		if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed patching leftover resources in shoot status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) inventory(ctx context.Context, namespace string) ([]gardencorev1beta1.LeftoverResource, error) {
+	var (
+		resources               []gardencorev1beta1.LeftoverResource
+		knownMachineDeployments = sets.New[string]()
+	)
+
+	for _, extension := range extensionKinds {
+		list := extension.newObjectListFunc()
+		if err := r.SeedClient.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed listing extension resources of kind %s: %w", extension.objKind, err)
+		}
+
+		if err := meta.EachListItem(list, func(o runtime.Object) error {
+			obj, ok := o.(extensionsv1alpha1.Object)
+			if !ok {
+				return fmt.Errorf("expected extensionsv1alpha1.Object but got %T", o)
+			}
+
+			if worker, ok := obj.(*extensionsv1alpha1.Worker); ok {
+				for _, machineDeployment := range worker.Status.MachineDeployments {
+					knownMachineDeployments.Insert(machineDeployment.Name)
+				}
+			}
+
+			resources = append(resources, extensionLeftoverResource(extension.objKind, obj))
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
+	if err := r.SeedClient.List(ctx, machineDeploymentList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing machine deployments: %w", err)
+	}
+
+	for _, machineDeployment := range machineDeploymentList.Items {
+		resource := gardencorev1beta1.LeftoverResource{
+			Kind:        "MachineDeployment",
+			Name:        machineDeployment.Name,
+			State:       gardencorev1beta1.LeftoverResourceStateToBeDeleted,
+			Description: ptr.To(fmt.Sprintf("%d machine(s) exist.", machineDeployment.Status.Replicas)),
+		}
+		if !knownMachineDeployments.Has(machineDeployment.Name) {
+			resource.State = gardencorev1beta1.LeftoverResourceStatePotentiallyOrphaned
+			resource.Description = ptr.To(fmt.Sprintf("%d machine(s) exist, but the machine deployment is not managed by any Worker.", machineDeployment.Status.Replicas))
+		}
+		resources = append(resources, resource)
+	}
+
+	slices.SortFunc(resources, func(a, b gardencorev1beta1.LeftoverResource) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+	})
+
+	return resources, nil
+}
+
+func extensionLeftoverResource(kind string, obj extensionsv1alpha1.Object) gardencorev1beta1.LeftoverResource {
+	var (
+		resource = gardencorev1beta1.LeftoverResource{
+			Kind:  kind,
+			Name:  obj.GetName(),
+			Type:  ptr.To(obj.GetExtensionSpec().GetExtensionType()),
+			State: gardencorev1beta1.LeftoverResourceStateToBeDeleted,
+		}
+		lastOperation = obj.GetExtensionStatus().GetLastOperation()
+		lastError     = obj.GetExtensionStatus().GetLastError()
+	)
+
+	switch {
+	case lastOperation != nil && lastOperation.Type == gardencorev1beta1.LastOperationTypeMigrate && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded:
+		resource.State = gardencorev1beta1.LeftoverResourceStatePotentiallyOrphaned
+		resource.Description = ptr.To("Resource was migrated, hence its deletion does not destroy the resources it manages.")
+	case lastOperation != nil && lastOperation.Type == gardencorev1beta1.LastOperationTypeDelete && lastError != nil:
+		resource.State = gardencorev1beta1.LeftoverResourceStatePotentiallyOrphaned
+		resource.Description = ptr.To("Deletion failed: " + lastError.Description)
+	case obj.GetDeletionTimestamp() != nil:
+		resource.Description = ptr.To("Deletion is in progress.")
+	}
+
+	return resource
+}
+
+var extensionKinds = []struct {
+	objKind           string
+	newObjectListFunc func() client.ObjectList
+}{
+	{extensionsv1alpha1.ContainerRuntimeResource, func() client.ObjectList { return &extensionsv1alpha1.ContainerRuntimeList{} }},
+	{extensionsv1alpha1.ControlPlaneResource, func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} }},
+	{extensionsv1alpha1.DNSRecordResource, func() client.ObjectList { return &extensionsv1alpha1.DNSRecordList{} }},
+	{extensionsv1alpha1.ExtensionResource, func() client.ObjectList { return &extensionsv1alpha1.ExtensionList{} }},
+	{extensionsv1alpha1.InfrastructureResource, func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} }},
+	{extensionsv1alpha1.NetworkResource, func() client.ObjectList { return &extensionsv1alpha1.NetworkList{} }},
+	{extensionsv1alpha1.OperatingSystemConfigResource, func() client.ObjectList { return &extensionsv1alpha1.OperatingSystemConfigList{} }},
+	{extensionsv1alpha1.PrivateLinkResource, func() client.ObjectList { return &extensionsv1alpha1.PrivateLinkList{} }},
+	{extensionsv1alpha1.WorkerResource, func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} }},
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package leftover_test
+
+import (
+	"context"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/leftover"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+		shoot        *gardencorev1beta1.Shoot
+		request      reconcile.Request
+
+		seedName   = "seed"
+		namespace  = "shoot--dev--shoot"
+		syncPeriod = time.Minute
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config:       config.ShootLeftoverControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:        fakeClock,
+			SeedName:     seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev", Finalizers: []string{"gardener"}},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: namespace},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	deleteShoot := func() {
+		ExpectWithOffset(1, gardenClient.Create(ctx, shoot)).To(Succeed())
+		ExpectWithOffset(1, gardenClient.Delete(ctx, shoot)).To(Succeed())
+	}
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the shoot is not marked for deletion", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.LeftoverResources).To(BeNil())
+	})
+
+	It("should do nothing if the shoot is managed by another seed", func() {
+		shoot.Spec.SeedName = ptr.To("other-seed")
+		deleteShoot()
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.LeftoverResources).To(BeNil())
+	})
+
+	It("should report the leftover resources", func() {
+		deleteShoot()
+
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+			Status: extensionsv1alpha1.InfrastructureStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeDelete, State: gardencorev1beta1.LastOperationStateError},
+				LastError:     &gardencorev1beta1.LastError{Description: "cannot delete vpc"},
+			}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-external", Namespace: namespace},
+			Spec:       extensionsv1alpha1.DNSRecordSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace},
+			Spec:       extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "foo"}},
+			Status: extensionsv1alpha1.ExtensionStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded},
+			}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec:       extensionsv1alpha1.WorkerSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+			Status:     extensionsv1alpha1.WorkerStatus{MachineDeployments: []extensionsv1alpha1.MachineDeployment{{Name: "shoot-worker-z1"}}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-worker-z1", Namespace: namespace},
+			Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: 2},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &machinev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-old-z1", Namespace: namespace},
+			Status:     machinev1alpha1.MachineDeploymentStatus{Replicas: 1},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "other-namespace"},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.LeftoverResources).NotTo(BeNil())
+		Expect(shoot.Status.LeftoverResources.LastUpdateTime.Time).To(Equal(fakeClock.Now()))
+		Expect(shoot.Status.LeftoverResources.Resources).To(Equal([]gardencorev1beta1.LeftoverResource{
+			{Kind: "DNSRecord", Name: "shoot-external", Type: ptr.To("local"), State: gardencorev1beta1.LeftoverResourceStateToBeDeleted},
+			{Kind: "Extension", Name: "foo", Type: ptr.To("foo"), State: gardencorev1beta1.LeftoverResourceStatePotentiallyOrphaned, Description: ptr.To("Resource was migrated, hence its deletion does not destroy the resources it manages.")},
+			{Kind: "Infrastructure", Name: "shoot", Type: ptr.To("local"), State: gardencorev1beta1.LeftoverResourceStatePotentiallyOrphaned, Description: ptr.To("Deletion failed: cannot delete vpc")},
+			{Kind: "MachineDeployment", Name: "shoot-old-z1", State: gardencorev1beta1.LeftoverResourceStatePotentiallyOrphaned, Description: ptr.To("1 machine(s) exist, but the machine deployment is not managed by any Worker.")},
+			{Kind: "MachineDeployment", Name: "shoot-worker-z1", State: gardencorev1beta1.LeftoverResourceStateToBeDeleted, Description: ptr.To("2 machine(s) exist.")},
+			{Kind: "Worker", Name: "shoot", Type: ptr.To("local"), State: gardencorev1beta1.LeftoverResourceStateToBeDeleted},
+		}))
+	})
+
+	It("should only update the last update time when the inventory changed", func() {
+		deleteShoot()
+
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec:       extensionsv1alpha1.WorkerSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+		})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.LeftoverResources.Resources).To(HaveLen(1))
+		lastUpdateTime := shoot.Status.LeftoverResources.LastUpdateTime
+
+		fakeClock.Step(time.Minute)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.LeftoverResources.LastUpdateTime).To(Equal(lastUpdateTime))
+
+		Expect(seedClient.Delete(ctx, &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace}})).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.LeftoverResources.Resources).To(BeEmpty())
+		Expect(shoot.Status.LeftoverResources.LastUpdateTime.Time).To(Equal(fakeClock.Now()))
+	})
+})
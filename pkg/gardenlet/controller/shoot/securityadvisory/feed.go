@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityadvisory
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// FeedDataKey is the key in the data of the feed ConfigMap which contains the CVE feed.
+const FeedDataKey = "feed.yaml"
+
+// Feed is the CVE feed supplied by the Gardener operator.
+type Feed struct {
+	// Advisories is the list of known vulnerabilities.
+	Advisories []Advisory `json:"advisories"`
+}
+
+// Advisory describes a known vulnerability and the machine images and packages affected by it.
+type Advisory struct {
+	// ID is the identifier of the vulnerability, e.g., a CVE ID.
+	ID string `json:"id"`
+	// Severity is the severity of the vulnerability.
+	Severity *string `json:"severity,omitempty"`
+	// Summary is a short description of the vulnerability.
+	Summary *string `json:"summary,omitempty"`
+	// MachineImages is the list of affected machine images.
+	MachineImages []AffectedArtifact `json:"machineImages,omitempty"`
+	// Packages is the list of affected packages.
+	Packages []AffectedArtifact `json:"packages,omitempty"`
+}
+
+// AffectedArtifact is a machine image or a package which is affected by a vulnerability in the given versions.
+type AffectedArtifact struct {
+	// Name is the name of the machine image or package.
+	Name string `json:"name"`
+	// Versions is the list of affected versions.
+	Versions []string `json:"versions"`
+}
+
+// ParseFeed parses the given CVE feed.
+func ParseFeed(data []byte) (*Feed, error) {
+	feed := &Feed{}
+	if err := yaml.Unmarshal(data, feed); err != nil {
+		return nil, fmt.Errorf("failed parsing CVE feed: %w", err)
+	}
+
+	for i, advisory := range feed.Advisories {
+		if advisory.ID == "" {
+			return nil, fmt.Errorf("advisory %d in CVE feed does not have an ID", i)
+		}
+	}
+
+	return feed, nil
+}
+
+// ComputeAdvisories returns the security advisories for the given machine images of worker pools (keyed by the name
+// of the worker pool). The result is sorted by the ID of the advisories.
+func ComputeAdvisories(feed *Feed, machineImages map[string]*extensionsv1alpha1.OperatingSystemMachineImage) []gardencorev1beta1.ShootSecurityAdvisory {
+	var advisories []gardencorev1beta1.ShootSecurityAdvisory
+
+	for _, advisory := range feed.Advisories {
+		workerPools := sets.New[string]()
+		for workerPool, machineImage := range machineImages {
+			if machineImage != nil && affects(advisory, machineImage) {
+				workerPools.Insert(workerPool)
+			}
+		}
+
+		if workerPools.Len() == 0 {
+			continue
+		}
+
+		advisories = append(advisories, gardencorev1beta1.ShootSecurityAdvisory{
+			ID:          advisory.ID,
+			Severity:    advisory.Severity,
+			Summary:     advisory.Summary,
+			WorkerPools: sets.List(workerPools),
+		})
+	}
+
+	slices.SortFunc(advisories, func(a, b gardencorev1beta1.ShootSecurityAdvisory) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return advisories
+}
+
+func affects(advisory Advisory, machineImage *extensionsv1alpha1.OperatingSystemMachineImage) bool {
+	for _, affected := range advisory.MachineImages {
+		if affected.Name == machineImage.Name && slices.Contains(affected.Versions, machineImage.Version) {
+			return true
+		}
+	}
+
+	for _, affected := range advisory.Packages {
+		for _, pkg := range machineImage.Packages {
+			if affected.Name == pkg.Name && slices.Contains(affected.Versions, pkg.Version) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
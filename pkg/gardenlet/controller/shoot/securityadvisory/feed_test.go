@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityadvisory_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/securityadvisory"
+)
+
+var _ = Describe("Feed", func() {
+	Describe("#ParseFeed", func() {
+		It("should parse the feed", func() {
+			feed, err := ParseFeed([]byte(`advisories:
+- id: CVE-2024-0001
+  severity: High
+  summary: Remote code execution in openssh
+  packages:
+  - name: openssh-server
+    versions: ["9.2p1-2"]
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(feed).To(Equal(&Feed{Advisories: []Advisory{{
+				ID:       "CVE-2024-0001",
+				Severity: ptr.To("High"),
+				Summary:  ptr.To("Remote code execution in openssh"),
+				Packages: []AffectedArtifact{{Name: "openssh-server", Versions: []string{"9.2p1-2"}}},
+			}}}))
+		})
+
+		It("should fail if the feed cannot be parsed", func() {
+			_, err := ParseFeed([]byte(`advisories: foo`))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing CVE feed")))
+		})
+
+		It("should fail if an advisory has no ID", func() {
+			_, err := ParseFeed([]byte(`advisories:
+- severity: High
+`))
+			Expect(err).To(MatchError("advisory 0 in CVE feed does not have an ID"))
+		})
+	})
+
+	Describe("#ComputeAdvisories", func() {
+		var feed *Feed
+
+		BeforeEach(func() {
+			feed = &Feed{Advisories: []Advisory{
+				{
+					ID:            "CVE-2024-0002",
+					MachineImages: []AffectedArtifact{{Name: "gardenlinux", Versions: []string{"1312.1.0", "1312.2.0"}}},
+				},
+				{
+					ID:       "CVE-2024-0001",
+					Severity: ptr.To("Critical"),
+					Packages: []AffectedArtifact{{Name: "openssh-server", Versions: []string{"9.2p1-2"}}},
+				},
+				{
+					ID:            "CVE-2024-0003",
+					MachineImages: []AffectedArtifact{{Name: "ubuntu", Versions: []string{"22.04"}}},
+				},
+			}}
+		})
+
+		It("should return the advisories affecting the worker pools", func() {
+			Expect(ComputeAdvisories(feed, map[string]*extensionsv1alpha1.OperatingSystemMachineImage{
+				"pool-a": {Name: "gardenlinux", Version: "1312.1.0"},
+				"pool-b": {Name: "gardenlinux", Version: "1312.3.0", Packages: []extensionsv1alpha1.OperatingSystemPackage{{Name: "openssh-server", Version: "9.2p1-2"}}},
+				"pool-c": {Name: "gardenlinux", Version: "1312.2.0", Packages: []extensionsv1alpha1.OperatingSystemPackage{{Name: "openssh-server", Version: "9.2p1-3"}}},
+				"pool-d": nil,
+			})).To(Equal([]gardencorev1beta1.ShootSecurityAdvisory{
+				{ID: "CVE-2024-0001", Severity: ptr.To("Critical"), WorkerPools: []string{"pool-b"}},
+				{ID: "CVE-2024-0002", WorkerPools: []string{"pool-a", "pool-c"}},
+			}))
+		})
+
+		It("should return nothing if no worker pool is affected", func() {
+			Expect(ComputeAdvisories(feed, map[string]*extensionsv1alpha1.OperatingSystemMachineImage{
+				"pool-a": {Name: "gardenlinux", Version: "1443.0.0"},
+			})).To(BeEmpty())
+		})
+	})
+})
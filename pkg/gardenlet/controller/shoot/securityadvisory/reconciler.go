@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityadvisory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// EventReasonSecurityAdvisory is the reason of the event which is emitted when new security advisories affect the
+// worker pools of a shoot.
+const EventReasonSecurityAdvisory = "SecurityAdvisory"
+
+// Reconciler determines the known vulnerabilities affecting the machine images of the worker pools of Shoots.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.ShootSecurityAdvisoryControllerConfiguration
+	Recorder     record.EventRecorder
+	SeedName     string
+}
+
+// Reconcile cross-references the machine image metadata reported in the status of the OperatingSystemConfigs of the
+// Shoot with the CVE feed and writes the affected worker pools to the Shoot status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.Status.LastOperation == nil || len(shoot.Spec.Provider.Workers) == 0 {
+		log.V(1).Info("Skipping security advisory computation because shoot was not yet created or has no workers")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	feed, err := r.readFeed(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	machineImages, err := r.machineImages(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	advisories := ComputeAdvisories(feed, machineImages)
+	if apiequality.Semantic.DeepEqual(advisories, shoot.Status.SecurityAdvisories) {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	reported := sets.New[string]()
+	for _, advisory := range shoot.Status.SecurityAdvisories {
+		reported.Insert(advisory.ID)
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.SecurityAdvisories = advisories
+	if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating security advisories in shoot status: %w", err)
+	}
+
+	for _, advisory := range advisories {
+		if reported.Has(advisory.ID) {
+			continue
+		}
+		log.Info("Worker pools are affected by security advisory", "id", advisory.ID, "workerPools", advisory.WorkerPools)
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventReasonSecurityAdvisory, "Worker pools %s are affected by %s, consider updating their machine images", strings.Join(advisory.WorkerPools, ", "), advisory.ID)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) readFeed(ctx context.Context) (*Feed, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Namespace: v1beta1constants.GardenNamespace, Name: ptr.Deref(r.Config.FeedConfigMapName, "")}, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading CVE feed: %w", err)
+	}
+
+	return ParseFeed([]byte(configMap.Data[FeedDataKey]))
+}
+
+// machineImages returns the machine images of the worker pools of the given shoot. The metadata reported by the
+// operating system extensions in the status of the OperatingSystemConfigs is preferred. If it is not available, only
+// the name and version of the machine image in the Shoot specification are considered.
+func (r *Reconciler) machineImages(ctx context.Context, shoot *gardencorev1beta1.Shoot) (map[string]*extensionsv1alpha1.OperatingSystemMachineImage, error) {
+	oscList := &extensionsv1alpha1.OperatingSystemConfigList{}
+	if err := r.SeedClient.List(ctx, oscList, client.InNamespace(shoot.Status.TechnicalID)); err != nil {
+		return nil, fmt.Errorf("failed listing OperatingSystemConfigs: %w", err)
+	}
+
+	reported := make(map[string]*extensionsv1alpha1.OperatingSystemMachineImage)
+	for _, osc := range oscList.Items {
+		if osc.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile && osc.Status.MachineImage != nil {
+			reported[osc.Labels[v1beta1constants.LabelWorkerPool]] = osc.Status.MachineImage
+		}
+	}
+
+	machineImages := make(map[string]*extensionsv1alpha1.OperatingSystemMachineImage, len(shoot.Spec.Provider.Workers))
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if machineImage, ok := reported[worker.Name]; ok {
+			machineImages[worker.Name] = machineImage
+			continue
+		}
+
+		if worker.Machine.Image != nil {
+			machineImages[worker.Name] = &extensionsv1alpha1.OperatingSystemMachineImage{
+				Name:    worker.Machine.Image.Name,
+				Version: ptr.Deref(worker.Machine.Image.Version, ""),
+			}
+		}
+	}
+
+	return machineImages, nil
+}
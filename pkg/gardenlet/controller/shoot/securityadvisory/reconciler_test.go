@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package securityadvisory_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/securityadvisory"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+		seedClient   client.Client
+		recorder     *record.FakeRecorder
+		reconciler   *Reconciler
+		shoot        *gardencorev1beta1.Shoot
+		feed         *corev1.ConfigMap
+		request      reconcile.Request
+
+		seedName   = "seed"
+		namespace  = "shoot--dev--shoot"
+		syncPeriod = time.Hour
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.ShootSecurityAdvisoryControllerConfiguration{
+				SyncPeriod:        &metav1.Duration{Duration: syncPeriod},
+				FeedConfigMapName: ptr.To("cve-feed"),
+			},
+			Recorder: recorder,
+			SeedName: seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To(seedName),
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+					{Name: "pool-a", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1312.1.0")}}},
+					{Name: "pool-b", Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1312.3.0")}}},
+				}},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID:   namespace,
+				LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		feed = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "cve-feed", Namespace: v1beta1constants.GardenNamespace},
+			Data: map[string]string{FeedDataKey: `advisories:
+- id: CVE-2024-0001
+  packages:
+  - name: openssh-server
+    versions: ["9.2p1-2"]
+- id: CVE-2024-0002
+  severity: High
+  machineImages:
+  - name: gardenlinux
+    versions: ["1312.1.0"]
+`},
+		}
+	})
+
+	It("should do nothing if the shoot is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the shoot belongs to another seed", func() {
+		shoot.Spec.SeedName = ptr.To("other-seed")
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue if the shoot was not yet created", func() {
+		shoot.Status.LastOperation = nil
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+	})
+
+	It("should fail if the CVE feed does not exist", func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed reading CVE feed")))
+		Expect(err).To(BeNotFoundError())
+	})
+
+	Context("with CVE feed", func() {
+		BeforeEach(func() {
+			Expect(seedClient.Create(ctx, feed)).To(Succeed())
+			Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should use the machine images from the shoot specification if none were reported", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.SecurityAdvisories).To(Equal([]gardencorev1beta1.ShootSecurityAdvisory{
+				{ID: "CVE-2024-0002", Severity: ptr.To("High"), WorkerPools: []string{"pool-a"}},
+			}))
+			Expect(recorder.Events).To(Receive(Equal("Warning SecurityAdvisory Worker pools pool-a are affected by CVE-2024-0002, consider updating their machine images")))
+		})
+
+		It("should use the machine image metadata reported by the operating system extension", func() {
+			Expect(seedClient.Create(ctx, &extensionsv1alpha1.OperatingSystemConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "osc-pool-b", Namespace: namespace, Labels: map[string]string{v1beta1constants.LabelWorkerPool: "pool-b"}},
+				Spec:       extensionsv1alpha1.OperatingSystemConfigSpec{Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile},
+				Status: extensionsv1alpha1.OperatingSystemConfigStatus{MachineImage: &extensionsv1alpha1.OperatingSystemMachineImage{
+					Name:     "gardenlinux",
+					Version:  "1312.3.0",
+					Packages: []extensionsv1alpha1.OperatingSystemPackage{{Name: "openssh-server", Version: "9.2p1-2"}},
+				}},
+			})).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.SecurityAdvisories).To(Equal([]gardencorev1beta1.ShootSecurityAdvisory{
+				{ID: "CVE-2024-0001", WorkerPools: []string{"pool-b"}},
+				{ID: "CVE-2024-0002", Severity: ptr.To("High"), WorkerPools: []string{"pool-a"}},
+			}))
+		})
+
+		It("should not emit events for advisories which were already reported", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(recorder.Events).To(HaveLen(1))
+			<-recorder.Events
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			shoot.Spec.Provider.Workers[1].Machine.Image.Version = ptr.To("1312.1.0")
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.SecurityAdvisories).To(Equal([]gardencorev1beta1.ShootSecurityAdvisory{
+				{ID: "CVE-2024-0002", Severity: ptr.To("High"), WorkerPools: []string{"pool-a", "pool-b"}},
+			}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should remove the advisories once the worker pools are no longer affected", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1312.3.0")
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(gardenClient.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+			Expect(shoot.Status.SecurityAdvisories).To(BeEmpty())
+		})
+	})
+})
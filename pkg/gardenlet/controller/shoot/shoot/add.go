@@ -39,6 +39,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Config.Controllers.Shoot.Prioritization != nil {
+		r.priorityLimiter = newPriorityLimiter(r.Config.Controllers.Shoot.Prioritization)
+	}
 
 	// It's not possible to call builder.Build() without adding atleast one watch, and without this, we can't get the controller logger.
 	// Hence, we have to build up the controller manually.
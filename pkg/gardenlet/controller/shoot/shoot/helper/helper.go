@@ -11,6 +11,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 )
@@ -36,6 +37,24 @@ func ComputeOperationType(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.Last
 	return v1beta1helper.ComputeOperationType(shoot.ObjectMeta, shoot.Status.LastOperation)
 }
 
+// ComputePriorityBand determines the priority band of a reconciliation request for the given shoot. Operations
+// triggered by users (creations, deletions, spec changes incl. reconcile and retry annotations, migrations and
+// restorations) take precedence over retries of unsuccessful operations, which in turn take precedence over periodic
+// syncs of shoots whose last operation succeeded.
+func ComputePriorityBand(shoot *gardencorev1beta1.Shoot) gardenletconfig.ShootPriorityBandName {
+	if shoot.DeletionTimestamp != nil ||
+		shoot.Generation != shoot.Status.ObservedGeneration ||
+		ComputeOperationType(shoot) != gardencorev1beta1.LastOperationTypeReconcile {
+		return gardenletconfig.ShootPriorityBandUserTriggered
+	}
+
+	if shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return gardenletconfig.ShootPriorityBandRetry
+	}
+
+	return gardenletconfig.ShootPriorityBandPeriodicSync
+}
+
 // GetEtcdDeployTimeout returns the timeout for the etcd deployment task of the reconcile flow.
 func GetEtcdDeployTimeout(shoot *shoot.Shoot, defaultDuration time.Duration) time.Duration {
 	timeout := defaultDuration
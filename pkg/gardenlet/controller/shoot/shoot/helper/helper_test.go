@@ -14,6 +14,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 )
@@ -153,6 +154,58 @@ var _ = Describe("ComputeOperationType", func() {
 	})
 })
 
+var _ = Describe("ComputePriorityBand", func() {
+	var shoot *gardencorev1beta1.Shoot
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 2,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To("seed"),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:           ptr.To("seed"),
+				ObservedGeneration: 2,
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+	})
+
+	It("should return PeriodicSync if the shoot is up-to-date and the last operation succeeded", func() {
+		Expect(ComputePriorityBand(shoot)).To(Equal(gardenletconfig.ShootPriorityBandPeriodicSync))
+	})
+
+	It("should return Retry if the last operation did not succeed", func() {
+		shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+		Expect(ComputePriorityBand(shoot)).To(Equal(gardenletconfig.ShootPriorityBandRetry))
+	})
+
+	It("should return UserTriggered if the spec was changed", func() {
+		shoot.Generation = 3
+		Expect(ComputePriorityBand(shoot)).To(Equal(gardenletconfig.ShootPriorityBandUserTriggered))
+	})
+
+	It("should return UserTriggered if the shoot is being deleted", func() {
+		shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		Expect(ComputePriorityBand(shoot)).To(Equal(gardenletconfig.ShootPriorityBandUserTriggered))
+	})
+
+	It("should return UserTriggered if the shoot is being created", func() {
+		shoot.Status.LastOperation = nil
+		Expect(ComputePriorityBand(shoot)).To(Equal(gardenletconfig.ShootPriorityBandUserTriggered))
+	})
+
+	It("should return UserTriggered if the shoot is being migrated", func() {
+		shoot.Spec.SeedName = ptr.To("other")
+		Expect(ComputePriorityBand(shoot)).To(Equal(gardenletconfig.ShootPriorityBandUserTriggered))
+	})
+})
+
 var _ = Describe("GetEtcdDeployTimeout", func() {
 	var (
 		s              *shoot.Shoot
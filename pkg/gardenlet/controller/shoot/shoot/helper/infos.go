@@ -65,6 +65,8 @@ type ControllerInfos struct {
 
 	// based on shoot object
 	isIgnored                             bool
+	isPaused                              bool
+	pausedUntil                           *time.Time
 	isFailed                              bool
 	isUpToDate                            bool
 	confineSpecUpdateRollout              bool
@@ -90,6 +92,7 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 		respectSyncPeriodOverwrite: respectSyncPeriodOverwrite,
 
 		isIgnored:                             gardenerutils.ShouldIgnoreShoot(respectSyncPeriodOverwrite, shoot),
+		isPaused:                              v1beta1helper.IsShootReconciliationPaused(shoot, clock.Now()),
 		isFailed:                              gardenerutils.IsShootFailedAndUpToDate(shoot),
 		isUpToDate:                            gardenerutils.IsObservedAtLatestGenerationAndSucceeded(shoot),
 		confineSpecUpdateRollout:              v1beta1helper.ShootConfinesSpecUpdateRollout(shoot.Spec.Maintenance),
@@ -100,6 +103,10 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 		syncPeriod: gardenerutils.SyncPeriodOfShoot(respectSyncPeriodOverwrite, cfg.SyncPeriod.Duration, shoot),
 	}
 
+	if shoot.Spec.Reconciliation != nil && shoot.Spec.Reconciliation.PausedUntil != nil {
+		i.pausedUntil = &shoot.Spec.Reconciliation.PausedUntil.Time
+	}
+
 	i.ShouldReconcileNow = i.shouldReconcileNow()
 	i.ShouldOnlySyncClusterResource = i.shouldOnlySyncClusterResource()
 	i.EnqueueAfter = i.enqueueAfter()
@@ -114,6 +121,11 @@ func (i ControllerInfos) shouldReconcileNow() bool {
 		return false
 	}
 
+	// if the reconciliation of the shoot is paused, it doesn't matter which operation is triggered either
+	if i.isPaused {
+		return false
+	}
+
 	// in the following checks, we optimize when and how often existing shoots are reconciled
 	// all operations other than Reconcile are excluded from these optimizations as we want to perform the operations immediately
 	if i.OperationType != gardencorev1beta1.LastOperationTypeReconcile {
@@ -151,7 +163,7 @@ func (i ControllerInfos) shouldReconcileNow() bool {
 }
 
 func (i ControllerInfos) shouldOnlySyncClusterResource() bool {
-	return i.isFailed || i.isIgnored
+	return i.isFailed || i.isIgnored || i.isPaused
 }
 
 func (i ControllerInfos) enqueueAfter() time.Duration {
@@ -160,6 +172,12 @@ func (i ControllerInfos) enqueueAfter() time.Duration {
 		return 0
 	}
 
+	// if the reconciliation of the shoot is paused, we need to enqueue the shoot now to sync the cluster resource to the
+	// seed as well
+	if i.isPaused {
+		return 0
+	}
+
 	// in the following checks, we optimize when existing shoots are reconciled after gardenlet startup to prevent
 	// overload situations caused by immediately reconciling all existing shoots
 	// all operations other than Reconcile are excluded from these optimizations as we want to perform the operations immediately
@@ -203,6 +221,17 @@ func (i ControllerInfos) requeueAfter() reconcile.Result {
 		return reconcile.Result{}
 	}
 
+	// if the reconciliation of the shoot is paused until a given time, we want to requeue the shoot when the pause ends
+	if i.isPaused {
+		if i.pausedUntil != nil {
+			return reconcile.Result{RequeueAfter: i.pausedUntil.Sub(i.clock.Now())}
+		}
+
+		// otherwise, we don't want to requeue the shoot, it will be reconciled once the pause is lifted (which changes the
+		// shoot's spec)
+		return reconcile.Result{}
+	}
+
 	// from here on, we have a case of regular reconciliation (i.e., shoot is observed at latest generation and in
 	// 'Reconcile Succeeded' state).
 
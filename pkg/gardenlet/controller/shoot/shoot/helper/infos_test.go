@@ -129,6 +129,47 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot reconciliation is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("foo")}
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+
+			Context("paused until a given time", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(2 * time.Hour)}
+				})
+
+				It("should not reconcile the shoot but sync the cluster resource", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				})
+
+				It("should requeue the shoot when the pause ends", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Hour}))
+				})
+			})
+
+			Context("pause has ended", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(-time.Minute)}
+				})
+
+				It("should not only sync the cluster resource", func() {
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+				})
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -357,6 +398,47 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot reconciliation is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("foo")}
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+
+			Context("paused until a given time", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(2 * time.Hour)}
+				})
+
+				It("should not reconcile the shoot but sync the cluster resource", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				})
+
+				It("should requeue the shoot when the pause ends", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Hour}))
+				})
+			})
+
+			Context("pause has ended", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(-time.Minute)}
+				})
+
+				It("should not only sync the cluster resource", func() {
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+				})
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -448,6 +530,47 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot reconciliation is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("foo")}
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+
+			Context("paused until a given time", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(2 * time.Hour)}
+				})
+
+				It("should not reconcile the shoot but sync the cluster resource", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				})
+
+				It("should requeue the shoot when the pause ends", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Hour}))
+				})
+			})
+
+			Context("pause has ended", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(-time.Minute)}
+				})
+
+				It("should not only sync the cluster resource", func() {
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+				})
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -541,6 +664,47 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot reconciliation is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("foo")}
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+
+			Context("paused until a given time", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(2 * time.Hour)}
+				})
+
+				It("should not reconcile the shoot but sync the cluster resource", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				})
+
+				It("should requeue the shoot when the pause ends", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Hour}))
+				})
+			})
+
+			Context("pause has ended", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(-time.Minute)}
+				})
+
+				It("should not only sync the cluster resource", func() {
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+				})
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
@@ -632,6 +796,47 @@ var _ = Describe("CalculateControllerInfos", func() {
 			})
 		})
 
+		Context("shoot reconciliation is paused", func() {
+			BeforeEach(func() {
+				shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("foo")}
+			})
+
+			It("should not reconcile the shoot but sync the cluster resource", func() {
+				Expect(infos.ShouldReconcileNow).To(BeFalse())
+				Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+			})
+
+			It("should not requeue the shoot after syncing the cluster resource", func() {
+				Expect(infos.RequeueAfter).To(Equal(reconcile.Result{}))
+			})
+
+			Context("paused until a given time", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(2 * time.Hour)}
+				})
+
+				It("should not reconcile the shoot but sync the cluster resource", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeTrue())
+				})
+
+				It("should requeue the shoot when the pause ends", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Hour}))
+				})
+			})
+
+			Context("pause has ended", func() {
+				BeforeEach(func() {
+					shoot.Spec.Reconciliation.PausedUntil = &metav1.Time{Time: cl.Now().Add(-time.Minute)}
+				})
+
+				It("should not only sync the cluster resource", func() {
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+				})
+			})
+		})
+
 		Context("shoot is failed", func() {
 			BeforeEach(func() {
 				shoot.Status.ObservedGeneration = shoot.Generation
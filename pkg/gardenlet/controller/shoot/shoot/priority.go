@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"sync"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// priorityLimiter limits the number of workers which reconcile shoots of the same priority band at the same time.
+type priorityLimiter struct {
+	lock     sync.Mutex
+	limits   map[config.ShootPriorityBandName]int
+	inFlight map[config.ShootPriorityBandName]int
+}
+
+func newPriorityLimiter(prioritization *config.ShootPrioritization) *priorityLimiter {
+	l := &priorityLimiter{
+		limits:   make(map[config.ShootPriorityBandName]int),
+		inFlight: make(map[config.ShootPriorityBandName]int),
+	}
+
+	if prioritization != nil {
+		for _, band := range prioritization.Bands {
+			l.limits[band.Name] = band.MaxConcurrentReconciles
+		}
+	}
+
+	return l
+}
+
+// tryAcquire returns true if a worker may reconcile a shoot of the given priority band. In this case, release must be
+// called for the band once the reconciliation is finished.
+func (l *priorityLimiter) tryAcquire(band config.ShootPriorityBandName) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if limit, ok := l.limits[band]; ok && l.inFlight[band] >= limit {
+		return false
+	}
+
+	l.inFlight[band]++
+	return true
+}
+
+// release marks a reconciliation of the given priority band as finished.
+func (l *priorityLimiter) release(band config.ShootPriorityBandName) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.inFlight[band] > 0 {
+		l.inFlight[band]--
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+var _ = Describe("priorityLimiter", func() {
+	var limiter *priorityLimiter
+
+	BeforeEach(func() {
+		limiter = newPriorityLimiter(&config.ShootPrioritization{
+			Bands: []config.ShootPriorityBand{
+				{Name: config.ShootPriorityBandPeriodicSync, MaxConcurrentReconciles: 2},
+			},
+		})
+	})
+
+	It("should limit the number of concurrent reconciliations of a configured band", func() {
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeFalse())
+
+		limiter.release(config.ShootPriorityBandPeriodicSync)
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+	})
+
+	It("should not limit bands which are not configured", func() {
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+
+		for i := 0; i < 10; i++ {
+			Expect(limiter.tryAcquire(config.ShootPriorityBandUserTriggered)).To(BeTrue())
+		}
+	})
+
+	It("should not count releases without acquisitions", func() {
+		limiter.release(config.ShootPriorityBandPeriodicSync)
+
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeTrue())
+		Expect(limiter.tryAcquire(config.ShootPriorityBandPeriodicSync)).To(BeFalse())
+	})
+})
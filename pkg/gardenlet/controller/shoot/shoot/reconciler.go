@@ -12,6 +12,9 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -53,7 +56,10 @@ import (
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
-const taskID = "initializeOperation"
+const (
+	taskID     = "initializeOperation"
+	tracerName = "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
+)
 
 // Reconciler implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
 type Reconciler struct {
@@ -66,6 +72,8 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+
+	priorityLimiter *priorityLimiter
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -86,6 +94,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if r.priorityLimiter != nil {
+		priorityBand := helper.ComputePriorityBand(shoot)
+		if !r.priorityLimiter.tryAcquire(priorityBand) {
+			deferralPeriod := r.Config.Controllers.Shoot.Prioritization.DeferralPeriod.Duration
+			log.V(1).Info("Deferring reconciliation because all workers of the priority band are busy", "priorityBand", priorityBand, "deferralPeriod", deferralPeriod)
+			return reconcile.Result{RequeueAfter: deferralPeriod}, nil
+		}
+		defer r.priorityLimiter.release(priorityBand)
+	}
+
+	// The span groups the spans of all flow tasks executed for this shoot (if tracing is enabled).
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "Shoot", trace.WithAttributes(
+		attribute.String("shoot.namespace", shoot.Namespace),
+		attribute.String("shoot.name", shoot.Name),
+		attribute.String("shoot.uid", string(shoot.UID)),
+	))
+	defer span.End()
+
 	if shoot.DeletionTimestamp != nil {
 		return r.deleteShoot(ctx, log, shoot)
 	}
@@ -288,7 +314,7 @@ func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoo
 				updateErr := r.patchShootStatusOperationError(ctx, shoot, syncErr.Error(), i.OperationType, shoot.Status.LastErrors...)
 				return nil, reconcile.Result{}, errorsutils.WithSuppressed(syncErr, updateErr)
 			}
-			return nil, reconcile.Result{}, nil
+			return nil, i.RequeueAfter, nil
 		}
 
 		return nil, i.RequeueAfter, nil
@@ -852,9 +878,11 @@ func lastErrorsOperationInitializationFailure(lastErrors []gardencorev1beta1.Las
 
 	if errors.As(err, &incompleteDNSConfigError) {
 		return v1beta1helper.UpsertLastError(lastErrors, gardencorev1beta1.LastError{
-			TaskID:      ptr.To(taskID),
-			Description: err.Error(),
-			Codes:       []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem},
+			TaskID:          ptr.To(taskID),
+			Description:     err.Error(),
+			Codes:           []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem},
+			Category:        v1beta1helper.ErrorCategoryForCodes(gardencorev1beta1.ErrorConfigurationProblem),
+			RemediationHint: v1beta1helper.RemediationHintForCodes(gardencorev1beta1.ErrorConfigurationProblem),
 		})
 	}
 
@@ -620,13 +620,29 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf:       botanist.Shoot.IsWorkerless || !nonTerminatingNamespace,
 			Dependencies: flow.NewTaskIDs(syncPointCleaned),
 		})
+		destroyPrivateLink = g.Add(flow.Task{
+			Name: "Destroying private link endpoint for the Shoot control plane",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.Extensions.PrivateLink.Destroy(ctx)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       botanist.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilKubeAPIServerDeleted),
+		})
+		waitUntilPrivateLinkDeleted = g.Add(flow.Task{
+			Name: "Waiting until private link endpoint for the Shoot control plane has been deleted",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.Extensions.PrivateLink.WaitCleanup(ctx)
+			}),
+			SkipIf:       botanist.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(destroyPrivateLink),
+		})
 		deleteInfrastructure = g.Add(flow.Task{
 			Name: "Destroying shoot infrastructure",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.Infrastructure.Destroy(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       botanist.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilControlPlaneDeleted),
+			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilControlPlaneDeleted, waitUntilPrivateLinkDeleted),
 		})
 		waitUntilInfrastructureDeleted = g.Add(flow.Task{
 			Name: "Waiting until shoot infrastructure has been deleted",
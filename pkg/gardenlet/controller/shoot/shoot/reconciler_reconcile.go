@@ -75,6 +75,9 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		errors.ToExecute("Check required extensions", func() error {
 			return botanist.WaitUntilRequiredExtensionsReady(ctx)
 		}),
+		errors.ToExecute("Check required artifacts are available in registry mirror", func() error {
+			return botanist.VerifyArtifactsAvailable(ctx)
+		}),
 		errors.ToExecute("Check if copy of backups is required", func() error {
 			isCopyOfBackupsRequired, err = botanist.IsCopyOfBackupsRequired(ctx)
 			return err
@@ -99,6 +102,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		shootControlPlaneLoggingEnabled = botanist.Shoot.IsShootControlPlaneLoggingEnabled(botanist.Config)
 		deployKubeAPIServerTaskTimeout  = defaultTimeout
 		shootSSHAccessEnabled           = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
+		privateLinkEnabled              = v1beta1helper.ShootEnablesPrivateLink(o.Shoot.GetInfo())
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -212,6 +216,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployInfrastructure),
 		})
+		deployPrivateLink = g.Add(flow.Task{
+			Name:         "Deploying private link endpoint for the Shoot control plane",
+			Fn:           botanist.DeployPrivateLink,
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || !privateLinkEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureReady, deployInternalDomainDNSRecord),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Destroying private link endpoint for the Shoot control plane",
+			Fn:           botanist.DestroyPrivateLink,
+			SkipIf:       o.Shoot.IsWorkerless || privateLinkEnabled,
+			Dependencies: flow.NewTaskIDs(deployInternalDomainDNSRecord),
+		})
 		deploySourceBackupEntry = g.Add(flow.Task{
 			Name:   "Deploying source backup entry",
 			Fn:     botanist.DeploySourceBackupEntry,
@@ -270,11 +286,17 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       !allowBackup || skipReadiness || !botanist.IsRestorePhase(),
 			Dependencies: flow.NewTaskIDs(destroySourceBackupEntry),
 		})
+		migrateEtcdMembersToZones = g.Add(flow.Task{
+			Name:         "Migrating main and events etcd members to multiple zones",
+			Fn:           flow.TaskFn(botanist.MigrateEtcdMembersToZones).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
+			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness || !v1beta1helper.IsMultiZonalShootControlPlane(botanist.Shoot.GetInfo()),
+			Dependencies: flow.NewTaskIDs(deployETCD),
+		})
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
 			Fn:           botanist.WaitUntilEtcdsReady,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
-			Dependencies: flow.NewTaskIDs(deployETCD),
+			Dependencies: flow.NewTaskIDs(deployETCD, migrateEtcdMembersToZones),
 		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name:         "Deploying extension resources before kube-apiserver",
@@ -489,8 +511,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, waitUntilGardenerResourceManagerReady),
 		})
 		_ = g.Add(flow.Task{
-			Name:         "Deploying dependency-watchdog shoot access resources",
-			Fn:           flow.TaskFn(botanist.DeployDependencyWatchdogAccess).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Name:         "Destroying dependency-watchdog shoot access resources",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.DependencyWatchdogAccess.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, waitUntilGardenerResourceManagerReady),
 		})
 		deployKubeControllerManager = g.Add(flow.Task{
@@ -639,6 +661,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, waitUntilOperatingSystemConfigReady, waitUntilShootNamespacesReady),
 		})
+		deployAdditionalSystemComponents = g.Add(flow.Task{
+			Name:         "Deploying additional system components",
+			Fn:           flow.TaskFn(botanist.DeployAdditionalSystemComponents).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
+		})
 		deployKubeProxy = g.Add(flow.Task{
 			Name:         "Deploying kube-proxy system component",
 			Fn:           flow.TaskFn(botanist.DeployKubeProxy).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -693,6 +721,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		deployAddonCharts = g.Add(flow.Task{
+			Name:         "Deploying addon charts",
+			Fn:           flow.TaskFn(botanist.DeployAddonCharts).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
+		})
 		deployManagedResourceForGardenerNodeAgent = g.Add(flow.Task{
 			Name:         "Deploying managed resources for the gardener-node-agent",
 			Fn:           flow.TaskFn(botanist.DeployManagedResourceForGardenerNodeAgent).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -710,10 +744,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			deployMetricsServer,
 			deployVPNShoot,
 			deployNodeProblemDetector,
+			deployAdditionalSystemComponents,
 			deployKubeProxy,
 			deployBlackboxExporter,
 			deployKubernetesDashboard,
 			deployNginxIngressAddon,
+			deployAddonCharts,
 		)
 
 		scaleClusterAutoscalerToZero = g.Add(flow.Task{
@@ -726,7 +762,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name:         "Deploying machine-controller-manager",
 			Fn:           flow.TaskFn(botanist.DeployMachineControllerManager),
 			SkipIf:       o.Shoot.IsWorkerless,
-			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, deployReferencedResources, waitUntilInfrastructureReady, initializeShootClients, waitUntilOperatingSystemConfigReady, waitUntilNetworkIsReady, createNewServiceAccountSecrets, scaleClusterAutoscalerToZero),
+			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, deployReferencedResources, waitUntilInfrastructureReady, deployPrivateLink, initializeShootClients, waitUntilOperatingSystemConfigReady, waitUntilNetworkIsReady, createNewServiceAccountSecrets, scaleClusterAutoscalerToZero),
 		})
 		deployWorker = g.Add(flow.Task{
 			Name:         "Configuring shoot worker pools",
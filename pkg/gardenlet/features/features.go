@@ -27,5 +27,7 @@ func GetFeatures() []featuregate.Feature {
 		features.IPv6SingleStack,
 		features.ShootManagedIssuer,
 		features.VPAAndHPAForAPIServer,
+		features.MutationAttribution,
+		features.DNSRecordHealthProbes,
 	}
 }
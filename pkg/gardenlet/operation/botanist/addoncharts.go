@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/shoot/addonchart"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// DefaultAddonCharts returns a registry of deployers for the addon charts configured in the shoot specification.
+func (b *Botanist) DefaultAddonCharts() (*component.ComponentRegistry, error) {
+	registry := component.NewComponentRegistry()
+
+	addons := b.Shoot.GetInfo().Spec.Addons
+	if addons == nil || len(addons.Charts) == 0 {
+		return registry, nil
+	}
+
+	helmRegistry, err := oci.NewHelmRegistry()
+	if err != nil {
+		return nil, err
+	}
+	mirror, err := gardenlethelper.ArtifactMirror(b.Config)
+	if err != nil {
+		return nil, err
+	}
+	if mirror != nil {
+		helmRegistry.WithMirror(mirror)
+	}
+	chartRenderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: b.ShootVersion()})
+
+	for _, chart := range addons.Charts {
+		var chartValues map[string]any
+		if chart.Values != nil && len(chart.Values.Raw) > 0 {
+			if err := json.Unmarshal(chart.Values.Raw, &chartValues); err != nil {
+				return nil, fmt.Errorf("failed unmarshalling values of addon %q: %w", chart.Name, err)
+			}
+		}
+
+		values := addonchart.Values{
+			Name:      chart.Name,
+			Namespace: ptr.Deref(chart.Namespace, metav1.NamespaceSystem),
+			OCIRepository: gardencorev1.OCIRepository{
+				Ref:        chart.OCIRepository.Ref,
+				Repository: chart.OCIRepository.Repository,
+				Tag:        chart.OCIRepository.Tag,
+				Digest:     chart.OCIRepository.Digest,
+			},
+			ChartValues: chartValues,
+		}
+
+		if err := registry.Register(chart.Name, addonchart.New(b.SeedClientSet.Client(), chartRenderer, helmRegistry, b.Shoot.SeedNamespace, values)); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// DeployAddonCharts deploys the addon charts and deletes those which are no longer configured.
+func (b *Botanist) DeployAddonCharts(ctx context.Context) error {
+	registry := b.Shoot.Components.Addons.Charts
+	if err := registry.Deploy(ctx); err != nil {
+		return err
+	}
+
+	return addonchart.DeleteStale(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, sets.New(registry.Names()...))
+}
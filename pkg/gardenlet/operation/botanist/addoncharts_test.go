@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("AddonCharts", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+
+		fakeClient client.Client
+		botanist   *Botanist
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(),
+			Shoot: &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				Components: &shootpkg.Components{
+					Addons: &shootpkg.Addons{},
+				},
+			},
+		}}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#DefaultAddonCharts", func() {
+		It("should return an empty registry when no addon charts are configured", func() {
+			registry, err := botanist.DefaultAddonCharts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.Names()).To(BeEmpty())
+		})
+
+		Context("with addon charts", func() {
+			BeforeEach(func() {
+				shoot.Spec.Addons = &gardencorev1beta1.Addons{
+					Charts: []gardencorev1beta1.AddonChart{
+						{Name: "foo", OCIRepository: gardencorev1beta1.OCIRepository{Ref: ptr.To("registry.example.com/charts/foo:1.0.0")}},
+						{Name: "bar", Namespace: ptr.To("bar"), OCIRepository: gardencorev1beta1.OCIRepository{Ref: ptr.To("registry.example.com/charts/bar:1.0.0")}, Values: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}},
+					},
+				}
+			})
+
+			It("should register a deployer for every addon chart", func() {
+				registry, err := botanist.DefaultAddonCharts()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(registry.Names()).To(Equal([]string{"bar", "foo"}))
+			})
+
+			It("should fail when the values cannot be unmarshalled", func() {
+				shoot.Spec.Addons.Charts[1].Values = &runtime.RawExtension{Raw: []byte(`["foo"]`)}
+
+				_, err := botanist.DefaultAddonCharts()
+				Expect(err).To(MatchError(ContainSubstring(`failed unmarshalling values of addon "bar"`)))
+			})
+		})
+	})
+
+	Describe("#DeployAddonCharts", func() {
+		It("should delete the ManagedResources of addon charts which are no longer configured", func() {
+			stale := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      "addon-chart-stale",
+				Namespace: namespace,
+				Labels:    map[string]string{"gardener.cloud/role": "shoot-addon-chart"},
+			}}
+			Expect(fakeClient.Create(ctx, stale)).To(Succeed())
+
+			var err error
+			botanist.Shoot.Components.Addons.Charts, err = botanist.DefaultAddonCharts()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(botanist.DeployAddonCharts(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(stale), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+	})
+})
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// VerifyArtifactsAvailable checks that all container images and Helm charts required for the shoot have been synced to
+// the registry mirror of the seed. It is a no-op if the artifact pre-sync is not configured.
+func (b *Botanist) VerifyArtifactsAvailable(ctx context.Context) error {
+	if b.ArtifactMirror == nil {
+		return nil
+	}
+
+	references, err := gardenerutils.ImageReferences(b.ImageVector(), b.SeedVersion(), b.ShootVersion())
+	if err != nil {
+		return fmt.Errorf("failed computing required images: %w", err)
+	}
+	references = append(references, gardenerutils.AddonChartReferences(b.Shoot.GetInfo())...)
+
+	var missing []string
+	for _, reference := range references {
+		exists, err := b.ArtifactMirror.Exists(ctx, reference)
+		if err != nil {
+			return fmt.Errorf("failed checking whether %s is available in registry mirror: %w", reference, err)
+		}
+		if !exists {
+			missing = append(missing, reference)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("required artifacts are not yet available in registry mirror: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	fakeoci "github.com/gardener/gardener/pkg/utils/oci/fake"
+)
+
+var _ = Describe("ArtifactPreSync", func() {
+	var (
+		ctx = context.TODO()
+
+		mirror   *fakeoci.Mirror
+		botanist *Botanist
+		shoot    *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		mirror = fakeoci.NewMirror()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			SeedClientSet:  kubernetesfake.NewClientSetBuilder().WithVersion("1.30.0").Build(),
+			Shoot:          &shootpkg.Shoot{},
+			ArtifactMirror: mirror,
+		}}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				Addons: &gardencorev1beta1.Addons{
+					Charts: []gardencorev1beta1.AddonChart{{
+						Name:          "foo",
+						OCIRepository: gardencorev1beta1.OCIRepository{Ref: ptr.To("example.com/charts/foo:1.0.0")},
+					}},
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#VerifyArtifactsAvailable", func() {
+		It("should do nothing if no artifact mirror is configured", func() {
+			botanist.ArtifactMirror = nil
+
+			Expect(botanist.VerifyArtifactsAvailable(ctx)).To(Succeed())
+		})
+
+		It("should return an error listing the missing artifacts", func() {
+			err := botanist.VerifyArtifactsAvailable(ctx)
+			Expect(err).To(MatchError(ContainSubstring("required artifacts are not yet available in registry mirror")))
+			Expect(err).To(MatchError(ContainSubstring("example.com/charts/foo:1.0.0")))
+		})
+
+		It("should succeed if all artifacts are available", func() {
+			images, err := gardenerutils.ImageReferences(botanist.ImageVector(), "1.30.0", "1.31.1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(images).NotTo(BeEmpty())
+
+			for _, image := range images {
+				mirror.AddArtifact(image)
+			}
+			mirror.AddArtifact("example.com/charts/foo:1.0.0")
+
+			Expect(botanist.VerifyArtifactsAvailable(ctx)).To(Succeed())
+		})
+	})
+})
@@ -42,7 +42,11 @@ func (b *Botanist) DefaultCoreBackupEntry() corebackupentry.Interface {
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployBackupEntry(ctx context.Context) error {
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.BackupEntry.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.BackupEntry.Restore(ctx, shootState)
 	}
 	return b.Shoot.Components.BackupEntry.Deploy(ctx)
 }
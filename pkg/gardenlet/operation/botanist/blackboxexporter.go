@@ -10,6 +10,7 @@ import (
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/blackboxexporter"
@@ -21,6 +22,22 @@ import (
 
 // DefaultBlackboxExporterControlPlane returns a deployer for the blackbox-exporter.
 func (b *Botanist) DefaultBlackboxExporterControlPlane() (component.DeployWaiter, error) {
+	var (
+		probes    = v1beta1helper.GetShootObservabilityProbes(b.Shoot.GetInfo())
+		podLabels = map[string]string{
+			// needed to talk to shoot API server via istio-ingressgateway
+			v1beta1constants.LabelNetworkPolicyToDNS:            v1beta1constants.LabelNetworkPolicyAllowed,
+			v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
+			gardenerutils.NetworkPolicyLabel(v1beta1constants.LabelNetworkPolicyIstioIngressNamespaceAlias+"-istio-ingressgateway", 9443): v1beta1constants.LabelNetworkPolicyAllowed,
+			gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port):                   v1beta1constants.LabelNetworkPolicyAllowed,
+		}
+	)
+
+	if len(probes) > 0 {
+		// needed to probe load balancers of the shoot which are only reachable via private networks
+		podLabels[v1beta1constants.LabelNetworkPolicyToPrivateNetworks] = v1beta1constants.LabelNetworkPolicyAllowed
+	}
+
 	return sharedcomponent.NewBlackboxExporter(
 		b.SeedClientSet.Client(),
 		b.SecretsManager,
@@ -29,16 +46,11 @@ func (b *Botanist) DefaultBlackboxExporterControlPlane() (component.DeployWaiter
 			ClusterType:       component.ClusterTypeSeed,
 			VPAEnabled:        true,
 			KubernetesVersion: b.Seed.KubernetesVersion,
-			PodLabels: map[string]string{
-				// needed to talk to shoot API server via istio-ingressgateway
-				v1beta1constants.LabelNetworkPolicyToDNS:            v1beta1constants.LabelNetworkPolicyAllowed,
-				v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
-				gardenerutils.NetworkPolicyLabel(v1beta1constants.LabelNetworkPolicyIstioIngressNamespaceAlias+"-istio-ingressgateway", 9443): v1beta1constants.LabelNetworkPolicyAllowed,
-				gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port):                   v1beta1constants.LabelNetworkPolicyAllowed,
-			},
+			PodLabels:         podLabels,
 			PriorityClassName: v1beta1constants.PriorityClassNameShootControlPlane100,
 			Config:            controlplaneblackboxexporter.Config(),
-			ScrapeConfigs:     controlplaneblackboxexporter.ScrapeConfig(b.Shoot.SeedNamespace, monitoringv1alpha1.Target("https://"+gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain)+"/healthz")),
+			ScrapeConfigs:     controlplaneblackboxexporter.ScrapeConfig(b.Shoot.SeedNamespace, monitoringv1alpha1.Target("https://"+gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain)+"/healthz"), probes),
+			PrometheusRules:   controlplaneblackboxexporter.PrometheusRule(b.Shoot.SeedNamespace, probes),
 			Replicas:          b.Shoot.GetReplicas(1),
 		},
 	)
@@ -54,6 +54,11 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		}
 	}
 
+	certificateValidities, err := b.certificateValidities()
+	if err != nil {
+		return nil, err
+	}
+
 	o.SecretsManager, err = secretsmanager.New(
 		ctx,
 		b.Logger.WithName("secretsmanager"),
@@ -62,8 +67,10 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		b.Shoot.SeedNamespace,
 		v1beta1constants.SecretManagerIdentityGardenlet,
 		secretsmanager.Config{
-			CASecretAutoRotation: false,
-			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			CASecretAutoRotation:  false,
+			SecretNamesToTimes:    b.lastSecretRotationStartTimes(),
+			CertificateValidities: certificateValidities,
+			RecordRotationHistory: true,
 		},
 	)
 	if err != nil {
@@ -89,6 +96,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.Extensions.PrivateLink = b.DefaultPrivateLink()
 		o.Shoot.Components.Extensions.Worker = b.DefaultWorker()
 	}
 
@@ -212,6 +220,10 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		if err != nil {
 			return nil, err
 		}
+		o.Shoot.Components.SystemComponents.Additional, err = b.DefaultAdditionalSystemComponents()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// other components
@@ -222,6 +234,10 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 
 	// Addons
 	if !o.Shoot.IsWorkerless {
+		o.Shoot.Components.Addons.Charts, err = b.DefaultAddonCharts()
+		if err != nil {
+			return nil, err
+		}
 		o.Shoot.Components.Addons.KubernetesDashboard, err = b.DefaultKubernetesDashboard()
 		if err != nil {
 			return nil, err
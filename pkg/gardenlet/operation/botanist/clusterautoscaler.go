@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/autoscaling/clusterautoscaler"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -23,7 +24,7 @@ import (
 
 // DefaultClusterAutoscaler returns a deployer for the cluster-autoscaler.
 func (b *Botanist) DefaultClusterAutoscaler() (clusterautoscaler.Interface, error) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameClusterAutoscaler, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	image, err := b.ImageVector().FindImage(imagevector.ImageNameClusterAutoscaler, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +40,7 @@ func (b *Botanist) DefaultClusterAutoscaler() (clusterautoscaler.Interface, erro
 		b.SecretsManager,
 		image.String(),
 		b.Shoot.GetReplicas(1),
-		b.Shoot.GetInfo().Spec.Kubernetes.ClusterAutoscaler,
+		v1beta1helper.EffectiveClusterAutoscalerConfig(b.Shoot.GetInfo().Spec.Kubernetes.ClusterAutoscaler, b.Shoot.CloudProfile.Spec.ClusterAutoscalerProfiles),
 		ptr.Deref(maxNodesTotal, 0),
 		b.Seed.KubernetesVersion,
 	), nil
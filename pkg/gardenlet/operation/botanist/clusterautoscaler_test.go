@@ -61,6 +61,7 @@ var _ = Describe("ClusterAutoscaler", func() {
 			kubernetesClient.EXPECT().Client()
 			kubernetesClient.EXPECT().Version().Return("1.25.0")
 			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.25.0"}}})
+			botanist.Shoot.CloudProfile = &gardencorev1beta1.CloudProfile{}
 		})
 
 		It("should successfully create a cluster-autoscaler interface", func() {
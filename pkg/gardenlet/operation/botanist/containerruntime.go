@@ -29,7 +29,11 @@ func (b *Botanist) DefaultContainerRuntime() containerruntime.Interface {
 // the Shoot is in the restore phase of the control plane migration
 func (b *Botanist) DeployContainerRuntime(ctx context.Context) error {
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.ContainerRuntime.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.ContainerRuntime.Restore(ctx, shootState)
 	}
 	return b.Shoot.Components.Extensions.ContainerRuntime.Deploy(ctx)
 }
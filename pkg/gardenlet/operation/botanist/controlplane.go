@@ -27,7 +27,7 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
-func (b *Botanist) determineControllerReplicas(ctx context.Context, deploymentName string, defaultReplicas int32, controlledByDependencyWatchdog bool) (int32, error) {
+func (b *Botanist) determineControllerReplicas(ctx context.Context, deploymentName string, defaultReplicas int32, controlledByNodeLeaseProbe bool) (int32, error) {
 	isCreateOrRestoreOperation := b.Shoot.GetInfo().Status.LastOperation != nil &&
 		(b.Shoot.GetInfo().Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate ||
 			b.Shoot.GetInfo().Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeRestore)
@@ -39,8 +39,8 @@ func (b *Botanist) determineControllerReplicas(ctx context.Context, deploymentNa
 		// so keep the replicas which are already available.
 		return kubernetesutils.CurrentReplicaCountForDeployment(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, deploymentName)
 	}
-	if controlledByDependencyWatchdog && !isCreateOrRestoreOperation && !b.Shoot.HibernationEnabled && !b.Shoot.GetInfo().Status.IsHibernated {
-		// The replicas of the component are controlled by dependency-watchdog and
+	if controlledByNodeLeaseProbe && !isCreateOrRestoreOperation && !b.Shoot.HibernationEnabled && !b.Shoot.GetInfo().Status.IsHibernated {
+		// The replicas of the component are controlled by the node lease probe of the shoot care controller and
 		// Shoot is being reconciled with .spec.hibernation.enabled=.status.isHibernated=false,
 		// so keep the replicas which are already available.
 		return kubernetesutils.CurrentReplicaCountForDeployment(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, deploymentName)
@@ -187,7 +187,11 @@ func (b *Botanist) DeployControlPlaneExposure(ctx context.Context) error {
 
 func (b *Botanist) deployOrRestoreControlPlane(ctx context.Context, controlPlane extensionscontrolplane.Interface) error {
 	if b.IsRestorePhase() {
-		return controlPlane.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return controlPlane.Restore(ctx, shootState)
 	}
 	return controlPlane.Deploy(ctx)
 }
@@ -195,7 +199,11 @@ func (b *Botanist) deployOrRestoreControlPlane(ctx context.Context, controlPlane
 // RestoreControlPlane restores the ControlPlane custom resource (purpose normal)
 func (b *Botanist) RestoreControlPlane(ctx context.Context) error {
 	b.Shoot.Components.Extensions.ControlPlane.SetInfrastructureProviderStatus(b.Shoot.Components.Extensions.Infrastructure.ProviderStatus())
-	return b.Shoot.Components.Extensions.ControlPlane.Restore(ctx, b.Shoot.GetShootState())
+	shootState, err := b.Shoot.LoadShootState(ctx)
+	if err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.ControlPlane.Restore(ctx, shootState)
 }
 
 // RestartControlPlanePods restarts (deletes) pods of the shoot control plane.
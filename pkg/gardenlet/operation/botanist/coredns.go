@@ -25,7 +25,7 @@ import (
 
 // DefaultCoreDNS returns a deployer for the CoreDNS.
 func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameCoredns, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	image, err := b.findImageForSystemComponents(imagevector.ImageNameCoredns, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +38,7 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		Image:          image.String(),
 		PodNetworkCIDR: b.Shoot.Networks.Pods.String(),
 		// NodeNetworkCIDR is set on deployment to handle dynamice node network CIDRs
+		AdditionalNodeNetworkCIDRs:      b.Shoot.GetInfo().Spec.Networking.AdditionalNodes,
 		AutoscalingMode:                 gardencorev1beta1.CoreDNSAutoscalingModeHorizontal,
 		SearchPathRewritesEnabled:       v1beta1helper.IsCoreDNSRewritingEnabled(features.DefaultFeatureGate.Enabled(features.CoreDNSQueryRewriting), b.Shoot.GetInfo().GetAnnotations()),
 		SearchPathRewriteCommonSuffixes: getCommonSuffixesForRewriting(b.Shoot.GetInfo().Spec.SystemComponents),
@@ -49,7 +50,7 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 	}
 
 	if v1beta1helper.IsCoreDNSAutoscalingModeUsed(b.Shoot.GetInfo().Spec.SystemComponents, gardencorev1beta1.CoreDNSAutoscalingModeClusterProportional) {
-		image, err = imagevector.ImageVector().FindImage(imagevector.ImageNameClusterProportionalAutoscaler, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+		image, err = b.findImageForSystemComponents(imagevector.ImageNameClusterProportionalAutoscaler, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 		if err != nil {
 			return nil, err
 		}
@@ -5,9 +5,6 @@
 package botanist
 
 import (
-	"context"
-
-	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/dependencywatchdog"
 )
@@ -24,12 +21,3 @@ func (b *Botanist) DefaultDependencyWatchdogAccess() component.Deployer {
 		},
 	)
 }
-
-// DeployDependencyWatchdogAccess deploys the DependencyWatchdogAccess resources.
-func (b *Botanist) DeployDependencyWatchdogAccess(ctx context.Context) error {
-	if !v1beta1helper.SeedSettingDependencyWatchdogProberEnabled(b.Seed.GetInfo().Spec.Settings) {
-		return b.Shoot.Components.DependencyWatchdogAccess.Destroy(ctx)
-	}
-
-	return b.Shoot.Components.DependencyWatchdogAccess.Deploy(ctx)
-}
@@ -8,9 +8,11 @@ import (
 	"context"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	extensionsdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -32,6 +34,7 @@ func (b *Botanist) DefaultExternalDNSRecord() extensionsdnsrecord.Interface {
 		}
 		values.SecretData = b.Shoot.ExternalDomain.SecretData
 		values.DNSName = gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain)
+		values.HealthProbe = apiServerDNSRecordHealthProbe()
 	}
 
 	return extensionsdnsrecord.New(
@@ -65,6 +68,7 @@ func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 		}
 		values.SecretData = b.Garden.InternalDomain.SecretData
 		values.DNSName = gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain)
+		values.HealthProbe = apiServerDNSRecordHealthProbe()
 	}
 
 	return extensionsdnsrecord.New(
@@ -77,6 +81,13 @@ func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 	)
 }
 
+func apiServerDNSRecordHealthProbe() *extensionsv1alpha1.DNSRecordHealthProbe {
+	if !features.DefaultFeatureGate.Enabled(features.DNSRecordHealthProbes) {
+		return nil
+	}
+	return &extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}
+}
+
 // DeployOrDestroyExternalDNSRecord deploys, restores, or destroys the external DNSRecord and waits for the operation to complete.
 func (b *Botanist) DeployOrDestroyExternalDNSRecord(ctx context.Context) error {
 	if b.NeedsExternalDNS() {
@@ -88,6 +99,9 @@ func (b *Botanist) DeployOrDestroyExternalDNSRecord(ctx context.Context) error {
 // DeployOrDestroyInternalDNSRecord deploys, restores, or destroys the internal DNSRecord and waits for the operation to complete.
 func (b *Botanist) DeployOrDestroyInternalDNSRecord(ctx context.Context) error {
 	if b.NeedsInternalDNS() {
+		if err := b.targetInternalDNSRecordAtPrivateLink(ctx); err != nil {
+			return err
+		}
 		return b.deployInternalDNSRecord(ctx)
 	}
 	return b.DestroyInternalDNSRecord(ctx)
@@ -143,7 +157,11 @@ func (b *Botanist) MigrateInternalDNSRecord(ctx context.Context) error {
 
 func (b *Botanist) deployOrRestoreDNSRecord(ctx context.Context, dnsRecord component.DeployMigrateWaiter) error {
 	if b.IsRestorePhase() {
-		return dnsRecord.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return dnsRecord.Restore(ctx, shootState)
 	}
 	return dnsRecord.Deploy(ctx)
 }
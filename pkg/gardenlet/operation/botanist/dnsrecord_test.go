@@ -32,6 +32,7 @@ import (
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
 	mockdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord/mock"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -196,6 +197,12 @@ var _ = Describe("dnsrecord", func() {
 			}))
 		})
 
+		It("should declare a health probe if the DNSRecordHealthProbes feature gate is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.DNSRecordHealthProbes, true))
+
+			Expect(b.DefaultExternalDNSRecord().GetValues().HealthProbe).To(Equal(&extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}))
+		})
+
 		DescribeTable("should set AnnotateOperation value to true",
 			func(mutateShootFn func()) {
 				mutateShootFn()
@@ -299,6 +306,12 @@ var _ = Describe("dnsrecord", func() {
 			}))
 		})
 
+		It("should declare a health probe if the DNSRecordHealthProbes feature gate is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.DNSRecordHealthProbes, true))
+
+			Expect(b.DefaultInternalDNSRecord().GetValues().HealthProbe).To(Equal(&extensionsv1alpha1.DNSRecordHealthProbe{Port: 443}))
+		})
+
 		DescribeTable("should set AnnotateOperation value to true",
 			func(mutateShootFn func()) {
 				mutateShootFn()
@@ -6,7 +6,9 @@ package botanist
 
 import (
 	"context"
+	"fmt"
 
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,7 +34,9 @@ var NewEtcd = etcd.New
 
 // DefaultEtcd returns a deployer for the etcd.
 func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, error) {
-	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class)
+	etcdConfig, etcdStatus := getETCDConfigAndStatus(b.Shoot.GetInfo(), role)
+
+	defragmentationSchedule, err := determineDefragmentationSchedule(b.Shoot.GetInfo(), b.ManagedSeed, class, etcdConfig, etcdStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +51,15 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 		hvpaEnabled = features.DefaultFeatureGate.Enabled(features.HVPAForShootedSeed)
 	}
 
+	var (
+		autoCompactionMode      *druidv1alpha1.CompactionMode
+		autoCompactionRetention *string
+	)
+	if etcdConfig != nil && etcdConfig.Compaction != nil {
+		autoCompactionMode = ptr.To(druidv1alpha1.CompactionMode(ptr.Deref(etcdConfig.Compaction.Mode, gardencorev1beta1.ETCDCompactionModePeriodic)))
+		autoCompactionRetention = etcdConfig.Compaction.Retention
+	}
+
 	e := NewEtcd(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -58,6 +71,8 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 			Replicas:                    replicas,
 			StorageCapacity:             b.Seed.GetValidVolumeSize("10Gi"),
 			DefragmentationSchedule:     &defragmentationSchedule,
+			AutoCompactionMode:          autoCompactionMode,
+			AutoCompactionRetention:     autoCompactionRetention,
 			CARotationPhase:             v1beta1helper.GetShootCARotationPhase(b.Shoot.GetInfo().Status.Credentials),
 			RuntimeKubernetesVersion:    b.Seed.KubernetesVersion,
 			HVPAEnabled:                 hvpaEnabled,
@@ -249,11 +264,34 @@ func determineBackupSchedule(shoot *gardencorev1beta1.Shoot) (string, error) {
 	)
 }
 
-func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSeed *seedmanagementv1alpha1.ManagedSeed, class etcd.Class) (string, error) {
-	scheduleFormat := "%d %d */3 * *"
+func determineDefragmentationSchedule(
+	shoot *gardencorev1beta1.Shoot,
+	managedSeed *seedmanagementv1alpha1.ManagedSeed,
+	class etcd.Class,
+	etcdConfig *gardencorev1beta1.ETCDConfig,
+	etcdStatus *gardencorev1beta1.ETCDStatus,
+) (
+	string,
+	error,
+) {
+	intervalDays := int32(3)
 	if managedSeed != nil && class == etcd.ClassImportant {
 		// defrag important etcds of ManagedSeeds daily in the maintenance window
-		scheduleFormat = "%d %d * * *"
+		intervalDays = 1
+	}
+
+	if etcdConfig != nil && etcdConfig.Defragmentation != nil {
+		intervalDays = ptr.Deref(etcdConfig.Defragmentation.IntervalDays, intervalDays)
+
+		if threshold := etcdConfig.Defragmentation.DBSizeThreshold; threshold != nil && etcdStatus != nil && etcdStatus.DBSize != nil && etcdStatus.DBSize.Cmp(*threshold) >= 0 {
+			// defrag daily in the maintenance window as long as the database exceeds the configured threshold
+			intervalDays = 1
+		}
+	}
+
+	scheduleFormat := "%d %d * * *"
+	if intervalDays > 1 {
+		scheduleFormat = fmt.Sprintf("%%d %%d */%d * *", intervalDays)
 	}
 
 	return timewindow.DetermineSchedule(
@@ -266,6 +304,34 @@ func determineDefragmentationSchedule(shoot *gardencorev1beta1.Shoot, managedSee
 	)
 }
 
+// getETCDConfigAndStatus returns the configuration of the etcd with the given role from the Shoot specification and
+// the information about it from the Shoot status.
+func getETCDConfigAndStatus(shoot *gardencorev1beta1.Shoot, role string) (*gardencorev1beta1.ETCDConfig, *gardencorev1beta1.ETCDStatus) {
+	var (
+		etcdConfig *gardencorev1beta1.ETCDConfig
+		etcdStatus *gardencorev1beta1.ETCDStatus
+	)
+
+	switch role {
+	case v1beta1constants.ETCDRoleMain:
+		if shoot.Spec.Kubernetes.ETCD != nil {
+			etcdConfig = shoot.Spec.Kubernetes.ETCD.Main
+		}
+		if shoot.Status.ETCD != nil {
+			etcdStatus = shoot.Status.ETCD.Main
+		}
+	case v1beta1constants.ETCDRoleEvents:
+		if shoot.Spec.Kubernetes.ETCD != nil {
+			etcdConfig = shoot.Spec.Kubernetes.ETCD.Events
+		}
+		if shoot.Status.ETCD != nil {
+			etcdStatus = shoot.Status.ETCD.Events
+		}
+	}
+
+	return etcdConfig, etcdStatus
+}
+
 func getEtcdReplicas(shoot *gardencorev1beta1.Shoot) int32 {
 	if v1beta1helper.IsHAControlPlaneConfigured(shoot) {
 		return 3
@@ -21,6 +21,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -226,6 +227,66 @@ var _ = Describe("Etcd", func() {
 			})
 		})
 
+		Context("etcd configuration", func() {
+			var values etcd.Values
+
+			BeforeEach(func() {
+				DeferCleanup(test.WithVars(&NewEtcd, func(_ logr.Logger, _ client.Client, _ string, _ secretsmanager.Interface, v etcd.Values) etcd.Interface {
+					values = v
+					return mocketcd.NewMockInterface(ctrl)
+				}))
+
+				botanist.Shoot.GetInfo().Spec.Kubernetes.ETCD = &gardencorev1beta1.ETCD{
+					Main: &gardencorev1beta1.ETCDConfig{
+						Compaction: &gardencorev1beta1.ETCDCompaction{Retention: ptr.To("1h")},
+						Defragmentation: &gardencorev1beta1.ETCDDefragmentation{
+							IntervalDays:    ptr.To[int32](7),
+							DBSizeThreshold: ptr.To(resource.MustParse("4Gi")),
+						},
+					},
+					Events: &gardencorev1beta1.ETCDConfig{
+						Compaction: &gardencorev1beta1.ETCDCompaction{Mode: ptr.To(gardencorev1beta1.ETCDCompactionModeRevision), Retention: ptr.To("1000")},
+					},
+				}
+			})
+
+			It("should pass the configuration of the main etcd", func() {
+				Expect(botanist.DefaultEtcd("main", class)).NotTo(BeNil())
+
+				Expect(values.AutoCompactionMode).To(PointTo(Equal(druidv1alpha1.Periodic)))
+				Expect(values.AutoCompactionRetention).To(PointTo(Equal("1h")))
+				Expect(values.DefragmentationSchedule).To(PointTo(Equal("34 12 */7 * *")))
+			})
+
+			It("should pass the configuration of the events etcd", func() {
+				Expect(botanist.DefaultEtcd("events", class)).NotTo(BeNil())
+
+				Expect(values.AutoCompactionMode).To(PointTo(Equal(druidv1alpha1.Revision)))
+				Expect(values.AutoCompactionRetention).To(PointTo(Equal("1000")))
+				Expect(values.DefragmentationSchedule).To(PointTo(Equal("34 12 */3 * *")))
+			})
+
+			It("should defragment daily if the database size exceeds the threshold", func() {
+				botanist.Shoot.GetInfo().Status.ETCD = &gardencorev1beta1.ShootETCDStatus{
+					Main: &gardencorev1beta1.ETCDStatus{DBSize: ptr.To(resource.MustParse("5Gi"))},
+				}
+
+				Expect(botanist.DefaultEtcd("main", class)).NotTo(BeNil())
+
+				Expect(values.DefragmentationSchedule).To(PointTo(Equal("34 12 * * *")))
+			})
+
+			It("should keep the interval if the database size is below the threshold", func() {
+				botanist.Shoot.GetInfo().Status.ETCD = &gardencorev1beta1.ShootETCDStatus{
+					Main: &gardencorev1beta1.ETCDStatus{DBSize: ptr.To(resource.MustParse("3Gi"))},
+				}
+
+				Expect(botanist.DefaultEtcd("main", class)).NotTo(BeNil())
+
+				Expect(values.DefragmentationSchedule).To(PointTo(Equal("34 12 */7 * *")))
+			})
+		})
+
 		It("should return an error because the maintenance time window cannot be parsed", func() {
 			defer test.WithFeatureGate(features.DefaultFeatureGate, features.HVPA, true)()
 			botanist.Shoot.GetInfo().Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// exposed for testing
+var (
+	// IntervalWaitEtcdMemberMigrated is the interval when waiting until an etcd member which was moved to another zone
+	// is ready again.
+	IntervalWaitEtcdMemberMigrated = 5 * time.Second
+	// TimeoutWaitEtcdMemberMigrated is the timeout when waiting until an etcd member which was moved to another zone is
+	// ready again.
+	TimeoutWaitEtcdMemberMigrated = 10 * time.Minute
+)
+
+// MigrateEtcdMembersToZones spreads the members of the main and events etcd across zones if the shoot's control plane
+// has failure tolerance type 'zone'. This is required when the failure tolerance type of an existing shoot is changed
+// from 'node' to 'zone' since the volumes of all members are located in a single zone and hence the members cannot be
+// spread without recreating their volumes. Members are migrated one after the other, i.e., the etcd never loses its
+// quorum. A migrated member joins the cluster again with an empty data directory and is synced by its peers.
+func (b *Botanist) MigrateEtcdMembersToZones(ctx context.Context) error {
+	if !v1beta1helper.IsMultiZonalShootControlPlane(b.Shoot.GetInfo()) {
+		return nil
+	}
+
+	return flow.Parallel(
+		func(ctx context.Context) error {
+			return b.migrateEtcdMembersToZones(ctx, v1beta1constants.ETCDMain)
+		},
+		func(ctx context.Context) error {
+			return b.migrateEtcdMembersToZones(ctx, v1beta1constants.ETCDEvents)
+		},
+	)(ctx)
+}
+
+func (b *Botanist) migrateEtcdMembersToZones(ctx context.Context, name string) error {
+	var (
+		log        = b.Logger.WithValues("etcd", client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: name})
+		seedClient = b.SeedClientSet.Client()
+	)
+
+	for {
+		statefulSet := &appsv1.StatefulSet{}
+		if err := seedClient.Get(ctx, client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: name}, statefulSet); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		if statefulSet.Spec.Replicas == nil || *statefulSet.Spec.Replicas < 3 {
+			return nil
+		}
+
+		// Members are only moved once the pod template spreads them across zones. Otherwise, the recreated member might
+		// be scheduled to the very same zone again.
+		if !slices.ContainsFunc(statefulSet.Spec.Template.Spec.TopologySpreadConstraints, func(constraint corev1.TopologySpreadConstraint) bool {
+			return constraint.TopologyKey == corev1.LabelTopologyZone
+		}) {
+			return fmt.Errorf("statefulset %s does not spread its pods across zones yet", client.ObjectKeyFromObject(statefulSet))
+		}
+
+		pod, pvcNames, err := findEtcdMemberToMigrate(ctx, seedClient, statefulSet)
+		if err != nil {
+			return err
+		}
+
+		if pod == nil {
+			return nil
+		}
+
+		log.Info("Moving etcd member to another zone by recreating its volume", "pod", client.ObjectKeyFromObject(pod))
+		if err := migrateEtcdMember(ctx, log, seedClient, pod, pvcNames); err != nil {
+			return fmt.Errorf("failed moving etcd member %s to another zone: %w", client.ObjectKeyFromObject(pod), err)
+		}
+		log.Info("Successfully moved etcd member to another zone", "pod", client.ObjectKeyFromObject(pod))
+	}
+}
+
+// findEtcdMemberToMigrate returns the member with the highest ordinal whose volume is located in a zone which is
+// already used by a member with a lower ordinal. It also returns the names of the PVCs used by this member.
+func findEtcdMemberToMigrate(ctx context.Context, c client.Client, statefulSet *appsv1.StatefulSet) (*corev1.Pod, []string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(statefulSet.Spec.Selector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList, client.InNamespace(statefulSet.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, nil, err
+	}
+
+	pods := podList.Items
+	slices.SortFunc(pods, func(a, b corev1.Pod) int {
+		return statefulSetPodOrdinal(a.Name) - statefulSetPodOrdinal(b.Name)
+	})
+
+	var (
+		usedZones     = map[string]struct{}{}
+		candidate     *corev1.Pod
+		candidatePVCs []string
+	)
+
+	for i := range pods {
+		pod := &pods[i]
+
+		var pvcNames, zones []string
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			pvcNames = append(pvcNames, volume.PersistentVolumeClaim.ClaimName)
+
+			volumeZones, err := getZonesOfPersistentVolumeClaim(ctx, c, pod.Namespace, volume.PersistentVolumeClaim.ClaimName)
+			if err != nil {
+				return nil, nil, err
+			}
+			zones = append(zones, volumeZones...)
+		}
+
+		var zoneAlreadyUsed bool
+		for _, zone := range zones {
+			if _, ok := usedZones[zone]; ok {
+				zoneAlreadyUsed = true
+			}
+			usedZones[zone] = struct{}{}
+		}
+
+		if zoneAlreadyUsed {
+			candidate, candidatePVCs = pod, pvcNames
+		}
+	}
+
+	return candidate, candidatePVCs, nil
+}
+
+func getZonesOfPersistentVolumeClaim(ctx context.Context, c client.Client, namespace, name string) ([]string, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed getting PVC %s: %w", client.ObjectKeyFromObject(pvc), err)
+	}
+
+	// Skip handling if PV has not been created yet.
+	if pvc.Spec.VolumeName == "" {
+		return nil, nil
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := c.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		return nil, fmt.Errorf("failed getting PV %s: %w", pvc.Spec.VolumeName, err)
+	}
+
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return nil, nil
+	}
+
+	var zones []string
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		zones = append(zones, ExtractZonesFromNodeSelectorTerm(term)...)
+	}
+	return zones, nil
+}
+
+func migrateEtcdMember(ctx context.Context, log logr.Logger, c client.Client, pod *corev1.Pod, pvcNames []string) error {
+	// The PVCs are protected by the `kubernetes.io/pvc-protection` finalizer as long as the pod exists, hence they are
+	// only removed after the pod has been deleted.
+	for _, pvcName := range pvcNames {
+		if err := c.Delete(ctx, &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: pvcName, Namespace: pod.Namespace}}); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting PVC %s: %w", pvcName, err)
+		}
+	}
+
+	if err := c.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting pod: %w", err)
+	}
+
+	oldUIDs := map[types.UID]struct{}{pod.UID: {}}
+
+	return retry.UntilTimeout(ctx, IntervalWaitEtcdMemberMigrated, TimeoutWaitEtcdMemberMigrated, func(ctx context.Context) (bool, error) {
+		newPod := &corev1.Pod{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(pod), newPod); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.MinorError(fmt.Errorf("pod was not recreated yet"))
+			}
+			return retry.SevereError(err)
+		}
+
+		if _, ok := oldUIDs[newPod.UID]; ok {
+			return retry.MinorError(fmt.Errorf("pod was not recreated yet"))
+		}
+
+		// The statefulset controller might recreate the pod before the old PVCs are gone. In this case, the new pod would
+		// use the old volumes, hence it has to be deleted again until the PVCs have been recreated as well.
+		for _, pvcName := range pvcNames {
+			pvc := &corev1.PersistentVolumeClaim{}
+			if err := c.Get(ctx, client.ObjectKey{Namespace: pod.Namespace, Name: pvcName}, pvc); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return retry.SevereError(err)
+			}
+
+			if pvc.DeletionTimestamp != nil {
+				log.Info("Pod was recreated before its volume was deleted, deleting it again", "pod", client.ObjectKeyFromObject(newPod))
+				oldUIDs[newPod.UID] = struct{}{}
+				if err := c.Delete(ctx, newPod); client.IgnoreNotFound(err) != nil {
+					return retry.SevereError(err)
+				}
+				return retry.MinorError(fmt.Errorf("volume %s is still terminating", pvcName))
+			}
+		}
+
+		if !health.IsPodReady(newPod) {
+			return retry.MinorError(fmt.Errorf("pod is not ready yet"))
+		}
+
+		return retry.Ok()
+	})
+}
+
+func statefulSetPodOrdinal(name string) int {
+	ordinal, err := strconv.Atoi(name[strings.LastIndex(name, "-")+1:])
+	if err != nil {
+		return -1
+	}
+	return ordinal
+}
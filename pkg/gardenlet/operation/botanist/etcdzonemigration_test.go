@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("EtcdZoneMigration", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		seedClient client.Client
+		botanist   *Botanist
+		shoot      *gardencorev1beta1.Shoot
+
+		nextZones []string
+		uidCount  int
+	)
+
+	newPersistentVolume := func(name, zone string) *corev1.PersistentVolume {
+		return &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1.PersistentVolumeSpec{
+				NodeAffinity: &corev1.VolumeNodeAffinity{
+					Required: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      corev1.LabelTopologyZone,
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{zone},
+							}},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	newPersistentVolumeClaim := func(name, volumeName string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: volumeName},
+		}
+	}
+
+	newPod := func(name, pvcName string) *corev1.Pod {
+		uidCount++
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{"instance": "etcd-main"},
+				UID:       types.UID(fmt.Sprintf("uid-%d", uidCount)),
+			},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name: "main-etcd",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	pvcName := func(ordinal int) string {
+		return fmt.Sprintf("main-etcd-etcd-main-%d", ordinal)
+	}
+
+	getZoneOfMember := func(ordinal int) string {
+		pvc := &corev1.PersistentVolumeClaim{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: pvcName(ordinal)}, pvc)).To(Succeed())
+		pv := &corev1.PersistentVolume{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, pv)).To(Succeed())
+		return ExtractZonesFromNodeSelectorTerm(pv.Spec.NodeAffinity.Required.NodeSelectorTerms[0])[0]
+	}
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithVars(
+			&IntervalWaitEtcdMemberMigrated, time.Millisecond,
+			&TimeoutWaitEtcdMemberMigrated, time.Second,
+		))
+
+		nextZones = []string{"b", "c"}
+		uidCount = 0
+
+		// The interceptor simulates the statefulset controller and the volume provisioner, i.e., deleted pods are
+		// recreated immediately and deleted PVCs are recreated with a volume in the next free zone.
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if err := c.Delete(ctx, obj, opts...); err != nil {
+					return err
+				}
+
+				switch obj.(type) {
+				case *corev1.Pod:
+					pod := newPod(obj.GetName(), "")
+					pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = "main-etcd-" + obj.GetName()
+					return c.Create(ctx, pod)
+				case *corev1.PersistentVolumeClaim:
+					if len(nextZones) == 0 {
+						return fmt.Errorf("no free zone left")
+					}
+					pvName := "pv-" + obj.GetName() + "-" + nextZones[0]
+					if err := c.Create(ctx, newPersistentVolume(pvName, nextZones[0])); err != nil {
+						return err
+					}
+					nextZones = nextZones[1:]
+					return c.Create(ctx, newPersistentVolumeClaim(obj.GetName(), pvName))
+				}
+				return nil
+			},
+		}).Build()
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				ControlPlane: &gardencorev1beta1.ControlPlane{
+					HighAvailability: &gardencorev1beta1.HighAvailability{
+						FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone},
+					},
+				},
+			},
+		}
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:        logr.Discard(),
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot:         &shootpkg.Shoot{SeedNamespace: namespace},
+		}}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#MigrateEtcdMembersToZones", func() {
+		var statefulSet *appsv1.StatefulSet
+
+		BeforeEach(func() {
+			statefulSet = &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To[int32](3),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"instance": "etcd-main"}},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+								TopologyKey:       corev1.LabelTopologyZone,
+								MaxSkew:           1,
+								WhenUnsatisfiable: corev1.DoNotSchedule,
+							}},
+						},
+					},
+				},
+			}
+
+			for i := 0; i < 3; i++ {
+				pvName := fmt.Sprintf("pv-%d", i)
+				Expect(seedClient.Create(ctx, newPersistentVolume(pvName, "a"))).To(Succeed())
+				Expect(seedClient.Create(ctx, newPersistentVolumeClaim(pvcName(i), pvName))).To(Succeed())
+				Expect(seedClient.Create(ctx, newPod(fmt.Sprintf("etcd-main-%d", i), pvcName(i)))).To(Succeed())
+			}
+		})
+
+		It("should do nothing if the control plane is not multi-zonal", func() {
+			shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type = gardencorev1beta1.FailureToleranceTypeNode
+			Expect(seedClient.Create(ctx, statefulSet)).To(Succeed())
+
+			Expect(botanist.MigrateEtcdMembersToZones(ctx)).To(Succeed())
+
+			Expect(getZoneOfMember(0)).To(Equal("a"))
+			Expect(getZoneOfMember(1)).To(Equal("a"))
+			Expect(getZoneOfMember(2)).To(Equal("a"))
+		})
+
+		It("should do nothing if the etcd is not a multi-node etcd", func() {
+			statefulSet.Spec.Replicas = ptr.To[int32](1)
+			Expect(seedClient.Create(ctx, statefulSet)).To(Succeed())
+
+			Expect(botanist.MigrateEtcdMembersToZones(ctx)).To(Succeed())
+
+			Expect(getZoneOfMember(2)).To(Equal("a"))
+		})
+
+		It("should fail if the statefulset does not spread its pods across zones yet", func() {
+			statefulSet.Spec.Template.Spec.TopologySpreadConstraints = nil
+			Expect(seedClient.Create(ctx, statefulSet)).To(Succeed())
+
+			Expect(botanist.MigrateEtcdMembersToZones(ctx)).To(MatchError(ContainSubstring("does not spread its pods across zones yet")))
+		})
+
+		It("should move the members to different zones one after the other", func() {
+			Expect(seedClient.Create(ctx, statefulSet)).To(Succeed())
+
+			Expect(botanist.MigrateEtcdMembersToZones(ctx)).To(Succeed())
+
+			Expect(getZoneOfMember(0)).To(Equal("a"))
+			Expect(getZoneOfMember(1)).To(Equal("c"))
+			Expect(getZoneOfMember(2)).To(Equal("b"))
+			Expect(nextZones).To(BeEmpty())
+		})
+
+		It("should do nothing if the members are already spread across zones", func() {
+			Expect(seedClient.Delete(ctx, &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}})).To(Succeed())
+			Expect(seedClient.Create(ctx, newPersistentVolume("pv-1", "b"))).To(Succeed())
+			Expect(seedClient.Delete(ctx, &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-2"}})).To(Succeed())
+			Expect(seedClient.Create(ctx, newPersistentVolume("pv-2", "c"))).To(Succeed())
+			nextZones = nil
+			Expect(seedClient.Create(ctx, statefulSet)).To(Succeed())
+
+			Expect(botanist.MigrateEtcdMembersToZones(ctx)).To(Succeed())
+
+			Expect(getZoneOfMember(0)).To(Equal("a"))
+			Expect(getZoneOfMember(1)).To(Equal("b"))
+			Expect(getZoneOfMember(2)).To(Equal("c"))
+		})
+	})
+})
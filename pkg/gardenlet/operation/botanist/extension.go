@@ -39,7 +39,11 @@ func (b *Botanist) DefaultExtension(ctx context.Context) (extension.Interface, e
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployExtensionsAfterKubeAPIServer(ctx context.Context) error {
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.Extension.RestoreAfterKubeAPIServer(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.Extension.RestoreAfterKubeAPIServer(ctx, shootState)
 	}
 	return b.Shoot.Components.Extensions.Extension.DeployAfterKubeAPIServer(ctx)
 }
@@ -48,7 +52,11 @@ func (b *Botanist) DeployExtensionsAfterKubeAPIServer(ctx context.Context) error
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployExtensionsAfterWorker(ctx context.Context) error {
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.Extension.RestoreAfterWorker(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.Extension.RestoreAfterWorker(ctx, shootState)
 	}
 	return b.Shoot.Components.Extensions.Extension.DeployAfterWorker(ctx)
 }
@@ -57,7 +65,11 @@ func (b *Botanist) DeployExtensionsAfterWorker(ctx context.Context) error {
 // the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployExtensionsBeforeKubeAPIServer(ctx context.Context) error {
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.Extension.RestoreBeforeKubeAPIServer(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.Extension.RestoreBeforeKubeAPIServer(ctx, shootState)
 	}
 	return b.Shoot.Components.Extensions.Extension.DeployBeforeKubeAPIServer(ctx)
 }
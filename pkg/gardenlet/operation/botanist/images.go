@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// findImageForAllNodes returns the image with the given name for components running on the nodes of all worker pools,
+// e.g., DaemonSets. The image must support the CPU architectures of all worker pools of the shoot.
+func (b *Botanist) findImageForAllNodes(name string, opts ...imagevectorutils.FindOptionFunc) (*imagevectorutils.Image, error) {
+	return imagevectorutils.FindImageForArchitectures(b.ImageVector(), name, v1beta1helper.WorkerArchitectures(b.Shoot.GetInfo().Spec.Provider.Workers), opts...)
+}
+
+// findImageForSystemComponents returns the image with the given name for system components which can be scheduled on
+// the nodes of all worker pools allowing system components. The image must support the CPU architectures of all these
+// worker pools.
+func (b *Botanist) findImageForSystemComponents(name string, opts ...imagevectorutils.FindOptionFunc) (*imagevectorutils.Image, error) {
+	var workers []gardencorev1beta1.Worker
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if v1beta1helper.SystemComponentsAllowed(&worker) {
+			workers = append(workers, worker)
+		}
+	}
+
+	return imagevectorutils.FindImageForArchitectures(b.ImageVector(), name, v1beta1helper.WorkerArchitectures(workers), opts...)
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/utils/ptr"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
@@ -23,12 +25,14 @@ func (b *Botanist) DefaultInfrastructure() infrastructure.Interface {
 		b.Logger,
 		b.SeedClientSet.Client(),
 		&infrastructure.Values{
-			Namespace:         b.Shoot.SeedNamespace,
-			Name:              b.Shoot.GetInfo().Name,
-			Type:              b.Shoot.GetInfo().Spec.Provider.Type,
-			ProviderConfig:    b.Shoot.GetInfo().Spec.Provider.InfrastructureConfig,
-			Region:            b.Shoot.GetInfo().Spec.Region,
-			AnnotateOperation: controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployInfrastructure) || b.IsRestorePhase(),
+			Namespace:                b.Shoot.SeedNamespace,
+			Name:                     b.Shoot.GetInfo().Name,
+			Type:                     b.Shoot.GetInfo().Spec.Provider.Type,
+			ProviderConfig:           b.Shoot.GetInfo().Spec.Provider.InfrastructureConfig,
+			Region:                   b.Shoot.GetInfo().Spec.Region,
+			ResourceTags:             v1beta1helper.GetShootResourceTags(b.Shoot.GetInfo(), b.Garden.Project.Name),
+			EgressOnlyInternetAccess: v1beta1helper.ShootEnablesEgressOnlyInternetAccess(b.Shoot.GetInfo()),
+			AnnotateOperation:        controllerutils.HasTask(b.Shoot.GetInfo().Annotations, v1beta1constants.ShootTaskDeployInfrastructure) || b.IsRestorePhase(),
 		},
 		infrastructure.DefaultInterval,
 		infrastructure.DefaultSevereThreshold,
@@ -48,19 +52,28 @@ func (b *Botanist) DeployInfrastructure(ctx context.Context) error {
 	}
 
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.Infrastructure.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.Infrastructure.Restore(ctx, shootState)
 	}
 
 	return b.Shoot.Components.Extensions.Infrastructure.Deploy(ctx)
 }
 
 // WaitForInfrastructure waits until the infrastructure reconciliation has finished and extracts the provider status
-// out of it.
+// out of it. It fails if the shoot requests egress-only internet access for its worker nodes but the provider extension
+// does not confirm that it honors the request.
 func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
 	if err := b.Shoot.Components.Extensions.Infrastructure.Wait(ctx); err != nil {
 		return err
 	}
 
+	if v1beta1helper.ShootEnablesEgressOnlyInternetAccess(b.Shoot.GetInfo()) && !ptr.Deref(b.Shoot.Components.Extensions.Infrastructure.EgressOnlyInternetAccess(), false) {
+		return v1beta1helper.NewErrorWithCodes(fmt.Errorf("infrastructure provider %q does not honor the requested egress-only internet access for worker nodes", b.Shoot.GetInfo().Spec.Provider.Type), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
 	if nodesCIDR := b.Shoot.Components.Extensions.Infrastructure.NodesCIDR(); nodesCIDR != nil {
 		if err := b.Shoot.UpdateInfo(ctx, b.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
 			shoot.Spec.Networking.Nodes = nodesCIDR
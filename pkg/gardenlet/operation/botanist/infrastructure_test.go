@@ -19,6 +19,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
@@ -176,6 +177,40 @@ var _ = Describe("Infrastructure", func() {
 			Expect(botanist.Shoot.GetInfo()).To(Equal(shoot))
 		})
 
+		Context("egress-only internet access", func() {
+			BeforeEach(func() {
+				shoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Type = "provider"
+				shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "worker"}}
+				shoot.Spec.Provider.WorkersSettings = &gardencorev1beta1.WorkersSettings{EgressOnlyInternetAccess: &gardencorev1beta1.EgressOnlyInternetAccess{Enabled: true}}
+				botanist.Shoot.SetInfo(shoot)
+			})
+
+			It("should successfully wait if the provider honors egress-only internet access", func() {
+				infrastructure.EXPECT().Wait(ctx)
+				infrastructure.EXPECT().EgressOnlyInternetAccess().Return(ptr.To(true))
+				infrastructure.EXPECT().NodesCIDR()
+
+				Expect(botanist.WaitForInfrastructure(ctx)).To(Succeed())
+			})
+
+			It("should fail if the provider does not honor egress-only internet access", func() {
+				infrastructure.EXPECT().Wait(ctx)
+				infrastructure.EXPECT().EgressOnlyInternetAccess().Return(ptr.To(false))
+
+				err := botanist.WaitForInfrastructure(ctx)
+				Expect(err).To(MatchError(ContainSubstring(`infrastructure provider "provider" does not honor the requested egress-only internet access for worker nodes`)))
+				Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			})
+
+			It("should fail if the provider does not report whether it honors egress-only internet access", func() {
+				infrastructure.EXPECT().Wait(ctx)
+				infrastructure.EXPECT().EgressOnlyInternetAccess()
+
+				Expect(botanist.WaitForInfrastructure(ctx)).To(MatchError(ContainSubstring("does not honor the requested egress-only internet access")))
+			})
+		})
+
 		It("should return the error during wait", func() {
 			infrastructure.EXPECT().Wait(ctx).Return(fakeErr)
 
@@ -25,6 +25,7 @@ import (
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
@@ -52,6 +53,8 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		vpnConfig.Enabled = true
 		vpnConfig.PodNetworkCIDR = pods
 		// NodeNetworkCIDR is set on deployment to handle dynamice node network CIDRs
+		vpnConfig.AdditionalNodeNetworkCIDRs = b.Shoot.GetInfo().Spec.Networking.AdditionalNodes
+		vpnConfig.PathMTU = v1beta1helper.ShootPathMTU(b.Shoot.GetInfo())
 		vpnConfig.HighAvailabilityEnabled = b.Shoot.VPNHighAvailabilityEnabled
 		vpnConfig.HighAvailabilityNumberOfSeedServers = b.Shoot.VPNHighAvailabilityNumberOfSeedServers
 		vpnConfig.HighAvailabilityNumberOfShootClients = b.Shoot.VPNHighAvailabilityNumberOfShootClients
@@ -65,6 +68,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		b.Shoot.GetInfo().ObjectMeta,
 		b.Seed.KubernetesVersion,
 		b.Shoot.KubernetesVersion,
+		b.ImageVector(),
 		b.SecretsManager,
 		"",
 		b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer,
@@ -78,6 +82,7 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		nil,
 		nil,
 		nil,
+		gardenlethelper.IsSlowRequestLoggingEnabled(b.Config) && gardenlethelper.IsLoggingEnabled(b.Config),
 	)
 }
 
@@ -215,10 +220,12 @@ func (b *Botanist) computeKubeAPIServerServerCertificateConfig() kubeapiserver.S
 		dnsNames = append(dnsNames, *(b.Shoot.GetInfo().Spec.DNS.Domain), gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain))
 	}
 
-	return kubeapiserver.ServerCertificateConfig{
-		ExtraIPAddresses: ipAddresses,
-		ExtraDNSNames:    dnsNames,
+	var additionalSANs *gardencorev1beta1.KubeAPIServerAdditionalSANs
+	if kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		additionalSANs = kubeAPIServer.AdditionalSANs
 	}
+
+	return kubeapiserver.ComputeKubeAPIServerServerCertificateConfig(ipAddresses, dnsNames, additionalSANs)
 }
 
 func (b *Botanist) computeKubeAPIServerSNIConfig() kubeapiserver.SNIConfig {
@@ -80,15 +80,22 @@ func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
 		v1beta1constants.DeploymentNameKubeAPIServer,
 		b.Shoot.SeedNamespace,
 		func() *kubeapiserverexposure.SNIValues {
+			apiServerProxy := &kubeapiserverexposure.APIServerProxy{
+				APIServerClusterIP: b.APIServerClusterIP,
+				NamespaceUID:       b.SeedNamespaceObject.UID,
+			}
+
+			if settings := b.Seed.GetInfo().Spec.Settings; settings != nil && settings.APIServerProxy != nil {
+				apiServerProxy.MaxConnections = settings.APIServerProxy.MaxConnections
+				apiServerProxy.MaxConnectionsPerSecond = settings.APIServerProxy.MaxConnectionsPerSecond
+			}
+
 			return &kubeapiserverexposure.SNIValues{
 				Hosts: []string{
 					gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain),
 					gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain),
 				},
-				APIServerProxy: &kubeapiserverexposure.APIServerProxy{
-					APIServerClusterIP: b.APIServerClusterIP,
-					NamespaceUID:       b.SeedNamespaceObject.UID,
-				},
+				APIServerProxy: apiServerProxy,
 				IstioIngressGateway: kubeapiserverexposure.IstioIngressGateway{
 					Namespace: b.IstioNamespace(),
 					Labels:    b.IstioLabels(),
@@ -26,7 +26,7 @@ import (
 
 // DefaultKubeProxy returns a deployer for the kube-proxy.
 func (b *Botanist) DefaultKubeProxy() (kubeproxy.Interface, error) {
-	imageAlpine, err := imagevector.ImageVector().FindImage(imagevector.ImageNameAlpineConntrack, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	imageAlpine, err := b.findImageForAllNodes(imagevector.ImageNameAlpineConntrack, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +88,7 @@ func (b *Botanist) computeWorkerPoolsForKubeProxy(ctx context.Context) ([]kubepr
 			return nil, err
 		}
 
-		image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
+		image, err := b.ImageVector().FindImage(imagevector.ImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()), imagevectorutils.Architecture(v1beta1helper.WorkerArchitecture(worker)))
 		if err != nil {
 			return nil, err
 		}
@@ -117,7 +117,12 @@ func (b *Botanist) computeWorkerPoolsForKubeProxy(ctx context.Context) ([]kubepr
 			return nil, err
 		}
 
-		image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersionString), imagevectorutils.TargetVersion(kubernetesVersionString))
+		architecture := node.Labels[corev1.LabelArchStable]
+		if architecture == "" {
+			architecture = v1beta1constants.ArchitectureAMD64
+		}
+
+		image, err := b.ImageVector().FindImage(imagevector.ImageNameKubeProxy, imagevectorutils.RuntimeVersion(kubernetesVersionString), imagevectorutils.TargetVersion(kubernetesVersionString), imagevectorutils.Architecture(architecture))
 		if err != nil {
 			return nil, err
 		}
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 )
@@ -271,6 +272,54 @@ users:
 
 				Expect(botanist.DeployKubeProxy(ctx)).To(Succeed())
 			})
+
+			It("with architecture-specific images", func() {
+				botanist.ImageVectorOverride = imagevectorutils.ImageVector{{
+					Name:          "kube-proxy",
+					Repository:    "example.com/kube-proxy-arm64",
+					Architectures: []string{"arm64"},
+				}}
+				botanist.Shoot.GetInfo().Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
+
+				Expect(fakeShootClient.Create(ctx, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+						Labels: map[string]string{
+							"kubernetes.io/arch":                       "arm64",
+							"worker.gardener.cloud/pool":               poolName3,
+							"worker.gardener.cloud/kubernetes-version": kubernetesVersionPool2.String(),
+						},
+					},
+				})).To(Succeed())
+
+				kubeProxy.EXPECT().SetWorkerPools(gomock.AssignableToTypeOf([]kubeproxy.WorkerPool{})).DoAndReturn(func(actual []kubeproxy.WorkerPool) {
+					verifyWorkerPools(actual, []kubeproxy.WorkerPool{
+						{
+							Name:              poolName1,
+							KubernetesVersion: kubernetesVersionControlPlane,
+							Image:             "example.com/kube-proxy-arm64:v" + kubernetesVersionControlPlane.String(),
+						},
+						{
+							Name:              poolName2,
+							KubernetesVersion: kubernetesVersionPool2,
+							Image:             repositoryKubeProxyImage + ":v" + kubernetesVersionPool2.String(),
+						},
+						{
+							Name:              poolName3,
+							KubernetesVersion: kubernetesVersionPool3,
+							Image:             repositoryKubeProxyImage + ":v" + kubernetesVersionPool3.String(),
+						},
+						{
+							Name:              poolName3,
+							KubernetesVersion: kubernetesVersionPool2,
+							Image:             "example.com/kube-proxy-arm64:v" + kubernetesVersionPool2.String(),
+						},
+					})
+				})
+				kubeProxy.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployKubeProxy(ctx)).To(Succeed())
+			})
 		})
 	})
 })
@@ -16,7 +16,7 @@ import (
 
 // DefaultKubeStateMetrics returns a deployer for the kube-state-metrics.
 func (b *Botanist) DefaultKubeStateMetrics() (component.DeployWaiter, error) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameKubeStateMetrics, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	image, err := b.ImageVector().FindImage(imagevector.ImageNameKubeStateMetrics, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 	if err != nil {
 		return nil, err
 	}
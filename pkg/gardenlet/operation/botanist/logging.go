@@ -6,10 +6,14 @@ package botanist
 
 import (
 	"context"
+	"time"
+
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/imagevector"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/eventlogger"
 	"github.com/gardener/gardener/pkg/component/shared"
@@ -69,7 +73,7 @@ func (b *Botanist) isShootEventLoggerEnabled() bool {
 
 // DefaultEventLogger returns a deployer for the shoot-event-logger.
 func (b *Botanist) DefaultEventLogger() (component.Deployer, error) {
-	imageEventLogger, err := imagevector.ImageVector().FindImage(imagevector.ImageNameEventLogger, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	imageEventLogger, err := b.ImageVector().FindImage(imagevector.ImageNameEventLogger, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 	if err != nil {
 		return nil, err
 	}
@@ -81,10 +85,49 @@ func (b *Botanist) DefaultEventLogger() (component.Deployer, error) {
 		eventlogger.Values{
 			Image:    imageEventLogger.String(),
 			Replicas: b.Shoot.GetReplicas(1),
+			Archive:  b.computeEventArchiveValues(),
 		},
 	)
 }
 
+// defaultEventArchiveRetentionDays is the number of days for which archived events are retained if not configured
+// otherwise in the shoot specification.
+const defaultEventArchiveRetentionDays = 30
+
+func (b *Botanist) computeEventArchiveValues() *eventlogger.ArchiveValues {
+	eventArchive := v1beta1helper.GetShootEventArchive(b.Shoot.GetInfo())
+	if eventArchive == nil {
+		return nil
+	}
+
+	values := &eventlogger.ArchiveValues{
+		Retention: time.Duration(ptr.Deref(eventArchive.RetentionDays, defaultEventArchiveRetentionDays)) * 24 * time.Hour,
+	}
+
+	if sink := eventArchive.Sink; sink != nil {
+		for _, resource := range b.Shoot.GetInfo().Spec.Resources {
+			if resource.Name == sink.ResourceName {
+				values.StorageProvider = sink.Provider
+				values.SecretName = v1beta1constants.ReferencedResourcesPrefix + resource.ResourceRef.Name
+				return values
+			}
+		}
+		b.Logger.Info("Not archiving events because the sink refers to an unknown resource", "resourceName", sink.ResourceName)
+		return nil
+	}
+
+	// Without a dedicated sink, the events are exported next to the etcd backups of the shoot.
+	if b.Seed.GetInfo().Spec.Backup == nil {
+		b.Logger.Info("Not archiving events because neither a sink is configured nor the seed has a backup bucket")
+		return nil
+	}
+
+	values.StorageProvider = b.Seed.GetInfo().Spec.Backup.Provider
+	values.SecretName = v1beta1constants.BackupSecretName
+	values.Prefix = b.Shoot.BackupEntryName + "/events"
+	return values
+}
+
 // DefaultVali returns a deployer for Vali.
 func (b *Botanist) DefaultVali() (component.Deployer, error) {
 	return shared.NewVali(
@@ -97,5 +140,6 @@ func (b *Botanist) DefaultVali() (component.Deployer, error) {
 		v1beta1constants.PriorityClassNameShootControlPlane100,
 		nil,
 		b.ComputeValiHost(),
+		v1beta1helper.GetShootObservabilityLogging(b.Shoot.GetInfo()),
 	)
 }
@@ -15,7 +15,7 @@ import (
 
 // DefaultMetricsServer returns a deployer for the metrics-server.
 func (b *Botanist) DefaultMetricsServer() (component.DeployWaiter, error) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameMetricsServer, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	image, err := b.findImageForSystemComponents(imagevector.ImageNameMetricsServer, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
 	if err != nil {
 		return nil, err
 	}
@@ -28,6 +28,7 @@ import (
 	mockcontrolplane "github.com/gardener/gardener/pkg/component/extensions/controlplane/mock"
 	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
 	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
+	mockprivatelink "github.com/gardener/gardener/pkg/component/extensions/privatelink/mock"
 	mockworker "github.com/gardener/gardener/pkg/component/extensions/worker/mock"
 	mockbackupentry "github.com/gardener/gardener/pkg/component/garden/backupentry/mock"
 	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
@@ -48,6 +49,7 @@ var _ = Describe("migration", func() {
 		infrastructure        *mockinfrastructure.MockInterface
 		network               *mockcomponent.MockDeployMigrateWaiter
 		operatingSystemConfig *mockoperatingsystemconfig.MockInterface
+		privateLink           *mockprivatelink.MockInterface
 		worker                *mockworker.MockInterface
 
 		botanist *Botanist
@@ -67,6 +69,7 @@ var _ = Describe("migration", func() {
 		infrastructure = mockinfrastructure.NewMockInterface(ctrl)
 		network = mockcomponent.NewMockDeployMigrateWaiter(ctrl)
 		operatingSystemConfig = mockoperatingsystemconfig.NewMockInterface(ctrl)
+		privateLink = mockprivatelink.NewMockInterface(ctrl)
 		worker = mockworker.NewMockInterface(ctrl)
 
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
@@ -83,6 +86,7 @@ var _ = Describe("migration", func() {
 						Infrastructure:        infrastructure,
 						Network:               network,
 						OperatingSystemConfig: operatingSystemConfig,
+						PrivateLink:           privateLink,
 						Worker:                worker,
 					},
 				},
@@ -99,6 +103,7 @@ var _ = Describe("migration", func() {
 			containerRuntime.EXPECT().Migrate(ctx)
 			controlPlaneExposure.EXPECT().Migrate(ctx)
 			network.EXPECT().Migrate(ctx)
+			privateLink.EXPECT().Migrate(ctx)
 			operatingSystemConfig.EXPECT().Migrate(ctx)
 			worker.EXPECT().Migrate(ctx)
 
@@ -109,6 +114,7 @@ var _ = Describe("migration", func() {
 			containerRuntime.EXPECT().Migrate(ctx)
 			controlPlaneExposure.EXPECT().Migrate(ctx)
 			network.EXPECT().Migrate(ctx).Return(fakeErr)
+			privateLink.EXPECT().Migrate(ctx)
 			operatingSystemConfig.EXPECT().Migrate(ctx)
 			worker.EXPECT().Migrate(ctx)
 
@@ -123,6 +129,7 @@ var _ = Describe("migration", func() {
 			containerRuntime.EXPECT().WaitMigrate(ctx)
 			controlPlaneExposure.EXPECT().WaitMigrate(ctx)
 			network.EXPECT().WaitMigrate(ctx)
+			privateLink.EXPECT().WaitMigrate(ctx)
 			operatingSystemConfig.EXPECT().WaitMigrate(ctx)
 			worker.EXPECT().WaitMigrate(ctx)
 
@@ -139,6 +146,7 @@ var _ = Describe("migration", func() {
 			containerRuntime.EXPECT().WaitMigrate(ctx)
 			controlPlaneExposure.EXPECT().WaitMigrate(ctx)
 			network.EXPECT().WaitMigrate(ctx).Return(fakeErr)
+			privateLink.EXPECT().WaitMigrate(ctx)
 			operatingSystemConfig.EXPECT().WaitMigrate(ctx)
 			worker.EXPECT().WaitMigrate(ctx).Return(fakeErr)
 
@@ -153,6 +161,7 @@ var _ = Describe("migration", func() {
 			containerRuntime.EXPECT().Destroy(ctx)
 			controlPlaneExposure.EXPECT().Destroy(ctx)
 			network.EXPECT().Destroy(ctx)
+			privateLink.EXPECT().Destroy(ctx)
 			operatingSystemConfig.EXPECT().Destroy(ctx)
 			worker.EXPECT().Destroy(ctx)
 
@@ -169,6 +178,7 @@ var _ = Describe("migration", func() {
 			containerRuntime.EXPECT().Destroy(ctx).Return(fakeErr)
 			controlPlaneExposure.EXPECT().Destroy(ctx).Return(fakeErr)
 			network.EXPECT().Destroy(ctx)
+			privateLink.EXPECT().Destroy(ctx)
 			operatingSystemConfig.EXPECT().Destroy(ctx)
 			worker.EXPECT().Destroy(ctx)
 
@@ -71,12 +71,74 @@ func (b *Botanist) DeployAlertManager(ctx context.Context) error {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameObservabilityIngressUsers)
 	}
 
+	externalReceivers, err := b.alertmanagerExternalReceivers(ctx)
+	if err != nil {
+		return err
+	}
+
 	b.Shoot.Components.ControlPlane.Alertmanager.SetIngressAuthSecret(ingressAuthSecret)
 	b.Shoot.Components.ControlPlane.Alertmanager.SetIngressWildcardCertSecret(b.ControlPlaneWildcardCert)
+	b.Shoot.Components.ControlPlane.Alertmanager.SetExternalReceivers(externalReceivers)
 
 	return b.Shoot.Components.ControlPlane.Alertmanager.Deploy(ctx)
 }
 
+// dataKeyAlertReceiverURL is the data key in the secrets referenced by alert receivers which contains the URL.
+const dataKeyAlertReceiverURL = "url"
+
+// alertmanagerExternalReceivers computes the external receivers for the shoot alertmanager based on the alert routes
+// of the project. The URLs of webhook and Slack receivers are read from the referenced secrets in the project namespace.
+func (b *Botanist) alertmanagerExternalReceivers(ctx context.Context) ([]alertmanager.ExternalReceiver, error) {
+	if b.Garden == nil || b.Garden.Project == nil {
+		return nil, nil
+	}
+
+	var externalReceivers []alertmanager.ExternalReceiver
+
+	for _, route := range b.Garden.Project.Spec.AlertRoutes {
+		externalReceiver := alertmanager.ExternalReceiver{
+			Name:       route.Name,
+			Severities: route.Severities,
+		}
+
+		switch {
+		case route.Receiver.Email != nil:
+			externalReceiver.EmailReceivers = route.Receiver.Email.To
+		case route.Receiver.Webhook != nil:
+			url, err := b.readAlertReceiverURL(ctx, route.Receiver.Webhook.SecretName)
+			if err != nil {
+				return nil, fmt.Errorf("failed reading URL for webhook receiver of alert route %q: %w", route.Name, err)
+			}
+			externalReceiver.WebhookURL = url
+		case route.Receiver.Slack != nil:
+			url, err := b.readAlertReceiverURL(ctx, route.Receiver.Slack.SecretName)
+			if err != nil {
+				return nil, fmt.Errorf("failed reading URL for slack receiver of alert route %q: %w", route.Name, err)
+			}
+			externalReceiver.SlackURL = url
+			externalReceiver.SlackChannel = ptr.Deref(route.Receiver.Slack.Channel, "")
+		}
+
+		externalReceivers = append(externalReceivers, externalReceiver)
+	}
+
+	return externalReceivers, nil
+}
+
+func (b *Botanist) readAlertReceiverURL(ctx context.Context, secretName string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: b.Shoot.GetInfo().Namespace}, secret); err != nil {
+		return "", err
+	}
+
+	url, ok := secret.Data[dataKeyAlertReceiverURL]
+	if !ok || len(url) == 0 {
+		return "", fmt.Errorf("secret %s does not contain data key %q", client.ObjectKeyFromObject(secret), dataKeyAlertReceiverURL)
+	}
+
+	return string(url), nil
+}
+
 // DefaultPrometheus creates a new prometheus deployer.
 func (b *Botanist) DefaultPrometheus() (prometheus.Interface, error) {
 	externalLabels := map[string]string{
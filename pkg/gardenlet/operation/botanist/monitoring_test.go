@@ -12,15 +12,18 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	mockalertmanager "github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
@@ -106,10 +109,61 @@ var _ = Describe("Monitoring", func() {
 				alertManager.EXPECT().SetIngressWildcardCertSecret(gomock.AssignableToTypeOf(&corev1.Secret{})).Do(func(s *corev1.Secret) {
 					Expect(s.Name).To(Equal(ingressWildcardSecret.Name))
 				})
+				alertManager.EXPECT().SetExternalReceivers(nil)
 				alertManager.EXPECT().Deploy(ctx)
 
 				Expect(botanist.DeployAlertManager(ctx)).To(Succeed())
 			})
+
+			Context("with alert routes in the project", func() {
+				var fakeGardenClient client.Client
+
+				BeforeEach(func() {
+					fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+					botanist.GardenClient = fakeGardenClient
+					botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}})
+					botanist.Garden = &garden.Garden{
+						Project: &gardencorev1beta1.Project{
+							Spec: gardencorev1beta1.ProjectSpec{
+								AlertRoutes: []gardencorev1beta1.AlertRoute{
+									{Name: "mail", Severities: []string{"critical"}, Receiver: gardencorev1beta1.AlertReceiver{Email: &gardencorev1beta1.EmailAlertReceiver{To: []string{"ops@example.com"}}}},
+									{Name: "webhook", Receiver: gardencorev1beta1.AlertReceiver{Webhook: &gardencorev1beta1.WebhookAlertReceiver{SecretName: "webhook"}}},
+									{Name: "slack", Receiver: gardencorev1beta1.AlertReceiver{Slack: &gardencorev1beta1.SlackAlertReceiver{SecretName: "slack", Channel: ptr.To("#alerts")}}},
+								},
+							},
+						},
+					}
+
+					alertManager.EXPECT().SetIngressAuthSecret(gomock.Any()).AnyTimes()
+					alertManager.EXPECT().SetIngressWildcardCertSecret(gomock.Any()).AnyTimes()
+				})
+
+				It("should deploy with the external receivers", func() {
+					Expect(fakeGardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "garden-foo"}, Data: map[string][]byte{"url": []byte("https://webhook.example.com")}})).To(Succeed())
+					Expect(fakeGardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "slack", Namespace: "garden-foo"}, Data: map[string][]byte{"url": []byte("https://slack.example.com")}})).To(Succeed())
+
+					alertManager.EXPECT().SetExternalReceivers([]alertmanager.ExternalReceiver{
+						{Name: "mail", Severities: []string{"critical"}, EmailReceivers: []string{"ops@example.com"}},
+						{Name: "webhook", WebhookURL: "https://webhook.example.com"},
+						{Name: "slack", SlackURL: "https://slack.example.com", SlackChannel: "#alerts"},
+					})
+					alertManager.EXPECT().Deploy(ctx)
+
+					Expect(botanist.DeployAlertManager(ctx)).To(Succeed())
+				})
+
+				It("should fail if a referenced secret does not exist", func() {
+					Expect(fakeGardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "garden-foo"}, Data: map[string][]byte{"url": []byte("https://webhook.example.com")}})).To(Succeed())
+
+					Expect(botanist.DeployAlertManager(ctx)).To(MatchError(ContainSubstring(`failed reading URL for slack receiver of alert route "slack"`)))
+				})
+
+				It("should fail if a referenced secret does not contain the URL", func() {
+					Expect(fakeGardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "garden-foo"}})).To(Succeed())
+
+					Expect(botanist.DeployAlertManager(ctx)).To(MatchError(ContainSubstring(`does not contain data key "url"`)))
+				})
+			})
 		})
 	})
 })
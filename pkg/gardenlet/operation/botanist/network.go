@@ -7,6 +7,7 @@ package botanist
 import (
 	"context"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/extensions/network"
@@ -30,6 +31,8 @@ func (b *Botanist) DefaultNetwork() component.DeployMigrateWaiter {
 			ProviderConfig: b.Shoot.GetInfo().Spec.Networking.ProviderConfig,
 			PodCIDR:        b.Shoot.Networks.Pods,
 			ServiceCIDR:    b.Shoot.Networks.Services,
+			NodeCIDRs:      v1beta1helper.NodeNetworkCIDRs(b.Shoot.GetInfo().Spec.Networking),
+			PathMTU:        v1beta1helper.ShootPathMTU(b.Shoot.GetInfo()),
 		},
 		network.DefaultInterval,
 		network.DefaultSevereThreshold,
@@ -41,7 +44,11 @@ func (b *Botanist) DefaultNetwork() component.DeployMigrateWaiter {
 // the Shoot is in the restore phase of the control plane migration
 func (b *Botanist) DeployNetwork(ctx context.Context) error {
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.Network.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.Network.Restore(ctx, shootState)
 	}
 
 	return b.Shoot.Components.Extensions.Network.Deploy(ctx)
@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 var _ = Describe("NodeExporter", func() {
@@ -70,6 +71,23 @@ var _ = Describe("NodeExporter", func() {
 			Expect(nodeExporter).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should fail if the image does not support the architectures of all worker pools", func() {
+			botanist.ImageVectorOverride = imagevectorutils.ImageVector{{
+				Name:          "node-exporter",
+				Repository:    "example.com/node-exporter-amd64",
+				Tag:           ptr.To("v1.0.0"),
+				Architectures: []string{"amd64"},
+			}}
+			botanist.Shoot.GetInfo().Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "amd64", Machine: gardencorev1beta1.Machine{Architecture: ptr.To("amd64")}},
+				{Name: "arm64", Machine: gardencorev1beta1.Machine{Architecture: ptr.To("arm64")}},
+			}
+
+			nodeExporter, err := botanist.DefaultNodeExporter()
+			Expect(nodeExporter).To(BeNil())
+			Expect(err).To(MatchError(ContainSubstring(`image "node-exporter" has no multi-architecture support for architectures [amd64 arm64]`)))
+		})
 	})
 
 	Describe("#ReconcileNodeExporter", func() {
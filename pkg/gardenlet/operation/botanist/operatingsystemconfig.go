@@ -13,12 +13,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/version"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
@@ -36,21 +39,27 @@ const SecretLabelKeyManagedResource = "managed-resource"
 
 // DefaultOperatingSystemConfig creates the default deployer for the OperatingSystemConfig custom resource.
 func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interface, error) {
-	oscImages, err := imagevectorutils.FindImages(imagevector.ImageVector(), []string{imagevector.ImageNamePauseContainer, imagevector.ImageNameValitail}, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
-	if err != nil {
-		return nil, err
-	}
+	// The images are pulled on the nodes, hence they are resolved for each CPU architecture of the worker pools.
+	oscImages := make(map[string]map[string]*imagevectorutils.Image)
+	for _, architecture := range v1beta1helper.WorkerArchitectures(b.Shoot.GetInfo().Spec.Provider.Workers) {
+		images, err := imagevectorutils.FindImages(b.ImageVector(), []string{imagevector.ImageNamePauseContainer, imagevector.ImageNameValitail}, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()), imagevectorutils.Architecture(architecture))
+		if err != nil {
+			return nil, err
+		}
 
-	// This image is intentionally not part of the above FindImages call because this function defaults the image tag to
-	// the ShootVersion (which is the Kubernetes version of the shoot cluster) in case the image tag in the image vector
-	// is not set. This is true for gardener-node-agent because gardenlet always deploys it with its own version (ref
-	// WithOptionalTag call a few lines below).
-	// See also: https://github.com/gardener/gardener/issues/9577
-	oscImages[imagevector.ImageNameGardenerNodeAgent], err = imagevector.ImageVector().FindImage(imagevector.ImageNameGardenerNodeAgent)
-	if err != nil {
-		return nil, fmt.Errorf("failed finding image %q: %w", imagevector.ImageNameGardenerNodeAgent, err)
+		// This image is intentionally not part of the above FindImages call because this function defaults the image tag to
+		// the ShootVersion (which is the Kubernetes version of the shoot cluster) in case the image tag in the image vector
+		// is not set. This is true for gardener-node-agent because gardenlet always deploys it with its own version (ref
+		// WithOptionalTag call a few lines below).
+		// See also: https://github.com/gardener/gardener/issues/9577
+		images[imagevector.ImageNameGardenerNodeAgent], err = b.ImageVector().FindImage(imagevector.ImageNameGardenerNodeAgent, imagevectorutils.Architecture(architecture))
+		if err != nil {
+			return nil, fmt.Errorf("failed finding image %q: %w", imagevector.ImageNameGardenerNodeAgent, err)
+		}
+		images[imagevector.ImageNameGardenerNodeAgent].WithOptionalTag(version.Get().GitVersion)
+
+		oscImages[architecture] = images
 	}
-	oscImages[imagevector.ImageNameGardenerNodeAgent].WithOptionalTag(version.Get().GitVersion)
 
 	clusterDNSAddress := b.Shoot.Networks.CoreDNS.String()
 	if b.Shoot.NodeLocalDNSEnabled && b.Shoot.IPVSEnabled() {
@@ -74,16 +83,17 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 			KubernetesVersion: b.Shoot.KubernetesVersion,
 			Workers:           b.Shoot.GetInfo().Spec.Provider.Workers,
 			OriginalValues: operatingsystemconfig.OriginalValues{
-				ClusterDNSAddress:   clusterDNSAddress,
-				ClusterDomain:       gardencorev1beta1.DefaultDomain,
-				Images:              oscImages,
-				KubeletConfig:       b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
-				MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
-				SSHAccessEnabled:    v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
-				ValitailEnabled:     valitailEnabled,
-				ValiIngressHostName: valiIngressHost,
-				NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
-				PrimaryIPFamily:     b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				ClusterDNSAddress:    clusterDNSAddress,
+				ClusterDomain:        gardencorev1beta1.DefaultDomain,
+				Images:               oscImages,
+				KubeletConfig:        b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
+				MachineTypes:         b.Shoot.CloudProfile.Spec.MachineTypes,
+				SSHAccessEnabled:     v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
+				ValitailEnabled:      valitailEnabled,
+				ValiIngressHostName:  valiIngressHost,
+				NodeLocalDNSEnabled:  v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+				PrimaryIPFamily:      b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				ContainerdRegistries: computeContainerdRegistries(b.Shoot.GetInfo()),
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -92,6 +102,43 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 	), nil
 }
 
+// computeContainerdRegistries converts the containerd registries configured in the shoot specification into the
+// values of the OperatingSystemConfig component. Secrets referenced via `.spec.resources` are copied into the shoot
+// namespace in the seed with the referenced resources prefix (see DeployReferencedResources).
+func computeContainerdRegistries(shoot *gardencorev1beta1.Shoot) []operatingsystemconfig.ContainerdRegistry {
+	workersSettings := shoot.Spec.Provider.WorkersSettings
+	if workersSettings == nil || workersSettings.Containerd == nil {
+		return nil
+	}
+
+	var registries []operatingsystemconfig.ContainerdRegistry
+	for _, registry := range workersSettings.Containerd.Registries {
+		r := operatingsystemconfig.ContainerdRegistry{
+			Upstream: registry.Upstream,
+			Server:   ptr.Deref(registry.Server, ""),
+		}
+
+		for _, host := range registry.Hosts {
+			h := operatingsystemconfig.ContainerdRegistryHost{URL: host.URL}
+			for _, capability := range host.Capabilities {
+				h.Capabilities = append(h.Capabilities, string(capability))
+			}
+
+			if host.ResourceName != nil {
+				if resource := v1beta1helper.GetResourceByName(shoot.Spec.Resources, *host.ResourceName); resource != nil {
+					h.SecretName = ptr.To(v1beta1constants.ReferencedResourcesPrefix + resource.ResourceRef.Name)
+				}
+			}
+
+			r.Hosts = append(r.Hosts, h)
+		}
+
+		registries = append(registries, r)
+	}
+
+	return registries
+}
+
 // DeployOperatingSystemConfig deploys the OperatingSystemConfig custom resource and triggers the restore operation in
 // case the Shoot is in the restore phase of the control plane migration.
 func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
@@ -114,16 +161,46 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 			publicKeys = append(publicKeys, string(sshKeypairSecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
 		}
 
+		grantedPublicKeys, err := b.grantedSSHAccessRequestPublicKeys(ctx)
+		if err != nil {
+			return err
+		}
+		publicKeys = append(publicKeys, grantedPublicKeys...)
+
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
 	}
 
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.OperatingSystemConfig.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.OperatingSystemConfig.Restore(ctx, shootState)
 	}
 
 	return b.Shoot.Components.Extensions.OperatingSystemConfig.Deploy(ctx)
 }
 
+// grantedSSHAccessRequestPublicKeys returns the public keys of all currently granted SSHAccessRequests for the shoot.
+// SSHAccessRequests which expired or are being deleted are not considered, i.e., their keys are removed from the
+// worker nodes with the next reconciliation.
+func (b *Botanist) grantedSSHAccessRequestPublicKeys(ctx context.Context) ([]string, error) {
+	sshAccessRequestList := &operationsv1alpha1.SSHAccessRequestList{}
+	if err := b.GardenClient.List(ctx, sshAccessRequestList, client.InNamespace(b.Shoot.GetInfo().Namespace), client.MatchingFields{operations.SSHAccessRequestShootName: b.Shoot.GetInfo().Name}); err != nil {
+		return nil, fmt.Errorf("failed listing SSHAccessRequests: %w", err)
+	}
+
+	var publicKeys []string
+	for _, sshAccessRequest := range sshAccessRequestList.Items {
+		if sshAccessRequest.DeletionTimestamp != nil || sshAccessRequest.Status.Phase != operationsv1alpha1.AccessRequestGranted {
+			continue
+		}
+		publicKeys = append(publicKeys, sshAccessRequest.Spec.SSHPublicKey)
+	}
+
+	return publicKeys, nil
+}
+
 func (b *Botanist) getOperatingSystemConfigCABundle(clusterCABundle []byte) *string {
 	var caBundle string
 
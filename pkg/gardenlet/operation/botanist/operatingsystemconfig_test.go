@@ -22,9 +22,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
@@ -48,8 +51,9 @@ var _ = Describe("operatingsystemconfig", func() {
 		ctrl                  *gomock.Controller
 		operatingSystemConfig *mockoperatingsystemconfig.MockInterface
 
-		fakeClient client.Client
-		sm         secretsmanager.Interface
+		fakeClient       client.Client
+		fakeGardenClient client.Client
+		sm               secretsmanager.Interface
 
 		botanist *Botanist
 
@@ -70,6 +74,10 @@ var _ = Describe("operatingsystemconfig", func() {
 		operatingSystemConfig = mockoperatingsystemconfig.NewMockInterface(ctrl)
 
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeGardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&operationsv1alpha1.SSHAccessRequest{}, operations.SSHAccessRequestShootName, indexer.SSHAccessRequestShootNameIndexerFunc).
+			Build()
 		sm = fakesecretsmanager.New(fakeClient, namespace)
 
 		By("Create secrets managed outside of this function for which secretsmanager.Get() will be called")
@@ -79,6 +87,7 @@ var _ = Describe("operatingsystemconfig", func() {
 		botanist = &Botanist{
 			Operation: &operation.Operation{
 				APIServerAddress: apiServerAddress,
+				GardenClient:     fakeGardenClient,
 				SecretsManager:   sm,
 				Shoot: &shootpkg.Shoot{
 					CloudProfile: &gardencorev1beta1.CloudProfile{},
@@ -102,6 +111,7 @@ var _ = Describe("operatingsystemconfig", func() {
 			},
 		})
 		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-testing"},
 			Spec: gardencorev1beta1.ShootSpec{
 				Provider: gardencorev1beta1.Provider{
 					Workers: []gardencorev1beta1.Worker{
@@ -158,6 +168,46 @@ var _ = Describe("operatingsystemconfig", func() {
 			})
 		})
 
+		Context("SSH access requests", func() {
+			newSSHAccessRequest := func(name, shootName, publicKey string, phase operationsv1alpha1.AccessRequestPhase) *operationsv1alpha1.SSHAccessRequest {
+				return &operationsv1alpha1.SSHAccessRequest{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-testing"},
+					Spec: operationsv1alpha1.SSHAccessRequestSpec{
+						ShootRef:     corev1.LocalObjectReference{Name: shootName},
+						SSHPublicKey: publicKey,
+					},
+					Status: operationsv1alpha1.SSHAccessRequestStatus{Phase: phase},
+				}
+			}
+
+			BeforeEach(func() {
+				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
+				operatingSystemConfig.EXPECT().SetCABundle(nil)
+			})
+
+			It("should add the public keys of granted SSH access requests only", func() {
+				Expect(fakeGardenClient.Create(ctx, newSSHAccessRequest("granted", "shoot", "granted-key", operationsv1alpha1.AccessRequestGranted))).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, newSSHAccessRequest("pending", "shoot", "pending-key", operationsv1alpha1.AccessRequestPending))).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, newSSHAccessRequest("expired", "shoot", "expired-key", operationsv1alpha1.AccessRequestExpired))).To(Succeed())
+				Expect(fakeGardenClient.Create(ctx, newSSHAccessRequest("other-shoot", "other", "other-key", operationsv1alpha1.AccessRequestGranted))).To(Succeed())
+
+				operatingSystemConfig.EXPECT().SetSSHPublicKeys([]string{"", "granted-key"})
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+
+			It("should not add the public keys if SSH access is disabled", func() {
+				Expect(fakeGardenClient.Create(ctx, newSSHAccessRequest("granted", "shoot", "granted-key", operationsv1alpha1.AccessRequestGranted))).To(Succeed())
+
+				shoot := botanist.Shoot.GetInfo()
+				shoot.Spec.Provider.WorkersSettings = &gardencorev1beta1.WorkersSettings{SSHAccess: &gardencorev1beta1.SSHAccess{Enabled: false}}
+				botanist.Shoot.SetInfo(shoot)
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+		})
+
 		Context("restore", func() {
 			BeforeEach(func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/component/extensions/privatelink"
+)
+
+// DefaultPrivateLink creates the default deployer for the PrivateLink custom resource.
+func (b *Botanist) DefaultPrivateLink() privatelink.Interface {
+	var providerConfig *runtime.RawExtension
+	if networking := b.Shoot.GetInfo().Spec.Networking; networking != nil && networking.PrivateLink != nil {
+		providerConfig = networking.PrivateLink.ProviderConfig
+	}
+
+	return privatelink.New(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		&privatelink.Values{
+			Namespace:      b.Shoot.SeedNamespace,
+			Name:           b.Shoot.GetInfo().Name,
+			Type:           b.Shoot.GetInfo().Spec.Provider.Type,
+			ProviderConfig: providerConfig,
+			Region:         b.Shoot.GetInfo().Spec.Region,
+		},
+		privatelink.DefaultInterval,
+		privatelink.DefaultSevereThreshold,
+		privatelink.DefaultTimeout,
+	)
+}
+
+// DeployPrivateLink deploys the PrivateLink custom resource (or triggers the restore operation in case the Shoot is in
+// the restore phase of the control plane migration), waits until the private endpoint has been provisioned, and points
+// the internal DNSRecord to its address.
+func (b *Botanist) DeployPrivateLink(ctx context.Context) error {
+	if b.IsRestorePhase() {
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		if err := b.Shoot.Components.Extensions.PrivateLink.Restore(ctx, shootState); err != nil {
+			return err
+		}
+	} else if err := b.Shoot.Components.Extensions.PrivateLink.Deploy(ctx); err != nil {
+		return err
+	}
+
+	if err := b.Shoot.Components.Extensions.PrivateLink.Wait(ctx); err != nil {
+		return err
+	}
+
+	address := b.Shoot.Components.Extensions.PrivateLink.Address()
+	if address == nil || len(*address) == 0 {
+		return v1beta1helper.NewErrorWithCodes(fmt.Errorf("private link provider %q did not report the address of the private endpoint", b.Shoot.GetInfo().Spec.Provider.Type), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	if !b.NeedsInternalDNS() {
+		return nil
+	}
+
+	b.targetInternalDNSRecordAt(*address)
+	return b.deployInternalDNSRecord(ctx)
+}
+
+// DestroyPrivateLink deletes the PrivateLink custom resource and waits until it is gone.
+func (b *Botanist) DestroyPrivateLink(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.PrivateLink.Destroy(ctx); err != nil {
+		return err
+	}
+	return b.Shoot.Components.Extensions.PrivateLink.WaitCleanup(ctx)
+}
+
+// targetInternalDNSRecordAtPrivateLink points the internal DNSRecord to the address of the private endpoint if the
+// Shoot enables private link and the endpoint has already been provisioned. Otherwise, the internal DNSRecord keeps
+// pointing to the address of the kube-apiserver load balancer.
+func (b *Botanist) targetInternalDNSRecordAtPrivateLink(ctx context.Context) error {
+	if !v1beta1helper.ShootEnablesPrivateLink(b.Shoot.GetInfo()) {
+		return nil
+	}
+
+	if _, err := b.Shoot.Components.Extensions.PrivateLink.Get(ctx); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if address := b.Shoot.Components.Extensions.PrivateLink.Address(); address != nil && len(*address) > 0 {
+		b.targetInternalDNSRecordAt(*address)
+	}
+	return nil
+}
+
+func (b *Botanist) targetInternalDNSRecordAt(address string) {
+	b.Shoot.Components.Extensions.InternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(address))
+	b.Shoot.Components.Extensions.InternalDNSRecord.SetValues([]string{address})
+}
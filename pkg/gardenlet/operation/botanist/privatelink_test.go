@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	mockdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord/mock"
+	mockprivatelink "github.com/gardener/gardener/pkg/component/extensions/privatelink/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("PrivateLink", func() {
+	var (
+		ctrl              *gomock.Controller
+		privateLink       *mockprivatelink.MockInterface
+		internalDNSRecord *mockdnsrecord.MockInterface
+		botanist          *Botanist
+
+		ctx        = context.TODO()
+		fakeErr    = errors.New("fake")
+		shootState = &gardencorev1beta1.ShootState{}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		privateLink = mockprivatelink.NewMockInterface(ctrl)
+		internalDNSRecord = mockdnsrecord.NewMockInterface(ctrl)
+		botanist = &Botanist{Operation: &operation.Operation{
+			Garden: &garden.Garden{
+				InternalDomain: &gardenerutils.Domain{Provider: "internal-provider"},
+			},
+			Shoot: &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					Extensions: &shootpkg.Extensions{
+						InternalDNSRecord: internalDNSRecord,
+						PrivateLink:       privateLink,
+					},
+				},
+			},
+		}}
+		botanist.Shoot.SetShootState(shootState)
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Type:    "foo",
+					Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+				},
+				Networking: &gardencorev1beta1.Networking{
+					PrivateLink: &gardencorev1beta1.PrivateLink{Enabled: true},
+				},
+			},
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DeployPrivateLink", func() {
+		Context("deploy", func() {
+			It("should deploy successfully and point the internal DNS record to the private endpoint", func() {
+				gomock.InOrder(
+					privateLink.EXPECT().Deploy(ctx),
+					privateLink.EXPECT().Wait(ctx),
+					privateLink.EXPECT().Address().Return(ptr.To("10.250.0.42")),
+					internalDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA),
+					internalDNSRecord.EXPECT().SetValues([]string{"10.250.0.42"}),
+					internalDNSRecord.EXPECT().Deploy(ctx),
+					internalDNSRecord.EXPECT().Wait(ctx),
+				)
+
+				Expect(botanist.DeployPrivateLink(ctx)).To(Succeed())
+			})
+
+			It("should not touch the internal DNS record if internal DNS is not needed", func() {
+				botanist.Garden.InternalDomain = nil
+
+				privateLink.EXPECT().Deploy(ctx)
+				privateLink.EXPECT().Wait(ctx)
+				privateLink.EXPECT().Address().Return(ptr.To("10.250.0.42"))
+
+				Expect(botanist.DeployPrivateLink(ctx)).To(Succeed())
+			})
+
+			It("should return the error during deployment", func() {
+				privateLink.EXPECT().Deploy(ctx).Return(fakeErr)
+				Expect(botanist.DeployPrivateLink(ctx)).To(MatchError(fakeErr))
+			})
+
+			It("should return the error while waiting", func() {
+				privateLink.EXPECT().Deploy(ctx)
+				privateLink.EXPECT().Wait(ctx).Return(fakeErr)
+				Expect(botanist.DeployPrivateLink(ctx)).To(MatchError(fakeErr))
+			})
+
+			It("should return an error if the provider did not report an address", func() {
+				privateLink.EXPECT().Deploy(ctx)
+				privateLink.EXPECT().Wait(ctx)
+				privateLink.EXPECT().Address()
+
+				Expect(botanist.DeployPrivateLink(ctx)).To(MatchError(ContainSubstring("did not report the address of the private endpoint")))
+			})
+		})
+
+		Context("restore", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Status = gardencorev1beta1.ShootStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type: gardencorev1beta1.LastOperationTypeRestore,
+					},
+				}
+			})
+
+			It("should restore successfully", func() {
+				privateLink.EXPECT().Restore(ctx, shootState)
+				privateLink.EXPECT().Wait(ctx)
+				privateLink.EXPECT().Address().Return(ptr.To("10.250.0.42"))
+				internalDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA)
+				internalDNSRecord.EXPECT().SetValues([]string{"10.250.0.42"})
+				internalDNSRecord.EXPECT().Restore(ctx, shootState)
+				internalDNSRecord.EXPECT().Wait(ctx)
+
+				Expect(botanist.DeployPrivateLink(ctx)).To(Succeed())
+			})
+
+			It("should return the error during restoration", func() {
+				privateLink.EXPECT().Restore(ctx, shootState).Return(fakeErr)
+				Expect(botanist.DeployPrivateLink(ctx)).To(MatchError(fakeErr))
+			})
+		})
+	})
+
+	Describe("#DestroyPrivateLink", func() {
+		It("should destroy successfully", func() {
+			privateLink.EXPECT().Destroy(ctx)
+			privateLink.EXPECT().WaitCleanup(ctx)
+			Expect(botanist.DestroyPrivateLink(ctx)).To(Succeed())
+		})
+
+		It("should return the error during destruction", func() {
+			privateLink.EXPECT().Destroy(ctx).Return(fakeErr)
+			Expect(botanist.DestroyPrivateLink(ctx)).To(MatchError(fakeErr))
+		})
+	})
+
+	Describe("#DeployOrDestroyInternalDNSRecord", func() {
+		It("should point the internal DNS record to the private endpoint if it was already provisioned", func() {
+			gomock.InOrder(
+				privateLink.EXPECT().Get(ctx),
+				privateLink.EXPECT().Address().Return(ptr.To("10.250.0.42")),
+				internalDNSRecord.EXPECT().SetRecordType(extensionsv1alpha1.DNSRecordTypeA),
+				internalDNSRecord.EXPECT().SetValues([]string{"10.250.0.42"}),
+				internalDNSRecord.EXPECT().Deploy(ctx),
+				internalDNSRecord.EXPECT().Wait(ctx),
+			)
+
+			Expect(botanist.DeployOrDestroyInternalDNSRecord(ctx)).To(Succeed())
+		})
+
+		It("should keep the internal DNS record values if the private link does not exist yet", func() {
+			privateLink.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "foo"))
+			internalDNSRecord.EXPECT().Deploy(ctx)
+			internalDNSRecord.EXPECT().Wait(ctx)
+
+			Expect(botanist.DeployOrDestroyInternalDNSRecord(ctx)).To(Succeed())
+		})
+
+		It("should return the error if the private link cannot be read", func() {
+			privateLink.EXPECT().Get(ctx).Return(nil, fakeErr)
+
+			Expect(botanist.DeployOrDestroyInternalDNSRecord(ctx)).To(MatchError(fakeErr))
+		})
+	})
+})
@@ -44,6 +44,7 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		defaultNotReadyTolerationSeconds,
 		defaultUnreachableTolerationSeconds,
 		version,
+		b.ImageVector(),
 		logger.InfoLevel, logger.FormatJSON,
 		"",
 		true,
@@ -22,6 +22,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -110,10 +111,46 @@ func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 	return rotation
 }
 
+// certificateValidities returns the certificate validities configured for the gardenlet. Validities of server and
+// client certificates can be shortened per shoot via the shoot.gardener.cloud/certificate-validities annotation, but
+// they cannot exceed the validities configured by the operator.
+func (b *Botanist) certificateValidities() (map[secretsutils.CertType]secretsmanager.CertificateValidity, error) {
+	validities := gardenlethelper.CertificateValidities(b.Config)
+
+	value, ok := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootCertificateValidities]
+	if !ok {
+		return validities, nil
+	}
+
+	shootValidities, err := gardenerutils.ParseCertificateValidities(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing annotation %s: %w", v1beta1constants.AnnotationShootCertificateValidities, err)
+	}
+
+	if validities == nil && len(shootValidities) > 0 {
+		validities = make(map[secretsutils.CertType]secretsmanager.CertificateValidity, len(shootValidities))
+	}
+
+	for certType, duration := range shootValidities {
+		validity := validities[certType]
+		if validity.Validity == nil || duration < *validity.Validity {
+			validity.Validity = ptr.To(duration)
+		}
+		validities[certType] = validity
+	}
+
+	return validities, nil
+}
+
 func (b *Botanist) restoreSecretsFromShootStateForSecretsManagerAdoption(ctx context.Context) error {
+	shootState, err := b.Shoot.LoadShootState(ctx)
+	if err != nil {
+		return err
+	}
+
 	var fns []flow.TaskFn
 
-	for _, v := range b.Shoot.GetShootState().Spec.Gardener {
+	for _, v := range shootState.Spec.Gardener {
 		entry := v
 
 		if entry.Labels[secretsmanager.LabelKeyManagedBy] != secretsmanager.LabelValueSecretsManager ||
@@ -12,7 +12,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/discovery"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component"
 	shootsystem "github.com/gardener/gardener/pkg/component/shoot/system"
+	"github.com/gardener/gardener/pkg/component/shoot/systemcomponent"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -57,3 +60,29 @@ func (b *Botanist) DeployShootSystem(ctx context.Context) error {
 	b.Shoot.Components.SystemComponents.Resources.SetAPIResourceList(apiResourceList)
 	return b.Shoot.Components.SystemComponents.Resources.Deploy(ctx)
 }
+
+// DefaultAdditionalSystemComponents returns a registry of the additional system components which are deployed into
+// every shoot cluster of the seed. They are configured via secrets with the `shoot-system-component` garden role.
+func (b *Botanist) DefaultAdditionalSystemComponents() (*component.ComponentRegistry, error) {
+	registry := component.NewComponentRegistry()
+
+	for _, key := range b.GetSecretKeysOfRole(v1beta1constants.GardenRoleShootSystemComponent) {
+		secret := b.LoadSecret(key)
+		if err := registry.Register(secret.Name, systemcomponent.New(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, secret.Name, secret.Data)); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// DeployAdditionalSystemComponents deploys the additional system components and deletes those which are no longer
+// configured.
+func (b *Botanist) DeployAdditionalSystemComponents(ctx context.Context) error {
+	registry := b.Shoot.Components.SystemComponents.Additional
+	if err := registry.Deploy(ctx); err != nil {
+		return err
+	}
+
+	return systemcomponent.DeleteStale(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, sets.New(registry.Names()...))
+}
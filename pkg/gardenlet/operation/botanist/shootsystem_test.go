@@ -11,15 +11,21 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	fakediscovery "k8s.io/client-go/discovery/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/test"
 	mockshootsystem "github.com/gardener/gardener/pkg/component/shoot/system/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("ShootSystem", func() {
@@ -90,6 +96,49 @@ var _ = Describe("ShootSystem", func() {
 			Expect(botanist.DeployShootSystem(ctx)).To(Equal(fakeErr))
 		})
 	})
+
+	Describe("#DeployAdditionalSystemComponents", func() {
+		var (
+			ctx        = context.TODO()
+			namespace  = "shoot--foo--bar"
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build()
+			botanist.Shoot = &shootpkg.Shoot{
+				SeedNamespace: namespace,
+				Components: &shootpkg.Components{
+					SystemComponents: &shootpkg.SystemComponents{},
+				},
+			}
+
+			botanist.StoreSecret("shoot-system-component-foo", &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "seed-test"},
+				Data:       map[string][]byte{"configmap.yaml": []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n  namespace: kube-system\n")},
+			})
+		})
+
+		It("should deploy the configured components and delete stale ones", func() {
+			stale := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot-system-component-stale",
+				Namespace: namespace,
+				Labels:    map[string]string{"gardener.cloud/role": "shoot-system-component"},
+			}}
+			Expect(fakeClient.Create(ctx, stale)).To(Succeed())
+
+			var err error
+			botanist.Shoot.Components.SystemComponents.Additional, err = botanist.DefaultAdditionalSystemComponents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(botanist.Shoot.Components.SystemComponents.Additional.Names()).To(ConsistOf("foo"))
+
+			Expect(botanist.DeployAdditionalSystemComponents(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "shoot-system-component-foo"}, &resourcesv1alpha1.ManagedResource{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(stale), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+	})
 })
 
 type fakeDiscoveryWithServerPreferredResources struct {
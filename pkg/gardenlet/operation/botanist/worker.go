@@ -7,6 +7,7 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -45,6 +46,7 @@ func (b *Botanist) DefaultWorker() worker.Interface {
 			KubernetesVersion:   b.Shoot.KubernetesVersion,
 			MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
 			NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+			ResourceTags:        v1beta1helper.GetShootResourceTags(b.Shoot.GetInfo(), b.Garden.Project.Name),
 		},
 		worker.DefaultInterval,
 		worker.DefaultSevereThreshold,
@@ -67,7 +69,11 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 	b.Shoot.Components.Extensions.Worker.SetWorkerNameToOperatingSystemConfigsMap(b.Shoot.Components.Extensions.OperatingSystemConfig.WorkerNameToOperatingSystemConfigsMap())
 
 	if b.IsRestorePhase() {
-		return b.Shoot.Components.Extensions.Worker.Restore(ctx, b.Shoot.GetShootState())
+		shootState, err := b.Shoot.LoadShootState(ctx)
+		if err != nil {
+			return err
+		}
+		return b.Shoot.Components.Extensions.Worker.Restore(ctx, shootState)
 	}
 
 	return b.Shoot.Components.Extensions.Worker.Deploy(ctx)
@@ -155,6 +161,53 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 	return result
 }
 
+// KubeletConfigUpdatedForAllWorkerPools checks if the kubelet configuration reported by gardener-node-agent on all the
+// nodes for all the provided worker pools matches the desired kubelet configuration of their operating system config.
+// Nodes which have not reported their effective kubelet configuration yet as well as worker pools whose operating
+// system config secret does not carry a desired kubelet configuration checksum are skipped.
+func KubeletConfigUpdatedForAllWorkerPools(
+	workers []gardencorev1beta1.Worker,
+	workerPoolToNodes map[string][]corev1.Node,
+	workerPoolToOperatingSystemConfigSecretMeta map[string]metav1.ObjectMeta,
+) error {
+	var result error
+
+	for _, worker := range workers {
+		secretMeta, ok := workerPoolToOperatingSystemConfigSecretMeta[worker.Name]
+		if !ok {
+			continue
+		}
+
+		desiredChecksum, ok := secretMeta.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumDesiredKubeletConfig]
+		if !ok {
+			continue
+		}
+
+		var outdatedNodes []string
+		for _, node := range workerPoolToNodes[worker.Name] {
+			nodeWillBeDeleted, err := nodeToBeDeleted(node, secretMeta.Name)
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("failed checking whether node %q will be deleted: %w", node.Name, err))
+				continue
+			}
+
+			if nodeWillBeDeleted {
+				continue
+			}
+
+			if effectiveChecksum, ok := node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumEffectiveKubeletConfig]; ok && effectiveChecksum != desiredChecksum {
+				outdatedNodes = append(outdatedNodes, node.Name)
+			}
+		}
+
+		if len(outdatedNodes) > 0 {
+			result = multierror.Append(result, fmt.Errorf("the effective kubelet configuration of worker pool %q is outdated on nodes %s", worker.Name, strings.Join(outdatedNodes, ", ")))
+		}
+	}
+
+	return result
+}
+
 func nodeToBeDeleted(node corev1.Node, gardenerNodeAgentSecretName string) (bool, error) {
 	if nodeTaintedForNoSchedule(node) {
 		return true, nil
@@ -382,6 +382,95 @@ var _ = Describe("Worker", func() {
 		),
 	)
 
+	DescribeTable("#KubeletConfigUpdatedForAllWorkerPools",
+		func(workers []gardencorev1beta1.Worker, workerPoolToNodes map[string][]corev1.Node, workerPoolToCloudConfigSecretMeta map[string]metav1.ObjectMeta, matcher gomegatypes.GomegaMatcher) {
+			Expect(KubeletConfigUpdatedForAllWorkerPools(workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta)).To(matcher)
+		},
+
+		Entry("secret meta missing",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			nil,
+			nil,
+			BeNil(),
+		),
+		Entry("desired checksum annotation missing",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{ObjectMeta: metav1.ObjectMeta{
+				Name:        "node1",
+				Annotations: map[string]string{"checksum/effective-kubelet-config": "foo"},
+				Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+			}}}},
+			map[string]metav1.ObjectMeta{"pool1": {Name: "gardener-node-agent--c63c0"}},
+			BeNil(),
+		),
+		Entry("effective checksum not reported yet",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{ObjectMeta: metav1.ObjectMeta{
+				Name:   "node1",
+				Labels: map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+			}}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/desired-kubelet-config": "foo"},
+			}},
+			BeNil(),
+		),
+		Entry("effective checksum outdated",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}},
+			map[string][]corev1.Node{
+				"pool1": {
+					{ObjectMeta: metav1.ObjectMeta{
+						Name:        "node1",
+						Annotations: map[string]string{"checksum/effective-kubelet-config": "outdated"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					}},
+					{ObjectMeta: metav1.ObjectMeta{
+						Name:        "node2",
+						Annotations: map[string]string{"checksum/effective-kubelet-config": "foo"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					}},
+					{ObjectMeta: metav1.ObjectMeta{
+						Name:        "node3",
+						Annotations: map[string]string{"checksum/effective-kubelet-config": "outdated"},
+						Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+					}},
+				},
+				"pool2": {{ObjectMeta: metav1.ObjectMeta{
+					Name:        "node4",
+					Annotations: map[string]string{"checksum/effective-kubelet-config": "bar"},
+					Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--5dcdf"},
+				}}},
+			},
+			map[string]metav1.ObjectMeta{
+				"pool1": {
+					Name:        "gardener-node-agent--c63c0",
+					Annotations: map[string]string{"checksum/desired-kubelet-config": "foo"},
+				},
+				"pool2": {
+					Name:        "gardener-node-agent--5dcdf",
+					Annotations: map[string]string{"checksum/desired-kubelet-config": "bar"},
+				},
+			},
+			MatchError(ContainSubstring(`the effective kubelet configuration of worker pool "pool1" is outdated on nodes node1, node3`)),
+		),
+		Entry("skip node marked by MCM for termination",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node1",
+					Annotations: map[string]string{"checksum/effective-kubelet-config": "outdated"},
+					Labels:      map[string]string{"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0"},
+				},
+				Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "deployment.machine.sapcloud.io/prefer-no-schedule", Effect: corev1.TaintEffectPreferNoSchedule}}},
+			}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/desired-kubelet-config": "foo"},
+			}},
+			BeNil(),
+		),
+	)
+
 	Describe("#WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools", func() {
 		var (
 			seedInterface  *kubernetesmock.MockInterface
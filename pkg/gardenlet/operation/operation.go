@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
@@ -32,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
@@ -190,6 +192,14 @@ func (b *Builder) Build(
 	}
 	operation.Config = config
 
+	mirror, err := helper.ArtifactMirror(config)
+	if err != nil {
+		return nil, err
+	}
+	if mirror != nil {
+		operation.ArtifactMirror = mirror
+	}
+
 	secretsMap, err := b.secretsFunc()
 	if err != nil {
 		return nil, err
@@ -253,6 +263,15 @@ func (b *Builder) Build(
 		return nil, fmt.Errorf("could not read managed seed API server settings of shoot %s/%s: %+v", shoot.GetInfo().Namespace, shoot.GetInfo().Name, err)
 	}
 
+	imageVectorOverrideList := &gardencorev1beta1.ImageVectorOverrideList{}
+	if err := gardenClient.List(ctx, imageVectorOverrideList); err != nil {
+		return nil, fmt.Errorf("failed listing ImageVectorOverrides: %w", err)
+	}
+	operation.ImageVectorOverride, err = gardenerutils.ShootImageVectorOverride(imageVectorOverrideList.Items, seed.GetInfo(), shoot.GetInfo())
+	if err != nil {
+		return nil, fmt.Errorf("could not compute image vector override for shoot %s/%s: %w", shoot.GetInfo().Namespace, shoot.GetInfo().Name, err)
+	}
+
 	return operation, nil
 }
 
@@ -382,6 +401,15 @@ func (o *Operation) CleanShootTaskError(ctx context.Context, taskID string) {
 	}
 }
 
+// ImageVector returns the image vector for the components of the shoot. It consists of the image vector of the gardenlet,
+// which includes the ImageVectorOverrides selecting the seed, and the ImageVectorOverrides selecting the shoot.
+func (o *Operation) ImageVector() imagevectorutils.ImageVector {
+	if len(o.ImageVectorOverride) == 0 {
+		return imagevector.ImageVector()
+	}
+	return imagevectorutils.Merge(imagevector.ImageVector(), o.ImageVectorOverride)
+}
+
 // SeedVersion is a shorthand for the kubernetes version of the SeedClientSet.
 func (o *Operation) SeedVersion() string {
 	return o.SeedClientSet.Version()
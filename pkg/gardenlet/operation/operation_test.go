@@ -9,12 +9,15 @@ import (
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 var _ = Describe("operation", func() {
@@ -71,4 +74,28 @@ var _ = Describe("operation", func() {
 			"ingress.seed.example.com",
 			Equal("t-barProject--fooShoot.ingress.seed.example.com")),
 	)
+
+	Describe("#ImageVector", func() {
+		It("should return the image vector of the gardenlet if there is no override", func() {
+			o := &Operation{}
+
+			Expect(o.ImageVector()).To(Equal(imagevector.ImageVector()))
+		})
+
+		It("should apply the override on top of the image vector of the gardenlet", func() {
+			o := &Operation{
+				ImageVectorOverride: imagevectorutils.ImageVector{
+					{Name: imagevector.ImageNameCoredns, Repository: "registry.example.com/coredns", Tag: ptr.To("v1.11.1-hotfix.1")},
+				},
+			}
+
+			image, err := o.ImageVector().FindImage(imagevector.ImageNameCoredns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image.String()).To(Equal("registry.example.com/coredns:v1.11.1-hotfix.1"))
+
+			image, err = imagevector.ImageVector().FindImage(imagevector.ImageNameCoredns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(image.String()).NotTo(ContainSubstring("hotfix"))
+		})
+	})
 })
@@ -30,6 +30,7 @@ import (
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 // NewBuilder returns a new Builder.
@@ -295,11 +296,19 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	if lastOperation := shootObject.Status.LastOperation; lastOperation != nil &&
 		lastOperation.Type == gardencorev1beta1.LastOperationTypeRestore &&
 		lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
-		shootState := &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: shootObject.Name, Namespace: shootObject.Namespace}}
-		if err := c.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
-			return nil, err
+		// The ShootState is only read when it is needed for the first time by any of the restore steps, see LoadShootState.
+		shoot.shootStateLoader = func(ctx context.Context) (*gardencorev1beta1.ShootState, error) {
+			shootState := &gardencorev1beta1.ShootState{ObjectMeta: metav1.ObjectMeta{Name: shootObject.Name, Namespace: shootObject.Namespace}}
+			if err := c.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
+				return nil, err
+			}
+
+			if err := shootstate.DecompressSpec(&shootState.Spec); err != nil {
+				return nil, fmt.Errorf("failed decompressing ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+			}
+
+			return shootState, nil
 		}
-		shoot.SetShootState(shootState)
 	}
 
 	return shoot, nil
@@ -322,10 +331,41 @@ func (s *Shoot) SetInfo(shoot *gardencorev1beta1.Shoot) {
 	s.info.Store(shoot)
 }
 
-// GetShootState returns the shootstate resource of this Shoot in a concurrency safe way.
+// LoadShootState returns the shootstate resource of this Shoot in a concurrency safe way. If it was not loaded yet,
+// it is read from the garden cluster and its compressed data entries are decompressed. The result is cached for
+// subsequent calls. The shootstate resource is only available if the Shoot is in the restore phase of the control plane
+// migration.
+// This method should be used only for reading the data of the returned shootstate resource. The returned shootstate
+// resource MUST NOT BE MODIFIED (except in test code) since this might interfere with other concurrent reads and writes.
+func (s *Shoot) LoadShootState(ctx context.Context) (*gardencorev1beta1.ShootState, error) {
+	if shootState := s.GetShootState(); shootState != nil {
+		return shootState, nil
+	}
+
+	s.shootStateMutex.Lock()
+	defer s.shootStateMutex.Unlock()
+
+	if shootState := s.GetShootState(); shootState != nil {
+		return shootState, nil
+	}
+
+	if s.shootStateLoader == nil {
+		return nil, fmt.Errorf("shootstate is only available when the shoot is in the restore phase")
+	}
+
+	shootState, err := s.shootStateLoader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading shootstate: %w", err)
+	}
+
+	s.SetShootState(shootState)
+	return shootState, nil
+}
+
+// GetShootState returns the shootstate resource of this Shoot in a concurrency safe way if it was loaded already, see
+// LoadShootState.
 // This method should be used only for reading the data of the returned shootstate resource. The returned shootstate
 // resource MUST NOT BE MODIFIED (except in test code) since this might interfere with other concurrent reads and writes.
-// To properly update the shootstate resource of this Shoot use SaveGardenerResourceDataInShootState.
 func (s *Shoot) GetShootState() *gardencorev1beta1.ShootState {
 	shootState, ok := s.shootState.Load().(*gardencorev1beta1.ShootState)
 	if !ok {
@@ -338,7 +378,6 @@ func (s *Shoot) GetShootState() *gardencorev1beta1.ShootState {
 // This method is not protected by a mutex and does not update the shootstate resource in the cluster and so
 // should be used only in exceptional situations, or as a convenience in test code. The shootstate passed as a parameter
 // MUST NOT BE MODIFIED after the call to SetShootState (except in test code) since this might interfere with other concurrent reads and writes.
-// To properly update the shootstate resource of this Shoot use SaveGardenerResourceDataInShootState.
 func (s *Shoot) SetShootState(shootState *gardencorev1beta1.ShootState) {
 	s.shootState.Store(shootState)
 }
@@ -410,6 +449,7 @@ func (s *Shoot) GetExtensionComponentsForParallelMigration() []component.DeployM
 		s.Components.Extensions.ControlPlaneExposure,
 		s.Components.Extensions.Network,
 		s.Components.Extensions.OperatingSystemConfig,
+		s.Components.Extensions.PrivateLink,
 		s.Components.Extensions.Worker,
 	}
 }
@@ -5,6 +5,7 @@
 package shoot_test
 
 import (
+	"context"
 	"net"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -134,6 +135,20 @@ var _ = Describe("shoot", func() {
 			})
 		})
 
+		Describe("#LoadShootState", func() {
+			It("should return the shootstate if it was set already", func() {
+				shootState := &gardencorev1beta1.ShootState{}
+				shoot.SetShootState(shootState)
+
+				Expect(shoot.LoadShootState(context.TODO())).To(BeIdenticalTo(shootState))
+			})
+
+			It("should fail if the shoot is not in the restore phase", func() {
+				_, err := shoot.LoadShootState(context.TODO())
+				Expect(err).To(MatchError(ContainSubstring("shootstate is only available when the shoot is in the restore phase")))
+			})
+		})
+
 		Describe("#ComputeInClusterAPIServerAddress", func() {
 			seedNamespace := "foo"
 			s := &Shoot{SeedNamespace: seedNamespace}
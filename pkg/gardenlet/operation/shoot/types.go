@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/extension"
 	"github.com/gardener/gardener/pkg/component/extensions/infrastructure"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/component/extensions/privatelink"
 	"github.com/gardener/gardener/pkg/component/extensions/worker"
 	"github.com/gardener/gardener/pkg/component/garden/backupentry"
 	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
@@ -63,7 +64,9 @@ type Shoot struct {
 	info      atomic.Value
 	infoMutex sync.Mutex
 
-	shootState atomic.Value
+	shootState       atomic.Value
+	shootStateMutex  sync.Mutex
+	shootStateLoader func(context.Context) (*gardencorev1beta1.ShootState, error)
 
 	Secret        *corev1.Secret
 	CloudProfile  *gardencorev1beta1.CloudProfile
@@ -148,11 +151,13 @@ type Extensions struct {
 	Infrastructure        infrastructure.Interface
 	Network               component.DeployMigrateWaiter
 	OperatingSystemConfig operatingsystemconfig.Interface
+	PrivateLink           privatelink.Interface
 	Worker                worker.Interface
 }
 
 // SystemComponents contains references to system components.
 type SystemComponents struct {
+	Additional          *component.ComponentRegistry
 	APIServerProxy      apiserverproxy.Interface
 	BlackboxExporter    component.DeployWaiter
 	ClusterIdentity     clusteridentity.Interface
@@ -169,6 +174,7 @@ type SystemComponents struct {
 
 // Addons contains references for the addons.
 type Addons struct {
+	Charts              *component.ComponentRegistry
 	KubernetesDashboard kubernetesdashboard.Interface
 	NginxIngress        component.Deployer
 }
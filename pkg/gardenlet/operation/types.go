@@ -21,6 +21,8 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -59,6 +61,14 @@ type Operation struct {
 	APIServerClusterIP    string
 	SeedNamespaceObject   *corev1.Namespace
 
+	// ImageVectorOverride is the image vector override for the shoot computed from the ImageVectorOverrides selecting
+	// it. It is applied on top of the image vector of the gardenlet, see ImageVector().
+	ImageVectorOverride imagevector.ImageVector
+
+	// ArtifactMirror is the registry mirror to which the artifacts required for the shoot are synced. It is nil if the
+	// artifact pre-sync is not configured.
+	ArtifactMirror oci.ArtifactMirror
+
 	// ControlPlaneWildcardCert is a wildcard tls certificate which is issued for the seed's ingress domain.
 	ControlPlaneWildcardCert *corev1.Secret
 }
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+// controllerKey is the key which controller-runtime uses for the name of the controller in the loggers it passes to
+// reconcilers.
+const controllerKey = "controller"
+
+// DefaultLevels are the log levels of the component. They are used by the logger set up by the component and can be
+// changed at runtime, e.g., via the log levels endpoint.
+var DefaultLevels = NewLevels()
+
+// Levels holds the global log level and log levels for individual controllers which override the global log level.
+// Both can be changed at runtime.
+type Levels struct {
+	global zap.AtomicLevel
+
+	lock      sync.RWMutex
+	overrides map[string]zapcore.Level
+}
+
+// NewLevels returns new Levels with the info log level and without overrides.
+func NewLevels() *Levels {
+	return &Levels{
+		global:    zap.NewAtomicLevelAt(zap.InfoLevel),
+		overrides: make(map[string]zapcore.Level),
+	}
+}
+
+// Global returns the global log level.
+func (l *Levels) Global() string {
+	return levelToString(l.global.Level())
+}
+
+// SetGlobal sets the global log level.
+func (l *Levels) SetGlobal(level string) error {
+	zapLevel, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	l.global.SetLevel(zapLevel)
+	return nil
+}
+
+// Overrides returns the log levels for individual controllers.
+func (l *Levels) Overrides() map[string]string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	out := make(map[string]string, len(l.overrides))
+	for controller, level := range l.overrides {
+		out[controller] = levelToString(level)
+	}
+	return out
+}
+
+// SetOverrides replaces the log levels for individual controllers.
+func (l *Levels) SetOverrides(overrides map[string]string) error {
+	parsed := make(map[string]zapcore.Level, len(overrides))
+	for controller, level := range overrides {
+		zapLevel, err := parseLevel(level)
+		if err != nil {
+			return fmt.Errorf("failed parsing log level for controller %q: %w", controller, err)
+		}
+		parsed[controller] = zapLevel
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.overrides = parsed
+	return nil
+}
+
+// Enabled returns true if the given level is enabled for the given controller. The global log level is used if there
+// is no override for the controller.
+func (l *Levels) Enabled(controller string, level zapcore.Level) bool {
+	if controller != "" {
+		l.lock.RLock()
+		override, ok := l.overrides[controller]
+		l.lock.RUnlock()
+
+		if ok {
+			return override.Enabled(level)
+		}
+	}
+
+	return l.global.Enabled(level)
+}
+
+// WithLevels returns an option for NewZapLogger which makes the logger respect the given levels. The level passed to
+// NewZapLogger is ignored in this case.
+func WithLevels(levels *Levels) logzap.Opts {
+	return func(o *logzap.Options) {
+		// let the wrapping core decide which entries are written
+		level := zap.NewAtomicLevelAt(zapcore.Level(math.MinInt8))
+		o.Level = &level
+		o.ZapOpts = append(o.ZapOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return &levelsCore{Core: core, levels: levels}
+		}))
+	}
+}
+
+// ControllerName returns the name of the controller the given logger belongs to. It returns an empty string if the
+// logger does not belong to a controller or was not created with WithLevels.
+func ControllerName(log logr.Logger) string {
+	underlier, ok := log.GetSink().(zapr.Underlier)
+	if !ok {
+		return ""
+	}
+
+	core, ok := underlier.GetUnderlying().Core().(*levelsCore)
+	if !ok {
+		return ""
+	}
+
+	return core.controller
+}
+
+// levelsCore is a zapcore.Core which filters the log entries based on the levels for the controller the logger
+// belongs to.
+type levelsCore struct {
+	zapcore.Core
+	levels     *Levels
+	controller string
+}
+
+func (c *levelsCore) Enabled(level zapcore.Level) bool {
+	return c.levels.Enabled(c.controller, level)
+}
+
+func (c *levelsCore) With(fields []zapcore.Field) zapcore.Core {
+	controller := c.controller
+	for _, field := range fields {
+		if field.Key == controllerKey && field.Type == zapcore.StringType {
+			controller = field.String
+		}
+	}
+
+	return &levelsCore{Core: c.Core.With(fields), levels: c.levels, controller: controller}
+}
+
+func (c *levelsCore) Check(entry zapcore.Entry, checkedEntry *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return checkedEntry
+	}
+	return c.Core.Check(entry, checkedEntry)
+}
+
+func parseLevel(level string) (zapcore.Level, error) {
+	switch level {
+	case DebugLevel:
+		return zap.DebugLevel, nil
+	case ErrorLevel:
+		return zap.ErrorLevel, nil
+	case "", InfoLevel:
+		return zap.InfoLevel, nil
+	default:
+		return 0, fmt.Errorf("invalid log level %q", level)
+	}
+}
+
+func levelToString(level zapcore.Level) string {
+	switch {
+	case level <= zap.DebugLevel:
+		return DebugLevel
+	case level >= zap.ErrorLevel:
+		return ErrorLevel
+	default:
+		return InfoLevel
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger_test
+
+import (
+	"bytes"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	. "github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("Levels", func() {
+	var (
+		levels *Levels
+		buffer *bytes.Buffer
+		log    logr.Logger
+	)
+
+	BeforeEach(func() {
+		levels = NewLevels()
+		buffer = &bytes.Buffer{}
+
+		var err error
+		log, err = NewZapLogger(InfoLevel, FormatJSON, WithLevels(levels), logzap.WriteTo(buffer))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should use the global log level", func() {
+		log.V(1).Info("foo")
+		Expect(buffer.String()).To(BeEmpty())
+
+		Expect(levels.SetGlobal(DebugLevel)).To(Succeed())
+		Expect(levels.Global()).To(Equal(DebugLevel))
+
+		log.V(1).Info("foo")
+		Expect(buffer.String()).To(ContainSubstring(`"msg":"foo"`))
+	})
+
+	It("should use the log level overrides of controllers", func() {
+		Expect(levels.SetOverrides(map[string]string{"shoot-care": DebugLevel, "shoot": ErrorLevel})).To(Succeed())
+		Expect(levels.Overrides()).To(Equal(map[string]string{"shoot-care": DebugLevel, "shoot": ErrorLevel}))
+
+		log.WithValues("controller", "shoot-care").V(1).Info("care")
+		log.WithValues("controller", "shoot").Info("shoot")
+		log.WithValues("controller", "seed").V(1).Info("seed-debug")
+		log.WithValues("controller", "seed").Info("seed-info")
+
+		Expect(buffer.String()).To(ContainSubstring(`"msg":"care"`))
+		Expect(buffer.String()).NotTo(ContainSubstring(`"msg":"shoot"`))
+		Expect(buffer.String()).NotTo(ContainSubstring(`"msg":"seed-debug"`))
+		Expect(buffer.String()).To(ContainSubstring(`"msg":"seed-info"`))
+	})
+
+	It("should apply changed overrides to existing loggers", func() {
+		controllerLog := log.WithValues("controller", "shoot-care")
+		Expect(controllerLog.V(1).Enabled()).To(BeFalse())
+
+		Expect(levels.SetOverrides(map[string]string{"shoot-care": DebugLevel})).To(Succeed())
+		Expect(controllerLog.V(1).Enabled()).To(BeTrue())
+		Expect(log.V(1).Enabled()).To(BeFalse())
+	})
+
+	It("should reject invalid log levels", func() {
+		Expect(levels.SetGlobal("invalid")).To(MatchError(ContainSubstring("invalid log level")))
+		Expect(levels.SetOverrides(map[string]string{"shoot-care": "invalid"})).To(MatchError(ContainSubstring(`controller "shoot-care"`)))
+	})
+
+	Describe("#ControllerName", func() {
+		It("should return the name of the controller the logger belongs to", func() {
+			Expect(ControllerName(log)).To(BeEmpty())
+			Expect(ControllerName(log.WithValues("controller", "shoot-care").WithName("reconciler").V(1))).To(Equal("shoot-care"))
+		})
+
+		It("should return an empty string for loggers not created with levels", func() {
+			Expect(ControllerName(logr.Discard())).To(BeEmpty())
+			Expect(ControllerName(MustNewZapLogger(InfoLevel, FormatJSON).WithValues("controller", "shoot-care"))).To(BeEmpty())
+		})
+	})
+})
@@ -8,7 +8,6 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
-	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -32,17 +31,9 @@ func NewZapLogger(level string, format string, additionalOpts ...logzap.Opts) (l
 	var opts []logzap.Opts
 
 	// map our log levels to zap levels
-	var zapLevel zapcore.LevelEnabler
-
-	switch level {
-	case DebugLevel:
-		zapLevel = zap.DebugLevel
-	case ErrorLevel:
-		zapLevel = zap.ErrorLevel
-	case "", InfoLevel:
-		zapLevel = zap.InfoLevel
-	default:
-		return logr.Logger{}, fmt.Errorf("invalid log level %q", level)
+	zapLevel, err := parseLevel(level)
+	if err != nil {
+		return logr.Logger{}, err
 	}
 
 	opts = append(opts, logzap.Level(zapLevel))
@@ -43,6 +43,15 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyChecksumDesiredKubeletConfig is a constant for an annotation key on a Secret describing the checksum
+	// of the kubelet configuration file contained in the operating system configuration in the data map.
+	AnnotationKeyChecksumDesiredKubeletConfig = "checksum/desired-kubelet-config"
+	// AnnotationKeyChecksumEffectiveKubeletConfig is a constant for an annotation key on a Node describing the checksum
+	// of the kubelet configuration file currently present on the node.
+	AnnotationKeyChecksumEffectiveKubeletConfig = "checksum/effective-kubelet-config"
+	// AnnotationKeyPathMTU is a constant for an annotation key on a Node describing the discovered maximum transmission
+	// unit of the network path from the node to the kube-apiserver endpoint of the shoot, i.e., to the seed.
+	AnnotationKeyPathMTU = "node-agent.gardener.cloud/path-mtu"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
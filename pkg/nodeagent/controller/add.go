@@ -7,6 +7,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,9 +17,11 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/hostnamecheck"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/kubeletconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/pathmtu"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
 )
 
@@ -55,6 +58,21 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
+	if err := (&kubeletconfig.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+		return fmt.Errorf("failed adding kubelet-config controller: %w", err)
+	}
+
+	apiServerURL, err := url.Parse(cfg.APIServer.Server)
+	if err != nil {
+		return fmt.Errorf("failed parsing API server address %q: %w", cfg.APIServer.Server, err)
+	}
+
+	if err := (&pathmtu.Reconciler{
+		Host: apiServerURL.Hostname(),
+	}).AddToManager(mgr, nodePredicate); err != nil {
+		return fmt.Errorf("failed adding path-mtu controller: %w", err)
+	}
+
 	if err := (&hostnamecheck.Reconciler{
 		HostName:      hostName,
 		CancelContext: cancel,
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// Reconciler reports the checksum of the kubelet configuration file present on the node via an annotation on the
+// Node object.
+type Reconciler struct {
+	Client     client.Client
+	FS         afero.Afero
+	SyncPeriod time.Duration
+}
+
+// Reconcile computes the checksum of the kubelet configuration file and maintains it in an annotation on the Node.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	kubeletConfig, err := r.FS.ReadFile(v1beta1constants.OperatingSystemConfigFilePathKubeletConfig)
+	if err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return reconcile.Result{}, fmt.Errorf("failed reading kubelet configuration file: %w", err)
+		}
+
+		log.V(1).Info("Kubelet configuration file does not exist yet, requeueing", "path", v1beta1constants.OperatingSystemConfigFilePathKubeletConfig)
+		return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	checksum := utils.ComputeSHA256Hex(kubeletConfig)
+	if node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumEffectiveKubeletConfig] == checksum {
+		return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	log.Info("Updating checksum of effective kubelet configuration on node", "checksum", checksum)
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumEffectiveKubeletConfig, checksum)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching node with checksum of effective kubelet configuration: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
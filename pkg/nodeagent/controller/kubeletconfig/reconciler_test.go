@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletconfig_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/kubeletconfig"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fs         afero.Afero
+		reconciler *Reconciler
+
+		node    *corev1.Node
+		request reconcile.Request
+
+		syncPeriod = 5 * time.Minute
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		reconciler = &Reconciler{Client: fakeClient, FS: fs, SyncPeriod: syncPeriod}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+	})
+
+	It("should do nothing when the node is gone", func() {
+		Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should requeue when the kubelet configuration file does not exist yet", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).NotTo(HaveKey("checksum/effective-kubelet-config"))
+	})
+
+	It("should report the checksum of the kubelet configuration file", func() {
+		Expect(fs.WriteFile("/var/lib/kubelet/config/kubelet", []byte("config"), 0600)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue("checksum/effective-kubelet-config", utils.ComputeSHA256Hex([]byte("config"))))
+	})
+
+	It("should update the checksum when the kubelet configuration file changes", func() {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, "checksum/effective-kubelet-config", utils.ComputeSHA256Hex([]byte("old-config")))
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+		Expect(fs.WriteFile("/var/lib/kubelet/config/kubelet", []byte("new-config"), 0600)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue("checksum/effective-kubelet-config", utils.ComputeSHA256Hex([]byte("new-config"))))
+	})
+})
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathmtu
+
+import (
+	"context"
+)
+
+// Prober discovers the maximum transmission unit of the network path to a remote host.
+type Prober interface {
+	// Probe returns the path MTU to the given host.
+	Probe(ctx context.Context, host string) (int, error)
+}
+
+// ProberFunc is a function that implements Prober.
+type ProberFunc func(ctx context.Context, host string) (int, error)
+
+// Probe returns the path MTU to the given host.
+func (f ProberFunc) Probe(ctx context.Context, host string) (int, error) {
+	return f(ctx, host)
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathmtu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"time"
+)
+
+const (
+	// probePort is the port the probe packets are sent to. The kube-apiserver endpoint of the shoot does not need to
+	// answer, the probe packets only have to traverse the same path as the connections to the endpoint.
+	probePort = "443"
+	// maxProbeAttempts is the maximum number of probe packets sent to converge to the path MTU.
+	maxProbeAttempts = 8
+	// probeWait is the duration to wait for routers on the path to report a smaller MTU after a probe packet was sent.
+	probeWait = 500 * time.Millisecond
+
+	ipv4HeaderLength = 20
+	ipv6HeaderLength = 40
+	udpHeaderLength  = 8
+)
+
+// NewProber returns a Prober which performs path MTU discovery with UDP packets for which fragmentation is prohibited.
+// Routers on the path which cannot forward such packets report their MTU via ICMP, which the kernel records for the
+// destination.
+func NewProber() Prober {
+	return ProberFunc(probe)
+}
+
+func probe(ctx context.Context, host string) (int, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", net.JoinHostPort(host, probePort))
+	if err != nil {
+		return 0, fmt.Errorf("failed dialing %s: %w", host, err)
+	}
+	defer conn.Close()
+
+	udpConn, ok := conn.(*net.UDPConn)
+	if !ok {
+		return 0, fmt.Errorf("unexpected connection type %T", conn)
+	}
+	rawConn, err := udpConn.SyscallConn()
+	if err != nil {
+		return 0, fmt.Errorf("failed getting raw connection: %w", err)
+	}
+
+	level, optDiscover, optMTU, headerLength := syscall.IPPROTO_IP, syscall.IP_MTU_DISCOVER, syscall.IP_MTU, ipv4HeaderLength+udpHeaderLength
+	if udpConn.RemoteAddr().(*net.UDPAddr).IP.To4() == nil {
+		level, optDiscover, optMTU, headerLength = syscall.IPPROTO_IPV6, syscall.IPV6_MTU_DISCOVER, syscall.IPV6_MTU, ipv6HeaderLength+udpHeaderLength
+	}
+
+	if err := control(rawConn, func(fd int) error {
+		return syscall.SetsockoptInt(fd, level, optDiscover, syscall.IP_PMTUDISC_DO)
+	}); err != nil {
+		return 0, fmt.Errorf("failed prohibiting fragmentation: %w", err)
+	}
+
+	getMTU := func() (int, error) {
+		var mtu int
+		err := control(rawConn, func(fd int) error {
+			var err error
+			mtu, err = syscall.GetsockoptInt(fd, level, optMTU)
+			return err
+		})
+		return mtu, err
+	}
+
+	mtu, err := getMTU()
+	if err != nil {
+		return 0, fmt.Errorf("failed reading MTU: %w", err)
+	}
+
+	for range maxProbeAttempts {
+		// EMSGSIZE means that the kernel already knows a smaller MTU, ECONNREFUSED is caused by an ICMP "port
+		// unreachable" for a previous probe. Neither prevents the discovery.
+		if _, err := conn.Write(make([]byte, mtu-headerLength)); err != nil && !errors.Is(err, syscall.EMSGSIZE) && !errors.Is(err, syscall.ECONNREFUSED) {
+			return 0, fmt.Errorf("failed sending probe: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(probeWait):
+		}
+
+		newMTU, err := getMTU()
+		if err != nil {
+			return 0, fmt.Errorf("failed reading MTU: %w", err)
+		}
+		if newMTU >= mtu {
+			return mtu, nil
+		}
+		mtu = newMTU
+	}
+
+	return mtu, nil
+}
+
+func control(rawConn syscall.RawConn, f func(fd int) error) error {
+	var err error
+	if controlErr := rawConn.Control(func(fd uintptr) {
+		err = f(int(fd))
+	}); controlErr != nil {
+		return controlErr
+	}
+	return err
+}
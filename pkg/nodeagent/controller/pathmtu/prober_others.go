@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package pathmtu
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// NewProber returns a Prober which fails since path MTU discovery is only supported on Linux.
+func NewProber() Prober {
+	return ProberFunc(func(context.Context, string) (int, error) {
+		return 0, fmt.Errorf("path MTU discovery is not supported on %s", runtime.GOOS)
+	})
+}
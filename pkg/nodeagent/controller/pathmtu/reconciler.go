@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathmtu
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// Reconciler discovers the maximum transmission unit of the network path from the node to the kube-apiserver endpoint
+// of the shoot and reports it via an annotation on the Node object. The endpoint is exposed by the seed, hence the
+// result also applies to the VPN connection between the seed and the node.
+type Reconciler struct {
+	Client     client.Client
+	Prober     Prober
+	Host       string
+	SyncPeriod time.Duration
+}
+
+// Reconcile probes the path MTU and maintains it in an annotation on the Node.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	mtu, err := r.Prober.Probe(ctx, r.Host)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed probing path MTU to %s: %w", r.Host, err)
+	}
+
+	pathMTU := strconv.Itoa(mtu)
+	if node.Annotations[nodeagentv1alpha1.AnnotationKeyPathMTU] == pathMTU {
+		return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	log.Info("Updating path MTU on node", "host", r.Host, "pathMTU", mtu)
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyPathMTU, pathMTU)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching node with path MTU: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathmtu_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/pathmtu"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		node    *corev1.Node
+		request reconcile.Request
+
+		host       = "api.example.com"
+		pathMTU    int
+		probeErr   error
+		syncPeriod = 5 * time.Minute
+	)
+
+	BeforeEach(func() {
+		pathMTU, probeErr = 1400, nil
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Prober: ProberFunc(func(_ context.Context, h string) (int, error) {
+				Expect(h).To(Equal(host))
+				return pathMTU, probeErr
+			}),
+			Host:       host,
+			SyncPeriod: syncPeriod,
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+	})
+
+	It("should do nothing when the node is gone", func() {
+		Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should add the path MTU annotation to the node", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/path-mtu", "1400"))
+	})
+
+	It("should update the path MTU annotation when the path MTU changed", func() {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/path-mtu", "1500")
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/path-mtu", "1400"))
+	})
+
+	It("should return the error when probing fails", func() {
+		probeErr = errors.New("fake")
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("failed probing path MTU to api.example.com: fake")))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/path-mtu"))
+	})
+})
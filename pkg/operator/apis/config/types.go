@@ -56,6 +56,8 @@ type ControllerConfiguration struct {
 	Garden GardenControllerConfig
 	// GardenCare is the configuration for the garden care controller
 	GardenCare GardenCareControllerConfiguration
+	// GardenDrift is the configuration for the garden drift controller.
+	GardenDrift GardenDriftControllerConfiguration
 	// NetworkPolicy is the configuration for the NetworkPolicy controller.
 	NetworkPolicy NetworkPolicyControllerConfiguration
 	// VPAEvictionRequirements is the configuration for the VPAEvictionrequirements controller.
@@ -71,6 +73,15 @@ type GardenCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// GardenDriftControllerConfiguration defines the configuration of the GardenDrift controller.
+type GardenDriftControllerConfiguration struct {
+	// SyncPeriod is the duration how often the deployed objects are compared with their desired state.
+	SyncPeriod *metav1.Duration
+	// AutoRevert specifies whether a drift of the deployed objects shall be reverted automatically by triggering the
+	// reconciliation of the affected ManagedResources.
+	AutoRevert *bool
+}
+
 // GardenControllerConfig is the configuration for the garden controller.
 type GardenControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
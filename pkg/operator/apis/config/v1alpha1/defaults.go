@@ -98,3 +98,13 @@ func SetDefaults_GardenCareControllerConfiguration(obj *GardenCareControllerConf
 		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
 	}
 }
+
+// SetDefaults_GardenDriftControllerConfiguration sets defaults for the GardenDriftControllerConfiguration object.
+func SetDefaults_GardenDriftControllerConfiguration(obj *GardenDriftControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.AutoRevert == nil {
+		obj.AutoRevert = ptr.To(false)
+	}
+}
@@ -260,5 +260,30 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.Controllers.GardenCare.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 			})
 		})
+
+		Describe("GardenDrift controller defaulting", func() {
+			It("should default the GardenDrift controller config", func() {
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.GardenDrift.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+				Expect(obj.Controllers.GardenDrift.AutoRevert).To(PointTo(BeFalse()))
+			})
+
+			It("should not overwrite already set values for GardenDrift controller config", func() {
+				obj = &OperatorConfiguration{
+					Controllers: ControllerConfiguration{
+						GardenDrift: GardenDriftControllerConfiguration{
+							SyncPeriod: &metav1.Duration{Duration: time.Minute},
+							AutoRevert: ptr.To(true),
+						},
+					},
+				}
+
+				SetObjectDefaults_OperatorConfiguration(obj)
+
+				Expect(obj.Controllers.GardenDrift.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+				Expect(obj.Controllers.GardenDrift.AutoRevert).To(PointTo(BeTrue()))
+			})
+		})
 	})
 })
@@ -59,6 +59,8 @@ type ControllerConfiguration struct {
 	Garden GardenControllerConfig `json:"garden"`
 	// GardenCare is the configuration for the garden care controller
 	GardenCare GardenCareControllerConfiguration `json:"gardenCare"`
+	// GardenDrift is the configuration for the garden drift controller.
+	GardenDrift GardenDriftControllerConfiguration `json:"gardenDrift"`
 	// NetworkPolicy is the configuration for the NetworkPolicy controller.
 	NetworkPolicy NetworkPolicyControllerConfiguration `json:"networkPolicy"`
 	// VPAEvictionRequirements is the configuration for the VPAEvictionrequirements controller.
@@ -76,6 +78,17 @@ type GardenCareControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// GardenDriftControllerConfiguration defines the configuration of the GardenDrift controller.
+type GardenDriftControllerConfiguration struct {
+	// SyncPeriod is the duration how often the deployed objects are compared with their desired state.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// AutoRevert specifies whether a drift of the deployed objects shall be reverted automatically by triggering the
+	// reconciliation of the affected ManagedResources.
+	// +optional
+	AutoRevert *bool `json:"autoRevert,omitempty"`
+}
+
 // GardenControllerConfig is the configuration for the garden controller.
 type GardenControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
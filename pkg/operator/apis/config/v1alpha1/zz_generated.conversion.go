@@ -69,6 +69,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GardenDriftControllerConfiguration)(nil), (*config.GardenDriftControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenDriftControllerConfiguration_To_config_GardenDriftControllerConfiguration(a.(*GardenDriftControllerConfiguration), b.(*config.GardenDriftControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GardenDriftControllerConfiguration)(nil), (*GardenDriftControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GardenDriftControllerConfiguration_To_v1alpha1_GardenDriftControllerConfiguration(a.(*config.GardenDriftControllerConfiguration), b.(*GardenDriftControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyControllerConfiguration)(nil), (*config.NetworkPolicyControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(a.(*NetworkPolicyControllerConfiguration), b.(*config.NetworkPolicyControllerConfiguration), scope)
 	}); err != nil {
@@ -161,6 +171,9 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	if err := Convert_v1alpha1_GardenCareControllerConfiguration_To_config_GardenCareControllerConfiguration(&in.GardenCare, &out.GardenCare, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_GardenDriftControllerConfiguration_To_config_GardenDriftControllerConfiguration(&in.GardenDrift, &out.GardenDrift, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
 		return err
 	}
@@ -182,6 +195,9 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	if err := Convert_config_GardenCareControllerConfiguration_To_v1alpha1_GardenCareControllerConfiguration(&in.GardenCare, &out.GardenCare, s); err != nil {
 		return err
 	}
+	if err := Convert_config_GardenDriftControllerConfiguration_To_v1alpha1_GardenDriftControllerConfiguration(&in.GardenDrift, &out.GardenDrift, s); err != nil {
+		return err
+	}
 	if err := Convert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPolicyControllerConfiguration(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
 		return err
 	}
@@ -242,6 +258,28 @@ func Convert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfig(in
 	return autoConvert_config_GardenControllerConfig_To_v1alpha1_GardenControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_GardenDriftControllerConfiguration_To_config_GardenDriftControllerConfiguration(in *GardenDriftControllerConfiguration, out *config.GardenDriftControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.AutoRevert = (*bool)(unsafe.Pointer(in.AutoRevert))
+	return nil
+}
+
+// Convert_v1alpha1_GardenDriftControllerConfiguration_To_config_GardenDriftControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GardenDriftControllerConfiguration_To_config_GardenDriftControllerConfiguration(in *GardenDriftControllerConfiguration, out *config.GardenDriftControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GardenDriftControllerConfiguration_To_config_GardenDriftControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_GardenDriftControllerConfiguration_To_v1alpha1_GardenDriftControllerConfiguration(in *config.GardenDriftControllerConfiguration, out *GardenDriftControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.AutoRevert = (*bool)(unsafe.Pointer(in.AutoRevert))
+	return nil
+}
+
+// Convert_config_GardenDriftControllerConfiguration_To_v1alpha1_GardenDriftControllerConfiguration is an autogenerated conversion function.
+func Convert_config_GardenDriftControllerConfiguration_To_v1alpha1_GardenDriftControllerConfiguration(in *config.GardenDriftControllerConfiguration, out *GardenDriftControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_GardenDriftControllerConfiguration_To_v1alpha1_GardenDriftControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkPolicyControllerConfiguration_To_config_NetworkPolicyControllerConfiguration(in *NetworkPolicyControllerConfiguration, out *config.NetworkPolicyControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.AdditionalNamespaceSelectors = *(*[]v1.LabelSelector)(unsafe.Pointer(&in.AdditionalNamespaceSelectors))
@@ -38,6 +38,7 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.Garden.DeepCopyInto(&out.Garden)
 	in.GardenCare.DeepCopyInto(&out.GardenCare)
+	in.GardenDrift.DeepCopyInto(&out.GardenDrift)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.VPAEvictionRequirements.DeepCopyInto(&out.VPAEvictionRequirements)
 	return
@@ -110,6 +111,32 @@ func (in *GardenControllerConfig) DeepCopy() *GardenControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenDriftControllerConfiguration) DeepCopyInto(out *GardenDriftControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AutoRevert != nil {
+		in, out := &in.AutoRevert, &out.AutoRevert
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenDriftControllerConfiguration.
+func (in *GardenDriftControllerConfiguration) DeepCopy() *GardenDriftControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenDriftControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfiguration) DeepCopyInto(out *NetworkPolicyControllerConfiguration) {
 	*out = *in
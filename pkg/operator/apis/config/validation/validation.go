@@ -41,6 +41,7 @@ func validateControllerConfiguration(conf config.ControllerConfiguration, fldPat
 
 	allErrs = append(allErrs, validateGardenControllerConfiguration(conf.Garden, fldPath.Child("garden"))...)
 	allErrs = append(allErrs, validateGardenCareControllerConfiguration(conf.GardenCare, fldPath.Child("gardenCare"))...)
+	allErrs = append(allErrs, validateGardenDriftControllerConfiguration(conf.GardenDrift, fldPath.Child("gardenDrift"))...)
 	allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(conf.NetworkPolicy, fldPath.Child("networkPolicy"))...)
 
 	return allErrs
@@ -63,6 +64,14 @@ func validateGardenCareControllerConfiguration(conf config.GardenCareControllerC
 	return allErrs
 }
 
+func validateGardenDriftControllerConfiguration(conf config.GardenDriftControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	return allErrs
+}
+
 func validateNetworkPolicyControllerConfiguration(conf config.NetworkPolicyControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -42,6 +42,9 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 				GardenCare: config.GardenCareControllerConfiguration{
 					SyncPeriod: &metav1.Duration{Duration: time.Minute},
 				},
+				GardenDrift: config.GardenDriftControllerConfiguration{
+					SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+				},
 				NetworkPolicy: config.NetworkPolicyControllerConfiguration{
 					ConcurrentSyncs: ptr.To(5),
 				},
@@ -136,6 +139,30 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			})
 		})
 
+		Context("GardenDrift", func() {
+			It("should return errors because sync period is nil", func() {
+				conf.Controllers.GardenDrift.SyncPeriod = nil
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.gardenDrift.syncPeriod"),
+					})),
+				))
+			})
+
+			It("should return errors because sync period is < 15s", func() {
+				conf.Controllers.GardenDrift.SyncPeriod = &metav1.Duration{Duration: time.Second}
+
+				Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.gardenDrift.syncPeriod"),
+					})),
+				))
+			})
+		})
+
 		Context("network policy", func() {
 			It("should return errors because concurrent syncs are <= 0", func() {
 				conf.Controllers.NetworkPolicy.ConcurrentSyncs = ptr.To(0)
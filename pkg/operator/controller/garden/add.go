@@ -16,6 +16,7 @@ import (
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
 	"github.com/gardener/gardener/pkg/operator/apis/config"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/care"
+	"github.com/gardener/gardener/pkg/operator/controller/garden/drift"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/garden"
 	"github.com/gardener/gardener/pkg/operator/controller/garden/reference"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -68,6 +69,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if err := (&drift.Reconciler{
+		Config:          *cfg,
+		GardenClientMap: gardenClientMap,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding drift reconciler: %w", err)
+	}
+
 	if err := reference.AddToManager(mgr, v1beta1constants.GardenNamespace); err != nil {
 		return fmt.Errorf("failed adding reference reconciler: %w", err)
 	}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// Compare compares the desired object with the current object and returns the paths of all fields whose current
+// values differ from their desired values. Fields which are only present in the current object (e.g., defaulted fields
+// or fields populated by other controllers) are not considered as drift. Similar to the ManagedResource controller of
+// gardener-resource-manager, only the labels and annotations of the metadata are considered, the status is ignored,
+// and the replicas and resources are ignored if the object is annotated to preserve them.
+func Compare(desired, current *unstructured.Unstructured) []string {
+	c := &comparator{
+		preserveReplicas:  desired.GetAnnotations()[resourcesv1alpha1.PreserveReplicas] == "true",
+		preserveResources: desired.GetAnnotations()[resourcesv1alpha1.PreserveResources] == "true",
+	}
+
+	for key, desiredValue := range desired.Object {
+		switch key {
+		case "status":
+			continue
+		case "metadata":
+			desiredMetadata, _ := desiredValue.(map[string]any)
+			currentMetadata, _ := current.Object[key].(map[string]any)
+			for _, metadataKey := range []string{"labels", "annotations"} {
+				if value, ok := desiredMetadata[metadataKey]; ok {
+					c.compare([]string{key, metadataKey}, value, currentMetadata[metadataKey])
+				}
+			}
+		default:
+			c.compare([]string{key}, desiredValue, current.Object[key])
+		}
+	}
+
+	slices.Sort(c.drifts)
+	return c.drifts
+}
+
+type comparator struct {
+	preserveReplicas  bool
+	preserveResources bool
+	drifts            []string
+}
+
+func (c *comparator) compare(path []string, desired, current any) {
+	if c.ignored(path) {
+		return
+	}
+
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		currentValue, ok := current.(map[string]any)
+		if !ok {
+			if len(desiredValue) > 0 || current != nil {
+				c.addDrift(path)
+			}
+			return
+		}
+
+		for key, value := range desiredValue {
+			c.compare(childPath(path, key), value, currentValue[key])
+		}
+
+	case []any:
+		currentValue, ok := current.([]any)
+		if !ok {
+			if len(desiredValue) > 0 || current != nil {
+				c.addDrift(path)
+			}
+			return
+		}
+
+		if len(desiredValue) != len(currentValue) {
+			c.addDrift(path)
+			return
+		}
+
+		for i := range desiredValue {
+			c.compare(childPath(path, fmt.Sprintf("[%d]", i)), desiredValue[i], currentValue[i])
+		}
+
+	default:
+		if !scalarsEqual(desired, current) {
+			c.addDrift(path)
+		}
+	}
+}
+
+func childPath(path []string, element string) []string {
+	return append(path[:len(path):len(path)], element)
+}
+
+func (c *comparator) ignored(path []string) bool {
+	if c.preserveReplicas && len(path) == 2 && path[0] == "spec" && path[1] == "replicas" {
+		return true
+	}
+
+	// Resource requirements of containers in pod templates are located at '...containers[i].resources'.
+	if c.preserveResources && len(path) >= 3 && path[len(path)-1] == "resources" &&
+		(path[len(path)-3] == "containers" || path[len(path)-3] == "initContainers") {
+		return true
+	}
+
+	return false
+}
+
+func (c *comparator) addDrift(path []string) {
+	c.drifts = append(c.drifts, strings.ReplaceAll(strings.Join(path, "."), ".[", "["))
+}
+
+func scalarsEqual(desired, current any) bool {
+	// Fields with zero values are typically omitted by the API server.
+	if current == nil {
+		return desired == nil || reflect.ValueOf(desired).IsZero()
+	}
+
+	if desiredNumber, ok := toFloat64(desired); ok {
+		currentNumber, ok := toFloat64(current)
+		return ok && desiredNumber == currentNumber
+	}
+
+	if desiredString, ok := desired.(string); ok {
+		currentString, ok := current.(string)
+		if !ok {
+			return false
+		}
+		if desiredString == currentString {
+			return true
+		}
+
+		// Quantities are normalized by the API server, e.g. '1000m' is stored as '1'.
+		desiredQuantity, err := resource.ParseQuantity(desiredString)
+		if err != nil {
+			return false
+		}
+		currentQuantity, err := resource.ParseQuantity(currentString)
+		return err == nil && desiredQuantity.Cmp(currentQuantity) == 0
+	}
+
+	return reflect.DeepEqual(desired, current)
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	}
+	return 0, false
+}
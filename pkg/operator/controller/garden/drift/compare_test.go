@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/drift"
+)
+
+var _ = Describe("Compare", func() {
+	var desired, current *unstructured.Unstructured
+
+	BeforeEach(func() {
+		desired = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]any{
+				"name":        "foo",
+				"namespace":   "garden",
+				"labels":      map[string]any{"app": "foo"},
+				"annotations": map[string]any{"foo": "bar"},
+			},
+			"spec": map[string]any{
+				"replicas": float64(2),
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{
+								"name":  "foo",
+								"image": "foo:v1",
+								"resources": map[string]any{
+									"requests": map[string]any{"cpu": "1000m"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": map[string]any{},
+		}}
+
+		current = desired.DeepCopy()
+		Expect(unstructured.SetNestedField(current.Object, "abc", "metadata", "resourceVersion")).To(Succeed())
+		Expect(unstructured.SetNestedField(current.Object, "gardener", "metadata", "labels", "resources.gardener.cloud/managed-by")).To(Succeed())
+		Expect(unstructured.SetNestedField(current.Object, int64(2), "spec", "replicas")).To(Succeed())
+		Expect(unstructured.SetNestedField(current.Object, int64(600), "spec", "progressDeadlineSeconds")).To(Succeed())
+		Expect(unstructured.SetNestedField(current.Object, map[string]any{"replicas": int64(2)}, "status")).To(Succeed())
+		Expect(unstructured.SetNestedSlice(current.Object, []any{
+			map[string]any{
+				"name":                     "foo",
+				"image":                    "foo:v1",
+				"terminationMessagePolicy": "File",
+				"resources": map[string]any{
+					"requests": map[string]any{"cpu": "1"},
+				},
+			},
+		}, "spec", "template", "spec", "containers")).To(Succeed())
+	})
+
+	It("should not report a drift if the current object only contains additional or normalized fields", func() {
+		Expect(Compare(desired, current)).To(BeEmpty())
+	})
+
+	It("should not report a drift for omitted fields with zero values", func() {
+		Expect(unstructured.SetNestedField(desired.Object, false, "spec", "paused")).To(Succeed())
+		Expect(unstructured.SetNestedField(desired.Object, nil, "metadata", "creationTimestamp")).To(Succeed())
+
+		Expect(Compare(desired, current)).To(BeEmpty())
+	})
+
+	It("should report drifts of modified fields", func() {
+		Expect(unstructured.SetNestedField(current.Object, int64(3), "spec", "replicas")).To(Succeed())
+		Expect(unstructured.SetNestedField(current.Object, "baz", "metadata", "annotations", "foo")).To(Succeed())
+		containers, _, _ := unstructured.NestedSlice(current.Object, "spec", "template", "spec", "containers")
+		containers[0].(map[string]any)["image"] = "foo:v2"
+		Expect(unstructured.SetNestedSlice(current.Object, containers, "spec", "template", "spec", "containers")).To(Succeed())
+
+		Expect(Compare(desired, current)).To(ConsistOf(
+			"metadata.annotations.foo",
+			"spec.replicas",
+			"spec.template.spec.containers[0].image",
+		))
+	})
+
+	It("should report drifts of removed fields and lists with a different length", func() {
+		unstructured.RemoveNestedField(current.Object, "metadata", "labels", "app")
+		Expect(unstructured.SetNestedSlice(current.Object, []any{}, "spec", "template", "spec", "containers")).To(Succeed())
+
+		Expect(Compare(desired, current)).To(ConsistOf(
+			"metadata.labels.app",
+			"spec.template.spec.containers",
+		))
+	})
+
+	It("should ignore replicas and resources if they shall be preserved", func() {
+		desired.SetAnnotations(map[string]string{
+			"resources.gardener.cloud/preserve-replicas":  "true",
+			"resources.gardener.cloud/preserve-resources": "true",
+		})
+		current.SetAnnotations(desired.GetAnnotations())
+		Expect(unstructured.SetNestedField(current.Object, int64(3), "spec", "replicas")).To(Succeed())
+		containers, _, _ := unstructured.NestedSlice(current.Object, "spec", "template", "spec", "containers")
+		containers[0].(map[string]any)["resources"] = map[string]any{"requests": map[string]any{"cpu": "2"}}
+		Expect(unstructured.SetNestedSlice(current.Object, containers, "spec", "template", "spec", "containers")).To(Succeed())
+
+		Expect(Compare(desired, current)).To(BeEmpty())
+	})
+})
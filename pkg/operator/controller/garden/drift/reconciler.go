@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+)
+
+// maxReportedDrifts is the maximum number of drifted objects which are listed in the message of the condition.
+const maxReportedDrifts = 10
+
+// Reconciler periodically compares the desired state of the objects deployed for the garden components with the
+// objects in the runtime and virtual garden cluster, and reports drifts in the Garden status.
+type Reconciler struct {
+	RuntimeClient   client.Client
+	Config          config.OperatorConfiguration
+	Clock           clock.Clock
+	GardenClientMap clientmap.ClientMap
+	GardenNamespace string
+}
+
+type objectDrift struct {
+	managedResource client.ObjectKey
+	object          string
+	fields          []string
+}
+
+func (d objectDrift) String() string {
+	if len(d.fields) == 0 {
+		return fmt.Sprintf("%s (ManagedResource %s) is missing", d.object, d.managedResource)
+	}
+	return fmt.Sprintf("%s (ManagedResource %s) differs in %s", d.object, d.managedResource, strings.Join(d.fields, ", "))
+}
+
+// Reconcile compares the desired state of the objects deployed for the garden components with the current objects.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	reconcileCtx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, r.Config.Controllers.GardenDrift.SyncPeriod.Duration)
+	defer cancel()
+
+	garden := &operatorv1alpha1.Garden{}
+	if err := r.RuntimeClient.Get(reconcileCtx, req.NamespacedName, garden); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if garden.DeletionTimestamp != nil {
+		log.V(1).Info("Garden is being deleted, skipping drift detection")
+		return reconcile.Result{}, nil
+	}
+
+	// While the garden is being reconciled, the desired state of the objects is updated before it is applied by
+	// gardener-resource-manager, hence the detection would report false positives.
+	if garden.Status.LastOperation == nil || garden.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		log.V(1).Info("Garden is being reconciled, skipping drift detection")
+		return reconcile.Result{RequeueAfter: r.Config.Controllers.GardenDrift.SyncPeriod.Duration}, nil
+	}
+
+	ctx, cancel := controllerutils.GetChildReconciliationContext(reconcileCtx, r.Config.Controllers.GardenDrift.SyncPeriod.Duration)
+	defer cancel()
+
+	log.V(1).Info("Starting drift detection")
+
+	drifts, driftedManagedResources, err := r.detectDrifts(ctx, garden)
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, garden.Status.Conditions, operatorv1alpha1.ConfigurationInSync)
+	switch {
+	case err != nil:
+		condition = v1beta1helper.NewConditionOrError(r.Clock, condition, nil, err)
+	case len(drifts) == 0:
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "NoDriftDetected", "All objects deployed for the garden components match their desired state.")
+	default:
+		log.Info("Detected drift of objects deployed for the garden components", "drifts", len(drifts))
+
+		message := driftMessage(drifts)
+		if ptr.Deref(r.Config.Controllers.GardenDrift.AutoRevert, false) {
+			if err := r.revertDrifts(ctx, driftedManagedResources); err != nil {
+				return reconcile.Result{}, err
+			}
+			message += " Triggered reconciliation of the affected ManagedResources to revert the drift."
+		}
+
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "DriftDetected", message)
+	}
+
+	if v1beta1helper.ConditionsNeedUpdate(garden.Status.Conditions, []gardencorev1beta1.Condition{condition}) {
+		log.Info("Updating garden status conditions")
+		patch := client.MergeFrom(garden.DeepCopy())
+		garden.Status.Conditions = v1beta1helper.MergeConditions(garden.Status.Conditions, condition)
+		if err := r.RuntimeClient.Status().Patch(reconcileCtx, garden, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update garden status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.Controllers.GardenDrift.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) detectDrifts(ctx context.Context, garden *operatorv1alpha1.Garden) ([]objectDrift, []resourcesv1alpha1.ManagedResource, error) {
+	log := logf.FromContext(ctx)
+
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.RuntimeClient.List(ctx, managedResourceList, client.InNamespace(r.GardenNamespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed listing ManagedResources in namespace %s: %w", r.GardenNamespace, err)
+	}
+
+	var virtualClient client.Client
+	if gardenClientSet, err := r.GardenClientMap.GetClient(ctx, keys.ForGarden(garden)); err != nil {
+		log.V(1).Info("Could not get garden client, skipping ManagedResources for virtual garden", "error", err)
+	} else {
+		virtualClient = gardenClientSet.Client()
+	}
+
+	var (
+		drifts                  []objectDrift
+		driftedManagedResources []resourcesv1alpha1.ManagedResource
+		errs                    []error
+	)
+
+	for _, managedResource := range managedResourceList.Items {
+		if !shouldCheckManagedResource(&managedResource) {
+			continue
+		}
+
+		var targetClient client.Client
+		switch ptr.Deref(managedResource.Spec.Class, "") {
+		case "":
+			targetClient = virtualClient
+		case v1beta1constants.SeedResourceManagerClass:
+			targetClient = r.RuntimeClient
+		}
+		if targetClient == nil {
+			continue
+		}
+
+		managedResourceDrifts, err := r.detectManagedResourceDrifts(ctx, targetClient, &managedResource)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed detecting drift of ManagedResource %s: %w", client.ObjectKeyFromObject(&managedResource), err))
+			continue
+		}
+
+		if len(managedResourceDrifts) > 0 {
+			drifts = append(drifts, managedResourceDrifts...)
+			driftedManagedResources = append(driftedManagedResources, managedResource)
+		}
+	}
+
+	return drifts, driftedManagedResources, errors.Join(errs...)
+}
+
+// shouldCheckManagedResource returns true if the objects of the given ManagedResource are expected to match their
+// desired state, i.e., the ManagedResource is not ignored and its latest desired state was applied successfully.
+func shouldCheckManagedResource(managedResource *resourcesv1alpha1.ManagedResource) bool {
+	if managedResource.DeletionTimestamp != nil ||
+		managedResource.Annotations[resourcesv1alpha1.Ignore] == "true" ||
+		v1beta1helper.HasOperationAnnotation(managedResource.Annotations) ||
+		managedResource.Status.ObservedGeneration != managedResource.Generation {
+		return false
+	}
+
+	conditionApplied := v1beta1helper.GetCondition(managedResource.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+	return conditionApplied != nil && conditionApplied.Status == gardencorev1beta1.ConditionTrue
+}
+
+func (r *Reconciler) detectManagedResourceDrifts(ctx context.Context, targetClient client.Client, managedResource *resourcesv1alpha1.ManagedResource) ([]objectDrift, error) {
+	desiredObjects, err := r.desiredObjects(ctx, managedResource)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []objectDrift
+	for _, desired := range desiredObjects {
+		if desired.GetAnnotations()[resourcesv1alpha1.Ignore] == "true" || desired.GetAnnotations()[resourcesv1alpha1.Mode] == resourcesv1alpha1.ModeIgnore {
+			continue
+		}
+
+		namespaced, err := targetClient.IsObjectNamespaced(desired)
+		if err != nil {
+			return nil, fmt.Errorf("failed determining scope of %s %s: %w", desired.GetKind(), client.ObjectKeyFromObject(desired), err)
+		}
+		if namespaced && desired.GetNamespace() == "" {
+			desired.SetNamespace(metav1.NamespaceDefault)
+		}
+
+		var (
+			objectName = desired.GetKind() + " " + client.ObjectKeyFromObject(desired).String()
+			current    = &unstructured.Unstructured{}
+		)
+
+		current.SetGroupVersionKind(desired.GroupVersionKind())
+		if err := targetClient.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed reading %s: %w", objectName, err)
+			}
+			drifts = append(drifts, objectDrift{managedResource: client.ObjectKeyFromObject(managedResource), object: objectName})
+			continue
+		}
+
+		if fields := Compare(desired, current); len(fields) > 0 {
+			drifts = append(drifts, objectDrift{managedResource: client.ObjectKeyFromObject(managedResource), object: objectName, fields: fields})
+		}
+	}
+
+	return drifts, nil
+}
+
+func (r *Reconciler) desiredObjects(ctx context.Context, managedResource *resourcesv1alpha1.ManagedResource) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	for _, ref := range managedResource.Spec.SecretRefs {
+		secret := &corev1.Secret{}
+		if err := r.RuntimeClient.Get(ctx, client.ObjectKey{Namespace: managedResource.Namespace, Name: ref.Name}, secret); err != nil {
+			return nil, fmt.Errorf("failed reading secret %q: %w", ref.Name, err)
+		}
+
+		// Sort secret's data keys to keep a consistent ordering of the objects
+		secretKeys := make([]string, 0, len(secret.Data))
+		for key := range secret.Data {
+			secretKeys = append(secretKeys, key)
+		}
+		slices.Sort(secretKeys)
+
+		for _, key := range secretKeys {
+			decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(secret.Data[key]), 1024)
+			for {
+				var decodedObj map[string]any
+				if err := decoder.Decode(&decodedObj); err != nil {
+					if err == io.EOF {
+						break
+					}
+					return nil, fmt.Errorf("failed decoding key %q of secret %q: %w", key, ref.Name, err)
+				}
+
+				if decodedObj != nil {
+					objects = append(objects, &unstructured.Unstructured{Object: decodedObj})
+				}
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+func (r *Reconciler) revertDrifts(ctx context.Context, managedResources []resourcesv1alpha1.ManagedResource) error {
+	log := logf.FromContext(ctx)
+
+	for _, managedResource := range managedResources {
+		log.Info("Triggering reconciliation of ManagedResource to revert drift", "managedResource", client.ObjectKeyFromObject(&managedResource))
+
+		patch := client.MergeFrom(managedResource.DeepCopy())
+		metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		if err := r.RuntimeClient.Patch(ctx, &managedResource, patch); err != nil {
+			return fmt.Errorf("failed triggering reconciliation of ManagedResource %s: %w", client.ObjectKeyFromObject(&managedResource), err)
+		}
+	}
+
+	return nil
+}
+
+func driftMessage(drifts []objectDrift) string {
+	descriptions := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		descriptions = append(descriptions, drift.String())
+	}
+	slices.Sort(descriptions)
+
+	message := "Objects deployed for the garden components deviate from their desired state: " + strings.Join(descriptions[:min(len(descriptions), maxReportedDrifts)], "; ")
+	if len(descriptions) > maxReportedDrifts {
+		message += fmt.Sprintf(" (and %d more)", len(descriptions)-maxReportedDrifts)
+	}
+	return message + "."
+}
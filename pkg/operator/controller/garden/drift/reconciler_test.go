@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
+	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/operator/apis/config"
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	. "github.com/gardener/gardener/pkg/operator/controller/garden/drift"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		gardenName      = "garden"
+		gardenNamespace = "garden"
+		syncPeriod      = 10 * time.Minute
+	)
+
+	var (
+		ctx           context.Context
+		runtimeClient client.Client
+		virtualClient client.Client
+		fakeClock     *testclock.FakeClock
+		reconciler    *Reconciler
+		req           reconcile.Request
+
+		garden          *operatorv1alpha1.Garden
+		managedResource *resourcesv1alpha1.ManagedResource
+		secret          *corev1.Secret
+		configMap       *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+		restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.RuntimeScheme).WithRESTMapper(restMapper).WithStatusSubresource(&operatorv1alpha1.Garden{}).Build()
+		virtualClient = fakeclient.NewClientBuilder().WithScheme(operatorclient.VirtualScheme).WithRESTMapper(restMapper).Build()
+
+		garden = &operatorv1alpha1.Garden{
+			ObjectMeta: metav1.ObjectMeta{Name: gardenName},
+			Status: operatorv1alpha1.GardenStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "managedresource-foo", Namespace: gardenNamespace},
+			Data: map[string][]byte{"configmap.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: garden
+  labels:
+    app: foo
+data:
+  foo: bar
+`)},
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: gardenNamespace, Generation: 1},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class:      ptr.To(v1beta1constants.SeedResourceManagerClass),
+				SecretRefs: []corev1.LocalObjectReference{{Name: secret.Name}},
+			},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				ObservedGeneration: 1,
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "foo",
+				Namespace:   gardenNamespace,
+				Labels:      map[string]string{"app": "foo", "resources.gardener.cloud/managed-by": "gardener"},
+				Annotations: map[string]string{"resources.gardener.cloud/origin": "garden/foo"},
+			},
+			Data: map[string]string{"foo": "bar"},
+		}
+
+		reconciler = &Reconciler{
+			RuntimeClient: runtimeClient,
+			Config: config.OperatorConfiguration{
+				Controllers: config.ControllerConfiguration{
+					GardenDrift: config.GardenDriftControllerConfiguration{
+						SyncPeriod: &metav1.Duration{Duration: syncPeriod},
+						AutoRevert: ptr.To(false),
+					},
+				},
+			},
+			Clock:           fakeClock,
+			GardenClientMap: fakeclientmap.NewClientMapBuilder().WithRuntimeClientForKey(keys.ForGarden(garden), virtualClient).Build(),
+			GardenNamespace: gardenNamespace,
+		}
+
+		req = reconcile.Request{NamespacedName: client.ObjectKey{Name: gardenName}}
+	})
+
+	JustBeforeEach(func() {
+		Expect(runtimeClient.Create(ctx, garden)).To(Succeed())
+		Expect(runtimeClient.Create(ctx, secret)).To(Succeed())
+		Expect(runtimeClient.Create(ctx, managedResource)).To(Succeed())
+	})
+
+	getCondition := func() *gardencorev1beta1.Condition {
+		Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(garden), garden)).To(Succeed())
+		for _, condition := range garden.Status.Conditions {
+			if condition.Type == operatorv1alpha1.ConfigurationInSync {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	It("should do nothing if the garden is gone", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "other"}})).To(Equal(reconcile.Result{}))
+	})
+
+	Context("garden is being reconciled", func() {
+		BeforeEach(func() {
+			garden.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		})
+
+		It("should skip the drift detection", func() {
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(BeNil())
+		})
+	})
+
+	Context("objects of the runtime cluster", func() {
+		It("should report that no drift was detected", func() {
+			Expect(runtimeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1beta1.ConditionTrue),
+				"Reason": Equal("NoDriftDetected"),
+			})))
+		})
+
+		It("should report modified objects", func() {
+			configMap.Data["foo"] = "baz"
+			delete(configMap.Labels, "app")
+			Expect(runtimeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Reason":  Equal("DriftDetected"),
+				"Message": Equal("Objects deployed for the garden components deviate from their desired state: ConfigMap garden/foo (ManagedResource garden/foo) differs in data.foo, metadata.labels.app."),
+			})))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		})
+
+		It("should report missing objects", func() {
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Reason":  Equal("DriftDetected"),
+				"Message": Equal("Objects deployed for the garden components deviate from their desired state: ConfigMap garden/foo (ManagedResource garden/foo) is missing."),
+			})))
+		})
+
+		It("should trigger the reconciliation of the ManagedResource if auto-revert is enabled", func() {
+			reconciler.Config.Controllers.GardenDrift.AutoRevert = ptr.To(true)
+			configMap.Data["foo"] = "baz"
+			Expect(runtimeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Reason":  Equal("DriftDetected"),
+				"Message": HaveSuffix("Triggered reconciliation of the affected ManagedResources to revert the drift."),
+			})))
+
+			Expect(runtimeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+
+		Context("ManagedResource was not applied yet", func() {
+			BeforeEach(func() {
+				managedResource.Status.ObservedGeneration = 0
+			})
+
+			It("should not check the objects of the ManagedResource", func() {
+				Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+				Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(gardencorev1beta1.ConditionTrue),
+				})))
+			})
+		})
+	})
+
+	Context("objects of the virtual garden cluster", func() {
+		BeforeEach(func() {
+			managedResource.Spec.Class = nil
+		})
+
+		It("should compare the objects in the virtual garden cluster", func() {
+			Expect(runtimeClient.Create(ctx, configMap.DeepCopy())).To(Succeed())
+			configMap.Data["foo"] = "baz"
+			Expect(virtualClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(gardencorev1beta1.ConditionFalse),
+				"Message": ContainSubstring("differs in data.foo"),
+			})))
+		})
+	})
+})
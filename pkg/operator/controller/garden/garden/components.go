@@ -220,7 +220,7 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
-	c.virtualSystem = r.newVirtualSystem(enableSeedAuthorizer)
+	c.virtualSystem = r.newVirtualSystem(garden, enableSeedAuthorizer)
 	c.virtualGardenGardenerAccess = r.newGardenerAccess(garden, secretsManager)
 
 	// gardener control plane components
@@ -367,6 +367,7 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		nil,
 		nil,
 		r.RuntimeVersion,
+		imagevector.ImageVector(),
 		r.Config.LogLevel, r.Config.LogFormat,
 		namePrefix,
 		false,
@@ -612,6 +613,7 @@ func (r *Reconciler) newKubeAPIServer(
 		metav1.ObjectMeta{Namespace: r.GardenNamespace, Name: garden.Name},
 		r.RuntimeVersion,
 		targetVersion,
+		imagevector.ImageVector(),
 		secretsManager,
 		namePrefix,
 		apiServerConfig,
@@ -625,6 +627,7 @@ func (r *Reconciler) newKubeAPIServer(
 		authenticationWebhookConfig,
 		authorizationWebhookConfig,
 		resourcesToStoreInETCDEvents,
+		false,
 	)
 }
 
@@ -730,6 +733,7 @@ func (r *Reconciler) newKubeControllerManager(
 		r.GardenNamespace,
 		r.RuntimeVersion,
 		targetVersion,
+		imagevector.ImageVector(),
 		secretsManager,
 		namePrefix,
 		config,
@@ -928,8 +932,14 @@ func getLoadBalancerServiceAnnotations(garden *operatorv1alpha1.Garden) map[stri
 	return nil
 }
 
-func (r *Reconciler) newVirtualSystem(enableSeedAuthorizer bool) component.DeployWaiter {
-	return virtualgardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace, virtualgardensystem.Values{SeedAuthorizerEnabled: enableSeedAuthorizer})
+func (r *Reconciler) newVirtualSystem(garden *operatorv1alpha1.Garden, enableSeedAuthorizer bool) component.DeployWaiter {
+	values := virtualgardensystem.Values{SeedAuthorizerEnabled: enableSeedAuthorizer}
+
+	if kubeAPIServer := garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer; kubeAPIServer != nil {
+		values.PriorityAndFairness = kubeAPIServer.PriorityAndFairness
+	}
+
+	return virtualgardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace, values)
 }
 
 func (r *Reconciler) newGardenerAPIServer(ctx context.Context, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (gardenerapiserver.Interface, error) {
@@ -1013,6 +1023,13 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 				ProjectSelector: defaultProjectQuota.ProjectSelector,
 			})
 		}
+
+		for _, defaultProjectLimitRange := range config.DefaultProjectLimitRanges {
+			values.LimitRanges = append(values.LimitRanges, controllermanagerv1alpha1.LimitRangeConfiguration{
+				Config:          defaultProjectLimitRange.Config,
+				ProjectSelector: defaultProjectLimitRange.ProjectSelector,
+			})
+		}
 	}
 
 	return gardenercontrollermanager.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
@@ -1158,6 +1175,7 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 		v1beta1constants.PriorityClassNameGardenSystem100,
 		nil,
 		"",
+		nil,
 	)
 }
 
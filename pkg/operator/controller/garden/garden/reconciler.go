@@ -90,8 +90,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.GardenNamespace,
 		operatorv1alpha1.SecretManagerIdentityOperator,
 		secretsmanager.Config{
-			CASecretAutoRotation: true,
-			SecretNamesToTimes:   lastSecretRotationStartTimes(garden),
+			CASecretAutoRotation:  true,
+			SecretNamesToTimes:    lastSecretRotationStartTimes(garden),
+			RecordRotationHistory: true,
 		},
 	)
 	if err != nil {
@@ -111,7 +112,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return result, nil
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.Controllers.Garden.SyncPeriod.Duration}, r.updateStatusOperationSuccess(ctx, garden, operationType)
+	if err := r.updateStatusOperationSuccess(ctx, garden, operationType); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if helper.IsETCDEncryptionKeyRotationAutomated(garden) && helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) == gardencorev1beta1.RotationPrepared {
+		log.Info("Requeuing to complete automated ETCD encryption key rotation")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.Controllers.Garden.SyncPeriod.Duration}, nil
 }
 
 func (r *Reconciler) ensureAtMostOneGardenExists(ctx context.Context) error {
@@ -211,6 +221,20 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, garden *ope
 		startRotationObservability(garden, &now)
 	}
 
+	if operationType == gardencorev1beta1.LastOperationTypeReconcile {
+		switch phase := helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials); {
+		case helper.IsETCDEncryptionKeyRotationTriggered(garden) && (phase == "" || phase == gardencorev1beta1.RotationCompleted):
+			startRotationETCDEncryptionKey(garden, &now)
+			helper.MutateETCDEncryptionKeyRotationAutomation(garden, func(automation *operatorv1alpha1.ETCDEncryptionKeyRotationAutomation) {
+				automation.TriggerID = helper.GetETCDEncryptionKeyRotationTriggerID(garden)
+				automation.LastVerificationTime = nil
+			})
+		case helper.IsETCDEncryptionKeyRotationAutomated(garden) && phase == gardencorev1beta1.RotationPrepared &&
+			garden.Status.Credentials.Rotation.ETCDEncryptionKeyAutomation.LastVerificationTime != nil:
+			completeRotationETCDEncryptionKey(garden, &now)
+		}
+	}
+
 	if err := r.RuntimeClientSet.Client().Status().Update(ctx, garden); err != nil {
 		return err
 	}
@@ -386,6 +386,14 @@ func (r *Reconciler) reconcile(
 				apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources),
 			Dependencies: flow.NewTaskIDs(initializeVirtualClusterClient, waitUntilGardenerAPIServerReady),
 		})
+		verifyResourcesRewritten = g.Add(flow.Task{
+			Name: "Verifying that all encrypted resources were re-encrypted with new ETCD encryption key",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return r.verifyEncryptedDataRewritten(ctx, garden, virtualClusterClientSet, secretsManager, resourcesToEncrypt, encryptedResources, defaultEncryptedGVKs)
+			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
+			SkipIf:       helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			Dependencies: flow.NewTaskIDs(rewriteResourcesAddLabel),
+		})
 		snapshotETCD = g.Add(flow.Task{
 			Name: "Snapshotting ETCD after modification of encryption config or resources are re-encrypted with new ETCD encryption key",
 			Fn: func(ctx context.Context) error {
@@ -394,7 +402,7 @@ func (r *Reconciler) reconcile(
 			SkipIf: !allowBackup ||
 				(helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing &&
 					apiequality.Semantic.DeepEqual(resourcesToEncrypt, encryptedResources)),
-			Dependencies: flow.NewTaskIDs(rewriteResourcesAddLabel),
+			Dependencies: flow.NewTaskIDs(rewriteResourcesAddLabel, verifyResourcesRewritten),
 		})
 		_ = g.Add(flow.Task{
 			Name: "Removing label from re-encrypted resources after modification of encryption config or rotation of ETCD encryption key",
@@ -462,7 +470,7 @@ func (r *Reconciler) reconcile(
 		deployPrometheusGarden = g.Add(flow.Task{
 			Name: "Deploying Garden Prometheus",
 			Fn: func(ctx context.Context) error {
-				return r.deployGardenPrometheus(ctx, log, secretsManager, c.prometheusGarden, virtualClusterClient)
+				return r.deployGardenPrometheus(ctx, log, garden, secretsManager, c.prometheusGarden, virtualClusterClient)
 			},
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, deployPrometheusCRD, waitUntilGardenerAPIServerReady, initializeVirtualClusterClient),
 		})
@@ -569,6 +577,7 @@ func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, ku
 	return func(ctx context.Context) error {
 		var (
 			serviceAccountConfig *gardencorev1beta1.ServiceAccountConfig
+			additionalSANs       *gardencorev1beta1.KubeAPIServerAdditionalSANs
 			sniConfig            = kubeapiserver.SNIConfig{Enabled: false}
 		)
 
@@ -577,6 +586,10 @@ func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, ku
 				serviceAccountConfig = apiServer.KubeAPIServerConfig.ServiceAccountConfig
 			}
 
+			if apiServer.KubeAPIServerConfig != nil {
+				additionalSANs = apiServer.KubeAPIServerConfig.AdditionalSANs
+			}
+
 			if apiServer.SNI != nil {
 				sniConfig.TLS = append(sniConfig.TLS, kubeapiserver.TLSSNIConfig{
 					SecretName:     &apiServer.SNI.SecretName,
@@ -596,9 +609,7 @@ func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, ku
 				externalHostname,
 				helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials),
 			),
-			kubeapiserver.ServerCertificateConfig{
-				ExtraDNSNames: getAPIServerDomains(garden.Spec.VirtualCluster.DNS.Domains),
-			},
+			kubeapiserver.ComputeKubeAPIServerServerCertificateConfig(nil, getAPIServerDomains(garden.Spec.VirtualCluster.DNS.Domains), additionalSANs),
 			sniConfig,
 			externalHostname,
 			externalHostname,
@@ -611,6 +622,39 @@ func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, ku
 	}
 }
 
+func (r *Reconciler) verifyEncryptedDataRewritten(
+	ctx context.Context,
+	garden *operatorv1alpha1.Garden,
+	virtualClusterClientSet kubernetes.Interface,
+	secretsManager secretsmanager.Interface,
+	resourcesToEncrypt []string,
+	encryptedResources []string,
+	defaultEncryptedGVKs []schema.GroupVersionKind,
+) error {
+	var rotationStartTime time.Time
+	if rotation := garden.Status.Credentials.Rotation.ETCDEncryptionKey; rotation.LastInitiationTime != nil {
+		rotationStartTime = rotation.LastInitiationTime.Time
+	}
+
+	if err := secretsrotation.VerifyEncryptedDataRewritten(ctx, virtualClusterClientSet, secretsManager, resourcesToEncrypt, encryptedResources, defaultEncryptedGVKs, rotationStartTime); err != nil {
+		return err
+	}
+
+	if !helper.IsETCDEncryptionKeyRotationAutomated(garden) {
+		return nil
+	}
+
+	patch := client.MergeFrom(garden.DeepCopy())
+	helper.MutateETCDEncryptionKeyRotationAutomation(garden, func(automation *operatorv1alpha1.ETCDEncryptionKeyRotationAutomation) {
+		automation.LastVerificationTime = &metav1.Time{Time: r.Clock.Now().UTC()}
+	})
+	if err := r.RuntimeClientSet.Client().Status().Patch(ctx, garden, patch); err != nil {
+		return fmt.Errorf("error patching Garden status after verifying re-encryption of resources: %w", err)
+	}
+
+	return nil
+}
+
 func (r *Reconciler) snapshotETCDFunc(secretsManager secretsmanager.Interface, etcdMain etcd.Interface) func(context.Context) error {
 	return func(ctx context.Context) error {
 		return shared.SnapshotEtcd(ctx, secretsManager, etcdMain)
@@ -647,7 +691,7 @@ func (r *Reconciler) deployGardenerAPIServerFunc(garden *operatorv1alpha1.Garden
 	}
 }
 
-func (r *Reconciler) deployGardenPrometheus(ctx context.Context, log logr.Logger, secretsManager secretsmanager.Interface, prometheus prometheus.Interface, virtualGardenClient client.Client) error {
+func (r *Reconciler) deployGardenPrometheus(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface, prometheus prometheus.Interface, virtualGardenClient client.Client) error {
 	if err := gardenerutils.NewShootAccessSecret(gardenprometheus.AccessSecretName, r.GardenNamespace).Reconcile(ctx, r.RuntimeClientSet.Client()); err != nil {
 		return fmt.Errorf("failed reconciling access secret for garden prometheus: %w", err)
 	}
@@ -680,20 +724,38 @@ func (r *Reconciler) deployGardenPrometheus(ctx context.Context, log logr.Logger
 		}
 	}
 
+	var (
+		seedSelector       = labels.NewSelector()
+		additionalMatchers []string
+	)
+
+	if metricsFederation := helper.GetMetricsFederation(garden); metricsFederation != nil {
+		if metricsFederation.SeedSelector != nil {
+			seedSelector, err = metav1.LabelSelectorAsSelector(metricsFederation.SeedSelector)
+			if err != nil {
+				return fmt.Errorf("failed parsing seed selector for metrics federation: %w", err)
+			}
+		}
+		additionalMatchers = metricsFederation.AdditionalMatchers
+	}
+
 	// fetch ingress urls of reachable seeds for prometheus-aggregate scrape config
 	seedList := &gardencorev1beta1.SeedList{}
-	if err := virtualGardenClient.List(ctx, seedList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(utils.MustNewRequirement(v1beta1constants.LabelSeedNetwork, selection.NotEquals, v1beta1constants.LabelSeedNetworkPrivate))}); err != nil {
-		return fmt.Errorf("failed listing secrets in virtual garden: %w", err)
+	if err := virtualGardenClient.List(ctx, seedList, client.MatchingLabelsSelector{Selector: seedSelector.Add(utils.MustNewRequirement(v1beta1constants.LabelSeedNetwork, selection.NotEquals, v1beta1constants.LabelSeedNetworkPrivate))}); err != nil {
+		return fmt.Errorf("failed listing seeds in virtual garden: %w", err)
 	}
 
-	var prometheusAggregateTargets []monitoringv1alpha1.Target
+	var prometheusAggregateTargets []gardenprometheus.AggregateTarget
 	for _, seed := range seedList.Items {
 		if seed.Spec.Ingress != nil {
-			prometheusAggregateTargets = append(prometheusAggregateTargets, monitoringv1alpha1.Target(v1beta1constants.IngressDomainPrefixPrometheusAggregate+"."+seed.Spec.Ingress.Domain))
+			prometheusAggregateTargets = append(prometheusAggregateTargets, gardenprometheus.AggregateTarget{
+				SeedName: seed.Name,
+				Address:  monitoringv1alpha1.Target(v1beta1constants.IngressDomainPrefixPrometheusAggregate + "." + seed.Spec.Ingress.Domain),
+			})
 		}
 	}
 
-	prometheus.SetCentralScrapeConfigs(gardenprometheus.CentralScrapeConfigs(prometheusAggregateTargets, globalMonitoringSecretRuntime))
+	prometheus.SetCentralScrapeConfigs(gardenprometheus.CentralScrapeConfigs(prometheusAggregateTargets, globalMonitoringSecretRuntime, additionalMatchers))
 	return prometheus.Deploy(ctx)
 }
 
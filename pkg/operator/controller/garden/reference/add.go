@@ -17,13 +17,15 @@ import (
 // AddToManager adds the garden-reference controller to the given manager.
 func AddToManager(mgr manager.Manager, gardenNamespace string) error {
 	return (&reference.Reconciler{
-		ConcurrentSyncs:             ptr.To(1),
-		NewObjectFunc:               func() client.Object { return &operatorv1alpha1.Garden{} },
-		NewObjectListFunc:           func() client.ObjectList { return &operatorv1alpha1.GardenList{} },
-		GetNamespace:                func(client.Object) string { return gardenNamespace },
-		GetReferencedSecretNames:    getReferencedSecretNames,
-		GetReferencedConfigMapNames: getReferencedConfigMapNames,
-		ReferenceChangedPredicate:   Predicate,
+		ConcurrentSyncs:   ptr.To(1),
+		NewObjectFunc:     func() client.Object { return &operatorv1alpha1.Garden{} },
+		NewObjectListFunc: func() client.ObjectList { return &operatorv1alpha1.GardenList{} },
+		GetNamespace:      func(client.Object) string { return gardenNamespace },
+		ReferencedKinds: []reference.ReferencedKind{
+			reference.SecretKind(getReferencedSecretNames),
+			reference.ConfigMapKind(getReferencedConfigMapNames),
+		},
+		ReferenceChangedPredicate: Predicate,
 	}).AddToManager(mgr)
 }
 
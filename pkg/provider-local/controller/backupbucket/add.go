@@ -11,7 +11,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/faultinjection"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
 
@@ -37,7 +39,7 @@ type AddOptions struct {
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts backupoptions.AddOptions) error {
 	return backupbucket.Add(ctx, mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(mgr, opts.BackupBucketPath),
+		Actuator:          faultinjection.BackupBucketActuator(newActuator(mgr, opts.BackupBucketPath), faultinjection.NewInjector(extensionsv1alpha1.BackupBucketResource, opts.FaultInjection)),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              local.Type,
@@ -11,7 +11,9 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/faultinjection"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
 
@@ -25,7 +27,7 @@ var DefaultAddOptions = backupoptions.AddOptions{}
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts backupoptions.AddOptions) error {
 	return backupentry.Add(ctx, mgr, backupentry.AddArgs{
-		Actuator:          genericactuator.NewActuator(mgr, newActuator(mgr, opts.ContainerMountPath, opts.BackupBucketPath)),
+		Actuator:          faultinjection.BackupEntryActuator(genericactuator.NewActuator(mgr, newActuator(mgr, opts.ContainerMountPath, opts.BackupBucketPath)), faultinjection.NewInjector(extensionsv1alpha1.BackupEntryResource, opts.FaultInjection)),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupentry.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              local.Type,
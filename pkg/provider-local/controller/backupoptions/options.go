@@ -7,6 +7,8 @@ package backupoptions
 import (
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/gardener/gardener/pkg/provider-local/faultinjection"
 )
 
 const (
@@ -36,6 +38,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// FaultInjection configures the faults which are injected into the operations of the actuator.
+	FaultInjection *faultinjection.Config
 }
 
 // AddFlags implements Flagger.AddFlags.
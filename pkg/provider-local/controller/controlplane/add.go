@@ -15,6 +15,8 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/provider-local/faultinjection"
 	"github.com/gardener/gardener/pkg/provider-local/imagevector"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
@@ -32,6 +34,8 @@ type AddOptions struct {
 	ShootWebhookConfig *atomic.Value
 	// WebhookServerNamespace is the namespace in which the webhook server runs.
 	WebhookServerNamespace string
+	// FaultInjection configures the faults which are injected into the operations of the actuator.
+	FaultInjection *faultinjection.Config
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -46,7 +50,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return controlplane.Add(ctx, mgr, controlplane.AddArgs{
-		Actuator:          genericActuator,
+		Actuator:          faultinjection.ControlPlaneActuator(genericActuator, faultinjection.NewInjector(extensionsv1alpha1.ControlPlaneResource, opts.FaultInjection)),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              local.Type,
@@ -12,6 +12,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/provider-local/faultinjection"
 )
 
 const (
@@ -32,13 +34,15 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// FaultInjection configures the faults which are injected into the operations of the actuator.
+	FaultInjection *faultinjection.Config
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
 	return extension.Add(ctx, mgr, extension.AddArgs{
-		Actuator:          NewActuator(mgr),
+		Actuator:          faultinjection.ExtensionActuator(NewActuator(mgr), faultinjection.NewInjector(extensionsv1alpha1.ExtensionResource, opts.FaultInjection)),
 		ControllerOptions: opts.Controller,
 		Name:              applicationName,
 		FinalizerSuffix:   Type,
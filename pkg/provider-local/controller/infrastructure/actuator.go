@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -94,7 +95,13 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, infrastructure
 		}
 	}
 
-	return nil
+	// Machine pods never get public IPs, hence egress-only internet access can always be honored.
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.EgressOnlyInternetAccess = nil
+	if infrastructure.Spec.EgressOnlyInternetAccess {
+		infrastructure.Status.EgressOnlyInternetAccess = ptr.To(true)
+	}
+	return a.client.Status().Patch(ctx, infrastructure, patch)
 }
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, _ *extensionscontroller.Cluster) error {
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
+	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	operationReconcile = "reconcile"
+	operationDelete    = "delete"
+)
+
+// BackupBucketActuator returns a backupbucket.Actuator which injects faults into the Reconcile and Delete operations of
+// the given actuator. It returns the given actuator if the injector is nil.
+func BackupBucketActuator(actuator backupbucket.Actuator, injector *Injector) backupbucket.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &backupBucketActuator{Actuator: actuator, injector: injector}
+}
+
+type backupBucketActuator struct {
+	backupbucket.Actuator
+	injector *Injector
+}
+
+func (a *backupBucketActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.BackupBucket) error {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj)
+}
+
+func (a *backupBucketActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.BackupBucket) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj)
+}
+
+// BackupEntryActuator returns a backupentry.Actuator which injects faults into the Reconcile and Delete operations of
+// the given actuator. It returns the given actuator if the injector is nil.
+func BackupEntryActuator(actuator backupentry.Actuator, injector *Injector) backupentry.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &backupEntryActuator{Actuator: actuator, injector: injector}
+}
+
+type backupEntryActuator struct {
+	backupentry.Actuator
+	injector *Injector
+}
+
+func (a *backupEntryActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.BackupEntry) error {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj)
+}
+
+func (a *backupEntryActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.BackupEntry) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj)
+}
+
+// ControlPlaneActuator returns a controlplane.Actuator which injects faults into the Reconcile and Delete operations of
+// the given actuator. It returns the given actuator if the injector is nil.
+func ControlPlaneActuator(actuator controlplane.Actuator, injector *Injector) controlplane.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &controlPlaneActuator{Actuator: actuator, injector: injector}
+}
+
+type controlPlaneActuator struct {
+	controlplane.Actuator
+	injector *Injector
+}
+
+func (a *controlPlaneActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return false, err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj, cluster)
+}
+
+func (a *controlPlaneActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj, cluster)
+}
+
+// DNSRecordActuator returns a dnsrecord.Actuator which injects faults into the Reconcile and Delete operations of the
+// given actuator. It returns the given actuator if the injector is nil.
+func DNSRecordActuator(actuator dnsrecord.Actuator, injector *Injector) dnsrecord.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &dnsRecordActuator{Actuator: actuator, injector: injector}
+}
+
+type dnsRecordActuator struct {
+	dnsrecord.Actuator
+	injector *Injector
+}
+
+func (a *dnsRecordActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj, cluster)
+}
+
+func (a *dnsRecordActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj, cluster)
+}
+
+// ExtensionActuator returns an extension.Actuator which injects faults into the Reconcile and Delete operations of the
+// given actuator. It returns the given actuator if the injector is nil.
+func ExtensionActuator(actuator extension.Actuator, injector *Injector) extension.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &extensionActuator{Actuator: actuator, injector: injector}
+}
+
+type extensionActuator struct {
+	extension.Actuator
+	injector *Injector
+}
+
+func (a *extensionActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.Extension) error {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj)
+}
+
+func (a *extensionActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.Extension) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj)
+}
+
+// InfrastructureActuator returns an infrastructure.Actuator which injects faults into the Reconcile and Delete
+// operations of the given actuator. It returns the given actuator if the injector is nil.
+func InfrastructureActuator(actuator infrastructure.Actuator, injector *Injector) infrastructure.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &infrastructureActuator{Actuator: actuator, injector: injector}
+}
+
+type infrastructureActuator struct {
+	infrastructure.Actuator
+	injector *Injector
+}
+
+func (a *infrastructureActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj, cluster)
+}
+
+func (a *infrastructureActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj, cluster)
+}
+
+// OperatingSystemConfigActuator returns an operatingsystemconfig.Actuator which injects faults into the Reconcile and
+// Delete operations of the given actuator. It returns the given actuator if the injector is nil.
+func OperatingSystemConfigActuator(actuator operatingsystemconfig.Actuator, injector *Injector) operatingsystemconfig.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &operatingSystemConfigActuator{Actuator: actuator, injector: injector}
+}
+
+type operatingSystemConfigActuator struct {
+	operatingsystemconfig.Actuator
+	injector *Injector
+}
+
+func (a *operatingSystemConfigActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return nil, nil, nil, err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj)
+}
+
+func (a *operatingSystemConfigActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.OperatingSystemConfig) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj)
+}
+
+// WorkerActuator returns a worker.Actuator which injects faults into the Reconcile and Delete operations of the given
+// actuator. It returns the given actuator if the injector is nil.
+func WorkerActuator(actuator worker.Actuator, injector *Injector) worker.Actuator {
+	if injector == nil {
+		return actuator
+	}
+	return &workerActuator{Actuator: actuator, injector: injector}
+}
+
+type workerActuator struct {
+	worker.Actuator
+	injector *Injector
+}
+
+func (a *workerActuator) Reconcile(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationReconcile); err != nil {
+		return err
+	}
+	return a.Actuator.Reconcile(ctx, log, obj, cluster)
+}
+
+func (a *workerActuator) Delete(ctx context.Context, log logr.Logger, obj *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	if err := a.injector.Inject(ctx, log, operationDelete); err != nil {
+		return err
+	}
+	return a.Actuator.Delete(ctx, log, obj, cluster)
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	mockworker "github.com/gardener/gardener/extensions/pkg/controller/worker/mock"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/provider-local/faultinjection"
+)
+
+var _ = Describe("Actuators", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		ctrl     *gomock.Controller
+		actuator *mockworker.MockActuator
+
+		worker  *extensionsv1alpha1.Worker
+		cluster *extensionscontroller.Cluster
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		actuator = mockworker.NewMockActuator(ctrl)
+
+		worker = &extensionsv1alpha1.Worker{}
+		cluster = &extensionscontroller.Cluster{}
+	})
+
+	Describe("#WorkerActuator", func() {
+		It("should return the given actuator if the injector is nil", func() {
+			Expect(WorkerActuator(actuator, nil)).To(BeIdenticalTo(actuator))
+		})
+
+		It("should inject errors into the reconcile and delete operations", func() {
+			wrapped := WorkerActuator(actuator, NewInjector("Worker", &Config{ErrorProbability: 1}))
+
+			Expect(wrapped.Reconcile(ctx, log, worker, cluster)).To(MatchError("injected fault for reconcile operation of Worker"))
+			Expect(wrapped.Delete(ctx, log, worker, cluster)).To(MatchError("injected fault for delete operation of Worker"))
+		})
+
+		It("should call the actuator if no error is injected", func() {
+			wrapped := WorkerActuator(actuator, NewInjector("Worker", &Config{DelayProbability: 1, Delay: time.Nanosecond}))
+
+			actuator.EXPECT().Reconcile(ctx, log, worker, cluster)
+			actuator.EXPECT().Delete(ctx, log, worker, cluster)
+
+			Expect(wrapped.Reconcile(ctx, log, worker, cluster)).To(Succeed())
+			Expect(wrapped.Delete(ctx, log, worker, cluster)).To(Succeed())
+		})
+
+		It("should not inject faults into other operations", func() {
+			wrapped := WorkerActuator(actuator, NewInjector("Worker", &Config{ErrorProbability: 1}))
+
+			actuator.EXPECT().Migrate(ctx, log, worker, cluster)
+			actuator.EXPECT().Restore(ctx, log, worker, cluster)
+
+			Expect(wrapped.Migrate(ctx, log, worker, cluster)).To(Succeed())
+			Expect(wrapped.Restore(ctx, log, worker, cluster)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// Config configures the faults which are injected into the operations of the actuator of an extension kind.
+type Config struct {
+	// ErrorProbability is the probability (between 0 and 1) that an operation fails with an injected error.
+	ErrorProbability float64
+	// ErrorCode is the optional error code of the injected errors. It can be used to verify the error classification of
+	// gardenlet, e.g., whether errors with this code are retried.
+	ErrorCode gardencorev1beta1.ErrorCode
+	// DelayProbability is the probability (between 0 and 1) that an operation is delayed.
+	DelayProbability float64
+	// Delay is the duration by which an operation is delayed.
+	Delay time.Duration
+}
+
+// Injector injects faults into the operations of the actuator of an extension kind. A nil Injector does not inject any
+// faults.
+type Injector struct {
+	kind   string
+	config Config
+	clock  clock.Clock
+}
+
+// NewInjector creates a new Injector for the given extension kind. It returns nil if the given config is nil or does not
+// inject any faults.
+func NewInjector(kind string, config *Config) *Injector {
+	return newInjector(kind, config, clock.RealClock{})
+}
+
+func newInjector(kind string, config *Config, clock clock.Clock) *Injector {
+	if config == nil || (config.ErrorProbability <= 0 && (config.DelayProbability <= 0 || config.Delay <= 0)) {
+		return nil
+	}
+
+	return &Injector{
+		kind:   kind,
+		config: *config,
+		clock:  clock,
+	}
+}
+
+// Inject delays the given operation and/or returns an error according to the configured probabilities.
+func (i *Injector) Inject(ctx context.Context, log logr.Logger, operation string) error {
+	if i == nil {
+		return nil
+	}
+
+	if i.config.Delay > 0 && rand.Float64() < i.config.DelayProbability {
+		log.Info("Injecting delay", "kind", i.kind, "operation", operation, "delay", i.config.Delay)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-i.clock.After(i.config.Delay):
+		}
+	}
+
+	if rand.Float64() < i.config.ErrorProbability {
+		log.Info("Injecting error", "kind", i.kind, "operation", operation, "errorCode", i.config.ErrorCode)
+
+		err := fmt.Errorf("injected fault for %s operation of %s", operation, i.kind)
+		if i.config.ErrorCode != "" {
+			return v1beta1helper.NewErrorWithCodes(err, i.config.ErrorCode)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+var _ = Describe("Injector", func() {
+	var (
+		ctx       = context.Background()
+		log       = logr.Discard()
+		fakeClock *testclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+	})
+
+	Describe("#NewInjector", func() {
+		It("should return nil if no config is given", func() {
+			Expect(NewInjector("Infrastructure", nil)).To(BeNil())
+		})
+
+		It("should return nil if the config does not inject any faults", func() {
+			Expect(NewInjector("Infrastructure", &Config{DelayProbability: 1})).To(BeNil())
+			Expect(NewInjector("Infrastructure", &Config{Delay: time.Second})).To(BeNil())
+		})
+
+		It("should return an injector if the config injects faults", func() {
+			Expect(NewInjector("Infrastructure", &Config{ErrorProbability: 0.1})).NotTo(BeNil())
+			Expect(NewInjector("Infrastructure", &Config{DelayProbability: 0.1, Delay: time.Second})).NotTo(BeNil())
+		})
+	})
+
+	Describe("#Inject", func() {
+		It("should not inject any faults if the injector is nil", func() {
+			var injector *Injector
+			Expect(injector.Inject(ctx, log, "reconcile")).To(Succeed())
+		})
+
+		It("should inject an error without code", func() {
+			injector := newInjector("Infrastructure", &Config{ErrorProbability: 1}, fakeClock)
+
+			err := injector.Inject(ctx, log, "reconcile")
+			Expect(err).To(MatchError("injected fault for reconcile operation of Infrastructure"))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(BeEmpty())
+		})
+
+		It("should inject an error with the configured code", func() {
+			injector := newInjector("Worker", &Config{ErrorProbability: 1, ErrorCode: gardencorev1beta1.ErrorInfraRateLimitsExceeded}, fakeClock)
+
+			err := injector.Inject(ctx, log, "delete")
+			Expect(err).To(MatchError("injected fault for delete operation of Worker"))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraRateLimitsExceeded))
+		})
+
+		It("should delay the operation", func() {
+			injector := newInjector("Worker", &Config{DelayProbability: 1, Delay: time.Minute}, fakeClock)
+
+			done := make(chan error)
+			go func() {
+				done <- injector.Inject(ctx, log, "reconcile")
+			}()
+
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			Consistently(done).ShouldNot(Receive())
+
+			fakeClock.Step(time.Minute)
+			Eventually(done).Should(Receive(BeNil()))
+		})
+
+		It("should stop delaying the operation when the context is cancelled", func() {
+			injector := newInjector("Worker", &Config{DelayProbability: 1, Delay: time.Minute}, fakeClock)
+
+			cancelCtx, cancel := context.WithCancel(ctx)
+			done := make(chan error)
+			go func() {
+				done <- injector.Inject(cancelCtx, log, "reconcile")
+			}()
+
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+			cancel()
+			Eventually(done).Should(Receive(MatchError(context.Canceled)))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// SupportedKinds are the extension kinds into whose operations faults can be injected.
+var SupportedKinds = sets.New(
+	extensionsv1alpha1.BackupBucketResource,
+	extensionsv1alpha1.BackupEntryResource,
+	extensionsv1alpha1.ControlPlaneResource,
+	extensionsv1alpha1.DNSRecordResource,
+	extensionsv1alpha1.ExtensionResource,
+	extensionsv1alpha1.InfrastructureResource,
+	extensionsv1alpha1.OperatingSystemConfigResource,
+	extensionsv1alpha1.WorkerResource,
+)
+
+// Options are command line options for injecting faults into the operations of the actuators of provider-local. All
+// options map extension kinds to the respective setting.
+type Options struct {
+	// ErrorProbabilities are the probabilities that an operation fails with an injected error.
+	ErrorProbabilities map[string]string
+	// ErrorCodes are the error codes of the injected errors.
+	ErrorCodes map[string]string
+	// DelayProbabilities are the probabilities that an operation is delayed.
+	DelayProbabilities map[string]string
+	// Delays are the durations by which operations are delayed.
+	Delays map[string]string
+
+	config *ControllerConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	kinds := strings.Join(sets.List(SupportedKinds), ", ")
+	fs.StringToStringVar(&o.ErrorProbabilities, "fault-injection-error-probability", o.ErrorProbabilities, "Probabilities (between 0 and 1) per extension kind that an operation fails with an injected error, e.g., 'Infrastructure=0.5'. Supported kinds: "+kinds)
+	fs.StringToStringVar(&o.ErrorCodes, "fault-injection-error-code", o.ErrorCodes, "Error codes per extension kind of the injected errors, e.g., 'Infrastructure=ERR_INFRA_RATE_LIMITS_EXCEEDED'.")
+	fs.StringToStringVar(&o.DelayProbabilities, "fault-injection-delay-probability", o.DelayProbabilities, "Probabilities (between 0 and 1) per extension kind that an operation is delayed, e.g., 'Worker=1'.")
+	fs.StringToStringVar(&o.Delays, "fault-injection-delay", o.Delays, "Durations per extension kind by which operations are delayed, e.g., 'Worker=30s'.")
+}
+
+// Complete implements Completer.Complete.
+func (o *Options) Complete() error {
+	configs := map[string]*Config{}
+	configFor := func(kind string) (*Config, error) {
+		if !SupportedKinds.Has(kind) {
+			return nil, fmt.Errorf("fault injection is not supported for extension kind %q, supported kinds are: %s", kind, strings.Join(sets.List(SupportedKinds), ", "))
+		}
+		if configs[kind] == nil {
+			configs[kind] = &Config{}
+		}
+		return configs[kind], nil
+	}
+
+	for kind, value := range o.ErrorProbabilities {
+		config, err := configFor(kind)
+		if err != nil {
+			return err
+		}
+		if config.ErrorProbability, err = parseProbability(value); err != nil {
+			return fmt.Errorf("invalid error probability for extension kind %q: %w", kind, err)
+		}
+	}
+
+	for kind, value := range o.ErrorCodes {
+		config, err := configFor(kind)
+		if err != nil {
+			return err
+		}
+		config.ErrorCode = gardencorev1beta1.ErrorCode(value)
+	}
+
+	for kind, value := range o.DelayProbabilities {
+		config, err := configFor(kind)
+		if err != nil {
+			return err
+		}
+		if config.DelayProbability, err = parseProbability(value); err != nil {
+			return fmt.Errorf("invalid delay probability for extension kind %q: %w", kind, err)
+		}
+	}
+
+	for kind, value := range o.Delays {
+		config, err := configFor(kind)
+		if err != nil {
+			return err
+		}
+		if config.Delay, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid delay for extension kind %q: %w", kind, err)
+		}
+	}
+
+	o.config = &ControllerConfig{Configs: configs}
+	return nil
+}
+
+// Completed returns the completed ControllerConfig. Only call this if `Complete` was successful.
+func (o *Options) Completed() *ControllerConfig {
+	return o.config
+}
+
+// ControllerConfig is a completed fault injection configuration.
+type ControllerConfig struct {
+	// Configs are the fault injection configurations per extension kind.
+	Configs map[string]*Config
+}
+
+// Apply sets the fault injection configuration of the given extension kind.
+func (c *ControllerConfig) Apply(kind string, config **Config) {
+	*config = c.Configs[kind]
+}
+
+func parseProbability(value string) (float64, error) {
+	probability, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if probability < 0 || probability > 1 {
+		return 0, fmt.Errorf("probability must be between 0 and 1, got %s", value)
+	}
+	return probability, nil
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/provider-local/faultinjection"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+		fs      *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+		fs = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		options.AddFlags(fs)
+	})
+
+	It("should complete an empty configuration", func() {
+		Expect(fs.Parse(nil)).To(Succeed())
+		Expect(options.Complete()).To(Succeed())
+
+		var config *Config
+		options.Completed().Apply("Infrastructure", &config)
+		Expect(config).To(BeNil())
+	})
+
+	It("should complete the configuration per extension kind", func() {
+		Expect(fs.Parse([]string{
+			"--fault-injection-error-probability=Infrastructure=0.5,Worker=1",
+			"--fault-injection-error-code=Infrastructure=ERR_INFRA_RATE_LIMITS_EXCEEDED",
+			"--fault-injection-delay-probability=Worker=0.25",
+			"--fault-injection-delay=Worker=30s",
+		})).To(Succeed())
+		Expect(options.Complete()).To(Succeed())
+
+		var infrastructureConfig, workerConfig, dnsRecordConfig *Config
+		options.Completed().Apply("Infrastructure", &infrastructureConfig)
+		options.Completed().Apply("Worker", &workerConfig)
+		options.Completed().Apply("DNSRecord", &dnsRecordConfig)
+
+		Expect(infrastructureConfig).To(Equal(&Config{ErrorProbability: 0.5, ErrorCode: gardencorev1beta1.ErrorInfraRateLimitsExceeded}))
+		Expect(workerConfig).To(Equal(&Config{ErrorProbability: 1, DelayProbability: 0.25, Delay: 30 * time.Second}))
+		Expect(dnsRecordConfig).To(BeNil())
+	})
+
+	It("should fail for unsupported extension kinds", func() {
+		Expect(fs.Parse([]string{"--fault-injection-error-probability=Network=0.5"})).To(Succeed())
+		Expect(options.Complete()).To(MatchError(ContainSubstring(`fault injection is not supported for extension kind "Network"`)))
+	})
+
+	It("should fail for invalid probabilities", func() {
+		Expect(fs.Parse([]string{"--fault-injection-delay-probability=Worker=1.5"})).To(Succeed())
+		Expect(options.Complete()).To(MatchError(ContainSubstring(`invalid delay probability for extension kind "Worker"`)))
+	})
+
+	It("should fail for invalid delays", func() {
+		Expect(fs.Parse([]string{"--fault-injection-delay=Worker=foo"})).To(Succeed())
+		Expect(options.Complete()).To(MatchError(ContainSubstring(`invalid delay for extension kind "Worker"`)))
+	})
+})
@@ -61,7 +61,6 @@ func AddToManagerWithOptions(mgr manager.Manager, _ AddOptions) (*extensionswebh
 		ObjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
 			{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{
 				"machine",
-				"dependency-watchdog-prober",
 				"blackbox-exporter",
 			}},
 		}},
@@ -288,6 +288,23 @@ type PodSchedulerNameWebhookConfig struct {
 	Enabled bool
 	// SchedulerName is the name of the scheduler that should be written into the .spec.schedulerName of pod resources.
 	SchedulerName *string
+	// Policies are applied to pods in namespaces matching their namespace selector.
+	Policies []PodPolicy
+}
+
+// PodPolicy is a policy which is applied to pods in namespaces matching the namespace selector. Fields which are
+// already specified by a pod are not overwritten.
+type PodPolicy struct {
+	// NamespaceSelector selects the namespaces of the pods to which this policy applies. If not specified, the policy
+	// applies to pods in all namespaces.
+	NamespaceSelector *metav1.LabelSelector
+	// RuntimeClassName is the name of the RuntimeClass that should be written into the .spec.runtimeClassName of pods.
+	RuntimeClassName *string
+	// SeccompProfile is the seccomp profile that should be written into the .spec.securityContext.seccompProfile of pods.
+	SeccompProfile *corev1.SeccompProfile
+	// PriorityClassName is the name of the PriorityClass that should be written into the .spec.priorityClassName of
+	// pods.
+	PriorityClassName *string
 }
 
 // PodTopologySpreadConstraintsWebhookConfig is the configuration for the pod-topology-spread-constraints webhook.
@@ -320,6 +320,28 @@ type PodSchedulerNameWebhookConfig struct {
 	// SchedulerName is the name of the scheduler that should be written into the .spec.schedulerName of pod resources.
 	// +optional
 	SchedulerName *string `json:"schedulerName,omitempty"`
+	// Policies are applied to pods in namespaces matching their namespace selector.
+	// +optional
+	Policies []PodPolicy `json:"policies,omitempty"`
+}
+
+// PodPolicy is a policy which is applied to pods in namespaces matching the namespace selector. Fields which are
+// already specified by a pod are not overwritten.
+type PodPolicy struct {
+	// NamespaceSelector selects the namespaces of the pods to which this policy applies. If not specified, the policy
+	// applies to pods in all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// RuntimeClassName is the name of the RuntimeClass that should be written into the .spec.runtimeClassName of pods.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// SeccompProfile is the seccomp profile that should be written into the .spec.securityContext.seccompProfile of pods.
+	// +optional
+	SeccompProfile *corev1.SeccompProfile `json:"seccompProfile,omitempty"`
+	// PriorityClassName is the name of the PriorityClass that should be written into the .spec.priorityClassName of
+	// pods.
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
 }
 
 // PodTopologySpreadConstraintsWebhookConfig is the configuration for the pod-topology-spread-constraints webhook.
@@ -178,6 +178,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodPolicy)(nil), (*config.PodPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodPolicy_To_config_PodPolicy(a.(*PodPolicy), b.(*config.PodPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodPolicy)(nil), (*PodPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodPolicy_To_v1alpha1_PodPolicy(a.(*config.PodPolicy), b.(*PodPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodSchedulerNameWebhookConfig)(nil), (*config.PodSchedulerNameWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(a.(*PodSchedulerNameWebhookConfig), b.(*config.PodSchedulerNameWebhookConfig), scope)
 	}); err != nil {
@@ -675,9 +685,36 @@ func Convert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeCriti
 	return autoConvert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeCriticalComponentsControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodPolicy_To_config_PodPolicy(in *PodPolicy, out *config.PodPolicy, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.RuntimeClassName = (*string)(unsafe.Pointer(in.RuntimeClassName))
+	out.SeccompProfile = (*corev1.SeccompProfile)(unsafe.Pointer(in.SeccompProfile))
+	out.PriorityClassName = (*string)(unsafe.Pointer(in.PriorityClassName))
+	return nil
+}
+
+// Convert_v1alpha1_PodPolicy_To_config_PodPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_PodPolicy_To_config_PodPolicy(in *PodPolicy, out *config.PodPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodPolicy_To_config_PodPolicy(in, out, s)
+}
+
+func autoConvert_config_PodPolicy_To_v1alpha1_PodPolicy(in *config.PodPolicy, out *PodPolicy, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.RuntimeClassName = (*string)(unsafe.Pointer(in.RuntimeClassName))
+	out.SeccompProfile = (*corev1.SeccompProfile)(unsafe.Pointer(in.SeccompProfile))
+	out.PriorityClassName = (*string)(unsafe.Pointer(in.PriorityClassName))
+	return nil
+}
+
+// Convert_config_PodPolicy_To_v1alpha1_PodPolicy is an autogenerated conversion function.
+func Convert_config_PodPolicy_To_v1alpha1_PodPolicy(in *config.PodPolicy, out *PodPolicy, s conversion.Scope) error {
+	return autoConvert_config_PodPolicy_To_v1alpha1_PodPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(in *PodSchedulerNameWebhookConfig, out *config.PodSchedulerNameWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.Policies = *(*[]config.PodPolicy)(unsafe.Pointer(&in.Policies))
 	return nil
 }
 
@@ -689,6 +726,7 @@ func Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWe
 func autoConvert_config_PodSchedulerNameWebhookConfig_To_v1alpha1_PodSchedulerNameWebhookConfig(in *config.PodSchedulerNameWebhookConfig, out *PodSchedulerNameWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
+	out.Policies = *(*[]PodPolicy)(unsafe.Pointer(&in.Policies))
 	return nil
 }
 
@@ -7,8 +7,10 @@ package validation
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigvalidation "k8s.io/component-base/config/validation"
@@ -162,6 +164,50 @@ func validatePodSchedulerNameWebhookConfiguration(conf config.PodSchedulerNameWe
 		allErrs = append(allErrs, field.Required(fldPath.Child("schedulerName"), "must specify schedulerName when webhook is enabled"))
 	}
 
+	for i, policy := range conf.Policies {
+		idxPath := fldPath.Child("policies").Index(i)
+
+		if policy.NamespaceSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(policy.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("namespaceSelector"))...)
+		}
+
+		if policy.RuntimeClassName == nil && policy.SeccompProfile == nil && policy.PriorityClassName == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify at least one of runtimeClassName, seccompProfile or priorityClassName"))
+		}
+
+		if policy.RuntimeClassName != nil && len(*policy.RuntimeClassName) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("runtimeClassName"), *policy.RuntimeClassName, "must not be empty"))
+		}
+
+		if policy.PriorityClassName != nil && len(*policy.PriorityClassName) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("priorityClassName"), *policy.PriorityClassName, "must not be empty"))
+		}
+
+		if policy.SeccompProfile != nil {
+			allErrs = append(allErrs, validateSeccompProfile(policy.SeccompProfile, idxPath.Child("seccompProfile"))...)
+		}
+	}
+
+	return allErrs
+}
+
+var availableSeccompProfileTypes = sets.New(
+	corev1.SeccompProfileTypeRuntimeDefault,
+	corev1.SeccompProfileTypeUnconfined,
+	corev1.SeccompProfileTypeLocalhost,
+)
+
+func validateSeccompProfile(profile *corev1.SeccompProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableSeccompProfileTypes.Has(profile.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), profile.Type, sets.List(availableSeccompProfileTypes)))
+	}
+
+	if profile.Type == corev1.SeccompProfileTypeLocalhost && len(ptr.Deref(profile.LocalhostProfile, "")) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("localhostProfile"), "must specify localhostProfile for type Localhost"))
+	}
+
 	return allErrs
 }
 
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -371,6 +372,69 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid pod policies", func() {
+					conf.Webhooks.PodSchedulerName.Enabled = true
+					conf.Webhooks.PodSchedulerName.SchedulerName = ptr.To("default-scheduler")
+					conf.Webhooks.PodSchedulerName.Policies = []config.PodPolicy{
+						{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+							RuntimeClassName:  ptr.To("gvisor"),
+							SeccompProfile:    &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+							PriorityClassName: ptr.To("high"),
+						},
+						{
+							SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: ptr.To("profile.json")},
+						},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors for invalid pod policies", func() {
+					conf.Webhooks.PodSchedulerName.Enabled = true
+					conf.Webhooks.PodSchedulerName.SchedulerName = ptr.To("default-scheduler")
+					conf.Webhooks.PodSchedulerName.Policies = []config.PodPolicy{
+						{
+							NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "Foo"}}},
+						},
+						{
+							RuntimeClassName:  ptr.To(""),
+							SeccompProfile:    &corev1.SeccompProfile{Type: "Foo"},
+							PriorityClassName: ptr.To(""),
+						},
+						{
+							SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost},
+						},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("webhooks.podSchedulerName.policies[0].namespaceSelector.matchExpressions[0].operator"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("webhooks.podSchedulerName.policies[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("webhooks.podSchedulerName.policies[1].runtimeClassName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("webhooks.podSchedulerName.policies[1].priorityClassName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("webhooks.podSchedulerName.policies[1].seccompProfile.type"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("webhooks.podSchedulerName.policies[2].seccompProfile.localhostProfile"),
+						})),
+					))
+				})
 			})
 
 			Context("projected token mount", func() {
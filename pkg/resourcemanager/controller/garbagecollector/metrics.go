@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garbagecollector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "gardener_resource_manager"
+
+var (
+	metricsFactory = promauto.With(runtimemetrics.Registry)
+
+	// ObjectsCollected defines the counter garbage_collector_objects_collected_total.
+	ObjectsCollected = metricsFactory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "garbage_collector_objects_collected_total",
+			Help:      "Number of garbage-collectable objects which were deleted by the garbage collector because they were no longer referenced.",
+		},
+		[]string{"kind"},
+	)
+
+	// ObjectsRetained defines the gauge garbage_collector_objects_retained.
+	ObjectsRetained = metricsFactory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "garbage_collector_objects_retained",
+			Help:      "Number of garbage-collectable objects which were retained by the last garbage collection run because they are still referenced.",
+		},
+		[]string{"kind"},
+	)
+)
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +48,11 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, _ reconcile.Request
 	log.Info("Starting garbage collection")
 	defer log.Info("Garbage collection finished")
 
+	referenceIndex, err := r.buildReferenceIndex(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	var (
 		labels                  = client.MatchingLabels{references.LabelKeyGarbageCollectable: references.LabelValueGarbageCollectable}
 		objectsToGarbageCollect = map[objectId]struct{}{}
@@ -65,49 +71,23 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, _ reconcile.Request
 			return reconcile.Result{}, err
 		}
 
+		retained := 0
 		for _, obj := range objList.Items {
 			if obj.CreationTimestamp.Add(*r.MinimumObjectLifetime).UTC().After(r.Clock.Now().UTC()) {
 				// Do not consider recently created objects for garbage collection.
 				continue
 			}
 
-			objectsToGarbageCollect[objectId{resource.kind, obj.Namespace, obj.Name}] = struct{}{}
-		}
-	}
-
-	var (
-		items             []metav1.PartialObjectMetadata
-		groupVersionKinds = []schema.GroupVersionKind{
-			appsv1.SchemeGroupVersion.WithKind("DeploymentList"),
-			appsv1.SchemeGroupVersion.WithKind("StatefulSetList"),
-			appsv1.SchemeGroupVersion.WithKind("DaemonSetList"),
-			batchv1.SchemeGroupVersion.WithKind("JobList"),
-			corev1.SchemeGroupVersion.WithKind("PodList"),
-			batchv1.SchemeGroupVersion.WithKind("CronJobList"),
-			resourcesv1alpha1.SchemeGroupVersion.WithKind("ManagedResourceList"),
-		}
-	)
-
-	for _, gvk := range groupVersionKinds {
-		objList := &metav1.PartialObjectMetadataList{}
-		objList.SetGroupVersionKind(gvk)
-		if err := r.TargetReader.List(ctx, objList); err != nil {
-			if !meta.IsNoMatchError(err) {
-				return reconcile.Result{}, err
-			}
-		}
-		items = append(items, objList.Items...)
-	}
-
-	for _, objectMeta := range items {
-		for key, objectName := range objectMeta.Annotations {
-			objectKind := references.KindFromAnnotationKey(key)
-			if objectKind == "" || objectName == "" {
+			id := objectId{resource.kind, obj.Namespace, obj.Name}
+			if referenceIndex.Has(id) {
+				retained++
 				continue
 			}
 
-			delete(objectsToGarbageCollect, objectId{objectKind, objectMeta.Namespace, objectName})
+			objectsToGarbageCollect[id] = struct{}{}
 		}
+
+		ObjectsRetained.WithLabelValues(resource.kind).Set(float64(retained))
 	}
 
 	var (
@@ -140,9 +120,14 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, _ reconcile.Request
 				"name", objId.name,
 			)
 
-			if err := r.TargetWriter.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
-				results <- err
+			if err := r.TargetWriter.Delete(ctx, obj); err != nil {
+				if client.IgnoreNotFound(err) != nil {
+					results <- err
+				}
+				return
 			}
+
+			ObjectsCollected.WithLabelValues(objId.kind).Inc()
 		})
 	}
 
@@ -160,6 +145,48 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, _ reconcile.Request
 	return reconcile.Result{Requeue: true, RequeueAfter: r.Config.SyncPeriod.Duration}, errorList.ErrorOrNil()
 }
 
+// buildReferenceIndex returns the set of all ConfigMaps and Secrets which are referenced by the annotations of the
+// relevant objects. References without a namespace point to the namespace of the referencing object, while
+// cross-namespace references contain the namespace of the referenced object.
+func (r *Reconciler) buildReferenceIndex(ctx context.Context) (sets.Set[objectId], error) {
+	var (
+		referenceIndex    = sets.New[objectId]()
+		groupVersionKinds = []schema.GroupVersionKind{
+			appsv1.SchemeGroupVersion.WithKind("DeploymentList"),
+			appsv1.SchemeGroupVersion.WithKind("StatefulSetList"),
+			appsv1.SchemeGroupVersion.WithKind("DaemonSetList"),
+			batchv1.SchemeGroupVersion.WithKind("JobList"),
+			corev1.SchemeGroupVersion.WithKind("PodList"),
+			batchv1.SchemeGroupVersion.WithKind("CronJobList"),
+			resourcesv1alpha1.SchemeGroupVersion.WithKind("ManagedResourceList"),
+		}
+	)
+
+	for _, gvk := range groupVersionKinds {
+		objList := &metav1.PartialObjectMetadataList{}
+		objList.SetGroupVersionKind(gvk)
+		if err := r.TargetReader.List(ctx, objList); err != nil {
+			if !meta.IsNoMatchError(err) {
+				return nil, err
+			}
+		}
+
+		for _, objectMeta := range objList.Items {
+			for key, value := range objectMeta.Annotations {
+				objectKind := references.KindFromAnnotationKey(key)
+				if objectKind == "" || value == "" {
+					continue
+				}
+
+				namespace, name := references.ObjectKeyFromAnnotationValue(value, objectMeta.Namespace)
+				referenceIndex.Insert(objectId{objectKind, namespace, name})
+			}
+		}
+	}
+
+	return referenceIndex, nil
+}
+
 type objectId struct {
 	kind      string
 	namespace string
@@ -10,6 +10,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -254,6 +255,49 @@ var _ = Describe("Collector", func() {
 				*labeledConfigMap7,
 			))
 		})
+
+		It("should retain resources referenced from other namespaces and report metrics", func() {
+			Expect(c.Create(ctx, labeledSecret1)).To(Succeed())
+			Expect(c.Create(ctx, labeledSecret1System)).To(Succeed())
+			Expect(c.Create(ctx, labeledSecret2)).To(Succeed())
+			Expect(c.Create(ctx, labeledConfigMap1)).To(Succeed())
+			Expect(c.Create(ctx, labeledConfigMap1System)).To(Succeed())
+
+			Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      "mr1",
+				Namespace: "other",
+				Annotations: map[string]string{
+					references.CrossNamespaceAnnotationKey(references.KindSecret, labeledSecret1System.Namespace, labeledSecret1System.Name): references.CrossNamespaceAnnotationValue(labeledSecret1System.Namespace, labeledSecret1System.Name),
+				},
+			}})).To(Succeed())
+			Expect(c.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name:      "deploy1",
+				Namespace: "other",
+				Annotations: map[string]string{
+					references.CrossNamespaceAnnotationKey(references.KindConfigMap, labeledConfigMap1.Namespace, labeledConfigMap1.Name): references.CrossNamespaceAnnotationValue(labeledConfigMap1.Namespace, labeledConfigMap1.Name),
+					references.AnnotationKey(references.KindSecret, labeledSecret1.Name):                                                  labeledSecret1.Name,
+				},
+			}})).To(Succeed())
+
+			collectedSecretsBefore := testutil.ToFloat64(ObjectsCollected.WithLabelValues(references.KindSecret))
+			collectedConfigMapsBefore := testutil.ToFloat64(ObjectsCollected.WithLabelValues(references.KindConfigMap))
+
+			_, err := gc.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			secretList := &corev1.SecretList{}
+			Expect(c.List(ctx, secretList)).To(Succeed())
+			Expect(secretList.Items).To(ConsistOf(*labeledSecret1System))
+
+			configMapList := &corev1.ConfigMapList{}
+			Expect(c.List(ctx, configMapList)).To(Succeed())
+			Expect(configMapList.Items).To(ConsistOf(*labeledConfigMap1))
+
+			Expect(testutil.ToFloat64(ObjectsRetained.WithLabelValues(references.KindSecret))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(ObjectsRetained.WithLabelValues(references.KindConfigMap))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(ObjectsCollected.WithLabelValues(references.KindSecret)) - collectedSecretsBefore).To(Equal(float64(2)))
+			Expect(testutil.ToFloat64(ObjectsCollected.WithLabelValues(references.KindConfigMap)) - collectedConfigMapsBefore).To(Equal(float64(1)))
+		})
 	})
 })
 
@@ -32,7 +32,8 @@ const (
 	// workload.
 	LabelValueGarbageCollectable = "true"
 
-	delimiter = "-"
+	delimiter          = "-"
+	namespaceDelimiter = "/"
 	// AnnotationKeyPrefix is a constant for the prefix used in annotations keys to indicate references to
 	// other resources.
 	AnnotationKeyPrefix = "reference.resources.gardener.cloud/"
@@ -52,6 +53,29 @@ func AnnotationKey(kind, name string) string {
 	return AnnotationKeyPrefix + kind + delimiter + sha256hex[:8]
 }
 
+// CrossNamespaceAnnotationKey computes a reference annotation key based on the given object kind, namespace and name.
+// It must be used for references to objects in another namespace than the one of the referencing object, together with
+// the value returned by `CrossNamespaceAnnotationValue`.
+func CrossNamespaceAnnotationKey(kind, namespace, name string) string {
+	return AnnotationKey(kind, CrossNamespaceAnnotationValue(namespace, name))
+}
+
+// CrossNamespaceAnnotationValue computes the value of a reference annotation for an object in another namespace than
+// the one of the referencing object.
+func CrossNamespaceAnnotationValue(namespace, name string) string {
+	return namespace + namespaceDelimiter + name
+}
+
+// ObjectKeyFromAnnotationValue computes the namespace and name of the referenced object based on the given reference
+// annotation value and the namespace of the referencing object. Values of cross-namespace references contain the
+// namespace of the referenced object.
+func ObjectKeyFromAnnotationValue(value, referencingNamespace string) (namespace, name string) {
+	if namespace, name, found := strings.Cut(value, namespaceDelimiter); found {
+		return namespace, name
+	}
+	return referencingNamespace, value
+}
+
 // KindFromAnnotationKey computes the object kind and object name based on the given reference annotation key. If
 // the key is not valid then both return values will be empty.
 func KindFromAnnotationKey(key string) string {
@@ -142,7 +166,12 @@ func InjectAnnotations(obj runtime.Object, additional ...string) error {
 		o.Spec.JobTemplate.Spec.Template.Annotations = mergeAnnotations(o.Spec.JobTemplate.Spec.Template.Annotations, referenceAnnotations)
 
 	case *resourcesv1alpha1.ManagedResource:
-		referenceAnnotations := computeAnnotationsFromLocalObjRefs(o.Spec.SecretRefs, KindSecret, additional...)
+		secretRefs := append([]corev1.LocalObjectReference{}, o.Spec.SecretRefs...)
+		if o.Spec.CRDManagement != nil && o.Spec.CRDManagement.ConversionWebhookCABundleSecretRef != nil {
+			secretRefs = append(secretRefs, *o.Spec.CRDManagement.ConversionWebhookCABundleSecretRef)
+		}
+
+		referenceAnnotations := computeAnnotationsFromLocalObjRefs(secretRefs, KindSecret, additional...)
 		o.Annotations = mergeAnnotations(o.Annotations, referenceAnnotations)
 
 	default:
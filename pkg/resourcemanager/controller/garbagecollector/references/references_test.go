@@ -35,6 +35,32 @@ var _ = Describe("References", func() {
 		})
 	})
 
+	Describe("#CrossNamespaceAnnotationKey", func() {
+		It("should compute the expected key", func() {
+			Expect(CrossNamespaceAnnotationKey(kind, "namespace", name)).To(Equal(AnnotationKey(kind, "namespace/name")))
+		})
+	})
+
+	Describe("#CrossNamespaceAnnotationValue", func() {
+		It("should compute the expected value", func() {
+			Expect(CrossNamespaceAnnotationValue("namespace", name)).To(Equal("namespace/name"))
+		})
+	})
+
+	Describe("#ObjectKeyFromAnnotationValue", func() {
+		It("should return the namespace of the referencing object for namespace-local references", func() {
+			namespace, objName := ObjectKeyFromAnnotationValue(name, "referencing")
+			Expect(namespace).To(Equal("referencing"))
+			Expect(objName).To(Equal(name))
+		})
+
+		It("should return the namespace contained in the value for cross-namespace references", func() {
+			namespace, objName := ObjectKeyFromAnnotationValue("namespace/name", "referencing")
+			Expect(namespace).To(Equal("namespace"))
+			Expect(objName).To(Equal(name))
+		})
+	})
+
 	Describe("#KindFromAnnotationKey", func() {
 		It("should return the expected kind", func() {
 			Expect(KindFromAnnotationKey(key)).To(Equal(kind))
@@ -357,6 +383,9 @@ var _ = Describe("References", func() {
 					SecretRefs: []corev1.LocalObjectReference{
 						{Name: secret2}, {Name: secret5},
 					},
+					CRDManagement: &resourcesv1alpha1.CRDManagement{
+						ConversionWebhookCABundleSecretRef: &corev1.LocalObjectReference{Name: secret6},
+					},
 				},
 			}
 		)
@@ -466,6 +495,7 @@ var _ = Describe("References", func() {
 						"some-existing":                    "annotation",
 						AnnotationKey(KindSecret, secret2): secret2,
 						AnnotationKey(KindSecret, secret5): secret5,
+						AnnotationKey(KindSecret, secret6): secret6,
 						additionalAnnotation1:              "",
 						additionalAnnotation2:              "",
 					}))
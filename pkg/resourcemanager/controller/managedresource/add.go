@@ -6,6 +6,7 @@ package managedresource
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -121,7 +122,12 @@ func (r *Reconciler) MapSecretToManagedResources(managedResourcePredicates ...pr
 				continue
 			}
 
-			for _, secretRef := range mr.Spec.SecretRefs {
+			secretRefs := mr.Spec.SecretRefs
+			if mr.Spec.CRDManagement != nil && mr.Spec.CRDManagement.ConversionWebhookCABundleSecretRef != nil {
+				secretRefs = append(slices.Clone(secretRefs), *mr.Spec.CRDManagement.ConversionWebhookCABundleSecretRef)
+			}
+
+			for _, secretRef := range secretRefs {
 				if secretRef.Name == secret.Name {
 					requests = append(requests, reconcile.Request{
 						NamespacedName: types.NamespacedName{
@@ -123,4 +123,34 @@ var _ = Describe("#MapSecretToManagedResources", func() {
 			}},
 		))
 	})
+
+	It("should correctly map to ManagedResources that reference the secret as conversion webhook CA bundle", func() {
+		mr := resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mr",
+				Namespace: secret.Namespace,
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class:      ptr.To(filter.ResourceClass()),
+				SecretRefs: []corev1.LocalObjectReference{{Name: "other"}},
+				CRDManagement: &resourcesv1alpha1.CRDManagement{
+					ConversionWebhookCABundleSecretRef: &corev1.LocalObjectReference{Name: secret.Name},
+				},
+			},
+		}
+
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResourceList{}), client.InNamespace(secret.Namespace)).
+			DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOption) error {
+				list.(*resourcesv1alpha1.ManagedResourceList).Items = []resourcesv1alpha1.ManagedResource{mr}
+				return nil
+			})
+
+		requests := m.Map(ctx, logr.Discard(), c, secret)
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      mr.Name,
+				Namespace: mr.Namespace,
+			}},
+		))
+	})
 })
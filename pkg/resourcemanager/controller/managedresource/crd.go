@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+func isCustomResourceDefinition(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().GroupKind() == apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition").GroupKind()
+}
+
+func waitForEstablished(mr *resourcesv1alpha1.ManagedResource) bool {
+	return mr.Spec.CRDManagement != nil && ptr.Deref(mr.Spec.CRDManagement.WaitForEstablished, false)
+}
+
+func protectFromDeletion(mr *resourcesv1alpha1.ManagedResource) bool {
+	return mr.Spec.CRDManagement != nil && ptr.Deref(mr.Spec.CRDManagement.ProtectFromDeletion, false)
+}
+
+// partitionCustomResourceDefinitions splits the given objects into CustomResourceDefinitions and all other objects.
+func partitionCustomResourceDefinitions(objects []object) (crds, others []object) {
+	for _, obj := range objects {
+		if isCustomResourceDefinition(obj.obj) {
+			crds = append(crds, obj)
+		} else {
+			others = append(others, obj)
+		}
+	}
+	return
+}
+
+// conversionWebhookCABundle reads the certificate bundle which should be injected into the conversion webhook client
+// configuration of CustomResourceDefinitions. It returns nil if no bundle is configured.
+func (r *Reconciler) conversionWebhookCABundle(ctx context.Context, mr *resourcesv1alpha1.ManagedResource) ([]byte, error) {
+	if mr.Spec.CRDManagement == nil || mr.Spec.CRDManagement.ConversionWebhookCABundleSecretRef == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.SourceClient.Get(ctx, client.ObjectKey{Namespace: mr.Namespace, Name: mr.Spec.CRDManagement.ConversionWebhookCABundleSecretRef.Name}, secret); err != nil {
+		return nil, err
+	}
+
+	caBundle, ok := secret.Data[secretsutils.DataKeyCertificateBundle]
+	if !ok || len(caBundle) == 0 {
+		return nil, fmt.Errorf("secret %s does not contain a certificate bundle in data key %q", client.ObjectKeyFromObject(secret), secretsutils.DataKeyCertificateBundle)
+	}
+
+	return caBundle, nil
+}
+
+// injectConversionWebhookCABundle injects the given certificate bundle into the conversion webhook client configuration
+// of the given CustomResourceDefinition if it uses the `Webhook` conversion strategy.
+func injectConversionWebhookCABundle(obj *unstructured.Unstructured, caBundle []byte) error {
+	if len(caBundle) == 0 || !isCustomResourceDefinition(obj) {
+		return nil
+	}
+
+	strategy, _, err := unstructured.NestedString(obj.Object, "spec", "conversion", "strategy")
+	if err != nil {
+		return err
+	}
+	if strategy != string(apiextensionsv1.WebhookConverter) {
+		return nil
+	}
+
+	return unstructured.SetNestedField(obj.Object, base64.StdEncoding.EncodeToString(caBundle), "spec", "conversion", "webhook", "clientConfig", "caBundle")
+}
+
+// checkCustomResourceDefinitionsEstablished returns an error if at least one of the given CustomResourceDefinitions is
+// not yet established.
+func (r *Reconciler) checkCustomResourceDefinitionsEstablished(ctx context.Context, crds []object) error {
+	var errs []error
+
+	for _, obj := range crds {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Name: obj.obj.GetName()}, crd); err != nil {
+			return err
+		}
+
+		if err := health.CheckCustomResourceDefinition(crd); err != nil {
+			errs = append(errs, fmt.Errorf("CustomResourceDefinition %q is not yet established: %w", crd.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkNoCustomResourcesExist returns an error if custom resources of the kind defined by the given
+// CustomResourceDefinition still exist.
+func checkNoCustomResourcesExist(ctx context.Context, c client.Reader, scheme *runtime.Scheme, obj *unstructured.Unstructured) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := scheme.Convert(obj, crd, nil); err != nil {
+		return fmt.Errorf("could not convert object to CustomResourceDefinition: %w", err)
+	}
+
+	for _, version := range crd.Spec.Versions {
+		if !version.Served {
+			continue
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetAPIVersion(crd.Spec.Group + "/" + version.Name)
+		list.SetKind(crd.Spec.Names.ListKind)
+		if list.GetKind() == "" {
+			list.SetKind(crd.Spec.Names.Kind + "List")
+		}
+
+		if err := c.List(ctx, list, client.Limit(1)); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				return nil
+			}
+			return fmt.Errorf("could not list %s resources: %w", crd.Spec.Names.Kind, err)
+		}
+
+		if len(list.Items) > 0 {
+			return fmt.Errorf("cannot delete CustomResourceDefinition %q because there are still %s resources left in the cluster", crd.Name, crd.Spec.Names.Kind)
+		}
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("CustomResourceDefinition management", func() {
+	var (
+		ctx = context.TODO()
+
+		mr  *resourcesv1alpha1.ManagedResource
+		crd *apiextensionsv1.CustomResourceDefinition
+	)
+
+	toUnstructured := func(obj runtime.Object) *unstructured.Unstructured {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return &unstructured.Unstructured{Object: u}
+	}
+
+	BeforeEach(func() {
+		mr = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+		}
+
+		crd = &apiextensionsv1.CustomResourceDefinition{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+			ObjectMeta: metav1.ObjectMeta{Name: "foos.example.com"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: "example.com",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Foo", ListKind: "FooList", Plural: "foos"},
+				Scope: apiextensionsv1.NamespaceScoped,
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha1", Served: false},
+					{Name: "v1", Served: true, Storage: true},
+				},
+			},
+		}
+	})
+
+	Describe("#waitForEstablished and #protectFromDeletion", func() {
+		It("should return false if CRD management is not configured", func() {
+			Expect(waitForEstablished(mr)).To(BeFalse())
+			Expect(protectFromDeletion(mr)).To(BeFalse())
+		})
+
+		It("should return the configured values", func() {
+			mr.Spec.CRDManagement = &resourcesv1alpha1.CRDManagement{WaitForEstablished: ptr.To(true), ProtectFromDeletion: ptr.To(false)}
+
+			Expect(waitForEstablished(mr)).To(BeTrue())
+			Expect(protectFromDeletion(mr)).To(BeFalse())
+		})
+	})
+
+	Describe("#partitionCustomResourceDefinitions", func() {
+		It("should split CustomResourceDefinitions from other objects", func() {
+			crdObj := object{obj: toUnstructured(crd)}
+			configMapObj := object{obj: toUnstructured(&corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}})}
+
+			crds, others := partitionCustomResourceDefinitions([]object{configMapObj, crdObj})
+			Expect(crds).To(ConsistOf(crdObj))
+			Expect(others).To(ConsistOf(configMapObj))
+		})
+	})
+
+	Describe("#conversionWebhookCABundle", func() {
+		var (
+			reconciler *Reconciler
+			secret     *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			reconciler = &Reconciler{SourceClient: fakeclient.NewClientBuilder().Build()}
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: mr.Namespace},
+				Data:       map[string][]byte{"bundle.crt": []byte("ca")},
+			}
+		})
+
+		It("should return nil if no secret is referenced", func() {
+			Expect(reconciler.conversionWebhookCABundle(ctx, mr)).To(BeNil())
+		})
+
+		It("should return the certificate bundle of the referenced secret", func() {
+			Expect(reconciler.SourceClient.Create(ctx, secret)).To(Succeed())
+			mr.Spec.CRDManagement = &resourcesv1alpha1.CRDManagement{ConversionWebhookCABundleSecretRef: &corev1.LocalObjectReference{Name: secret.Name}}
+
+			Expect(reconciler.conversionWebhookCABundle(ctx, mr)).To(Equal([]byte("ca")))
+		})
+
+		It("should fail if the referenced secret does not contain a certificate bundle", func() {
+			secret.Data = nil
+			Expect(reconciler.SourceClient.Create(ctx, secret)).To(Succeed())
+			mr.Spec.CRDManagement = &resourcesv1alpha1.CRDManagement{ConversionWebhookCABundleSecretRef: &corev1.LocalObjectReference{Name: secret.Name}}
+
+			_, err := reconciler.conversionWebhookCABundle(ctx, mr)
+			Expect(err).To(MatchError(ContainSubstring("does not contain a certificate bundle")))
+		})
+	})
+
+	Describe("#injectConversionWebhookCABundle", func() {
+		It("should inject the CA bundle if the webhook conversion strategy is used", func() {
+			crd.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook: &apiextensionsv1.WebhookConversion{
+					ClientConfig:             &apiextensionsv1.WebhookClientConfig{URL: ptr.To("https://example.com")},
+					ConversionReviewVersions: []string{"v1"},
+				},
+			}
+			obj := toUnstructured(crd)
+
+			Expect(injectConversionWebhookCABundle(obj, []byte("ca"))).To(Succeed())
+
+			caBundle, _, err := unstructured.NestedString(obj.Object, "spec", "conversion", "webhook", "clientConfig", "caBundle")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(caBundle).To(Equal(base64.StdEncoding.EncodeToString([]byte("ca"))))
+		})
+
+		It("should not inject the CA bundle if no conversion webhook is used", func() {
+			obj := toUnstructured(crd)
+			expected := obj.DeepCopy()
+
+			Expect(injectConversionWebhookCABundle(obj, []byte("ca"))).To(Succeed())
+			Expect(obj).To(Equal(expected))
+		})
+	})
+
+	Describe("#checkNoCustomResourcesExist", func() {
+		var (
+			c        client.Client
+			gvk      = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Foo"}
+			resource *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(gvk, meta.RESTScopeNamespace)
+			c = fakeclient.NewClientBuilder().WithRESTMapper(restMapper).Build()
+
+			resource = &unstructured.Unstructured{}
+			resource.SetGroupVersionKind(gvk)
+			resource.SetName("foo")
+			resource.SetNamespace("default")
+		})
+
+		It("should succeed if no custom resources exist", func() {
+			Expect(checkNoCustomResourcesExist(ctx, c, kubernetes.SeedScheme, toUnstructured(crd))).To(Succeed())
+		})
+
+		It("should fail if custom resources still exist", func() {
+			Expect(c.Create(ctx, resource)).To(Succeed())
+
+			Expect(checkNoCustomResourcesExist(ctx, c, kubernetes.SeedScheme, toUnstructured(crd))).To(MatchError(ContainSubstring("there are still Foo resources left")))
+		})
+
+		It("should succeed if the kind is not known to the cluster", func() {
+			c = fakeclient.NewClientBuilder().WithRESTMapper(meta.NewDefaultRESTMapper(nil)).Build()
+
+			Expect(checkNoCustomResourcesExist(ctx, c, kubernetes.SeedScheme, toUnstructured(crd))).To(Succeed())
+		})
+	})
+})
@@ -56,6 +56,10 @@ import (
 	utilclient "github.com/gardener/gardener/pkg/utils/kubernetes/client"
 )
 
+// requeueAfterCRDsNotEstablished is the duration after which a ManagedResource is reconciled again while its
+// CustomResourceDefinitions are not yet established.
+const requeueAfterCRDsNotEstablished = 5 * time.Second
+
 var (
 	deletePropagationForeground = metav1.DeletePropagationForeground
 	foregroundDeletionAPIGroups = sets.New(appsv1.GroupName, extensionsv1beta1.GroupName, batchv1.GroupName)
@@ -156,6 +160,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// Initialize condition based on the current status.
 	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
 
+	caBundle, err := r.conversionWebhookCABundle(reconcileCtx, mr)
+	if err != nil {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, "CannotReadSecret", err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not read conversion webhook CA bundle: %w", err)
+	}
+
 	for _, ref := range mr.Spec.SecretRefs {
 		secret := &corev1.Secret{}
 		if err := r.SourceClient.Get(reconcileCtx, client.ObjectKey{Namespace: mr.Namespace, Name: ref.Name}, secret); err != nil {
@@ -207,6 +221,19 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 				obj := &unstructured.Unstructured{Object: decodedObj}
 				objLog = objLog.WithValues("object", client.Object(obj))
 
+				if err := injectConversionWebhookCABundle(obj, caBundle); err != nil {
+					dErr := &decodingError{
+						err:         fmt.Errorf("could not inject conversion webhook CA bundle: %w", err),
+						secret:      client.ObjectKeyFromObject(secret),
+						secretKey:   secretKey,
+						indexInFile: indexInFile,
+					}
+					decodingErrors = append(decodingErrors, dErr)
+					objLog.Error(dErr.err, "Could not inject conversion webhook CA bundle")
+					decodedObj = nil
+					continue
+				}
+
 				// look up scope of objects' kind to check, if we should default the namespace field
 				mapping, err := r.TargetRESTMapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
 				if err != nil || mapping == nil {
@@ -275,6 +302,9 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		}
 	}
 
+	// the conversion webhook CA bundle is part of the desired state of the CustomResourceDefinitions
+	hash.Write(caBundle)
+
 	// calculate the checksum for the referenced secrets data.
 	secretsDataChecksum := hex.EncodeToString(hash.Sum(nil))
 
@@ -343,13 +373,30 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+
+	objectsToApply := newResourcesObjects
+	if waitForEstablished(mr) {
+		var crds []object
+		crds, objectsToApply = partitionCustomResourceDefinitions(newResourcesObjects)
+
+		if err := r.applyNewResources(reconcileCtx, log, origin, crds, injectLabels, equivalences); err != nil {
+			return r.handleApplyFailure(ctx, mr, conditionResourcesApplied, err)
+		}
+
+		if err := r.checkCustomResourceDefinitionsEstablished(reconcileCtx, crds); err != nil {
+			log.Info("Waiting for CustomResourceDefinitions to be established before applying the remaining resources", "err", err)
+
+			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, resourcesv1alpha1.ConditionApplyProgressing, err.Error())
+			if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+			}
+
+			return reconcile.Result{RequeueAfter: requeueAfterCRDsNotEstablished}, nil
 		}
+	}
 
-		return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
+	if err := r.applyNewResources(reconcileCtx, log, origin, objectsToApply, injectLabels, equivalences); err != nil {
+		return r.handleApplyFailure(ctx, mr, conditionResourcesApplied, err)
 	}
 
 	if len(decodingErrors) != 0 {
@@ -366,6 +413,15 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
+func (r *Reconciler) handleApplyFailure(ctx context.Context, mr *resourcesv1alpha1.ManagedResource, conditionResourcesApplied gardencorev1beta1.Condition, err error) (reconcile.Result, error) {
+	conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
+	if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
+}
+
 func (r *Reconciler) delete(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource) (reconcile.Result, error) {
 	log.Info("Started deleting resources created by ManagedResource")
 
@@ -671,6 +727,7 @@ func (r *Reconciler) cleanOldResources(ctx context.Context, log logr.Logger, mr
 		results         = make(chan *output)
 		wg              sync.WaitGroup
 		deletePVCs      = mr.Spec.DeletePersistentVolumeClaims != nil && *mr.Spec.DeletePersistentVolumeClaims
+		protectCRDs     = protectFromDeletion(mr)
 		deletionPending = false
 		errorList       = &multierror.Error{
 			ErrorFormat: errorsutils.NewErrorFormatFuncWithPrefix("Could not clean all old resources"),
@@ -717,6 +774,14 @@ func (r *Reconciler) cleanOldResources(ctx context.Context, log logr.Logger, mr
 					return
 				}
 
+				if protectCRDs && isCustomResourceDefinition(obj) {
+					if err := checkNoCustomResourcesExist(ctx, r.TargetClient, r.TargetScheme, obj); err != nil {
+						logger.Info("Deletion of CustomResourceDefinition is blocked", "reason", err.Error())
+						results <- &output{obj, true, err}
+						return
+					}
+				}
+
 				if err := cleanup(ctx, r.TargetClient, r.TargetScheme, obj, deletePVCs); err != nil {
 					logger.Error(err, "Error during cleanup")
 					results <- &output{obj, true, err}
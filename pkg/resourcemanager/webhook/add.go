@@ -85,7 +85,10 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 
 	if cfg.Webhooks.PodSchedulerName.Enabled {
 		if err := (&podschedulername.Handler{
+			Logger:        mgr.GetLogger().WithName("webhook").WithName(podschedulername.HandlerName),
+			TargetReader:  targetCluster.GetCache(),
 			SchedulerName: *cfg.Webhooks.PodSchedulerName.SchedulerName,
+			Policies:      cfg.Webhooks.PodSchedulerName.Policies,
 		}).AddToManager(mgr); err != nil {
 			return fmt.Errorf("failed adding %s webhook handler: %w", podschedulername.HandlerName, err)
 		}
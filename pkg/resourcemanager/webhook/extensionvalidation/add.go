@@ -39,6 +39,8 @@ const (
 	WebhookPathNetwork = "/validate-extensions-gardener-cloud-v1alpha1-network"
 	// WebhookPathOperatingSystemConfig is the HTTP handler path for this admission webhook handler for OperatingSystemConfig.
 	WebhookPathOperatingSystemConfig = "/validate-extensions-gardener-cloud-v1alpha1-operatingsystemconfig"
+	// WebhookPathPrivateLink is the HTTP handler path for this admission webhook handler for PrivateLink.
+	WebhookPathPrivateLink = "/validate-extensions-gardener-cloud-v1alpha1-privatelink"
 	// WebhookPathWorker is the HTTP handler path for this admission webhook handler for Worker.
 	WebhookPathWorker = "/validate-extensions-gardener-cloud-v1alpha1-worker"
 )
@@ -57,6 +59,7 @@ func AddToManager(mgr manager.Manager) error {
 		&extensionsv1alpha1.Infrastructure{}:        &infrastructureValidator{},
 		&extensionsv1alpha1.Network{}:               &networkValidator{},
 		&extensionsv1alpha1.OperatingSystemConfig{}: &operatingSystemConfigValidator{},
+		&extensionsv1alpha1.PrivateLink{}:           &privateLinkValidator{},
 		&extensionsv1alpha1.Worker{}:                &workerValidator{},
 	} {
 		if err := builder.
@@ -29,6 +29,7 @@ type (
 	infrastructureValidator        struct{}
 	networkValidator               struct{}
 	operatingSystemConfigValidator struct{}
+	privateLinkValidator           struct{}
 	workerValidator                struct{}
 )
 
@@ -252,6 +253,26 @@ func (operatingSystemConfigValidator) ValidateDelete(_ context.Context, _ runtim
 	return nil, nil
 }
 
+func (privateLinkValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	object := obj.(*extensionsv1alpha1.PrivateLink)
+	if errs := validation.ValidatePrivateLink(object); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.PrivateLinkResource), object.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (privateLinkValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	object := newObj.(*extensionsv1alpha1.PrivateLink)
+	if errs := validation.ValidatePrivateLinkUpdate(object, oldObj.(*extensionsv1alpha1.PrivateLink)); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.PrivateLinkResource), object.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (privateLinkValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
 func (workerValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	object := obj.(*extensionsv1alpha1.Worker)
 	if errs := validation.ValidateWorker(object); len(errs) > 0 {
@@ -76,6 +76,16 @@ func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.R
 		zones = sets.List(sets.New(strings.Split(v, ",")...).Delete(""))
 	}
 
+	// Zones affected by an outage are only excluded if at least one zone remains available. Only Deployments consider
+	// them since a changed pod template of stateful workload (e.g., etcd) would cause a rolling update which endangers
+	// its quorum while a zone is unavailable.
+	availableZones := zones
+	if v, ok := namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigUnavailableZones]; ok {
+		if remainingZones := sets.List(sets.New(zones...).Delete(strings.Split(v, ",")...)); len(remainingZones) > 0 {
+			availableZones = remainingZones
+		}
+	}
+
 	if v, err := strconv.ParseBool(namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigZonePinning]); err == nil {
 		isZonePinningEnabled = v
 	}
@@ -87,9 +97,9 @@ func (h *Handler) Handle(ctx context.Context, req admission.Request) admission.R
 
 	switch requestGK {
 	case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():
-		obj, err = h.handleDeployment(req, failureToleranceType, zones, isHorizontallyScaled, maxReplicas, isZonePinningEnabled)
+		obj, err = h.handleDeployment(req, failureToleranceType, availableZones, isHorizontallyScaled, maxReplicas, isZonePinningEnabled)
 	case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
-		obj, err = h.handleStatefulSet(req, failureToleranceType, zones, isHorizontallyScaled, maxReplicas, isZonePinningEnabled)
+		obj, err = h.handleStatefulSet(ctx, req, failureToleranceType, zones, isHorizontallyScaled, maxReplicas, isZonePinningEnabled)
 	case autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler").GroupKind():
 		obj, err = h.handleHorizontalPodAutoscaler(req, failureToleranceType)
 	case hvpav1alpha1.SchemeGroupVersionHvpa.WithKind("Hvpa").GroupKind():
@@ -169,6 +179,7 @@ func (h *Handler) handleDeployment(
 }
 
 func (h *Handler) handleStatefulSet(
+	ctx context.Context,
 	req admission.Request,
 	failureToleranceType *gardencorev1beta1.FailureToleranceType,
 	zones []string,
@@ -197,11 +208,27 @@ func (h *Handler) handleStatefulSet(
 		return nil, err
 	}
 
+	// Pods of a StatefulSet are bound to the zones of their existing zonal volumes. If these zones were not part of the
+	// node affinity anymore (e.g., because the zones of the namespace have been changed), such pods could never be
+	// scheduled again. Hence, the zones of existing volumes are always allowed in addition to the zones of the namespace.
+	affinityZones := zones
+	if len(zones) > 0 && len(statefulSet.Spec.VolumeClaimTemplates) > 0 {
+		volumeZones, err := h.zonesOfExistingVolumes(ctx, statefulSet)
+		if err != nil {
+			return nil, err
+		}
+
+		if additionalZones := volumeZones.Difference(sets.New(zones...)); additionalZones.Len() > 0 {
+			log.Info("Adding zones of existing volumes to node affinity", "zones", sets.List(additionalZones))
+			affinityZones = sets.List(volumeZones.Insert(zones...))
+		}
+	}
+
 	h.mutateNodeAffinity(
 		// TODO(ScheererJ): Remove "failureToleranceType != nil" after the shoot namespaces have been annotated with
 		//  "zone-pinning=enabled" as well (today, only the istio-ingress namespaces have this annotation).
 		failureToleranceType != nil || isZonePinningEnabled,
-		zones,
+		affinityZones,
 		&statefulSet.Spec.Template,
 	)
 
@@ -222,6 +249,85 @@ func (h *Handler) handleStatefulSet(
 	return statefulSet, nil
 }
 
+// zonesOfExistingVolumes returns the zones of the PersistentVolumes which are bound to the PersistentVolumeClaims
+// created for the volume claim templates of the given StatefulSet.
+func (h *Handler) zonesOfExistingVolumes(ctx context.Context, statefulSet *appsv1.StatefulSet) (sets.Set[string], error) {
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := h.TargetClient.List(ctx, pvcList, client.InNamespace(statefulSet.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list PersistentVolumeClaims: %w", err)
+	}
+
+	zones := sets.New[string]()
+
+	for _, pvc := range pvcList.Items {
+		if !isClaimOfStatefulSet(pvc.Name, statefulSet) || pvc.Spec.VolumeName == "" {
+			continue
+		}
+
+		pv := &corev1.PersistentVolume{}
+		if err := h.TargetClient.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get PersistentVolume %s: %w", pvc.Spec.VolumeName, err)
+		}
+
+		zones.Insert(zonesOfPersistentVolume(pv)...)
+	}
+
+	return zones, nil
+}
+
+// isClaimOfStatefulSet checks whether the PersistentVolumeClaim with the given name was created by the StatefulSet
+// controller for one of the volume claim templates of the given StatefulSet, i.e., whether its name has the form
+// `<template-name>-<statefulset-name>-<ordinal>`.
+func isClaimOfStatefulSet(claimName string, statefulSet *appsv1.StatefulSet) bool {
+	for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+		ordinal, ok := strings.CutPrefix(claimName, template.Name+"-"+statefulSet.Name+"-")
+		if !ok {
+			continue
+		}
+
+		if _, err := strconv.ParseUint(ordinal, 10, 32); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// zonesOfPersistentVolume returns the zones to which the given PersistentVolume is bound, based on its node affinity
+// or, for volumes provisioned by in-tree volume plugins, on its zone labels.
+func zonesOfPersistentVolume(pv *corev1.PersistentVolume) []string {
+	zones := sets.New[string]()
+
+	if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+		for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+			for _, expr := range term.MatchExpressions {
+				if expr.Operator == corev1.NodeSelectorOpIn && isZoneTopologyKey(expr.Key) {
+					zones.Insert(expr.Values...)
+				}
+			}
+		}
+	}
+
+	for _, key := range []string{corev1.LabelTopologyZone, corev1.LabelFailureDomainBetaZone} {
+		if v, ok := pv.Labels[key]; ok {
+			// Volumes spanning multiple zones have the zones separated with "__" in the label value, see
+			// https://kubernetes.io/docs/reference/labels-annotations-taints/#topologykubernetesiozone.
+			zones.Insert(strings.Split(v, "__")...)
+		}
+	}
+
+	return sets.List(zones.Delete(""))
+}
+
+// isZoneTopologyKey checks whether the given key describes a zone. Besides the well-known labels, CSI drivers
+// typically use their own topology keys ending with `/zone` (e.g., `topology.ebs.csi.aws.com/zone`).
+func isZoneTopologyKey(key string) bool {
+	return key == corev1.LabelTopologyZone || key == corev1.LabelFailureDomainBetaZone || strings.HasSuffix(key, "/zone")
+}
+
 func (h *Handler) handleHvpa(req admission.Request, failureToleranceType *gardencorev1beta1.FailureToleranceType) (runtime.Object, error) {
 	hvpa := &hvpav1alpha1.Hvpa{}
 	if err := h.Decoder.Decode(req, hvpa); err != nil {
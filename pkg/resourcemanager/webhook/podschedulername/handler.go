@@ -8,17 +8,32 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
-// Handler handles admission requests and sets the spec.schedulerName field in Pod resources.
+// Handler handles admission requests and sets the spec.schedulerName field in Pod resources. In addition, it applies
+// the configured policies to pods in namespaces matching their namespace selector.
 type Handler struct {
+	Logger       logr.Logger
+	TargetReader client.Reader
+
 	SchedulerName string
+	Policies      []config.PodPolicy
 }
 
-// Default defaults the scheduler name of the provided pod.
-func (h *Handler) Default(_ context.Context, obj runtime.Object) error {
+// Default defaults the scheduler name of the provided pod and applies the configured policies.
+func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
 		return fmt.Errorf("expected *corev1.Pod but got %T", obj)
@@ -29,5 +44,123 @@ func (h *Handler) Default(_ context.Context, obj runtime.Object) error {
 		pod.Spec.SchedulerName = h.SchedulerName
 	}
 
+	if len(h.Policies) == 0 {
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := h.TargetReader.Get(ctx, client.ObjectKey{Name: req.Namespace}, namespace); err != nil {
+		return fmt.Errorf("failed reading namespace %s: %w", req.Namespace, err)
+	}
+
+	log := h.Logger.WithValues("pod", kubernetesutils.ObjectKeyForCreateWebhooks(pod, req))
+
+	for _, policy := range h.Policies {
+		matches, err := namespaceMatches(policy.NamespaceSelector, namespace)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			continue
+		}
+
+		if err := h.applyPolicy(ctx, log, pod, policy); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func namespaceMatches(namespaceSelector *metav1.LabelSelector, namespace *corev1.Namespace) (bool, error) {
+	if namespaceSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing namespace selector: %w", err)
+	}
+
+	return selector.Matches(labels.Set(namespace.Labels)), nil
+}
+
+// applyPolicy applies the given policy to the pod. Fields which are already specified by the pod are not overwritten.
+// The RuntimeClass and Priority admission plugins of the kube-apiserver run before mutating webhooks, hence the
+// handler has to resolve the referenced RuntimeClass and PriorityClass itself.
+func (h *Handler) applyPolicy(ctx context.Context, log logr.Logger, pod *corev1.Pod, policy config.PodPolicy) error {
+	if policy.RuntimeClassName != nil && pod.Spec.RuntimeClassName == nil {
+		runtimeClass := &nodev1.RuntimeClass{}
+		if err := h.TargetReader.Get(ctx, client.ObjectKey{Name: *policy.RuntimeClassName}, runtimeClass); err != nil {
+			return fmt.Errorf("failed reading RuntimeClass %s: %w", *policy.RuntimeClassName, err)
+		}
+
+		log.Info("Setting runtime class name", "runtimeClassName", runtimeClass.Name)
+		setRuntimeClass(pod, runtimeClass)
+	}
+
+	if policy.SeccompProfile != nil && (pod.Spec.SecurityContext == nil || pod.Spec.SecurityContext.SeccompProfile == nil) {
+		if pod.Spec.SecurityContext == nil {
+			pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+		}
+
+		log.Info("Setting seccomp profile", "seccompProfileType", policy.SeccompProfile.Type)
+		pod.Spec.SecurityContext.SeccompProfile = policy.SeccompProfile.DeepCopy()
+	}
+
+	if policy.PriorityClassName != nil && pod.Spec.PriorityClassName == "" {
+		priorityClass := &schedulingv1.PriorityClass{}
+		if err := h.TargetReader.Get(ctx, client.ObjectKey{Name: *policy.PriorityClassName}, priorityClass); err != nil {
+			return fmt.Errorf("failed reading PriorityClass %s: %w", *policy.PriorityClassName, err)
+		}
+
+		log.Info("Setting priority class name", "priorityClassName", priorityClass.Name)
+		pod.Spec.PriorityClassName = priorityClass.Name
+		pod.Spec.Priority = &priorityClass.Value
+		pod.Spec.PreemptionPolicy = priorityClass.PreemptionPolicy
+	}
+
+	return nil
+}
+
+func setRuntimeClass(pod *corev1.Pod, runtimeClass *nodev1.RuntimeClass) {
+	pod.Spec.RuntimeClassName = &runtimeClass.Name
+
+	if runtimeClass.Overhead != nil && pod.Spec.Overhead == nil {
+		pod.Spec.Overhead = runtimeClass.Overhead.PodFixed.DeepCopy()
+	}
+
+	if runtimeClass.Scheduling == nil {
+		return
+	}
+
+	for key, value := range runtimeClass.Scheduling.NodeSelector {
+		if _, ok := pod.Spec.NodeSelector[key]; ok {
+			continue
+		}
+		if pod.Spec.NodeSelector == nil {
+			pod.Spec.NodeSelector = make(map[string]string, len(runtimeClass.Scheduling.NodeSelector))
+		}
+		pod.Spec.NodeSelector[key] = value
+	}
+
+	for _, toleration := range runtimeClass.Scheduling.Tolerations {
+		if !hasToleration(pod.Spec.Tolerations, toleration) {
+			pod.Spec.Tolerations = append(pod.Spec.Tolerations, toleration)
+		}
+	}
+}
+
+func hasToleration(tolerations []corev1.Toleration, toleration corev1.Toleration) bool {
+	for _, t := range tolerations {
+		if t.MatchToleration(&toleration) {
+			return true
+		}
+	}
+	return false
+}
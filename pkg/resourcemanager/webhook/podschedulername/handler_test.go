@@ -7,10 +7,23 @@ package podschedulername_test
 import (
 	"context"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
 )
 
@@ -42,5 +55,97 @@ var _ = Describe("Handler", func() {
 			Expect(handler.Default(ctx, pod)).To(Succeed())
 			Expect(pod.Spec.SchedulerName).To(Equal(handler.SchedulerName))
 		})
+
+		Context("policies", func() {
+			var (
+				fakeClient    client.Client
+				namespace     *corev1.Namespace
+				runtimeClass  *nodev1.RuntimeClass
+				priorityClass *schedulingv1.PriorityClass
+			)
+
+			BeforeEach(func() {
+				namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{"gardener.cloud/role": "shoot"}}}
+				runtimeClass = &nodev1.RuntimeClass{
+					ObjectMeta: metav1.ObjectMeta{Name: "gvisor"},
+					Handler:    "runsc",
+					Overhead:   &nodev1.Overhead{PodFixed: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")}},
+					Scheduling: &nodev1.Scheduling{
+						NodeSelector: map[string]string{"runtime": "gvisor", "foo": "baz"},
+						Tolerations:  []corev1.Toleration{{Key: "runtime", Operator: corev1.TolerationOpEqual, Value: "gvisor", Effect: corev1.TaintEffectNoSchedule}},
+					},
+				}
+				priorityClass = &schedulingv1.PriorityClass{
+					ObjectMeta:       metav1.ObjectMeta{Name: "high"},
+					Value:            1000,
+					PreemptionPolicy: ptr.To(corev1.PreemptNever),
+				}
+
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(namespace, runtimeClass, priorityClass).Build()
+
+				handler.Logger = logr.Discard()
+				handler.TargetReader = fakeClient
+				handler.Policies = []config.PodPolicy{
+					{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"gardener.cloud/role": "shoot"}},
+						RuntimeClassName:  ptr.To(runtimeClass.Name),
+						PriorityClassName: ptr.To(priorityClass.Name),
+					},
+					{
+						SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+					},
+				}
+
+				pod.Spec.NodeSelector = map[string]string{"foo": "bar"}
+				pod.Spec.Priority = ptr.To[int32](0)
+				ctx = admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Namespace: namespace.Name}})
+			})
+
+			It("should apply the policies matching the namespace", func() {
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.RuntimeClassName).To(PointTo(Equal(runtimeClass.Name)))
+				Expect(pod.Spec.Overhead).To(Equal(runtimeClass.Overhead.PodFixed))
+				Expect(pod.Spec.NodeSelector).To(Equal(map[string]string{"foo": "bar", "runtime": "gvisor"}))
+				Expect(pod.Spec.Tolerations).To(Equal(runtimeClass.Scheduling.Tolerations))
+
+				Expect(pod.Spec.PriorityClassName).To(Equal(priorityClass.Name))
+				Expect(pod.Spec.Priority).To(PointTo(Equal(priorityClass.Value)))
+				Expect(pod.Spec.PreemptionPolicy).To(PointTo(Equal(corev1.PreemptNever)))
+
+				Expect(pod.Spec.SecurityContext.SeccompProfile).To(Equal(&corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}))
+			})
+
+			It("should not apply policies whose namespace selector does not match", func() {
+				namespace.Labels = nil
+				Expect(fakeClient.Update(ctx, namespace)).To(Succeed())
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.RuntimeClassName).To(BeNil())
+				Expect(pod.Spec.PriorityClassName).To(BeEmpty())
+				Expect(pod.Spec.SecurityContext.SeccompProfile).To(Equal(&corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}))
+			})
+
+			It("should not overwrite fields specified by the pod", func() {
+				pod.Spec.RuntimeClassName = ptr.To("runc")
+				pod.Spec.PriorityClassName = "low"
+				pod.Spec.SecurityContext = &corev1.PodSecurityContext{SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}}
+
+				Expect(handler.Default(ctx, pod)).To(Succeed())
+
+				Expect(pod.Spec.RuntimeClassName).To(PointTo(Equal("runc")))
+				Expect(pod.Spec.Overhead).To(BeNil())
+				Expect(pod.Spec.PriorityClassName).To(Equal("low"))
+				Expect(pod.Spec.Priority).To(PointTo(BeEquivalentTo(0)))
+				Expect(pod.Spec.SecurityContext.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeUnconfined))
+			})
+
+			It("should fail if the referenced RuntimeClass does not exist", func() {
+				Expect(fakeClient.Delete(ctx, runtimeClass)).To(Succeed())
+
+				Expect(handler.Default(ctx, pod)).To(MatchError(ContainSubstring("failed reading RuntimeClass gvisor")))
+			})
+		})
 	})
 })
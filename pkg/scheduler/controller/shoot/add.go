@@ -45,12 +45,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		Complete(r)
 }
 
-// ShootPredicate is a predicate that returns true if a shoot is not assigned to a seed
-// and the default scheduler is configured.
+// ShootPredicate is a predicate that returns true if a shoot is not assigned to a seed or must be rescheduled to
+// another seed, and the default scheduler is configured.
 func (r *Reconciler) ShootPredicate() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
-			return shoot.Spec.SeedName == nil &&
+			return (shoot.Spec.SeedName == nil || shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true") &&
 				ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName
 		}
 		return false
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
 )
 
@@ -74,6 +75,20 @@ var _ = Describe("Add", func() {
 			})
 		})
 
+		Context("shoot is assigned and must be rescheduled", func() {
+			BeforeEach(func() {
+				shoot.Spec.SeedName = ptr.To("seed")
+				shoot.Annotations = map[string]string{v1beta1constants.AnnotationSchedulingReschedule: "true"}
+			})
+
+			It("should be true", func() {
+				Expect(predicate.Create(createEvent)).To(BeTrue())
+				Expect(predicate.Update(updateEvent)).To(BeTrue())
+				Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+				Expect(predicate.Generic(genericEvent)).To(BeTrue())
+			})
+		})
+
 		Context("shoot defines schedulerName", func() {
 			Context("default-scheduler", func() {
 				BeforeEach(func() {
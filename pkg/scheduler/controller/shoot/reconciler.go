@@ -56,6 +56,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if shoot.Spec.SeedName != nil {
+		if shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true" {
+			return r.reschedule(ctx, log, shoot)
+		}
+
 		log.Info("Shoot already scheduled onto seed, nothing left to do", "seed", *shoot.Spec.SeedName)
 		return reconcile.Result{}, nil
 	}
@@ -90,6 +94,58 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, nil
 }
 
+// reschedule moves the shoot away from its current seed if this seed is being decommissioned. The actual control plane
+// migration is performed by the gardenlets once the new seed has been bound.
+func (r *Reconciler) reschedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
+	currentSeedName := *shoot.Spec.SeedName
+	log = log.WithValues("currentSeed", currentSeedName)
+
+	currentSeed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: currentSeedName}, currentSeed); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed reading current seed %s: %w", currentSeedName, err)
+		}
+		currentSeed = nil
+	}
+
+	if shoot.DeletionTimestamp != nil || !v1beta1helper.IsSeedDecommissioning(currentSeed) {
+		log.Info("Shoot does not need to be rescheduled, removing annotation")
+		return reconcile.Result{}, r.removeRescheduleAnnotation(ctx, shoot)
+	}
+
+	seed, err := r.determineSeed(ctx, log, shoot)
+	if err != nil {
+		r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, "Failed to reschedule Shoot away from decommissioned seed '%s': %s", currentSeedName, err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to determine seed for rescheduling shoot: %w", err)
+	}
+
+	shoot.Spec.SeedName = &seed.Name
+	if err = r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, "Failed to reschedule Shoot away from decommissioned seed '%s': %s", currentSeedName, err.Error())
+		return reconcile.Result{}, fmt.Errorf("failed to bind shoot to seed: %w", err)
+	}
+
+	log.Info(
+		"Shoot successfully rescheduled to seed",
+		"cloudprofile", shoot.Spec.CloudProfileName,
+		"region", shoot.Spec.Region,
+		"seed", seed.Name,
+		"strategy", r.Config.Strategy,
+	)
+
+	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Rescheduled from decommissioned seed '%s' to seed '%s'", currentSeedName, seed.Name)
+	return reconcile.Result{}, r.removeRescheduleAnnotation(ctx, shoot)
+}
+
+func (r *Reconciler) removeRescheduleAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingReschedule)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing reschedule annotation: %w", err)
+	}
+	return nil
+}
+
 func (r *Reconciler) reportFailedScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, err error) {
 	description := "Failed to schedule Shoot: " + err.Error()
 	r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, description)
@@ -144,6 +200,12 @@ func (r *Reconciler) determineSeed(
 	if err != nil {
 		return nil, err
 	}
+	if shoot.Spec.SeedName != nil {
+		filteredSeeds, err = filterSeedsForControlPlaneMigration(seedList.Items, filteredSeeds, *shoot.Spec.SeedName)
+		if err != nil {
+			return nil, err
+		}
+	}
 	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
 	if err != nil {
 		return nil, err
@@ -160,6 +222,10 @@ func (r *Reconciler) determineSeed(
 	if err != nil {
 		return nil, err
 	}
+	filteredSeeds, err = filterSeedsForPrivateLink(filteredSeeds, shoot)
+	if err != nil {
+		return nil, err
+	}
 	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
 	if err != nil {
 		return nil, err
@@ -200,7 +266,7 @@ func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cl
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return seed.DeletionTimestamp == nil && !v1beta1helper.IsSeedDecommissioning(seed) && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
 }
 
 func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
@@ -213,7 +279,38 @@ func filterUsableSeeds(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.S
 	}
 
 	if len(matchingSeeds) == 0 {
-		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
+		return nil, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, not decommissioning, visible and ready)", len(seedList))
+	}
+	return matchingSeeds, nil
+}
+
+// filterSeedsForControlPlaneMigration filters seeds which are eligible as target of a control plane migration away
+// from the seed with the given name, i.e., seeds with a backup configuration and the same provider type.
+func filterSeedsForControlPlaneMigration(allSeeds, seedList []gardencorev1beta1.Seed, currentSeedName string) ([]gardencorev1beta1.Seed, error) {
+	var currentSeed *gardencorev1beta1.Seed
+	for _, seed := range allSeeds {
+		if seed.Name == currentSeedName {
+			currentSeed = seed.DeepCopy()
+			break
+		}
+	}
+
+	if currentSeed == nil {
+		return nil, fmt.Errorf("current seed %q of the shoot was not found", currentSeedName)
+	}
+	if currentSeed.Spec.Backup == nil {
+		return nil, fmt.Errorf("cannot migrate control plane because backup is not configured for current seed %q", currentSeedName)
+	}
+
+	var matchingSeeds []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if seed.Name != currentSeedName && seed.Spec.Backup != nil && seed.Spec.Provider.Type == currentSeed.Spec.Provider.Type {
+			matchingSeeds = append(matchingSeeds, seed)
+		}
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, fmt.Errorf("none out of the %d seeds has a backup configuration and the same provider type %q as the current seed %q", len(seedList), currentSeed.Spec.Provider.Type, currentSeedName)
 	}
 	return matchingSeeds, nil
 }
@@ -277,6 +374,24 @@ func filterSeedsForZonalShootControlPlanes(seedList []gardencorev1beta1.Seed, sh
 	return seedList, nil
 }
 
+// filterSeedsForPrivateLink filters seeds supporting private link connectivity in case the shoot enables it.
+func filterSeedsForPrivateLink(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Seed, error) {
+	if !v1beta1helper.ShootEnablesPrivateLink(shoot) {
+		return seedList, nil
+	}
+
+	var seedsSupportingPrivateLink []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if v1beta1helper.SeedSettingPrivateLinkEnabled(seed.Spec.Settings) {
+			seedsSupportingPrivateLink = append(seedsSupportingPrivateLink, seed)
+		}
+	}
+	if len(seedsSupportingPrivateLink) == 0 {
+		return nil, fmt.Errorf("none of the %d seeds supports private link connectivity", len(seedList))
+	}
+	return seedsSupportingPrivateLink, nil
+}
+
 func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, strategy config.CandidateDeterminationStrategy, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
@@ -501,6 +616,16 @@ func networksAreDisjointed(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta
 		errorMessages = append(errorMessages, e.ErrorBody())
 	}
 
+	for _, e := range cidrvalidation.ValidateAdditionalNodeNetworksDisjointedness(
+		field.NewPath(""),
+		shoot.Spec.Networking.AdditionalNodes,
+		seed.Spec.Networks.Nodes,
+		seed.Spec.Networks.Pods,
+		seed.Spec.Networks.Services,
+	) {
+		errorMessages = append(errorMessages, e.ErrorBody())
+	}
+
 	return len(errorMessages) == 0, fmt.Errorf("invalid networks: %s", errorMessages)
 }
 
@@ -10,16 +10,21 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
@@ -191,6 +196,26 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
 
+		It("should find a seed cluster supporting private link for a shoot with private link enabled", func() {
+			secondSeed := seedBase
+			secondSeed.Name = "seed-private-link"
+			secondSeed.Spec.Settings = &gardencorev1beta1.SeedSettings{
+				Scheduling:  &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				PrivateLink: &gardencorev1beta1.SeedSettingPrivateLink{Enabled: true},
+			}
+
+			shoot.Spec.Networking.PrivateLink = &gardencorev1beta1.PrivateLink{Enabled: true}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
 		// FAIL
 
 		It("should fail because it cannot find a seed cluster 1) 'Same Region' seed determination strategy 2) region that no seed supports", func() {
@@ -222,6 +247,18 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster supporting private link for a shoot with private link enabled", func() {
+			shoot.Spec.Networking.PrivateLink = &gardencorev1beta1.PrivateLink{Enabled: true}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError("none of the 1 seeds supports private link connectivity"))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail when the only available seed has < 3 zones for a shoot with failure tolerance type 'zone'", func() {
 			seed.Spec.Provider.Zones = []string{"1", "2"}
 
@@ -672,6 +709,17 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to network disjointedness of additional node networks", func() {
+			shoot.Spec.Networking.AdditionalNodes = []string{*seed.Spec.Networks.Nodes}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("shoot node network intersects with seed node network")))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to non-tolerated taints", func() {
 			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
 			shoot.Spec.Tolerations = nil
@@ -794,6 +842,17 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to decommissioning", func() {
+			seed.Spec.Operation = ptr.To(gardencorev1beta1.SeedOperationDecommission)
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("not decommissioning")))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to invisibility", func() {
 			seed.Spec.Settings = &gardencorev1beta1.SeedSettings{
 				Scheduling: &gardencorev1beta1.SeedSettingScheduling{
@@ -810,6 +869,110 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("RESCHEDULING - Shoot references a Seed which is being decommissioned", func() {
+		var targetSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			fakeGardenClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+						if subResourceName == "binding" {
+							return c.Update(ctx, obj)
+						}
+						return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+					},
+				}).
+				Build()
+
+			cloudProfile = cloudProfileBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			seed = seedBase.DeepCopy()
+			seed.Spec.Backup = &gardencorev1beta1.SeedBackup{Provider: providerType}
+			seed.Spec.Operation = ptr.To(gardencorev1beta1.SeedOperationDecommission)
+			seed.Status.Conditions = append(seed.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue})
+
+			targetSeed = seed.DeepCopy()
+			targetSeed.Name = "seed-2"
+			targetSeed.Spec.Operation = nil
+
+			shoot = shootBase.DeepCopy()
+			shoot.Spec.SeedName = ptr.To(seed.Name)
+			shoot.Annotations = map[string]string{v1beta1constants.AnnotationSchedulingReschedule: "true"}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+		})
+
+		It("should find a target seed with backup and the same provider type", func() {
+			seedWithoutBackup := targetSeed.DeepCopy()
+			seedWithoutBackup.Name = "seed-3"
+			seedWithoutBackup.Spec.Backup = nil
+
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seedWithoutBackup)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(targetSeed.Name))
+		})
+
+		It("should fail because no other seed has a backup configuration", func() {
+			targetSeed.Spec.Backup = nil
+
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("has a backup configuration and the same provider type")))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fail because the current seed has no backup configuration", func() {
+			seed.Spec.Backup = nil
+
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("backup is not configured for current seed")))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should only remove the annotation if the current seed is not being decommissioned", func() {
+			seed.Spec.Operation = nil
+
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal(seed.Name)))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+		})
+
+		It("should reschedule the shoot and remove the annotation", func() {
+			recorder := record.NewFakeRecorder(1)
+			reconciler.Recorder = recorder
+
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, targetSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal(targetSeed.Name)))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationSchedulingReschedule))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Rescheduled from decommissioned seed 'seed-1' to seed 'seed-2'")))
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
@@ -12,5 +12,7 @@ import (
 
 // RegisterFeatureGates registers the feature gates of gardener-scheduler.
 func RegisterFeatureGates() {
-	utilruntime.Must(features.DefaultFeatureGate.Add(features.GetFeatures()))
+	utilruntime.Must(features.DefaultFeatureGate.Add(features.GetFeatures(
+		features.MutationAttribution,
+	)))
 }
@@ -13,6 +13,10 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
@@ -21,6 +25,8 @@ import (
 const (
 	logKeyFlow = "flow"
 	logKeyTask = "task"
+
+	tracerName = "github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // ErrorCleaner is called when a task which errored during the previous reconciliation phase completes with success
@@ -97,6 +103,9 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// TracerProvider is used to emit spans for the flow and each of its tasks. If not set, the globally registered
+	// tracer provider is used (which is a no-op provider unless tracing was enabled).
+	TracerProvider trace.TracerProvider
 }
 
 // Run starts an execution of a Flow.
@@ -169,6 +178,11 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	tracerProvider := opts.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
@@ -177,6 +191,7 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		tracerProvider.Tracer(tracerName),
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -192,6 +207,7 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	tracer           trace.Tracer
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -222,17 +238,25 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	go func() {
 		start := time.Now().UTC()
 
+		taskCtx, span := e.tracer.Start(ctx, string(id), trace.WithAttributes(
+			attribute.String(logKeyFlow, e.flow.name),
+			attribute.String(logKeyTask, string(id)),
+		))
+
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		err := node.fn(taskCtx)
 		end := time.Now().UTC()
 		log.V(1).Info("Finished", "duration", end.Sub(start))
 
 		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			log.Error(err, "Error")
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
 		}
+		span.End()
 
 		e.done <- &nodeResult{TaskID: id, Error: err}
 	}()
@@ -270,9 +294,18 @@ func (e *execution) reportProgress(ctx context.Context) {
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	defer close(e.done)
 
+	ctx, span := e.tracer.Start(ctx, e.flow.name, trace.WithAttributes(attribute.String(logKeyFlow, e.flow.name)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -11,6 +11,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
@@ -147,6 +150,43 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		It("should emit a span for the flow and each executed task", func() {
+			var (
+				recorder       = tracetest.NewSpanRecorder()
+				tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+				err1 = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				f = g.Compile()
+			)
+			DeferCleanup(func() { Expect(tracerProvider.Shutdown(ctx)).To(Succeed()) })
+
+			Expect(f.Run(ctx, flow.Opts{TracerProvider: tracerProvider})).NotTo(Succeed())
+
+			spans := make(map[string]sdktrace.ReadOnlySpan)
+			for _, span := range recorder.Ended() {
+				spans[span.Name()] = span
+			}
+			Expect(spans).To(HaveLen(3))
+			Expect(spans).To(HaveKey("foo"))
+			Expect(spans).NotTo(HaveKey("z"))
+
+			flowSpan := spans["foo"]
+			Expect(flowSpan.Parent().IsValid()).To(BeFalse())
+			Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+
+			Expect(spans["x"].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+			Expect(spans["x"].Status().Code).To(Equal(codes.Unset))
+
+			Expect(spans["y"].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+			Expect(spans["y"].Status().Code).To(Equal(codes.Error))
+			Expect(spans["y"].Status().Description).To(ContainSubstring("err1"))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// ImageReferences returns the references of all container images in the given image vector which might be used by
+// components running on a seed with the given Kubernetes version and targeting one of the given Kubernetes versions.
+func ImageReferences(vector imagevector.ImageVector, seedVersion string, targetVersions ...string) ([]string, error) {
+	references := sets.New[string]()
+
+	for _, targetVersion := range targetVersions {
+		images, err := imagevector.FindAllImages(vector, imagevector.RuntimeVersion(seedVersion), imagevector.TargetVersion(targetVersion))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, image := range images {
+			references.Insert(image.String())
+		}
+	}
+
+	return sets.List(references), nil
+}
+
+// AddonChartReferences returns the references of the Helm charts of the addons configured for the given shoot.
+func AddonChartReferences(shoot *gardencorev1beta1.Shoot) []string {
+	if shoot.Spec.Addons == nil {
+		return nil
+	}
+
+	references := make([]string, 0, len(shoot.Spec.Addons.Charts))
+	for _, chart := range shoot.Spec.Addons.Charts {
+		references = append(references, (&gardencorev1.OCIRepository{
+			Ref:        chart.OCIRepository.Ref,
+			Repository: chart.OCIRepository.Repository,
+			Tag:        chart.OCIRepository.Tag,
+			Digest:     chart.OCIRepository.Digest,
+		}).GetURL())
+	}
+
+	return references
+}
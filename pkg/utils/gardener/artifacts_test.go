@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("Artifacts", func() {
+	Describe("#ImageReferences", func() {
+		vector := imagevector.ImageVector{
+			{Name: "foo", Repository: "registry.example.com/foo", Tag: ptr.To("v1")},
+			{Name: "bar", Repository: "registry.example.com/bar", Tag: ptr.To("v1"), TargetVersion: ptr.To("< 1.30")},
+			{Name: "bar", Repository: "registry.example.com/bar", Tag: ptr.To("v2"), TargetVersion: ptr.To(">= 1.30")},
+			{Name: "baz", Repository: "registry.example.com/baz", RuntimeVersion: ptr.To("< 1.29")},
+			{Name: "hyperkube", Repository: "registry.example.com/hyperkube"},
+		}
+
+		It("should return the images for all target versions", func() {
+			Expect(ImageReferences(vector, "1.30.0", "1.29.3", "1.30.1")).To(Equal([]string{
+				"registry.example.com/bar:v1",
+				"registry.example.com/bar:v2",
+				"registry.example.com/foo:v1",
+				"registry.example.com/hyperkube:v1.29.3",
+				"registry.example.com/hyperkube:v1.30.1",
+			}))
+		})
+
+		It("should return no images without target versions", func() {
+			Expect(ImageReferences(vector, "1.30.0")).To(BeEmpty())
+		})
+	})
+
+	Describe("#AddonChartReferences", func() {
+		It("should return nil if no addons are configured", func() {
+			Expect(AddonChartReferences(&gardencorev1beta1.Shoot{})).To(BeNil())
+		})
+
+		It("should return the references of the addon charts", func() {
+			shoot := &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Addons: &gardencorev1beta1.Addons{
+						Charts: []gardencorev1beta1.AddonChart{
+							{Name: "foo", OCIRepository: gardencorev1beta1.OCIRepository{Ref: ptr.To("oci://registry.example.com/charts/foo:1.0.0")}},
+							{Name: "bar", OCIRepository: gardencorev1beta1.OCIRepository{Repository: ptr.To("registry.example.com/charts/bar"), Tag: ptr.To("2.0.0")}},
+						},
+					},
+				},
+			}
+
+			Expect(AddonChartReferences(shoot)).To(Equal([]string{
+				"registry.example.com/charts/foo:1.0.0",
+				"registry.example.com/charts/bar:2.0.0",
+			}))
+		})
+	})
+})
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+var (
+	// ConfigurableShootCareConditionTypes are the types of the care conditions of Shoots whose thresholds, stale
+	// durations, and severities can be overridden via annotations.
+	ConfigurableShootCareConditionTypes = sets.New(
+		gardencorev1beta1.ShootAPIServerAvailable,
+		gardencorev1beta1.ShootControlPlaneHealthy,
+		gardencorev1beta1.ShootObservabilityComponentsHealthy,
+		gardencorev1beta1.ShootSystemComponentsHealthy,
+		gardencorev1beta1.ShootEveryNodeReady,
+	)
+	// CareConditionSeverities are the supported severities of care conditions.
+	CareConditionSeverities = sets.New(
+		v1beta1constants.CareConditionSeverityError,
+		v1beta1constants.CareConditionSeverityWarning,
+	)
+)
+
+// ParseCareConditionDurations parses the value of the care.gardener.cloud/condition-thresholds and
+// care.gardener.cloud/condition-stale-durations annotations, i.e., a comma-separated list of `<type>=<duration>`.
+func ParseCareConditionDurations(value string) (map[gardencorev1beta1.ConditionType]time.Duration, error) {
+	values, err := parseCareConditionValues(value)
+	if err != nil {
+		return nil, err
+	}
+
+	durations := make(map[gardencorev1beta1.ConditionType]time.Duration, len(values))
+	for conditionType, v := range values {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration for condition type %q: %w", conditionType, err)
+		}
+		if duration < 0 {
+			return nil, fmt.Errorf("duration for condition type %q must not be negative", conditionType)
+		}
+		durations[conditionType] = duration
+	}
+
+	return durations, nil
+}
+
+// ParseCareConditionSeverities parses the value of the care.gardener.cloud/condition-severities annotation, i.e., a
+// comma-separated list of `<type>=<severity>`.
+func ParseCareConditionSeverities(value string) (map[gardencorev1beta1.ConditionType]string, error) {
+	values, err := parseCareConditionValues(value)
+	if err != nil {
+		return nil, err
+	}
+
+	for conditionType, severity := range values {
+		if !CareConditionSeverities.Has(severity) {
+			return nil, fmt.Errorf("unsupported severity %q for condition type %q, supported severities are %s", severity, conditionType, strings.Join(sets.List(CareConditionSeverities), ", "))
+		}
+	}
+
+	return values, nil
+}
+
+func parseCareConditionValues(value string) (map[gardencorev1beta1.ConditionType]string, error) {
+	values := map[gardencorev1beta1.ConditionType]string{}
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, v, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("entry %q must have the format <type>=<value>", entry)
+		}
+
+		conditionType := gardencorev1beta1.ConditionType(strings.TrimSpace(key))
+		if !ConfigurableShootCareConditionTypes.Has(conditionType) {
+			return nil, fmt.Errorf("unsupported condition type %q, supported types are %s", conditionType, strings.Join(conditionTypesToStrings(sets.List(ConfigurableShootCareConditionTypes)), ", "))
+		}
+		if _, ok := values[conditionType]; ok {
+			return nil, fmt.Errorf("duplicate condition type %q", conditionType)
+		}
+
+		values[conditionType] = strings.TrimSpace(v)
+	}
+
+	return values, nil
+}
+
+func conditionTypesToStrings(conditionTypes []gardencorev1beta1.ConditionType) []string {
+	out := make([]string, 0, len(conditionTypes))
+	for _, conditionType := range conditionTypes {
+		out = append(out, string(conditionType))
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Care", func() {
+	Describe("#ParseCareConditionDurations", func() {
+		It("should parse the durations", func() {
+			Expect(ParseCareConditionDurations("APIServerAvailable=1m, EveryNodeReady = 10m,")).To(Equal(map[gardencorev1beta1.ConditionType]time.Duration{
+				gardencorev1beta1.ShootAPIServerAvailable: time.Minute,
+				gardencorev1beta1.ShootEveryNodeReady:     10 * time.Minute,
+			}))
+		})
+
+		It("should return an empty map for an empty value", func() {
+			Expect(ParseCareConditionDurations("")).To(BeEmpty())
+		})
+
+		It("should fail for entries without separator", func() {
+			_, err := ParseCareConditionDurations("APIServerAvailable")
+			Expect(err).To(MatchError(`entry "APIServerAvailable" must have the format <type>=<value>`))
+		})
+
+		It("should fail for unsupported condition types", func() {
+			_, err := ParseCareConditionDurations("ObservabilityProbesHealthy=1m")
+			Expect(err).To(MatchError(ContainSubstring(`unsupported condition type "ObservabilityProbesHealthy"`)))
+		})
+
+		It("should fail for duplicate condition types", func() {
+			_, err := ParseCareConditionDurations("EveryNodeReady=1m,EveryNodeReady=2m")
+			Expect(err).To(MatchError(`duplicate condition type "EveryNodeReady"`))
+		})
+
+		It("should fail for invalid durations", func() {
+			_, err := ParseCareConditionDurations("EveryNodeReady=foo")
+			Expect(err).To(MatchError(ContainSubstring(`invalid duration for condition type "EveryNodeReady"`)))
+		})
+
+		It("should fail for negative durations", func() {
+			_, err := ParseCareConditionDurations("EveryNodeReady=-1m")
+			Expect(err).To(MatchError(`duration for condition type "EveryNodeReady" must not be negative`))
+		})
+	})
+
+	Describe("#ParseCareConditionSeverities", func() {
+		It("should parse the severities", func() {
+			Expect(ParseCareConditionSeverities("SystemComponentsHealthy=Warning,ControlPlaneHealthy=Error")).To(Equal(map[gardencorev1beta1.ConditionType]string{
+				gardencorev1beta1.ShootSystemComponentsHealthy: "Warning",
+				gardencorev1beta1.ShootControlPlaneHealthy:     "Error",
+			}))
+		})
+
+		It("should fail for unsupported severities", func() {
+			_, err := ParseCareConditionSeverities("SystemComponentsHealthy=Info")
+			Expect(err).To(MatchError(`unsupported severity "Info" for condition type "SystemComponentsHealthy", supported severities are Error, Warning`))
+		})
+	})
+})
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// ConfigurableShootCertificateTypes are the types of the certificates of Shoots whose validities can be overridden via
+// annotations.
+var ConfigurableShootCertificateTypes = sets.New(secretsutils.ServerCert, secretsutils.ClientCert)
+
+// ParseCertificateValidities parses the value of the shoot.gardener.cloud/certificate-validities annotation, i.e., a
+// comma-separated list of `<type>=<duration>`.
+func ParseCertificateValidities(value string) (map[secretsutils.CertType]time.Duration, error) {
+	validities := map[secretsutils.CertType]time.Duration{}
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, v, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("entry %q must have the format <type>=<duration>", entry)
+		}
+
+		certType := secretsutils.CertType(strings.TrimSpace(key))
+		if !ConfigurableShootCertificateTypes.Has(certType) {
+			return nil, fmt.Errorf("unsupported certificate type %q, supported types are %s", certType, strings.Join(certTypesToStrings(sets.List(ConfigurableShootCertificateTypes)), ", "))
+		}
+		if _, ok := validities[certType]; ok {
+			return nil, fmt.Errorf("duplicate certificate type %q", certType)
+		}
+
+		duration, err := time.ParseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration for certificate type %q: %w", certType, err)
+		}
+		if duration <= secretsmanager.RenewBeforeExpiration {
+			return nil, fmt.Errorf("duration for certificate type %q must be longer than %s", certType, secretsmanager.RenewBeforeExpiration)
+		}
+
+		validities[certType] = duration
+	}
+
+	return validities, nil
+}
+
+func certTypesToStrings(certTypes []secretsutils.CertType) []string {
+	out := make([]string, 0, len(certTypes))
+	for _, certType := range certTypes {
+		out = append(out, string(certType))
+	}
+	return out
+}
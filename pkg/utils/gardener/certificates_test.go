@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Certificates", func() {
+	Describe("#ParseCertificateValidities", func() {
+		It("should parse the validities", func() {
+			Expect(ParseCertificateValidities("server=720h, client = 360h,")).To(Equal(map[secretsutils.CertType]time.Duration{
+				secretsutils.ServerCert: 720 * time.Hour,
+				secretsutils.ClientCert: 360 * time.Hour,
+			}))
+		})
+
+		It("should return an empty map for an empty value", func() {
+			Expect(ParseCertificateValidities("")).To(BeEmpty())
+		})
+
+		It("should fail for entries without separator", func() {
+			_, err := ParseCertificateValidities("server")
+			Expect(err).To(MatchError(`entry "server" must have the format <type>=<duration>`))
+		})
+
+		It("should fail for unsupported certificate types", func() {
+			_, err := ParseCertificateValidities("ca=720h")
+			Expect(err).To(MatchError(`unsupported certificate type "ca", supported types are client, server`))
+		})
+
+		It("should fail for duplicate certificate types", func() {
+			_, err := ParseCertificateValidities("server=720h,server=360h")
+			Expect(err).To(MatchError(`duplicate certificate type "server"`))
+		})
+
+		It("should fail for invalid durations", func() {
+			_, err := ParseCertificateValidities("client=foo")
+			Expect(err).To(MatchError(ContainSubstring(`invalid duration for certificate type "client"`)))
+		})
+
+		It("should fail for too short durations", func() {
+			_, err := ParseCertificateValidities("client=240h")
+			Expect(err).To(MatchError(`duration for certificate type "client" must be longer than 240h0m0s`))
+		})
+	})
+})
@@ -182,6 +182,14 @@ func ReadGardenSecrets(
 			logInfo = append(logInfo, fmt.Sprintf("monitoring basic auth secret %q", secret.Name))
 		}
 
+		// Retrieving additional system components which shall be deployed into all shoot clusters with a label
+		// indicating the Garden role shoot-system-component.
+		if secret.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleShootSystemComponent {
+			systemComponentSecret := secret
+			secretsMap[fmt.Sprintf("%s-%s", v1beta1constants.GardenRoleShootSystemComponent, secret.Name)] = &systemComponentSecret
+			logInfo = append(logInfo, fmt.Sprintf("shoot system component secret %q", secret.Name))
+		}
+
 		if secret.Labels[v1beta1constants.GardenRole] == v1beta1constants.GardenRoleShootServiceAccountIssuer {
 			shootIssuer := secret
 			if hostname, ok := secret.Data["hostname"]; !ok {
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// SeedImageVectorOverride computes the image vector override which applies to all components deployed by the gardenlet
+// of the given seed. It consists of the images of all ImageVectorOverrides without shoot selector whose seed selector
+// matches the seed. ImageVectorOverrides are applied in the order of their names.
+func SeedImageVectorOverride(overrides []gardencorev1beta1.ImageVectorOverride, seed *gardencorev1beta1.Seed) (imagevector.ImageVector, error) {
+	return imageVectorOverride(overrides, func(override gardencorev1beta1.ImageVectorOverride) (bool, error) {
+		if override.Spec.ShootSelector != nil {
+			return false, nil
+		}
+		return selectorMatches(override.Spec.SeedSelector, seed.Labels)
+	})
+}
+
+// ShootImageVectorOverride computes the image vector override which applies to the components of the given shoot on
+// top of the seed image vector override. It consists of the images of all ImageVectorOverrides whose shoot selector
+// matches the shoot and whose seed selector is either not set or matches the seed. ImageVectorOverrides are applied in
+// the order of their names.
+func ShootImageVectorOverride(overrides []gardencorev1beta1.ImageVectorOverride, seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) (imagevector.ImageVector, error) {
+	return imageVectorOverride(overrides, func(override gardencorev1beta1.ImageVectorOverride) (bool, error) {
+		if override.Spec.ShootSelector == nil {
+			return false, nil
+		}
+		if matches, err := selectorMatches(override.Spec.SeedSelector, seed.Labels); err != nil || !matches {
+			return false, err
+		}
+		return selectorMatches(override.Spec.ShootSelector, shoot.Labels)
+	})
+}
+
+func imageVectorOverride(overrides []gardencorev1beta1.ImageVectorOverride, matches func(gardencorev1beta1.ImageVectorOverride) (bool, error)) (imagevector.ImageVector, error) {
+	var selected []gardencorev1beta1.ImageVectorOverride
+	for _, override := range overrides {
+		ok, err := matches(override)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking whether ImageVectorOverride %q applies: %w", override.Name, err)
+		}
+		if ok {
+			selected = append(selected, override)
+		}
+	}
+
+	slices.SortFunc(selected, func(a, b gardencorev1beta1.ImageVectorOverride) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var vectors []imagevector.ImageVector
+	for _, override := range selected {
+		vectors = append(vectors, imageVectorFromImageOverrides(override.Spec.Images))
+	}
+
+	return imagevector.Merge(vectors...), nil
+}
+
+func imageVectorFromImageOverrides(images []gardencorev1beta1.ImageOverride) imagevector.ImageVector {
+	out := make(imagevector.ImageVector, 0, len(images))
+	for _, image := range images {
+		// The image vector does not distinguish between tags and digests, see imagevector.Image.String().
+		tag := image.Tag
+		if image.Digest != nil {
+			tag = image.Digest
+		}
+
+		out = append(out, &imagevector.ImageSource{
+			Name:           image.Name,
+			RuntimeVersion: image.RuntimeVersion,
+			TargetVersion:  image.TargetVersion,
+			Repository:     image.Repository,
+			Tag:            tag,
+		})
+	}
+	return out
+}
+
+func selectorMatches(selector *metav1.LabelSelector, objectLabels map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return s.Matches(labels.Set(objectLabels)), nil
+}
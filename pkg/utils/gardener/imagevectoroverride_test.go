@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("ImageVectorOverride", func() {
+	const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	var (
+		seed      *gardencorev1beta1.Seed
+		shoot     *gardencorev1beta1.Shoot
+		overrides []gardencorev1beta1.ImageVectorOverride
+	)
+
+	BeforeEach(func() {
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed", Labels: map[string]string{"region": "eu"}}}
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Labels: map[string]string{"hotfix": "true"}}}
+
+		overrides = []gardencorev1beta1.ImageVectorOverride{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "b-all-seeds"},
+				Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+					Images: []gardencorev1beta1.ImageOverride{{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v2")}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-eu-seeds"},
+				Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+					Images: []gardencorev1beta1.ImageOverride{
+						{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v1")},
+						{Name: "bar", Repository: "registry/bar", Digest: ptr.To(digest), TargetVersion: ptr.To(">= 1.30")},
+					},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "us-seeds"},
+				Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "us"}},
+					Images:       []gardencorev1beta1.ImageOverride{{Name: "baz", Repository: "registry/baz", Tag: ptr.To("v1")}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "hotfix-shoots"},
+				Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"hotfix": "true"}},
+					Images:        []gardencorev1beta1.ImageOverride{{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v3-hotfix")}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "hotfix-shoots-us"},
+				Spec: gardencorev1beta1.ImageVectorOverrideSpec{
+					SeedSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"region": "us"}},
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"hotfix": "true"}},
+					Images:        []gardencorev1beta1.ImageOverride{{Name: "baz", Repository: "registry/baz", Tag: ptr.To("v2")}},
+				},
+			},
+		}
+	})
+
+	Describe("#SeedImageVectorOverride", func() {
+		It("should merge the images of all overrides for the seed in the order of their names", func() {
+			Expect(SeedImageVectorOverride(overrides, seed)).To(Equal(imagevector.ImageVector{
+				{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v2"), Version: ptr.To("v1")},
+				{Name: "bar", Repository: "registry/bar", Tag: ptr.To(digest), TargetVersion: ptr.To(">= 1.30")},
+			}))
+		})
+
+		It("should return an empty override if no override matches", func() {
+			overrides = overrides[2:]
+
+			Expect(SeedImageVectorOverride(overrides, seed)).To(BeEmpty())
+		})
+
+		It("should return an error for invalid selectors", func() {
+			overrides[0].Spec.SeedSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "Invalid"}}}
+
+			_, err := SeedImageVectorOverride(overrides, seed)
+			Expect(err).To(MatchError(ContainSubstring(`failed checking whether ImageVectorOverride "b-all-seeds" applies`)))
+		})
+	})
+
+	Describe("#ShootImageVectorOverride", func() {
+		It("should merge the images of all overrides for the shoot", func() {
+			Expect(ShootImageVectorOverride(overrides, seed, shoot)).To(Equal(imagevector.ImageVector{
+				{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v3-hotfix")},
+			}))
+		})
+
+		It("should consider the seed selector", func() {
+			seed.Labels["region"] = "us"
+
+			Expect(ShootImageVectorOverride(overrides, seed, shoot)).To(Equal(imagevector.ImageVector{
+				{Name: "foo", Repository: "registry/foo", Tag: ptr.To("v3-hotfix")},
+				{Name: "baz", Repository: "registry/baz", Tag: ptr.To("v2")},
+			}))
+		})
+
+		It("should return an empty override if the shoot is not selected", func() {
+			shoot.Labels = nil
+
+			Expect(ShootImageVectorOverride(overrides, seed, shoot)).To(BeEmpty())
+		})
+	})
+})
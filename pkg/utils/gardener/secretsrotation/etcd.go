@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
@@ -183,6 +184,59 @@ func rewriteEncryptedData(
 	return flow.Sequential(taskFns...)(ctx)
 }
 
+// VerifyEncryptedDataRewritten verifies that all encrypted data in all namespaces in the target cluster which existed
+// before the given time carry the label whose value is the name of the current ETCD encryption key secret, i.e., that
+// they were rewritten to ETCD and are encrypted with the new key. Data created afterwards is already encrypted with
+// the new key and does not need to be checked.
+func VerifyEncryptedDataRewritten(
+	ctx context.Context,
+	targetClientSet kubernetes.Interface,
+	secretsManager secretsmanager.Interface,
+	resourcesToEncrypt []string,
+	encryptedResources []string,
+	defaultGVKs []schema.GroupVersionKind,
+	rotationStartTime time.Time,
+) error {
+	encryptedGVKs, _, err := GetResourcesForRewrite(targetClientSet.Kubernetes().Discovery(), resourcesToEncrypt, encryptedResources, defaultGVKs)
+	if err != nil {
+		return err
+	}
+
+	etcdEncryptionKeySecret, found := secretsManager.Get(v1beta1constants.SecretNameETCDEncryptionKey, secretsmanager.Current)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameETCDEncryptionKey)
+	}
+
+	var notRewritten []string
+
+	for _, gvk := range encryptedGVKs {
+		objList := &metav1.PartialObjectMetadataList{}
+		objList.SetGroupVersionKind(gvk)
+
+		if err := targetClientSet.Client().List(ctx, objList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(utils.MustNewRequirement(labelKeyRotationKeyName, selection.NotEquals, etcdEncryptionKeySecret.Name))}); err != nil {
+			return err
+		}
+
+		count := 0
+		for _, obj := range objList.Items {
+			if obj.CreationTimestamp.Time.Before(rotationStartTime) {
+				count++
+			}
+		}
+
+		if count > 0 {
+			notRewritten = append(notRewritten, fmt.Sprintf("%d %s", count, gvk.String()))
+		}
+	}
+
+	if len(notRewritten) > 0 {
+		slices.Sort(notRewritten)
+		return fmt.Errorf("not all encrypted objects were rewritten with the current ETCD encryption key: %s", strings.Join(notRewritten, ", "))
+	}
+
+	return nil
+}
+
 // SnapshotETCDAfterRewritingEncryptedData performs a full snapshot on ETCD after the encrypted data (like secrets) have
 // been rewritten as part of the ETCD encryption secret rotation. It adds an annotation to the API server deployment
 // after it's done so that it does not take another snapshot again after it succeeded once.
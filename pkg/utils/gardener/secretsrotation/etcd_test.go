@@ -6,6 +6,7 @@ package secretsrotation_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -233,6 +234,42 @@ var _ = Describe("ETCD", func() {
 			})
 		})
 
+		Describe("#VerifyEncryptedDataRewritten", func() {
+			var (
+				resources   []string
+				defaultGVKs []schema.GroupVersionKind
+			)
+
+			BeforeEach(func() {
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+
+				resources = []string{
+					corev1.Resource("secrets").String(),
+					corev1.Resource("configmaps").String(),
+					appsv1.Resource("deployments").String(),
+					discoveryv1.Resource("endpointslices").String(),
+				}
+
+				defaultGVKs = []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("Secret")}
+			})
+
+			It("should return an error if not all resources were rewritten", func() {
+				Expect(VerifyEncryptedDataRewritten(ctx, fakeTargetInterface, fakeSecretsManager, resources, resources, defaultGVKs, time.Now())).To(MatchError(
+					"not all encrypted objects were rewritten with the current ETCD encryption key: 1 /v1, Kind=ConfigMap, 1 discovery.k8s.io/v1, Kind=EndpointSlice, 2 /v1, Kind=Secret, 2 apps/v1, Kind=Deployment",
+				))
+			})
+
+			It("should succeed if all resources were rewritten", func() {
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, runtimeClient, fakeTargetInterface, fakeSecretsManager, kubeAPIServerNamespace, kubeAPIServerDeploymentName, resources, resources, defaultGVKs)).To(Succeed())
+
+				Expect(VerifyEncryptedDataRewritten(ctx, fakeTargetInterface, fakeSecretsManager, resources, resources, defaultGVKs, time.Now())).To(Succeed())
+			})
+
+			It("should ignore resources created after the rotation was started", func() {
+				Expect(VerifyEncryptedDataRewritten(ctx, fakeTargetInterface, fakeSecretsManager, resources, resources, defaultGVKs, time.Time{})).To(Succeed())
+			})
+		})
+
 		Describe("#SnapshotETCDAfterRewritingEncryptedData", func() {
 			var (
 				ctrl     *gomock.Controller
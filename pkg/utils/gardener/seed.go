@@ -124,6 +124,10 @@ func ComputeRequiredExtensionsForSeed(seed *gardencorev1beta1.Seed) sets.Set[str
 		wantedKindTypeCombinations.Insert(ExtensionsID(extensionsv1alpha1.DNSRecordResource, seed.Spec.DNS.Provider.Type))
 	}
 
+	if helper.SeedIngressControllerProvidedByExtension(seed.Spec.Ingress) {
+		wantedKindTypeCombinations.Insert(ExtensionsID(extensionsv1alpha1.ExtensionResource, seed.Spec.Ingress.Controller.Kind))
+	}
+
 	// add extension combinations for seed provider type
 	wantedKindTypeCombinations.Insert(ExtensionsID(extensionsv1alpha1.ControlPlaneResource, seed.Spec.Provider.Type))
 	wantedKindTypeCombinations.Insert(ExtensionsID(extensionsv1alpha1.InfrastructureResource, seed.Spec.Provider.Type))
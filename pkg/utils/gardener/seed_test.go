@@ -140,6 +140,19 @@ var _ = Describe("utils", func() {
 			))
 		})
 
+		It("should return the required types for seed with an ingress controller provided by an extension", func() {
+			seed.Spec.Ingress = &gardencorev1beta1.Ingress{
+				Controller: gardencorev1beta1.IngressController{Kind: "contour"},
+			}
+
+			Expect(ComputeRequiredExtensionsForSeed(seed).UnsortedList()).To(ConsistOf(
+				"Extension/contour",
+				"ControlPlane/providerA",
+				"Infrastructure/providerA",
+				"Worker/providerA",
+			))
+		})
+
 		It("should return the required types for seed w/o DNS provider", func() {
 			Expect(ComputeRequiredExtensionsForSeed(seed).UnsortedList()).To(ConsistOf(
 				"ControlPlane/providerA",
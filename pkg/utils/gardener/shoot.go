@@ -176,6 +176,10 @@ func NodeLabelsForWorkerPool(workerPool gardencorev1beta1.Worker, nodeLocalDNSEn
 		labels[v1beta1constants.LabelWorkerPoolSystemComponents] = "true"
 	}
 
+	if workerPool.Confidential != nil {
+		labels[v1beta1constants.LabelWorkerPoolConfidential] = "true"
+	}
+
 	// worker pool name labels
 	labels[v1beta1constants.LabelWorkerPool] = workerPool.Name
 	labels[v1beta1constants.LabelWorkerPoolDeprecated] = workerPool.Name
@@ -652,6 +656,9 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 		if shoot.Spec.Networking != nil && shoot.Spec.Networking.Type != nil {
 			requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.NetworkResource, *shoot.Spec.Networking.Type))
 		}
+		if v1beta1helper.ShootEnablesPrivateLink(shoot) {
+			requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.PrivateLinkResource, shoot.Spec.Provider.Type))
+		}
 	}
 
 	disabledExtensions := sets.New[string]()
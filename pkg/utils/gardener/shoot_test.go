@@ -316,6 +316,19 @@ var _ = Describe("Shoot", func() {
 			)
 		})
 
+		It("should not add the confidential label if the worker pool is not confidential", func() {
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "osc-key")).NotTo(
+				HaveKey("worker.gardener.cloud/confidential"),
+			)
+		})
+
+		It("should add the confidential label if the worker pool is confidential", func() {
+			workerPool.Confidential = &gardencorev1beta1.WorkerConfidential{}
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "osc-key")).To(
+				HaveKeyWithValue("worker.gardener.cloud/confidential", "true"),
+			)
+		})
+
 		It("should correctly handle the node-local-dns label", func() {
 			Expect(NodeLabelsForWorkerPool(workerPool, false, "osc-key")).To(
 				HaveKeyWithValue("networking.gardener.cloud/node-local-dns-enabled", "false"),
@@ -1175,6 +1188,29 @@ var _ = Describe("Shoot", func() {
 			)))
 		})
 
+		It("should compute the correct list of required extensions (shoot enables private link)", func() {
+			shoot.Spec.Networking.PrivateLink = &gardencorev1beta1.PrivateLink{Enabled: true}
+
+			result := ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, internalDomain, externalDomain)
+
+			Expect(result).To(BeEquivalentTo(sets.New(
+				ExtensionsID(extensionsv1alpha1.BackupBucketResource, backupProvider),
+				ExtensionsID(extensionsv1alpha1.BackupEntryResource, backupProvider),
+				ExtensionsID(extensionsv1alpha1.ControlPlaneResource, seedProvider),
+				ExtensionsID(extensionsv1alpha1.ControlPlaneResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.InfrastructureResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.NetworkResource, networkingType),
+				ExtensionsID(extensionsv1alpha1.PrivateLinkResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.WorkerResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, extensionType1),
+				ExtensionsID(extensionsv1alpha1.OperatingSystemConfigResource, oscType),
+				ExtensionsID(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType1),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType2),
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, extensionType2),
+			)))
+		})
+
 		It("should compute the correct list of required extensions (shoot explicitly disables globally enabled extension)", func() {
 			shoot.Spec.Extensions = append(shoot.Spec.Extensions, gardencorev1beta1.Extension{
 				Type:     extensionType2,
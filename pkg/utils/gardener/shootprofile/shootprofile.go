@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootprofile
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// Version is the version of the profile format produced by Export. Import rejects profiles of other versions.
+const Version = "v1"
+
+// Export converts the given Shoot into a provider-agnostic ShootProfile. The machine types of the worker pools are
+// abstracted to their capabilities as maintained in the given CloudProfile, volume types are abstracted to their
+// class. Provider-specific configuration (infrastructure, control plane and worker provider configs, zones, machine
+// images, credentials) is not part of the profile.
+func Export(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfileSpec) (*gardencorev1beta1.ShootProfile, error) {
+	profile := &gardencorev1beta1.ShootProfile{
+		Version:           Version,
+		KubernetesVersion: shoot.Spec.Kubernetes.Version,
+		Purpose:           shoot.Spec.Purpose,
+		ControlPlane:      shoot.Spec.ControlPlane.DeepCopy(),
+		Maintenance:       shoot.Spec.Maintenance.DeepCopy(),
+		Hibernation:       shoot.Spec.Hibernation.DeepCopy(),
+	}
+
+	if networking := shoot.Spec.Networking.DeepCopy(); networking != nil {
+		networking.ProviderConfig = nil
+		profile.Networking = networking
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		machineType := v1beta1helper.FindMachineTypeByName(cloudProfile.MachineTypes, worker.Machine.Type)
+		if machineType == nil {
+			return nil, fmt.Errorf("machine type %q of worker pool %q is not defined in the CloudProfile", worker.Machine.Type, worker.Name)
+		}
+
+		workerProfile := gardencorev1beta1.WorkerProfile{
+			Name: worker.Name,
+			Machine: gardencorev1beta1.MachineRequirements{
+				CPU:          machineType.CPU,
+				GPU:          machineType.GPU,
+				Memory:       machineType.Memory,
+				Architecture: ptr.To(architectureOf(machineType.Architecture)),
+				Capabilities: machineType.Capabilities.DeepCopy(),
+			},
+			Minimum:   worker.Minimum,
+			Maximum:   worker.Maximum,
+			ZoneCount: int32(len(worker.Zones)),
+			Labels:    worker.Labels,
+			Taints:    worker.Taints,
+		}
+
+		if worker.Volume != nil {
+			workerProfile.Volume = &gardencorev1beta1.VolumeRequirements{VolumeSize: worker.Volume.VolumeSize}
+			if worker.Volume.Type != nil {
+				for _, volumeType := range cloudProfile.VolumeTypes {
+					if volumeType.Name == *worker.Volume.Type {
+						workerProfile.Volume.Class = ptr.To(volumeType.Class)
+						break
+					}
+				}
+			}
+		}
+
+		profile.Workers = append(profile.Workers, workerProfile)
+	}
+
+	return profile, nil
+}
+
+// Import converts the given ShootProfile into a Shoot for the given CloudProfile and region. For every worker pool,
+// the smallest usable machine type satisfying the capability requirements of the profile and being available in the
+// selected zones is chosen. Aspects of the profile which cannot be imported exactly (e.g., an unavailable Kubernetes
+// version or volume class) are approximated and reported as warnings. The returned Shoot has neither metadata nor
+// credentials set, they have to be completed by the client.
+func Import(profile *gardencorev1beta1.ShootProfile, cloudProfileName string, cloudProfile *gardencorev1beta1.CloudProfileSpec, regionName string) (*gardencorev1beta1.Shoot, []string, error) {
+	if profile.Version != Version {
+		return nil, nil, fmt.Errorf("unsupported profile version %q, only %q is supported", profile.Version, Version)
+	}
+
+	var region *gardencorev1beta1.Region
+	for _, r := range cloudProfile.Regions {
+		if r.Name == regionName {
+			region = &r
+			break
+		}
+	}
+	if region == nil {
+		return nil, nil, fmt.Errorf("region %q is not defined in the CloudProfile", regionName)
+	}
+
+	var warnings []string
+
+	kubernetesVersion, changed, err := importKubernetesVersion(profile.KubernetesVersion, cloudProfile.Kubernetes.Versions)
+	if err != nil {
+		return nil, nil, err
+	}
+	if changed {
+		warnings = append(warnings, fmt.Sprintf("Kubernetes version %s is not offered, using %s instead", profile.KubernetesVersion, kubernetesVersion))
+	}
+
+	shoot := &gardencorev1beta1.Shoot{
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfileName: cloudProfileName,
+			Region:           regionName,
+			Kubernetes:       gardencorev1beta1.Kubernetes{Version: kubernetesVersion},
+			Provider:         gardencorev1beta1.Provider{Type: cloudProfile.Type},
+			Purpose:          profile.Purpose,
+			ControlPlane:     profile.ControlPlane.DeepCopy(),
+			Networking:       profile.Networking.DeepCopy(),
+			Maintenance:      profile.Maintenance.DeepCopy(),
+			Hibernation:      profile.Hibernation.DeepCopy(),
+		},
+	}
+
+	for _, workerProfile := range profile.Workers {
+		worker, workerWarnings, err := importWorker(workerProfile, cloudProfile, region)
+		if err != nil {
+			return nil, nil, err
+		}
+		shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, *worker)
+		warnings = append(warnings, workerWarnings...)
+	}
+
+	return shoot, warnings, nil
+}
+
+func importKubernetesVersion(version string, versions []gardencorev1beta1.ExpirableVersion) (string, bool, error) {
+	wanted, err := semver.NewVersion(version)
+	if err != nil {
+		return "", false, fmt.Errorf("failed parsing Kubernetes version %q: %w", version, err)
+	}
+
+	isExpired := v1beta1helper.FilterExpiredVersion()
+
+	for _, v := range versions {
+		if expired, _ := isExpired(v, nil); v.Version == version && !expired {
+			return version, false, nil
+		}
+	}
+
+	// Fall back to the latest offered version of the same minor version.
+	found, latest, err := v1beta1helper.GetLatestQualifyingVersion(versions, isExpired, func(_ gardencorev1beta1.ExpirableVersion, v *semver.Version) (bool, error) {
+		return v.Major() != wanted.Major() || v.Minor() != wanted.Minor(), nil
+	})
+	if err != nil {
+		return "", false, err
+	}
+	if !found {
+		return "", false, fmt.Errorf("no Kubernetes version with minor version %d.%d is offered", wanted.Major(), wanted.Minor())
+	}
+
+	return latest.Version, true, nil
+}
+
+func importWorker(workerProfile gardencorev1beta1.WorkerProfile, cloudProfile *gardencorev1beta1.CloudProfileSpec, region *gardencorev1beta1.Region) (*gardencorev1beta1.Worker, []string, error) {
+	var warnings []string
+
+	zoneCount := int(workerProfile.ZoneCount)
+	if zoneCount > len(region.Zones) {
+		warnings = append(warnings, fmt.Sprintf("worker pool %q: region %s offers only %d of %d zones", workerProfile.Name, region.Name, len(region.Zones), zoneCount))
+		zoneCount = len(region.Zones)
+	}
+	zones := region.Zones[:zoneCount]
+
+	machineType := selectMachineType(workerProfile.Machine, cloudProfile.MachineTypes, zones)
+	if machineType == nil {
+		return nil, nil, fmt.Errorf("worker pool %q: no usable machine type with at least %s CPU, %s GPU, %s memory, architecture %s, and the required capabilities is available",
+			workerProfile.Name, workerProfile.Machine.CPU.String(), workerProfile.Machine.GPU.String(), workerProfile.Machine.Memory.String(), architectureOf(workerProfile.Machine.Architecture))
+	}
+
+	worker := &gardencorev1beta1.Worker{
+		Name: workerProfile.Name,
+		Machine: gardencorev1beta1.Machine{
+			Type:         machineType.Name,
+			Architecture: ptr.To(architectureOf(machineType.Architecture)),
+		},
+		Minimum: workerProfile.Minimum,
+		Maximum: workerProfile.Maximum,
+		Labels:  workerProfile.Labels,
+		Taints:  workerProfile.Taints,
+	}
+
+	for _, zone := range zones {
+		worker.Zones = append(worker.Zones, zone.Name)
+	}
+
+	if workerProfile.Volume != nil {
+		worker.Volume = &gardencorev1beta1.Volume{VolumeSize: workerProfile.Volume.VolumeSize}
+
+		if volumeType := selectVolumeType(workerProfile.Volume.Class, cloudProfile.VolumeTypes, zones); volumeType != nil {
+			worker.Volume.Type = &volumeType.Name
+			if workerProfile.Volume.Class != nil && volumeType.Class != *workerProfile.Volume.Class {
+				warnings = append(warnings, fmt.Sprintf("worker pool %q: no volume type of class %s is available, using %s of class %s instead", workerProfile.Name, *workerProfile.Volume.Class, volumeType.Name, volumeType.Class))
+			}
+		}
+	}
+
+	return worker, warnings, nil
+}
+
+// selectMachineType returns the smallest usable machine type satisfying the given requirements which is available in
+// all given zones. Machine types are ordered by CPU, memory, and GPU.
+func selectMachineType(requirements gardencorev1beta1.MachineRequirements, machineTypes []gardencorev1beta1.MachineType, zones []gardencorev1beta1.AvailabilityZone) *gardencorev1beta1.MachineType {
+	var selected *gardencorev1beta1.MachineType
+
+	for _, machineType := range machineTypes {
+		if !ptr.Deref(machineType.Usable, true) ||
+			architectureOf(machineType.Architecture) != architectureOf(requirements.Architecture) ||
+			!v1beta1helper.MachineTypeSatisfiesRequirements(machineType, gardencorev1beta1.MachineTypeRequirements{
+				CPU:          &requirements.CPU,
+				GPU:          &requirements.GPU,
+				Memory:       &requirements.Memory,
+				Capabilities: requirements.Capabilities,
+			}) ||
+			slices.ContainsFunc(zones, func(zone gardencorev1beta1.AvailabilityZone) bool {
+				return slices.Contains(zone.UnavailableMachineTypes, machineType.Name)
+			}) {
+			continue
+		}
+
+		if selected == nil || v1beta1helper.CompareMachineTypeSizes(machineType, *selected) < 0 {
+			selected = &machineType
+		}
+	}
+
+	return selected
+}
+
+// selectVolumeType returns the first usable volume type of the given class which is available in all given zones. If
+// there is no such volume type, the first usable volume type of any class is returned.
+func selectVolumeType(class *string, volumeTypes []gardencorev1beta1.VolumeType, zones []gardencorev1beta1.AvailabilityZone) *gardencorev1beta1.VolumeType {
+	var fallback *gardencorev1beta1.VolumeType
+
+	for _, volumeType := range volumeTypes {
+		if !ptr.Deref(volumeType.Usable, true) ||
+			slices.ContainsFunc(zones, func(zone gardencorev1beta1.AvailabilityZone) bool {
+				return slices.Contains(zone.UnavailableVolumeTypes, volumeType.Name)
+			}) {
+			continue
+		}
+
+		if class == nil || volumeType.Class == *class {
+			return &volumeType
+		}
+		if fallback == nil {
+			fallback = &volumeType
+		}
+	}
+
+	return fallback
+}
+
+func architectureOf(architecture *string) string {
+	return ptr.Deref(architecture, v1beta1constants.ArchitectureAMD64)
+}
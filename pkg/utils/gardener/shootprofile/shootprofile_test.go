@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootprofile_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootprofile"
+)
+
+var _ = Describe("ShootProfile", func() {
+	var (
+		awsProfile *gardencorev1beta1.CloudProfileSpec
+		gcpProfile *gardencorev1beta1.CloudProfileSpec
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		awsProfile = &gardencorev1beta1.CloudProfileSpec{
+			Type: "aws",
+			MachineTypes: []gardencorev1beta1.MachineType{
+				{Name: "m5.large", CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi"), Architecture: ptr.To("amd64")},
+				{Name: "m5d.large", CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi"), Architecture: ptr.To("amd64"), Capabilities: &gardencorev1beta1.MachineTypeCapabilities{NVMe: ptr.To(true)}},
+				{Name: "m6g.xlarge", CPU: resource.MustParse("4"), Memory: resource.MustParse("16Gi"), Architecture: ptr.To("arm64")},
+			},
+			VolumeTypes: []gardencorev1beta1.VolumeType{
+				{Name: "gp3", Class: "standard"},
+				{Name: "io1", Class: "premium"},
+			},
+		}
+
+		gcpProfile = &gardencorev1beta1.CloudProfileSpec{
+			Type: "gcp",
+			Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{
+				{Version: "1.29.3"},
+				{Version: "1.29.1", ExpirationDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+				{Version: "1.28.8"},
+			}},
+			MachineTypes: []gardencorev1beta1.MachineType{
+				{Name: "n2-standard-8", CPU: resource.MustParse("8"), Memory: resource.MustParse("32Gi")},
+				{Name: "n2-standard-2", CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi")},
+				{Name: "n1-standard-2", CPU: resource.MustParse("2"), Memory: resource.MustParse("7500Mi")},
+				{Name: "e2-standard-2", CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi"), Usable: ptr.To(false)},
+				{Name: "t2a-standard-4", CPU: resource.MustParse("4"), Memory: resource.MustParse("16Gi"), Architecture: ptr.To("arm64")},
+				{Name: "a2-highgpu-1g", CPU: resource.MustParse("12"), GPU: resource.MustParse("1"), Memory: resource.MustParse("85Gi")},
+				{Name: "n2d-standard-4", CPU: resource.MustParse("4"), Memory: resource.MustParse("16Gi"), Capabilities: &gardencorev1beta1.MachineTypeCapabilities{ConfidentialCompute: ptr.To(true)}},
+			},
+			VolumeTypes: []gardencorev1beta1.VolumeType{
+				{Name: "pd-standard", Class: "standard"},
+				{Name: "pd-balanced", Class: "standard"},
+			},
+			Regions: []gardencorev1beta1.Region{{
+				Name: "europe-west1",
+				Zones: []gardencorev1beta1.AvailabilityZone{
+					{Name: "europe-west1-b", UnavailableVolumeTypes: []string{"pd-standard"}},
+					{Name: "europe-west1-c"},
+				},
+			}},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: "aws",
+				Region:           "eu-west-1",
+				Kubernetes:       gardencorev1beta1.Kubernetes{Version: "1.29.1"},
+				Purpose:          ptr.To(gardencorev1beta1.ShootPurposeProduction),
+				Networking:       &gardencorev1beta1.Networking{Type: ptr.To("calico"), Nodes: ptr.To("10.250.0.0/16")},
+				Provider: gardencorev1beta1.Provider{
+					Type: "aws",
+					Workers: []gardencorev1beta1.Worker{
+						{
+							Name:    "amd",
+							Machine: gardencorev1beta1.Machine{Type: "m5.large"},
+							Minimum: 1,
+							Maximum: 3,
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("gp3"), VolumeSize: "50Gi"},
+							Zones:   []string{"eu-west-1a", "eu-west-1b"},
+							Labels:  map[string]string{"foo": "bar"},
+							Taints:  []corev1.Taint{{Key: "foo", Effect: corev1.TaintEffectNoSchedule}},
+						},
+						{
+							Name:    "arm",
+							Machine: gardencorev1beta1.Machine{Type: "m6g.xlarge"},
+							Minimum: 0,
+							Maximum: 2,
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("io1"), VolumeSize: "20Gi"},
+							Zones:   []string{"eu-west-1a", "eu-west-1b", "eu-west-1c"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Export", func() {
+		It("should abstract the machine and volume types", func() {
+			profile, err := Export(shoot, awsProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(profile).To(Equal(&gardencorev1beta1.ShootProfile{
+				Version:           "v1",
+				KubernetesVersion: "1.29.1",
+				Purpose:           ptr.To(gardencorev1beta1.ShootPurposeProduction),
+				Networking:        &gardencorev1beta1.Networking{Type: ptr.To("calico"), Nodes: ptr.To("10.250.0.0/16")},
+				Workers: []gardencorev1beta1.WorkerProfile{
+					{
+						Name:      "amd",
+						Machine:   gardencorev1beta1.MachineRequirements{CPU: resource.MustParse("2"), Memory: resource.MustParse("8Gi"), Architecture: ptr.To("amd64")},
+						Volume:    &gardencorev1beta1.VolumeRequirements{VolumeSize: "50Gi", Class: ptr.To("standard")},
+						Minimum:   1,
+						Maximum:   3,
+						ZoneCount: 2,
+						Labels:    map[string]string{"foo": "bar"},
+						Taints:    []corev1.Taint{{Key: "foo", Effect: corev1.TaintEffectNoSchedule}},
+					},
+					{
+						Name:      "arm",
+						Machine:   gardencorev1beta1.MachineRequirements{CPU: resource.MustParse("4"), Memory: resource.MustParse("16Gi"), Architecture: ptr.To("arm64")},
+						Volume:    &gardencorev1beta1.VolumeRequirements{VolumeSize: "20Gi", Class: ptr.To("premium")},
+						Maximum:   2,
+						ZoneCount: 3,
+					},
+				},
+			}))
+		})
+
+		It("should export the capabilities of the machine types", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "m5d.large"
+
+			profile, err := Export(shoot, awsProfile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.Workers[0].Machine.Capabilities).To(Equal(&gardencorev1beta1.MachineTypeCapabilities{NVMe: ptr.To(true)}))
+		})
+
+		It("should fail if a machine type is not defined in the CloudProfile", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := Export(shoot, awsProfile)
+			Expect(err).To(MatchError(ContainSubstring(`machine type "unknown" of worker pool "amd" is not defined`)))
+		})
+	})
+
+	Describe("#Import", func() {
+		var profile *gardencorev1beta1.ShootProfile
+
+		BeforeEach(func() {
+			var err error
+			profile, err = Export(shoot, awsProfile)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should map the machine types via their capabilities", func() {
+			imported, warnings, err := Import(profile, "gcp", gcpProfile, "europe-west1")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(imported.Spec.CloudProfileName).To(Equal("gcp"))
+			Expect(imported.Spec.Region).To(Equal("europe-west1"))
+			Expect(imported.Spec.Provider.Type).To(Equal("gcp"))
+			Expect(imported.Spec.Purpose).To(Equal(ptr.To(gardencorev1beta1.ShootPurposeProduction)))
+			Expect(imported.Spec.Networking).To(Equal(&gardencorev1beta1.Networking{Type: ptr.To("calico"), Nodes: ptr.To("10.250.0.0/16")}))
+			Expect(imported.Spec.Kubernetes.Version).To(Equal("1.29.3"))
+			Expect(imported.Spec.Provider.Workers).To(Equal([]gardencorev1beta1.Worker{
+				{
+					Name:    "amd",
+					Machine: gardencorev1beta1.Machine{Type: "n2-standard-2", Architecture: ptr.To("amd64")},
+					Minimum: 1,
+					Maximum: 3,
+					Volume:  &gardencorev1beta1.Volume{Type: ptr.To("pd-balanced"), VolumeSize: "50Gi"},
+					Zones:   []string{"europe-west1-b", "europe-west1-c"},
+					Labels:  map[string]string{"foo": "bar"},
+					Taints:  []corev1.Taint{{Key: "foo", Effect: corev1.TaintEffectNoSchedule}},
+				},
+				{
+					Name:    "arm",
+					Machine: gardencorev1beta1.Machine{Type: "t2a-standard-4", Architecture: ptr.To("arm64")},
+					Maximum: 2,
+					Volume:  &gardencorev1beta1.Volume{Type: ptr.To("pd-balanced"), VolumeSize: "20Gi"},
+					Zones:   []string{"europe-west1-b", "europe-west1-c"},
+				},
+			}))
+			Expect(warnings).To(ConsistOf(
+				"Kubernetes version 1.29.1 is not offered, using 1.29.3 instead",
+				`worker pool "arm": region europe-west1 offers only 2 of 3 zones`,
+				`worker pool "arm": no volume type of class premium is available, using pd-balanced of class standard instead`,
+			))
+		})
+
+		It("should consider GPU requirements", func() {
+			profile.Workers = profile.Workers[:1]
+			profile.Workers[0].Machine.GPU = resource.MustParse("1")
+
+			imported, _, err := Import(profile, "gcp", gcpProfile, "europe-west1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported.Spec.Provider.Workers[0].Machine.Type).To(Equal("a2-highgpu-1g"))
+		})
+
+		It("should consider capability requirements", func() {
+			profile.Workers = profile.Workers[:1]
+			profile.Workers[0].Machine.Capabilities = &gardencorev1beta1.MachineTypeCapabilities{ConfidentialCompute: ptr.To(true)}
+
+			imported, _, err := Import(profile, "gcp", gcpProfile, "europe-west1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imported.Spec.Provider.Workers[0].Machine.Type).To(Equal("n2d-standard-4"))
+		})
+
+		It("should fail if no machine type satisfies the requirements", func() {
+			profile.Workers[0].Machine.Memory = resource.MustParse("1Ti")
+
+			_, _, err := Import(profile, "gcp", gcpProfile, "europe-west1")
+			Expect(err).To(MatchError(ContainSubstring(`worker pool "amd": no usable machine type`)))
+		})
+
+		It("should fail if the region is not defined in the CloudProfile", func() {
+			_, _, err := Import(profile, "gcp", gcpProfile, "us-east1")
+			Expect(err).To(MatchError(`region "us-east1" is not defined in the CloudProfile`))
+		})
+
+		It("should fail if no Kubernetes version of the same minor version is offered", func() {
+			profile.KubernetesVersion = "1.30.0"
+
+			_, _, err := Import(profile, "gcp", gcpProfile, "europe-west1")
+			Expect(err).To(MatchError("no Kubernetes version with minor version 1.30 is offered"))
+		})
+
+		It("should fail for unsupported profile versions", func() {
+			profile.Version = "v2"
+
+			_, _, err := Import(profile, "gcp", gcpProfile, "europe-west1")
+			Expect(err).To(MatchError(ContainSubstring(`unsupported profile version "v2"`)))
+		})
+	})
+})
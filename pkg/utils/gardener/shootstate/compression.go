@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// CompressionThreshold is the minimum size in bytes of a data entry in the ShootState spec for which compression is
+// attempted. Smaller entries are persisted as they are.
+const CompressionThreshold = 1024
+
+// compressedDataPrefix is the prefix of the JSON encoding of compressedData. It is used to cheaply detect compressed
+// data entries without unmarshalling all entries.
+var compressedDataPrefix = []byte(`{"gzip/data":`)
+
+// compressedData is the structure of compressed data entries in the ShootState spec. The JSON key contains a slash
+// which is not allowed in keys of secret data, hence it cannot collide with the data of persisted secrets.
+type compressedData struct {
+	Data []byte `json:"gzip/data"`
+}
+
+// CompressSpec compresses all data entries in the given ShootState spec which exceed the CompressionThreshold, as long
+// as the compressed representation is smaller than the original one. The machine state is skipped since it has its
+// own compressed format, see DecompressMachineState.
+func CompressSpec(spec *gardencorev1beta1.ShootStateSpec) error {
+	for i, data := range spec.Gardener {
+		if data.Type == v1beta1constants.DataTypeMachineState {
+			continue
+		}
+
+		compressed, err := CompressData(data.Data.Raw)
+		if err != nil {
+			return fmt.Errorf("failed compressing gardener data %q: %w", data.Name, err)
+		}
+		spec.Gardener[i].Data.Raw = compressed
+	}
+
+	for i, data := range spec.Extensions {
+		if data.State == nil {
+			continue
+		}
+
+		compressed, err := CompressData(data.State.Raw)
+		if err != nil {
+			return fmt.Errorf("failed compressing state of extension %s: %w", extensionStateKey(data), err)
+		}
+		spec.Extensions[i].State.Raw = compressed
+	}
+
+	for i, data := range spec.Resources {
+		compressed, err := CompressData(data.Data.Raw)
+		if err != nil {
+			return fmt.Errorf("failed compressing resource %s %q: %w", data.Kind, data.Name, err)
+		}
+		spec.Resources[i].Data.Raw = compressed
+	}
+
+	return nil
+}
+
+// DecompressSpec decompresses all compressed data entries in the given ShootState spec. Data entries which are not
+// compressed are kept as they are.
+func DecompressSpec(spec *gardencorev1beta1.ShootStateSpec) error {
+	for i, data := range spec.Gardener {
+		decompressed, err := DecompressData(data.Data.Raw)
+		if err != nil {
+			return fmt.Errorf("failed decompressing gardener data %q: %w", data.Name, err)
+		}
+		spec.Gardener[i].Data.Raw = decompressed
+	}
+
+	for i, data := range spec.Extensions {
+		if data.State == nil {
+			continue
+		}
+
+		decompressed, err := DecompressData(data.State.Raw)
+		if err != nil {
+			return fmt.Errorf("failed decompressing state of extension %s: %w", extensionStateKey(data), err)
+		}
+		spec.Extensions[i].State.Raw = decompressed
+	}
+
+	for i, data := range spec.Resources {
+		decompressed, err := DecompressData(data.Data.Raw)
+		if err != nil {
+			return fmt.Errorf("failed decompressing resource %s %q: %w", data.Kind, data.Name, err)
+		}
+		spec.Resources[i].Data.Raw = decompressed
+	}
+
+	return nil
+}
+
+// CompressData compresses the given JSON data if it exceeds the CompressionThreshold and if the compressed
+// representation is smaller. Otherwise, the data is returned unchanged.
+func CompressData(data []byte) ([]byte, error) {
+	if len(data) < CompressionThreshold || isCompressed(data) {
+		return data, nil
+	}
+
+	dataCompressed, err := gzipCompress(data)
+	if err != nil {
+		return nil, err
+	}
+
+	compressed, err := json.Marshal(&compressedData{Data: dataCompressed})
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling compressed data: %w", err)
+	}
+
+	if len(compressed) >= len(data) {
+		return data, nil
+	}
+	return compressed, nil
+}
+
+// DecompressData decompresses the given data if it was compressed with CompressData. Otherwise, the data is returned
+// unchanged.
+func DecompressData(data []byte) ([]byte, error) {
+	if !isCompressed(data) {
+		return data, nil
+	}
+
+	var compressed compressedData
+	if err := json.Unmarshal(data, &compressed); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling JSON to compressed data structure: %w", err)
+	}
+
+	return gzipDecompress(compressed.Data)
+}
+
+func isCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, compressedDataPrefix)
+}
+
+func extensionStateKey(data gardencorev1beta1.ExtensionResourceState) string {
+	key := data.Kind
+	if data.Name != nil {
+		key += "/" + *data.Name
+	}
+	if data.Purpose != nil {
+		key += "/" + *data.Purpose
+	}
+	return key
+}
+
+func gzipCompress(data []byte) ([]byte, error) {
+	var dataCompressed bytes.Buffer
+	gzipWriter, err := gzip.NewWriterLevel(&dataCompressed, gzip.BestCompression)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gzip writer for compressing data: %w", err)
+	}
+
+	defer gzipWriter.Close()
+
+	if _, err := gzipWriter.Write(data); err != nil {
+		return nil, fmt.Errorf("failed writing data for compression: %w", err)
+	}
+
+	// Close ensures any unwritten data is flushed and the gzip footer is written. Without this, the `dataCompressed`
+	// buffer would not contain any data. Hence, we have to call it explicitly here after writing, in addition to the
+	// 'defer' call above.
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed closing the gzip writer after compressing the data: %w", err)
+	}
+
+	return dataCompressed.Bytes(), nil
+}
+
+func gzipDecompress(dataCompressed []byte) ([]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(dataCompressed))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gzip reader for decompressing data: %w", err)
+	}
+	defer gzipReader.Close()
+
+	var data bytes.Buffer
+	if _, err := data.ReadFrom(gzipReader); err != nil {
+		return nil, fmt.Errorf("failed reading data for decompression: %w", err)
+	}
+
+	return data.Bytes(), nil
+}
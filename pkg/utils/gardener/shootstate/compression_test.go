@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootstate_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+var _ = Describe("Compression", func() {
+	var (
+		smallData        = []byte(`{"foo":"bar"}`)
+		compressibleData = []byte(`{"foo":"` + strings.Repeat("bar", 1000) + `"}`)
+	)
+
+	Describe("#CompressData", func() {
+		It("should not compress data below the threshold", func() {
+			Expect(CompressData(smallData)).To(Equal(smallData))
+		})
+
+		It("should not compress data if the compressed representation is not smaller", func() {
+			random := make([]byte, 2*CompressionThreshold)
+			_, err := rand.Read(random)
+			Expect(err).NotTo(HaveOccurred())
+			incompressibleData := []byte(`{"foo":"` + base64.StdEncoding.EncodeToString(random) + `"}`)
+
+			Expect(CompressData(incompressibleData)).To(Equal(incompressibleData))
+		})
+
+		It("should compress data above the threshold", func() {
+			compressed, err := CompressData(compressibleData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(compressed)).To(HavePrefix(`{"gzip/data":`))
+			Expect(len(compressed)).To(BeNumerically("<", len(compressibleData)))
+
+			By("Do not compress already compressed data again")
+			Expect(CompressData(compressed)).To(Equal(compressed))
+		})
+	})
+
+	Describe("#DecompressData", func() {
+		It("should return uncompressed data unchanged", func() {
+			Expect(DecompressData(smallData)).To(Equal(smallData))
+			Expect(DecompressData(nil)).To(BeNil())
+		})
+
+		It("should fail if the compressed data cannot be unmarshalled", func() {
+			_, err := DecompressData([]byte(`{"gzip/data":foo`))
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling JSON to compressed data structure")))
+		})
+
+		It("should fail if the compressed data is not gzipped", func() {
+			_, err := DecompressData([]byte(`{"gzip/data":"eW91LXNob3VsZC1ub3QtaGF2ZS1yZWFkLXRoaXM="}`))
+			Expect(err).To(MatchError(ContainSubstring("failed creating gzip reader for decompressing data")))
+		})
+
+		It("should decompress compressed data", func() {
+			compressed, err := CompressData(compressibleData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecompressData(compressed)).To(Equal(compressibleData))
+		})
+	})
+
+	Describe("#CompressSpec, #DecompressSpec", func() {
+		It("should compress and decompress all data entries except the machine state", func() {
+			machineState := []byte(`{"state":"` + strings.Repeat("a", 2*CompressionThreshold) + `"}`)
+
+			spec := &gardencorev1beta1.ShootStateSpec{
+				Gardener: []gardencorev1beta1.GardenerResourceData{
+					{Name: "small", Type: "secret", Data: runtime.RawExtension{Raw: smallData}},
+					{Name: "large", Type: "secret", Data: runtime.RawExtension{Raw: compressibleData}},
+					{Name: "machine-state", Type: "machine-state", Data: runtime.RawExtension{Raw: machineState}},
+				},
+				Extensions: []gardencorev1beta1.ExtensionResourceState{
+					{Kind: "Worker"},
+					{Kind: "Infrastructure", State: &runtime.RawExtension{Raw: compressibleData}},
+				},
+				Resources: []gardencorev1beta1.ResourceData{
+					{Data: runtime.RawExtension{Raw: compressibleData}},
+				},
+			}
+			original := spec.DeepCopy()
+
+			Expect(CompressSpec(spec)).To(Succeed())
+			Expect(spec.Gardener[0].Data.Raw).To(Equal(smallData))
+			Expect(string(spec.Gardener[1].Data.Raw)).To(HavePrefix(`{"gzip/data":`))
+			Expect(spec.Gardener[2].Data.Raw).To(Equal(machineState))
+			Expect(spec.Extensions[0].State).To(BeNil())
+			Expect(string(spec.Extensions[1].State.Raw)).To(HavePrefix(`{"gzip/data":`))
+			Expect(string(spec.Resources[0].Data.Raw)).To(HavePrefix(`{"gzip/data":`))
+
+			Expect(DecompressSpec(spec)).To(Succeed())
+			Expect(spec).To(Equal(original))
+		})
+	})
+})
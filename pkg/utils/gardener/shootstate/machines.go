@@ -5,9 +5,7 @@
 package shootstate
 
 import (
-	"bytes"
 	"cmp"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -148,26 +146,12 @@ func compressMachineState(state []byte) ([]byte, error) {
 		return nil, nil
 	}
 
-	var stateCompressed bytes.Buffer
-	gzipWriter, err := gzip.NewWriterLevel(&stateCompressed, gzip.BestCompression)
+	stateCompressed, err := gzipCompress(state)
 	if err != nil {
-		return nil, fmt.Errorf("failed creating gzip writer for compressing machine state data: %w", err)
+		return nil, fmt.Errorf("failed compressing machine state data: %w", err)
 	}
 
-	defer gzipWriter.Close()
-
-	if _, err := gzipWriter.Write(state); err != nil {
-		return nil, fmt.Errorf("failed writing machine state data for compression: %w", err)
-	}
-
-	// Close ensures any unwritten data is flushed and the gzip footer is written. Without this, the `stateCompressed`
-	// buffer would not contain any data. Hence, we have to call it explicitly here after writing, in addition to the
-	// 'defer' call above.
-	if err := gzipWriter.Close(); err != nil {
-		return nil, fmt.Errorf("failed closing the gzip writer after compressing the machine state data: %w", err)
-	}
-
-	return json.Marshal(&compressedMachineState{State: stateCompressed.Bytes()})
+	return json.Marshal(&compressedMachineState{State: stateCompressed})
 }
 
 // DecompressMachineState decompresses the machine state data.
@@ -181,16 +165,10 @@ func DecompressMachineState(stateCompressed []byte) ([]byte, error) {
 		return nil, fmt.Errorf("failed unmarshalling JSON to compressed machine state structure: %w", err)
 	}
 
-	gzipReader, err := gzip.NewReader(bytes.NewReader(machineState.State))
+	state, err := gzipDecompress(machineState.State)
 	if err != nil {
-		return nil, fmt.Errorf("failed creating gzip reader for decompressing machine state data: %w", err)
-	}
-	defer gzipReader.Close()
-
-	var state bytes.Buffer
-	if _, err := state.ReadFrom(gzipReader); err != nil {
-		return nil, fmt.Errorf("failed reading machine state data for decompression: %w", err)
+		return nil, fmt.Errorf("failed decompressing machine state data: %w", err)
 	}
 
-	return state.Bytes(), nil
+	return state, nil
 }
@@ -27,7 +27,7 @@ var _ = Describe("Machines", func() {
 
 		It("should fail because the gzip reader cannot be created", func() {
 			state, err := DecompressMachineState([]byte(`{"state":"eW91LXNob3VsZC1ub3QtaGF2ZS1yZWFkLXRoaXM="}`))
-			Expect(err).To(MatchError(ContainSubstring("failed creating gzip reader for decompressing machine state data")))
+			Expect(err).To(MatchError(ContainSubstring("failed creating gzip reader for decompressing data")))
 			Expect(state).To(BeNil())
 		})
 
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,7 +32,9 @@ import (
 )
 
 // Deploy deploys the ShootState resource with the effective state for the given shoot into the garden
-// cluster.
+// cluster. Data entries exceeding the CompressionThreshold are persisted in compressed form, see CompressSpec. The
+// ShootState is only written if its spec changes, i.e., the gardener.cloud/timestamp annotation reflects the last time
+// the persisted state was changed.
 func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool) error {
 	shootState := &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
@@ -45,34 +48,43 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		return fmt.Errorf("failed computing spec of ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
 	}
 
+	if err := CompressSpec(spec); err != nil {
+		return fmt.Errorf("failed compressing spec of ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
 	_, err = controllerutils.GetAndCreateOrStrategicMergePatch(ctx, gardenClient, shootState, func() error {
-		metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339))
+		desiredSpec := *spec
 
-		if overwriteSpec {
-			shootState.Spec = *spec
-			return nil
-		}
+		if !overwriteSpec {
+			gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.DeepCopy().Gardener)
+			for _, data := range spec.Gardener {
+				gardenerData.Upsert(data.DeepCopy())
+			}
+			desiredSpec.Gardener = gardenerData
 
-		gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
-		for _, data := range spec.Gardener {
-			gardenerData.Upsert(data.DeepCopy())
-		}
-		shootState.Spec.Gardener = gardenerData
+			extensionsData := v1beta1helper.ExtensionResourceStateList(shootState.Spec.DeepCopy().Extensions)
+			for _, data := range spec.Extensions {
+				extensionsData.Upsert(data.DeepCopy())
+			}
+			desiredSpec.Extensions = extensionsData
 
-		extensionsData := v1beta1helper.ExtensionResourceStateList(shootState.Spec.Extensions)
-		for _, data := range spec.Extensions {
-			extensionsData.Upsert(data.DeepCopy())
+			resourcesData := v1beta1helper.ResourceDataList(shootState.Spec.DeepCopy().Resources)
+			for _, data := range spec.Resources {
+				resourcesData.Upsert(data.DeepCopy())
+			}
+			desiredSpec.Resources = resourcesData
 		}
-		shootState.Spec.Extensions = extensionsData
 
-		resourcesData := v1beta1helper.ResourceDataList(shootState.Spec.Resources)
-		for _, data := range spec.Resources {
-			resourcesData.Upsert(data.DeepCopy())
+		// Only the changed entries of the spec are sent to the garden cluster. If nothing changed, the ShootState is not
+		// written at all to reduce the load on the garden etcd.
+		if shootState.ResourceVersion != "" && apiequality.Semantic.DeepEqual(shootState.Spec, desiredSpec) {
+			return nil
 		}
-		shootState.Spec.Resources = resourcesData
 
+		metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339))
+		shootState.Spec = desiredSpec
 		return nil
-	})
+	}, controllerutils.SkipEmptyPatch{})
 	return err
 }
 
@@ -209,6 +221,7 @@ func computeExtensionsDataAndResources(
 		{extensionsv1alpha1.InfrastructureResource, func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} }},
 		{extensionsv1alpha1.NetworkResource, func() client.ObjectList { return &extensionsv1alpha1.NetworkList{} }},
 		{extensionsv1alpha1.OperatingSystemConfigResource, func() client.ObjectList { return &extensionsv1alpha1.OperatingSystemConfigList{} }},
+		{extensionsv1alpha1.PrivateLinkResource, func() client.ObjectList { return &extensionsv1alpha1.PrivateLinkList{} }},
 		{extensionsv1alpha1.WorkerResource, func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} }},
 	} {
 		objList := extension.newObjectListFunc()
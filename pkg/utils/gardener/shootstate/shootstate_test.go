@@ -6,6 +6,8 @@ package shootstate_test
 
 import (
 	"context"
+	"encoding/base64"
+	"strings"
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -15,7 +17,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/api/extensions"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
@@ -36,7 +38,7 @@ var _ = Describe("ShootState", func() {
 
 		fakeGardenClient client.Client
 		fakeSeedClient   client.Client
-		fakeClock        clock.Clock
+		fakeClock        *testclock.FakeClock
 
 		shoot      *gardencorev1beta1.Shoot
 		shootState *gardencorev1beta1.ShootState
@@ -211,7 +213,7 @@ var _ = Describe("ShootState", func() {
 			It("should compute the expected spec for both gardener and extensions data and overwrite the spec", func() {
 				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
-				Expect(shootState.Spec).To(Equal(expectedSpec))
+				Expect(withDecompressedMachineState(shootState.Spec)).To(Equal(withDecompressedMachineState(expectedSpec)))
 			})
 
 			It("should compute the expected spec for both gardener and extensions data and keep existing data in the spec", func() {
@@ -221,7 +223,51 @@ var _ = Describe("ShootState", func() {
 				expectedSpec.Gardener = append(existingGardenerData, expectedSpec.Gardener...)
 				expectedSpec.Extensions = append(existingExtensionsData, expectedSpec.Extensions...)
 				expectedSpec.Resources = append(existingResourcesData, expectedSpec.Resources...)
-				Expect(shootState.Spec).To(Equal(expectedSpec))
+				Expect(withDecompressedMachineState(shootState.Spec)).To(Equal(withDecompressedMachineState(expectedSpec)))
+			})
+
+			It("should compress large data entries", func() {
+				largeSecret := newSecret("large-secret", seedNamespace, true)
+				largeSecret.Data["large-secret"] = []byte(strings.Repeat("some-data", 1000))
+				Expect(fakeSeedClient.Create(ctx, largeSecret)).To(Succeed())
+
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+				gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+				data := gardenerData.Get("large-secret")
+				Expect(data).NotTo(BeNil())
+				Expect(string(data.Data.Raw)).To(HavePrefix(`{"gzip/data":`))
+				Expect(len(data.Data.Raw)).To(BeNumerically("<", CompressionThreshold))
+
+				decompressed, err := DecompressData(data.Data.Raw)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decompressed).To(MatchJSON(`{"large-secret":"` + base64.StdEncoding.EncodeToString(largeSecret.Data["large-secret"]) + `"}`))
+
+				By("Keep small data entries uncompressed")
+				Expect(gardenerData.Get("secret1").Data.Raw).To(Equal([]byte(`{"secret1":"c29tZS1kYXRh"}`)))
+			})
+
+			It("should only write the ShootState if the persisted state changes", func() {
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				resourceVersion, timestamp := shootState.ResourceVersion, shootState.Annotations["gardener.cloud/timestamp"]
+
+				By("Deploy again without changes")
+				fakeClock.Step(time.Hour)
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				Expect(shootState.ResourceVersion).To(Equal(resourceVersion))
+				Expect(shootState.Annotations).To(HaveKeyWithValue("gardener.cloud/timestamp", timestamp))
+
+				By("Deploy again after the state changed")
+				Expect(fakeSeedClient.Create(ctx, newSecret("secret4", seedNamespace, true))).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				Expect(shootState.ResourceVersion).NotTo(Equal(resourceVersion))
+				Expect(shootState.Annotations).To(HaveKeyWithValue("gardener.cloud/timestamp", fakeClock.Now().UTC().Format(time.RFC3339)))
+				gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+				Expect(gardenerData.Get("secret4")).NotTo(BeNil())
 			})
 		})
 	})
@@ -239,6 +285,22 @@ var _ = Describe("ShootState", func() {
 	})
 })
 
+// withDecompressedMachineState returns a copy of the given spec with the decompressed machine state. The compressed
+// representation depends on the gzip implementation of the Go version, hence it is not compared directly.
+func withDecompressedMachineState(spec gardencorev1beta1.ShootStateSpec) gardencorev1beta1.ShootStateSpec {
+	out := spec.DeepCopy()
+	for i, data := range out.Gardener {
+		if data.Type != "machine-state" {
+			continue
+		}
+
+		state, err := DecompressMachineState(data.Data.Raw)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		out.Gardener[i].Data.Raw = state
+	}
+	return *out
+}
+
 func newSecret(name, namespace string, withPersistLabel bool) *corev1.Secret {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
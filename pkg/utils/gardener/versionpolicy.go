@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// MinimumVersions contains the effective minimum Kubernetes and machine image versions declared by VersionPolicies.
+type MinimumVersions struct {
+	// Kubernetes is the minimum Kubernetes version. It is nil if no minimum Kubernetes version is declared.
+	Kubernetes *semver.Version
+	// MachineImages maps machine image names to their minimum versions.
+	MachineImages map[string]*semver.Version
+}
+
+// ComputeMinimumVersions computes the highest minimum versions declared by the given VersionPolicies. If
+// forceUpdateOnly is true, only policies requesting forceful updates are considered.
+func ComputeMinimumVersions(policies []gardencorev1beta1.VersionPolicy, forceUpdateOnly bool) (MinimumVersions, error) {
+	minimumVersions := MinimumVersions{MachineImages: make(map[string]*semver.Version)}
+
+	for _, policy := range policies {
+		if forceUpdateOnly && !policy.Spec.ForceUpdate {
+			continue
+		}
+
+		if policy.Spec.Kubernetes != nil {
+			version, err := semver.NewVersion(policy.Spec.Kubernetes.MinimumVersion)
+			if err != nil {
+				return MinimumVersions{}, fmt.Errorf("failed parsing minimum Kubernetes version of VersionPolicy %q: %w", policy.Name, err)
+			}
+			if minimumVersions.Kubernetes == nil || version.GreaterThan(minimumVersions.Kubernetes) {
+				minimumVersions.Kubernetes = version
+			}
+		}
+
+		for _, machineImage := range policy.Spec.MachineImages {
+			version, err := semver.NewVersion(machineImage.MinimumVersion)
+			if err != nil {
+				return MinimumVersions{}, fmt.Errorf("failed parsing minimum version of machine image %q of VersionPolicy %q: %w", machineImage.Name, policy.Name, err)
+			}
+			if current, ok := minimumVersions.MachineImages[machineImage.Name]; !ok || version.GreaterThan(current) {
+				minimumVersions.MachineImages[machineImage.Name] = version
+			}
+		}
+	}
+
+	return minimumVersions, nil
+}
+
+// KubernetesVersionBelowMinimum returns true if the given Kubernetes version is lower than the minimum Kubernetes
+// version.
+func (m MinimumVersions) KubernetesVersionBelowMinimum(version string) (bool, error) {
+	return versionBelowMinimum(version, m.Kubernetes)
+}
+
+// MachineImageVersionBelowMinimum returns true if the given version of the machine image with the given name is lower
+// than its minimum version.
+func (m MinimumVersions) MachineImageVersionBelowMinimum(name, version string) (bool, error) {
+	return versionBelowMinimum(version, m.MachineImages[name])
+}
+
+// ShootViolations returns descriptions of all Kubernetes and machine image versions of the given Shoot which are lower
+// than the minimum versions.
+func (m MinimumVersions) ShootViolations(shoot *gardencorev1beta1.Shoot) ([]string, error) {
+	var violations []string
+
+	belowMinimum, err := m.KubernetesVersionBelowMinimum(shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, err
+	}
+	if belowMinimum {
+		violations = append(violations, fmt.Sprintf("Kubernetes version %q of the control plane is lower than the minimum version %q", shoot.Spec.Kubernetes.Version, m.Kubernetes.Original()))
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			belowMinimum, err := m.KubernetesVersionBelowMinimum(*worker.Kubernetes.Version)
+			if err != nil {
+				return nil, err
+			}
+			if belowMinimum {
+				violations = append(violations, fmt.Sprintf("Kubernetes version %q of worker pool %q is lower than the minimum version %q", *worker.Kubernetes.Version, worker.Name, m.Kubernetes.Original()))
+			}
+		}
+
+		if image := worker.Machine.Image; image != nil && image.Version != nil {
+			belowMinimum, err := m.MachineImageVersionBelowMinimum(image.Name, *image.Version)
+			if err != nil {
+				return nil, err
+			}
+			if belowMinimum {
+				violations = append(violations, fmt.Sprintf("version %q of machine image %q of worker pool %q is lower than the minimum version %q", *image.Version, image.Name, worker.Name, m.MachineImages[image.Name].Original()))
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+func versionBelowMinimum(version string, minimum *semver.Version) (bool, error) {
+	if minimum == nil {
+		return false, nil
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing version %q: %w", version, err)
+	}
+
+	return v.LessThan(minimum), nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("VersionPolicy", func() {
+	var policies []gardencorev1beta1.VersionPolicy
+
+	BeforeEach(func() {
+		policies = []gardencorev1beta1.VersionPolicy{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "baseline"},
+				Spec: gardencorev1beta1.VersionPolicySpec{
+					Kubernetes:    &gardencorev1beta1.KubernetesVersionPolicy{MinimumVersion: "1.29.0"},
+					MachineImages: []gardencorev1beta1.MachineImageVersionPolicy{{Name: "gardenlinux", MinimumVersion: "1443.3.0"}},
+					ForceUpdate:   true,
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "recommended"},
+				Spec: gardencorev1beta1.VersionPolicySpec{
+					Kubernetes: &gardencorev1beta1.KubernetesVersionPolicy{MinimumVersion: "1.30.0"},
+					MachineImages: []gardencorev1beta1.MachineImageVersionPolicy{
+						{Name: "gardenlinux", MinimumVersion: "1592.1.0"},
+						{Name: "ubuntu", MinimumVersion: "22.4.0"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ComputeMinimumVersions", func() {
+		It("should compute the highest minimum versions of all policies", func() {
+			minimumVersions, err := ComputeMinimumVersions(policies, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimumVersions.Kubernetes.String()).To(Equal("1.30.0"))
+			Expect(minimumVersions.MachineImages).To(HaveLen(2))
+			Expect(minimumVersions.MachineImages["gardenlinux"].String()).To(Equal("1592.1.0"))
+			Expect(minimumVersions.MachineImages["ubuntu"].String()).To(Equal("22.4.0"))
+		})
+
+		It("should only consider policies requesting forceful updates", func() {
+			minimumVersions, err := ComputeMinimumVersions(policies, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimumVersions.Kubernetes.String()).To(Equal("1.29.0"))
+			Expect(minimumVersions.MachineImages).To(HaveLen(1))
+			Expect(minimumVersions.MachineImages["gardenlinux"].String()).To(Equal("1443.3.0"))
+		})
+
+		It("should return empty minimum versions if there are no policies", func() {
+			minimumVersions, err := ComputeMinimumVersions(nil, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(minimumVersions.Kubernetes).To(BeNil())
+			Expect(minimumVersions.MachineImages).To(BeEmpty())
+		})
+
+		It("should fail for invalid versions", func() {
+			policies[1].Spec.Kubernetes.MinimumVersion = "foo"
+
+			_, err := ComputeMinimumVersions(policies, false)
+			Expect(err).To(MatchError(ContainSubstring(`failed parsing minimum Kubernetes version of VersionPolicy "recommended"`)))
+		})
+	})
+
+	Describe("#ShootViolations", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.2"},
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{
+								Name:    "worker1",
+								Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.2.0")}},
+							},
+							{
+								Name:       "worker2",
+								Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.29.5")},
+								Machine:    gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "ubuntu", Version: ptr.To("20.4.0")}},
+							},
+							{
+								Name:    "worker3",
+								Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "suse", Version: ptr.To("1.0.0")}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return no violations for compliant shoots", func() {
+			minimumVersions, err := ComputeMinimumVersions(policies, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(minimumVersions.ShootViolations(shoot)).To(BeEmpty())
+		})
+
+		It("should return all violations", func() {
+			minimumVersions, err := ComputeMinimumVersions(policies, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(minimumVersions.ShootViolations(shoot)).To(ConsistOf(
+				`Kubernetes version "1.29.5" of worker pool "worker2" is lower than the minimum version "1.30.0"`,
+				`version "20.4.0" of machine image "ubuntu" of worker pool "worker2" is lower than the minimum version "22.4.0"`,
+			))
+		})
+
+		It("should report the control plane version", func() {
+			shoot.Spec.Kubernetes.Version = "1.29.9"
+			minimumVersions, err := ComputeMinimumVersions(policies[1:], false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(minimumVersions.ShootViolations(shoot)).To(ContainElement(`Kubernetes version "1.29.9" of the control plane is lower than the minimum version "1.30.0"`))
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"slices"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
@@ -359,6 +360,68 @@ func FindImages(v ImageVector, names []string, opts ...FindOptionFunc) (map[stri
 	return images, nil
 }
 
+// FindImageForArchitectures returns the image with the given <name> from the sources in the image vector which can be
+// used on machines of all given <architectures>, e.g., for DaemonSets running on all nodes of a cluster with worker
+// pools of different architectures. An error is returned if the image resolved for one of the architectures differs
+// from the others, i.e., if there is no image with multi-architecture support for all given architectures.
+func FindImageForArchitectures(v ImageVector, name string, architectures []string, opts ...FindOptionFunc) (*Image, error) {
+	if len(architectures) == 0 {
+		return v.FindImage(name, opts...)
+	}
+
+	var image *Image
+	for _, architecture := range architectures {
+		img, err := v.FindImage(name, append(slices.Clone(opts), Architecture(architecture))...)
+		if err != nil {
+			return nil, err
+		}
+
+		if image == nil {
+			image = img
+		} else if image.String() != img.String() {
+			return nil, fmt.Errorf("image %q has no multi-architecture support for architectures %v", name, architectures)
+		}
+	}
+
+	return image, nil
+}
+
+// FindAllImages returns all images from the image vector whose runtime and target version constraints are satisfied by
+// the given options, i.e., all images which might be used for the given versions. Architecture constraints are ignored,
+// so images for all architectures are returned. Image sources without a tag are skipped if no target version is given.
+func FindAllImages(v ImageVector, opts ...FindOptionFunc) ([]*Image, error) {
+	o := &FindOptions{}
+	o = o.ApplyOptions(opts)
+
+	var (
+		images []*Image
+		seen   = sets.New[string]()
+	)
+
+	for _, source := range v {
+		if _, ok, err := checkVersionConstraint(source.RuntimeVersion, o.RuntimeVersion); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		if _, ok, err := checkVersionConstraint(source.TargetVersion, o.TargetVersion); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		image := source.ToImage(o.TargetVersion)
+		if image.Tag == nil || seen.Has(image.String()) {
+			continue
+		}
+
+		seen.Insert(image.String())
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
 // ToImage applies the given <targetK8sVersion> to the source to produce an output image.
 // If the tag of an image source is empty, it will use the given <targetVersion> as tag.
 func (i *ImageSource) ToImage(targetVersion *string) *Image {
@@ -597,6 +597,71 @@ images:
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		Describe("#FindAllImages", func() {
+			var (
+				untaggedSrc = &ImageSource{Name: "untagged", Repository: "untagged-repo"}
+				oldSrc      = &ImageSource{Name: "versioned", Repository: "old-repo", Tag: ptr.To("v1"), TargetVersion: ptr.To("< 1.30")}
+				newSrc      = &ImageSource{Name: "versioned", Repository: "new-repo", Tag: ptr.To("v2"), TargetVersion: ptr.To(">= 1.30")}
+				amd64Src    = &ImageSource{Name: "single", Repository: "amd64-repo", Tag: ptr.To("v1"), Architectures: []string{amd64}}
+				arm64Src    = &ImageSource{Name: "single", Repository: "arm64-repo", Tag: ptr.To("v1"), Architectures: []string{arm64}}
+				duplicate   = &ImageSource{Name: "duplicate", Repository: "amd64-repo", Tag: ptr.To("v1")}
+				v           = ImageVector{untaggedSrc, oldSrc, newSrc, amd64Src, arm64Src, duplicate}
+			)
+
+			It("should return all images matching the target version", func() {
+				Expect(FindAllImages(v, TargetVersion("1.30.1"))).To(Equal([]*Image{
+					untaggedSrc.ToImage(ptr.To("1.30.1")),
+					newSrc.ToImage(ptr.To("1.30.1")),
+					amd64Src.ToImage(ptr.To("1.30.1")),
+					arm64Src.ToImage(ptr.To("1.30.1")),
+				}))
+			})
+
+			It("should skip images without tag if no target version is given", func() {
+				Expect(FindAllImages(v)).To(Equal([]*Image{
+					oldSrc.ToImage(nil),
+					newSrc.ToImage(nil),
+					amd64Src.ToImage(nil),
+					arm64Src.ToImage(nil),
+				}))
+			})
+
+			It("should fail for an invalid version constraint", func() {
+				_, err := FindAllImages(ImageVector{{Name: "invalid", Repository: "repo", TargetVersion: ptr.To("invalid")}}, TargetVersion("1.30.1"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("#FindImageForArchitectures", func() {
+			var (
+				multiArchSrc = &ImageSource{Name: "multi", Repository: "multi-repo", Tag: ptr.To("v1")}
+				amd64Src     = &ImageSource{Name: "single", Repository: "amd64-repo", Tag: ptr.To("v1"), Architectures: []string{amd64}}
+				arm64Src     = &ImageSource{Name: "single", Repository: "arm64-repo", Tag: ptr.To("v1"), Architectures: []string{arm64}}
+			)
+
+			It("should find the image without architectures", func() {
+				Expect(FindImageForArchitectures(ImageVector{amd64Src}, "single", nil)).To(Equal(amd64Src.ToImage(nil)))
+			})
+
+			It("should find an image with multi-architecture support", func() {
+				Expect(FindImageForArchitectures(ImageVector{multiArchSrc}, "multi", []string{amd64, arm64})).To(Equal(multiArchSrc.ToImage(nil)))
+			})
+
+			It("should find the image for a single architecture", func() {
+				Expect(FindImageForArchitectures(ImageVector{amd64Src, arm64Src}, "single", []string{arm64})).To(Equal(arm64Src.ToImage(nil)))
+			})
+
+			It("should fail if the images differ per architecture", func() {
+				_, err := FindImageForArchitectures(ImageVector{amd64Src, arm64Src}, "single", []string{amd64, arm64})
+				Expect(err).To(MatchError(ContainSubstring("has no multi-architecture support")))
+			})
+
+			It("should fail if there is no image for one of the architectures", func() {
+				_, err := FindImageForArchitectures(ImageVector{amd64Src}, "single", []string{amd64, arm64})
+				Expect(err).To(MatchError(ContainSubstring("could not find image")))
+			})
+		})
 	})
 
 	Describe("> Image", func() {
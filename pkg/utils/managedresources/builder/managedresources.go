@@ -115,6 +115,12 @@ func (m *ManagedResource) DeletePersistentVolumeClaims(v bool) *ManagedResource
 	return m
 }
 
+// WithCRDManagement sets the CRDManagement field.
+func (m *ManagedResource) WithCRDManagement(crdManagement *resourcesv1alpha1.CRDManagement) *ManagedResource {
+	m.resource.Spec.CRDManagement = crdManagement
+	return m
+}
+
 // Reconcile creates or updates the ManagedResource as well as marks all referenced secrets as garbage collectable.
 func (m *ManagedResource) Reconcile(ctx context.Context) error {
 	resource := &resourcesv1alpha1.ManagedResource{
@@ -297,6 +297,7 @@ var _ = Describe("Resource Manager", func() {
 					ForceOverwriteLabels(forceOverwriteLabels).
 					KeepObjects(keepObjects).
 					DeletePersistentVolumeClaims(deletePersistentVolumeClaims).
+					WithCRDManagement(&resourcesv1alpha1.CRDManagement{WaitForEstablished: ptr.To(true)}).
 					Reconcile(ctx),
 			).To(Succeed())
 
@@ -319,6 +320,7 @@ var _ = Describe("Resource Manager", func() {
 					ForceOverwriteLabels:         ptr.To(forceOverwriteLabels),
 					KeepObjects:                  ptr.To(keepObjects),
 					DeletePersistentVolumeClaims: ptr.To(deletePersistentVolumeClaims),
+					CRDManagement:                &resourcesv1alpha1.CRDManagement{WaitForEstablished: ptr.To(true)},
 				},
 			}
 
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+var _ oci.ArtifactMirror = &Mirror{}
+
+// Mirror implements oci.ArtifactMirror and records the artifacts synced via `.Sync()`.
+type Mirror struct {
+	mu        sync.Mutex
+	artifacts sets.Set[string]
+	failures  map[string]error
+}
+
+// NewMirror returns a new mirror.
+func NewMirror() *Mirror {
+	return &Mirror{
+		artifacts: sets.New[string](),
+		failures:  make(map[string]error),
+	}
+}
+
+// Sync implements oci.ArtifactMirror.
+func (m *Mirror) Sync(_ context.Context, source string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err, ok := m.failures[source]; ok {
+		return fmt.Errorf("failed syncing %s: %w", source, err)
+	}
+	m.artifacts.Insert(source)
+	return nil
+}
+
+// Exists implements oci.ArtifactMirror.
+func (m *Mirror) Exists(_ context.Context, source string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.artifacts.Has(source), nil
+}
+
+// AddArtifact adds an artifact to the fake mirror.
+func (m *Mirror) AddArtifact(source string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.artifacts.Insert(source)
+}
+
+// SetFailure makes syncing the given artifact fail with the given error.
+func (m *Mirror) SetFailure(source string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failures[source] = err
+}
+
+// Artifacts returns the artifacts contained in the fake mirror.
+func (m *Mirror) Artifacts() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return sets.List(m.artifacts)
+}
@@ -21,6 +21,9 @@ import (
 const (
 	mediaTypeHelm = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
 
+	// verifiedCacheKeySuffix is appended to the cache keys of charts whose signature was verified.
+	verifiedCacheKeySuffix = "#verified"
+
 	localRegistry        = "localhost:5001"
 	inKubernetesRegistry = "garden.local.gardener.cloud:5001"
 )
@@ -32,7 +35,9 @@ type Interface interface {
 
 // HelmRegistry can pull OCI Helm Charts.
 type HelmRegistry struct {
-	cache cacher
+	cache    cacher
+	verifier *signatureVerifier
+	mirror   *Mirror
 }
 
 // NewHelmRegistry creates a new HelmRegistry.
@@ -42,12 +47,46 @@ func NewHelmRegistry() (*HelmRegistry, error) {
 	}, nil
 }
 
+// NewHelmRegistryWithSignatureVerification creates a new HelmRegistry which only returns charts carrying a valid
+// cosign signature of one of the given PEM-encoded public keys.
+func NewHelmRegistryWithSignatureVerification(publicKeysPEM []string) (*HelmRegistry, error) {
+	if len(publicKeysPEM) == 0 {
+		return nil, fmt.Errorf("at least one public key is required for signature verification")
+	}
+
+	verifier := &signatureVerifier{}
+	for i, publicKeyPEM := range publicKeysPEM {
+		publicKey, err := ParsePublicKey(publicKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key at index %d: %w", i, err)
+		}
+		verifier.publicKeys = append(verifier.publicKeys, publicKey)
+	}
+
+	return &HelmRegistry{
+		cache:    defaultCache,
+		verifier: verifier,
+	}, nil
+}
+
+// WithMirror configures the HelmRegistry to pull all charts from the given registry mirror instead of their original
+// location.
+func (r *HelmRegistry) WithMirror(mirror *Mirror) *HelmRegistry {
+	r.mirror = mirror
+	return r
+}
+
 // Pull from the repository and return the compressed archive.
 func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
 	ref, err := buildRef(oci)
 	if err != nil {
 		return nil, err
 	}
+	if r.mirror != nil {
+		if ref, err = r.mirror.Reference(ref); err != nil {
+			return nil, err
+		}
+	}
 	remoteOpts := []remote.Option{
 		remote.WithContext(ctx),
 	}
@@ -56,6 +95,10 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 	if err != nil {
 		return nil, err
 	}
+	// verified charts are cached separately, so that charts pulled without verification are never returned
+	if key != "" && r.verifier != nil {
+		key += verifiedCacheKeySuffix
+	}
 	if key != "" {
 		if blob, found := r.cache.Get(key); found {
 			return blob, nil
@@ -76,19 +119,24 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 	if err != nil {
 		return nil, err
 	}
-	key = ref.Context().Digest(digest.String()).Name()
+	digestRef := ref.Context().Digest(digest.String())
+	key = digestRef.Name()
+	if r.verifier != nil {
+		if err := r.verifier.Verify(digestRef, remoteOpts...); err != nil {
+			return nil, err
+		}
+		key += verifiedCacheKeySuffix
+	}
 	r.cache.Set(key, blob)
 
 	return blob, nil
 }
 
 func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
-	ref := oci.GetURL()
-
-	opts := []name.Option{
-		name.StrictValidation,
-	}
+	return parseReference(oci.GetURL(), name.StrictValidation)
+}
 
+func parseReference(ref string, opts ...name.Option) (name.Reference, error) {
 	// in the local setup, we need to replace the registry and configure that we don't want to use TLS
 	if strings.Contains(ref, localRegistry) {
 		ref = strings.Replace(ref, localRegistry, inKubernetesRegistry, 1)
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// ArtifactMirror copies OCI artifacts to a registry mirror. Artifacts are identified by their references, e.g.,
+// `Image.String()` for container images or `OCIRepository.GetURL()` for Helm charts.
+type ArtifactMirror interface {
+	// Sync copies the artifact with the given reference to the mirror.
+	Sync(ctx context.Context, source string) error
+	// Exists checks whether the mirror contains the artifact with the given reference.
+	Exists(ctx context.Context, source string) (bool, error)
+}
+
+var _ ArtifactMirror = &Mirror{}
+
+// Mirror copies OCI artifacts, i.e., container images and Helm charts, to a registry mirror. The artifacts are stored
+// below the address of the mirror in a repository consisting of the registry host and the repository of the source,
+// e.g., `europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet` is stored in
+// `<mirror>/europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet`. Colons separating the port of the
+// source registry host are replaced by underscores, as they are not allowed in repository names.
+type Mirror struct {
+	registry string
+	insecure bool
+}
+
+// NewMirror creates a new Mirror for the registry with the given address (`host[:port][/path]`). If insecure is true,
+// the registry is accessed via plain HTTP.
+func NewMirror(registry string, insecure bool) (*Mirror, error) {
+	m := &Mirror{
+		registry: strings.TrimSuffix(registry, "/"),
+		insecure: insecure,
+	}
+
+	if _, err := name.NewRepository(path.Join(m.registry, "gardener"), m.nameOptions()...); err != nil {
+		return nil, fmt.Errorf("invalid registry mirror address %q: %w", registry, err)
+	}
+
+	return m, nil
+}
+
+// Reference returns the reference of the given source artifact in the mirror.
+func (m *Mirror) Reference(source name.Reference) (name.Reference, error) {
+	repository, err := name.NewRepository(path.Join(
+		m.registry,
+		strings.ReplaceAll(source.Context().RegistryStr(), ":", "_"),
+		source.Context().RepositoryStr(),
+	), m.nameOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ref := source.(type) {
+	case name.Digest:
+		return repository.Digest(ref.DigestStr()), nil
+	case name.Tag:
+		return repository.Tag(ref.TagStr()), nil
+	default:
+		return nil, fmt.Errorf("unsupported reference type %T", source)
+	}
+}
+
+// Sync copies the artifact with the given reference to the mirror unless the mirror already contains it. The cosign
+// signatures of the artifact are copied as well (if existing), so that they can still be verified when the artifact is
+// pulled from the mirror.
+func (m *Mirror) Sync(ctx context.Context, source string) error {
+	sourceRef, err := parseReference(source)
+	if err != nil {
+		return fmt.Errorf("failed parsing reference %q: %w", source, err)
+	}
+	mirrorRef, err := m.Reference(sourceRef)
+	if err != nil {
+		return fmt.Errorf("failed computing mirror reference for %q: %w", source, err)
+	}
+
+	remoteOpts := []remote.Option{
+		remote.WithContext(ctx),
+	}
+
+	desc, err := remote.Get(sourceRef, remoteOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to get artifact %s: %w", sourceRef, err)
+	}
+	if err := copyArtifact(desc, mirrorRef, remoteOpts...); err != nil {
+		return fmt.Errorf("failed to copy artifact %s to %s: %w", sourceRef, mirrorRef, err)
+	}
+
+	sourceSignatureRef := signatureTag(sourceRef.Context(), desc.Digest.String())
+	signatureDesc, err := remote.Get(sourceSignatureRef, remoteOpts...)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get signatures %s: %w", sourceSignatureRef, err)
+	}
+
+	mirrorSignatureRef := signatureTag(mirrorRef.Context(), desc.Digest.String())
+	if err := copyArtifact(signatureDesc, mirrorSignatureRef, remoteOpts...); err != nil {
+		return fmt.Errorf("failed to copy signatures %s to %s: %w", sourceSignatureRef, mirrorSignatureRef, err)
+	}
+
+	return nil
+}
+
+// Exists checks whether the mirror contains the artifact with the given reference.
+func (m *Mirror) Exists(ctx context.Context, source string) (bool, error) {
+	sourceRef, err := parseReference(source)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing reference %q: %w", source, err)
+	}
+	mirrorRef, err := m.Reference(sourceRef)
+	if err != nil {
+		return false, fmt.Errorf("failed computing mirror reference for %q: %w", source, err)
+	}
+
+	if _, err := remote.Head(mirrorRef, remote.WithContext(ctx)); err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check artifact %s: %w", mirrorRef, err)
+	}
+
+	return true, nil
+}
+
+func (m *Mirror) nameOptions() []name.Option {
+	if m.insecure {
+		return []name.Option{name.Insecure}
+	}
+	return nil
+}
+
+// copyArtifact writes the artifact described by the given descriptor to the destination unless the destination
+// already points to an artifact with the same digest.
+func copyArtifact(desc *remote.Descriptor, destination name.Reference, opts ...remote.Option) error {
+	if existing, err := remote.Head(destination, opts...); err == nil && existing.Digest == desc.Digest {
+		return nil
+	}
+
+	if desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return err
+		}
+		return remote.WriteIndex(destination, index, opts...)
+	}
+
+	image, err := desc.Image()
+	if err != nil {
+		return err
+	}
+	return remote.Write(destination, image, opts...)
+}
+
+func isNotFound(err error) bool {
+	var transportErr *transport.Error
+	return errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound
+}
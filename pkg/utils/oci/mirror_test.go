@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("Mirror", func() {
+	const digest = "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a"
+
+	var ctx = context.Background()
+
+	Describe("#NewMirror", func() {
+		It("should fail for an invalid registry address", func() {
+			_, err := NewMirror("registry.example.com/Mirror", false)
+			Expect(err).To(MatchError(ContainSubstring("invalid registry mirror address")))
+		})
+	})
+
+	Describe("#Reference", func() {
+		var mirror *Mirror
+
+		BeforeEach(func() {
+			var err error
+			mirror, err = NewMirror("registry.garden.svc:5000/mirror/", true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should compute the reference in the mirror",
+			func(source string, want name.Reference) {
+				sourceRef, err := name.ParseReference(source)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mirror.Reference(sourceRef)).To(Equal(want))
+			},
+			Entry("tag",
+				"europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet:v1.0.0",
+				name.MustParseReference("registry.garden.svc:5000/mirror/europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet:v1.0.0", name.Insecure),
+			),
+			Entry("digest",
+				"europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet@"+digest,
+				name.MustParseReference("registry.garden.svc:5000/mirror/europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet@"+digest, name.Insecure),
+			),
+			Entry("registry host with port",
+				"registry.example.com:8443/foo:v1.0.0",
+				name.MustParseReference("registry.garden.svc:5000/mirror/registry.example.com_8443/foo:v1.0.0", name.Insecure),
+			),
+			Entry("docker hub image without registry host",
+				"alpine:3.20",
+				name.MustParseReference("registry.garden.svc:5000/mirror/index.docker.io/library/alpine:3.20", name.Insecure),
+			),
+		)
+	})
+
+	Describe("#Sync and #Exists", func() {
+		var (
+			mirror      *Mirror
+			repository  string
+			chartDigest string
+		)
+
+		BeforeEach(func() {
+			suffix := strings.ToLower(strings.ReplaceAll(CurrentSpecReport().LeafNodeText, " ", "-"))
+
+			var err error
+			mirror, err = NewMirror(registryAddress+"/mirror-"+suffix, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			repository, chartDigest = pushChart("mirror-" + suffix)
+		})
+
+		It("should copy the artifact to the mirror", func() {
+			source := repository + ":0.1.0"
+
+			Expect(mirror.Exists(ctx, source)).To(BeFalse())
+			Expect(mirror.Sync(ctx, source)).To(Succeed())
+			Expect(mirror.Exists(ctx, source)).To(BeTrue())
+			Expect(mirror.Exists(ctx, repository+"@"+chartDigest)).To(BeTrue())
+
+			// syncing again is a no-op
+			Expect(mirror.Sync(ctx, source)).To(Succeed())
+		})
+
+		It("should copy the signatures of the artifact", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(repository, chartDigest, chartDigest, key)
+
+			Expect(mirror.Sync(ctx, repository+"@"+chartDigest)).To(Succeed())
+
+			hr, err := NewHelmRegistryWithSignatureVerification([]string{publicKeyPEM(key)})
+			Expect(err).NotTo(HaveOccurred())
+			hr.cache = newCache()
+
+			Expect(hr.WithMirror(mirror).Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Digest: ptr.To(chartDigest)})).To(Equal(rawChart))
+		})
+
+		It("should pull charts from the mirror", func() {
+			hr := (&HelmRegistry{cache: newCache()}).WithMirror(mirror)
+			oci := &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")}
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(HaveOccurred())
+
+			Expect(mirror.Sync(ctx, repository+":0.1.0")).To(Succeed())
+			Expect(hr.Pull(ctx, oci)).To(Equal(rawChart))
+
+			sourceRef, err := name.ParseReference(repository + ":0.1.0")
+			Expect(err).NotTo(HaveOccurred())
+			mirrorRef, err := mirror.Reference(sourceRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mirrorRef.Name()).To(HavePrefix(registryAddress + "/mirror-"))
+			_, err = remote.Head(mirrorRef)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the artifact does not exist", func() {
+			Expect(mirror.Sync(ctx, repository+":0.2.0")).To(MatchError(ContainSubstring("failed to get artifact")))
+		})
+	})
+})
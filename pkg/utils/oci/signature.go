@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	// mediaTypeCosignSimpleSigning is the media type of the layers of cosign signature artifacts.
+	mediaTypeCosignSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// annotationCosignSignature is the layer annotation containing the base64-encoded signature of the layer payload.
+	annotationCosignSignature = "dev.cosignproject.cosign/signature"
+	// signatureTagSuffix is the suffix of the tag under which cosign stores the signatures of an artifact.
+	signatureTagSuffix = ".sig"
+)
+
+// ErrSignatureVerificationFailed is returned (wrapped) when an artifact does not carry a valid signature of any of the
+// trusted public keys.
+var ErrSignatureVerificationFailed = errors.New("signature verification failed")
+
+// ParsePublicKey parses the given PEM-encoded public key. ECDSA, RSA, and Ed25519 keys are supported.
+func ParsePublicKey(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key: %w", err)
+	}
+
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// simpleSigningPayload is the payload signed by cosign, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// signatureVerifier verifies cosign signatures of OCI artifacts against a set of trusted public keys.
+type signatureVerifier struct {
+	publicKeys []crypto.PublicKey
+}
+
+// Verify checks that the artifact with the given digest carries a cosign signature of one of the trusted public keys.
+// Cosign stores the signatures of an artifact in the same repository under the tag `sha256-<hex>.sig`.
+func (v *signatureVerifier) Verify(ref name.Digest, opts ...remote.Option) error {
+	signatureRef := signatureTag(ref.Context(), ref.DigestStr())
+
+	signatureImage, err := remote.Image(signatureRef, opts...)
+	if err != nil {
+		return fmt.Errorf("%w: failed to pull signatures %s: %w", ErrSignatureVerificationFailed, signatureRef, err)
+	}
+
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("%w: failed to read manifest of signatures %s: %w", ErrSignatureVerificationFailed, signatureRef, err)
+	}
+
+	for _, descriptor := range manifest.Layers {
+		if string(descriptor.MediaType) != mediaTypeCosignSimpleSigning {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(descriptor.Annotations[annotationCosignSignature])
+		if err != nil || len(signature) == 0 {
+			continue
+		}
+
+		payload, err := readLayer(signatureImage, descriptor.Digest)
+		if err != nil {
+			return err
+		}
+
+		if v.verifyPayload(payload, signature, ref.DigestStr()) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: no valid signature of a trusted public key found for %s", ErrSignatureVerificationFailed, ref)
+}
+
+// signatureTag returns the tag under which cosign stores the signatures of the artifact with the given digest.
+func signatureTag(repository name.Repository, digest string) name.Tag {
+	return repository.Tag(strings.Replace(digest, ":", "-", 1) + signatureTagSuffix)
+}
+
+func (v *signatureVerifier) verifyPayload(payload, signature []byte, digest string) bool {
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil || p.Critical.Image.DockerManifestDigest != digest {
+		return false
+	}
+
+	hash := sha256.Sum256(payload)
+	for _, publicKey := range v.publicKeys {
+		switch key := publicKey.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func readLayer(image gcrv1.Image, digest gcrv1.Hash) ([]byte, error) {
+	layer, err := image.LayerByDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signature layer %s: %w", digest, err)
+	}
+
+	blob, err := layer.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signature layer %s: %w", digest, err)
+	}
+	defer blob.Close()
+
+	return io.ReadAll(blob)
+}
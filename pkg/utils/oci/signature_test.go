@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	helmregistry "helm.sh/helm/v3/pkg/registry"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+)
+
+var _ = Describe("signature verification", func() {
+	var (
+		ctx = context.Background()
+
+		trustedKey, untrustedKey *ecdsa.PrivateKey
+		rc                       *recordingCache
+		hr                       *HelmRegistry
+	)
+
+	BeforeEach(func() {
+		var err error
+		trustedKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		untrustedKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		hr, err = NewHelmRegistryWithSignatureVerification([]string{publicKeyPEM(trustedKey)})
+		Expect(err).NotTo(HaveOccurred())
+		rc = &recordingCache{cache: newCache()}
+		hr.cache = rc
+	})
+
+	Describe("#NewHelmRegistryWithSignatureVerification", func() {
+		It("should fail without public keys", func() {
+			_, err := NewHelmRegistryWithSignatureVerification(nil)
+			Expect(err).To(MatchError(ContainSubstring("at least one public key is required")))
+		})
+
+		It("should fail for invalid public keys", func() {
+			_, err := NewHelmRegistryWithSignatureVerification([]string{publicKeyPEM(trustedKey), "foo"})
+			Expect(err).To(MatchError("invalid public key at index 1: no PEM block found"))
+		})
+	})
+
+	Describe("#Pull", func() {
+		It("should return the chart if it was signed with a trusted key", func() {
+			repository, digest := pushChart("signed")
+			pushSignature(repository, digest, digest, trustedKey)
+
+			out, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should cache verified charts", func() {
+			repository, digest := pushChart("cached")
+			pushSignature(repository, digest, digest, trustedKey)
+			oci := &gardencorev1.OCIRepository{Repository: ptr.To(repository), Digest: ptr.To(digest)}
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rc.cacheHits).To(Equal(0))
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rc.cacheHits).To(Equal(1))
+		})
+
+		It("should not return charts cached without verification", func() {
+			repository, digest := pushChart("cached-unverified")
+			oci := &gardencorev1.OCIRepository{Repository: ptr.To(repository), Digest: ptr.To(digest)}
+
+			_, err := (&HelmRegistry{cache: rc}).Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ErrSignatureVerificationFailed))
+		})
+
+		It("should fail if the chart is not signed", func() {
+			repository, _ := pushChart("unsigned")
+
+			_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+			Expect(err).To(MatchError(ErrSignatureVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("failed to pull signatures")))
+		})
+
+		It("should fail if the chart was signed with an untrusted key", func() {
+			repository, digest := pushChart("untrusted")
+			pushSignature(repository, digest, digest, untrustedKey)
+
+			_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+			Expect(err).To(MatchError(ErrSignatureVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("no valid signature of a trusted public key found")))
+		})
+
+		It("should fail if the signature was issued for a different artifact", func() {
+			repository, digest := pushChart("other-digest")
+			pushSignature(repository, digest, "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", trustedKey)
+
+			_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{Repository: ptr.To(repository), Tag: ptr.To("0.1.0")})
+			Expect(err).To(MatchError(ErrSignatureVerificationFailed))
+		})
+	})
+})
+
+func publicKeyPEM(key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// pushChart pushes the example chart to a new repository and returns the repository and the digest of the chart.
+func pushChart(repositoryName string) (string, string) {
+	c, err := helmregistry.NewClient()
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	repository := fmt.Sprintf("%s/charts/%s/example", registryAddress, repositoryName)
+	res, err := c.Push(rawChart, repository+":0.1.0")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return repository, res.Manifest.Digest
+}
+
+// pushSignature pushes a cosign signature of the given signed digest for the artifact with the given digest.
+func pushSignature(repository, digest, signedDigest string, key crypto.Signer) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, repository, signedDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	image, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, types.MediaType(mediaTypeCosignSimpleSigning)),
+		Annotations: map[string]string{annotationCosignSignature: base64.StdEncoding.EncodeToString(signature)},
+	})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	tag, err := name.NewTag(repository + ":" + strings.Replace(digest, ":", "-", 1) + signatureTagSuffix)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, remote.Write(tag, mutate.MediaType(image, types.OCIManifestSchema1))).To(Succeed())
+}
@@ -28,6 +28,10 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 		return nil, fmt.Errorf("failed applying generate options for config %s: %w", config.GetName(), err)
 	}
 
+	if validity, ok := m.certificateValidity(certificateSecretConfig(config)); ok && options.RenewAfterValidityPercentage == 0 {
+		options.RenewAfterValidityPercentage = validity.RenewAfterValidityPercentage
+	}
+
 	var bundleFor *string
 	if options.isBundleSecret {
 		bundleFor = ptr.To(strings.TrimSuffix(config.GetName(), nameSuffixBundle))
@@ -95,6 +99,17 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		certConfig.CommonName = objectMeta.Name
 	}
 
+	// The configured validity is only applied when generating the certificate (and not before computing the object
+	// metadata) so that changing it does not change the secret names, i.e., it does not cause immediate rotations.
+	if certConfig := certificateSecretConfig(config); certConfig != nil {
+		if validity, ok := m.certificateValidity(certConfig); ok && validity.Validity != nil &&
+			(certConfig.Validity == nil || *certConfig.Validity > *validity.Validity) {
+			originalValidity := certConfig.Validity
+			certConfig.Validity = validity.Validity
+			defer func() { certConfig.Validity = originalValidity }()
+		}
+	}
+
 	data, err := config.Generate()
 	if err != nil {
 		return nil, fmt.Errorf("failed generating data: %w", err)
@@ -114,6 +129,10 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		if err := m.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
 			return nil, fmt.Errorf("failed reading existing secret: %w", err)
 		}
+	} else if m.recordRotationHistory && objectMeta.Labels[LabelKeyBundleFor] == "" {
+		if err := m.recordRotation(ctx, config, secret); err != nil {
+			return nil, fmt.Errorf("failed recording rotation history: %w", err)
+		}
 	}
 
 	m.logger.Info("Generated new secret", "configName", config.GetName(), "secretName", secret.Name)
@@ -611,6 +611,96 @@ var _ = Describe("Generate", func() {
 				))
 			})
 
+			Context("with configured certificate validities", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
+
+					mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{
+						CertificateValidities: map[secretsutils.CertType]CertificateValidity{
+							secretsutils.CACert:     {Validity: ptr.To(90 * 24 * time.Hour), RenewAfterValidityPercentage: 50},
+							secretsutils.ServerCert: {Validity: ptr.To(30 * 24 * time.Hour), RenewAfterValidityPercentage: 70},
+						},
+					})
+					Expect(err).NotTo(HaveOccurred())
+					m = mgr.(*manager)
+				})
+
+				It("should apply the configured validity and renewal percentage", func() {
+					By("Generate new CA secret")
+					caSecret, err := m.Generate(ctx, caConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					By("Generate new server secret")
+					serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+					Expect(err).NotTo(HaveOccurred())
+
+					By("Generate new client secret")
+					clientSecret, err := m.Generate(ctx, clientConfig, SignedByCA(caName))
+					Expect(err).NotTo(HaveOccurred())
+
+					By("Verify labels")
+					Expect(caSecret.Labels).To(And(
+						HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().AddDate(10, 0, 0).Unix(), 10)),
+						Not(HaveKey("renew-after-validity-percentage")),
+					), "settings for CAs must be ignored if CAs are not rotated automatically")
+					Expect(serverSecret.Labels).To(And(
+						HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().Add(30*24*time.Hour).Unix(), 10)),
+						HaveKeyWithValue("renew-after-validity-percentage", "70"),
+					))
+					Expect(clientSecret.Labels).To(And(
+						HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().AddDate(10, 0, 0).Unix(), 10)),
+						Not(HaveKey("renew-after-validity-percentage")),
+					))
+
+					By("Verify the certificate config was not mutated")
+					Expect(serverConfig.Validity).To(BeNil())
+				})
+
+				It("should keep a shorter validity and an explicit renewal percentage of the certificate config", func() {
+					_, err := m.Generate(ctx, caConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					serverConfig.Validity = ptr.To(24 * time.Hour)
+					serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName), RenewAfterValidityPercentage(90))
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(serverSecret.Labels).To(And(
+						HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().Add(24*time.Hour).Unix(), 10)),
+						HaveKeyWithValue("renew-after-validity-percentage", "90"),
+					))
+				})
+
+				It("should cap a longer validity of the certificate config", func() {
+					_, err := m.Generate(ctx, caConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					serverConfig.Validity = ptr.To(365 * 24 * time.Hour)
+					serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(serverSecret.Labels).To(HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().Add(30*24*time.Hour).Unix(), 10)))
+					Expect(serverConfig.Validity).To(PointTo(Equal(365 * 24 * time.Hour)))
+				})
+
+				It("should apply the configured validity to CAs if they are rotated automatically", func() {
+					mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, Config{
+						CASecretAutoRotation: true,
+						CertificateValidities: map[secretsutils.CertType]CertificateValidity{
+							secretsutils.CACert: {Validity: ptr.To(90 * 24 * time.Hour), RenewAfterValidityPercentage: 50},
+						},
+					})
+					Expect(err).NotTo(HaveOccurred())
+
+					caSecret, err := mgr.Generate(ctx, caConfig)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(caSecret.Labels).To(And(
+						HaveKeyWithValue("valid-until-time", strconv.FormatInt(fakeClock.Now().Add(90*24*time.Hour).Unix(), 10)),
+						HaveKeyWithValue("renew-after-validity-percentage", "50"),
+					))
+				})
+			})
+
 			It("should keep the same server cert even when the CA rotates", func() {
 				By("Generate new CA secret")
 				caSecret, err := m.Generate(ctx, caConfig)
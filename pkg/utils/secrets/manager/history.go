@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// RotationHistoryLimit is the maximum number of entries kept in the rotation history per secret configuration name.
+const RotationHistoryLimit = 10
+
+// RotationTrigger describes why a new secret was generated.
+type RotationTrigger string
+
+const (
+	// RotationTriggerCreation is a constant for a trigger describing that the secret was generated for the first time.
+	RotationTriggerCreation RotationTrigger = "Creation"
+	// RotationTriggerConfigChange is a constant for a trigger describing that the secret was regenerated because its
+	// configuration has changed.
+	RotationTriggerConfigChange RotationTrigger = "ConfigChange"
+	// RotationTriggerSigningCAChange is a constant for a trigger describing that the certificate was regenerated because
+	// the certificate authority which has signed it has changed.
+	RotationTriggerSigningCAChange RotationTrigger = "SigningCAChange"
+	// RotationTriggerAutoRenewal is a constant for a trigger describing that the secret was regenerated because it was
+	// about to expire.
+	RotationTriggerAutoRenewal RotationTrigger = "AutoRenewal"
+	// RotationTriggerRotation is a constant for a trigger describing that the secret was regenerated because its
+	// rotation was initiated (e.g., by a credentials rotation operation of a shoot).
+	RotationTriggerRotation RotationTrigger = "Rotation"
+)
+
+// RotationHistoryEntry describes the generation of a secret.
+type RotationHistoryEntry struct {
+	// SecretName is the name of the generated secret.
+	SecretName string `json:"secretName"`
+	// Class is the class of the generated secret, e.g. 'certificate-authority' or 'basic-auth'.
+	Class string `json:"class"`
+	// Trigger describes why the secret was generated.
+	Trigger RotationTrigger `json:"trigger"`
+	// GeneratedAt is the time when the secret was generated.
+	GeneratedAt metav1.Time `json:"generatedAt"`
+	// LastRotationInitiationTime is the time when the last rotation of the secret was initiated.
+	LastRotationInitiationTime *metav1.Time `json:"lastRotationInitiationTime,omitempty"`
+}
+
+// RotationHistoryConfigMapName returns the name of the ConfigMap containing the rotation history of the secrets
+// managed by the secrets manager with the given identity.
+func RotationHistoryConfigMapName(identity string) string {
+	return "secrets-manager-rotation-history-" + identity
+}
+
+// ReadRotationHistory reads the rotation history of the secrets managed by the secrets manager with the given identity
+// in the given namespace. The keys of the returned map are the names of the secret configurations, the entries are
+// ordered from oldest to newest.
+func ReadRotationHistory(ctx context.Context, c client.Reader, namespace, identity string) (map[string][]RotationHistoryEntry, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: RotationHistoryConfigMapName(identity), Namespace: namespace}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	history := make(map[string][]RotationHistoryEntry, len(configMap.Data))
+	for name, value := range configMap.Data {
+		var entries []RotationHistoryEntry
+		if err := json.Unmarshal([]byte(value), &entries); err != nil {
+			return nil, fmt.Errorf("failed unmarshalling rotation history for %q: %w", name, err)
+		}
+		history[name] = entries
+	}
+
+	return history, nil
+}
+
+func (m *manager) recordRotation(ctx context.Context, config secretsutils.ConfigInterface, secret *corev1.Secret) error {
+	entry := RotationHistoryEntry{
+		SecretName:  secret.Name,
+		Class:       secretClassForConfig(config),
+		Trigger:     m.rotationTrigger(config.GetName(), secret.Labels),
+		GeneratedAt: metav1.NewTime(m.clock.Now().UTC()),
+	}
+
+	if lastRotationInitiationTime := secret.Labels[LabelKeyLastRotationInitiationTime]; lastRotationInitiationTime != "" {
+		unix, err := strconv.ParseInt(lastRotationInitiationTime, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed parsing last rotation initiation time %q: %w", lastRotationInitiationTime, err)
+		}
+		entry.LastRotationInitiationTime = &metav1.Time{Time: time.Unix(unix, 0).UTC()}
+	}
+
+	m.historyLock.Lock()
+	defer m.historyLock.Unlock()
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: RotationHistoryConfigMapName(m.identity), Namespace: m.namespace}}
+	if err := m.client.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		configMap.Labels = map[string]string{
+			LabelKeyManagedBy:       LabelValueSecretsManager,
+			LabelKeyManagerIdentity: m.identity,
+		}
+		if configMap.Data, err = appendRotationHistoryEntry(nil, config.GetName(), entry); err != nil {
+			return err
+		}
+		return m.client.Create(ctx, configMap)
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	data, err := appendRotationHistoryEntry(configMap.Data, config.GetName(), entry)
+	if err != nil {
+		return err
+	}
+	configMap.Data = data
+	return m.client.Patch(ctx, configMap, patch)
+}
+
+func appendRotationHistoryEntry(data map[string]string, name string, entry RotationHistoryEntry) (map[string]string, error) {
+	if data == nil {
+		data = make(map[string]string, 1)
+	}
+
+	var entries []RotationHistoryEntry
+	if value, ok := data[name]; ok {
+		if err := json.Unmarshal([]byte(value), &entries); err != nil {
+			return nil, fmt.Errorf("failed unmarshalling rotation history for %q: %w", name, err)
+		}
+	}
+
+	entries = append(entries, entry)
+	if len(entries) > RotationHistoryLimit {
+		entries = entries[len(entries)-RotationHistoryLimit:]
+	}
+
+	value, err := json.Marshal(entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling rotation history for %q: %w", name, err)
+	}
+	data[name] = string(value)
+
+	return data, nil
+}
+
+func (m *manager) rotationTrigger(name string, labels map[string]string) RotationTrigger {
+	existingLabels, found := m.existingSecretLabels[name]
+	if !found {
+		return RotationTriggerCreation
+	}
+
+	if existingLabels[LabelKeyLastRotationInitiationTime] != labels[LabelKeyLastRotationInitiationTime] {
+		if m.autoRenewedSecretNames.Has(name) {
+			return RotationTriggerAutoRenewal
+		}
+		return RotationTriggerRotation
+	}
+
+	if existingLabels[LabelKeyChecksumSigningCA] != labels[LabelKeyChecksumSigningCA] {
+		return RotationTriggerSigningCAChange
+	}
+
+	return RotationTriggerConfigChange
+}
+
+func secretClassForConfig(config secretsutils.ConfigInterface) string {
+	switch cfg := config.(type) {
+	case *secretsutils.CertificateSecretConfig:
+		return certificateClass(cfg.CertType)
+	case *secretsutils.ControlPlaneSecretConfig:
+		if cfg.CertificateSecretConfig != nil {
+			return certificateClass(cfg.CertificateSecretConfig.CertType)
+		}
+		return "kubeconfig"
+	case *secretsutils.KubeconfigSecretConfig:
+		return "kubeconfig"
+	case *secretsutils.BasicAuthSecretConfig:
+		return "basic-auth"
+	case *secretsutils.StaticTokenSecretConfig:
+		return "static-token"
+	case *secretsutils.RSASecretConfig:
+		if cfg.UsedForSSH {
+			return "ssh-key-pair"
+		}
+		return "rsa-private-key"
+	case *secretsutils.ETCDEncryptionKeySecretConfig:
+		return "etcd-encryption-key"
+	case *secretsutils.VPNTLSAuthConfig:
+		return "vpn-tls-auth"
+	}
+
+	return "generic"
+}
+
+func certificateClass(certType secretsutils.CertType) string {
+	switch certType {
+	case secretsutils.CACert:
+		return "certificate-authority"
+	case secretsutils.ServerCert:
+		return "server-certificate"
+	case secretsutils.ClientCert:
+		return "client-certificate"
+	case secretsutils.ServerClientCert:
+		return "server-client-certificate"
+	}
+
+	return "certificate"
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Rotation History", func() {
+	var (
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+		identity  = "test"
+		name      = "config"
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		config     *secretsutils.BasicAuthSecretConfig
+
+		newManager = func(config Config) *manager {
+			mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, namespace, identity, config)
+			Expect(err).NotTo(HaveOccurred())
+			return mgr.(*manager)
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		config = &secretsutils.BasicAuthSecretConfig{
+			Name:           name,
+			Format:         secretsutils.BasicAuthFormatNormal,
+			Username:       "foo",
+			PasswordLength: 3,
+		}
+	})
+
+	It("should not record the history if it is not enabled", func() {
+		_, err := newManager(Config{}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: RotationHistoryConfigMapName(identity), Namespace: namespace}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		Expect(ReadRotationHistory(ctx, fakeClient, namespace, identity)).To(BeNil())
+	})
+
+	It("should record the creation and regeneration of secrets", func() {
+		secret, err := newManager(Config{RecordRotationHistory: true}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: RotationHistoryConfigMapName(identity), Namespace: namespace}, configMap)).To(Succeed())
+		Expect(configMap.Labels).To(Equal(map[string]string{
+			"managed-by":       "secrets-manager",
+			"manager-identity": identity,
+		}))
+
+		By("Change config")
+		fakeClock.Step(time.Hour)
+		config.PasswordLength = 4
+		newSecret, err := newManager(Config{RecordRotationHistory: true}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ReadRotationHistory(ctx, fakeClient, namespace, identity)).To(Equal(map[string][]RotationHistoryEntry{
+			name: {
+				{
+					SecretName:  secret.Name,
+					Class:       "basic-auth",
+					Trigger:     RotationTriggerCreation,
+					GeneratedAt: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Local()),
+				},
+				{
+					SecretName:  newSecret.Name,
+					Class:       "basic-auth",
+					Trigger:     RotationTriggerConfigChange,
+					GeneratedAt: metav1.NewTime(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC).Local()),
+				},
+			},
+		}))
+	})
+
+	It("should not record the generation of existing secrets", func() {
+		_, err := newManager(Config{RecordRotationHistory: true}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newManager(Config{RecordRotationHistory: true}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ReadRotationHistory(ctx, fakeClient, namespace, identity)).To(HaveKeyWithValue(name, HaveLen(1)))
+	})
+
+	It("should record automatic renewals", func() {
+		_, err := newManager(Config{RecordRotationHistory: true}).Generate(ctx, config, Validity(30*24*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeClock.Step(21 * 24 * time.Hour)
+		_, err = newManager(Config{RecordRotationHistory: true}).Generate(ctx, config, Validity(30*24*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+
+		history, err := ReadRotationHistory(ctx, fakeClient, namespace, identity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history[name]).To(HaveLen(2))
+		Expect(history[name][1]).To(MatchFields(IgnoreExtras, Fields{
+			"Trigger":                    Equal(RotationTriggerAutoRenewal),
+			"LastRotationInitiationTime": PointTo(Equal(metav1.NewTime(fakeClock.Now().Local()))),
+		}))
+	})
+
+	It("should record initiated rotations", func() {
+		_, err := newManager(Config{RecordRotationHistory: true}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		rotationInitiationTime := fakeClock.Now().Add(time.Hour)
+		_, err = newManager(Config{RecordRotationHistory: true, SecretNamesToTimes: map[string]time.Time{name: rotationInitiationTime}}).Generate(ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		history, err := ReadRotationHistory(ctx, fakeClient, namespace, identity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history[name]).To(HaveLen(2))
+		Expect(history[name][1]).To(MatchFields(IgnoreExtras, Fields{
+			"Trigger":                    Equal(RotationTriggerRotation),
+			"LastRotationInitiationTime": PointTo(Equal(metav1.NewTime(rotationInitiationTime.Local()))),
+		}))
+	})
+
+	It("should record certificates without their bundles", func() {
+		m := newManager(Config{RecordRotationHistory: true})
+		_, err := m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = m.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert}, SignedByCA("ca"))
+		Expect(err).NotTo(HaveOccurred())
+
+		history, err := ReadRotationHistory(ctx, fakeClient, namespace, identity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history).To(MatchAllKeys(Keys{
+			"ca":     ConsistOf(MatchFields(IgnoreExtras, Fields{"Class": Equal("certificate-authority"), "Trigger": Equal(RotationTriggerCreation)})),
+			"server": ConsistOf(MatchFields(IgnoreExtras, Fields{"Class": Equal("server-certificate"), "Trigger": Equal(RotationTriggerCreation)})),
+		}))
+	})
+
+	It("should limit the number of entries per secret", func() {
+		for i := 0; i < RotationHistoryLimit+2; i++ {
+			config.PasswordLength = i + 1
+			_, err := newManager(Config{RecordRotationHistory: true}).Generate(ctx, config)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		history, err := ReadRotationHistory(ctx, fakeClient, namespace, identity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history[name]).To(HaveLen(RotationHistoryLimit))
+		Expect(history[name][0].Trigger).To(Equal(RotationTriggerConfigChange))
+	})
+})
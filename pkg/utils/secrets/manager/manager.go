@@ -14,6 +14,7 @@ import (
 	"github.com/mitchellh/hashstructure/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -67,6 +68,9 @@ const (
 	nameSuffixBundle = "-bundle"
 )
 
+// RenewBeforeExpiration is the duration before the end of their validity at which secrets are renewed at the latest.
+const RenewBeforeExpiration = 10 * 24 * time.Hour
+
 type (
 	manager struct {
 		lock                        sync.Mutex
@@ -77,6 +81,13 @@ type (
 		namespace                   string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		caSecretAutoRotation        bool
+		certificateValidities       map[secretsutils.CertType]CertificateValidity
+
+		recordRotationHistory  bool
+		historyLock            sync.Mutex
+		existingSecretLabels   map[string]map[string]string
+		autoRenewedSecretNames sets.Set[string]
 	}
 
 	nameToUnixTime map[string]string
@@ -100,6 +111,24 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// CertificateValidities contains the validity settings for generated certificates per certificate type. Settings
+		// for CA certificates are only considered if CASecretAutoRotation is enabled since CA certificates are not
+		// renewed automatically otherwise.
+		CertificateValidities map[secretsutils.CertType]CertificateValidity
+		// RecordRotationHistory states whether the generation of new secrets is recorded in a ConfigMap in the namespace
+		// (defaults to false). See RotationHistoryConfigMapName and ReadRotationHistory.
+		RecordRotationHistory bool
+	}
+
+	// CertificateValidity contains validity settings for certificates of a certain type.
+	CertificateValidity struct {
+		// Validity is the validity of certificates whose configuration does not specify a validity. Certificates whose
+		// configuration specifies a longer validity are generated with this validity instead. Changed values only apply
+		// to certificates generated afterwards, i.e., existing certificates are not regenerated immediately.
+		Validity *time.Duration
+		// RenewAfterValidityPercentage is the percentage of the validity after which certificates are renewed if the
+		// Generate call does not specify a percentage itself.
+		RenewAfterValidityPercentage int
 	}
 )
 
@@ -134,6 +163,11 @@ func New(
 		namespace:                   namespace,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		caSecretAutoRotation:        rotation.CASecretAutoRotation,
+		certificateValidities:       rotation.CertificateValidities,
+		recordRotationHistory:       rotation.RecordRotationHistory,
+		existingSecretLabels:        make(map[string]map[string]string),
+		autoRenewedSecretNames:      sets.New[string](),
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -166,6 +200,7 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 		if !found || oldSecret.CreationTimestamp.Time.Before(secret.CreationTimestamp.Time) {
 			nameToNewestSecret[secret.Labels[LabelKeyName]] = *secret.DeepCopy()
 			m.lastRotationInitiationTimes[secret.Labels[LabelKeyName]] = secret.Labels[LabelKeyLastRotationInitiationTime]
+			m.existingSecretLabels[secret.Labels[LabelKeyName]] = secret.Labels
 		}
 	}
 
@@ -183,12 +218,14 @@ func (m *manager) initialize(ctx context.Context, rotation Config) error {
 		if mustRenew {
 			m.logger.Info("Preparing secret for automatic renewal", "secret", secret.Name, "issuedAt", secret.Labels[LabelKeyIssuedAtTime], "validUntil", secret.Labels[LabelKeyValidUntilTime])
 			m.lastRotationInitiationTimes[name] = unixTime(m.clock.Now())
+			m.autoRenewedSecretNames.Insert(name)
 		}
 	}
 
 	// If the user has provided last rotation initiation times then use those.
 	for name, time := range rotation.SecretNamesToTimes {
 		m.lastRotationInitiationTimes[name] = unixTime(time)
+		m.autoRenewedSecretNames.Delete(name)
 	}
 
 	return nil
@@ -227,7 +264,7 @@ func (m *manager) mustAutoRenewSecret(secret corev1.Secret) (bool, error) {
 	)
 
 	// Renew if 80% of the validity has been reached or if the secret expires in less than 10d.
-	return now.After(renewAt) || now.After(validUntil.Add(-10*24*time.Hour)), nil
+	return now.After(renewAt) || now.After(validUntil.Add(-RenewBeforeExpiration)), nil
 }
 
 func (m *manager) addToStore(name string, secret *corev1.Secret, class secretClass) error {
@@ -374,6 +411,23 @@ func isCASecret(data map[string][]byte) bool {
 	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
 }
 
+// certificateValidity returns the configured validity settings for the given certificate configuration. The second
+// return value indicates whether settings were found.
+func (m *manager) certificateValidity(certConfig *secretsutils.CertificateSecretConfig) (CertificateValidity, bool) {
+	if certConfig == nil || (certConfig.CertType == secretsutils.CACert && !m.caSecretAutoRotation) {
+		return CertificateValidity{}, false
+	}
+
+	certType := certConfig.CertType
+	// Certificates used for both server and client authentication are treated like server certificates.
+	if certType == secretsutils.ServerClientCert {
+		certType = secretsutils.ServerCert
+	}
+
+	validity, ok := m.certificateValidities[certType]
+	return validity, ok
+}
+
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
 	var certificateConfig *secretsutils.CertificateSecretConfig
 
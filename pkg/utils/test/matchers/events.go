@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type eventMatcher struct {
+	ctx    context.Context
+	reader client.Reader
+	reason string
+
+	actualReasons []string
+}
+
+func (e *eventMatcher) Match(actual any) (bool, error) {
+	obj, ok := actual.(client.Object)
+	if !ok {
+		return false, fmt.Errorf("expected a client.Object, got:\n%s", format.Object(actual, 1))
+	}
+
+	// Events for cluster-scoped objects are created in the default namespace.
+	namespace := obj.GetNamespace()
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	var (
+		found   bool
+		listErr error
+	)
+
+	// Use a dedicated Gomega instance, so that a failed poll does not fail the test but only makes the matcher return
+	// false. It respects the default timeout and polling interval of Eventually.
+	g := gomega.NewGomega(func(string, ...int) {})
+	g.Eventually(func() bool {
+		eventList := &corev1.EventList{}
+		if listErr = e.reader.List(e.ctx, eventList, client.InNamespace(namespace)); listErr != nil {
+			return false
+		}
+
+		e.actualReasons = nil
+		for _, event := range eventList.Items {
+			if !involves(event, obj) {
+				continue
+			}
+			e.actualReasons = append(e.actualReasons, event.Reason)
+			if event.Reason == e.reason {
+				found = true
+			}
+		}
+		return found
+	}).Should(gomega.BeTrue())
+
+	if !found && listErr != nil {
+		return false, fmt.Errorf("failed listing events: %w", listErr)
+	}
+	return found, nil
+}
+
+func (e *eventMatcher) FailureMessage(actual any) string {
+	return format.Message(actual, fmt.Sprintf("to eventually emit an event with reason %q, but only found events with reasons %v", e.reason, e.actualReasons))
+}
+
+func (e *eventMatcher) NegatedFailureMessage(actual any) string {
+	return format.Message(actual, fmt.Sprintf("not to emit an event with reason %q", e.reason))
+}
+
+func involves(event corev1.Event, obj client.Object) bool {
+	if obj.GetUID() != "" {
+		return event.InvolvedObject.UID == obj.GetUID()
+	}
+	return event.InvolvedObject.Name == obj.GetName() && event.InvolvedObject.Namespace == obj.GetNamespace()
+}
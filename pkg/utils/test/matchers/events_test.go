@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Event Matcher", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		shoot      *gardencorev1beta1.Shoot
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-foo", UID: "shoot-uid"}}
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "foo", UID: "seed-uid"}}
+
+		Expect(fakeClient.Create(ctx, &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "shoot-event", Namespace: shoot.Namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: "Shoot", Name: shoot.Name, Namespace: shoot.Namespace, UID: shoot.UID},
+			Reason:         "Reconciled",
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "seed-event", Namespace: metav1.NamespaceDefault},
+			InvolvedObject: corev1.ObjectReference{Kind: "Seed", Name: seed.Name, UID: seed.UID},
+			Reason:         "Bootstrapped",
+		})).To(Succeed())
+	})
+
+	It("should match an event of a namespaced object", func() {
+		Expect(shoot).To(EmitEventually(ctx, fakeClient, "Reconciled"))
+	})
+
+	It("should match an event of a cluster-scoped object", func() {
+		Expect(seed).To(EmitEventually(ctx, fakeClient, "Bootstrapped"))
+	})
+
+	It("should not match events of other objects or with other reasons", func() {
+		matcher := EmitEventually(ctx, fakeClient, "Bootstrapped")
+
+		Expect(matcher.Match(shoot)).To(BeFalse())
+		Expect(matcher.FailureMessage(shoot)).To(ContainSubstring(`with reason "Bootstrapped", but only found events with reasons [Reconciled]`))
+	})
+})
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	testruntime "github.com/gardener/gardener/pkg/utils/test/runtime"
 )
 
@@ -160,3 +161,35 @@ func NewManagedResourceConsistOfObjectsMatcher(cl client.Client) func(...client.
 		}
 	}
 }
+
+// HaveCondition returns a matcher which checks whether the object it is being compared against has a condition of the
+// given type with the given status and reason. If the reason is empty, it is not checked. The actual value must either
+// be a list of conditions or (a pointer to) a typed Gardener object whose status contains conditions, e.g. a Shoot,
+// Seed, Garden, ManagedResource, or extension object.
+func HaveCondition(conditionType gardencorev1beta1.ConditionType, status gardencorev1beta1.ConditionStatus, reason string) types.GomegaMatcher {
+	return &conditionMatcher{
+		conditionType: conditionType,
+		status:        status,
+		reason:        reason,
+	}
+}
+
+// HaveLastOperation returns a matcher which checks whether the last operation of the object it is being compared
+// against is in the given state. The actual value must either be a last operation or (a pointer to) a typed Gardener
+// object whose status contains a last operation, e.g. a Shoot, Garden, or extension object.
+func HaveLastOperation(state gardencorev1beta1.LastOperationState) types.GomegaMatcher {
+	return &lastOperationMatcher{
+		state: state,
+	}
+}
+
+// EmitEventually returns a matcher which checks whether an event with the given reason is eventually emitted for the
+// object it is being compared against. The events are polled with the given reader using the default timeout and
+// polling interval of Eventually.
+func EmitEventually(ctx context.Context, reader client.Reader, reason string) types.GomegaMatcher {
+	return &eventMatcher{
+		ctx:    ctx,
+		reader: reader,
+		reason: reason,
+	}
+}
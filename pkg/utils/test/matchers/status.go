@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/onsi/gomega/format"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+type conditionMatcher struct {
+	conditionType gardencorev1beta1.ConditionType
+	status        gardencorev1beta1.ConditionStatus
+	reason        string
+
+	actualCondition *gardencorev1beta1.Condition
+}
+
+func (c *conditionMatcher) Match(actual any) (bool, error) {
+	conditions, err := conditionsOf(actual)
+	if err != nil {
+		return false, err
+	}
+
+	c.actualCondition = nil
+	for _, condition := range conditions {
+		if condition.Type == c.conditionType {
+			c.actualCondition = condition.DeepCopy()
+			break
+		}
+	}
+
+	return c.actualCondition != nil &&
+		c.actualCondition.Status == c.status &&
+		(c.reason == "" || c.actualCondition.Reason == c.reason), nil
+}
+
+func (c *conditionMatcher) FailureMessage(_ any) string {
+	return format.Message(c.actual(), "to be", c.expected())
+}
+
+func (c *conditionMatcher) NegatedFailureMessage(_ any) string {
+	return format.Message(c.actual(), "not to be", c.expected())
+}
+
+func (c *conditionMatcher) actual() any {
+	if c.actualCondition == nil {
+		return fmt.Sprintf("no condition of type %s", c.conditionType)
+	}
+	return fmt.Sprintf("condition %s with status %s and reason %q (message: %q)", c.actualCondition.Type, c.actualCondition.Status, c.actualCondition.Reason, c.actualCondition.Message)
+}
+
+func (c *conditionMatcher) expected() string {
+	if c.reason == "" {
+		return fmt.Sprintf("condition %s with status %s", c.conditionType, c.status)
+	}
+	return fmt.Sprintf("condition %s with status %s and reason %q", c.conditionType, c.status, c.reason)
+}
+
+type lastOperationMatcher struct {
+	state gardencorev1beta1.LastOperationState
+
+	actualLastOperation *gardencorev1beta1.LastOperation
+}
+
+func (l *lastOperationMatcher) Match(actual any) (bool, error) {
+	lastOperation, err := lastOperationOf(actual)
+	if err != nil {
+		return false, err
+	}
+
+	l.actualLastOperation = lastOperation
+	return lastOperation != nil && lastOperation.State == l.state, nil
+}
+
+func (l *lastOperationMatcher) FailureMessage(_ any) string {
+	return format.Message(l.actual(), "to be", l.expected())
+}
+
+func (l *lastOperationMatcher) NegatedFailureMessage(_ any) string {
+	return format.Message(l.actual(), "not to be", l.expected())
+}
+
+func (l *lastOperationMatcher) actual() any {
+	if l.actualLastOperation == nil {
+		return "no last operation"
+	}
+	return fmt.Sprintf("last operation %s in state %s (description: %q)", l.actualLastOperation.Type, l.actualLastOperation.State, l.actualLastOperation.Description)
+}
+
+func (l *lastOperationMatcher) expected() string {
+	return fmt.Sprintf("last operation in state %s", l.state)
+}
+
+// conditionsOf returns the conditions of the given object. The object must either be a list of conditions or (a pointer
+// to) a struct whose status contains a 'Conditions' field.
+func conditionsOf(actual any) ([]gardencorev1beta1.Condition, error) {
+	if conditions, ok := actual.([]gardencorev1beta1.Condition); ok {
+		return conditions, nil
+	}
+
+	field, err := statusField(actual, "Conditions")
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, ok := field.Interface().([]gardencorev1beta1.Condition)
+	if !ok {
+		return nil, fmt.Errorf("expected the status conditions to be of type []gardencorev1beta1.Condition, got %s", field.Type())
+	}
+	return conditions, nil
+}
+
+// lastOperationOf returns the last operation of the given object. The object must either be a last operation or (a
+// pointer to) a struct whose status contains a 'LastOperation' field.
+func lastOperationOf(actual any) (*gardencorev1beta1.LastOperation, error) {
+	if lastOperation, ok := actual.(*gardencorev1beta1.LastOperation); ok {
+		return lastOperation, nil
+	}
+
+	field, err := statusField(actual, "LastOperation")
+	if err != nil {
+		return nil, err
+	}
+
+	lastOperation, ok := field.Interface().(*gardencorev1beta1.LastOperation)
+	if !ok {
+		return nil, fmt.Errorf("expected the status last operation to be of type *gardencorev1beta1.LastOperation, got %s", field.Type())
+	}
+	return lastOperation, nil
+}
+
+func statusField(actual any, name string) (reflect.Value, error) {
+	value := reflect.Indirect(reflect.ValueOf(actual))
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("expected a struct or a pointer to a struct, got:\n%s", format.Object(actual, 1))
+	}
+
+	status := reflect.Indirect(value.FieldByName("Status"))
+	if status.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("expected an object with a status, got:\n%s", format.Object(actual, 1))
+	}
+
+	// FieldByName also finds fields of embedded structs, e.g., the DefaultStatus of extension objects.
+	field := status.FieldByName(name)
+	if !field.IsValid() {
+		return reflect.Value{}, fmt.Errorf("expected an object with a status containing %s, got:\n%s", name, format.Object(actual, 1))
+	}
+	return field, nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Status Matchers", func() {
+	var condition gardencorev1beta1.Condition
+
+	BeforeEach(func() {
+		condition = gardencorev1beta1.Condition{
+			Type:   gardencorev1beta1.ShootAPIServerAvailable,
+			Status: gardencorev1beta1.ConditionTrue,
+			Reason: "HealthzRequestSucceeded",
+		}
+	})
+
+	Describe("#HaveCondition", func() {
+		It("should match a condition of a shoot", func() {
+			shoot := &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Conditions: []gardencorev1beta1.Condition{condition}}}
+
+			Expect(shoot).To(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, "HealthzRequestSucceeded"))
+			Expect(shoot).To(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, ""))
+			Expect(shoot).NotTo(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionFalse, ""))
+			Expect(shoot).NotTo(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, "Foo"))
+			Expect(shoot).NotTo(HaveCondition(gardencorev1beta1.ShootControlPlaneHealthy, gardencorev1beta1.ConditionTrue, ""))
+		})
+
+		It("should match a condition of a ManagedResource", func() {
+			managedResource := resourcesv1alpha1.ManagedResource{Status: resourcesv1alpha1.ManagedResourceStatus{Conditions: []gardencorev1beta1.Condition{condition}}}
+
+			Expect(managedResource).To(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, ""))
+		})
+
+		It("should match a condition of an extension object", func() {
+			infrastructure := &extensionsv1alpha1.Infrastructure{}
+			infrastructure.Status.Conditions = []gardencorev1beta1.Condition{condition}
+
+			Expect(infrastructure).To(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, ""))
+		})
+
+		It("should match a list of conditions", func() {
+			Expect([]gardencorev1beta1.Condition{condition}).To(HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, ""))
+		})
+
+		It("should return an error for objects without conditions", func() {
+			matcher := HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionTrue, "")
+
+			_, err := matcher.Match(&gardencorev1beta1.Project{})
+			Expect(err).To(MatchError(ContainSubstring("status containing Conditions")))
+
+			_, err = matcher.Match("foo")
+			Expect(err).To(MatchError(ContainSubstring("expected a struct")))
+		})
+
+		It("should describe the actual condition in the failure message", func() {
+			matcher := HaveCondition(gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ConditionFalse, "Foo")
+
+			Expect(matcher.Match([]gardencorev1beta1.Condition{condition})).To(BeFalse())
+			Expect(matcher.FailureMessage(nil)).To(And(
+				ContainSubstring(`condition APIServerAvailable with status True and reason "HealthzRequestSucceeded"`),
+				ContainSubstring(`condition APIServerAvailable with status False and reason "Foo"`),
+			))
+		})
+	})
+
+	Describe("#HaveLastOperation", func() {
+		It("should match the last operation of a shoot", func() {
+			shoot := &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded}}}
+
+			Expect(shoot).To(HaveLastOperation(gardencorev1beta1.LastOperationStateSucceeded))
+			Expect(shoot).NotTo(HaveLastOperation(gardencorev1beta1.LastOperationStateFailed))
+		})
+
+		It("should match the last operation of an extension object", func() {
+			infrastructure := &extensionsv1alpha1.Infrastructure{}
+			infrastructure.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateError}
+
+			Expect(infrastructure).To(HaveLastOperation(gardencorev1beta1.LastOperationStateError))
+		})
+
+		It("should not match if there is no last operation", func() {
+			matcher := HaveLastOperation(gardencorev1beta1.LastOperationStateSucceeded)
+
+			Expect(matcher.Match(&gardencorev1beta1.Shoot{})).To(BeFalse())
+			Expect(matcher.FailureMessage(nil)).To(ContainSubstring("no last operation"))
+		})
+	})
+})
@@ -23,6 +23,18 @@ func ValidateNetworkDisjointedness(fldPath *field.Path, shootNodes, shootPods, s
 	return allErrs
 }
 
+// ValidateAdditionalNodeNetworksDisjointedness validates that the given additional shoot node networks and the seed
+// networks are disjoint.
+func ValidateAdditionalNodeNetworksDisjointedness(fldPath *field.Path, shootAdditionalNodes []string, seedNodes *string, seedPods, seedServices string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, additionalNodes := range shootAdditionalNodes {
+		allErrs = append(allErrs, validateOverlapWithSeed(fldPath.Child("additionalNodes").Index(i), &additionalNodes, "node", false, seedNodes, seedPods, seedServices)...)
+	}
+
+	return allErrs
+}
+
 func validateOverlapWithSeed(fldPath *field.Path, shootNetwork *string, networkType string, networkRequired bool, seedNodes *string, seedPods, seedServices string) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -580,6 +580,55 @@ var _ = Describe("utils", func() {
 		})
 	})
 
+	Describe("#ValidateAdditionalNodeNetworksDisjointedness", func() {
+		var (
+			seedPodsCIDR     = "10.241.128.0/17"
+			seedServicesCIDR = "10.241.0.0/17"
+			seedNodesCIDR    = "10.240.0.0/16"
+		)
+
+		It("should pass the validation", func() {
+			Expect(ValidateAdditionalNodeNetworksDisjointedness(
+				field.NewPath(""),
+				[]string{"10.243.0.0/16", "10.244.0.0/16"},
+				&seedNodesCIDR,
+				seedPodsCIDR,
+				seedServicesCIDR,
+			)).To(BeEmpty())
+		})
+
+		It("should fail due to disjointedness", func() {
+			Expect(ValidateAdditionalNodeNetworksDisjointedness(
+				field.NewPath(""),
+				[]string{"10.243.0.0/16", "10.240.0.0/24", "10.241.0.0/24", "10.241.128.0/24", "192.168.123.0/24"},
+				&seedNodesCIDR,
+				seedPodsCIDR,
+				seedServicesCIDR,
+			)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("[].additionalNodes[1]"),
+					"Detail": Equal("shoot node network intersects with seed node network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("[].additionalNodes[2]"),
+					"Detail": Equal("shoot node network intersects with seed service network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("[].additionalNodes[3]"),
+					"Detail": Equal("shoot node network intersects with seed pod network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("[].additionalNodes[4]"),
+					"Detail": Equal("shoot node network intersects with default vpn network (192.168.123.0/24)"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateShootNetworkDisjointedness IPv4", func() {
 		It("should pass the validation", func() {
 			var (
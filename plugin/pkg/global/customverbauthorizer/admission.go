@@ -28,6 +28,12 @@ const (
 	// CustomVerbModifyProjectTolerationsWhitelist is a constant for the custom verb that allows modifying the
 	// `.spec.tolerations.whitelist` field in `Project` resources.
 	CustomVerbModifyProjectTolerationsWhitelist = "modify-spec-tolerations-whitelist"
+	// CustomVerbModifyProjectNamespaceResources is a constant for the custom verb that allows modifying the
+	// `.spec.namespaceResources` field in `Project` resources.
+	CustomVerbModifyProjectNamespaceResources = "modify-spec-namespaceresources"
+	// CustomVerbModifyProjectCustomRoles is a constant for the custom verb that allows modifying the
+	// `.spec.customRoles` field in `Project` resources.
+	CustomVerbModifyProjectCustomRoles = "modify-spec-customroles"
 	// CustomVerbProjectManageMembers is a constant for the custom verb that allows to manage human users or
 	// groups subjects in the `.spec.members` field in `Project` resources.
 	CustomVerbProjectManageMembers = "manage-members"
@@ -100,7 +106,21 @@ func (c *CustomVerbAuthorizer) admitProjects(ctx context.Context, a admission.At
 	}
 
 	if mustCheckProjectTolerationsWhitelist(oldObj.Spec.Tolerations, obj.Spec.Tolerations) {
-		return c.authorize(ctx, a, CustomVerbModifyProjectTolerationsWhitelist, "modify .spec.tolerations.whitelist")
+		if err := c.authorize(ctx, a, CustomVerbModifyProjectTolerationsWhitelist, "modify .spec.tolerations.whitelist"); err != nil {
+			return err
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldObj.Spec.NamespaceResources, obj.Spec.NamespaceResources) {
+		if err := c.authorize(ctx, a, CustomVerbModifyProjectNamespaceResources, "modify .spec.namespaceResources"); err != nil {
+			return err
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldObj.Spec.CustomRoles, obj.Spec.CustomRoles) {
+		if err := c.authorize(ctx, a, CustomVerbModifyProjectCustomRoles, "modify .spec.customRoles"); err != nil {
+			return err
+		}
 	}
 
 	if mustCheckProjectMembers(oldObj.Spec.Members, obj.Spec.Members, obj.Spec.Owner, a.GetUserInfo()) {
@@ -10,12 +10,15 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
 	servieaccount "k8s.io/apiserver/pkg/authentication/serviceaccount"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/plugin/pkg/global/customverbauthorizer"
@@ -145,6 +148,140 @@ var _ = Describe("customverbauthorizer", func() {
 				})
 			})
 
+			Context("modify-spec-namespaceresources verb", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbModifyProjectNamespaceResources
+				})
+
+				It("should always allow creating a project without namespace resources", func() {
+					attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a project without changing namespace resources", func() {
+					project.Spec.NamespaceResources = &core.ProjectNamespaceResources{ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")}}
+					oldProject := project.DeepCopy()
+					project.Spec.Description = ptr.To("foo")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a project with namespace resources", func() {
+						project.Spec.NamespaceResources = &core.ProjectNamespaceResources{ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")}}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow updating a project's namespace resources", func() {
+						project.Spec.NamespaceResources = &core.ProjectNamespaceResources{ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")}}
+						oldProject := project.DeepCopy()
+						project.Spec.NamespaceResources.ResourceQuota["count/shoots.core.gardener.cloud"] = resource.MustParse("20")
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a project with namespace resources", func() {
+						project.Spec.NamespaceResources = &core.ProjectNamespaceResources{ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")}}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid removing a project's namespace resources", func() {
+						project.Spec.NamespaceResources = &core.ProjectNamespaceResources{ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("10")}}
+						oldProject := project.DeepCopy()
+						project.Spec.NamespaceResources = nil
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
+
+			Context("modify-spec-customroles verb", func() {
+				var customRoles []core.ProjectCustomRole
+
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbModifyProjectCustomRoles
+					customRoles = []core.ProjectCustomRole{{
+						Name:  "deployer",
+						Rules: []rbacv1.PolicyRule{{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "update"}}},
+					}}
+				})
+
+				It("should always allow creating a project without custom roles", func() {
+					attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a project without changing custom roles", func() {
+					project.Spec.CustomRoles = customRoles
+					oldProject := project.DeepCopy()
+					project.Spec.Description = ptr.To("foo")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a project with custom roles", func() {
+						project.Spec.CustomRoles = customRoles
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow updating a project's custom roles", func() {
+						project.Spec.CustomRoles = customRoles
+						oldProject := project.DeepCopy()
+						project.Spec.CustomRoles[0].Rules[0].Verbs = append(project.Spec.CustomRoles[0].Rules[0].Verbs, "delete")
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a project with custom roles", func() {
+						project.Spec.CustomRoles = customRoles
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid extending a project's custom roles", func() {
+						project.Spec.CustomRoles = customRoles
+						oldProject := project.DeepCopy()
+						project.Spec.CustomRoles[0].Rules = append(project.Spec.CustomRoles[0].Rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}})
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
+
 			Context("manage-members verb", func() {
 				BeforeEach(func() {
 					authorizeAttributes.Verb = CustomVerbProjectManageMembers
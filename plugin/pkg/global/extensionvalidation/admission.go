@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -17,12 +18,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/security"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
@@ -44,11 +49,13 @@ type ExtensionValidator struct {
 	*admission.Handler
 	controllerRegistrationLister gardencorev1beta1listers.ControllerRegistrationLister
 	backupBucketLister           gardencorev1beta1listers.BackupBucketLister
+	secretLister                 kubecorev1listers.SecretLister
 	readyFunc                    admission.ReadyFunc
 }
 
 var (
 	_ = admissioninitializer.WantsCoreInformerFactory(&ExtensionValidator{})
+	_ = admissioninitializer.WantsKubeInformerFactory(&ExtensionValidator{})
 
 	readyFuncs []admission.ReadyFunc
 )
@@ -77,6 +84,14 @@ func (e *ExtensionValidator) SetCoreInformerFactory(f gardencoreinformers.Shared
 	readyFuncs = append(readyFuncs, controllerRegistrationInformer.Informer().HasSynced, backupBucketInformer.Informer().HasSynced)
 }
 
+// SetKubeInformerFactory gets Lister from SharedInformerFactory.
+func (e *ExtensionValidator) SetKubeInformerFactory(f kubeinformers.SharedInformerFactory) {
+	secretInformer := f.Core().V1().Secrets()
+	e.secretLister = secretInformer.Lister()
+
+	readyFuncs = append(readyFuncs, secretInformer.Informer().HasSynced)
+}
+
 func (e *ExtensionValidator) waitUntilReady(attrs admission.Attributes) error {
 	// Wait until the caches have been synced
 	if e.readyFunc == nil {
@@ -105,6 +120,9 @@ func (e *ExtensionValidator) ValidateInitialization() error {
 	if e.backupBucketLister == nil {
 		return errors.New("missing BackupBucket lister")
 	}
+	if e.secretLister == nil {
+		return errors.New("missing Secret lister")
+	}
 	return nil
 }
 
@@ -117,7 +135,7 @@ func (e *ExtensionValidator) Validate(_ context.Context, a admission.Attributes,
 	}
 
 	switch a.GetKind().GroupKind() {
-	case core.Kind("BackupBucket"), core.Kind("BackupEntry"), core.Kind("Seed"), core.Kind("Shoot"):
+	case core.Kind("BackupBucket"), core.Kind("BackupEntry"), core.Kind("Seed"), core.Kind("Shoot"), core.Kind("SecretBinding"), security.Kind("CredentialsBinding"):
 	default:
 		return nil
 	}
@@ -208,6 +226,64 @@ func (e *ExtensionValidator) Validate(_ context.Context, a admission.Attributes,
 		if !apiequality.Semantic.DeepEqual(shoot.Spec, oldShoot.Spec) {
 			validationError = e.validateShoot(kindToTypesMap, computeWorkerlessSupportedExtensionTypes(controllerRegistrationList), shoot.Spec, gardencorehelper.IsWorkerless(shoot))
 		}
+
+	case core.Kind("SecretBinding"):
+		secretBinding, ok := a.GetObject().(*core.SecretBinding)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert object into SecretBinding object")
+		}
+
+		oldSecretBinding := &core.SecretBinding{}
+		if oldObj := a.GetOldObject(); oldObj != nil {
+			oldSecretBinding, ok = oldObj.(*core.SecretBinding)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old object into SecretBinding object")
+			}
+		}
+
+		if secretBinding.Provider != nil &&
+			(!apiequality.Semantic.DeepEqual(secretBinding.Provider, oldSecretBinding.Provider) || !apiequality.Semantic.DeepEqual(secretBinding.SecretRef, oldSecretBinding.SecretRef)) {
+			validationError = e.validateCredentialsProvider(
+				kindToTypesMap,
+				computeRequiredCredentialKeys(controllerRegistrationList),
+				"SecretBinding",
+				gardencorehelper.GetSecretBindingTypes(secretBinding),
+				secretBinding.SecretRef.Namespace,
+				secretBinding.SecretRef.Name,
+			)
+		}
+
+	case security.Kind("CredentialsBinding"):
+		credentialsBinding, ok := a.GetObject().(*security.CredentialsBinding)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert object into CredentialsBinding object")
+		}
+
+		oldCredentialsBinding := &security.CredentialsBinding{}
+		if oldObj := a.GetOldObject(); oldObj != nil {
+			oldCredentialsBinding, ok = oldObj.(*security.CredentialsBinding)
+			if !ok {
+				return apierrors.NewBadRequest("could not convert old object into CredentialsBinding object")
+			}
+		}
+
+		if !apiequality.Semantic.DeepEqual(credentialsBinding.Provider, oldCredentialsBinding.Provider) ||
+			!apiequality.Semantic.DeepEqual(credentialsBinding.CredentialsRef, oldCredentialsBinding.CredentialsRef) {
+			var secretNamespace, secretName string
+			// Only secrets have keys which can be validated, other credentials (e.g., WorkloadIdentities) are not checked.
+			if credentialsBinding.CredentialsRef.APIVersion == "v1" && credentialsBinding.CredentialsRef.Kind == "Secret" {
+				secretNamespace, secretName = credentialsBinding.CredentialsRef.Namespace, credentialsBinding.CredentialsRef.Name
+			}
+
+			validationError = e.validateCredentialsProvider(
+				kindToTypesMap,
+				computeRequiredCredentialKeys(controllerRegistrationList),
+				"CredentialsBinding",
+				[]string{credentialsBinding.Provider.Type},
+				secretNamespace,
+				secretName,
+			)
+		}
 	}
 
 	if validationError != nil {
@@ -258,6 +334,10 @@ func (e *ExtensionValidator) validateSeed(kindToTypesMap map[string]sets.Set[str
 		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.DNSRecordResource, provider.Type, fmt.Sprintf("%s extension type: %s", message, field.NewPath("spec", "dns", "provider").Child("type"))})
 	}
 
+	if spec.Ingress != nil && spec.Ingress.Controller.Kind != v1beta1constants.IngressKindNginx {
+		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, spec.Ingress.Controller.Kind, fmt.Sprintf("%s ingress controller kind: %s", message, field.NewPath("spec", "ingress", "controller", "kind"))})
+	}
+
 	return requiredExtensions.areRegistered(kindToTypesMap)
 }
 
@@ -278,6 +358,9 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 		if spec.Networking != nil && spec.Networking.Type != nil {
 			requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.NetworkResource, *spec.Networking.Type, fmt.Sprintf("%s networking type: %s", message, field.NewPath("spec", "networking", "type"))})
 		}
+		if spec.Networking != nil && spec.Networking.PrivateLink != nil && spec.Networking.PrivateLink.Enabled {
+			requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.PrivateLinkResource, spec.Provider.Type, fmt.Sprintf("%s private link type: %s", message, field.NewPath("spec", "provider", "type"))})
+		}
 	}
 
 	if spec.DNS != nil {
@@ -323,6 +406,62 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 	return result
 }
 
+// validateCredentialsProvider validates that the given provider types are registered and that the referenced secret
+// (if any) contains all keys required by the providers.
+func (e *ExtensionValidator) validateCredentialsProvider(
+	kindToTypesMap map[string]sets.Set[string],
+	typeToRequiredKeys map[string]sets.Set[string],
+	kind string,
+	providerTypes []string,
+	secretNamespace, secretName string,
+) error {
+	var (
+		result  error
+		message = fmt.Sprintf("given %s uses non-registered provider type: %s", kind, field.NewPath("provider", "type"))
+	)
+
+	for _, providerType := range providerTypes {
+		if err := isExtensionRegistered(kindToTypesMap, extensionsv1alpha1.InfrastructureResource, providerType, message); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if result != nil || secretName == "" {
+		return result
+	}
+
+	requiredKeys := sets.New[string]()
+	for _, providerType := range providerTypes {
+		requiredKeys = requiredKeys.Union(typeToRequiredKeys[providerType])
+	}
+	if requiredKeys.Len() == 0 {
+		return nil
+	}
+
+	secret, err := e.secretLister.Secrets(secretNamespace).Get(secretName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The existence of the secret is checked by the ResourceReferenceManager admission plugin.
+			return nil
+		}
+		return err
+	}
+
+	existingKeys := sets.New[string]()
+	for key := range secret.Data {
+		existingKeys.Insert(key)
+	}
+	for key := range secret.StringData {
+		existingKeys.Insert(key)
+	}
+
+	if missingKeys := requiredKeys.Difference(existingKeys); missingKeys.Len() > 0 {
+		return fmt.Errorf("given %s references secret %s/%s which misses keys required by provider type %q: %s", kind, secretNamespace, secretName, strings.Join(providerTypes, ","), strings.Join(sets.List(missingKeys), ", "))
+	}
+
+	return nil
+}
+
 // Helper functions
 
 type requiredExtension struct {
@@ -376,6 +515,28 @@ func computeRegisteredPrimaryExtensionKindTypes(controllerRegistrationList []*ga
 	return out
 }
 
+// computeRequiredCredentialKeys computes a map that maps the provider types to the set of keys that are required in
+// credentials secrets according to the (primary) Infrastructure resources of the registrations.
+func computeRequiredCredentialKeys(controllerRegistrationList []*gardencorev1beta1.ControllerRegistration) map[string]sets.Set[string] {
+	out := map[string]sets.Set[string]{}
+
+	for _, controllerRegistration := range controllerRegistrationList {
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if resource.Kind != extensionsv1alpha1.InfrastructureResource || (resource.Primary != nil && !*resource.Primary) {
+				continue
+			}
+
+			if _, ok := out[resource.Type]; !ok {
+				out[resource.Type] = sets.New[string]()
+			}
+
+			out[resource.Type].Insert(resource.RequiredCredentialKeys...)
+		}
+	}
+
+	return out
+}
+
 // computeWorkerlessSupportedExtensionTypes computes Extension types that are supported for workerless Shoots.
 func computeWorkerlessSupportedExtensionTypes(controllerRegistrationList []*gardencorev1beta1.ControllerRegistration) sets.Set[string] {
 	out := sets.Set[string]{}
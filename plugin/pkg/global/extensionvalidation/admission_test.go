@@ -10,13 +10,16 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/security"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	. "github.com/gardener/gardener/plugin/pkg/global/extensionvalidation"
 )
@@ -24,6 +27,7 @@ import (
 var _ = Describe("ExtensionValidator", func() {
 	var (
 		coreInformerFactory gardencoreinformers.SharedInformerFactory
+		kubeInformerFactory kubeinformers.SharedInformerFactory
 		admissionHandler    *ExtensionValidator
 	)
 
@@ -33,9 +37,12 @@ var _ = Describe("ExtensionValidator", func() {
 
 		coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 		admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+
+		kubeInformerFactory = kubeinformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
 	})
 
-	It("should do nothing because the resource is not BackupBucket, BackupEntry, Seed, or Shoot", func() {
+	It("should do nothing because the resource is not BackupBucket, BackupEntry, Seed, Shoot, SecretBinding, or CredentialsBinding", func() {
 		attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), "", "", core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
 
 		err := admissionHandler.Validate(context.TODO(), attrs, nil)
@@ -186,7 +193,11 @@ var _ = Describe("ExtensionValidator", func() {
 					Backup: &core.SeedBackup{
 						Provider: "bar",
 					},
-					Ingress: &core.Ingress{},
+					Ingress: &core.Ingress{
+						Controller: core.IngressController{
+							Kind: "nginx",
+						},
+					},
 					DNS: core.SeedDNS{
 						Provider: &core.SeedDNSProvider{
 							Type: "baz",
@@ -223,6 +234,31 @@ var _ = Describe("ExtensionValidator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should allow to create the object with an ingress controller kind provided by a registered extension", func() {
+			registerAllExtensions()
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(createControllerRegistrationForKindType(extensionsv1alpha1.ExtensionResource, "contour", true, nil))).To(Succeed())
+
+			seedWithContour := seed.DeepCopy()
+			seedWithContour.Spec.Ingress.Controller.Kind = "contour"
+
+			attrs := admission.NewAttributesRecord(seedWithContour, nil, core.Kind("Seed").WithVersion("version"), seedWithContour.Namespace, seedWithContour.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should prevent the object from being created because the ingress controller kind is not registered", func() {
+			registerAllExtensions()
+
+			seedWithContour := seed.DeepCopy()
+			seedWithContour.Spec.Ingress.Controller.Kind = "contour"
+
+			attrs := admission.NewAttributesRecord(seedWithContour, nil, core.Kind("Seed").WithVersion("version"), seedWithContour.Namespace, seedWithContour.Name, core.Resource("seeds").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			err := admissionHandler.Validate(context.TODO(), attrs, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ingress controller kind: spec.ingress.controller.kind"))
+		})
+
 		It("should prevent the object from being created because some extension is not registered", func() {
 			for _, registration := range kindToTypes {
 				registerAllExtensions()
@@ -362,6 +398,21 @@ var _ = Describe("ExtensionValidator", func() {
 			}
 		})
 
+		It("should prevent the object from being created because the private link extension is not registered", func() {
+			registerAllExtensions()
+
+			shootWithPrivateLink := shoot.DeepCopy()
+			shootWithPrivateLink.Spec.Networking.PrivateLink = &core.PrivateLink{Enabled: true}
+
+			attrs := admission.NewAttributesRecord(shootWithPrivateLink, nil, core.Kind("Shoot").WithVersion("version"), shootWithPrivateLink.Namespace, shootWithPrivateLink.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("given Shoot uses non-registered private link type")))
+
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.PrivateLinkResource, shoot.Spec.Provider.Type, true, nil)
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
 		It("should prevent the object from being created because no extension type is registered", func() {
 			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
 
@@ -453,6 +504,156 @@ var _ = Describe("ExtensionValidator", func() {
 		})
 	})
 
+	Context("SecretBinding", func() {
+		var (
+			secret        *corev1.Secret
+			secretBinding *core.SecretBinding
+		)
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden-dev"},
+				Data:       map[string][]byte{"accessKeyID": []byte("foo"), "secretAccessKey": []byte("bar")},
+			}
+			secretBinding = &core.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "sb", Namespace: "garden-dev"},
+				SecretRef:  corev1.SecretReference{Name: secret.Name, Namespace: secret.Namespace},
+				Provider:   &core.SecretBindingProvider{Type: "foo"},
+			}
+
+			Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(secret)).To(Succeed())
+		})
+
+		It("should allow to create the object because the provider type is registered", func() {
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.InfrastructureResource, "foo", true, nil)
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should allow to create the object without provider", func() {
+			secretBinding.Provider = nil
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should prevent the object from being created because the provider type is not registered", func() {
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.ControlPlaneResource, "foo", true, nil)
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(`non-registered provider type: provider.type ("foo")`)))
+		})
+
+		It("should prevent the object from being created because one of multiple provider types is not registered", func() {
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.InfrastructureResource, "foo", true, nil)
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+			secretBinding.Provider.Type = "foo,bar"
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(`non-registered provider type: provider.type ("bar")`)))
+		})
+
+		It("should allow to create the object because the secret contains all required keys", func() {
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.InfrastructureResource, "foo", true, nil)
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"accessKeyID", "secretAccessKey"}
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should prevent the object from being created because the secret misses required keys", func() {
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.InfrastructureResource, "foo", true, nil)
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"accessKeyID", "secretAccessKey", "region"}
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(`references secret garden-dev/secret which misses keys required by provider type "foo": region`)))
+		})
+
+		It("should allow to create the object if the secret does not exist", func() {
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.InfrastructureResource, "foo", true, nil)
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"region"}
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+			secretBinding.SecretRef.Name = "other"
+
+			attrs := admission.NewAttributesRecord(secretBinding, nil, core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should allow to update the object if neither provider nor secret reference changed", func() {
+			attrs := admission.NewAttributesRecord(secretBinding, secretBinding.DeepCopy(), core.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, core.Resource("secretbindings").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+	})
+
+	Context("CredentialsBinding", func() {
+		var (
+			secret             *corev1.Secret
+			credentialsBinding *security.CredentialsBinding
+		)
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden-dev"},
+				Data:       map[string][]byte{"accessKeyID": []byte("foo")},
+			}
+			credentialsBinding = &security.CredentialsBinding{
+				ObjectMeta:     metav1.ObjectMeta{Name: "cb", Namespace: "garden-dev"},
+				CredentialsRef: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Name: secret.Name, Namespace: secret.Namespace},
+				Provider:       security.CredentialsBindingProvider{Type: "foo"},
+			}
+
+			Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(secret)).To(Succeed())
+
+			controllerRegistration := createControllerRegistrationForKindType(extensionsv1alpha1.InfrastructureResource, "foo", true, nil)
+			controllerRegistration.Spec.Resources[0].RequiredCredentialKeys = []string{"accessKeyID"}
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistration)).To(Succeed())
+		})
+
+		It("should allow to create the object", func() {
+			attrs := admission.NewAttributesRecord(credentialsBinding, nil, security.Kind("CredentialsBinding").WithVersion("version"), credentialsBinding.Namespace, credentialsBinding.Name, security.Resource("credentialsbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+
+		It("should prevent the object from being created because the provider type is not registered", func() {
+			credentialsBinding.Provider.Type = "bar"
+
+			attrs := admission.NewAttributesRecord(credentialsBinding, nil, security.Kind("CredentialsBinding").WithVersion("version"), credentialsBinding.Namespace, credentialsBinding.Name, security.Resource("credentialsbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring(`given CredentialsBinding uses non-registered provider type: provider.type ("bar")`)))
+		})
+
+		It("should prevent the object from being created because the secret misses required keys", func() {
+			secret.Data = map[string][]byte{"secretAccessKey": []byte("bar")}
+			Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Update(secret)).To(Succeed())
+
+			attrs := admission.NewAttributesRecord(credentialsBinding, nil, security.Kind("CredentialsBinding").WithVersion("version"), credentialsBinding.Namespace, credentialsBinding.Name, security.Resource("credentialsbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("misses keys required by provider type \"foo\": accessKeyID")))
+		})
+
+		It("should not check the keys of credentials other than secrets", func() {
+			credentialsBinding.CredentialsRef = corev1.ObjectReference{APIVersion: "security.gardener.cloud/v1alpha1", Kind: "WorkloadIdentity", Name: "foo", Namespace: "garden-dev"}
+
+			attrs := admission.NewAttributesRecord(credentialsBinding, nil, security.Kind("CredentialsBinding").WithVersion("version"), credentialsBinding.Namespace, credentialsBinding.Name, security.Resource("credentialsbindings").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+		})
+	})
+
 	Describe("#Register", func() {
 		It("should register the plugin", func() {
 			plugins := admission.NewPlugins()
@@ -486,15 +687,23 @@ var _ = Describe("ExtensionValidator", func() {
 	})
 
 	Describe("#ValidateInitialization", func() {
-		It("should return error if no ControllerRegistrationLister and BackupBucketLister are set", func() {
+		It("should return error if no ControllerRegistrationLister, BackupBucketLister and SecretLister are set", func() {
 			dr, _ := New()
 			err := dr.ValidateInitialization()
 			Expect(err).To(HaveOccurred())
 		})
 
-		It("should not return error if ControllerRegistrationLister, BackupBucketLister and core client are set", func() {
+		It("should return error if no SecretLister is set", func() {
+			dr, _ := New()
+			dr.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			err := dr.ValidateInitialization()
+			Expect(err).To(MatchError("missing Secret lister"))
+		})
+
+		It("should not return error if ControllerRegistrationLister, BackupBucketLister and SecretLister are set", func() {
 			dr, _ := New()
 			dr.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+			dr.SetKubeInformerFactory(kubeinformers.NewSharedInformerFactory(nil, 0))
 			err := dr.ValidateInitialization()
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -44,6 +44,8 @@ const (
 	PluginNameShootDNSRewriting = "ShootDNSRewriting"
 	// PluginNameShootExposureClass is the name of the ShootExposureClass admission plugin.
 	PluginNameShootExposureClass = "ShootExposureClass"
+	// PluginNameShootKubernetesVersionUpgrade is the name of the ShootKubernetesVersionUpgrade admission plugin.
+	PluginNameShootKubernetesVersionUpgrade = "ShootKubernetesVersionUpgrade"
 	// PluginNameShootManagedSeed is the name of the ShootManagedSeed admission plugin.
 	PluginNameShootManagedSeed = "ShootManagedSeed"
 	// PluginNameShootNodeLocalDNSEnabledByDefault is the name of the ShootNodeLocalDNSEnabledByDefault admission plugin.
@@ -52,6 +54,8 @@ const (
 	PluginNameClusterOpenIDConnectPreset = "ClusterOpenIDConnectPreset"
 	// PluginNameOpenIDConnectPreset is the name of the OpenIDConnectPreset admission plugin.
 	PluginNameOpenIDConnectPreset = "OpenIDConnectPreset"
+	// PluginNameShootProviderQuotaPreflight is the name of the ShootProviderQuotaPreflight admission plugin.
+	PluginNameShootProviderQuotaPreflight = "ShootProviderQuotaPreflight"
 	// PluginNameShootQuotaValidator is the name of the ShootQuotaValidator admission plugin.
 	PluginNameShootQuotaValidator = "ShootQuotaValidator"
 	// PluginNameShootTolerationRestriction is the name of the ShootTolerationRestriction admission plugin.
@@ -79,6 +83,8 @@ func AllPluginNames() []string {
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootValidator,                    // ShootValidator
+		PluginNameShootKubernetesVersionUpgrade,     // ShootKubernetesVersionUpgrade
+		PluginNameShootProviderQuotaPreflight,       // ShootProviderQuotaPreflight
 		PluginNameSeedValidator,                     // SeedValidator
 		PluginNameControllerRegistrationResources,   // ControllerRegistrationResources
 		PluginNameNamespacedCloudProfileValidator,   // NamespacedCloudProfileValidator
@@ -120,6 +126,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameShootResourceReservation,        // ShootResourceReservation
 		PluginNameShootQuotaValidator,             // ShootQuotaValidator
 		PluginNameShootValidator,                  // ShootValidator
+		PluginNameShootKubernetesVersionUpgrade,   // ShootKubernetesVersionUpgrade
 		PluginNameSeedValidator,                   // SeedValidator
 		PluginNameControllerRegistrationResources, // ControllerRegistrationResources
 		PluginNameNamespacedCloudProfileValidator, // NamespacedCloudProfileValidator
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetesversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+)
+
+// CustomVerbSkipKubernetesVersionUpgradeChecks is a constant for the custom verb that allows skipping the Kubernetes
+// version upgrade checks for shoots by annotating them with `shoot.gardener.cloud/skip-kubernetes-version-upgrade-checks=true`.
+const CustomVerbSkipKubernetesVersionUpgradeChecks = "skip-kubernetes-version-upgrade-checks"
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootKubernetesVersionUpgrade, func(_ io.Reader) (admission.Interface, error) {
+		return New(), nil
+	})
+}
+
+// KubernetesVersionUpgrade contains an admission handler and an authorizer.
+type KubernetesVersionUpgrade struct {
+	*admission.Handler
+	authorizer authorizer.Authorizer
+}
+
+var (
+	_ = admissioninitializer.WantsAuthorizer(&KubernetesVersionUpgrade{})
+
+	_ admission.ValidationInterface = &KubernetesVersionUpgrade{}
+)
+
+// New creates a new KubernetesVersionUpgrade admission plugin.
+func New() *KubernetesVersionUpgrade {
+	return &KubernetesVersionUpgrade{
+		Handler: admission.NewHandler(admission.Update),
+	}
+}
+
+// SetAuthorizer gets the authorizer.
+func (k *KubernetesVersionUpgrade) SetAuthorizer(authorizer authorizer.Authorizer) {
+	k.authorizer = authorizer
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (k *KubernetesVersionUpgrade) ValidateInitialization() error {
+	if k.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	return nil
+}
+
+// Validate rejects Kubernetes version downgrades of the control plane and the worker pools of shoots, as well as
+// control plane upgrades which skip a minor version. Worker pools may skip minor versions since their nodes are
+// replaced during the update.
+func (k *KubernetesVersionUpgrade) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	switch {
+	case a.GetKind().GroupKind() != core.Kind("Shoot"),
+		a.GetOperation() != admission.Update,
+		a.GetSubresource() != "":
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+	oldShoot, ok := a.GetOldObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
+	}
+
+	allErrs := validateKubernetesVersionUpgrade(&shoot.Spec, &oldShoot.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	if shoot.Annotations[v1beta1constants.ShootSkipKubernetesVersionUpgradeChecks] == "true" {
+		return k.authorize(ctx, a)
+	}
+
+	return apierrors.NewInvalid(a.GetKind().GroupKind(), shoot.Name, allErrs)
+}
+
+func validateKubernetesVersionUpgrade(newSpec, oldSpec *core.ShootSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// An unset version is rejected by the static API validation.
+	if len(newSpec.Kubernetes.Version) == 0 {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, gardencorevalidation.ValidateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, false, fldPath.Child("kubernetes", "version"))...)
+
+	for i, newWorker := range newSpec.Provider.Workers {
+		oldWorker := getWorker(oldSpec.Provider.Workers, newWorker.Name)
+		if oldWorker == nil {
+			continue
+		}
+
+		oldKubernetesVersion := oldSpec.Kubernetes.Version
+		newKubernetesVersion := newSpec.Kubernetes.Version
+		if oldWorker.Kubernetes != nil && oldWorker.Kubernetes.Version != nil {
+			oldKubernetesVersion = *oldWorker.Kubernetes.Version
+		}
+		if newWorker.Kubernetes != nil && newWorker.Kubernetes.Version != nil {
+			newKubernetesVersion = *newWorker.Kubernetes.Version
+		}
+
+		allErrs = append(allErrs, gardencorevalidation.ValidateKubernetesVersionUpdate(newKubernetesVersion, oldKubernetesVersion, true, fldPath.Child("provider", "workers").Index(i).Child("kubernetes", "version"))...)
+	}
+
+	return allErrs
+}
+
+func getWorker(workers []core.Worker, name string) *core.Worker {
+	for _, worker := range workers {
+		if worker.Name == name {
+			return &worker
+		}
+	}
+	return nil
+}
+
+func (k *KubernetesVersionUpgrade) authorize(ctx context.Context, a admission.Attributes) error {
+	var (
+		userInfo = a.GetUserInfo()
+		resource = a.GetResource()
+	)
+
+	decision, _, err := k.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            userInfo,
+		APIGroup:        resource.Group,
+		Resource:        resource.Resource,
+		Namespace:       a.GetNamespace(),
+		Name:            a.GetName(),
+		Verb:            CustomVerbSkipKubernetesVersionUpgradeChecks,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return err
+	}
+	if decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to skip the kubernetes version upgrade checks via the %q annotation for %q", userInfo.GetName(), v1beta1constants.ShootSkipKubernetesVersionUpgradeChecks, resource.Resource))
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetesversion_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/kubernetesversion"
+	mockauthorizer "github.com/gardener/gardener/third_party/mock/apiserver/authorization/authorizer"
+)
+
+var _ = Describe("KubernetesVersionUpgrade", func() {
+	var (
+		ctx  = context.TODO()
+		auth *mockauthorizer.MockAuthorizer
+
+		admissionHandler *KubernetesVersionUpgrade
+		userInfo         = &user.DefaultInfo{Name: "foo"}
+
+		shoot, oldShoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		auth = mockauthorizer.NewMockAuthorizer(gomock.NewController(GinkgoT()))
+
+		admissionHandler = New()
+		admissionHandler.SetAuthorizer(auth)
+
+		oldShoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: core.ShootSpec{
+				Kubernetes: core.Kubernetes{Version: "1.28.2"},
+				Provider: core.Provider{Workers: []core.Worker{
+					{Name: "worker1"},
+					{Name: "worker2", Kubernetes: &core.WorkerKubernetes{Version: ptr.To("1.26.5")}},
+				}},
+			},
+		}
+		shoot = oldShoot.DeepCopy()
+	})
+
+	validate := func(shoot, oldShoot *core.Shoot, subresource string) error {
+		attrs := admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), subresource, admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+		return admissionHandler.Validate(ctx, attrs, nil)
+	}
+
+	Describe("#Validate", func() {
+		It("should ignore other resources", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), "", "foo", core.Resource("foos").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should ignore subresources", func() {
+			shoot.Spec.Kubernetes.Version = "1.27.0"
+			Expect(validate(shoot, oldShoot, "status")).To(Succeed())
+		})
+
+		It("should allow updates without version changes", func() {
+			Expect(validate(shoot, oldShoot, "")).To(Succeed())
+		})
+
+		It("should allow patch and minor version upgrades", func() {
+			shoot.Spec.Kubernetes.Version = "1.29.0"
+			shoot.Spec.Provider.Workers[1].Kubernetes.Version = ptr.To("1.26.6")
+
+			Expect(validate(shoot, oldShoot, "")).To(Succeed())
+		})
+
+		It("should allow worker pools to skip minor versions", func() {
+			shoot.Spec.Provider.Workers[1].Kubernetes.Version = ptr.To("1.28.2")
+
+			Expect(validate(shoot, oldShoot, "")).To(Succeed())
+		})
+
+		It("should allow worker pools to follow the control plane version", func() {
+			shoot.Spec.Provider.Workers[1].Kubernetes = nil
+
+			Expect(validate(shoot, oldShoot, "")).To(Succeed())
+		})
+
+		It("should allow adding worker pools with lower versions", func() {
+			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, core.Worker{Name: "worker3", Kubernetes: &core.WorkerKubernetes{Version: ptr.To("1.26.0")}})
+
+			Expect(validate(shoot, oldShoot, "")).To(Succeed())
+		})
+
+		It("should ignore an unset version", func() {
+			shoot.Spec.Kubernetes.Version = ""
+
+			Expect(validate(shoot, oldShoot, "")).To(Succeed())
+		})
+
+		It("should forbid control plane version downgrades", func() {
+			shoot.Spec.Kubernetes.Version = "1.28.1"
+
+			err := validate(shoot, oldShoot, "")
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(And(
+				ContainSubstring("spec.kubernetes.version: Forbidden: kubernetes version downgrade is not supported"),
+				ContainSubstring("spec.provider.workers[0].kubernetes.version: Forbidden: kubernetes version downgrade is not supported"),
+			))
+		})
+
+		It("should forbid control plane version upgrades skipping a minor version", func() {
+			shoot.Spec.Kubernetes.Version = "1.30.0"
+
+			err := validate(shoot, oldShoot, "")
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.kubernetes.version: Forbidden: kubernetes version upgrade cannot skip a minor version"))
+		})
+
+		It("should forbid worker pool version downgrades", func() {
+			shoot.Spec.Provider.Workers[1].Kubernetes.Version = ptr.To("1.25.9")
+
+			err := validate(shoot, oldShoot, "")
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.provider.workers[1].kubernetes.version: Forbidden: kubernetes version downgrade is not supported"))
+		})
+
+		It("should forbid worker pool version downgrades when setting a version", func() {
+			shoot.Spec.Provider.Workers[0].Kubernetes = &core.WorkerKubernetes{Version: ptr.To("1.27.0")}
+
+			err := validate(shoot, oldShoot, "")
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].kubernetes.version: Forbidden: kubernetes version downgrade is not supported"))
+		})
+
+		Context("skip annotation", func() {
+			var authorizeAttributes authorizer.AttributesRecord
+
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/skip-kubernetes-version-upgrade-checks", "true")
+				shoot.Spec.Kubernetes.Version = "1.27.0"
+
+				authorizeAttributes = authorizer.AttributesRecord{
+					User:            userInfo,
+					APIGroup:        "core.gardener.cloud",
+					Resource:        "shoots",
+					Namespace:       shoot.Namespace,
+					Name:            shoot.Name,
+					Verb:            "skip-kubernetes-version-upgrade-checks",
+					ResourceRequest: true,
+				}
+			})
+
+			It("should not check permissions if there are no violations", func() {
+				shoot.Spec.Kubernetes.Version = oldShoot.Spec.Kubernetes.Version
+
+				Expect(validate(shoot, oldShoot, "")).To(Succeed())
+			})
+
+			It("should allow a downgrade if the user is allowed to skip the checks", func() {
+				auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+
+				Expect(validate(shoot, oldShoot, "")).To(Succeed())
+			})
+
+			It("should forbid a downgrade if the user is not allowed to skip the checks", func() {
+				auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+
+				err := validate(shoot, oldShoot, "")
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`user "foo" is not allowed to skip the kubernetes version upgrade checks`))
+			})
+
+			It("should ignore annotation values other than true", func() {
+				shoot.Annotations["shoot.gardener.cloud/skip-kubernetes-version-upgrade-checks"] = "false"
+
+				Expect(apierrors.IsInvalid(validate(shoot, oldShoot, ""))).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootKubernetesVersionUpgrade"))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should only handle UPDATE operations", func() {
+			Expect(admissionHandler.Handles(admission.Update)).To(BeTrue())
+			Expect(admissionHandler.Handles(admission.Create)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(admissionHandler.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the authorizer is missing", func() {
+			Expect(New().ValidateInitialization()).To(MatchError("missing authorizer"))
+		})
+
+		It("should not return an error if the authorizer is set", func() {
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
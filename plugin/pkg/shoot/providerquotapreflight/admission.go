@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package providerquotapreflight
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight"
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight/v1alpha1"
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight/validation"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootProviderQuotaPreflight, func(config io.Reader) (admission.Interface, error) {
+		cfg, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validation.ValidateConfiguration(cfg); len(err) > 0 {
+			return nil, fmt.Errorf("invalid config: %+v", err)
+		}
+
+		return New(cfg.Webhooks)
+	})
+}
+
+// ProviderQuotaPreflight contains required information to process admission requests.
+type ProviderQuotaPreflight struct {
+	*admission.Handler
+	webhooks []webhook
+}
+
+type webhook struct {
+	shootproviderquotapreflight.Webhook
+	client *http.Client
+}
+
+var _ admission.ValidationInterface = &ProviderQuotaPreflight{}
+
+// New creates a new ShootProviderQuotaPreflight admission plugin.
+func New(webhooks []shootproviderquotapreflight.Webhook) (*ProviderQuotaPreflight, error) {
+	p := &ProviderQuotaPreflight{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+
+	for _, w := range webhooks {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if len(w.CABundle) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(w.CABundle) {
+				return nil, fmt.Errorf("failed parsing CA bundle of webhook %q", w.Name)
+			}
+			tlsConfig.RootCAs = pool
+		}
+
+		p.webhooks = append(p.webhooks, webhook{
+			Webhook: w,
+			client: &http.Client{
+				Timeout:   time.Duration(ptr.Deref(w.TimeoutSeconds, 10)) * time.Second,
+				Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			},
+		})
+	}
+
+	return p, nil
+}
+
+// Validate calls the quota preflight webhooks of the provider type of the shoot when it is created or its capacity is
+// increased, and rejects the request if any of the webhooks reports that the quotas of the cloud provider do not
+// suffice.
+func (p *ProviderQuotaPreflight) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if a.GetKind().GroupKind() != core.Kind("Shoot") || a.GetSubresource() != "" || len(p.webhooks) == 0 {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewInternalError(errors.New("could not convert resource into Shoot object"))
+	}
+
+	request := &v1alpha1.QuotaPreflightRequest{
+		UID:       uuid.NewUUID(),
+		Operation: string(a.GetOperation()),
+	}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(shoot, &request.Shoot, nil); err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewInternalError(errors.New("could not convert old resource into Shoot object"))
+		}
+
+		if shoot.DeletionTimestamp != nil || !capacityIncreased(oldShoot, shoot) {
+			return nil
+		}
+
+		request.OldShoot = &gardencorev1beta1.Shoot{}
+		if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(oldShoot, request.OldShoot, nil); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+
+	var violations []string
+	for _, w := range p.webhooks {
+		if w.ProviderType != shoot.Spec.Provider.Type {
+			continue
+		}
+
+		response, err := w.call(ctx, request)
+		if err != nil {
+			if ptr.Deref(w.FailurePolicy, admissionregistrationv1.Ignore) == admissionregistrationv1.Fail {
+				return apierrors.NewInternalError(fmt.Errorf("failed calling quota preflight webhook %q: %w", w.Name, err))
+			}
+			warning.AddWarning(ctx, "", fmt.Sprintf("quota preflight webhook %q could not be called, quotas of the cloud provider were not checked: %v", w.Name, err))
+			continue
+		}
+
+		if !response.Allowed {
+			if len(response.Violations) == 0 {
+				violations = append(violations, fmt.Sprintf("%s: quota of the cloud provider does not suffice", w.Name))
+			}
+			for _, violation := range response.Violations {
+				violations = append(violations, fmt.Sprintf("%s: %s", w.Name, violation))
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("quota preflight failed: %s", strings.Join(violations, "; ")))
+	}
+
+	return nil
+}
+
+func (w *webhook) call(ctx context.Context, request *v1alpha1.QuotaPreflightRequest) (*v1alpha1.QuotaPreflightResponse, error) {
+	review := &v1alpha1.QuotaPreflightReview{Request: request}
+	review.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	review.Kind = "QuotaPreflightReview"
+
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := w.client.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", httpResponse.StatusCode)
+	}
+
+	result := &v1alpha1.QuotaPreflightReview{}
+	if err := json.NewDecoder(httpResponse.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("failed decoding response: %w", err)
+	}
+	if result.Response == nil {
+		return nil, errors.New("response is missing")
+	}
+	if result.Response.UID != request.UID {
+		return nil, fmt.Errorf("expected response for request %q but got %q", request.UID, result.Response.UID)
+	}
+
+	return result.Response, nil
+}
+
+// capacityIncreased returns true if the update of the shoot might require additional resources at the cloud provider,
+// i.e. if worker pools are added, their machine types are changed, their maximum is increased, or the shoot is woken up.
+func capacityIncreased(oldShoot, shoot *core.Shoot) bool {
+	if isHibernationEnabled(oldShoot) && !isHibernationEnabled(shoot) {
+		return true
+	}
+
+	oldWorkers := make(map[string]core.Worker, len(oldShoot.Spec.Provider.Workers))
+	for _, worker := range oldShoot.Spec.Provider.Workers {
+		oldWorkers[worker.Name] = worker
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		oldWorker, ok := oldWorkers[worker.Name]
+		if !ok || oldWorker.Machine.Type != worker.Machine.Type || worker.Maximum > oldWorker.Maximum {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isHibernationEnabled(shoot *core.Shoot) bool {
+	return shoot.Spec.Hibernation != nil && ptr.Deref(shoot.Spec.Hibernation.Enabled, false)
+}
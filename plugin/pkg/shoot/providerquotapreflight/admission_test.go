@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package providerquotapreflight_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight"
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight"
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight/v1alpha1"
+)
+
+var _ = Describe("ProviderQuotaPreflight", func() {
+	var (
+		ctx      = context.TODO()
+		userInfo = &user.DefaultInfo{Name: "foo"}
+
+		server   *httptest.Server
+		caBundle []byte
+		requests []*v1alpha1.QuotaPreflightRequest
+		respond  func(*v1alpha1.QuotaPreflightRequest) *v1alpha1.QuotaPreflightResponse
+
+		shoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		respond = func(request *v1alpha1.QuotaPreflightRequest) *v1alpha1.QuotaPreflightResponse {
+			return &v1alpha1.QuotaPreflightResponse{UID: request.UID, Allowed: true}
+		}
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			review := &v1alpha1.QuotaPreflightReview{}
+			Expect(json.NewDecoder(r.Body).Decode(review)).To(Succeed())
+			Expect(review.Kind).To(Equal("QuotaPreflightReview"))
+			Expect(review.APIVersion).To(Equal("shootproviderquotapreflight.admission.gardener.cloud/v1alpha1"))
+			requests = append(requests, review.Request)
+
+			review.Response = respond(review.Request)
+			Expect(json.NewEncoder(w).Encode(review)).To(Succeed())
+		}))
+		DeferCleanup(server.Close)
+		caBundle = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+			Spec: core.ShootSpec{
+				Provider: core.Provider{
+					Type: "aws",
+					Workers: []core.Worker{
+						{Name: "worker", Machine: core.Machine{Type: "large"}, Minimum: 1, Maximum: 3},
+					},
+				},
+			},
+		}
+	})
+
+	newPlugin := func(webhooks ...shootproviderquotapreflight.Webhook) *ProviderQuotaPreflight {
+		plugin, err := New(webhooks)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return plugin
+	}
+
+	newWebhook := func(name, providerType string) shootproviderquotapreflight.Webhook {
+		return shootproviderquotapreflight.Webhook{
+			Name:           name,
+			ProviderType:   providerType,
+			URL:            server.URL,
+			CABundle:       caBundle,
+			TimeoutSeconds: ptr.To[int32](5),
+		}
+	}
+
+	create := func(plugin *ProviderQuotaPreflight, shoot *core.Shoot) error {
+		attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+		return plugin.Validate(ctx, attrs, nil)
+	}
+
+	update := func(plugin *ProviderQuotaPreflight, shoot, oldShoot *core.Shoot) error {
+		attrs := admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+		return plugin.Validate(ctx, attrs, nil)
+	}
+
+	Describe("#Validate", func() {
+		It("should ignore other resources", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Foo").WithVersion("version"), "", "foo", core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			Expect(newPlugin(newWebhook("aws-quota", "aws")).Validate(ctx, attrs, nil)).To(Succeed())
+			Expect(requests).To(BeEmpty())
+		})
+
+		It("should not call webhooks of other provider types", func() {
+			Expect(create(newPlugin(newWebhook("gcp-quota", "gcp")), shoot)).To(Succeed())
+			Expect(requests).To(BeEmpty())
+		})
+
+		It("should call the webhook on creation and allow the request", func() {
+			Expect(create(newPlugin(newWebhook("aws-quota", "aws")), shoot)).To(Succeed())
+
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Operation).To(Equal("CREATE"))
+			Expect(requests[0].Shoot.Name).To(Equal("shoot"))
+			Expect(requests[0].Shoot.Spec.Provider.Workers[0].Maximum).To(Equal(int32(3)))
+			Expect(requests[0].OldShoot).To(BeNil())
+		})
+
+		It("should aggregate the violations of all webhooks", func() {
+			respond = func(request *v1alpha1.QuotaPreflightRequest) *v1alpha1.QuotaPreflightResponse {
+				return &v1alpha1.QuotaPreflightResponse{UID: request.UID, Violations: []string{"vCPU quota exceeded"}}
+			}
+
+			err := create(newPlugin(newWebhook("aws-vcpu", "aws"), newWebhook("aws-eip", "aws")), shoot)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("quota preflight failed: aws-vcpu: vCPU quota exceeded; aws-eip: vCPU quota exceeded"))
+			Expect(requests).To(HaveLen(2))
+		})
+
+		It("should reject the request if the webhook does not report violations", func() {
+			respond = func(request *v1alpha1.QuotaPreflightRequest) *v1alpha1.QuotaPreflightResponse {
+				return &v1alpha1.QuotaPreflightResponse{UID: request.UID}
+			}
+
+			err := create(newPlugin(newWebhook("aws-quota", "aws")), shoot)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("aws-quota: quota of the cloud provider does not suffice"))
+		})
+
+		Context("failing webhooks", func() {
+			BeforeEach(func() {
+				respond = func(_ *v1alpha1.QuotaPreflightRequest) *v1alpha1.QuotaPreflightResponse {
+					return &v1alpha1.QuotaPreflightResponse{UID: "other"}
+				}
+			})
+
+			It("should ignore failing webhooks by default", func() {
+				Expect(create(newPlugin(newWebhook("aws-quota", "aws")), shoot)).To(Succeed())
+			})
+
+			It("should reject the request if the failure policy is Fail", func() {
+				webhook := newWebhook("aws-quota", "aws")
+				webhook.FailurePolicy = ptr.To(admissionregistrationv1.Fail)
+
+				err := create(newPlugin(webhook), shoot)
+				Expect(apierrors.IsInternalError(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`failed calling quota preflight webhook "aws-quota"`))
+			})
+
+			It("should reject the request if the server certificate cannot be verified and the failure policy is Fail", func() {
+				webhook := newWebhook("aws-quota", "aws")
+				webhook.CABundle = nil
+				webhook.FailurePolicy = ptr.To(admissionregistrationv1.Fail)
+
+				Expect(apierrors.IsInternalError(create(newPlugin(webhook), shoot))).To(BeTrue())
+				Expect(requests).To(BeEmpty())
+			})
+		})
+
+		Context("updates", func() {
+			var (
+				plugin   *ProviderQuotaPreflight
+				oldShoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				plugin = newPlugin(newWebhook("aws-quota", "aws"))
+				oldShoot = shoot.DeepCopy()
+			})
+
+			It("should not call the webhook if the capacity is not increased", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 2
+				shoot.Labels = map[string]string{"foo": "bar"}
+
+				Expect(update(plugin, shoot, oldShoot)).To(Succeed())
+				Expect(requests).To(BeEmpty())
+			})
+
+			It("should not call the webhook for subresources", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+
+				attrs := admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "status", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(plugin.Validate(ctx, attrs, nil)).To(Succeed())
+				Expect(requests).To(BeEmpty())
+			})
+
+			DescribeTable("should call the webhook if the capacity is increased",
+				func(mutate func()) {
+					mutate()
+
+					Expect(update(plugin, shoot, oldShoot)).To(Succeed())
+					Expect(requests).To(HaveLen(1))
+					Expect(requests[0].Operation).To(Equal("UPDATE"))
+					Expect(requests[0].OldShoot).NotTo(BeNil())
+				},
+
+				Entry("maximum is increased", func() { shoot.Spec.Provider.Workers[0].Maximum = 5 }),
+				Entry("machine type is changed", func() { shoot.Spec.Provider.Workers[0].Machine.Type = "xlarge" }),
+				Entry("worker pool is added", func() {
+					shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, core.Worker{Name: "worker2", Machine: core.Machine{Type: "small"}, Maximum: 1})
+				}),
+				Entry("shoot is woken up", func() {
+					oldShoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+					shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(false)}
+				}),
+			)
+		})
+	})
+
+	Describe("#New", func() {
+		It("should fail for an invalid CA bundle", func() {
+			webhook := newWebhook("aws-quota", "aws")
+			webhook.CABundle = []byte("foo")
+
+			_, err := New([]shootproviderquotapreflight.Webhook{webhook})
+			Expect(err).To(MatchError(`failed parsing CA bundle of webhook "aws-quota"`))
+		})
+
+		It("should only handle CREATE and UPDATE operations", func() {
+			plugin := newPlugin()
+			Expect(plugin.Handles(admission.Create)).To(BeTrue())
+			Expect(plugin.Handles(admission.Update)).To(BeTrue())
+			Expect(plugin.Handles(admission.Connect)).NotTo(BeTrue())
+			Expect(plugin.Handles(admission.Delete)).NotTo(BeTrue())
+		})
+	})
+
+	Describe("#Register", func() {
+		It("should register the plugin", func() {
+			plugins := admission.NewPlugins()
+			Register(plugins)
+
+			registered := plugins.Registered()
+			Expect(registered).To(HaveLen(1))
+			Expect(registered).To(ContainElement("ShootProviderQuotaPreflight"))
+		})
+	})
+})
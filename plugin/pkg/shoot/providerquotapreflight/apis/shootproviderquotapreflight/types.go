@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootproviderquotapreflight
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootProviderQuotaPreflight admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+	// Webhooks are the quota preflight webhooks exposed by provider extensions.
+	Webhooks []Webhook
+}
+
+// Webhook is a quota preflight webhook of a provider extension.
+type Webhook struct {
+	// Name is the name of the webhook.
+	Name string
+	// ProviderType is the provider type of the shoots (`.spec.provider.type`) which are checked by this webhook.
+	ProviderType string
+	// URL is the HTTPS endpoint of the webhook.
+	URL string
+	// CABundle is a PEM encoded CA bundle which is used to verify the serving certificate of the webhook.
+	// If it is not set, the system trust roots are used.
+	CABundle []byte
+	// TimeoutSeconds is the timeout for calls to the webhook.
+	TimeoutSeconds *int32
+	// FailurePolicy defines how errors and timeouts of calls to the webhook are handled.
+	FailurePolicy *admissionregistrationv1.FailurePolicyType
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_Webhook sets defaults for the Webhook.
+func SetDefaults_Webhook(obj *Webhook) {
+	if obj.TimeoutSeconds == nil {
+		obj.TimeoutSeconds = ptr.To[int32](10)
+	}
+	if obj.FailurePolicy == nil {
+		obj.FailurePolicy = ptr.To(admissionregistrationv1.Ignore)
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight
+// +k8s:defaulter-gen=TypeMeta
+// +groupName=shootproviderquotapreflight.admission.gardener.cloud
+
+package v1alpha1 // import "github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight/v1alpha1"
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Configuration provides configuration for the ShootProviderQuotaPreflight admission controller.
+type Configuration struct {
+	metav1.TypeMeta
+	// Webhooks are the quota preflight webhooks exposed by provider extensions.
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+}
+
+// Webhook is a quota preflight webhook of a provider extension.
+type Webhook struct {
+	// Name is the name of the webhook.
+	Name string `json:"name"`
+	// ProviderType is the provider type of the shoots (`.spec.provider.type`) which are checked by this webhook.
+	ProviderType string `json:"providerType"`
+	// URL is the HTTPS endpoint of the webhook.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded CA bundle which is used to verify the serving certificate of the webhook.
+	// If it is not set, the system trust roots are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// TimeoutSeconds is the timeout for calls to the webhook. Defaults to 10 seconds.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// FailurePolicy defines how errors and timeouts of calls to the webhook are handled. Allowed values are `Ignore`
+	// and `Fail`. Defaults to `Ignore`.
+	// +optional
+	FailurePolicy *admissionregistrationv1.FailurePolicyType `json:"failurePolicy,omitempty"`
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaPreflightReview is sent to the quota preflight webhooks of provider extensions when a shoot is created or its
+// capacity is increased. The webhooks are expected to respond with the same object and the response field set.
+type QuotaPreflightReview struct {
+	metav1.TypeMeta `json:",inline"`
+	// Request contains the shoot whose resources should be checked against the quotas of the cloud provider.
+	// +optional
+	Request *QuotaPreflightRequest `json:"request,omitempty"`
+	// Response contains the verdict of the webhook.
+	// +optional
+	Response *QuotaPreflightResponse `json:"response,omitempty"`
+}
+
+// QuotaPreflightRequest contains the shoot whose resources should be checked against the quotas of the cloud provider.
+type QuotaPreflightRequest struct {
+	// UID identifies the request. It must be copied to the response.
+	UID types.UID `json:"uid"`
+	// Operation is the operation of the shoot request, i.e. CREATE or UPDATE.
+	Operation string `json:"operation"`
+	// Shoot is the shoot which is created or updated.
+	Shoot gardencorev1beta1.Shoot `json:"shoot"`
+	// OldShoot is the shoot before the update. It is only set for UPDATE operations.
+	// +optional
+	OldShoot *gardencorev1beta1.Shoot `json:"oldShoot,omitempty"`
+}
+
+// QuotaPreflightResponse contains the verdict of the webhook.
+type QuotaPreflightResponse struct {
+	// UID is the UID of the request.
+	UID types.UID `json:"uid"`
+	// Allowed indicates whether the quotas of the cloud provider suffice for the shoot.
+	Allowed bool `json:"allowed"`
+	// Violations describe the quotas which do not suffice, e.g. "vCPU quota in region eu-west-1 exceeded (requested 96, available 64)".
+	// +optional
+	Violations []string `json:"violations,omitempty"`
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net/url"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight"
+)
+
+var supportedFailurePolicies = sets.New(admissionregistrationv1.Ignore, admissionregistrationv1.Fail)
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(config *shootproviderquotapreflight.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config == nil {
+		return allErrs
+	}
+
+	names := sets.New[string]()
+	for i, webhook := range config.Webhooks {
+		fldPath := field.NewPath("webhooks").Index(i)
+
+		if len(webhook.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide a name"))
+		} else if names.Has(webhook.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), webhook.Name))
+		}
+		names.Insert(webhook.Name)
+
+		if len(webhook.ProviderType) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("providerType"), "must provide a provider type"))
+		}
+
+		if len(webhook.URL) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must provide a URL"))
+		} else if u, err := url.Parse(webhook.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, err.Error()))
+		} else if u.Scheme != "https" || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), webhook.URL, "must be an absolute HTTPS URL"))
+		}
+
+		if webhook.TimeoutSeconds != nil && (*webhook.TimeoutSeconds < 1 || *webhook.TimeoutSeconds > 30) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), *webhook.TimeoutSeconds, "must be between 1 and 30 seconds"))
+		}
+
+		if webhook.FailurePolicy != nil && !supportedFailurePolicies.Has(*webhook.FailurePolicy) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("failurePolicy"), *webhook.FailurePolicy, sets.List(supportedFailurePolicies)))
+		}
+	}
+
+	return allErrs
+}
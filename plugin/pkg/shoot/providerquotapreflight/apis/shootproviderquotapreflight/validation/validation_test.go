@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/providerquotapreflight/apis/shootproviderquotapreflight/validation"
+)
+
+var _ = Describe("Validation", func() {
+	Describe("#ValidateConfiguration", func() {
+		var config *shootproviderquotapreflight.Configuration
+
+		BeforeEach(func() {
+			config = &shootproviderquotapreflight.Configuration{
+				Webhooks: []shootproviderquotapreflight.Webhook{{
+					Name:           "aws-quota",
+					ProviderType:   "aws",
+					URL:            "https://quota.example.com/preflight",
+					TimeoutSeconds: ptr.To[int32](10),
+					FailurePolicy:  ptr.To(admissionregistrationv1.Ignore),
+				}},
+			}
+		})
+
+		It("should allow an empty configuration", func() {
+			Expect(ValidateConfiguration(&shootproviderquotapreflight.Configuration{})).To(BeEmpty())
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should forbid missing fields", func() {
+			config.Webhooks = append(config.Webhooks, shootproviderquotapreflight.Webhook{})
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("webhooks[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("webhooks[1].providerType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("webhooks[1].url"),
+				})),
+			))
+		})
+
+		It("should forbid invalid values", func() {
+			config.Webhooks = append(config.Webhooks, shootproviderquotapreflight.Webhook{
+				Name:           "aws-quota",
+				ProviderType:   "aws",
+				URL:            "http://quota.example.com",
+				TimeoutSeconds: ptr.To[int32](60),
+				FailurePolicy:  ptr.To[admissionregistrationv1.FailurePolicyType]("Retry"),
+			})
+
+			Expect(ValidateConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("webhooks[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("webhooks[1].url"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("webhooks[1].timeoutSeconds"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("webhooks[1].failurePolicy"),
+				})),
+			))
+		})
+	})
+})
@@ -273,6 +273,9 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateShootHibernation(a); err != nil {
 		return err
 	}
+	if allErrs = validationContext.ensureMachineTypes(); len(allErrs) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
+	}
 	if allErrs = validationContext.ensureMachineImages(); len(allErrs) > 0 {
 		return admission.NewForbidden(a, fmt.Errorf("%+v", allErrs))
 	}
@@ -283,6 +286,7 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	allErrs = append(allErrs, validationContext.validateShootNetworks(a, helper.IsWorkerless(shoot))...)
 	allErrs = append(allErrs, validationContext.validateKubernetes(a)...)
 	allErrs = append(allErrs, validationContext.validateRegion()...)
+	allErrs = append(allErrs, validationContext.validateEgressOnlyInternetAccess()...)
 	allErrs = append(allErrs, validationContext.validateProvider(a)...)
 	allErrs = append(allErrs, validationContext.validateAdmissionPlugins(a, v.secretLister)...)
 
@@ -355,6 +359,15 @@ func (c *validationContext) validateSeedSelectionForMultiZonalShoot() error {
 	return nil
 }
 
+// validateSeedSelectionForPrivateLink ensures that the selected Seed supports private link connectivity when the Shoot
+// enables it.
+func (c *validationContext) validateSeedSelectionForPrivateLink() error {
+	if helper.ShootEnablesPrivateLink(c.shoot) && !v1beta1helper.SeedSettingPrivateLinkEnabled(c.seed.Spec.Settings) {
+		return fmt.Errorf("cannot schedule shoot '%s' with private link enabled on seed '%s' which does not support private link connectivity", c.shoot.Name, c.seed.Name)
+	}
+	return nil
+}
+
 func (c *validationContext) validateScheduling(ctx context.Context, a admission.Attributes, authorizer authorizer.Authorizer, shootLister gardencorev1beta1listers.ShootLister, seedLister gardencorev1beta1listers.SeedLister) error {
 	var (
 		shootIsBeingScheduled          = c.oldShoot.Spec.SeedName == nil && c.shoot.Spec.SeedName != nil
@@ -393,6 +406,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", c.shoot.Name, c.seed.Name))
 		}
 
+		if v1beta1helper.IsSeedDecommissioning(c.seed) {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is being decommissioned", c.shoot.Name, c.seed.Name))
+		}
+
 		seedTaints := []core.SeedTaint{}
 		if c.seed.Spec.Taints != nil {
 			for _, taint := range c.seed.Spec.Taints {
@@ -465,6 +482,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, err)
 		}
 
+		if err := c.validateSeedSelectionForPrivateLink(); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+
 		if c.seed.DeletionTimestamp != nil {
 			newMeta := c.shoot.ObjectMeta
 			oldMeta := *c.oldShoot.ObjectMeta.DeepCopy()
@@ -585,6 +606,110 @@ func (c *validationContext) validateShootHibernation(a admission.Attributes) err
 	return nil
 }
 
+// ensureMachineTypes selects the machine types of worker pools which request machine types by their requirements. The
+// selected machine type is kept as long as the requirements do not change. Explicitly specified machine types have to
+// satisfy the requirements.
+func (c *validationContext) ensureMachineTypes() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.shoot.DeletionTimestamp != nil {
+		return allErrs
+	}
+
+	for idx := range c.shoot.Spec.Provider.Workers {
+		var (
+			worker  = &c.shoot.Spec.Provider.Workers[idx]
+			fldPath = field.NewPath("spec", "provider", "workers").Index(idx).Child("machine")
+		)
+
+		if worker.Machine.Requirements == nil {
+			continue
+		}
+
+		var (
+			oldMachine      *core.Machine
+			oldConfidential bool
+		)
+		for _, oldWorker := range c.oldShoot.Spec.Provider.Workers {
+			if oldWorker.Name == worker.Name {
+				oldMachine = &oldWorker.Machine
+				oldConfidential = oldWorker.Confidential != nil
+				break
+			}
+		}
+
+		if oldMachine != nil &&
+			apiequality.Semantic.DeepEqual(oldMachine.Requirements, worker.Machine.Requirements) &&
+			ptr.Equal(oldMachine.Architecture, worker.Machine.Architecture) &&
+			oldConfidential == (worker.Confidential != nil) {
+			if worker.Machine.Type == "" {
+				worker.Machine.Type = oldMachine.Type
+			}
+			if worker.Machine.Type == oldMachine.Type {
+				continue
+			}
+		}
+
+		requirements := gardencorev1beta1.MachineTypeRequirements{}
+		if err := gardencorev1beta1.Convert_core_MachineTypeRequirements_To_v1beta1_MachineTypeRequirements(worker.Machine.Requirements, &requirements, nil); err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("requirements"), err))
+			continue
+		}
+
+		// Confidential worker pools can only run on machine types supporting confidential computing.
+		if worker.Confidential != nil {
+			capabilities := ptr.Deref(requirements.Capabilities, gardencorev1beta1.MachineTypeCapabilities{})
+			capabilities.ConfidentialCompute = ptr.To(true)
+			requirements.Capabilities = &capabilities
+		}
+
+		if worker.Machine.Type != "" {
+			// Unknown machine types are reported by the provider validation.
+			machineType := v1beta1helper.FindMachineTypeByName(c.cloudProfile.Spec.MachineTypes, worker.Machine.Type)
+			if machineType == nil || v1beta1helper.MachineTypeSatisfiesRequirements(*machineType, requirements) {
+				continue
+			}
+
+			// The requirements of the worker pool were changed and the previously selected machine type does not satisfy
+			// them anymore, hence a new machine type is selected.
+			if oldMachine == nil || worker.Machine.Type != oldMachine.Type {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), worker.Machine.Type, "machine type does not satisfy the requirements"))
+				continue
+			}
+		}
+
+		machineType := selectMachineType(c.cloudProfile.Spec.MachineTypes, worker.Machine.Architecture, requirements, c.cloudProfile.Spec.Regions, c.shoot.Spec.Region, worker.Zones)
+		if machineType == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requirements"), worker.Machine.Requirements, "no usable machine type which is available in all zones satisfies the requirements"))
+			continue
+		}
+		worker.Machine.Type = machineType.Name
+	}
+
+	return allErrs
+}
+
+// selectMachineType returns the smallest usable machine type of the given architecture which satisfies the given
+// requirements and is available in all given zones.
+func selectMachineType(machineTypes []gardencorev1beta1.MachineType, architecture *string, requirements gardencorev1beta1.MachineTypeRequirements, regions []gardencorev1beta1.Region, region string, zones []string) *gardencorev1beta1.MachineType {
+	var selected *gardencorev1beta1.MachineType
+
+	for _, t := range machineTypes {
+		if !ptr.Deref(t.Usable, false) ||
+			!ptr.Equal(t.Architecture, architecture) ||
+			!v1beta1helper.MachineTypeSatisfiesRequirements(t, requirements) ||
+			isUnavailableInAtleastOneZone(regions, region, zones, t.Name, func(zone gardencorev1beta1.AvailabilityZone) []string { return zone.UnavailableMachineTypes }) {
+			continue
+		}
+
+		if selected == nil || v1beta1helper.CompareMachineTypeSizes(t, *selected) < 0 {
+			selected = &t
+		}
+	}
+
+	return selected
+}
+
 func (c *validationContext) ensureMachineImages() field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -741,6 +866,22 @@ func (c *validationContext) validateShootNetworks(a admission.Attributes, worker
 				workerless,
 			)...)
 		}
+
+		// validate disjointedness of additional node networks with seed networks if shoot is being (re)scheduled or
+		// additional node networks are added
+		var oldAdditionalNodes []string
+		if c.oldShoot.Spec.Networking != nil {
+			oldAdditionalNodes = c.oldShoot.Spec.Networking.AdditionalNodes
+		}
+		if !apiequality.Semantic.DeepEqual(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) || !apiequality.Semantic.DeepEqual(oldAdditionalNodes, c.shoot.Spec.Networking.AdditionalNodes) {
+			allErrs = append(allErrs, cidrvalidation.ValidateAdditionalNodeNetworksDisjointedness(
+				path,
+				c.shoot.Spec.Networking.AdditionalNodes,
+				c.seed.Spec.Networks.Nodes,
+				c.seed.Spec.Networks.Pods,
+				c.seed.Spec.Networks.Services,
+			)...)
+		}
 	}
 
 	return allErrs
@@ -768,6 +909,8 @@ func (c *validationContext) validateKubernetes(a admission.Attributes) field.Err
 		allErrs = append(allErrs, validateKubernetesVersionConstraints(a, c.cloudProfile.Spec.Kubernetes.Versions, c.shoot.Spec.Kubernetes.Version, c.oldShoot.Spec.Kubernetes.Version, false, path.Child("version"))...)
 	}
 
+	allErrs = append(allErrs, c.validateClusterAutoscalerProfile(path.Child("clusterAutoscaler", "profile"))...)
+
 	if c.shoot.DeletionTimestamp == nil {
 		performKubernetesDefaulting(c.shoot, c.oldShoot)
 	}
@@ -775,6 +918,28 @@ func (c *validationContext) validateKubernetes(a admission.Attributes) field.Err
 	return allErrs
 }
 
+func (c *validationContext) validateClusterAutoscalerProfile(fldPath *field.Path) field.ErrorList {
+	if c.shoot.Spec.Kubernetes.ClusterAutoscaler == nil || c.shoot.Spec.Kubernetes.ClusterAutoscaler.Profile == nil {
+		return nil
+	}
+	profile := *c.shoot.Spec.Kubernetes.ClusterAutoscaler.Profile
+
+	// Do not block updates of existing clusters if the profile was removed from the CloudProfile in the meantime.
+	if oldClusterAutoscaler := c.oldShoot.Spec.Kubernetes.ClusterAutoscaler; oldClusterAutoscaler != nil && ptr.Equal(oldClusterAutoscaler.Profile, &profile) {
+		return nil
+	}
+
+	var supportedProfiles []string
+	for _, p := range c.cloudProfile.Spec.ClusterAutoscalerProfiles {
+		if string(p.Name) == string(profile) {
+			return nil
+		}
+		supportedProfiles = append(supportedProfiles, string(p.Name))
+	}
+
+	return field.ErrorList{field.NotSupported(fldPath, profile, supportedProfiles)}
+}
+
 func performKubernetesDefaulting(newShoot, oldShoot *core.Shoot) {
 	if newShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig == nil {
 		// Error is ignored here because we cannot do anything meaningful with it - variable will default to "false".
@@ -888,6 +1053,12 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 				allErrs = append(allErrs, field.Invalid(idxPath.Child("machine", "type"), worker.Machine.Type, fmt.Sprintf("%ssupported types are %+v", detail, supportedMachineTypes)))
 			}
 
+			if worker.Confidential != nil && isMachinePresentInCloudprofile &&
+				(oldWorker.Confidential == nil || worker.Machine.Type != oldWorker.Machine.Type) &&
+				!supportsConfidentialCompute(c.cloudProfile.Spec.MachineTypes, worker.Machine.Type) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("confidential"), worker.Machine.Type, fmt.Sprintf("machine type %q does not support confidential computing", worker.Machine.Type)))
+			}
+
 			isMachineImagePresentInCloudprofile, architectureSupported, activeMachineImageVersion, validMachineImageVersions := validateMachineImagesConstraints(a, c.cloudProfile.Spec.MachineImages, isNewWorkerPool, worker.Machine, oldWorker.Machine)
 			if !isMachineImagePresentInCloudprofile {
 				allErrs = append(allErrs, field.Invalid(idxPath.Child("machine", "image"), worker.Machine.Image, fmt.Sprintf("machine image version is not supported, supported machine image versions are: %+v", validMachineImageVersions)))
@@ -930,12 +1101,14 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 		if ok, minSize := validateVolumeSize(c.cloudProfile.Spec.VolumeTypes, c.cloudProfile.Spec.MachineTypes, worker.Machine.Type, worker.Volume); !ok {
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("volume", "size"), worker.Volume.VolumeSize, fmt.Sprintf("size must be >= %s", minSize)))
 		}
-		if worker.Kubernetes != nil {
-			if worker.Kubernetes.Kubelet != nil {
-				kubeletConfig = worker.Kubernetes.Kubelet
-			}
-			allErrs = append(allErrs, validateKubeletConfig(idxPath.Child("kubernetes").Child("kubelet"), c.cloudProfile.Spec.MachineTypes, worker.Machine.Type, kubeletConfig)...)
 
+		workerKubeletConfig := kubeletConfig
+		if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
+			workerKubeletConfig = worker.Kubernetes.Kubelet
+		}
+		allErrs = append(allErrs, validateKubeletConfig(idxPath.Child("kubernetes").Child("kubelet"), c.cloudProfile.Spec.MachineTypes, worker.Machine.Type, workerKubeletConfig)...)
+
+		if worker.Kubernetes != nil {
 			if worker.Kubernetes.Version != nil {
 				oldWorkerKubernetesVersion := c.oldShoot.Spec.Kubernetes.Version
 				if oldWorker.Kubernetes != nil && oldWorker.Kubernetes.Version != nil {
@@ -1259,6 +1432,13 @@ func validateMachineTypes(constraints []gardencorev1beta1.MachineType, machine,
 		sets.List(machinesWithSupportedArchitecture.Intersection(machinesAvailableInAllZones).Intersection(usableMachines))
 }
 
+// supportsConfidentialCompute returns true if the machine type with the given name has the confidential computing
+// capability.
+func supportsConfidentialCompute(machineTypes []gardencorev1beta1.MachineType, name string) bool {
+	machineType := v1beta1helper.FindMachineTypeByName(machineTypes, name)
+	return machineType != nil && v1beta1helper.MachineTypeHasCapabilities(*machineType, &gardencorev1beta1.MachineTypeCapabilities{ConfidentialCompute: ptr.To(true)})
+}
+
 func isUnavailableInAtleastOneZone(regions []gardencorev1beta1.Region, region string, zones []string, t string, unavailableTypes func(zone gardencorev1beta1.AvailabilityZone) []string) bool {
 	for _, r := range regions {
 		if r.Name != region {
@@ -1289,18 +1469,34 @@ func validateKubeletConfig(fldPath *field.Path, machineTypes []gardencorev1beta1
 		return allErrs
 	}
 
+	machineType := v1beta1helper.FindMachineTypeByName(machineTypes, workerMachineType)
+	if machineType == nil {
+		return allErrs
+	}
+
 	reservedCPU := *resource.NewQuantity(0, resource.DecimalSI)
 	reservedMemory := *resource.NewQuantity(0, resource.DecimalSI)
 
+	kubeReserved := gardencorev1beta1.KubeletConfigReserved{}
+	if kubeletConfig.ReservationPolicy != nil && *kubeletConfig.ReservationPolicy == core.KubeletReservationPolicyAuto {
+		kubeReserved = v1beta1helper.KubeReservedForMachineType(*machineType)
+	}
 	if kubeletConfig.KubeReserved != nil {
 		if kubeletConfig.KubeReserved.CPU != nil {
-			reservedCPU.Add(*kubeletConfig.KubeReserved.CPU)
+			kubeReserved.CPU = kubeletConfig.KubeReserved.CPU
 		}
 		if kubeletConfig.KubeReserved.Memory != nil {
-			reservedMemory.Add(*kubeletConfig.KubeReserved.Memory)
+			kubeReserved.Memory = kubeletConfig.KubeReserved.Memory
 		}
 	}
 
+	if kubeReserved.CPU != nil {
+		reservedCPU.Add(*kubeReserved.CPU)
+	}
+	if kubeReserved.Memory != nil {
+		reservedMemory.Add(*kubeReserved.Memory)
+	}
+
 	if kubeletConfig.SystemReserved != nil {
 		if kubeletConfig.SystemReserved.CPU != nil {
 			reservedCPU.Add(*kubeletConfig.SystemReserved.CPU)
@@ -1310,19 +1506,15 @@ func validateKubeletConfig(fldPath *field.Path, machineTypes []gardencorev1beta1
 		}
 	}
 
-	for _, machineType := range machineTypes {
-		if machineType.Name == workerMachineType {
-			capacityCPU := machineType.CPU
-			capacityMemory := machineType.Memory
+	capacityCPU := machineType.CPU
+	capacityMemory := machineType.Memory
 
-			if cmp := reservedCPU.Cmp(capacityCPU); cmp >= 0 {
-				allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("kubeReserved CPU + systemReserved CPU: %s", reservedCPU.String()), fmt.Sprintf("total reserved CPU (kubeReserved + systemReserved) cannot be more than the Node's CPU capacity '%s'", capacityCPU.String())))
-			}
+	if cmp := reservedCPU.Cmp(capacityCPU); cmp >= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("kubeReserved CPU + systemReserved CPU: %s", reservedCPU.String()), fmt.Sprintf("total reserved CPU (kubeReserved + systemReserved) cannot be more than the Node's CPU capacity '%s'", capacityCPU.String())))
+	}
 
-			if cmp := reservedMemory.Cmp(capacityMemory); cmp >= 0 {
-				allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("kubeReserved memory + systemReserved memory: %s", reservedMemory.String()), fmt.Sprintf("total reserved memory (kubeReserved + systemReserved) cannot be more than the Node's memory capacity '%s'", capacityMemory.String())))
-			}
-		}
+	if cmp := reservedMemory.Cmp(capacityMemory); cmp >= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("kubeReserved memory + systemReserved memory: %s", reservedMemory.String()), fmt.Sprintf("total reserved memory (kubeReserved + systemReserved) cannot be more than the Node's memory capacity '%s'", capacityMemory.String())))
 	}
 
 	return allErrs
@@ -1384,6 +1576,25 @@ func (c *validationContext) validateRegion() field.ErrorList {
 	return field.ErrorList{field.NotSupported(fldPath, region, validValues)}
 }
 
+func (c *validationContext) validateEgressOnlyInternetAccess() field.ErrorList {
+	if !helper.ShootEnablesEgressOnlyInternetAccess(c.shoot) || helper.ShootEnablesEgressOnlyInternetAccess(c.oldShoot) {
+		return nil
+	}
+
+	for _, r := range c.cloudProfile.Spec.Regions {
+		if r.Name != c.shoot.Spec.Region {
+			continue
+		}
+
+		if !ptr.Deref(r.EgressOnlyInternetAccessSupported, false) {
+			return field.ErrorList{field.Forbidden(field.NewPath("spec", "provider", "workersSettings", "egressOnlyInternetAccess", "enabled"), fmt.Sprintf("egress-only internet access for worker nodes is not supported in region %q", r.Name))}
+		}
+		break
+	}
+
+	return nil
+}
+
 func validateZones(constraints []gardencorev1beta1.Region, region, oldRegion string, worker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if region == oldRegion && reflect.DeepEqual(worker.Zones, oldWorker.Zones) {
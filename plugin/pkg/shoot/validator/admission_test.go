@@ -991,6 +991,42 @@ var _ = Describe("validator", func() {
 			})
 		})
 
+		Context("egress-only internet access", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.WorkersSettings = &core.WorkersSettings{EgressOnlyInternetAccess: &core.EgressOnlyInternetAccess{Enabled: true}}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+			})
+
+			It("should allow egress-only internet access if the region supports it", func() {
+				cloudProfile.Spec.Regions[0].EgressOnlyInternetAccessSupported = ptr.To(true)
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should reject egress-only internet access if the region does not support it", func() {
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("egress-only internet access for worker nodes is not supported in region \"europe\""))
+			})
+
+			It("should allow updates if egress-only internet access was already enabled", func() {
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				oldShoot := shoot.DeepCopy()
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("tests for region/zone updates", func() {
 			var (
 				oldShoot *core.Shoot
@@ -1332,6 +1368,29 @@ var _ = Describe("validator", func() {
 					})
 				})
 
+				Context("private link shoot scheduling checks on seed", func() {
+					BeforeEach(func() {
+						Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+						Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+						Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+						Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+						shoot.Spec.Networking.PrivateLink = &core.PrivateLink{Enabled: true}
+					})
+
+					It("should reject scheduling shoot with private link on seed without private link support", func() {
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(BeForbiddenError())
+					})
+
+					It("should allow scheduling shoot with private link on seed with private link support", func() {
+						seed.Spec.Settings = &gardencorev1beta1.SeedSettings{PrivateLink: &gardencorev1beta1.SeedSettingPrivateLink{Enabled: true}}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+				})
+
 				Context("cloud profile's seed selector", func() {
 					It("should reject shoot creation on seed when the cloud profile's seed selector is invalid", func() {
 						cloudProfile.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{
@@ -1797,6 +1856,50 @@ var _ = Describe("validator", func() {
 					Expect(err).To(BeForbiddenError())
 				})
 
+				It("should reject because an additional shoot node network and the seed node network intersect", func() {
+					shoot.Spec.Networking.AdditionalNodes = []string{seedNodesCIDR}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("shoot node network intersects with seed node network")))
+				})
+
+				It("should reject adding an additional shoot node network which intersects with the seed pod network", func() {
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+					shoot.Spec.Networking.AdditionalNodes = []string{seedPodsCIDR}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("shoot node network intersects with seed pod network")))
+				})
+
+				It("should allow adding an additional shoot node network which is disjoint with the seed networks", func() {
+					oldShoot.Spec.SeedName = shoot.Spec.SeedName
+					shoot.Spec.Networking.AdditionalNodes = []string{"10.123.0.0/16"}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
 				It("should reject because the shoot pod and the seed pod networks intersect", func() {
 					shoot.Spec.Networking.Pods = &seedPodsCIDR
 
@@ -2373,6 +2476,44 @@ var _ = Describe("validator", func() {
 				})
 			})
 
+			Context("cluster-autoscaler profile checks", func() {
+				BeforeEach(func() {
+					cloudProfile.Spec.ClusterAutoscalerProfiles = []gardencorev1beta1.ClusterAutoscalerProfileSettings{
+						{Name: gardencorev1beta1.ClusterAutoscalerProfileBalanced},
+					}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				})
+
+				It("should allow selecting a profile offered by the CloudProfile", func() {
+					shoot.Spec.Kubernetes.ClusterAutoscaler = &core.ClusterAutoscaler{Profile: ptr.To(core.ClusterAutoscalerProfileBalanced)}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should forbid selecting a profile not offered by the CloudProfile", func() {
+					shoot.Spec.Kubernetes.ClusterAutoscaler = &core.ClusterAutoscaler{Profile: ptr.To(core.ClusterAutoscalerProfileAggressive)}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("spec.kubernetes.clusterAutoscaler.profile: Unsupported value: \"aggressive\"")))
+				})
+
+				It("should allow updates of shoots whose profile is no longer offered by the CloudProfile", func() {
+					shoot.Spec.Kubernetes.ClusterAutoscaler = &core.ClusterAutoscaler{Profile: ptr.To(core.ClusterAutoscalerProfileAggressive)}
+					oldShoot := shoot.DeepCopy()
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+			})
+
 			Context("kubernetes kube-controller-manager's node monitor grace period defaulting", func() {
 				BeforeEach(func() {
 					cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{
@@ -2571,7 +2712,7 @@ var _ = Describe("validator", func() {
 					kubeletConfig.SystemReserved.CPU = &resourceCPU2
 					shoot.Spec.Kubernetes.Kubelet = kubeletConfig
 
-					shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, worker)
+					shoot.Spec.Provider.Workers = []core.Worker{worker}
 
 					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
 					err := admissionHandler.Admit(ctx, attrs, nil)
@@ -2595,6 +2736,43 @@ var _ = Describe("validator", func() {
 					Expect(err.Error()).To(ContainSubstring("total reserved CPU (kubeReserved + systemReserved) cannot be more than the Node's CPU capacity"))
 				})
 
+				It("should not allow creation of Shoot if the global kubeletConfig exceeds the CPU capacity of a worker without kubernetes settings", func() {
+					kubeletConfig.KubeReserved.CPU = &resourceCPU2
+					kubeletConfig.SystemReserved.CPU = &resourceCPU2
+					shoot.Spec.Kubernetes.Kubelet = kubeletConfig
+
+					worker.Kubernetes = nil
+					shoot.Spec.Provider.Workers = []core.Worker{worker}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("total reserved CPU (kubeReserved + systemReserved) cannot be more than the Node's CPU capacity"))
+				})
+
+				It("should consider the machine type's kube reserved resources for the auto reservation policy", func() {
+					cloudProfile.Spec.MachineTypes[len(cloudProfile.Spec.MachineTypes)-1].KubeReserved = &gardencorev1beta1.KubeletConfigReserved{CPU: &resourceCPU2}
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+
+					worker.Kubernetes.Kubelet.KubeReserved = nil
+					worker.Kubernetes.Kubelet.SystemReserved.CPU = &resourceCPU2
+					worker.Kubernetes.Kubelet.ReservationPolicy = ptr.To(core.KubeletReservationPolicyAuto)
+					shoot.Spec.Provider.Workers = []core.Worker{worker}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("total reserved CPU (kubeReserved + systemReserved) cannot be more than the Node's CPU capacity"))
+
+					worker.Kubernetes.Kubelet.ReservationPolicy = ptr.To(core.KubeletReservationPolicyStatic)
+					shoot.Spec.Provider.Workers = []core.Worker{worker}
+
+					attrs = admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
 				It("should not allow creation of Shoot if kubeReserved CPU is more than CPU capacity", func() {
 					resource := resourceCPU2
 					resource.Add(resourceCPU2)
@@ -2658,7 +2836,7 @@ var _ = Describe("validator", func() {
 					kubeletConfig.SystemReserved.Memory = &resourceMemory2
 					shoot.Spec.Kubernetes.Kubelet = kubeletConfig
 
-					shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, worker)
+					shoot.Spec.Provider.Workers = []core.Worker{worker}
 
 					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
 					err := admissionHandler.Admit(ctx, attrs, nil)
@@ -3978,6 +4156,197 @@ var _ = Describe("validator", func() {
 				})
 			})
 
+			Context("machine type requirements", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers = []core.Worker{
+						{
+							Name: "worker",
+							Machine: core.Machine{
+								Architecture: ptr.To("amd64"),
+								Requirements: &core.MachineTypeRequirements{
+									CPU:          ptr.To(resource.MustParse("2")),
+									Capabilities: &core.MachineTypeCapabilities{NVMe: ptr.To(true)},
+								},
+							},
+						},
+					}
+
+					cloudProfile.Spec.MachineTypes = append(cloudProfile.Spec.MachineTypes,
+						gardencorev1beta1.MachineType{
+							Name:         "machine-type-nvme-large",
+							CPU:          resource.MustParse("8"),
+							Memory:       resource.MustParse("32Gi"),
+							Architecture: ptr.To("amd64"),
+							Usable:       ptr.To(true),
+							Capabilities: &gardencorev1beta1.MachineTypeCapabilities{NVMe: ptr.To(true)},
+						},
+						gardencorev1beta1.MachineType{
+							Name:         "machine-type-nvme",
+							CPU:          resource.MustParse("4"),
+							Memory:       resource.MustParse("16Gi"),
+							Architecture: ptr.To("amd64"),
+							Usable:       ptr.To(true),
+							Capabilities: &gardencorev1beta1.MachineTypeCapabilities{NVMe: ptr.To(true)},
+						},
+					)
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				})
+
+				It("should select the smallest machine type satisfying the requirements", func() {
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("machine-type-nvme"))
+				})
+
+				It("should reject if no machine type satisfies the requirements", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Requirements.Capabilities.ConfidentialCompute = ptr.To(true)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("no usable machine type which is available in all zones satisfies the requirements")))
+				})
+
+				It("should allow explicitly specified machine types satisfying the requirements", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-nvme-large"
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("machine-type-nvme-large"))
+				})
+
+				It("should reject explicitly specified machine types not satisfying the requirements", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("machine type does not satisfy the requirements")))
+				})
+
+				It("should keep the selected machine type if the requirements are unchanged", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-nvme-large"
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("machine-type-nvme-large"))
+				})
+
+				It("should select a new machine type if the previously selected one does not satisfy changed requirements", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+					oldShoot.Spec.Provider.Workers[0].Machine.Requirements = nil
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("machine-type-nvme"))
+				})
+			})
+
+			Context("confidential worker pools", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers = []core.Worker{
+						{
+							Name: "worker",
+							Machine: core.Machine{
+								Type:         "machine-type-confidential",
+								Architecture: ptr.To("amd64"),
+							},
+							Confidential: &core.WorkerConfidential{},
+						},
+					}
+
+					cloudProfile.Spec.MachineTypes = append(cloudProfile.Spec.MachineTypes,
+						gardencorev1beta1.MachineType{
+							Name:         "machine-type-confidential-large",
+							CPU:          resource.MustParse("8"),
+							Memory:       resource.MustParse("32Gi"),
+							Architecture: ptr.To("amd64"),
+							Usable:       ptr.To(true),
+							Capabilities: &gardencorev1beta1.MachineTypeCapabilities{ConfidentialCompute: ptr.To(true)},
+						},
+						gardencorev1beta1.MachineType{
+							Name:         "machine-type-confidential",
+							CPU:          resource.MustParse("4"),
+							Memory:       resource.MustParse("16Gi"),
+							Architecture: ptr.To("amd64"),
+							Usable:       ptr.To(true),
+							Capabilities: &gardencorev1beta1.MachineTypeCapabilities{ConfidentialCompute: ptr.To(true)},
+						},
+					)
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				})
+
+				It("should allow machine types supporting confidential computing", func() {
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should reject machine types not supporting confidential computing", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring(`machine type "machine-type-1" does not support confidential computing`)))
+				})
+
+				It("should allow unchanged confidential worker pools whose machine type lost the capability", func() {
+					cloudProfile.Spec.MachineTypes[len(cloudProfile.Spec.MachineTypes)-1].Capabilities = nil
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+
+					oldShoot := shoot.DeepCopy()
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should select a machine type supporting confidential computing", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Type = ""
+					shoot.Spec.Provider.Workers[0].Machine.Requirements = &core.MachineTypeRequirements{
+						CPU: ptr.To(resource.MustParse("2")),
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("machine-type-confidential"))
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Requirements.Capabilities).To(BeNil())
+				})
+
+				It("should select a new machine type if the worker pool becomes confidential", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Requirements = &core.MachineTypeRequirements{
+						CPU: ptr.To(resource.MustParse("2")),
+					}
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+					oldShoot.Spec.Provider.Workers[0].Confidential = nil
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+
+					Expect(shoot.Spec.Provider.Workers[0].Machine.Type).To(Equal("machine-type-confidential"))
+				})
+			})
+
 			Context("volume checks", func() {
 				BeforeEach(func() {
 					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
@@ -4549,6 +4918,17 @@ var _ = Describe("validator", func() {
 					Expect(err).To(HaveOccurred())
 					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot 'shoot' on seed 'seed' that is already marked for deletion"))
 				})
+
+				It("should reject update of binding if target seed is being decommissioned", func() {
+					oldShoot.Spec.SeedName = nil
+					seed.Spec.Operation = ptr.To(gardencorev1beta1.SeedOperationDecommission)
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot 'shoot' on seed 'seed' that is being decommissioned"))
+				})
 			})
 
 			Context("shootIsBeingRescheduled a.k.a Control-Plane migration", func() {
@@ -4567,6 +4947,25 @@ var _ = Describe("validator", func() {
 					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", shoot.Name, newSeedName))
 				})
 
+				It("should reject update of binding if target seed is being decommissioned", func() {
+					newSeed.Spec.Operation = ptr.To(gardencorev1beta1.SeedOperationDecommission)
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is being decommissioned", shoot.Name, newSeedName))
+				})
+
+				It("should allow update of binding if the old seed is being decommissioned", func() {
+					seed.Spec.Operation = ptr.To(gardencorev1beta1.SeedOperationDecommission)
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
 				It("should reject update of binding, because target Seed doesn't have configuration for backup", func() {
 					newSeed.Spec.Backup = nil
 
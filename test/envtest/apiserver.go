@@ -18,7 +18,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/onsi/gomega/gexec"
@@ -47,6 +49,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/retry"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/gardener/gardener/pkg/utils/test/port"
+	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
 const (
@@ -72,6 +75,14 @@ type GardenerAPIServer struct {
 	// If not specified, the minimal set of arguments to run the APIServer will
 	// be used.
 	Args []string
+	// EnabledAdmissionPlugins is a list of admission plugins that should be enabled in addition to the plugins which
+	// are enabled by default.
+	EnabledAdmissionPlugins []string
+	// DisabledAdmissionPlugins is a list of admission plugins that should be disabled, e.g., because the test does not
+	// create the objects required by them.
+	DisabledAdmissionPlugins []string
+	// FeatureGates maps names of feature gates of gardener-apiserver to their desired state.
+	FeatureGates map[string]bool
 	// StartTimeout, StopTimeout specify the time the APIServer is allowed to
 	// take when starting and stoppping before an error is emitted.
 	// If not specified, these default to 20 seconds.
@@ -249,6 +260,12 @@ func (g *GardenerAPIServer) defaultSettings() error {
 		return err
 	}
 
+	admissionAndFeatureGateArgs, err := g.admissionAndFeatureGateArgs()
+	if err != nil {
+		return err
+	}
+	g.Args = append(admissionAndFeatureGateArgs, g.Args...)
+
 	g.Args = append([]string{
 		"--bind-address=" + addr.IP.String(),
 		"--etcd-servers=" + g.EtcdURL.String(),
@@ -265,6 +282,37 @@ func (g *GardenerAPIServer) defaultSettings() error {
 	return nil
 }
 
+// admissionAndFeatureGateArgs translates the configured admission plugins and feature gates to the respective
+// gardener-apiserver flags.
+func (g *GardenerAPIServer) admissionAndFeatureGateArgs() ([]string, error) {
+	var args []string
+
+	knownPlugins := sets.New(plugin.AllPluginNames()...)
+	for _, name := range slices.Concat(g.EnabledAdmissionPlugins, g.DisabledAdmissionPlugins) {
+		if !knownPlugins.Has(name) {
+			return nil, fmt.Errorf("unknown admission plugin %q", name)
+		}
+	}
+
+	if len(g.EnabledAdmissionPlugins) > 0 {
+		args = append(args, "--enable-admission-plugins="+strings.Join(g.EnabledAdmissionPlugins, ","))
+	}
+	if len(g.DisabledAdmissionPlugins) > 0 {
+		args = append(args, "--disable-admission-plugins="+strings.Join(g.DisabledAdmissionPlugins, ","))
+	}
+
+	if len(g.FeatureGates) > 0 {
+		featureGates := make([]string, 0, len(g.FeatureGates))
+		for name, enabled := range g.FeatureGates {
+			featureGates = append(featureGates, name+"="+strconv.FormatBool(enabled))
+		}
+		slices.Sort(featureGates)
+		args = append(args, "--feature-gates="+strings.Join(featureGates, ","))
+	}
+
+	return args, nil
+}
+
 // prepareKubeconfigFile marshals the test environments rest config to a kubeconfig file in the CertDir.
 func (g *GardenerAPIServer) prepareKubeconfigFile() (string, error) {
 	kubeconfigBytes, err := g.user.KubeConfig()
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// ShootFixtureProvider contains the provider specific settings of a Shoot fixture.
+type ShootFixtureProvider struct {
+	// Type is the provider type.
+	Type string
+	// CloudProfileName is the name of the CloudProfile.
+	CloudProfileName string
+	// Region is the region of the shoot.
+	Region string
+	// SecretBindingName is the name of the SecretBinding. It is only set for shoots with workers.
+	SecretBindingName string
+	// MachineType is the machine type of the default worker pool.
+	MachineType string
+	// NetworkingType is the networking type. It is only set for shoots with workers.
+	NetworkingType string
+}
+
+// LocalShootFixtureProvider contains the settings for shoots of the local provider.
+var LocalShootFixtureProvider = ShootFixtureProvider{
+	Type:              "local",
+	CloudProfileName:  "local",
+	Region:            "local",
+	SecretBindingName: "local",
+	MachineType:       "local",
+	NetworkingType:    "calico",
+}
+
+// DefaultShootFixtureKubernetesVersion is the Kubernetes version of Shoot fixtures.
+const DefaultShootFixtureKubernetesVersion = "1.30.0"
+
+// ShootFixtureMutator mutates a Shoot fixture.
+type ShootFixtureMutator func(*gardencorev1beta1.Shoot)
+
+// NewShootFixture returns a valid Shoot object with the given name for the local provider with a single worker pool.
+// The given mutators are applied in order, e.g., to use a different provider or to make the shoot highly available,
+// workerless, dual-stack, or hibernated.
+func NewShootFixture(name string, mutators ...ShootFixtureMutator) *gardencorev1beta1.Shoot {
+	shoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: gardencorev1beta1.ShootSpec{
+			Kubernetes: gardencorev1beta1.Kubernetes{
+				Version:                     DefaultShootFixtureKubernetesVersion,
+				EnableStaticTokenKubeconfig: ptr.To(false),
+			},
+			Networking: &gardencorev1beta1.Networking{},
+			Provider: gardencorev1beta1.Provider{
+				Workers: []gardencorev1beta1.Worker{{
+					Name: "worker",
+					CRI: &gardencorev1beta1.CRI{
+						Name: gardencorev1beta1.CRINameContainerD,
+					},
+					Minimum: 1,
+					Maximum: 1,
+				}},
+			},
+		},
+	}
+
+	WithProvider(LocalShootFixtureProvider)(shoot)
+
+	for _, mutate := range mutators {
+		mutate(shoot)
+	}
+
+	return shoot
+}
+
+// WithProvider sets the provider specific settings of the Shoot.
+func WithProvider(provider ShootFixtureProvider) ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.Provider.Type = provider.Type
+		shoot.Spec.CloudProfileName = provider.CloudProfileName
+		shoot.Spec.Region = provider.Region
+
+		if len(shoot.Spec.Provider.Workers) == 0 {
+			return
+		}
+
+		shoot.Spec.SecretBindingName = ptr.To(provider.SecretBindingName)
+		for i := range shoot.Spec.Provider.Workers {
+			shoot.Spec.Provider.Workers[i].Machine.Type = provider.MachineType
+		}
+		if shoot.Spec.Networking == nil {
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{}
+		}
+		shoot.Spec.Networking.Type = ptr.To(provider.NetworkingType)
+	}
+}
+
+// WithNamespace sets the namespace of the Shoot.
+func WithNamespace(namespace string) ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Namespace = namespace
+	}
+}
+
+// WithGenerateName makes the API server generate the name of the Shoot with the given prefix.
+func WithGenerateName(prefix string) ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Name = ""
+		shoot.GenerateName = prefix
+	}
+}
+
+// WithKubernetesVersion sets the Kubernetes version of the Shoot.
+func WithKubernetesVersion(version string) ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.Kubernetes.Version = version
+	}
+}
+
+// WithExposureClass sets the name of the ExposureClass of the Shoot.
+func WithExposureClass(name string) ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.ExposureClassName = ptr.To(name)
+	}
+}
+
+// WithHighAvailability makes the control plane of the Shoot highly available with the given failure tolerance type.
+func WithHighAvailability(failureToleranceType gardencorev1beta1.FailureToleranceType) ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
+			HighAvailability: &gardencorev1beta1.HighAvailability{
+				FailureTolerance: gardencorev1beta1.FailureTolerance{
+					Type: failureToleranceType,
+				},
+			},
+		}
+	}
+}
+
+// Workerless removes the worker pools and all worker related settings from the Shoot.
+func Workerless() ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.Provider.Workers = nil
+		shoot.Spec.SecretBindingName = nil
+		shoot.Spec.Kubernetes.Kubelet = nil
+
+		if shoot.Spec.Networking != nil {
+			shoot.Spec.Networking.Type = nil
+			shoot.Spec.Networking.Nodes = nil
+			shoot.Spec.Networking.Pods = nil
+			if len(shoot.Spec.Networking.IPFamilies) == 0 && shoot.Spec.Networking.Services == nil {
+				shoot.Spec.Networking = nil
+			}
+		}
+	}
+}
+
+// WithDualStack configures the Shoot networking for IPv4 and IPv6.
+func WithDualStack() ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		if shoot.Spec.Networking == nil {
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{}
+		}
+		shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+	}
+}
+
+// Hibernated makes the Shoot being created in hibernation.
+func Hibernated() ShootFixtureMutator {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+	}
+}
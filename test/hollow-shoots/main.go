@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/test/utils/hollow"
+)
+
+const name = "hollow-shoots"
+
+func main() {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   name,
+		Short: "Generate load of hollow shoots against a garden cluster",
+		Long: `Creates hollow seeds in the garden cluster, simulates their gardenlets, and creates the given number of hollow
+shoots. Hollow shoots are scheduled and reconciled like real shoots from the perspective of gardener-apiserver,
+gardener-scheduler, and gardener-controller-manager, but nothing is deployed for them. The latencies until the shoots
+are scheduled and ready are reported at the end.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			log, err := logger.NewZapLogger(logger.InfoLevel, logger.FormatText)
+			if err != nil {
+				return fmt.Errorf("error instantiating zap logger: %w", err)
+			}
+
+			logf.SetLogger(log)
+			klog.SetLogger(log)
+
+			log = logf.Log.WithName(name)
+
+			// don't output usage on further errors raised during execution
+			cmd.SilenceUsage = true
+			// further errors will be logged properly, don't duplicate
+			cmd.SilenceErrors = true
+
+			return run(cmd.Context(), log, opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	if err := cmd.ExecuteContext(signals.SetupSignalHandler()); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	kubeconfig        string
+	seeds             int
+	shoots            int
+	project           string
+	seedCapacity      int64
+	operationDuration time.Duration
+	concurrency       int
+	cleanup           bool
+}
+
+func (o *options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kubeconfig, "kubeconfig", os.Getenv("KUBECONFIG"), "Path to the kubeconfig of the garden cluster.")
+	fs.IntVar(&o.seeds, "seeds", 10, "Number of hollow seeds to register.")
+	fs.IntVar(&o.shoots, "shoots", 1000, "Number of hollow shoots to create.")
+	fs.StringVar(&o.project, "project", hollow.Type, "Name of the project in which hollow shoots are created.")
+	fs.Int64Var(&o.seedCapacity, "seed-capacity", 250, "Number of shoots which can be scheduled to a hollow seed.")
+	fs.DurationVar(&o.operationDuration, "operation-duration", 10*time.Second, "Simulated duration of shoot operations.")
+	fs.IntVar(&o.concurrency, "concurrency", 20, "Number of shoots which are created or deleted in parallel.")
+	fs.BoolVar(&o.cleanup, "cleanup", true, "Delete the hollow shoots after the measurement.")
+}
+
+func (o *options) validate() error {
+	if o.kubeconfig == "" {
+		return fmt.Errorf("--kubeconfig must be set")
+	}
+	if o.seeds <= 0 || o.shoots <= 0 || o.seedCapacity <= 0 || o.concurrency <= 0 {
+		return fmt.Errorf("--seeds, --shoots, --seed-capacity, and --concurrency must be positive")
+	}
+	if int64(o.seeds)*o.seedCapacity < int64(o.shoots) {
+		return fmt.Errorf("the hollow seeds can only host %d shoots, increase --seeds or --seed-capacity", int64(o.seeds)*o.seedCapacity)
+	}
+	return nil
+}
+
+func run(ctx context.Context, log logr.Logger, opts *options) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", opts.kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed loading kubeconfig: %w", err)
+	}
+	// The client-side rate limits would distort the measurements otherwise.
+	restConfig.QPS = 500
+	restConfig.Burst = 1000
+
+	mgr, err := manager.New(restConfig, manager.Options{
+		Scheme:  kubernetes.GardenScheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed creating manager: %w", err)
+	}
+
+	hollowOpts := hollow.Options{
+		ProjectName:       opts.project,
+		SeedCapacity:      opts.seedCapacity,
+		OperationDuration: opts.operationDuration,
+	}
+
+	if err := (&hollow.SeedReconciler{Options: hollowOpts}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding hollow seed reconciler: %w", err)
+	}
+	if err := (&hollow.ShootReconciler{Options: hollowOpts}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding hollow shoot reconciler: %w", err)
+	}
+
+	// Use an uncached client for setting up the environment and generating the load, the manager's cache is only started
+	// later on.
+	c, err := client.New(restConfig, client.Options{Scheme: kubernetes.GardenScheme})
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	log.Info("Ensuring prerequisites", "project", opts.project)
+	if err := hollow.EnsurePrerequisites(ctx, c, hollowOpts); err != nil {
+		return err
+	}
+
+	log.Info("Registering hollow seeds", "count", opts.seeds)
+	for i := 0; i < opts.seeds; i++ {
+		if err := c.Create(ctx, hollow.NewSeed(fmt.Sprintf("%s-%d", hollow.Type, i))); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating hollow seed: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- mgr.Start(ctx)
+	}()
+
+	log.Info("Waiting until hollow seeds are ready")
+	if err := hollow.WaitUntilSeedsReady(ctx, c, opts.seeds); err != nil {
+		return fmt.Errorf("failed waiting for hollow seeds to become ready: %w", err)
+	}
+
+	load := &hollow.Load{
+		Client:  c,
+		Log:     log,
+		Options: hollowOpts,
+		Load: hollow.LoadOptions{
+			Shoots:       opts.shoots,
+			Concurrency:  opts.concurrency,
+			NamePrefix:   "hollow-",
+			PollInterval: 5 * time.Second,
+		},
+	}
+
+	result, err := load.Run(ctx)
+	if err != nil {
+		return err
+	}
+	result.Print(os.Stdout)
+
+	if opts.cleanup {
+		if err := load.Cleanup(ctx); err != nil {
+			return fmt.Errorf("failed cleaning up hollow shoots: %w", err)
+		}
+	}
+
+	cancel()
+	return <-errCh
+}
@@ -31,6 +31,7 @@ import (
 	exposureclasscontroller "github.com/gardener/gardener/pkg/controllermanager/controller/exposureclass"
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	plugin "github.com/gardener/gardener/plugin/pkg"
 	gardenerenvtest "github.com/gardener/gardener/test/envtest"
 )
 
@@ -62,7 +63,19 @@ var _ = BeforeSuite(func() {
 	By("Start test environment")
 	testEnv = &gardenerenvtest.GardenerTestEnvironment{
 		GardenerAPIServer: &gardenerenvtest.GardenerAPIServer{
-			Args: []string{"--disable-admission-plugins=DeletionConfirmation,ResourceReferenceManager,ExtensionValidator,ShootDNS,ShootExposureClass,ShootQuotaValidator,ShootTolerationRestriction,ShootValidator"},
+			// The test creates shoots before the referenced ExposureClass and without any CloudProfile, Seed or Quota, hence
+			// the admission plugins verifying these references are disabled. All other plugins of gardener-apiserver are
+			// enabled as in a real landscape.
+			DisabledAdmissionPlugins: []string{
+				plugin.PluginNameDeletionConfirmation,
+				plugin.PluginNameResourceReferenceManager,
+				plugin.PluginNameExtensionValidator,
+				plugin.PluginNameShootDNS,
+				plugin.PluginNameShootExposureClass,
+				plugin.PluginNameShootQuotaValidator,
+				plugin.PluginNameShootTolerationRestriction,
+				plugin.PluginNameShootValidator,
+			},
 		},
 	}
 
@@ -8,12 +8,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/gardener/gardener/test/framework"
 )
 
 var _ = Describe("ExposureClass controller test", func() {
@@ -40,31 +40,11 @@ var _ = Describe("ExposureClass controller test", func() {
 			},
 		}
 
-		shoot = &gardencorev1beta1.Shoot{
-			ObjectMeta: metav1.ObjectMeta{
-				GenerateName: "test-",
-				Namespace:    testNamespace.Name,
-			},
-			Spec: gardencorev1beta1.ShootSpec{
-				ExposureClassName: ptr.To(exposureClass.Name),
-				CloudProfileName:  "test-cloudprofile",
-				SecretBindingName: ptr.To("my-provider-account"),
-				Region:            "foo-region",
-				Provider: gardencorev1beta1.Provider{
-					Type: "test-provider",
-					Workers: []gardencorev1beta1.Worker{
-						{
-							Name:    "cpu-worker",
-							Minimum: 2,
-							Maximum: 2,
-							Machine: gardencorev1beta1.Machine{Type: "large"},
-						},
-					},
-				},
-				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.26.1"},
-				Networking: &gardencorev1beta1.Networking{Type: ptr.To("foo-networking")},
-			},
-		}
+		shoot = framework.NewShootFixture("",
+			framework.WithGenerateName("test-"),
+			framework.WithNamespace(testNamespace.Name),
+			framework.WithExposureClass(exposureClass.Name),
+		)
 	})
 
 	JustBeforeEach(func() {
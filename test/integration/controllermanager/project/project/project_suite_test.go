@@ -56,6 +56,7 @@ var (
 	testRunID  string
 
 	defaultResourceQuota *corev1.ResourceQuota
+	defaultLimitRange    *corev1.LimitRange
 )
 
 var _ = BeforeSuite(func() {
@@ -121,6 +122,15 @@ var _ = BeforeSuite(func() {
 		},
 	}
 
+	defaultLimitRange = &corev1.LimitRange{
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			}},
+		},
+	}
+
 	Expect((&project.Reconciler{
 		Config: config.ProjectControllerConfiguration{
 			ConcurrentSyncs: ptr.To(5),
@@ -128,6 +138,10 @@ var _ = BeforeSuite(func() {
 				Config:          defaultResourceQuota,
 				ProjectSelector: &metav1.LabelSelector{},
 			}},
+			LimitRanges: []config.LimitRangeConfiguration{{
+				Config:          defaultLimitRange,
+				ProjectSelector: &metav1.LabelSelector{},
+			}},
 		},
 		// limit exponential backoff in tests
 		RateLimiter: workqueue.NewWithMaxWaitRateLimiter(workqueue.DefaultControllerRateLimiter(), 100*time.Millisecond),
@@ -407,7 +407,7 @@ var _ = Describe("Project controller tests", func() {
 			}).Should(Succeed())
 		})
 
-		It("should not overwrite increased quota settings", func() {
+		It("should revert modified quota settings", func() {
 			waitForQuota(resourceQuota)
 
 			By("Increase quota")
@@ -417,14 +417,28 @@ var _ = Describe("Project controller tests", func() {
 				resourceQuota.Spec.Hard[resourceName] = quantity
 			}
 			Expect(testClient.Patch(ctx, resourceQuota, patch)).To(Succeed())
-			increasedQuota := resourceQuota.DeepCopy()
 
-			triggerAndWaitForReconciliation(project)
+			By("Ensure increased quota is reverted")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
+				g.Expect(resourceQuota.Spec).To(DeepEqual(defaultResourceQuota.Spec))
+			}).Should(Succeed())
+		})
 
-			By("Ensure increased quota is not overwritten")
-			Consistently(func(g Gomega) {
+		It("should apply the quota overrides of the project", func() {
+			waitForQuota(resourceQuota)
+
+			By("Override quota in project")
+			patch := client.MergeFrom(project.DeepCopy())
+			project.Spec.NamespaceResources = &gardencorev1beta1.ProjectNamespaceResources{
+				ResourceQuota: corev1.ResourceList{"count/shoots.core.gardener.cloud": resource.MustParse("200")},
+			}
+			Expect(testClient.Patch(ctx, project, patch)).To(Succeed())
+
+			By("Ensure override is applied")
+			Eventually(func(g Gomega) {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
-				g.Expect(resourceQuota.Spec).To(DeepEqual(increasedQuota.Spec))
+				g.Expect(resourceQuota.Spec.Hard).To(HaveKeyWithValue(corev1.ResourceName("count/shoots.core.gardener.cloud"), resource.MustParse("200")))
 			}).Should(Succeed())
 		})
 
@@ -445,6 +459,58 @@ var _ = Describe("Project controller tests", func() {
 		})
 	})
 
+	Describe("Default LimitRange", func() {
+		var limitRange *corev1.LimitRange
+
+		BeforeEach(func() {
+			limitRange = &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener",
+				Namespace: projectNamespaceKey.Name,
+			}}
+		})
+
+		JustBeforeEach(func() {
+			waitForProjectPhase(project, gardencorev1beta1.ProjectReady)
+
+			By("Wait for limit range to be created")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(Succeed())
+				g.Expect(limitRange.Spec).To(DeepEqual(defaultLimitRange.Spec))
+			}).Should(Succeed())
+		})
+
+		It("should revert modified limit range settings", func() {
+			By("Modify limit range")
+			patch := client.MergeFrom(limitRange.DeepCopy())
+			limitRange.Spec.Limits[0].DefaultRequest[corev1.ResourceCPU] = resource.MustParse("1")
+			Expect(testClient.Patch(ctx, limitRange, patch)).To(Succeed())
+
+			By("Ensure modification is reverted")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(Succeed())
+				g.Expect(limitRange.Spec).To(DeepEqual(defaultLimitRange.Spec))
+			}).Should(Succeed())
+		})
+
+		It("should apply the limit range overrides of the project", func() {
+			overrides := []corev1.LimitRangeItem{{
+				Type: corev1.LimitTypePersistentVolumeClaim,
+				Max:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			}}
+
+			By("Override limit range in project")
+			patch := client.MergeFrom(project.DeepCopy())
+			project.Spec.NamespaceResources = &gardencorev1beta1.ProjectNamespaceResources{LimitRange: overrides}
+			Expect(testClient.Patch(ctx, project, patch)).To(Succeed())
+
+			By("Ensure overrides are applied")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(Succeed())
+				g.Expect(limitRange.Spec.Limits).To(DeepEqual(overrides))
+			}).Should(Succeed())
+		})
+	})
+
 	Describe("Member RBAC", func() {
 		var (
 			testUserName   string
@@ -387,6 +387,25 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 		))
 	})
 
+	It("should not maintain the shoot while its reconciliation is paused", func() {
+		By("Pause reconciliation")
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Spec.Reconciliation = &gardencorev1beta1.ShootReconciliation{Paused: true, Reason: ptr.To("investigating an incident")}
+		Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+		By("Trigger maintenance")
+		Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+		By("Ensure shoot is not maintained")
+		Consistently(func(g Gomega) map[string]string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			return shoot.Annotations
+		}).Should(And(
+			HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain),
+			Not(HaveKey("shoot.gardener.cloud/tasks")),
+		))
+	})
+
 	Context("operation annotations", func() {
 		var oldGeneration int64
 
@@ -21,6 +21,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 		secret1    *corev1.Secret
 		secret2    *corev1.Secret
 		secret3    *corev1.Secret
+		secret4    *corev1.Secret
 		configMap1 *corev1.ConfigMap
 		configMap2 *corev1.ConfigMap
 		shoot      *gardencorev1beta1.Shoot
@@ -37,6 +38,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 
 		secret2 = secret1.DeepCopy()
 		secret3 = secret1.DeepCopy()
+		secret4 = secret1.DeepCopy()
 
 		configMap1 = &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
@@ -59,6 +61,10 @@ var _ = Describe("Shoot Reference controller tests", func() {
 		Expect(testClient.Create(ctx, secret3)).To(Succeed())
 		log.Info("Created Secret for test", "secret", client.ObjectKeyFromObject(secret3))
 
+		By("Create Secret4")
+		Expect(testClient.Create(ctx, secret4)).To(Succeed())
+		log.Info("Created Secret for test", "secret", client.ObjectKeyFromObject(secret4))
+
 		By("Create ConfigMap1")
 		Expect(testClient.Create(ctx, configMap1)).To(Succeed())
 		log.Info("Created ConfigMap for test", "configMap", client.ObjectKeyFromObject(configMap1))
@@ -74,6 +80,8 @@ var _ = Describe("Shoot Reference controller tests", func() {
 			Expect(client.IgnoreNotFound(testClient.Delete(ctx, secret2))).To(Succeed())
 			By("Delete Secret3")
 			Expect(client.IgnoreNotFound(testClient.Delete(ctx, secret3))).To(Succeed())
+			By("Delete Secret4")
+			Expect(client.IgnoreNotFound(testClient.Delete(ctx, secret4))).To(Succeed())
 
 			By("Delete ConfigMap1")
 			Expect(client.IgnoreNotFound(testClient.Delete(ctx, configMap1))).To(Succeed())
@@ -114,6 +122,10 @@ var _ = Describe("Shoot Reference controller tests", func() {
 				Kubernetes: gardencorev1beta1.Kubernetes{
 					Version: "1.25.1",
 					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{{
+							Name:                 "ValidatingAdmissionWebhook",
+							KubeconfigSecretName: ptr.To(secret4.Name),
+						}},
 						AuditConfig: &gardencorev1beta1.AuditConfig{
 							AuditPolicy: &gardencorev1beta1.AuditPolicy{
 								ConfigMapRef: &corev1.ObjectReference{
@@ -183,7 +195,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 		})
 
 		It("should add finalizers to the referenced secrets and configmaps", func() {
-			for _, obj := range []client.Object{secret1, secret2, secret3, configMap1, configMap2} {
+			for _, obj := range []client.Object{secret1, secret2, secret3, secret4, configMap1, configMap2} {
 				Eventually(func(g Gomega) []string {
 					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
 					return obj.GetFinalizers()
@@ -198,7 +210,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 			shoot.Spec.Resources = nil
 			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
 
-			for _, obj := range []client.Object{shoot, secret1, secret2, secret3, configMap1, configMap2} {
+			for _, obj := range []client.Object{shoot, secret1, secret2, secret3, secret4, configMap1, configMap2} {
 				Eventually(func(g Gomega) []string {
 					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
 					return obj.GetFinalizers()
@@ -241,7 +253,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 					return shoot.GetFinalizers()
 				}).ShouldNot(ContainElement("gardener.cloud/reference-protection"), shoot.GetName()+" should not have the finalizer")
 
-				for _, obj := range []client.Object{shoot2, secret1, secret2, secret3, configMap1, configMap2} {
+				for _, obj := range []client.Object{shoot2, secret1, secret2, secret3, secret4, configMap1, configMap2} {
 					Consistently(func(g Gomega) []string {
 						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
 						return obj.GetFinalizers()
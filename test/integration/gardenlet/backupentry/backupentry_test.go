@@ -373,7 +373,7 @@ var _ = Describe("BackupEntry controller tests", func() {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
 				g.Expect(backupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
 				g.Expect(backupEntry.Status.LastError).To(BeNil())
-				g.Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+				g.Expect(backupEntry).To(HaveLastOperation(gardencorev1beta1.LastOperationStateSucceeded))
 				g.Expect(backupEntry.Status.LastOperation.Progress).To(Equal(int32(100)))
 				g.Expect(backupEntry.Status.ObservedGeneration).To(Equal(backupEntry.Generation))
 			}).Should(Succeed())
@@ -388,7 +388,7 @@ var _ = Describe("BackupEntry controller tests", func() {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
 				g.Expect(backupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
 				g.Expect(backupEntry.Status.LastError).NotTo(BeNil())
-				g.Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateError))
+				g.Expect(backupEntry).To(HaveLastOperation(gardencorev1beta1.LastOperationStateError))
 			}).Should(Succeed())
 		})
 
@@ -401,7 +401,7 @@ var _ = Describe("BackupEntry controller tests", func() {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
 				g.Expect(backupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
 				g.Expect(backupEntry.Status.LastError).NotTo(BeNil())
-				g.Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateError))
+				g.Expect(backupEntry).To(HaveLastOperation(gardencorev1beta1.LastOperationStateError))
 			}).Should(Succeed())
 		})
 	})
@@ -461,7 +461,7 @@ var _ = Describe("BackupEntry controller tests", func() {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
 				g.Expect(backupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
 				g.Expect(backupEntry.Status.LastError).To(BeNil())
-				g.Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+				g.Expect(backupEntry).To(HaveLastOperation(gardencorev1beta1.LastOperationStateSucceeded))
 				g.Expect(backupEntry.Status.LastOperation.Progress).To(Equal(int32(100)))
 				g.Expect(backupEntry.Status.ObservedGeneration).To(Equal(backupEntry.Generation))
 			}).Should(Succeed())
@@ -513,7 +513,7 @@ var _ = Describe("BackupEntry controller tests", func() {
 				g.Expect(backupEntry.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
 				g.Expect(backupEntry.Status.LastError).To(BeNil())
 				g.Expect(backupEntry.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeRestore))
-				g.Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+				g.Expect(backupEntry).To(HaveLastOperation(gardencorev1beta1.LastOperationStateSucceeded))
 				g.Expect(backupEntry.Status.LastOperation.Progress).To(Equal(int32(100)))
 				g.Expect(backupEntry.Status.ObservedGeneration).To(Equal(backupEntry.Generation))
 			}).Should(Succeed())
@@ -529,7 +529,7 @@ var _ = Describe("BackupEntry controller tests", func() {
 			Eventually(func(g Gomega) {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(backupEntry), backupEntry)).To(Succeed())
 				g.Expect(backupEntry.Status.LastError).To(BeNil())
-				g.Expect(backupEntry.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+				g.Expect(backupEntry).To(HaveLastOperation(gardencorev1beta1.LastOperationStateSucceeded))
 				g.Expect(backupEntry.Status.LastOperation.Progress).To(Equal(int32(100)))
 				g.Expect(backupEntry.Status.ObservedGeneration).To(Equal(backupEntry.Generation))
 			}).Should(Succeed())
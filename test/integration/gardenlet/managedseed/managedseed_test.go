@@ -17,7 +17,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	gardenletv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
@@ -309,10 +308,7 @@ var _ = Describe("ManagedSeed controller test", func() {
 		It("should set the ShootReconciled status of ManagedSeed to false because the shoot is not yet reconciled", func() {
 			Eventually(func(g Gomega) {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedSeed), managedSeed)).To(Succeed())
-				condition := v1beta1helper.GetCondition(managedSeed.Status.Conditions, seedmanagementv1alpha1.ManagedSeedShootReconciled)
-				g.Expect(condition).NotTo(BeNil())
-				g.Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
-				g.Expect(condition.Reason).To(Equal(gardencorev1beta1.EventReconciling))
+				g.Expect(managedSeed).To(HaveCondition(seedmanagementv1alpha1.ManagedSeedShootReconciled, gardencorev1beta1.ConditionFalse, gardencorev1beta1.EventReconciling))
 			}).Should(Succeed())
 		})
 	})
@@ -325,10 +321,7 @@ var _ = Describe("ManagedSeed controller test", func() {
 		It("should set the ShootReconciled status to true,create seed secrets specified in spec.backup.secretRef and spec.secretRef field of seed template and deploy gardenlet ", func() {
 			Eventually(func(g Gomega) {
 				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedSeed), managedSeed)).To(Succeed())
-				condition := v1beta1helper.GetCondition(managedSeed.Status.Conditions, seedmanagementv1alpha1.ManagedSeedShootReconciled)
-				g.Expect(condition).NotTo(BeNil())
-				g.Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
-				g.Expect(condition.Reason).To(Equal(gardencorev1beta1.EventReconciled))
+				g.Expect(managedSeed).To(HaveCondition(seedmanagementv1alpha1.ManagedSeedShootReconciled, gardencorev1beta1.ConditionTrue, gardencorev1beta1.EventReconciled))
 			}).Should(Succeed())
 
 			checkIfSeedSecretsCreated()
@@ -16,6 +16,7 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/utils/ptr"
@@ -901,6 +902,46 @@ var _ = Describe("HighAvailabilityConfig tests", func() {
 				func() corev1.PodSpec { return deployment.Spec.Template.Spec },
 				func(mutate func(spec *corev1.PodSpec)) { mutate(&deployment.Spec.Template.Spec) },
 			)
+
+			Context("when namespace is annotated with unavailable zones", func() {
+				BeforeEach(func() {
+					metav1.SetMetaDataLabel(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigConsider, "true")
+					metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigZones, strings.Join(zones, ","))
+					metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigFailureToleranceType, "zone")
+				})
+
+				It("should exclude the unavailable zones from the node affinity", func() {
+					metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigUnavailableZones, "b")
+					Expect(testClient.Update(ctx, namespace)).To(Succeed())
+
+					metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, "foo", "bar")
+					Expect(testClient.Update(ctx, deployment)).To(Succeed())
+
+					Expect(deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelTopologyZone,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{"a", "c"},
+						}},
+					}))
+				})
+
+				It("should not exclude the zones if all of them are unavailable", func() {
+					metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigUnavailableZones, strings.Join(zones, ","))
+					Expect(testClient.Update(ctx, namespace)).To(Succeed())
+
+					metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, "foo", "bar")
+					Expect(testClient.Update(ctx, deployment)).To(Succeed())
+
+					Expect(deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelTopologyZone,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   zones,
+						}},
+					}))
+				})
+			})
 		})
 
 		Context("for statefulsets", func() {
@@ -921,6 +962,89 @@ var _ = Describe("HighAvailabilityConfig tests", func() {
 				func() corev1.PodSpec { return statefulSet.Spec.Template.Spec },
 				func(mutate func(spec *corev1.PodSpec)) { mutate(&statefulSet.Spec.Template.Spec) },
 			)
+
+			Context("when statefulset has volumes bound to zones", func() {
+				var (
+					pv        *corev1.PersistentVolume
+					claimName func() string
+				)
+
+				BeforeEach(func() {
+					metav1.SetMetaDataLabel(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigConsider, "true")
+					metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigZones, "a,b")
+					metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, resourcesv1alpha1.HighAvailabilityConfigFailureToleranceType, "zone")
+
+					statefulSet.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{
+						ObjectMeta: metav1.ObjectMeta{Name: "data"},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							Resources:   corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")}},
+						},
+					}}
+
+					pv = &corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{Name: testIDPrefix + "-" + utils.ComputeSHA256Hex([]byte(uuid.NewUUID()))[:8]},
+						Spec: corev1.PersistentVolumeSpec{
+							AccessModes:            []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+							Capacity:               corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+							PersistentVolumeSource: corev1.PersistentVolumeSource{CSI: &corev1.CSIPersistentVolumeSource{Driver: "foo", VolumeHandle: "bar"}},
+							NodeAffinity: &corev1.VolumeNodeAffinity{Required: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+								MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "topology.foo.csi.example.com/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"c"}}},
+							}}}},
+						},
+					}
+
+					claimName = func() string { return "data-" + statefulSet.Name + "-0" }
+				})
+
+				JustBeforeEach(func() {
+					By("Create PersistentVolume")
+					Expect(testClient.Create(ctx, pv)).To(Succeed())
+					DeferCleanup(func() {
+						Expect(testClient.Delete(ctx, pv)).To(Succeed())
+					})
+
+					By("Create PersistentVolumeClaim")
+					pvc := &corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: claimName(), Namespace: namespace.Name},
+						Spec:       statefulSet.Spec.VolumeClaimTemplates[0].Spec,
+					}
+					pvc.Spec.VolumeName = pv.Name
+					Expect(testClient.Create(ctx, pvc)).To(Succeed())
+				})
+
+				It("should add the zones of the existing volumes to the node affinity", func() {
+					metav1.SetMetaDataAnnotation(&statefulSet.ObjectMeta, "foo", "bar")
+					Expect(testClient.Update(ctx, statefulSet)).To(Succeed())
+
+					Expect(statefulSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelTopologyZone,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{"a", "b", "c"},
+						}},
+					}))
+				})
+
+				Context("when the volume belongs to another statefulset", func() {
+					BeforeEach(func() {
+						claimName = func() string { return "data-other-0" }
+					})
+
+					It("should not consider the zones of the volume", func() {
+						metav1.SetMetaDataAnnotation(&statefulSet.ObjectMeta, "foo", "bar")
+						Expect(testClient.Update(ctx, statefulSet)).To(Succeed())
+
+						Expect(statefulSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      corev1.LabelTopologyZone,
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"a", "b"},
+							}},
+						}))
+					})
+				})
+			})
 		})
 	})
 
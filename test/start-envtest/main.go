@@ -16,6 +16,7 @@ import (
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -74,13 +75,19 @@ const (
 var supportedTypes = sets.New(typeKubernetes, typeGardener)
 
 type options struct {
-	environmentType string
-	kubeconfig      string
+	environmentType          string
+	kubeconfig               string
+	enabledAdmissionPlugins  []string
+	disabledAdmissionPlugins []string
+	featureGates             map[string]bool
 }
 
 func (o *options) addFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.environmentType, "environment-type", typeKubernetes, fmt.Sprintf("Type of environment to start. Supported values: %s", strings.Join(sets.List(supportedTypes), ", ")))
 	fs.StringVar(&o.kubeconfig, "kubeconfig", path.Join("..", "..", "dev", "envtest-kubeconfig.yaml"), "File to place the environment's admin kubeconfig in.")
+	fs.StringSliceVar(&o.enabledAdmissionPlugins, "enable-admission-plugins", nil, "Admission plugins of gardener-apiserver that should be enabled in addition to the default plugins (only for environment type gardener).")
+	fs.StringSliceVar(&o.disabledAdmissionPlugins, "disable-admission-plugins", nil, "Admission plugins of gardener-apiserver that should be disabled (only for environment type gardener).")
+	fs.Var(cliflag.NewMapStringBool(&o.featureGates), "feature-gates", "A set of key=value pairs that describe feature gates of gardener-apiserver (only for environment type gardener).")
 }
 
 func (o *options) validate() error {
@@ -105,7 +112,10 @@ func run(ctx context.Context, log logr.Logger, opts *options) error {
 		testEnv = &gardenerenvtest.GardenerTestEnvironment{
 			Environment: kubeEnvironment,
 			GardenerAPIServer: &gardenerenvtest.GardenerAPIServer{
-				Args: []string{"--disable-admission-plugins="},
+				Args:                     []string{"--disable-admission-plugins="},
+				EnabledAdmissionPlugins:  opts.enabledAdmissionPlugins,
+				DisabledAdmissionPlugins: opts.disabledAdmissionPlugins,
+				FeatureGates:             opts.featureGates,
 			},
 		}
 	}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hollow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// LabelHollow is the label key which marks objects as hollow, i.e., the seeds which are simulated by the hollow
+	// gardenlet and all other objects created for scale tests.
+	LabelHollow = "test.gardener.cloud/hollow"
+	// Type is the provider type and region of hollow seeds and shoots.
+	Type = "hollow"
+	// KubernetesVersion is the Kubernetes version of hollow shoots.
+	KubernetesVersion = "1.30.0"
+	// DNSSecretName is the name of the secret which is referenced as DNS provider secret by hollow seeds.
+	DNSSecretName = "hollow-dns"
+)
+
+// Options configures the hollow seeds and shoots.
+type Options struct {
+	// ProjectName is the name of the project in which hollow shoots are created.
+	ProjectName string
+	// SeedCapacity is the number of shoots which can be scheduled to a hollow seed.
+	SeedCapacity int64
+	// OperationDuration is the simulated duration of shoot operations. If it is zero, operations succeed immediately.
+	OperationDuration time.Duration
+}
+
+// ProjectNamespace returns the namespace of the project in which hollow shoots are created.
+func (o Options) ProjectNamespace() string {
+	return "garden-" + o.ProjectName
+}
+
+// EnsurePrerequisites creates the objects which are required for creating hollow seeds and shoots if they do not exist
+// yet, i.e., a CloudProfile, a ControllerRegistration for the hollow provider type, the secret referenced by the seeds,
+// the namespace for the seed leases, and the project for the hollow shoots.
+func EnsurePrerequisites(ctx context.Context, c client.Client, opts Options) error {
+	var (
+		labels       = map[string]string{LabelHollow: "true"}
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: Type, Labels: labels},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type:       Type,
+				Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{{Version: KubernetesVersion}}},
+				Regions:    []gardencorev1beta1.Region{{Name: Type}},
+				// Machine images and types are not needed for workerless shoots but required by the validation.
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name: Type,
+					Versions: []gardencorev1beta1.MachineImageVersion{{
+						ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"},
+						CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+					}},
+				}},
+				MachineTypes: []gardencorev1beta1.MachineType{{Name: Type}},
+			},
+		}
+		controllerRegistration = &gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: Type, Labels: labels},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{
+				// No deployment is specified since the extension resources are never created for hollow shoots. The
+				// registration is only needed for passing the validation of seeds and shoots with the hollow provider type.
+				Resources: []gardencorev1beta1.ControllerResource{
+					{Kind: extensionsv1alpha1.ControlPlaneResource, Type: Type},
+					{Kind: extensionsv1alpha1.DNSRecordResource, Type: Type},
+					{Kind: extensionsv1alpha1.InfrastructureResource, Type: Type},
+					{Kind: extensionsv1alpha1.WorkerResource, Type: Type},
+				},
+			},
+		}
+		gardenNamespace    = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.GardenNamespace}}
+		seedLeaseNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: gardencorev1beta1.GardenerSeedLeaseNamespace}}
+		dnsSecret          = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: DNSSecretName, Namespace: v1beta1constants.GardenNamespace, Labels: labels},
+			Type:       corev1.SecretTypeOpaque,
+		}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: opts.ProjectName, Labels: labels},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To(opts.ProjectNamespace())},
+		}
+	)
+
+	for _, obj := range []client.Object{cloudProfile, controllerRegistration, gardenNamespace, seedLeaseNamespace, dnsSecret, project} {
+		if err := c.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating %T %s: %w", obj, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	// The project namespace is created by gardener-controller-manager, hence wait until the project is ready before
+	// shoots are created in it.
+	return wait.PollUntilContextTimeout(ctx, time.Second, 2*time.Minute, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(project), project); err != nil {
+			return false, err
+		}
+		return project.Status.Phase == gardencorev1beta1.ProjectReady, nil
+	})
+}
+
+// WaitUntilSeedsReady waits until the given number of hollow seeds have been reconciled successfully and are hence
+// considered by gardener-scheduler.
+func WaitUntilSeedsReady(ctx context.Context, c client.Reader, count int) error {
+	return wait.PollUntilContextTimeout(ctx, time.Second, 2*time.Minute, true, func(ctx context.Context) (bool, error) {
+		seedList := &gardencorev1beta1.SeedList{}
+		if err := c.List(ctx, seedList, client.MatchingLabels{LabelHollow: "true"}); err != nil {
+			return false, err
+		}
+
+		ready := 0
+		for _, seed := range seedList.Items {
+			condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady)
+			if seed.Status.LastOperation != nil && condition != nil && condition.Status == gardencorev1beta1.ConditionTrue {
+				ready++
+			}
+		}
+		return ready >= count, nil
+	})
+}
+
+// NewSeed returns a hollow seed with the given name.
+func NewSeed(name string) *gardencorev1beta1.Seed {
+	return &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{LabelHollow: "true"},
+		},
+		Spec: gardencorev1beta1.SeedSpec{
+			Provider: gardencorev1beta1.SeedProvider{
+				Type:   Type,
+				Region: Type,
+			},
+			Ingress: &gardencorev1beta1.Ingress{
+				Domain:     name + ".hollow.example.com",
+				Controller: gardencorev1beta1.IngressController{Kind: v1beta1constants.IngressKindNginx},
+			},
+			DNS: gardencorev1beta1.SeedDNS{
+				Provider: &gardencorev1beta1.SeedDNSProvider{
+					Type:      Type,
+					SecretRef: corev1.SecretReference{Name: DNSSecretName, Namespace: v1beta1constants.GardenNamespace},
+				},
+			},
+			Networks: gardencorev1beta1.SeedNetworks{
+				Nodes:    ptr.To("10.0.0.0/16"),
+				Pods:     "10.1.0.0/16",
+				Services: "10.2.0.0/16",
+			},
+			Settings: &gardencorev1beta1.SeedSettings{
+				Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+			},
+		},
+	}
+}
+
+// NewShoot returns a hollow shoot with the given name in the given namespace. Hollow shoots are workerless and use
+// unmanaged DNS, so that no further objects (e.g., SecretBindings) are required for creating them.
+func NewShoot(name, namespace string) *gardencorev1beta1.Shoot {
+	return &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{LabelHollow: "true"},
+		},
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfileName: Type,
+			Region:           Type,
+			Provider:         gardencorev1beta1.Provider{Type: Type},
+			Kubernetes:       gardencorev1beta1.Kubernetes{Version: KubernetesVersion},
+			DNS: &gardencorev1beta1.DNS{
+				Providers: []gardencorev1beta1.DNSProvider{{Type: ptr.To(core.DNSUnmanaged)}},
+			},
+		},
+	}
+}
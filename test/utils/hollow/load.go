@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hollow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// LoadOptions configures the load which is generated against the garden cluster.
+type LoadOptions struct {
+	// Shoots is the number of hollow shoots to create.
+	Shoots int
+	// Concurrency is the number of shoots which are created or deleted in parallel.
+	Concurrency int
+	// NamePrefix is the prefix of the names of the hollow shoots.
+	NamePrefix string
+	// PollInterval is the interval in which the state of the shoots is checked.
+	PollInterval time.Duration
+}
+
+// Result contains the latencies measured for the hollow shoots.
+type Result struct {
+	// SchedulingLatencies are the durations between creating the shoots and the shoots being scheduled to a seed.
+	SchedulingLatencies []time.Duration
+	// ReadyLatencies are the durations between creating the shoots and the shoots' create operations having succeeded.
+	ReadyLatencies []time.Duration
+	// Total is the duration between starting the creation of the first shoot and all shoots being ready.
+	Total time.Duration
+}
+
+// Load creates hollow shoots in the project namespace and waits until all of them are scheduled and their create
+// operations have succeeded.
+type Load struct {
+	Client  client.Client
+	Log     logr.Logger
+	Options Options
+	Load    LoadOptions
+}
+
+// Run creates the hollow shoots and measures the latencies until they are scheduled and ready.
+func (l *Load) Run(ctx context.Context) (*Result, error) {
+	var (
+		namespace = l.Options.ProjectNamespace()
+		start     = time.Now()
+
+		lock         sync.Mutex
+		creationTime = make(map[string]time.Time, l.Load.Shoots)
+		scheduled    = make(map[string]time.Duration, l.Load.Shoots)
+		ready        = make(map[string]time.Duration, l.Load.Shoots)
+
+		fns = make([]flow.TaskFn, 0, l.Load.Shoots)
+	)
+
+	for i := 0; i < l.Load.Shoots; i++ {
+		name := fmt.Sprintf("%s%d", l.Load.NamePrefix, i)
+		fns = append(fns, func(ctx context.Context) error {
+			created := time.Now()
+			if err := l.Client.Create(ctx, NewShoot(name, namespace)); err != nil {
+				return fmt.Errorf("failed creating shoot %s/%s: %w", namespace, name, err)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			creationTime[name] = created
+			return nil
+		})
+	}
+
+	l.Log.Info("Creating hollow shoots", "count", l.Load.Shoots, "namespace", namespace, "concurrency", l.Load.Concurrency)
+	if err := flow.ParallelN(l.Load.Concurrency, fns...)(ctx); err != nil {
+		return nil, err
+	}
+
+	l.Log.Info("Waiting until hollow shoots are scheduled and ready")
+	if err := wait.PollUntilContextCancel(ctx, l.Load.PollInterval, true, func(ctx context.Context) (bool, error) {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := l.Client.List(ctx, shootList, client.InNamespace(namespace), client.MatchingLabels{LabelHollow: "true"}); err != nil {
+			return false, err
+		}
+
+		now := time.Now()
+		for _, shoot := range shootList.Items {
+			created, ok := creationTime[shoot.Name]
+			if !ok {
+				continue
+			}
+
+			if _, ok := scheduled[shoot.Name]; !ok && shoot.Spec.SeedName != nil {
+				scheduled[shoot.Name] = now.Sub(created)
+			}
+
+			if _, ok := ready[shoot.Name]; !ok && shoot.Status.LastOperation != nil &&
+				shoot.Status.LastOperation.Type == gardencorev1beta1.LastOperationTypeCreate &&
+				shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+				ready[shoot.Name] = now.Sub(created)
+			}
+		}
+
+		l.Log.Info("Hollow shoots status", "total", len(creationTime), "scheduled", len(scheduled), "ready", len(ready))
+		return len(ready) == len(creationTime), nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed waiting for hollow shoots to become ready: %w", err)
+	}
+
+	result := &Result{Total: time.Since(start)}
+	for _, latency := range scheduled {
+		result.SchedulingLatencies = append(result.SchedulingLatencies, latency)
+	}
+	for _, latency := range ready {
+		result.ReadyLatencies = append(result.ReadyLatencies, latency)
+	}
+
+	return result, nil
+}
+
+// Cleanup deletes all hollow shoots in the project namespace and waits until they are gone.
+func (l *Load) Cleanup(ctx context.Context) error {
+	namespace := l.Options.ProjectNamespace()
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := l.Client.List(ctx, shootList, client.InNamespace(namespace), client.MatchingLabels{LabelHollow: "true"}); err != nil {
+		return fmt.Errorf("failed listing hollow shoots: %w", err)
+	}
+
+	l.Log.Info("Deleting hollow shoots", "count", len(shootList.Items), "namespace", namespace)
+
+	var fns []flow.TaskFn
+	for _, s := range shootList.Items {
+		shoot := s.DeepCopy()
+		fns = append(fns, func(ctx context.Context) error {
+			patch := client.MergeFrom(shoot.DeepCopy())
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ConfirmationDeletion, "true")
+			if err := l.Client.Patch(ctx, shoot, patch); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+			return client.IgnoreNotFound(l.Client.Delete(ctx, shoot))
+		})
+	}
+
+	if err := flow.ParallelN(l.Load.Concurrency, fns...)(ctx); err != nil {
+		return err
+	}
+
+	return wait.PollUntilContextCancel(ctx, l.Load.PollInterval, true, func(ctx context.Context) (bool, error) {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := l.Client.List(ctx, shootList, client.InNamespace(namespace), client.MatchingLabels{LabelHollow: "true"}); err != nil {
+			return false, err
+		}
+		l.Log.Info("Waiting for hollow shoots to be deleted", "remaining", len(shootList.Items))
+		return len(shootList.Items) == 0, nil
+	})
+}
+
+// Print writes a summary of the measured latencies to the given writer.
+func (r *Result) Print(w io.Writer) {
+	fmt.Fprintf(w, "Total duration: %s\n", r.Total.Round(time.Millisecond))
+	printLatencies(w, "Scheduling latency", r.SchedulingLatencies)
+	printLatencies(w, "Ready latency", r.ReadyLatencies)
+}
+
+func printLatencies(w io.Writer, name string, latencies []time.Duration) {
+	if len(latencies) == 0 {
+		fmt.Fprintf(w, "%s: no samples\n", name)
+		return
+	}
+
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	percentile := func(p int) time.Duration {
+		return sorted[(len(sorted)-1)*p/100].Round(time.Millisecond)
+	}
+
+	fmt.Fprintf(w, "%s (%d samples): min=%s p50=%s p90=%s p99=%s max=%s\n",
+		name, len(sorted), sorted[0].Round(time.Millisecond), percentile(50), percentile(90), percentile(99), sorted[len(sorted)-1].Round(time.Millisecond))
+}
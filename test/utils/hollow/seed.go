@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hollow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// leaseDuration is the duration of the seed leases. Similar to gardenlet, the leases are renewed in this interval.
+const leaseDuration = 10 * time.Second
+
+// SeedReconciler simulates the gardenlets of all hollow seeds. It renews the seed leases and reports the seeds as ready
+// and healthy, so that shoots can be scheduled to them.
+type SeedReconciler struct {
+	Client   client.Client
+	Clock    clock.Clock
+	Identity *gardencorev1beta1.Gardener
+	Options  Options
+}
+
+// AddToManager adds SeedReconciler to the given manager.
+func (r *SeedReconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Identity == nil {
+		r.Identity = &gardencorev1beta1.Gardener{ID: Type, Name: Type, Version: "v0.0.0-" + Type}
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("hollow-seed").
+		For(&gardencorev1beta1.Seed{}, builder.WithPredicates(IsHollow(), predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 5,
+			RecoverPanic:            ptr.To(true),
+		}).
+		Complete(r)
+}
+
+// Reconcile renews the lease of the hollow seed and updates its status.
+func (r *SeedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if seed.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.renewLease(ctx, seed); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed renewing lease: %w", err)
+	}
+
+	if err := r.updateStatus(ctx, seed); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: leaseDuration}, nil
+}
+
+func (r *SeedReconciler) renewLease(ctx context.Context, seed *gardencorev1beta1.Seed) error {
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      seed.Name,
+			Namespace: gardencorev1beta1.GardenerSeedLeaseNamespace,
+		},
+	}
+
+	_, err := controllerutils.CreateOrGetAndMergePatch(ctx, r.Client, lease, func() error {
+		lease.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+			Kind:       "Seed",
+			Name:       seed.Name,
+			UID:        seed.UID,
+		}}
+		lease.Spec.HolderIdentity = ptr.To(seed.Name)
+		lease.Spec.LeaseDurationSeconds = ptr.To(int32(leaseDuration / time.Second))
+		lease.Spec.RenewTime = &metav1.MicroTime{Time: r.Clock.Now()}
+		return nil
+	})
+	return err
+}
+
+func (r *SeedReconciler) updateStatus(ctx context.Context, seed *gardencorev1beta1.Seed) error {
+	var (
+		patch = client.MergeFrom(seed.DeepCopy())
+		now   = metav1.NewTime(r.Clock.Now())
+
+		gardenletReady = v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady)
+		systemHealthy  = v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedSystemComponentsHealthy)
+	)
+
+	seed.Status.Conditions = v1beta1helper.MergeConditions(seed.Status.Conditions,
+		v1beta1helper.UpdatedConditionWithClock(r.Clock, gardenletReady, gardencorev1beta1.ConditionTrue, "GardenletReady", "Hollow gardenlet is posting ready status."),
+		v1beta1helper.UpdatedConditionWithClock(r.Clock, systemHealthy, gardencorev1beta1.ConditionTrue, "SystemComponentsRunning", "All system components of the hollow seed are healthy."),
+	)
+	seed.Status.Gardener = r.Identity
+	seed.Status.KubernetesVersion = ptr.To(KubernetesVersion)
+	seed.Status.ClusterIdentity = ptr.To(seed.Name)
+	seed.Status.ObservedGeneration = seed.Generation
+	seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(r.Options.SeedCapacity, resource.DecimalSI)}
+
+	if seed.Status.LastOperation == nil || seed.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:           gardencorev1beta1.LastOperationTypeReconcile,
+			State:          gardencorev1beta1.LastOperationStateSucceeded,
+			Progress:       100,
+			Description:    "Hollow seed has been reconciled.",
+			LastUpdateTime: now,
+		}
+	}
+
+	return r.Client.Status().Patch(ctx, seed, patch)
+}
+
+// IsHollow returns a predicate which returns true for objects which are labeled as hollow.
+func IsHollow() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[LabelHollow] == "true"
+	})
+}
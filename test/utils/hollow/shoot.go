@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hollow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ShootReconciler simulates the gardenlets of all hollow seeds for the shoots scheduled to them. It does not deploy
+// anything but only reports the operations of the shoots as succeeded after the configured operation duration.
+type ShootReconciler struct {
+	Client   client.Client
+	Clock    clock.Clock
+	Identity *gardencorev1beta1.Gardener
+	Options  Options
+}
+
+// AddToManager adds ShootReconciler to the given manager.
+func (r *ShootReconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Identity == nil {
+		r.Identity = &gardencorev1beta1.Gardener{ID: Type, Name: Type, Version: "v0.0.0-" + Type}
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named("hollow-shoot").
+		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				shoot, ok := obj.(*gardencorev1beta1.Shoot)
+				return ok && shoot.Spec.SeedName != nil
+			}),
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}),
+		)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 50,
+			RecoverPanic:            ptr.To(true),
+		}).
+		Complete(r)
+}
+
+// Reconcile simulates the operations of shoots scheduled to hollow seeds.
+func (r *ShootReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, req.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.Spec.SeedName == nil {
+		return reconcile.Result{}, nil
+	}
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: *shoot.Spec.SeedName}, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed getting seed %s: %w", *shoot.Spec.SeedName, err)
+	}
+
+	if seed.Labels[LabelHollow] != "true" {
+		// The shoot is not scheduled to a hollow seed and hence handled by a real gardenlet.
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return r.delete(ctx, log, shoot)
+	}
+	return r.reconcile(ctx, log, shoot)
+}
+
+func (r *ShootReconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(shoot, gardencorev1beta1.GardenerName) {
+		log.V(1).Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.Client, shoot, gardencorev1beta1.GardenerName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed adding finalizer: %w", err)
+		}
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation != nil &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded &&
+		shoot.Status.ObservedGeneration == shoot.Generation &&
+		shoot.Annotations[v1beta1constants.GardenerOperation] != v1beta1constants.GardenerOperationReconcile {
+		return reconcile.Result{}, nil
+	}
+
+	operationType := gardencorev1beta1.LastOperationTypeReconcile
+	if lastOperation == nil || (lastOperation.Type == gardencorev1beta1.LastOperationTypeCreate && lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded) {
+		operationType = gardencorev1beta1.LastOperationTypeCreate
+	}
+
+	if v, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; ok && v == v1beta1constants.GardenerOperationReconcile {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing operation annotation: %w", err)
+		}
+	}
+
+	if result, err := r.simulateOperation(ctx, log, shoot, operationType); err != nil || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	log.Info("Hollow shoot operation succeeded", "operation", operationType)
+	return reconcile.Result{}, r.patchStatus(ctx, shoot, operationType, gardencorev1beta1.LastOperationStateSucceeded)
+}
+
+func (r *ShootReconciler) delete(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
+	if !controllerutil.ContainsFinalizer(shoot, gardencorev1beta1.GardenerName) {
+		return reconcile.Result{}, nil
+	}
+
+	if result, err := r.simulateOperation(ctx, log, shoot, gardencorev1beta1.LastOperationTypeDelete); err != nil || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	log.Info("Hollow shoot operation succeeded, removing finalizer", "operation", gardencorev1beta1.LastOperationTypeDelete)
+	if err := controllerutils.RemoveFinalizers(ctx, r.Client, shoot, gardencorev1beta1.GardenerName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed removing finalizer: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// simulateOperation marks the given operation as processing and requests a requeue until the configured operation
+// duration has passed.
+func (r *ShootReconciler) simulateOperation(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType) (reconcile.Result, error) {
+	if r.Options.OperationDuration <= 0 {
+		return reconcile.Result{}, nil
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation == nil ||
+		lastOperation.Type != operationType ||
+		lastOperation.State != gardencorev1beta1.LastOperationStateProcessing ||
+		shoot.Status.ObservedGeneration != shoot.Generation {
+		log.Info("Hollow shoot operation started", "operation", operationType)
+		if err := r.patchStatus(ctx, shoot, operationType, gardencorev1beta1.LastOperationStateProcessing); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Options.OperationDuration}, nil
+	}
+
+	if remaining := r.Options.OperationDuration - r.Clock.Since(lastOperation.LastUpdateTime.Time); remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *ShootReconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType, state gardencorev1beta1.LastOperationState) error {
+	var (
+		patch    = client.MergeFrom(shoot.DeepCopy())
+		now      = metav1.NewTime(r.Clock.Now())
+		progress = int32(0)
+	)
+
+	if state == gardencorev1beta1.LastOperationStateSucceeded {
+		progress = 100
+
+		var conditions []gardencorev1beta1.Condition
+		for _, conditionType := range gardenerutils.GetShootConditionTypes(true) {
+			condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Conditions, conditionType)
+			conditions = append(conditions, v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "HollowShootHealthy", "Hollow shoot is healthy."))
+		}
+		shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, conditions...)
+	}
+
+	shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+		Type:           operationType,
+		State:          state,
+		Progress:       progress,
+		Description:    fmt.Sprintf("Hollow shoot operation %s is %s.", operationType, state),
+		LastUpdateTime: now,
+	}
+	shoot.Status.Gardener = *r.Identity
+	shoot.Status.ObservedGeneration = shoot.Generation
+	shoot.Status.SeedName = shoot.Spec.SeedName
+	shoot.Status.UID = shoot.UID
+	shoot.Status.IsHibernated = v1beta1helper.HibernationIsEnabled(shoot)
+	if shoot.Status.TechnicalID == "" {
+		shoot.Status.TechnicalID = gardenerutils.ComputeTechnicalID(r.Options.ProjectName, shoot)
+	}
+
+	if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching status: %w", err)
+	}
+	return nil
+}